@@ -0,0 +1,178 @@
+// Command loadgen drives the stock watcher and CheckStock handler with
+// simulated users against the mock Best Buy client and reports latency and
+// throughput. Numbers from a reference run are kept in BENCHMARKS.md.
+//
+//	go run ./cmd/loadgen -users 500 -cycles 5 -latency 100ms
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/handler"
+	"github.com/tmcauley/stock-checker/backend/internal/loadtest"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+func main() {
+	users := flag.Int("users", 100, "simulated users")
+	products := flag.Int("products", 3, "products on each user's watchlist")
+	stores := flag.Int("stores", 3, "stores on each user's watchlist")
+	postalCodes := flag.Int("postal-codes", 5, "distinct postal codes users are spread across")
+	cycles := flag.Int("cycles", 3, "watcher cycles to run")
+	requests := flag.Int("requests", 1000, "CheckStock requests to send")
+	concurrency := flag.Int("concurrency", 20, "concurrent CheckStock callers")
+	latency := flag.Duration("latency", 100*time.Millisecond, "simulated Best Buy API latency per call")
+	flag.Parse()
+
+	ctx := context.Background()
+	client := bestbuy.NewMockClientWithLatency(*latency)
+
+	codes := make([]string, *postalCodes)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("%05d", 94100+i)
+	}
+
+	targets, err := loadtest.Watchlists(ctx, client, *users, *products, *stores, codes)
+	if err != nil {
+		log.Fatalf("Failed to build watchlists: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "users\t%d\n", *users)
+	fmt.Fprintf(w, "watch targets\t%d\n", len(targets))
+	fmt.Fprintf(w, "api latency\t%v\n", *latency)
+	w.Flush()
+
+	runWatcher(ctx, client, targets, *cycles)
+	runCheckStock(ctx, client, targets, *requests, *concurrency)
+}
+
+// runWatcher runs watcher cycles over the watchlists and reports cycle time
+func runWatcher(ctx context.Context, client bestbuy.Client, targets []database.WatchTarget, cycles int) {
+	store := loadtest.NewMemoryStore(targets)
+	sink := &loadtest.CountingSink{}
+	p := poller.New(client, store, sink, nil, poller.Config{})
+
+	var durations []time.Duration
+	for i := 0; i < cycles; i++ {
+		start := time.Now()
+		if err := p.RunCycle(ctx); err != nil {
+			log.Fatalf("Cycle %d failed: %v", i+1, err)
+		}
+		durations = append(durations, time.Since(start))
+	}
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "\nWATCHER")
+	fmt.Fprintf(w, "cycles\t%d\n", cycles)
+	fmt.Fprintf(w, "mean cycle\t%v\n", (total / time.Duration(cycles)).Round(time.Millisecond))
+	fmt.Fprintf(w, "targets/sec\t%.0f\n", float64(len(targets)*cycles)/total.Seconds())
+	fmt.Fprintf(w, "alerts\t%d\n", sink.Alerts())
+	fmt.Fprintf(w, "events\t%d\n", store.Events())
+	w.Flush()
+}
+
+// runCheckStock sends CheckStock requests shaped like each user's watchlist
+// from concurrent callers and reports the latency distribution
+func runCheckStock(ctx context.Context, client bestbuy.Client, targets []database.WatchTarget, requests, concurrency int) {
+	h := handler.NewStockCheckerHandler(client, nil, nil, nil)
+
+	// One request per user: their SKUs, stores and postal code
+	byUser := make(map[int]*stockcheckerv1.CheckStockRequest)
+	var userIDs []int
+	for _, t := range targets {
+		req, ok := byUser[t.UserID]
+		if !ok {
+			req = &stockcheckerv1.CheckStockRequest{PostalCode: t.PostalCode}
+			byUser[t.UserID] = req
+			userIDs = append(userIDs, t.UserID)
+		}
+		if !contains(req.Skus, t.SKU) {
+			req.Skus = append(req.Skus, t.SKU)
+		}
+		if !contains(req.StoreIds, t.StoreID) {
+			req.StoreIds = append(req.StoreIds, t.StoreID)
+		}
+	}
+
+	jobs := make(chan *stockcheckerv1.CheckStockRequest)
+	var mu sync.Mutex
+	var latencies []time.Duration
+	var failures int
+
+	var wg sync.WaitGroup
+	start := time.Now()
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for req := range jobs {
+				t := time.Now()
+				_, err := h.CheckStock(ctx, connect.NewRequest(req))
+				d := time.Since(t)
+
+				mu.Lock()
+				if err != nil {
+					failures++
+				} else {
+					latencies = append(latencies, d)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := 0; i < requests; i++ {
+		jobs <- byUser[userIDs[i%len(userIDs)]]
+	}
+	close(jobs)
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "\nCHECKSTOCK")
+	fmt.Fprintf(w, "requests\t%d (%d failed)\n", requests, failures)
+	fmt.Fprintf(w, "concurrency\t%d\n", concurrency)
+	fmt.Fprintf(w, "requests/sec\t%.1f\n", float64(requests)/elapsed.Seconds())
+	fmt.Fprintf(w, "p50\t%v\n", percentile(latencies, 0.50))
+	fmt.Fprintf(w, "p95\t%v\n", percentile(latencies, 0.95))
+	fmt.Fprintf(w, "p99\t%v\n", percentile(latencies, 0.99))
+	w.Flush()
+}
+
+// percentile returns the p-th percentile of sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(float64(len(sorted)-1) * p)
+	return sorted[i].Round(time.Millisecond)
+}
+
+// contains reports whether list contains s
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+// Command poller runs the background stock watcher as its own process, so
+// stock checks keep running while the API server is redeployed or scaled.
+// Set EMBEDDED_POLLER=false on the server when running it.
+//
+//	go run ./cmd/poller
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/tmcauley/stock-checker/backend/internal/apierr"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/cache"
+	"github.com/tmcauley/stock-checker/backend/internal/config"
+	"github.com/tmcauley/stock-checker/backend/internal/costco"
+	"github.com/tmcauley/stock-checker/backend/internal/credentials"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/gamestop"
+	"github.com/tmcauley/stock-checker/backend/internal/latency"
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+	"github.com/tmcauley/stock-checker/backend/internal/projection"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"github.com/tmcauley/stock-checker/backend/internal/target"
+	"github.com/tmcauley/stock-checker/backend/internal/usage"
+)
+
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if !cfg.HasDatabase() {
+		log.Fatal("DATABASE_URL is required (watch lists are stored in the database)")
+	}
+
+	var admin *notify.AdminNotifier
+	if cfg.HasAdminNotifications() {
+		notifier, err := notify.New(cfg.AdminNotifyChannel, json.RawMessage(cfg.AdminNotifyConfig))
+		if err != nil {
+			log.Fatalf("Invalid admin notification channel: %v", err)
+		}
+		admin = notify.NewAdminNotifier(notifier)
+	} else if cfg.HasCredentialsKey() {
+		// An admin may set the channel up at runtime
+		admin = notify.NewAdminNotifier(nil)
+	}
+
+	domain, err := bestbuy.LookupDomain(cfg.ProductDomain)
+	if err != nil {
+		log.Fatalf("Invalid PRODUCT_DOMAIN: %v", err)
+	}
+	if cfg.ProductCategory != "" {
+		domain.Category = cfg.ProductCategory
+	}
+
+	var bbClient bestbuy.Client
+	var quota *bestbuy.Quota       // nil unless calling api.bestbuy.com with a key
+	var callLog *bestbuy.CallLog   // nil unless sampling calls to the real API
+	var throttle *bestbuy.Throttle // nil unless calling the real API
+	var bbAPIClient *bestbuy.APIClient
+	if cfg.UseMockData {
+		log.Println("Using mock Best Buy API client")
+		bbClient = bestbuy.NewMockClient()
+
+		if cfg.ScenarioFile != "" {
+			scenario, err := bestbuy.LoadScenario(cfg.ScenarioFile)
+			if err != nil {
+				log.Fatalf("Failed to load scenario: %v", err)
+			}
+			bbClient = bestbuy.NewScenarioClient(bestbuy.NewMockClient(), scenario)
+			log.Printf("Running scenario %q (%d events)", scenario.Name, len(scenario.Events))
+		}
+	} else {
+		region, err := bestbuy.ParseRegion(cfg.BestBuyRegion)
+		if err != nil {
+			log.Fatalf("Invalid BESTBUY_REGION: %v", err)
+		}
+		bbAPIClient = bestbuy.NewAPIClientForRegion(region, cfg.BestBuyAPIKey, cfg.UserAgent)
+		bbAPIClient.SetRestrictedTTL(cfg.RestrictedSKUTTL)
+		bbAPIClient.SetDomain(domain)
+		throttle = bbAPIClient.Throttle()
+		if region == bestbuy.RegionUS {
+			quota = bestbuy.NewQuota(cfg.BestBuyDailyQuota)
+			bbAPIClient.SetQuota(quota)
+		}
+		if cfg.APICallSampleRate > 0 {
+			callLog = bestbuy.NewCallLog(cfg.APICallSampleRate)
+			bbAPIClient.SetCallLog(callLog)
+		}
+		bbClient = bestbuy.NewMonitoredClient(bbAPIClient, func(err error) {
+			apierr.Report(admin, err)
+		})
+
+		// Shares product lookups with the API server when both use Redis
+		responseCache, err := cache.New(cfg.RedisURL)
+		if err != nil {
+			log.Fatalf("Invalid REDIS_URL: %v", err)
+		}
+		bbClient = cache.NewBestBuyClient(bbClient, responseCache, cache.TTLs{
+			Stores:   cfg.CacheTTLStores,
+			Products: cfg.CacheTTLProducts,
+			Product:  cfg.CacheTTLProduct,
+		})
+	}
+
+	// Retailer calls are the watcher's only latency-sensitive work
+	tracker := latency.NewTracker(admin, latency.Config{Sustain: cfg.LatencySustain})
+	bbClient = bestbuy.NewTimedClient(bbClient, tracker.Observer("bestbuy.", cfg.RetailerLatencyBudget))
+
+	// Other retailers are polled with their real client; mocks only when everything is mocked
+	retailers := retailer.NewRegistry()
+	var targetAPIClient *target.APIClient
+	if cfg.TargetAPIKey != "" && !cfg.UseMockFor(string(retailer.Target)) {
+		targetAPIClient = target.NewAPIClient(cfg.TargetAPIKey, cfg.UserAgent)
+		retailers.Register(retailer.Target, retailer.NewTarget(targetAPIClient), false)
+	}
+	if !cfg.UseMockFor(string(retailer.GameStop)) {
+		site, err := gamestop.ParseSite(cfg.GameStopSite)
+		if err != nil {
+			log.Fatalf("Invalid GAMESTOP_SITE: %v", err)
+		}
+		retailers.Register(retailer.GameStop, retailer.NewGameStop(gamestop.NewAPIClient(site, cfg.UserAgent)), false)
+	}
+	if !cfg.UseMockFor(string(retailer.Costco)) {
+		retailers.Register(retailer.Costco, retailer.NewCostco(costco.NewAPIClient(cfg.UserAgent)), false)
+	}
+	for _, id := range retailer.All {
+		if _, ok := retailers.Get(id); ok || id == retailer.BestBuy || !cfg.UseMockData {
+			continue
+		}
+		client, err := retailer.NewMock(id)
+		if err != nil {
+			log.Fatalf("Failed to create %s mock client: %v", id, err)
+		}
+		retailers.Register(id, client, true)
+	}
+	polled := retailers.Polled(cfg.UseMockData)
+	for id, client := range polled {
+		polled[id] = retailer.NewTimed(client, tracker.Observer(string(id)+".", cfg.RetailerLatencyBudget))
+	}
+
+	db, err := database.New(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Polling writes snapshots and events, so it waits out maintenance
+	// instead of exiting and being restarted over and over
+	if cfg.MaintenanceMode {
+		log.Println("Maintenance mode: poller paused until restarted without MAINTENANCE_MODE")
+		<-ctx.Done()
+		return
+	}
+
+	if err := db.RunMigrations(filepath.Join("migrations")); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+	if err := db.CheckSchema(ctx); err != nil {
+		log.Fatalf("Refusing to start: %v", err)
+	}
+
+	// Keys and the admin channel rotated by an admin through the server
+	if cfg.HasCredentialsKey() {
+		creds, err := credentials.New(db, cfg.CredentialsKey, credentials.Defaults(cfg))
+		if err != nil {
+			log.Fatalf("Invalid CREDENTIALS_KEY: %v", err)
+		}
+		creds.Use(credentials.Clients{BestBuy: bbAPIClient, Target: targetAPIClient, Admin: admin})
+		if err := creds.Load(ctx); err != nil {
+			log.Printf("Warning: failed to load stored credentials: %v", err)
+		}
+		go creds.Run(ctx, credentials.DefaultReloadInterval)
+	}
+
+	// Must-have alerts are followed up with a call unless acknowledged; the
+	// server handles the acknowledgment links, which share the database
+	escalator := notify.NewEscalator(db, cfg.PublicURL+"/notify/ack")
+	defer escalator.Close()
+	sink := poller.NewNotificationSink(db)
+	sink.SetEscalator(escalator)
+	userUsage := usage.NewRecorder()
+	sink.SetUsage(userUsage)
+
+	watcher := poller.New(bbClient, db, sink, admin, poller.Config{
+		Interval:     cfg.PollInterval,
+		HeartbeatURL: cfg.HeartbeatURL,
+		Retailers:    polled,
+		Quota:        quota,
+		Throttle:     throttle,
+		Usage:        userUsage,
+	})
+
+	go tracker.Run(ctx)
+	if quota != nil {
+		go quota.Run(ctx, db)
+	}
+	if callLog != nil {
+		go callLog.Run(ctx, db)
+	}
+	go userUsage.Run(ctx, db)
+
+	if cfg.MetricsAddr != "" {
+		metrics.Serve(cfg.MetricsAddr)
+	}
+
+	// Dashboard read models are projected from the watcher's event log
+	go projection.New(db, projection.DefaultInterval).Run(ctx)
+
+	// Products newly listed in watched sets are saved for their watchers
+	go poller.NewSetWatcher(bbClient, db, poller.DefaultSetInterval).Run(ctx)
+
+	// New listings matching users' product watches alert them
+	go poller.NewProductWatcher(bbClient, db, sink.DeliverNewProducts, poller.DefaultProductWatchInterval).Run(ctx)
+
+	// Alerts for nice-to-have products are sent as a digest
+	go poller.NewDigester(db, sink.DeliverDigest, poller.DefaultDigestInterval).Run(ctx)
+
+	// Watchers of restricted products hear when invitation windows open
+	go poller.NewDropWatcher(db, sink.DeliverDrop, poller.DefaultDropInterval).Run(ctx)
+
+	// Reminders made from forwarded Best Buy emails go out when due
+	go poller.NewReminderSender(db, sink.DeliverReminder, poller.DefaultReminderInterval).Run(ctx)
+
+	watcher.Run(ctx)
+	log.Println("Poller stopped")
+}
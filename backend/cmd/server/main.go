@@ -2,33 +2,192 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
 	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
 
 	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
 	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	stockcheckerv2 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2/stockcheckerv2connect"
+	"github.com/tmcauley/stock-checker/backend/internal/apierr"
+	"github.com/tmcauley/stock-checker/backend/internal/apispec"
 	"github.com/tmcauley/stock-checker/backend/internal/auth"
 	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/cache"
+	"github.com/tmcauley/stock-checker/backend/internal/chaos"
+	"github.com/tmcauley/stock-checker/backend/internal/clientip"
 	"github.com/tmcauley/stock-checker/backend/internal/config"
+	"github.com/tmcauley/stock-checker/backend/internal/costco"
+	"github.com/tmcauley/stock-checker/backend/internal/credentials"
 	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/gamestop"
 	"github.com/tmcauley/stock-checker/backend/internal/handler"
-	"golang.org/x/net/http2"
-	"golang.org/x/net/http2/h2c"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/inbound"
+	"github.com/tmcauley/stock-checker/backend/internal/latency"
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/pokemontcg"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+	"github.com/tmcauley/stock-checker/backend/internal/projection"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"github.com/tmcauley/stock-checker/backend/internal/status"
+	"github.com/tmcauley/stock-checker/backend/internal/stream"
+	"github.com/tmcauley/stock-checker/backend/internal/target"
+	"github.com/tmcauley/stock-checker/backend/internal/usage"
 )
 
 func main() {
 	// Load configuration
-	cfg := config.Load()
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+
+	// Admin notification channel for operational events (optional)
+	var admin *notify.AdminNotifier
+	if cfg.HasAdminNotifications() {
+		notifier, err := notify.New(cfg.AdminNotifyChannel, json.RawMessage(cfg.AdminNotifyConfig))
+		if err != nil {
+			log.Fatalf("Invalid admin notification channel: %v", err)
+		}
+		admin = notify.NewAdminNotifier(notifier)
+		log.Printf("Admin notifications enabled (%s)", cfg.AdminNotifyChannel)
+	} else if cfg.HasCredentialsKey() && cfg.HasDatabase() {
+		// An admin may set the channel up at runtime
+		admin = notify.NewAdminNotifier(nil)
+	}
+
+	// p95 latency of RPCs and retailer calls, reported to the admin when over budget
+	tracker := latency.NewTracker(admin, latency.Config{Sustain: cfg.LatencySustain})
+	background := newWorkers()
+	background.Go(tracker.Run)
+
+	// The kind of product tracked picks what browsing and product watches search
+	domain, err := bestbuy.LookupDomain(cfg.ProductDomain)
+	if err != nil {
+		log.Fatalf("Invalid PRODUCT_DOMAIN: %v", err)
+	}
+	if cfg.ProductCategory != "" {
+		domain.Category = cfg.ProductCategory
+	}
+	log.Printf("Tracking %s (category %s)", domain.Name, domain.Category)
 
 	// Create Best Buy API client (mock or real based on config)
 	var bbClient bestbuy.Client
-	if cfg.UseMockData {
-		log.Println("Using mock Best Buy API client (no API key provided)")
+	var quota *bestbuy.Quota       // nil unless calling api.bestbuy.com with a key
+	var callLog *bestbuy.CallLog   // nil unless sampling calls to the real API
+	var throttle *bestbuy.Throttle // nil unless calling the real API
+	var bbAPIClient *bestbuy.APIClient
+	if cfg.UseMockFor(string(retailer.BestBuy)) {
+		log.Println("Using mock Best Buy API client")
 		bbClient = bestbuy.NewMockClient()
+
+		if cfg.ScenarioFile != "" {
+			scenario, err := bestbuy.LoadScenario(cfg.ScenarioFile)
+			if err != nil {
+				log.Fatalf("Failed to load scenario: %v", err)
+			}
+			bbClient = bestbuy.NewScenarioClient(bestbuy.NewMockClient(), scenario)
+			log.Printf("Running scenario %q (%d events)", scenario.Name, len(scenario.Events))
+		}
 	} else {
-		log.Println("Using real Best Buy API client")
-		bbClient = bestbuy.NewAPIClient(cfg.BestBuyAPIKey)
+		if cfg.ScenarioFile != "" {
+			log.Println("Warning: SCENARIO_FILE is ignored when using the real Best Buy API")
+		}
+		region, err := bestbuy.ParseRegion(cfg.BestBuyRegion)
+		if err != nil {
+			log.Fatalf("Invalid BESTBUY_REGION: %v", err)
+		}
+		log.Printf("Using real Best Buy API client (%s)", region)
+		bbAPIClient = bestbuy.NewAPIClientForRegion(region, cfg.BestBuyAPIKey, cfg.UserAgent)
+		bbAPIClient.SetRestrictedTTL(cfg.RestrictedSKUTTL)
+		bbAPIClient.SetDomain(domain)
+		throttle = bbAPIClient.Throttle()
+		if region == bestbuy.RegionUS {
+			quota = bestbuy.NewQuota(cfg.BestBuyDailyQuota)
+			bbAPIClient.SetQuota(quota)
+		}
+		if cfg.APICallSampleRate > 0 {
+			callLog = bestbuy.NewCallLog(cfg.APICallSampleRate)
+			bbAPIClient.SetCallLog(callLog)
+		}
+		bbClient = bestbuy.NewMonitoredClient(bbAPIClient, func(err error) {
+			apierr.Report(admin, err)
+		})
+
+		// Identical queries from different users (and, with Redis, processes) share one API call
+		responseCache, err := cache.New(cfg.RedisURL)
+		if err != nil {
+			log.Fatalf("Invalid REDIS_URL: %v", err)
+		}
+		bbClient = cache.NewBestBuyClient(bbClient, responseCache, cache.TTLs{
+			Stores:   cfg.CacheTTLStores,
+			Products: cfg.CacheTTLProducts,
+			Product:  cfg.CacheTTLProduct,
+		})
+	}
+
+	bbClient = bestbuy.NewTimedClient(bbClient, tracker.Observer("bestbuy.", cfg.RetailerLatencyBudget))
+
+	// Injected failures go outside the timing and monitoring so they don't trip admin alerts
+	if cfg.ChaosEnabled {
+		log.Printf("Warning: chaos testing enabled, requests with an %s header get injected failures", chaos.Header)
+		bbClient = chaos.NewClient(bbClient)
+	}
+
+	// Other retailers use their real adapter when one exists and is configured, else their mock
+	retailers := retailer.NewRegistry()
+	retailers.Register(retailer.BestBuy, retailer.NewBestBuy(bbClient), cfg.UseMockFor(string(retailer.BestBuy)))
+	var targetAPIClient *target.APIClient
+	if cfg.TargetAPIKey != "" && !cfg.UseMockFor(string(retailer.Target)) {
+		targetAPIClient = target.NewAPIClient(cfg.TargetAPIKey, cfg.UserAgent)
+		targetClient := retailer.NewTarget(targetAPIClient)
+		retailers.Register(retailer.Target, retailer.NewTimed(targetClient, tracker.Observer("target.", cfg.RetailerLatencyBudget)), false)
+		log.Println("Using real Target API client")
+	}
+	if !cfg.UseMockFor(string(retailer.GameStop)) {
+		site, err := gamestop.ParseSite(cfg.GameStopSite)
+		if err != nil {
+			log.Fatalf("Invalid GAMESTOP_SITE: %v", err)
+		}
+		gameStopClient := retailer.NewGameStop(gamestop.NewAPIClient(site, cfg.UserAgent))
+		retailers.Register(retailer.GameStop, retailer.NewTimed(gameStopClient, tracker.Observer("gamestop.", cfg.RetailerLatencyBudget)), false)
+		log.Printf("Using real GameStop API client (%s)", site)
+	}
+	if !cfg.UseMockFor(string(retailer.Costco)) {
+		costcoClient := retailer.NewCostco(costco.NewAPIClient(cfg.UserAgent))
+		retailers.Register(retailer.Costco, retailer.NewTimed(costcoClient, tracker.Observer("costco.", cfg.RetailerLatencyBudget)), false)
+		log.Println("Using real Costco API client")
+	}
+	for _, id := range retailer.All {
+		if _, ok := retailers.Get(id); ok {
+			continue
+		}
+		client, err := retailer.NewMock(id)
+		if err != nil {
+			log.Fatalf("Failed to create %s mock client: %v", id, err)
+		}
+		retailers.Register(id, retailer.NewTimed(client, tracker.Observer(string(id)+".", cfg.RetailerLatencyBudget)), true)
+		log.Printf("Using mock %s client", id)
+	}
+	if cfg.ChaosEnabled {
+		for _, id := range retailers.IDs() {
+			if id == retailer.BestBuy {
+				continue // already wraps the chaos Best Buy client
+			}
+			client, _ := retailers.Get(id)
+			retailers.Register(id, chaos.NewRetailerClient(client), retailers.IsMock(id))
+		}
 	}
 
 	// Database connection (optional for local development)
@@ -43,14 +202,43 @@ func main() {
 		}
 		defer db.Close()
 
-		// Run migrations
-		migrationsDir := filepath.Join("migrations")
-		if err := db.RunMigrations(migrationsDir); err != nil {
-			log.Fatalf("Failed to run migrations: %v", err)
+		// Run migrations, unless they're running elsewhere while we serve reads
+		if cfg.MaintenanceMode {
+			log.Println("Maintenance mode: skipping migrations and rejecting writes")
+			if err := db.CheckSchema(context.Background()); err != nil {
+				log.Printf("Warning: %v; serving read-only until maintenance ends", err)
+			}
+		} else {
+			migrationsDir := filepath.Join("migrations")
+			if err := db.RunMigrations(migrationsDir); err != nil {
+				log.Fatalf("Failed to run migrations: %v", err)
+			}
+			if err := db.CheckSchema(context.Background()); err != nil {
+				log.Fatalf("Refusing to start: %v", err)
+			}
+			// Products saved before set names were parsed get them now
+			if n, err := db.BackfillProductSets(context.Background()); err != nil {
+				log.Printf("Warning: failed to fill in product sets: %v", err)
+			} else if n > 0 {
+				log.Printf("Filled in sets for %d saved products", n)
+			}
 		}
 
-		// Seed initial allowed emails
-		for _, email := range cfg.InitialAllowedEmails {
+		// Seed initial allowed emails and "@domain" entries (writes wait for
+		// maintenance to end)
+		seedEmails := cfg.InitialAllowedEmails
+		if cfg.MaintenanceMode {
+			seedEmails = nil
+		}
+		for _, email := range seedEmails {
+			if domain, ok := strings.CutPrefix(email, "@"); ok {
+				if err := db.AddAllowedDomain(context.Background(), domain, nil); err != nil {
+					log.Printf("Warning: failed to add allowed domain %s: %v", domain, err)
+				} else {
+					log.Printf("Added allowed domain: %s", domain)
+				}
+				continue
+			}
 			if err := db.AddAllowedEmail(context.Background(), email, nil); err != nil {
 				log.Printf("Warning: failed to add allowed email %s: %v", email, err)
 			} else {
@@ -58,33 +246,214 @@ func main() {
 			}
 		}
 
+		// Seed admins from config; after that, roles are managed with AdminSetUserRole
+		if len(cfg.AdminEmails) > 0 && !cfg.MaintenanceMode {
+			if n, err := db.PromoteAdmins(context.Background(), cfg.AdminEmails); err != nil {
+				log.Printf("Warning: failed to promote admins: %v", err)
+			} else if n > 0 {
+				log.Printf("Promoted %d users to admin", n)
+			}
+		}
+
 		log.Println("Database connected and migrated")
 	} else {
 		log.Println("Running without database (localStorage mode)")
 	}
 
+	// Client IPs come from X-Forwarded-For only behind these proxies
+	proxies, err := clientip.ParseProxies(cfg.TrustedProxies)
+	if err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+
 	// Auth handler (optional)
 	if cfg.HasAuth() && db != nil {
-		authHandler = auth.New(
-			db,
-			cfg.GoogleClientID,
-			cfg.GoogleClientSecret,
-			cfg.GoogleRedirectURL,
-			cfg.FrontendURL,
-			cfg.SecureCookies,
-		)
-		log.Println("Google OAuth enabled")
+		var providers []*auth.Provider
+		if cfg.HasGoogleAuth() {
+			providers = append(providers, auth.NewGoogleProvider(cfg.GoogleClientID, cfg.GoogleClientSecret, cfg.OAuthRedirectURL))
+		}
+		if cfg.HasGitHubAuth() {
+			providers = append(providers, auth.NewGitHubProvider(cfg.GitHubClientID, cfg.GitHubClientSecret, cfg.OAuthRedirectURL))
+		}
+		if cfg.HasOIDCAuth() {
+			oidc, err := auth.NewOIDCProvider(context.Background(), cfg.OIDCName, cfg.OIDCIssuerURL, cfg.OIDCClientID, cfg.OIDCClientSecret, cfg.OAuthRedirectURL)
+			if err != nil {
+				log.Fatalf("Failed to set up OIDC login: %v", err)
+			}
+			providers = append(providers, oidc)
+		}
+		authHandler = auth.New(db, cfg.FrontendURL, cfg.SecureCookies, providers...)
+		authHandler.SetAdminEmails(cfg.AdminEmails)
+		authHandler.SetTrustedProxies(proxies)
+		for _, p := range providers {
+			log.Printf("Login with %s enabled", p.Name)
+		}
+		if cfg.HasEmailAuth() {
+			var emailCfg notify.EmailConfig
+			if err := json.Unmarshal([]byte(cfg.LoginEmailConfig), &emailCfg); err != nil {
+				log.Fatalf("Invalid LOGIN_EMAIL_CONFIG: %v", err)
+			}
+			probe := emailCfg
+			probe.To = emailCfg.From
+			if _, err := notify.NewEmail(probe); err != nil {
+				log.Fatalf("Invalid LOGIN_EMAIL_CONFIG: %v", err)
+			}
+			authHandler.SetEmailLogin(emailCfg, cfg.PublicURL)
+			log.Println("Login with emailed links enabled")
+		}
 	} else {
 		log.Println("Running without authentication")
 	}
 
+	// Alert escalation (phone calls for unacknowledged emergency alerts) needs the database
+	var escalator *notify.Escalator
+	if db != nil {
+		escalator = notify.NewEscalator(db, cfg.PublicURL+"/notify/ack")
+		defer escalator.Close()
+	}
+
+	// Read RPCs are cached per user until a write changes what they return;
+	// a watcher running in its own process is only picked up after the TTL
+	responses := handler.NewResponseCache(handler.DefaultResponseCacheTTL)
+
+	// What each user's account uses, shown to them next to the refresh button
+	userUsage := usage.NewRecorder()
+
+	// Background stock watcher needs the database for watch lists and channels.
+	// It can also run as its own process (cmd/poller), in which case the server
+	// keeps an idle watcher around for simulations only.
+	var watcher *poller.Poller
+	if db != nil {
+		sink := poller.NewNotificationSink(db)
+		sink.SetEscalator(escalator)
+		sink.SetUsage(userUsage)
+		watcher = poller.New(bbClient, db, sink, admin, poller.Config{
+			Interval:     cfg.PollInterval,
+			HeartbeatURL: cfg.HeartbeatURL,
+			Retailers:    retailers.Polled(cfg.UseMockData),
+			Quota:        quota,
+			Throttle:     throttle,
+			Usage:        userUsage,
+		})
+
+		if cfg.MaintenanceMode {
+			log.Println("Embedded stock watcher paused for maintenance")
+		} else if cfg.EmbeddedPoller {
+			background.Go(watcher.Run)
+
+			// Dashboard read models are projected from the watcher's event log
+			projector := projection.New(db, projection.DefaultInterval)
+			projector.OnProjected(responses.InvalidateStock)
+			background.Go(projector.Run)
+
+			// Products newly listed in watched sets are saved for their watchers
+			setWatcher := poller.NewSetWatcher(bbClient, db, poller.DefaultSetInterval)
+			setWatcher.OnAdded(responses.InvalidateProducts)
+			background.Go(setWatcher.Run)
+
+			// New listings matching users' product watches alert them
+			background.Go(poller.NewProductWatcher(bbClient, db, sink.DeliverNewProducts, poller.DefaultProductWatchInterval).Run)
+
+			// Alerts for nice-to-have products are sent as a digest
+			background.Go(poller.NewDigester(db, sink.DeliverDigest, poller.DefaultDigestInterval).Run)
+
+			// Watchers of restricted products hear when invitation windows open
+			background.Go(poller.NewDropWatcher(db, sink.DeliverDrop, poller.DefaultDropInterval).Run)
+
+			// Reminders made from forwarded Best Buy emails go out when due
+			background.Go(poller.NewReminderSender(db, sink.DeliverReminder, poller.DefaultReminderInterval).Run)
+		} else {
+			log.Println("Embedded stock watcher disabled (EMBEDDED_POLLER=false)")
+		}
+
+		// Calls are counted with the poller's, so each process sees the whole day's usage
+		if quota != nil && !cfg.MaintenanceMode {
+			background.Go(func(ctx context.Context) { quota.Run(ctx, db) })
+		}
+		if callLog != nil && !cfg.MaintenanceMode {
+			background.Go(func(ctx context.Context) { callLog.Run(ctx, db) })
+		}
+		if !cfg.MaintenanceMode {
+			background.Go(func(ctx context.Context) { userUsage.Run(ctx, db) })
+		}
+	}
+
 	// Create the handler
-	stockCheckerHandler := handler.NewStockCheckerHandler(bbClient, db)
+	stockCheckerHandler := handler.NewStockCheckerHandler(bbClient, db, admin, watcher)
+	stockCheckerHandler.SetCheckConcurrency(cfg.CheckConcurrency)
+	stockCheckerHandler.SetProductDomain(domain)
+	maintenance := handler.NewMaintenance(cfg.MaintenanceMode, cfg.MaintenanceRetryAfter)
+	stockCheckerHandler.SetMaintenance(maintenance)
+	stockCheckerHandler.SetAnnouncement(cfg.Announcement)
+	if db != nil {
+		stockCheckerHandler.SetUsage(userUsage)
+	}
+	stockCheckerHandler.SetAPILoad(quota, throttle)
+	if db != nil && cfg.HasInboundEmail() {
+		stockCheckerHandler.SetInboundEmail(cfg.InboundEmailDomain)
+	}
+	stockCheckerHandler.SetVersion(cfg.Version)
+	if authHandler != nil {
+		stockCheckerHandler.SetLoginProviders(authHandler.Providers(), authHandler.EmailLoginEnabled())
+		if len(authHandler.Providers()) > 0 {
+			stockCheckerHandler.SetInviteURL(strings.TrimSuffix(cfg.PublicURL, "/") + "/auth/invite")
+		}
+	}
+
+	// Live stock updates follow the event log, wherever the watcher runs
+	var streams *stream.Hub
+	if db != nil {
+		streams = stream.NewHub(db, stream.DefaultInterval)
+		background.Go(streams.Run)
+		stockCheckerHandler.SetStreams(streams)
+	}
+
+	var tcgAPIClient *pokemontcg.APIClient
+	if db != nil && cfg.TCGEnrichment {
+		var tcgClient pokemontcg.Client = pokemontcg.NewMockClient()
+		if !cfg.UseMockData {
+			tcgAPIClient = pokemontcg.NewAPIClient(cfg.PokemonTCGAPIKey, cfg.UserAgent)
+			tcgClient = tcgAPIClient
+		}
+		stockCheckerHandler.SetTCGSets(pokemontcg.NewSets(tcgClient, db))
+	}
+
+	// Admins can rotate retailer keys and the admin channel without a restart;
+	// rotated values are stored encrypted and override the environment
+	if db != nil && cfg.HasCredentialsKey() {
+		creds, err := credentials.New(db, cfg.CredentialsKey, credentials.Defaults(cfg))
+		if err != nil {
+			log.Fatalf("Invalid CREDENTIALS_KEY: %v", err)
+		}
+		creds.Use(credentials.Clients{
+			BestBuy:    bbAPIClient,
+			Target:     targetAPIClient,
+			PokemonTCG: tcgAPIClient,
+			Admin:      admin,
+		})
+		if err := creds.Load(context.Background()); err != nil {
+			log.Printf("Warning: failed to load stored credentials: %v", err)
+		}
+		background.Go(func(ctx context.Context) { creds.Run(ctx, credentials.DefaultReloadInterval) })
+		stockCheckerHandler.SetCredentials(creds)
+	}
+
+	// Authenticate each RPC by session or API key when auth is configured;
+	// public RPCs like SearchStores also work signed out
+	interceptors := []connect.Interceptor{tracker.Interceptor(cfg.RPCLatencyBudget), handler.DeprecationInterceptor()}
+	if authHandler != nil {
+		interceptors = append(interceptors, stockCheckerHandler.AuthInterceptor(authHandler))
+	}
+	interceptors = append(interceptors, handler.AdminInterceptor(), maintenance.Interceptor(), handler.PriorityInterceptor(), responses.Interceptor())
 
-	// Create the Connect service path and handler
+	// Create the Connect service paths and handlers (v1 stays mounted while clients migrate to v2)
 	path, connectHandler := stockcheckerv1connect.NewStockCheckerServiceHandler(
-		stockCheckerHandler,
-		connect.WithInterceptors(),
+		handler.NewCachingHandler(stockCheckerHandler, responses),
+		connect.WithInterceptors(interceptors...),
+	)
+	pathV2, connectHandlerV2 := stockcheckerv2connect.NewStockCheckerServiceHandler(
+		handler.NewStockCheckerV2Handler(stockCheckerHandler, retailers),
+		connect.WithInterceptors(interceptors...),
 	)
 
 	// Create a new mux and register the handler
@@ -100,35 +469,149 @@ func main() {
 		w.Write([]byte(`{"status":"ok"}`))
 	})
 
+	// Pick up the browser's language for messages shown before a user has chosen one
+	mux.Handle(path, i18n.Middleware(connectHandler))
+	mux.Handle(pathV2, i18n.Middleware(connectHandlerV2))
+
+	// API description for integrators generating clients for this exact version
+	apiSpec := apispec.NewHandler(apispec.Config{
+		Title:      "Stock Checker API",
+		Version:    cfg.Version,
+		ServerURL:  cfg.PublicURL,
+		Deprecated: handler.DeprecatedNames(),
+	}, stockcheckerv1.File_stockchecker_v1_service_proto.Services().Get(0),
+		stockcheckerv2.File_stockchecker_v2_service_proto.Services().Get(0))
+	mux.Handle(apispec.OpenAPIPath, apiSpec)
+	mux.Handle(apispec.BundlePath, apiSpec)
+
 	// Auth endpoints (if auth is configured)
 	if authHandler != nil {
 		mux.HandleFunc("/auth/login", authHandler.HandleLogin)
 		mux.HandleFunc("/auth/callback", authHandler.HandleCallback)
 		mux.HandleFunc("/auth/logout", authHandler.HandleLogout)
+		mux.HandleFunc("/auth/email", authHandler.HandleEmailLogin)
+		mux.HandleFunc("/auth/email/callback", authHandler.HandleEmailCallback)
+		mux.HandleFunc("/auth/invite", authHandler.HandleInvite)
+	}
 
-		// Wrap Connect handler with auth middleware for protected endpoints
-		mux.Handle(path, authHandler.Middleware(connectHandler))
-	} else {
-		mux.Handle(path, connectHandler)
+	// Alert acknowledgment links (cancel pending escalations)
+	if escalator != nil {
+		mux.HandleFunc("/notify/ack", escalator.HandleAck)
+	}
+
+	// Best Buy emails users forward, POSTed by the domain's mail provider
+	if db != nil && cfg.HasInboundEmail() {
+		mux.Handle(inbound.Path, inbound.NewHandler(db, bbClient, cfg.InboundEmailDomain, cfg.InboundEmailSecret))
+		log.Printf("Forwarded emails to @%s enabled", cfg.InboundEmailDomain)
+	}
+
+	// Public stock feed for community sites to embed
+	if len(cfg.FeaturedSKUs) > 0 {
+		mux.Handle("/status.json", status.NewHandler(bbClient, status.Config{
+			SKUs:           cfg.FeaturedSKUs,
+			PostalCode:     cfg.FeaturedPostalCode,
+			TTL:            cfg.StatusCacheTTL,
+			TrustedProxies: proxies,
+		}))
+	}
+
+	// Prometheus metrics on their own port, kept off the public API
+	if cfg.MetricsAddr != "" {
+		metrics.Serve(cfg.MetricsAddr)
 	}
 
 	// Add CORS middleware
-	corsHandler := corsMiddleware(mux, cfg.FrontendURL)
+	var root http.Handler = mux
+	if cfg.ChaosEnabled {
+		root = chaos.Middleware(mux)
+	}
+	corsHandler := corsMiddleware(root, cfg.FrontendURL)
+
+	tlsConfig, redirect, err := serverTLS(cfg)
+	if err != nil {
+		log.Fatalf("Invalid TLS configuration: %v", err)
+	}
+	scheme := "http"
+	if tlsConfig != nil {
+		scheme = "https"
+	}
 
 	log.Printf("Starting server on :%s", cfg.Port)
-	log.Printf("StockCheckerService available at http://localhost:%s%s", cfg.Port, path)
+	log.Printf("StockCheckerService available at %s://localhost:%s%s", scheme, cfg.Port, path)
+	log.Printf("StockCheckerService v2 available at %s://localhost:%s%s", scheme, cfg.Port, pathV2)
 	if authHandler != nil {
-		log.Printf("Auth endpoints: /auth/login, /auth/callback, /auth/logout")
+		log.Printf("Auth endpoints: /auth/login, /auth/callback, /auth/logout, /auth/email")
 	}
 
-	// Use h2c for HTTP/2 without TLS (needed for Connect)
-	err := http.ListenAndServe(
-		":"+cfg.Port,
-		h2c.NewHandler(corsHandler, &http2.Server{}),
-	)
-	if err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	// Serve HTTP/2 as well as HTTP/1.1 (needed for Connect), without TLS
+	// unless HTTPS is served here
+	var protocols http.Protocols
+	protocols.SetHTTP1(true)
+	if tlsConfig != nil {
+		protocols.SetHTTP2(true)
+	} else {
+		protocols.SetUnencryptedHTTP2(true)
+	}
+	srv := &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           corsHandler,
+		Protocols:         &protocols,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	// Live updates end when shutdown starts, so clients reconnect elsewhere
+	// instead of holding the drain open
+	if streams != nil {
+		srv.RegisterOnShutdown(streams.Close)
+	}
+
+	// Plain HTTP is redirected to HTTPS, and answers Let's Encrypt's challenges
+	var redirectSrv *http.Server
+	if redirect != nil && cfg.HTTPRedirectAddr != "" {
+		redirectSrv = &http.Server{
+			Addr:              cfg.HTTPRedirectAddr,
+			Handler:           redirect,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		log.Printf("Redirecting HTTP on %s to HTTPS", cfg.HTTPRedirectAddr)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		var err error
+		if srv.TLSConfig != nil {
+			err = srv.ListenAndServeTLS("", "") // certificates come from the TLS config
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+	if redirectSrv != nil {
+		go func() {
+			if err := redirectSrv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("Failed to start HTTP redirect: %v", err)
+			}
+		}()
+	}
+	<-ctx.Done()
+	stop() // a second signal exits straight away
+
+	// Stop taking requests and let in-flight ones finish, then stop the
+	// background loops; the escalator and database close as main returns
+	log.Printf("Shutting down; waiting up to %s for requests to finish", cfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if redirectSrv != nil {
+		redirectSrv.Close()
+	}
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Requests still running at the shutdown deadline: %v", err)
 	}
+	background.Stop()
+	log.Println("Server stopped")
 }
 
 // corsMiddleware adds CORS headers
@@ -141,9 +624,9 @@ func corsMiddleware(next http.Handler, frontendURL string) http.Handler {
 
 		w.Header().Set("Access-Control-Allow-Origin", origin)
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol-Version, Cookie")
+		w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, Connect-Protocol-Version, Cookie, X-Chaos")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
-		w.Header().Set("Access-Control-Expose-Headers", "Connect-Protocol-Version")
+		w.Header().Set("Access-Control-Expose-Headers", "Connect-Protocol-Version, Deprecation, Sunset")
 
 		// Handle preflight requests
 		if r.Method == "OPTIONS" {
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/config"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// certCheckInterval is how often the certificate files are checked for renewal
+const certCheckInterval = time.Minute
+
+// serverTLS returns the TLS settings for serving HTTPS as configured, and a
+// handler for plain HTTP that redirects to HTTPS (answering Let's Encrypt's
+// challenges with autocert). Both are nil when HTTPS isn't served here.
+func serverTLS(cfg *config.Config) (*tls.Config, http.Handler, error) {
+	switch {
+	case cfg.UsesAutocert():
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.AutocertHosts...),
+			Cache:      autocert.DirCache(cfg.AutocertCacheDir),
+			Email:      cfg.AutocertEmail,
+		}
+		return m.TLSConfig(), m.HTTPHandler(nil), nil
+
+	case cfg.ServesTLS():
+		certs := &certFiles{certFile: cfg.TLSCertFile, keyFile: cfg.TLSKeyFile}
+		if err := certs.load(); err != nil {
+			return nil, nil, err
+		}
+		tlsConfig := &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certs.get,
+		}
+		return tlsConfig, redirectToHTTPS(cfg.Port), nil
+	}
+	return nil, nil, nil
+}
+
+// certFiles serves a certificate from files, picking up renewed files (e.g.
+// from certbot) without a restart
+type certFiles struct {
+	certFile, keyFile string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time // of the certificate file when loaded
+	checked time.Time
+}
+
+// load reads the certificate and key
+func (c *certFiles) load() error {
+	info, err := os.Stat(c.certFile)
+	if err != nil {
+		return fmt.Errorf("failed to read TLS certificate: %w", err)
+	}
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	c.cert, c.modTime = &cert, info.ModTime()
+	return nil
+}
+
+// get returns the certificate, reloading it if the file changed. A renewal
+// that fails to load keeps the current certificate in use.
+func (c *certFiles) get(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now := time.Now(); now.Sub(c.checked) >= certCheckInterval {
+		c.checked = now
+		if info, err := os.Stat(c.certFile); err == nil && !info.ModTime().Equal(c.modTime) {
+			if err := c.load(); err != nil {
+				log.Printf("Keeping the current TLS certificate: %v", err)
+			} else {
+				log.Println("Reloaded renewed TLS certificate")
+			}
+		}
+	}
+	return c.cert, nil
+}
+
+// redirectToHTTPS redirects plain HTTP requests to the same URL over HTTPS
+// on port
+func redirectToHTTPS(port string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host := r.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if port != "443" {
+			host = net.JoinHostPort(host, port)
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// workers runs the server's background loops and stops them together on
+// shutdown, so none is cut off while using the database
+type workers struct {
+	ctx  context.Context
+	stop context.CancelFunc
+	wg   sync.WaitGroup
+}
+
+func newWorkers() *workers {
+	ctx, stop := context.WithCancel(context.Background())
+	return &workers{ctx: ctx, stop: stop}
+}
+
+// Go runs loop in the background until Stop
+func (w *workers) Go(loop func(ctx context.Context)) {
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		loop(w.ctx)
+	}()
+}
+
+// Stop cancels every loop and waits for them to return
+func (w *workers) Stop() {
+	w.stop()
+	w.wg.Wait()
+}
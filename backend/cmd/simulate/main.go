@@ -0,0 +1,95 @@
+// Command simulate runs one stock watcher cycle without sending anything and
+// prints the notifications that would fire for each user. Useful when tuning
+// templates or alert settings.
+//
+//	go run ./cmd/simulate -mock
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/config"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+func main() {
+	useMock := flag.Bool("mock", false, "check against mock data instead of the live Best Buy API")
+	showBody := flag.Bool("body", false, "print the rendered notification body")
+	flag.Parse()
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration: %v", err)
+	}
+	if !cfg.HasDatabase() {
+		log.Fatal("DATABASE_URL is required (watch lists are stored in the database)")
+	}
+
+	db, err := database.New(cfg.DatabaseURL)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.RunMigrations(filepath.Join("migrations")); err != nil {
+		log.Fatalf("Failed to run migrations: %v", err)
+	}
+
+	var bbClient bestbuy.Client
+	if *useMock || cfg.UseMockData {
+		bbClient = bestbuy.NewMockClient()
+	} else {
+		region, err := bestbuy.ParseRegion(cfg.BestBuyRegion)
+		if err != nil {
+			log.Fatalf("Invalid BESTBUY_REGION: %v", err)
+		}
+		bbClient = bestbuy.NewAPIClientForRegion(region, cfg.BestBuyAPIKey, cfg.UserAgent)
+	}
+
+	// A fresh watcher has no state, so every in-stock store counts as new
+	sink := poller.NewNotificationSink(db)
+	p := poller.New(bbClient, db, sink, nil, poller.Config{})
+
+	ctx := context.Background()
+	alerts, err := p.Simulate(ctx, bbClient, true)
+	if err != nil {
+		log.Fatalf("Simulation failed: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "USER\tSKU\tPRODUCT\tSTORES\tCHANNEL\tTITLE")
+	for _, alert := range alerts {
+		user, err := db.GetUserByID(ctx, alert.UserID)
+		if err != nil {
+			log.Fatalf("Failed to load user %d: %v", alert.UserID, err)
+		}
+
+		rendered, err := sink.Render(ctx, alert)
+		if err != nil {
+			log.Printf("Warning: failed to render alert for %s: %v", user.Email, err)
+		}
+		if len(rendered) == 0 {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t(none)\t\n", user.Email, alert.SKU, alert.ProductName, len(alert.Stores))
+			continue
+		}
+
+		for _, r := range rendered {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n", user.Email, alert.SKU, alert.ProductName, len(alert.Stores), r.Channel.ChannelType, r.Message.Title)
+			if *showBody {
+				fmt.Fprintf(w, "\t\t\t\t\t%s\n", strings.ReplaceAll(r.Message.Body, "\n", " / "))
+			}
+		}
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d alerts would fire\n", len(alerts))
+}
@@ -9,6 +9,8 @@ package stockcheckerv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +23,506 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Retailer sells a product. v1 RPCs look products up at Best Buy, but saved
+// products and alerts can come from any retailer v2 serves.
+type Retailer int32
+
+const (
+	Retailer_RETAILER_UNSPECIFIED Retailer = 0
+	Retailer_RETAILER_BEST_BUY    Retailer = 1
+	Retailer_RETAILER_WALMART     Retailer = 2
+	Retailer_RETAILER_TARGET      Retailer = 3
+	Retailer_RETAILER_GAMESTOP    Retailer = 4 // GameStop, or EB Games when the server is configured for Canada
+	Retailer_RETAILER_COSTCO      Retailer = 5
+)
+
+// Enum value maps for Retailer.
+var (
+	Retailer_name = map[int32]string{
+		0: "RETAILER_UNSPECIFIED",
+		1: "RETAILER_BEST_BUY",
+		2: "RETAILER_WALMART",
+		3: "RETAILER_TARGET",
+		4: "RETAILER_GAMESTOP",
+		5: "RETAILER_COSTCO",
+	}
+	Retailer_value = map[string]int32{
+		"RETAILER_UNSPECIFIED": 0,
+		"RETAILER_BEST_BUY":    1,
+		"RETAILER_WALMART":     2,
+		"RETAILER_TARGET":      3,
+		"RETAILER_GAMESTOP":    4,
+		"RETAILER_COSTCO":      5,
+	}
+)
+
+func (x Retailer) Enum() *Retailer {
+	p := new(Retailer)
+	*p = x
+	return p
+}
+
+func (x Retailer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Retailer) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Retailer) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[0]
+}
+
+func (x Retailer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Retailer.Descriptor instead.
+func (Retailer) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+// WatchPriority routes a saved product's alerts
+type WatchPriority int32
+
+const (
+	WatchPriority_WATCH_PRIORITY_UNSPECIFIED  WatchPriority = 0 // alerts go to every enabled channel
+	WatchPriority_WATCH_PRIORITY_MUST_HAVE    WatchPriority = 1 // alerts go to the urgent channels as emergencies, with a follow-up call if set up
+	WatchPriority_WATCH_PRIORITY_NICE_TO_HAVE WatchPriority = 2 // alerts wait for the digest
+)
+
+// Enum value maps for WatchPriority.
+var (
+	WatchPriority_name = map[int32]string{
+		0: "WATCH_PRIORITY_UNSPECIFIED",
+		1: "WATCH_PRIORITY_MUST_HAVE",
+		2: "WATCH_PRIORITY_NICE_TO_HAVE",
+	}
+	WatchPriority_value = map[string]int32{
+		"WATCH_PRIORITY_UNSPECIFIED":  0,
+		"WATCH_PRIORITY_MUST_HAVE":    1,
+		"WATCH_PRIORITY_NICE_TO_HAVE": 2,
+	}
+)
+
+func (x WatchPriority) Enum() *WatchPriority {
+	p := new(WatchPriority)
+	*p = x
+	return p
+}
+
+func (x WatchPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[1].Descriptor()
+}
+
+func (WatchPriority) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[1]
+}
+
+func (x WatchPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchPriority.Descriptor instead.
+func (WatchPriority) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+// ProductType is the kind of sealed TCG product, read from the product name
+type ProductType int32
+
+const (
+	ProductType_PRODUCT_TYPE_UNSPECIFIED       ProductType = 0 // not a recognised TCG product
+	ProductType_PRODUCT_TYPE_ELITE_TRAINER_BOX ProductType = 1
+	ProductType_PRODUCT_TYPE_BOOSTER_BUNDLE    ProductType = 2
+	ProductType_PRODUCT_TYPE_BOOSTER_BOX       ProductType = 3
+	ProductType_PRODUCT_TYPE_BOOSTER_PACK      ProductType = 4
+	ProductType_PRODUCT_TYPE_TIN               ProductType = 5
+	ProductType_PRODUCT_TYPE_COLLECTION        ProductType = 6
+	ProductType_PRODUCT_TYPE_BLISTER           ProductType = 7
+)
+
+// Enum value maps for ProductType.
+var (
+	ProductType_name = map[int32]string{
+		0: "PRODUCT_TYPE_UNSPECIFIED",
+		1: "PRODUCT_TYPE_ELITE_TRAINER_BOX",
+		2: "PRODUCT_TYPE_BOOSTER_BUNDLE",
+		3: "PRODUCT_TYPE_BOOSTER_BOX",
+		4: "PRODUCT_TYPE_BOOSTER_PACK",
+		5: "PRODUCT_TYPE_TIN",
+		6: "PRODUCT_TYPE_COLLECTION",
+		7: "PRODUCT_TYPE_BLISTER",
+	}
+	ProductType_value = map[string]int32{
+		"PRODUCT_TYPE_UNSPECIFIED":       0,
+		"PRODUCT_TYPE_ELITE_TRAINER_BOX": 1,
+		"PRODUCT_TYPE_BOOSTER_BUNDLE":    2,
+		"PRODUCT_TYPE_BOOSTER_BOX":       3,
+		"PRODUCT_TYPE_BOOSTER_PACK":      4,
+		"PRODUCT_TYPE_TIN":               5,
+		"PRODUCT_TYPE_COLLECTION":        6,
+		"PRODUCT_TYPE_BLISTER":           7,
+	}
+)
+
+func (x ProductType) Enum() *ProductType {
+	p := new(ProductType)
+	*p = x
+	return p
+}
+
+func (x ProductType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductType) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[2].Descriptor()
+}
+
+func (ProductType) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[2]
+}
+
+func (x ProductType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductType.Descriptor instead.
+func (ProductType) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+// User represents an authenticated user
+// UserRole is what a user is allowed to do
+type UserRole int32
+
+const (
+	UserRole_USER_ROLE_UNSPECIFIED UserRole = 0
+	UserRole_USER_ROLE_USER        UserRole = 1 // manages their own watchlist and alerts
+	UserRole_USER_ROLE_ADMIN       UserRole = 2 // also manages shared defaults, sign-ins and moderation
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "USER_ROLE_UNSPECIFIED",
+		1: "USER_ROLE_USER",
+		2: "USER_ROLE_ADMIN",
+	}
+	UserRole_value = map[string]int32{
+		"USER_ROLE_UNSPECIFIED": 0,
+		"USER_ROLE_USER":        1,
+		"USER_ROLE_ADMIN":       2,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[3]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+// SkuErrorCode is why a SKU couldn't be checked
+type SkuErrorCode int32
+
+const (
+	SkuErrorCode_SKU_ERROR_CODE_UNSPECIFIED    SkuErrorCode = 0
+	SkuErrorCode_SKU_ERROR_CODE_NOT_FOUND      SkuErrorCode = 1 // Best Buy has no product with this SKU
+	SkuErrorCode_SKU_ERROR_CODE_RESTRICTED     SkuErrorCode = 2 // Best Buy doesn't publish availability for this SKU
+	SkuErrorCode_SKU_ERROR_CODE_RATE_LIMITED   SkuErrorCode = 3 // try again after retry_after_seconds
+	SkuErrorCode_SKU_ERROR_CODE_QUOTA_EXCEEDED SkuErrorCode = 4 // the daily API quota is used up
+	SkuErrorCode_SKU_ERROR_CODE_API_KEY        SkuErrorCode = 5 // the server's API key was rejected
+	SkuErrorCode_SKU_ERROR_CODE_UNAVAILABLE    SkuErrorCode = 6 // the API failed, timed out or returned something unexpected
+)
+
+// Enum value maps for SkuErrorCode.
+var (
+	SkuErrorCode_name = map[int32]string{
+		0: "SKU_ERROR_CODE_UNSPECIFIED",
+		1: "SKU_ERROR_CODE_NOT_FOUND",
+		2: "SKU_ERROR_CODE_RESTRICTED",
+		3: "SKU_ERROR_CODE_RATE_LIMITED",
+		4: "SKU_ERROR_CODE_QUOTA_EXCEEDED",
+		5: "SKU_ERROR_CODE_API_KEY",
+		6: "SKU_ERROR_CODE_UNAVAILABLE",
+	}
+	SkuErrorCode_value = map[string]int32{
+		"SKU_ERROR_CODE_UNSPECIFIED":    0,
+		"SKU_ERROR_CODE_NOT_FOUND":      1,
+		"SKU_ERROR_CODE_RESTRICTED":     2,
+		"SKU_ERROR_CODE_RATE_LIMITED":   3,
+		"SKU_ERROR_CODE_QUOTA_EXCEEDED": 4,
+		"SKU_ERROR_CODE_API_KEY":        5,
+		"SKU_ERROR_CODE_UNAVAILABLE":    6,
+	}
+)
+
+func (x SkuErrorCode) Enum() *SkuErrorCode {
+	p := new(SkuErrorCode)
+	*p = x
+	return p
+}
+
+func (x SkuErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SkuErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[4].Descriptor()
+}
+
+func (SkuErrorCode) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[4]
+}
+
+func (x SkuErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SkuErrorCode.Descriptor instead.
+func (SkuErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+// DuplicateReason is why a saved product may be the same item as another
+type DuplicateReason int32
+
+const (
+	DuplicateReason_DUPLICATE_REASON_UNSPECIFIED       DuplicateReason = 0
+	DuplicateReason_DUPLICATE_REASON_SAME_UPC          DuplicateReason = 1 // same barcode under another SKU
+	DuplicateReason_DUPLICATE_REASON_SAME_MODEL_NUMBER DuplicateReason = 2
+	DuplicateReason_DUPLICATE_REASON_SAME_SET          DuplicateReason = 3 // same set in different packaging, judged by name
+)
+
+// Enum value maps for DuplicateReason.
+var (
+	DuplicateReason_name = map[int32]string{
+		0: "DUPLICATE_REASON_UNSPECIFIED",
+		1: "DUPLICATE_REASON_SAME_UPC",
+		2: "DUPLICATE_REASON_SAME_MODEL_NUMBER",
+		3: "DUPLICATE_REASON_SAME_SET",
+	}
+	DuplicateReason_value = map[string]int32{
+		"DUPLICATE_REASON_UNSPECIFIED":       0,
+		"DUPLICATE_REASON_SAME_UPC":          1,
+		"DUPLICATE_REASON_SAME_MODEL_NUMBER": 2,
+		"DUPLICATE_REASON_SAME_SET":          3,
+	}
+)
+
+func (x DuplicateReason) Enum() *DuplicateReason {
+	p := new(DuplicateReason)
+	*p = x
+	return p
+}
+
+func (x DuplicateReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[5].Descriptor()
+}
+
+func (DuplicateReason) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[5]
+}
+
+func (x DuplicateReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicateReason.Descriptor instead.
+func (DuplicateReason) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+// WatchlistChangeAction is what happened to a saved store or product
+type WatchlistChangeAction int32
+
+const (
+	WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_UNSPECIFIED WatchlistChangeAction = 0
+	WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_ADDED       WatchlistChangeAction = 1
+	WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_UPDATED     WatchlistChangeAction = 2
+	WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_REMOVED     WatchlistChangeAction = 3
+)
+
+// Enum value maps for WatchlistChangeAction.
+var (
+	WatchlistChangeAction_name = map[int32]string{
+		0: "WATCHLIST_CHANGE_ACTION_UNSPECIFIED",
+		1: "WATCHLIST_CHANGE_ACTION_ADDED",
+		2: "WATCHLIST_CHANGE_ACTION_UPDATED",
+		3: "WATCHLIST_CHANGE_ACTION_REMOVED",
+	}
+	WatchlistChangeAction_value = map[string]int32{
+		"WATCHLIST_CHANGE_ACTION_UNSPECIFIED": 0,
+		"WATCHLIST_CHANGE_ACTION_ADDED":       1,
+		"WATCHLIST_CHANGE_ACTION_UPDATED":     2,
+		"WATCHLIST_CHANGE_ACTION_REMOVED":     3,
+	}
+)
+
+func (x WatchlistChangeAction) Enum() *WatchlistChangeAction {
+	p := new(WatchlistChangeAction)
+	*p = x
+	return p
+}
+
+func (x WatchlistChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchlistChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[6].Descriptor()
+}
+
+func (WatchlistChangeAction) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[6]
+}
+
+func (x WatchlistChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchlistChangeAction.Descriptor instead.
+func (WatchlistChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+// StoreConfidence is how often users found stock on the shelf when a store reported it
+type StoreConfidence int32
+
+const (
+	StoreConfidence_STORE_CONFIDENCE_UNSPECIFIED StoreConfidence = 0 // too few confirmations to say
+	StoreConfidence_STORE_CONFIDENCE_LOW         StoreConfidence = 1
+	StoreConfidence_STORE_CONFIDENCE_MEDIUM      StoreConfidence = 2
+	StoreConfidence_STORE_CONFIDENCE_HIGH        StoreConfidence = 3
+)
+
+// Enum value maps for StoreConfidence.
+var (
+	StoreConfidence_name = map[int32]string{
+		0: "STORE_CONFIDENCE_UNSPECIFIED",
+		1: "STORE_CONFIDENCE_LOW",
+		2: "STORE_CONFIDENCE_MEDIUM",
+		3: "STORE_CONFIDENCE_HIGH",
+	}
+	StoreConfidence_value = map[string]int32{
+		"STORE_CONFIDENCE_UNSPECIFIED": 0,
+		"STORE_CONFIDENCE_LOW":         1,
+		"STORE_CONFIDENCE_MEDIUM":      2,
+		"STORE_CONFIDENCE_HIGH":        3,
+	}
+)
+
+func (x StoreConfidence) Enum() *StoreConfidence {
+	p := new(StoreConfidence)
+	*p = x
+	return p
+}
+
+func (x StoreConfidence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StoreConfidence) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[7].Descriptor()
+}
+
+func (StoreConfidence) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[7]
+}
+
+func (x StoreConfidence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StoreConfidence.Descriptor instead.
+func (StoreConfidence) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+// SightingStatus is where a sighting is in moderation
+type SightingStatus int32
+
+const (
+	SightingStatus_SIGHTING_STATUS_UNSPECIFIED SightingStatus = 0 // every status, when filtering
+	SightingStatus_SIGHTING_STATUS_PENDING     SightingStatus = 1
+	SightingStatus_SIGHTING_STATUS_CONFIRMED   SightingStatus = 2 // approved; watchers were alerted
+	SightingStatus_SIGHTING_STATUS_REJECTED    SightingStatus = 3
+)
+
+// Enum value maps for SightingStatus.
+var (
+	SightingStatus_name = map[int32]string{
+		0: "SIGHTING_STATUS_UNSPECIFIED",
+		1: "SIGHTING_STATUS_PENDING",
+		2: "SIGHTING_STATUS_CONFIRMED",
+		3: "SIGHTING_STATUS_REJECTED",
+	}
+	SightingStatus_value = map[string]int32{
+		"SIGHTING_STATUS_UNSPECIFIED": 0,
+		"SIGHTING_STATUS_PENDING":     1,
+		"SIGHTING_STATUS_CONFIRMED":   2,
+		"SIGHTING_STATUS_REJECTED":    3,
+	}
+)
+
+func (x SightingStatus) Enum() *SightingStatus {
+	p := new(SightingStatus)
+	*p = x
+	return p
+}
+
+func (x SightingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SightingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v1_service_proto_enumTypes[8].Descriptor()
+}
+
+func (SightingStatus) Type() protoreflect.EnumType {
+	return &file_stockchecker_v1_service_proto_enumTypes[8]
+}
+
+func (x SightingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SightingStatus.Descriptor instead.
+func (SightingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
 // Store represents a Best Buy store location
 type Store struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -32,25 +534,11224 @@ type Store struct {
 	PostalCode    string                 `protobuf:"bytes,6,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
 	Phone         string                 `protobuf:"bytes,7,opt,name=phone,proto3" json:"phone,omitempty"`
 	DistanceMiles float64                `protobuf:"fixed64,8,opt,name=distance_miles,json=distanceMiles,proto3" json:"distance_miles,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // when the store was saved; unset in search results
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // when the saved store was last changed
+	// Opening hours in the store's time zone, holiday hours included. Unset
+	// when the store's hours are unknown.
+	OpenNow       bool                   `protobuf:"varint,11,opt,name=open_now,json=openNow,proto3" json:"open_now,omitempty"`
+	HoursToday    string                 `protobuf:"bytes,12,opt,name=hours_today,json=hoursToday,proto3" json:"hours_today,omitempty"`        // "10:00-21:00" or "closed"; empty if unknown
+	SpecialHours  bool                   `protobuf:"varint,13,opt,name=special_hours,json=specialHours,proto3" json:"special_hours,omitempty"` // today's hours differ from the usual ones, e.g. a holiday
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`                 // when a closed store next opens
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Store) Reset() {
-	*x = Store{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[0]
+func (x *Store) Reset() {
+	*x = Store{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Store) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Store) ProtoMessage() {}
+
+func (x *Store) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Store.ProtoReflect.Descriptor instead.
+func (*Store) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Store) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *Store) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Store) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Store) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Store) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Store) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Store) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Store) GetDistanceMiles() float64 {
+	if x != nil {
+		return x.DistanceMiles
+	}
+	return 0
+}
+
+func (x *Store) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Store) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Store) GetOpenNow() bool {
+	if x != nil {
+		return x.OpenNow
+	}
+	return false
+}
+
+func (x *Store) GetHoursToday() string {
+	if x != nil {
+		return x.HoursToday
+	}
+	return ""
+}
+
+func (x *Store) GetSpecialHours() bool {
+	if x != nil {
+		return x.SpecialHours
+	}
+	return false
+}
+
+func (x *Store) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+// Product represents a Best Buy product
+type Product struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Deprecated: Marked as deprecated in stockchecker/v1/service.proto.
+	SalePrice      float64                `protobuf:"fixed64,3,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"` // use sale_price_cents; kept for older clients
+	ThumbnailUrl   string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ProductUrl     string                 `protobuf:"bytes,5,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
+	SalePriceCents int64                  `protobuf:"varint,6,opt,name=sale_price_cents,json=salePriceCents,proto3" json:"sale_price_cents,omitempty"`                        // sale price in cents of currency_code
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                          // when the product was saved; unset in search results
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                          // when the saved product was last changed
+	CurrencyCode   string                 `protobuf:"bytes,9,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`                                 // ISO 4217 code of the prices, e.g. "CAD" on bestbuy.ca; empty means USD
+	SetName        string                 `protobuf:"bytes,10,opt,name=set_name,json=setName,proto3" json:"set_name,omitempty"`                                               // TCG set read from the name, e.g. "Prismatic Evolutions"; empty if not found
+	ProductType    ProductType            `protobuf:"varint,11,opt,name=product_type,json=productType,proto3,enum=stockchecker.v1.ProductType" json:"product_type,omitempty"` // sealed product type read from the name
+	MsrpCents      int64                  `protobuf:"varint,12,opt,name=msrp_cents,json=msrpCents,proto3" json:"msrp_cents,omitempty"`                                        // MSRP of the set and product type in US cents; 0 if unknown
+	AboveMsrp      bool                   `protobuf:"varint,13,opt,name=above_msrp,json=aboveMsrp,proto3" json:"above_msrp,omitempty"`                                        // priced above MSRP, e.g. a marked-up bundle
+	Priority       WatchPriority          `protobuf:"varint,14,opt,name=priority,proto3,enum=stockchecker.v1.WatchPriority" json:"priority,omitempty"`                        // routes the saved product's alerts
+	Retailer       Retailer               `protobuf:"varint,15,opt,name=retailer,proto3,enum=stockchecker.v1.Retailer" json:"retailer,omitempty"`                             // who sells the product; unspecified is Best Buy
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in stockchecker/v1/service.proto.
+func (x *Product) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *Product) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Product) GetProductUrl() string {
+	if x != nil {
+		return x.ProductUrl
+	}
+	return ""
+}
+
+func (x *Product) GetSalePriceCents() int64 {
+	if x != nil {
+		return x.SalePriceCents
+	}
+	return 0
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Product) GetSetName() string {
+	if x != nil {
+		return x.SetName
+	}
+	return ""
+}
+
+func (x *Product) GetProductType() ProductType {
+	if x != nil {
+		return x.ProductType
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
+
+func (x *Product) GetMsrpCents() int64 {
+	if x != nil {
+		return x.MsrpCents
+	}
+	return 0
+}
+
+func (x *Product) GetAboveMsrp() bool {
+	if x != nil {
+		return x.AboveMsrp
+	}
+	return false
+}
+
+func (x *Product) GetPriority() WatchPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return WatchPriority_WATCH_PRIORITY_UNSPECIFIED
+}
+
+func (x *Product) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+// StockStatus represents the availability of a product at a store
+type StockStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Store          *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Product        *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	InStock        bool                   `protobuf:"varint,3,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	LowStock       bool                   `protobuf:"varint,4,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	PickupEligible bool                   `protobuf:"varint,5,opt,name=pickup_eligible,json=pickupEligible,proto3" json:"pickup_eligible,omitempty"`
+	IsMyStore      bool                   `protobuf:"varint,6,opt,name=is_my_store,json=isMyStore,proto3" json:"is_my_store,omitempty"` // True if store is in user's "My Stores" list
+	CheckedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`    // when availability was fetched from the retailer
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockStatus) Reset() {
+	*x = StockStatus{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockStatus) ProtoMessage() {}
+
+func (x *StockStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockStatus.ProtoReflect.Descriptor instead.
+func (*StockStatus) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StockStatus) GetStore() *Store {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *StockStatus) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *StockStatus) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *StockStatus) GetLowStock() bool {
+	if x != nil {
+		return x.LowStock
+	}
+	return false
+}
+
+func (x *StockStatus) GetPickupEligible() bool {
+	if x != nil {
+		return x.PickupEligible
+	}
+	return false
+}
+
+func (x *StockStatus) GetIsMyStore() bool {
+	if x != nil {
+		return x.IsMyStore
+	}
+	return false
+}
+
+func (x *StockStatus) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PictureUrl    string                 `protobuf:"bytes,4,opt,name=picture_url,json=pictureUrl,proto3" json:"picture_url,omitempty"`
+	Locale        string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"` // Language for notifications and messages (en, es, fr)
+	IsAdmin       bool                   `protobuf:"varint,6,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Role          UserRole               `protobuf:"varint,8,opt,name=role,proto3,enum=stockchecker.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *User) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetPictureUrl() string {
+	if x != nil {
+		return x.PictureUrl
+	}
+	return ""
+}
+
+func (x *User) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *User) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+// SearchStoresRequest is the request for searching stores
+type SearchStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostalCode    string                 `protobuf:"bytes,1,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	RadiusMiles   int32                  `protobuf:"varint,2,opt,name=radius_miles,json=radiusMiles,proto3" json:"radius_miles,omitempty"` // defaults to 25 if not specified
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`                           // name of one of the user's locations; replaces postal_code and radius_miles
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStoresRequest) Reset() {
+	*x = SearchStoresRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStoresRequest) ProtoMessage() {}
+
+func (x *SearchStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStoresRequest.ProtoReflect.Descriptor instead.
+func (*SearchStoresRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchStoresRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *SearchStoresRequest) GetRadiusMiles() int32 {
+	if x != nil {
+		return x.RadiusMiles
+	}
+	return 0
+}
+
+func (x *SearchStoresRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// SearchStoresResponse is the response containing matching stores
+type SearchStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStoresResponse) Reset() {
+	*x = SearchStoresResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStoresResponse) ProtoMessage() {}
+
+func (x *SearchStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStoresResponse.ProtoReflect.Descriptor instead.
+func (*SearchStoresResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchStoresResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+// SearchProductsRequest is the request for searching products
+type SearchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                        // search term or SKU
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`                  // optional category filter (e.g., "POKEMON CARDS")
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 100
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchProductsResponse is the response containing matching products
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	TotalSize     int32                  `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`              // products matching the search across all pages
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchProductsResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// CheckStockRequest is the request for checking stock
+type CheckStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreIds      []string               `protobuf:"bytes,1,rep,name=store_ids,json=storeIds,proto3" json:"store_ids,omitempty"` // User's saved store IDs (for highlighting)
+	Skus          []string               `protobuf:"bytes,2,rep,name=skus,proto3" json:"skus,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"` // Postal code to search from (250 mile radius)
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`                       // name of one of the user's locations; replaces postal_code and limits results to its radius
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStockRequest) Reset() {
+	*x = CheckStockRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStockRequest) ProtoMessage() {}
+
+func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
+func (*CheckStockRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CheckStockRequest) GetStoreIds() []string {
+	if x != nil {
+		return x.StoreIds
+	}
+	return nil
+}
+
+func (x *CheckStockRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *CheckStockRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *CheckStockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// SkuError explains why a SKU couldn't be checked
+type SkuError struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sku               string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Message           string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // localized description
+	Code              SkuErrorCode           `protobuf:"varint,3,opt,name=code,proto3,enum=stockchecker.v1.SkuErrorCode" json:"code,omitempty"`
+	RetryAfterSeconds int32                  `protobuf:"varint,4,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"` // set for SKU_ERROR_CODE_RATE_LIMITED
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SkuError) Reset() {
+	*x = SkuError{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkuError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkuError) ProtoMessage() {}
+
+func (x *SkuError) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkuError.ProtoReflect.Descriptor instead.
+func (*SkuError) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SkuError) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SkuError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SkuError) GetCode() SkuErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return SkuErrorCode_SKU_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *SkuError) GetRetryAfterSeconds() int32 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+// MaintenanceError is attached to the Unavailable error returned by RPCs
+// that change data while the server is read-only for database maintenance.
+// Reads (RPCs without side effects) keep working.
+type MaintenanceError struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RetryAfterSeconds int32                  `protobuf:"varint,1,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"` // when to try again
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MaintenanceError) Reset() {
+	*x = MaintenanceError{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceError) ProtoMessage() {}
+
+func (x *MaintenanceError) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceError.ProtoReflect.Descriptor instead.
+func (*MaintenanceError) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MaintenanceError) GetRetryAfterSeconds() int32 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+// CheckStockResponse is the response containing stock status
+type CheckStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*StockStatus         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Errors        []*SkuError            `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // SKUs that couldn't be checked; results cover the rest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStockResponse) Reset() {
+	*x = CheckStockResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStockResponse) ProtoMessage() {}
+
+func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
+func (*CheckStockResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CheckStockResponse) GetResults() []*StockStatus {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CheckStockResponse) GetErrors() []*SkuError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// GetCurrentUserRequest is empty - user is determined from session
+type GetCurrentUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentUserRequest) Reset() {
+	*x = GetCurrentUserRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserRequest) ProtoMessage() {}
+
+func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+// GetCurrentUserResponse returns the current user
+type GetCurrentUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrentUserResponse) Reset() {
+	*x = GetCurrentUserResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrentUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserResponse) ProtoMessage() {}
+
+func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetCurrentUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// SetMyLocaleRequest sets the user's language
+type SetMyLocaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        string                 `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"` // en, es or fr
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMyLocaleRequest) Reset() {
+	*x = SetMyLocaleRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMyLocaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMyLocaleRequest) ProtoMessage() {}
+
+func (x *SetMyLocaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMyLocaleRequest.ProtoReflect.Descriptor instead.
+func (*SetMyLocaleRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetMyLocaleRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// SetMyLocaleResponse is empty on success
+type SetMyLocaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMyLocaleResponse) Reset() {
+	*x = SetMyLocaleResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMyLocaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMyLocaleResponse) ProtoMessage() {}
+
+func (x *SetMyLocaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMyLocaleResponse.ProtoReflect.Descriptor instead.
+func (*SetMyLocaleResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+// GetMyStoresRequest is empty - user is determined from session
+type GetMyStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyStoresRequest) Reset() {
+	*x = GetMyStoresRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyStoresRequest) ProtoMessage() {}
+
+func (x *GetMyStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyStoresRequest.ProtoReflect.Descriptor instead.
+func (*GetMyStoresRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+// GetMyStoresResponse returns the user's saved stores
+type GetMyStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyStoresResponse) Reset() {
+	*x = GetMyStoresResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyStoresResponse) ProtoMessage() {}
+
+func (x *GetMyStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyStoresResponse.ProtoReflect.Descriptor instead.
+func (*GetMyStoresResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetMyStoresResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+// AddMyStoreRequest adds a store to the user's list
+type AddMyStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMyStoreRequest) Reset() {
+	*x = AddMyStoreRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyStoreRequest) ProtoMessage() {}
+
+func (x *AddMyStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyStoreRequest.ProtoReflect.Descriptor instead.
+func (*AddMyStoreRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddMyStoreRequest) GetStore() *Store {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+// AddMyStoreResponse is empty on success
+type AddMyStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMyStoreResponse) Reset() {
+	*x = AddMyStoreResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyStoreResponse) ProtoMessage() {}
+
+func (x *AddMyStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyStoreResponse.ProtoReflect.Descriptor instead.
+func (*AddMyStoreResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+// RemoveMyStoreRequest removes a store from the user's list
+type RemoveMyStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyStoreRequest) Reset() {
+	*x = RemoveMyStoreRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyStoreRequest) ProtoMessage() {}
+
+func (x *RemoveMyStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyStoreRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMyStoreRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveMyStoreRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+// RemoveMyStoreResponse is empty on success
+type RemoveMyStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyStoreResponse) Reset() {
+	*x = RemoveMyStoreResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyStoreResponse) ProtoMessage() {}
+
+func (x *RemoveMyStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyStoreResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMyStoreResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+// GetMyProductsRequest - user is determined from session
+type GetMyProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetName       string                 `protobuf:"bytes,1,opt,name=set_name,json=setName,proto3" json:"set_name,omitempty"` // only products from this set, ignoring case; empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyProductsRequest) Reset() {
+	*x = GetMyProductsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyProductsRequest) ProtoMessage() {}
+
+func (x *GetMyProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetMyProductsRequest) GetSetName() string {
+	if x != nil {
+		return x.SetName
+	}
+	return ""
+}
+
+// GetMyProductsResponse returns the user's saved products
+type GetMyProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyProductsResponse) Reset() {
+	*x = GetMyProductsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyProductsResponse) ProtoMessage() {}
+
+func (x *GetMyProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetMyProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// AddMyProductRequest adds a product to the user's list
+type AddMyProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMyProductRequest) Reset() {
+	*x = AddMyProductRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyProductRequest) ProtoMessage() {}
+
+func (x *AddMyProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyProductRequest.ProtoReflect.Descriptor instead.
+func (*AddMyProductRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AddMyProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// PossibleDuplicate is a saved product that may duplicate the one being added
+type PossibleDuplicate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        DuplicateReason        `protobuf:"varint,3,opt,name=reason,proto3,enum=stockchecker.v1.DuplicateReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PossibleDuplicate) Reset() {
+	*x = PossibleDuplicate{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PossibleDuplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PossibleDuplicate) ProtoMessage() {}
+
+func (x *PossibleDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PossibleDuplicate.ProtoReflect.Descriptor instead.
+func (*PossibleDuplicate) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PossibleDuplicate) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *PossibleDuplicate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PossibleDuplicate) GetReason() DuplicateReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DuplicateReason_DUPLICATE_REASON_UNSPECIFIED
+}
+
+// AddMyProductResponse warns about saved products that look like the same
+// item; the product is added either way
+type AddMyProductResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PossibleDuplicates []*PossibleDuplicate   `protobuf:"bytes,1,rep,name=possible_duplicates,json=possibleDuplicates,proto3" json:"possible_duplicates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddMyProductResponse) Reset() {
+	*x = AddMyProductResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyProductResponse) ProtoMessage() {}
+
+func (x *AddMyProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyProductResponse.ProtoReflect.Descriptor instead.
+func (*AddMyProductResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddMyProductResponse) GetPossibleDuplicates() []*PossibleDuplicate {
+	if x != nil {
+		return x.PossibleDuplicates
+	}
+	return nil
+}
+
+// RemoveMyProductRequest removes a product from the user's list
+type RemoveMyProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyProductRequest) Reset() {
+	*x = RemoveMyProductRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyProductRequest) ProtoMessage() {}
+
+func (x *RemoveMyProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyProductRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMyProductRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveMyProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// RemoveMyProductResponse is empty on success
+type RemoveMyProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyProductResponse) Reset() {
+	*x = RemoveMyProductResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyProductResponse) ProtoMessage() {}
+
+func (x *RemoveMyProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyProductResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMyProductResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+// RemoveMyProductsRequest removes several products from the user's list at once
+type RemoveMyProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []string               `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"` // SKUs that aren't saved are skipped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyProductsRequest) Reset() {
+	*x = RemoveMyProductsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyProductsRequest) ProtoMessage() {}
+
+func (x *RemoveMyProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyProductsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMyProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RemoveMyProductsRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// RemoveMyProductsResponse returns how many products were removed
+type RemoveMyProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Removed       int32                  `protobuf:"varint,1,opt,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyProductsResponse) Reset() {
+	*x = RemoveMyProductsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyProductsResponse) ProtoMessage() {}
+
+func (x *RemoveMyProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyProductsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMyProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RemoveMyProductsResponse) GetRemoved() int32 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+// ClearWatchlistRequest removes every product from the user's list
+type ClearWatchlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Confirm       bool                   `protobuf:"varint,1,opt,name=confirm,proto3" json:"confirm,omitempty"`                                  // must be set; guards against clearing by accident
+	IncludeStores bool                   `protobuf:"varint,2,opt,name=include_stores,json=includeStores,proto3" json:"include_stores,omitempty"` // also remove the user's saved stores
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearWatchlistRequest) Reset() {
+	*x = ClearWatchlistRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearWatchlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearWatchlistRequest) ProtoMessage() {}
+
+func (x *ClearWatchlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearWatchlistRequest.ProtoReflect.Descriptor instead.
+func (*ClearWatchlistRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClearWatchlistRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+func (x *ClearWatchlistRequest) GetIncludeStores() bool {
+	if x != nil {
+		return x.IncludeStores
+	}
+	return false
+}
+
+// ClearWatchlistResponse returns how much was removed. Each removal is a
+// watchlist change, so UndoLastChange restores items one at a time.
+type ClearWatchlistResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RemovedProducts int32                  `protobuf:"varint,1,opt,name=removed_products,json=removedProducts,proto3" json:"removed_products,omitempty"`
+	RemovedStores   int32                  `protobuf:"varint,2,opt,name=removed_stores,json=removedStores,proto3" json:"removed_stores,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClearWatchlistResponse) Reset() {
+	*x = ClearWatchlistResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearWatchlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearWatchlistResponse) ProtoMessage() {}
+
+func (x *ClearWatchlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearWatchlistResponse.ProtoReflect.Descriptor instead.
+func (*ClearWatchlistResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ClearWatchlistResponse) GetRemovedProducts() int32 {
+	if x != nil {
+		return x.RemovedProducts
+	}
+	return 0
+}
+
+func (x *ClearWatchlistResponse) GetRemovedStores() int32 {
+	if x != nil {
+		return x.RemovedStores
+	}
+	return 0
+}
+
+// ImportMyProductsRequest adds a pasted list of SKUs, UPCs or product URLs to the user's list
+type ImportMyProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // one product per line, or separated by commas, semicolons or tabs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMyProductsRequest) Reset() {
+	*x = ImportMyProductsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMyProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMyProductsRequest) ProtoMessage() {}
+
+func (x *ImportMyProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMyProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportMyProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportMyProductsRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// ImportMyProductsResponse lists what was added and what couldn't be
+type ImportMyProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"` // products added to the list
+	Rejected      []string               `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"` // entries that aren't products or weren't found
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportMyProductsResponse) Reset() {
+	*x = ImportMyProductsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportMyProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportMyProductsResponse) ProtoMessage() {}
+
+func (x *ImportMyProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportMyProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportMyProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportMyProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ImportMyProductsResponse) GetRejected() []string {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+// BrowsePokemonProductsRequest is the request for browsing Pokemon products
+type BrowsePokemonProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllPages      bool                   `protobuf:"varint,1,opt,name=all_pages,json=allPages,proto3" json:"all_pages,omitempty"` // return every product in the subclass instead of the first 100; slower
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowsePokemonProductsRequest) Reset() {
+	*x = BrowsePokemonProductsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowsePokemonProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowsePokemonProductsRequest) ProtoMessage() {}
+
+func (x *BrowsePokemonProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowsePokemonProductsRequest.ProtoReflect.Descriptor instead.
+func (*BrowsePokemonProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BrowsePokemonProductsRequest) GetAllPages() bool {
+	if x != nil {
+		return x.AllPages
+	}
+	return false
+}
+
+// BrowsePokemonProductsResponse returns Pokemon products from the trading cards category
+type BrowsePokemonProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowsePokemonProductsResponse) Reset() {
+	*x = BrowsePokemonProductsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowsePokemonProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowsePokemonProductsResponse) ProtoMessage() {}
+
+func (x *BrowsePokemonProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowsePokemonProductsResponse.ProtoReflect.Descriptor instead.
+func (*BrowsePokemonProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BrowsePokemonProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+// NotificationChannel is a user's configuration for one way of receiving alerts
+type NotificationChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelType   string                 `protobuf:"bytes,1,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"` // pushover, gotify, matrix, twilio_voice, email, webhook, discord
+	Config        string                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`                              // channel-specific JSON; secrets are omitted in responses
+	Enabled       bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Rollup        string                 `protobuf:"bytes,6,opt,name=rollup,proto3" json:"rollup,omitempty"` // summary (one message listing every store, the default) or per_store; empty keeps the saved mode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationChannel) Reset() {
+	*x = NotificationChannel{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannel) ProtoMessage() {}
+
+func (x *NotificationChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannel.ProtoReflect.Descriptor instead.
+func (*NotificationChannel) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NotificationChannel) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+func (x *NotificationChannel) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *NotificationChannel) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationChannel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NotificationChannel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NotificationChannel) GetRollup() string {
+	if x != nil {
+		return x.Rollup
+	}
+	return ""
+}
+
+// GetNotificationChannelsRequest is empty - user is determined from session
+type GetNotificationChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationChannelsRequest) Reset() {
+	*x = GetNotificationChannelsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationChannelsRequest) ProtoMessage() {}
+
+func (x *GetNotificationChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+// GetNotificationChannelsResponse returns the user's configured channels
+type GetNotificationChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []*NotificationChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationChannelsResponse) Reset() {
+	*x = GetNotificationChannelsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationChannelsResponse) ProtoMessage() {}
+
+func (x *GetNotificationChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationChannelsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetNotificationChannelsResponse) GetChannels() []*NotificationChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// SetNotificationChannelRequest creates or replaces a channel's configuration.
+// Secrets left out of config keep their saved values.
+type SetNotificationChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *NotificationChannel   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationChannelRequest) Reset() {
+	*x = SetNotificationChannelRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationChannelRequest) ProtoMessage() {}
+
+func (x *SetNotificationChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationChannelRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationChannelRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetNotificationChannelRequest) GetChannel() *NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// SetNotificationChannelResponse returns the saved channel
+type SetNotificationChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *NotificationChannel   `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationChannelResponse) Reset() {
+	*x = SetNotificationChannelResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationChannelResponse) ProtoMessage() {}
+
+func (x *SetNotificationChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationChannelResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationChannelResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetNotificationChannelResponse) GetChannel() *NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
+}
+
+// DeleteNotificationChannelRequest removes a channel
+type DeleteNotificationChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelType   string                 `protobuf:"bytes,1,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationChannelRequest) Reset() {
+	*x = DeleteNotificationChannelRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationChannelRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationChannelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationChannelRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteNotificationChannelRequest) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+// DeleteNotificationChannelResponse is empty on success
+type DeleteNotificationChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationChannelResponse) Reset() {
+	*x = DeleteNotificationChannelResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationChannelResponse) ProtoMessage() {}
+
+func (x *DeleteNotificationChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationChannelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationChannelResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+// NotificationTemplate customizes notification content using Go text/template syntax.
+// Available fields: .Product, .SKU, .Price, .Image, .Stores, .Distance, .Links.Product, .Links.AddToCart
+type NotificationTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelType   string                 `protobuf:"bytes,1,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"` // channel the template applies to; empty applies to every channel
+	TitleTemplate string                 `protobuf:"bytes,2,opt,name=title_template,json=titleTemplate,proto3" json:"title_template,omitempty"`
+	BodyTemplate  string                 `protobuf:"bytes,3,opt,name=body_template,json=bodyTemplate,proto3" json:"body_template,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // True for admin-defined defaults used when a user has no template
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationTemplate) Reset() {
+	*x = NotificationTemplate{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationTemplate) ProtoMessage() {}
+
+func (x *NotificationTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationTemplate.ProtoReflect.Descriptor instead.
+func (*NotificationTemplate) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *NotificationTemplate) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetTitleTemplate() string {
+	if x != nil {
+		return x.TitleTemplate
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetBodyTemplate() string {
+	if x != nil {
+		return x.BodyTemplate
+	}
+	return ""
+}
+
+func (x *NotificationTemplate) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// GetNotificationTemplatesRequest is empty - user is determined from session
+type GetNotificationTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationTemplatesRequest) Reset() {
+	*x = GetNotificationTemplatesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationTemplatesRequest) ProtoMessage() {}
+
+func (x *GetNotificationTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+// GetNotificationTemplatesResponse returns the user's templates and the admin defaults
+type GetNotificationTemplatesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Templates     []*NotificationTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationTemplatesResponse) Reset() {
+	*x = GetNotificationTemplatesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationTemplatesResponse) ProtoMessage() {}
+
+func (x *GetNotificationTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetNotificationTemplatesResponse) GetTemplates() []*NotificationTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// SetNotificationTemplateRequest creates or replaces a template
+type SetNotificationTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *NotificationTemplate  `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"` // is_default requires an admin
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationTemplateRequest) Reset() {
+	*x = SetNotificationTemplateRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationTemplateRequest) ProtoMessage() {}
+
+func (x *SetNotificationTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetNotificationTemplateRequest) GetTemplate() *NotificationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// SetNotificationTemplateResponse is empty on success
+type SetNotificationTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationTemplateResponse) Reset() {
+	*x = SetNotificationTemplateResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationTemplateResponse) ProtoMessage() {}
+
+func (x *SetNotificationTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+// DeleteNotificationTemplateRequest removes a template, reverting to the default
+type DeleteNotificationTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelType   string                 `protobuf:"bytes,1,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // remove the admin default instead (requires an admin)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationTemplateRequest) Reset() {
+	*x = DeleteNotificationTemplateRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteNotificationTemplateRequest) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+func (x *DeleteNotificationTemplateRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+// DeleteNotificationTemplateResponse is empty on success
+type DeleteNotificationTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNotificationTemplateResponse) Reset() {
+	*x = DeleteNotificationTemplateResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNotificationTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteNotificationTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+// SendTestNotificationRequest renders a notification from sample data and optionally sends it
+type SendTestNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelType   string                 `protobuf:"bytes,1,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"`
+	Template      *NotificationTemplate  `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`                           // optional unsaved template to preview; defaults to the configured one
+	PreviewOnly   bool                   `protobuf:"varint,3,opt,name=preview_only,json=previewOnly,proto3" json:"preview_only,omitempty"` // render without sending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestNotificationRequest) Reset() {
+	*x = SendTestNotificationRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestNotificationRequest) ProtoMessage() {}
+
+func (x *SendTestNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendTestNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SendTestNotificationRequest) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+func (x *SendTestNotificationRequest) GetTemplate() *NotificationTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *SendTestNotificationRequest) GetPreviewOnly() bool {
+	if x != nil {
+		return x.PreviewOnly
+	}
+	return false
+}
+
+// SendTestNotificationResponse returns the rendered notification
+type SendTestNotificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Sent          bool                   `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTestNotificationResponse) Reset() {
+	*x = SendTestNotificationResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTestNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestNotificationResponse) ProtoMessage() {}
+
+func (x *SendTestNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendTestNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SendTestNotificationResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendTestNotificationResponse) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendTestNotificationResponse) GetSent() bool {
+	if x != nil {
+		return x.Sent
+	}
+	return false
+}
+
+// SimulateWatcherCycleRequest configures a dry run of the stock watcher (admin only)
+type SimulateWatcherCycleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UseMockData   bool                   `protobuf:"varint,1,opt,name=use_mock_data,json=useMockData,proto3" json:"use_mock_data,omitempty"` // check against mock data instead of the live Best Buy API
+	FromEmpty     bool                   `protobuf:"varint,2,opt,name=from_empty,json=fromEmpty,proto3" json:"from_empty,omitempty"`         // treat every in-stock store as new instead of starting from the watcher's current state
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateWatcherCycleRequest) Reset() {
+	*x = SimulateWatcherCycleRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateWatcherCycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateWatcherCycleRequest) ProtoMessage() {}
+
+func (x *SimulateWatcherCycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateWatcherCycleRequest.ProtoReflect.Descriptor instead.
+func (*SimulateWatcherCycleRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SimulateWatcherCycleRequest) GetUseMockData() bool {
+	if x != nil {
+		return x.UseMockData
+	}
+	return false
+}
+
+func (x *SimulateWatcherCycleRequest) GetFromEmpty() bool {
+	if x != nil {
+		return x.FromEmpty
+	}
+	return false
+}
+
+// SimulatedNotification is a notification the watcher would send
+type SimulatedNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Stores        []*Store               `protobuf:"bytes,3,rep,name=stores,proto3" json:"stores,omitempty"`                              // stores that came into stock
+	ChannelType   string                 `protobuf:"bytes,4,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"` // empty if the user has no enabled channel (nothing would be sent)
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatedNotification) Reset() {
+	*x = SimulatedNotification{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatedNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedNotification) ProtoMessage() {}
+
+func (x *SimulatedNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedNotification.ProtoReflect.Descriptor instead.
+func (*SimulatedNotification) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SimulatedNotification) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *SimulatedNotification) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *SimulatedNotification) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+func (x *SimulatedNotification) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+func (x *SimulatedNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SimulatedNotification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// SimulateWatcherCycleResponse lists the notifications that would fire
+type SimulateWatcherCycleResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Notifications []*SimulatedNotification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateWatcherCycleResponse) Reset() {
+	*x = SimulateWatcherCycleResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateWatcherCycleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateWatcherCycleResponse) ProtoMessage() {}
+
+func (x *SimulateWatcherCycleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateWatcherCycleResponse.ProtoReflect.Descriptor instead.
+func (*SimulateWatcherCycleResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SimulateWatcherCycleResponse) GetNotifications() []*SimulatedNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+// GetMyDashboardRequest selects the range of the daily availability history
+type GetMyDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"` // days of history including today; defaults to 7, max 90
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyDashboardRequest) Reset() {
+	*x = GetMyDashboardRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyDashboardRequest) ProtoMessage() {}
+
+func (x *GetMyDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetMyDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetMyDashboardRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// CurrentAvailability is the current stock state of a watched product at a saved store
+type CurrentAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	StoreId       string                 `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	StoreName     string                 `protobuf:"bytes,4,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	InStock       bool                   `protobuf:"varint,5,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	LowStock      bool                   `protobuf:"varint,6,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	Since         string                 `protobuf:"bytes,7,opt,name=since,proto3" json:"since,omitempty"` // RFC 3339 time of the last change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrentAvailability) Reset() {
+	*x = CurrentAvailability{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrentAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrentAvailability) ProtoMessage() {}
+
+func (x *CurrentAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrentAvailability.ProtoReflect.Descriptor instead.
+func (*CurrentAvailability) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CurrentAvailability) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CurrentAvailability) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *CurrentAvailability) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *CurrentAvailability) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *CurrentAvailability) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *CurrentAvailability) GetLowStock() bool {
+	if x != nil {
+		return x.LowStock
+	}
+	return false
+}
+
+func (x *CurrentAvailability) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+// DailyAvailability is how long a product was in stock at a store on one UTC day
+type DailyAvailability struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sku            string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	StoreId        string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Day            string                 `protobuf:"bytes,3,opt,name=day,proto3" json:"day,omitempty"` // YYYY-MM-DD
+	InStockMinutes int32                  `protobuf:"varint,4,opt,name=in_stock_minutes,json=inStockMinutes,proto3" json:"in_stock_minutes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DailyAvailability) Reset() {
+	*x = DailyAvailability{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyAvailability) ProtoMessage() {}
+
+func (x *DailyAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyAvailability.ProtoReflect.Descriptor instead.
+func (*DailyAvailability) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DailyAvailability) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *DailyAvailability) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *DailyAvailability) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *DailyAvailability) GetInStockMinutes() int32 {
+	if x != nil {
+		return x.InStockMinutes
+	}
+	return 0
+}
+
+// GetMyDashboardResponse summarizes stock for the user's products at their saved stores
+type GetMyDashboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Availability  []*CurrentAvailability `protobuf:"bytes,1,rep,name=availability,proto3" json:"availability,omitempty"`
+	Daily         []*DailyAvailability   `protobuf:"bytes,2,rep,name=daily,proto3" json:"daily,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyDashboardResponse) Reset() {
+	*x = GetMyDashboardResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyDashboardResponse) ProtoMessage() {}
+
+func (x *GetMyDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyDashboardResponse.ProtoReflect.Descriptor instead.
+func (*GetMyDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetMyDashboardResponse) GetAvailability() []*CurrentAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+func (x *GetMyDashboardResponse) GetDaily() []*DailyAvailability {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+// UpdateMyProductRequest changes details of a saved product
+type UpdateMyProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`                         // sku identifies the product and can't be changed
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // name, sale_price_cents, thumbnail_url, product_url; empty updates all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyProductRequest) Reset() {
+	*x = UpdateMyProductRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyProductRequest) ProtoMessage() {}
+
+func (x *UpdateMyProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyProductRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateMyProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *UpdateMyProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateMyProductResponse returns the updated product
+type UpdateMyProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyProductResponse) Reset() {
+	*x = UpdateMyProductResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyProductResponse) ProtoMessage() {}
+
+func (x *UpdateMyProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMyProductResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateMyProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// NotificationPreferences control which alerts the user receives
+type NotificationPreferences struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AlertsEnabled       bool                   `protobuf:"varint,1,opt,name=alerts_enabled,json=alertsEnabled,proto3" json:"alerts_enabled,omitempty"`
+	IncludeLowStock     bool                   `protobuf:"varint,2,opt,name=include_low_stock,json=includeLowStock,proto3" json:"include_low_stock,omitempty"`     // alert on stores reporting low stock
+	MaxDistanceMiles    float64                `protobuf:"fixed64,3,opt,name=max_distance_miles,json=maxDistanceMiles,proto3" json:"max_distance_miles,omitempty"` // ignore stores further away; 0 means no limit
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UrgentChannels      []string               `protobuf:"bytes,5,rep,name=urgent_channels,json=urgentChannels,proto3" json:"urgent_channels,omitempty"`                   // channel types must-have alerts go to; empty for every enabled channel
+	DigestIntervalHours int32                  `protobuf:"varint,6,opt,name=digest_interval_hours,json=digestIntervalHours,proto3" json:"digest_interval_hours,omitempty"` // how long nice-to-have alerts wait for a digest (1-168, default 24)
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *NotificationPreferences) GetAlertsEnabled() bool {
+	if x != nil {
+		return x.AlertsEnabled
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetIncludeLowStock() bool {
+	if x != nil {
+		return x.IncludeLowStock
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetMaxDistanceMiles() float64 {
+	if x != nil {
+		return x.MaxDistanceMiles
+	}
+	return 0
+}
+
+func (x *NotificationPreferences) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetUrgentChannels() []string {
+	if x != nil {
+		return x.UrgentChannels
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetDigestIntervalHours() int32 {
+	if x != nil {
+		return x.DigestIntervalHours
+	}
+	return 0
+}
+
+// GetNotificationPreferencesRequest is empty - user is determined from session
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+// GetNotificationPreferencesResponse returns the user's preferences (defaults if never saved)
+type GetNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// UpdateNotificationPreferencesRequest changes the user's preferences
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask   `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // alerts_enabled, include_low_stock, max_distance_miles; empty updates all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *UpdateNotificationPreferencesRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateNotificationPreferencesResponse returns the updated preferences
+type UpdateNotificationPreferencesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesResponse) Reset() {
+	*x = UpdateNotificationPreferencesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateNotificationPreferencesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// AlertRule narrows when a watched product triggers an alert
+type AlertRule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Sku              string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Enabled          bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	MaxPriceCents    int64                  `protobuf:"varint,3,opt,name=max_price_cents,json=maxPriceCents,proto3" json:"max_price_cents,omitempty"` // only alert at or below this price; 0 means any price
+	MinStores        int32                  `protobuf:"varint,4,opt,name=min_stores,json=minStores,proto3" json:"min_stores,omitempty"`               // only alert when at least this many stores come into stock in one check
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	MaxDistanceMiles float64                `protobuf:"fixed64,6,opt,name=max_distance_miles,json=maxDistanceMiles,proto3" json:"max_distance_miles,omitempty"` // only alert for stores within this distance of location; 0 means no limit
+	Location         string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`                                             // name of one of the user's locations; empty measures from the watched postal code
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AlertRule) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AlertRule) GetMaxPriceCents() int64 {
+	if x != nil {
+		return x.MaxPriceCents
+	}
+	return 0
+}
+
+func (x *AlertRule) GetMinStores() int32 {
+	if x != nil {
+		return x.MinStores
+	}
+	return 0
+}
+
+func (x *AlertRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AlertRule) GetMaxDistanceMiles() float64 {
+	if x != nil {
+		return x.MaxDistanceMiles
+	}
+	return 0
+}
+
+func (x *AlertRule) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+// GetAlertRulesRequest is empty - user is determined from session
+type GetAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRulesRequest) Reset() {
+	*x = GetAlertRulesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRulesRequest) ProtoMessage() {}
+
+func (x *GetAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+// GetAlertRulesResponse returns the rules the user has saved; other products use the defaults
+type GetAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAlertRulesResponse) Reset() {
+	*x = GetAlertRulesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAlertRulesResponse) ProtoMessage() {}
+
+func (x *GetAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// UpdateAlertRuleRequest creates or changes the rule for a watched product
+type UpdateAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`                               // sku identifies the product
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"` // enabled, max_price_cents, min_stores, max_distance_miles, location; empty updates all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleRequest) Reset() {
+	*x = UpdateAlertRuleRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleRequest) ProtoMessage() {}
+
+func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateAlertRuleRequest) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *UpdateAlertRuleRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// UpdateAlertRuleResponse returns the updated rule
+type UpdateAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *AlertRule             `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAlertRuleResponse) Reset() {
+	*x = UpdateAlertRuleResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAlertRuleResponse) ProtoMessage() {}
+
+func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateAlertRuleResponse) GetRule() *AlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// SyncChangesRequest asks for everything that changed since a previous sync
+type SyncChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SinceToken    string                 `protobuf:"bytes,1,opt,name=since_token,json=sinceToken,proto3" json:"since_token,omitempty"` // next_token from the previous sync; empty for a full sync
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncChangesRequest) Reset() {
+	*x = SyncChangesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChangesRequest) ProtoMessage() {}
+
+func (x *SyncChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChangesRequest.ProtoReflect.Descriptor instead.
+func (*SyncChangesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SyncChangesRequest) GetSinceToken() string {
+	if x != nil {
+		return x.SinceToken
+	}
+	return ""
+}
+
+// StockSnapshot is the latest stock state the watcher saw for a product near a postal code
+type StockSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Sku             string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	PostalCode      string                 `protobuf:"bytes,2,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	InStockStoreIds []string               `protobuf:"bytes,3,rep,name=in_stock_store_ids,json=inStockStoreIds,proto3" json:"in_stock_store_ids,omitempty"`
+	CheckedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockSnapshot) Reset() {
+	*x = StockSnapshot{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockSnapshot) ProtoMessage() {}
+
+func (x *StockSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockSnapshot.ProtoReflect.Descriptor instead.
+func (*StockSnapshot) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *StockSnapshot) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *StockSnapshot) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *StockSnapshot) GetInStockStoreIds() []string {
+	if x != nil {
+		return x.InStockStoreIds
+	}
+	return nil
+}
+
+func (x *StockSnapshot) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// SyncChangesResponse lists the changes to apply locally. Changes can repeat
+// across syncs, so clients should apply them as upserts.
+type SyncChangesResponse struct {
+	state           protoimpl.MessageState   `protogen:"open.v1"`
+	Stores          []*Store                 `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"` // saved stores added or changed
+	RemovedStoreIds []string                 `protobuf:"bytes,2,rep,name=removed_store_ids,json=removedStoreIds,proto3" json:"removed_store_ids,omitempty"`
+	Products        []*Product               `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"` // saved products added or changed
+	RemovedSkus     []string                 `protobuf:"bytes,4,rep,name=removed_skus,json=removedSkus,proto3" json:"removed_skus,omitempty"`
+	Preferences     *NotificationPreferences `protobuf:"bytes,5,opt,name=preferences,proto3" json:"preferences,omitempty"`                             // unset if unchanged
+	AlertRules      []*AlertRule             `protobuf:"bytes,6,rep,name=alert_rules,json=alertRules,proto3" json:"alert_rules,omitempty"`             // rules added or changed
+	StockSnapshots  []*StockSnapshot         `protobuf:"bytes,7,rep,name=stock_snapshots,json=stockSnapshots,proto3" json:"stock_snapshots,omitempty"` // latest stock near the user's stores
+	NextToken       string                   `protobuf:"bytes,8,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`                // pass as since_token on the next sync
+	FullSync        bool                     `protobuf:"varint,9,opt,name=full_sync,json=fullSync,proto3" json:"full_sync,omitempty"`                  // true if this is a full snapshot that replaces local state
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SyncChangesResponse) Reset() {
+	*x = SyncChangesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncChangesResponse) ProtoMessage() {}
+
+func (x *SyncChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncChangesResponse.ProtoReflect.Descriptor instead.
+func (*SyncChangesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SyncChangesResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetRemovedStoreIds() []string {
+	if x != nil {
+		return x.RemovedStoreIds
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetRemovedSkus() []string {
+	if x != nil {
+		return x.RemovedSkus
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetAlertRules() []*AlertRule {
+	if x != nil {
+		return x.AlertRules
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetStockSnapshots() []*StockSnapshot {
+	if x != nil {
+		return x.StockSnapshots
+	}
+	return nil
+}
+
+func (x *SyncChangesResponse) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+func (x *SyncChangesResponse) GetFullSync() bool {
+	if x != nil {
+		return x.FullSync
+	}
+	return false
+}
+
+// WatchlistChange is one change to the user's saved stores or products, from any client
+type WatchlistChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      string                 `protobuf:"bytes,1,opt,name=retailer,proto3" json:"retailer,omitempty"` // retailer ID, e.g. "bestbuy"
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`           // set for product changes
+	Action        WatchlistChangeAction  `protobuf:"varint,3,opt,name=action,proto3,enum=stockchecker.v1.WatchlistChangeAction" json:"action,omitempty"`
+	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // name of the product or store when the change was made
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	StoreId       string                 `protobuf:"bytes,6,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"` // set for store changes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchlistChange) Reset() {
+	*x = WatchlistChange{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistChange) ProtoMessage() {}
+
+func (x *WatchlistChange) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistChange.ProtoReflect.Descriptor instead.
+func (*WatchlistChange) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *WatchlistChange) GetRetailer() string {
+	if x != nil {
+		return x.Retailer
+	}
+	return ""
+}
+
+func (x *WatchlistChange) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *WatchlistChange) GetAction() WatchlistChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_UNSPECIFIED
+}
+
+func (x *WatchlistChange) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *WatchlistChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *WatchlistChange) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+// ListWatchlistChangesRequest asks for changes to the user's saved stores and products
+type ListWatchlistChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`                        // changes at or after this time; unset for all
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistChangesRequest) Reset() {
+	*x = ListWatchlistChangesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistChangesRequest) ProtoMessage() {}
+
+func (x *ListWatchlistChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListWatchlistChangesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListWatchlistChangesRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListWatchlistChangesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWatchlistChangesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListWatchlistChangesResponse lists changes oldest first
+type ListWatchlistChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*WatchlistChange     `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWatchlistChangesResponse) Reset() {
+	*x = ListWatchlistChangesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWatchlistChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWatchlistChangesResponse) ProtoMessage() {}
+
+func (x *ListWatchlistChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWatchlistChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListWatchlistChangesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListWatchlistChangesResponse) GetChanges() []*WatchlistChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListWatchlistChangesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UndoLastChangeRequest asks to undo the user's most recent watchlist change
+type UndoLastChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastChangeRequest) Reset() {
+	*x = UndoLastChangeRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastChangeRequest) ProtoMessage() {}
+
+func (x *UndoLastChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoLastChangeRequest.ProtoReflect.Descriptor instead.
+func (*UndoLastChangeRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+// UndoLastChangeResponse is the change that was undone. Undoing it is logged
+// as a change of its own.
+type UndoLastChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Undone        *WatchlistChange       `protobuf:"bytes,1,opt,name=undone,proto3" json:"undone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UndoLastChangeResponse) Reset() {
+	*x = UndoLastChangeResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UndoLastChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoLastChangeResponse) ProtoMessage() {}
+
+func (x *UndoLastChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoLastChangeResponse.ProtoReflect.Descriptor instead.
+func (*UndoLastChangeResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UndoLastChangeResponse) GetUndone() *WatchlistChange {
+	if x != nil {
+		return x.Undone
+	}
+	return nil
+}
+
+// SetWatch is a rule to save every product from a TCG set
+type SetWatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetName       string                 `protobuf:"bytes,1,opt,name=set_name,json=setName,proto3" json:"set_name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TcgSet        *TcgSet                `protobuf:"bytes,3,opt,name=tcg_set,json=tcgSet,proto3" json:"tcg_set,omitempty"` // details of the set; unset if unknown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWatch) Reset() {
+	*x = SetWatch{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWatch) ProtoMessage() {}
+
+func (x *SetWatch) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWatch.ProtoReflect.Descriptor instead.
+func (*SetWatch) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetWatch) GetSetName() string {
+	if x != nil {
+		return x.SetName
+	}
+	return ""
+}
+
+func (x *SetWatch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SetWatch) GetTcgSet() *TcgSet {
+	if x != nil {
+		return x.TcgSet
+	}
+	return nil
+}
+
+// TcgSet is a TCG expansion's details, from the Pokemon TCG API
+type TcgSet struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // e.g. "sv8pt5"
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Series           string                 `protobuf:"bytes,3,opt,name=series,proto3" json:"series,omitempty"`
+	ReleaseDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`                   // midnight UTC on the release day
+	PrintedCardCount int32                  `protobuf:"varint,5,opt,name=printed_card_count,json=printedCardCount,proto3" json:"printed_card_count,omitempty"` // cards numbered in the set, as printed on them
+	CardCount        int32                  `protobuf:"varint,6,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`                        // every card, including secret rares
+	LogoUrl          string                 `protobuf:"bytes,7,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	SymbolUrl        string                 `protobuf:"bytes,8,opt,name=symbol_url,json=symbolUrl,proto3" json:"symbol_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TcgSet) Reset() {
+	*x = TcgSet{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TcgSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcgSet) ProtoMessage() {}
+
+func (x *TcgSet) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcgSet.ProtoReflect.Descriptor instead.
+func (*TcgSet) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *TcgSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TcgSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TcgSet) GetSeries() string {
+	if x != nil {
+		return x.Series
+	}
+	return ""
+}
+
+func (x *TcgSet) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *TcgSet) GetPrintedCardCount() int32 {
+	if x != nil {
+		return x.PrintedCardCount
+	}
+	return 0
+}
+
+func (x *TcgSet) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *TcgSet) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *TcgSet) GetSymbolUrl() string {
+	if x != nil {
+		return x.SymbolUrl
+	}
+	return ""
+}
+
+// Msrp is the manufacturer's suggested retail price of a product type
+type Msrp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetName       string                 `protobuf:"bytes,1,opt,name=set_name,json=setName,proto3" json:"set_name,omitempty"` // empty for every set; a set's own price wins
+	ProductType   ProductType            `protobuf:"varint,2,opt,name=product_type,json=productType,proto3,enum=stockchecker.v1.ProductType" json:"product_type,omitempty"`
+	PriceCents    int64                  `protobuf:"varint,3,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"` // US cents
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Msrp) Reset() {
+	*x = Msrp{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Msrp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Msrp) ProtoMessage() {}
+
+func (x *Msrp) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Msrp.ProtoReflect.Descriptor instead.
+func (*Msrp) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Msrp) GetSetName() string {
+	if x != nil {
+		return x.SetName
+	}
+	return ""
+}
+
+func (x *Msrp) GetProductType() ProductType {
+	if x != nil {
+		return x.ProductType
+	}
+	return ProductType_PRODUCT_TYPE_UNSPECIFIED
+}
+
+func (x *Msrp) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+// ListMsrpsRequest is empty
+type ListMsrpsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMsrpsRequest) Reset() {
+	*x = ListMsrpsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMsrpsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMsrpsRequest) ProtoMessage() {}
+
+func (x *ListMsrpsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMsrpsRequest.ProtoReflect.Descriptor instead.
+func (*ListMsrpsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{83}
+}
+
+// ListMsrpsResponse lists the maintained MSRPs
+type ListMsrpsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msrps         []*Msrp                `protobuf:"bytes,1,rep,name=msrps,proto3" json:"msrps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMsrpsResponse) Reset() {
+	*x = ListMsrpsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMsrpsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMsrpsResponse) ProtoMessage() {}
+
+func (x *ListMsrpsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMsrpsResponse.ProtoReflect.Descriptor instead.
+func (*ListMsrpsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListMsrpsResponse) GetMsrps() []*Msrp {
+	if x != nil {
+		return x.Msrps
+	}
+	return nil
+}
+
+// SetMsrpRequest sets an MSRP (admin only). A zero price removes it.
+type SetMsrpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Msrp          *Msrp                  `protobuf:"bytes,1,opt,name=msrp,proto3" json:"msrp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMsrpRequest) Reset() {
+	*x = SetMsrpRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMsrpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMsrpRequest) ProtoMessage() {}
+
+func (x *SetMsrpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMsrpRequest.ProtoReflect.Descriptor instead.
+func (*SetMsrpRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetMsrpRequest) GetMsrp() *Msrp {
+	if x != nil {
+		return x.Msrp
+	}
+	return nil
+}
+
+// SetMsrpResponse is empty on success
+type SetMsrpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMsrpResponse) Reset() {
+	*x = SetMsrpResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMsrpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMsrpResponse) ProtoMessage() {}
+
+func (x *SetMsrpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMsrpResponse.ProtoReflect.Descriptor instead.
+func (*SetMsrpResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{86}
+}
+
+// GetProductDetailsRequest asks for a Best Buy product's details
+type GetProductDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductDetailsRequest) Reset() {
+	*x = GetProductDetailsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductDetailsRequest) ProtoMessage() {}
+
+func (x *GetProductDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetProductDetailsRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// GetProductDetailsResponse is a product with the details of its TCG set
+type GetProductDetailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	TcgSet        *TcgSet                `protobuf:"bytes,2,opt,name=tcg_set,json=tcgSet,proto3" json:"tcg_set,omitempty"` // unset if the product isn't from a known set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductDetailsResponse) Reset() {
+	*x = GetProductDetailsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductDetailsResponse) ProtoMessage() {}
+
+func (x *GetProductDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetProductDetailsResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *GetProductDetailsResponse) GetTcgSet() *TcgSet {
+	if x != nil {
+		return x.TcgSet
+	}
+	return nil
+}
+
+// GetMySetWatchesRequest is empty - user is determined from session
+type GetMySetWatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMySetWatchesRequest) Reset() {
+	*x = GetMySetWatchesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMySetWatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMySetWatchesRequest) ProtoMessage() {}
+
+func (x *GetMySetWatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMySetWatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetMySetWatchesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{89}
+}
+
+// GetMySetWatchesResponse lists the sets the user watches
+type GetMySetWatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetWatches    []*SetWatch            `protobuf:"bytes,1,rep,name=set_watches,json=setWatches,proto3" json:"set_watches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMySetWatchesResponse) Reset() {
+	*x = GetMySetWatchesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMySetWatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMySetWatchesResponse) ProtoMessage() {}
+
+func (x *GetMySetWatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMySetWatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetMySetWatchesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetMySetWatchesResponse) GetSetWatches() []*SetWatch {
+	if x != nil {
+		return x.SetWatches
+	}
+	return nil
+}
+
+// WatchSetRequest asks to save every product from a set, now and as they're listed
+type WatchSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetName       string                 `protobuf:"bytes,1,opt,name=set_name,json=setName,proto3" json:"set_name,omitempty"` // e.g. "Prismatic Evolutions"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSetRequest) Reset() {
+	*x = WatchSetRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSetRequest) ProtoMessage() {}
+
+func (x *WatchSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSetRequest.ProtoReflect.Descriptor instead.
+func (*WatchSetRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *WatchSetRequest) GetSetName() string {
+	if x != nil {
+		return x.SetName
+	}
+	return ""
+}
+
+// WatchSetResponse lists the products saved from the set right away
+type WatchSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetWatch      *SetWatch              `protobuf:"bytes,1,opt,name=set_watch,json=setWatch,proto3" json:"set_watch,omitempty"`
+	AddedProducts []*Product             `protobuf:"bytes,2,rep,name=added_products,json=addedProducts,proto3" json:"added_products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSetResponse) Reset() {
+	*x = WatchSetResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSetResponse) ProtoMessage() {}
+
+func (x *WatchSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSetResponse.ProtoReflect.Descriptor instead.
+func (*WatchSetResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *WatchSetResponse) GetSetWatch() *SetWatch {
+	if x != nil {
+		return x.SetWatch
+	}
+	return nil
+}
+
+func (x *WatchSetResponse) GetAddedProducts() []*Product {
+	if x != nil {
+		return x.AddedProducts
+	}
+	return nil
+}
+
+// UnwatchSetRequest asks to stop watching a set. Products already saved stay saved.
+type UnwatchSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SetName       string                 `protobuf:"bytes,1,opt,name=set_name,json=setName,proto3" json:"set_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchSetRequest) Reset() {
+	*x = UnwatchSetRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchSetRequest) ProtoMessage() {}
+
+func (x *UnwatchSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchSetRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchSetRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UnwatchSetRequest) GetSetName() string {
+	if x != nil {
+		return x.SetName
+	}
+	return ""
+}
+
+// UnwatchSetResponse is empty on success
+type UnwatchSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchSetResponse) Reset() {
+	*x = UnwatchSetResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchSetResponse) ProtoMessage() {}
+
+func (x *UnwatchSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchSetResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchSetResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{94}
+}
+
+// Acquisition is a purchase of one of the user's saved products
+type Acquisition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // assigned when recorded
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`    // copied from the saved product
+	SetName       string                 `protobuf:"bytes,4,opt,name=set_name,json=setName,proto3" json:"set_name,omitempty"`                // TCG set of the product; empty if none
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                            // defaults to 1
+	PriceCents    int64                  `protobuf:"varint,6,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`      // price paid per item, in currency_code
+	CurrencyCode  string                 `protobuf:"bytes,7,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // ISO 4217 code; empty means USD
+	StoreName     string                 `protobuf:"bytes,8,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`          // where it was bought, e.g. a store name or "online"; optional
+	PurchasedOn   string                 `protobuf:"bytes,9,opt,name=purchased_on,json=purchasedOn,proto3" json:"purchased_on,omitempty"`    // YYYY-MM-DD; defaults to today (UTC)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Acquisition) Reset() {
+	*x = Acquisition{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Acquisition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Acquisition) ProtoMessage() {}
+
+func (x *Acquisition) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Acquisition.ProtoReflect.Descriptor instead.
+func (*Acquisition) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Acquisition) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Acquisition) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Acquisition) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *Acquisition) GetSetName() string {
+	if x != nil {
+		return x.SetName
+	}
+	return ""
+}
+
+func (x *Acquisition) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Acquisition) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *Acquisition) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Acquisition) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *Acquisition) GetPurchasedOn() string {
+	if x != nil {
+		return x.PurchasedOn
+	}
+	return ""
+}
+
+// MarkPurchasedRequest records a purchase of a saved product. The product's
+// name and set are filled in from the user's list.
+type MarkPurchasedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acquisition   *Acquisition           `protobuf:"bytes,1,opt,name=acquisition,proto3" json:"acquisition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPurchasedRequest) Reset() {
+	*x = MarkPurchasedRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPurchasedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPurchasedRequest) ProtoMessage() {}
+
+func (x *MarkPurchasedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPurchasedRequest.ProtoReflect.Descriptor instead.
+func (*MarkPurchasedRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *MarkPurchasedRequest) GetAcquisition() *Acquisition {
+	if x != nil {
+		return x.Acquisition
+	}
+	return nil
+}
+
+// MarkPurchasedResponse returns the recorded purchase
+type MarkPurchasedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acquisition   *Acquisition           `protobuf:"bytes,1,opt,name=acquisition,proto3" json:"acquisition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkPurchasedResponse) Reset() {
+	*x = MarkPurchasedResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkPurchasedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkPurchasedResponse) ProtoMessage() {}
+
+func (x *MarkPurchasedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkPurchasedResponse.ProtoReflect.Descriptor instead.
+func (*MarkPurchasedResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *MarkPurchasedResponse) GetAcquisition() *Acquisition {
+	if x != nil {
+		return x.Acquisition
+	}
+	return nil
+}
+
+// GetMyAcquisitionsRequest selects a range of the user's purchases
+type GetMyAcquisitionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`                          // YYYY-MM-DD, inclusive; empty for no lower bound
+	Until         string                 `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`                        // YYYY-MM-DD, inclusive; empty for no upper bound
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyAcquisitionsRequest) Reset() {
+	*x = GetMyAcquisitionsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyAcquisitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyAcquisitionsRequest) ProtoMessage() {}
+
+func (x *GetMyAcquisitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyAcquisitionsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyAcquisitionsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetMyAcquisitionsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetMyAcquisitionsRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *GetMyAcquisitionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMyAcquisitionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// GetMyAcquisitionsResponse lists purchases newest first
+type GetMyAcquisitionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acquisitions  []*Acquisition         `protobuf:"bytes,1,rep,name=acquisitions,proto3" json:"acquisitions,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyAcquisitionsResponse) Reset() {
+	*x = GetMyAcquisitionsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyAcquisitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyAcquisitionsResponse) ProtoMessage() {}
+
+func (x *GetMyAcquisitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyAcquisitionsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyAcquisitionsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetMyAcquisitionsResponse) GetAcquisitions() []*Acquisition {
+	if x != nil {
+		return x.Acquisitions
+	}
+	return nil
+}
+
+func (x *GetMyAcquisitionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// DeleteAcquisitionRequest selects one of the user's purchases
+type DeleteAcquisitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAcquisitionRequest) Reset() {
+	*x = DeleteAcquisitionRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAcquisitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAcquisitionRequest) ProtoMessage() {}
+
+func (x *DeleteAcquisitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAcquisitionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAcquisitionRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteAcquisitionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteAcquisitionResponse is empty on success
+type DeleteAcquisitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAcquisitionResponse) Reset() {
+	*x = DeleteAcquisitionResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAcquisitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAcquisitionResponse) ProtoMessage() {}
+
+func (x *DeleteAcquisitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAcquisitionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAcquisitionResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{101}
+}
+
+// SpendTotal is the spend of a group of purchases in one currency
+type SpendTotal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                       // the month ("2026-01") or set name the total is for; empty for unparsed sets and overall totals
+	CurrencyCode  string                 `protobuf:"bytes,2,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // empty means USD
+	TotalCents    int64                  `protobuf:"varint,3,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"` // items bought
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpendTotal) Reset() {
+	*x = SpendTotal{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendTotal) ProtoMessage() {}
+
+func (x *SpendTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendTotal.ProtoReflect.Descriptor instead.
+func (*SpendTotal) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SpendTotal) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SpendTotal) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *SpendTotal) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *SpendTotal) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// GetAcquisitionSummaryRequest selects the purchases to total
+type GetAcquisitionSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`   // YYYY-MM-DD, inclusive; empty for no lower bound
+	Until         string                 `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"` // YYYY-MM-DD, inclusive; empty for no upper bound
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAcquisitionSummaryRequest) Reset() {
+	*x = GetAcquisitionSummaryRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcquisitionSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcquisitionSummaryRequest) ProtoMessage() {}
+
+func (x *GetAcquisitionSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcquisitionSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetAcquisitionSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetAcquisitionSummaryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAcquisitionSummaryRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+// StoreReliability scores a store's reported stock from the last 90 days of confirmations
+type StoreReliability struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StoreId           string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	FoundCount        int32                  `protobuf:"varint,2,opt,name=found_count,json=foundCount,proto3" json:"found_count,omitempty"` // confirmations that found the product on the shelf
+	ConfirmationCount int32                  `protobuf:"varint,3,opt,name=confirmation_count,json=confirmationCount,proto3" json:"confirmation_count,omitempty"`
+	Score             float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"` // estimated chance reported stock is on the shelf, 0-1; 0.5 without confirmations
+	Confidence        StoreConfidence        `protobuf:"varint,5,opt,name=confidence,proto3,enum=stockchecker.v1.StoreConfidence" json:"confidence,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StoreReliability) Reset() {
+	*x = StoreReliability{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreReliability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreReliability) ProtoMessage() {}
+
+func (x *StoreReliability) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreReliability.ProtoReflect.Descriptor instead.
+func (*StoreReliability) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *StoreReliability) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *StoreReliability) GetFoundCount() int32 {
+	if x != nil {
+		return x.FoundCount
+	}
+	return 0
+}
+
+func (x *StoreReliability) GetConfirmationCount() int32 {
+	if x != nil {
+		return x.ConfirmationCount
+	}
+	return 0
+}
+
+func (x *StoreReliability) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *StoreReliability) GetConfidence() StoreConfidence {
+	if x != nil {
+		return x.Confidence
+	}
+	return StoreConfidence_STORE_CONFIDENCE_UNSPECIFIED
+}
+
+// ConfirmStockRequest reports whether an alerted product was on a store's shelf
+type ConfirmStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"` // one of the user's saved products
+	StoreId       string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Found         bool                   `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmStockRequest) Reset() {
+	*x = ConfirmStockRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmStockRequest) ProtoMessage() {}
+
+func (x *ConfirmStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmStockRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmStockRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ConfirmStockRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ConfirmStockRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ConfirmStockRequest) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+// ConfirmStockResponse returns the store's updated reliability
+type ConfirmStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reliability   *StoreReliability      `protobuf:"bytes,1,opt,name=reliability,proto3" json:"reliability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmStockResponse) Reset() {
+	*x = ConfirmStockResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmStockResponse) ProtoMessage() {}
+
+func (x *ConfirmStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmStockResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmStockResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ConfirmStockResponse) GetReliability() *StoreReliability {
+	if x != nil {
+		return x.Reliability
+	}
+	return nil
+}
+
+// GetStoreReliabilityRequest selects the stores to score
+type GetStoreReliabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreIds      []string               `protobuf:"bytes,1,rep,name=store_ids,json=storeIds,proto3" json:"store_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreReliabilityRequest) Reset() {
+	*x = GetStoreReliabilityRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreReliabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreReliabilityRequest) ProtoMessage() {}
+
+func (x *GetStoreReliabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreReliabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreReliabilityRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetStoreReliabilityRequest) GetStoreIds() []string {
+	if x != nil {
+		return x.StoreIds
+	}
+	return nil
+}
+
+// GetStoreReliabilityResponse scores each requested store, in request order
+type GetStoreReliabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*StoreReliability    `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreReliabilityResponse) Reset() {
+	*x = GetStoreReliabilityResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreReliabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreReliabilityResponse) ProtoMessage() {}
+
+func (x *GetStoreReliabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreReliabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetStoreReliabilityResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetStoreReliabilityResponse) GetStores() []*StoreReliability {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+// Sighting is stock a user reported seeing on a store's shelf
+type Sighting struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku         string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductName string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // looked up from the SKU
+	StoreId     string                 `protobuf:"bytes,4,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	StoreName   string                 `protobuf:"bytes,5,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Quantity    int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`                 // how many were on the shelf; 0 if not counted
+	HasPhoto    bool                   `protobuf:"varint,7,opt,name=has_photo,json=hasPhoto,proto3" json:"has_photo,omitempty"` // admins can fetch it with GetSightingPhoto
+	Status      SightingStatus         `protobuf:"varint,8,opt,name=status,proto3,enum=stockchecker.v1.SightingStatus" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ModeratedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"` // unset while pending
+	// Share of the reporter's recently moderated sightings that were confirmed,
+	// smoothed toward 0.5; set by ListSightings for moderators
+	ReporterScore float64 `protobuf:"fixed64,11,opt,name=reporter_score,json=reporterScore,proto3" json:"reporter_score,omitempty"`
+	ReporterMuted bool    `protobuf:"varint,12,opt,name=reporter_muted,json=reporterMuted,proto3" json:"reporter_muted,omitempty"` // the reporter can't report sightings until their score recovers
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sighting) Reset() {
+	*x = Sighting{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sighting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sighting) ProtoMessage() {}
+
+func (x *Sighting) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sighting.ProtoReflect.Descriptor instead.
+func (*Sighting) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Sighting) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Sighting) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Sighting) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *Sighting) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *Sighting) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *Sighting) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Sighting) GetHasPhoto() bool {
+	if x != nil {
+		return x.HasPhoto
+	}
+	return false
+}
+
+func (x *Sighting) GetStatus() SightingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SightingStatus_SIGHTING_STATUS_UNSPECIFIED
+}
+
+func (x *Sighting) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Sighting) GetModeratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModeratedAt
+	}
+	return nil
+}
+
+func (x *Sighting) GetReporterScore() float64 {
+	if x != nil {
+		return x.ReporterScore
+	}
+	return 0
+}
+
+func (x *Sighting) GetReporterMuted() bool {
+	if x != nil {
+		return x.ReporterMuted
+	}
+	return false
+}
+
+// ReportSightingRequest reports stock seen on a store's shelf
+type ReportSightingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	StoreId       string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	StoreName     string                 `protobuf:"bytes,3,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"` // optional
+	Photo         []byte                 `protobuf:"bytes,5,opt,name=photo,proto3" json:"photo,omitempty"`        // optional JPEG, PNG or WebP of at most 2 MB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSightingRequest) Reset() {
+	*x = ReportSightingRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSightingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSightingRequest) ProtoMessage() {}
+
+func (x *ReportSightingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSightingRequest.ProtoReflect.Descriptor instead.
+func (*ReportSightingRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ReportSightingRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReportSightingRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *ReportSightingRequest) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *ReportSightingRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReportSightingRequest) GetPhoto() []byte {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+// ReportSightingResponse returns the sighting, pending moderation
+type ReportSightingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sighting      *Sighting              `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSightingResponse) Reset() {
+	*x = ReportSightingResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSightingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSightingResponse) ProtoMessage() {}
+
+func (x *ReportSightingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSightingResponse.ProtoReflect.Descriptor instead.
+func (*ReportSightingResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ReportSightingResponse) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+// ListSightingsRequest selects sightings to moderate (admin only)
+type ListSightingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        SightingStatus         `protobuf:"varint,1,opt,name=status,proto3,enum=stockchecker.v1.SightingStatus" json:"status,omitempty"` // unset for every status
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                 // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSightingsRequest) Reset() {
+	*x = ListSightingsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSightingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSightingsRequest) ProtoMessage() {}
+
+func (x *ListSightingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSightingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSightingsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListSightingsRequest) GetStatus() SightingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SightingStatus_SIGHTING_STATUS_UNSPECIFIED
+}
+
+func (x *ListSightingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSightingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListSightingsResponse lists sightings oldest first
+type ListSightingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sightings     []*Sighting            `protobuf:"bytes,1,rep,name=sightings,proto3" json:"sightings,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSightingsResponse) Reset() {
+	*x = ListSightingsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSightingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSightingsResponse) ProtoMessage() {}
+
+func (x *ListSightingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSightingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSightingsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListSightingsResponse) GetSightings() []*Sighting {
+	if x != nil {
+		return x.Sightings
+	}
+	return nil
+}
+
+func (x *ListSightingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetSightingPhotoRequest selects a sighting (admin only)
+type GetSightingPhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSightingPhotoRequest) Reset() {
+	*x = GetSightingPhotoRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSightingPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSightingPhotoRequest) ProtoMessage() {}
+
+func (x *GetSightingPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSightingPhotoRequest.ProtoReflect.Descriptor instead.
+func (*GetSightingPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetSightingPhotoRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetSightingPhotoResponse returns a sighting's photo
+type GetSightingPhotoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Photo         []byte                 `protobuf:"bytes,1,opt,name=photo,proto3" json:"photo,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` // e.g. "image/jpeg"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSightingPhotoResponse) Reset() {
+	*x = GetSightingPhotoResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSightingPhotoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSightingPhotoResponse) ProtoMessage() {}
+
+func (x *GetSightingPhotoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSightingPhotoResponse.ProtoReflect.Descriptor instead.
+func (*GetSightingPhotoResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetSightingPhotoResponse) GetPhoto() []byte {
+	if x != nil {
+		return x.Photo
+	}
+	return nil
+}
+
+func (x *GetSightingPhotoResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// ModerateSightingRequest approves or rejects a pending sighting (admin only)
+type ModerateSightingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"` // approving alerts the users watching the product at the store
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateSightingRequest) Reset() {
+	*x = ModerateSightingRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateSightingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateSightingRequest) ProtoMessage() {}
+
+func (x *ModerateSightingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateSightingRequest.ProtoReflect.Descriptor instead.
+func (*ModerateSightingRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ModerateSightingRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateSightingRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+// ModerateSightingResponse returns the moderated sighting
+type ModerateSightingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sighting      *Sighting              `protobuf:"bytes,1,opt,name=sighting,proto3" json:"sighting,omitempty"`
+	AlertsSent    int32                  `protobuf:"varint,2,opt,name=alerts_sent,json=alertsSent,proto3" json:"alerts_sent,omitempty"` // watchers alerted about an approved sighting
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateSightingResponse) Reset() {
+	*x = ModerateSightingResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateSightingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateSightingResponse) ProtoMessage() {}
+
+func (x *ModerateSightingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateSightingResponse.ProtoReflect.Descriptor instead.
+func (*ModerateSightingResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ModerateSightingResponse) GetSighting() *Sighting {
+	if x != nil {
+		return x.Sighting
+	}
+	return nil
+}
+
+func (x *ModerateSightingResponse) GetAlertsSent() int32 {
+	if x != nil {
+		return x.AlertsSent
+	}
+	return 0
+}
+
+// GetAcquisitionSummaryResponse totals spend per month, per set and overall.
+// Purchases in different currencies are totaled separately.
+type GetAcquisitionSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Months        []*SpendTotal          `protobuf:"bytes,1,rep,name=months,proto3" json:"months,omitempty"` // newest first
+	Sets          []*SpendTotal          `protobuf:"bytes,2,rep,name=sets,proto3" json:"sets,omitempty"`     // highest spend first
+	Totals        []*SpendTotal          `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"` // one per currency
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAcquisitionSummaryResponse) Reset() {
+	*x = GetAcquisitionSummaryResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAcquisitionSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAcquisitionSummaryResponse) ProtoMessage() {}
+
+func (x *GetAcquisitionSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAcquisitionSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetAcquisitionSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetAcquisitionSummaryResponse) GetMonths() []*SpendTotal {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *GetAcquisitionSummaryResponse) GetSets() []*SpendTotal {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+func (x *GetAcquisitionSummaryResponse) GetTotals() []*SpendTotal {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+// GetOfflineBundleRequest asks for the data the app caches for offline viewing
+type GetOfflineBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"` // version of the bundle the client has cached, if any
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfflineBundleRequest) Reset() {
+	*x = GetOfflineBundleRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfflineBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflineBundleRequest) ProtoMessage() {}
+
+func (x *GetOfflineBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflineBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetOfflineBundleRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetOfflineBundleRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// GetOfflineBundleResponse is a compact snapshot of the user's watch list.
+// If not_modified is set, the cached bundle is current and nothing else is filled in.
+type GetOfflineBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NotModified   bool                   `protobuf:"varint,1,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // changes whenever the bundle's content changes
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Stores        []*Store               `protobuf:"bytes,4,rep,name=stores,proto3" json:"stores,omitempty"`             // saved stores with address and phone
+	Products      []*Product             `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`         // saved products
+	Availability  []*CurrentAvailability `protobuf:"bytes,6,rep,name=availability,proto3" json:"availability,omitempty"` // latest known stock per product and store
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfflineBundleResponse) Reset() {
+	*x = GetOfflineBundleResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfflineBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfflineBundleResponse) ProtoMessage() {}
+
+func (x *GetOfflineBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfflineBundleResponse.ProtoReflect.Descriptor instead.
+func (*GetOfflineBundleResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetOfflineBundleResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *GetOfflineBundleResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetOfflineBundleResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *GetOfflineBundleResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+func (x *GetOfflineBundleResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetOfflineBundleResponse) GetAvailability() []*CurrentAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+// GetStockHistoryRequest selects a product at a store and the range of history to return
+type GetStockHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	StoreId       string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	Days          int32                  `protobuf:"varint,3,opt,name=days,proto3" json:"days,omitempty"` // days of history including today; defaults to 7, max 90
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockHistoryRequest) Reset() {
+	*x = GetStockHistoryRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockHistoryRequest) ProtoMessage() {}
+
+func (x *GetStockHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStockHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetStockHistoryRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *GetStockHistoryRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *GetStockHistoryRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+// StockCheck is the result of one availability check
+type StockCheck struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InStock       bool                   `protobuf:"varint,1,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	LowStock      bool                   `protobuf:"varint,2,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockCheck) Reset() {
+	*x = StockCheck{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockCheck) ProtoMessage() {}
+
+func (x *StockCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockCheck.ProtoReflect.Descriptor instead.
+func (*StockCheck) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *StockCheck) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *StockCheck) GetLowStock() bool {
+	if x != nil {
+		return x.LowStock
+	}
+	return false
+}
+
+func (x *StockCheck) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// GetStockHistoryResponse returns the checks in range, newest first
+type GetStockHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checks        []*StockCheck          `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	LastInStockAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_in_stock_at,json=lastInStockAt,proto3" json:"last_in_stock_at,omitempty"` // unset if never seen in stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockHistoryResponse) Reset() {
+	*x = GetStockHistoryResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockHistoryResponse) ProtoMessage() {}
+
+func (x *GetStockHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetStockHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetStockHistoryResponse) GetChecks() []*StockCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *GetStockHistoryResponse) GetLastInStockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastInStockAt
+	}
+	return nil
+}
+
+// StockEvent is a product coming into or going out of stock at a store
+type StockEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // increases with every event
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	StoreId       string                 `protobuf:"bytes,3,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	StoreName     string                 `protobuf:"bytes,4,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	InStock       bool                   `protobuf:"varint,5,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	LowStock      bool                   `protobuf:"varint,6,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockEvent) Reset() {
+	*x = StockEvent{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockEvent) ProtoMessage() {}
+
+func (x *StockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockEvent.ProtoReflect.Descriptor instead.
+func (*StockEvent) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *StockEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockEvent) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *StockEvent) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *StockEvent) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *StockEvent) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+func (x *StockEvent) GetLowStock() bool {
+	if x != nil {
+		return x.LowStock
+	}
+	return false
+}
+
+func (x *StockEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// WatchStockRequest selects the products to stream stock changes for
+type WatchStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Skus  []string               `protobuf:"bytes,1,rep,name=skus,proto3" json:"skus,omitempty"` // empty for every product
+	// Events held for the client while it's busy, 1-256 (default 64). When the
+	// buffer is full a newer event for the same product and store replaces the
+	// held one, and otherwise the oldest held event is dropped.
+	BufferSize int32 `protobuf:"varint,2,opt,name=buffer_size,json=bufferSize,proto3" json:"buffer_size,omitempty"`
+	// Resume token from the last message of an earlier stream, to get the
+	// events recorded since it instead of starting from now
+	ResumeToken   string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStockRequest) Reset() {
+	*x = WatchStockRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStockRequest) ProtoMessage() {}
+
+func (x *WatchStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStockRequest.ProtoReflect.Descriptor instead.
+func (*WatchStockRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *WatchStockRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *WatchStockRequest) GetBufferSize() int32 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+func (x *WatchStockRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// WatchStockResponse is a batch of stock changes, oldest first. A batch is
+// sent when the stream opens and at least every 30 seconds, with no events
+// if nothing changed, so dead connections are noticed.
+type WatchStockResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*StockEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Events dropped since the last batch because the buffer was full, or
+	// because a resumed stream was too far behind; when set, refresh with
+	// CheckStock to catch up
+	Skipped       int32  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	ResumeToken   string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // pass to WatchStock to pick up after this batch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStockResponse) Reset() {
+	*x = WatchStockResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStockResponse) ProtoMessage() {}
+
+func (x *WatchStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStockResponse.ProtoReflect.Descriptor instead.
+func (*WatchStockResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *WatchStockResponse) GetEvents() []*StockEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WatchStockResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *WatchStockResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// CheckStoreNowRequest selects one of the user's saved stores
+type CheckStoreNowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStoreNowRequest) Reset() {
+	*x = CheckStoreNowRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStoreNowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStoreNowRequest) ProtoMessage() {}
+
+func (x *CheckStoreNowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStoreNowRequest.ProtoReflect.Descriptor instead.
+func (*CheckStoreNowRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CheckStoreNowRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+// CheckStoreNowResponse lists the user's watched products at the store,
+// in stock first, then low stock, then out of stock
+type CheckStoreNowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Results       []*StockStatus         `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`                         // store is left unset in each result
+	FailedSkus    []string               `protobuf:"bytes,3,rep,name=failed_skus,json=failedSkus,proto3" json:"failed_skus,omitempty"` // products that couldn't be checked
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStoreNowResponse) Reset() {
+	*x = CheckStoreNowResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStoreNowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStoreNowResponse) ProtoMessage() {}
+
+func (x *CheckStoreNowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStoreNowResponse.ProtoReflect.Descriptor instead.
+func (*CheckStoreNowResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CheckStoreNowResponse) GetStore() *Store {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *CheckStoreNowResponse) GetResults() []*StockStatus {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CheckStoreNowResponse) GetFailedSkus() []string {
+	if x != nil {
+		return x.FailedSkus
+	}
+	return nil
+}
+
+func (x *CheckStoreNowResponse) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// Location is a named place the user searches from, such as home or the office
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,2,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	RadiusMiles   int32                  `protobuf:"varint,3,opt,name=radius_miles,json=radiusMiles,proto3" json:"radius_miles,omitempty"` // defaults to 25, max 250
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`                         // optional; looked up from the postal code if unset
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *Location) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Location) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Location) GetRadiusMiles() int32 {
+	if x != nil {
+		return x.RadiusMiles
+	}
+	return 0
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// GetMyLocationsRequest is empty - user is determined from session
+type GetMyLocationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyLocationsRequest) Reset() {
+	*x = GetMyLocationsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyLocationsRequest) ProtoMessage() {}
+
+func (x *GetMyLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyLocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetMyLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{130}
+}
+
+// GetMyLocationsResponse lists the user's locations
+type GetMyLocationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*Location            `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyLocationsResponse) Reset() {
+	*x = GetMyLocationsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyLocationsResponse) ProtoMessage() {}
+
+func (x *GetMyLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyLocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetMyLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetMyLocationsResponse) GetLocations() []*Location {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+// SetMyLocationRequest creates a location or replaces the one with the same name
+type SetMyLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMyLocationRequest) Reset() {
+	*x = SetMyLocationRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMyLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMyLocationRequest) ProtoMessage() {}
+
+func (x *SetMyLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMyLocationRequest.ProtoReflect.Descriptor instead.
+func (*SetMyLocationRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SetMyLocationRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// SetMyLocationResponse returns the saved location
+type SetMyLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      *Location              `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMyLocationResponse) Reset() {
+	*x = SetMyLocationResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMyLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMyLocationResponse) ProtoMessage() {}
+
+func (x *SetMyLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMyLocationResponse.ProtoReflect.Descriptor instead.
+func (*SetMyLocationResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *SetMyLocationResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// DeleteMyLocationRequest removes a location
+type DeleteMyLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyLocationRequest) Reset() {
+	*x = DeleteMyLocationRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyLocationRequest) ProtoMessage() {}
+
+func (x *DeleteMyLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyLocationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyLocationRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteMyLocationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// DeleteMyLocationResponse is empty
+type DeleteMyLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyLocationResponse) Reset() {
+	*x = DeleteMyLocationResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyLocationResponse) ProtoMessage() {}
+
+func (x *DeleteMyLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyLocationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyLocationResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{135}
+}
+
+// GetProductBarcodeRequest selects the product to show a barcode for
+type GetProductBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBarcodeRequest) Reset() {
+	*x = GetProductBarcodeRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBarcodeRequest) ProtoMessage() {}
+
+func (x *GetProductBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*GetProductBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetProductBarcodeRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// GetProductBarcodeResponse is a barcode store associates can scan to look the product up
+type GetProductBarcodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Symbology     string                 `protobuf:"bytes,3,opt,name=symbology,proto3" json:"symbology,omitempty"` // upc_a if the product has a UPC, otherwise code128 of the SKU
+	Payload       string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`     // the encoded text
+	Svg           string                 `protobuf:"bytes,5,opt,name=svg,proto3" json:"svg,omitempty"`             // the barcode rendered as an SVG image
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBarcodeResponse) Reset() {
+	*x = GetProductBarcodeResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBarcodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBarcodeResponse) ProtoMessage() {}
+
+func (x *GetProductBarcodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBarcodeResponse.ProtoReflect.Descriptor instead.
+func (*GetProductBarcodeResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetProductBarcodeResponse) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *GetProductBarcodeResponse) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *GetProductBarcodeResponse) GetSymbology() string {
+	if x != nil {
+		return x.Symbology
+	}
+	return ""
+}
+
+func (x *GetProductBarcodeResponse) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *GetProductBarcodeResponse) GetSvg() string {
+	if x != nil {
+		return x.Svg
+	}
+	return ""
+}
+
+// ProductWatch is a search in a category that alerts when Best Buy lists a
+// new product matching it
+type ProductWatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Best Buy category searched
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductWatch) Reset() {
+	*x = ProductWatch{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductWatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductWatch) ProtoMessage() {}
+
+func (x *ProductWatch) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductWatch.ProtoReflect.Descriptor instead.
+func (*ProductWatch) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ProductWatch) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductWatch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ProductWatch) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ProductWatch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetProductDomainRequest is empty
+type GetProductDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductDomainRequest) Reset() {
+	*x = GetProductDomainRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductDomainRequest) ProtoMessage() {}
+
+func (x *GetProductDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetProductDomainRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{139}
+}
+
+// GetProductDomainResponse describes the kind of product the deployment tracks
+type GetProductDomainResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                               // "tcg", "gpu", "consoles" or "lego"
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                           // e.g. "Pokemon TCG"
+	SearchCategory string                 `protobuf:"bytes,3,opt,name=search_category,json=searchCategory,proto3" json:"search_category,omitempty"` // default SearchProductsRequest.category; empty searches everything
+	CategoryId     string                 `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`             // category product watches search unless given one
+	Presets        []*ProductPreset       `protobuf:"bytes,5,rep,name=presets,proto3" json:"presets,omitempty"`                                     // popular products suggested to new users
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProductDomainResponse) Reset() {
+	*x = GetProductDomainResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductDomainResponse) ProtoMessage() {}
+
+func (x *GetProductDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetProductDomainResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetProductDomainResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProductDomainResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProductDomainResponse) GetSearchCategory() string {
+	if x != nil {
+		return x.SearchCategory
+	}
+	return ""
+}
+
+func (x *GetProductDomainResponse) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetProductDomainResponse) GetPresets() []*ProductPreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+// ProductPreset is a popular product the domain suggests watching. Adding it
+// caps its alerts at MSRP unless the user already has an alert rule for it.
+type ProductPreset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MsrpCents     int64                  `protobuf:"varint,3,opt,name=msrp_cents,json=msrpCents,proto3" json:"msrp_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductPreset) Reset() {
+	*x = ProductPreset{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductPreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPreset) ProtoMessage() {}
+
+func (x *ProductPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPreset.ProtoReflect.Descriptor instead.
+func (*ProductPreset) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ProductPreset) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductPreset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductPreset) GetMsrpCents() int64 {
+	if x != nil {
+		return x.MsrpCents
+	}
+	return 0
+}
+
+// GetMyProductWatchesRequest is empty - user is determined from session
+type GetMyProductWatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyProductWatchesRequest) Reset() {
+	*x = GetMyProductWatchesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyProductWatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyProductWatchesRequest) ProtoMessage() {}
+
+func (x *GetMyProductWatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyProductWatchesRequest.ProtoReflect.Descriptor instead.
+func (*GetMyProductWatchesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{142}
+}
+
+// GetMyProductWatchesResponse lists the user's product watches
+type GetMyProductWatchesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductWatches []*ProductWatch        `protobuf:"bytes,1,rep,name=product_watches,json=productWatches,proto3" json:"product_watches,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetMyProductWatchesResponse) Reset() {
+	*x = GetMyProductWatchesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyProductWatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyProductWatchesResponse) ProtoMessage() {}
+
+func (x *GetMyProductWatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyProductWatchesResponse.ProtoReflect.Descriptor instead.
+func (*GetMyProductWatchesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetMyProductWatchesResponse) GetProductWatches() []*ProductWatch {
+	if x != nil {
+		return x.ProductWatches
+	}
+	return nil
+}
+
+// WatchProductsRequest asks to be alerted when a product matching a search is listed
+type WatchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`                             // e.g. "Prismatic Evolutions"
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // optional; defaults to the product domain's category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProductsRequest) Reset() {
+	*x = WatchProductsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProductsRequest) ProtoMessage() {}
+
+func (x *WatchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProductsRequest.ProtoReflect.Descriptor instead.
+func (*WatchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *WatchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *WatchProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+// WatchProductsResponse returns the watch. Products listed now don't alert.
+type WatchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductWatch  *ProductWatch          `protobuf:"bytes,1,opt,name=product_watch,json=productWatch,proto3" json:"product_watch,omitempty"`
+	ListedCount   int32                  `protobuf:"varint,2,opt,name=listed_count,json=listedCount,proto3" json:"listed_count,omitempty"` // products matching the search now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchProductsResponse) Reset() {
+	*x = WatchProductsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchProductsResponse) ProtoMessage() {}
+
+func (x *WatchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchProductsResponse.ProtoReflect.Descriptor instead.
+func (*WatchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *WatchProductsResponse) GetProductWatch() *ProductWatch {
+	if x != nil {
+		return x.ProductWatch
+	}
+	return nil
+}
+
+func (x *WatchProductsResponse) GetListedCount() int32 {
+	if x != nil {
+		return x.ListedCount
+	}
+	return 0
+}
+
+// UnwatchProductsRequest asks to stop a product watch
+type UnwatchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchProductsRequest) Reset() {
+	*x = UnwatchProductsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchProductsRequest) ProtoMessage() {}
+
+func (x *UnwatchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchProductsRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *UnwatchProductsRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// UnwatchProductsResponse is empty on success
+type UnwatchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchProductsResponse) Reset() {
+	*x = UnwatchProductsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchProductsResponse) ProtoMessage() {}
+
+func (x *UnwatchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchProductsResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{147}
+}
+
+// AllowedEmail is an email address allowed to sign in
+type AllowedEmail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	AddedBy       string                 `protobuf:"bytes,2,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"` // email of the admin who added it; empty if seeded from ALLOWED_EMAILS
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllowedEmail) Reset() {
+	*x = AllowedEmail{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowedEmail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedEmail) ProtoMessage() {}
+
+func (x *AllowedEmail) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedEmail.ProtoReflect.Descriptor instead.
+func (*AllowedEmail) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *AllowedEmail) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AllowedEmail) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *AllowedEmail) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AdminAddAllowedEmailRequest allows an email address to sign in (admin only)
+type AdminAddAllowedEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAddAllowedEmailRequest) Reset() {
+	*x = AdminAddAllowedEmailRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAddAllowedEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAddAllowedEmailRequest) ProtoMessage() {}
+
+func (x *AdminAddAllowedEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAddAllowedEmailRequest.ProtoReflect.Descriptor instead.
+func (*AdminAddAllowedEmailRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *AdminAddAllowedEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// AdminAddAllowedEmailResponse is empty on success
+type AdminAddAllowedEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAddAllowedEmailResponse) Reset() {
+	*x = AdminAddAllowedEmailResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAddAllowedEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAddAllowedEmailResponse) ProtoMessage() {}
+
+func (x *AdminAddAllowedEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAddAllowedEmailResponse.ProtoReflect.Descriptor instead.
+func (*AdminAddAllowedEmailResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{150}
+}
+
+// AdminRemoveAllowedEmailRequest stops an email address signing in (admin only)
+type AdminRemoveAllowedEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRemoveAllowedEmailRequest) Reset() {
+	*x = AdminRemoveAllowedEmailRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRemoveAllowedEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRemoveAllowedEmailRequest) ProtoMessage() {}
+
+func (x *AdminRemoveAllowedEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRemoveAllowedEmailRequest.ProtoReflect.Descriptor instead.
+func (*AdminRemoveAllowedEmailRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *AdminRemoveAllowedEmailRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// AdminRemoveAllowedEmailResponse is empty on success
+type AdminRemoveAllowedEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRemoveAllowedEmailResponse) Reset() {
+	*x = AdminRemoveAllowedEmailResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRemoveAllowedEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRemoveAllowedEmailResponse) ProtoMessage() {}
+
+func (x *AdminRemoveAllowedEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRemoveAllowedEmailResponse.ProtoReflect.Descriptor instead.
+func (*AdminRemoveAllowedEmailResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{152}
+}
+
+// AdminListAllowedEmailsRequest lists the allowed email addresses (admin only)
+type AdminListAllowedEmailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListAllowedEmailsRequest) Reset() {
+	*x = AdminListAllowedEmailsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListAllowedEmailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListAllowedEmailsRequest) ProtoMessage() {}
+
+func (x *AdminListAllowedEmailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListAllowedEmailsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListAllowedEmailsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *AdminListAllowedEmailsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListAllowedEmailsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// AdminListAllowedEmailsResponse lists allowed email addresses alphabetically
+type AdminListAllowedEmailsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowedEmails []*AllowedEmail        `protobuf:"bytes,1,rep,name=allowed_emails,json=allowedEmails,proto3" json:"allowed_emails,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListAllowedEmailsResponse) Reset() {
+	*x = AdminListAllowedEmailsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListAllowedEmailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListAllowedEmailsResponse) ProtoMessage() {}
+
+func (x *AdminListAllowedEmailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListAllowedEmailsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListAllowedEmailsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *AdminListAllowedEmailsResponse) GetAllowedEmails() []*AllowedEmail {
+	if x != nil {
+		return x.AllowedEmails
+	}
+	return nil
+}
+
+func (x *AdminListAllowedEmailsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AllowedDomain is an email domain whose verified addresses may all sign in
+type AllowedDomain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`                  // e.g. "mycompany.com"
+	AddedBy       string                 `protobuf:"bytes,2,opt,name=added_by,json=addedBy,proto3" json:"added_by,omitempty"` // email of the admin who added it; empty if seeded from ALLOWED_EMAILS
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllowedDomain) Reset() {
+	*x = AllowedDomain{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowedDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedDomain) ProtoMessage() {}
+
+func (x *AllowedDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedDomain.ProtoReflect.Descriptor instead.
+func (*AllowedDomain) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *AllowedDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *AllowedDomain) GetAddedBy() string {
+	if x != nil {
+		return x.AddedBy
+	}
+	return ""
+}
+
+func (x *AllowedDomain) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AdminAddAllowedDomainRequest allows every address on a domain to sign in (admin only)
+type AdminAddAllowedDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // "mycompany.com" or "@mycompany.com"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAddAllowedDomainRequest) Reset() {
+	*x = AdminAddAllowedDomainRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAddAllowedDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAddAllowedDomainRequest) ProtoMessage() {}
+
+func (x *AdminAddAllowedDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAddAllowedDomainRequest.ProtoReflect.Descriptor instead.
+func (*AdminAddAllowedDomainRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *AdminAddAllowedDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// AdminAddAllowedDomainResponse is empty on success
+type AdminAddAllowedDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminAddAllowedDomainResponse) Reset() {
+	*x = AdminAddAllowedDomainResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminAddAllowedDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminAddAllowedDomainResponse) ProtoMessage() {}
+
+func (x *AdminAddAllowedDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminAddAllowedDomainResponse.ProtoReflect.Descriptor instead.
+func (*AdminAddAllowedDomainResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{157}
+}
+
+// AdminRemoveAllowedDomainRequest stops a domain's addresses signing in (admin only)
+type AdminRemoveAllowedDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRemoveAllowedDomainRequest) Reset() {
+	*x = AdminRemoveAllowedDomainRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRemoveAllowedDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRemoveAllowedDomainRequest) ProtoMessage() {}
+
+func (x *AdminRemoveAllowedDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRemoveAllowedDomainRequest.ProtoReflect.Descriptor instead.
+func (*AdminRemoveAllowedDomainRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *AdminRemoveAllowedDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// AdminRemoveAllowedDomainResponse is empty on success
+type AdminRemoveAllowedDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRemoveAllowedDomainResponse) Reset() {
+	*x = AdminRemoveAllowedDomainResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRemoveAllowedDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRemoveAllowedDomainResponse) ProtoMessage() {}
+
+func (x *AdminRemoveAllowedDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRemoveAllowedDomainResponse.ProtoReflect.Descriptor instead.
+func (*AdminRemoveAllowedDomainResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{159}
+}
+
+// AdminListAllowedDomainsRequest lists the allowed email domains (admin only)
+type AdminListAllowedDomainsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListAllowedDomainsRequest) Reset() {
+	*x = AdminListAllowedDomainsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListAllowedDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListAllowedDomainsRequest) ProtoMessage() {}
+
+func (x *AdminListAllowedDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListAllowedDomainsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListAllowedDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *AdminListAllowedDomainsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListAllowedDomainsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// AdminListAllowedDomainsResponse lists allowed email domains alphabetically
+type AdminListAllowedDomainsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AllowedDomains []*AllowedDomain       `protobuf:"bytes,1,rep,name=allowed_domains,json=allowedDomains,proto3" json:"allowed_domains,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AdminListAllowedDomainsResponse) Reset() {
+	*x = AdminListAllowedDomainsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListAllowedDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListAllowedDomainsResponse) ProtoMessage() {}
+
+func (x *AdminListAllowedDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListAllowedDomainsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListAllowedDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *AdminListAllowedDomainsResponse) GetAllowedDomains() []*AllowedDomain {
+	if x != nil {
+		return x.AllowedDomains
+	}
+	return nil
+}
+
+func (x *AdminListAllowedDomainsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Invite is a single-use link an admin created; signing in with it allows the
+// account's email
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`                            // who it's for
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // email of the admin who created it
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UsedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=used_at,json=usedAt,proto3" json:"used_at,omitempty"` // unset until used
+	UsedBy        string                 `protobuf:"bytes,6,opt,name=used_by,json=usedBy,proto3" json:"used_by,omitempty"` // email that signed in with it; empty until used
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *Invite) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Invite) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UsedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetUsedBy() string {
+	if x != nil {
+		return x.UsedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AdminCreateInviteRequest creates an invite link (admin only)
+type AdminCreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`    // optional, at most 200 characters
+	Hours         int32                  `protobuf:"varint,2,opt,name=hours,proto3" json:"hours,omitempty"` // how long it works; defaults to 168 (a week), max 720
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateInviteRequest) Reset() {
+	*x = AdminCreateInviteRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateInviteRequest) ProtoMessage() {}
+
+func (x *AdminCreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*AdminCreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *AdminCreateInviteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AdminCreateInviteRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+// AdminCreateInviteResponse returns the invite and its link. The link is
+// only ever returned here.
+type AdminCreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateInviteResponse) Reset() {
+	*x = AdminCreateInviteResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateInviteResponse) ProtoMessage() {}
+
+func (x *AdminCreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*AdminCreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *AdminCreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *AdminCreateInviteResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// AdminListInvitesRequest lists invites (admin only)
+type AdminListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListInvitesRequest) Reset() {
+	*x = AdminListInvitesRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListInvitesRequest) ProtoMessage() {}
+
+func (x *AdminListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*AdminListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *AdminListInvitesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListInvitesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// AdminListInvitesResponse lists invites, newest first
+type AdminListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListInvitesResponse) Reset() {
+	*x = AdminListInvitesResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListInvitesResponse) ProtoMessage() {}
+
+func (x *AdminListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*AdminListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *AdminListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+func (x *AdminListInvitesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AdminRevokeInviteRequest revokes an unused invite (admin only)
+type AdminRevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRevokeInviteRequest) Reset() {
+	*x = AdminRevokeInviteRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeInviteRequest) ProtoMessage() {}
+
+func (x *AdminRevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*AdminRevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *AdminRevokeInviteRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// AdminRevokeInviteResponse is empty on success
+type AdminRevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRevokeInviteResponse) Reset() {
+	*x = AdminRevokeInviteResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRevokeInviteResponse) ProtoMessage() {}
+
+func (x *AdminRevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*AdminRevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{168}
+}
+
+// AdminListUsersRequest lists the users who have signed in (admin only)
+type AdminListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // default 50, max 200
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUsersRequest) Reset() {
+	*x = AdminListUsersRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUsersRequest) ProtoMessage() {}
+
+func (x *AdminListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUsersRequest.ProtoReflect.Descriptor instead.
+func (*AdminListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *AdminListUsersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AdminListUsersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// AdminListUsersResponse lists users oldest first
+type AdminListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListUsersResponse) Reset() {
+	*x = AdminListUsersResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListUsersResponse) ProtoMessage() {}
+
+func (x *AdminListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListUsersResponse.ProtoReflect.Descriptor instead.
+func (*AdminListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *AdminListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *AdminListUsersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AdminSetUserRoleRequest promotes or demotes a user (admin only)
+type AdminSetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int32                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          UserRole               `protobuf:"varint,2,opt,name=role,proto3,enum=stockchecker.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetUserRoleRequest) Reset() {
+	*x = AdminSetUserRoleRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetUserRoleRequest) ProtoMessage() {}
+
+func (x *AdminSetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *AdminSetUserRoleRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminSetUserRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+// AdminSetUserRoleResponse returns the updated user
+type AdminSetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetUserRoleResponse) Reset() {
+	*x = AdminSetUserRoleResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetUserRoleResponse) ProtoMessage() {}
+
+func (x *AdminSetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*AdminSetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *AdminSetUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// Credential is a retailer API key or notifier setting admins can rotate
+// without a restart. Its value is never returned.
+type Credential struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                            // the environment variable it overrides, e.g. BESTBUY_API_KEY
+	Set           bool                   `protobuf:"varint,2,opt,name=set,proto3" json:"set,omitempty"`                             // has a value, from the environment or an admin
+	Overridden    bool                   `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"`               // set by an admin; clearing it goes back to the environment's value
+	Hint          string                 `protobuf:"bytes,4,opt,name=hint,proto3" json:"hint,omitempty"`                            // last four characters of a key, or the admin channel type
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"` // email of the admin who set it; empty if from the environment
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // unset if from the environment
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Credential) Reset() {
+	*x = Credential{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Credential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credential) ProtoMessage() {}
+
+func (x *Credential) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credential.ProtoReflect.Descriptor instead.
+func (*Credential) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *Credential) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Credential) GetSet() bool {
+	if x != nil {
+		return x.Set
+	}
+	return false
+}
+
+func (x *Credential) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *Credential) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+func (x *Credential) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Credential) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// AdminListCredentialsRequest lists the credentials admins can rotate (admin only)
+type AdminListCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListCredentialsRequest) Reset() {
+	*x = AdminListCredentialsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListCredentialsRequest) ProtoMessage() {}
+
+func (x *AdminListCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{174}
+}
+
+// AdminListCredentialsResponse lists every rotatable credential
+type AdminListCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credentials   []*Credential          `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListCredentialsResponse) Reset() {
+	*x = AdminListCredentialsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListCredentialsResponse) ProtoMessage() {}
+
+func (x *AdminListCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*AdminListCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *AdminListCredentialsResponse) GetCredentials() []*Credential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// AdminSetCredentialRequest rotates a credential (admin only)
+type AdminSetCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The new key, or for ADMIN_NOTIFY the channel as
+	// {"channel": "gotify", "config": {...}}
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetCredentialRequest) Reset() {
+	*x = AdminSetCredentialRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetCredentialRequest) ProtoMessage() {}
+
+func (x *AdminSetCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetCredentialRequest.ProtoReflect.Descriptor instead.
+func (*AdminSetCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *AdminSetCredentialRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminSetCredentialRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// AdminSetCredentialResponse returns the rotated credential
+type AdminSetCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credential    *Credential            `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSetCredentialResponse) Reset() {
+	*x = AdminSetCredentialResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSetCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSetCredentialResponse) ProtoMessage() {}
+
+func (x *AdminSetCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSetCredentialResponse.ProtoReflect.Descriptor instead.
+func (*AdminSetCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *AdminSetCredentialResponse) GetCredential() *Credential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+// AdminClearCredentialRequest goes back to a credential's value from the environment (admin only)
+type AdminClearCredentialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminClearCredentialRequest) Reset() {
+	*x = AdminClearCredentialRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminClearCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminClearCredentialRequest) ProtoMessage() {}
+
+func (x *AdminClearCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminClearCredentialRequest.ProtoReflect.Descriptor instead.
+func (*AdminClearCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *AdminClearCredentialRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// AdminClearCredentialResponse returns the credential as now configured
+type AdminClearCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credential    *Credential            `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminClearCredentialResponse) Reset() {
+	*x = AdminClearCredentialResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminClearCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminClearCredentialResponse) ProtoMessage() {}
+
+func (x *AdminClearCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminClearCredentialResponse.ProtoReflect.Descriptor instead.
+func (*AdminClearCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *AdminClearCredentialResponse) GetCredential() *Credential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+// ApiCallStats sums up sampled Best Buy API calls to one endpoint at one
+// priority. Estimates scale the sample up by the sample rate.
+type ApiCallStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint           string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"` // path without values, e.g. "/v1/products/{id}/stores.json"
+	Priority           string                 `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"` // "background", "normal", "interactive" or "live"
+	SampledCalls       int32                  `protobuf:"varint,3,opt,name=sampled_calls,json=sampledCalls,proto3" json:"sampled_calls,omitempty"`
+	EstimatedCalls     float64                `protobuf:"fixed64,4,opt,name=estimated_calls,json=estimatedCalls,proto3" json:"estimated_calls,omitempty"`
+	EstimatedQuotaCost float64                `protobuf:"fixed64,5,opt,name=estimated_quota_cost,json=estimatedQuotaCost,proto3" json:"estimated_quota_cost,omitempty"` // calls counted against the daily quota
+	EstimatedErrors    float64                `protobuf:"fixed64,6,opt,name=estimated_errors,json=estimatedErrors,proto3" json:"estimated_errors,omitempty"`            // calls without a 2xx or 304 response
+	AvgLatencyMs       float64                `protobuf:"fixed64,7,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	P95LatencyMs       float64                `protobuf:"fixed64,8,opt,name=p95_latency_ms,json=p95LatencyMs,proto3" json:"p95_latency_ms,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ApiCallStats) Reset() {
+	*x = ApiCallStats{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiCallStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiCallStats) ProtoMessage() {}
+
+func (x *ApiCallStats) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiCallStats.ProtoReflect.Descriptor instead.
+func (*ApiCallStats) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ApiCallStats) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ApiCallStats) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *ApiCallStats) GetSampledCalls() int32 {
+	if x != nil {
+		return x.SampledCalls
+	}
+	return 0
+}
+
+func (x *ApiCallStats) GetEstimatedCalls() float64 {
+	if x != nil {
+		return x.EstimatedCalls
+	}
+	return 0
+}
+
+func (x *ApiCallStats) GetEstimatedQuotaCost() float64 {
+	if x != nil {
+		return x.EstimatedQuotaCost
+	}
+	return 0
+}
+
+func (x *ApiCallStats) GetEstimatedErrors() float64 {
+	if x != nil {
+		return x.EstimatedErrors
+	}
+	return 0
+}
+
+func (x *ApiCallStats) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+func (x *ApiCallStats) GetP95LatencyMs() float64 {
+	if x != nil {
+		return x.P95LatencyMs
+	}
+	return 0
+}
+
+// AdminGetApiCallStatsRequest selects how far back to sum up sampled calls (admin only)
+type AdminGetApiCallStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         int32                  `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"` // defaults to 24, max 720 (calls are kept for 30 days)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetApiCallStatsRequest) Reset() {
+	*x = AdminGetApiCallStatsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetApiCallStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetApiCallStatsRequest) ProtoMessage() {}
+
+func (x *AdminGetApiCallStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetApiCallStatsRequest.ProtoReflect.Descriptor instead.
+func (*AdminGetApiCallStatsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *AdminGetApiCallStatsRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+// AdminGetApiCallStatsResponse lists call stats, the most quota used first
+type AdminGetApiCallStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*ApiCallStats        `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminGetApiCallStatsResponse) Reset() {
+	*x = AdminGetApiCallStatsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminGetApiCallStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGetApiCallStatsResponse) ProtoMessage() {}
+
+func (x *AdminGetApiCallStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGetApiCallStatsResponse.ProtoReflect.Descriptor instead.
+func (*AdminGetApiCallStatsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *AdminGetApiCallStatsResponse) GetStats() []*ApiCallStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *AdminGetApiCallStatsResponse) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// ApiKey is a key a user created so scripts and bots can call the API
+type ApiKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"` // start of the key, to tell keys apart
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"` // unset if never used
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *ApiKey) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+// GetMyApiKeysRequest lists the user's API keys
+type GetMyApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyApiKeysRequest) Reset() {
+	*x = GetMyApiKeysRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyApiKeysRequest) ProtoMessage() {}
+
+func (x *GetMyApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*GetMyApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{184}
+}
+
+// GetMyApiKeysResponse returns the user's API keys
+type GetMyApiKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyApiKeysResponse) Reset() {
+	*x = GetMyApiKeysResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyApiKeysResponse) ProtoMessage() {}
+
+func (x *GetMyApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*GetMyApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *GetMyApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+// CreateApiKeyRequest creates an API key
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // what the key is for, e.g. "discord bot"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateApiKeyResponse returns the new key. The key is only ever returned
+// here; send it as "Authorization: Bearer <key>".
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+// RevokeApiKeyRequest revokes an API key
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *RevokeApiKeyRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// RevokeApiKeyResponse confirms the key was revoked
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{189}
+}
+
+// Session is a signed-in browser
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`      // of the browser that signed in
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`      // the session signed in from
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // when the user signed in
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // to the minute
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"` // the session making the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *Session) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// ListSessionsRequest lists the user's sessions
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{191}
+}
+
+// ListSessionsResponse returns the user's unexpired sessions, most recently used first
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// RevokeSessionRequest signs out one session, or every session with all
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`   // ignored when all is set
+	All           bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"` // log out everywhere, including the current session
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *RevokeSessionRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RevokeSessionRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// RevokeSessionResponse returns how many sessions were signed out
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revoked       int32                  `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *RevokeSessionResponse) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+// ExportMyDataRequest exports everything stored about the user
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{195}
+}
+
+// ExportMyDataResponse returns the user's rows in every table as a JSON
+// object keyed by table name. Session tokens and key hashes are left out.
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"` // suggested name for saving the export
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *ExportMyDataResponse) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+// DeleteMyAccountRequest deletes the user's account and all their data
+type DeleteMyAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Confirm       bool                   `protobuf:"varint,1,opt,name=confirm,proto3" json:"confirm,omitempty"` // must be set; guards against deleting by accident
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountRequest) Reset() {
+	*x = DeleteMyAccountRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountRequest) ProtoMessage() {}
+
+func (x *DeleteMyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *DeleteMyAccountRequest) GetConfirm() bool {
+	if x != nil {
+		return x.Confirm
+	}
+	return false
+}
+
+// DeleteMyAccountResponse confirms the account was deleted; the session used
+// to call it is signed out
+type DeleteMyAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAccountResponse) Reset() {
+	*x = DeleteMyAccountResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAccountResponse) ProtoMessage() {}
+
+func (x *DeleteMyAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyAccountResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{198}
+}
+
+// GetClientBootstrapRequest is empty - the user, if any, is determined from session
+type GetClientBootstrapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientBootstrapRequest) Reset() {
+	*x = GetClientBootstrapRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientBootstrapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientBootstrapRequest) ProtoMessage() {}
+
+func (x *GetClientBootstrapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientBootstrapRequest.ProtoReflect.Descriptor instead.
+func (*GetClientBootstrapRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{199}
+}
+
+// ClientFeatures says which optional parts of the app this server supports
+type ClientFeatures struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Watchlists    bool                   `protobuf:"varint,1,opt,name=watchlists,proto3" json:"watchlists,omitempty"`                         // saved stores, products and alerts (needs a database)
+	StockWatcher  bool                   `protobuf:"varint,2,opt,name=stock_watcher,json=stockWatcher,proto3" json:"stock_watcher,omitempty"` // background checks that send alerts
+	TcgSets       bool                   `protobuf:"varint,3,opt,name=tcg_sets,json=tcgSets,proto3" json:"tcg_sets,omitempty"`                // set details from the Pokemon TCG API
+	Msrps         bool                   `protobuf:"varint,4,opt,name=msrps,proto3" json:"msrps,omitempty"`                                   // MSRP lookups and price-gouging flags
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientFeatures) Reset() {
+	*x = ClientFeatures{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientFeatures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientFeatures) ProtoMessage() {}
+
+func (x *ClientFeatures) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientFeatures.ProtoReflect.Descriptor instead.
+func (*ClientFeatures) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *ClientFeatures) GetWatchlists() bool {
+	if x != nil {
+		return x.Watchlists
+	}
+	return false
+}
+
+func (x *ClientFeatures) GetStockWatcher() bool {
+	if x != nil {
+		return x.StockWatcher
+	}
+	return false
+}
+
+func (x *ClientFeatures) GetTcgSets() bool {
+	if x != nil {
+		return x.TcgSets
+	}
+	return false
+}
+
+func (x *ClientFeatures) GetMsrps() bool {
+	if x != nil {
+		return x.Msrps
+	}
+	return false
+}
+
+// ServerStatus is the server's current operating state
+type ServerStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReadOnly      bool                   `protobuf:"varint,1,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`               // maintenance mode: changes are rejected until it ends
+	ProductDomain string                 `protobuf:"bytes,2,opt,name=product_domain,json=productDomain,proto3" json:"product_domain,omitempty"` // kind of product tracked, e.g. "Pokemon TCG"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerStatus) Reset() {
+	*x = ServerStatus{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerStatus) ProtoMessage() {}
+
+func (x *ServerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerStatus.ProtoReflect.Descriptor instead.
+func (*ServerStatus) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *ServerStatus) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ServerStatus) GetProductDomain() string {
+	if x != nil {
+		return x.ProductDomain
+	}
+	return ""
+}
+
+// ChannelState is whether one of the user's notification channels is on
+type ChannelState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelType   string                 `protobuf:"bytes,1,opt,name=channel_type,json=channelType,proto3" json:"channel_type,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelState) Reset() {
+	*x = ChannelState{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelState) ProtoMessage() {}
+
+func (x *ChannelState) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelState.ProtoReflect.Descriptor instead.
+func (*ChannelState) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *ChannelState) GetChannelType() string {
+	if x != nil {
+		return x.ChannelType
+	}
+	return ""
+}
+
+func (x *ChannelState) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// WatchlistCounts summarizes the user's watchlist
+type WatchlistCounts struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Stores          int32                  `protobuf:"varint,1,opt,name=stores,proto3" json:"stores,omitempty"`
+	Products        int32                  `protobuf:"varint,2,opt,name=products,proto3" json:"products,omitempty"`
+	InStockProducts int32                  `protobuf:"varint,3,opt,name=in_stock_products,json=inStockProducts,proto3" json:"in_stock_products,omitempty"` // products in stock at any saved store
+	ProductWatches  int32                  `protobuf:"varint,4,opt,name=product_watches,json=productWatches,proto3" json:"product_watches,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WatchlistCounts) Reset() {
+	*x = WatchlistCounts{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchlistCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchlistCounts) ProtoMessage() {}
+
+func (x *WatchlistCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchlistCounts.ProtoReflect.Descriptor instead.
+func (*WatchlistCounts) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *WatchlistCounts) GetStores() int32 {
+	if x != nil {
+		return x.Stores
+	}
+	return 0
+}
+
+func (x *WatchlistCounts) GetProducts() int32 {
+	if x != nil {
+		return x.Products
+	}
+	return 0
+}
+
+func (x *WatchlistCounts) GetInStockProducts() int32 {
+	if x != nil {
+		return x.InStockProducts
+	}
+	return 0
+}
+
+func (x *WatchlistCounts) GetProductWatches() int32 {
+	if x != nil {
+		return x.ProductWatches
+	}
+	return 0
+}
+
+// LoginProvider is a way to sign in, offered as a button on the login page
+type LoginProvider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // google, github or oidc; passed to /auth/login?provider=
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginProvider) Reset() {
+	*x = LoginProvider{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginProvider) ProtoMessage() {}
+
+func (x *LoginProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginProvider.ProtoReflect.Descriptor instead.
+func (*LoginProvider) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *LoginProvider) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoginProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// GetClientBootstrapResponse returns everything the web app needs on load
+type GetClientBootstrapResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"` // unset when signed out
+	Features       *ClientFeatures        `protobuf:"bytes,2,opt,name=features,proto3" json:"features,omitempty"`
+	Status         *ServerStatus          `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Announcement   string                 `protobuf:"bytes,4,opt,name=announcement,proto3" json:"announcement,omitempty"`                           // banner shown to everyone; empty for none
+	Channels       []*ChannelState        `protobuf:"bytes,5,rep,name=channels,proto3" json:"channels,omitempty"`                                   // empty when signed out
+	Watchlist      *WatchlistCounts       `protobuf:"bytes,6,opt,name=watchlist,proto3" json:"watchlist,omitempty"`                                 // unset when signed out
+	LoginProviders []*LoginProvider       `protobuf:"bytes,7,rep,name=login_providers,json=loginProviders,proto3" json:"login_providers,omitempty"` // empty when sign-in isn't configured
+	EmailLogin     bool                   `protobuf:"varint,8,opt,name=email_login,json=emailLogin,proto3" json:"email_login,omitempty"`            // magic links can be requested by POSTing an email to /auth/email
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetClientBootstrapResponse) Reset() {
+	*x = GetClientBootstrapResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Store) String() string {
+func (x *GetClientBootstrapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Store) ProtoMessage() {}
+func (*GetClientBootstrapResponse) ProtoMessage() {}
 
-func (x *Store) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[0]
+func (x *GetClientBootstrapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,94 +11762,95 @@ func (x *Store) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Store.ProtoReflect.Descriptor instead.
-func (*Store) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetClientBootstrapResponse.ProtoReflect.Descriptor instead.
+func (*GetClientBootstrapResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *Store) GetStoreId() string {
+func (x *GetClientBootstrapResponse) GetUser() *User {
 	if x != nil {
-		return x.StoreId
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *Store) GetName() string {
+func (x *GetClientBootstrapResponse) GetFeatures() *ClientFeatures {
 	if x != nil {
-		return x.Name
+		return x.Features
 	}
-	return ""
+	return nil
 }
 
-func (x *Store) GetAddress() string {
+func (x *GetClientBootstrapResponse) GetStatus() *ServerStatus {
 	if x != nil {
-		return x.Address
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *Store) GetCity() string {
+func (x *GetClientBootstrapResponse) GetAnnouncement() string {
 	if x != nil {
-		return x.City
+		return x.Announcement
 	}
 	return ""
 }
 
-func (x *Store) GetState() string {
+func (x *GetClientBootstrapResponse) GetChannels() []*ChannelState {
 	if x != nil {
-		return x.State
+		return x.Channels
 	}
-	return ""
+	return nil
 }
 
-func (x *Store) GetPostalCode() string {
+func (x *GetClientBootstrapResponse) GetWatchlist() *WatchlistCounts {
 	if x != nil {
-		return x.PostalCode
+		return x.Watchlist
 	}
-	return ""
+	return nil
 }
 
-func (x *Store) GetPhone() string {
+func (x *GetClientBootstrapResponse) GetLoginProviders() []*LoginProvider {
 	if x != nil {
-		return x.Phone
+		return x.LoginProviders
 	}
-	return ""
+	return nil
 }
 
-func (x *Store) GetDistanceMiles() float64 {
+func (x *GetClientBootstrapResponse) GetEmailLogin() bool {
 	if x != nil {
-		return x.DistanceMiles
+		return x.EmailLogin
 	}
-	return 0
+	return false
 }
 
-// Product represents a Best Buy product
-type Product struct {
+// ApiDeprecation is an RPC or field that will be removed. Calls using one
+// get Deprecation and Sunset response headers (RFC 9745 and RFC 8594).
+type ApiDeprecation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SalePrice     float64                `protobuf:"fixed64,3,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
-	ThumbnailUrl  string                 `protobuf:"bytes,4,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	ProductUrl    string                 `protobuf:"bytes,5,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`           // full name, e.g. "stockchecker.v1.Product.sale_price"
+	Replacement   string                 `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"` // full name of what to use instead; empty if nothing replaces it
+	DeprecatedAt  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deprecated_at,json=deprecatedAt,proto3" json:"deprecated_at,omitempty"`
+	SunsetAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sunset_at,json=sunsetAt,proto3" json:"sunset_at,omitempty"` // when it's removed; unset until scheduled
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[1]
+func (x *ApiDeprecation) Reset() {
+	*x = ApiDeprecation{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *ApiDeprecation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*ApiDeprecation) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[1]
+func (x *ApiDeprecation) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,74 +11861,70 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ApiDeprecation.ProtoReflect.Descriptor instead.
+func (*ApiDeprecation) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *Product) GetSku() string {
+func (x *ApiDeprecation) GetTarget() string {
 	if x != nil {
-		return x.Sku
+		return x.Target
 	}
 	return ""
 }
 
-func (x *Product) GetName() string {
+func (x *ApiDeprecation) GetReplacement() string {
 	if x != nil {
-		return x.Name
+		return x.Replacement
 	}
 	return ""
 }
 
-func (x *Product) GetSalePrice() float64 {
+func (x *ApiDeprecation) GetDeprecatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SalePrice
+		return x.DeprecatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *Product) GetThumbnailUrl() string {
+func (x *ApiDeprecation) GetSunsetAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.SunsetAt
 	}
-	return ""
+	return nil
 }
 
-func (x *Product) GetProductUrl() string {
+func (x *ApiDeprecation) GetNote() string {
 	if x != nil {
-		return x.ProductUrl
+		return x.Note
 	}
 	return ""
 }
 
-// StockStatus represents the availability of a product at a store
-type StockStatus struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Store          *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
-	Product        *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
-	InStock        bool                   `protobuf:"varint,3,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
-	LowStock       bool                   `protobuf:"varint,4,opt,name=low_stock,json=lowStock,proto3" json:"low_stock,omitempty"`
-	PickupEligible bool                   `protobuf:"varint,5,opt,name=pickup_eligible,json=pickupEligible,proto3" json:"pickup_eligible,omitempty"`
-	IsMyStore      bool                   `protobuf:"varint,6,opt,name=is_my_store,json=isMyStore,proto3" json:"is_my_store,omitempty"` // True if store is in user's "My Stores" list
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ApiChange is one dated entry in the API changelog
+type ApiChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // midnight UTC on the day the change shipped
+	Changes       []string               `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StockStatus) Reset() {
-	*x = StockStatus{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[2]
+func (x *ApiChange) Reset() {
+	*x = ApiChange{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StockStatus) String() string {
+func (x *ApiChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockStatus) ProtoMessage() {}
+func (*ApiChange) ProtoMessage() {}
 
-func (x *StockStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[2]
+func (x *ApiChange) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,79 +11935,87 @@ func (x *StockStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockStatus.ProtoReflect.Descriptor instead.
-func (*StockStatus) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ApiChange.ProtoReflect.Descriptor instead.
+func (*ApiChange) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *StockStatus) GetStore() *Store {
+func (x *ApiChange) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Store
+		return x.Date
 	}
 	return nil
 }
 
-func (x *StockStatus) GetProduct() *Product {
+func (x *ApiChange) GetChanges() []string {
 	if x != nil {
-		return x.Product
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *StockStatus) GetInStock() bool {
-	if x != nil {
-		return x.InStock
-	}
-	return false
+// GetApiInfoRequest is empty
+type GetApiInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StockStatus) GetLowStock() bool {
-	if x != nil {
-		return x.LowStock
-	}
-	return false
+func (x *GetApiInfoRequest) Reset() {
+	*x = GetApiInfoRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StockStatus) GetPickupEligible() bool {
-	if x != nil {
-		return x.PickupEligible
-	}
-	return false
+func (x *GetApiInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StockStatus) GetIsMyStore() bool {
+func (*GetApiInfoRequest) ProtoMessage() {}
+
+func (x *GetApiInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[208]
 	if x != nil {
-		return x.IsMyStore
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-// User represents an authenticated user
-type User struct {
+// Deprecated: Use GetApiInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetApiInfoRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{208}
+}
+
+// GetApiInfoResponse describes the API's version, what's deprecated and what changed
+type GetApiInfoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	PictureUrl    string                 `protobuf:"bytes,4,opt,name=picture_url,json=pictureUrl,proto3" json:"picture_url,omitempty"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`           // the server's release
+	Deprecations  []*ApiDeprecation      `protobuf:"bytes,2,rep,name=deprecations,proto3" json:"deprecations,omitempty"` // soonest sunset first
+	Changelog     []*ApiChange           `protobuf:"bytes,3,rep,name=changelog,proto3" json:"changelog,omitempty"`       // newest first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[3]
+func (x *GetApiInfoResponse) Reset() {
+	*x = GetApiInfoResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *GetApiInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*GetApiInfoResponse) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[3]
+func (x *GetApiInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,63 +12026,58 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *User) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use GetApiInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetApiInfoResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *User) GetEmail() string {
+func (x *GetApiInfoResponse) GetVersion() string {
 	if x != nil {
-		return x.Email
+		return x.Version
 	}
 	return ""
 }
 
-func (x *User) GetName() string {
+func (x *GetApiInfoResponse) GetDeprecations() []*ApiDeprecation {
 	if x != nil {
-		return x.Name
+		return x.Deprecations
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetPictureUrl() string {
+func (x *GetApiInfoResponse) GetChangelog() []*ApiChange {
 	if x != nil {
-		return x.PictureUrl
+		return x.Changelog
 	}
-	return ""
+	return nil
 }
 
-// SearchStoresRequest is the request for searching stores
-type SearchStoresRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostalCode    string                 `protobuf:"bytes,1,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
-	RadiusMiles   int32                  `protobuf:"varint,2,opt,name=radius_miles,json=radiusMiles,proto3" json:"radius_miles,omitempty"` // defaults to 25 if not specified
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// UsageDay is what a user's account used on one UTC day
+type UsageDay struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Day               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`                                                       // midnight UTC
+	InteractiveChecks int32                  `protobuf:"varint,2,opt,name=interactive_checks,json=interactiveChecks,proto3" json:"interactive_checks,omitempty"` // SKUs checked because the user asked
+	BackgroundChecks  int32                  `protobuf:"varint,3,opt,name=background_checks,json=backgroundChecks,proto3" json:"background_checks,omitempty"`    // SKUs the watcher checked for the user
+	Notifications     int32                  `protobuf:"varint,4,opt,name=notifications,proto3" json:"notifications,omitempty"`                                  // messages sent over the user's channels
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SearchStoresRequest) Reset() {
-	*x = SearchStoresRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[4]
+func (x *UsageDay) Reset() {
+	*x = UsageDay{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchStoresRequest) String() string {
+func (x *UsageDay) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchStoresRequest) ProtoMessage() {}
+func (*UsageDay) ProtoMessage() {}
 
-func (x *SearchStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[4]
+func (x *UsageDay) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,94 +12088,65 @@ func (x *SearchStoresRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchStoresRequest.ProtoReflect.Descriptor instead.
-func (*SearchStoresRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UsageDay.ProtoReflect.Descriptor instead.
+func (*UsageDay) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *SearchStoresRequest) GetPostalCode() string {
+func (x *UsageDay) GetDay() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PostalCode
+		return x.Day
 	}
-	return ""
+	return nil
 }
 
-func (x *SearchStoresRequest) GetRadiusMiles() int32 {
+func (x *UsageDay) GetInteractiveChecks() int32 {
 	if x != nil {
-		return x.RadiusMiles
+		return x.InteractiveChecks
 	}
 	return 0
 }
 
-// SearchStoresResponse is the response containing matching stores
-type SearchStoresResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SearchStoresResponse) Reset() {
-	*x = SearchStoresResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SearchStoresResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SearchStoresResponse) ProtoMessage() {}
-
-func (x *SearchStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[5]
+func (x *UsageDay) GetBackgroundChecks() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BackgroundChecks
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SearchStoresResponse.ProtoReflect.Descriptor instead.
-func (*SearchStoresResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{5}
+	return 0
 }
 
-func (x *SearchStoresResponse) GetStores() []*Store {
+func (x *UsageDay) GetNotifications() int32 {
 	if x != nil {
-		return x.Stores
+		return x.Notifications
 	}
-	return nil
+	return 0
 }
 
-// SearchProductsRequest is the request for searching products
-type SearchProductsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`       // search term or SKU
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // optional category filter (e.g., "POKEMON CARDS")
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ApiLoad describes how busy the retailer API shared by every user is. Fields
+// are 0 when the server isn't calling the real API.
+type ApiLoad struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CallIntervalMs      int32                  `protobuf:"varint,1,opt,name=call_interval_ms,json=callIntervalMs,proto3" json:"call_interval_ms,omitempty"`                // time left between calls; longer while the API pushes back
+	CallsRemainingToday int32                  `protobuf:"varint,2,opt,name=calls_remaining_today,json=callsRemainingToday,proto3" json:"calls_remaining_today,omitempty"` // the daily quota resets at midnight UTC
+	DailyCallLimit      int32                  `protobuf:"varint,3,opt,name=daily_call_limit,json=dailyCallLimit,proto3" json:"daily_call_limit,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *SearchProductsRequest) Reset() {
-	*x = SearchProductsRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[6]
+func (x *ApiLoad) Reset() {
+	*x = ApiLoad{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsRequest) String() string {
+func (x *ApiLoad) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsRequest) ProtoMessage() {}
+func (*ApiLoad) ProtoMessage() {}
 
-func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[6]
+func (x *ApiLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,48 +12157,55 @@ func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
-func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ApiLoad.ProtoReflect.Descriptor instead.
+func (*ApiLoad) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *SearchProductsRequest) GetQuery() string {
+func (x *ApiLoad) GetCallIntervalMs() int32 {
 	if x != nil {
-		return x.Query
+		return x.CallIntervalMs
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchProductsRequest) GetCategory() string {
+func (x *ApiLoad) GetCallsRemainingToday() int32 {
 	if x != nil {
-		return x.Category
+		return x.CallsRemainingToday
 	}
-	return ""
+	return 0
 }
 
-// SearchProductsResponse is the response containing matching products
-type SearchProductsResponse struct {
+func (x *ApiLoad) GetDailyCallLimit() int32 {
+	if x != nil {
+		return x.DailyCallLimit
+	}
+	return 0
+}
+
+// GetMyUsageRequest selects how many days of usage to return
+type GetMyUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"` // including today; defaults to 30, at most 90
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProductsResponse) Reset() {
-	*x = SearchProductsResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[7]
+func (x *GetMyUsageRequest) Reset() {
+	*x = GetMyUsageRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProductsResponse) String() string {
+func (x *GetMyUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProductsResponse) ProtoMessage() {}
+func (*GetMyUsageRequest) ProtoMessage() {}
 
-func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[7]
+func (x *GetMyUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,43 +12216,43 @@ func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
-func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetMyUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetMyUsageRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *SearchProductsResponse) GetProducts() []*Product {
+func (x *GetMyUsageRequest) GetDays() int32 {
 	if x != nil {
-		return x.Products
+		return x.Days
 	}
-	return nil
+	return 0
 }
 
-// CheckStockRequest is the request for checking stock
-type CheckStockRequest struct {
+// GetMyUsageResponse is the user's usage per day and the API's current load
+type GetMyUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StoreIds      []string               `protobuf:"bytes,1,rep,name=store_ids,json=storeIds,proto3" json:"store_ids,omitempty"` // User's saved store IDs (for highlighting)
-	Skus          []string               `protobuf:"bytes,2,rep,name=skus,proto3" json:"skus,omitempty"`
-	PostalCode    string                 `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"` // Postal code to search from (250 mile radius)
+	Days          []*UsageDay            `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`     // newest first; days without usage are left out
+	Totals        *UsageDay              `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"` // over the requested days; day is unset
+	Load          *ApiLoad               `protobuf:"bytes,3,opt,name=load,proto3" json:"load,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckStockRequest) Reset() {
-	*x = CheckStockRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[8]
+func (x *GetMyUsageResponse) Reset() {
+	*x = GetMyUsageResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckStockRequest) String() string {
+func (x *GetMyUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStockRequest) ProtoMessage() {}
+func (*GetMyUsageResponse) ProtoMessage() {}
 
-func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[8]
+func (x *GetMyUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,55 +12263,65 @@ func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
-func (*CheckStockRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetMyUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetMyUsageResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *CheckStockRequest) GetStoreIds() []string {
+func (x *GetMyUsageResponse) GetDays() []*UsageDay {
 	if x != nil {
-		return x.StoreIds
+		return x.Days
 	}
 	return nil
 }
 
-func (x *CheckStockRequest) GetSkus() []string {
+func (x *GetMyUsageResponse) GetTotals() *UsageDay {
 	if x != nil {
-		return x.Skus
+		return x.Totals
 	}
 	return nil
 }
 
-func (x *CheckStockRequest) GetPostalCode() string {
+func (x *GetMyUsageResponse) GetLoad() *ApiLoad {
 	if x != nil {
-		return x.PostalCode
+		return x.Load
 	}
-	return ""
+	return nil
 }
 
-// CheckStockResponse is the response containing stock status
-type CheckStockResponse struct {
+// Drop is an invitation-only sale of a restricted product: shoppers enter
+// while the window is open and the retailer invites some of them to buy
+type Drop struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*StockStatus         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`                                    // Best Buy SKU; AdminSaveDrop also takes a product URL
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // looked up from the SKU if left empty
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`                                // at most 200 characters
+	Details       string                 `protobuf:"bytes,5,opt,name=details,proto3" json:"details,omitempty"`                            // how to enter, at most 1000 characters
+	Url           string                 `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`                                    // the retailer's announcement; https only
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`             // entries open
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`          // entries close; unset if not announced
+	Watching      bool                   `protobuf:"varint,9,opt,name=watching,proto3" json:"watching,omitempty"`                         // the signed-in user watches the SKU; output only
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`      // output only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckStockResponse) Reset() {
-	*x = CheckStockResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[9]
+func (x *Drop) Reset() {
+	*x = Drop{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckStockResponse) String() string {
+func (x *Drop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckStockResponse) ProtoMessage() {}
+func (*Drop) ProtoMessage() {}
 
-func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[9]
+func (x *Drop) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -643,40 +12332,104 @@ func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
-func (*CheckStockResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Drop.ProtoReflect.Descriptor instead.
+func (*Drop) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *CheckStockResponse) GetResults() []*StockStatus {
+func (x *Drop) GetId() int32 {
 	if x != nil {
-		return x.Results
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Drop) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Drop) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *Drop) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Drop) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *Drop) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Drop) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
 	}
 	return nil
 }
 
-// GetCurrentUserRequest is empty - user is determined from session
-type GetCurrentUserRequest struct {
+func (x *Drop) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *Drop) GetWatching() bool {
+	if x != nil {
+		return x.Watching
+	}
+	return false
+}
+
+func (x *Drop) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// UpcomingDropsRequest lists drops whose windows haven't ended
+type UpcomingDropsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	WatchedOnly   bool                   `protobuf:"varint,1,opt,name=watched_only,json=watchedOnly,proto3" json:"watched_only,omitempty"` // only drops of products the user watches
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentUserRequest) Reset() {
-	*x = GetCurrentUserRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[10]
+func (x *UpcomingDropsRequest) Reset() {
+	*x = UpcomingDropsRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentUserRequest) String() string {
+func (x *UpcomingDropsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserRequest) ProtoMessage() {}
+func (*UpcomingDropsRequest) ProtoMessage() {}
 
-func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[10]
+func (x *UpcomingDropsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,34 +12440,42 @@ func (x *GetCurrentUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use UpcomingDropsRequest.ProtoReflect.Descriptor instead.
+func (*UpcomingDropsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{215}
 }
 
-// GetCurrentUserResponse returns the current user
-type GetCurrentUserResponse struct {
+func (x *UpcomingDropsRequest) GetWatchedOnly() bool {
+	if x != nil {
+		return x.WatchedOnly
+	}
+	return false
+}
+
+// UpcomingDropsResponse lists drops soonest opening first, open windows
+// included. A window with no announced close ends a day after it opens.
+type UpcomingDropsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Drops         []*Drop                `protobuf:"bytes,1,rep,name=drops,proto3" json:"drops,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrentUserResponse) Reset() {
-	*x = GetCurrentUserResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[11]
+func (x *UpcomingDropsResponse) Reset() {
+	*x = UpcomingDropsResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrentUserResponse) String() string {
+func (x *UpcomingDropsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserResponse) ProtoMessage() {}
+func (*UpcomingDropsResponse) ProtoMessage() {}
 
-func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[11]
+func (x *UpcomingDropsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,40 +12486,41 @@ func (x *GetCurrentUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use UpcomingDropsResponse.ProtoReflect.Descriptor instead.
+func (*UpcomingDropsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *GetCurrentUserResponse) GetUser() *User {
+func (x *UpcomingDropsResponse) GetDrops() []*Drop {
 	if x != nil {
-		return x.User
+		return x.Drops
 	}
 	return nil
 }
 
-// GetMyStoresRequest is empty - user is determined from session
-type GetMyStoresRequest struct {
+// AdminSaveDropRequest creates a drop, or updates one with an id (admin only)
+type AdminSaveDropRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drop          *Drop                  `protobuf:"bytes,1,opt,name=drop,proto3" json:"drop,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyStoresRequest) Reset() {
-	*x = GetMyStoresRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[12]
+func (x *AdminSaveDropRequest) Reset() {
+	*x = AdminSaveDropRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyStoresRequest) String() string {
+func (x *AdminSaveDropRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyStoresRequest) ProtoMessage() {}
+func (*AdminSaveDropRequest) ProtoMessage() {}
 
-func (x *GetMyStoresRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[12]
+func (x *AdminSaveDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,34 +12531,41 @@ func (x *GetMyStoresRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyStoresRequest.ProtoReflect.Descriptor instead.
-func (*GetMyStoresRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AdminSaveDropRequest.ProtoReflect.Descriptor instead.
+func (*AdminSaveDropRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{217}
 }
 
-// GetMyStoresResponse returns the user's saved stores
-type GetMyStoresResponse struct {
+func (x *AdminSaveDropRequest) GetDrop() *Drop {
+	if x != nil {
+		return x.Drop
+	}
+	return nil
+}
+
+// AdminSaveDropResponse returns the saved drop
+type AdminSaveDropResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	Drop          *Drop                  `protobuf:"bytes,1,opt,name=drop,proto3" json:"drop,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyStoresResponse) Reset() {
-	*x = GetMyStoresResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[13]
+func (x *AdminSaveDropResponse) Reset() {
+	*x = AdminSaveDropResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyStoresResponse) String() string {
+func (x *AdminSaveDropResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyStoresResponse) ProtoMessage() {}
+func (*AdminSaveDropResponse) ProtoMessage() {}
 
-func (x *GetMyStoresResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[13]
+func (x *AdminSaveDropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,41 +12576,41 @@ func (x *GetMyStoresResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyStoresResponse.ProtoReflect.Descriptor instead.
-func (*GetMyStoresResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AdminSaveDropResponse.ProtoReflect.Descriptor instead.
+func (*AdminSaveDropResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *GetMyStoresResponse) GetStores() []*Store {
+func (x *AdminSaveDropResponse) GetDrop() *Drop {
 	if x != nil {
-		return x.Stores
+		return x.Drop
 	}
 	return nil
 }
 
-// AddMyStoreRequest adds a store to the user's list
-type AddMyStoreRequest struct {
+// AdminDeleteDropRequest deletes a drop (admin only)
+type AdminDeleteDropRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMyStoreRequest) Reset() {
-	*x = AddMyStoreRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[14]
+func (x *AdminDeleteDropRequest) Reset() {
+	*x = AdminDeleteDropRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMyStoreRequest) String() string {
+func (x *AdminDeleteDropRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMyStoreRequest) ProtoMessage() {}
+func (*AdminDeleteDropRequest) ProtoMessage() {}
 
-func (x *AddMyStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[14]
+func (x *AdminDeleteDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,40 +12621,40 @@ func (x *AddMyStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMyStoreRequest.ProtoReflect.Descriptor instead.
-func (*AddMyStoreRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AdminDeleteDropRequest.ProtoReflect.Descriptor instead.
+func (*AdminDeleteDropRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *AddMyStoreRequest) GetStore() *Store {
+func (x *AdminDeleteDropRequest) GetId() int32 {
 	if x != nil {
-		return x.Store
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// AddMyStoreResponse is empty on success
-type AddMyStoreResponse struct {
+// AdminDeleteDropResponse is empty on success
+type AdminDeleteDropResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMyStoreResponse) Reset() {
-	*x = AddMyStoreResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[15]
+func (x *AdminDeleteDropResponse) Reset() {
+	*x = AdminDeleteDropResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMyStoreResponse) String() string {
+func (x *AdminDeleteDropResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMyStoreResponse) ProtoMessage() {}
+func (*AdminDeleteDropResponse) ProtoMessage() {}
 
-func (x *AddMyStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[15]
+func (x *AdminDeleteDropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,34 +12665,33 @@ func (x *AddMyStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMyStoreResponse.ProtoReflect.Descriptor instead.
-func (*AddMyStoreResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AdminDeleteDropResponse.ProtoReflect.Descriptor instead.
+func (*AdminDeleteDropResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{220}
 }
 
-// RemoveMyStoreRequest removes a store from the user's list
-type RemoveMyStoreRequest struct {
+// GetMyInboundEmailRequest gets the user's address for forwarding emails
+type GetMyInboundEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StoreId       string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMyStoreRequest) Reset() {
-	*x = RemoveMyStoreRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[16]
+func (x *GetMyInboundEmailRequest) Reset() {
+	*x = GetMyInboundEmailRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMyStoreRequest) String() string {
+func (x *GetMyInboundEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMyStoreRequest) ProtoMessage() {}
+func (*GetMyInboundEmailRequest) ProtoMessage() {}
 
-func (x *RemoveMyStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[16]
+func (x *GetMyInboundEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,40 +12702,34 @@ func (x *RemoveMyStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMyStoreRequest.ProtoReflect.Descriptor instead.
-func (*RemoveMyStoreRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *RemoveMyStoreRequest) GetStoreId() string {
-	if x != nil {
-		return x.StoreId
-	}
-	return ""
+// Deprecated: Use GetMyInboundEmailRequest.ProtoReflect.Descriptor instead.
+func (*GetMyInboundEmailRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{221}
 }
 
-// RemoveMyStoreResponse is empty on success
-type RemoveMyStoreResponse struct {
+// GetMyInboundEmailResponse is the address to forward Best Buy emails to
+type GetMyInboundEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMyStoreResponse) Reset() {
-	*x = RemoveMyStoreResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[17]
+func (x *GetMyInboundEmailResponse) Reset() {
+	*x = GetMyInboundEmailResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMyStoreResponse) String() string {
+func (x *GetMyInboundEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMyStoreResponse) ProtoMessage() {}
+func (*GetMyInboundEmailResponse) ProtoMessage() {}
 
-func (x *RemoveMyStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[17]
+func (x *GetMyInboundEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,33 +12740,40 @@ func (x *RemoveMyStoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMyStoreResponse.ProtoReflect.Descriptor instead.
-func (*RemoveMyStoreResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetMyInboundEmailResponse.ProtoReflect.Descriptor instead.
+func (*GetMyInboundEmailResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{222}
 }
 
-// GetMyProductsRequest is empty - user is determined from session
-type GetMyProductsRequest struct {
+func (x *GetMyInboundEmailResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// ResetMyInboundEmailRequest gives the user a new forwarding address
+type ResetMyInboundEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyProductsRequest) Reset() {
-	*x = GetMyProductsRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[18]
+func (x *ResetMyInboundEmailRequest) Reset() {
+	*x = ResetMyInboundEmailRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyProductsRequest) String() string {
+func (x *ResetMyInboundEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyProductsRequest) ProtoMessage() {}
+func (*ResetMyInboundEmailRequest) ProtoMessage() {}
 
-func (x *GetMyProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[18]
+func (x *ResetMyInboundEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,34 +12784,34 @@ func (x *GetMyProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyProductsRequest.ProtoReflect.Descriptor instead.
-func (*GetMyProductsRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ResetMyInboundEmailRequest.ProtoReflect.Descriptor instead.
+func (*ResetMyInboundEmailRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{223}
 }
 
-// GetMyProductsResponse returns the user's saved products
-type GetMyProductsResponse struct {
+// ResetMyInboundEmailResponse is the new address; mail to the old one is ignored
+type ResetMyInboundEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyProductsResponse) Reset() {
-	*x = GetMyProductsResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[19]
+func (x *ResetMyInboundEmailResponse) Reset() {
+	*x = ResetMyInboundEmailResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyProductsResponse) String() string {
+func (x *ResetMyInboundEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyProductsResponse) ProtoMessage() {}
+func (*ResetMyInboundEmailResponse) ProtoMessage() {}
 
-func (x *GetMyProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[19]
+func (x *ResetMyInboundEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1053,41 +12822,52 @@ func (x *GetMyProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyProductsResponse.ProtoReflect.Descriptor instead.
-func (*GetMyProductsResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ResetMyInboundEmailResponse.ProtoReflect.Descriptor instead.
+func (*ResetMyInboundEmailResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *GetMyProductsResponse) GetProducts() []*Product {
+func (x *ResetMyInboundEmailResponse) GetAddress() string {
 	if x != nil {
-		return x.Products
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-// AddMyProductRequest adds a product to the user's list
-type AddMyProductRequest struct {
+// Reminder is made from a Best Buy email the user forwarded: an invitation
+// to buy a restricted product, or an order ready for pickup
+type Reminder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "invitation" or "order_ready"
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`   // empty if the email didn't name one
+	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	StoreId       string                 `protobuf:"bytes,5,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"` // empty if the email didn't name one
+	StoreName     string                 `protobuf:"bytes,6,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	Subject       string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`          // of the forwarded email
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"` // invitation expires or order goes back to the shelf; unset if not given
+	RemindAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=remind_at,json=remindAt,proto3" json:"remind_at,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"` // unset until sent
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMyProductRequest) Reset() {
-	*x = AddMyProductRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[20]
+func (x *Reminder) Reset() {
+	*x = Reminder{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMyProductRequest) String() string {
+func (x *Reminder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMyProductRequest) ProtoMessage() {}
+func (*Reminder) ProtoMessage() {}
 
-func (x *AddMyProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[20]
+func (x *Reminder) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1098,78 +12878,110 @@ func (x *AddMyProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMyProductRequest.ProtoReflect.Descriptor instead.
-func (*AddMyProductRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use Reminder.ProtoReflect.Descriptor instead.
+func (*Reminder) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *AddMyProductRequest) GetProduct() *Product {
+func (x *Reminder) GetId() int32 {
 	if x != nil {
-		return x.Product
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-// AddMyProductResponse is empty on success
-type AddMyProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Reminder) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *AddMyProductResponse) Reset() {
-	*x = AddMyProductResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Reminder) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
 }
 
-func (x *AddMyProductResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Reminder) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
 }
 
-func (*AddMyProductResponse) ProtoMessage() {}
+func (x *Reminder) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
 
-func (x *AddMyProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[21]
+func (x *Reminder) GetStoreName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StoreName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AddMyProductResponse.ProtoReflect.Descriptor instead.
-func (*AddMyProductResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{21}
+func (x *Reminder) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
 }
 
-// RemoveMyProductRequest removes a product from the user's list
-type RemoveMyProductRequest struct {
+func (x *Reminder) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetRemindAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemindAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+func (x *Reminder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListMyRemindersRequest lists the user's reminders
+type ListMyRemindersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMyProductRequest) Reset() {
-	*x = RemoveMyProductRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[22]
+func (x *ListMyRemindersRequest) Reset() {
+	*x = ListMyRemindersRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMyProductRequest) String() string {
+func (x *ListMyRemindersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMyProductRequest) ProtoMessage() {}
+func (*ListMyRemindersRequest) ProtoMessage() {}
 
-func (x *RemoveMyProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[22]
+func (x *ListMyRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,40 +12992,34 @@ func (x *RemoveMyProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMyProductRequest.ProtoReflect.Descriptor instead.
-func (*RemoveMyProductRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *RemoveMyProductRequest) GetSku() string {
-	if x != nil {
-		return x.Sku
-	}
-	return ""
+// Deprecated: Use ListMyRemindersRequest.ProtoReflect.Descriptor instead.
+func (*ListMyRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{226}
 }
 
-// RemoveMyProductResponse is empty on success
-type RemoveMyProductResponse struct {
+// ListMyRemindersResponse lists reminders latest first; sent ones are kept 30 days
+type ListMyRemindersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminders     []*Reminder            `protobuf:"bytes,1,rep,name=reminders,proto3" json:"reminders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveMyProductResponse) Reset() {
-	*x = RemoveMyProductResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[23]
+func (x *ListMyRemindersResponse) Reset() {
+	*x = ListMyRemindersResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveMyProductResponse) String() string {
+func (x *ListMyRemindersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveMyProductResponse) ProtoMessage() {}
+func (*ListMyRemindersResponse) ProtoMessage() {}
 
-func (x *RemoveMyProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[23]
+func (x *ListMyRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,33 +13030,41 @@ func (x *RemoveMyProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveMyProductResponse.ProtoReflect.Descriptor instead.
-func (*RemoveMyProductResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ListMyRemindersResponse.ProtoReflect.Descriptor instead.
+func (*ListMyRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{227}
 }
 
-// BrowsePokemonProductsRequest is empty
-type BrowsePokemonProductsRequest struct {
+func (x *ListMyRemindersResponse) GetReminders() []*Reminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+// DeleteMyReminderRequest deletes one of the user's reminders
+type DeleteMyReminderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrowsePokemonProductsRequest) Reset() {
-	*x = BrowsePokemonProductsRequest{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[24]
+func (x *DeleteMyReminderRequest) Reset() {
+	*x = DeleteMyReminderRequest{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrowsePokemonProductsRequest) String() string {
+func (x *DeleteMyReminderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrowsePokemonProductsRequest) ProtoMessage() {}
+func (*DeleteMyReminderRequest) ProtoMessage() {}
 
-func (x *BrowsePokemonProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[24]
+func (x *DeleteMyReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,34 +13075,40 @@ func (x *BrowsePokemonProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrowsePokemonProductsRequest.ProtoReflect.Descriptor instead.
-func (*BrowsePokemonProductsRequest) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DeleteMyReminderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyReminderRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{228}
 }
 
-// BrowsePokemonProductsResponse returns Pokemon products from the trading cards category
-type BrowsePokemonProductsResponse struct {
+func (x *DeleteMyReminderRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteMyReminderResponse is empty on success
+type DeleteMyReminderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BrowsePokemonProductsResponse) Reset() {
-	*x = BrowsePokemonProductsResponse{}
-	mi := &file_stockchecker_v1_service_proto_msgTypes[25]
+func (x *DeleteMyReminderResponse) Reset() {
+	*x = DeleteMyReminderResponse{}
+	mi := &file_stockchecker_v1_service_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BrowsePokemonProductsResponse) String() string {
+func (x *DeleteMyReminderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrowsePokemonProductsResponse) ProtoMessage() {}
+func (*DeleteMyReminderResponse) ProtoMessage() {}
 
-func (x *BrowsePokemonProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stockchecker_v1_service_proto_msgTypes[25]
+func (x *DeleteMyReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v1_service_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,23 +13119,16 @@ func (x *BrowsePokemonProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrowsePokemonProductsResponse.ProtoReflect.Descriptor instead.
-func (*BrowsePokemonProductsResponse) Descriptor() ([]byte, []int) {
-	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *BrowsePokemonProductsResponse) GetProducts() []*Product {
-	if x != nil {
-		return x.Products
-	}
-	return nil
+// Deprecated: Use DeleteMyReminderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyReminderResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v1_service_proto_rawDescGZIP(), []int{229}
 }
 
 var File_stockchecker_v1_service_proto protoreflect.FileDescriptor
 
 const file_stockchecker_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1dstockchecker/v1/service.proto\x12\x0fstockchecker.v1\"\xd8\x01\n" +
+	"\x1dstockchecker/v1/service.proto\x12\x0fstockchecker.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe6\x03\n" +
 	"\x05Store\x12\x19\n" +
 	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
@@ -1325,49 +13138,100 @@ const file_stockchecker_v1_service_proto_rawDesc = "" +
 	"\vpostal_code\x18\x06 \x01(\tR\n" +
 	"postalCode\x12\x14\n" +
 	"\x05phone\x18\a \x01(\tR\x05phone\x12%\n" +
-	"\x0edistance_miles\x18\b \x01(\x01R\rdistanceMiles\"\x94\x01\n" +
+	"\x0edistance_miles\x18\b \x01(\x01R\rdistanceMiles\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x19\n" +
+	"\bopen_now\x18\v \x01(\bR\aopenNow\x12\x1f\n" +
+	"\vhours_today\x18\f \x01(\tR\n" +
+	"hoursToday\x12#\n" +
+	"\rspecial_hours\x18\r \x01(\bR\fspecialHours\x125\n" +
+	"\bopens_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\"\xea\x04\n" +
 	"\aProduct\x12\x10\n" +
 	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
 	"\n" +
-	"sale_price\x18\x03 \x01(\x01R\tsalePrice\x12#\n" +
+	"sale_price\x18\x03 \x01(\x01B\x02\x18\x01R\tsalePrice\x12#\n" +
 	"\rthumbnail_url\x18\x04 \x01(\tR\fthumbnailUrl\x12\x1f\n" +
 	"\vproduct_url\x18\x05 \x01(\tR\n" +
-	"productUrl\"\xf0\x01\n" +
+	"productUrl\x12(\n" +
+	"\x10sale_price_cents\x18\x06 \x01(\x03R\x0esalePriceCents\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12#\n" +
+	"\rcurrency_code\x18\t \x01(\tR\fcurrencyCode\x12\x19\n" +
+	"\bset_name\x18\n" +
+	" \x01(\tR\asetName\x12?\n" +
+	"\fproduct_type\x18\v \x01(\x0e2\x1c.stockchecker.v1.ProductTypeR\vproductType\x12\x1d\n" +
+	"\n" +
+	"msrp_cents\x18\f \x01(\x03R\tmsrpCents\x12\x1d\n" +
+	"\n" +
+	"above_msrp\x18\r \x01(\bR\taboveMsrp\x12:\n" +
+	"\bpriority\x18\x0e \x01(\x0e2\x1e.stockchecker.v1.WatchPriorityR\bpriority\x125\n" +
+	"\bretailer\x18\x0f \x01(\x0e2\x19.stockchecker.v1.RetailerR\bretailer\"\xab\x02\n" +
 	"\vStockStatus\x12,\n" +
 	"\x05store\x18\x01 \x01(\v2\x16.stockchecker.v1.StoreR\x05store\x122\n" +
 	"\aproduct\x18\x02 \x01(\v2\x18.stockchecker.v1.ProductR\aproduct\x12\x19\n" +
 	"\bin_stock\x18\x03 \x01(\bR\ainStock\x12\x1b\n" +
 	"\tlow_stock\x18\x04 \x01(\bR\blowStock\x12'\n" +
 	"\x0fpickup_eligible\x18\x05 \x01(\bR\x0epickupEligible\x12\x1e\n" +
-	"\vis_my_store\x18\x06 \x01(\bR\tisMyStore\"a\n" +
+	"\vis_my_store\x18\x06 \x01(\bR\tisMyStore\x129\n" +
+	"\n" +
+	"checked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"\xfe\x01\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1f\n" +
 	"\vpicture_url\x18\x04 \x01(\tR\n" +
-	"pictureUrl\"Y\n" +
+	"pictureUrl\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x12\x19\n" +
+	"\bis_admin\x18\x06 \x01(\bR\aisAdmin\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12-\n" +
+	"\x04role\x18\b \x01(\x0e2\x19.stockchecker.v1.UserRoleR\x04role\"u\n" +
 	"\x13SearchStoresRequest\x12\x1f\n" +
 	"\vpostal_code\x18\x01 \x01(\tR\n" +
 	"postalCode\x12!\n" +
-	"\fradius_miles\x18\x02 \x01(\x05R\vradiusMiles\"F\n" +
+	"\fradius_miles\x18\x02 \x01(\x05R\vradiusMiles\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\"F\n" +
 	"\x14SearchStoresResponse\x12.\n" +
-	"\x06stores\x18\x01 \x03(\v2\x16.stockchecker.v1.StoreR\x06stores\"I\n" +
+	"\x06stores\x18\x01 \x03(\v2\x16.stockchecker.v1.StoreR\x06stores\"\x85\x01\n" +
 	"\x15SearchProductsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
-	"\bcategory\x18\x02 \x01(\tR\bcategory\"N\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x95\x01\n" +
 	"\x16SearchProductsResponse\x124\n" +
-	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts\"e\n" +
+	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\x81\x01\n" +
 	"\x11CheckStockRequest\x12\x1b\n" +
 	"\tstore_ids\x18\x01 \x03(\tR\bstoreIds\x12\x12\n" +
 	"\x04skus\x18\x02 \x03(\tR\x04skus\x12\x1f\n" +
 	"\vpostal_code\x18\x03 \x01(\tR\n" +
-	"postalCode\"L\n" +
+	"postalCode\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\"\x99\x01\n" +
+	"\bSkuError\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x121\n" +
+	"\x04code\x18\x03 \x01(\x0e2\x1d.stockchecker.v1.SkuErrorCodeR\x04code\x12.\n" +
+	"\x13retry_after_seconds\x18\x04 \x01(\x05R\x11retryAfterSeconds\"B\n" +
+	"\x10MaintenanceError\x12.\n" +
+	"\x13retry_after_seconds\x18\x01 \x01(\x05R\x11retryAfterSeconds\"\x7f\n" +
 	"\x12CheckStockResponse\x126\n" +
-	"\aresults\x18\x01 \x03(\v2\x1c.stockchecker.v1.StockStatusR\aresults\"\x17\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.stockchecker.v1.StockStatusR\aresults\x121\n" +
+	"\x06errors\x18\x02 \x03(\v2\x19.stockchecker.v1.SkuErrorR\x06errors\"\x17\n" +
 	"\x15GetCurrentUserRequest\"C\n" +
 	"\x16GetCurrentUserResponse\x12)\n" +
-	"\x04user\x18\x01 \x01(\v2\x15.stockchecker.v1.UserR\x04user\"\x14\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.stockchecker.v1.UserR\x04user\",\n" +
+	"\x12SetMyLocaleRequest\x12\x16\n" +
+	"\x06locale\x18\x01 \x01(\tR\x06locale\"\x15\n" +
+	"\x13SetMyLocaleResponse\"\x14\n" +
 	"\x12GetMyStoresRequest\"E\n" +
 	"\x13GetMyStoresResponse\x12.\n" +
 	"\x06stores\x18\x01 \x03(\v2\x16.stockchecker.v1.StoreR\x06stores\"A\n" +
@@ -1376,33 +13240,886 @@ const file_stockchecker_v1_service_proto_rawDesc = "" +
 	"\x12AddMyStoreResponse\"1\n" +
 	"\x14RemoveMyStoreRequest\x12\x19\n" +
 	"\bstore_id\x18\x01 \x01(\tR\astoreId\"\x17\n" +
-	"\x15RemoveMyStoreResponse\"\x16\n" +
-	"\x14GetMyProductsRequest\"M\n" +
+	"\x15RemoveMyStoreResponse\"1\n" +
+	"\x14GetMyProductsRequest\x12\x19\n" +
+	"\bset_name\x18\x01 \x01(\tR\asetName\"M\n" +
 	"\x15GetMyProductsResponse\x124\n" +
 	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts\"I\n" +
 	"\x13AddMyProductRequest\x122\n" +
-	"\aproduct\x18\x01 \x01(\v2\x18.stockchecker.v1.ProductR\aproduct\"\x16\n" +
-	"\x14AddMyProductResponse\"*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.stockchecker.v1.ProductR\aproduct\"s\n" +
+	"\x11PossibleDuplicate\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x128\n" +
+	"\x06reason\x18\x03 \x01(\x0e2 .stockchecker.v1.DuplicateReasonR\x06reason\"k\n" +
+	"\x14AddMyProductResponse\x12S\n" +
+	"\x13possible_duplicates\x18\x01 \x03(\v2\".stockchecker.v1.PossibleDuplicateR\x12possibleDuplicates\"*\n" +
 	"\x16RemoveMyProductRequest\x12\x10\n" +
 	"\x03sku\x18\x01 \x01(\tR\x03sku\"\x19\n" +
-	"\x17RemoveMyProductResponse\"\x1e\n" +
-	"\x1cBrowsePokemonProductsRequest\"U\n" +
+	"\x17RemoveMyProductResponse\"-\n" +
+	"\x17RemoveMyProductsRequest\x12\x12\n" +
+	"\x04skus\x18\x01 \x03(\tR\x04skus\"4\n" +
+	"\x18RemoveMyProductsResponse\x12\x18\n" +
+	"\aremoved\x18\x01 \x01(\x05R\aremoved\"X\n" +
+	"\x15ClearWatchlistRequest\x12\x18\n" +
+	"\aconfirm\x18\x01 \x01(\bR\aconfirm\x12%\n" +
+	"\x0einclude_stores\x18\x02 \x01(\bR\rincludeStores\"j\n" +
+	"\x16ClearWatchlistResponse\x12)\n" +
+	"\x10removed_products\x18\x01 \x01(\x05R\x0fremovedProducts\x12%\n" +
+	"\x0eremoved_stores\x18\x02 \x01(\x05R\rremovedStores\"-\n" +
+	"\x17ImportMyProductsRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"l\n" +
+	"\x18ImportMyProductsResponse\x124\n" +
+	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts\x12\x1a\n" +
+	"\brejected\x18\x02 \x03(\tR\brejected\";\n" +
+	"\x1cBrowsePokemonProductsRequest\x12\x1b\n" +
+	"\tall_pages\x18\x01 \x01(\bR\ballPages\"U\n" +
 	"\x1dBrowsePokemonProductsResponse\x124\n" +
-	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts2\xbb\b\n" +
-	"\x13StockCheckerService\x12[\n" +
-	"\fSearchStores\x12$.stockchecker.v1.SearchStoresRequest\x1a%.stockchecker.v1.SearchStoresResponse\x12a\n" +
-	"\x0eSearchProducts\x12&.stockchecker.v1.SearchProductsRequest\x1a'.stockchecker.v1.SearchProductsResponse\x12U\n" +
+	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts\"\xf8\x01\n" +
+	"\x13NotificationChannel\x12!\n" +
+	"\fchannel_type\x18\x01 \x01(\tR\vchannelType\x12\x16\n" +
+	"\x06config\x18\x02 \x01(\tR\x06config\x12\x18\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06rollup\x18\x06 \x01(\tR\x06rollup\" \n" +
+	"\x1eGetNotificationChannelsRequest\"c\n" +
+	"\x1fGetNotificationChannelsResponse\x12@\n" +
+	"\bchannels\x18\x01 \x03(\v2$.stockchecker.v1.NotificationChannelR\bchannels\"_\n" +
+	"\x1dSetNotificationChannelRequest\x12>\n" +
+	"\achannel\x18\x01 \x01(\v2$.stockchecker.v1.NotificationChannelR\achannel\"`\n" +
+	"\x1eSetNotificationChannelResponse\x12>\n" +
+	"\achannel\x18\x01 \x01(\v2$.stockchecker.v1.NotificationChannelR\achannel\"E\n" +
+	" DeleteNotificationChannelRequest\x12!\n" +
+	"\fchannel_type\x18\x01 \x01(\tR\vchannelType\"#\n" +
+	"!DeleteNotificationChannelResponse\"\xa4\x01\n" +
+	"\x14NotificationTemplate\x12!\n" +
+	"\fchannel_type\x18\x01 \x01(\tR\vchannelType\x12%\n" +
+	"\x0etitle_template\x18\x02 \x01(\tR\rtitleTemplate\x12#\n" +
+	"\rbody_template\x18\x03 \x01(\tR\fbodyTemplate\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x04 \x01(\bR\tisDefault\"!\n" +
+	"\x1fGetNotificationTemplatesRequest\"g\n" +
+	" GetNotificationTemplatesResponse\x12C\n" +
+	"\ttemplates\x18\x01 \x03(\v2%.stockchecker.v1.NotificationTemplateR\ttemplates\"c\n" +
+	"\x1eSetNotificationTemplateRequest\x12A\n" +
+	"\btemplate\x18\x01 \x01(\v2%.stockchecker.v1.NotificationTemplateR\btemplate\"!\n" +
+	"\x1fSetNotificationTemplateResponse\"e\n" +
+	"!DeleteNotificationTemplateRequest\x12!\n" +
+	"\fchannel_type\x18\x01 \x01(\tR\vchannelType\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x02 \x01(\bR\tisDefault\"$\n" +
+	"\"DeleteNotificationTemplateResponse\"\xa6\x01\n" +
+	"\x1bSendTestNotificationRequest\x12!\n" +
+	"\fchannel_type\x18\x01 \x01(\tR\vchannelType\x12A\n" +
+	"\btemplate\x18\x02 \x01(\v2%.stockchecker.v1.NotificationTemplateR\btemplate\x12!\n" +
+	"\fpreview_only\x18\x03 \x01(\bR\vpreviewOnly\"\\\n" +
+	"\x1cSendTestNotificationResponse\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12\x12\n" +
+	"\x04sent\x18\x03 \x01(\bR\x04sent\"`\n" +
+	"\x1bSimulateWatcherCycleRequest\x12\"\n" +
+	"\ruse_mock_data\x18\x01 \x01(\bR\vuseMockData\x12\x1d\n" +
+	"\n" +
+	"from_empty\x18\x02 \x01(\bR\tfromEmpty\"\xf3\x01\n" +
+	"\x15SimulatedNotification\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.stockchecker.v1.UserR\x04user\x122\n" +
+	"\aproduct\x18\x02 \x01(\v2\x18.stockchecker.v1.ProductR\aproduct\x12.\n" +
+	"\x06stores\x18\x03 \x03(\v2\x16.stockchecker.v1.StoreR\x06stores\x12!\n" +
+	"\fchannel_type\x18\x04 \x01(\tR\vchannelType\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\"l\n" +
+	"\x1cSimulateWatcherCycleResponse\x12L\n" +
+	"\rnotifications\x18\x01 \x03(\v2&.stockchecker.v1.SimulatedNotificationR\rnotifications\"+\n" +
+	"\x15GetMyDashboardRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"\xd2\x01\n" +
+	"\x13CurrentAvailability\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x19\n" +
+	"\bstore_id\x18\x03 \x01(\tR\astoreId\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x04 \x01(\tR\tstoreName\x12\x19\n" +
+	"\bin_stock\x18\x05 \x01(\bR\ainStock\x12\x1b\n" +
+	"\tlow_stock\x18\x06 \x01(\bR\blowStock\x12\x14\n" +
+	"\x05since\x18\a \x01(\tR\x05since\"|\n" +
+	"\x11DailyAvailability\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\tR\x03day\x12(\n" +
+	"\x10in_stock_minutes\x18\x04 \x01(\x05R\x0einStockMinutes\"\x9c\x01\n" +
+	"\x16GetMyDashboardResponse\x12H\n" +
+	"\favailability\x18\x01 \x03(\v2$.stockchecker.v1.CurrentAvailabilityR\favailability\x128\n" +
+	"\x05daily\x18\x02 \x03(\v2\".stockchecker.v1.DailyAvailabilityR\x05daily\"\x89\x01\n" +
+	"\x16UpdateMyProductRequest\x122\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.stockchecker.v1.ProductR\aproduct\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"M\n" +
+	"\x17UpdateMyProductResponse\x122\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.stockchecker.v1.ProductR\aproduct\"\xb2\x02\n" +
+	"\x17NotificationPreferences\x12%\n" +
+	"\x0ealerts_enabled\x18\x01 \x01(\bR\ralertsEnabled\x12*\n" +
+	"\x11include_low_stock\x18\x02 \x01(\bR\x0fincludeLowStock\x12,\n" +
+	"\x12max_distance_miles\x18\x03 \x01(\x01R\x10maxDistanceMiles\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12'\n" +
+	"\x0furgent_channels\x18\x05 \x03(\tR\x0eurgentChannels\x122\n" +
+	"\x15digest_interval_hours\x18\x06 \x01(\x05R\x13digestIntervalHours\"#\n" +
+	"!GetNotificationPreferencesRequest\"p\n" +
+	"\"GetNotificationPreferencesResponse\x12J\n" +
+	"\vpreferences\x18\x01 \x01(\v2(.stockchecker.v1.NotificationPreferencesR\vpreferences\"\xaf\x01\n" +
+	"$UpdateNotificationPreferencesRequest\x12J\n" +
+	"\vpreferences\x18\x01 \x01(\v2(.stockchecker.v1.NotificationPreferencesR\vpreferences\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"s\n" +
+	"%UpdateNotificationPreferencesResponse\x12J\n" +
+	"\vpreferences\x18\x01 \x01(\v2(.stockchecker.v1.NotificationPreferencesR\vpreferences\"\x83\x02\n" +
+	"\tAlertRule\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12&\n" +
+	"\x0fmax_price_cents\x18\x03 \x01(\x03R\rmaxPriceCents\x12\x1d\n" +
+	"\n" +
+	"min_stores\x18\x04 \x01(\x05R\tminStores\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
+	"\x12max_distance_miles\x18\x06 \x01(\x01R\x10maxDistanceMiles\x12\x1a\n" +
+	"\blocation\x18\a \x01(\tR\blocation\"\x16\n" +
+	"\x14GetAlertRulesRequest\"I\n" +
+	"\x15GetAlertRulesResponse\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1a.stockchecker.v1.AlertRuleR\x05rules\"\x85\x01\n" +
+	"\x16UpdateAlertRuleRequest\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.stockchecker.v1.AlertRuleR\x04rule\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"I\n" +
+	"\x17UpdateAlertRuleResponse\x12.\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1a.stockchecker.v1.AlertRuleR\x04rule\"5\n" +
+	"\x12SyncChangesRequest\x12\x1f\n" +
+	"\vsince_token\x18\x01 \x01(\tR\n" +
+	"sinceToken\"\xaa\x01\n" +
+	"\rStockSnapshot\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1f\n" +
+	"\vpostal_code\x18\x02 \x01(\tR\n" +
+	"postalCode\x12+\n" +
+	"\x12in_stock_store_ids\x18\x03 \x03(\tR\x0finStockStoreIds\x129\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"\xd8\x03\n" +
+	"\x13SyncChangesResponse\x12.\n" +
+	"\x06stores\x18\x01 \x03(\v2\x16.stockchecker.v1.StoreR\x06stores\x12*\n" +
+	"\x11removed_store_ids\x18\x02 \x03(\tR\x0fremovedStoreIds\x124\n" +
+	"\bproducts\x18\x03 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts\x12!\n" +
+	"\fremoved_skus\x18\x04 \x03(\tR\vremovedSkus\x12J\n" +
+	"\vpreferences\x18\x05 \x01(\v2(.stockchecker.v1.NotificationPreferencesR\vpreferences\x12;\n" +
+	"\valert_rules\x18\x06 \x03(\v2\x1a.stockchecker.v1.AlertRuleR\n" +
+	"alertRules\x12G\n" +
+	"\x0fstock_snapshots\x18\a \x03(\v2\x1e.stockchecker.v1.StockSnapshotR\x0estockSnapshots\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\b \x01(\tR\tnextToken\x12\x1b\n" +
+	"\tfull_sync\x18\t \x01(\bR\bfullSync\"\xf8\x01\n" +
+	"\x0fWatchlistChange\x12\x1a\n" +
+	"\bretailer\x18\x01 \x01(\tR\bretailer\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12>\n" +
+	"\x06action\x18\x03 \x01(\x0e2&.stockchecker.v1.WatchlistChangeActionR\x06action\x12!\n" +
+	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x19\n" +
+	"\bstore_id\x18\x06 \x01(\tR\astoreId\"\x8b\x01\n" +
+	"\x1bListWatchlistChangesRequest\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x1cListWatchlistChangesResponse\x12:\n" +
+	"\achanges\x18\x01 \x03(\v2 .stockchecker.v1.WatchlistChangeR\achanges\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x17\n" +
+	"\x15UndoLastChangeRequest\"R\n" +
+	"\x16UndoLastChangeResponse\x128\n" +
+	"\x06undone\x18\x01 \x01(\v2 .stockchecker.v1.WatchlistChangeR\x06undone\"\x92\x01\n" +
+	"\bSetWatch\x12\x19\n" +
+	"\bset_name\x18\x01 \x01(\tR\asetName\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x120\n" +
+	"\atcg_set\x18\x03 \x01(\v2\x17.stockchecker.v1.TcgSetR\x06tcgSet\"\x8a\x02\n" +
+	"\x06TcgSet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06series\x18\x03 \x01(\tR\x06series\x12=\n" +
+	"\frelease_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vreleaseDate\x12,\n" +
+	"\x12printed_card_count\x18\x05 \x01(\x05R\x10printedCardCount\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x06 \x01(\x05R\tcardCount\x12\x19\n" +
+	"\blogo_url\x18\a \x01(\tR\alogoUrl\x12\x1d\n" +
+	"\n" +
+	"symbol_url\x18\b \x01(\tR\tsymbolUrl\"\x83\x01\n" +
+	"\x04Msrp\x12\x19\n" +
+	"\bset_name\x18\x01 \x01(\tR\asetName\x12?\n" +
+	"\fproduct_type\x18\x02 \x01(\x0e2\x1c.stockchecker.v1.ProductTypeR\vproductType\x12\x1f\n" +
+	"\vprice_cents\x18\x03 \x01(\x03R\n" +
+	"priceCents\"\x12\n" +
+	"\x10ListMsrpsRequest\"@\n" +
+	"\x11ListMsrpsResponse\x12+\n" +
+	"\x05msrps\x18\x01 \x03(\v2\x15.stockchecker.v1.MsrpR\x05msrps\";\n" +
+	"\x0eSetMsrpRequest\x12)\n" +
+	"\x04msrp\x18\x01 \x01(\v2\x15.stockchecker.v1.MsrpR\x04msrp\"\x11\n" +
+	"\x0fSetMsrpResponse\",\n" +
+	"\x18GetProductDetailsRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"\x81\x01\n" +
+	"\x19GetProductDetailsResponse\x122\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.stockchecker.v1.ProductR\aproduct\x120\n" +
+	"\atcg_set\x18\x02 \x01(\v2\x17.stockchecker.v1.TcgSetR\x06tcgSet\"\x18\n" +
+	"\x16GetMySetWatchesRequest\"U\n" +
+	"\x17GetMySetWatchesResponse\x12:\n" +
+	"\vset_watches\x18\x01 \x03(\v2\x19.stockchecker.v1.SetWatchR\n" +
+	"setWatches\",\n" +
+	"\x0fWatchSetRequest\x12\x19\n" +
+	"\bset_name\x18\x01 \x01(\tR\asetName\"\x8b\x01\n" +
+	"\x10WatchSetResponse\x126\n" +
+	"\tset_watch\x18\x01 \x01(\v2\x19.stockchecker.v1.SetWatchR\bsetWatch\x12?\n" +
+	"\x0eadded_products\x18\x02 \x03(\v2\x18.stockchecker.v1.ProductR\raddedProducts\".\n" +
+	"\x11UnwatchSetRequest\x12\x19\n" +
+	"\bset_name\x18\x01 \x01(\tR\asetName\"\x14\n" +
+	"\x12UnwatchSetResponse\"\x91\x02\n" +
+	"\vAcquisition\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x19\n" +
+	"\bset_name\x18\x04 \x01(\tR\asetName\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1f\n" +
+	"\vprice_cents\x18\x06 \x01(\x03R\n" +
+	"priceCents\x12#\n" +
+	"\rcurrency_code\x18\a \x01(\tR\fcurrencyCode\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\b \x01(\tR\tstoreName\x12!\n" +
+	"\fpurchased_on\x18\t \x01(\tR\vpurchasedOn\"V\n" +
+	"\x14MarkPurchasedRequest\x12>\n" +
+	"\vacquisition\x18\x01 \x01(\v2\x1c.stockchecker.v1.AcquisitionR\vacquisition\"W\n" +
+	"\x15MarkPurchasedResponse\x12>\n" +
+	"\vacquisition\x18\x01 \x01(\v2\x1c.stockchecker.v1.AcquisitionR\vacquisition\"\x80\x01\n" +
+	"\x18GetMyAcquisitionsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x14\n" +
+	"\x05until\x18\x02 \x01(\tR\x05until\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x85\x01\n" +
+	"\x19GetMyAcquisitionsResponse\x12@\n" +
+	"\facquisitions\x18\x01 \x03(\v2\x1c.stockchecker.v1.AcquisitionR\facquisitions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"*\n" +
+	"\x18DeleteAcquisitionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1b\n" +
+	"\x19DeleteAcquisitionResponse\"\x80\x01\n" +
+	"\n" +
+	"SpendTotal\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12#\n" +
+	"\rcurrency_code\x18\x02 \x01(\tR\fcurrencyCode\x12\x1f\n" +
+	"\vtotal_cents\x18\x03 \x01(\x03R\n" +
+	"totalCents\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"H\n" +
+	"\x1cGetAcquisitionSummaryRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x14\n" +
+	"\x05until\x18\x02 \x01(\tR\x05until\"\xd5\x01\n" +
+	"\x10StoreReliability\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x1f\n" +
+	"\vfound_count\x18\x02 \x01(\x05R\n" +
+	"foundCount\x12-\n" +
+	"\x12confirmation_count\x18\x03 \x01(\x05R\x11confirmationCount\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12@\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\x0e2 .stockchecker.v1.StoreConfidenceR\n" +
+	"confidence\"X\n" +
+	"\x13ConfirmStockRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12\x14\n" +
+	"\x05found\x18\x03 \x01(\bR\x05found\"[\n" +
+	"\x14ConfirmStockResponse\x12C\n" +
+	"\vreliability\x18\x01 \x01(\v2!.stockchecker.v1.StoreReliabilityR\vreliability\"9\n" +
+	"\x1aGetStoreReliabilityRequest\x12\x1b\n" +
+	"\tstore_ids\x18\x01 \x03(\tR\bstoreIds\"X\n" +
+	"\x1bGetStoreReliabilityResponse\x129\n" +
+	"\x06stores\x18\x01 \x03(\v2!.stockchecker.v1.StoreReliabilityR\x06stores\"\xc3\x03\n" +
+	"\bSighting\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x19\n" +
+	"\bstore_id\x18\x04 \x01(\tR\astoreId\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x05 \x01(\tR\tstoreName\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\thas_photo\x18\a \x01(\bR\bhasPhoto\x127\n" +
+	"\x06status\x18\b \x01(\x0e2\x1f.stockchecker.v1.SightingStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fmoderated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vmoderatedAt\x12%\n" +
+	"\x0ereporter_score\x18\v \x01(\x01R\rreporterScore\x12%\n" +
+	"\x0ereporter_muted\x18\f \x01(\bR\rreporterMuted\"\x95\x01\n" +
+	"\x15ReportSightingRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x03 \x01(\tR\tstoreName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05photo\x18\x05 \x01(\fR\x05photo\"O\n" +
+	"\x16ReportSightingResponse\x125\n" +
+	"\bsighting\x18\x01 \x01(\v2\x19.stockchecker.v1.SightingR\bsighting\"\x8b\x01\n" +
+	"\x14ListSightingsRequest\x127\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1f.stockchecker.v1.SightingStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"x\n" +
+	"\x15ListSightingsResponse\x127\n" +
+	"\tsightings\x18\x01 \x03(\v2\x19.stockchecker.v1.SightingR\tsightings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\")\n" +
+	"\x17GetSightingPhotoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"S\n" +
+	"\x18GetSightingPhotoResponse\x12\x14\n" +
+	"\x05photo\x18\x01 \x01(\fR\x05photo\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"C\n" +
+	"\x17ModerateSightingRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\"r\n" +
+	"\x18ModerateSightingResponse\x125\n" +
+	"\bsighting\x18\x01 \x01(\v2\x19.stockchecker.v1.SightingR\bsighting\x12\x1f\n" +
+	"\valerts_sent\x18\x02 \x01(\x05R\n" +
+	"alertsSent\"\xba\x01\n" +
+	"\x1dGetAcquisitionSummaryResponse\x123\n" +
+	"\x06months\x18\x01 \x03(\v2\x1b.stockchecker.v1.SpendTotalR\x06months\x12/\n" +
+	"\x04sets\x18\x02 \x03(\v2\x1b.stockchecker.v1.SpendTotalR\x04sets\x123\n" +
+	"\x06totals\x18\x03 \x03(\v2\x1b.stockchecker.v1.SpendTotalR\x06totals\"3\n" +
+	"\x17GetOfflineBundleRequest\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\xc6\x02\n" +
+	"\x18GetOfflineBundleResponse\x12!\n" +
+	"\fnot_modified\x18\x01 \x01(\bR\vnotModified\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12=\n" +
+	"\fgenerated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12.\n" +
+	"\x06stores\x18\x04 \x03(\v2\x16.stockchecker.v1.StoreR\x06stores\x124\n" +
+	"\bproducts\x18\x05 \x03(\v2\x18.stockchecker.v1.ProductR\bproducts\x12H\n" +
+	"\favailability\x18\x06 \x03(\v2$.stockchecker.v1.CurrentAvailabilityR\favailability\"Y\n" +
+	"\x16GetStockHistoryRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12\x12\n" +
+	"\x04days\x18\x03 \x01(\x05R\x04days\"\x7f\n" +
+	"\n" +
+	"StockCheck\x12\x19\n" +
+	"\bin_stock\x18\x01 \x01(\bR\ainStock\x12\x1b\n" +
+	"\tlow_stock\x18\x02 \x01(\bR\blowStock\x129\n" +
+	"\n" +
+	"checked_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"\x93\x01\n" +
+	"\x17GetStockHistoryResponse\x123\n" +
+	"\x06checks\x18\x01 \x03(\v2\x1b.stockchecker.v1.StockCheckR\x06checks\x12C\n" +
+	"\x10last_in_stock_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rlastInStockAt\"\xdd\x01\n" +
+	"\n" +
+	"StockEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x19\n" +
+	"\bstore_id\x18\x03 \x01(\tR\astoreId\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x04 \x01(\tR\tstoreName\x12\x19\n" +
+	"\bin_stock\x18\x05 \x01(\bR\ainStock\x12\x1b\n" +
+	"\tlow_stock\x18\x06 \x01(\bR\blowStock\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"k\n" +
+	"\x11WatchStockRequest\x12\x12\n" +
+	"\x04skus\x18\x01 \x03(\tR\x04skus\x12\x1f\n" +
+	"\vbuffer_size\x18\x02 \x01(\x05R\n" +
+	"bufferSize\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\x86\x01\n" +
+	"\x12WatchStockResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.stockchecker.v1.StockEventR\x06events\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"1\n" +
+	"\x14CheckStoreNowRequest\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\"\xd9\x01\n" +
+	"\x15CheckStoreNowResponse\x12,\n" +
+	"\x05store\x18\x01 \x01(\v2\x16.stockchecker.v1.StoreR\x05store\x126\n" +
+	"\aresults\x18\x02 \x03(\v2\x1c.stockchecker.v1.StockStatusR\aresults\x12\x1f\n" +
+	"\vfailed_skus\x18\x03 \x03(\tR\n" +
+	"failedSkus\x129\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"\x9c\x01\n" +
+	"\bLocation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vpostal_code\x18\x02 \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fradius_miles\x18\x03 \x01(\x05R\vradiusMiles\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\"\x17\n" +
+	"\x15GetMyLocationsRequest\"Q\n" +
+	"\x16GetMyLocationsResponse\x127\n" +
+	"\tlocations\x18\x01 \x03(\v2\x19.stockchecker.v1.LocationR\tlocations\"M\n" +
+	"\x14SetMyLocationRequest\x125\n" +
+	"\blocation\x18\x01 \x01(\v2\x19.stockchecker.v1.LocationR\blocation\"N\n" +
+	"\x15SetMyLocationResponse\x125\n" +
+	"\blocation\x18\x01 \x01(\v2\x19.stockchecker.v1.LocationR\blocation\"-\n" +
+	"\x17DeleteMyLocationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x1a\n" +
+	"\x18DeleteMyLocationResponse\",\n" +
+	"\x18GetProductBarcodeRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"\x9a\x01\n" +
+	"\x19GetProductBarcodeResponse\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1c\n" +
+	"\tsymbology\x18\x03 \x01(\tR\tsymbology\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\tR\apayload\x12\x10\n" +
+	"\x03svg\x18\x05 \x01(\tR\x03svg\"\x90\x01\n" +
+	"\fProductWatch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x129\n" +
 	"\n" +
-	"CheckStock\x12\".stockchecker.v1.CheckStockRequest\x1a#.stockchecker.v1.CheckStockResponse\x12a\n" +
-	"\x0eGetCurrentUser\x12&.stockchecker.v1.GetCurrentUserRequest\x1a'.stockchecker.v1.GetCurrentUserResponse\x12X\n" +
-	"\vGetMyStores\x12#.stockchecker.v1.GetMyStoresRequest\x1a$.stockchecker.v1.GetMyStoresResponse\x12U\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x19\n" +
+	"\x17GetProductDomainRequest\"\xc2\x01\n" +
+	"\x18GetProductDomainResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x0fsearch_category\x18\x03 \x01(\tR\x0esearchCategory\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\tR\n" +
+	"categoryId\x128\n" +
+	"\apresets\x18\x05 \x03(\v2\x1e.stockchecker.v1.ProductPresetR\apresets\"T\n" +
+	"\rProductPreset\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"msrp_cents\x18\x03 \x01(\x03R\tmsrpCents\"\x1c\n" +
+	"\x1aGetMyProductWatchesRequest\"e\n" +
+	"\x1bGetMyProductWatchesResponse\x12F\n" +
+	"\x0fproduct_watches\x18\x01 \x03(\v2\x1d.stockchecker.v1.ProductWatchR\x0eproductWatches\"M\n" +
+	"\x14WatchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\"~\n" +
+	"\x15WatchProductsResponse\x12B\n" +
+	"\rproduct_watch\x18\x01 \x01(\v2\x1d.stockchecker.v1.ProductWatchR\fproductWatch\x12!\n" +
+	"\flisted_count\x18\x02 \x01(\x05R\vlistedCount\"(\n" +
+	"\x16UnwatchProductsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x19\n" +
+	"\x17UnwatchProductsResponse\"z\n" +
+	"\fAllowedEmail\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x19\n" +
+	"\badded_by\x18\x02 \x01(\tR\aaddedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x1bAdminAddAllowedEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"\x1e\n" +
+	"\x1cAdminAddAllowedEmailResponse\"6\n" +
+	"\x1eAdminRemoveAllowedEmailRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"!\n" +
+	"\x1fAdminRemoveAllowedEmailResponse\"[\n" +
+	"\x1dAdminListAllowedEmailsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8e\x01\n" +
+	"\x1eAdminListAllowedEmailsResponse\x12D\n" +
+	"\x0eallowed_emails\x18\x01 \x03(\v2\x1d.stockchecker.v1.AllowedEmailR\rallowedEmails\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"}\n" +
+	"\rAllowedDomain\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x19\n" +
+	"\badded_by\x18\x02 \x01(\tR\aaddedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"6\n" +
+	"\x1cAdminAddAllowedDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"\x1f\n" +
+	"\x1dAdminAddAllowedDomainResponse\"9\n" +
+	"\x1fAdminRemoveAllowedDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"\"\n" +
+	" AdminRemoveAllowedDomainResponse\"\\\n" +
+	"\x1eAdminListAllowedDomainsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x92\x01\n" +
+	"\x1fAdminListAllowedDomainsResponse\x12G\n" +
+	"\x0fallowed_domains\x18\x01 \x03(\v2\x1e.stockchecker.v1.AllowedDomainR\x0eallowedDomains\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8f\x02\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x123\n" +
+	"\aused_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06usedAt\x12\x17\n" +
+	"\aused_by\x18\x06 \x01(\tR\x06usedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"D\n" +
+	"\x18AdminCreateInviteRequest\x12\x12\n" +
+	"\x04note\x18\x01 \x01(\tR\x04note\x12\x14\n" +
+	"\x05hours\x18\x02 \x01(\x05R\x05hours\"^\n" +
+	"\x19AdminCreateInviteResponse\x12/\n" +
+	"\x06invite\x18\x01 \x01(\v2\x17.stockchecker.v1.InviteR\x06invite\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"U\n" +
+	"\x17AdminListInvitesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"u\n" +
+	"\x18AdminListInvitesResponse\x121\n" +
+	"\ainvites\x18\x01 \x03(\v2\x17.stockchecker.v1.InviteR\ainvites\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"*\n" +
+	"\x18AdminRevokeInviteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1b\n" +
+	"\x19AdminRevokeInviteResponse\"S\n" +
+	"\x15AdminListUsersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"m\n" +
+	"\x16AdminListUsersResponse\x12+\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.stockchecker.v1.UserR\x05users\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"a\n" +
+	"\x17AdminSetUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x05R\x06userId\x12-\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x19.stockchecker.v1.UserRoleR\x04role\"E\n" +
+	"\x18AdminSetUserRoleResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.stockchecker.v1.UserR\x04user\"\xc0\x01\n" +
+	"\n" +
+	"Credential\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03set\x18\x02 \x01(\bR\x03set\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x03 \x01(\bR\n" +
+	"overridden\x12\x12\n" +
+	"\x04hint\x18\x04 \x01(\tR\x04hint\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1d\n" +
+	"\x1bAdminListCredentialsRequest\"]\n" +
+	"\x1cAdminListCredentialsResponse\x12=\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x1b.stockchecker.v1.CredentialR\vcredentials\"E\n" +
+	"\x19AdminSetCredentialRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"Y\n" +
+	"\x1aAdminSetCredentialResponse\x12;\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1b.stockchecker.v1.CredentialR\n" +
+	"credential\"1\n" +
+	"\x1bAdminClearCredentialRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"[\n" +
+	"\x1cAdminClearCredentialResponse\x12;\n" +
+	"\n" +
+	"credential\x18\x01 \x01(\v2\x1b.stockchecker.v1.CredentialR\n" +
+	"credential\"\xbd\x02\n" +
+	"\fApiCallStats\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\tR\bpriority\x12#\n" +
+	"\rsampled_calls\x18\x03 \x01(\x05R\fsampledCalls\x12'\n" +
+	"\x0festimated_calls\x18\x04 \x01(\x01R\x0eestimatedCalls\x120\n" +
+	"\x14estimated_quota_cost\x18\x05 \x01(\x01R\x12estimatedQuotaCost\x12)\n" +
+	"\x10estimated_errors\x18\x06 \x01(\x01R\x0festimatedErrors\x12$\n" +
+	"\x0eavg_latency_ms\x18\a \x01(\x01R\favgLatencyMs\x12$\n" +
+	"\x0ep95_latency_ms\x18\b \x01(\x01R\fp95LatencyMs\"3\n" +
+	"\x1bAdminGetApiCallStatsRequest\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\x05R\x05hours\"\x85\x01\n" +
+	"\x1cAdminGetApiCallStatsResponse\x123\n" +
+	"\x05stats\x18\x01 \x03(\v2\x1d.stockchecker.v1.ApiCallStatsR\x05stats\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"\xbd\x01\n" +
+	"\x06ApiKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\x15\n" +
+	"\x13GetMyApiKeysRequest\"J\n" +
+	"\x14GetMyApiKeysResponse\x122\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x17.stockchecker.v1.ApiKeyR\aapiKeys\")\n" +
+	"\x13CreateApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"Z\n" +
+	"\x14CreateApiKeyResponse\x120\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x17.stockchecker.v1.ApiKeyR\x06apiKey\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"%\n" +
+	"\x13RevokeApiKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x16\n" +
+	"\x14RevokeApiKeyResponse\"\xa5\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_seen_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"\x15\n" +
+	"\x13ListSessionsRequest\"L\n" +
+	"\x14ListSessionsResponse\x124\n" +
+	"\bsessions\x18\x01 \x03(\v2\x18.stockchecker.v1.SessionR\bsessions\"8\n" +
+	"\x14RevokeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\arevoked\x18\x01 \x01(\x05R\arevoked\"\x15\n" +
+	"\x13ExportMyDataRequest\"F\n" +
+	"\x14ExportMyDataResponse\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\tR\x04json\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename\"2\n" +
+	"\x16DeleteMyAccountRequest\x12\x18\n" +
+	"\aconfirm\x18\x01 \x01(\bR\aconfirm\"\x19\n" +
+	"\x17DeleteMyAccountResponse\"\x1b\n" +
+	"\x19GetClientBootstrapRequest\"\x86\x01\n" +
+	"\x0eClientFeatures\x12\x1e\n" +
+	"\n" +
+	"watchlists\x18\x01 \x01(\bR\n" +
+	"watchlists\x12#\n" +
+	"\rstock_watcher\x18\x02 \x01(\bR\fstockWatcher\x12\x19\n" +
+	"\btcg_sets\x18\x03 \x01(\bR\atcgSets\x12\x14\n" +
+	"\x05msrps\x18\x04 \x01(\bR\x05msrps\"R\n" +
+	"\fServerStatus\x12\x1b\n" +
+	"\tread_only\x18\x01 \x01(\bR\breadOnly\x12%\n" +
+	"\x0eproduct_domain\x18\x02 \x01(\tR\rproductDomain\"K\n" +
+	"\fChannelState\x12!\n" +
+	"\fchannel_type\x18\x01 \x01(\tR\vchannelType\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"\x9a\x01\n" +
+	"\x0fWatchlistCounts\x12\x16\n" +
+	"\x06stores\x18\x01 \x01(\x05R\x06stores\x12\x1a\n" +
+	"\bproducts\x18\x02 \x01(\x05R\bproducts\x12*\n" +
+	"\x11in_stock_products\x18\x03 \x01(\x05R\x0finStockProducts\x12'\n" +
+	"\x0fproduct_watches\x18\x04 \x01(\x05R\x0eproductWatches\"3\n" +
+	"\rLoginProvider\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc4\x03\n" +
+	"\x1aGetClientBootstrapResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.stockchecker.v1.UserR\x04user\x12;\n" +
+	"\bfeatures\x18\x02 \x01(\v2\x1f.stockchecker.v1.ClientFeaturesR\bfeatures\x125\n" +
+	"\x06status\x18\x03 \x01(\v2\x1d.stockchecker.v1.ServerStatusR\x06status\x12\"\n" +
+	"\fannouncement\x18\x04 \x01(\tR\fannouncement\x129\n" +
+	"\bchannels\x18\x05 \x03(\v2\x1d.stockchecker.v1.ChannelStateR\bchannels\x12>\n" +
+	"\twatchlist\x18\x06 \x01(\v2 .stockchecker.v1.WatchlistCountsR\twatchlist\x12G\n" +
+	"\x0flogin_providers\x18\a \x03(\v2\x1e.stockchecker.v1.LoginProviderR\x0eloginProviders\x12\x1f\n" +
+	"\vemail_login\x18\b \x01(\bR\n" +
+	"emailLogin\"\xd8\x01\n" +
+	"\x0eApiDeprecation\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12 \n" +
+	"\vreplacement\x18\x02 \x01(\tR\vreplacement\x12?\n" +
+	"\rdeprecated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fdeprecatedAt\x127\n" +
+	"\tsunset_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bsunsetAt\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"U\n" +
+	"\tApiChange\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x18\n" +
+	"\achanges\x18\x02 \x03(\tR\achanges\"\x13\n" +
+	"\x11GetApiInfoRequest\"\xad\x01\n" +
+	"\x12GetApiInfoResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12C\n" +
+	"\fdeprecations\x18\x02 \x03(\v2\x1f.stockchecker.v1.ApiDeprecationR\fdeprecations\x128\n" +
+	"\tchangelog\x18\x03 \x03(\v2\x1a.stockchecker.v1.ApiChangeR\tchangelog\"\xba\x01\n" +
+	"\bUsageDay\x12,\n" +
+	"\x03day\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x03day\x12-\n" +
+	"\x12interactive_checks\x18\x02 \x01(\x05R\x11interactiveChecks\x12+\n" +
+	"\x11background_checks\x18\x03 \x01(\x05R\x10backgroundChecks\x12$\n" +
+	"\rnotifications\x18\x04 \x01(\x05R\rnotifications\"\x91\x01\n" +
+	"\aApiLoad\x12(\n" +
+	"\x10call_interval_ms\x18\x01 \x01(\x05R\x0ecallIntervalMs\x122\n" +
+	"\x15calls_remaining_today\x18\x02 \x01(\x05R\x13callsRemainingToday\x12(\n" +
+	"\x10daily_call_limit\x18\x03 \x01(\x05R\x0edailyCallLimit\"'\n" +
+	"\x11GetMyUsageRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\"\xa4\x01\n" +
+	"\x12GetMyUsageResponse\x12-\n" +
+	"\x04days\x18\x01 \x03(\v2\x19.stockchecker.v1.UsageDayR\x04days\x121\n" +
+	"\x06totals\x18\x02 \x01(\v2\x19.stockchecker.v1.UsageDayR\x06totals\x12,\n" +
+	"\x04load\x18\x03 \x01(\v2\x18.stockchecker.v1.ApiLoadR\x04load\"\xd4\x02\n" +
+	"\x04Drop\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
+	"\adetails\x18\x05 \x01(\tR\adetails\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x125\n" +
+	"\bopens_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\x127\n" +
+	"\tcloses_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12\x1a\n" +
+	"\bwatching\x18\t \x01(\bR\bwatching\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"9\n" +
+	"\x14UpcomingDropsRequest\x12!\n" +
+	"\fwatched_only\x18\x01 \x01(\bR\vwatchedOnly\"D\n" +
+	"\x15UpcomingDropsResponse\x12+\n" +
+	"\x05drops\x18\x01 \x03(\v2\x15.stockchecker.v1.DropR\x05drops\"A\n" +
+	"\x14AdminSaveDropRequest\x12)\n" +
+	"\x04drop\x18\x01 \x01(\v2\x15.stockchecker.v1.DropR\x04drop\"B\n" +
+	"\x15AdminSaveDropResponse\x12)\n" +
+	"\x04drop\x18\x01 \x01(\v2\x15.stockchecker.v1.DropR\x04drop\"(\n" +
+	"\x16AdminDeleteDropRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x19\n" +
+	"\x17AdminDeleteDropResponse\"\x1a\n" +
+	"\x18GetMyInboundEmailRequest\"5\n" +
+	"\x19GetMyInboundEmailResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\x1c\n" +
+	"\x1aResetMyInboundEmailRequest\"7\n" +
+	"\x1bResetMyInboundEmailResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\x93\x03\n" +
+	"\bReminder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x12\x19\n" +
+	"\bstore_id\x18\x05 \x01(\tR\astoreId\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x06 \x01(\tR\tstoreName\x12\x18\n" +
+	"\asubject\x18\a \x01(\tR\asubject\x121\n" +
+	"\x06due_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x127\n" +
+	"\tremind_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bremindAt\x123\n" +
+	"\asent_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x18\n" +
+	"\x16ListMyRemindersRequest\"R\n" +
+	"\x17ListMyRemindersResponse\x127\n" +
+	"\treminders\x18\x01 \x03(\v2\x19.stockchecker.v1.ReminderR\treminders\")\n" +
+	"\x17DeleteMyReminderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x1a\n" +
+	"\x18DeleteMyReminderResponse*\x92\x01\n" +
+	"\bRetailer\x12\x18\n" +
+	"\x14RETAILER_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11RETAILER_BEST_BUY\x10\x01\x12\x14\n" +
+	"\x10RETAILER_WALMART\x10\x02\x12\x13\n" +
+	"\x0fRETAILER_TARGET\x10\x03\x12\x15\n" +
+	"\x11RETAILER_GAMESTOP\x10\x04\x12\x13\n" +
+	"\x0fRETAILER_COSTCO\x10\x05*n\n" +
+	"\rWatchPriority\x12\x1e\n" +
+	"\x1aWATCH_PRIORITY_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18WATCH_PRIORITY_MUST_HAVE\x10\x01\x12\x1f\n" +
+	"\x1bWATCH_PRIORITY_NICE_TO_HAVE\x10\x02*\xfa\x01\n" +
+	"\vProductType\x12\x1c\n" +
+	"\x18PRODUCT_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1ePRODUCT_TYPE_ELITE_TRAINER_BOX\x10\x01\x12\x1f\n" +
+	"\x1bPRODUCT_TYPE_BOOSTER_BUNDLE\x10\x02\x12\x1c\n" +
+	"\x18PRODUCT_TYPE_BOOSTER_BOX\x10\x03\x12\x1d\n" +
+	"\x19PRODUCT_TYPE_BOOSTER_PACK\x10\x04\x12\x14\n" +
+	"\x10PRODUCT_TYPE_TIN\x10\x05\x12\x1b\n" +
+	"\x17PRODUCT_TYPE_COLLECTION\x10\x06\x12\x18\n" +
+	"\x14PRODUCT_TYPE_BLISTER\x10\a*N\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eUSER_ROLE_USER\x10\x01\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x02*\xeb\x01\n" +
+	"\fSkuErrorCode\x12\x1e\n" +
+	"\x1aSKU_ERROR_CODE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SKU_ERROR_CODE_NOT_FOUND\x10\x01\x12\x1d\n" +
+	"\x19SKU_ERROR_CODE_RESTRICTED\x10\x02\x12\x1f\n" +
+	"\x1bSKU_ERROR_CODE_RATE_LIMITED\x10\x03\x12!\n" +
+	"\x1dSKU_ERROR_CODE_QUOTA_EXCEEDED\x10\x04\x12\x1a\n" +
+	"\x16SKU_ERROR_CODE_API_KEY\x10\x05\x12\x1e\n" +
+	"\x1aSKU_ERROR_CODE_UNAVAILABLE\x10\x06*\x99\x01\n" +
+	"\x0fDuplicateReason\x12 \n" +
+	"\x1cDUPLICATE_REASON_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DUPLICATE_REASON_SAME_UPC\x10\x01\x12&\n" +
+	"\"DUPLICATE_REASON_SAME_MODEL_NUMBER\x10\x02\x12\x1d\n" +
+	"\x19DUPLICATE_REASON_SAME_SET\x10\x03*\xad\x01\n" +
+	"\x15WatchlistChangeAction\x12'\n" +
+	"#WATCHLIST_CHANGE_ACTION_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dWATCHLIST_CHANGE_ACTION_ADDED\x10\x01\x12#\n" +
+	"\x1fWATCHLIST_CHANGE_ACTION_UPDATED\x10\x02\x12#\n" +
+	"\x1fWATCHLIST_CHANGE_ACTION_REMOVED\x10\x03*\x85\x01\n" +
+	"\x0fStoreConfidence\x12 \n" +
+	"\x1cSTORE_CONFIDENCE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STORE_CONFIDENCE_LOW\x10\x01\x12\x1b\n" +
+	"\x17STORE_CONFIDENCE_MEDIUM\x10\x02\x12\x19\n" +
+	"\x15STORE_CONFIDENCE_HIGH\x10\x03*\x8b\x01\n" +
+	"\x0eSightingStatus\x12\x1f\n" +
+	"\x1bSIGHTING_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SIGHTING_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19SIGHTING_STATUS_CONFIRMED\x10\x02\x12\x1c\n" +
+	"\x18SIGHTING_STATUS_REJECTED\x10\x032\xa0M\n" +
+	"\x13StockCheckerService\x12`\n" +
+	"\fSearchStores\x12$.stockchecker.v1.SearchStoresRequest\x1a%.stockchecker.v1.SearchStoresResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x0eSearchProducts\x12&.stockchecker.v1.SearchProductsRequest\x1a'.stockchecker.v1.SearchProductsResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\n" +
+	"CheckStock\x12\".stockchecker.v1.CheckStockRequest\x1a#.stockchecker.v1.CheckStockResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x0eGetCurrentUser\x12&.stockchecker.v1.GetCurrentUserRequest\x1a'.stockchecker.v1.GetCurrentUserResponse\"\x03\x90\x02\x01\x12X\n" +
+	"\vSetMyLocale\x12#.stockchecker.v1.SetMyLocaleRequest\x1a$.stockchecker.v1.SetMyLocaleResponse\x12]\n" +
+	"\vGetMyStores\x12#.stockchecker.v1.GetMyStoresRequest\x1a$.stockchecker.v1.GetMyStoresResponse\"\x03\x90\x02\x01\x12U\n" +
 	"\n" +
 	"AddMyStore\x12\".stockchecker.v1.AddMyStoreRequest\x1a#.stockchecker.v1.AddMyStoreResponse\x12^\n" +
-	"\rRemoveMyStore\x12%.stockchecker.v1.RemoveMyStoreRequest\x1a&.stockchecker.v1.RemoveMyStoreResponse\x12^\n" +
-	"\rGetMyProducts\x12%.stockchecker.v1.GetMyProductsRequest\x1a&.stockchecker.v1.GetMyProductsResponse\x12[\n" +
+	"\rRemoveMyStore\x12%.stockchecker.v1.RemoveMyStoreRequest\x1a&.stockchecker.v1.RemoveMyStoreResponse\x12c\n" +
+	"\rGetMyProducts\x12%.stockchecker.v1.GetMyProductsRequest\x1a&.stockchecker.v1.GetMyProductsResponse\"\x03\x90\x02\x01\x12[\n" +
 	"\fAddMyProduct\x12$.stockchecker.v1.AddMyProductRequest\x1a%.stockchecker.v1.AddMyProductResponse\x12d\n" +
-	"\x0fRemoveMyProduct\x12'.stockchecker.v1.RemoveMyProductRequest\x1a(.stockchecker.v1.RemoveMyProductResponse\x12v\n" +
-	"\x15BrowsePokemonProducts\x12-.stockchecker.v1.BrowsePokemonProductsRequest\x1a..stockchecker.v1.BrowsePokemonProductsResponseB\xce\x01\n" +
+	"\x0fUpdateMyProduct\x12'.stockchecker.v1.UpdateMyProductRequest\x1a(.stockchecker.v1.UpdateMyProductResponse\x12d\n" +
+	"\x0fRemoveMyProduct\x12'.stockchecker.v1.RemoveMyProductRequest\x1a(.stockchecker.v1.RemoveMyProductResponse\x12g\n" +
+	"\x10RemoveMyProducts\x12(.stockchecker.v1.RemoveMyProductsRequest\x1a).stockchecker.v1.RemoveMyProductsResponse\x12a\n" +
+	"\x0eClearWatchlist\x12&.stockchecker.v1.ClearWatchlistRequest\x1a'.stockchecker.v1.ClearWatchlistResponse\x12g\n" +
+	"\x10ImportMyProducts\x12(.stockchecker.v1.ImportMyProductsRequest\x1a).stockchecker.v1.ImportMyProductsResponse\x12{\n" +
+	"\x15BrowsePokemonProducts\x12-.stockchecker.v1.BrowsePokemonProductsRequest\x1a..stockchecker.v1.BrowsePokemonProductsResponse\"\x03\x90\x02\x01\x12\x8a\x01\n" +
+	"\x1aGetNotificationPreferences\x122.stockchecker.v1.GetNotificationPreferencesRequest\x1a3.stockchecker.v1.GetNotificationPreferencesResponse\"\x03\x90\x02\x01\x12\x8e\x01\n" +
+	"\x1dUpdateNotificationPreferences\x125.stockchecker.v1.UpdateNotificationPreferencesRequest\x1a6.stockchecker.v1.UpdateNotificationPreferencesResponse\x12c\n" +
+	"\rGetAlertRules\x12%.stockchecker.v1.GetAlertRulesRequest\x1a&.stockchecker.v1.GetAlertRulesResponse\"\x03\x90\x02\x01\x12d\n" +
+	"\x0fUpdateAlertRule\x12'.stockchecker.v1.UpdateAlertRuleRequest\x1a(.stockchecker.v1.UpdateAlertRuleResponse\x12\x84\x01\n" +
+	"\x18GetNotificationTemplates\x120.stockchecker.v1.GetNotificationTemplatesRequest\x1a1.stockchecker.v1.GetNotificationTemplatesResponse\"\x03\x90\x02\x01\x12|\n" +
+	"\x17SetNotificationTemplate\x12/.stockchecker.v1.SetNotificationTemplateRequest\x1a0.stockchecker.v1.SetNotificationTemplateResponse\x12\x85\x01\n" +
+	"\x1aDeleteNotificationTemplate\x122.stockchecker.v1.DeleteNotificationTemplateRequest\x1a3.stockchecker.v1.DeleteNotificationTemplateResponse\x12\x81\x01\n" +
+	"\x17GetNotificationChannels\x12/.stockchecker.v1.GetNotificationChannelsRequest\x1a0.stockchecker.v1.GetNotificationChannelsResponse\"\x03\x90\x02\x01\x12y\n" +
+	"\x16SetNotificationChannel\x12..stockchecker.v1.SetNotificationChannelRequest\x1a/.stockchecker.v1.SetNotificationChannelResponse\x12\x82\x01\n" +
+	"\x19DeleteNotificationChannel\x121.stockchecker.v1.DeleteNotificationChannelRequest\x1a2.stockchecker.v1.DeleteNotificationChannelResponse\x12s\n" +
+	"\x14SendTestNotification\x12,.stockchecker.v1.SendTestNotificationRequest\x1a-.stockchecker.v1.SendTestNotificationResponse\x12s\n" +
+	"\x14SimulateWatcherCycle\x12,.stockchecker.v1.SimulateWatcherCycleRequest\x1a-.stockchecker.v1.SimulateWatcherCycleResponse\x12f\n" +
+	"\x0eGetMyDashboard\x12&.stockchecker.v1.GetMyDashboardRequest\x1a'.stockchecker.v1.GetMyDashboardResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x0eGetMyLocations\x12&.stockchecker.v1.GetMyLocationsRequest\x1a'.stockchecker.v1.GetMyLocationsResponse\"\x03\x90\x02\x01\x12^\n" +
+	"\rSetMyLocation\x12%.stockchecker.v1.SetMyLocationRequest\x1a&.stockchecker.v1.SetMyLocationResponse\x12g\n" +
+	"\x10DeleteMyLocation\x12(.stockchecker.v1.DeleteMyLocationRequest\x1a).stockchecker.v1.DeleteMyLocationResponse\x12o\n" +
+	"\x11GetProductBarcode\x12).stockchecker.v1.GetProductBarcodeRequest\x1a*.stockchecker.v1.GetProductBarcodeResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\rCheckStoreNow\x12%.stockchecker.v1.CheckStoreNowRequest\x1a&.stockchecker.v1.CheckStoreNowResponse\"\x03\x90\x02\x01\x12i\n" +
+	"\x0fGetStockHistory\x12'.stockchecker.v1.GetStockHistoryRequest\x1a(.stockchecker.v1.GetStockHistoryResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\n" +
+	"WatchStock\x12\".stockchecker.v1.WatchStockRequest\x1a#.stockchecker.v1.WatchStockResponse0\x01\x12l\n" +
+	"\x10GetOfflineBundle\x12(.stockchecker.v1.GetOfflineBundleRequest\x1a).stockchecker.v1.GetOfflineBundleResponse\"\x03\x90\x02\x01\x12X\n" +
+	"\vSyncChanges\x12#.stockchecker.v1.SyncChangesRequest\x1a$.stockchecker.v1.SyncChangesResponse\x12x\n" +
+	"\x14ListWatchlistChanges\x12,.stockchecker.v1.ListWatchlistChangesRequest\x1a-.stockchecker.v1.ListWatchlistChangesResponse\"\x03\x90\x02\x01\x12a\n" +
+	"\x0eUndoLastChange\x12&.stockchecker.v1.UndoLastChangeRequest\x1a'.stockchecker.v1.UndoLastChangeResponse\x12o\n" +
+	"\x11GetProductDetails\x12).stockchecker.v1.GetProductDetailsRequest\x1a*.stockchecker.v1.GetProductDetailsResponse\"\x03\x90\x02\x01\x12W\n" +
+	"\tListMsrps\x12!.stockchecker.v1.ListMsrpsRequest\x1a\".stockchecker.v1.ListMsrpsResponse\"\x03\x90\x02\x01\x12L\n" +
+	"\aSetMsrp\x12\x1f.stockchecker.v1.SetMsrpRequest\x1a .stockchecker.v1.SetMsrpResponse\x12i\n" +
+	"\x0fGetMySetWatches\x12'.stockchecker.v1.GetMySetWatchesRequest\x1a(.stockchecker.v1.GetMySetWatchesResponse\"\x03\x90\x02\x01\x12O\n" +
+	"\bWatchSet\x12 .stockchecker.v1.WatchSetRequest\x1a!.stockchecker.v1.WatchSetResponse\x12U\n" +
+	"\n" +
+	"UnwatchSet\x12\".stockchecker.v1.UnwatchSetRequest\x1a#.stockchecker.v1.UnwatchSetResponse\x12^\n" +
+	"\rMarkPurchased\x12%.stockchecker.v1.MarkPurchasedRequest\x1a&.stockchecker.v1.MarkPurchasedResponse\x12o\n" +
+	"\x11GetMyAcquisitions\x12).stockchecker.v1.GetMyAcquisitionsRequest\x1a*.stockchecker.v1.GetMyAcquisitionsResponse\"\x03\x90\x02\x01\x12j\n" +
+	"\x11DeleteAcquisition\x12).stockchecker.v1.DeleteAcquisitionRequest\x1a*.stockchecker.v1.DeleteAcquisitionResponse\x12{\n" +
+	"\x15GetAcquisitionSummary\x12-.stockchecker.v1.GetAcquisitionSummaryRequest\x1a..stockchecker.v1.GetAcquisitionSummaryResponse\"\x03\x90\x02\x01\x12[\n" +
+	"\fConfirmStock\x12$.stockchecker.v1.ConfirmStockRequest\x1a%.stockchecker.v1.ConfirmStockResponse\x12u\n" +
+	"\x13GetStoreReliability\x12+.stockchecker.v1.GetStoreReliabilityRequest\x1a,.stockchecker.v1.GetStoreReliabilityResponse\"\x03\x90\x02\x01\x12a\n" +
+	"\x0eReportSighting\x12&.stockchecker.v1.ReportSightingRequest\x1a'.stockchecker.v1.ReportSightingResponse\x12c\n" +
+	"\rListSightings\x12%.stockchecker.v1.ListSightingsRequest\x1a&.stockchecker.v1.ListSightingsResponse\"\x03\x90\x02\x01\x12l\n" +
+	"\x10GetSightingPhoto\x12(.stockchecker.v1.GetSightingPhotoRequest\x1a).stockchecker.v1.GetSightingPhotoResponse\"\x03\x90\x02\x01\x12g\n" +
+	"\x10ModerateSighting\x12(.stockchecker.v1.ModerateSightingRequest\x1a).stockchecker.v1.ModerateSightingResponse\x12l\n" +
+	"\x10GetProductDomain\x12(.stockchecker.v1.GetProductDomainRequest\x1a).stockchecker.v1.GetProductDomainResponse\"\x03\x90\x02\x01\x12u\n" +
+	"\x13GetMyProductWatches\x12+.stockchecker.v1.GetMyProductWatchesRequest\x1a,.stockchecker.v1.GetMyProductWatchesResponse\"\x03\x90\x02\x01\x12^\n" +
+	"\rWatchProducts\x12%.stockchecker.v1.WatchProductsRequest\x1a&.stockchecker.v1.WatchProductsResponse\x12d\n" +
+	"\x0fUnwatchProducts\x12'.stockchecker.v1.UnwatchProductsRequest\x1a(.stockchecker.v1.UnwatchProductsResponse\x12s\n" +
+	"\x14AdminAddAllowedEmail\x12,.stockchecker.v1.AdminAddAllowedEmailRequest\x1a-.stockchecker.v1.AdminAddAllowedEmailResponse\x12|\n" +
+	"\x17AdminRemoveAllowedEmail\x12/.stockchecker.v1.AdminRemoveAllowedEmailRequest\x1a0.stockchecker.v1.AdminRemoveAllowedEmailResponse\x12~\n" +
+	"\x16AdminListAllowedEmails\x12..stockchecker.v1.AdminListAllowedEmailsRequest\x1a/.stockchecker.v1.AdminListAllowedEmailsResponse\"\x03\x90\x02\x01\x12v\n" +
+	"\x15AdminAddAllowedDomain\x12-.stockchecker.v1.AdminAddAllowedDomainRequest\x1a..stockchecker.v1.AdminAddAllowedDomainResponse\x12\x7f\n" +
+	"\x18AdminRemoveAllowedDomain\x120.stockchecker.v1.AdminRemoveAllowedDomainRequest\x1a1.stockchecker.v1.AdminRemoveAllowedDomainResponse\x12\x81\x01\n" +
+	"\x17AdminListAllowedDomains\x12/.stockchecker.v1.AdminListAllowedDomainsRequest\x1a0.stockchecker.v1.AdminListAllowedDomainsResponse\"\x03\x90\x02\x01\x12j\n" +
+	"\x11AdminCreateInvite\x12).stockchecker.v1.AdminCreateInviteRequest\x1a*.stockchecker.v1.AdminCreateInviteResponse\x12l\n" +
+	"\x10AdminListInvites\x12(.stockchecker.v1.AdminListInvitesRequest\x1a).stockchecker.v1.AdminListInvitesResponse\"\x03\x90\x02\x01\x12j\n" +
+	"\x11AdminRevokeInvite\x12).stockchecker.v1.AdminRevokeInviteRequest\x1a*.stockchecker.v1.AdminRevokeInviteResponse\x12f\n" +
+	"\x0eAdminListUsers\x12&.stockchecker.v1.AdminListUsersRequest\x1a'.stockchecker.v1.AdminListUsersResponse\"\x03\x90\x02\x01\x12g\n" +
+	"\x10AdminSetUserRole\x12(.stockchecker.v1.AdminSetUserRoleRequest\x1a).stockchecker.v1.AdminSetUserRoleResponse\x12x\n" +
+	"\x14AdminListCredentials\x12,.stockchecker.v1.AdminListCredentialsRequest\x1a-.stockchecker.v1.AdminListCredentialsResponse\"\x03\x90\x02\x01\x12m\n" +
+	"\x12AdminSetCredential\x12*.stockchecker.v1.AdminSetCredentialRequest\x1a+.stockchecker.v1.AdminSetCredentialResponse\x12s\n" +
+	"\x14AdminClearCredential\x12,.stockchecker.v1.AdminClearCredentialRequest\x1a-.stockchecker.v1.AdminClearCredentialResponse\x12x\n" +
+	"\x14AdminGetApiCallStats\x12,.stockchecker.v1.AdminGetApiCallStatsRequest\x1a-.stockchecker.v1.AdminGetApiCallStatsResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\fGetMyApiKeys\x12$.stockchecker.v1.GetMyApiKeysRequest\x1a%.stockchecker.v1.GetMyApiKeysResponse\"\x03\x90\x02\x01\x12[\n" +
+	"\fCreateApiKey\x12$.stockchecker.v1.CreateApiKeyRequest\x1a%.stockchecker.v1.CreateApiKeyResponse\x12[\n" +
+	"\fRevokeApiKey\x12$.stockchecker.v1.RevokeApiKeyRequest\x1a%.stockchecker.v1.RevokeApiKeyResponse\x12`\n" +
+	"\fListSessions\x12$.stockchecker.v1.ListSessionsRequest\x1a%.stockchecker.v1.ListSessionsResponse\"\x03\x90\x02\x01\x12^\n" +
+	"\rRevokeSession\x12%.stockchecker.v1.RevokeSessionRequest\x1a&.stockchecker.v1.RevokeSessionResponse\x12`\n" +
+	"\fExportMyData\x12$.stockchecker.v1.ExportMyDataRequest\x1a%.stockchecker.v1.ExportMyDataResponse\"\x03\x90\x02\x01\x12d\n" +
+	"\x0fDeleteMyAccount\x12'.stockchecker.v1.DeleteMyAccountRequest\x1a(.stockchecker.v1.DeleteMyAccountResponse\x12r\n" +
+	"\x12GetClientBootstrap\x12*.stockchecker.v1.GetClientBootstrapRequest\x1a+.stockchecker.v1.GetClientBootstrapResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\n" +
+	"GetApiInfo\x12\".stockchecker.v1.GetApiInfoRequest\x1a#.stockchecker.v1.GetApiInfoResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\n" +
+	"GetMyUsage\x12\".stockchecker.v1.GetMyUsageRequest\x1a#.stockchecker.v1.GetMyUsageResponse\"\x03\x90\x02\x01\x12c\n" +
+	"\rUpcomingDrops\x12%.stockchecker.v1.UpcomingDropsRequest\x1a&.stockchecker.v1.UpcomingDropsResponse\"\x03\x90\x02\x01\x12^\n" +
+	"\rAdminSaveDrop\x12%.stockchecker.v1.AdminSaveDropRequest\x1a&.stockchecker.v1.AdminSaveDropResponse\x12d\n" +
+	"\x0fAdminDeleteDrop\x12'.stockchecker.v1.AdminDeleteDropRequest\x1a(.stockchecker.v1.AdminDeleteDropResponse\x12j\n" +
+	"\x11GetMyInboundEmail\x12).stockchecker.v1.GetMyInboundEmailRequest\x1a*.stockchecker.v1.GetMyInboundEmailResponse\x12p\n" +
+	"\x13ResetMyInboundEmail\x12+.stockchecker.v1.ResetMyInboundEmailRequest\x1a,.stockchecker.v1.ResetMyInboundEmailResponse\x12i\n" +
+	"\x0fListMyReminders\x12'.stockchecker.v1.ListMyRemindersRequest\x1a(.stockchecker.v1.ListMyRemindersResponse\"\x03\x90\x02\x01\x12g\n" +
+	"\x10DeleteMyReminder\x12(.stockchecker.v1.DeleteMyReminderRequest\x1a).stockchecker.v1.DeleteMyReminderResponseB\xce\x01\n" +
 	"\x13com.stockchecker.v1B\fServiceProtoP\x01ZLgithub.com/tmcauley/stock-checker/backend/gen/stockchecker/v1;stockcheckerv1\xa2\x02\x03SXX\xaa\x02\x0fStockchecker.V1\xca\x02\x0fStockchecker\\V1\xe2\x02\x1bStockchecker\\V1\\GPBMetadata\xea\x02\x10Stockchecker::V1b\x06proto3"
 
 var (
@@ -1417,74 +14134,604 @@ func file_stockchecker_v1_service_proto_rawDescGZIP() []byte {
 	return file_stockchecker_v1_service_proto_rawDescData
 }
 
-var file_stockchecker_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_stockchecker_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_stockchecker_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 230)
 var file_stockchecker_v1_service_proto_goTypes = []any{
-	(*Store)(nil),                         // 0: stockchecker.v1.Store
-	(*Product)(nil),                       // 1: stockchecker.v1.Product
-	(*StockStatus)(nil),                   // 2: stockchecker.v1.StockStatus
-	(*User)(nil),                          // 3: stockchecker.v1.User
-	(*SearchStoresRequest)(nil),           // 4: stockchecker.v1.SearchStoresRequest
-	(*SearchStoresResponse)(nil),          // 5: stockchecker.v1.SearchStoresResponse
-	(*SearchProductsRequest)(nil),         // 6: stockchecker.v1.SearchProductsRequest
-	(*SearchProductsResponse)(nil),        // 7: stockchecker.v1.SearchProductsResponse
-	(*CheckStockRequest)(nil),             // 8: stockchecker.v1.CheckStockRequest
-	(*CheckStockResponse)(nil),            // 9: stockchecker.v1.CheckStockResponse
-	(*GetCurrentUserRequest)(nil),         // 10: stockchecker.v1.GetCurrentUserRequest
-	(*GetCurrentUserResponse)(nil),        // 11: stockchecker.v1.GetCurrentUserResponse
-	(*GetMyStoresRequest)(nil),            // 12: stockchecker.v1.GetMyStoresRequest
-	(*GetMyStoresResponse)(nil),           // 13: stockchecker.v1.GetMyStoresResponse
-	(*AddMyStoreRequest)(nil),             // 14: stockchecker.v1.AddMyStoreRequest
-	(*AddMyStoreResponse)(nil),            // 15: stockchecker.v1.AddMyStoreResponse
-	(*RemoveMyStoreRequest)(nil),          // 16: stockchecker.v1.RemoveMyStoreRequest
-	(*RemoveMyStoreResponse)(nil),         // 17: stockchecker.v1.RemoveMyStoreResponse
-	(*GetMyProductsRequest)(nil),          // 18: stockchecker.v1.GetMyProductsRequest
-	(*GetMyProductsResponse)(nil),         // 19: stockchecker.v1.GetMyProductsResponse
-	(*AddMyProductRequest)(nil),           // 20: stockchecker.v1.AddMyProductRequest
-	(*AddMyProductResponse)(nil),          // 21: stockchecker.v1.AddMyProductResponse
-	(*RemoveMyProductRequest)(nil),        // 22: stockchecker.v1.RemoveMyProductRequest
-	(*RemoveMyProductResponse)(nil),       // 23: stockchecker.v1.RemoveMyProductResponse
-	(*BrowsePokemonProductsRequest)(nil),  // 24: stockchecker.v1.BrowsePokemonProductsRequest
-	(*BrowsePokemonProductsResponse)(nil), // 25: stockchecker.v1.BrowsePokemonProductsResponse
+	(Retailer)(0),                                 // 0: stockchecker.v1.Retailer
+	(WatchPriority)(0),                            // 1: stockchecker.v1.WatchPriority
+	(ProductType)(0),                              // 2: stockchecker.v1.ProductType
+	(UserRole)(0),                                 // 3: stockchecker.v1.UserRole
+	(SkuErrorCode)(0),                             // 4: stockchecker.v1.SkuErrorCode
+	(DuplicateReason)(0),                          // 5: stockchecker.v1.DuplicateReason
+	(WatchlistChangeAction)(0),                    // 6: stockchecker.v1.WatchlistChangeAction
+	(StoreConfidence)(0),                          // 7: stockchecker.v1.StoreConfidence
+	(SightingStatus)(0),                           // 8: stockchecker.v1.SightingStatus
+	(*Store)(nil),                                 // 9: stockchecker.v1.Store
+	(*Product)(nil),                               // 10: stockchecker.v1.Product
+	(*StockStatus)(nil),                           // 11: stockchecker.v1.StockStatus
+	(*User)(nil),                                  // 12: stockchecker.v1.User
+	(*SearchStoresRequest)(nil),                   // 13: stockchecker.v1.SearchStoresRequest
+	(*SearchStoresResponse)(nil),                  // 14: stockchecker.v1.SearchStoresResponse
+	(*SearchProductsRequest)(nil),                 // 15: stockchecker.v1.SearchProductsRequest
+	(*SearchProductsResponse)(nil),                // 16: stockchecker.v1.SearchProductsResponse
+	(*CheckStockRequest)(nil),                     // 17: stockchecker.v1.CheckStockRequest
+	(*SkuError)(nil),                              // 18: stockchecker.v1.SkuError
+	(*MaintenanceError)(nil),                      // 19: stockchecker.v1.MaintenanceError
+	(*CheckStockResponse)(nil),                    // 20: stockchecker.v1.CheckStockResponse
+	(*GetCurrentUserRequest)(nil),                 // 21: stockchecker.v1.GetCurrentUserRequest
+	(*GetCurrentUserResponse)(nil),                // 22: stockchecker.v1.GetCurrentUserResponse
+	(*SetMyLocaleRequest)(nil),                    // 23: stockchecker.v1.SetMyLocaleRequest
+	(*SetMyLocaleResponse)(nil),                   // 24: stockchecker.v1.SetMyLocaleResponse
+	(*GetMyStoresRequest)(nil),                    // 25: stockchecker.v1.GetMyStoresRequest
+	(*GetMyStoresResponse)(nil),                   // 26: stockchecker.v1.GetMyStoresResponse
+	(*AddMyStoreRequest)(nil),                     // 27: stockchecker.v1.AddMyStoreRequest
+	(*AddMyStoreResponse)(nil),                    // 28: stockchecker.v1.AddMyStoreResponse
+	(*RemoveMyStoreRequest)(nil),                  // 29: stockchecker.v1.RemoveMyStoreRequest
+	(*RemoveMyStoreResponse)(nil),                 // 30: stockchecker.v1.RemoveMyStoreResponse
+	(*GetMyProductsRequest)(nil),                  // 31: stockchecker.v1.GetMyProductsRequest
+	(*GetMyProductsResponse)(nil),                 // 32: stockchecker.v1.GetMyProductsResponse
+	(*AddMyProductRequest)(nil),                   // 33: stockchecker.v1.AddMyProductRequest
+	(*PossibleDuplicate)(nil),                     // 34: stockchecker.v1.PossibleDuplicate
+	(*AddMyProductResponse)(nil),                  // 35: stockchecker.v1.AddMyProductResponse
+	(*RemoveMyProductRequest)(nil),                // 36: stockchecker.v1.RemoveMyProductRequest
+	(*RemoveMyProductResponse)(nil),               // 37: stockchecker.v1.RemoveMyProductResponse
+	(*RemoveMyProductsRequest)(nil),               // 38: stockchecker.v1.RemoveMyProductsRequest
+	(*RemoveMyProductsResponse)(nil),              // 39: stockchecker.v1.RemoveMyProductsResponse
+	(*ClearWatchlistRequest)(nil),                 // 40: stockchecker.v1.ClearWatchlistRequest
+	(*ClearWatchlistResponse)(nil),                // 41: stockchecker.v1.ClearWatchlistResponse
+	(*ImportMyProductsRequest)(nil),               // 42: stockchecker.v1.ImportMyProductsRequest
+	(*ImportMyProductsResponse)(nil),              // 43: stockchecker.v1.ImportMyProductsResponse
+	(*BrowsePokemonProductsRequest)(nil),          // 44: stockchecker.v1.BrowsePokemonProductsRequest
+	(*BrowsePokemonProductsResponse)(nil),         // 45: stockchecker.v1.BrowsePokemonProductsResponse
+	(*NotificationChannel)(nil),                   // 46: stockchecker.v1.NotificationChannel
+	(*GetNotificationChannelsRequest)(nil),        // 47: stockchecker.v1.GetNotificationChannelsRequest
+	(*GetNotificationChannelsResponse)(nil),       // 48: stockchecker.v1.GetNotificationChannelsResponse
+	(*SetNotificationChannelRequest)(nil),         // 49: stockchecker.v1.SetNotificationChannelRequest
+	(*SetNotificationChannelResponse)(nil),        // 50: stockchecker.v1.SetNotificationChannelResponse
+	(*DeleteNotificationChannelRequest)(nil),      // 51: stockchecker.v1.DeleteNotificationChannelRequest
+	(*DeleteNotificationChannelResponse)(nil),     // 52: stockchecker.v1.DeleteNotificationChannelResponse
+	(*NotificationTemplate)(nil),                  // 53: stockchecker.v1.NotificationTemplate
+	(*GetNotificationTemplatesRequest)(nil),       // 54: stockchecker.v1.GetNotificationTemplatesRequest
+	(*GetNotificationTemplatesResponse)(nil),      // 55: stockchecker.v1.GetNotificationTemplatesResponse
+	(*SetNotificationTemplateRequest)(nil),        // 56: stockchecker.v1.SetNotificationTemplateRequest
+	(*SetNotificationTemplateResponse)(nil),       // 57: stockchecker.v1.SetNotificationTemplateResponse
+	(*DeleteNotificationTemplateRequest)(nil),     // 58: stockchecker.v1.DeleteNotificationTemplateRequest
+	(*DeleteNotificationTemplateResponse)(nil),    // 59: stockchecker.v1.DeleteNotificationTemplateResponse
+	(*SendTestNotificationRequest)(nil),           // 60: stockchecker.v1.SendTestNotificationRequest
+	(*SendTestNotificationResponse)(nil),          // 61: stockchecker.v1.SendTestNotificationResponse
+	(*SimulateWatcherCycleRequest)(nil),           // 62: stockchecker.v1.SimulateWatcherCycleRequest
+	(*SimulatedNotification)(nil),                 // 63: stockchecker.v1.SimulatedNotification
+	(*SimulateWatcherCycleResponse)(nil),          // 64: stockchecker.v1.SimulateWatcherCycleResponse
+	(*GetMyDashboardRequest)(nil),                 // 65: stockchecker.v1.GetMyDashboardRequest
+	(*CurrentAvailability)(nil),                   // 66: stockchecker.v1.CurrentAvailability
+	(*DailyAvailability)(nil),                     // 67: stockchecker.v1.DailyAvailability
+	(*GetMyDashboardResponse)(nil),                // 68: stockchecker.v1.GetMyDashboardResponse
+	(*UpdateMyProductRequest)(nil),                // 69: stockchecker.v1.UpdateMyProductRequest
+	(*UpdateMyProductResponse)(nil),               // 70: stockchecker.v1.UpdateMyProductResponse
+	(*NotificationPreferences)(nil),               // 71: stockchecker.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),     // 72: stockchecker.v1.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),    // 73: stockchecker.v1.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferencesRequest)(nil),  // 74: stockchecker.v1.UpdateNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesResponse)(nil), // 75: stockchecker.v1.UpdateNotificationPreferencesResponse
+	(*AlertRule)(nil),                             // 76: stockchecker.v1.AlertRule
+	(*GetAlertRulesRequest)(nil),                  // 77: stockchecker.v1.GetAlertRulesRequest
+	(*GetAlertRulesResponse)(nil),                 // 78: stockchecker.v1.GetAlertRulesResponse
+	(*UpdateAlertRuleRequest)(nil),                // 79: stockchecker.v1.UpdateAlertRuleRequest
+	(*UpdateAlertRuleResponse)(nil),               // 80: stockchecker.v1.UpdateAlertRuleResponse
+	(*SyncChangesRequest)(nil),                    // 81: stockchecker.v1.SyncChangesRequest
+	(*StockSnapshot)(nil),                         // 82: stockchecker.v1.StockSnapshot
+	(*SyncChangesResponse)(nil),                   // 83: stockchecker.v1.SyncChangesResponse
+	(*WatchlistChange)(nil),                       // 84: stockchecker.v1.WatchlistChange
+	(*ListWatchlistChangesRequest)(nil),           // 85: stockchecker.v1.ListWatchlistChangesRequest
+	(*ListWatchlistChangesResponse)(nil),          // 86: stockchecker.v1.ListWatchlistChangesResponse
+	(*UndoLastChangeRequest)(nil),                 // 87: stockchecker.v1.UndoLastChangeRequest
+	(*UndoLastChangeResponse)(nil),                // 88: stockchecker.v1.UndoLastChangeResponse
+	(*SetWatch)(nil),                              // 89: stockchecker.v1.SetWatch
+	(*TcgSet)(nil),                                // 90: stockchecker.v1.TcgSet
+	(*Msrp)(nil),                                  // 91: stockchecker.v1.Msrp
+	(*ListMsrpsRequest)(nil),                      // 92: stockchecker.v1.ListMsrpsRequest
+	(*ListMsrpsResponse)(nil),                     // 93: stockchecker.v1.ListMsrpsResponse
+	(*SetMsrpRequest)(nil),                        // 94: stockchecker.v1.SetMsrpRequest
+	(*SetMsrpResponse)(nil),                       // 95: stockchecker.v1.SetMsrpResponse
+	(*GetProductDetailsRequest)(nil),              // 96: stockchecker.v1.GetProductDetailsRequest
+	(*GetProductDetailsResponse)(nil),             // 97: stockchecker.v1.GetProductDetailsResponse
+	(*GetMySetWatchesRequest)(nil),                // 98: stockchecker.v1.GetMySetWatchesRequest
+	(*GetMySetWatchesResponse)(nil),               // 99: stockchecker.v1.GetMySetWatchesResponse
+	(*WatchSetRequest)(nil),                       // 100: stockchecker.v1.WatchSetRequest
+	(*WatchSetResponse)(nil),                      // 101: stockchecker.v1.WatchSetResponse
+	(*UnwatchSetRequest)(nil),                     // 102: stockchecker.v1.UnwatchSetRequest
+	(*UnwatchSetResponse)(nil),                    // 103: stockchecker.v1.UnwatchSetResponse
+	(*Acquisition)(nil),                           // 104: stockchecker.v1.Acquisition
+	(*MarkPurchasedRequest)(nil),                  // 105: stockchecker.v1.MarkPurchasedRequest
+	(*MarkPurchasedResponse)(nil),                 // 106: stockchecker.v1.MarkPurchasedResponse
+	(*GetMyAcquisitionsRequest)(nil),              // 107: stockchecker.v1.GetMyAcquisitionsRequest
+	(*GetMyAcquisitionsResponse)(nil),             // 108: stockchecker.v1.GetMyAcquisitionsResponse
+	(*DeleteAcquisitionRequest)(nil),              // 109: stockchecker.v1.DeleteAcquisitionRequest
+	(*DeleteAcquisitionResponse)(nil),             // 110: stockchecker.v1.DeleteAcquisitionResponse
+	(*SpendTotal)(nil),                            // 111: stockchecker.v1.SpendTotal
+	(*GetAcquisitionSummaryRequest)(nil),          // 112: stockchecker.v1.GetAcquisitionSummaryRequest
+	(*StoreReliability)(nil),                      // 113: stockchecker.v1.StoreReliability
+	(*ConfirmStockRequest)(nil),                   // 114: stockchecker.v1.ConfirmStockRequest
+	(*ConfirmStockResponse)(nil),                  // 115: stockchecker.v1.ConfirmStockResponse
+	(*GetStoreReliabilityRequest)(nil),            // 116: stockchecker.v1.GetStoreReliabilityRequest
+	(*GetStoreReliabilityResponse)(nil),           // 117: stockchecker.v1.GetStoreReliabilityResponse
+	(*Sighting)(nil),                              // 118: stockchecker.v1.Sighting
+	(*ReportSightingRequest)(nil),                 // 119: stockchecker.v1.ReportSightingRequest
+	(*ReportSightingResponse)(nil),                // 120: stockchecker.v1.ReportSightingResponse
+	(*ListSightingsRequest)(nil),                  // 121: stockchecker.v1.ListSightingsRequest
+	(*ListSightingsResponse)(nil),                 // 122: stockchecker.v1.ListSightingsResponse
+	(*GetSightingPhotoRequest)(nil),               // 123: stockchecker.v1.GetSightingPhotoRequest
+	(*GetSightingPhotoResponse)(nil),              // 124: stockchecker.v1.GetSightingPhotoResponse
+	(*ModerateSightingRequest)(nil),               // 125: stockchecker.v1.ModerateSightingRequest
+	(*ModerateSightingResponse)(nil),              // 126: stockchecker.v1.ModerateSightingResponse
+	(*GetAcquisitionSummaryResponse)(nil),         // 127: stockchecker.v1.GetAcquisitionSummaryResponse
+	(*GetOfflineBundleRequest)(nil),               // 128: stockchecker.v1.GetOfflineBundleRequest
+	(*GetOfflineBundleResponse)(nil),              // 129: stockchecker.v1.GetOfflineBundleResponse
+	(*GetStockHistoryRequest)(nil),                // 130: stockchecker.v1.GetStockHistoryRequest
+	(*StockCheck)(nil),                            // 131: stockchecker.v1.StockCheck
+	(*GetStockHistoryResponse)(nil),               // 132: stockchecker.v1.GetStockHistoryResponse
+	(*StockEvent)(nil),                            // 133: stockchecker.v1.StockEvent
+	(*WatchStockRequest)(nil),                     // 134: stockchecker.v1.WatchStockRequest
+	(*WatchStockResponse)(nil),                    // 135: stockchecker.v1.WatchStockResponse
+	(*CheckStoreNowRequest)(nil),                  // 136: stockchecker.v1.CheckStoreNowRequest
+	(*CheckStoreNowResponse)(nil),                 // 137: stockchecker.v1.CheckStoreNowResponse
+	(*Location)(nil),                              // 138: stockchecker.v1.Location
+	(*GetMyLocationsRequest)(nil),                 // 139: stockchecker.v1.GetMyLocationsRequest
+	(*GetMyLocationsResponse)(nil),                // 140: stockchecker.v1.GetMyLocationsResponse
+	(*SetMyLocationRequest)(nil),                  // 141: stockchecker.v1.SetMyLocationRequest
+	(*SetMyLocationResponse)(nil),                 // 142: stockchecker.v1.SetMyLocationResponse
+	(*DeleteMyLocationRequest)(nil),               // 143: stockchecker.v1.DeleteMyLocationRequest
+	(*DeleteMyLocationResponse)(nil),              // 144: stockchecker.v1.DeleteMyLocationResponse
+	(*GetProductBarcodeRequest)(nil),              // 145: stockchecker.v1.GetProductBarcodeRequest
+	(*GetProductBarcodeResponse)(nil),             // 146: stockchecker.v1.GetProductBarcodeResponse
+	(*ProductWatch)(nil),                          // 147: stockchecker.v1.ProductWatch
+	(*GetProductDomainRequest)(nil),               // 148: stockchecker.v1.GetProductDomainRequest
+	(*GetProductDomainResponse)(nil),              // 149: stockchecker.v1.GetProductDomainResponse
+	(*ProductPreset)(nil),                         // 150: stockchecker.v1.ProductPreset
+	(*GetMyProductWatchesRequest)(nil),            // 151: stockchecker.v1.GetMyProductWatchesRequest
+	(*GetMyProductWatchesResponse)(nil),           // 152: stockchecker.v1.GetMyProductWatchesResponse
+	(*WatchProductsRequest)(nil),                  // 153: stockchecker.v1.WatchProductsRequest
+	(*WatchProductsResponse)(nil),                 // 154: stockchecker.v1.WatchProductsResponse
+	(*UnwatchProductsRequest)(nil),                // 155: stockchecker.v1.UnwatchProductsRequest
+	(*UnwatchProductsResponse)(nil),               // 156: stockchecker.v1.UnwatchProductsResponse
+	(*AllowedEmail)(nil),                          // 157: stockchecker.v1.AllowedEmail
+	(*AdminAddAllowedEmailRequest)(nil),           // 158: stockchecker.v1.AdminAddAllowedEmailRequest
+	(*AdminAddAllowedEmailResponse)(nil),          // 159: stockchecker.v1.AdminAddAllowedEmailResponse
+	(*AdminRemoveAllowedEmailRequest)(nil),        // 160: stockchecker.v1.AdminRemoveAllowedEmailRequest
+	(*AdminRemoveAllowedEmailResponse)(nil),       // 161: stockchecker.v1.AdminRemoveAllowedEmailResponse
+	(*AdminListAllowedEmailsRequest)(nil),         // 162: stockchecker.v1.AdminListAllowedEmailsRequest
+	(*AdminListAllowedEmailsResponse)(nil),        // 163: stockchecker.v1.AdminListAllowedEmailsResponse
+	(*AllowedDomain)(nil),                         // 164: stockchecker.v1.AllowedDomain
+	(*AdminAddAllowedDomainRequest)(nil),          // 165: stockchecker.v1.AdminAddAllowedDomainRequest
+	(*AdminAddAllowedDomainResponse)(nil),         // 166: stockchecker.v1.AdminAddAllowedDomainResponse
+	(*AdminRemoveAllowedDomainRequest)(nil),       // 167: stockchecker.v1.AdminRemoveAllowedDomainRequest
+	(*AdminRemoveAllowedDomainResponse)(nil),      // 168: stockchecker.v1.AdminRemoveAllowedDomainResponse
+	(*AdminListAllowedDomainsRequest)(nil),        // 169: stockchecker.v1.AdminListAllowedDomainsRequest
+	(*AdminListAllowedDomainsResponse)(nil),       // 170: stockchecker.v1.AdminListAllowedDomainsResponse
+	(*Invite)(nil),                                // 171: stockchecker.v1.Invite
+	(*AdminCreateInviteRequest)(nil),              // 172: stockchecker.v1.AdminCreateInviteRequest
+	(*AdminCreateInviteResponse)(nil),             // 173: stockchecker.v1.AdminCreateInviteResponse
+	(*AdminListInvitesRequest)(nil),               // 174: stockchecker.v1.AdminListInvitesRequest
+	(*AdminListInvitesResponse)(nil),              // 175: stockchecker.v1.AdminListInvitesResponse
+	(*AdminRevokeInviteRequest)(nil),              // 176: stockchecker.v1.AdminRevokeInviteRequest
+	(*AdminRevokeInviteResponse)(nil),             // 177: stockchecker.v1.AdminRevokeInviteResponse
+	(*AdminListUsersRequest)(nil),                 // 178: stockchecker.v1.AdminListUsersRequest
+	(*AdminListUsersResponse)(nil),                // 179: stockchecker.v1.AdminListUsersResponse
+	(*AdminSetUserRoleRequest)(nil),               // 180: stockchecker.v1.AdminSetUserRoleRequest
+	(*AdminSetUserRoleResponse)(nil),              // 181: stockchecker.v1.AdminSetUserRoleResponse
+	(*Credential)(nil),                            // 182: stockchecker.v1.Credential
+	(*AdminListCredentialsRequest)(nil),           // 183: stockchecker.v1.AdminListCredentialsRequest
+	(*AdminListCredentialsResponse)(nil),          // 184: stockchecker.v1.AdminListCredentialsResponse
+	(*AdminSetCredentialRequest)(nil),             // 185: stockchecker.v1.AdminSetCredentialRequest
+	(*AdminSetCredentialResponse)(nil),            // 186: stockchecker.v1.AdminSetCredentialResponse
+	(*AdminClearCredentialRequest)(nil),           // 187: stockchecker.v1.AdminClearCredentialRequest
+	(*AdminClearCredentialResponse)(nil),          // 188: stockchecker.v1.AdminClearCredentialResponse
+	(*ApiCallStats)(nil),                          // 189: stockchecker.v1.ApiCallStats
+	(*AdminGetApiCallStatsRequest)(nil),           // 190: stockchecker.v1.AdminGetApiCallStatsRequest
+	(*AdminGetApiCallStatsResponse)(nil),          // 191: stockchecker.v1.AdminGetApiCallStatsResponse
+	(*ApiKey)(nil),                                // 192: stockchecker.v1.ApiKey
+	(*GetMyApiKeysRequest)(nil),                   // 193: stockchecker.v1.GetMyApiKeysRequest
+	(*GetMyApiKeysResponse)(nil),                  // 194: stockchecker.v1.GetMyApiKeysResponse
+	(*CreateApiKeyRequest)(nil),                   // 195: stockchecker.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),                  // 196: stockchecker.v1.CreateApiKeyResponse
+	(*RevokeApiKeyRequest)(nil),                   // 197: stockchecker.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),                  // 198: stockchecker.v1.RevokeApiKeyResponse
+	(*Session)(nil),                               // 199: stockchecker.v1.Session
+	(*ListSessionsRequest)(nil),                   // 200: stockchecker.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                  // 201: stockchecker.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                  // 202: stockchecker.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),                 // 203: stockchecker.v1.RevokeSessionResponse
+	(*ExportMyDataRequest)(nil),                   // 204: stockchecker.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),                  // 205: stockchecker.v1.ExportMyDataResponse
+	(*DeleteMyAccountRequest)(nil),                // 206: stockchecker.v1.DeleteMyAccountRequest
+	(*DeleteMyAccountResponse)(nil),               // 207: stockchecker.v1.DeleteMyAccountResponse
+	(*GetClientBootstrapRequest)(nil),             // 208: stockchecker.v1.GetClientBootstrapRequest
+	(*ClientFeatures)(nil),                        // 209: stockchecker.v1.ClientFeatures
+	(*ServerStatus)(nil),                          // 210: stockchecker.v1.ServerStatus
+	(*ChannelState)(nil),                          // 211: stockchecker.v1.ChannelState
+	(*WatchlistCounts)(nil),                       // 212: stockchecker.v1.WatchlistCounts
+	(*LoginProvider)(nil),                         // 213: stockchecker.v1.LoginProvider
+	(*GetClientBootstrapResponse)(nil),            // 214: stockchecker.v1.GetClientBootstrapResponse
+	(*ApiDeprecation)(nil),                        // 215: stockchecker.v1.ApiDeprecation
+	(*ApiChange)(nil),                             // 216: stockchecker.v1.ApiChange
+	(*GetApiInfoRequest)(nil),                     // 217: stockchecker.v1.GetApiInfoRequest
+	(*GetApiInfoResponse)(nil),                    // 218: stockchecker.v1.GetApiInfoResponse
+	(*UsageDay)(nil),                              // 219: stockchecker.v1.UsageDay
+	(*ApiLoad)(nil),                               // 220: stockchecker.v1.ApiLoad
+	(*GetMyUsageRequest)(nil),                     // 221: stockchecker.v1.GetMyUsageRequest
+	(*GetMyUsageResponse)(nil),                    // 222: stockchecker.v1.GetMyUsageResponse
+	(*Drop)(nil),                                  // 223: stockchecker.v1.Drop
+	(*UpcomingDropsRequest)(nil),                  // 224: stockchecker.v1.UpcomingDropsRequest
+	(*UpcomingDropsResponse)(nil),                 // 225: stockchecker.v1.UpcomingDropsResponse
+	(*AdminSaveDropRequest)(nil),                  // 226: stockchecker.v1.AdminSaveDropRequest
+	(*AdminSaveDropResponse)(nil),                 // 227: stockchecker.v1.AdminSaveDropResponse
+	(*AdminDeleteDropRequest)(nil),                // 228: stockchecker.v1.AdminDeleteDropRequest
+	(*AdminDeleteDropResponse)(nil),               // 229: stockchecker.v1.AdminDeleteDropResponse
+	(*GetMyInboundEmailRequest)(nil),              // 230: stockchecker.v1.GetMyInboundEmailRequest
+	(*GetMyInboundEmailResponse)(nil),             // 231: stockchecker.v1.GetMyInboundEmailResponse
+	(*ResetMyInboundEmailRequest)(nil),            // 232: stockchecker.v1.ResetMyInboundEmailRequest
+	(*ResetMyInboundEmailResponse)(nil),           // 233: stockchecker.v1.ResetMyInboundEmailResponse
+	(*Reminder)(nil),                              // 234: stockchecker.v1.Reminder
+	(*ListMyRemindersRequest)(nil),                // 235: stockchecker.v1.ListMyRemindersRequest
+	(*ListMyRemindersResponse)(nil),               // 236: stockchecker.v1.ListMyRemindersResponse
+	(*DeleteMyReminderRequest)(nil),               // 237: stockchecker.v1.DeleteMyReminderRequest
+	(*DeleteMyReminderResponse)(nil),              // 238: stockchecker.v1.DeleteMyReminderResponse
+	(*timestamppb.Timestamp)(nil),                 // 239: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                 // 240: google.protobuf.FieldMask
 }
 var file_stockchecker_v1_service_proto_depIdxs = []int32{
-	0,  // 0: stockchecker.v1.StockStatus.store:type_name -> stockchecker.v1.Store
-	1,  // 1: stockchecker.v1.StockStatus.product:type_name -> stockchecker.v1.Product
-	0,  // 2: stockchecker.v1.SearchStoresResponse.stores:type_name -> stockchecker.v1.Store
-	1,  // 3: stockchecker.v1.SearchProductsResponse.products:type_name -> stockchecker.v1.Product
-	2,  // 4: stockchecker.v1.CheckStockResponse.results:type_name -> stockchecker.v1.StockStatus
-	3,  // 5: stockchecker.v1.GetCurrentUserResponse.user:type_name -> stockchecker.v1.User
-	0,  // 6: stockchecker.v1.GetMyStoresResponse.stores:type_name -> stockchecker.v1.Store
-	0,  // 7: stockchecker.v1.AddMyStoreRequest.store:type_name -> stockchecker.v1.Store
-	1,  // 8: stockchecker.v1.GetMyProductsResponse.products:type_name -> stockchecker.v1.Product
-	1,  // 9: stockchecker.v1.AddMyProductRequest.product:type_name -> stockchecker.v1.Product
-	1,  // 10: stockchecker.v1.BrowsePokemonProductsResponse.products:type_name -> stockchecker.v1.Product
-	4,  // 11: stockchecker.v1.StockCheckerService.SearchStores:input_type -> stockchecker.v1.SearchStoresRequest
-	6,  // 12: stockchecker.v1.StockCheckerService.SearchProducts:input_type -> stockchecker.v1.SearchProductsRequest
-	8,  // 13: stockchecker.v1.StockCheckerService.CheckStock:input_type -> stockchecker.v1.CheckStockRequest
-	10, // 14: stockchecker.v1.StockCheckerService.GetCurrentUser:input_type -> stockchecker.v1.GetCurrentUserRequest
-	12, // 15: stockchecker.v1.StockCheckerService.GetMyStores:input_type -> stockchecker.v1.GetMyStoresRequest
-	14, // 16: stockchecker.v1.StockCheckerService.AddMyStore:input_type -> stockchecker.v1.AddMyStoreRequest
-	16, // 17: stockchecker.v1.StockCheckerService.RemoveMyStore:input_type -> stockchecker.v1.RemoveMyStoreRequest
-	18, // 18: stockchecker.v1.StockCheckerService.GetMyProducts:input_type -> stockchecker.v1.GetMyProductsRequest
-	20, // 19: stockchecker.v1.StockCheckerService.AddMyProduct:input_type -> stockchecker.v1.AddMyProductRequest
-	22, // 20: stockchecker.v1.StockCheckerService.RemoveMyProduct:input_type -> stockchecker.v1.RemoveMyProductRequest
-	24, // 21: stockchecker.v1.StockCheckerService.BrowsePokemonProducts:input_type -> stockchecker.v1.BrowsePokemonProductsRequest
-	5,  // 22: stockchecker.v1.StockCheckerService.SearchStores:output_type -> stockchecker.v1.SearchStoresResponse
-	7,  // 23: stockchecker.v1.StockCheckerService.SearchProducts:output_type -> stockchecker.v1.SearchProductsResponse
-	9,  // 24: stockchecker.v1.StockCheckerService.CheckStock:output_type -> stockchecker.v1.CheckStockResponse
-	11, // 25: stockchecker.v1.StockCheckerService.GetCurrentUser:output_type -> stockchecker.v1.GetCurrentUserResponse
-	13, // 26: stockchecker.v1.StockCheckerService.GetMyStores:output_type -> stockchecker.v1.GetMyStoresResponse
-	15, // 27: stockchecker.v1.StockCheckerService.AddMyStore:output_type -> stockchecker.v1.AddMyStoreResponse
-	17, // 28: stockchecker.v1.StockCheckerService.RemoveMyStore:output_type -> stockchecker.v1.RemoveMyStoreResponse
-	19, // 29: stockchecker.v1.StockCheckerService.GetMyProducts:output_type -> stockchecker.v1.GetMyProductsResponse
-	21, // 30: stockchecker.v1.StockCheckerService.AddMyProduct:output_type -> stockchecker.v1.AddMyProductResponse
-	23, // 31: stockchecker.v1.StockCheckerService.RemoveMyProduct:output_type -> stockchecker.v1.RemoveMyProductResponse
-	25, // 32: stockchecker.v1.StockCheckerService.BrowsePokemonProducts:output_type -> stockchecker.v1.BrowsePokemonProductsResponse
-	22, // [22:33] is the sub-list for method output_type
-	11, // [11:22] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	239, // 0: stockchecker.v1.Store.created_at:type_name -> google.protobuf.Timestamp
+	239, // 1: stockchecker.v1.Store.updated_at:type_name -> google.protobuf.Timestamp
+	239, // 2: stockchecker.v1.Store.opens_at:type_name -> google.protobuf.Timestamp
+	239, // 3: stockchecker.v1.Product.created_at:type_name -> google.protobuf.Timestamp
+	239, // 4: stockchecker.v1.Product.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 5: stockchecker.v1.Product.product_type:type_name -> stockchecker.v1.ProductType
+	1,   // 6: stockchecker.v1.Product.priority:type_name -> stockchecker.v1.WatchPriority
+	0,   // 7: stockchecker.v1.Product.retailer:type_name -> stockchecker.v1.Retailer
+	9,   // 8: stockchecker.v1.StockStatus.store:type_name -> stockchecker.v1.Store
+	10,  // 9: stockchecker.v1.StockStatus.product:type_name -> stockchecker.v1.Product
+	239, // 10: stockchecker.v1.StockStatus.checked_at:type_name -> google.protobuf.Timestamp
+	239, // 11: stockchecker.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 12: stockchecker.v1.User.role:type_name -> stockchecker.v1.UserRole
+	9,   // 13: stockchecker.v1.SearchStoresResponse.stores:type_name -> stockchecker.v1.Store
+	10,  // 14: stockchecker.v1.SearchProductsResponse.products:type_name -> stockchecker.v1.Product
+	4,   // 15: stockchecker.v1.SkuError.code:type_name -> stockchecker.v1.SkuErrorCode
+	11,  // 16: stockchecker.v1.CheckStockResponse.results:type_name -> stockchecker.v1.StockStatus
+	18,  // 17: stockchecker.v1.CheckStockResponse.errors:type_name -> stockchecker.v1.SkuError
+	12,  // 18: stockchecker.v1.GetCurrentUserResponse.user:type_name -> stockchecker.v1.User
+	9,   // 19: stockchecker.v1.GetMyStoresResponse.stores:type_name -> stockchecker.v1.Store
+	9,   // 20: stockchecker.v1.AddMyStoreRequest.store:type_name -> stockchecker.v1.Store
+	10,  // 21: stockchecker.v1.GetMyProductsResponse.products:type_name -> stockchecker.v1.Product
+	10,  // 22: stockchecker.v1.AddMyProductRequest.product:type_name -> stockchecker.v1.Product
+	5,   // 23: stockchecker.v1.PossibleDuplicate.reason:type_name -> stockchecker.v1.DuplicateReason
+	34,  // 24: stockchecker.v1.AddMyProductResponse.possible_duplicates:type_name -> stockchecker.v1.PossibleDuplicate
+	10,  // 25: stockchecker.v1.ImportMyProductsResponse.products:type_name -> stockchecker.v1.Product
+	10,  // 26: stockchecker.v1.BrowsePokemonProductsResponse.products:type_name -> stockchecker.v1.Product
+	239, // 27: stockchecker.v1.NotificationChannel.created_at:type_name -> google.protobuf.Timestamp
+	239, // 28: stockchecker.v1.NotificationChannel.updated_at:type_name -> google.protobuf.Timestamp
+	46,  // 29: stockchecker.v1.GetNotificationChannelsResponse.channels:type_name -> stockchecker.v1.NotificationChannel
+	46,  // 30: stockchecker.v1.SetNotificationChannelRequest.channel:type_name -> stockchecker.v1.NotificationChannel
+	46,  // 31: stockchecker.v1.SetNotificationChannelResponse.channel:type_name -> stockchecker.v1.NotificationChannel
+	53,  // 32: stockchecker.v1.GetNotificationTemplatesResponse.templates:type_name -> stockchecker.v1.NotificationTemplate
+	53,  // 33: stockchecker.v1.SetNotificationTemplateRequest.template:type_name -> stockchecker.v1.NotificationTemplate
+	53,  // 34: stockchecker.v1.SendTestNotificationRequest.template:type_name -> stockchecker.v1.NotificationTemplate
+	12,  // 35: stockchecker.v1.SimulatedNotification.user:type_name -> stockchecker.v1.User
+	10,  // 36: stockchecker.v1.SimulatedNotification.product:type_name -> stockchecker.v1.Product
+	9,   // 37: stockchecker.v1.SimulatedNotification.stores:type_name -> stockchecker.v1.Store
+	63,  // 38: stockchecker.v1.SimulateWatcherCycleResponse.notifications:type_name -> stockchecker.v1.SimulatedNotification
+	66,  // 39: stockchecker.v1.GetMyDashboardResponse.availability:type_name -> stockchecker.v1.CurrentAvailability
+	67,  // 40: stockchecker.v1.GetMyDashboardResponse.daily:type_name -> stockchecker.v1.DailyAvailability
+	10,  // 41: stockchecker.v1.UpdateMyProductRequest.product:type_name -> stockchecker.v1.Product
+	240, // 42: stockchecker.v1.UpdateMyProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	10,  // 43: stockchecker.v1.UpdateMyProductResponse.product:type_name -> stockchecker.v1.Product
+	239, // 44: stockchecker.v1.NotificationPreferences.updated_at:type_name -> google.protobuf.Timestamp
+	71,  // 45: stockchecker.v1.GetNotificationPreferencesResponse.preferences:type_name -> stockchecker.v1.NotificationPreferences
+	71,  // 46: stockchecker.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> stockchecker.v1.NotificationPreferences
+	240, // 47: stockchecker.v1.UpdateNotificationPreferencesRequest.update_mask:type_name -> google.protobuf.FieldMask
+	71,  // 48: stockchecker.v1.UpdateNotificationPreferencesResponse.preferences:type_name -> stockchecker.v1.NotificationPreferences
+	239, // 49: stockchecker.v1.AlertRule.updated_at:type_name -> google.protobuf.Timestamp
+	76,  // 50: stockchecker.v1.GetAlertRulesResponse.rules:type_name -> stockchecker.v1.AlertRule
+	76,  // 51: stockchecker.v1.UpdateAlertRuleRequest.rule:type_name -> stockchecker.v1.AlertRule
+	240, // 52: stockchecker.v1.UpdateAlertRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	76,  // 53: stockchecker.v1.UpdateAlertRuleResponse.rule:type_name -> stockchecker.v1.AlertRule
+	239, // 54: stockchecker.v1.StockSnapshot.checked_at:type_name -> google.protobuf.Timestamp
+	9,   // 55: stockchecker.v1.SyncChangesResponse.stores:type_name -> stockchecker.v1.Store
+	10,  // 56: stockchecker.v1.SyncChangesResponse.products:type_name -> stockchecker.v1.Product
+	71,  // 57: stockchecker.v1.SyncChangesResponse.preferences:type_name -> stockchecker.v1.NotificationPreferences
+	76,  // 58: stockchecker.v1.SyncChangesResponse.alert_rules:type_name -> stockchecker.v1.AlertRule
+	82,  // 59: stockchecker.v1.SyncChangesResponse.stock_snapshots:type_name -> stockchecker.v1.StockSnapshot
+	6,   // 60: stockchecker.v1.WatchlistChange.action:type_name -> stockchecker.v1.WatchlistChangeAction
+	239, // 61: stockchecker.v1.WatchlistChange.changed_at:type_name -> google.protobuf.Timestamp
+	239, // 62: stockchecker.v1.ListWatchlistChangesRequest.since:type_name -> google.protobuf.Timestamp
+	84,  // 63: stockchecker.v1.ListWatchlistChangesResponse.changes:type_name -> stockchecker.v1.WatchlistChange
+	84,  // 64: stockchecker.v1.UndoLastChangeResponse.undone:type_name -> stockchecker.v1.WatchlistChange
+	239, // 65: stockchecker.v1.SetWatch.created_at:type_name -> google.protobuf.Timestamp
+	90,  // 66: stockchecker.v1.SetWatch.tcg_set:type_name -> stockchecker.v1.TcgSet
+	239, // 67: stockchecker.v1.TcgSet.release_date:type_name -> google.protobuf.Timestamp
+	2,   // 68: stockchecker.v1.Msrp.product_type:type_name -> stockchecker.v1.ProductType
+	91,  // 69: stockchecker.v1.ListMsrpsResponse.msrps:type_name -> stockchecker.v1.Msrp
+	91,  // 70: stockchecker.v1.SetMsrpRequest.msrp:type_name -> stockchecker.v1.Msrp
+	10,  // 71: stockchecker.v1.GetProductDetailsResponse.product:type_name -> stockchecker.v1.Product
+	90,  // 72: stockchecker.v1.GetProductDetailsResponse.tcg_set:type_name -> stockchecker.v1.TcgSet
+	89,  // 73: stockchecker.v1.GetMySetWatchesResponse.set_watches:type_name -> stockchecker.v1.SetWatch
+	89,  // 74: stockchecker.v1.WatchSetResponse.set_watch:type_name -> stockchecker.v1.SetWatch
+	10,  // 75: stockchecker.v1.WatchSetResponse.added_products:type_name -> stockchecker.v1.Product
+	104, // 76: stockchecker.v1.MarkPurchasedRequest.acquisition:type_name -> stockchecker.v1.Acquisition
+	104, // 77: stockchecker.v1.MarkPurchasedResponse.acquisition:type_name -> stockchecker.v1.Acquisition
+	104, // 78: stockchecker.v1.GetMyAcquisitionsResponse.acquisitions:type_name -> stockchecker.v1.Acquisition
+	7,   // 79: stockchecker.v1.StoreReliability.confidence:type_name -> stockchecker.v1.StoreConfidence
+	113, // 80: stockchecker.v1.ConfirmStockResponse.reliability:type_name -> stockchecker.v1.StoreReliability
+	113, // 81: stockchecker.v1.GetStoreReliabilityResponse.stores:type_name -> stockchecker.v1.StoreReliability
+	8,   // 82: stockchecker.v1.Sighting.status:type_name -> stockchecker.v1.SightingStatus
+	239, // 83: stockchecker.v1.Sighting.created_at:type_name -> google.protobuf.Timestamp
+	239, // 84: stockchecker.v1.Sighting.moderated_at:type_name -> google.protobuf.Timestamp
+	118, // 85: stockchecker.v1.ReportSightingResponse.sighting:type_name -> stockchecker.v1.Sighting
+	8,   // 86: stockchecker.v1.ListSightingsRequest.status:type_name -> stockchecker.v1.SightingStatus
+	118, // 87: stockchecker.v1.ListSightingsResponse.sightings:type_name -> stockchecker.v1.Sighting
+	118, // 88: stockchecker.v1.ModerateSightingResponse.sighting:type_name -> stockchecker.v1.Sighting
+	111, // 89: stockchecker.v1.GetAcquisitionSummaryResponse.months:type_name -> stockchecker.v1.SpendTotal
+	111, // 90: stockchecker.v1.GetAcquisitionSummaryResponse.sets:type_name -> stockchecker.v1.SpendTotal
+	111, // 91: stockchecker.v1.GetAcquisitionSummaryResponse.totals:type_name -> stockchecker.v1.SpendTotal
+	239, // 92: stockchecker.v1.GetOfflineBundleResponse.generated_at:type_name -> google.protobuf.Timestamp
+	9,   // 93: stockchecker.v1.GetOfflineBundleResponse.stores:type_name -> stockchecker.v1.Store
+	10,  // 94: stockchecker.v1.GetOfflineBundleResponse.products:type_name -> stockchecker.v1.Product
+	66,  // 95: stockchecker.v1.GetOfflineBundleResponse.availability:type_name -> stockchecker.v1.CurrentAvailability
+	239, // 96: stockchecker.v1.StockCheck.checked_at:type_name -> google.protobuf.Timestamp
+	131, // 97: stockchecker.v1.GetStockHistoryResponse.checks:type_name -> stockchecker.v1.StockCheck
+	239, // 98: stockchecker.v1.GetStockHistoryResponse.last_in_stock_at:type_name -> google.protobuf.Timestamp
+	239, // 99: stockchecker.v1.StockEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	133, // 100: stockchecker.v1.WatchStockResponse.events:type_name -> stockchecker.v1.StockEvent
+	9,   // 101: stockchecker.v1.CheckStoreNowResponse.store:type_name -> stockchecker.v1.Store
+	11,  // 102: stockchecker.v1.CheckStoreNowResponse.results:type_name -> stockchecker.v1.StockStatus
+	239, // 103: stockchecker.v1.CheckStoreNowResponse.checked_at:type_name -> google.protobuf.Timestamp
+	138, // 104: stockchecker.v1.GetMyLocationsResponse.locations:type_name -> stockchecker.v1.Location
+	138, // 105: stockchecker.v1.SetMyLocationRequest.location:type_name -> stockchecker.v1.Location
+	138, // 106: stockchecker.v1.SetMyLocationResponse.location:type_name -> stockchecker.v1.Location
+	239, // 107: stockchecker.v1.ProductWatch.created_at:type_name -> google.protobuf.Timestamp
+	150, // 108: stockchecker.v1.GetProductDomainResponse.presets:type_name -> stockchecker.v1.ProductPreset
+	147, // 109: stockchecker.v1.GetMyProductWatchesResponse.product_watches:type_name -> stockchecker.v1.ProductWatch
+	147, // 110: stockchecker.v1.WatchProductsResponse.product_watch:type_name -> stockchecker.v1.ProductWatch
+	239, // 111: stockchecker.v1.AllowedEmail.created_at:type_name -> google.protobuf.Timestamp
+	157, // 112: stockchecker.v1.AdminListAllowedEmailsResponse.allowed_emails:type_name -> stockchecker.v1.AllowedEmail
+	239, // 113: stockchecker.v1.AllowedDomain.created_at:type_name -> google.protobuf.Timestamp
+	164, // 114: stockchecker.v1.AdminListAllowedDomainsResponse.allowed_domains:type_name -> stockchecker.v1.AllowedDomain
+	239, // 115: stockchecker.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	239, // 116: stockchecker.v1.Invite.used_at:type_name -> google.protobuf.Timestamp
+	239, // 117: stockchecker.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	171, // 118: stockchecker.v1.AdminCreateInviteResponse.invite:type_name -> stockchecker.v1.Invite
+	171, // 119: stockchecker.v1.AdminListInvitesResponse.invites:type_name -> stockchecker.v1.Invite
+	12,  // 120: stockchecker.v1.AdminListUsersResponse.users:type_name -> stockchecker.v1.User
+	3,   // 121: stockchecker.v1.AdminSetUserRoleRequest.role:type_name -> stockchecker.v1.UserRole
+	12,  // 122: stockchecker.v1.AdminSetUserRoleResponse.user:type_name -> stockchecker.v1.User
+	239, // 123: stockchecker.v1.Credential.updated_at:type_name -> google.protobuf.Timestamp
+	182, // 124: stockchecker.v1.AdminListCredentialsResponse.credentials:type_name -> stockchecker.v1.Credential
+	182, // 125: stockchecker.v1.AdminSetCredentialResponse.credential:type_name -> stockchecker.v1.Credential
+	182, // 126: stockchecker.v1.AdminClearCredentialResponse.credential:type_name -> stockchecker.v1.Credential
+	189, // 127: stockchecker.v1.AdminGetApiCallStatsResponse.stats:type_name -> stockchecker.v1.ApiCallStats
+	239, // 128: stockchecker.v1.AdminGetApiCallStatsResponse.since:type_name -> google.protobuf.Timestamp
+	239, // 129: stockchecker.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	239, // 130: stockchecker.v1.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	192, // 131: stockchecker.v1.GetMyApiKeysResponse.api_keys:type_name -> stockchecker.v1.ApiKey
+	192, // 132: stockchecker.v1.CreateApiKeyResponse.api_key:type_name -> stockchecker.v1.ApiKey
+	239, // 133: stockchecker.v1.Session.created_at:type_name -> google.protobuf.Timestamp
+	239, // 134: stockchecker.v1.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	239, // 135: stockchecker.v1.Session.expires_at:type_name -> google.protobuf.Timestamp
+	199, // 136: stockchecker.v1.ListSessionsResponse.sessions:type_name -> stockchecker.v1.Session
+	12,  // 137: stockchecker.v1.GetClientBootstrapResponse.user:type_name -> stockchecker.v1.User
+	209, // 138: stockchecker.v1.GetClientBootstrapResponse.features:type_name -> stockchecker.v1.ClientFeatures
+	210, // 139: stockchecker.v1.GetClientBootstrapResponse.status:type_name -> stockchecker.v1.ServerStatus
+	211, // 140: stockchecker.v1.GetClientBootstrapResponse.channels:type_name -> stockchecker.v1.ChannelState
+	212, // 141: stockchecker.v1.GetClientBootstrapResponse.watchlist:type_name -> stockchecker.v1.WatchlistCounts
+	213, // 142: stockchecker.v1.GetClientBootstrapResponse.login_providers:type_name -> stockchecker.v1.LoginProvider
+	239, // 143: stockchecker.v1.ApiDeprecation.deprecated_at:type_name -> google.protobuf.Timestamp
+	239, // 144: stockchecker.v1.ApiDeprecation.sunset_at:type_name -> google.protobuf.Timestamp
+	239, // 145: stockchecker.v1.ApiChange.date:type_name -> google.protobuf.Timestamp
+	215, // 146: stockchecker.v1.GetApiInfoResponse.deprecations:type_name -> stockchecker.v1.ApiDeprecation
+	216, // 147: stockchecker.v1.GetApiInfoResponse.changelog:type_name -> stockchecker.v1.ApiChange
+	239, // 148: stockchecker.v1.UsageDay.day:type_name -> google.protobuf.Timestamp
+	219, // 149: stockchecker.v1.GetMyUsageResponse.days:type_name -> stockchecker.v1.UsageDay
+	219, // 150: stockchecker.v1.GetMyUsageResponse.totals:type_name -> stockchecker.v1.UsageDay
+	220, // 151: stockchecker.v1.GetMyUsageResponse.load:type_name -> stockchecker.v1.ApiLoad
+	239, // 152: stockchecker.v1.Drop.opens_at:type_name -> google.protobuf.Timestamp
+	239, // 153: stockchecker.v1.Drop.closes_at:type_name -> google.protobuf.Timestamp
+	239, // 154: stockchecker.v1.Drop.created_at:type_name -> google.protobuf.Timestamp
+	223, // 155: stockchecker.v1.UpcomingDropsResponse.drops:type_name -> stockchecker.v1.Drop
+	223, // 156: stockchecker.v1.AdminSaveDropRequest.drop:type_name -> stockchecker.v1.Drop
+	223, // 157: stockchecker.v1.AdminSaveDropResponse.drop:type_name -> stockchecker.v1.Drop
+	239, // 158: stockchecker.v1.Reminder.due_at:type_name -> google.protobuf.Timestamp
+	239, // 159: stockchecker.v1.Reminder.remind_at:type_name -> google.protobuf.Timestamp
+	239, // 160: stockchecker.v1.Reminder.sent_at:type_name -> google.protobuf.Timestamp
+	239, // 161: stockchecker.v1.Reminder.created_at:type_name -> google.protobuf.Timestamp
+	234, // 162: stockchecker.v1.ListMyRemindersResponse.reminders:type_name -> stockchecker.v1.Reminder
+	13,  // 163: stockchecker.v1.StockCheckerService.SearchStores:input_type -> stockchecker.v1.SearchStoresRequest
+	15,  // 164: stockchecker.v1.StockCheckerService.SearchProducts:input_type -> stockchecker.v1.SearchProductsRequest
+	17,  // 165: stockchecker.v1.StockCheckerService.CheckStock:input_type -> stockchecker.v1.CheckStockRequest
+	21,  // 166: stockchecker.v1.StockCheckerService.GetCurrentUser:input_type -> stockchecker.v1.GetCurrentUserRequest
+	23,  // 167: stockchecker.v1.StockCheckerService.SetMyLocale:input_type -> stockchecker.v1.SetMyLocaleRequest
+	25,  // 168: stockchecker.v1.StockCheckerService.GetMyStores:input_type -> stockchecker.v1.GetMyStoresRequest
+	27,  // 169: stockchecker.v1.StockCheckerService.AddMyStore:input_type -> stockchecker.v1.AddMyStoreRequest
+	29,  // 170: stockchecker.v1.StockCheckerService.RemoveMyStore:input_type -> stockchecker.v1.RemoveMyStoreRequest
+	31,  // 171: stockchecker.v1.StockCheckerService.GetMyProducts:input_type -> stockchecker.v1.GetMyProductsRequest
+	33,  // 172: stockchecker.v1.StockCheckerService.AddMyProduct:input_type -> stockchecker.v1.AddMyProductRequest
+	69,  // 173: stockchecker.v1.StockCheckerService.UpdateMyProduct:input_type -> stockchecker.v1.UpdateMyProductRequest
+	36,  // 174: stockchecker.v1.StockCheckerService.RemoveMyProduct:input_type -> stockchecker.v1.RemoveMyProductRequest
+	38,  // 175: stockchecker.v1.StockCheckerService.RemoveMyProducts:input_type -> stockchecker.v1.RemoveMyProductsRequest
+	40,  // 176: stockchecker.v1.StockCheckerService.ClearWatchlist:input_type -> stockchecker.v1.ClearWatchlistRequest
+	42,  // 177: stockchecker.v1.StockCheckerService.ImportMyProducts:input_type -> stockchecker.v1.ImportMyProductsRequest
+	44,  // 178: stockchecker.v1.StockCheckerService.BrowsePokemonProducts:input_type -> stockchecker.v1.BrowsePokemonProductsRequest
+	72,  // 179: stockchecker.v1.StockCheckerService.GetNotificationPreferences:input_type -> stockchecker.v1.GetNotificationPreferencesRequest
+	74,  // 180: stockchecker.v1.StockCheckerService.UpdateNotificationPreferences:input_type -> stockchecker.v1.UpdateNotificationPreferencesRequest
+	77,  // 181: stockchecker.v1.StockCheckerService.GetAlertRules:input_type -> stockchecker.v1.GetAlertRulesRequest
+	79,  // 182: stockchecker.v1.StockCheckerService.UpdateAlertRule:input_type -> stockchecker.v1.UpdateAlertRuleRequest
+	54,  // 183: stockchecker.v1.StockCheckerService.GetNotificationTemplates:input_type -> stockchecker.v1.GetNotificationTemplatesRequest
+	56,  // 184: stockchecker.v1.StockCheckerService.SetNotificationTemplate:input_type -> stockchecker.v1.SetNotificationTemplateRequest
+	58,  // 185: stockchecker.v1.StockCheckerService.DeleteNotificationTemplate:input_type -> stockchecker.v1.DeleteNotificationTemplateRequest
+	47,  // 186: stockchecker.v1.StockCheckerService.GetNotificationChannels:input_type -> stockchecker.v1.GetNotificationChannelsRequest
+	49,  // 187: stockchecker.v1.StockCheckerService.SetNotificationChannel:input_type -> stockchecker.v1.SetNotificationChannelRequest
+	51,  // 188: stockchecker.v1.StockCheckerService.DeleteNotificationChannel:input_type -> stockchecker.v1.DeleteNotificationChannelRequest
+	60,  // 189: stockchecker.v1.StockCheckerService.SendTestNotification:input_type -> stockchecker.v1.SendTestNotificationRequest
+	62,  // 190: stockchecker.v1.StockCheckerService.SimulateWatcherCycle:input_type -> stockchecker.v1.SimulateWatcherCycleRequest
+	65,  // 191: stockchecker.v1.StockCheckerService.GetMyDashboard:input_type -> stockchecker.v1.GetMyDashboardRequest
+	139, // 192: stockchecker.v1.StockCheckerService.GetMyLocations:input_type -> stockchecker.v1.GetMyLocationsRequest
+	141, // 193: stockchecker.v1.StockCheckerService.SetMyLocation:input_type -> stockchecker.v1.SetMyLocationRequest
+	143, // 194: stockchecker.v1.StockCheckerService.DeleteMyLocation:input_type -> stockchecker.v1.DeleteMyLocationRequest
+	145, // 195: stockchecker.v1.StockCheckerService.GetProductBarcode:input_type -> stockchecker.v1.GetProductBarcodeRequest
+	136, // 196: stockchecker.v1.StockCheckerService.CheckStoreNow:input_type -> stockchecker.v1.CheckStoreNowRequest
+	130, // 197: stockchecker.v1.StockCheckerService.GetStockHistory:input_type -> stockchecker.v1.GetStockHistoryRequest
+	134, // 198: stockchecker.v1.StockCheckerService.WatchStock:input_type -> stockchecker.v1.WatchStockRequest
+	128, // 199: stockchecker.v1.StockCheckerService.GetOfflineBundle:input_type -> stockchecker.v1.GetOfflineBundleRequest
+	81,  // 200: stockchecker.v1.StockCheckerService.SyncChanges:input_type -> stockchecker.v1.SyncChangesRequest
+	85,  // 201: stockchecker.v1.StockCheckerService.ListWatchlistChanges:input_type -> stockchecker.v1.ListWatchlistChangesRequest
+	87,  // 202: stockchecker.v1.StockCheckerService.UndoLastChange:input_type -> stockchecker.v1.UndoLastChangeRequest
+	96,  // 203: stockchecker.v1.StockCheckerService.GetProductDetails:input_type -> stockchecker.v1.GetProductDetailsRequest
+	92,  // 204: stockchecker.v1.StockCheckerService.ListMsrps:input_type -> stockchecker.v1.ListMsrpsRequest
+	94,  // 205: stockchecker.v1.StockCheckerService.SetMsrp:input_type -> stockchecker.v1.SetMsrpRequest
+	98,  // 206: stockchecker.v1.StockCheckerService.GetMySetWatches:input_type -> stockchecker.v1.GetMySetWatchesRequest
+	100, // 207: stockchecker.v1.StockCheckerService.WatchSet:input_type -> stockchecker.v1.WatchSetRequest
+	102, // 208: stockchecker.v1.StockCheckerService.UnwatchSet:input_type -> stockchecker.v1.UnwatchSetRequest
+	105, // 209: stockchecker.v1.StockCheckerService.MarkPurchased:input_type -> stockchecker.v1.MarkPurchasedRequest
+	107, // 210: stockchecker.v1.StockCheckerService.GetMyAcquisitions:input_type -> stockchecker.v1.GetMyAcquisitionsRequest
+	109, // 211: stockchecker.v1.StockCheckerService.DeleteAcquisition:input_type -> stockchecker.v1.DeleteAcquisitionRequest
+	112, // 212: stockchecker.v1.StockCheckerService.GetAcquisitionSummary:input_type -> stockchecker.v1.GetAcquisitionSummaryRequest
+	114, // 213: stockchecker.v1.StockCheckerService.ConfirmStock:input_type -> stockchecker.v1.ConfirmStockRequest
+	116, // 214: stockchecker.v1.StockCheckerService.GetStoreReliability:input_type -> stockchecker.v1.GetStoreReliabilityRequest
+	119, // 215: stockchecker.v1.StockCheckerService.ReportSighting:input_type -> stockchecker.v1.ReportSightingRequest
+	121, // 216: stockchecker.v1.StockCheckerService.ListSightings:input_type -> stockchecker.v1.ListSightingsRequest
+	123, // 217: stockchecker.v1.StockCheckerService.GetSightingPhoto:input_type -> stockchecker.v1.GetSightingPhotoRequest
+	125, // 218: stockchecker.v1.StockCheckerService.ModerateSighting:input_type -> stockchecker.v1.ModerateSightingRequest
+	148, // 219: stockchecker.v1.StockCheckerService.GetProductDomain:input_type -> stockchecker.v1.GetProductDomainRequest
+	151, // 220: stockchecker.v1.StockCheckerService.GetMyProductWatches:input_type -> stockchecker.v1.GetMyProductWatchesRequest
+	153, // 221: stockchecker.v1.StockCheckerService.WatchProducts:input_type -> stockchecker.v1.WatchProductsRequest
+	155, // 222: stockchecker.v1.StockCheckerService.UnwatchProducts:input_type -> stockchecker.v1.UnwatchProductsRequest
+	158, // 223: stockchecker.v1.StockCheckerService.AdminAddAllowedEmail:input_type -> stockchecker.v1.AdminAddAllowedEmailRequest
+	160, // 224: stockchecker.v1.StockCheckerService.AdminRemoveAllowedEmail:input_type -> stockchecker.v1.AdminRemoveAllowedEmailRequest
+	162, // 225: stockchecker.v1.StockCheckerService.AdminListAllowedEmails:input_type -> stockchecker.v1.AdminListAllowedEmailsRequest
+	165, // 226: stockchecker.v1.StockCheckerService.AdminAddAllowedDomain:input_type -> stockchecker.v1.AdminAddAllowedDomainRequest
+	167, // 227: stockchecker.v1.StockCheckerService.AdminRemoveAllowedDomain:input_type -> stockchecker.v1.AdminRemoveAllowedDomainRequest
+	169, // 228: stockchecker.v1.StockCheckerService.AdminListAllowedDomains:input_type -> stockchecker.v1.AdminListAllowedDomainsRequest
+	172, // 229: stockchecker.v1.StockCheckerService.AdminCreateInvite:input_type -> stockchecker.v1.AdminCreateInviteRequest
+	174, // 230: stockchecker.v1.StockCheckerService.AdminListInvites:input_type -> stockchecker.v1.AdminListInvitesRequest
+	176, // 231: stockchecker.v1.StockCheckerService.AdminRevokeInvite:input_type -> stockchecker.v1.AdminRevokeInviteRequest
+	178, // 232: stockchecker.v1.StockCheckerService.AdminListUsers:input_type -> stockchecker.v1.AdminListUsersRequest
+	180, // 233: stockchecker.v1.StockCheckerService.AdminSetUserRole:input_type -> stockchecker.v1.AdminSetUserRoleRequest
+	183, // 234: stockchecker.v1.StockCheckerService.AdminListCredentials:input_type -> stockchecker.v1.AdminListCredentialsRequest
+	185, // 235: stockchecker.v1.StockCheckerService.AdminSetCredential:input_type -> stockchecker.v1.AdminSetCredentialRequest
+	187, // 236: stockchecker.v1.StockCheckerService.AdminClearCredential:input_type -> stockchecker.v1.AdminClearCredentialRequest
+	190, // 237: stockchecker.v1.StockCheckerService.AdminGetApiCallStats:input_type -> stockchecker.v1.AdminGetApiCallStatsRequest
+	193, // 238: stockchecker.v1.StockCheckerService.GetMyApiKeys:input_type -> stockchecker.v1.GetMyApiKeysRequest
+	195, // 239: stockchecker.v1.StockCheckerService.CreateApiKey:input_type -> stockchecker.v1.CreateApiKeyRequest
+	197, // 240: stockchecker.v1.StockCheckerService.RevokeApiKey:input_type -> stockchecker.v1.RevokeApiKeyRequest
+	200, // 241: stockchecker.v1.StockCheckerService.ListSessions:input_type -> stockchecker.v1.ListSessionsRequest
+	202, // 242: stockchecker.v1.StockCheckerService.RevokeSession:input_type -> stockchecker.v1.RevokeSessionRequest
+	204, // 243: stockchecker.v1.StockCheckerService.ExportMyData:input_type -> stockchecker.v1.ExportMyDataRequest
+	206, // 244: stockchecker.v1.StockCheckerService.DeleteMyAccount:input_type -> stockchecker.v1.DeleteMyAccountRequest
+	208, // 245: stockchecker.v1.StockCheckerService.GetClientBootstrap:input_type -> stockchecker.v1.GetClientBootstrapRequest
+	217, // 246: stockchecker.v1.StockCheckerService.GetApiInfo:input_type -> stockchecker.v1.GetApiInfoRequest
+	221, // 247: stockchecker.v1.StockCheckerService.GetMyUsage:input_type -> stockchecker.v1.GetMyUsageRequest
+	224, // 248: stockchecker.v1.StockCheckerService.UpcomingDrops:input_type -> stockchecker.v1.UpcomingDropsRequest
+	226, // 249: stockchecker.v1.StockCheckerService.AdminSaveDrop:input_type -> stockchecker.v1.AdminSaveDropRequest
+	228, // 250: stockchecker.v1.StockCheckerService.AdminDeleteDrop:input_type -> stockchecker.v1.AdminDeleteDropRequest
+	230, // 251: stockchecker.v1.StockCheckerService.GetMyInboundEmail:input_type -> stockchecker.v1.GetMyInboundEmailRequest
+	232, // 252: stockchecker.v1.StockCheckerService.ResetMyInboundEmail:input_type -> stockchecker.v1.ResetMyInboundEmailRequest
+	235, // 253: stockchecker.v1.StockCheckerService.ListMyReminders:input_type -> stockchecker.v1.ListMyRemindersRequest
+	237, // 254: stockchecker.v1.StockCheckerService.DeleteMyReminder:input_type -> stockchecker.v1.DeleteMyReminderRequest
+	14,  // 255: stockchecker.v1.StockCheckerService.SearchStores:output_type -> stockchecker.v1.SearchStoresResponse
+	16,  // 256: stockchecker.v1.StockCheckerService.SearchProducts:output_type -> stockchecker.v1.SearchProductsResponse
+	20,  // 257: stockchecker.v1.StockCheckerService.CheckStock:output_type -> stockchecker.v1.CheckStockResponse
+	22,  // 258: stockchecker.v1.StockCheckerService.GetCurrentUser:output_type -> stockchecker.v1.GetCurrentUserResponse
+	24,  // 259: stockchecker.v1.StockCheckerService.SetMyLocale:output_type -> stockchecker.v1.SetMyLocaleResponse
+	26,  // 260: stockchecker.v1.StockCheckerService.GetMyStores:output_type -> stockchecker.v1.GetMyStoresResponse
+	28,  // 261: stockchecker.v1.StockCheckerService.AddMyStore:output_type -> stockchecker.v1.AddMyStoreResponse
+	30,  // 262: stockchecker.v1.StockCheckerService.RemoveMyStore:output_type -> stockchecker.v1.RemoveMyStoreResponse
+	32,  // 263: stockchecker.v1.StockCheckerService.GetMyProducts:output_type -> stockchecker.v1.GetMyProductsResponse
+	35,  // 264: stockchecker.v1.StockCheckerService.AddMyProduct:output_type -> stockchecker.v1.AddMyProductResponse
+	70,  // 265: stockchecker.v1.StockCheckerService.UpdateMyProduct:output_type -> stockchecker.v1.UpdateMyProductResponse
+	37,  // 266: stockchecker.v1.StockCheckerService.RemoveMyProduct:output_type -> stockchecker.v1.RemoveMyProductResponse
+	39,  // 267: stockchecker.v1.StockCheckerService.RemoveMyProducts:output_type -> stockchecker.v1.RemoveMyProductsResponse
+	41,  // 268: stockchecker.v1.StockCheckerService.ClearWatchlist:output_type -> stockchecker.v1.ClearWatchlistResponse
+	43,  // 269: stockchecker.v1.StockCheckerService.ImportMyProducts:output_type -> stockchecker.v1.ImportMyProductsResponse
+	45,  // 270: stockchecker.v1.StockCheckerService.BrowsePokemonProducts:output_type -> stockchecker.v1.BrowsePokemonProductsResponse
+	73,  // 271: stockchecker.v1.StockCheckerService.GetNotificationPreferences:output_type -> stockchecker.v1.GetNotificationPreferencesResponse
+	75,  // 272: stockchecker.v1.StockCheckerService.UpdateNotificationPreferences:output_type -> stockchecker.v1.UpdateNotificationPreferencesResponse
+	78,  // 273: stockchecker.v1.StockCheckerService.GetAlertRules:output_type -> stockchecker.v1.GetAlertRulesResponse
+	80,  // 274: stockchecker.v1.StockCheckerService.UpdateAlertRule:output_type -> stockchecker.v1.UpdateAlertRuleResponse
+	55,  // 275: stockchecker.v1.StockCheckerService.GetNotificationTemplates:output_type -> stockchecker.v1.GetNotificationTemplatesResponse
+	57,  // 276: stockchecker.v1.StockCheckerService.SetNotificationTemplate:output_type -> stockchecker.v1.SetNotificationTemplateResponse
+	59,  // 277: stockchecker.v1.StockCheckerService.DeleteNotificationTemplate:output_type -> stockchecker.v1.DeleteNotificationTemplateResponse
+	48,  // 278: stockchecker.v1.StockCheckerService.GetNotificationChannels:output_type -> stockchecker.v1.GetNotificationChannelsResponse
+	50,  // 279: stockchecker.v1.StockCheckerService.SetNotificationChannel:output_type -> stockchecker.v1.SetNotificationChannelResponse
+	52,  // 280: stockchecker.v1.StockCheckerService.DeleteNotificationChannel:output_type -> stockchecker.v1.DeleteNotificationChannelResponse
+	61,  // 281: stockchecker.v1.StockCheckerService.SendTestNotification:output_type -> stockchecker.v1.SendTestNotificationResponse
+	64,  // 282: stockchecker.v1.StockCheckerService.SimulateWatcherCycle:output_type -> stockchecker.v1.SimulateWatcherCycleResponse
+	68,  // 283: stockchecker.v1.StockCheckerService.GetMyDashboard:output_type -> stockchecker.v1.GetMyDashboardResponse
+	140, // 284: stockchecker.v1.StockCheckerService.GetMyLocations:output_type -> stockchecker.v1.GetMyLocationsResponse
+	142, // 285: stockchecker.v1.StockCheckerService.SetMyLocation:output_type -> stockchecker.v1.SetMyLocationResponse
+	144, // 286: stockchecker.v1.StockCheckerService.DeleteMyLocation:output_type -> stockchecker.v1.DeleteMyLocationResponse
+	146, // 287: stockchecker.v1.StockCheckerService.GetProductBarcode:output_type -> stockchecker.v1.GetProductBarcodeResponse
+	137, // 288: stockchecker.v1.StockCheckerService.CheckStoreNow:output_type -> stockchecker.v1.CheckStoreNowResponse
+	132, // 289: stockchecker.v1.StockCheckerService.GetStockHistory:output_type -> stockchecker.v1.GetStockHistoryResponse
+	135, // 290: stockchecker.v1.StockCheckerService.WatchStock:output_type -> stockchecker.v1.WatchStockResponse
+	129, // 291: stockchecker.v1.StockCheckerService.GetOfflineBundle:output_type -> stockchecker.v1.GetOfflineBundleResponse
+	83,  // 292: stockchecker.v1.StockCheckerService.SyncChanges:output_type -> stockchecker.v1.SyncChangesResponse
+	86,  // 293: stockchecker.v1.StockCheckerService.ListWatchlistChanges:output_type -> stockchecker.v1.ListWatchlistChangesResponse
+	88,  // 294: stockchecker.v1.StockCheckerService.UndoLastChange:output_type -> stockchecker.v1.UndoLastChangeResponse
+	97,  // 295: stockchecker.v1.StockCheckerService.GetProductDetails:output_type -> stockchecker.v1.GetProductDetailsResponse
+	93,  // 296: stockchecker.v1.StockCheckerService.ListMsrps:output_type -> stockchecker.v1.ListMsrpsResponse
+	95,  // 297: stockchecker.v1.StockCheckerService.SetMsrp:output_type -> stockchecker.v1.SetMsrpResponse
+	99,  // 298: stockchecker.v1.StockCheckerService.GetMySetWatches:output_type -> stockchecker.v1.GetMySetWatchesResponse
+	101, // 299: stockchecker.v1.StockCheckerService.WatchSet:output_type -> stockchecker.v1.WatchSetResponse
+	103, // 300: stockchecker.v1.StockCheckerService.UnwatchSet:output_type -> stockchecker.v1.UnwatchSetResponse
+	106, // 301: stockchecker.v1.StockCheckerService.MarkPurchased:output_type -> stockchecker.v1.MarkPurchasedResponse
+	108, // 302: stockchecker.v1.StockCheckerService.GetMyAcquisitions:output_type -> stockchecker.v1.GetMyAcquisitionsResponse
+	110, // 303: stockchecker.v1.StockCheckerService.DeleteAcquisition:output_type -> stockchecker.v1.DeleteAcquisitionResponse
+	127, // 304: stockchecker.v1.StockCheckerService.GetAcquisitionSummary:output_type -> stockchecker.v1.GetAcquisitionSummaryResponse
+	115, // 305: stockchecker.v1.StockCheckerService.ConfirmStock:output_type -> stockchecker.v1.ConfirmStockResponse
+	117, // 306: stockchecker.v1.StockCheckerService.GetStoreReliability:output_type -> stockchecker.v1.GetStoreReliabilityResponse
+	120, // 307: stockchecker.v1.StockCheckerService.ReportSighting:output_type -> stockchecker.v1.ReportSightingResponse
+	122, // 308: stockchecker.v1.StockCheckerService.ListSightings:output_type -> stockchecker.v1.ListSightingsResponse
+	124, // 309: stockchecker.v1.StockCheckerService.GetSightingPhoto:output_type -> stockchecker.v1.GetSightingPhotoResponse
+	126, // 310: stockchecker.v1.StockCheckerService.ModerateSighting:output_type -> stockchecker.v1.ModerateSightingResponse
+	149, // 311: stockchecker.v1.StockCheckerService.GetProductDomain:output_type -> stockchecker.v1.GetProductDomainResponse
+	152, // 312: stockchecker.v1.StockCheckerService.GetMyProductWatches:output_type -> stockchecker.v1.GetMyProductWatchesResponse
+	154, // 313: stockchecker.v1.StockCheckerService.WatchProducts:output_type -> stockchecker.v1.WatchProductsResponse
+	156, // 314: stockchecker.v1.StockCheckerService.UnwatchProducts:output_type -> stockchecker.v1.UnwatchProductsResponse
+	159, // 315: stockchecker.v1.StockCheckerService.AdminAddAllowedEmail:output_type -> stockchecker.v1.AdminAddAllowedEmailResponse
+	161, // 316: stockchecker.v1.StockCheckerService.AdminRemoveAllowedEmail:output_type -> stockchecker.v1.AdminRemoveAllowedEmailResponse
+	163, // 317: stockchecker.v1.StockCheckerService.AdminListAllowedEmails:output_type -> stockchecker.v1.AdminListAllowedEmailsResponse
+	166, // 318: stockchecker.v1.StockCheckerService.AdminAddAllowedDomain:output_type -> stockchecker.v1.AdminAddAllowedDomainResponse
+	168, // 319: stockchecker.v1.StockCheckerService.AdminRemoveAllowedDomain:output_type -> stockchecker.v1.AdminRemoveAllowedDomainResponse
+	170, // 320: stockchecker.v1.StockCheckerService.AdminListAllowedDomains:output_type -> stockchecker.v1.AdminListAllowedDomainsResponse
+	173, // 321: stockchecker.v1.StockCheckerService.AdminCreateInvite:output_type -> stockchecker.v1.AdminCreateInviteResponse
+	175, // 322: stockchecker.v1.StockCheckerService.AdminListInvites:output_type -> stockchecker.v1.AdminListInvitesResponse
+	177, // 323: stockchecker.v1.StockCheckerService.AdminRevokeInvite:output_type -> stockchecker.v1.AdminRevokeInviteResponse
+	179, // 324: stockchecker.v1.StockCheckerService.AdminListUsers:output_type -> stockchecker.v1.AdminListUsersResponse
+	181, // 325: stockchecker.v1.StockCheckerService.AdminSetUserRole:output_type -> stockchecker.v1.AdminSetUserRoleResponse
+	184, // 326: stockchecker.v1.StockCheckerService.AdminListCredentials:output_type -> stockchecker.v1.AdminListCredentialsResponse
+	186, // 327: stockchecker.v1.StockCheckerService.AdminSetCredential:output_type -> stockchecker.v1.AdminSetCredentialResponse
+	188, // 328: stockchecker.v1.StockCheckerService.AdminClearCredential:output_type -> stockchecker.v1.AdminClearCredentialResponse
+	191, // 329: stockchecker.v1.StockCheckerService.AdminGetApiCallStats:output_type -> stockchecker.v1.AdminGetApiCallStatsResponse
+	194, // 330: stockchecker.v1.StockCheckerService.GetMyApiKeys:output_type -> stockchecker.v1.GetMyApiKeysResponse
+	196, // 331: stockchecker.v1.StockCheckerService.CreateApiKey:output_type -> stockchecker.v1.CreateApiKeyResponse
+	198, // 332: stockchecker.v1.StockCheckerService.RevokeApiKey:output_type -> stockchecker.v1.RevokeApiKeyResponse
+	201, // 333: stockchecker.v1.StockCheckerService.ListSessions:output_type -> stockchecker.v1.ListSessionsResponse
+	203, // 334: stockchecker.v1.StockCheckerService.RevokeSession:output_type -> stockchecker.v1.RevokeSessionResponse
+	205, // 335: stockchecker.v1.StockCheckerService.ExportMyData:output_type -> stockchecker.v1.ExportMyDataResponse
+	207, // 336: stockchecker.v1.StockCheckerService.DeleteMyAccount:output_type -> stockchecker.v1.DeleteMyAccountResponse
+	214, // 337: stockchecker.v1.StockCheckerService.GetClientBootstrap:output_type -> stockchecker.v1.GetClientBootstrapResponse
+	218, // 338: stockchecker.v1.StockCheckerService.GetApiInfo:output_type -> stockchecker.v1.GetApiInfoResponse
+	222, // 339: stockchecker.v1.StockCheckerService.GetMyUsage:output_type -> stockchecker.v1.GetMyUsageResponse
+	225, // 340: stockchecker.v1.StockCheckerService.UpcomingDrops:output_type -> stockchecker.v1.UpcomingDropsResponse
+	227, // 341: stockchecker.v1.StockCheckerService.AdminSaveDrop:output_type -> stockchecker.v1.AdminSaveDropResponse
+	229, // 342: stockchecker.v1.StockCheckerService.AdminDeleteDrop:output_type -> stockchecker.v1.AdminDeleteDropResponse
+	231, // 343: stockchecker.v1.StockCheckerService.GetMyInboundEmail:output_type -> stockchecker.v1.GetMyInboundEmailResponse
+	233, // 344: stockchecker.v1.StockCheckerService.ResetMyInboundEmail:output_type -> stockchecker.v1.ResetMyInboundEmailResponse
+	236, // 345: stockchecker.v1.StockCheckerService.ListMyReminders:output_type -> stockchecker.v1.ListMyRemindersResponse
+	238, // 346: stockchecker.v1.StockCheckerService.DeleteMyReminder:output_type -> stockchecker.v1.DeleteMyReminderResponse
+	255, // [255:347] is the sub-list for method output_type
+	163, // [163:255] is the sub-list for method input_type
+	163, // [163:163] is the sub-list for extension type_name
+	163, // [163:163] is the sub-list for extension extendee
+	0,   // [0:163] is the sub-list for field type_name
 }
 
 func init() { file_stockchecker_v1_service_proto_init() }
@@ -1497,13 +14744,14 @@ func file_stockchecker_v1_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stockchecker_v1_service_proto_rawDesc), len(file_stockchecker_v1_service_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   26,
+			NumEnums:      9,
+			NumMessages:   230,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_stockchecker_v1_service_proto_goTypes,
 		DependencyIndexes: file_stockchecker_v1_service_proto_depIdxs,
+		EnumInfos:         file_stockchecker_v1_service_proto_enumTypes,
 		MessageInfos:      file_stockchecker_v1_service_proto_msgTypes,
 	}.Build()
 	File_stockchecker_v1_service_proto = out.File
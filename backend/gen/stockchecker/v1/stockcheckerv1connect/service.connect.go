@@ -45,6 +45,9 @@ const (
 	// StockCheckerServiceGetCurrentUserProcedure is the fully-qualified name of the
 	// StockCheckerService's GetCurrentUser RPC.
 	StockCheckerServiceGetCurrentUserProcedure = "/stockchecker.v1.StockCheckerService/GetCurrentUser"
+	// StockCheckerServiceSetMyLocaleProcedure is the fully-qualified name of the StockCheckerService's
+	// SetMyLocale RPC.
+	StockCheckerServiceSetMyLocaleProcedure = "/stockchecker.v1.StockCheckerService/SetMyLocale"
 	// StockCheckerServiceGetMyStoresProcedure is the fully-qualified name of the StockCheckerService's
 	// GetMyStores RPC.
 	StockCheckerServiceGetMyStoresProcedure = "/stockchecker.v1.StockCheckerService/GetMyStores"
@@ -60,12 +63,252 @@ const (
 	// StockCheckerServiceAddMyProductProcedure is the fully-qualified name of the StockCheckerService's
 	// AddMyProduct RPC.
 	StockCheckerServiceAddMyProductProcedure = "/stockchecker.v1.StockCheckerService/AddMyProduct"
+	// StockCheckerServiceUpdateMyProductProcedure is the fully-qualified name of the
+	// StockCheckerService's UpdateMyProduct RPC.
+	StockCheckerServiceUpdateMyProductProcedure = "/stockchecker.v1.StockCheckerService/UpdateMyProduct"
 	// StockCheckerServiceRemoveMyProductProcedure is the fully-qualified name of the
 	// StockCheckerService's RemoveMyProduct RPC.
 	StockCheckerServiceRemoveMyProductProcedure = "/stockchecker.v1.StockCheckerService/RemoveMyProduct"
+	// StockCheckerServiceRemoveMyProductsProcedure is the fully-qualified name of the
+	// StockCheckerService's RemoveMyProducts RPC.
+	StockCheckerServiceRemoveMyProductsProcedure = "/stockchecker.v1.StockCheckerService/RemoveMyProducts"
+	// StockCheckerServiceClearWatchlistProcedure is the fully-qualified name of the
+	// StockCheckerService's ClearWatchlist RPC.
+	StockCheckerServiceClearWatchlistProcedure = "/stockchecker.v1.StockCheckerService/ClearWatchlist"
+	// StockCheckerServiceImportMyProductsProcedure is the fully-qualified name of the
+	// StockCheckerService's ImportMyProducts RPC.
+	StockCheckerServiceImportMyProductsProcedure = "/stockchecker.v1.StockCheckerService/ImportMyProducts"
 	// StockCheckerServiceBrowsePokemonProductsProcedure is the fully-qualified name of the
 	// StockCheckerService's BrowsePokemonProducts RPC.
 	StockCheckerServiceBrowsePokemonProductsProcedure = "/stockchecker.v1.StockCheckerService/BrowsePokemonProducts"
+	// StockCheckerServiceGetNotificationPreferencesProcedure is the fully-qualified name of the
+	// StockCheckerService's GetNotificationPreferences RPC.
+	StockCheckerServiceGetNotificationPreferencesProcedure = "/stockchecker.v1.StockCheckerService/GetNotificationPreferences"
+	// StockCheckerServiceUpdateNotificationPreferencesProcedure is the fully-qualified name of the
+	// StockCheckerService's UpdateNotificationPreferences RPC.
+	StockCheckerServiceUpdateNotificationPreferencesProcedure = "/stockchecker.v1.StockCheckerService/UpdateNotificationPreferences"
+	// StockCheckerServiceGetAlertRulesProcedure is the fully-qualified name of the
+	// StockCheckerService's GetAlertRules RPC.
+	StockCheckerServiceGetAlertRulesProcedure = "/stockchecker.v1.StockCheckerService/GetAlertRules"
+	// StockCheckerServiceUpdateAlertRuleProcedure is the fully-qualified name of the
+	// StockCheckerService's UpdateAlertRule RPC.
+	StockCheckerServiceUpdateAlertRuleProcedure = "/stockchecker.v1.StockCheckerService/UpdateAlertRule"
+	// StockCheckerServiceGetNotificationTemplatesProcedure is the fully-qualified name of the
+	// StockCheckerService's GetNotificationTemplates RPC.
+	StockCheckerServiceGetNotificationTemplatesProcedure = "/stockchecker.v1.StockCheckerService/GetNotificationTemplates"
+	// StockCheckerServiceSetNotificationTemplateProcedure is the fully-qualified name of the
+	// StockCheckerService's SetNotificationTemplate RPC.
+	StockCheckerServiceSetNotificationTemplateProcedure = "/stockchecker.v1.StockCheckerService/SetNotificationTemplate"
+	// StockCheckerServiceDeleteNotificationTemplateProcedure is the fully-qualified name of the
+	// StockCheckerService's DeleteNotificationTemplate RPC.
+	StockCheckerServiceDeleteNotificationTemplateProcedure = "/stockchecker.v1.StockCheckerService/DeleteNotificationTemplate"
+	// StockCheckerServiceGetNotificationChannelsProcedure is the fully-qualified name of the
+	// StockCheckerService's GetNotificationChannels RPC.
+	StockCheckerServiceGetNotificationChannelsProcedure = "/stockchecker.v1.StockCheckerService/GetNotificationChannels"
+	// StockCheckerServiceSetNotificationChannelProcedure is the fully-qualified name of the
+	// StockCheckerService's SetNotificationChannel RPC.
+	StockCheckerServiceSetNotificationChannelProcedure = "/stockchecker.v1.StockCheckerService/SetNotificationChannel"
+	// StockCheckerServiceDeleteNotificationChannelProcedure is the fully-qualified name of the
+	// StockCheckerService's DeleteNotificationChannel RPC.
+	StockCheckerServiceDeleteNotificationChannelProcedure = "/stockchecker.v1.StockCheckerService/DeleteNotificationChannel"
+	// StockCheckerServiceSendTestNotificationProcedure is the fully-qualified name of the
+	// StockCheckerService's SendTestNotification RPC.
+	StockCheckerServiceSendTestNotificationProcedure = "/stockchecker.v1.StockCheckerService/SendTestNotification"
+	// StockCheckerServiceSimulateWatcherCycleProcedure is the fully-qualified name of the
+	// StockCheckerService's SimulateWatcherCycle RPC.
+	StockCheckerServiceSimulateWatcherCycleProcedure = "/stockchecker.v1.StockCheckerService/SimulateWatcherCycle"
+	// StockCheckerServiceGetMyDashboardProcedure is the fully-qualified name of the
+	// StockCheckerService's GetMyDashboard RPC.
+	StockCheckerServiceGetMyDashboardProcedure = "/stockchecker.v1.StockCheckerService/GetMyDashboard"
+	// StockCheckerServiceGetMyLocationsProcedure is the fully-qualified name of the
+	// StockCheckerService's GetMyLocations RPC.
+	StockCheckerServiceGetMyLocationsProcedure = "/stockchecker.v1.StockCheckerService/GetMyLocations"
+	// StockCheckerServiceSetMyLocationProcedure is the fully-qualified name of the
+	// StockCheckerService's SetMyLocation RPC.
+	StockCheckerServiceSetMyLocationProcedure = "/stockchecker.v1.StockCheckerService/SetMyLocation"
+	// StockCheckerServiceDeleteMyLocationProcedure is the fully-qualified name of the
+	// StockCheckerService's DeleteMyLocation RPC.
+	StockCheckerServiceDeleteMyLocationProcedure = "/stockchecker.v1.StockCheckerService/DeleteMyLocation"
+	// StockCheckerServiceGetProductBarcodeProcedure is the fully-qualified name of the
+	// StockCheckerService's GetProductBarcode RPC.
+	StockCheckerServiceGetProductBarcodeProcedure = "/stockchecker.v1.StockCheckerService/GetProductBarcode"
+	// StockCheckerServiceCheckStoreNowProcedure is the fully-qualified name of the
+	// StockCheckerService's CheckStoreNow RPC.
+	StockCheckerServiceCheckStoreNowProcedure = "/stockchecker.v1.StockCheckerService/CheckStoreNow"
+	// StockCheckerServiceGetStockHistoryProcedure is the fully-qualified name of the
+	// StockCheckerService's GetStockHistory RPC.
+	StockCheckerServiceGetStockHistoryProcedure = "/stockchecker.v1.StockCheckerService/GetStockHistory"
+	// StockCheckerServiceWatchStockProcedure is the fully-qualified name of the StockCheckerService's
+	// WatchStock RPC.
+	StockCheckerServiceWatchStockProcedure = "/stockchecker.v1.StockCheckerService/WatchStock"
+	// StockCheckerServiceGetOfflineBundleProcedure is the fully-qualified name of the
+	// StockCheckerService's GetOfflineBundle RPC.
+	StockCheckerServiceGetOfflineBundleProcedure = "/stockchecker.v1.StockCheckerService/GetOfflineBundle"
+	// StockCheckerServiceSyncChangesProcedure is the fully-qualified name of the StockCheckerService's
+	// SyncChanges RPC.
+	StockCheckerServiceSyncChangesProcedure = "/stockchecker.v1.StockCheckerService/SyncChanges"
+	// StockCheckerServiceListWatchlistChangesProcedure is the fully-qualified name of the
+	// StockCheckerService's ListWatchlistChanges RPC.
+	StockCheckerServiceListWatchlistChangesProcedure = "/stockchecker.v1.StockCheckerService/ListWatchlistChanges"
+	// StockCheckerServiceUndoLastChangeProcedure is the fully-qualified name of the
+	// StockCheckerService's UndoLastChange RPC.
+	StockCheckerServiceUndoLastChangeProcedure = "/stockchecker.v1.StockCheckerService/UndoLastChange"
+	// StockCheckerServiceGetProductDetailsProcedure is the fully-qualified name of the
+	// StockCheckerService's GetProductDetails RPC.
+	StockCheckerServiceGetProductDetailsProcedure = "/stockchecker.v1.StockCheckerService/GetProductDetails"
+	// StockCheckerServiceListMsrpsProcedure is the fully-qualified name of the StockCheckerService's
+	// ListMsrps RPC.
+	StockCheckerServiceListMsrpsProcedure = "/stockchecker.v1.StockCheckerService/ListMsrps"
+	// StockCheckerServiceSetMsrpProcedure is the fully-qualified name of the StockCheckerService's
+	// SetMsrp RPC.
+	StockCheckerServiceSetMsrpProcedure = "/stockchecker.v1.StockCheckerService/SetMsrp"
+	// StockCheckerServiceGetMySetWatchesProcedure is the fully-qualified name of the
+	// StockCheckerService's GetMySetWatches RPC.
+	StockCheckerServiceGetMySetWatchesProcedure = "/stockchecker.v1.StockCheckerService/GetMySetWatches"
+	// StockCheckerServiceWatchSetProcedure is the fully-qualified name of the StockCheckerService's
+	// WatchSet RPC.
+	StockCheckerServiceWatchSetProcedure = "/stockchecker.v1.StockCheckerService/WatchSet"
+	// StockCheckerServiceUnwatchSetProcedure is the fully-qualified name of the StockCheckerService's
+	// UnwatchSet RPC.
+	StockCheckerServiceUnwatchSetProcedure = "/stockchecker.v1.StockCheckerService/UnwatchSet"
+	// StockCheckerServiceMarkPurchasedProcedure is the fully-qualified name of the
+	// StockCheckerService's MarkPurchased RPC.
+	StockCheckerServiceMarkPurchasedProcedure = "/stockchecker.v1.StockCheckerService/MarkPurchased"
+	// StockCheckerServiceGetMyAcquisitionsProcedure is the fully-qualified name of the
+	// StockCheckerService's GetMyAcquisitions RPC.
+	StockCheckerServiceGetMyAcquisitionsProcedure = "/stockchecker.v1.StockCheckerService/GetMyAcquisitions"
+	// StockCheckerServiceDeleteAcquisitionProcedure is the fully-qualified name of the
+	// StockCheckerService's DeleteAcquisition RPC.
+	StockCheckerServiceDeleteAcquisitionProcedure = "/stockchecker.v1.StockCheckerService/DeleteAcquisition"
+	// StockCheckerServiceGetAcquisitionSummaryProcedure is the fully-qualified name of the
+	// StockCheckerService's GetAcquisitionSummary RPC.
+	StockCheckerServiceGetAcquisitionSummaryProcedure = "/stockchecker.v1.StockCheckerService/GetAcquisitionSummary"
+	// StockCheckerServiceConfirmStockProcedure is the fully-qualified name of the StockCheckerService's
+	// ConfirmStock RPC.
+	StockCheckerServiceConfirmStockProcedure = "/stockchecker.v1.StockCheckerService/ConfirmStock"
+	// StockCheckerServiceGetStoreReliabilityProcedure is the fully-qualified name of the
+	// StockCheckerService's GetStoreReliability RPC.
+	StockCheckerServiceGetStoreReliabilityProcedure = "/stockchecker.v1.StockCheckerService/GetStoreReliability"
+	// StockCheckerServiceReportSightingProcedure is the fully-qualified name of the
+	// StockCheckerService's ReportSighting RPC.
+	StockCheckerServiceReportSightingProcedure = "/stockchecker.v1.StockCheckerService/ReportSighting"
+	// StockCheckerServiceListSightingsProcedure is the fully-qualified name of the
+	// StockCheckerService's ListSightings RPC.
+	StockCheckerServiceListSightingsProcedure = "/stockchecker.v1.StockCheckerService/ListSightings"
+	// StockCheckerServiceGetSightingPhotoProcedure is the fully-qualified name of the
+	// StockCheckerService's GetSightingPhoto RPC.
+	StockCheckerServiceGetSightingPhotoProcedure = "/stockchecker.v1.StockCheckerService/GetSightingPhoto"
+	// StockCheckerServiceModerateSightingProcedure is the fully-qualified name of the
+	// StockCheckerService's ModerateSighting RPC.
+	StockCheckerServiceModerateSightingProcedure = "/stockchecker.v1.StockCheckerService/ModerateSighting"
+	// StockCheckerServiceGetProductDomainProcedure is the fully-qualified name of the
+	// StockCheckerService's GetProductDomain RPC.
+	StockCheckerServiceGetProductDomainProcedure = "/stockchecker.v1.StockCheckerService/GetProductDomain"
+	// StockCheckerServiceGetMyProductWatchesProcedure is the fully-qualified name of the
+	// StockCheckerService's GetMyProductWatches RPC.
+	StockCheckerServiceGetMyProductWatchesProcedure = "/stockchecker.v1.StockCheckerService/GetMyProductWatches"
+	// StockCheckerServiceWatchProductsProcedure is the fully-qualified name of the
+	// StockCheckerService's WatchProducts RPC.
+	StockCheckerServiceWatchProductsProcedure = "/stockchecker.v1.StockCheckerService/WatchProducts"
+	// StockCheckerServiceUnwatchProductsProcedure is the fully-qualified name of the
+	// StockCheckerService's UnwatchProducts RPC.
+	StockCheckerServiceUnwatchProductsProcedure = "/stockchecker.v1.StockCheckerService/UnwatchProducts"
+	// StockCheckerServiceAdminAddAllowedEmailProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminAddAllowedEmail RPC.
+	StockCheckerServiceAdminAddAllowedEmailProcedure = "/stockchecker.v1.StockCheckerService/AdminAddAllowedEmail"
+	// StockCheckerServiceAdminRemoveAllowedEmailProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminRemoveAllowedEmail RPC.
+	StockCheckerServiceAdminRemoveAllowedEmailProcedure = "/stockchecker.v1.StockCheckerService/AdminRemoveAllowedEmail"
+	// StockCheckerServiceAdminListAllowedEmailsProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminListAllowedEmails RPC.
+	StockCheckerServiceAdminListAllowedEmailsProcedure = "/stockchecker.v1.StockCheckerService/AdminListAllowedEmails"
+	// StockCheckerServiceAdminAddAllowedDomainProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminAddAllowedDomain RPC.
+	StockCheckerServiceAdminAddAllowedDomainProcedure = "/stockchecker.v1.StockCheckerService/AdminAddAllowedDomain"
+	// StockCheckerServiceAdminRemoveAllowedDomainProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminRemoveAllowedDomain RPC.
+	StockCheckerServiceAdminRemoveAllowedDomainProcedure = "/stockchecker.v1.StockCheckerService/AdminRemoveAllowedDomain"
+	// StockCheckerServiceAdminListAllowedDomainsProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminListAllowedDomains RPC.
+	StockCheckerServiceAdminListAllowedDomainsProcedure = "/stockchecker.v1.StockCheckerService/AdminListAllowedDomains"
+	// StockCheckerServiceAdminCreateInviteProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminCreateInvite RPC.
+	StockCheckerServiceAdminCreateInviteProcedure = "/stockchecker.v1.StockCheckerService/AdminCreateInvite"
+	// StockCheckerServiceAdminListInvitesProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminListInvites RPC.
+	StockCheckerServiceAdminListInvitesProcedure = "/stockchecker.v1.StockCheckerService/AdminListInvites"
+	// StockCheckerServiceAdminRevokeInviteProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminRevokeInvite RPC.
+	StockCheckerServiceAdminRevokeInviteProcedure = "/stockchecker.v1.StockCheckerService/AdminRevokeInvite"
+	// StockCheckerServiceAdminListUsersProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminListUsers RPC.
+	StockCheckerServiceAdminListUsersProcedure = "/stockchecker.v1.StockCheckerService/AdminListUsers"
+	// StockCheckerServiceAdminSetUserRoleProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminSetUserRole RPC.
+	StockCheckerServiceAdminSetUserRoleProcedure = "/stockchecker.v1.StockCheckerService/AdminSetUserRole"
+	// StockCheckerServiceAdminListCredentialsProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminListCredentials RPC.
+	StockCheckerServiceAdminListCredentialsProcedure = "/stockchecker.v1.StockCheckerService/AdminListCredentials"
+	// StockCheckerServiceAdminSetCredentialProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminSetCredential RPC.
+	StockCheckerServiceAdminSetCredentialProcedure = "/stockchecker.v1.StockCheckerService/AdminSetCredential"
+	// StockCheckerServiceAdminClearCredentialProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminClearCredential RPC.
+	StockCheckerServiceAdminClearCredentialProcedure = "/stockchecker.v1.StockCheckerService/AdminClearCredential"
+	// StockCheckerServiceAdminGetApiCallStatsProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminGetApiCallStats RPC.
+	StockCheckerServiceAdminGetApiCallStatsProcedure = "/stockchecker.v1.StockCheckerService/AdminGetApiCallStats"
+	// StockCheckerServiceGetMyApiKeysProcedure is the fully-qualified name of the StockCheckerService's
+	// GetMyApiKeys RPC.
+	StockCheckerServiceGetMyApiKeysProcedure = "/stockchecker.v1.StockCheckerService/GetMyApiKeys"
+	// StockCheckerServiceCreateApiKeyProcedure is the fully-qualified name of the StockCheckerService's
+	// CreateApiKey RPC.
+	StockCheckerServiceCreateApiKeyProcedure = "/stockchecker.v1.StockCheckerService/CreateApiKey"
+	// StockCheckerServiceRevokeApiKeyProcedure is the fully-qualified name of the StockCheckerService's
+	// RevokeApiKey RPC.
+	StockCheckerServiceRevokeApiKeyProcedure = "/stockchecker.v1.StockCheckerService/RevokeApiKey"
+	// StockCheckerServiceListSessionsProcedure is the fully-qualified name of the StockCheckerService's
+	// ListSessions RPC.
+	StockCheckerServiceListSessionsProcedure = "/stockchecker.v1.StockCheckerService/ListSessions"
+	// StockCheckerServiceRevokeSessionProcedure is the fully-qualified name of the
+	// StockCheckerService's RevokeSession RPC.
+	StockCheckerServiceRevokeSessionProcedure = "/stockchecker.v1.StockCheckerService/RevokeSession"
+	// StockCheckerServiceExportMyDataProcedure is the fully-qualified name of the StockCheckerService's
+	// ExportMyData RPC.
+	StockCheckerServiceExportMyDataProcedure = "/stockchecker.v1.StockCheckerService/ExportMyData"
+	// StockCheckerServiceDeleteMyAccountProcedure is the fully-qualified name of the
+	// StockCheckerService's DeleteMyAccount RPC.
+	StockCheckerServiceDeleteMyAccountProcedure = "/stockchecker.v1.StockCheckerService/DeleteMyAccount"
+	// StockCheckerServiceGetClientBootstrapProcedure is the fully-qualified name of the
+	// StockCheckerService's GetClientBootstrap RPC.
+	StockCheckerServiceGetClientBootstrapProcedure = "/stockchecker.v1.StockCheckerService/GetClientBootstrap"
+	// StockCheckerServiceGetApiInfoProcedure is the fully-qualified name of the StockCheckerService's
+	// GetApiInfo RPC.
+	StockCheckerServiceGetApiInfoProcedure = "/stockchecker.v1.StockCheckerService/GetApiInfo"
+	// StockCheckerServiceGetMyUsageProcedure is the fully-qualified name of the StockCheckerService's
+	// GetMyUsage RPC.
+	StockCheckerServiceGetMyUsageProcedure = "/stockchecker.v1.StockCheckerService/GetMyUsage"
+	// StockCheckerServiceUpcomingDropsProcedure is the fully-qualified name of the
+	// StockCheckerService's UpcomingDrops RPC.
+	StockCheckerServiceUpcomingDropsProcedure = "/stockchecker.v1.StockCheckerService/UpcomingDrops"
+	// StockCheckerServiceAdminSaveDropProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminSaveDrop RPC.
+	StockCheckerServiceAdminSaveDropProcedure = "/stockchecker.v1.StockCheckerService/AdminSaveDrop"
+	// StockCheckerServiceAdminDeleteDropProcedure is the fully-qualified name of the
+	// StockCheckerService's AdminDeleteDrop RPC.
+	StockCheckerServiceAdminDeleteDropProcedure = "/stockchecker.v1.StockCheckerService/AdminDeleteDrop"
+	// StockCheckerServiceGetMyInboundEmailProcedure is the fully-qualified name of the
+	// StockCheckerService's GetMyInboundEmail RPC.
+	StockCheckerServiceGetMyInboundEmailProcedure = "/stockchecker.v1.StockCheckerService/GetMyInboundEmail"
+	// StockCheckerServiceResetMyInboundEmailProcedure is the fully-qualified name of the
+	// StockCheckerService's ResetMyInboundEmail RPC.
+	StockCheckerServiceResetMyInboundEmailProcedure = "/stockchecker.v1.StockCheckerService/ResetMyInboundEmail"
+	// StockCheckerServiceListMyRemindersProcedure is the fully-qualified name of the
+	// StockCheckerService's ListMyReminders RPC.
+	StockCheckerServiceListMyRemindersProcedure = "/stockchecker.v1.StockCheckerService/ListMyReminders"
+	// StockCheckerServiceDeleteMyReminderProcedure is the fully-qualified name of the
+	// StockCheckerService's DeleteMyReminder RPC.
+	StockCheckerServiceDeleteMyReminderProcedure = "/stockchecker.v1.StockCheckerService/DeleteMyReminder"
 )
 
 // StockCheckerServiceClient is a client for the stockchecker.v1.StockCheckerService service.
@@ -78,6 +321,8 @@ type StockCheckerServiceClient interface {
 	CheckStock(context.Context, *connect.Request[v1.CheckStockRequest]) (*connect.Response[v1.CheckStockResponse], error)
 	// GetCurrentUser returns the currently authenticated user
 	GetCurrentUser(context.Context, *connect.Request[v1.GetCurrentUserRequest]) (*connect.Response[v1.GetCurrentUserResponse], error)
+	// SetMyLocale sets the language used for the user's notifications and messages
+	SetMyLocale(context.Context, *connect.Request[v1.SetMyLocaleRequest]) (*connect.Response[v1.SetMyLocaleResponse], error)
 	// GetMyStores returns the user's saved stores
 	GetMyStores(context.Context, *connect.Request[v1.GetMyStoresRequest]) (*connect.Response[v1.GetMyStoresResponse], error)
 	// AddMyStore adds a store to the user's list
@@ -88,10 +333,192 @@ type StockCheckerServiceClient interface {
 	GetMyProducts(context.Context, *connect.Request[v1.GetMyProductsRequest]) (*connect.Response[v1.GetMyProductsResponse], error)
 	// AddMyProduct adds a product to the user's list
 	AddMyProduct(context.Context, *connect.Request[v1.AddMyProductRequest]) (*connect.Response[v1.AddMyProductResponse], error)
+	// UpdateMyProduct changes the fields of a saved product named in the update mask
+	UpdateMyProduct(context.Context, *connect.Request[v1.UpdateMyProductRequest]) (*connect.Response[v1.UpdateMyProductResponse], error)
 	// RemoveMyProduct removes a product from the user's list
 	RemoveMyProduct(context.Context, *connect.Request[v1.RemoveMyProductRequest]) (*connect.Response[v1.RemoveMyProductResponse], error)
+	// RemoveMyProducts removes several products from the user's list in one transaction
+	RemoveMyProducts(context.Context, *connect.Request[v1.RemoveMyProductsRequest]) (*connect.Response[v1.RemoveMyProductsResponse], error)
+	// ClearWatchlist removes every product (and optionally store) from the
+	// user's list in one transaction; confirm must be set
+	ClearWatchlist(context.Context, *connect.Request[v1.ClearWatchlistRequest]) (*connect.Response[v1.ClearWatchlistResponse], error)
+	// ImportMyProducts adds a pasted list of products to the user's list
+	ImportMyProducts(context.Context, *connect.Request[v1.ImportMyProductsRequest]) (*connect.Response[v1.ImportMyProductsResponse], error)
 	// BrowsePokemonProducts returns Pokemon products from Best Buy's trading cards category
 	BrowsePokemonProducts(context.Context, *connect.Request[v1.BrowsePokemonProductsRequest]) (*connect.Response[v1.BrowsePokemonProductsResponse], error)
+	// GetNotificationPreferences returns the user's notification preferences
+	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
+	// UpdateNotificationPreferences changes the preferences named in the update mask
+	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
+	// GetAlertRules returns the user's per-product alert rules
+	GetAlertRules(context.Context, *connect.Request[v1.GetAlertRulesRequest]) (*connect.Response[v1.GetAlertRulesResponse], error)
+	// UpdateAlertRule changes the rule fields named in the update mask
+	UpdateAlertRule(context.Context, *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error)
+	// GetNotificationTemplates returns the user's notification templates and the admin defaults
+	GetNotificationTemplates(context.Context, *connect.Request[v1.GetNotificationTemplatesRequest]) (*connect.Response[v1.GetNotificationTemplatesResponse], error)
+	// SetNotificationTemplate validates and saves a notification template
+	SetNotificationTemplate(context.Context, *connect.Request[v1.SetNotificationTemplateRequest]) (*connect.Response[v1.SetNotificationTemplateResponse], error)
+	// DeleteNotificationTemplate removes a notification template
+	DeleteNotificationTemplate(context.Context, *connect.Request[v1.DeleteNotificationTemplateRequest]) (*connect.Response[v1.DeleteNotificationTemplateResponse], error)
+	// GetNotificationChannels returns the user's notification channels
+	GetNotificationChannels(context.Context, *connect.Request[v1.GetNotificationChannelsRequest]) (*connect.Response[v1.GetNotificationChannelsResponse], error)
+	// SetNotificationChannel validates and saves a notification channel
+	SetNotificationChannel(context.Context, *connect.Request[v1.SetNotificationChannelRequest]) (*connect.Response[v1.SetNotificationChannelResponse], error)
+	// DeleteNotificationChannel removes a notification channel
+	DeleteNotificationChannel(context.Context, *connect.Request[v1.DeleteNotificationChannelRequest]) (*connect.Response[v1.DeleteNotificationChannelResponse], error)
+	// SendTestNotification previews a notification and optionally sends it over a configured channel
+	SendTestNotification(context.Context, *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[v1.SendTestNotificationResponse], error)
+	// SimulateWatcherCycle runs the stock watcher without sending anything and reports which notifications would fire (admin only)
+	SimulateWatcherCycle(context.Context, *connect.Request[v1.SimulateWatcherCycleRequest]) (*connect.Response[v1.SimulateWatcherCycleResponse], error)
+	// GetMyDashboard returns current and recent availability of the user's watched products
+	GetMyDashboard(context.Context, *connect.Request[v1.GetMyDashboardRequest]) (*connect.Response[v1.GetMyDashboardResponse], error)
+	// GetMyLocations returns the user's locations
+	GetMyLocations(context.Context, *connect.Request[v1.GetMyLocationsRequest]) (*connect.Response[v1.GetMyLocationsResponse], error)
+	// SetMyLocation creates or replaces one of the user's locations
+	SetMyLocation(context.Context, *connect.Request[v1.SetMyLocationRequest]) (*connect.Response[v1.SetMyLocationResponse], error)
+	// DeleteMyLocation removes one of the user's locations
+	DeleteMyLocation(context.Context, *connect.Request[v1.DeleteMyLocationRequest]) (*connect.Response[v1.DeleteMyLocationResponse], error)
+	// GetProductBarcode returns a scannable barcode for a product
+	GetProductBarcode(context.Context, *connect.Request[v1.GetProductBarcodeRequest]) (*connect.Response[v1.GetProductBarcodeResponse], error)
+	// CheckStoreNow live-checks every watched product at one of the user's stores
+	CheckStoreNow(context.Context, *connect.Request[v1.CheckStoreNowRequest]) (*connect.Response[v1.CheckStoreNowResponse], error)
+	// GetStockHistory returns the availability checks of a product at a store
+	GetStockHistory(context.Context, *connect.Request[v1.GetStockHistoryRequest]) (*connect.Response[v1.GetStockHistoryResponse], error)
+	// WatchStock streams stock changes as the watcher records them. A client
+	// that stops reading for a minute is disconnected; reconnecting with the
+	// last resume token delivers the changes missed in between.
+	WatchStock(context.Context, *connect.Request[v1.WatchStockRequest]) (*connect.ServerStreamForClient[v1.WatchStockResponse], error)
+	// GetOfflineBundle returns the user's watch list and latest stock for offline viewing
+	GetOfflineBundle(context.Context, *connect.Request[v1.GetOfflineBundleRequest]) (*connect.Response[v1.GetOfflineBundleResponse], error)
+	// SyncChanges returns changes to the user's saved data and latest stock since a previous sync
+	SyncChanges(context.Context, *connect.Request[v1.SyncChangesRequest]) (*connect.Response[v1.SyncChangesResponse], error)
+	// ListWatchlistChanges returns the history of changes to the user's saved stores and products
+	ListWatchlistChanges(context.Context, *connect.Request[v1.ListWatchlistChangesRequest]) (*connect.Response[v1.ListWatchlistChangesResponse], error)
+	// UndoLastChange reverses the user's most recent change to their saved
+	// stores or products, if it was made within the last hour. Calling it again
+	// undoes the change before that.
+	UndoLastChange(context.Context, *connect.Request[v1.UndoLastChangeRequest]) (*connect.Response[v1.UndoLastChangeResponse], error)
+	// GetProductDetails returns a product with the details of its TCG set
+	GetProductDetails(context.Context, *connect.Request[v1.GetProductDetailsRequest]) (*connect.Response[v1.GetProductDetailsResponse], error)
+	// ListMsrps returns the MSRPs listings are compared against
+	ListMsrps(context.Context, *connect.Request[v1.ListMsrpsRequest]) (*connect.Response[v1.ListMsrpsResponse], error)
+	// SetMsrp sets or removes an MSRP (admin only)
+	SetMsrp(context.Context, *connect.Request[v1.SetMsrpRequest]) (*connect.Response[v1.SetMsrpResponse], error)
+	// GetMySetWatches returns the sets the user watches
+	GetMySetWatches(context.Context, *connect.Request[v1.GetMySetWatchesRequest]) (*connect.Response[v1.GetMySetWatchesResponse], error)
+	// WatchSet saves every product from a set, including ones listed later.
+	// Products the user removes stay removed.
+	WatchSet(context.Context, *connect.Request[v1.WatchSetRequest]) (*connect.Response[v1.WatchSetResponse], error)
+	// UnwatchSet stops watching a set
+	UnwatchSet(context.Context, *connect.Request[v1.UnwatchSetRequest]) (*connect.Response[v1.UnwatchSetResponse], error)
+	// MarkPurchased records a purchase of one of the user's saved products
+	MarkPurchased(context.Context, *connect.Request[v1.MarkPurchasedRequest]) (*connect.Response[v1.MarkPurchasedResponse], error)
+	// GetMyAcquisitions returns the user's recorded purchases, newest first
+	GetMyAcquisitions(context.Context, *connect.Request[v1.GetMyAcquisitionsRequest]) (*connect.Response[v1.GetMyAcquisitionsResponse], error)
+	// DeleteAcquisition removes one of the user's recorded purchases
+	DeleteAcquisition(context.Context, *connect.Request[v1.DeleteAcquisitionRequest]) (*connect.Response[v1.DeleteAcquisitionResponse], error)
+	// GetAcquisitionSummary totals the user's spend per month and set
+	GetAcquisitionSummary(context.Context, *connect.Request[v1.GetAcquisitionSummaryRequest]) (*connect.Response[v1.GetAcquisitionSummaryResponse], error)
+	// ConfirmStock records whether an alerted product was really on a store's shelf
+	ConfirmStock(context.Context, *connect.Request[v1.ConfirmStockRequest]) (*connect.Response[v1.ConfirmStockResponse], error)
+	// GetStoreReliability scores how often stores' reported stock was on the shelf
+	GetStoreReliability(context.Context, *connect.Request[v1.GetStoreReliabilityRequest]) (*connect.Response[v1.GetStoreReliabilityResponse], error)
+	// ReportSighting reports stock seen on a store's shelf, for moderation
+	ReportSighting(context.Context, *connect.Request[v1.ReportSightingRequest]) (*connect.Response[v1.ReportSightingResponse], error)
+	// ListSightings lists reported sightings for moderation (admin only)
+	ListSightings(context.Context, *connect.Request[v1.ListSightingsRequest]) (*connect.Response[v1.ListSightingsResponse], error)
+	// GetSightingPhoto returns a sighting's photo (admin only)
+	GetSightingPhoto(context.Context, *connect.Request[v1.GetSightingPhotoRequest]) (*connect.Response[v1.GetSightingPhotoResponse], error)
+	// ModerateSighting approves or rejects a sighting, alerting watchers if approved (admin only)
+	ModerateSighting(context.Context, *connect.Request[v1.ModerateSightingRequest]) (*connect.Response[v1.ModerateSightingResponse], error)
+	// GetProductDomain returns the kind of product the deployment tracks
+	GetProductDomain(context.Context, *connect.Request[v1.GetProductDomainRequest]) (*connect.Response[v1.GetProductDomainResponse], error)
+	// GetMyProductWatches returns the user's product watches
+	GetMyProductWatches(context.Context, *connect.Request[v1.GetMyProductWatchesRequest]) (*connect.Response[v1.GetMyProductWatchesResponse], error)
+	// WatchProducts alerts the user when Best Buy lists a new product matching a search
+	WatchProducts(context.Context, *connect.Request[v1.WatchProductsRequest]) (*connect.Response[v1.WatchProductsResponse], error)
+	// UnwatchProducts stops a product watch
+	UnwatchProducts(context.Context, *connect.Request[v1.UnwatchProductsRequest]) (*connect.Response[v1.UnwatchProductsResponse], error)
+	// AdminAddAllowedEmail allows an email address to sign in (admin only)
+	AdminAddAllowedEmail(context.Context, *connect.Request[v1.AdminAddAllowedEmailRequest]) (*connect.Response[v1.AdminAddAllowedEmailResponse], error)
+	// AdminRemoveAllowedEmail stops an email address signing in and signs it out (admin only)
+	AdminRemoveAllowedEmail(context.Context, *connect.Request[v1.AdminRemoveAllowedEmailRequest]) (*connect.Response[v1.AdminRemoveAllowedEmailResponse], error)
+	// AdminListAllowedEmails lists the email addresses allowed to sign in (admin only)
+	AdminListAllowedEmails(context.Context, *connect.Request[v1.AdminListAllowedEmailsRequest]) (*connect.Response[v1.AdminListAllowedEmailsResponse], error)
+	// AdminAddAllowedDomain allows every verified address on a domain to sign in (admin only)
+	AdminAddAllowedDomain(context.Context, *connect.Request[v1.AdminAddAllowedDomainRequest]) (*connect.Response[v1.AdminAddAllowedDomainResponse], error)
+	// AdminRemoveAllowedDomain stops a domain's addresses signing in and signs
+	// out those not allowed individually (admin only)
+	AdminRemoveAllowedDomain(context.Context, *connect.Request[v1.AdminRemoveAllowedDomainRequest]) (*connect.Response[v1.AdminRemoveAllowedDomainResponse], error)
+	// AdminListAllowedDomains lists the email domains allowed to sign in (admin only)
+	AdminListAllowedDomains(context.Context, *connect.Request[v1.AdminListAllowedDomainsRequest]) (*connect.Response[v1.AdminListAllowedDomainsResponse], error)
+	// AdminCreateInvite creates a single-use, expiring link that allows
+	// whoever signs in with it (admin only)
+	AdminCreateInvite(context.Context, *connect.Request[v1.AdminCreateInviteRequest]) (*connect.Response[v1.AdminCreateInviteResponse], error)
+	// AdminListInvites lists invite links, used or not (admin only)
+	AdminListInvites(context.Context, *connect.Request[v1.AdminListInvitesRequest]) (*connect.Response[v1.AdminListInvitesResponse], error)
+	// AdminRevokeInvite revokes an invite link that hasn't been used (admin only)
+	AdminRevokeInvite(context.Context, *connect.Request[v1.AdminRevokeInviteRequest]) (*connect.Response[v1.AdminRevokeInviteResponse], error)
+	// AdminListUsers lists the users who have signed in (admin only)
+	AdminListUsers(context.Context, *connect.Request[v1.AdminListUsersRequest]) (*connect.Response[v1.AdminListUsersResponse], error)
+	// AdminSetUserRole promotes a user to admin or demotes them (admin only)
+	AdminSetUserRole(context.Context, *connect.Request[v1.AdminSetUserRoleRequest]) (*connect.Response[v1.AdminSetUserRoleResponse], error)
+	// AdminListCredentials lists the retailer keys and notifier settings admins can rotate (admin only)
+	AdminListCredentials(context.Context, *connect.Request[v1.AdminListCredentialsRequest]) (*connect.Response[v1.AdminListCredentialsResponse], error)
+	// AdminSetCredential rotates a retailer key or notifier setting without a restart (admin only)
+	AdminSetCredential(context.Context, *connect.Request[v1.AdminSetCredentialRequest]) (*connect.Response[v1.AdminSetCredentialResponse], error)
+	// AdminClearCredential goes back to a credential's value from the environment (admin only)
+	AdminClearCredential(context.Context, *connect.Request[v1.AdminClearCredentialRequest]) (*connect.Response[v1.AdminClearCredentialResponse], error)
+	// AdminGetApiCallStats sums up a sample of recent Best Buy API calls by
+	// endpoint and priority, to show what uses up the quota (admin only)
+	AdminGetApiCallStats(context.Context, *connect.Request[v1.AdminGetApiCallStatsRequest]) (*connect.Response[v1.AdminGetApiCallStatsResponse], error)
+	// GetMyApiKeys lists the user's API keys
+	GetMyApiKeys(context.Context, *connect.Request[v1.GetMyApiKeysRequest]) (*connect.Response[v1.GetMyApiKeysResponse], error)
+	// CreateApiKey creates an API key for calling the API without signing in
+	CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error)
+	// RevokeApiKey revokes one of the user's API keys
+	RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error)
+	// ListSessions lists the browsers the user is signed in on
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession signs out one of the user's sessions, or all of them
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// ExportMyData dumps everything stored about the user as JSON, for keeping
+	// a copy or moving to another instance
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
+	// DeleteMyAccount deletes the user's account and all their data. The only
+	// admin can't delete their account.
+	DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error)
+	// GetClientBootstrap returns everything the web app needs on load in one call;
+	// it works signed out, leaving the user's parts unset
+	GetClientBootstrap(context.Context, *connect.Request[v1.GetClientBootstrapRequest]) (*connect.Response[v1.GetClientBootstrapResponse], error)
+	// GetApiInfo returns the API changelog and what's deprecated, for
+	// integrators to check before upgrading; it works signed out
+	GetApiInfo(context.Context, *connect.Request[v1.GetApiInfoRequest]) (*connect.Response[v1.GetApiInfoResponse], error)
+	// GetMyUsage returns the checks and notifications counted for the user
+	// each day, with how busy the shared retailer API is, so users can tell
+	// why checks are slower than usual
+	GetMyUsage(context.Context, *connect.Request[v1.GetMyUsageRequest]) (*connect.Response[v1.GetMyUsageResponse], error)
+	// UpcomingDrops lists invitation-only drops of restricted products whose
+	// entry windows haven't ended. Users watching a drop's SKU are notified
+	// when its window opens.
+	UpcomingDrops(context.Context, *connect.Request[v1.UpcomingDropsRequest]) (*connect.Response[v1.UpcomingDropsResponse], error)
+	// AdminSaveDrop creates or updates a drop from a retailer's announcement;
+	// moving its opening time notifies watchers again (admin only)
+	AdminSaveDrop(context.Context, *connect.Request[v1.AdminSaveDropRequest]) (*connect.Response[v1.AdminSaveDropResponse], error)
+	// AdminDeleteDrop deletes a drop (admin only)
+	AdminDeleteDrop(context.Context, *connect.Request[v1.AdminDeleteDropRequest]) (*connect.Response[v1.AdminDeleteDropResponse], error)
+	// GetMyInboundEmail returns the user's address for forwarding Best Buy
+	// invitation and order-ready emails, which become reminders before the
+	// invitation expires or the order goes back to the shelf. The address is
+	// made on the first call.
+	GetMyInboundEmail(context.Context, *connect.Request[v1.GetMyInboundEmailRequest]) (*connect.Response[v1.GetMyInboundEmailResponse], error)
+	// ResetMyInboundEmail replaces the user's forwarding address, e.g. if it
+	// leaked; mail to the old one is ignored
+	ResetMyInboundEmail(context.Context, *connect.Request[v1.ResetMyInboundEmailRequest]) (*connect.Response[v1.ResetMyInboundEmailResponse], error)
+	// ListMyReminders lists the reminders made from the user's forwarded emails
+	ListMyReminders(context.Context, *connect.Request[v1.ListMyRemindersRequest]) (*connect.Response[v1.ListMyRemindersResponse], error)
+	// DeleteMyReminder deletes a reminder, so it isn't sent
+	DeleteMyReminder(context.Context, *connect.Request[v1.DeleteMyReminderRequest]) (*connect.Response[v1.DeleteMyReminderResponse], error)
 }
 
 // NewStockCheckerServiceClient constructs a client for the stockchecker.v1.StockCheckerService
@@ -109,30 +536,41 @@ func NewStockCheckerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			httpClient,
 			baseURL+StockCheckerServiceSearchStoresProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("SearchStores")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		searchProducts: connect.NewClient[v1.SearchProductsRequest, v1.SearchProductsResponse](
 			httpClient,
 			baseURL+StockCheckerServiceSearchProductsProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("SearchProducts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		checkStock: connect.NewClient[v1.CheckStockRequest, v1.CheckStockResponse](
 			httpClient,
 			baseURL+StockCheckerServiceCheckStockProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("CheckStock")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getCurrentUser: connect.NewClient[v1.GetCurrentUserRequest, v1.GetCurrentUserResponse](
 			httpClient,
 			baseURL+StockCheckerServiceGetCurrentUserProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("GetCurrentUser")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setMyLocale: connect.NewClient[v1.SetMyLocaleRequest, v1.SetMyLocaleResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSetMyLocaleProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SetMyLocale")),
 			connect.WithClientOptions(opts...),
 		),
 		getMyStores: connect.NewClient[v1.GetMyStoresRequest, v1.GetMyStoresResponse](
 			httpClient,
 			baseURL+StockCheckerServiceGetMyStoresProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyStores")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		addMyStore: connect.NewClient[v1.AddMyStoreRequest, v1.AddMyStoreResponse](
@@ -151,6 +589,7 @@ func NewStockCheckerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			httpClient,
 			baseURL+StockCheckerServiceGetMyProductsProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyProducts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		addMyProduct: connect.NewClient[v1.AddMyProductRequest, v1.AddMyProductResponse](
@@ -159,16 +598,532 @@ func NewStockCheckerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("AddMyProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		updateMyProduct: connect.NewClient[v1.UpdateMyProductRequest, v1.UpdateMyProductResponse](
+			httpClient,
+			baseURL+StockCheckerServiceUpdateMyProductProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("UpdateMyProduct")),
+			connect.WithClientOptions(opts...),
+		),
 		removeMyProduct: connect.NewClient[v1.RemoveMyProductRequest, v1.RemoveMyProductResponse](
 			httpClient,
 			baseURL+StockCheckerServiceRemoveMyProductProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyProduct")),
 			connect.WithClientOptions(opts...),
 		),
+		removeMyProducts: connect.NewClient[v1.RemoveMyProductsRequest, v1.RemoveMyProductsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceRemoveMyProductsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		clearWatchlist: connect.NewClient[v1.ClearWatchlistRequest, v1.ClearWatchlistResponse](
+			httpClient,
+			baseURL+StockCheckerServiceClearWatchlistProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ClearWatchlist")),
+			connect.WithClientOptions(opts...),
+		),
+		importMyProducts: connect.NewClient[v1.ImportMyProductsRequest, v1.ImportMyProductsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceImportMyProductsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ImportMyProducts")),
+			connect.WithClientOptions(opts...),
+		),
 		browsePokemonProducts: connect.NewClient[v1.BrowsePokemonProductsRequest, v1.BrowsePokemonProductsResponse](
 			httpClient,
 			baseURL+StockCheckerServiceBrowsePokemonProductsProcedure,
 			connect.WithSchema(stockCheckerServiceMethods.ByName("BrowsePokemonProducts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationPreferences: connect.NewClient[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetNotificationPreferencesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetNotificationPreferences")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationPreferences: connect.NewClient[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceUpdateNotificationPreferencesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("UpdateNotificationPreferences")),
+			connect.WithClientOptions(opts...),
+		),
+		getAlertRules: connect.NewClient[v1.GetAlertRulesRequest, v1.GetAlertRulesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetAlertRulesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetAlertRules")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		updateAlertRule: connect.NewClient[v1.UpdateAlertRuleRequest, v1.UpdateAlertRuleResponse](
+			httpClient,
+			baseURL+StockCheckerServiceUpdateAlertRuleProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("UpdateAlertRule")),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationTemplates: connect.NewClient[v1.GetNotificationTemplatesRequest, v1.GetNotificationTemplatesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetNotificationTemplatesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetNotificationTemplates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setNotificationTemplate: connect.NewClient[v1.SetNotificationTemplateRequest, v1.SetNotificationTemplateResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSetNotificationTemplateProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SetNotificationTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNotificationTemplate: connect.NewClient[v1.DeleteNotificationTemplateRequest, v1.DeleteNotificationTemplateResponse](
+			httpClient,
+			baseURL+StockCheckerServiceDeleteNotificationTemplateProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteNotificationTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationChannels: connect.NewClient[v1.GetNotificationChannelsRequest, v1.GetNotificationChannelsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetNotificationChannelsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetNotificationChannels")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setNotificationChannel: connect.NewClient[v1.SetNotificationChannelRequest, v1.SetNotificationChannelResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSetNotificationChannelProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SetNotificationChannel")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNotificationChannel: connect.NewClient[v1.DeleteNotificationChannelRequest, v1.DeleteNotificationChannelResponse](
+			httpClient,
+			baseURL+StockCheckerServiceDeleteNotificationChannelProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteNotificationChannel")),
+			connect.WithClientOptions(opts...),
+		),
+		sendTestNotification: connect.NewClient[v1.SendTestNotificationRequest, v1.SendTestNotificationResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSendTestNotificationProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SendTestNotification")),
+			connect.WithClientOptions(opts...),
+		),
+		simulateWatcherCycle: connect.NewClient[v1.SimulateWatcherCycleRequest, v1.SimulateWatcherCycleResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSimulateWatcherCycleProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SimulateWatcherCycle")),
+			connect.WithClientOptions(opts...),
+		),
+		getMyDashboard: connect.NewClient[v1.GetMyDashboardRequest, v1.GetMyDashboardResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMyDashboardProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyDashboard")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getMyLocations: connect.NewClient[v1.GetMyLocationsRequest, v1.GetMyLocationsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMyLocationsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyLocations")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setMyLocation: connect.NewClient[v1.SetMyLocationRequest, v1.SetMyLocationResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSetMyLocationProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SetMyLocation")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMyLocation: connect.NewClient[v1.DeleteMyLocationRequest, v1.DeleteMyLocationResponse](
+			httpClient,
+			baseURL+StockCheckerServiceDeleteMyLocationProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteMyLocation")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductBarcode: connect.NewClient[v1.GetProductBarcodeRequest, v1.GetProductBarcodeResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetProductBarcodeProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetProductBarcode")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		checkStoreNow: connect.NewClient[v1.CheckStoreNowRequest, v1.CheckStoreNowResponse](
+			httpClient,
+			baseURL+StockCheckerServiceCheckStoreNowProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("CheckStoreNow")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getStockHistory: connect.NewClient[v1.GetStockHistoryRequest, v1.GetStockHistoryResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetStockHistoryProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetStockHistory")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		watchStock: connect.NewClient[v1.WatchStockRequest, v1.WatchStockResponse](
+			httpClient,
+			baseURL+StockCheckerServiceWatchStockProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("WatchStock")),
+			connect.WithClientOptions(opts...),
+		),
+		getOfflineBundle: connect.NewClient[v1.GetOfflineBundleRequest, v1.GetOfflineBundleResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetOfflineBundleProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetOfflineBundle")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		syncChanges: connect.NewClient[v1.SyncChangesRequest, v1.SyncChangesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSyncChangesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SyncChanges")),
+			connect.WithClientOptions(opts...),
+		),
+		listWatchlistChanges: connect.NewClient[v1.ListWatchlistChangesRequest, v1.ListWatchlistChangesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListWatchlistChangesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListWatchlistChanges")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		undoLastChange: connect.NewClient[v1.UndoLastChangeRequest, v1.UndoLastChangeResponse](
+			httpClient,
+			baseURL+StockCheckerServiceUndoLastChangeProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("UndoLastChange")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductDetails: connect.NewClient[v1.GetProductDetailsRequest, v1.GetProductDetailsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetProductDetailsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetProductDetails")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listMsrps: connect.NewClient[v1.ListMsrpsRequest, v1.ListMsrpsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListMsrpsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListMsrps")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		setMsrp: connect.NewClient[v1.SetMsrpRequest, v1.SetMsrpResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSetMsrpProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SetMsrp")),
+			connect.WithClientOptions(opts...),
+		),
+		getMySetWatches: connect.NewClient[v1.GetMySetWatchesRequest, v1.GetMySetWatchesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMySetWatchesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMySetWatches")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		watchSet: connect.NewClient[v1.WatchSetRequest, v1.WatchSetResponse](
+			httpClient,
+			baseURL+StockCheckerServiceWatchSetProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("WatchSet")),
+			connect.WithClientOptions(opts...),
+		),
+		unwatchSet: connect.NewClient[v1.UnwatchSetRequest, v1.UnwatchSetResponse](
+			httpClient,
+			baseURL+StockCheckerServiceUnwatchSetProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("UnwatchSet")),
+			connect.WithClientOptions(opts...),
+		),
+		markPurchased: connect.NewClient[v1.MarkPurchasedRequest, v1.MarkPurchasedResponse](
+			httpClient,
+			baseURL+StockCheckerServiceMarkPurchasedProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("MarkPurchased")),
+			connect.WithClientOptions(opts...),
+		),
+		getMyAcquisitions: connect.NewClient[v1.GetMyAcquisitionsRequest, v1.GetMyAcquisitionsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMyAcquisitionsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyAcquisitions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAcquisition: connect.NewClient[v1.DeleteAcquisitionRequest, v1.DeleteAcquisitionResponse](
+			httpClient,
+			baseURL+StockCheckerServiceDeleteAcquisitionProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteAcquisition")),
+			connect.WithClientOptions(opts...),
+		),
+		getAcquisitionSummary: connect.NewClient[v1.GetAcquisitionSummaryRequest, v1.GetAcquisitionSummaryResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetAcquisitionSummaryProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetAcquisitionSummary")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		confirmStock: connect.NewClient[v1.ConfirmStockRequest, v1.ConfirmStockResponse](
+			httpClient,
+			baseURL+StockCheckerServiceConfirmStockProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ConfirmStock")),
+			connect.WithClientOptions(opts...),
+		),
+		getStoreReliability: connect.NewClient[v1.GetStoreReliabilityRequest, v1.GetStoreReliabilityResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetStoreReliabilityProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetStoreReliability")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reportSighting: connect.NewClient[v1.ReportSightingRequest, v1.ReportSightingResponse](
+			httpClient,
+			baseURL+StockCheckerServiceReportSightingProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ReportSighting")),
+			connect.WithClientOptions(opts...),
+		),
+		listSightings: connect.NewClient[v1.ListSightingsRequest, v1.ListSightingsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListSightingsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListSightings")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSightingPhoto: connect.NewClient[v1.GetSightingPhotoRequest, v1.GetSightingPhotoResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetSightingPhotoProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetSightingPhoto")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		moderateSighting: connect.NewClient[v1.ModerateSightingRequest, v1.ModerateSightingResponse](
+			httpClient,
+			baseURL+StockCheckerServiceModerateSightingProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ModerateSighting")),
+			connect.WithClientOptions(opts...),
+		),
+		getProductDomain: connect.NewClient[v1.GetProductDomainRequest, v1.GetProductDomainResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetProductDomainProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetProductDomain")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getMyProductWatches: connect.NewClient[v1.GetMyProductWatchesRequest, v1.GetMyProductWatchesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMyProductWatchesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyProductWatches")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		watchProducts: connect.NewClient[v1.WatchProductsRequest, v1.WatchProductsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceWatchProductsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("WatchProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		unwatchProducts: connect.NewClient[v1.UnwatchProductsRequest, v1.UnwatchProductsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceUnwatchProductsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("UnwatchProducts")),
+			connect.WithClientOptions(opts...),
+		),
+		adminAddAllowedEmail: connect.NewClient[v1.AdminAddAllowedEmailRequest, v1.AdminAddAllowedEmailResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminAddAllowedEmailProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminAddAllowedEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		adminRemoveAllowedEmail: connect.NewClient[v1.AdminRemoveAllowedEmailRequest, v1.AdminRemoveAllowedEmailResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminRemoveAllowedEmailProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminRemoveAllowedEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		adminListAllowedEmails: connect.NewClient[v1.AdminListAllowedEmailsRequest, v1.AdminListAllowedEmailsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminListAllowedEmailsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListAllowedEmails")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		adminAddAllowedDomain: connect.NewClient[v1.AdminAddAllowedDomainRequest, v1.AdminAddAllowedDomainResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminAddAllowedDomainProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminAddAllowedDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		adminRemoveAllowedDomain: connect.NewClient[v1.AdminRemoveAllowedDomainRequest, v1.AdminRemoveAllowedDomainResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminRemoveAllowedDomainProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminRemoveAllowedDomain")),
+			connect.WithClientOptions(opts...),
+		),
+		adminListAllowedDomains: connect.NewClient[v1.AdminListAllowedDomainsRequest, v1.AdminListAllowedDomainsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminListAllowedDomainsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListAllowedDomains")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		adminCreateInvite: connect.NewClient[v1.AdminCreateInviteRequest, v1.AdminCreateInviteResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminCreateInviteProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminCreateInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		adminListInvites: connect.NewClient[v1.AdminListInvitesRequest, v1.AdminListInvitesResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminListInvitesProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListInvites")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		adminRevokeInvite: connect.NewClient[v1.AdminRevokeInviteRequest, v1.AdminRevokeInviteResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminRevokeInviteProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminRevokeInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		adminListUsers: connect.NewClient[v1.AdminListUsersRequest, v1.AdminListUsersResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminListUsersProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListUsers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		adminSetUserRole: connect.NewClient[v1.AdminSetUserRoleRequest, v1.AdminSetUserRoleResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminSetUserRoleProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminSetUserRole")),
+			connect.WithClientOptions(opts...),
+		),
+		adminListCredentials: connect.NewClient[v1.AdminListCredentialsRequest, v1.AdminListCredentialsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminListCredentialsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListCredentials")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		adminSetCredential: connect.NewClient[v1.AdminSetCredentialRequest, v1.AdminSetCredentialResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminSetCredentialProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminSetCredential")),
+			connect.WithClientOptions(opts...),
+		),
+		adminClearCredential: connect.NewClient[v1.AdminClearCredentialRequest, v1.AdminClearCredentialResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminClearCredentialProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminClearCredential")),
+			connect.WithClientOptions(opts...),
+		),
+		adminGetApiCallStats: connect.NewClient[v1.AdminGetApiCallStatsRequest, v1.AdminGetApiCallStatsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminGetApiCallStatsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminGetApiCallStats")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getMyApiKeys: connect.NewClient[v1.GetMyApiKeysRequest, v1.GetMyApiKeysResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMyApiKeysProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyApiKeys")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createApiKey: connect.NewClient[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse](
+			httpClient,
+			baseURL+StockCheckerServiceCreateApiKeyProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("CreateApiKey")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiKey: connect.NewClient[v1.RevokeApiKeyRequest, v1.RevokeApiKeyResponse](
+			httpClient,
+			baseURL+StockCheckerServiceRevokeApiKeyProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("RevokeApiKey")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListSessionsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListSessions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+StockCheckerServiceRevokeSessionProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		exportMyData: connect.NewClient[v1.ExportMyDataRequest, v1.ExportMyDataResponse](
+			httpClient,
+			baseURL+StockCheckerServiceExportMyDataProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ExportMyData")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMyAccount: connect.NewClient[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse](
+			httpClient,
+			baseURL+StockCheckerServiceDeleteMyAccountProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteMyAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getClientBootstrap: connect.NewClient[v1.GetClientBootstrapRequest, v1.GetClientBootstrapResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetClientBootstrapProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetClientBootstrap")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getApiInfo: connect.NewClient[v1.GetApiInfoRequest, v1.GetApiInfoResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetApiInfoProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetApiInfo")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getMyUsage: connect.NewClient[v1.GetMyUsageRequest, v1.GetMyUsageResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMyUsageProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		upcomingDrops: connect.NewClient[v1.UpcomingDropsRequest, v1.UpcomingDropsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceUpcomingDropsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("UpcomingDrops")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		adminSaveDrop: connect.NewClient[v1.AdminSaveDropRequest, v1.AdminSaveDropResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminSaveDropProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminSaveDrop")),
+			connect.WithClientOptions(opts...),
+		),
+		adminDeleteDrop: connect.NewClient[v1.AdminDeleteDropRequest, v1.AdminDeleteDropResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAdminDeleteDropProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AdminDeleteDrop")),
+			connect.WithClientOptions(opts...),
+		),
+		getMyInboundEmail: connect.NewClient[v1.GetMyInboundEmailRequest, v1.GetMyInboundEmailResponse](
+			httpClient,
+			baseURL+StockCheckerServiceGetMyInboundEmailProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyInboundEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		resetMyInboundEmail: connect.NewClient[v1.ResetMyInboundEmailRequest, v1.ResetMyInboundEmailResponse](
+			httpClient,
+			baseURL+StockCheckerServiceResetMyInboundEmailProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ResetMyInboundEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyReminders: connect.NewClient[v1.ListMyRemindersRequest, v1.ListMyRemindersResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListMyRemindersProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListMyReminders")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		deleteMyReminder: connect.NewClient[v1.DeleteMyReminderRequest, v1.DeleteMyReminderResponse](
+			httpClient,
+			baseURL+StockCheckerServiceDeleteMyReminderProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteMyReminder")),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -176,17 +1131,98 @@ func NewStockCheckerServiceClient(httpClient connect.HTTPClient, baseURL string,
 
 // stockCheckerServiceClient implements StockCheckerServiceClient.
 type stockCheckerServiceClient struct {
-	searchStores          *connect.Client[v1.SearchStoresRequest, v1.SearchStoresResponse]
-	searchProducts        *connect.Client[v1.SearchProductsRequest, v1.SearchProductsResponse]
-	checkStock            *connect.Client[v1.CheckStockRequest, v1.CheckStockResponse]
-	getCurrentUser        *connect.Client[v1.GetCurrentUserRequest, v1.GetCurrentUserResponse]
-	getMyStores           *connect.Client[v1.GetMyStoresRequest, v1.GetMyStoresResponse]
-	addMyStore            *connect.Client[v1.AddMyStoreRequest, v1.AddMyStoreResponse]
-	removeMyStore         *connect.Client[v1.RemoveMyStoreRequest, v1.RemoveMyStoreResponse]
-	getMyProducts         *connect.Client[v1.GetMyProductsRequest, v1.GetMyProductsResponse]
-	addMyProduct          *connect.Client[v1.AddMyProductRequest, v1.AddMyProductResponse]
-	removeMyProduct       *connect.Client[v1.RemoveMyProductRequest, v1.RemoveMyProductResponse]
-	browsePokemonProducts *connect.Client[v1.BrowsePokemonProductsRequest, v1.BrowsePokemonProductsResponse]
+	searchStores                  *connect.Client[v1.SearchStoresRequest, v1.SearchStoresResponse]
+	searchProducts                *connect.Client[v1.SearchProductsRequest, v1.SearchProductsResponse]
+	checkStock                    *connect.Client[v1.CheckStockRequest, v1.CheckStockResponse]
+	getCurrentUser                *connect.Client[v1.GetCurrentUserRequest, v1.GetCurrentUserResponse]
+	setMyLocale                   *connect.Client[v1.SetMyLocaleRequest, v1.SetMyLocaleResponse]
+	getMyStores                   *connect.Client[v1.GetMyStoresRequest, v1.GetMyStoresResponse]
+	addMyStore                    *connect.Client[v1.AddMyStoreRequest, v1.AddMyStoreResponse]
+	removeMyStore                 *connect.Client[v1.RemoveMyStoreRequest, v1.RemoveMyStoreResponse]
+	getMyProducts                 *connect.Client[v1.GetMyProductsRequest, v1.GetMyProductsResponse]
+	addMyProduct                  *connect.Client[v1.AddMyProductRequest, v1.AddMyProductResponse]
+	updateMyProduct               *connect.Client[v1.UpdateMyProductRequest, v1.UpdateMyProductResponse]
+	removeMyProduct               *connect.Client[v1.RemoveMyProductRequest, v1.RemoveMyProductResponse]
+	removeMyProducts              *connect.Client[v1.RemoveMyProductsRequest, v1.RemoveMyProductsResponse]
+	clearWatchlist                *connect.Client[v1.ClearWatchlistRequest, v1.ClearWatchlistResponse]
+	importMyProducts              *connect.Client[v1.ImportMyProductsRequest, v1.ImportMyProductsResponse]
+	browsePokemonProducts         *connect.Client[v1.BrowsePokemonProductsRequest, v1.BrowsePokemonProductsResponse]
+	getNotificationPreferences    *connect.Client[v1.GetNotificationPreferencesRequest, v1.GetNotificationPreferencesResponse]
+	updateNotificationPreferences *connect.Client[v1.UpdateNotificationPreferencesRequest, v1.UpdateNotificationPreferencesResponse]
+	getAlertRules                 *connect.Client[v1.GetAlertRulesRequest, v1.GetAlertRulesResponse]
+	updateAlertRule               *connect.Client[v1.UpdateAlertRuleRequest, v1.UpdateAlertRuleResponse]
+	getNotificationTemplates      *connect.Client[v1.GetNotificationTemplatesRequest, v1.GetNotificationTemplatesResponse]
+	setNotificationTemplate       *connect.Client[v1.SetNotificationTemplateRequest, v1.SetNotificationTemplateResponse]
+	deleteNotificationTemplate    *connect.Client[v1.DeleteNotificationTemplateRequest, v1.DeleteNotificationTemplateResponse]
+	getNotificationChannels       *connect.Client[v1.GetNotificationChannelsRequest, v1.GetNotificationChannelsResponse]
+	setNotificationChannel        *connect.Client[v1.SetNotificationChannelRequest, v1.SetNotificationChannelResponse]
+	deleteNotificationChannel     *connect.Client[v1.DeleteNotificationChannelRequest, v1.DeleteNotificationChannelResponse]
+	sendTestNotification          *connect.Client[v1.SendTestNotificationRequest, v1.SendTestNotificationResponse]
+	simulateWatcherCycle          *connect.Client[v1.SimulateWatcherCycleRequest, v1.SimulateWatcherCycleResponse]
+	getMyDashboard                *connect.Client[v1.GetMyDashboardRequest, v1.GetMyDashboardResponse]
+	getMyLocations                *connect.Client[v1.GetMyLocationsRequest, v1.GetMyLocationsResponse]
+	setMyLocation                 *connect.Client[v1.SetMyLocationRequest, v1.SetMyLocationResponse]
+	deleteMyLocation              *connect.Client[v1.DeleteMyLocationRequest, v1.DeleteMyLocationResponse]
+	getProductBarcode             *connect.Client[v1.GetProductBarcodeRequest, v1.GetProductBarcodeResponse]
+	checkStoreNow                 *connect.Client[v1.CheckStoreNowRequest, v1.CheckStoreNowResponse]
+	getStockHistory               *connect.Client[v1.GetStockHistoryRequest, v1.GetStockHistoryResponse]
+	watchStock                    *connect.Client[v1.WatchStockRequest, v1.WatchStockResponse]
+	getOfflineBundle              *connect.Client[v1.GetOfflineBundleRequest, v1.GetOfflineBundleResponse]
+	syncChanges                   *connect.Client[v1.SyncChangesRequest, v1.SyncChangesResponse]
+	listWatchlistChanges          *connect.Client[v1.ListWatchlistChangesRequest, v1.ListWatchlistChangesResponse]
+	undoLastChange                *connect.Client[v1.UndoLastChangeRequest, v1.UndoLastChangeResponse]
+	getProductDetails             *connect.Client[v1.GetProductDetailsRequest, v1.GetProductDetailsResponse]
+	listMsrps                     *connect.Client[v1.ListMsrpsRequest, v1.ListMsrpsResponse]
+	setMsrp                       *connect.Client[v1.SetMsrpRequest, v1.SetMsrpResponse]
+	getMySetWatches               *connect.Client[v1.GetMySetWatchesRequest, v1.GetMySetWatchesResponse]
+	watchSet                      *connect.Client[v1.WatchSetRequest, v1.WatchSetResponse]
+	unwatchSet                    *connect.Client[v1.UnwatchSetRequest, v1.UnwatchSetResponse]
+	markPurchased                 *connect.Client[v1.MarkPurchasedRequest, v1.MarkPurchasedResponse]
+	getMyAcquisitions             *connect.Client[v1.GetMyAcquisitionsRequest, v1.GetMyAcquisitionsResponse]
+	deleteAcquisition             *connect.Client[v1.DeleteAcquisitionRequest, v1.DeleteAcquisitionResponse]
+	getAcquisitionSummary         *connect.Client[v1.GetAcquisitionSummaryRequest, v1.GetAcquisitionSummaryResponse]
+	confirmStock                  *connect.Client[v1.ConfirmStockRequest, v1.ConfirmStockResponse]
+	getStoreReliability           *connect.Client[v1.GetStoreReliabilityRequest, v1.GetStoreReliabilityResponse]
+	reportSighting                *connect.Client[v1.ReportSightingRequest, v1.ReportSightingResponse]
+	listSightings                 *connect.Client[v1.ListSightingsRequest, v1.ListSightingsResponse]
+	getSightingPhoto              *connect.Client[v1.GetSightingPhotoRequest, v1.GetSightingPhotoResponse]
+	moderateSighting              *connect.Client[v1.ModerateSightingRequest, v1.ModerateSightingResponse]
+	getProductDomain              *connect.Client[v1.GetProductDomainRequest, v1.GetProductDomainResponse]
+	getMyProductWatches           *connect.Client[v1.GetMyProductWatchesRequest, v1.GetMyProductWatchesResponse]
+	watchProducts                 *connect.Client[v1.WatchProductsRequest, v1.WatchProductsResponse]
+	unwatchProducts               *connect.Client[v1.UnwatchProductsRequest, v1.UnwatchProductsResponse]
+	adminAddAllowedEmail          *connect.Client[v1.AdminAddAllowedEmailRequest, v1.AdminAddAllowedEmailResponse]
+	adminRemoveAllowedEmail       *connect.Client[v1.AdminRemoveAllowedEmailRequest, v1.AdminRemoveAllowedEmailResponse]
+	adminListAllowedEmails        *connect.Client[v1.AdminListAllowedEmailsRequest, v1.AdminListAllowedEmailsResponse]
+	adminAddAllowedDomain         *connect.Client[v1.AdminAddAllowedDomainRequest, v1.AdminAddAllowedDomainResponse]
+	adminRemoveAllowedDomain      *connect.Client[v1.AdminRemoveAllowedDomainRequest, v1.AdminRemoveAllowedDomainResponse]
+	adminListAllowedDomains       *connect.Client[v1.AdminListAllowedDomainsRequest, v1.AdminListAllowedDomainsResponse]
+	adminCreateInvite             *connect.Client[v1.AdminCreateInviteRequest, v1.AdminCreateInviteResponse]
+	adminListInvites              *connect.Client[v1.AdminListInvitesRequest, v1.AdminListInvitesResponse]
+	adminRevokeInvite             *connect.Client[v1.AdminRevokeInviteRequest, v1.AdminRevokeInviteResponse]
+	adminListUsers                *connect.Client[v1.AdminListUsersRequest, v1.AdminListUsersResponse]
+	adminSetUserRole              *connect.Client[v1.AdminSetUserRoleRequest, v1.AdminSetUserRoleResponse]
+	adminListCredentials          *connect.Client[v1.AdminListCredentialsRequest, v1.AdminListCredentialsResponse]
+	adminSetCredential            *connect.Client[v1.AdminSetCredentialRequest, v1.AdminSetCredentialResponse]
+	adminClearCredential          *connect.Client[v1.AdminClearCredentialRequest, v1.AdminClearCredentialResponse]
+	adminGetApiCallStats          *connect.Client[v1.AdminGetApiCallStatsRequest, v1.AdminGetApiCallStatsResponse]
+	getMyApiKeys                  *connect.Client[v1.GetMyApiKeysRequest, v1.GetMyApiKeysResponse]
+	createApiKey                  *connect.Client[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse]
+	revokeApiKey                  *connect.Client[v1.RevokeApiKeyRequest, v1.RevokeApiKeyResponse]
+	listSessions                  *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession                 *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	exportMyData                  *connect.Client[v1.ExportMyDataRequest, v1.ExportMyDataResponse]
+	deleteMyAccount               *connect.Client[v1.DeleteMyAccountRequest, v1.DeleteMyAccountResponse]
+	getClientBootstrap            *connect.Client[v1.GetClientBootstrapRequest, v1.GetClientBootstrapResponse]
+	getApiInfo                    *connect.Client[v1.GetApiInfoRequest, v1.GetApiInfoResponse]
+	getMyUsage                    *connect.Client[v1.GetMyUsageRequest, v1.GetMyUsageResponse]
+	upcomingDrops                 *connect.Client[v1.UpcomingDropsRequest, v1.UpcomingDropsResponse]
+	adminSaveDrop                 *connect.Client[v1.AdminSaveDropRequest, v1.AdminSaveDropResponse]
+	adminDeleteDrop               *connect.Client[v1.AdminDeleteDropRequest, v1.AdminDeleteDropResponse]
+	getMyInboundEmail             *connect.Client[v1.GetMyInboundEmailRequest, v1.GetMyInboundEmailResponse]
+	resetMyInboundEmail           *connect.Client[v1.ResetMyInboundEmailRequest, v1.ResetMyInboundEmailResponse]
+	listMyReminders               *connect.Client[v1.ListMyRemindersRequest, v1.ListMyRemindersResponse]
+	deleteMyReminder              *connect.Client[v1.DeleteMyReminderRequest, v1.DeleteMyReminderResponse]
 }
 
 // SearchStores calls stockchecker.v1.StockCheckerService.SearchStores.
@@ -209,6 +1245,11 @@ func (c *stockCheckerServiceClient) GetCurrentUser(ctx context.Context, req *con
 	return c.getCurrentUser.CallUnary(ctx, req)
 }
 
+// SetMyLocale calls stockchecker.v1.StockCheckerService.SetMyLocale.
+func (c *stockCheckerServiceClient) SetMyLocale(ctx context.Context, req *connect.Request[v1.SetMyLocaleRequest]) (*connect.Response[v1.SetMyLocaleResponse], error) {
+	return c.setMyLocale.CallUnary(ctx, req)
+}
+
 // GetMyStores calls stockchecker.v1.StockCheckerService.GetMyStores.
 func (c *stockCheckerServiceClient) GetMyStores(ctx context.Context, req *connect.Request[v1.GetMyStoresRequest]) (*connect.Response[v1.GetMyStoresResponse], error) {
 	return c.getMyStores.CallUnary(ctx, req)
@@ -234,19 +1275,420 @@ func (c *stockCheckerServiceClient) AddMyProduct(ctx context.Context, req *conne
 	return c.addMyProduct.CallUnary(ctx, req)
 }
 
+// UpdateMyProduct calls stockchecker.v1.StockCheckerService.UpdateMyProduct.
+func (c *stockCheckerServiceClient) UpdateMyProduct(ctx context.Context, req *connect.Request[v1.UpdateMyProductRequest]) (*connect.Response[v1.UpdateMyProductResponse], error) {
+	return c.updateMyProduct.CallUnary(ctx, req)
+}
+
 // RemoveMyProduct calls stockchecker.v1.StockCheckerService.RemoveMyProduct.
 func (c *stockCheckerServiceClient) RemoveMyProduct(ctx context.Context, req *connect.Request[v1.RemoveMyProductRequest]) (*connect.Response[v1.RemoveMyProductResponse], error) {
 	return c.removeMyProduct.CallUnary(ctx, req)
 }
 
+// RemoveMyProducts calls stockchecker.v1.StockCheckerService.RemoveMyProducts.
+func (c *stockCheckerServiceClient) RemoveMyProducts(ctx context.Context, req *connect.Request[v1.RemoveMyProductsRequest]) (*connect.Response[v1.RemoveMyProductsResponse], error) {
+	return c.removeMyProducts.CallUnary(ctx, req)
+}
+
+// ClearWatchlist calls stockchecker.v1.StockCheckerService.ClearWatchlist.
+func (c *stockCheckerServiceClient) ClearWatchlist(ctx context.Context, req *connect.Request[v1.ClearWatchlistRequest]) (*connect.Response[v1.ClearWatchlistResponse], error) {
+	return c.clearWatchlist.CallUnary(ctx, req)
+}
+
+// ImportMyProducts calls stockchecker.v1.StockCheckerService.ImportMyProducts.
+func (c *stockCheckerServiceClient) ImportMyProducts(ctx context.Context, req *connect.Request[v1.ImportMyProductsRequest]) (*connect.Response[v1.ImportMyProductsResponse], error) {
+	return c.importMyProducts.CallUnary(ctx, req)
+}
+
 // BrowsePokemonProducts calls stockchecker.v1.StockCheckerService.BrowsePokemonProducts.
 func (c *stockCheckerServiceClient) BrowsePokemonProducts(ctx context.Context, req *connect.Request[v1.BrowsePokemonProductsRequest]) (*connect.Response[v1.BrowsePokemonProductsResponse], error) {
 	return c.browsePokemonProducts.CallUnary(ctx, req)
 }
 
-// StockCheckerServiceHandler is an implementation of the stockchecker.v1.StockCheckerService
-// service.
-type StockCheckerServiceHandler interface {
+// GetNotificationPreferences calls stockchecker.v1.StockCheckerService.GetNotificationPreferences.
+func (c *stockCheckerServiceClient) GetNotificationPreferences(ctx context.Context, req *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
+	return c.getNotificationPreferences.CallUnary(ctx, req)
+}
+
+// UpdateNotificationPreferences calls
+// stockchecker.v1.StockCheckerService.UpdateNotificationPreferences.
+func (c *stockCheckerServiceClient) UpdateNotificationPreferences(ctx context.Context, req *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error) {
+	return c.updateNotificationPreferences.CallUnary(ctx, req)
+}
+
+// GetAlertRules calls stockchecker.v1.StockCheckerService.GetAlertRules.
+func (c *stockCheckerServiceClient) GetAlertRules(ctx context.Context, req *connect.Request[v1.GetAlertRulesRequest]) (*connect.Response[v1.GetAlertRulesResponse], error) {
+	return c.getAlertRules.CallUnary(ctx, req)
+}
+
+// UpdateAlertRule calls stockchecker.v1.StockCheckerService.UpdateAlertRule.
+func (c *stockCheckerServiceClient) UpdateAlertRule(ctx context.Context, req *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error) {
+	return c.updateAlertRule.CallUnary(ctx, req)
+}
+
+// GetNotificationTemplates calls stockchecker.v1.StockCheckerService.GetNotificationTemplates.
+func (c *stockCheckerServiceClient) GetNotificationTemplates(ctx context.Context, req *connect.Request[v1.GetNotificationTemplatesRequest]) (*connect.Response[v1.GetNotificationTemplatesResponse], error) {
+	return c.getNotificationTemplates.CallUnary(ctx, req)
+}
+
+// SetNotificationTemplate calls stockchecker.v1.StockCheckerService.SetNotificationTemplate.
+func (c *stockCheckerServiceClient) SetNotificationTemplate(ctx context.Context, req *connect.Request[v1.SetNotificationTemplateRequest]) (*connect.Response[v1.SetNotificationTemplateResponse], error) {
+	return c.setNotificationTemplate.CallUnary(ctx, req)
+}
+
+// DeleteNotificationTemplate calls stockchecker.v1.StockCheckerService.DeleteNotificationTemplate.
+func (c *stockCheckerServiceClient) DeleteNotificationTemplate(ctx context.Context, req *connect.Request[v1.DeleteNotificationTemplateRequest]) (*connect.Response[v1.DeleteNotificationTemplateResponse], error) {
+	return c.deleteNotificationTemplate.CallUnary(ctx, req)
+}
+
+// GetNotificationChannels calls stockchecker.v1.StockCheckerService.GetNotificationChannels.
+func (c *stockCheckerServiceClient) GetNotificationChannels(ctx context.Context, req *connect.Request[v1.GetNotificationChannelsRequest]) (*connect.Response[v1.GetNotificationChannelsResponse], error) {
+	return c.getNotificationChannels.CallUnary(ctx, req)
+}
+
+// SetNotificationChannel calls stockchecker.v1.StockCheckerService.SetNotificationChannel.
+func (c *stockCheckerServiceClient) SetNotificationChannel(ctx context.Context, req *connect.Request[v1.SetNotificationChannelRequest]) (*connect.Response[v1.SetNotificationChannelResponse], error) {
+	return c.setNotificationChannel.CallUnary(ctx, req)
+}
+
+// DeleteNotificationChannel calls stockchecker.v1.StockCheckerService.DeleteNotificationChannel.
+func (c *stockCheckerServiceClient) DeleteNotificationChannel(ctx context.Context, req *connect.Request[v1.DeleteNotificationChannelRequest]) (*connect.Response[v1.DeleteNotificationChannelResponse], error) {
+	return c.deleteNotificationChannel.CallUnary(ctx, req)
+}
+
+// SendTestNotification calls stockchecker.v1.StockCheckerService.SendTestNotification.
+func (c *stockCheckerServiceClient) SendTestNotification(ctx context.Context, req *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[v1.SendTestNotificationResponse], error) {
+	return c.sendTestNotification.CallUnary(ctx, req)
+}
+
+// SimulateWatcherCycle calls stockchecker.v1.StockCheckerService.SimulateWatcherCycle.
+func (c *stockCheckerServiceClient) SimulateWatcherCycle(ctx context.Context, req *connect.Request[v1.SimulateWatcherCycleRequest]) (*connect.Response[v1.SimulateWatcherCycleResponse], error) {
+	return c.simulateWatcherCycle.CallUnary(ctx, req)
+}
+
+// GetMyDashboard calls stockchecker.v1.StockCheckerService.GetMyDashboard.
+func (c *stockCheckerServiceClient) GetMyDashboard(ctx context.Context, req *connect.Request[v1.GetMyDashboardRequest]) (*connect.Response[v1.GetMyDashboardResponse], error) {
+	return c.getMyDashboard.CallUnary(ctx, req)
+}
+
+// GetMyLocations calls stockchecker.v1.StockCheckerService.GetMyLocations.
+func (c *stockCheckerServiceClient) GetMyLocations(ctx context.Context, req *connect.Request[v1.GetMyLocationsRequest]) (*connect.Response[v1.GetMyLocationsResponse], error) {
+	return c.getMyLocations.CallUnary(ctx, req)
+}
+
+// SetMyLocation calls stockchecker.v1.StockCheckerService.SetMyLocation.
+func (c *stockCheckerServiceClient) SetMyLocation(ctx context.Context, req *connect.Request[v1.SetMyLocationRequest]) (*connect.Response[v1.SetMyLocationResponse], error) {
+	return c.setMyLocation.CallUnary(ctx, req)
+}
+
+// DeleteMyLocation calls stockchecker.v1.StockCheckerService.DeleteMyLocation.
+func (c *stockCheckerServiceClient) DeleteMyLocation(ctx context.Context, req *connect.Request[v1.DeleteMyLocationRequest]) (*connect.Response[v1.DeleteMyLocationResponse], error) {
+	return c.deleteMyLocation.CallUnary(ctx, req)
+}
+
+// GetProductBarcode calls stockchecker.v1.StockCheckerService.GetProductBarcode.
+func (c *stockCheckerServiceClient) GetProductBarcode(ctx context.Context, req *connect.Request[v1.GetProductBarcodeRequest]) (*connect.Response[v1.GetProductBarcodeResponse], error) {
+	return c.getProductBarcode.CallUnary(ctx, req)
+}
+
+// CheckStoreNow calls stockchecker.v1.StockCheckerService.CheckStoreNow.
+func (c *stockCheckerServiceClient) CheckStoreNow(ctx context.Context, req *connect.Request[v1.CheckStoreNowRequest]) (*connect.Response[v1.CheckStoreNowResponse], error) {
+	return c.checkStoreNow.CallUnary(ctx, req)
+}
+
+// GetStockHistory calls stockchecker.v1.StockCheckerService.GetStockHistory.
+func (c *stockCheckerServiceClient) GetStockHistory(ctx context.Context, req *connect.Request[v1.GetStockHistoryRequest]) (*connect.Response[v1.GetStockHistoryResponse], error) {
+	return c.getStockHistory.CallUnary(ctx, req)
+}
+
+// WatchStock calls stockchecker.v1.StockCheckerService.WatchStock.
+func (c *stockCheckerServiceClient) WatchStock(ctx context.Context, req *connect.Request[v1.WatchStockRequest]) (*connect.ServerStreamForClient[v1.WatchStockResponse], error) {
+	return c.watchStock.CallServerStream(ctx, req)
+}
+
+// GetOfflineBundle calls stockchecker.v1.StockCheckerService.GetOfflineBundle.
+func (c *stockCheckerServiceClient) GetOfflineBundle(ctx context.Context, req *connect.Request[v1.GetOfflineBundleRequest]) (*connect.Response[v1.GetOfflineBundleResponse], error) {
+	return c.getOfflineBundle.CallUnary(ctx, req)
+}
+
+// SyncChanges calls stockchecker.v1.StockCheckerService.SyncChanges.
+func (c *stockCheckerServiceClient) SyncChanges(ctx context.Context, req *connect.Request[v1.SyncChangesRequest]) (*connect.Response[v1.SyncChangesResponse], error) {
+	return c.syncChanges.CallUnary(ctx, req)
+}
+
+// ListWatchlistChanges calls stockchecker.v1.StockCheckerService.ListWatchlistChanges.
+func (c *stockCheckerServiceClient) ListWatchlistChanges(ctx context.Context, req *connect.Request[v1.ListWatchlistChangesRequest]) (*connect.Response[v1.ListWatchlistChangesResponse], error) {
+	return c.listWatchlistChanges.CallUnary(ctx, req)
+}
+
+// UndoLastChange calls stockchecker.v1.StockCheckerService.UndoLastChange.
+func (c *stockCheckerServiceClient) UndoLastChange(ctx context.Context, req *connect.Request[v1.UndoLastChangeRequest]) (*connect.Response[v1.UndoLastChangeResponse], error) {
+	return c.undoLastChange.CallUnary(ctx, req)
+}
+
+// GetProductDetails calls stockchecker.v1.StockCheckerService.GetProductDetails.
+func (c *stockCheckerServiceClient) GetProductDetails(ctx context.Context, req *connect.Request[v1.GetProductDetailsRequest]) (*connect.Response[v1.GetProductDetailsResponse], error) {
+	return c.getProductDetails.CallUnary(ctx, req)
+}
+
+// ListMsrps calls stockchecker.v1.StockCheckerService.ListMsrps.
+func (c *stockCheckerServiceClient) ListMsrps(ctx context.Context, req *connect.Request[v1.ListMsrpsRequest]) (*connect.Response[v1.ListMsrpsResponse], error) {
+	return c.listMsrps.CallUnary(ctx, req)
+}
+
+// SetMsrp calls stockchecker.v1.StockCheckerService.SetMsrp.
+func (c *stockCheckerServiceClient) SetMsrp(ctx context.Context, req *connect.Request[v1.SetMsrpRequest]) (*connect.Response[v1.SetMsrpResponse], error) {
+	return c.setMsrp.CallUnary(ctx, req)
+}
+
+// GetMySetWatches calls stockchecker.v1.StockCheckerService.GetMySetWatches.
+func (c *stockCheckerServiceClient) GetMySetWatches(ctx context.Context, req *connect.Request[v1.GetMySetWatchesRequest]) (*connect.Response[v1.GetMySetWatchesResponse], error) {
+	return c.getMySetWatches.CallUnary(ctx, req)
+}
+
+// WatchSet calls stockchecker.v1.StockCheckerService.WatchSet.
+func (c *stockCheckerServiceClient) WatchSet(ctx context.Context, req *connect.Request[v1.WatchSetRequest]) (*connect.Response[v1.WatchSetResponse], error) {
+	return c.watchSet.CallUnary(ctx, req)
+}
+
+// UnwatchSet calls stockchecker.v1.StockCheckerService.UnwatchSet.
+func (c *stockCheckerServiceClient) UnwatchSet(ctx context.Context, req *connect.Request[v1.UnwatchSetRequest]) (*connect.Response[v1.UnwatchSetResponse], error) {
+	return c.unwatchSet.CallUnary(ctx, req)
+}
+
+// MarkPurchased calls stockchecker.v1.StockCheckerService.MarkPurchased.
+func (c *stockCheckerServiceClient) MarkPurchased(ctx context.Context, req *connect.Request[v1.MarkPurchasedRequest]) (*connect.Response[v1.MarkPurchasedResponse], error) {
+	return c.markPurchased.CallUnary(ctx, req)
+}
+
+// GetMyAcquisitions calls stockchecker.v1.StockCheckerService.GetMyAcquisitions.
+func (c *stockCheckerServiceClient) GetMyAcquisitions(ctx context.Context, req *connect.Request[v1.GetMyAcquisitionsRequest]) (*connect.Response[v1.GetMyAcquisitionsResponse], error) {
+	return c.getMyAcquisitions.CallUnary(ctx, req)
+}
+
+// DeleteAcquisition calls stockchecker.v1.StockCheckerService.DeleteAcquisition.
+func (c *stockCheckerServiceClient) DeleteAcquisition(ctx context.Context, req *connect.Request[v1.DeleteAcquisitionRequest]) (*connect.Response[v1.DeleteAcquisitionResponse], error) {
+	return c.deleteAcquisition.CallUnary(ctx, req)
+}
+
+// GetAcquisitionSummary calls stockchecker.v1.StockCheckerService.GetAcquisitionSummary.
+func (c *stockCheckerServiceClient) GetAcquisitionSummary(ctx context.Context, req *connect.Request[v1.GetAcquisitionSummaryRequest]) (*connect.Response[v1.GetAcquisitionSummaryResponse], error) {
+	return c.getAcquisitionSummary.CallUnary(ctx, req)
+}
+
+// ConfirmStock calls stockchecker.v1.StockCheckerService.ConfirmStock.
+func (c *stockCheckerServiceClient) ConfirmStock(ctx context.Context, req *connect.Request[v1.ConfirmStockRequest]) (*connect.Response[v1.ConfirmStockResponse], error) {
+	return c.confirmStock.CallUnary(ctx, req)
+}
+
+// GetStoreReliability calls stockchecker.v1.StockCheckerService.GetStoreReliability.
+func (c *stockCheckerServiceClient) GetStoreReliability(ctx context.Context, req *connect.Request[v1.GetStoreReliabilityRequest]) (*connect.Response[v1.GetStoreReliabilityResponse], error) {
+	return c.getStoreReliability.CallUnary(ctx, req)
+}
+
+// ReportSighting calls stockchecker.v1.StockCheckerService.ReportSighting.
+func (c *stockCheckerServiceClient) ReportSighting(ctx context.Context, req *connect.Request[v1.ReportSightingRequest]) (*connect.Response[v1.ReportSightingResponse], error) {
+	return c.reportSighting.CallUnary(ctx, req)
+}
+
+// ListSightings calls stockchecker.v1.StockCheckerService.ListSightings.
+func (c *stockCheckerServiceClient) ListSightings(ctx context.Context, req *connect.Request[v1.ListSightingsRequest]) (*connect.Response[v1.ListSightingsResponse], error) {
+	return c.listSightings.CallUnary(ctx, req)
+}
+
+// GetSightingPhoto calls stockchecker.v1.StockCheckerService.GetSightingPhoto.
+func (c *stockCheckerServiceClient) GetSightingPhoto(ctx context.Context, req *connect.Request[v1.GetSightingPhotoRequest]) (*connect.Response[v1.GetSightingPhotoResponse], error) {
+	return c.getSightingPhoto.CallUnary(ctx, req)
+}
+
+// ModerateSighting calls stockchecker.v1.StockCheckerService.ModerateSighting.
+func (c *stockCheckerServiceClient) ModerateSighting(ctx context.Context, req *connect.Request[v1.ModerateSightingRequest]) (*connect.Response[v1.ModerateSightingResponse], error) {
+	return c.moderateSighting.CallUnary(ctx, req)
+}
+
+// GetProductDomain calls stockchecker.v1.StockCheckerService.GetProductDomain.
+func (c *stockCheckerServiceClient) GetProductDomain(ctx context.Context, req *connect.Request[v1.GetProductDomainRequest]) (*connect.Response[v1.GetProductDomainResponse], error) {
+	return c.getProductDomain.CallUnary(ctx, req)
+}
+
+// GetMyProductWatches calls stockchecker.v1.StockCheckerService.GetMyProductWatches.
+func (c *stockCheckerServiceClient) GetMyProductWatches(ctx context.Context, req *connect.Request[v1.GetMyProductWatchesRequest]) (*connect.Response[v1.GetMyProductWatchesResponse], error) {
+	return c.getMyProductWatches.CallUnary(ctx, req)
+}
+
+// WatchProducts calls stockchecker.v1.StockCheckerService.WatchProducts.
+func (c *stockCheckerServiceClient) WatchProducts(ctx context.Context, req *connect.Request[v1.WatchProductsRequest]) (*connect.Response[v1.WatchProductsResponse], error) {
+	return c.watchProducts.CallUnary(ctx, req)
+}
+
+// UnwatchProducts calls stockchecker.v1.StockCheckerService.UnwatchProducts.
+func (c *stockCheckerServiceClient) UnwatchProducts(ctx context.Context, req *connect.Request[v1.UnwatchProductsRequest]) (*connect.Response[v1.UnwatchProductsResponse], error) {
+	return c.unwatchProducts.CallUnary(ctx, req)
+}
+
+// AdminAddAllowedEmail calls stockchecker.v1.StockCheckerService.AdminAddAllowedEmail.
+func (c *stockCheckerServiceClient) AdminAddAllowedEmail(ctx context.Context, req *connect.Request[v1.AdminAddAllowedEmailRequest]) (*connect.Response[v1.AdminAddAllowedEmailResponse], error) {
+	return c.adminAddAllowedEmail.CallUnary(ctx, req)
+}
+
+// AdminRemoveAllowedEmail calls stockchecker.v1.StockCheckerService.AdminRemoveAllowedEmail.
+func (c *stockCheckerServiceClient) AdminRemoveAllowedEmail(ctx context.Context, req *connect.Request[v1.AdminRemoveAllowedEmailRequest]) (*connect.Response[v1.AdminRemoveAllowedEmailResponse], error) {
+	return c.adminRemoveAllowedEmail.CallUnary(ctx, req)
+}
+
+// AdminListAllowedEmails calls stockchecker.v1.StockCheckerService.AdminListAllowedEmails.
+func (c *stockCheckerServiceClient) AdminListAllowedEmails(ctx context.Context, req *connect.Request[v1.AdminListAllowedEmailsRequest]) (*connect.Response[v1.AdminListAllowedEmailsResponse], error) {
+	return c.adminListAllowedEmails.CallUnary(ctx, req)
+}
+
+// AdminAddAllowedDomain calls stockchecker.v1.StockCheckerService.AdminAddAllowedDomain.
+func (c *stockCheckerServiceClient) AdminAddAllowedDomain(ctx context.Context, req *connect.Request[v1.AdminAddAllowedDomainRequest]) (*connect.Response[v1.AdminAddAllowedDomainResponse], error) {
+	return c.adminAddAllowedDomain.CallUnary(ctx, req)
+}
+
+// AdminRemoveAllowedDomain calls stockchecker.v1.StockCheckerService.AdminRemoveAllowedDomain.
+func (c *stockCheckerServiceClient) AdminRemoveAllowedDomain(ctx context.Context, req *connect.Request[v1.AdminRemoveAllowedDomainRequest]) (*connect.Response[v1.AdminRemoveAllowedDomainResponse], error) {
+	return c.adminRemoveAllowedDomain.CallUnary(ctx, req)
+}
+
+// AdminListAllowedDomains calls stockchecker.v1.StockCheckerService.AdminListAllowedDomains.
+func (c *stockCheckerServiceClient) AdminListAllowedDomains(ctx context.Context, req *connect.Request[v1.AdminListAllowedDomainsRequest]) (*connect.Response[v1.AdminListAllowedDomainsResponse], error) {
+	return c.adminListAllowedDomains.CallUnary(ctx, req)
+}
+
+// AdminCreateInvite calls stockchecker.v1.StockCheckerService.AdminCreateInvite.
+func (c *stockCheckerServiceClient) AdminCreateInvite(ctx context.Context, req *connect.Request[v1.AdminCreateInviteRequest]) (*connect.Response[v1.AdminCreateInviteResponse], error) {
+	return c.adminCreateInvite.CallUnary(ctx, req)
+}
+
+// AdminListInvites calls stockchecker.v1.StockCheckerService.AdminListInvites.
+func (c *stockCheckerServiceClient) AdminListInvites(ctx context.Context, req *connect.Request[v1.AdminListInvitesRequest]) (*connect.Response[v1.AdminListInvitesResponse], error) {
+	return c.adminListInvites.CallUnary(ctx, req)
+}
+
+// AdminRevokeInvite calls stockchecker.v1.StockCheckerService.AdminRevokeInvite.
+func (c *stockCheckerServiceClient) AdminRevokeInvite(ctx context.Context, req *connect.Request[v1.AdminRevokeInviteRequest]) (*connect.Response[v1.AdminRevokeInviteResponse], error) {
+	return c.adminRevokeInvite.CallUnary(ctx, req)
+}
+
+// AdminListUsers calls stockchecker.v1.StockCheckerService.AdminListUsers.
+func (c *stockCheckerServiceClient) AdminListUsers(ctx context.Context, req *connect.Request[v1.AdminListUsersRequest]) (*connect.Response[v1.AdminListUsersResponse], error) {
+	return c.adminListUsers.CallUnary(ctx, req)
+}
+
+// AdminSetUserRole calls stockchecker.v1.StockCheckerService.AdminSetUserRole.
+func (c *stockCheckerServiceClient) AdminSetUserRole(ctx context.Context, req *connect.Request[v1.AdminSetUserRoleRequest]) (*connect.Response[v1.AdminSetUserRoleResponse], error) {
+	return c.adminSetUserRole.CallUnary(ctx, req)
+}
+
+// AdminListCredentials calls stockchecker.v1.StockCheckerService.AdminListCredentials.
+func (c *stockCheckerServiceClient) AdminListCredentials(ctx context.Context, req *connect.Request[v1.AdminListCredentialsRequest]) (*connect.Response[v1.AdminListCredentialsResponse], error) {
+	return c.adminListCredentials.CallUnary(ctx, req)
+}
+
+// AdminSetCredential calls stockchecker.v1.StockCheckerService.AdminSetCredential.
+func (c *stockCheckerServiceClient) AdminSetCredential(ctx context.Context, req *connect.Request[v1.AdminSetCredentialRequest]) (*connect.Response[v1.AdminSetCredentialResponse], error) {
+	return c.adminSetCredential.CallUnary(ctx, req)
+}
+
+// AdminClearCredential calls stockchecker.v1.StockCheckerService.AdminClearCredential.
+func (c *stockCheckerServiceClient) AdminClearCredential(ctx context.Context, req *connect.Request[v1.AdminClearCredentialRequest]) (*connect.Response[v1.AdminClearCredentialResponse], error) {
+	return c.adminClearCredential.CallUnary(ctx, req)
+}
+
+// AdminGetApiCallStats calls stockchecker.v1.StockCheckerService.AdminGetApiCallStats.
+func (c *stockCheckerServiceClient) AdminGetApiCallStats(ctx context.Context, req *connect.Request[v1.AdminGetApiCallStatsRequest]) (*connect.Response[v1.AdminGetApiCallStatsResponse], error) {
+	return c.adminGetApiCallStats.CallUnary(ctx, req)
+}
+
+// GetMyApiKeys calls stockchecker.v1.StockCheckerService.GetMyApiKeys.
+func (c *stockCheckerServiceClient) GetMyApiKeys(ctx context.Context, req *connect.Request[v1.GetMyApiKeysRequest]) (*connect.Response[v1.GetMyApiKeysResponse], error) {
+	return c.getMyApiKeys.CallUnary(ctx, req)
+}
+
+// CreateApiKey calls stockchecker.v1.StockCheckerService.CreateApiKey.
+func (c *stockCheckerServiceClient) CreateApiKey(ctx context.Context, req *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error) {
+	return c.createApiKey.CallUnary(ctx, req)
+}
+
+// RevokeApiKey calls stockchecker.v1.StockCheckerService.RevokeApiKey.
+func (c *stockCheckerServiceClient) RevokeApiKey(ctx context.Context, req *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error) {
+	return c.revokeApiKey.CallUnary(ctx, req)
+}
+
+// ListSessions calls stockchecker.v1.StockCheckerService.ListSessions.
+func (c *stockCheckerServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls stockchecker.v1.StockCheckerService.RevokeSession.
+func (c *stockCheckerServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// ExportMyData calls stockchecker.v1.StockCheckerService.ExportMyData.
+func (c *stockCheckerServiceClient) ExportMyData(ctx context.Context, req *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return c.exportMyData.CallUnary(ctx, req)
+}
+
+// DeleteMyAccount calls stockchecker.v1.StockCheckerService.DeleteMyAccount.
+func (c *stockCheckerServiceClient) DeleteMyAccount(ctx context.Context, req *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error) {
+	return c.deleteMyAccount.CallUnary(ctx, req)
+}
+
+// GetClientBootstrap calls stockchecker.v1.StockCheckerService.GetClientBootstrap.
+func (c *stockCheckerServiceClient) GetClientBootstrap(ctx context.Context, req *connect.Request[v1.GetClientBootstrapRequest]) (*connect.Response[v1.GetClientBootstrapResponse], error) {
+	return c.getClientBootstrap.CallUnary(ctx, req)
+}
+
+// GetApiInfo calls stockchecker.v1.StockCheckerService.GetApiInfo.
+func (c *stockCheckerServiceClient) GetApiInfo(ctx context.Context, req *connect.Request[v1.GetApiInfoRequest]) (*connect.Response[v1.GetApiInfoResponse], error) {
+	return c.getApiInfo.CallUnary(ctx, req)
+}
+
+// GetMyUsage calls stockchecker.v1.StockCheckerService.GetMyUsage.
+func (c *stockCheckerServiceClient) GetMyUsage(ctx context.Context, req *connect.Request[v1.GetMyUsageRequest]) (*connect.Response[v1.GetMyUsageResponse], error) {
+	return c.getMyUsage.CallUnary(ctx, req)
+}
+
+// UpcomingDrops calls stockchecker.v1.StockCheckerService.UpcomingDrops.
+func (c *stockCheckerServiceClient) UpcomingDrops(ctx context.Context, req *connect.Request[v1.UpcomingDropsRequest]) (*connect.Response[v1.UpcomingDropsResponse], error) {
+	return c.upcomingDrops.CallUnary(ctx, req)
+}
+
+// AdminSaveDrop calls stockchecker.v1.StockCheckerService.AdminSaveDrop.
+func (c *stockCheckerServiceClient) AdminSaveDrop(ctx context.Context, req *connect.Request[v1.AdminSaveDropRequest]) (*connect.Response[v1.AdminSaveDropResponse], error) {
+	return c.adminSaveDrop.CallUnary(ctx, req)
+}
+
+// AdminDeleteDrop calls stockchecker.v1.StockCheckerService.AdminDeleteDrop.
+func (c *stockCheckerServiceClient) AdminDeleteDrop(ctx context.Context, req *connect.Request[v1.AdminDeleteDropRequest]) (*connect.Response[v1.AdminDeleteDropResponse], error) {
+	return c.adminDeleteDrop.CallUnary(ctx, req)
+}
+
+// GetMyInboundEmail calls stockchecker.v1.StockCheckerService.GetMyInboundEmail.
+func (c *stockCheckerServiceClient) GetMyInboundEmail(ctx context.Context, req *connect.Request[v1.GetMyInboundEmailRequest]) (*connect.Response[v1.GetMyInboundEmailResponse], error) {
+	return c.getMyInboundEmail.CallUnary(ctx, req)
+}
+
+// ResetMyInboundEmail calls stockchecker.v1.StockCheckerService.ResetMyInboundEmail.
+func (c *stockCheckerServiceClient) ResetMyInboundEmail(ctx context.Context, req *connect.Request[v1.ResetMyInboundEmailRequest]) (*connect.Response[v1.ResetMyInboundEmailResponse], error) {
+	return c.resetMyInboundEmail.CallUnary(ctx, req)
+}
+
+// ListMyReminders calls stockchecker.v1.StockCheckerService.ListMyReminders.
+func (c *stockCheckerServiceClient) ListMyReminders(ctx context.Context, req *connect.Request[v1.ListMyRemindersRequest]) (*connect.Response[v1.ListMyRemindersResponse], error) {
+	return c.listMyReminders.CallUnary(ctx, req)
+}
+
+// DeleteMyReminder calls stockchecker.v1.StockCheckerService.DeleteMyReminder.
+func (c *stockCheckerServiceClient) DeleteMyReminder(ctx context.Context, req *connect.Request[v1.DeleteMyReminderRequest]) (*connect.Response[v1.DeleteMyReminderResponse], error) {
+	return c.deleteMyReminder.CallUnary(ctx, req)
+}
+
+// StockCheckerServiceHandler is an implementation of the stockchecker.v1.StockCheckerService
+// service.
+type StockCheckerServiceHandler interface {
 	// SearchStores searches for Best Buy stores near a location
 	SearchStores(context.Context, *connect.Request[v1.SearchStoresRequest]) (*connect.Response[v1.SearchStoresResponse], error)
 	// SearchProducts searches for products by keyword or SKU
@@ -255,6 +1697,8 @@ type StockCheckerServiceHandler interface {
 	CheckStock(context.Context, *connect.Request[v1.CheckStockRequest]) (*connect.Response[v1.CheckStockResponse], error)
 	// GetCurrentUser returns the currently authenticated user
 	GetCurrentUser(context.Context, *connect.Request[v1.GetCurrentUserRequest]) (*connect.Response[v1.GetCurrentUserResponse], error)
+	// SetMyLocale sets the language used for the user's notifications and messages
+	SetMyLocale(context.Context, *connect.Request[v1.SetMyLocaleRequest]) (*connect.Response[v1.SetMyLocaleResponse], error)
 	// GetMyStores returns the user's saved stores
 	GetMyStores(context.Context, *connect.Request[v1.GetMyStoresRequest]) (*connect.Response[v1.GetMyStoresResponse], error)
 	// AddMyStore adds a store to the user's list
@@ -265,10 +1709,192 @@ type StockCheckerServiceHandler interface {
 	GetMyProducts(context.Context, *connect.Request[v1.GetMyProductsRequest]) (*connect.Response[v1.GetMyProductsResponse], error)
 	// AddMyProduct adds a product to the user's list
 	AddMyProduct(context.Context, *connect.Request[v1.AddMyProductRequest]) (*connect.Response[v1.AddMyProductResponse], error)
+	// UpdateMyProduct changes the fields of a saved product named in the update mask
+	UpdateMyProduct(context.Context, *connect.Request[v1.UpdateMyProductRequest]) (*connect.Response[v1.UpdateMyProductResponse], error)
 	// RemoveMyProduct removes a product from the user's list
 	RemoveMyProduct(context.Context, *connect.Request[v1.RemoveMyProductRequest]) (*connect.Response[v1.RemoveMyProductResponse], error)
+	// RemoveMyProducts removes several products from the user's list in one transaction
+	RemoveMyProducts(context.Context, *connect.Request[v1.RemoveMyProductsRequest]) (*connect.Response[v1.RemoveMyProductsResponse], error)
+	// ClearWatchlist removes every product (and optionally store) from the
+	// user's list in one transaction; confirm must be set
+	ClearWatchlist(context.Context, *connect.Request[v1.ClearWatchlistRequest]) (*connect.Response[v1.ClearWatchlistResponse], error)
+	// ImportMyProducts adds a pasted list of products to the user's list
+	ImportMyProducts(context.Context, *connect.Request[v1.ImportMyProductsRequest]) (*connect.Response[v1.ImportMyProductsResponse], error)
 	// BrowsePokemonProducts returns Pokemon products from Best Buy's trading cards category
 	BrowsePokemonProducts(context.Context, *connect.Request[v1.BrowsePokemonProductsRequest]) (*connect.Response[v1.BrowsePokemonProductsResponse], error)
+	// GetNotificationPreferences returns the user's notification preferences
+	GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error)
+	// UpdateNotificationPreferences changes the preferences named in the update mask
+	UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error)
+	// GetAlertRules returns the user's per-product alert rules
+	GetAlertRules(context.Context, *connect.Request[v1.GetAlertRulesRequest]) (*connect.Response[v1.GetAlertRulesResponse], error)
+	// UpdateAlertRule changes the rule fields named in the update mask
+	UpdateAlertRule(context.Context, *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error)
+	// GetNotificationTemplates returns the user's notification templates and the admin defaults
+	GetNotificationTemplates(context.Context, *connect.Request[v1.GetNotificationTemplatesRequest]) (*connect.Response[v1.GetNotificationTemplatesResponse], error)
+	// SetNotificationTemplate validates and saves a notification template
+	SetNotificationTemplate(context.Context, *connect.Request[v1.SetNotificationTemplateRequest]) (*connect.Response[v1.SetNotificationTemplateResponse], error)
+	// DeleteNotificationTemplate removes a notification template
+	DeleteNotificationTemplate(context.Context, *connect.Request[v1.DeleteNotificationTemplateRequest]) (*connect.Response[v1.DeleteNotificationTemplateResponse], error)
+	// GetNotificationChannels returns the user's notification channels
+	GetNotificationChannels(context.Context, *connect.Request[v1.GetNotificationChannelsRequest]) (*connect.Response[v1.GetNotificationChannelsResponse], error)
+	// SetNotificationChannel validates and saves a notification channel
+	SetNotificationChannel(context.Context, *connect.Request[v1.SetNotificationChannelRequest]) (*connect.Response[v1.SetNotificationChannelResponse], error)
+	// DeleteNotificationChannel removes a notification channel
+	DeleteNotificationChannel(context.Context, *connect.Request[v1.DeleteNotificationChannelRequest]) (*connect.Response[v1.DeleteNotificationChannelResponse], error)
+	// SendTestNotification previews a notification and optionally sends it over a configured channel
+	SendTestNotification(context.Context, *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[v1.SendTestNotificationResponse], error)
+	// SimulateWatcherCycle runs the stock watcher without sending anything and reports which notifications would fire (admin only)
+	SimulateWatcherCycle(context.Context, *connect.Request[v1.SimulateWatcherCycleRequest]) (*connect.Response[v1.SimulateWatcherCycleResponse], error)
+	// GetMyDashboard returns current and recent availability of the user's watched products
+	GetMyDashboard(context.Context, *connect.Request[v1.GetMyDashboardRequest]) (*connect.Response[v1.GetMyDashboardResponse], error)
+	// GetMyLocations returns the user's locations
+	GetMyLocations(context.Context, *connect.Request[v1.GetMyLocationsRequest]) (*connect.Response[v1.GetMyLocationsResponse], error)
+	// SetMyLocation creates or replaces one of the user's locations
+	SetMyLocation(context.Context, *connect.Request[v1.SetMyLocationRequest]) (*connect.Response[v1.SetMyLocationResponse], error)
+	// DeleteMyLocation removes one of the user's locations
+	DeleteMyLocation(context.Context, *connect.Request[v1.DeleteMyLocationRequest]) (*connect.Response[v1.DeleteMyLocationResponse], error)
+	// GetProductBarcode returns a scannable barcode for a product
+	GetProductBarcode(context.Context, *connect.Request[v1.GetProductBarcodeRequest]) (*connect.Response[v1.GetProductBarcodeResponse], error)
+	// CheckStoreNow live-checks every watched product at one of the user's stores
+	CheckStoreNow(context.Context, *connect.Request[v1.CheckStoreNowRequest]) (*connect.Response[v1.CheckStoreNowResponse], error)
+	// GetStockHistory returns the availability checks of a product at a store
+	GetStockHistory(context.Context, *connect.Request[v1.GetStockHistoryRequest]) (*connect.Response[v1.GetStockHistoryResponse], error)
+	// WatchStock streams stock changes as the watcher records them. A client
+	// that stops reading for a minute is disconnected; reconnecting with the
+	// last resume token delivers the changes missed in between.
+	WatchStock(context.Context, *connect.Request[v1.WatchStockRequest], *connect.ServerStream[v1.WatchStockResponse]) error
+	// GetOfflineBundle returns the user's watch list and latest stock for offline viewing
+	GetOfflineBundle(context.Context, *connect.Request[v1.GetOfflineBundleRequest]) (*connect.Response[v1.GetOfflineBundleResponse], error)
+	// SyncChanges returns changes to the user's saved data and latest stock since a previous sync
+	SyncChanges(context.Context, *connect.Request[v1.SyncChangesRequest]) (*connect.Response[v1.SyncChangesResponse], error)
+	// ListWatchlistChanges returns the history of changes to the user's saved stores and products
+	ListWatchlistChanges(context.Context, *connect.Request[v1.ListWatchlistChangesRequest]) (*connect.Response[v1.ListWatchlistChangesResponse], error)
+	// UndoLastChange reverses the user's most recent change to their saved
+	// stores or products, if it was made within the last hour. Calling it again
+	// undoes the change before that.
+	UndoLastChange(context.Context, *connect.Request[v1.UndoLastChangeRequest]) (*connect.Response[v1.UndoLastChangeResponse], error)
+	// GetProductDetails returns a product with the details of its TCG set
+	GetProductDetails(context.Context, *connect.Request[v1.GetProductDetailsRequest]) (*connect.Response[v1.GetProductDetailsResponse], error)
+	// ListMsrps returns the MSRPs listings are compared against
+	ListMsrps(context.Context, *connect.Request[v1.ListMsrpsRequest]) (*connect.Response[v1.ListMsrpsResponse], error)
+	// SetMsrp sets or removes an MSRP (admin only)
+	SetMsrp(context.Context, *connect.Request[v1.SetMsrpRequest]) (*connect.Response[v1.SetMsrpResponse], error)
+	// GetMySetWatches returns the sets the user watches
+	GetMySetWatches(context.Context, *connect.Request[v1.GetMySetWatchesRequest]) (*connect.Response[v1.GetMySetWatchesResponse], error)
+	// WatchSet saves every product from a set, including ones listed later.
+	// Products the user removes stay removed.
+	WatchSet(context.Context, *connect.Request[v1.WatchSetRequest]) (*connect.Response[v1.WatchSetResponse], error)
+	// UnwatchSet stops watching a set
+	UnwatchSet(context.Context, *connect.Request[v1.UnwatchSetRequest]) (*connect.Response[v1.UnwatchSetResponse], error)
+	// MarkPurchased records a purchase of one of the user's saved products
+	MarkPurchased(context.Context, *connect.Request[v1.MarkPurchasedRequest]) (*connect.Response[v1.MarkPurchasedResponse], error)
+	// GetMyAcquisitions returns the user's recorded purchases, newest first
+	GetMyAcquisitions(context.Context, *connect.Request[v1.GetMyAcquisitionsRequest]) (*connect.Response[v1.GetMyAcquisitionsResponse], error)
+	// DeleteAcquisition removes one of the user's recorded purchases
+	DeleteAcquisition(context.Context, *connect.Request[v1.DeleteAcquisitionRequest]) (*connect.Response[v1.DeleteAcquisitionResponse], error)
+	// GetAcquisitionSummary totals the user's spend per month and set
+	GetAcquisitionSummary(context.Context, *connect.Request[v1.GetAcquisitionSummaryRequest]) (*connect.Response[v1.GetAcquisitionSummaryResponse], error)
+	// ConfirmStock records whether an alerted product was really on a store's shelf
+	ConfirmStock(context.Context, *connect.Request[v1.ConfirmStockRequest]) (*connect.Response[v1.ConfirmStockResponse], error)
+	// GetStoreReliability scores how often stores' reported stock was on the shelf
+	GetStoreReliability(context.Context, *connect.Request[v1.GetStoreReliabilityRequest]) (*connect.Response[v1.GetStoreReliabilityResponse], error)
+	// ReportSighting reports stock seen on a store's shelf, for moderation
+	ReportSighting(context.Context, *connect.Request[v1.ReportSightingRequest]) (*connect.Response[v1.ReportSightingResponse], error)
+	// ListSightings lists reported sightings for moderation (admin only)
+	ListSightings(context.Context, *connect.Request[v1.ListSightingsRequest]) (*connect.Response[v1.ListSightingsResponse], error)
+	// GetSightingPhoto returns a sighting's photo (admin only)
+	GetSightingPhoto(context.Context, *connect.Request[v1.GetSightingPhotoRequest]) (*connect.Response[v1.GetSightingPhotoResponse], error)
+	// ModerateSighting approves or rejects a sighting, alerting watchers if approved (admin only)
+	ModerateSighting(context.Context, *connect.Request[v1.ModerateSightingRequest]) (*connect.Response[v1.ModerateSightingResponse], error)
+	// GetProductDomain returns the kind of product the deployment tracks
+	GetProductDomain(context.Context, *connect.Request[v1.GetProductDomainRequest]) (*connect.Response[v1.GetProductDomainResponse], error)
+	// GetMyProductWatches returns the user's product watches
+	GetMyProductWatches(context.Context, *connect.Request[v1.GetMyProductWatchesRequest]) (*connect.Response[v1.GetMyProductWatchesResponse], error)
+	// WatchProducts alerts the user when Best Buy lists a new product matching a search
+	WatchProducts(context.Context, *connect.Request[v1.WatchProductsRequest]) (*connect.Response[v1.WatchProductsResponse], error)
+	// UnwatchProducts stops a product watch
+	UnwatchProducts(context.Context, *connect.Request[v1.UnwatchProductsRequest]) (*connect.Response[v1.UnwatchProductsResponse], error)
+	// AdminAddAllowedEmail allows an email address to sign in (admin only)
+	AdminAddAllowedEmail(context.Context, *connect.Request[v1.AdminAddAllowedEmailRequest]) (*connect.Response[v1.AdminAddAllowedEmailResponse], error)
+	// AdminRemoveAllowedEmail stops an email address signing in and signs it out (admin only)
+	AdminRemoveAllowedEmail(context.Context, *connect.Request[v1.AdminRemoveAllowedEmailRequest]) (*connect.Response[v1.AdminRemoveAllowedEmailResponse], error)
+	// AdminListAllowedEmails lists the email addresses allowed to sign in (admin only)
+	AdminListAllowedEmails(context.Context, *connect.Request[v1.AdminListAllowedEmailsRequest]) (*connect.Response[v1.AdminListAllowedEmailsResponse], error)
+	// AdminAddAllowedDomain allows every verified address on a domain to sign in (admin only)
+	AdminAddAllowedDomain(context.Context, *connect.Request[v1.AdminAddAllowedDomainRequest]) (*connect.Response[v1.AdminAddAllowedDomainResponse], error)
+	// AdminRemoveAllowedDomain stops a domain's addresses signing in and signs
+	// out those not allowed individually (admin only)
+	AdminRemoveAllowedDomain(context.Context, *connect.Request[v1.AdminRemoveAllowedDomainRequest]) (*connect.Response[v1.AdminRemoveAllowedDomainResponse], error)
+	// AdminListAllowedDomains lists the email domains allowed to sign in (admin only)
+	AdminListAllowedDomains(context.Context, *connect.Request[v1.AdminListAllowedDomainsRequest]) (*connect.Response[v1.AdminListAllowedDomainsResponse], error)
+	// AdminCreateInvite creates a single-use, expiring link that allows
+	// whoever signs in with it (admin only)
+	AdminCreateInvite(context.Context, *connect.Request[v1.AdminCreateInviteRequest]) (*connect.Response[v1.AdminCreateInviteResponse], error)
+	// AdminListInvites lists invite links, used or not (admin only)
+	AdminListInvites(context.Context, *connect.Request[v1.AdminListInvitesRequest]) (*connect.Response[v1.AdminListInvitesResponse], error)
+	// AdminRevokeInvite revokes an invite link that hasn't been used (admin only)
+	AdminRevokeInvite(context.Context, *connect.Request[v1.AdminRevokeInviteRequest]) (*connect.Response[v1.AdminRevokeInviteResponse], error)
+	// AdminListUsers lists the users who have signed in (admin only)
+	AdminListUsers(context.Context, *connect.Request[v1.AdminListUsersRequest]) (*connect.Response[v1.AdminListUsersResponse], error)
+	// AdminSetUserRole promotes a user to admin or demotes them (admin only)
+	AdminSetUserRole(context.Context, *connect.Request[v1.AdminSetUserRoleRequest]) (*connect.Response[v1.AdminSetUserRoleResponse], error)
+	// AdminListCredentials lists the retailer keys and notifier settings admins can rotate (admin only)
+	AdminListCredentials(context.Context, *connect.Request[v1.AdminListCredentialsRequest]) (*connect.Response[v1.AdminListCredentialsResponse], error)
+	// AdminSetCredential rotates a retailer key or notifier setting without a restart (admin only)
+	AdminSetCredential(context.Context, *connect.Request[v1.AdminSetCredentialRequest]) (*connect.Response[v1.AdminSetCredentialResponse], error)
+	// AdminClearCredential goes back to a credential's value from the environment (admin only)
+	AdminClearCredential(context.Context, *connect.Request[v1.AdminClearCredentialRequest]) (*connect.Response[v1.AdminClearCredentialResponse], error)
+	// AdminGetApiCallStats sums up a sample of recent Best Buy API calls by
+	// endpoint and priority, to show what uses up the quota (admin only)
+	AdminGetApiCallStats(context.Context, *connect.Request[v1.AdminGetApiCallStatsRequest]) (*connect.Response[v1.AdminGetApiCallStatsResponse], error)
+	// GetMyApiKeys lists the user's API keys
+	GetMyApiKeys(context.Context, *connect.Request[v1.GetMyApiKeysRequest]) (*connect.Response[v1.GetMyApiKeysResponse], error)
+	// CreateApiKey creates an API key for calling the API without signing in
+	CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error)
+	// RevokeApiKey revokes one of the user's API keys
+	RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error)
+	// ListSessions lists the browsers the user is signed in on
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession signs out one of the user's sessions, or all of them
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// ExportMyData dumps everything stored about the user as JSON, for keeping
+	// a copy or moving to another instance
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
+	// DeleteMyAccount deletes the user's account and all their data. The only
+	// admin can't delete their account.
+	DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error)
+	// GetClientBootstrap returns everything the web app needs on load in one call;
+	// it works signed out, leaving the user's parts unset
+	GetClientBootstrap(context.Context, *connect.Request[v1.GetClientBootstrapRequest]) (*connect.Response[v1.GetClientBootstrapResponse], error)
+	// GetApiInfo returns the API changelog and what's deprecated, for
+	// integrators to check before upgrading; it works signed out
+	GetApiInfo(context.Context, *connect.Request[v1.GetApiInfoRequest]) (*connect.Response[v1.GetApiInfoResponse], error)
+	// GetMyUsage returns the checks and notifications counted for the user
+	// each day, with how busy the shared retailer API is, so users can tell
+	// why checks are slower than usual
+	GetMyUsage(context.Context, *connect.Request[v1.GetMyUsageRequest]) (*connect.Response[v1.GetMyUsageResponse], error)
+	// UpcomingDrops lists invitation-only drops of restricted products whose
+	// entry windows haven't ended. Users watching a drop's SKU are notified
+	// when its window opens.
+	UpcomingDrops(context.Context, *connect.Request[v1.UpcomingDropsRequest]) (*connect.Response[v1.UpcomingDropsResponse], error)
+	// AdminSaveDrop creates or updates a drop from a retailer's announcement;
+	// moving its opening time notifies watchers again (admin only)
+	AdminSaveDrop(context.Context, *connect.Request[v1.AdminSaveDropRequest]) (*connect.Response[v1.AdminSaveDropResponse], error)
+	// AdminDeleteDrop deletes a drop (admin only)
+	AdminDeleteDrop(context.Context, *connect.Request[v1.AdminDeleteDropRequest]) (*connect.Response[v1.AdminDeleteDropResponse], error)
+	// GetMyInboundEmail returns the user's address for forwarding Best Buy
+	// invitation and order-ready emails, which become reminders before the
+	// invitation expires or the order goes back to the shelf. The address is
+	// made on the first call.
+	GetMyInboundEmail(context.Context, *connect.Request[v1.GetMyInboundEmailRequest]) (*connect.Response[v1.GetMyInboundEmailResponse], error)
+	// ResetMyInboundEmail replaces the user's forwarding address, e.g. if it
+	// leaked; mail to the old one is ignored
+	ResetMyInboundEmail(context.Context, *connect.Request[v1.ResetMyInboundEmailRequest]) (*connect.Response[v1.ResetMyInboundEmailResponse], error)
+	// ListMyReminders lists the reminders made from the user's forwarded emails
+	ListMyReminders(context.Context, *connect.Request[v1.ListMyRemindersRequest]) (*connect.Response[v1.ListMyRemindersResponse], error)
+	// DeleteMyReminder deletes a reminder, so it isn't sent
+	DeleteMyReminder(context.Context, *connect.Request[v1.DeleteMyReminderRequest]) (*connect.Response[v1.DeleteMyReminderResponse], error)
 }
 
 // NewStockCheckerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -282,30 +1908,41 @@ func NewStockCheckerServiceHandler(svc StockCheckerServiceHandler, opts ...conne
 		StockCheckerServiceSearchStoresProcedure,
 		svc.SearchStores,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("SearchStores")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	stockCheckerServiceSearchProductsHandler := connect.NewUnaryHandler(
 		StockCheckerServiceSearchProductsProcedure,
 		svc.SearchProducts,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("SearchProducts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	stockCheckerServiceCheckStockHandler := connect.NewUnaryHandler(
 		StockCheckerServiceCheckStockProcedure,
 		svc.CheckStock,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("CheckStock")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	stockCheckerServiceGetCurrentUserHandler := connect.NewUnaryHandler(
 		StockCheckerServiceGetCurrentUserProcedure,
 		svc.GetCurrentUser,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("GetCurrentUser")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSetMyLocaleHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSetMyLocaleProcedure,
+		svc.SetMyLocale,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SetMyLocale")),
 		connect.WithHandlerOptions(opts...),
 	)
 	stockCheckerServiceGetMyStoresHandler := connect.NewUnaryHandler(
 		StockCheckerServiceGetMyStoresProcedure,
 		svc.GetMyStores,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyStores")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	stockCheckerServiceAddMyStoreHandler := connect.NewUnaryHandler(
@@ -324,6 +1961,7 @@ func NewStockCheckerServiceHandler(svc StockCheckerServiceHandler, opts ...conne
 		StockCheckerServiceGetMyProductsProcedure,
 		svc.GetMyProducts,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyProducts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	stockCheckerServiceAddMyProductHandler := connect.NewUnaryHandler(
@@ -332,16 +1970,532 @@ func NewStockCheckerServiceHandler(svc StockCheckerServiceHandler, opts ...conne
 		connect.WithSchema(stockCheckerServiceMethods.ByName("AddMyProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	stockCheckerServiceUpdateMyProductHandler := connect.NewUnaryHandler(
+		StockCheckerServiceUpdateMyProductProcedure,
+		svc.UpdateMyProduct,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("UpdateMyProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
 	stockCheckerServiceRemoveMyProductHandler := connect.NewUnaryHandler(
 		StockCheckerServiceRemoveMyProductProcedure,
 		svc.RemoveMyProduct,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyProduct")),
 		connect.WithHandlerOptions(opts...),
 	)
+	stockCheckerServiceRemoveMyProductsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceRemoveMyProductsProcedure,
+		svc.RemoveMyProducts,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceClearWatchlistHandler := connect.NewUnaryHandler(
+		StockCheckerServiceClearWatchlistProcedure,
+		svc.ClearWatchlist,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ClearWatchlist")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceImportMyProductsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceImportMyProductsProcedure,
+		svc.ImportMyProducts,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ImportMyProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
 	stockCheckerServiceBrowsePokemonProductsHandler := connect.NewUnaryHandler(
 		StockCheckerServiceBrowsePokemonProductsProcedure,
 		svc.BrowsePokemonProducts,
 		connect.WithSchema(stockCheckerServiceMethods.ByName("BrowsePokemonProducts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetNotificationPreferencesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetNotificationPreferencesProcedure,
+		svc.GetNotificationPreferences,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetNotificationPreferences")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceUpdateNotificationPreferencesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceUpdateNotificationPreferencesProcedure,
+		svc.UpdateNotificationPreferences,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("UpdateNotificationPreferences")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetAlertRulesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetAlertRulesProcedure,
+		svc.GetAlertRules,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetAlertRules")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceUpdateAlertRuleHandler := connect.NewUnaryHandler(
+		StockCheckerServiceUpdateAlertRuleProcedure,
+		svc.UpdateAlertRule,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("UpdateAlertRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetNotificationTemplatesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetNotificationTemplatesProcedure,
+		svc.GetNotificationTemplates,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetNotificationTemplates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSetNotificationTemplateHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSetNotificationTemplateProcedure,
+		svc.SetNotificationTemplate,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SetNotificationTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceDeleteNotificationTemplateHandler := connect.NewUnaryHandler(
+		StockCheckerServiceDeleteNotificationTemplateProcedure,
+		svc.DeleteNotificationTemplate,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteNotificationTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetNotificationChannelsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetNotificationChannelsProcedure,
+		svc.GetNotificationChannels,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetNotificationChannels")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSetNotificationChannelHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSetNotificationChannelProcedure,
+		svc.SetNotificationChannel,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SetNotificationChannel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceDeleteNotificationChannelHandler := connect.NewUnaryHandler(
+		StockCheckerServiceDeleteNotificationChannelProcedure,
+		svc.DeleteNotificationChannel,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteNotificationChannel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSendTestNotificationHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSendTestNotificationProcedure,
+		svc.SendTestNotification,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SendTestNotification")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSimulateWatcherCycleHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSimulateWatcherCycleProcedure,
+		svc.SimulateWatcherCycle,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SimulateWatcherCycle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMyDashboardHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMyDashboardProcedure,
+		svc.GetMyDashboard,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyDashboard")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMyLocationsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMyLocationsProcedure,
+		svc.GetMyLocations,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyLocations")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSetMyLocationHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSetMyLocationProcedure,
+		svc.SetMyLocation,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SetMyLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceDeleteMyLocationHandler := connect.NewUnaryHandler(
+		StockCheckerServiceDeleteMyLocationProcedure,
+		svc.DeleteMyLocation,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteMyLocation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetProductBarcodeHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetProductBarcodeProcedure,
+		svc.GetProductBarcode,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetProductBarcode")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceCheckStoreNowHandler := connect.NewUnaryHandler(
+		StockCheckerServiceCheckStoreNowProcedure,
+		svc.CheckStoreNow,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("CheckStoreNow")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetStockHistoryHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetStockHistoryProcedure,
+		svc.GetStockHistory,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetStockHistory")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceWatchStockHandler := connect.NewServerStreamHandler(
+		StockCheckerServiceWatchStockProcedure,
+		svc.WatchStock,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("WatchStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetOfflineBundleHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetOfflineBundleProcedure,
+		svc.GetOfflineBundle,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetOfflineBundle")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSyncChangesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSyncChangesProcedure,
+		svc.SyncChanges,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SyncChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceListWatchlistChangesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListWatchlistChangesProcedure,
+		svc.ListWatchlistChanges,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListWatchlistChanges")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceUndoLastChangeHandler := connect.NewUnaryHandler(
+		StockCheckerServiceUndoLastChangeProcedure,
+		svc.UndoLastChange,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("UndoLastChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetProductDetailsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetProductDetailsProcedure,
+		svc.GetProductDetails,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetProductDetails")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceListMsrpsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListMsrpsProcedure,
+		svc.ListMsrps,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListMsrps")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSetMsrpHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSetMsrpProcedure,
+		svc.SetMsrp,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SetMsrp")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMySetWatchesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMySetWatchesProcedure,
+		svc.GetMySetWatches,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMySetWatches")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceWatchSetHandler := connect.NewUnaryHandler(
+		StockCheckerServiceWatchSetProcedure,
+		svc.WatchSet,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("WatchSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceUnwatchSetHandler := connect.NewUnaryHandler(
+		StockCheckerServiceUnwatchSetProcedure,
+		svc.UnwatchSet,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("UnwatchSet")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceMarkPurchasedHandler := connect.NewUnaryHandler(
+		StockCheckerServiceMarkPurchasedProcedure,
+		svc.MarkPurchased,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("MarkPurchased")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMyAcquisitionsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMyAcquisitionsProcedure,
+		svc.GetMyAcquisitions,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyAcquisitions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceDeleteAcquisitionHandler := connect.NewUnaryHandler(
+		StockCheckerServiceDeleteAcquisitionProcedure,
+		svc.DeleteAcquisition,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteAcquisition")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetAcquisitionSummaryHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetAcquisitionSummaryProcedure,
+		svc.GetAcquisitionSummary,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetAcquisitionSummary")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceConfirmStockHandler := connect.NewUnaryHandler(
+		StockCheckerServiceConfirmStockProcedure,
+		svc.ConfirmStock,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ConfirmStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetStoreReliabilityHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetStoreReliabilityProcedure,
+		svc.GetStoreReliability,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetStoreReliability")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceReportSightingHandler := connect.NewUnaryHandler(
+		StockCheckerServiceReportSightingProcedure,
+		svc.ReportSighting,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ReportSighting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceListSightingsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListSightingsProcedure,
+		svc.ListSightings,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListSightings")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetSightingPhotoHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetSightingPhotoProcedure,
+		svc.GetSightingPhoto,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetSightingPhoto")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceModerateSightingHandler := connect.NewUnaryHandler(
+		StockCheckerServiceModerateSightingProcedure,
+		svc.ModerateSighting,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ModerateSighting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetProductDomainHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetProductDomainProcedure,
+		svc.GetProductDomain,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetProductDomain")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMyProductWatchesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMyProductWatchesProcedure,
+		svc.GetMyProductWatches,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyProductWatches")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceWatchProductsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceWatchProductsProcedure,
+		svc.WatchProducts,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("WatchProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceUnwatchProductsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceUnwatchProductsProcedure,
+		svc.UnwatchProducts,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("UnwatchProducts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminAddAllowedEmailHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminAddAllowedEmailProcedure,
+		svc.AdminAddAllowedEmail,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminAddAllowedEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminRemoveAllowedEmailHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminRemoveAllowedEmailProcedure,
+		svc.AdminRemoveAllowedEmail,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminRemoveAllowedEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminListAllowedEmailsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminListAllowedEmailsProcedure,
+		svc.AdminListAllowedEmails,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListAllowedEmails")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminAddAllowedDomainHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminAddAllowedDomainProcedure,
+		svc.AdminAddAllowedDomain,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminAddAllowedDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminRemoveAllowedDomainHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminRemoveAllowedDomainProcedure,
+		svc.AdminRemoveAllowedDomain,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminRemoveAllowedDomain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminListAllowedDomainsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminListAllowedDomainsProcedure,
+		svc.AdminListAllowedDomains,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListAllowedDomains")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminCreateInviteHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminCreateInviteProcedure,
+		svc.AdminCreateInvite,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminCreateInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminListInvitesHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminListInvitesProcedure,
+		svc.AdminListInvites,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListInvites")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminRevokeInviteHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminRevokeInviteProcedure,
+		svc.AdminRevokeInvite,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminRevokeInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminListUsersHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminListUsersProcedure,
+		svc.AdminListUsers,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListUsers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminSetUserRoleHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminSetUserRoleProcedure,
+		svc.AdminSetUserRole,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminSetUserRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminListCredentialsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminListCredentialsProcedure,
+		svc.AdminListCredentials,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminListCredentials")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminSetCredentialHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminSetCredentialProcedure,
+		svc.AdminSetCredential,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminSetCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminClearCredentialHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminClearCredentialProcedure,
+		svc.AdminClearCredential,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminClearCredential")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminGetApiCallStatsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminGetApiCallStatsProcedure,
+		svc.AdminGetApiCallStats,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminGetApiCallStats")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMyApiKeysHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMyApiKeysProcedure,
+		svc.GetMyApiKeys,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyApiKeys")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceCreateApiKeyHandler := connect.NewUnaryHandler(
+		StockCheckerServiceCreateApiKeyProcedure,
+		svc.CreateApiKey,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("CreateApiKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceRevokeApiKeyHandler := connect.NewUnaryHandler(
+		StockCheckerServiceRevokeApiKeyProcedure,
+		svc.RevokeApiKey,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("RevokeApiKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceListSessionsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListSessions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		StockCheckerServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceExportMyDataHandler := connect.NewUnaryHandler(
+		StockCheckerServiceExportMyDataProcedure,
+		svc.ExportMyData,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ExportMyData")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceDeleteMyAccountHandler := connect.NewUnaryHandler(
+		StockCheckerServiceDeleteMyAccountProcedure,
+		svc.DeleteMyAccount,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteMyAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetClientBootstrapHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetClientBootstrapProcedure,
+		svc.GetClientBootstrap,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetClientBootstrap")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetApiInfoHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetApiInfoProcedure,
+		svc.GetApiInfo,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetApiInfo")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMyUsageHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMyUsageProcedure,
+		svc.GetMyUsage,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceUpcomingDropsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceUpcomingDropsProcedure,
+		svc.UpcomingDrops,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("UpcomingDrops")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminSaveDropHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminSaveDropProcedure,
+		svc.AdminSaveDrop,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminSaveDrop")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAdminDeleteDropHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAdminDeleteDropProcedure,
+		svc.AdminDeleteDrop,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AdminDeleteDrop")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceGetMyInboundEmailHandler := connect.NewUnaryHandler(
+		StockCheckerServiceGetMyInboundEmailProcedure,
+		svc.GetMyInboundEmail,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("GetMyInboundEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceResetMyInboundEmailHandler := connect.NewUnaryHandler(
+		StockCheckerServiceResetMyInboundEmailProcedure,
+		svc.ResetMyInboundEmail,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ResetMyInboundEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceListMyRemindersHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListMyRemindersProcedure,
+		svc.ListMyReminders,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListMyReminders")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceDeleteMyReminderHandler := connect.NewUnaryHandler(
+		StockCheckerServiceDeleteMyReminderProcedure,
+		svc.DeleteMyReminder,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("DeleteMyReminder")),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/stockchecker.v1.StockCheckerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -354,6 +2508,8 @@ func NewStockCheckerServiceHandler(svc StockCheckerServiceHandler, opts ...conne
 			stockCheckerServiceCheckStockHandler.ServeHTTP(w, r)
 		case StockCheckerServiceGetCurrentUserProcedure:
 			stockCheckerServiceGetCurrentUserHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSetMyLocaleProcedure:
+			stockCheckerServiceSetMyLocaleHandler.ServeHTTP(w, r)
 		case StockCheckerServiceGetMyStoresProcedure:
 			stockCheckerServiceGetMyStoresHandler.ServeHTTP(w, r)
 		case StockCheckerServiceAddMyStoreProcedure:
@@ -364,10 +2520,170 @@ func NewStockCheckerServiceHandler(svc StockCheckerServiceHandler, opts ...conne
 			stockCheckerServiceGetMyProductsHandler.ServeHTTP(w, r)
 		case StockCheckerServiceAddMyProductProcedure:
 			stockCheckerServiceAddMyProductHandler.ServeHTTP(w, r)
+		case StockCheckerServiceUpdateMyProductProcedure:
+			stockCheckerServiceUpdateMyProductHandler.ServeHTTP(w, r)
 		case StockCheckerServiceRemoveMyProductProcedure:
 			stockCheckerServiceRemoveMyProductHandler.ServeHTTP(w, r)
+		case StockCheckerServiceRemoveMyProductsProcedure:
+			stockCheckerServiceRemoveMyProductsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceClearWatchlistProcedure:
+			stockCheckerServiceClearWatchlistHandler.ServeHTTP(w, r)
+		case StockCheckerServiceImportMyProductsProcedure:
+			stockCheckerServiceImportMyProductsHandler.ServeHTTP(w, r)
 		case StockCheckerServiceBrowsePokemonProductsProcedure:
 			stockCheckerServiceBrowsePokemonProductsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetNotificationPreferencesProcedure:
+			stockCheckerServiceGetNotificationPreferencesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceUpdateNotificationPreferencesProcedure:
+			stockCheckerServiceUpdateNotificationPreferencesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetAlertRulesProcedure:
+			stockCheckerServiceGetAlertRulesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceUpdateAlertRuleProcedure:
+			stockCheckerServiceUpdateAlertRuleHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetNotificationTemplatesProcedure:
+			stockCheckerServiceGetNotificationTemplatesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSetNotificationTemplateProcedure:
+			stockCheckerServiceSetNotificationTemplateHandler.ServeHTTP(w, r)
+		case StockCheckerServiceDeleteNotificationTemplateProcedure:
+			stockCheckerServiceDeleteNotificationTemplateHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetNotificationChannelsProcedure:
+			stockCheckerServiceGetNotificationChannelsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSetNotificationChannelProcedure:
+			stockCheckerServiceSetNotificationChannelHandler.ServeHTTP(w, r)
+		case StockCheckerServiceDeleteNotificationChannelProcedure:
+			stockCheckerServiceDeleteNotificationChannelHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSendTestNotificationProcedure:
+			stockCheckerServiceSendTestNotificationHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSimulateWatcherCycleProcedure:
+			stockCheckerServiceSimulateWatcherCycleHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMyDashboardProcedure:
+			stockCheckerServiceGetMyDashboardHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMyLocationsProcedure:
+			stockCheckerServiceGetMyLocationsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSetMyLocationProcedure:
+			stockCheckerServiceSetMyLocationHandler.ServeHTTP(w, r)
+		case StockCheckerServiceDeleteMyLocationProcedure:
+			stockCheckerServiceDeleteMyLocationHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetProductBarcodeProcedure:
+			stockCheckerServiceGetProductBarcodeHandler.ServeHTTP(w, r)
+		case StockCheckerServiceCheckStoreNowProcedure:
+			stockCheckerServiceCheckStoreNowHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetStockHistoryProcedure:
+			stockCheckerServiceGetStockHistoryHandler.ServeHTTP(w, r)
+		case StockCheckerServiceWatchStockProcedure:
+			stockCheckerServiceWatchStockHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetOfflineBundleProcedure:
+			stockCheckerServiceGetOfflineBundleHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSyncChangesProcedure:
+			stockCheckerServiceSyncChangesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceListWatchlistChangesProcedure:
+			stockCheckerServiceListWatchlistChangesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceUndoLastChangeProcedure:
+			stockCheckerServiceUndoLastChangeHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetProductDetailsProcedure:
+			stockCheckerServiceGetProductDetailsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceListMsrpsProcedure:
+			stockCheckerServiceListMsrpsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSetMsrpProcedure:
+			stockCheckerServiceSetMsrpHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMySetWatchesProcedure:
+			stockCheckerServiceGetMySetWatchesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceWatchSetProcedure:
+			stockCheckerServiceWatchSetHandler.ServeHTTP(w, r)
+		case StockCheckerServiceUnwatchSetProcedure:
+			stockCheckerServiceUnwatchSetHandler.ServeHTTP(w, r)
+		case StockCheckerServiceMarkPurchasedProcedure:
+			stockCheckerServiceMarkPurchasedHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMyAcquisitionsProcedure:
+			stockCheckerServiceGetMyAcquisitionsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceDeleteAcquisitionProcedure:
+			stockCheckerServiceDeleteAcquisitionHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetAcquisitionSummaryProcedure:
+			stockCheckerServiceGetAcquisitionSummaryHandler.ServeHTTP(w, r)
+		case StockCheckerServiceConfirmStockProcedure:
+			stockCheckerServiceConfirmStockHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetStoreReliabilityProcedure:
+			stockCheckerServiceGetStoreReliabilityHandler.ServeHTTP(w, r)
+		case StockCheckerServiceReportSightingProcedure:
+			stockCheckerServiceReportSightingHandler.ServeHTTP(w, r)
+		case StockCheckerServiceListSightingsProcedure:
+			stockCheckerServiceListSightingsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetSightingPhotoProcedure:
+			stockCheckerServiceGetSightingPhotoHandler.ServeHTTP(w, r)
+		case StockCheckerServiceModerateSightingProcedure:
+			stockCheckerServiceModerateSightingHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetProductDomainProcedure:
+			stockCheckerServiceGetProductDomainHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMyProductWatchesProcedure:
+			stockCheckerServiceGetMyProductWatchesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceWatchProductsProcedure:
+			stockCheckerServiceWatchProductsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceUnwatchProductsProcedure:
+			stockCheckerServiceUnwatchProductsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminAddAllowedEmailProcedure:
+			stockCheckerServiceAdminAddAllowedEmailHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminRemoveAllowedEmailProcedure:
+			stockCheckerServiceAdminRemoveAllowedEmailHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminListAllowedEmailsProcedure:
+			stockCheckerServiceAdminListAllowedEmailsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminAddAllowedDomainProcedure:
+			stockCheckerServiceAdminAddAllowedDomainHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminRemoveAllowedDomainProcedure:
+			stockCheckerServiceAdminRemoveAllowedDomainHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminListAllowedDomainsProcedure:
+			stockCheckerServiceAdminListAllowedDomainsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminCreateInviteProcedure:
+			stockCheckerServiceAdminCreateInviteHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminListInvitesProcedure:
+			stockCheckerServiceAdminListInvitesHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminRevokeInviteProcedure:
+			stockCheckerServiceAdminRevokeInviteHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminListUsersProcedure:
+			stockCheckerServiceAdminListUsersHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminSetUserRoleProcedure:
+			stockCheckerServiceAdminSetUserRoleHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminListCredentialsProcedure:
+			stockCheckerServiceAdminListCredentialsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminSetCredentialProcedure:
+			stockCheckerServiceAdminSetCredentialHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminClearCredentialProcedure:
+			stockCheckerServiceAdminClearCredentialHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminGetApiCallStatsProcedure:
+			stockCheckerServiceAdminGetApiCallStatsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMyApiKeysProcedure:
+			stockCheckerServiceGetMyApiKeysHandler.ServeHTTP(w, r)
+		case StockCheckerServiceCreateApiKeyProcedure:
+			stockCheckerServiceCreateApiKeyHandler.ServeHTTP(w, r)
+		case StockCheckerServiceRevokeApiKeyProcedure:
+			stockCheckerServiceRevokeApiKeyHandler.ServeHTTP(w, r)
+		case StockCheckerServiceListSessionsProcedure:
+			stockCheckerServiceListSessionsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceRevokeSessionProcedure:
+			stockCheckerServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case StockCheckerServiceExportMyDataProcedure:
+			stockCheckerServiceExportMyDataHandler.ServeHTTP(w, r)
+		case StockCheckerServiceDeleteMyAccountProcedure:
+			stockCheckerServiceDeleteMyAccountHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetClientBootstrapProcedure:
+			stockCheckerServiceGetClientBootstrapHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetApiInfoProcedure:
+			stockCheckerServiceGetApiInfoHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMyUsageProcedure:
+			stockCheckerServiceGetMyUsageHandler.ServeHTTP(w, r)
+		case StockCheckerServiceUpcomingDropsProcedure:
+			stockCheckerServiceUpcomingDropsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminSaveDropProcedure:
+			stockCheckerServiceAdminSaveDropHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAdminDeleteDropProcedure:
+			stockCheckerServiceAdminDeleteDropHandler.ServeHTTP(w, r)
+		case StockCheckerServiceGetMyInboundEmailProcedure:
+			stockCheckerServiceGetMyInboundEmailHandler.ServeHTTP(w, r)
+		case StockCheckerServiceResetMyInboundEmailProcedure:
+			stockCheckerServiceResetMyInboundEmailHandler.ServeHTTP(w, r)
+		case StockCheckerServiceListMyRemindersProcedure:
+			stockCheckerServiceListMyRemindersHandler.ServeHTTP(w, r)
+		case StockCheckerServiceDeleteMyReminderProcedure:
+			stockCheckerServiceDeleteMyReminderHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -393,6 +2709,10 @@ func (UnimplementedStockCheckerServiceHandler) GetCurrentUser(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetCurrentUser is not implemented"))
 }
 
+func (UnimplementedStockCheckerServiceHandler) SetMyLocale(context.Context, *connect.Request[v1.SetMyLocaleRequest]) (*connect.Response[v1.SetMyLocaleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SetMyLocale is not implemented"))
+}
+
 func (UnimplementedStockCheckerServiceHandler) GetMyStores(context.Context, *connect.Request[v1.GetMyStoresRequest]) (*connect.Response[v1.GetMyStoresResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyStores is not implemented"))
 }
@@ -413,10 +2733,330 @@ func (UnimplementedStockCheckerServiceHandler) AddMyProduct(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AddMyProduct is not implemented"))
 }
 
+func (UnimplementedStockCheckerServiceHandler) UpdateMyProduct(context.Context, *connect.Request[v1.UpdateMyProductRequest]) (*connect.Response[v1.UpdateMyProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.UpdateMyProduct is not implemented"))
+}
+
 func (UnimplementedStockCheckerServiceHandler) RemoveMyProduct(context.Context, *connect.Request[v1.RemoveMyProductRequest]) (*connect.Response[v1.RemoveMyProductResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.RemoveMyProduct is not implemented"))
 }
 
+func (UnimplementedStockCheckerServiceHandler) RemoveMyProducts(context.Context, *connect.Request[v1.RemoveMyProductsRequest]) (*connect.Response[v1.RemoveMyProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.RemoveMyProducts is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ClearWatchlist(context.Context, *connect.Request[v1.ClearWatchlistRequest]) (*connect.Response[v1.ClearWatchlistResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ClearWatchlist is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ImportMyProducts(context.Context, *connect.Request[v1.ImportMyProductsRequest]) (*connect.Response[v1.ImportMyProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ImportMyProducts is not implemented"))
+}
+
 func (UnimplementedStockCheckerServiceHandler) BrowsePokemonProducts(context.Context, *connect.Request[v1.BrowsePokemonProductsRequest]) (*connect.Response[v1.BrowsePokemonProductsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.BrowsePokemonProducts is not implemented"))
 }
+
+func (UnimplementedStockCheckerServiceHandler) GetNotificationPreferences(context.Context, *connect.Request[v1.GetNotificationPreferencesRequest]) (*connect.Response[v1.GetNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) UpdateNotificationPreferences(context.Context, *connect.Request[v1.UpdateNotificationPreferencesRequest]) (*connect.Response[v1.UpdateNotificationPreferencesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.UpdateNotificationPreferences is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetAlertRules(context.Context, *connect.Request[v1.GetAlertRulesRequest]) (*connect.Response[v1.GetAlertRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetAlertRules is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) UpdateAlertRule(context.Context, *connect.Request[v1.UpdateAlertRuleRequest]) (*connect.Response[v1.UpdateAlertRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.UpdateAlertRule is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetNotificationTemplates(context.Context, *connect.Request[v1.GetNotificationTemplatesRequest]) (*connect.Response[v1.GetNotificationTemplatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetNotificationTemplates is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SetNotificationTemplate(context.Context, *connect.Request[v1.SetNotificationTemplateRequest]) (*connect.Response[v1.SetNotificationTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SetNotificationTemplate is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) DeleteNotificationTemplate(context.Context, *connect.Request[v1.DeleteNotificationTemplateRequest]) (*connect.Response[v1.DeleteNotificationTemplateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.DeleteNotificationTemplate is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetNotificationChannels(context.Context, *connect.Request[v1.GetNotificationChannelsRequest]) (*connect.Response[v1.GetNotificationChannelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetNotificationChannels is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SetNotificationChannel(context.Context, *connect.Request[v1.SetNotificationChannelRequest]) (*connect.Response[v1.SetNotificationChannelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SetNotificationChannel is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) DeleteNotificationChannel(context.Context, *connect.Request[v1.DeleteNotificationChannelRequest]) (*connect.Response[v1.DeleteNotificationChannelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.DeleteNotificationChannel is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SendTestNotification(context.Context, *connect.Request[v1.SendTestNotificationRequest]) (*connect.Response[v1.SendTestNotificationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SendTestNotification is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SimulateWatcherCycle(context.Context, *connect.Request[v1.SimulateWatcherCycleRequest]) (*connect.Response[v1.SimulateWatcherCycleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SimulateWatcherCycle is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMyDashboard(context.Context, *connect.Request[v1.GetMyDashboardRequest]) (*connect.Response[v1.GetMyDashboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyDashboard is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMyLocations(context.Context, *connect.Request[v1.GetMyLocationsRequest]) (*connect.Response[v1.GetMyLocationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyLocations is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SetMyLocation(context.Context, *connect.Request[v1.SetMyLocationRequest]) (*connect.Response[v1.SetMyLocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SetMyLocation is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) DeleteMyLocation(context.Context, *connect.Request[v1.DeleteMyLocationRequest]) (*connect.Response[v1.DeleteMyLocationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.DeleteMyLocation is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetProductBarcode(context.Context, *connect.Request[v1.GetProductBarcodeRequest]) (*connect.Response[v1.GetProductBarcodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetProductBarcode is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) CheckStoreNow(context.Context, *connect.Request[v1.CheckStoreNowRequest]) (*connect.Response[v1.CheckStoreNowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.CheckStoreNow is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetStockHistory(context.Context, *connect.Request[v1.GetStockHistoryRequest]) (*connect.Response[v1.GetStockHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetStockHistory is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) WatchStock(context.Context, *connect.Request[v1.WatchStockRequest], *connect.ServerStream[v1.WatchStockResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.WatchStock is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetOfflineBundle(context.Context, *connect.Request[v1.GetOfflineBundleRequest]) (*connect.Response[v1.GetOfflineBundleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetOfflineBundle is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SyncChanges(context.Context, *connect.Request[v1.SyncChangesRequest]) (*connect.Response[v1.SyncChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SyncChanges is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ListWatchlistChanges(context.Context, *connect.Request[v1.ListWatchlistChangesRequest]) (*connect.Response[v1.ListWatchlistChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ListWatchlistChanges is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) UndoLastChange(context.Context, *connect.Request[v1.UndoLastChangeRequest]) (*connect.Response[v1.UndoLastChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.UndoLastChange is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetProductDetails(context.Context, *connect.Request[v1.GetProductDetailsRequest]) (*connect.Response[v1.GetProductDetailsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetProductDetails is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ListMsrps(context.Context, *connect.Request[v1.ListMsrpsRequest]) (*connect.Response[v1.ListMsrpsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ListMsrps is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SetMsrp(context.Context, *connect.Request[v1.SetMsrpRequest]) (*connect.Response[v1.SetMsrpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.SetMsrp is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMySetWatches(context.Context, *connect.Request[v1.GetMySetWatchesRequest]) (*connect.Response[v1.GetMySetWatchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMySetWatches is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) WatchSet(context.Context, *connect.Request[v1.WatchSetRequest]) (*connect.Response[v1.WatchSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.WatchSet is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) UnwatchSet(context.Context, *connect.Request[v1.UnwatchSetRequest]) (*connect.Response[v1.UnwatchSetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.UnwatchSet is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) MarkPurchased(context.Context, *connect.Request[v1.MarkPurchasedRequest]) (*connect.Response[v1.MarkPurchasedResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.MarkPurchased is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMyAcquisitions(context.Context, *connect.Request[v1.GetMyAcquisitionsRequest]) (*connect.Response[v1.GetMyAcquisitionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyAcquisitions is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) DeleteAcquisition(context.Context, *connect.Request[v1.DeleteAcquisitionRequest]) (*connect.Response[v1.DeleteAcquisitionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.DeleteAcquisition is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetAcquisitionSummary(context.Context, *connect.Request[v1.GetAcquisitionSummaryRequest]) (*connect.Response[v1.GetAcquisitionSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetAcquisitionSummary is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ConfirmStock(context.Context, *connect.Request[v1.ConfirmStockRequest]) (*connect.Response[v1.ConfirmStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ConfirmStock is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetStoreReliability(context.Context, *connect.Request[v1.GetStoreReliabilityRequest]) (*connect.Response[v1.GetStoreReliabilityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetStoreReliability is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ReportSighting(context.Context, *connect.Request[v1.ReportSightingRequest]) (*connect.Response[v1.ReportSightingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ReportSighting is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ListSightings(context.Context, *connect.Request[v1.ListSightingsRequest]) (*connect.Response[v1.ListSightingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ListSightings is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetSightingPhoto(context.Context, *connect.Request[v1.GetSightingPhotoRequest]) (*connect.Response[v1.GetSightingPhotoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetSightingPhoto is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ModerateSighting(context.Context, *connect.Request[v1.ModerateSightingRequest]) (*connect.Response[v1.ModerateSightingResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ModerateSighting is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetProductDomain(context.Context, *connect.Request[v1.GetProductDomainRequest]) (*connect.Response[v1.GetProductDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetProductDomain is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMyProductWatches(context.Context, *connect.Request[v1.GetMyProductWatchesRequest]) (*connect.Response[v1.GetMyProductWatchesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyProductWatches is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) WatchProducts(context.Context, *connect.Request[v1.WatchProductsRequest]) (*connect.Response[v1.WatchProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.WatchProducts is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) UnwatchProducts(context.Context, *connect.Request[v1.UnwatchProductsRequest]) (*connect.Response[v1.UnwatchProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.UnwatchProducts is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminAddAllowedEmail(context.Context, *connect.Request[v1.AdminAddAllowedEmailRequest]) (*connect.Response[v1.AdminAddAllowedEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminAddAllowedEmail is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminRemoveAllowedEmail(context.Context, *connect.Request[v1.AdminRemoveAllowedEmailRequest]) (*connect.Response[v1.AdminRemoveAllowedEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminRemoveAllowedEmail is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminListAllowedEmails(context.Context, *connect.Request[v1.AdminListAllowedEmailsRequest]) (*connect.Response[v1.AdminListAllowedEmailsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminListAllowedEmails is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminAddAllowedDomain(context.Context, *connect.Request[v1.AdminAddAllowedDomainRequest]) (*connect.Response[v1.AdminAddAllowedDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminAddAllowedDomain is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminRemoveAllowedDomain(context.Context, *connect.Request[v1.AdminRemoveAllowedDomainRequest]) (*connect.Response[v1.AdminRemoveAllowedDomainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminRemoveAllowedDomain is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminListAllowedDomains(context.Context, *connect.Request[v1.AdminListAllowedDomainsRequest]) (*connect.Response[v1.AdminListAllowedDomainsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminListAllowedDomains is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminCreateInvite(context.Context, *connect.Request[v1.AdminCreateInviteRequest]) (*connect.Response[v1.AdminCreateInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminCreateInvite is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminListInvites(context.Context, *connect.Request[v1.AdminListInvitesRequest]) (*connect.Response[v1.AdminListInvitesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminListInvites is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminRevokeInvite(context.Context, *connect.Request[v1.AdminRevokeInviteRequest]) (*connect.Response[v1.AdminRevokeInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminRevokeInvite is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminListUsers(context.Context, *connect.Request[v1.AdminListUsersRequest]) (*connect.Response[v1.AdminListUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminListUsers is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminSetUserRole(context.Context, *connect.Request[v1.AdminSetUserRoleRequest]) (*connect.Response[v1.AdminSetUserRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminSetUserRole is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminListCredentials(context.Context, *connect.Request[v1.AdminListCredentialsRequest]) (*connect.Response[v1.AdminListCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminListCredentials is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminSetCredential(context.Context, *connect.Request[v1.AdminSetCredentialRequest]) (*connect.Response[v1.AdminSetCredentialResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminSetCredential is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminClearCredential(context.Context, *connect.Request[v1.AdminClearCredentialRequest]) (*connect.Response[v1.AdminClearCredentialResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminClearCredential is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminGetApiCallStats(context.Context, *connect.Request[v1.AdminGetApiCallStatsRequest]) (*connect.Response[v1.AdminGetApiCallStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminGetApiCallStats is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMyApiKeys(context.Context, *connect.Request[v1.GetMyApiKeysRequest]) (*connect.Response[v1.GetMyApiKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyApiKeys is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.CreateApiKey is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[v1.RevokeApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.RevokeApiKey is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ListSessions is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ExportMyData is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) DeleteMyAccount(context.Context, *connect.Request[v1.DeleteMyAccountRequest]) (*connect.Response[v1.DeleteMyAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.DeleteMyAccount is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetClientBootstrap(context.Context, *connect.Request[v1.GetClientBootstrapRequest]) (*connect.Response[v1.GetClientBootstrapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetClientBootstrap is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetApiInfo(context.Context, *connect.Request[v1.GetApiInfoRequest]) (*connect.Response[v1.GetApiInfoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetApiInfo is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMyUsage(context.Context, *connect.Request[v1.GetMyUsageRequest]) (*connect.Response[v1.GetMyUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyUsage is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) UpcomingDrops(context.Context, *connect.Request[v1.UpcomingDropsRequest]) (*connect.Response[v1.UpcomingDropsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.UpcomingDrops is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminSaveDrop(context.Context, *connect.Request[v1.AdminSaveDropRequest]) (*connect.Response[v1.AdminSaveDropResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminSaveDrop is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AdminDeleteDrop(context.Context, *connect.Request[v1.AdminDeleteDropRequest]) (*connect.Response[v1.AdminDeleteDropResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.AdminDeleteDrop is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) GetMyInboundEmail(context.Context, *connect.Request[v1.GetMyInboundEmailRequest]) (*connect.Response[v1.GetMyInboundEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.GetMyInboundEmail is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ResetMyInboundEmail(context.Context, *connect.Request[v1.ResetMyInboundEmailRequest]) (*connect.Response[v1.ResetMyInboundEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ResetMyInboundEmail is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ListMyReminders(context.Context, *connect.Request[v1.ListMyRemindersRequest]) (*connect.Response[v1.ListMyRemindersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.ListMyReminders is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) DeleteMyReminder(context.Context, *connect.Request[v1.DeleteMyReminderRequest]) (*connect.Response[v1.DeleteMyReminderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v1.StockCheckerService.DeleteMyReminder is not implemented"))
+}
@@ -0,0 +1,1970 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: stockchecker/v2/service.proto
+
+package stockcheckerv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Retailer identifies the retailer a store or product belongs to
+type Retailer int32
+
+const (
+	Retailer_RETAILER_UNSPECIFIED Retailer = 0 // treated as RETAILER_BEST_BUY in requests
+	Retailer_RETAILER_BEST_BUY    Retailer = 1
+	Retailer_RETAILER_WALMART     Retailer = 2
+	Retailer_RETAILER_TARGET      Retailer = 3
+	Retailer_RETAILER_GAMESTOP    Retailer = 4 // GameStop, or EB Games when the server is configured for Canada
+	Retailer_RETAILER_COSTCO      Retailer = 5
+)
+
+// Enum value maps for Retailer.
+var (
+	Retailer_name = map[int32]string{
+		0: "RETAILER_UNSPECIFIED",
+		1: "RETAILER_BEST_BUY",
+		2: "RETAILER_WALMART",
+		3: "RETAILER_TARGET",
+		4: "RETAILER_GAMESTOP",
+		5: "RETAILER_COSTCO",
+	}
+	Retailer_value = map[string]int32{
+		"RETAILER_UNSPECIFIED": 0,
+		"RETAILER_BEST_BUY":    1,
+		"RETAILER_WALMART":     2,
+		"RETAILER_TARGET":      3,
+		"RETAILER_GAMESTOP":    4,
+		"RETAILER_COSTCO":      5,
+	}
+)
+
+func (x Retailer) Enum() *Retailer {
+	p := new(Retailer)
+	*p = x
+	return p
+}
+
+func (x Retailer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Retailer) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v2_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Retailer) Type() protoreflect.EnumType {
+	return &file_stockchecker_v2_service_proto_enumTypes[0]
+}
+
+func (x Retailer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Retailer.Descriptor instead.
+func (Retailer) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{0}
+}
+
+// AvailabilityStatus is the stock level of a product at a store
+type AvailabilityStatus int32
+
+const (
+	AvailabilityStatus_AVAILABILITY_STATUS_UNSPECIFIED  AvailabilityStatus = 0
+	AvailabilityStatus_AVAILABILITY_STATUS_IN_STOCK     AvailabilityStatus = 1
+	AvailabilityStatus_AVAILABILITY_STATUS_LOW_STOCK    AvailabilityStatus = 2
+	AvailabilityStatus_AVAILABILITY_STATUS_OUT_OF_STOCK AvailabilityStatus = 3
+	AvailabilityStatus_AVAILABILITY_STATUS_RESTRICTED   AvailabilityStatus = 4 // the retailer won't report store stock for the product
+)
+
+// Enum value maps for AvailabilityStatus.
+var (
+	AvailabilityStatus_name = map[int32]string{
+		0: "AVAILABILITY_STATUS_UNSPECIFIED",
+		1: "AVAILABILITY_STATUS_IN_STOCK",
+		2: "AVAILABILITY_STATUS_LOW_STOCK",
+		3: "AVAILABILITY_STATUS_OUT_OF_STOCK",
+		4: "AVAILABILITY_STATUS_RESTRICTED",
+	}
+	AvailabilityStatus_value = map[string]int32{
+		"AVAILABILITY_STATUS_UNSPECIFIED":  0,
+		"AVAILABILITY_STATUS_IN_STOCK":     1,
+		"AVAILABILITY_STATUS_LOW_STOCK":    2,
+		"AVAILABILITY_STATUS_OUT_OF_STOCK": 3,
+		"AVAILABILITY_STATUS_RESTRICTED":   4,
+	}
+)
+
+func (x AvailabilityStatus) Enum() *AvailabilityStatus {
+	p := new(AvailabilityStatus)
+	*p = x
+	return p
+}
+
+func (x AvailabilityStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AvailabilityStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_stockchecker_v2_service_proto_enumTypes[1].Descriptor()
+}
+
+func (AvailabilityStatus) Type() protoreflect.EnumType {
+	return &file_stockchecker_v2_service_proto_enumTypes[1]
+}
+
+func (x AvailabilityStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AvailabilityStatus.Descriptor instead.
+func (AvailabilityStatus) EnumDescriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{1}
+}
+
+// Money is an amount in a currency, laid out like google.type.Money
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"` // ISO 4217, e.g. "USD"
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`                                  // whole units of the currency
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`                                  // billionths of a unit, same sign as units
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+// Store represents a retailer store location
+type Store struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	StoreId       string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	City          string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	State         string                 `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,7,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Phone         string                 `protobuf:"bytes,8,opt,name=phone,proto3" json:"phone,omitempty"`
+	DistanceMiles float64                `protobuf:"fixed64,9,opt,name=distance_miles,json=distanceMiles,proto3" json:"distance_miles,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // when the store was saved; unset in search results
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // when the saved store was last changed
+	Name          string                 `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`                            // resource name: users/{user}/stores/{store} when saved, otherwise stores/{store}
+	// Opening hours in the store's time zone, holiday hours included. Unset
+	// when the store's hours are unknown.
+	OpenNow       bool                   `protobuf:"varint,13,opt,name=open_now,json=openNow,proto3" json:"open_now,omitempty"`
+	HoursToday    string                 `protobuf:"bytes,14,opt,name=hours_today,json=hoursToday,proto3" json:"hours_today,omitempty"`        // "10:00-21:00" or "closed"; empty if unknown
+	SpecialHours  bool                   `protobuf:"varint,15,opt,name=special_hours,json=specialHours,proto3" json:"special_hours,omitempty"` // today's hours differ from the usual ones, e.g. a holiday
+	OpensAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`                 // when a closed store next opens
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Store) Reset() {
+	*x = Store{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Store) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Store) ProtoMessage() {}
+
+func (x *Store) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Store.ProtoReflect.Descriptor instead.
+func (*Store) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Store) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *Store) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *Store) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Store) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Store) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Store) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Store) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Store) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Store) GetDistanceMiles() float64 {
+	if x != nil {
+		return x.DistanceMiles
+	}
+	return 0
+}
+
+func (x *Store) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Store) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Store) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Store) GetOpenNow() bool {
+	if x != nil {
+		return x.OpenNow
+	}
+	return false
+}
+
+func (x *Store) GetHoursToday() string {
+	if x != nil {
+		return x.HoursToday
+	}
+	return ""
+}
+
+func (x *Store) GetSpecialHours() bool {
+	if x != nil {
+		return x.SpecialHours
+	}
+	return false
+}
+
+func (x *Store) GetOpensAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpensAt
+	}
+	return nil
+}
+
+// Product represents a retailer product
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	SalePrice     *Money                 `protobuf:"bytes,4,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,5,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ProductUrl    string                 `protobuf:"bytes,6,opt,name=product_url,json=productUrl,proto3" json:"product_url,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                    // when the product was saved; unset in search results
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                    // when the saved product was last changed
+	Name          string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`                                                                               // resource name: users/{user}/products/{product} when saved, otherwise products/{product}
+	OnlineStatus  AvailabilityStatus     `protobuf:"varint,10,opt,name=online_status,json=onlineStatus,proto3,enum=stockchecker.v2.AvailabilityStatus" json:"online_status,omitempty"` // availability to order from the retailer's website; unspecified if the retailer doesn't report it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Product) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Product) GetSalePrice() *Money {
+	if x != nil {
+		return x.SalePrice
+	}
+	return nil
+}
+
+func (x *Product) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *Product) GetProductUrl() string {
+	if x != nil {
+		return x.ProductUrl
+	}
+	return ""
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetOnlineStatus() AvailabilityStatus {
+	if x != nil {
+		return x.OnlineStatus
+	}
+	return AvailabilityStatus_AVAILABILITY_STATUS_UNSPECIFIED
+}
+
+// StockStatus represents the availability of a product at a store. For
+// retailers that report online availability (Costco), CheckStock also returns
+// a status without a store for ordering from the website.
+type StockStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Store          *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Product        *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Status         AvailabilityStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=stockchecker.v2.AvailabilityStatus" json:"status,omitempty"`
+	PickupEligible bool                   `protobuf:"varint,4,opt,name=pickup_eligible,json=pickupEligible,proto3" json:"pickup_eligible,omitempty"`
+	IsMyStore      bool                   `protobuf:"varint,5,opt,name=is_my_store,json=isMyStore,proto3" json:"is_my_store,omitempty"` // True if store is in user's "My Stores" list
+	CheckedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockStatus) Reset() {
+	*x = StockStatus{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockStatus) ProtoMessage() {}
+
+func (x *StockStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockStatus.ProtoReflect.Descriptor instead.
+func (*StockStatus) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StockStatus) GetStore() *Store {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *StockStatus) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *StockStatus) GetStatus() AvailabilityStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AvailabilityStatus_AVAILABILITY_STATUS_UNSPECIFIED
+}
+
+func (x *StockStatus) GetPickupEligible() bool {
+	if x != nil {
+		return x.PickupEligible
+	}
+	return false
+}
+
+func (x *StockStatus) GetIsMyStore() bool {
+	if x != nil {
+		return x.IsMyStore
+	}
+	return false
+}
+
+func (x *StockStatus) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+// SearchStoresRequest is the request for searching stores
+type SearchStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,2,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	RadiusMiles   int32                  `protobuf:"varint,3,opt,name=radius_miles,json=radiusMiles,proto3" json:"radius_miles,omitempty"` // defaults to 25 if not specified
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`          // defaults to 50, max 200
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`        // next_page_token from a previous response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStoresRequest) Reset() {
+	*x = SearchStoresRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStoresRequest) ProtoMessage() {}
+
+func (x *SearchStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStoresRequest.ProtoReflect.Descriptor instead.
+func (*SearchStoresRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchStoresRequest) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *SearchStoresRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *SearchStoresRequest) GetRadiusMiles() int32 {
+	if x != nil {
+		return x.RadiusMiles
+	}
+	return 0
+}
+
+func (x *SearchStoresRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchStoresRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchStoresResponse is a page of matching stores
+type SearchStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchStoresResponse) Reset() {
+	*x = SearchStoresResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchStoresResponse) ProtoMessage() {}
+
+func (x *SearchStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchStoresResponse.ProtoReflect.Descriptor instead.
+func (*SearchStoresResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchStoresResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+func (x *SearchStoresResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// SearchProductsRequest is the request for searching products
+type SearchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                          // search term or SKU
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`                    // optional category filter (e.g., "POKEMON CARDS")
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // defaults to 50, max 200
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchProductsRequest) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// SearchProductsResponse is a page of matching products
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// CheckStockRequest is the request for checking stock
+type CheckStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	StoreIds      []string               `protobuf:"bytes,2,rep,name=store_ids,json=storeIds,proto3" json:"store_ids,omitempty"` // User's saved store IDs (for highlighting)
+	Skus          []string               `protobuf:"bytes,3,rep,name=skus,proto3" json:"skus,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"` // Postal code to search from (250 mile radius)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStockRequest) Reset() {
+	*x = CheckStockRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStockRequest) ProtoMessage() {}
+
+func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
+func (*CheckStockRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CheckStockRequest) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *CheckStockRequest) GetStoreIds() []string {
+	if x != nil {
+		return x.StoreIds
+	}
+	return nil
+}
+
+func (x *CheckStockRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *CheckStockRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+// CheckStockResponse is the response containing stock status
+type CheckStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*StockStatus         `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStockResponse) Reset() {
+	*x = CheckStockResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStockResponse) ProtoMessage() {}
+
+func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
+func (*CheckStockResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckStockResponse) GetResults() []*StockStatus {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ListMyStoresRequest pages through the user's saved stores
+type ListMyStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 50, max 200
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Parent        string                 `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`                                    // users/{user}; defaults to users/me
+	Retailer      Retailer               `protobuf:"varint,4,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"` // only list this retailer's stores; every retailer if unspecified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyStoresRequest) Reset() {
+	*x = ListMyStoresRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyStoresRequest) ProtoMessage() {}
+
+func (x *ListMyStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyStoresRequest.ProtoReflect.Descriptor instead.
+func (*ListMyStoresRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMyStoresRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyStoresRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMyStoresRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListMyStoresRequest) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+// ListMyStoresResponse is a page of the user's saved stores, newest first
+type ListMyStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyStoresResponse) Reset() {
+	*x = ListMyStoresResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyStoresResponse) ProtoMessage() {}
+
+func (x *ListMyStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyStoresResponse.ProtoReflect.Descriptor instead.
+func (*ListMyStoresResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMyStoresResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+func (x *ListMyStoresResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AddMyStoreRequest adds a store to the user's list
+type AddMyStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Store         *Store                 `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMyStoreRequest) Reset() {
+	*x = AddMyStoreRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyStoreRequest) ProtoMessage() {}
+
+func (x *AddMyStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyStoreRequest.ProtoReflect.Descriptor instead.
+func (*AddMyStoreRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AddMyStoreRequest) GetStore() *Store {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+// AddMyStoreResponse is empty on success
+type AddMyStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMyStoreResponse) Reset() {
+	*x = AddMyStoreResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyStoreResponse) ProtoMessage() {}
+
+func (x *AddMyStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyStoreResponse.ProtoReflect.Descriptor instead.
+func (*AddMyStoreResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{13}
+}
+
+// RemoveMyStoreRequest removes a store from the user's list
+type RemoveMyStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	StoreId       string                 `protobuf:"bytes,2,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"` // used when name is empty
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                      // users/{user}/stores/{store}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyStoreRequest) Reset() {
+	*x = RemoveMyStoreRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyStoreRequest) ProtoMessage() {}
+
+func (x *RemoveMyStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyStoreRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMyStoreRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveMyStoreRequest) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *RemoveMyStoreRequest) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *RemoveMyStoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RemoveMyStoreResponse is empty on success
+type RemoveMyStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyStoreResponse) Reset() {
+	*x = RemoveMyStoreResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyStoreResponse) ProtoMessage() {}
+
+func (x *RemoveMyStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyStoreResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMyStoreResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{15}
+}
+
+// ListMyProductsRequest pages through the user's saved products
+type ListMyProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // defaults to 50, max 200
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Parent        string                 `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`                                    // users/{user}; defaults to users/me
+	Retailer      Retailer               `protobuf:"varint,4,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"` // only list this retailer's products; every retailer if unspecified
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyProductsRequest) Reset() {
+	*x = ListMyProductsRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyProductsRequest) ProtoMessage() {}
+
+func (x *ListMyProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyProductsRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMyProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMyProductsRequest) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *ListMyProductsRequest) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+// ListMyProductsResponse is a page of the user's saved products, newest first
+type ListMyProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyProductsResponse) Reset() {
+	*x = ListMyProductsResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyProductsResponse) ProtoMessage() {}
+
+func (x *ListMyProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyProductsResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMyProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListMyProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// AddMyProductRequest adds a product to the user's list
+type AddMyProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMyProductRequest) Reset() {
+	*x = AddMyProductRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyProductRequest) ProtoMessage() {}
+
+func (x *AddMyProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyProductRequest.ProtoReflect.Descriptor instead.
+func (*AddMyProductRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddMyProductRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// AddMyProductResponse is empty on success
+type AddMyProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddMyProductResponse) Reset() {
+	*x = AddMyProductResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMyProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMyProductResponse) ProtoMessage() {}
+
+func (x *AddMyProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMyProductResponse.ProtoReflect.Descriptor instead.
+func (*AddMyProductResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{19}
+}
+
+// RemoveMyProductRequest removes a product from the user's list
+type RemoveMyProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`   // used when name is empty
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // users/{user}/products/{product}
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyProductRequest) Reset() {
+	*x = RemoveMyProductRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyProductRequest) ProtoMessage() {}
+
+func (x *RemoveMyProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyProductRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMyProductRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveMyProductRequest) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *RemoveMyProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *RemoveMyProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RemoveMyProductResponse is empty on success
+type RemoveMyProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveMyProductResponse) Reset() {
+	*x = RemoveMyProductResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMyProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMyProductResponse) ProtoMessage() {}
+
+func (x *RemoveMyProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMyProductResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMyProductResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{21}
+}
+
+// RetailerInfo describes a retailer the server can search and check
+type RetailerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailer      Retailer               `protobuf:"varint,1,opt,name=retailer,proto3,enum=stockchecker.v2.Retailer" json:"retailer,omitempty"`
+	Mock          bool                   `protobuf:"varint,2,opt,name=mock,proto3" json:"mock,omitempty"`                      // served by offline mock data rather than the retailer's API
+	CanSave       bool                   `protobuf:"varint,3,opt,name=can_save,json=canSave,proto3" json:"can_save,omitempty"` // stores and products can be added to the user's lists (always true; kept for older clients)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetailerInfo) Reset() {
+	*x = RetailerInfo{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetailerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetailerInfo) ProtoMessage() {}
+
+func (x *RetailerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetailerInfo.ProtoReflect.Descriptor instead.
+func (*RetailerInfo) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RetailerInfo) GetRetailer() Retailer {
+	if x != nil {
+		return x.Retailer
+	}
+	return Retailer_RETAILER_UNSPECIFIED
+}
+
+func (x *RetailerInfo) GetMock() bool {
+	if x != nil {
+		return x.Mock
+	}
+	return false
+}
+
+func (x *RetailerInfo) GetCanSave() bool {
+	if x != nil {
+		return x.CanSave
+	}
+	return false
+}
+
+// ListRetailersRequest is empty
+type ListRetailersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetailersRequest) Reset() {
+	*x = ListRetailersRequest{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetailersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetailersRequest) ProtoMessage() {}
+
+func (x *ListRetailersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetailersRequest.ProtoReflect.Descriptor instead.
+func (*ListRetailersRequest) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{23}
+}
+
+// ListRetailersResponse lists the available retailers
+type ListRetailersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Retailers     []*RetailerInfo        `protobuf:"bytes,1,rep,name=retailers,proto3" json:"retailers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetailersResponse) Reset() {
+	*x = ListRetailersResponse{}
+	mi := &file_stockchecker_v2_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetailersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetailersResponse) ProtoMessage() {}
+
+func (x *ListRetailersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stockchecker_v2_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetailersResponse.ProtoReflect.Descriptor instead.
+func (*ListRetailersResponse) Descriptor() ([]byte, []int) {
+	return file_stockchecker_v2_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListRetailersResponse) GetRetailers() []*RetailerInfo {
+	if x != nil {
+		return x.Retailers
+	}
+	return nil
+}
+
+var File_stockchecker_v2_service_proto protoreflect.FileDescriptor
+
+const file_stockchecker_v2_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dstockchecker/v2/service.proto\x12\x0fstockchecker.v2\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n" +
+	"\x05Money\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"\xc0\x04\n" +
+	"\x05Store\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\a \x01(\tR\n" +
+	"postalCode\x12\x14\n" +
+	"\x05phone\x18\b \x01(\tR\x05phone\x12%\n" +
+	"\x0edistance_miles\x18\t \x01(\x01R\rdistanceMiles\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\f \x01(\tR\x04name\x12\x19\n" +
+	"\bopen_now\x18\r \x01(\bR\aopenNow\x12\x1f\n" +
+	"\vhours_today\x18\x0e \x01(\tR\n" +
+	"hoursToday\x12#\n" +
+	"\rspecial_hours\x18\x0f \x01(\bR\fspecialHours\x125\n" +
+	"\bopens_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\aopensAt\"\xc6\x03\n" +
+	"\aProduct\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x125\n" +
+	"\n" +
+	"sale_price\x18\x04 \x01(\v2\x16.stockchecker.v2.MoneyR\tsalePrice\x12#\n" +
+	"\rthumbnail_url\x18\x05 \x01(\tR\fthumbnailUrl\x12\x1f\n" +
+	"\vproduct_url\x18\x06 \x01(\tR\n" +
+	"productUrl\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x12H\n" +
+	"\ronline_status\x18\n" +
+	" \x01(\x0e2#.stockchecker.v2.AvailabilityStatusR\fonlineStatus\"\xb0\x02\n" +
+	"\vStockStatus\x12,\n" +
+	"\x05store\x18\x01 \x01(\v2\x16.stockchecker.v2.StoreR\x05store\x122\n" +
+	"\aproduct\x18\x02 \x01(\v2\x18.stockchecker.v2.ProductR\aproduct\x12;\n" +
+	"\x06status\x18\x03 \x01(\x0e2#.stockchecker.v2.AvailabilityStatusR\x06status\x12'\n" +
+	"\x0fpickup_eligible\x18\x04 \x01(\bR\x0epickupEligible\x12\x1e\n" +
+	"\vis_my_store\x18\x05 \x01(\bR\tisMyStore\x129\n" +
+	"\n" +
+	"checked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"\xcc\x01\n" +
+	"\x13SearchStoresRequest\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x1f\n" +
+	"\vpostal_code\x18\x02 \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fradius_miles\x18\x03 \x01(\x05R\vradiusMiles\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"n\n" +
+	"\x14SearchStoresResponse\x12.\n" +
+	"\x06stores\x18\x01 \x03(\v2\x16.stockchecker.v2.StoreR\x06stores\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbc\x01\n" +
+	"\x15SearchProductsRequest\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"v\n" +
+	"\x16SearchProductsResponse\x124\n" +
+	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v2.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9c\x01\n" +
+	"\x11CheckStockRequest\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x1b\n" +
+	"\tstore_ids\x18\x02 \x03(\tR\bstoreIds\x12\x12\n" +
+	"\x04skus\x18\x03 \x03(\tR\x04skus\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\"L\n" +
+	"\x12CheckStockResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.stockchecker.v2.StockStatusR\aresults\"\xa0\x01\n" +
+	"\x13ListMyStoresRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06parent\x18\x03 \x01(\tR\x06parent\x125\n" +
+	"\bretailer\x18\x04 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\"n\n" +
+	"\x14ListMyStoresResponse\x12.\n" +
+	"\x06stores\x18\x01 \x03(\v2\x16.stockchecker.v2.StoreR\x06stores\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"A\n" +
+	"\x11AddMyStoreRequest\x12,\n" +
+	"\x05store\x18\x01 \x01(\v2\x16.stockchecker.v2.StoreR\x05store\"\x14\n" +
+	"\x12AddMyStoreResponse\"|\n" +
+	"\x14RemoveMyStoreRequest\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x19\n" +
+	"\bstore_id\x18\x02 \x01(\tR\astoreId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x17\n" +
+	"\x15RemoveMyStoreResponse\"\xa2\x01\n" +
+	"\x15ListMyProductsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06parent\x18\x03 \x01(\tR\x06parent\x125\n" +
+	"\bretailer\x18\x04 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\"v\n" +
+	"\x16ListMyProductsResponse\x124\n" +
+	"\bproducts\x18\x01 \x03(\v2\x18.stockchecker.v2.ProductR\bproducts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"I\n" +
+	"\x13AddMyProductRequest\x122\n" +
+	"\aproduct\x18\x01 \x01(\v2\x18.stockchecker.v2.ProductR\aproduct\"\x16\n" +
+	"\x14AddMyProductResponse\"u\n" +
+	"\x16RemoveMyProductRequest\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x19\n" +
+	"\x17RemoveMyProductResponse\"t\n" +
+	"\fRetailerInfo\x125\n" +
+	"\bretailer\x18\x01 \x01(\x0e2\x19.stockchecker.v2.RetailerR\bretailer\x12\x12\n" +
+	"\x04mock\x18\x02 \x01(\bR\x04mock\x12\x19\n" +
+	"\bcan_save\x18\x03 \x01(\bR\acanSave\"\x16\n" +
+	"\x14ListRetailersRequest\"T\n" +
+	"\x15ListRetailersResponse\x12;\n" +
+	"\tretailers\x18\x01 \x03(\v2\x1d.stockchecker.v2.RetailerInfoR\tretailers*\x92\x01\n" +
+	"\bRetailer\x12\x18\n" +
+	"\x14RETAILER_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11RETAILER_BEST_BUY\x10\x01\x12\x14\n" +
+	"\x10RETAILER_WALMART\x10\x02\x12\x13\n" +
+	"\x0fRETAILER_TARGET\x10\x03\x12\x15\n" +
+	"\x11RETAILER_GAMESTOP\x10\x04\x12\x13\n" +
+	"\x0fRETAILER_COSTCO\x10\x05*\xc8\x01\n" +
+	"\x12AvailabilityStatus\x12#\n" +
+	"\x1fAVAILABILITY_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cAVAILABILITY_STATUS_IN_STOCK\x10\x01\x12!\n" +
+	"\x1dAVAILABILITY_STATUS_LOW_STOCK\x10\x02\x12$\n" +
+	" AVAILABILITY_STATUS_OUT_OF_STOCK\x10\x03\x12\"\n" +
+	"\x1eAVAILABILITY_STATUS_RESTRICTED\x10\x042\xe4\a\n" +
+	"\x13StockCheckerService\x12c\n" +
+	"\rListRetailers\x12%.stockchecker.v2.ListRetailersRequest\x1a&.stockchecker.v2.ListRetailersResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\fSearchStores\x12$.stockchecker.v2.SearchStoresRequest\x1a%.stockchecker.v2.SearchStoresResponse\"\x03\x90\x02\x01\x12f\n" +
+	"\x0eSearchProducts\x12&.stockchecker.v2.SearchProductsRequest\x1a'.stockchecker.v2.SearchProductsResponse\"\x03\x90\x02\x01\x12Z\n" +
+	"\n" +
+	"CheckStock\x12\".stockchecker.v2.CheckStockRequest\x1a#.stockchecker.v2.CheckStockResponse\"\x03\x90\x02\x01\x12`\n" +
+	"\fListMyStores\x12$.stockchecker.v2.ListMyStoresRequest\x1a%.stockchecker.v2.ListMyStoresResponse\"\x03\x90\x02\x01\x12U\n" +
+	"\n" +
+	"AddMyStore\x12\".stockchecker.v2.AddMyStoreRequest\x1a#.stockchecker.v2.AddMyStoreResponse\x12^\n" +
+	"\rRemoveMyStore\x12%.stockchecker.v2.RemoveMyStoreRequest\x1a&.stockchecker.v2.RemoveMyStoreResponse\x12f\n" +
+	"\x0eListMyProducts\x12&.stockchecker.v2.ListMyProductsRequest\x1a'.stockchecker.v2.ListMyProductsResponse\"\x03\x90\x02\x01\x12[\n" +
+	"\fAddMyProduct\x12$.stockchecker.v2.AddMyProductRequest\x1a%.stockchecker.v2.AddMyProductResponse\x12d\n" +
+	"\x0fRemoveMyProduct\x12'.stockchecker.v2.RemoveMyProductRequest\x1a(.stockchecker.v2.RemoveMyProductResponseB\xce\x01\n" +
+	"\x13com.stockchecker.v2B\fServiceProtoP\x01ZLgithub.com/tmcauley/stock-checker/backend/gen/stockchecker/v2;stockcheckerv2\xa2\x02\x03SXX\xaa\x02\x0fStockchecker.V2\xca\x02\x0fStockchecker\\V2\xe2\x02\x1bStockchecker\\V2\\GPBMetadata\xea\x02\x10Stockchecker::V2b\x06proto3"
+
+var (
+	file_stockchecker_v2_service_proto_rawDescOnce sync.Once
+	file_stockchecker_v2_service_proto_rawDescData []byte
+)
+
+func file_stockchecker_v2_service_proto_rawDescGZIP() []byte {
+	file_stockchecker_v2_service_proto_rawDescOnce.Do(func() {
+		file_stockchecker_v2_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_stockchecker_v2_service_proto_rawDesc), len(file_stockchecker_v2_service_proto_rawDesc)))
+	})
+	return file_stockchecker_v2_service_proto_rawDescData
+}
+
+var file_stockchecker_v2_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_stockchecker_v2_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_stockchecker_v2_service_proto_goTypes = []any{
+	(Retailer)(0),                   // 0: stockchecker.v2.Retailer
+	(AvailabilityStatus)(0),         // 1: stockchecker.v2.AvailabilityStatus
+	(*Money)(nil),                   // 2: stockchecker.v2.Money
+	(*Store)(nil),                   // 3: stockchecker.v2.Store
+	(*Product)(nil),                 // 4: stockchecker.v2.Product
+	(*StockStatus)(nil),             // 5: stockchecker.v2.StockStatus
+	(*SearchStoresRequest)(nil),     // 6: stockchecker.v2.SearchStoresRequest
+	(*SearchStoresResponse)(nil),    // 7: stockchecker.v2.SearchStoresResponse
+	(*SearchProductsRequest)(nil),   // 8: stockchecker.v2.SearchProductsRequest
+	(*SearchProductsResponse)(nil),  // 9: stockchecker.v2.SearchProductsResponse
+	(*CheckStockRequest)(nil),       // 10: stockchecker.v2.CheckStockRequest
+	(*CheckStockResponse)(nil),      // 11: stockchecker.v2.CheckStockResponse
+	(*ListMyStoresRequest)(nil),     // 12: stockchecker.v2.ListMyStoresRequest
+	(*ListMyStoresResponse)(nil),    // 13: stockchecker.v2.ListMyStoresResponse
+	(*AddMyStoreRequest)(nil),       // 14: stockchecker.v2.AddMyStoreRequest
+	(*AddMyStoreResponse)(nil),      // 15: stockchecker.v2.AddMyStoreResponse
+	(*RemoveMyStoreRequest)(nil),    // 16: stockchecker.v2.RemoveMyStoreRequest
+	(*RemoveMyStoreResponse)(nil),   // 17: stockchecker.v2.RemoveMyStoreResponse
+	(*ListMyProductsRequest)(nil),   // 18: stockchecker.v2.ListMyProductsRequest
+	(*ListMyProductsResponse)(nil),  // 19: stockchecker.v2.ListMyProductsResponse
+	(*AddMyProductRequest)(nil),     // 20: stockchecker.v2.AddMyProductRequest
+	(*AddMyProductResponse)(nil),    // 21: stockchecker.v2.AddMyProductResponse
+	(*RemoveMyProductRequest)(nil),  // 22: stockchecker.v2.RemoveMyProductRequest
+	(*RemoveMyProductResponse)(nil), // 23: stockchecker.v2.RemoveMyProductResponse
+	(*RetailerInfo)(nil),            // 24: stockchecker.v2.RetailerInfo
+	(*ListRetailersRequest)(nil),    // 25: stockchecker.v2.ListRetailersRequest
+	(*ListRetailersResponse)(nil),   // 26: stockchecker.v2.ListRetailersResponse
+	(*timestamppb.Timestamp)(nil),   // 27: google.protobuf.Timestamp
+}
+var file_stockchecker_v2_service_proto_depIdxs = []int32{
+	0,  // 0: stockchecker.v2.Store.retailer:type_name -> stockchecker.v2.Retailer
+	27, // 1: stockchecker.v2.Store.created_at:type_name -> google.protobuf.Timestamp
+	27, // 2: stockchecker.v2.Store.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 3: stockchecker.v2.Store.opens_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: stockchecker.v2.Product.retailer:type_name -> stockchecker.v2.Retailer
+	2,  // 5: stockchecker.v2.Product.sale_price:type_name -> stockchecker.v2.Money
+	27, // 6: stockchecker.v2.Product.created_at:type_name -> google.protobuf.Timestamp
+	27, // 7: stockchecker.v2.Product.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 8: stockchecker.v2.Product.online_status:type_name -> stockchecker.v2.AvailabilityStatus
+	3,  // 9: stockchecker.v2.StockStatus.store:type_name -> stockchecker.v2.Store
+	4,  // 10: stockchecker.v2.StockStatus.product:type_name -> stockchecker.v2.Product
+	1,  // 11: stockchecker.v2.StockStatus.status:type_name -> stockchecker.v2.AvailabilityStatus
+	27, // 12: stockchecker.v2.StockStatus.checked_at:type_name -> google.protobuf.Timestamp
+	0,  // 13: stockchecker.v2.SearchStoresRequest.retailer:type_name -> stockchecker.v2.Retailer
+	3,  // 14: stockchecker.v2.SearchStoresResponse.stores:type_name -> stockchecker.v2.Store
+	0,  // 15: stockchecker.v2.SearchProductsRequest.retailer:type_name -> stockchecker.v2.Retailer
+	4,  // 16: stockchecker.v2.SearchProductsResponse.products:type_name -> stockchecker.v2.Product
+	0,  // 17: stockchecker.v2.CheckStockRequest.retailer:type_name -> stockchecker.v2.Retailer
+	5,  // 18: stockchecker.v2.CheckStockResponse.results:type_name -> stockchecker.v2.StockStatus
+	0,  // 19: stockchecker.v2.ListMyStoresRequest.retailer:type_name -> stockchecker.v2.Retailer
+	3,  // 20: stockchecker.v2.ListMyStoresResponse.stores:type_name -> stockchecker.v2.Store
+	3,  // 21: stockchecker.v2.AddMyStoreRequest.store:type_name -> stockchecker.v2.Store
+	0,  // 22: stockchecker.v2.RemoveMyStoreRequest.retailer:type_name -> stockchecker.v2.Retailer
+	0,  // 23: stockchecker.v2.ListMyProductsRequest.retailer:type_name -> stockchecker.v2.Retailer
+	4,  // 24: stockchecker.v2.ListMyProductsResponse.products:type_name -> stockchecker.v2.Product
+	4,  // 25: stockchecker.v2.AddMyProductRequest.product:type_name -> stockchecker.v2.Product
+	0,  // 26: stockchecker.v2.RemoveMyProductRequest.retailer:type_name -> stockchecker.v2.Retailer
+	0,  // 27: stockchecker.v2.RetailerInfo.retailer:type_name -> stockchecker.v2.Retailer
+	24, // 28: stockchecker.v2.ListRetailersResponse.retailers:type_name -> stockchecker.v2.RetailerInfo
+	25, // 29: stockchecker.v2.StockCheckerService.ListRetailers:input_type -> stockchecker.v2.ListRetailersRequest
+	6,  // 30: stockchecker.v2.StockCheckerService.SearchStores:input_type -> stockchecker.v2.SearchStoresRequest
+	8,  // 31: stockchecker.v2.StockCheckerService.SearchProducts:input_type -> stockchecker.v2.SearchProductsRequest
+	10, // 32: stockchecker.v2.StockCheckerService.CheckStock:input_type -> stockchecker.v2.CheckStockRequest
+	12, // 33: stockchecker.v2.StockCheckerService.ListMyStores:input_type -> stockchecker.v2.ListMyStoresRequest
+	14, // 34: stockchecker.v2.StockCheckerService.AddMyStore:input_type -> stockchecker.v2.AddMyStoreRequest
+	16, // 35: stockchecker.v2.StockCheckerService.RemoveMyStore:input_type -> stockchecker.v2.RemoveMyStoreRequest
+	18, // 36: stockchecker.v2.StockCheckerService.ListMyProducts:input_type -> stockchecker.v2.ListMyProductsRequest
+	20, // 37: stockchecker.v2.StockCheckerService.AddMyProduct:input_type -> stockchecker.v2.AddMyProductRequest
+	22, // 38: stockchecker.v2.StockCheckerService.RemoveMyProduct:input_type -> stockchecker.v2.RemoveMyProductRequest
+	26, // 39: stockchecker.v2.StockCheckerService.ListRetailers:output_type -> stockchecker.v2.ListRetailersResponse
+	7,  // 40: stockchecker.v2.StockCheckerService.SearchStores:output_type -> stockchecker.v2.SearchStoresResponse
+	9,  // 41: stockchecker.v2.StockCheckerService.SearchProducts:output_type -> stockchecker.v2.SearchProductsResponse
+	11, // 42: stockchecker.v2.StockCheckerService.CheckStock:output_type -> stockchecker.v2.CheckStockResponse
+	13, // 43: stockchecker.v2.StockCheckerService.ListMyStores:output_type -> stockchecker.v2.ListMyStoresResponse
+	15, // 44: stockchecker.v2.StockCheckerService.AddMyStore:output_type -> stockchecker.v2.AddMyStoreResponse
+	17, // 45: stockchecker.v2.StockCheckerService.RemoveMyStore:output_type -> stockchecker.v2.RemoveMyStoreResponse
+	19, // 46: stockchecker.v2.StockCheckerService.ListMyProducts:output_type -> stockchecker.v2.ListMyProductsResponse
+	21, // 47: stockchecker.v2.StockCheckerService.AddMyProduct:output_type -> stockchecker.v2.AddMyProductResponse
+	23, // 48: stockchecker.v2.StockCheckerService.RemoveMyProduct:output_type -> stockchecker.v2.RemoveMyProductResponse
+	39, // [39:49] is the sub-list for method output_type
+	29, // [29:39] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_stockchecker_v2_service_proto_init() }
+func file_stockchecker_v2_service_proto_init() {
+	if File_stockchecker_v2_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stockchecker_v2_service_proto_rawDesc), len(file_stockchecker_v2_service_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_stockchecker_v2_service_proto_goTypes,
+		DependencyIndexes: file_stockchecker_v2_service_proto_depIdxs,
+		EnumInfos:         file_stockchecker_v2_service_proto_enumTypes,
+		MessageInfos:      file_stockchecker_v2_service_proto_msgTypes,
+	}.Build()
+	File_stockchecker_v2_service_proto = out.File
+	file_stockchecker_v2_service_proto_goTypes = nil
+	file_stockchecker_v2_service_proto_depIdxs = nil
+}
@@ -0,0 +1,403 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: stockchecker/v2/service.proto
+
+package stockcheckerv2connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v2 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StockCheckerServiceName is the fully-qualified name of the StockCheckerService service.
+	StockCheckerServiceName = "stockchecker.v2.StockCheckerService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StockCheckerServiceListRetailersProcedure is the fully-qualified name of the
+	// StockCheckerService's ListRetailers RPC.
+	StockCheckerServiceListRetailersProcedure = "/stockchecker.v2.StockCheckerService/ListRetailers"
+	// StockCheckerServiceSearchStoresProcedure is the fully-qualified name of the StockCheckerService's
+	// SearchStores RPC.
+	StockCheckerServiceSearchStoresProcedure = "/stockchecker.v2.StockCheckerService/SearchStores"
+	// StockCheckerServiceSearchProductsProcedure is the fully-qualified name of the
+	// StockCheckerService's SearchProducts RPC.
+	StockCheckerServiceSearchProductsProcedure = "/stockchecker.v2.StockCheckerService/SearchProducts"
+	// StockCheckerServiceCheckStockProcedure is the fully-qualified name of the StockCheckerService's
+	// CheckStock RPC.
+	StockCheckerServiceCheckStockProcedure = "/stockchecker.v2.StockCheckerService/CheckStock"
+	// StockCheckerServiceListMyStoresProcedure is the fully-qualified name of the StockCheckerService's
+	// ListMyStores RPC.
+	StockCheckerServiceListMyStoresProcedure = "/stockchecker.v2.StockCheckerService/ListMyStores"
+	// StockCheckerServiceAddMyStoreProcedure is the fully-qualified name of the StockCheckerService's
+	// AddMyStore RPC.
+	StockCheckerServiceAddMyStoreProcedure = "/stockchecker.v2.StockCheckerService/AddMyStore"
+	// StockCheckerServiceRemoveMyStoreProcedure is the fully-qualified name of the
+	// StockCheckerService's RemoveMyStore RPC.
+	StockCheckerServiceRemoveMyStoreProcedure = "/stockchecker.v2.StockCheckerService/RemoveMyStore"
+	// StockCheckerServiceListMyProductsProcedure is the fully-qualified name of the
+	// StockCheckerService's ListMyProducts RPC.
+	StockCheckerServiceListMyProductsProcedure = "/stockchecker.v2.StockCheckerService/ListMyProducts"
+	// StockCheckerServiceAddMyProductProcedure is the fully-qualified name of the StockCheckerService's
+	// AddMyProduct RPC.
+	StockCheckerServiceAddMyProductProcedure = "/stockchecker.v2.StockCheckerService/AddMyProduct"
+	// StockCheckerServiceRemoveMyProductProcedure is the fully-qualified name of the
+	// StockCheckerService's RemoveMyProduct RPC.
+	StockCheckerServiceRemoveMyProductProcedure = "/stockchecker.v2.StockCheckerService/RemoveMyProduct"
+)
+
+// StockCheckerServiceClient is a client for the stockchecker.v2.StockCheckerService service.
+type StockCheckerServiceClient interface {
+	// ListRetailers returns the retailers the server has adapters for
+	ListRetailers(context.Context, *connect.Request[v2.ListRetailersRequest]) (*connect.Response[v2.ListRetailersResponse], error)
+	// SearchStores searches for stores near a location
+	SearchStores(context.Context, *connect.Request[v2.SearchStoresRequest]) (*connect.Response[v2.SearchStoresResponse], error)
+	// SearchProducts searches for products by keyword or SKU
+	SearchProducts(context.Context, *connect.Request[v2.SearchProductsRequest]) (*connect.Response[v2.SearchProductsResponse], error)
+	// CheckStock checks inventory for products near a postal code
+	CheckStock(context.Context, *connect.Request[v2.CheckStockRequest]) (*connect.Response[v2.CheckStockResponse], error)
+	// ListMyStores returns the user's saved stores
+	ListMyStores(context.Context, *connect.Request[v2.ListMyStoresRequest]) (*connect.Response[v2.ListMyStoresResponse], error)
+	// AddMyStore adds a store to the user's list
+	AddMyStore(context.Context, *connect.Request[v2.AddMyStoreRequest]) (*connect.Response[v2.AddMyStoreResponse], error)
+	// RemoveMyStore removes a store from the user's list
+	RemoveMyStore(context.Context, *connect.Request[v2.RemoveMyStoreRequest]) (*connect.Response[v2.RemoveMyStoreResponse], error)
+	// ListMyProducts returns the user's saved products
+	ListMyProducts(context.Context, *connect.Request[v2.ListMyProductsRequest]) (*connect.Response[v2.ListMyProductsResponse], error)
+	// AddMyProduct adds a product to the user's list
+	AddMyProduct(context.Context, *connect.Request[v2.AddMyProductRequest]) (*connect.Response[v2.AddMyProductResponse], error)
+	// RemoveMyProduct removes a product from the user's list
+	RemoveMyProduct(context.Context, *connect.Request[v2.RemoveMyProductRequest]) (*connect.Response[v2.RemoveMyProductResponse], error)
+}
+
+// NewStockCheckerServiceClient constructs a client for the stockchecker.v2.StockCheckerService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStockCheckerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StockCheckerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	stockCheckerServiceMethods := v2.File_stockchecker_v2_service_proto.Services().ByName("StockCheckerService").Methods()
+	return &stockCheckerServiceClient{
+		listRetailers: connect.NewClient[v2.ListRetailersRequest, v2.ListRetailersResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListRetailersProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListRetailers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		searchStores: connect.NewClient[v2.SearchStoresRequest, v2.SearchStoresResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSearchStoresProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SearchStores")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		searchProducts: connect.NewClient[v2.SearchProductsRequest, v2.SearchProductsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceSearchProductsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("SearchProducts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		checkStock: connect.NewClient[v2.CheckStockRequest, v2.CheckStockResponse](
+			httpClient,
+			baseURL+StockCheckerServiceCheckStockProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("CheckStock")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listMyStores: connect.NewClient[v2.ListMyStoresRequest, v2.ListMyStoresResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListMyStoresProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListMyStores")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		addMyStore: connect.NewClient[v2.AddMyStoreRequest, v2.AddMyStoreResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAddMyStoreProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AddMyStore")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMyStore: connect.NewClient[v2.RemoveMyStoreRequest, v2.RemoveMyStoreResponse](
+			httpClient,
+			baseURL+StockCheckerServiceRemoveMyStoreProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyStore")),
+			connect.WithClientOptions(opts...),
+		),
+		listMyProducts: connect.NewClient[v2.ListMyProductsRequest, v2.ListMyProductsResponse](
+			httpClient,
+			baseURL+StockCheckerServiceListMyProductsProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("ListMyProducts")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		addMyProduct: connect.NewClient[v2.AddMyProductRequest, v2.AddMyProductResponse](
+			httpClient,
+			baseURL+StockCheckerServiceAddMyProductProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("AddMyProduct")),
+			connect.WithClientOptions(opts...),
+		),
+		removeMyProduct: connect.NewClient[v2.RemoveMyProductRequest, v2.RemoveMyProductResponse](
+			httpClient,
+			baseURL+StockCheckerServiceRemoveMyProductProcedure,
+			connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyProduct")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// stockCheckerServiceClient implements StockCheckerServiceClient.
+type stockCheckerServiceClient struct {
+	listRetailers   *connect.Client[v2.ListRetailersRequest, v2.ListRetailersResponse]
+	searchStores    *connect.Client[v2.SearchStoresRequest, v2.SearchStoresResponse]
+	searchProducts  *connect.Client[v2.SearchProductsRequest, v2.SearchProductsResponse]
+	checkStock      *connect.Client[v2.CheckStockRequest, v2.CheckStockResponse]
+	listMyStores    *connect.Client[v2.ListMyStoresRequest, v2.ListMyStoresResponse]
+	addMyStore      *connect.Client[v2.AddMyStoreRequest, v2.AddMyStoreResponse]
+	removeMyStore   *connect.Client[v2.RemoveMyStoreRequest, v2.RemoveMyStoreResponse]
+	listMyProducts  *connect.Client[v2.ListMyProductsRequest, v2.ListMyProductsResponse]
+	addMyProduct    *connect.Client[v2.AddMyProductRequest, v2.AddMyProductResponse]
+	removeMyProduct *connect.Client[v2.RemoveMyProductRequest, v2.RemoveMyProductResponse]
+}
+
+// ListRetailers calls stockchecker.v2.StockCheckerService.ListRetailers.
+func (c *stockCheckerServiceClient) ListRetailers(ctx context.Context, req *connect.Request[v2.ListRetailersRequest]) (*connect.Response[v2.ListRetailersResponse], error) {
+	return c.listRetailers.CallUnary(ctx, req)
+}
+
+// SearchStores calls stockchecker.v2.StockCheckerService.SearchStores.
+func (c *stockCheckerServiceClient) SearchStores(ctx context.Context, req *connect.Request[v2.SearchStoresRequest]) (*connect.Response[v2.SearchStoresResponse], error) {
+	return c.searchStores.CallUnary(ctx, req)
+}
+
+// SearchProducts calls stockchecker.v2.StockCheckerService.SearchProducts.
+func (c *stockCheckerServiceClient) SearchProducts(ctx context.Context, req *connect.Request[v2.SearchProductsRequest]) (*connect.Response[v2.SearchProductsResponse], error) {
+	return c.searchProducts.CallUnary(ctx, req)
+}
+
+// CheckStock calls stockchecker.v2.StockCheckerService.CheckStock.
+func (c *stockCheckerServiceClient) CheckStock(ctx context.Context, req *connect.Request[v2.CheckStockRequest]) (*connect.Response[v2.CheckStockResponse], error) {
+	return c.checkStock.CallUnary(ctx, req)
+}
+
+// ListMyStores calls stockchecker.v2.StockCheckerService.ListMyStores.
+func (c *stockCheckerServiceClient) ListMyStores(ctx context.Context, req *connect.Request[v2.ListMyStoresRequest]) (*connect.Response[v2.ListMyStoresResponse], error) {
+	return c.listMyStores.CallUnary(ctx, req)
+}
+
+// AddMyStore calls stockchecker.v2.StockCheckerService.AddMyStore.
+func (c *stockCheckerServiceClient) AddMyStore(ctx context.Context, req *connect.Request[v2.AddMyStoreRequest]) (*connect.Response[v2.AddMyStoreResponse], error) {
+	return c.addMyStore.CallUnary(ctx, req)
+}
+
+// RemoveMyStore calls stockchecker.v2.StockCheckerService.RemoveMyStore.
+func (c *stockCheckerServiceClient) RemoveMyStore(ctx context.Context, req *connect.Request[v2.RemoveMyStoreRequest]) (*connect.Response[v2.RemoveMyStoreResponse], error) {
+	return c.removeMyStore.CallUnary(ctx, req)
+}
+
+// ListMyProducts calls stockchecker.v2.StockCheckerService.ListMyProducts.
+func (c *stockCheckerServiceClient) ListMyProducts(ctx context.Context, req *connect.Request[v2.ListMyProductsRequest]) (*connect.Response[v2.ListMyProductsResponse], error) {
+	return c.listMyProducts.CallUnary(ctx, req)
+}
+
+// AddMyProduct calls stockchecker.v2.StockCheckerService.AddMyProduct.
+func (c *stockCheckerServiceClient) AddMyProduct(ctx context.Context, req *connect.Request[v2.AddMyProductRequest]) (*connect.Response[v2.AddMyProductResponse], error) {
+	return c.addMyProduct.CallUnary(ctx, req)
+}
+
+// RemoveMyProduct calls stockchecker.v2.StockCheckerService.RemoveMyProduct.
+func (c *stockCheckerServiceClient) RemoveMyProduct(ctx context.Context, req *connect.Request[v2.RemoveMyProductRequest]) (*connect.Response[v2.RemoveMyProductResponse], error) {
+	return c.removeMyProduct.CallUnary(ctx, req)
+}
+
+// StockCheckerServiceHandler is an implementation of the stockchecker.v2.StockCheckerService
+// service.
+type StockCheckerServiceHandler interface {
+	// ListRetailers returns the retailers the server has adapters for
+	ListRetailers(context.Context, *connect.Request[v2.ListRetailersRequest]) (*connect.Response[v2.ListRetailersResponse], error)
+	// SearchStores searches for stores near a location
+	SearchStores(context.Context, *connect.Request[v2.SearchStoresRequest]) (*connect.Response[v2.SearchStoresResponse], error)
+	// SearchProducts searches for products by keyword or SKU
+	SearchProducts(context.Context, *connect.Request[v2.SearchProductsRequest]) (*connect.Response[v2.SearchProductsResponse], error)
+	// CheckStock checks inventory for products near a postal code
+	CheckStock(context.Context, *connect.Request[v2.CheckStockRequest]) (*connect.Response[v2.CheckStockResponse], error)
+	// ListMyStores returns the user's saved stores
+	ListMyStores(context.Context, *connect.Request[v2.ListMyStoresRequest]) (*connect.Response[v2.ListMyStoresResponse], error)
+	// AddMyStore adds a store to the user's list
+	AddMyStore(context.Context, *connect.Request[v2.AddMyStoreRequest]) (*connect.Response[v2.AddMyStoreResponse], error)
+	// RemoveMyStore removes a store from the user's list
+	RemoveMyStore(context.Context, *connect.Request[v2.RemoveMyStoreRequest]) (*connect.Response[v2.RemoveMyStoreResponse], error)
+	// ListMyProducts returns the user's saved products
+	ListMyProducts(context.Context, *connect.Request[v2.ListMyProductsRequest]) (*connect.Response[v2.ListMyProductsResponse], error)
+	// AddMyProduct adds a product to the user's list
+	AddMyProduct(context.Context, *connect.Request[v2.AddMyProductRequest]) (*connect.Response[v2.AddMyProductResponse], error)
+	// RemoveMyProduct removes a product from the user's list
+	RemoveMyProduct(context.Context, *connect.Request[v2.RemoveMyProductRequest]) (*connect.Response[v2.RemoveMyProductResponse], error)
+}
+
+// NewStockCheckerServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStockCheckerServiceHandler(svc StockCheckerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	stockCheckerServiceMethods := v2.File_stockchecker_v2_service_proto.Services().ByName("StockCheckerService").Methods()
+	stockCheckerServiceListRetailersHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListRetailersProcedure,
+		svc.ListRetailers,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListRetailers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSearchStoresHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSearchStoresProcedure,
+		svc.SearchStores,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SearchStores")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceSearchProductsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceSearchProductsProcedure,
+		svc.SearchProducts,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("SearchProducts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceCheckStockHandler := connect.NewUnaryHandler(
+		StockCheckerServiceCheckStockProcedure,
+		svc.CheckStock,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("CheckStock")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceListMyStoresHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListMyStoresProcedure,
+		svc.ListMyStores,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListMyStores")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAddMyStoreHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAddMyStoreProcedure,
+		svc.AddMyStore,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AddMyStore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceRemoveMyStoreHandler := connect.NewUnaryHandler(
+		StockCheckerServiceRemoveMyStoreProcedure,
+		svc.RemoveMyStore,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyStore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceListMyProductsHandler := connect.NewUnaryHandler(
+		StockCheckerServiceListMyProductsProcedure,
+		svc.ListMyProducts,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("ListMyProducts")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceAddMyProductHandler := connect.NewUnaryHandler(
+		StockCheckerServiceAddMyProductProcedure,
+		svc.AddMyProduct,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("AddMyProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	stockCheckerServiceRemoveMyProductHandler := connect.NewUnaryHandler(
+		StockCheckerServiceRemoveMyProductProcedure,
+		svc.RemoveMyProduct,
+		connect.WithSchema(stockCheckerServiceMethods.ByName("RemoveMyProduct")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/stockchecker.v2.StockCheckerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StockCheckerServiceListRetailersProcedure:
+			stockCheckerServiceListRetailersHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSearchStoresProcedure:
+			stockCheckerServiceSearchStoresHandler.ServeHTTP(w, r)
+		case StockCheckerServiceSearchProductsProcedure:
+			stockCheckerServiceSearchProductsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceCheckStockProcedure:
+			stockCheckerServiceCheckStockHandler.ServeHTTP(w, r)
+		case StockCheckerServiceListMyStoresProcedure:
+			stockCheckerServiceListMyStoresHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAddMyStoreProcedure:
+			stockCheckerServiceAddMyStoreHandler.ServeHTTP(w, r)
+		case StockCheckerServiceRemoveMyStoreProcedure:
+			stockCheckerServiceRemoveMyStoreHandler.ServeHTTP(w, r)
+		case StockCheckerServiceListMyProductsProcedure:
+			stockCheckerServiceListMyProductsHandler.ServeHTTP(w, r)
+		case StockCheckerServiceAddMyProductProcedure:
+			stockCheckerServiceAddMyProductHandler.ServeHTTP(w, r)
+		case StockCheckerServiceRemoveMyProductProcedure:
+			stockCheckerServiceRemoveMyProductHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStockCheckerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStockCheckerServiceHandler struct{}
+
+func (UnimplementedStockCheckerServiceHandler) ListRetailers(context.Context, *connect.Request[v2.ListRetailersRequest]) (*connect.Response[v2.ListRetailersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.ListRetailers is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SearchStores(context.Context, *connect.Request[v2.SearchStoresRequest]) (*connect.Response[v2.SearchStoresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.SearchStores is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) SearchProducts(context.Context, *connect.Request[v2.SearchProductsRequest]) (*connect.Response[v2.SearchProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.SearchProducts is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) CheckStock(context.Context, *connect.Request[v2.CheckStockRequest]) (*connect.Response[v2.CheckStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.CheckStock is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ListMyStores(context.Context, *connect.Request[v2.ListMyStoresRequest]) (*connect.Response[v2.ListMyStoresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.ListMyStores is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AddMyStore(context.Context, *connect.Request[v2.AddMyStoreRequest]) (*connect.Response[v2.AddMyStoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.AddMyStore is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) RemoveMyStore(context.Context, *connect.Request[v2.RemoveMyStoreRequest]) (*connect.Response[v2.RemoveMyStoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.RemoveMyStore is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) ListMyProducts(context.Context, *connect.Request[v2.ListMyProductsRequest]) (*connect.Response[v2.ListMyProductsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.ListMyProducts is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) AddMyProduct(context.Context, *connect.Request[v2.AddMyProductRequest]) (*connect.Response[v2.AddMyProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.AddMyProduct is not implemented"))
+}
+
+func (UnimplementedStockCheckerServiceHandler) RemoveMyProduct(context.Context, *connect.Request[v2.RemoveMyProductRequest]) (*connect.Response[v2.RemoveMyProductResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("stockchecker.v2.StockCheckerService.RemoveMyProduct is not implemented"))
+}
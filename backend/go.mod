@@ -5,12 +5,14 @@ go 1.24.0
 require (
 	connectrpc.com/connect v1.17.0
 	github.com/lib/pq v1.10.9
-	golang.org/x/net v0.48.0
+	golang.org/x/crypto v0.46.0
 	golang.org/x/oauth2 v0.34.0
 	google.golang.org/protobuf v1.36.11
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	cloud.google.com/go/compute/metadata v0.3.0 // indirect
+	golang.org/x/net v0.48.0 // indirect
 	golang.org/x/text v0.32.0 // indirect
 )
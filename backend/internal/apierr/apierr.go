@@ -0,0 +1,26 @@
+// Package apierr tells operators about Best Buy API failures, for the API
+// server and the standalone poller alike.
+package apierr
+
+import (
+	"errors"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// Report forwards a Best Buy API failure to the admin channel. A rejected
+// key or exhausted quota is reported at once; anything else only once
+// failures spike.
+func Report(admin *notify.AdminNotifier, err error) {
+	var keyErr *bestbuy.APIKeyError
+	var quotaErr *bestbuy.QuotaExceededError
+	switch {
+	case errors.As(err, &keyErr):
+		admin.Report(notify.EventAPIKeyInvalid, err.Error())
+	case errors.As(err, &quotaErr):
+		admin.Report(notify.EventQuotaExhausted, err.Error())
+	default:
+		admin.Count(notify.EventAdapterDown, err.Error())
+	}
+}
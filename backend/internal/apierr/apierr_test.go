@@ -0,0 +1,50 @@
+package apierr
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// channelNotifier hands every message it's sent to a channel
+type channelNotifier chan notify.Message
+
+func (n channelNotifier) Channel() string { return "test" }
+
+func (n channelNotifier) Send(ctx context.Context, msg notify.Message) error {
+	n <- msg
+	return nil
+}
+
+func TestReport(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		title string // "" if nothing is sent at once
+	}{
+		{"rejected key", &bestbuy.APIKeyError{StatusCode: 403, Body: "Developer Inactive"}, "[Admin] Best Buy API key rejected"},
+		{"quota", &bestbuy.QuotaExceededError{Body: "Over Rate Limit"}, "[Admin] Best Buy API quota exhausted"},
+		{"one timeout", errors.New("failed to execute request: timeout"), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := make(channelNotifier, 1)
+			Report(notify.NewAdminNotifier(sent), tt.err)
+
+			select {
+			case msg := <-sent:
+				if msg.Title != tt.title {
+					t.Errorf("sent %q, want %q", msg.Title, tt.title)
+				}
+			case <-time.After(100 * time.Millisecond):
+				if tt.title != "" {
+					t.Errorf("nothing sent, want %q", tt.title)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package apispec
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var service = stockcheckerv1.File_stockchecker_v1_service_proto.Services().Get(0)
+
+func TestNewDocument(t *testing.T) {
+	doc := NewDocument("Stock Checker API", "1.0.0", "https://stock.example.com/",
+		[]string{"stockchecker.v1.StockCheckerService.GetMyStores"}, service)
+
+	op := doc.Paths["/stockchecker.v1.StockCheckerService/GetMyStores"].Post
+	if op == nil {
+		t.Fatalf("GetMyStores missing; paths: %v", doc.Operations())
+	}
+	if op.RequestBody.Content["application/json"].Schema.Ref != "#/components/schemas/stockchecker.v1.GetMyStoresRequest" {
+		t.Errorf("request schema %+v", op.RequestBody.Content["application/json"].Schema)
+	}
+	if !op.Deprecated {
+		t.Error("GetMyStores not marked deprecated")
+	}
+	if _, ok := doc.Paths["/stockchecker.v1.StockCheckerService/WatchStock"]; ok {
+		t.Error("streaming RPC WatchStock described as plain JSON")
+	}
+	if doc.Servers[0].URL != "https://stock.example.com" {
+		t.Errorf("server %q", doc.Servers[0].URL)
+	}
+
+	// Fields follow the protobuf JSON mapping
+	product := doc.Components.Schemas["stockchecker.v1.Product"]
+	if product == nil {
+		t.Fatal("Product schema missing")
+	}
+	if s := product.Properties["salePriceCents"]; s == nil || s.Type != "string" || s.Format != "int64" {
+		t.Errorf("int64 salePriceCents = %+v, want a string", s)
+	}
+	if s := product.Properties["createdAt"]; s == nil || s.Format != "date-time" {
+		t.Errorf("timestamp createdAt = %+v, want a date-time string", s)
+	}
+	if s := product.Properties["salePrice"]; s == nil || !s.Deprecated {
+		t.Errorf("salePrice = %+v, want it deprecated", s)
+	}
+
+	// Every reference resolves
+	body, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var refs func(v any)
+	refs = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok {
+				name := ref[len("#/components/schemas/"):]
+				if doc.Components.Schemas[name] == nil {
+					t.Errorf("unresolved $ref %s", ref)
+				}
+			}
+			for _, child := range v {
+				refs(child)
+			}
+		case []any:
+			for _, child := range v {
+				refs(child)
+			}
+		}
+	}
+	var generic any
+	json.Unmarshal(body, &generic)
+	refs(generic)
+}
+
+func TestHandlerServesBundle(t *testing.T) {
+	srv := httptest.NewServer(NewHandler(Config{Title: "Stock Checker API", Version: "1.0.0"}, service))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + BundlePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status %d", resp.StatusCode)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string][]byte)
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name], _ = io.ReadAll(r)
+		r.Close()
+	}
+	for _, name := range []string{"README.md", "openapi.json", "buf.gen.yaml", descriptorSetFile} {
+		if len(files[name]) == 0 {
+			t.Errorf("bundle has no %s", name)
+		}
+	}
+
+	// The compiled API links on its own, imports included
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(files[descriptorSetFile], &set); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := protodesc.NewFiles(&set); err != nil {
+		t.Errorf("descriptor set doesn't link: %v", err)
+	}
+
+	// Unchanged APIs aren't downloaded again
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+OpenAPIPath, nil)
+	req.Header.Set("If-None-Match", resp.Header.Get("ETag"))
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("status %d with a matching ETag, want 304", resp.StatusCode)
+	}
+}
@@ -0,0 +1,101 @@
+package apispec
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Paths the handler serves
+const (
+	OpenAPIPath = "/openapi.json"
+	BundlePath  = "/client.zip"
+)
+
+// Config configures the handler
+type Config struct {
+	Title     string
+	Version   string // the server's release, e.g. from APP_VERSION
+	ServerURL string // public URL clients call; omitted from the document if empty
+
+	// Full names of RPCs and fields deprecated without a proto option
+	Deprecated []string
+}
+
+// Handler serves the OpenAPI document and the client bundle. Both are built
+// on first use and tagged with a hash of the API, so clients and caches can
+// tell when a deployment changed it.
+type Handler struct {
+	cfg      Config
+	services []protoreflect.ServiceDescriptor
+
+	once    sync.Once
+	openapi []byte
+	bundle  []byte
+	etag    string
+	err     error
+}
+
+// NewHandler creates a handler describing services
+func NewHandler(cfg Config, services ...protoreflect.ServiceDescriptor) *Handler {
+	return &Handler{cfg: cfg, services: services}
+}
+
+// build renders the document and bundle
+func (h *Handler) build() {
+	set := DescriptorSet(h.services...)
+	descriptors, err := proto.MarshalOptions{Deterministic: true}.Marshal(set)
+	if err != nil {
+		h.err = err
+		return
+	}
+	sum := sha256.Sum256(descriptors)
+	hash := hex.EncodeToString(sum[:6])
+	h.etag = `"` + h.cfg.Version + "-" + hash + `"`
+
+	// The hash tells apart builds that share a version, like "dev"
+	doc := NewDocument(h.cfg.Title, h.cfg.Version+"+"+hash, h.cfg.ServerURL, h.cfg.Deprecated, h.services...)
+	if h.openapi, h.err = json.MarshalIndent(doc, "", "  "); h.err != nil {
+		return
+	}
+	h.bundle, h.err = NewBundle(doc, set)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.URL.Path != OpenAPIPath && r.URL.Path != BundlePath {
+		http.NotFound(w, r)
+		return
+	}
+	h.once.Do(h.build)
+	if h.err != nil {
+		http.Error(w, "failed to describe the API", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", h.etag)
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Header().Set("Access-Control-Allow-Origin", "*") // for browser-based API explorers
+	if r.Header.Get("If-None-Match") == h.etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	switch r.URL.Path {
+	case OpenAPIPath:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(h.openapi)
+	default:
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="stock-checker-client.zip"`)
+		w.Write(h.bundle)
+	}
+}
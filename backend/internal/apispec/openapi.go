@@ -0,0 +1,316 @@
+// Package apispec describes the server's Connect API for third-party
+// integrators: an OpenAPI document and a bundle for generating clients, both
+// built from the compiled-in protobuf descriptors so they always match the
+// running server's version exactly.
+package apispec
+
+import (
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Document is an OpenAPI 3.1 document
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Servers    []Server            `json:"servers,omitempty"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+	Security   []map[string][]any  `json:"security,omitempty"`
+
+	deprecated map[protoreflect.FullName]bool // beyond the proto options
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Server is a base URL the API is served from
+type Server struct {
+	URL string `json:"url"`
+}
+
+// PathItem holds the operations on a path; Connect RPCs are always POSTs
+type PathItem struct {
+	Post *Operation `json:"post,omitempty"`
+}
+
+// Operation is one RPC
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Tags        []string            `json:"tags"`
+	Deprecated  bool                `json:"deprecated,omitempty"`
+	Parameters  []Parameter         `json:"parameters"`
+	RequestBody RequestBody         `json:"requestBody"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter is a request header
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is an RPC's request message
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is an RPC's response message, or its error
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+// MediaType is a body's schema
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the message schemas and security schemes
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme is a way to authenticate
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	In          string `json:"in,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Schema is a JSON Schema, as far as the protobuf JSON mapping needs one
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Deprecated           bool               `json:"deprecated,omitempty"`
+}
+
+// errorSchema is the name of the Connect error schema
+const errorSchema = "connect.error"
+
+// NewDocument describes the unary RPCs of services in the Connect protocol's
+// JSON form. Streaming RPCs need a Connect client and are left out. RPCs and
+// fields are marked deprecated by their proto options or by full name in
+// deprecated.
+func NewDocument(title, version, serverURL string, deprecated []string, services ...protoreflect.ServiceDescriptor) *Document {
+	doc := &Document{
+		OpenAPI: "3.1.0",
+		Info: Info{
+			Title:   title,
+			Version: version,
+			Description: "Every RPC is a POST of its request message as JSON to /<service>/<method>, " +
+				"following the Connect protocol (https://connectrpc.com/docs/protocol). " +
+				"Streaming RPCs are available to Connect and gRPC clients only; see /client.zip.",
+		},
+		Paths: make(map[string]PathItem),
+		Components: Components{
+			Schemas: map[string]*Schema{
+				errorSchema: {
+					Type:        "object",
+					Description: "A Connect error",
+					Properties: map[string]*Schema{
+						"code":    {Type: "string", Description: "e.g. not_found, permission_denied or unauthenticated"},
+						"message": {Type: "string"},
+						"details": {Type: "array", Items: &Schema{Type: "object"}},
+					},
+				},
+			},
+			SecuritySchemes: map[string]SecurityScheme{
+				"apiKey": {Type: "http", Scheme: "bearer", Description: "An API key created in the app's settings"},
+				"session": {
+					Type: "apiKey", In: "cookie", Name: "session_token",
+					Description: "The web app's session; public RPCs also work signed out",
+				},
+			},
+		},
+		Security:   []map[string][]any{{"apiKey": {}}, {"session": {}}},
+		deprecated: make(map[protoreflect.FullName]bool, len(deprecated)),
+	}
+	for _, name := range deprecated {
+		doc.deprecated[protoreflect.FullName(name)] = true
+	}
+	if serverURL != "" {
+		doc.Servers = []Server{{URL: strings.TrimSuffix(serverURL, "/")}}
+	}
+
+	for _, service := range services {
+		methods := service.Methods()
+		for i := 0; i < methods.Len(); i++ {
+			method := methods.Get(i)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+			doc.addSchema(method.Input())
+			doc.addSchema(method.Output())
+			doc.Paths["/"+string(service.FullName())+"/"+string(method.Name())] = PathItem{Post: &Operation{
+				OperationID: string(method.FullName()),
+				Tags:        []string{string(service.FullName())},
+				Deprecated:  doc.deprecated[method.FullName()] || isDeprecated(method.Options()),
+				Parameters: []Parameter{{
+					Name: "Connect-Protocol-Version", In: "header", Required: true,
+					Schema: &Schema{Type: "string", Enum: []string{"1"}},
+				}},
+				RequestBody: RequestBody{
+					Required: true,
+					Content:  jsonContent(ref(method.Input().FullName())),
+				},
+				Responses: map[string]Response{
+					"200":     {Description: "Success", Content: jsonContent(ref(method.Output().FullName()))},
+					"default": {Description: "Error", Content: jsonContent(&Schema{Ref: "#/components/schemas/" + errorSchema})},
+				},
+			}}
+		}
+	}
+	return doc
+}
+
+// Operations returns the paths of every operation, sorted
+func (d *Document) Operations() []string {
+	paths := make([]string, 0, len(d.Paths))
+	for path := range d.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// addSchema adds the schema of a message and every message and enum it uses
+func (d *Document) addSchema(msg protoreflect.MessageDescriptor) {
+	name := string(msg.FullName())
+	if _, ok := d.Components.Schemas[name]; ok || wellKnown(msg) != nil {
+		return
+	}
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	d.Components.Schemas[name] = schema // before fields, as messages can refer to themselves
+
+	fields := msg.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		s := d.fieldSchema(field)
+		if d.deprecated[field.FullName()] || isDeprecated(field.Options()) {
+			s.Deprecated = true
+		}
+		schema.Properties[field.JSONName()] = s
+	}
+}
+
+// fieldSchema returns the schema of a field's value in the protobuf JSON mapping
+func (d *Document) fieldSchema(field protoreflect.FieldDescriptor) *Schema {
+	switch {
+	case field.IsMap():
+		return &Schema{Type: "object", AdditionalProperties: d.valueSchema(field.MapValue())}
+	case field.IsList():
+		return &Schema{Type: "array", Items: d.valueSchema(field)}
+	}
+	return d.valueSchema(field)
+}
+
+// valueSchema returns the schema of one value of a field
+func (d *Document) valueSchema(field protoreflect.FieldDescriptor) *Schema {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &Schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &Schema{Type: "string", Format: "int64"} // 64-bit integers are strings in JSON
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &Schema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &Schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &Schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &Schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		enum := field.Enum()
+		name := string(enum.FullName())
+		if _, ok := d.Components.Schemas[name]; !ok {
+			values := enum.Values()
+			schema := &Schema{Type: "string"}
+			for i := 0; i < values.Len(); i++ {
+				schema.Enum = append(schema.Enum, string(values.Get(i).Name()))
+			}
+			d.Components.Schemas[name] = schema
+		}
+		return ref(enum.FullName())
+	default: // messages and groups
+		if s := wellKnown(field.Message()); s != nil {
+			return s
+		}
+		d.addSchema(field.Message())
+		return ref(field.Message().FullName())
+	}
+}
+
+// wellKnown returns the schema of a well-known type with its own JSON form,
+// or nil for other messages
+func wellKnown(msg protoreflect.MessageDescriptor) *Schema {
+	switch msg.FullName() {
+	case "google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}
+	case "google.protobuf.Duration":
+		return &Schema{Type: "string", Description: "Seconds with an s suffix, e.g. 1.5s"}
+	case "google.protobuf.FieldMask":
+		return &Schema{Type: "string", Description: "Comma-separated field paths in lowerCamelCase"}
+	case "google.protobuf.Struct":
+		return &Schema{Type: "object"}
+	case "google.protobuf.Value":
+		return &Schema{}
+	case "google.protobuf.Empty":
+		return &Schema{Type: "object"}
+	case "google.protobuf.StringValue":
+		return &Schema{Type: "string"}
+	case "google.protobuf.BoolValue":
+		return &Schema{Type: "boolean"}
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return &Schema{Type: "integer"}
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return &Schema{Type: "string", Format: "int64"}
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue":
+		return &Schema{Type: "number"}
+	}
+	return nil
+}
+
+// isDeprecated reports whether options mark a method or field deprecated
+func isDeprecated(opts protoreflect.ProtoMessage) bool {
+	switch o := opts.(type) {
+	case *descriptorpb.MethodOptions:
+		return o.GetDeprecated()
+	case *descriptorpb.FieldOptions:
+		return o.GetDeprecated()
+	}
+	return false
+}
+
+func ref(name protoreflect.FullName) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + string(name)}
+}
+
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: schema}}
+}
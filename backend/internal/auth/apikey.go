@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+)
+
+const (
+	// APIKeyPrefix starts every API key, so leaked keys are easy to spot
+	APIKeyPrefix = "sck_"
+
+	// apiKeyDisplayLen is how much of a key is kept to identify it in listings
+	apiKeyDisplayLen = len(APIKeyPrefix) + 8
+)
+
+// GenerateAPIKey generates a new API key, returning it and the prefix shown
+// in listings once the key itself is gone
+func GenerateAPIKey() (key, prefix string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:apiKeyDisplayLen], nil
+}
+
+// HashAPIKey hashes an API key for storage and lookup. Keys are random, so
+// a plain SHA-256 is enough; there's nothing to brute force.
+func HashAPIKey(key string) string {
+	return hashToken(key)
+}
+
+// BearerToken gets the token from an "Authorization: Bearer <token>" header
+// value, or "" if there isn't one
+func BearerToken(header string) string {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
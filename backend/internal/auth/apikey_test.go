@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, prefix, err := GenerateAPIKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, APIKeyPrefix) || !strings.HasPrefix(key, prefix) || len(prefix) >= len(key) {
+		t.Errorf("key %q, prefix %q", key, prefix)
+	}
+	other, _, _ := GenerateAPIKey()
+	if other == key {
+		t.Error("generated the same key twice")
+	}
+	if HashAPIKey(key) == HashAPIKey(other) || len(HashAPIKey(key)) != 64 {
+		t.Errorf("hashes %q, %q", HashAPIKey(key), HashAPIKey(other))
+	}
+}
+
+func TestBearerToken(t *testing.T) {
+	tests := map[string]string{
+		"Bearer sck_abc":  "sck_abc",
+		"bearer  sck_abc": "sck_abc",
+		"Basic dXNlcjpw":  "",
+		"sck_abc":         "",
+		"":                "",
+	}
+	for header, want := range tests {
+		if got := BearerToken(header); got != want {
+			t.Errorf("BearerToken(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
@@ -3,16 +3,19 @@ package auth
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
 	"encoding/base64"
-	"encoding/json"
+	"encoding/hex"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/tmcauley/stock-checker/backend/internal/clientip"
 	"github.com/tmcauley/stock-checker/backend/internal/database"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
 )
 
 const (
@@ -32,30 +35,57 @@ type GoogleUserInfo struct {
 // Auth handles authentication
 type Auth struct {
 	db           *database.DB
-	oauthConfig  *oauth2.Config
+	providers    []*Provider // the first is used when a login doesn't pick one
 	frontendURL  string
 	secureCookie bool
+	adminEmails  map[string]bool  // promoted to admin when they sign in
+	proxies      clientip.Proxies // reverse proxies whose X-Forwarded-For is believed
+
+	loginEmail *notify.EmailConfig // SMTP server for magic links; nil if disabled
+	publicURL  string              // backend URL magic links point at
 }
 
-// New creates a new Auth handler
-func New(db *database.DB, clientID, clientSecret, redirectURL, frontendURL string, secureCookie bool) *Auth {
+// New creates a new Auth handler that signs users in with any of the providers
+func New(db *database.DB, frontendURL string, secureCookie bool, providers ...*Provider) *Auth {
 	return &Auth{
-		db: db,
-		oauthConfig: &oauth2.Config{
-			ClientID:     clientID,
-			ClientSecret: clientSecret,
-			RedirectURL:  redirectURL,
-			Scopes: []string{
-				"https://www.googleapis.com/auth/userinfo.email",
-				"https://www.googleapis.com/auth/userinfo.profile",
-			},
-			Endpoint: google.Endpoint,
-		},
+		db:           db,
+		providers:    providers,
 		frontendURL:  frontendURL,
 		secureCookie: secureCookie,
 	}
 }
 
+// Providers returns the login providers, in the order they're offered
+func (a *Auth) Providers() []*Provider {
+	return a.providers
+}
+
+// provider finds a login provider by ID; an empty ID is the first provider
+func (a *Auth) provider(id string) *Provider {
+	for _, p := range a.providers {
+		if id == "" || p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// SetAdminEmails sets the emails given the admin role when they sign in, so
+// the first admin can be seeded from config
+func (a *Auth) SetAdminEmails(emails []string) {
+	a.adminEmails = make(map[string]bool, len(emails))
+	for _, email := range emails {
+		a.adminEmails[strings.ToLower(email)] = true
+	}
+}
+
+// SetTrustedProxies sets the reverse proxies in front of the server, whose
+// X-Forwarded-For gives the client's IP. Without them the connection's
+// address is used.
+func (a *Auth) SetTrustedProxies(proxies clientip.Proxies) {
+	a.proxies = proxies
+}
+
 // generateToken generates a random token
 func generateToken() (string, error) {
 	b := make([]byte, 32)
@@ -65,14 +95,29 @@ func generateToken() (string, error) {
 	return base64.URLEncoding.EncodeToString(b), nil
 }
 
-// HandleLogin redirects to Google OAuth
+// hashToken hashes a random token for storage and lookup
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// HandleLogin redirects to the login provider chosen by the "provider"
+// query parameter, or the first provider if there's none
 func (a *Auth) HandleLogin(w http.ResponseWriter, r *http.Request) {
-	// Generate state token to prevent CSRF
-	state, err := generateToken()
+	provider := a.provider(r.URL.Query().Get("provider"))
+	if provider == nil {
+		http.Error(w, "Unknown login provider", http.StatusBadRequest)
+		return
+	}
+
+	// Generate state token to prevent CSRF. It starts with the provider so
+	// every provider can share one callback URL.
+	token, err := generateToken()
 	if err != nil {
 		http.Error(w, "Failed to generate state", http.StatusInternalServerError)
 		return
 	}
+	state := provider.ID + "." + token
 
 	// Store state in cookie
 	// Use SameSiteNoneMode for cross-origin requests (frontend on different domain)
@@ -90,12 +135,12 @@ func (a *Auth) HandleLogin(w http.ResponseWriter, r *http.Request) {
 		SameSite: sameSite,
 	})
 
-	// Redirect to Google
-	url := a.oauthConfig.AuthCodeURL(state)
+	// Redirect to the provider
+	url := provider.config.AuthCodeURL(state)
 	http.Redirect(w, r, url, http.StatusTemporaryRedirect)
 }
 
-// HandleCallback handles the OAuth callback from Google
+// HandleCallback handles the OAuth callback from a login provider
 func (a *Auth) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -105,6 +150,12 @@ func (a *Auth) HandleCallback(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Invalid state", http.StatusBadRequest)
 		return
 	}
+	providerID, _, _ := strings.Cut(stateCookie.Value, ".")
+	provider := a.provider(providerID)
+	if providerID == "" || provider == nil {
+		http.Error(w, "Invalid state", http.StatusBadRequest)
+		return
+	}
 
 	// Clear state cookie
 	http.SetCookie(w, &http.Cookie{
@@ -116,37 +167,72 @@ func (a *Auth) HandleCallback(w http.ResponseWriter, r *http.Request) {
 
 	// Exchange code for token
 	code := r.URL.Query().Get("code")
-	token, err := a.oauthConfig.Exchange(ctx, code)
+	token, err := provider.config.Exchange(ctx, code)
 	if err != nil {
 		http.Error(w, "Failed to exchange token", http.StatusInternalServerError)
 		return
 	}
 
-	// Get user info from Google
-	userInfo, err := a.getUserInfo(ctx, token)
+	// Get the account from the provider
+	profile, err := provider.profile(ctx, provider.config.Client(ctx, token))
 	if err != nil {
 		http.Error(w, "Failed to get user info", http.StatusInternalServerError)
 		return
 	}
 
+	// Accounts are allowed and linked by email, so it must be one the provider verified
+	if profile.Email == "" || !profile.EmailVerified {
+		http.Redirect(w, r, a.frontendURL+"?error=email_not_verified", http.StatusTemporaryRedirect)
+		return
+	}
+
+	a.signIn(w, r, database.Identity{
+		Provider:   provider.ID,
+		Subject:    profile.Subject,
+		Email:      profile.Email,
+		Name:       profile.Name,
+		PictureURL: profile.Picture,
+	}, "")
+}
+
+// signIn starts a session for the user a verified account belongs to, if
+// its email is allowed or an invite lets it in, and redirects to the frontend.
+// inviteHash is the invite the sign-in was started with, if not the cookie's.
+func (a *Auth) signIn(w http.ResponseWriter, r *http.Request, id database.Identity, inviteHash string) {
+	ctx := r.Context()
+
 	// Check if email is allowed
-	allowed, err := a.db.IsEmailAllowed(ctx, userInfo.Email)
+	allowed, err := a.db.IsEmailAllowed(ctx, id.Email)
 	if err != nil {
 		http.Error(w, "Database error", http.StatusInternalServerError)
 		return
 	}
+	if !allowed {
+		// An invite lets in an email nobody added yet
+		allowed, err = a.useInvite(w, r, id.Email, inviteHash)
+		if err != nil {
+			http.Error(w, "Database error", http.StatusInternalServerError)
+			return
+		}
+	}
 	if !allowed {
 		// Redirect to frontend with error
 		http.Redirect(w, r, a.frontendURL+"?error=not_allowed", http.StatusTemporaryRedirect)
 		return
 	}
 
-	// Create or update user
-	user, err := a.db.GetOrCreateUser(ctx, userInfo.ID, userInfo.Email, userInfo.Name, userInfo.Picture)
+	// Find the user the account belongs to, linking it by email the first time
+	user, err := a.db.SignInIdentity(ctx, id)
 	if err != nil {
 		http.Error(w, "Failed to create user", http.StatusInternalServerError)
 		return
 	}
+	if a.adminEmails[strings.ToLower(user.Email)] && !user.IsAdmin() {
+		if _, err := a.db.SetUserRole(ctx, user.ID, database.RoleAdmin); err != nil {
+			http.Error(w, "Failed to update user", http.StatusInternalServerError)
+			return
+		}
+	}
 
 	// Create session
 	sessionToken, err := generateToken()
@@ -156,7 +242,13 @@ func (a *Auth) HandleCallback(w http.ResponseWriter, r *http.Request) {
 	}
 
 	expiresAt := time.Now().Add(SessionDuration)
-	if err := a.db.CreateSession(ctx, user.ID, sessionToken, expiresAt); err != nil {
+	if err := a.db.CreateSession(ctx, database.Session{
+		Token:     sessionToken,
+		UserID:    user.ID,
+		UserAgent: r.UserAgent(),
+		IPAddress: a.proxies.IP(r),
+		ExpiresAt: expiresAt,
+	}); err != nil {
 		http.Error(w, "Failed to save session", http.StatusInternalServerError)
 		return
 	}
@@ -210,70 +302,58 @@ func (a *Auth) HandleLogout(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, a.frontendURL, http.StatusTemporaryRedirect)
 }
 
-// getUserInfo fetches user info from Google
-func (a *Auth) getUserInfo(ctx context.Context, token *oauth2.Token) (*GoogleUserInfo, error) {
-	client := a.oauthConfig.Client(ctx, token)
-	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+// SessionFromHeader gets the session whose cookie is in the request headers
+// and its user, or nils if there's no cookie or the session has expired
+func (a *Auth) SessionFromHeader(ctx context.Context, header http.Header) (*database.Session, *database.User, error) {
+	cookie, err := (&http.Request{Header: header}).Cookie(SessionCookieName)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil
 	}
 
-	var userInfo GoogleUserInfo
-	if err := json.Unmarshal(body, &userInfo); err != nil {
-		return nil, err
+	session, err := a.db.GetSession(ctx, cookie.Value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, nil
 	}
-
-	return &userInfo, nil
-}
-
-// GetUserFromRequest gets the current user from the request
-func (a *Auth) GetUserFromRequest(r *http.Request) (*database.User, error) {
-	cookie, err := r.Cookie(SessionCookieName)
-	if err != nil {
-		return nil, fmt.Errorf("no session cookie")
-	}
-
-	session, err := a.db.GetSession(r.Context(), cookie.Value)
 	if err != nil {
-		return nil, fmt.Errorf("invalid session")
+		return nil, nil, fmt.Errorf("failed to load session: %w", err)
 	}
 
-	user, err := a.db.GetUserByID(r.Context(), session.UserID)
+	user, err := a.db.GetUserByID(ctx, session.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("user not found")
+		return nil, nil, fmt.Errorf("failed to load user: %w", err)
 	}
 
-	return user, nil
-}
-
-// Middleware returns an auth middleware that requires authentication
-func (a *Auth) Middleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		user, err := a.GetUserFromRequest(r)
-		if err != nil {
-			http.Error(w, "Unauthorized", http.StatusUnauthorized)
-			return
-		}
-
-		// Add user to context
-		ctx := context.WithValue(r.Context(), userContextKey, user)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	return session, user, nil
 }
 
 // Context key for user
 type contextKey string
 
-const userContextKey contextKey = "user"
+const (
+	userContextKey    contextKey = "user"
+	sessionContextKey contextKey = "session"
+)
+
+// WithUser returns a context carrying the authenticated user
+func WithUser(ctx context.Context, user *database.User) context.Context {
+	return context.WithValue(ctx, userContextKey, user)
+}
 
 // UserFromContext gets the user from context
 func UserFromContext(ctx context.Context) *database.User {
 	user, _ := ctx.Value(userContextKey).(*database.User)
 	return user
 }
+
+// WithSession returns a context carrying the ID of the session the request
+// was authenticated with
+func WithSession(ctx context.Context, sessionID int) context.Context {
+	return context.WithValue(ctx, sessionContextKey, sessionID)
+}
+
+// SessionIDFromContext gets the ID of the request's session, or 0 if it was
+// made with an API key
+func SessionIDFromContext(ctx context.Context) int {
+	id, _ := ctx.Value(sessionContextKey).(int)
+	return id
+}
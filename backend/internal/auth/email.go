@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// ProviderEmail is the provider ID of accounts signed in with a magic link
+const ProviderEmail = "email"
+
+// Magic link limits
+const (
+	LoginLinkDuration = 15 * time.Minute // how long a link works
+	loginLinkWindow   = 15 * time.Minute // window the request limits apply to
+	maxLinksPerEmail  = 3
+	maxLinksPerIP     = 10
+)
+
+// SetEmailLogin enables signing in with a magic link sent by email over the
+// SMTP server in cfg (its To is ignored). Links point at publicURL.
+func (a *Auth) SetEmailLogin(cfg notify.EmailConfig, publicURL string) {
+	a.loginEmail = &cfg
+	a.publicURL = strings.TrimSuffix(publicURL, "/")
+}
+
+// EmailLoginEnabled reports whether users can sign in with a magic link
+func (a *Auth) EmailLoginEnabled() bool {
+	return a.loginEmail != nil
+}
+
+// HandleEmailLogin sends a magic link to the "email" form value. It answers
+// the same way whether or not the email is allowed to sign in, so it can't
+// be used to find out who has access; only allowed emails, or any email in a
+// browser that opened a usable invite, are sent a link.
+func (a *Auth) HandleEmailLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if a.loginEmail == nil {
+		http.NotFound(w, r)
+		return
+	}
+	ctx := r.Context()
+
+	addr, err := mail.ParseAddress(strings.TrimSpace(r.FormValue("email")))
+	if err != nil {
+		http.Error(w, "Invalid email", http.StatusBadRequest)
+		return
+	}
+	email := strings.ToLower(addr.Address)
+
+	ip := a.proxies.IP(r)
+	byEmail, byIP, err := a.db.CountLoginLinks(ctx, email, ip, time.Now().Add(-loginLinkWindow))
+	if err != nil {
+		http.Error(w, "Database error", http.StatusInternalServerError)
+		return
+	}
+	if byEmail >= maxLinksPerEmail || byIP >= maxLinksPerIP {
+		w.Header().Set("Retry-After", strconv.Itoa(int(loginLinkWindow.Seconds())))
+		http.Error(w, "Too many sign-in links requested; try again later", http.StatusTooManyRequests)
+		return
+	}
+
+	// Every request is recorded so the limits also cover emails that aren't
+	// allowed. The invite goes with the link, so it's used even if the link is
+	// opened in another browser.
+	token, err := generateToken()
+	if err != nil {
+		http.Error(w, "Failed to create link", http.StatusInternalServerError)
+		return
+	}
+	inviteHash := cookieInvite(r)
+	if err := a.db.CreateLoginLink(ctx, email, hashToken(token), inviteHash, ip, time.Now().Add(LoginLinkDuration)); err != nil {
+		http.Error(w, "Database error", http.StatusInternalServerError)
+		return
+	}
+
+	allowed, err := a.db.IsEmailAllowed(ctx, email)
+	if err == nil && !allowed && inviteHash != "" {
+		allowed, err = a.db.IsInviteUsable(ctx, inviteHash)
+	}
+	if err != nil {
+		http.Error(w, "Database error", http.StatusInternalServerError)
+		return
+	}
+	if allowed {
+		// Sent in the background so the response time doesn't reveal the answer
+		locale := i18n.FromAcceptLanguage(r.Header.Get("Accept-Language"))
+		go a.sendLoginLink(context.WithoutCancel(ctx), email, token, locale)
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// sendLoginLink emails a magic link
+func (a *Auth) sendLoginLink(ctx context.Context, email, token string, locale i18n.Locale) {
+	cfg := *a.loginEmail
+	cfg.To = email
+	sender, err := notify.NewEmail(cfg)
+	if err != nil {
+		log.Printf("Login link for %s not sent: %v", email, err)
+		return
+	}
+
+	err = sender.Send(ctx, notify.Message{
+		Title: i18n.T(locale, "auth.login_link_title"),
+		Body:  i18n.T(locale, "auth.login_link_body", int(LoginLinkDuration.Minutes())),
+		URL:   a.publicURL + "/auth/email/callback?token=" + url.QueryEscape(token),
+	})
+	if err != nil {
+		log.Printf("Login link for %s not sent: %v", email, err)
+	}
+}
+
+// HandleEmailCallback signs in the owner of a magic link. Each link works once.
+func (a *Auth) HandleEmailCallback(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if a.loginEmail == nil || token == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	email, inviteHash, err := a.db.UseLoginLink(r.Context(), hashToken(token))
+	if err != nil {
+		http.Error(w, "Database error", http.StatusInternalServerError)
+		return
+	}
+	if email == "" {
+		http.Redirect(w, r, a.frontendURL+"?error=link_expired", http.StatusTemporaryRedirect)
+		return
+	}
+
+	// Opening the link proves the user receives mail at the address
+	a.signIn(w, r, database.Identity{
+		Provider: ProviderEmail,
+		Subject:  email,
+		Email:    email,
+	}, inviteHash)
+}
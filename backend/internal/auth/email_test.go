@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+func TestHandleEmailLoginRejectsBadRequests(t *testing.T) {
+	disabled := New(nil, "http://localhost:5173", false)
+	enabled := New(nil, "http://localhost:5173", false)
+	enabled.SetEmailLogin(notify.EmailConfig{Host: "smtp.example.com", From: "stock@example.com"}, "http://localhost:8080/")
+
+	tests := []struct {
+		name   string
+		auth   *Auth
+		method string
+		email  string
+		want   int
+	}{
+		{"disabled", disabled, http.MethodPost, "ash@example.com", http.StatusNotFound},
+		{"get", enabled, http.MethodGet, "ash@example.com", http.StatusMethodNotAllowed},
+		{"missing email", enabled, http.MethodPost, "", http.StatusBadRequest},
+		{"invalid email", enabled, http.MethodPost, "not an email", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		form := url.Values{"email": {tt.email}}
+		req := httptest.NewRequest(tt.method, "/auth/email", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		tt.auth.HandleEmailLogin(rec, req)
+		if rec.Code != tt.want {
+			t.Errorf("%s: status %d, want %d", tt.name, rec.Code, tt.want)
+		}
+	}
+	if enabled.publicURL != "http://localhost:8080" {
+		t.Errorf("publicURL = %q", enabled.publicURL)
+	}
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+)
+
+// Invite limits
+const (
+	DefaultInviteDuration = 7 * 24 * time.Hour  // how long an invite works unless the admin says otherwise
+	MaxInviteDuration     = 30 * 24 * time.Hour // the longest an invite can work
+	inviteCookieName      = "invite_token"
+	inviteCookieAge       = time.Hour // time to pick a provider and sign in after opening an invite
+)
+
+// GenerateInvite generates a new invite token
+func GenerateInvite() (string, error) {
+	return generateToken()
+}
+
+// HashInvite hashes an invite token for storage and lookup
+func HashInvite(token string) string {
+	return hashToken(token)
+}
+
+// HandleInvite opens an invite link: it keeps the invite's token in a cookie
+// and sends the browser to the frontend to sign in. The invite is used up by
+// the first sign-in whose email isn't already allowed.
+func (a *Auth) HandleInvite(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Redirect(w, r, a.frontendURL+"?error=invite_invalid", http.StatusTemporaryRedirect)
+		return
+	}
+	usable, err := a.db.IsInviteUsable(r.Context(), HashInvite(token))
+	if err != nil {
+		http.Error(w, "Database error", http.StatusInternalServerError)
+		return
+	}
+	if !usable {
+		http.Redirect(w, r, a.frontendURL+"?error=invite_invalid", http.StatusTemporaryRedirect)
+		return
+	}
+
+	// Use SameSiteNoneMode for cross-origin requests (frontend on different domain)
+	sameSite := http.SameSiteLaxMode
+	if a.secureCookie {
+		sameSite = http.SameSiteNoneMode
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     inviteCookieName,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int(inviteCookieAge.Seconds()),
+		HttpOnly: true,
+		Secure:   a.secureCookie,
+		SameSite: sameSite,
+	})
+
+	http.Redirect(w, r, a.frontendURL+"?invited=true", http.StatusTemporaryRedirect)
+}
+
+// cookieInvite returns the hash of the invite in the request's cookie, or ""
+// if the browser hasn't opened one
+func cookieInvite(r *http.Request) string {
+	cookie, err := r.Cookie(inviteCookieName)
+	if err != nil || cookie.Value == "" {
+		return ""
+	}
+	return HashInvite(cookie.Value)
+}
+
+// useInvite allows email to sign in with the invite the sign-in was started
+// with (by hash, "" for none), or else the one in the request's cookie,
+// clearing the cookie. It reports false if there's no usable invite.
+func (a *Auth) useInvite(w http.ResponseWriter, r *http.Request, email, inviteHash string) (bool, error) {
+	if fromCookie := cookieInvite(r); fromCookie != "" {
+		http.SetCookie(w, &http.Cookie{
+			Name:   inviteCookieName,
+			Value:  "",
+			Path:   "/",
+			MaxAge: -1,
+		})
+		if inviteHash == "" {
+			inviteHash = fromCookie
+		}
+	}
+	if inviteHash == "" {
+		return false, nil
+	}
+	return a.db.UseInvite(r.Context(), inviteHash, email)
+}
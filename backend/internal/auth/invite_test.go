@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleInviteWithoutToken(t *testing.T) {
+	a := New(nil, "http://localhost:5173", false)
+
+	rec := httptest.NewRecorder()
+	a.HandleInvite(rec, httptest.NewRequest(http.MethodGet, "/auth/invite", nil))
+	if got := rec.Header().Get("Location"); got != "http://localhost:5173?error=invite_invalid" {
+		t.Errorf("redirected to %q, want the invalid invite error", got)
+	}
+	if len(rec.Result().Cookies()) != 0 {
+		t.Error("set a cookie for a missing invite")
+	}
+}
+
+func TestUseInviteWithoutCookie(t *testing.T) {
+	a := New(nil, "http://localhost:5173", false)
+
+	used, err := a.useInvite(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/auth/callback", nil), "ash@example.com", "")
+	if used || err != nil {
+		t.Errorf("useInvite = %v, %v; want false without an invite cookie", used, err)
+	}
+}
+
+func TestCookieInvite(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/auth/email", nil)
+	if got := cookieInvite(req); got != "" {
+		t.Errorf("cookieInvite = %q without an invite cookie", got)
+	}
+
+	// A magic link requested after opening an invite carries it, so the
+	// invite lets its email in wherever the link is opened
+	req.AddCookie(&http.Cookie{Name: inviteCookieName, Value: "invite-token"})
+	if got := cookieInvite(req); got != HashInvite("invite-token") {
+		t.Errorf("cookieInvite = %q, want the invite token's hash", got)
+	}
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newIssuer serves an OIDC discovery document and userinfo endpoint
+func newIssuer(t *testing.T, claimedIssuer string, userinfo map[string]any) *httptest.Server {
+	t.Helper()
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.well-known/openid-configuration":
+			issuer := claimedIssuer
+			if issuer == "" {
+				issuer = srv.URL
+			}
+			json.NewEncoder(w).Encode(map[string]string{
+				"issuer":                 issuer,
+				"authorization_endpoint": srv.URL + "/authorize",
+				"token_endpoint":         srv.URL + "/token",
+				"userinfo_endpoint":      srv.URL + "/userinfo",
+			})
+		case "/userinfo":
+			json.NewEncoder(w).Encode(userinfo)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestOIDCProvider(t *testing.T) {
+	srv := newIssuer(t, "", map[string]any{
+		"sub":                "abc123",
+		"email":              "ash@example.com",
+		"email_verified":     true,
+		"preferred_username": "ash",
+	})
+
+	p, err := NewOIDCProvider(context.Background(), "Authentik", srv.URL+"/", "id", "secret", "http://localhost:8080/auth/callback")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != ProviderOIDC || p.Name != "Authentik" || p.config.Endpoint.TokenURL != srv.URL+"/token" {
+		t.Errorf("provider = %+v, endpoint %+v", p, p.config.Endpoint)
+	}
+
+	profile, err := p.profile(context.Background(), srv.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Profile{Subject: "abc123", Email: "ash@example.com", EmailVerified: true, Name: "ash"}
+	if *profile != want {
+		t.Errorf("profile = %+v, want %+v", *profile, want)
+	}
+}
+
+func TestOIDCProviderRejectsMismatchedIssuer(t *testing.T) {
+	srv := newIssuer(t, "https://evil.example.com", nil)
+	if _, err := NewOIDCProvider(context.Background(), "", srv.URL, "id", "secret", ""); err == nil {
+		t.Error("accepted a discovery document for another issuer")
+	}
+}
+
+func TestProviderLookup(t *testing.T) {
+	google := NewGoogleProvider("id", "secret", "")
+	github := NewGitHubProvider("id", "secret", "")
+	a := New(nil, "http://localhost:5173", false, google, github)
+
+	if got := a.provider(""); got != google {
+		t.Errorf("default provider = %v, want google", got.ID)
+	}
+	if got := a.provider(ProviderGitHub); got != github {
+		t.Errorf("github provider = %v", got)
+	}
+	if got := a.provider(ProviderOIDC); got != nil {
+		t.Errorf("unconfigured provider = %v, want nil", got.ID)
+	}
+}
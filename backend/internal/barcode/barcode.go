@@ -0,0 +1,186 @@
+// Package barcode encodes product identifiers as linear barcodes that store
+// associates can scan, and renders them as SVG.
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+)
+
+// Symbology is a barcode format
+type Symbology string
+
+// Supported symbologies
+const (
+	UPCA    Symbology = "upc_a"
+	Code128 Symbology = "code128"
+)
+
+// Barcode is an encoded barcode: a run of modules (narrow bars or spaces),
+// true for bars
+type Barcode struct {
+	Symbology Symbology
+	Text      string // human-readable text printed under the bars
+	Modules   []bool
+}
+
+// ErrInvalidUPC is returned for UPCs that aren't 12 digits with a valid check digit
+var ErrInvalidUPC = errors.New("UPC must be 12 digits with a valid check digit")
+
+// upcLeft are the odd-parity patterns of the left half of a UPC-A; the right
+// half uses their complements
+var upcLeft = [10]string{
+	"0001101", "0011001", "0010011", "0111101", "0100011",
+	"0110001", "0101111", "0111011", "0110111", "0001011",
+}
+
+// EncodeUPCA encodes a 12-digit UPC-A
+func EncodeUPCA(upc string) (*Barcode, error) {
+	if len(upc) != 12 || strings.Trim(upc, "0123456789") != "" || !validCheckDigit(upc) {
+		return nil, ErrInvalidUPC
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("101")
+	for i, c := range upc {
+		if i == 6 {
+			pattern.WriteString("01010")
+		}
+		p := upcLeft[c-'0']
+		if i >= 6 {
+			p = complement(p)
+		}
+		pattern.WriteString(p)
+	}
+	pattern.WriteString("101")
+
+	return &Barcode{Symbology: UPCA, Text: upc, Modules: modules(pattern.String())}, nil
+}
+
+// validCheckDigit validates the GTIN check digit of a UPC-A
+func validCheckDigit(digits string) bool {
+	sum := 0
+	for i, c := range digits[:len(digits)-1] {
+		d := int(c - '0')
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(digits[len(digits)-1]-'0')
+}
+
+// complement flips every module of a pattern
+func complement(p string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '0' {
+			return '1'
+		}
+		return '0'
+	}, p)
+}
+
+// modules converts a "1010" pattern to modules
+func modules(pattern string) []bool {
+	m := make([]bool, len(pattern))
+	for i, c := range pattern {
+		m[i] = c == '1'
+	}
+	return m
+}
+
+// code128Widths are the bar/space widths of every Code 128 symbol value,
+// starting with a bar. 103-105 are the start codes and 106 the stop code.
+var code128Widths = [107]string{
+	"212222", "222122", "222221", "121223", "121322", "131222", "122213", "122312", "132212", "221213",
+	"221312", "231212", "112232", "122132", "122231", "113222", "123122", "123221", "223211", "221132",
+	"221231", "213212", "223112", "312131", "311222", "321122", "321221", "312212", "322112", "322211",
+	"212123", "212321", "232121", "111323", "131123", "131321", "112313", "132113", "132311", "211313",
+	"231113", "231311", "112133", "112331", "132131", "113123", "113321", "133121", "313121", "211331",
+	"231131", "213113", "213311", "213131", "311123", "311321", "331121", "312113", "312311", "332111",
+	"314111", "221411", "431111", "111224", "111422", "121124", "121421", "141122", "141221", "112214",
+	"112412", "122114", "122411", "142112", "142211", "241211", "221114", "413111", "241112", "134111",
+	"111242", "121142", "121241", "114212", "124112", "124211", "411212", "421112", "421211", "212141",
+	"214121", "412121", "111143", "111341", "131141", "114113", "114311", "411113", "411311", "113141",
+	"114131", "311141", "411131", "211412", "211214", "211232", "2331112",
+}
+
+// Code 128 special values
+const (
+	code128StartB = 104
+	code128StartC = 105
+	code128Stop   = 106
+)
+
+// EncodeCode128 encodes printable ASCII text as Code 128. All-digit text of
+// even length uses code set C (two digits per symbol), anything else code set B.
+func EncodeCode128(text string) (*Barcode, error) {
+	if text == "" {
+		return nil, fmt.Errorf("nothing to encode")
+	}
+
+	var values []int
+	if len(text)%2 == 0 && strings.Trim(text, "0123456789") == "" {
+		values = append(values, code128StartC)
+		for i := 0; i < len(text); i += 2 {
+			values = append(values, int(text[i]-'0')*10+int(text[i+1]-'0'))
+		}
+	} else {
+		values = append(values, code128StartB)
+		for _, c := range text {
+			if c < ' ' || c > '~' {
+				return nil, fmt.Errorf("code 128 can't encode %q", c)
+			}
+			values = append(values, int(c-' '))
+		}
+	}
+
+	// Check symbol: start value plus each value weighted by its position, mod 103
+	check := values[0]
+	for i, v := range values[1:] {
+		check += (i + 1) * v
+	}
+	values = append(values, check%103, code128Stop)
+
+	var m []bool
+	for _, v := range values {
+		for i, w := range code128Widths[v] {
+			bar := i%2 == 0
+			for n := 0; n < int(w-'0'); n++ {
+				m = append(m, bar)
+			}
+		}
+	}
+
+	return &Barcode{Symbology: Code128, Text: text, Modules: m}, nil
+}
+
+// SVG renders the barcode with its text underneath. moduleWidth and height
+// are in pixels; a quiet zone of 10 modules is left on each side.
+func (b *Barcode) SVG(moduleWidth, height int) string {
+	const quiet = 10
+	const textHeight = 14
+
+	width := (len(b.Modules) + 2*quiet) * moduleWidth
+
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height+textHeight, width, height+textHeight)
+	fmt.Fprintf(&s, `<rect width="100%%" height="100%%" fill="#fff"/>`)
+	for i := 0; i < len(b.Modules); {
+		if !b.Modules[i] {
+			i++
+			continue
+		}
+		j := i
+		for j < len(b.Modules) && b.Modules[j] {
+			j++
+		}
+		fmt.Fprintf(&s, `<rect x="%d" width="%d" height="%d"/>`, (quiet+i)*moduleWidth, (j-i)*moduleWidth, height)
+		i = j
+	}
+	fmt.Fprintf(&s, `<text x="%d" y="%d" font-family="monospace" font-size="12" text-anchor="middle">%s</text>`, width/2, height+textHeight-2, html.EscapeString(b.Text))
+	s.WriteString(`</svg>`)
+	return s.String()
+}
@@ -0,0 +1,133 @@
+package barcode
+
+import (
+	"strings"
+	"testing"
+)
+
+// pattern converts modules back to a "1010" string
+func pattern(m []bool) string {
+	var b strings.Builder
+	for _, bar := range m {
+		if bar {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+func TestEncodeUPCA(t *testing.T) {
+	b, err := EncodeUPCA("036000291452")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := pattern(b.Modules)
+	if len(got) != 95 {
+		t.Fatalf("got %d modules, want 95", len(got))
+	}
+	// Guards, then "0" (left, odd parity) and "2" (right, complement of 0010011)
+	if !strings.HasPrefix(got, "101"+"0001101") || !strings.HasSuffix(got, "1101100"+"101") || got[45:50] != "01010" {
+		t.Errorf("unexpected pattern %s", got)
+	}
+
+	for _, upc := range []string{"036000291453", "03600029145", "03600029145a"} {
+		if _, err := EncodeUPCA(upc); err != ErrInvalidUPC {
+			t.Errorf("EncodeUPCA(%q) = %v, want ErrInvalidUPC", upc, err)
+		}
+	}
+}
+
+func TestCode128Widths(t *testing.T) {
+	for v, w := range code128Widths {
+		sum := 0
+		for _, c := range w {
+			sum += int(c - '0')
+		}
+		want := 11
+		if v == code128Stop {
+			want = 13
+		}
+		if sum != want {
+			t.Errorf("value %d (%s) is %d modules wide, want %d", v, w, sum, want)
+		}
+	}
+}
+
+// decodeCode128 splits modules back into symbol values
+func decodeCode128(t *testing.T, m []bool) []int {
+	t.Helper()
+
+	widths := make(map[string]int)
+	for v, w := range code128Widths {
+		widths[w] = v
+	}
+
+	var values []int
+	for i := 0; i < len(m); {
+		var w strings.Builder
+		n := 6
+		if len(m)-i == 13 {
+			n = 7
+		}
+		for k := 0; k < n; k++ {
+			j := i
+			for j < len(m) && m[j] == m[i] {
+				j++
+			}
+			w.WriteByte(byte('0' + j - i))
+			i = j
+		}
+		v, ok := widths[w.String()]
+		if !ok {
+			t.Fatalf("unknown symbol %s", w.String())
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestEncodeCode128(t *testing.T) {
+	tests := []struct {
+		text string
+		want []int
+	}{
+		// Code set C: 65 79 54, check (105 + 65 + 2*79 + 3*54) % 103 = 78
+		{"657954", []int{code128StartC, 65, 79, 54, 78, code128Stop}},
+		// Code set B: 'P'=48 'J'=42 'J'=42 '1'=17 '2'=18 '3'=19 'C'=35, check 879 % 103 = 55
+		{"PJJ123C", []int{code128StartB, 48, 42, 42, 17, 18, 19, 35, 55, code128Stop}},
+	}
+	for _, tt := range tests {
+		b, err := EncodeCode128(tt.text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := decodeCode128(t, b.Modules)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: got values %v, want %v", tt.text, got, tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: got values %v, want %v", tt.text, got, tt.want)
+				break
+			}
+		}
+	}
+
+	if _, err := EncodeCode128("café"); err == nil {
+		t.Error("expected an error for non-ASCII text")
+	}
+}
+
+func TestSVG(t *testing.T) {
+	b, err := EncodeCode128("<6579543>")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svg := b.SVG(2, 60)
+	if !strings.HasPrefix(svg, "<svg") || !strings.Contains(svg, "&lt;6579543&gt;") {
+		t.Errorf("unexpected SVG %s", svg)
+	}
+}
@@ -0,0 +1,165 @@
+package bestbuy
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Call log defaults
+const (
+	DefaultCallSampleRate = 0.1                 // share of upstream calls recorded
+	callLogFlushInterval  = 30 * time.Second    // how often sampled calls are saved
+	callLogRetention      = 30 * 24 * time.Hour // how long saved calls are kept
+	callLogMaxPending     = 5000                // calls held between flushes; more are dropped
+)
+
+// Call is one request sent to the API, as recorded in the call log
+type Call struct {
+	Endpoint   string        // path template without values, e.g. "/v1/products/{id}/stores.json"
+	Status     int           // HTTP status, 0 if no response arrived
+	Latency    time.Duration // until the response body was read
+	Cost       int           // calls counted against the daily quota
+	Priority   string        // "background", "normal", "interactive" or "live"
+	SampleRate float64       // share of calls recorded when this one was
+	At         time.Time
+}
+
+// CallStore saves sampled calls for offline analysis
+type CallStore interface {
+	// SaveAPICalls saves calls to api
+	SaveAPICalls(ctx context.Context, api string, calls []Call) error
+	// DeleteAPICallsBefore deletes calls made before t
+	DeleteAPICallsBefore(ctx context.Context, t time.Time) error
+}
+
+// CallLog records a random sample of the client's calls, so what is using up
+// the quota can be worked out without logging every call
+type CallLog struct {
+	rate   float64
+	random func() float64
+
+	mu      sync.Mutex
+	pending []Call
+	dropped int // calls sampled but not kept because the store fell behind
+}
+
+// NewCallLog creates a call log that records rate (0 to 1) of calls
+func NewCallLog(rate float64) *CallLog {
+	return &CallLog{rate: min(max(rate, 0), 1), random: rand.Float64}
+}
+
+// record samples one call. The endpoint is reduced to a template first, so
+// API keys, SKUs and postal codes are never stored.
+func (l *CallLog) record(ctx context.Context, endpoint string, status int, latency time.Duration, cost int) {
+	if l == nil || l.rate == 0 || l.random() >= l.rate {
+		return
+	}
+	call := Call{
+		Endpoint:   endpointTemplate(endpoint),
+		Status:     status,
+		Latency:    latency,
+		Cost:       cost,
+		Priority:   priorityName(ctx),
+		SampleRate: l.rate,
+		At:         time.Now(),
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.pending) >= callLogMaxPending {
+		l.dropped++
+		return
+	}
+	l.pending = append(l.pending, call)
+}
+
+// Run saves sampled calls to store every 30 seconds and deletes those older
+// than 30 days, until ctx is done
+func (l *CallLog) Run(ctx context.Context, store CallStore) {
+	ticker := time.NewTicker(callLogFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.flush(ctx, store)
+		case <-ctx.Done():
+			// Save what's been sampled since the last flush
+			l.flush(context.WithoutCancel(ctx), store)
+			return
+		}
+	}
+}
+
+// flush saves the pending calls and prunes old ones
+func (l *CallLog) flush(ctx context.Context, store CallStore) {
+	l.mu.Lock()
+	calls, dropped := l.pending, l.dropped
+	l.pending, l.dropped = nil, 0
+	l.mu.Unlock()
+
+	if dropped > 0 {
+		log.Printf("Best Buy call log: dropped %d sampled calls while saving fell behind", dropped)
+	}
+	if len(calls) > 0 {
+		if err := store.SaveAPICalls(ctx, "bestbuy", calls); err != nil {
+			log.Printf("Failed to save Best Buy call log: %v", err)
+		}
+	}
+	if err := store.DeleteAPICallsBefore(ctx, time.Now().Add(-callLogRetention)); err != nil {
+		log.Printf("Failed to prune Best Buy call log: %v", err)
+	}
+}
+
+// priorityName names the priority class of requests made with ctx
+func priorityName(ctx context.Context) string {
+	if IsHighPriority(ctx) {
+		return "live"
+	}
+	switch PriorityOf(ctx) {
+	case PriorityBackground:
+		return "background"
+	case PriorityInteractive:
+		return "interactive"
+	default:
+		return "normal"
+	}
+}
+
+var (
+	// numberSegment matches path segments that are SKUs or other IDs
+	numberSegment = regexp.MustCompile(`/\d+(\.json)?(/|$)`)
+	// filterTerm matches one term of a Best Buy query filter, capturing its attribute
+	filterTerm = regexp.MustCompile(`^([A-Za-z][\w.]*)`)
+)
+
+// endpointTemplate reduces a request URL to its path with the values left
+// out: "/products(search=pokemon&active=*)" becomes "/products(search,active)"
+// and "/products/6548371/stores.json" becomes "/products/{id}/stores.json"
+func endpointTemplate(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "unknown"
+	}
+	path := u.Path
+	if open := strings.Index(path, "("); open >= 0 && strings.HasSuffix(path, ")") {
+		var attrs []string
+		for _, term := range strings.Split(path[open+1:len(path)-1], "&") {
+			if m := filterTerm.FindString(term); m != "" {
+				attrs = append(attrs, m)
+			}
+		}
+		path = path[:open] + "(" + strings.Join(attrs, ",") + ")"
+	}
+	// Segments can share a slash, so replace until none are left
+	for numberSegment.MatchString(path) {
+		path = numberSegment.ReplaceAllString(path, "/{id}$1$2")
+	}
+	return path
+}
@@ -0,0 +1,71 @@
+package bestbuy
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"https://api.bestbuy.com/v1/products/6548371/stores.json?postalCode=94103&apiKey=secret", "/v1/products/{id}/stores.json"},
+		{"https://api.bestbuy.com/v1/products/6548371.json?apiKey=secret", "/v1/products/{id}.json"},
+		{"https://api.bestbuy.com/v1/stores(area(94103,25))?format=json&apiKey=secret", "/v1/stores(area)"},
+		{"https://api.bestbuy.com/v1/products(search=pokemon%20cards&active=*)?format=json&apiKey=secret", "/v1/products(search,active)"},
+		{"https://api.bestbuy.com/v1/products(categoryPath.id=abcat0707002&search=etb)?page=2", "/v1/products(categoryPath.id,search)"},
+		{"https://www.bestbuy.ca/api/v2/json/product/16123456?lang=en-CA", "/api/v2/json/product/{id}"},
+		{"https://www.bestbuy.ca/ecomm-api/availability/products?skus=16123456", "/ecomm-api/availability/products"},
+	}
+	for _, tt := range tests {
+		if got := endpointTemplate(tt.endpoint); got != tt.want {
+			t.Errorf("endpointTemplate(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+// recordingCallStore keeps saved calls in memory
+type recordingCallStore struct {
+	calls []Call
+}
+
+func (s *recordingCallStore) SaveAPICalls(ctx context.Context, api string, calls []Call) error {
+	s.calls = append(s.calls, calls...)
+	return nil
+}
+
+func (s *recordingCallStore) DeleteAPICallsBefore(ctx context.Context, t time.Time) error {
+	return nil
+}
+
+func TestCallLogSamples(t *testing.T) {
+	l := NewCallLog(0.25)
+	draws := []float64{0.1, 0.3, 0.2, 0.9}
+	l.random = func() float64 {
+		d := draws[0]
+		draws = draws[1:]
+		return d
+	}
+
+	ctx := WithPriority(context.Background(), PriorityBackground)
+	for range 4 {
+		l.record(ctx, "https://api.bestbuy.com/v1/products/6548371.json?apiKey=secret", 200, time.Second, 1)
+	}
+
+	store := &recordingCallStore{}
+	l.flush(ctx, store)
+	if len(store.calls) != 2 {
+		t.Fatalf("saved %d calls, want the 2 sampled", len(store.calls))
+	}
+	c := store.calls[0]
+	if c.Endpoint != "/v1/products/{id}.json" || c.Priority != "background" || c.SampleRate != 0.25 || c.Cost != 1 {
+		t.Errorf("saved %+v", c)
+	}
+
+	l.flush(ctx, store)
+	if len(store.calls) != 2 {
+		t.Errorf("flushing again saved %d calls, want none new", len(store.calls)-2)
+	}
+}
@@ -0,0 +1,281 @@
+package bestbuy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+)
+
+// Region is the Best Buy site a client talks to
+type Region string
+
+// Supported regions
+const (
+	RegionUS Region = "us" // api.bestbuy.com, prices in USD
+	RegionCA Region = "ca" // bestbuy.ca, prices in CAD
+)
+
+// regionBaseURLs are the API base URLs of each region
+var regionBaseURLs = map[Region]string{
+	RegionUS: "https://api.bestbuy.com/v1",
+	RegionCA: caSiteURL,
+}
+
+// caSiteURL is the bestbuy.ca storefront, which also serves its API
+const caSiteURL = "https://www.bestbuy.ca"
+
+// ParseRegion parses a region name, defaulting to the US when empty
+func ParseRegion(s string) (Region, error) {
+	if s == "" {
+		return RegionUS, nil
+	}
+	region := Region(strings.ToLower(s))
+	if _, ok := regionBaseURLs[region]; !ok {
+		return "", fmt.Errorf("unknown Best Buy region %q (want %q or %q)", s, RegionUS, RegionCA)
+	}
+	return region, nil
+}
+
+// Currency returns the ISO 4217 code of the region's prices
+func (r Region) Currency() string {
+	if r == RegionCA {
+		return "CAD"
+	}
+	return "USD"
+}
+
+// bestbuy.ca measures distances in kilometres
+const kmPerMile = 1.609344
+
+// caCategoryTradingCards is the bestbuy.ca trading cards category
+const caCategoryTradingCards = "collectible-trading-cards"
+
+// caProduct is a product in bestbuy.ca search and product responses. SKUs
+// are strings and product URLs are relative to the site.
+type caProduct struct {
+	SKU            string      `json:"sku"`
+	Name           string      `json:"name"`
+	SalePrice      money.Cents `json:"salePrice"`
+	RegularPrice   money.Cents `json:"regularPrice"`
+	ThumbnailImage string      `json:"thumbnailImage"`
+	HighResImage   string      `json:"highResImage"`
+	ProductURL     string      `json:"productUrl"`
+	ShortDesc      string      `json:"shortDescription"`
+	BrandName      string      `json:"brandName"`
+	ModelNumber    string      `json:"modelNumber"`
+	IsOnlineOnly   bool        `json:"isOnlineOnly"`
+	IsPurchasable  bool        `json:"isPurchasable"`
+}
+
+// product converts a bestbuy.ca product
+func (p caProduct) product() Product {
+	sku, _ := strconv.Atoi(p.SKU)
+	productURL := p.ProductURL
+	if strings.HasPrefix(productURL, "/") {
+		productURL = caSiteURL + productURL
+	}
+	return Product{
+		SKU:                 sku,
+		Name:                p.Name,
+		SalePrice:           p.SalePrice,
+		RegularPrice:        p.RegularPrice,
+		ThumbnailImage:      p.ThumbnailImage,
+		Image:               p.HighResImage,
+		URL:                 productURL,
+		ShortDescription:    p.ShortDesc,
+		Manufacturer:        p.BrandName,
+		ModelNumber:         p.ModelNumber,
+		InStoreAvailability: !p.IsOnlineOnly,
+		OnlineAvailability:  p.IsPurchasable,
+		Currency:            RegionCA.Currency(),
+	}
+}
+
+// searchStoresCA searches for bestbuy.ca stores near a postal code
+func (c *APIClient) searchStoresCA(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	params := url.Values{
+		"lang":       {"en-CA"},
+		"postalCode": {postalCode},
+		"radius":     {strconv.Itoa(int(math.Ceil(float64(radiusMiles) * kmPerMile)))},
+	}
+	body, err := c.doRequest(ctx, c.baseURL+"/api/v2/json/locations?"+params.Encode())
+	if err != nil {
+		log.Printf("Store search error: %v", err)
+		return nil, err
+	}
+
+	var result struct {
+		Locations []struct {
+			LocationID string  `json:"locationId"`
+			Name       string  `json:"name"`
+			Address1   string  `json:"address1"`
+			Address2   string  `json:"address2"`
+			City       string  `json:"city"`
+			Region     string  `json:"region"`
+			PostalCode string  `json:"postalCode"`
+			Phone      string  `json:"phone1"`
+			Distance   float64 `json:"distance"` // km
+			Lat        float64 `json:"latitude"`
+			Lng        float64 `json:"longitude"`
+		} `json:"locations"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	stores := make([]Store, 0, len(result.Locations))
+	for _, l := range result.Locations {
+		id, err := strconv.Atoi(l.LocationID)
+		if err != nil {
+			continue // warehouses and other non-retail locations use letters
+		}
+		stores = append(stores, Store{
+			StoreID:    id,
+			Name:       l.Name,
+			Address:    l.Address1,
+			Address2:   l.Address2,
+			City:       l.City,
+			State:      l.Region,
+			PostalCode: l.PostalCode,
+			Phone:      l.Phone,
+			Distance:   l.Distance / kmPerMile,
+			Lat:        l.Lat,
+			Lng:        l.Lng,
+		})
+	}
+	log.Printf("Store search returned %d results", len(stores))
+	return stores, nil
+}
+
+// searchProductsCA searches bestbuy.ca by keyword, optionally within a category
+func (c *APIClient) searchProductsCA(ctx context.Context, query string, category string, page PageRequest) (*ProductPage, error) {
+	category = c.domain.caCategoryFor(category)
+	params := url.Values{
+		"lang":     {"en-CA"},
+		"query":    {query},
+		"page":     {strconv.Itoa(page.number())},
+		"pageSize": {strconv.Itoa(page.size(50))},
+	}
+	if category != "" {
+		params.Set("category", category)
+	}
+	body, err := c.doRequest(ctx, c.baseURL+"/api/v2/json/search?"+params.Encode())
+	if err != nil {
+		log.Printf("Product search error: %v", err)
+		return nil, err
+	}
+
+	var result struct {
+		Products    []caProduct `json:"products"`
+		CurrentPage int         `json:"currentPage"`
+		TotalPages  int         `json:"totalPages"`
+		Total       int         `json:"total"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	products := make([]Product, 0, len(result.Products))
+	for _, p := range result.Products {
+		products = append(products, p.product())
+	}
+	log.Printf("Product search returned %d of %d results", len(products), result.Total)
+	return &ProductPage{Products: products, Page: max(result.CurrentPage, 1), TotalPages: result.TotalPages, Total: result.Total}, nil
+}
+
+// getProductCA gets a single bestbuy.ca product by SKU
+func (c *APIClient) getProductCA(ctx context.Context, sku string) (*Product, error) {
+	endpoint := fmt.Sprintf("%s/api/v2/json/product/%s?lang=en-CA", c.baseURL, url.PathEscape(sku))
+	body, err := c.doRequest(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var p caProduct
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	product := p.product()
+	return &product, nil
+}
+
+// caLowStockQuantity is the most units a store can have and be reported as low stock
+const caLowStockQuantity = 2
+
+// checkAvailabilityCA checks bestbuy.ca stores near a postal code for pickup stock
+func (c *APIClient) checkAvailabilityCA(ctx context.Context, sku string, postalCode string) ([]StoreAvailability, error) {
+	params := url.Values{
+		"accept":          {"application/vnd.bestbuy.standardproduct.v1+json"},
+		"accept-language": {"en-CA"},
+		"postalCode":      {postalCode},
+		"skus":            {sku},
+	}
+	body, err := c.doRequest(ctx, c.baseURL+"/ecomm-api/availability/products?"+params.Encode())
+	if err != nil {
+		// bestbuy.ca takes no key, so any 403 refuses this SKU rather than us
+		var keyErr *APIKeyError
+		if errors.As(err, &keyErr) && keyErr.StatusCode == http.StatusForbidden {
+			err = &ForbiddenError{Body: keyErr.Body}
+		}
+		var forbidden *ForbiddenError
+		if errors.As(err, &forbidden) {
+			c.restricted.add(sku)
+			return nil, &RestrictedError{SKU: sku, Err: forbidden}
+		}
+		return nil, err
+	}
+
+	var result struct {
+		Availabilities []struct {
+			SKU    string `json:"sku"`
+			Pickup struct {
+				Locations []struct {
+					LocationKey    string  `json:"locationKey"`
+					Name           string  `json:"name"`
+					City           string  `json:"city"`
+					Region         string  `json:"region"`
+					Distance       float64 `json:"distance"` // km
+					QuantityOnHand int     `json:"quantityOnHand"`
+					HasInventory   bool    `json:"hasInventory"`
+					IsReservable   bool    `json:"isReservable"`
+				} `json:"locations"`
+			} `json:"pickup"`
+		} `json:"availabilities"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	availability := []StoreAvailability{}
+	for _, a := range result.Availabilities {
+		if a.SKU != sku {
+			continue
+		}
+		for _, l := range a.Pickup.Locations {
+			if !l.HasInventory || l.QuantityOnHand <= 0 {
+				continue
+			}
+			availability = append(availability, StoreAvailability{
+				StoreID:        l.LocationKey,
+				StoreName:      l.Name,
+				City:           l.City,
+				State:          l.Region,
+				Distance:       l.Distance / kmPerMile,
+				InStock:        true,
+				LowStock:       l.QuantityOnHand <= caLowStockQuantity,
+				PickupEligible: l.IsReservable,
+			})
+		}
+	}
+	log.Printf("CheckAvailability returned %d stores with product in stock", len(availability))
+	return availability, nil
+}
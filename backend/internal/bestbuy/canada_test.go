@@ -0,0 +1,89 @@
+package bestbuy
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// newCATestClient returns a bestbuy.ca client answered with testdata/file
+func newCATestClient(t *testing.T, file string) *APIClient {
+	t.Helper()
+
+	c := newTestClient(t, http.StatusOK, file)
+	c.region = RegionCA
+	return c
+}
+
+func TestDecodeCanadaResponses(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		file string
+		call func(c *APIClient) (any, error)
+	}{
+		{
+			name: "ca_stores",
+			file: "ca_stores.json",
+			call: func(c *APIClient) (any, error) { return c.SearchStores(ctx, "M5V3L9", 10) },
+		},
+		{
+			name: "ca_product",
+			file: "ca_product.json",
+			call: func(c *APIClient) (any, error) { return c.GetProductBySKU(ctx, "18935296") },
+		},
+		{
+			name: "ca_products",
+			file: "ca_search.json",
+			call: func(c *APIClient) (any, error) { return c.SearchProducts(ctx, "prismatic", "", PageRequest{}) },
+		},
+		{
+			name: "ca_availability",
+			file: "ca_availability.json",
+			call: func(c *APIClient) (any, error) { return c.CheckAvailability(ctx, "18935296", "M5V3L9") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call(newCATestClient(t, tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, tt.name, got)
+		})
+	}
+}
+
+func TestCanadaPrices(t *testing.T) {
+	p, err := newCATestClient(t, "ca_product.json").GetProductBySKU(context.Background(), "18935296")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.SKU != 18935296 || p.SalePrice != 7999 || p.RegularPrice != 8999 || p.Currency != "CAD" {
+		t.Errorf("product = %+v, want SKU 18935296 at 79.99 CAD (regularly 89.99)", p)
+	}
+	if !strings.HasPrefix(p.URL, "https://www.bestbuy.ca/en-ca/product/") {
+		t.Errorf("URL = %q, want an absolute product URL", p.URL)
+	}
+}
+
+func TestParseRegion(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want Region
+	}{
+		{"", RegionUS},
+		{"us", RegionUS},
+		{"CA", RegionCA},
+	} {
+		if got, err := ParseRegion(tt.in); err != nil || got != tt.want {
+			t.Errorf("ParseRegion(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := ParseRegion("uk"); err == nil {
+		t.Error("ParseRegion(\"uk\") succeeded, want an error")
+	}
+}
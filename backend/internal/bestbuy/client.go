@@ -3,6 +3,7 @@ package bestbuy
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -10,8 +11,12 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
-	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/hours"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
 )
 
 // Known category IDs for Best Buy
@@ -24,11 +29,11 @@ type Client interface {
 	// SearchStores searches for stores near a postal code within a radius
 	SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error)
 
-	// SearchProducts searches for products by keyword, optionally filtered by subclass
-	SearchProducts(ctx context.Context, query string, subclass string) ([]Product, error)
+	// SearchProducts returns a page of products matching a keyword, optionally filtered by subclass
+	SearchProducts(ctx context.Context, query string, subclass string, page PageRequest) (*ProductPage, error)
 
-	// SearchProductsInCategory searches for products within a category
-	SearchProductsInCategory(ctx context.Context, categoryID string, query string) ([]Product, error)
+	// SearchProductsInCategory returns a page of products within a category
+	SearchProductsInCategory(ctx context.Context, categoryID string, query string, page PageRequest) (*ProductPage, error)
 
 	// GetProductBySKU gets a single product by its SKU
 	GetProductBySKU(ctx context.Context, sku string) (*Product, error)
@@ -36,8 +41,9 @@ type Client interface {
 	// CheckAvailability checks product availability using postal code (250 mile radius)
 	CheckAvailability(ctx context.Context, sku string, postalCode string) ([]StoreAvailability, error)
 
-	// BrowsePokemonProducts returns Pokemon TCG products from the trading cards category
-	BrowsePokemonProducts(ctx context.Context) ([]Product, error)
+	// BrowsePokemonProducts returns the products of the deployment's product
+	// domain, Pokemon TCG from the trading cards category by default
+	BrowsePokemonProducts(ctx context.Context, opts BrowseOptions) ([]Product, error)
 }
 
 // Store represents a Best Buy store from the API
@@ -57,6 +63,9 @@ type Store struct {
 	GMTOffset  int     `json:"gmtOffset"`
 	Lat        float64 `json:"lat"`
 	Lng        float64 `json:"lng"`
+
+	// DetailedHours are the hours of the coming days, including holiday hours
+	DetailedHours []hours.DetailedDay `json:"detailedHours,omitempty"`
 }
 
 // StoreIDString returns the store ID as a string
@@ -66,20 +75,21 @@ func (s Store) StoreIDString() string {
 
 // Product represents a Best Buy product from the API
 type Product struct {
-	SKU                 int     `json:"sku"`
-	Name                string  `json:"name"`
-	SalePrice           float64 `json:"salePrice"`
-	RegularPrice        float64 `json:"regularPrice"`
-	ThumbnailImage      string  `json:"thumbnailImage"`
-	Image               string  `json:"image"`
-	URL                 string  `json:"url"`
-	ShortDescription    string  `json:"shortDescription"`
-	LongDescription     string  `json:"longDescription"`
-	Manufacturer        string  `json:"manufacturer"`
-	ModelNumber         string  `json:"modelNumber"`
-	UPC                 string  `json:"upc"`
-	InStoreAvailability bool    `json:"inStoreAvailability"`
-	OnlineAvailability  bool    `json:"onlineAvailability"`
+	SKU                 int         `json:"sku"`
+	Name                string      `json:"name"`
+	SalePrice           money.Cents `json:"salePrice"`
+	RegularPrice        money.Cents `json:"regularPrice"`
+	ThumbnailImage      string      `json:"thumbnailImage"`
+	Image               string      `json:"image"`
+	URL                 string      `json:"url"`
+	ShortDescription    string      `json:"shortDescription"`
+	LongDescription     string      `json:"longDescription"`
+	Manufacturer        string      `json:"manufacturer"`
+	ModelNumber         string      `json:"modelNumber"`
+	UPC                 string      `json:"upc"`
+	InStoreAvailability bool        `json:"inStoreAvailability"`
+	OnlineAvailability  bool        `json:"onlineAvailability"`
+	Currency            string      `json:"currency,omitempty"` // ISO 4217 code of the prices; empty means USD
 }
 
 // SKUString returns the SKU as a string
@@ -99,83 +109,179 @@ type StoreAvailability struct {
 	PickupEligible bool    `json:"pickupEligible"`
 }
 
-// RateLimitError is returned when the API rate limit is exceeded
-type RateLimitError struct {
-	RetryAfter time.Duration
-}
-
-func (e *RateLimitError) Error() string {
-	return fmt.Sprintf("rate limit exceeded, retry after %v", e.RetryAfter)
-}
-
 // APIClient is the real Best Buy API client implementation
 type APIClient struct {
-	apiKey     string
+	apiKey     atomic.Pointer[string] // replaced by SetAPIKey when the key is rotated
 	baseURL    string
+	userAgent  string
 	httpClient *http.Client
+	cache      *responseCache
+	region     Region
+	inflight   coalescer // shares identical concurrent requests
+	quota      *Quota    // daily call budget; nil if untracked
+	calls      *CallLog  // samples calls for offline analysis; nil if off
+	restricted *restrictedSKUs
+	domain     Domain // what BrowsePokemonProducts browses
 
 	// Rate limiting
-	mu            sync.Mutex
-	lastRequest   time.Time
-	minInterval   time.Duration // Minimum time between requests
+	limiter       *limiter // nil for no limit
 	maxRetries    int
 	retryBaseWait time.Duration
 }
 
-// NewAPIClient creates a new Best Buy API client
-func NewAPIClient(apiKey string) *APIClient {
-	return &APIClient{
-		apiKey:  apiKey,
-		baseURL: "https://api.bestbuy.com/v1",
+// NewAPIClient creates a new Best Buy API client that identifies itself with
+// userAgent (webapi.DefaultUserAgent if empty)
+func NewAPIClient(apiKey string, userAgent string) *APIClient {
+	return NewAPIClientForRegion(RegionUS, apiKey, userAgent)
+}
+
+// NewAPIClientForRegion creates a Best Buy API client for the US or Canadian
+// site. bestbuy.ca doesn't use the API key.
+func NewAPIClientForRegion(region Region, apiKey string, userAgent string) *APIClient {
+	if userAgent == "" {
+		userAgent = webapi.DefaultUserAgent
+	}
+	c := &APIClient{
+		baseURL:   regionBaseURLs[region],
+		region:    region,
+		userAgent: userAgent,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
-		minInterval:   350 * time.Millisecond, // ~3 requests per second (safer for Best Buy's rate limits)
+		cache:         newResponseCache(),
+		restricted:    newRestrictedSKUs(DefaultRestrictedTTL),
+		domain:        domains[DefaultDomain],
+		limiter:       newThrottledLimiter(NewThrottle(DefaultMinInterval, DefaultMaxInterval), 2), // tuned from rate limit responses, at most 2 at once
 		maxRetries:    5,
 		retryBaseWait: 1 * time.Second,
 	}
+	c.SetAPIKey(apiKey)
+	return c
+}
+
+// SetAPIKey replaces the API key, e.g. when it's rotated. Requests already
+// sent finish with the old key.
+func (c *APIClient) SetAPIKey(key string) {
+	c.apiKey.Store(&key)
+}
+
+// key returns the current API key
+func (c *APIClient) key() string {
+	return *c.apiKey.Load()
+}
+
+// SetQuota counts the client's calls against a daily quota, refusing
+// non-interactive requests once only the interactive reserve is left
+func (c *APIClient) SetQuota(q *Quota) {
+	c.quota = q
+}
+
+// Throttle returns the throttle pacing the client's calls, nil if unlimited
+func (c *APIClient) Throttle() *Throttle {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.throttle
 }
 
-// doRequest performs an HTTP request with rate limiting and retry logic
+// SetCallLog records a sample of the client's calls in l
+func (c *APIClient) SetCallLog(l *CallLog) {
+	c.calls = l
+}
+
+// SetDomain sets the product domain BrowsePokemonProducts browses
+func (c *APIClient) SetDomain(d Domain) {
+	c.domain = d
+}
+
+// SetRestrictedTTL sets how long availability checks for a SKU are skipped
+// after the API refuses them as restricted; zero or less always asks the API
+func (c *APIClient) SetRestrictedTTL(ttl time.Duration) {
+	c.restricted = newRestrictedSKUs(ttl)
+}
+
+// doRequest performs an HTTP request with rate limiting and retry logic.
+// Concurrent requests of the same priority for the same endpoint share one
+// upstream request: users checking the same SKU cost a single call.
+// Each caller still stops waiting when its own context is done.
 func (c *APIClient) doRequest(ctx context.Context, endpoint string) ([]byte, error) {
+	key := coalesceKey(ctx, endpoint)
+
+	// The shared request outlives any one caller giving up, and carries none
+	// of the first caller's context over to the others
+	f := c.inflight.join(key, func() ([]byte, error) {
+		shared, cancel := detached(ctx)
+		defer cancel()
+		return c.fetch(shared, endpoint)
+	})
+	select {
+	case <-f.done:
+		return f.body, f.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fetch performs one request for doRequest. Responses are cached as allowed
+// by their cache headers; fresh responses are served without a request and
+// stale ones are revalidated. High-priority requests always go to the API.
+func (c *APIClient) fetch(ctx context.Context, endpoint string) ([]byte, error) {
+	cached := c.cache.get(endpoint)
+	if cached != nil && cached.fresh(time.Now()) && !IsHighPriority(ctx) {
+		return cached.body, nil
+	}
+
+	if err := c.quota.allow(ctx); err != nil {
+		return nil, err
+	}
+
 	var lastErr error
 
 	for attempt := 0; attempt <= c.maxRetries; attempt++ {
-		// Rate limiting - ensure minimum interval between requests
-		c.mu.Lock()
-		elapsed := time.Since(c.lastRequest)
-		if elapsed < c.minInterval {
-			sleepTime := c.minInterval - elapsed
-			c.mu.Unlock()
-			select {
-			case <-time.After(sleepTime):
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			}
-			c.mu.Lock()
+		// Rate limiting - wait for a token, behind higher priority requests
+		if err := c.limiter.wait(ctx, PriorityOf(ctx)); err != nil {
+			return nil, err
 		}
-		c.lastRequest = time.Now()
-		c.mu.Unlock()
 
 		// Create and execute request
 		req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
+		req.Header.Set("User-Agent", c.userAgent)
+		req.Header.Set("Accept", "application/json")
+		if cached != nil {
+			if cached.etag != "" {
+				req.Header.Set("If-None-Match", cached.etag)
+			}
+			if cached.lastModified != "" {
+				req.Header.Set("If-Modified-Since", cached.lastModified)
+			}
+		}
 
+		start := time.Now()
 		resp, err := c.httpClient.Do(req)
 		if err != nil {
+			c.calls.record(ctx, endpoint, 0, time.Since(start), 0)
 			lastErr = fmt.Errorf("failed to execute request: %w", err)
 			continue
 		}
+		c.quota.record()
 
 		body, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
+		c.calls.record(ctx, endpoint, resp.StatusCode, time.Since(start), c.quota.cost())
 		if err != nil {
 			lastErr = fmt.Errorf("failed to read response: %w", err)
 			continue
 		}
 
+		if resp.StatusCode == http.StatusNotModified && cached != nil {
+			c.limiter.succeeded(time.Since(start))
+			c.cache.refresh(endpoint, resp.Header, time.Now())
+			return cached.body, nil
+		}
+
 		// Handle rate limiting (429 Too Many Requests or 403 with rate limit message)
 		isRateLimited := resp.StatusCode == http.StatusTooManyRequests ||
 			(resp.StatusCode == http.StatusForbidden && strings.Contains(string(body), "per second limit"))
@@ -190,6 +296,7 @@ func (c *APIClient) doRequest(ctx context.Context, endpoint string) ([]byte, err
 				}
 			}
 
+			c.limiter.rateLimited()
 			log.Printf("Rate limited, waiting %v before retry (attempt %d/%d)", retryAfter, attempt+1, c.maxRetries)
 			lastErr = &RateLimitError{RetryAfter: retryAfter}
 
@@ -201,6 +308,28 @@ func (c *APIClient) doRequest(ctx context.Context, endpoint string) ([]byte, err
 			}
 		}
 
+		// Key and quota problems won't go away by retrying
+		if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusUnauthorized {
+			if strings.Contains(string(body), "Over Rate") || strings.Contains(string(body), "per day") {
+				c.quota.exhaust()
+				return nil, &QuotaExceededError{Body: string(body)}
+			}
+			// The gateway answers a bad key with its own HTML page; a JSON
+			// error from the API itself refuses just this resource
+			if resp.StatusCode == http.StatusForbidden && isAPIError(body) {
+				return nil, &ForbiddenError{Body: string(body)}
+			}
+			return nil, &APIKeyError{StatusCode: resp.StatusCode, Body: string(body)}
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, &NotFoundError{Body: string(body)}
+		}
+
+		if resp.StatusCode == http.StatusBadRequest {
+			return nil, &InvalidQueryError{Body: string(body)}
+		}
+
 		// Handle other errors
 		if resp.StatusCode != http.StatusOK {
 			lastErr = fmt.Errorf("API returned status %d: %s", resp.StatusCode, string(body))
@@ -219,6 +348,8 @@ func (c *APIClient) doRequest(ctx context.Context, endpoint string) ([]byte, err
 			}
 		}
 
+		c.limiter.succeeded(time.Since(start))
+		c.cache.store(endpoint, resp.Header, body, time.Now())
 		return body, nil
 	}
 
@@ -233,8 +364,15 @@ type storesResponse struct {
 
 // productsResponse is the API response for product searches
 type productsResponse struct {
-	Products []Product `json:"products"`
-	Total    int       `json:"total"`
+	Products    []Product `json:"products"`
+	Total       int       `json:"total"`
+	CurrentPage int       `json:"currentPage"`
+	TotalPages  int       `json:"totalPages"`
+}
+
+// page converts the response to a ProductPage
+func (r productsResponse) page() *ProductPage {
+	return &ProductPage{Products: r.Products, Page: max(r.CurrentPage, 1), TotalPages: r.TotalPages, Total: r.Total}
 }
 
 // availabilityResponse is the API response for availability checks
@@ -256,9 +394,12 @@ func (c *APIClient) SearchStores(ctx context.Context, postalCode string, radiusM
 	if radiusMiles <= 0 {
 		radiusMiles = 25
 	}
+	if c.region == RegionCA {
+		return c.searchStoresCA(ctx, postalCode, radiusMiles)
+	}
 
-	endpoint := fmt.Sprintf("%s/stores(area(%s,%d))?format=json&show=storeId,name,address,address2,city,region,postalCode,phone,distance,storeType,hours,hoursAmPm,gmtOffset,lat,lng&pageSize=50&apiKey=%s",
-		c.baseURL, url.QueryEscape(postalCode), radiusMiles, c.apiKey)
+	endpoint := fmt.Sprintf("%s/stores(area(%s,%d))?format=json&show=storeId,name,address,address2,city,region,postalCode,phone,distance,storeType,hours,hoursAmPm,gmtOffset,lat,lng,detailedHours&pageSize=50&apiKey=%s",
+		c.baseURL, url.QueryEscape(postalCode), radiusMiles, c.key())
 
 	log.Printf("Searching stores with endpoint: %s", endpoint)
 
@@ -281,24 +422,32 @@ func (c *APIClient) SearchStores(ctx context.Context, postalCode string, radiusM
 // skuPattern matches strings that look like SKUs (6-8 digits)
 var skuPattern = regexp.MustCompile(`^\d{6,8}$`)
 
-// SearchProducts searches for products by keyword or SKU, optionally filtered by subclass
-func (c *APIClient) SearchProducts(ctx context.Context, query string, subclass string) ([]Product, error) {
-	log.Printf("SearchProducts called with query: %s, subclass: %s", query, subclass)
+// upcPattern matches 12-digit UPC-A codes
+var upcPattern = regexp.MustCompile(`^\d{12}$`)
+
+// SearchProducts returns a page of products matching a keyword or SKU, optionally filtered by subclass
+func (c *APIClient) SearchProducts(ctx context.Context, query string, subclass string, page PageRequest) (*ProductPage, error) {
+	log.Printf("SearchProducts called with query: %s, subclass: %s, page: %d", query, subclass, page.number())
 
 	// Check if the query looks like a SKU (6-8 digit number)
-	if skuPattern.MatchString(query) {
+	if skuPattern.MatchString(query) && page.number() == 1 {
 		log.Printf("Query looks like a SKU, trying direct lookup first")
 		product, err := c.GetProductBySKU(ctx, query)
 		if err == nil && product != nil && product.SKU != 0 {
 			log.Printf("Found product by SKU: %s - %s", query, product.Name)
-			return []Product{*product}, nil
+			return &ProductPage{Products: []Product{*product}, Page: 1, TotalPages: 1, Total: 1}, nil
 		}
 		log.Printf("SKU lookup failed or returned empty, falling back to search: %v", err)
 	}
+	if c.region == RegionCA {
+		return c.searchProductsCA(ctx, query, subclass, page)
+	}
 
 	// Build the filter query
 	var filterParts []string
-	if query != "" {
+	if upcPattern.MatchString(query) {
+		filterParts = append(filterParts, fmt.Sprintf("upc=%s", query))
+	} else if query != "" {
 		filterParts = append(filterParts, fmt.Sprintf("search=%s", url.PathEscape(query)))
 	}
 	if subclass != "" {
@@ -314,8 +463,8 @@ func (c *APIClient) SearchProducts(ctx context.Context, query string, subclass s
 		filter += part
 	}
 
-	endpoint := fmt.Sprintf("%s/products(%s)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=50&apiKey=%s",
-		c.baseURL, filter, c.apiKey)
+	endpoint := fmt.Sprintf("%s/products(%s)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=%d&page=%d&apiKey=%s",
+		c.baseURL, filter, page.size(50), page.number(), c.key())
 
 	log.Printf("Searching products with endpoint: %s", endpoint)
 
@@ -331,14 +480,18 @@ func (c *APIClient) SearchProducts(ctx context.Context, query string, subclass s
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	log.Printf("Product search returned %d results", len(result.Products))
-	return result.Products, nil
+	log.Printf("Product search returned %d of %d results", len(result.Products), result.Total)
+	return result.page(), nil
 }
 
 // GetProductBySKU gets a single product by SKU
 func (c *APIClient) GetProductBySKU(ctx context.Context, sku string) (*Product, error) {
+	if c.region == RegionCA {
+		return c.getProductCA(ctx, sku)
+	}
+
 	endpoint := fmt.Sprintf("%s/products/%s.json?apiKey=%s",
-		c.baseURL, url.PathEscape(sku), c.apiKey)
+		c.baseURL, url.PathEscape(sku), c.key())
 
 	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
@@ -353,17 +506,21 @@ func (c *APIClient) GetProductBySKU(ctx context.Context, sku string) (*Product,
 	return &product, nil
 }
 
-// SearchProductsInCategory searches for products within a specific category
-func (c *APIClient) SearchProductsInCategory(ctx context.Context, categoryID string, query string) ([]Product, error) {
-	log.Printf("SearchProductsInCategory called with categoryID: %s, query: %s", categoryID, query)
+// SearchProductsInCategory returns a page of products within a specific category
+func (c *APIClient) SearchProductsInCategory(ctx context.Context, categoryID string, query string, page PageRequest) (*ProductPage, error) {
+	log.Printf("SearchProductsInCategory called with categoryID: %s, query: %s, page: %d", categoryID, query, page.number())
+
+	if c.region == RegionCA {
+		return c.searchProductsCA(ctx, query, categoryID, page)
+	}
 
 	var endpoint string
 	if query != "" {
-		endpoint = fmt.Sprintf("%s/products(categoryPath.id=%s&search=%s)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=100&apiKey=%s",
-			c.baseURL, categoryID, url.PathEscape(query), c.apiKey)
+		endpoint = fmt.Sprintf("%s/products(categoryPath.id=%s&search=%s)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=%d&page=%d&apiKey=%s",
+			c.baseURL, categoryID, url.PathEscape(query), page.size(maxPageSize), page.number(), c.key())
 	} else {
-		endpoint = fmt.Sprintf("%s/products(categoryPath.id=%s)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=100&apiKey=%s",
-			c.baseURL, categoryID, c.apiKey)
+		endpoint = fmt.Sprintf("%s/products(categoryPath.id=%s)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=%d&page=%d&apiKey=%s",
+			c.baseURL, categoryID, page.size(maxPageSize), page.number(), c.key())
 	}
 
 	log.Printf("Category search endpoint: %s", endpoint)
@@ -380,18 +537,38 @@ func (c *APIClient) SearchProductsInCategory(ctx context.Context, categoryID str
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	log.Printf("Category search returned %d results", len(result.Products))
-	return result.Products, nil
+	log.Printf("Category search returned %d of %d results", len(result.Products), result.Total)
+	return result.page(), nil
 }
 
-// BrowsePokemonProducts returns Pokemon TCG products (including inactive ones)
-func (c *APIClient) BrowsePokemonProducts(ctx context.Context) ([]Product, error) {
-	log.Printf("BrowsePokemonProducts called")
+// BrowsePokemonProducts returns the products of the client's product domain
+// (including inactive ones), Pokemon TCG unless set otherwise
+func (c *APIClient) BrowsePokemonProducts(ctx context.Context, opts BrowseOptions) ([]Product, error) {
+	log.Printf("BrowsePokemonProducts called (domain: %s, all pages: %v)", c.domain.ID, opts.AllPages)
 
-	// Search for Pokemon TCG cards by subclass, including inactive products
-	// Best Buy marks most Pokemon TCG as "inactive" due to invitation system
-	endpoint := fmt.Sprintf("%s/products(subclass=POKEMON%%20CARDS&active=*)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=100&apiKey=%s",
-		c.baseURL, c.apiKey)
+	browse := c.browsePokemonPage
+	if c.region == RegionCA {
+		browse = func(ctx context.Context, page PageRequest) (*ProductPage, error) {
+			return c.searchProductsCA(ctx, c.domain.caQuery, c.domain.Category, page)
+		}
+	}
+
+	if !opts.AllPages {
+		page, err := browse(ctx, PageRequest{Size: maxPageSize})
+		if err != nil {
+			return nil, err
+		}
+		return page.Products, nil
+	}
+	return allPages(ctx, browse)
+}
+
+// browsePokemonPage returns a page of the client's product domain
+func (c *APIClient) browsePokemonPage(ctx context.Context, page PageRequest) (*ProductPage, error) {
+	// Include inactive products: Best Buy marks most Pokemon TCG (and other
+	// hard-to-get drops) as "inactive" due to its invitation system
+	endpoint := fmt.Sprintf("%s/products(%s&active=*)?format=json&show=sku,name,salePrice,regularPrice,thumbnailImage,image,url,shortDescription,manufacturer,modelNumber,upc,inStoreAvailability,onlineAvailability&pageSize=%d&page=%d&apiKey=%s",
+		c.baseURL, strings.ReplaceAll(c.domain.browse, " ", "%20"), page.size(maxPageSize), page.number(), c.key())
 
 	log.Printf("Browse Pokemon endpoint: %s", endpoint)
 
@@ -407,8 +584,8 @@ func (c *APIClient) BrowsePokemonProducts(ctx context.Context) ([]Product, error
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	log.Printf("Browse Pokemon returned %d results", len(result.Products))
-	return result.Products, nil
+	log.Printf("Browse Pokemon page %d returned %d of %d results", page.number(), len(result.Products), result.Total)
+	return result.page(), nil
 }
 
 // storesProductsResponse is the API response for combined stores+products query
@@ -454,18 +631,27 @@ func (c *APIClient) CheckAvailability(ctx context.Context, sku string, postalCod
 	if postalCode == "" {
 		return []StoreAvailability{}, nil
 	}
+	// Restricted SKUs stay restricted for a while; asking again only wastes quota
+	if c.restricted.has(sku) {
+		return nil, &RestrictedError{SKU: sku, Err: errRestrictedCached}
+	}
+	if c.region == RegionCA {
+		return c.checkAvailabilityCA(ctx, sku, postalCode)
+	}
 
 	// Search for product availability using postal code
 	endpoint := fmt.Sprintf("%s/products/%s/stores.json?postalCode=%s&apiKey=%s",
-		c.baseURL, url.PathEscape(sku), url.QueryEscape(postalCode), c.apiKey)
+		c.baseURL, url.PathEscape(sku), url.QueryEscape(postalCode), c.key())
 
 	log.Printf("CheckAvailability endpoint: %s", endpoint)
 
 	body, err := c.doRequest(ctx, endpoint)
 	if err != nil {
-		if strings.Contains(err.Error(), "403") {
-			log.Printf("CheckAvailability: Access forbidden for SKU %s (likely rate limited or restricted)", sku)
-			return []StoreAvailability{}, nil
+		var forbidden *ForbiddenError
+		if errors.As(err, &forbidden) {
+			log.Printf("CheckAvailability: Access forbidden for SKU %s (likely restricted)", sku)
+			c.restricted.add(sku)
+			return nil, &RestrictedError{SKU: sku, Err: err}
 		}
 		log.Printf("CheckAvailability error: %v", err)
 		return nil, err
@@ -0,0 +1,632 @@
+package bestbuy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the current output")
+
+// newTestClient returns an APIClient whose requests are answered with the
+// contents of testdata/file and the given status code
+func newTestClient(t *testing.T, status int, file string) *APIClient {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", file))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+	c.maxRetries = 1
+	c.retryBaseWait = time.Millisecond
+	return c
+}
+
+// checkGolden compares got, encoded as indented JSON, with testdata/name.golden
+func checkGolden(t *testing.T, name string, got any) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("%s mismatch (run with -update to accept)\ngot:\n%s\nwant:\n%s", path, data, want)
+	}
+}
+
+func TestDecodeResponses(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		file string
+		call func(c *APIClient) (any, error)
+	}{
+		{
+			name: "stores",
+			file: "stores.json",
+			call: func(c *APIClient) (any, error) { return c.SearchStores(ctx, "94103", 25) },
+		},
+		{
+			name: "products",
+			file: "products.json",
+			call: func(c *APIClient) (any, error) { return c.SearchProducts(ctx, "prismatic", "", PageRequest{}) },
+		},
+		{
+			name: "category_products",
+			file: "products.json",
+			call: func(c *APIClient) (any, error) {
+				return c.SearchProductsInCategory(ctx, "pcmcat1604992984556", "", PageRequest{})
+			},
+		},
+		{
+			name: "product",
+			file: "product.json",
+			call: func(c *APIClient) (any, error) { return c.GetProductBySKU(ctx, "6606082") },
+		},
+		{
+			name: "availability",
+			file: "availability.json",
+			call: func(c *APIClient) (any, error) { return c.CheckAvailability(ctx, "6606082", "94103") },
+		},
+		{
+			name: "availability_empty",
+			file: "availability_empty.json",
+			call: func(c *APIClient) (any, error) { return c.CheckAvailability(ctx, "6606082", "94103") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call(newTestClient(t, http.StatusOK, tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkGolden(t, tt.name, got)
+		})
+	}
+}
+
+func TestDecodeStoresProducts(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "stores_products.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result storesProductsResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "stores_products", result)
+}
+
+func TestErrorResponses(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		status int
+		file   string
+		check  func(t *testing.T, err error)
+	}{
+		{
+			name:   "key inactive",
+			status: http.StatusForbidden,
+			file:   "error_key_inactive.html",
+			check: func(t *testing.T, err error) {
+				var keyErr *APIKeyError
+				if !errors.As(err, &keyErr) || keyErr.StatusCode != http.StatusForbidden || !errors.Is(err, ErrAPIKeyRejected) {
+					t.Errorf("got %v, want APIKeyError with status 403", err)
+				}
+			},
+		},
+		{
+			name:   "daily quota",
+			status: http.StatusForbidden,
+			file:   "error_quota.html",
+			check: func(t *testing.T, err error) {
+				var quotaErr *QuotaExceededError
+				if !errors.As(err, &quotaErr) || !errors.Is(err, ErrQuotaExceeded) {
+					t.Errorf("got %v, want QuotaExceededError", err)
+				}
+			},
+		},
+		{
+			name:   "per second limit",
+			status: http.StatusForbidden,
+			file:   "error_per_second.html",
+			check: func(t *testing.T, err error) {
+				var rateErr *RateLimitError
+				if !errors.As(err, &rateErr) || !errors.Is(err, ErrRateLimited) {
+					t.Errorf("got %v, want RateLimitError", err)
+				}
+			},
+		},
+		{
+			name:   "bad request",
+			status: http.StatusBadRequest,
+			file:   "error_bad_request.json",
+			check: func(t *testing.T, err error) {
+				if !errors.Is(err, ErrInvalidQuery) || !strings.Contains(err.Error(), "Couldn't understand") {
+					t.Errorf("got %v, want ErrInvalidQuery with the API message", err)
+				}
+			},
+		},
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			file:   "error_not_found.json",
+			check: func(t *testing.T, err error) {
+				var notFound *NotFoundError
+				if !errors.As(err, &notFound) || !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "status 404") {
+					t.Errorf("got %v, want NotFoundError", err)
+				}
+			},
+		},
+		{
+			name:   "server error",
+			status: http.StatusServiceUnavailable,
+			file:   "error_key_inactive.html",
+			check: func(t *testing.T, err error) {
+				if err == nil || !strings.Contains(err.Error(), "max retries exceeded") {
+					t.Errorf("got %v, want max retries exceeded", err)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestClient(t, tt.status, tt.file).GetProductBySKU(ctx, "6606082")
+			tt.check(t, err)
+		})
+	}
+}
+
+func TestRestrictedSKUSkipped(t *testing.T) {
+	ctx := context.Background()
+	body, err := os.ReadFile(filepath.Join("testdata", "error_restricted.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusForbidden)
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+	c.maxRetries = 1
+	now := time.Now()
+	c.restricted.now = func() time.Time { return now }
+
+	for i := range 2 {
+		if _, err := c.CheckAvailability(ctx, "6606082", "94103"); !errors.Is(err, ErrRestrictedSKU) {
+			t.Fatalf("check %d: got %v, want ErrRestrictedSKU", i, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("got %d API calls, want 1 while the SKU is remembered as restricted", calls)
+	}
+
+	now = now.Add(DefaultRestrictedTTL)
+	if _, err := c.CheckAvailability(ctx, "6606082", "94103"); !errors.Is(err, ErrRestrictedSKU) {
+		t.Fatalf("got %v, want ErrRestrictedSKU", err)
+	}
+	if calls != 2 {
+		t.Errorf("got %d API calls, want 2 once the restriction expired", calls)
+	}
+}
+
+func TestRejectedKeyNotRestricted(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, http.StatusForbidden, "error_key_inactive.html")
+
+	for i := range 2 {
+		_, err := c.CheckAvailability(ctx, "6606082", "94103")
+		if errors.Is(err, ErrRestrictedSKU) {
+			t.Fatalf("check %d: got %v, want the key error, not a restricted SKU", i, err)
+		}
+		var keyErr *APIKeyError
+		if !errors.As(err, &keyErr) {
+			t.Fatalf("check %d: got %v, want APIKeyError", i, err)
+		}
+	}
+	if c.restricted.has("6606082") {
+		t.Error("SKU remembered as restricted after the key was rejected")
+	}
+}
+
+func TestMalformedResponse(t *testing.T) {
+	_, err := newTestClient(t, http.StatusOK, "error_key_inactive.html").SearchStores(context.Background(), "94103", 25)
+	if err == nil || !strings.Contains(err.Error(), "failed to decode response") {
+		t.Errorf("got %v, want decode error", err)
+	}
+}
+
+func TestRequestHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Write([]byte(`{"stores":[]}`))
+	}))
+	defer srv.Close()
+
+	for _, tt := range []struct{ userAgent, want string }{
+		{"", webapi.DefaultUserAgent},
+		{"stock-checker/1.4.0 (+mailto:ops@example.com)", "stock-checker/1.4.0 (+mailto:ops@example.com)"},
+	} {
+		c := NewAPIClient("test-key", tt.userAgent)
+		c.baseURL = srv.URL
+		if _, err := c.SearchStores(context.Background(), "94103", 25); err != nil {
+			t.Fatal(err)
+		}
+		if ua := got.Get("User-Agent"); ua != tt.want {
+			t.Errorf("User-Agent = %q, want %q", ua, tt.want)
+		}
+		if accept := got.Get("Accept"); accept != "application/json" {
+			t.Errorf("Accept = %q, want application/json", accept)
+		}
+	}
+}
+
+func TestResponseCaching(t *testing.T) {
+	body := []byte(`{"stores":[{"storeId":281,"name":"San Francisco"}]}`)
+
+	tests := []struct {
+		name      string
+		header    map[string]string
+		wantCalls int
+		want304   int
+	}{
+		{"max-age served from cache", map[string]string{"Cache-Control": "max-age=60"}, 1, 0},
+		{"etag revalidated", map[string]string{"Cache-Control": "no-cache", "ETag": `"v1"`}, 3, 2},
+		{"last-modified revalidated", map[string]string{"Last-Modified": "Mon, 12 Oct 2026 10:00:00 GMT"}, 3, 2},
+		{"no-store not cached", map[string]string{"Cache-Control": "no-store", "ETag": `"v1"`}, 3, 0},
+		{"no validators not cached", nil, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls, notModified int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				for k, v := range tt.header {
+					w.Header().Set(k, v)
+				}
+				if (r.Header.Get("If-None-Match") != "" && r.Header.Get("If-None-Match") == tt.header["ETag"]) ||
+					(r.Header.Get("If-Modified-Since") != "" && r.Header.Get("If-Modified-Since") == tt.header["Last-Modified"]) {
+					notModified++
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Write(body)
+			}))
+			defer srv.Close()
+
+			c := NewAPIClient("test-key", "")
+			c.baseURL = srv.URL
+			c.limiter = nil
+
+			for i := 0; i < 3; i++ {
+				stores, err := c.SearchStores(context.Background(), "94103", 25)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(stores) != 1 || stores[0].StoreID != 281 {
+					t.Fatalf("call %d: got %+v", i, stores)
+				}
+			}
+			if calls != tt.wantCalls || notModified != tt.want304 {
+				t.Errorf("got %d requests (%d not modified), want %d (%d)", calls, notModified, tt.wantCalls, tt.want304)
+			}
+		})
+	}
+}
+
+func TestConcurrentRequestsShareOneCall(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Write([]byte(`{"stores":[{"storeId":281,"name":"San Francisco"}]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+
+	const callers = 5
+	var wg sync.WaitGroup
+	errs := make(chan error, callers)
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stores, err := c.SearchStores(context.Background(), "94103", 25)
+			if err == nil && len(stores) != 1 {
+				err = errors.New("wrong stores")
+			}
+			errs <- err
+		}()
+	}
+
+	// Let every caller join the in-flight request before answering it
+	for calls.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("%d upstream requests, want 1", n)
+	}
+}
+
+func TestCallerCanStopWaitingForSharedCall(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write([]byte(`{"stores":[]}`))
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.SearchStores(ctx, "94103", 25); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want deadline exceeded", err)
+	}
+}
+
+func TestSearchProductsPage(t *testing.T) {
+	var query atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query.Store(r.URL.Query())
+		w.Write([]byte(`{"products":[],"currentPage":3,"totalPages":4,"total":310}`))
+	}))
+	defer srv.Close()
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+
+	page, err := c.SearchProductsInCategory(context.Background(), CategoryTradingCards, "", PageRequest{Page: 3, Size: 500})
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := query.Load().(url.Values)
+	if params.Get("page") != "3" || params.Get("pageSize") != "100" {
+		t.Errorf("page=%q pageSize=%q, want 3 and 100", params.Get("page"), params.Get("pageSize"))
+	}
+	if page.Page != 3 || page.Total != 310 || !page.HasMore() {
+		t.Errorf("page = %+v, want page 3 of 4 with more", page)
+	}
+}
+
+func TestPageOf(t *testing.T) {
+	products := make([]Product, 5)
+	for i := range products {
+		products[i].SKU = i
+	}
+
+	tests := []struct {
+		req      PageRequest
+		skus     []int
+		hasMore  bool
+		numPages int
+	}{
+		{PageRequest{Size: 2}, []int{0, 1}, true, 3},
+		{PageRequest{Page: 3, Size: 2}, []int{4}, false, 3},
+		{PageRequest{Page: 4, Size: 2}, nil, false, 3},
+		{PageRequest{}, []int{0, 1, 2, 3, 4}, false, 1},
+	}
+	for _, tt := range tests {
+		page := pageOf(products, tt.req, 50)
+		var skus []int
+		for _, p := range page.Products {
+			skus = append(skus, p.SKU)
+		}
+		if !slices.Equal(skus, tt.skus) || page.HasMore() != tt.hasMore || page.TotalPages != tt.numPages {
+			t.Errorf("pageOf(%+v) = %v (more %v, pages %d), want %v (more %v, pages %d)",
+				tt.req, skus, page.HasMore(), page.TotalPages, tt.skus, tt.hasMore, tt.numPages)
+		}
+	}
+}
+
+func TestBrowseAllPages(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page := r.URL.Query().Get("page")
+		// SKU 2 shifts onto the second page between requests
+		skus := map[string]string{"1": `{"sku":1},{"sku":2}`, "2": `{"sku":2},{"sku":3}`, "3": `{"sku":4}`}[page]
+		fmt.Fprintf(w, `{"products":[%s],"currentPage":%s,"totalPages":3,"total":5}`, skus, page)
+	}))
+	defer srv.Close()
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+
+	products, err := c.BrowsePokemonProducts(context.Background(), BrowseOptions{AllPages: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var skus []int
+	for _, p := range products {
+		skus = append(skus, p.SKU)
+	}
+	if !slices.Equal(skus, []int{1, 2, 3, 4}) {
+		t.Errorf("skus = %v, want [1 2 3 4]", skus)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("made %d requests, want 3", got)
+	}
+
+	requests.Store(0)
+	if _, err := c.BrowsePokemonProducts(context.Background(), BrowseOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("first page only made %d requests, want 1", got)
+	}
+}
+
+func TestBrowseDomain(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"products":[],"currentPage":1,"totalPages":1,"total":0}`))
+	}))
+	defer srv.Close()
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+
+	for _, tt := range []struct{ domain, want string }{
+		{"", "/products(subclass=POKEMON CARDS&active=*)"},
+		{"GPU", "/products(categoryPath.id=abcat0507002&active=*)"},
+		{"lego", "/products(manufacturer=LEGO&active=*)"},
+	} {
+		d, err := LookupDomain(tt.domain)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.SetDomain(d)
+		if _, err := c.BrowsePokemonProducts(context.Background(), BrowseOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if path != tt.want {
+			t.Errorf("domain %q browsed %s, want %s", tt.domain, path, tt.want)
+		}
+	}
+
+	if _, err := LookupDomain("beanie babies"); err == nil {
+		t.Error("unknown domain: want error")
+	}
+}
+
+func TestDomainPresets(t *testing.T) {
+	for id, d := range domains {
+		seen := make(map[string]bool)
+		for _, p := range d.Presets {
+			if seen[p.SKU] {
+				t.Errorf("%s: preset %s listed twice", id, p.SKU)
+			}
+			seen[p.SKU] = true
+			if p.Name == "" || p.MSRP <= 0 {
+				t.Errorf("%s: preset %s needs a name and MSRP", id, p.SKU)
+			}
+			if got, ok := d.Preset(p.SKU); !ok || got != p {
+				t.Errorf("%s: Preset(%s) = %+v, %v", id, p.SKU, got, ok)
+			}
+		}
+	}
+
+	d, _ := LookupDomain("gpu")
+	if _, ok := d.Preset("6579543"); ok {
+		t.Error("gpu domain has a preset for a Pokemon product")
+	}
+}
+
+func TestCoalesceKeySeparatesPriorities(t *testing.T) {
+	ctx := context.Background()
+	keys := map[string]string{
+		"background":  coalesceKey(WithPriority(ctx, PriorityBackground), "/stores.json"),
+		"normal":      coalesceKey(ctx, "/stores.json"),
+		"interactive": coalesceKey(WithPriority(ctx, PriorityInteractive), "/stores.json"),
+		"live":        coalesceKey(WithHighPriority(ctx), "/stores.json"),
+	}
+	seen := make(map[string]string)
+	for class, key := range keys {
+		if other, ok := seen[key]; ok {
+			t.Errorf("%s and %s requests share key %q", class, other, key)
+		}
+		seen[key] = class
+	}
+	if coalesceKey(WithPriority(ctx, PriorityNormal), "/stores.json") != keys["normal"] {
+		t.Error("requests of the same class don't share a key")
+	}
+}
+
+func TestSharedCallKeepsOnlyPriority(t *testing.T) {
+	type userKey struct{}
+	caller, cancel := context.WithTimeout(context.WithValue(WithHighPriority(context.Background()), userKey{}, 7), time.Millisecond)
+	defer cancel()
+
+	shared, cancelShared := detached(caller)
+	defer cancelShared()
+	if shared.Value(userKey{}) != nil {
+		t.Error("shared context carries the caller's values")
+	}
+	if !IsHighPriority(shared) || PriorityOf(shared) != PriorityInteractive {
+		t.Error("shared context lost the request's priority")
+	}
+	<-caller.Done()
+	if shared.Err() != nil {
+		t.Error("shared context ended with the caller's")
+	}
+	if deadline, ok := shared.Deadline(); !ok || time.Until(deadline) > sharedFetchTimeout {
+		t.Errorf("shared deadline %v, want one within %v", deadline, sharedFetchTimeout)
+	}
+}
@@ -0,0 +1,74 @@
+package bestbuy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// sharedFetchTimeout bounds a request shared between callers, retries and
+// rate limiter waits included, since no caller's deadline applies to it
+const sharedFetchTimeout = 2 * time.Minute
+
+// detached returns the context for a request shared between callers. It
+// keeps only the request's priority, which the coalescing key already
+// matches, so one caller's deadline and values don't reach the others.
+func detached(ctx context.Context) (context.Context, context.CancelFunc) {
+	shared := WithPriority(context.Background(), PriorityOf(ctx))
+	if IsHighPriority(ctx) {
+		shared = WithHighPriority(shared)
+	}
+	return context.WithTimeout(shared, sharedFetchTimeout)
+}
+
+// coalesceKey returns the key callers share a request for endpoint under.
+// Only callers of the same priority class share one, so a request never
+// waits behind one of a lower class still waiting its turn; live checks,
+// which skip the response cache, only share with each other.
+func coalesceKey(ctx context.Context, endpoint string) string {
+	if IsHighPriority(ctx) {
+		return "live:" + endpoint
+	}
+	return fmt.Sprintf("%d:%s", PriorityOf(ctx), endpoint)
+}
+
+// flight is a request in progress that other callers can wait on
+type flight struct {
+	done chan struct{}
+	body []byte
+	err  error
+}
+
+// coalescer shares one in-progress request between concurrent callers
+// asking for the same key
+type coalescer struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+// join returns the flight for key, starting fn in the background if no
+// request for key is in progress
+func (c *coalescer) join(key string, fn func() ([]byte, error)) *flight {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if f, ok := c.flights[key]; ok {
+		return f
+	}
+	if c.flights == nil {
+		c.flights = make(map[string]*flight)
+	}
+	f := &flight{done: make(chan struct{})}
+	c.flights[key] = f
+
+	go func() {
+		f.body, f.err = fn()
+
+		c.mu.Lock()
+		delete(c.flights, key)
+		c.mu.Unlock()
+		close(f.done)
+	}()
+	return f
+}
@@ -0,0 +1,144 @@
+package bestbuy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+)
+
+// Domain is the kind of product a deployment tracks. It picks what browsing
+// returns, the category new-listing watches search by default and the sample
+// product shown when previewing notification templates.
+type Domain struct {
+	ID       string // "tcg", "gpu", "consoles" or "lego"
+	Name     string // shown in the app, e.g. "Pokemon TCG"
+	Subclass string // default search filter (SearchProducts subclass); empty searches everything
+	Category string // category new-listing watches search by default
+
+	browse     string // products() filter that browsing returns
+	caQuery    string // bestbuy.ca search that browsing returns
+	caCategory string // bestbuy.ca category for Category; empty for none
+
+	Sample  Product  // a representative product, for template previews
+	Presets []Preset // popular products suggested to new users
+}
+
+// Preset is a popular product a domain suggests watching. Its alerts start
+// capped at MSRP, since marked-up listings aren't the restock people want.
+type Preset struct {
+	SKU  string
+	Name string
+	MSRP money.Cents
+}
+
+// DefaultDomain is the domain used when none is configured
+const DefaultDomain = "tcg"
+
+// domains are the product domains a deployment can track
+var domains = map[string]Domain{
+	"tcg": {
+		ID:         "tcg",
+		Name:       "Pokemon TCG",
+		Subclass:   "POKEMON CARDS",
+		Category:   CategoryTradingCards,
+		browse:     "subclass=POKEMON CARDS",
+		caQuery:    "pokemon cards",
+		caCategory: caCategoryTradingCards,
+		Sample: Product{
+			SKU:            6579543,
+			Name:           "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box",
+			SalePrice:      5999,
+			ThumbnailImage: "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6579/6579543_sd.jpg",
+		},
+	},
+	"gpu": {
+		ID:       "gpu",
+		Name:     "Graphics cards",
+		Category: "abcat0507002",
+		browse:   "categoryPath.id=abcat0507002",
+		caQuery:  "graphics card",
+		Sample: Product{
+			SKU:            6614151,
+			Name:           "NVIDIA - GeForce RTX 5090 32GB GDDR7 Graphics Card - Dark Gun Metal",
+			SalePrice:      199999,
+			ThumbnailImage: "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6614/6614151_sd.jpg",
+		},
+		Presets: []Preset{
+			{SKU: "6614151", Name: "GeForce RTX 5090 Founders Edition", MSRP: 199999},
+			{SKU: "6614153", Name: "GeForce RTX 5080 Founders Edition", MSRP: 99999},
+			{SKU: "6614154", Name: "GeForce RTX 5070 Founders Edition", MSRP: 54999},
+		},
+	},
+	"consoles": {
+		ID:       "consoles",
+		Name:     "Game consoles",
+		Category: "abcat0700000",
+		browse:   "categoryPath.id=abcat0700000&search=console",
+		caQuery:  "video game console",
+		Sample: Product{
+			SKU:            6614313,
+			Name:           "Nintendo Switch 2 System",
+			SalePrice:      44999,
+			ThumbnailImage: "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6614/6614313_sd.jpg",
+		},
+		Presets: []Preset{
+			{SKU: "6614313", Name: "Nintendo Switch 2", MSRP: 44999},
+			{SKU: "6614325", Name: "Nintendo Switch 2 Mario Kart World Bundle", MSRP: 49999},
+			{SKU: "6578276", Name: "PlayStation 5 Pro", MSRP: 74999},
+			{SKU: "6428324", Name: "Xbox Series X", MSRP: 64999},
+		},
+	},
+	"lego": {
+		ID:       "lego",
+		Name:     "LEGO",
+		Category: "abcat0900000",
+		browse:   "manufacturer=LEGO",
+		caQuery:  "lego",
+		Sample: Product{
+			SKU:            6586117,
+			Name:           "LEGO - Icons Retro Radio 10334",
+			SalePrice:      9999,
+			ThumbnailImage: "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6586/6586117_sd.jpg",
+		},
+	},
+}
+
+// LookupDomain returns a product domain by ID, or the default if id is empty
+func LookupDomain(id string) (Domain, error) {
+	if id == "" {
+		id = DefaultDomain
+	}
+	d, ok := domains[strings.ToLower(id)]
+	if !ok {
+		ids := make([]string, 0, len(domains))
+		for id := range domains {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		return Domain{}, fmt.Errorf("unknown product domain %q (want one of %s)", id, strings.Join(ids, ", "))
+	}
+	return d, nil
+}
+
+// Preset returns the domain's preset for a SKU, if it has one
+func (d Domain) Preset(sku string) (Preset, bool) {
+	for _, p := range d.Presets {
+		if p.SKU == sku {
+			return p, true
+		}
+	}
+	return Preset{}, false
+}
+
+// caCategoryFor maps a US category to the domain's bestbuy.ca category
+func (d Domain) caCategoryFor(category string) string {
+	switch category {
+	case CategoryTradingCards:
+		return caCategoryTradingCards
+	case d.Category:
+		return d.caCategory
+	}
+	return category
+}
@@ -0,0 +1,132 @@
+package bestbuy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Sentinel errors for the kinds of failure callers handle differently. The
+// typed errors below match them with errors.Is, and carry the details.
+var (
+	ErrNotFound       = errors.New("bestbuy: not found")
+	ErrRestrictedSKU  = errors.New("bestbuy: availability restricted")
+	ErrQuotaExceeded  = errors.New("bestbuy: daily quota exceeded")
+	ErrInvalidQuery   = errors.New("bestbuy: invalid query")
+	ErrRateLimited    = errors.New("bestbuy: rate limited")
+	ErrAPIKeyRejected = errors.New("bestbuy: API key rejected")
+)
+
+// errRestrictedCached is the cause of a RestrictedError for a SKU recently
+// refused, returned without asking the API again
+var errRestrictedCached = errors.New("refused recently, not checked again yet")
+
+// RateLimitError is returned when the API rate limit is exceeded
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %v", e.RetryAfter)
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// APIKeyError is returned when the API key is rejected (invalid, expired or inactive)
+type APIKeyError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIKeyError) Error() string {
+	return fmt.Sprintf("API key rejected (status %d): %s", e.StatusCode, e.Body)
+}
+
+func (e *APIKeyError) Is(target error) bool {
+	return target == ErrAPIKeyRejected
+}
+
+// QuotaExceededError is returned when the daily API quota is used up
+type QuotaExceededError struct {
+	Body string
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("daily API quota exceeded: %s", e.Body)
+}
+
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// NotFoundError is returned when the API has no such product (or other resource)
+type NotFoundError struct {
+	Body string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("API returned status 404: %s", e.Body)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// InvalidQueryError is returned when the API can't parse a request, usually a
+// search with characters its query syntax doesn't allow
+type InvalidQueryError struct {
+	Body string
+}
+
+func (e *InvalidQueryError) Error() string {
+	return fmt.Sprintf("API returned status 400: %s", e.Body)
+}
+
+func (e *InvalidQueryError) Is(target error) bool {
+	return target == ErrInvalidQuery
+}
+
+// ForbiddenError is returned when the API itself refuses a request with a 403
+// and its usual JSON error, as it does for some restricted SKUs. The key is
+// fine: a rejected key gets the gateway's HTML page and an APIKeyError.
+type ForbiddenError struct {
+	Body string
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("API returned status 403: %s", e.Body)
+}
+
+// isAPIError reports whether body is the API's JSON error, as opposed to a
+// page from the gateway in front of it
+func isAPIError(body []byte) bool {
+	var resp struct {
+		Error *struct {
+			Code int `json:"code"`
+		} `json:"error"`
+	}
+	return json.Unmarshal(body, &resp) == nil && resp.Error != nil
+}
+
+// RestrictedError is returned when the API refuses availability for a product,
+// as it does for some restricted SKUs, even though the key is valid. Err is
+// the ForbiddenError it answered with, or errRestrictedCached.
+type RestrictedError struct {
+	SKU string
+	Err error
+}
+
+func (e *RestrictedError) Error() string {
+	return fmt.Sprintf("availability for SKU %s is restricted: %v", e.SKU, e.Err)
+}
+
+func (e *RestrictedError) Unwrap() error {
+	return e.Err
+}
+
+func (e *RestrictedError) Is(target error) bool {
+	return target == ErrRestrictedSKU
+}
@@ -0,0 +1,144 @@
+package bestbuy
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxCachedResponses bounds the number of responses kept by responseCache
+const maxCachedResponses = 1000
+
+// cachedResponse is a response body with the validators and freshness Best Buy sent with it
+type cachedResponse struct {
+	body         []byte
+	etag         string
+	lastModified string
+	expires      time.Time
+}
+
+// fresh reports whether the response can be served without asking the API
+func (r *cachedResponse) fresh(now time.Time) bool {
+	return now.Before(r.expires)
+}
+
+// revalidatable reports whether a stale response can be revalidated with a conditional request
+func (r *cachedResponse) revalidatable() bool {
+	return r.etag != "" || r.lastModified != ""
+}
+
+// responseCache caches API responses according to the upstream Cache-Control,
+// Expires, ETag and Last-Modified headers. Fresh responses are served without
+// a request; stale ones are revalidated so an unchanged response comes back as
+// a 304 instead of a full call.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+// newResponseCache creates an empty responseCache
+func newResponseCache() *responseCache {
+	return &responseCache{entries: make(map[string]*cachedResponse)}
+}
+
+// get returns the cached response for endpoint, if any
+func (c *responseCache) get(endpoint string) *cachedResponse {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries[endpoint]
+}
+
+// store caches a 200 response if its headers allow it
+func (c *responseCache) store(endpoint string, header http.Header, body []byte, now time.Time) {
+	expires, ok := freshUntil(header, now)
+	if !ok {
+		return
+	}
+	entry := &cachedResponse{
+		body:         body,
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+		expires:      expires,
+	}
+	if !entry.fresh(now) && !entry.revalidatable() {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[endpoint]; !exists && len(c.entries) >= maxCachedResponses {
+		c.evict(now)
+	}
+	c.entries[endpoint] = entry
+}
+
+// refresh updates the freshness of a response the API confirmed with a 304
+func (c *responseCache) refresh(endpoint string, header http.Header, now time.Time) {
+	expires, ok := freshUntil(header, now)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[endpoint]
+	if !exists {
+		return
+	}
+	if !ok {
+		delete(c.entries, endpoint)
+		return
+	}
+	entry.expires = expires
+	if etag := header.Get("ETag"); etag != "" {
+		entry.etag = etag
+	}
+}
+
+// evict makes room for one entry, preferring stale entries that can't be revalidated.
+// Must be called with mu held.
+func (c *responseCache) evict(now time.Time) {
+	var oldest string
+	var oldestExpiry time.Time
+	for endpoint, entry := range c.entries {
+		if !entry.fresh(now) && !entry.revalidatable() {
+			delete(c.entries, endpoint)
+			return
+		}
+		if oldest == "" || entry.expires.Before(oldestExpiry) {
+			oldest, oldestExpiry = endpoint, entry.expires
+		}
+	}
+	delete(c.entries, oldest)
+}
+
+// freshUntil returns when a response stops being fresh according to its
+// Cache-Control or Expires header. ok is false if the response must not be cached.
+func freshUntil(header http.Header, now time.Time) (expires time.Time, ok bool) {
+	for _, directive := range strings.Split(header.Get("Cache-Control"), ",") {
+		directive = strings.ToLower(strings.TrimSpace(directive))
+		switch {
+		case directive == "no-store":
+			return time.Time{}, false
+		case directive == "no-cache":
+			// Cacheable, but must be revalidated every time
+			return now, true
+		case strings.HasPrefix(directive, "max-age="):
+			if seconds, err := strconv.Atoi(strings.TrimPrefix(directive, "max-age=")); err == nil {
+				return now.Add(time.Duration(seconds) * time.Second), true
+			}
+		}
+	}
+
+	if v := header.Get("Expires"); v != "" {
+		// Invalid dates (such as "0") mean already expired
+		t, err := http.ParseTime(v)
+		if err != nil {
+			return now, true
+		}
+		return t, true
+	}
+
+	return now, true
+}
@@ -0,0 +1,115 @@
+package bestbuy
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// limiter is a token bucket shared by a client's requests. Tokens refill at a
+// steady rate up to a small burst, and a request takes one before it's sent.
+// Requests wait their turn by priority: while a higher priority request is
+// waiting, lower ones leave the tokens to it.
+type limiter struct {
+	interval time.Duration // time to refill one token
+	throttle *Throttle     // tunes interval when set
+	burst    float64
+
+	mu      sync.Mutex
+	tokens  float64
+	last    time.Time // when tokens was last refilled
+	waiting [priorityClasses]int
+}
+
+// newLimiter creates a limiter allowing one request per interval on average,
+// and burst at once. A zero interval means no limit.
+func newLimiter(interval time.Duration, burst int) *limiter {
+	if interval <= 0 {
+		return nil
+	}
+	return &limiter{interval: interval, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// newThrottledLimiter creates a limiter whose interval t tunes
+func newThrottledLimiter(t *Throttle, burst int) *limiter {
+	l := newLimiter(t.Interval(), burst)
+	l.throttle = t
+	return l
+}
+
+// refill adds the tokens earned since the last refill. Callers hold mu.
+func (l *limiter) refill() {
+	if l.throttle != nil {
+		l.interval = l.throttle.Interval()
+	}
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+float64(now.Sub(l.last))/float64(l.interval))
+	l.last = now
+}
+
+// rateLimited slows requests down after the API refused one for going too fast
+func (l *limiter) rateLimited() {
+	if l == nil {
+		return
+	}
+	l.throttle.rateLimited()
+}
+
+// succeeded counts a request the API accepted
+func (l *limiter) succeeded(latency time.Duration) {
+	if l == nil {
+		return
+	}
+	l.throttle.succeeded(latency)
+}
+
+// outranked reports whether requests of a higher priority than p are waiting. Callers hold mu.
+func (l *limiter) outranked(p Priority) bool {
+	for higher := p + 1; higher < priorityClasses; higher++ {
+		if l.waiting[higher] > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// wait blocks until a request of priority p may be sent
+func (l *limiter) wait(ctx context.Context, p Priority) error {
+	if l == nil {
+		return nil
+	}
+	p = min(max(p, PriorityBackground), priorityClasses-1)
+
+	l.mu.Lock()
+	l.waiting[p]++
+	defer func() {
+		l.mu.Lock()
+		l.waiting[p]--
+		l.mu.Unlock()
+	}()
+
+	for {
+		l.refill()
+		if l.tokens >= 1 && !l.outranked(p) {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+
+		// Sleep until the next token, or check back shortly while yielding it
+		wait := time.Duration((1 - l.tokens) * float64(l.interval))
+		if wait <= 0 {
+			wait = max(l.interval/4, time.Millisecond)
+		}
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+		l.mu.Lock()
+	}
+}
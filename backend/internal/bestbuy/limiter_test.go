@@ -0,0 +1,76 @@
+package bestbuy
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLimiterAllowsBurstThenPaces(t *testing.T) {
+	l := newLimiter(20*time.Millisecond, 2)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 2 {
+		if err := l.wait(ctx, PriorityNormal); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Errorf("burst of 2 took %v, want no wait", elapsed)
+	}
+
+	if err := l.wait(ctx, PriorityNormal); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("third request after %v, want it to wait for a token", elapsed)
+	}
+}
+
+func TestLimiterServesInteractiveBeforeBackground(t *testing.T) {
+	l := newLimiter(30*time.Millisecond, 1)
+	ctx := context.Background()
+	l.wait(ctx, PriorityBackground) // use the only token
+
+	var mu sync.Mutex
+	var order []Priority
+	var wg sync.WaitGroup
+	take := func(p Priority) {
+		defer wg.Done()
+		if err := l.wait(ctx, p); err != nil {
+			t.Error(err)
+			return
+		}
+		mu.Lock()
+		order = append(order, p)
+		mu.Unlock()
+	}
+
+	// The background requests queue first, but the interactive one goes ahead
+	wg.Add(3)
+	go take(PriorityBackground)
+	go take(PriorityBackground)
+	time.Sleep(5 * time.Millisecond)
+	go take(PriorityInteractive)
+	wg.Wait()
+
+	if len(order) != 3 || order[0] != PriorityInteractive {
+		t.Errorf("served in order %v, want the interactive request first", order)
+	}
+}
+
+func TestLimiterGivesUpWhenContextDone(t *testing.T) {
+	l := newLimiter(time.Hour, 1)
+	l.wait(context.Background(), PriorityNormal)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.wait(ctx, PriorityInteractive); err != context.DeadlineExceeded {
+		t.Errorf("wait = %v, want context.DeadlineExceeded", err)
+	}
+	if l.waiting[PriorityInteractive] != 0 {
+		t.Error("abandoned request still counted as waiting")
+	}
+}
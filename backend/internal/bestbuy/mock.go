@@ -16,8 +16,13 @@ type MockClient struct {
 
 // NewMockClient creates a new mock client
 func NewMockClient() *MockClient {
+	return NewMockClientWithLatency(100 * time.Millisecond) // Simulate 100ms API latency
+}
+
+// NewMockClientWithLatency creates a mock client that takes latency to answer each call
+func NewMockClientWithLatency(latency time.Duration) *MockClient {
 	return &MockClient{
-		latency: 100 * time.Millisecond, // Simulate 100ms API latency
+		latency: latency,
 	}
 }
 
@@ -102,96 +107,104 @@ var mockProducts = []Product{
 	{
 		SKU:                 6579543,
 		Name:                "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box",
-		SalePrice:           59.99,
-		RegularPrice:        59.99,
+		SalePrice:           5999,
+		RegularPrice:        5999,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6579/6579543_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-scarlet-violet-prismatic-evolutions-elite-trainer-box/6579543.p",
 		ShortDescription:    "Get ready for battle with the Prismatic Evolutions Elite Trainer Box!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875816",
 		InStoreAvailability: true,
 		OnlineAvailability:  false,
 	},
 	{
 		SKU:                 6579544,
 		Name:                "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Booster Bundle",
-		SalePrice:           29.99,
-		RegularPrice:        29.99,
+		SalePrice:           2999,
+		RegularPrice:        2999,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6579/6579544_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-scarlet-violet-prismatic-evolutions-booster-bundle/6579544.p",
 		ShortDescription:    "Collect amazing cards with the Prismatic Evolutions Booster Bundle!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875823",
 		InStoreAvailability: true,
 		OnlineAvailability:  false,
 	},
 	{
 		SKU:                 6579545,
 		Name:                "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Booster Pack",
-		SalePrice:           4.99,
-		RegularPrice:        4.99,
+		SalePrice:           499,
+		RegularPrice:        499,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6579/6579545_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-scarlet-violet-prismatic-evolutions-booster-pack/6579545.p",
 		ShortDescription:    "Each booster pack contains 10 cards from the Prismatic Evolutions expansion!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875830",
 		InStoreAvailability: true,
 		OnlineAvailability:  true,
 	},
 	{
 		SKU:                 6543210,
 		Name:                "Pokemon Trading Card Game: Scarlet & Violet 151 Ultra Premium Collection",
-		SalePrice:           139.99,
-		RegularPrice:        139.99,
+		SalePrice:           13999,
+		RegularPrice:        13999,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6543/6543210_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-scarlet-violet-151-ultra-premium-collection/6543210.p",
 		ShortDescription:    "The ultimate Pokemon 151 collection featuring exclusive cards!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875847",
 		InStoreAvailability: false,
 		OnlineAvailability:  false,
 	},
 	{
 		SKU:                 6543211,
 		Name:                "Pokemon Trading Card Game: Scarlet & Violet 151 Elite Trainer Box",
-		SalePrice:           49.99,
-		RegularPrice:        49.99,
+		SalePrice:           4999,
+		RegularPrice:        4999,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6543/6543211_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-scarlet-violet-151-elite-trainer-box/6543211.p",
 		ShortDescription:    "Collect the original 151 Pokemon with this Elite Trainer Box!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875854",
 		InStoreAvailability: true,
 		OnlineAvailability:  false,
 	},
 	{
 		SKU:                 6578901,
 		Name:                "Pokemon Trading Card Game: Surging Sparks Elite Trainer Box",
-		SalePrice:           54.99,
-		RegularPrice:        54.99,
+		SalePrice:           5499,
+		RegularPrice:        5499,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6578/6578901_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-surging-sparks-elite-trainer-box/6578901.p",
 		ShortDescription:    "Power up with the Surging Sparks Elite Trainer Box!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875861",
 		InStoreAvailability: true,
 		OnlineAvailability:  true,
 	},
 	{
 		SKU:                 6578902,
 		Name:                "Pokemon Trading Card Game: Surging Sparks Booster Bundle",
-		SalePrice:           24.99,
-		RegularPrice:        24.99,
+		SalePrice:           2499,
+		RegularPrice:        2499,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6578/6578902_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-surging-sparks-booster-bundle/6578902.p",
 		ShortDescription:    "Get 6 booster packs in this Surging Sparks bundle!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875878",
 		InStoreAvailability: true,
 		OnlineAvailability:  true,
 	},
 	{
 		SKU:                 6512345,
 		Name:                "Pokemon Trading Card Game: Paldean Fates Elite Trainer Box",
-		SalePrice:           59.99,
-		RegularPrice:        59.99,
+		SalePrice:           5999,
+		RegularPrice:        5999,
 		ThumbnailImage:      "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6512/6512345_sd.jpg",
 		URL:                 "https://www.bestbuy.com/site/pokemon-trading-card-game-paldean-fates-elite-trainer-box/6512345.p",
 		ShortDescription:    "Discover shiny Pokemon with the Paldean Fates Elite Trainer Box!",
 		Manufacturer:        "Pokemon",
+		UPC:                 "820650875885",
 		InStoreAvailability: true,
 		OnlineAvailability:  false,
 	},
@@ -224,8 +237,8 @@ func (c *MockClient) SearchStores(ctx context.Context, postalCode string, radius
 	return stores, nil
 }
 
-// SearchProducts searches for products by keyword, optionally filtered by subclass
-func (c *MockClient) SearchProducts(ctx context.Context, query string, subclass string) ([]Product, error) {
+// SearchProducts returns a page of products matching a keyword, optionally filtered by subclass
+func (c *MockClient) SearchProducts(ctx context.Context, query string, subclass string, page PageRequest) (*ProductPage, error) {
 	if err := c.simulateLatency(ctx); err != nil {
 		return nil, err
 	}
@@ -235,7 +248,7 @@ func (c *MockClient) SearchProducts(ctx context.Context, query string, subclass
 	var results []Product
 
 	for _, product := range mockProducts {
-		if query == "" || strings.Contains(strings.ToLower(product.Name), queryLower) ||
+		if query == "" || product.UPC == query || strings.Contains(strings.ToLower(product.Name), queryLower) ||
 			strings.Contains(fmt.Sprintf("%d", product.SKU), queryLower) ||
 			strings.Contains(strings.ToLower(product.ShortDescription), queryLower) {
 			results = append(results, product)
@@ -244,10 +257,10 @@ func (c *MockClient) SearchProducts(ctx context.Context, query string, subclass
 
 	// If no matches found and query looks like it could be Pokemon related, return all
 	if len(results) == 0 && (strings.Contains(queryLower, "pokemon") || strings.Contains(queryLower, "card")) {
-		return mockProducts, nil
+		results = mockProducts
 	}
 
-	return results, nil
+	return pageOf(results, page, 50), nil
 }
 
 // GetProductBySKU gets a single product by SKU
@@ -261,7 +274,7 @@ func (c *MockClient) GetProductBySKU(ctx context.Context, sku string) (*Product,
 			return &product, nil
 		}
 	}
-	return nil, fmt.Errorf("product not found: %s", sku)
+	return nil, &NotFoundError{Body: "product not found: " + sku}
 }
 
 // CheckAvailability checks product availability using postal code
@@ -280,7 +293,7 @@ func (c *MockClient) CheckAvailability(ctx context.Context, sku string, postalCo
 	}
 
 	if product == nil {
-		return nil, fmt.Errorf("product not found: %s", sku)
+		return nil, &NotFoundError{Body: "product not found: " + sku}
 	}
 
 	// Generate availability for all mock stores (simulating postal code search)
@@ -328,14 +341,17 @@ func (c *MockClient) CheckAvailability(ctx context.Context, sku string, postalCo
 	return availability, nil
 }
 
-// SearchProductsInCategory searches for products within a specific category
-func (c *MockClient) SearchProductsInCategory(ctx context.Context, categoryID string, query string) ([]Product, error) {
+// SearchProductsInCategory returns a page of products within a specific category
+func (c *MockClient) SearchProductsInCategory(ctx context.Context, categoryID string, query string, page PageRequest) (*ProductPage, error) {
 	// For mock, just delegate to regular search
-	return c.SearchProducts(ctx, query, "")
+	if page.Size <= 0 {
+		page.Size = maxPageSize
+	}
+	return c.SearchProducts(ctx, query, "", page)
 }
 
 // BrowsePokemonProducts returns Pokemon TCG products
-func (c *MockClient) BrowsePokemonProducts(ctx context.Context) ([]Product, error) {
+func (c *MockClient) BrowsePokemonProducts(ctx context.Context, opts BrowseOptions) ([]Product, error) {
 	if err := c.simulateLatency(ctx); err != nil {
 		return nil, err
 	}
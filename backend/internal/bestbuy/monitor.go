@@ -0,0 +1,125 @@
+package bestbuy
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// MonitoredClient wraps a Client and reports failed calls, so operators can be
+// told about expired keys or an unreachable API before users notice.
+type MonitoredClient struct {
+	Client
+	onError func(err error)
+}
+
+// NewMonitoredClient creates a client that calls onError for every failed request.
+// Cancelled requests and problems with a single product (unknown or restricted
+// SKUs) are not reported.
+func NewMonitoredClient(client Client, onError func(err error)) *MonitoredClient {
+	return &MonitoredClient{Client: client, onError: onError}
+}
+
+// report passes an error to the callback
+func (c *MonitoredClient) report(err error) {
+	var notFound *NotFoundError
+	var restricted *RestrictedError
+	if err == nil || errors.Is(err, context.Canceled) || errors.As(err, &notFound) || errors.As(err, &restricted) {
+		return
+	}
+	c.onError(err)
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *MonitoredClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	stores, err := c.Client.SearchStores(ctx, postalCode, radiusMiles)
+	c.report(err)
+	return stores, err
+}
+
+// SearchProducts returns a page of products matching a keyword, optionally filtered by subclass
+func (c *MonitoredClient) SearchProducts(ctx context.Context, query string, subclass string, page PageRequest) (*ProductPage, error) {
+	products, err := c.Client.SearchProducts(ctx, query, subclass, page)
+	c.report(err)
+	return products, err
+}
+
+// SearchProductsInCategory returns a page of products within a category
+func (c *MonitoredClient) SearchProductsInCategory(ctx context.Context, categoryID string, query string, page PageRequest) (*ProductPage, error) {
+	products, err := c.Client.SearchProductsInCategory(ctx, categoryID, query, page)
+	c.report(err)
+	return products, err
+}
+
+// GetProductBySKU gets a single product by its SKU
+func (c *MonitoredClient) GetProductBySKU(ctx context.Context, sku string) (*Product, error) {
+	product, err := c.Client.GetProductBySKU(ctx, sku)
+	c.report(err)
+	return product, err
+}
+
+// CheckAvailability checks product availability using postal code
+func (c *MonitoredClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]StoreAvailability, error) {
+	availability, err := c.Client.CheckAvailability(ctx, sku, postalCode)
+	c.report(err)
+	return availability, err
+}
+
+// BrowsePokemonProducts returns the products of the deployment's product domain
+func (c *MonitoredClient) BrowsePokemonProducts(ctx context.Context, opts BrowseOptions) ([]Product, error) {
+	products, err := c.Client.BrowsePokemonProducts(ctx, opts)
+	c.report(err)
+	return products, err
+}
+
+// TimedClient wraps a Client and reports how long each call took
+type TimedClient struct {
+	Client
+	observe func(call string, d time.Duration)
+}
+
+// NewTimedClient creates a client that calls observe with the method name and duration of every call
+func NewTimedClient(client Client, observe func(call string, d time.Duration)) *TimedClient {
+	return &TimedClient{Client: client, observe: observe}
+}
+
+// since reports the time since start for a call
+func (c *TimedClient) since(call string, start time.Time) {
+	c.observe(call, time.Since(start))
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *TimedClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	defer c.since("SearchStores", time.Now())
+	return c.Client.SearchStores(ctx, postalCode, radiusMiles)
+}
+
+// SearchProducts returns a page of products matching a keyword, optionally filtered by subclass
+func (c *TimedClient) SearchProducts(ctx context.Context, query string, subclass string, page PageRequest) (*ProductPage, error) {
+	defer c.since("SearchProducts", time.Now())
+	return c.Client.SearchProducts(ctx, query, subclass, page)
+}
+
+// SearchProductsInCategory returns a page of products within a category
+func (c *TimedClient) SearchProductsInCategory(ctx context.Context, categoryID string, query string, page PageRequest) (*ProductPage, error) {
+	defer c.since("SearchProductsInCategory", time.Now())
+	return c.Client.SearchProductsInCategory(ctx, categoryID, query, page)
+}
+
+// GetProductBySKU gets a single product by its SKU
+func (c *TimedClient) GetProductBySKU(ctx context.Context, sku string) (*Product, error) {
+	defer c.since("GetProductBySKU", time.Now())
+	return c.Client.GetProductBySKU(ctx, sku)
+}
+
+// CheckAvailability checks product availability using postal code
+func (c *TimedClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]StoreAvailability, error) {
+	defer c.since("CheckAvailability", time.Now())
+	return c.Client.CheckAvailability(ctx, sku, postalCode)
+}
+
+// BrowsePokemonProducts returns the products of the deployment's product domain
+func (c *TimedClient) BrowsePokemonProducts(ctx context.Context, opts BrowseOptions) ([]Product, error) {
+	defer c.since("BrowsePokemonProducts", time.Now())
+	return c.Client.BrowsePokemonProducts(ctx, opts)
+}
@@ -0,0 +1,91 @@
+package bestbuy
+
+import (
+	"context"
+	"log"
+)
+
+const (
+	// maxPageSize is the most products the API returns per page
+	maxPageSize = 100
+
+	// maxBrowsePages bounds how many pages a browse walks, so a runaway
+	// total can't use up the daily quota
+	maxBrowsePages = 20
+)
+
+// BrowseOptions tunes BrowsePokemonProducts
+type BrowseOptions struct {
+	// AllPages walks every result page instead of returning only the first.
+	// Each page is a separate request and waits its turn at the rate limiter.
+	AllPages bool
+}
+
+// PageRequest picks a page of product search results
+type PageRequest struct {
+	Page int // 1-based; 0 for the first page
+	Size int // products per page; 0 for the search's default, at most 100
+}
+
+// number returns the 1-based page number
+func (r PageRequest) number() int {
+	return max(r.Page, 1)
+}
+
+// size returns the page size, defaultSize if unset
+func (r PageRequest) size(defaultSize int) int {
+	if r.Size <= 0 {
+		return defaultSize
+	}
+	return min(r.Size, maxPageSize)
+}
+
+// ProductPage is a page of product search results
+type ProductPage struct {
+	Products   []Product
+	Page       int // 1-based
+	TotalPages int
+	Total      int // products matching the search
+}
+
+// HasMore reports whether there are pages after this one
+func (p *ProductPage) HasMore() bool {
+	return p.Page < p.TotalPages
+}
+
+// pageOf slices one page out of a complete result list
+func pageOf(products []Product, r PageRequest, defaultSize int) *ProductPage {
+	size := r.size(defaultSize)
+	page := &ProductPage{
+		Page:       r.number(),
+		TotalPages: max((len(products)+size-1)/size, 1),
+		Total:      len(products),
+	}
+	start := min((page.Page-1)*size, len(products))
+	page.Products = products[start:min(start+size, len(products))]
+	return page
+}
+
+// allPages fetches pages of maxPageSize products in order until the last one
+// and returns their products, dropping SKUs repeated across pages
+func allPages(ctx context.Context, fetch func(ctx context.Context, page PageRequest) (*ProductPage, error)) ([]Product, error) {
+	var products []Product
+	seen := make(map[int]bool)
+	for n := 1; n <= maxBrowsePages; n++ {
+		page, err := fetch(ctx, PageRequest{Page: n, Size: maxPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range page.Products {
+			if !seen[p.SKU] {
+				seen[p.SKU] = true
+				products = append(products, p)
+			}
+		}
+		if !page.HasMore() || len(page.Products) == 0 {
+			return products, nil
+		}
+	}
+	log.Printf("Stopped browsing after %d pages with %d products", maxBrowsePages, len(products))
+	return products, nil
+}
@@ -0,0 +1,47 @@
+package bestbuy
+
+import "context"
+
+// Priority orders requests waiting for the rate limiter: a request waits while
+// requests of a higher priority are waiting
+type Priority int
+
+// Priority classes
+const (
+	PriorityBackground  Priority = iota // the poller and other scheduled work
+	PriorityNormal                      // requests not marked otherwise
+	PriorityInteractive                 // users waiting on an RPC
+	priorityClasses
+)
+
+// priorityKey is the context key for request priority
+type priorityKey struct{}
+
+// liveKey is the context key for requests that must skip the response cache
+type liveKey struct{}
+
+// WithPriority marks requests made with ctx with a priority class
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityOf returns the priority of requests made with ctx, PriorityNormal if unmarked
+func PriorityOf(ctx context.Context) Priority {
+	if p, ok := ctx.Value(priorityKey{}).(Priority); ok {
+		return p
+	}
+	return PriorityNormal
+}
+
+// WithHighPriority marks requests made with ctx as live interactive checks:
+// they skip the response cache and go ahead of other requests waiting for the
+// rate limiter
+func WithHighPriority(ctx context.Context) context.Context {
+	return context.WithValue(WithPriority(ctx, PriorityInteractive), liveKey{}, true)
+}
+
+// IsHighPriority reports whether ctx was marked with WithHighPriority
+func IsHighPriority(ctx context.Context) bool {
+	live, _ := ctx.Value(liveKey{}).(bool)
+	return live
+}
@@ -0,0 +1,214 @@
+package bestbuy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+)
+
+// Quota defaults
+const (
+	DefaultDailyQuota  = 50000            // Best Buy's standard daily call limit per key
+	quotaReserveShare  = 10               // percent of the quota kept for interactive requests
+	quotaFlushInterval = 30 * time.Second // how often call counts are persisted
+)
+
+// Daily quota metrics, so operators see the key running low before Best Buy blocks it
+var (
+	quotaUsed = metrics.NewGauge(
+		"stock_checker_bestbuy_quota_used_calls",
+		"Best Buy API calls made today (UTC) by every process sharing the key.",
+	)
+	quotaRemaining = metrics.NewGauge(
+		"stock_checker_bestbuy_quota_remaining_calls",
+		"Best Buy API calls left in today's (UTC) quota.",
+	)
+)
+
+// QuotaStore persists daily call counts so processes sharing a key share one count
+type QuotaStore interface {
+	// AddAPICalls adds calls to the count for api on day and returns the new total
+	AddAPICalls(ctx context.Context, api string, day time.Time, calls int) (int, error)
+}
+
+// Quota tracks calls against Best Buy's daily quota, which resets at midnight
+// UTC. Once only the reserve is left, requests not marked PriorityInteractive
+// are refused so users can still check stock until the quota resets.
+type Quota struct {
+	limit   int
+	reserve int
+	now     func() time.Time
+
+	mu      sync.Mutex
+	day     time.Time // UTC day being counted
+	used    int       // calls today, as last persisted plus pending
+	pending int       // calls not yet persisted
+}
+
+// NewQuota creates a quota of dailyLimit calls
+func NewQuota(dailyLimit int) *Quota {
+	q := &Quota{limit: dailyLimit, reserve: dailyLimit * quotaReserveShare / 100, now: time.Now}
+	q.day = q.today()
+	q.publish()
+	return q
+}
+
+// today returns the start of the current UTC day
+func (q *Quota) today() time.Time {
+	return q.now().UTC().Truncate(24 * time.Hour)
+}
+
+// rollover starts a new count at midnight. Callers hold mu.
+func (q *Quota) rollover() {
+	if today := q.today(); today.After(q.day) {
+		q.day, q.used, q.pending = today, 0, 0
+	}
+}
+
+// publish updates the quota metrics. Callers hold mu, or own q.
+func (q *Quota) publish() {
+	quotaUsed.Set(float64(q.used))
+	quotaRemaining.Set(float64(max(q.limit-q.used, 0)))
+}
+
+// record counts one call
+func (q *Quota) record() {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	q.used++
+	q.pending++
+	q.publish()
+}
+
+// cost returns the quota calls one request uses: one, or none if untracked
+func (q *Quota) cost() int {
+	if q == nil {
+		return 0
+	}
+	return 1
+}
+
+// exhaust marks the quota used up, as Best Buy says it is
+func (q *Quota) exhaust() {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	q.used = max(q.used, q.limit)
+	q.publish()
+}
+
+// allow refuses non-interactive requests once only the reserve is left, and every
+// request once the quota is used up
+func (q *Quota) allow(ctx context.Context) error {
+	if q == nil {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	left := q.limit - q.used
+	switch {
+	case left <= 0:
+		return &QuotaExceededError{Body: fmt.Sprintf("all %d calls used today", q.limit)}
+	case left <= q.reserve && PriorityOf(ctx) < PriorityInteractive:
+		return &QuotaExceededError{Body: fmt.Sprintf("remaining %d calls are reserved for interactive requests", left)}
+	}
+	return nil
+}
+
+// Remaining returns the calls left today
+func (q *Quota) Remaining() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	return max(q.limit-q.used, 0)
+}
+
+// Limit returns the calls allowed per day
+func (q *Quota) Limit() int {
+	return q.limit
+}
+
+// Pace returns how long a background job running every interval should wait
+// before its next run: the interval while usage keeps pace with the day,
+// longer when calls are running ahead of it, and until midnight UTC once only
+// the reserve is left.
+func (q *Quota) Pace(interval time.Duration) time.Duration {
+	if q == nil {
+		return interval
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.rollover()
+	now := q.now()
+	if q.limit-q.used <= q.reserve {
+		return q.day.Add(24 * time.Hour).Sub(now)
+	}
+
+	// Budget the calls outside the reserve evenly over the day
+	elapsed := float64(now.Sub(q.day)) / float64(24*time.Hour)
+	usedShare := float64(q.used) / float64(q.limit-q.reserve)
+	if usedShare <= elapsed || elapsed == 0 {
+		return interval
+	}
+	return time.Duration(float64(interval) * usedShare / elapsed)
+}
+
+// Run persists the call count to store every 30 seconds until ctx is done,
+// picking up calls made by other processes with the same key
+func (q *Quota) Run(ctx context.Context, store QuotaStore) {
+	ticker := time.NewTicker(quotaFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		q.flush(ctx, store)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			// Save what's been counted since the last flush
+			q.flush(context.WithoutCancel(ctx), store)
+			return
+		}
+	}
+}
+
+// flush adds pending calls to the stored count and adopts the stored total
+func (q *Quota) flush(ctx context.Context, store QuotaStore) {
+	q.mu.Lock()
+	q.rollover()
+	day, calls := q.day, q.pending
+	q.pending = 0
+	q.mu.Unlock()
+
+	total, err := store.AddAPICalls(ctx, "bestbuy", day, calls)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.day.Equal(day) {
+		return // midnight passed; yesterday's count no longer matters
+	}
+	if err != nil {
+		log.Printf("Failed to save Best Buy quota usage: %v", err)
+		q.pending += calls
+		return
+	}
+	q.used = max(q.used, total+q.pending)
+	q.publish()
+}
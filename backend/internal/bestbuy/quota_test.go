@@ -0,0 +1,149 @@
+package bestbuy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newTestQuota returns a quota whose clock reads *now
+func newTestQuota(limit int, now *time.Time) *Quota {
+	q := NewQuota(limit)
+	q.now = func() time.Time { return *now }
+	q.day = q.today()
+	return q
+}
+
+func TestQuotaKeepsReserveForInteractiveRequests(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	q := newTestQuota(100, &now)
+	ctx := context.Background()
+
+	for range 90 {
+		q.record()
+	}
+	var quotaErr *QuotaExceededError
+	if err := q.allow(ctx); !errors.As(err, &quotaErr) {
+		t.Errorf("background request allowed with only the reserve left: %v", err)
+	}
+	if err := q.allow(WithHighPriority(ctx)); err != nil {
+		t.Errorf("interactive request refused with the reserve left: %v", err)
+	}
+	if err := q.allow(WithPriority(ctx, PriorityInteractive)); err != nil {
+		t.Errorf("RPC request refused with the reserve left: %v", err)
+	}
+
+	q.exhaust()
+	if err := q.allow(WithHighPriority(ctx)); !errors.As(err, &quotaErr) {
+		t.Errorf("interactive request allowed with the quota used up: %v", err)
+	}
+
+	// Midnight UTC starts a new day
+	now = now.Add(12 * time.Hour)
+	if err := q.allow(ctx); err != nil || q.Remaining() != 100 {
+		t.Errorf("after midnight: allow = %v, remaining %d; want a fresh quota", err, q.Remaining())
+	}
+}
+
+func TestQuotaPace(t *testing.T) {
+	now := time.Date(2026, 10, 16, 6, 0, 0, 0, time.UTC) // a quarter of the day gone
+	q := newTestQuota(1000, &now)
+	interval := 5 * time.Minute
+
+	// 900 calls outside the reserve; a quarter is 225
+	for range 200 {
+		q.record()
+	}
+	if got := q.Pace(interval); got != interval {
+		t.Errorf("Pace on budget = %v, want %v", got, interval)
+	}
+
+	for range 250 {
+		q.record()
+	}
+	if got := q.Pace(interval); got != 2*interval {
+		t.Errorf("Pace at twice the budget = %v, want %v", got, 2*interval)
+	}
+
+	for range 450 {
+		q.record()
+	}
+	if got := q.Pace(interval); got != 18*time.Hour {
+		t.Errorf("Pace with only the reserve left = %v, want until midnight", got)
+	}
+}
+
+// fakeQuotaStore holds one shared count
+type fakeQuotaStore struct {
+	calls int
+	err   error
+}
+
+func (s *fakeQuotaStore) AddAPICalls(ctx context.Context, api string, day time.Time, calls int) (int, error) {
+	if s.err != nil {
+		return 0, s.err
+	}
+	s.calls += calls
+	return s.calls, nil
+}
+
+func TestQuotaFlushSharesCount(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	q := newTestQuota(100, &now)
+	store := &fakeQuotaStore{calls: 40} // made by another process
+	ctx := context.Background()
+
+	for range 5 {
+		q.record()
+	}
+	q.flush(ctx, store)
+	if store.calls != 45 || q.Remaining() != 55 {
+		t.Errorf("stored %d calls, %d remaining; want 45 and 55", store.calls, q.Remaining())
+	}
+
+	// Failed flushes keep their calls for the next one
+	store.err = errors.New("connection refused")
+	q.record()
+	q.flush(ctx, store)
+	store.err = nil
+	q.flush(ctx, store)
+	if store.calls != 46 {
+		t.Errorf("stored %d calls, want 46", store.calls)
+	}
+}
+
+func TestAPIClientCountsCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("show") == "over" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errorCode":"403","errorMessage":"Over Rate Limit per day"}`))
+			return
+		}
+		w.Write([]byte(`{"stores":[]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.limiter = nil
+	q := NewQuota(1000)
+	c.SetQuota(q)
+
+	if _, err := c.SearchStores(context.Background(), "94103", 25); err != nil {
+		t.Fatal(err)
+	}
+	if q.Remaining() != 999 {
+		t.Errorf("remaining = %d after one call, want 999", q.Remaining())
+	}
+
+	// Best Buy saying the quota is gone overrides the local count
+	if _, err := c.doRequest(context.Background(), srv.URL+"/v1/stores?show=over"); err == nil {
+		t.Fatal("expected a quota error")
+	}
+	if q.Remaining() != 0 {
+		t.Errorf("remaining = %d after Best Buy refused the key, want 0", q.Remaining())
+	}
+}
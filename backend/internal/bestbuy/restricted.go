@@ -0,0 +1,46 @@
+package bestbuy
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultRestrictedTTL is how long a SKU whose availability was refused is
+// assumed to still be restricted
+const DefaultRestrictedTTL = 6 * time.Hour
+
+// restrictedSKUs remembers SKUs the API refused availability for, so
+// CheckAvailability doesn't spend quota asking again until ttl passes
+type restrictedSKUs struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu    sync.Mutex
+	until map[string]time.Time
+}
+
+func newRestrictedSKUs(ttl time.Duration) *restrictedSKUs {
+	return &restrictedSKUs{ttl: ttl, now: time.Now, until: make(map[string]time.Time)}
+}
+
+// add remembers sku as restricted for the TTL
+func (r *restrictedSKUs) add(sku string) {
+	if r.ttl <= 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.until[sku] = r.now().Add(r.ttl)
+}
+
+// has reports whether sku was restricted within the TTL, forgetting it once expired
+func (r *restrictedSKUs) has(sku string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	until, ok := r.until[sku]
+	if ok && !r.now().Before(until) {
+		delete(r.until, sku)
+		return false
+	}
+	return ok
+}
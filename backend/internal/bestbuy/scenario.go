@@ -0,0 +1,158 @@
+package bestbuy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Scenario stock statuses
+const (
+	ScenarioInStock    = "in_stock"
+	ScenarioLowStock   = "low_stock"
+	ScenarioOutOfStock = "out_of_stock"
+)
+
+// ScenarioEvent changes a product's stock at a store at a time relative to the scenario start
+type ScenarioEvent struct {
+	At     time.Duration `yaml:"at"`
+	SKU    string        `yaml:"sku"`
+	Store  string        `yaml:"store"`
+	Status string        `yaml:"status"`
+}
+
+// Scenario is a scripted sequence of stock changes for the mock client.
+// SKUs named in the scenario are out of stock everywhere until an event says
+// otherwise; every other SKU keeps the mock's usual availability.
+type Scenario struct {
+	Name   string          `yaml:"name"`
+	Events []ScenarioEvent `yaml:"events"`
+}
+
+// LoadScenario reads and validates a scenario YAML file
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario: %w", err)
+	}
+
+	var s Scenario
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario %s: %w", path, err)
+	}
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %w", path, err)
+	}
+
+	sort.SliceStable(s.Events, func(i, j int) bool { return s.Events[i].At < s.Events[j].At })
+	return &s, nil
+}
+
+// validate checks that every event refers to a mock product and store
+func (s *Scenario) validate() error {
+	for i, e := range s.Events {
+		if e.At < 0 {
+			return fmt.Errorf("event %d: negative time %v", i+1, e.At)
+		}
+		if mockProduct(e.SKU) == nil {
+			return fmt.Errorf("event %d: unknown mock SKU %q", i+1, e.SKU)
+		}
+		if mockStore(e.Store) == nil {
+			return fmt.Errorf("event %d: unknown mock store %q", i+1, e.Store)
+		}
+		switch e.Status {
+		case ScenarioInStock, ScenarioLowStock, ScenarioOutOfStock:
+		default:
+			return fmt.Errorf("event %d: unknown status %q", i+1, e.Status)
+		}
+	}
+	return nil
+}
+
+// mockProduct finds a mock product by SKU
+func mockProduct(sku string) *Product {
+	for i := range mockProducts {
+		if fmt.Sprintf("%d", mockProducts[i].SKU) == sku {
+			return &mockProducts[i]
+		}
+	}
+	return nil
+}
+
+// mockStore finds a mock store by ID
+func mockStore(storeID string) *Store {
+	for i := range mockStores {
+		if fmt.Sprintf("%d", mockStores[i].StoreID) == storeID {
+			return &mockStores[i]
+		}
+	}
+	return nil
+}
+
+// ScenarioClient is a MockClient whose availability follows a scenario
+type ScenarioClient struct {
+	*MockClient
+	scenario *Scenario
+	start    time.Time
+}
+
+// NewScenarioClient creates a ScenarioClient. The scenario clock starts now.
+func NewScenarioClient(mock *MockClient, scenario *Scenario) *ScenarioClient {
+	return &ScenarioClient{
+		MockClient: mock,
+		scenario:   scenario,
+		start:      time.Now(),
+	}
+}
+
+// CheckAvailability returns the scripted availability for scenario SKUs at the current scenario time
+func (c *ScenarioClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]StoreAvailability, error) {
+	elapsed := time.Since(c.start)
+
+	scripted := false
+	statuses := make(map[string]string)
+	for _, e := range c.scenario.Events {
+		if e.SKU != sku {
+			continue
+		}
+		scripted = true
+		if e.At <= elapsed {
+			statuses[e.Store] = e.Status
+		}
+	}
+	if !scripted {
+		return c.MockClient.CheckAvailability(ctx, sku, postalCode)
+	}
+
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	// Only add stores that have stock (like the real API)
+	availability := make([]StoreAvailability, 0)
+	for _, store := range mockStores {
+		storeID := fmt.Sprintf("%d", store.StoreID)
+		status := statuses[storeID]
+		if status != ScenarioInStock && status != ScenarioLowStock {
+			continue
+		}
+		availability = append(availability, StoreAvailability{
+			StoreID:        storeID,
+			StoreName:      store.Name,
+			City:           store.City,
+			State:          store.State,
+			Distance:       store.Distance,
+			InStock:        true,
+			LowStock:       status == ScenarioLowStock,
+			PickupEligible: true,
+		})
+	}
+
+	log.Printf("Scenario %q at T+%v: SKU %s in stock at %d stores", c.scenario.Name, elapsed.Truncate(time.Second), sku, len(availability))
+	return availability, nil
+}
@@ -0,0 +1,108 @@
+package bestbuy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+)
+
+// Throttle defaults
+const (
+	DefaultMinInterval     = 200 * time.Millisecond // Best Buy's limit of 5 calls per second
+	DefaultMaxInterval     = 5 * time.Second
+	defaultStartInterval   = 350 * time.Millisecond // the fixed interval used before tuning
+	throttleSpeedUpAfter   = 20                     // successes in a row before calls speed up
+	throttleSpeedUpStep    = 10 * time.Millisecond
+	throttleLatencyWeight  = 0.2 // weight of each new latency in the moving average
+	throttleConcurrencyGap = 1   // checks kept in flight beyond those the interval can start
+)
+
+// Throttle metrics, so operators see the pace calls settled on
+var (
+	throttleInterval = metrics.NewGauge(
+		"stock_checker_bestbuy_request_interval_seconds",
+		"Current time between Best Buy API calls, tuned from rate limit responses.",
+	)
+	throttleRateLimited = metrics.NewCounter(
+		"stock_checker_bestbuy_rate_limited_total",
+		"Best Buy API calls refused for going over the per-second limit.",
+	)
+)
+
+// Throttle tunes the time between calls to the highest rate the API accepts:
+// it halves the rate whenever a call is refused for going too fast, and
+// speeds up a little after every run of accepted calls. It also tracks call
+// latency so callers can tell how many requests to keep in flight.
+type Throttle struct {
+	min, max time.Duration
+
+	mu        sync.Mutex
+	interval  time.Duration
+	successes int           // accepted calls since the last change
+	latency   time.Duration // moving average of accepted calls
+}
+
+// NewThrottle creates a throttle that keeps the time between calls between
+// fastest and slowest
+func NewThrottle(fastest, slowest time.Duration) *Throttle {
+	t := &Throttle{min: fastest, max: slowest, interval: min(max(defaultStartInterval, fastest), slowest)}
+	throttleInterval.Set(t.interval.Seconds())
+	return t
+}
+
+// Interval returns the time to leave between calls
+func (t *Throttle) Interval() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.interval
+}
+
+// rateLimited slows calls down after the API refused one for going too fast
+func (t *Throttle) rateLimited() {
+	if t == nil {
+		return
+	}
+	throttleRateLimited.Inc()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.interval = min(t.interval*2, t.max)
+	t.successes = 0
+	throttleInterval.Set(t.interval.Seconds())
+}
+
+// succeeded counts an accepted call, speeding calls up after enough in a row
+func (t *Throttle) succeeded(latency time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.latency == 0 {
+		t.latency = latency
+	} else {
+		t.latency += time.Duration(throttleLatencyWeight * float64(latency-t.latency))
+	}
+
+	t.successes++
+	if t.successes >= throttleSpeedUpAfter && t.interval > t.min {
+		t.interval = max(t.interval-throttleSpeedUpStep, t.min)
+		t.successes = 0
+		throttleInterval.Set(t.interval.Seconds())
+	}
+}
+
+// Concurrency returns how many calls to keep in flight, at most limit: enough
+// that one is always ready when the interval lets the next call go
+func (t *Throttle) Concurrency(limit int) int {
+	if t == nil {
+		return 1
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	n := int(t.latency/t.interval) + throttleConcurrencyGap
+	return min(max(n, 1), limit)
+}
@@ -0,0 +1,55 @@
+package bestbuy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestThrottleBacksOffAndRecovers(t *testing.T) {
+	th := NewThrottle(200*time.Millisecond, time.Second)
+	if got := th.Interval(); got != defaultStartInterval {
+		t.Fatalf("starts at %v, want %v", got, defaultStartInterval)
+	}
+
+	th.rateLimited()
+	if got := th.Interval(); got != 2*defaultStartInterval {
+		t.Errorf("after a rate limit: %v, want %v", got, 2*defaultStartInterval)
+	}
+	th.rateLimited()
+	th.rateLimited()
+	if got := th.Interval(); got != time.Second {
+		t.Errorf("after repeated rate limits: %v, want the 1s ceiling", got)
+	}
+
+	// Each run of successes speeds calls up one step, down to the floor
+	for range throttleSpeedUpAfter {
+		th.succeeded(100 * time.Millisecond)
+	}
+	if got := th.Interval(); got != time.Second-throttleSpeedUpStep {
+		t.Errorf("after %d successes: %v, want one step faster", throttleSpeedUpAfter, got)
+	}
+	for range 1000 * throttleSpeedUpAfter {
+		th.succeeded(100 * time.Millisecond)
+	}
+	if got := th.Interval(); got != 200*time.Millisecond {
+		t.Errorf("after many successes: %v, want the 200ms floor", got)
+	}
+}
+
+func TestThrottleConcurrencyCoversLatency(t *testing.T) {
+	th := NewThrottle(200*time.Millisecond, time.Second)
+	for range 1000 * throttleSpeedUpAfter {
+		th.succeeded(time.Second) // five intervals
+	}
+	if got := th.Concurrency(8); got != 6 {
+		t.Errorf("concurrency = %d, want 6 to keep a call ready every interval", got)
+	}
+	if got := th.Concurrency(3); got != 3 {
+		t.Errorf("concurrency = %d, want the limit of 3", got)
+	}
+
+	var unset *Throttle
+	if got := unset.Concurrency(8); got != 1 {
+		t.Errorf("nil throttle concurrency = %d, want 1", got)
+	}
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+)
+
+// TTLs are how long each kind of Best Buy response is cached. A zero TTL
+// disables caching for that call.
+type TTLs struct {
+	Stores   time.Duration // SearchStores
+	Products time.Duration // SearchProducts
+	Product  time.Duration // GetProductBySKU
+}
+
+// DefaultTTLs suit stores rarely changing and prices changing a few times a day
+var DefaultTTLs = TTLs{
+	Stores:   24 * time.Hour,
+	Products: 15 * time.Minute,
+	Product:  time.Hour,
+}
+
+// BestBuyClient wraps a Best Buy client and caches store searches, product
+// searches and product lookups in a Store shared by every user (and, with
+// Redis, every process). Availability is never cached. High-priority
+// requests skip the cache but still refresh it.
+type BestBuyClient struct {
+	bestbuy.Client
+	store Store
+	ttls  TTLs
+}
+
+// NewBestBuyClient creates a caching Best Buy client
+func NewBestBuyClient(client bestbuy.Client, store Store, ttls TTLs) *BestBuyClient {
+	return &BestBuyClient{Client: client, store: store, ttls: ttls}
+}
+
+// cached answers from the store under key if possible, and otherwise calls
+// fetch and stores its result for ttl. Store failures only cost a cache miss.
+func cached[T any](ctx context.Context, store Store, key string, ttl time.Duration, fetch func() (T, error)) (T, error) {
+	if ttl <= 0 {
+		return fetch()
+	}
+
+	if !bestbuy.IsHighPriority(ctx) {
+		if data, ok, err := store.Get(ctx, key); err != nil {
+			log.Printf("Cache: failed to read %s: %v", key, err)
+		} else if ok {
+			var v T
+			if err := json.Unmarshal(data, &v); err == nil {
+				return v, nil
+			}
+		}
+	}
+
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	if data, err := json.Marshal(v); err == nil {
+		if err := store.Set(ctx, key, data, ttl); err != nil {
+			log.Printf("Cache: failed to write %s: %v", key, err)
+		}
+	}
+	return v, nil
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *BestBuyClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]bestbuy.Store, error) {
+	key := fmt.Sprintf("bestbuy:stores:%s:%d", postalCode, radiusMiles)
+	return cached(ctx, c.store, key, c.ttls.Stores, func() ([]bestbuy.Store, error) {
+		return c.Client.SearchStores(ctx, postalCode, radiusMiles)
+	})
+}
+
+// SearchProducts returns a page of products matching a keyword, optionally filtered by subclass
+func (c *BestBuyClient) SearchProducts(ctx context.Context, query string, subclass string, page bestbuy.PageRequest) (*bestbuy.ProductPage, error) {
+	key := fmt.Sprintf("bestbuy:products:%s:%s:%d:%d", subclass, strings.ToLower(strings.TrimSpace(query)), page.Page, page.Size)
+	return cached(ctx, c.store, key, c.ttls.Products, func() (*bestbuy.ProductPage, error) {
+		return c.Client.SearchProducts(ctx, query, subclass, page)
+	})
+}
+
+// GetProductBySKU gets a single product by its SKU
+func (c *BestBuyClient) GetProductBySKU(ctx context.Context, sku string) (*bestbuy.Product, error) {
+	return cached(ctx, c.store, "bestbuy:product:"+sku, c.ttls.Product, func() (*bestbuy.Product, error) {
+		return c.Client.GetProductBySKU(ctx, sku)
+	})
+}
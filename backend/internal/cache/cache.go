@@ -0,0 +1,141 @@
+// Package cache stores API responses shared between users and processes, in
+// Redis when one is configured and in memory otherwise, so identical
+// retailer queries are answered once per TTL instead of once per user.
+package cache
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Store is a key-value store with per-entry expiry
+type Store interface {
+	// Get returns the value stored under key, and false if there is none or it expired
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value under key for ttl
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// New returns a Redis store for redisURL that falls back to memory while
+// Redis is unreachable, or just a memory store if redisURL is empty
+func New(redisURL string) (Store, error) {
+	if redisURL == "" {
+		return NewMemory(DefaultMaxEntries), nil
+	}
+	redis, err := NewRedis(redisURL)
+	if err != nil {
+		return nil, err
+	}
+	return &fallbackStore{primary: redis, fallback: NewMemory(DefaultMaxEntries)}, nil
+}
+
+// DefaultMaxEntries bounds the memory store unless configured otherwise
+const DefaultMaxEntries = 5000
+
+// memoryEntry is a stored value and when it expires
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// Memory is an in-process Store. When full, expired entries are dropped
+// first, then the entry closest to expiring.
+type Memory struct {
+	mu         sync.Mutex
+	entries    map[string]memoryEntry
+	maxEntries int
+	now        func() time.Time
+}
+
+// NewMemory creates a memory store holding at most maxEntries values
+func NewMemory(maxEntries int) *Memory {
+	return &Memory{entries: make(map[string]memoryEntry), maxEntries: maxEntries, now: time.Now}
+}
+
+// Get returns the value stored under key
+func (m *Memory) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok || !m.now().Before(e.expires) {
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+// Set stores value under key for ttl
+func (m *Memory) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if _, ok := m.entries[key]; !ok && len(m.entries) >= m.maxEntries {
+		m.evict(now)
+	}
+	m.entries[key] = memoryEntry{value: value, expires: now.Add(ttl)}
+	return nil
+}
+
+// evict makes room for one entry. Callers hold mu.
+func (m *Memory) evict(now time.Time) {
+	var oldest string
+	var oldestExpiry time.Time
+	for k, e := range m.entries {
+		if !now.Before(e.expires) {
+			delete(m.entries, k)
+			continue
+		}
+		if oldest == "" || e.expires.Before(oldestExpiry) {
+			oldest, oldestExpiry = k, e.expires
+		}
+	}
+	if len(m.entries) >= m.maxEntries {
+		delete(m.entries, oldest)
+	}
+}
+
+// fallbackStore uses primary, and fallback for calls primary fails
+type fallbackStore struct {
+	primary  Store
+	fallback Store
+
+	mu      sync.Mutex
+	failing bool // primary's last call failed; logged once per outage
+}
+
+// Get reads from the primary store, or the fallback if it fails
+func (s *fallbackStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, ok, err := s.primary.Get(ctx, key)
+	if s.observe(err) {
+		return s.fallback.Get(ctx, key)
+	}
+	return value, ok, nil
+}
+
+// Set writes to the primary store, or the fallback if it fails
+func (s *fallbackStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	err := s.primary.Set(ctx, key, value, ttl)
+	if s.observe(err) {
+		return s.fallback.Set(ctx, key, value, ttl)
+	}
+	return nil
+}
+
+// observe logs when the primary store starts and stops failing, and reports
+// whether err means the fallback should be used
+func (s *fallbackStore) observe(err error) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil && !s.failing {
+		log.Printf("Cache: Redis unavailable, using in-memory cache: %v", err)
+	} else if err == nil && s.failing {
+		log.Println("Cache: Redis is back")
+	}
+	s.failing = err != nil
+	return err != nil
+}
@@ -0,0 +1,209 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+)
+
+func TestMemoryExpiryAndEviction(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := NewMemory(2)
+	m.now = func() time.Time { return now }
+
+	m.Set(ctx, "a", []byte("1"), time.Minute)
+	m.Set(ctx, "b", []byte("2"), time.Hour)
+	if v, ok, _ := m.Get(ctx, "a"); !ok || string(v) != "1" {
+		t.Fatalf("Get(a) = %q, %v; want 1", v, ok)
+	}
+
+	// Full: the entry closest to expiring makes room
+	m.Set(ctx, "c", []byte("3"), time.Hour)
+	if _, ok, _ := m.Get(ctx, "a"); ok {
+		t.Error("a should have been evicted")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, ok, _ := m.Get(ctx, "b"); ok {
+		t.Error("b should have expired")
+	}
+}
+
+// countingClient counts product lookups
+type countingClient struct {
+	bestbuy.Client
+	mu      sync.Mutex
+	lookups int
+}
+
+func (c *countingClient) GetProductBySKU(ctx context.Context, sku string) (*bestbuy.Product, error) {
+	c.mu.Lock()
+	c.lookups++
+	c.mu.Unlock()
+	if sku == "0" {
+		return nil, &bestbuy.NotFoundError{Body: "not found"}
+	}
+	return &bestbuy.Product{SKU: 6579543, Name: "Prismatic Evolutions Elite Trainer Box", SalePrice: 4999}, nil
+}
+
+func TestBestBuyClientSharesLookups(t *testing.T) {
+	ctx := context.Background()
+	upstream := &countingClient{}
+	c := NewBestBuyClient(upstream, NewMemory(10), DefaultTTLs)
+
+	for range 3 {
+		p, err := c.GetProductBySKU(ctx, "6579543")
+		if err != nil || p.SalePrice != 4999 {
+			t.Fatalf("GetProductBySKU = %+v, %v", p, err)
+		}
+	}
+	if upstream.lookups != 1 {
+		t.Errorf("%d upstream lookups, want 1", upstream.lookups)
+	}
+
+	// Interactive requests refresh the cache
+	c.GetProductBySKU(bestbuy.WithHighPriority(ctx), "6579543")
+	if upstream.lookups != 2 {
+		t.Errorf("%d upstream lookups, want a high-priority request to skip the cache", upstream.lookups)
+	}
+
+	// Errors aren't cached
+	c.GetProductBySKU(ctx, "0")
+	c.GetProductBySKU(ctx, "0")
+	if upstream.lookups != 4 {
+		t.Errorf("%d upstream lookups, want failed lookups retried", upstream.lookups)
+	}
+}
+
+// brokenStore fails every call
+type brokenStore struct{}
+
+func (brokenStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return nil, false, errors.New("connection refused")
+}
+
+func (brokenStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return errors.New("connection refused")
+}
+
+func TestFallbackStore(t *testing.T) {
+	ctx := context.Background()
+	s := &fallbackStore{primary: brokenStore{}, fallback: NewMemory(10)}
+
+	if err := s.Set(ctx, "k", []byte("v"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok, err := s.Get(ctx, "k"); err != nil || !ok || string(v) != "v" {
+		t.Errorf("Get = %q, %v, %v; want the value from memory", v, ok, err)
+	}
+}
+
+// serveRedis runs a server that answers GET and SET from a map, and returns its URL
+func serveRedis(t *testing.T) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	var mu sync.Mutex
+	data := make(map[string]string)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				for {
+					args, err := readCommand(r)
+					if err != nil {
+						return
+					}
+					mu.Lock()
+					switch strings.ToUpper(args[0]) {
+					case "SET":
+						data[args[1]] = args[2]
+						io.WriteString(conn, "+OK\r\n")
+					case "GET":
+						if v, ok := data[args[1]]; ok {
+							io.WriteString(conn, "$"+strconv.Itoa(len(v))+"\r\n"+v+"\r\n")
+						} else {
+							io.WriteString(conn, "$-1\r\n")
+						}
+					default:
+						io.WriteString(conn, "-ERR unknown command\r\n")
+					}
+					mu.Unlock()
+				}
+			}()
+		}
+	}()
+	return "redis://" + ln.Addr().String()
+}
+
+// readCommand reads a RESP array of bulk strings
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+	args := make([]string, n)
+	for i := range args {
+		if _, err := r.ReadString('\n'); err != nil { // $len
+			return nil, err
+		}
+		arg, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
+
+func TestRedis(t *testing.T) {
+	ctx := context.Background()
+	r, err := NewRedis(serveRedis(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok, err := r.Get(ctx, "missing"); ok || err != nil {
+		t.Errorf("Get(missing) = %v, %v; want a miss", ok, err)
+	}
+	if err := r.Set(ctx, "k", []byte(`{"sku":6579543}`), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok, err := r.Get(ctx, "k"); err != nil || !ok || string(v) != `{"sku":6579543}` {
+		t.Errorf("Get(k) = %q, %v, %v", v, ok, err)
+	}
+}
+
+func TestNewRedisURL(t *testing.T) {
+	r, err := NewRedis("redis://:secret@cache.internal/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.addr != "cache.internal:6379" || r.password != "secret" || r.db != 2 {
+		t.Errorf("parsed %+v", r)
+	}
+	if _, err := NewRedis("http://cache.internal"); err == nil {
+		t.Error("NewRedis accepted an http URL")
+	}
+}
@@ -0,0 +1,184 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Redis is a Store backed by a Redis server, speaking just enough of the
+// RESP protocol for GET and SET with an expiry
+type Redis struct {
+	addr     string
+	password string
+	db       int
+	timeout  time.Duration
+	conns    chan *redisConn // idle connections
+}
+
+// maxIdleConns is how many connections Redis keeps open between calls
+const maxIdleConns = 8
+
+// NewRedis creates a Redis store from a redis://[:password@]host:port[/db] URL.
+// Connections are opened on first use.
+func NewRedis(rawURL string) (*Redis, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "redis" || u.Host == "" {
+		return nil, fmt.Errorf("invalid Redis URL %q (want redis://[:password@]host:port[/db])", rawURL)
+	}
+
+	r := &Redis{addr: u.Host, timeout: 2 * time.Second, conns: make(chan *redisConn, maxIdleConns)}
+	if !strings.Contains(r.addr, ":") {
+		r.addr += ":6379"
+	}
+	if u.User != nil {
+		r.password, _ = u.User.Password()
+	}
+	if path := strings.TrimPrefix(u.Path, "/"); path != "" {
+		if r.db, err = strconv.Atoi(path); err != nil {
+			return nil, fmt.Errorf("invalid Redis database %q", path)
+		}
+	}
+	return r, nil
+}
+
+// redisConn is a connection with buffered reads
+type redisConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+// Get returns the value stored under key
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := r.do(ctx, "GET", key)
+	if err != nil {
+		return nil, false, err
+	}
+	if reply == nil {
+		return nil, false, nil
+	}
+	return reply, true, nil
+}
+
+// Set stores value under key for ttl
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := r.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+// do sends a command on an idle or new connection and returns the reply.
+// Connections that fail are closed instead of being reused.
+func (r *Redis) do(ctx context.Context, args ...string) ([]byte, error) {
+	conn, err := r.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.command(ctx, r.timeout, args...)
+	var redisErr redisError
+	if err != nil && !errors.As(err, &redisErr) {
+		conn.Close()
+		return nil, err
+	}
+
+	select {
+	case r.conns <- conn:
+	default:
+		conn.Close()
+	}
+	return reply, err
+}
+
+// conn returns an idle connection, or dials and authenticates a new one
+func (r *Redis) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case conn := <-r.conns:
+		return conn, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: r.timeout}
+	c, err := dialer.DialContext(ctx, "tcp", r.addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
+	}
+	conn := &redisConn{Conn: c, reader: bufio.NewReader(c)}
+
+	if r.password != "" {
+		if _, err := conn.command(ctx, r.timeout, "AUTH", r.password); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to authenticate with Redis: %w", err)
+		}
+	}
+	if r.db != 0 {
+		if _, err := conn.command(ctx, r.timeout, "SELECT", strconv.Itoa(r.db)); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to select Redis database %d: %w", r.db, err)
+		}
+	}
+	return conn, nil
+}
+
+// redisError is an error reply from the server; the connection is still usable
+type redisError string
+
+func (e redisError) Error() string {
+	return "Redis error: " + string(e)
+}
+
+// command writes args as a RESP array and reads one reply. Bulk string
+// replies are returned as is, simple strings as their text and nil as nil.
+func (c *redisConn) command(ctx context.Context, timeout time.Duration, args ...string) ([]byte, error) {
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	c.SetDeadline(deadline)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if _, err := io.WriteString(c, b.String()); err != nil {
+		return nil, fmt.Errorf("failed to write to Redis: %w", err)
+	}
+
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from Redis: %w", err)
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("empty reply from Redis")
+	}
+
+	switch line[0] {
+	case '+', ':':
+		return []byte(line[1:]), nil
+	case '-':
+		return nil, redisError(line[1:])
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid bulk length from Redis: %q", line)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		data := make([]byte, n+2) // value and trailing CRLF
+		if _, err := io.ReadFull(c.reader, data); err != nil {
+			return nil, fmt.Errorf("failed to read from Redis: %w", err)
+		}
+		return data[:n], nil
+	default:
+		return nil, fmt.Errorf("unexpected reply from Redis: %q", line)
+	}
+}
@@ -0,0 +1,99 @@
+// Package chaos injects retailer failures into a single request, chosen by
+// its X-Chaos header, so the frontend's error states can be exercised against
+// a running backend. It must only be enabled outside production.
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Header is the request header that selects faults, e.g.
+//
+//	X-Chaos: latency=2s, rate_limit
+//	X-Chaos: restricted=6505997
+const Header = "X-Chaos"
+
+// maxLatency caps injected latency so a typo can't hang a request
+const maxLatency = 30 * time.Second
+
+// Fault is the set of failures injected into one request's retailer calls
+type Fault struct {
+	Latency    time.Duration   // added before every call
+	RateLimit  bool            // every call fails as rate limited
+	Restricted bool            // availability checks fail as restricted
+	SKUs       map[string]bool // limits Restricted to these SKUs; all if empty
+}
+
+// restricts reports whether availability for sku should fail as restricted
+func (f *Fault) restricts(sku string) bool {
+	return f.Restricted && (len(f.SKUs) == 0 || f.SKUs[sku])
+}
+
+// Parse parses an X-Chaos header value: comma-separated faults, each
+// "latency=<duration>", "rate_limit" or "restricted[=<sku>]"
+func Parse(value string) (*Fault, error) {
+	f := &Fault{}
+	for _, part := range strings.Split(value, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch name {
+		case "":
+		case "latency":
+			d, err := time.ParseDuration(arg)
+			if err != nil || d < 0 {
+				return nil, fmt.Errorf("invalid latency %q", arg)
+			}
+			f.Latency = min(d, maxLatency)
+		case "rate_limit":
+			f.RateLimit = true
+		case "restricted":
+			f.Restricted = true
+			if arg != "" {
+				if f.SKUs == nil {
+					f.SKUs = make(map[string]bool)
+				}
+				f.SKUs[arg] = true
+			}
+		default:
+			return nil, fmt.Errorf("unknown fault %q", name)
+		}
+	}
+	return f, nil
+}
+
+// faultKey is the context key for a request's fault
+type faultKey struct{}
+
+// WithFault returns a context whose retailer calls fail as f describes
+func WithFault(ctx context.Context, f *Fault) context.Context {
+	return context.WithValue(ctx, faultKey{}, f)
+}
+
+// FromContext returns the fault for ctx, or nil if none was requested
+func FromContext(ctx context.Context) *Fault {
+	f, _ := ctx.Value(faultKey{}).(*Fault)
+	return f
+}
+
+// Middleware attaches the fault described by a request's X-Chaos header to its
+// context. Requests with an invalid header are rejected so typos aren't
+// mistaken for a healthy backend.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := r.Header.Get(Header)
+		if value == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		f, err := Parse(value)
+		if err != nil {
+			http.Error(w, "invalid "+Header+" header: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithFault(r.Context(), f)))
+	})
+}
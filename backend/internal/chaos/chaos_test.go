@@ -0,0 +1,88 @@
+package chaos
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+)
+
+func TestParse(t *testing.T) {
+	f, err := Parse("latency=2s, rate_limit, restricted=6579543")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if f.Latency != 2*time.Second || !f.RateLimit || !f.restricts("6579543") || f.restricts("6579544") {
+		t.Errorf("fault = %+v", f)
+	}
+
+	f, err = Parse("latency=1h")
+	if err != nil || f.Latency != maxLatency {
+		t.Errorf("latency not capped: %+v, %v", f, err)
+	}
+
+	f, err = Parse("restricted")
+	if err != nil || !f.restricts("6579543") {
+		t.Errorf("bare restricted should apply to every SKU: %+v, %v", f, err)
+	}
+
+	for _, value := range []string{"latency=soon", "latency=-1s", "timeout"} {
+		if _, err := Parse(value); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", value)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var got *Fault
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if got != nil {
+		t.Errorf("fault without header: %+v", got)
+	}
+
+	req.Header.Set(Header, "rate_limit")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if got == nil || !got.RateLimit {
+		t.Errorf("fault = %+v, want rate limit", got)
+	}
+
+	req.Header.Set(Header, "explode")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid header status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestClient(t *testing.T) {
+	client := NewClient(bestbuy.NewMockClientWithLatency(0))
+	ctx := context.Background()
+
+	if _, err := client.GetProductBySKU(ctx, "6579543"); err != nil {
+		t.Fatalf("no fault: %v", err)
+	}
+
+	var rateLimited *bestbuy.RateLimitError
+	_, err := client.SearchStores(WithFault(ctx, &Fault{RateLimit: true}), "94103", 25)
+	if !errors.As(err, &rateLimited) {
+		t.Errorf("rate limit fault: err = %v", err)
+	}
+
+	var restricted *bestbuy.RestrictedError
+	fault := &Fault{Restricted: true, SKUs: map[string]bool{"6579543": true}}
+	if _, err := client.CheckAvailability(WithFault(ctx, fault), "6579543", "94103"); !errors.As(err, &restricted) {
+		t.Errorf("restricted SKU: err = %v", err)
+	}
+	if _, err := client.CheckAvailability(WithFault(ctx, fault), "6579544", "94103"); err != nil {
+		t.Errorf("other SKU: %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package chaos
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// retryAfter is the wait reported by injected rate limits
+const retryAfter = 30 * time.Second
+
+// inject applies the request's latency and rate limit faults before a call
+func inject(ctx context.Context) error {
+	f := FromContext(ctx)
+	if f == nil {
+		return nil
+	}
+
+	if f.Latency > 0 {
+		select {
+		case <-time.After(f.Latency):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if f.RateLimit {
+		return &bestbuy.RateLimitError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// restricted returns the error the API gives for a restricted SKU, if the
+// request asked for sku to be restricted
+func restricted(ctx context.Context, sku string) error {
+	if f := FromContext(ctx); f != nil && f.restricts(sku) {
+		return &bestbuy.RestrictedError{
+			SKU: sku,
+			Err: &bestbuy.ForbiddenError{Body: "injected by " + Header},
+		}
+	}
+	return nil
+}
+
+// Client wraps a Best Buy client with the faults requested for each call's context
+type Client struct {
+	bestbuy.Client
+}
+
+// NewClient wraps client so requests carrying a fault fail as it describes
+func NewClient(client bestbuy.Client) *Client {
+	return &Client{Client: client}
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *Client) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]bestbuy.Store, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.Client.SearchStores(ctx, postalCode, radiusMiles)
+}
+
+// SearchProducts returns a page of products matching a keyword, optionally filtered by subclass
+func (c *Client) SearchProducts(ctx context.Context, query string, subclass string, page bestbuy.PageRequest) (*bestbuy.ProductPage, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.Client.SearchProducts(ctx, query, subclass, page)
+}
+
+// SearchProductsInCategory returns a page of products within a category
+func (c *Client) SearchProductsInCategory(ctx context.Context, categoryID string, query string, page bestbuy.PageRequest) (*bestbuy.ProductPage, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.Client.SearchProductsInCategory(ctx, categoryID, query, page)
+}
+
+// GetProductBySKU gets a single product by its SKU
+func (c *Client) GetProductBySKU(ctx context.Context, sku string) (*bestbuy.Product, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.Client.GetProductBySKU(ctx, sku)
+}
+
+// CheckAvailability checks product availability using postal code
+func (c *Client) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]bestbuy.StoreAvailability, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	if err := restricted(ctx, sku); err != nil {
+		return nil, err
+	}
+	return c.Client.CheckAvailability(ctx, sku, postalCode)
+}
+
+// BrowsePokemonProducts returns the products of the deployment's product domain
+func (c *Client) BrowsePokemonProducts(ctx context.Context, opts bestbuy.BrowseOptions) ([]bestbuy.Product, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.Client.BrowsePokemonProducts(ctx, opts)
+}
+
+// retailerClient wraps a retailer client with the faults requested for each call's context
+type retailerClient struct {
+	client retailer.Client
+}
+
+// NewRetailerClient wraps a retailer client so requests carrying a fault fail as it describes
+func NewRetailerClient(client retailer.Client) retailer.Client {
+	return &retailerClient{client: client}
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *retailerClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]retailer.Store, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.SearchStores(ctx, postalCode, radiusMiles)
+}
+
+// SearchProducts searches for products by keyword or SKU, optionally within a category
+func (c *retailerClient) SearchProducts(ctx context.Context, query string, category string) ([]retailer.Product, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.SearchProducts(ctx, query, category)
+}
+
+// GetProduct gets a single product by SKU
+func (c *retailerClient) GetProduct(ctx context.Context, sku string) (*retailer.Product, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetProduct(ctx, sku)
+}
+
+// CheckAvailability returns the stores near a postal code that have the product in stock
+func (c *retailerClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]retailer.Availability, error) {
+	if err := inject(ctx); err != nil {
+		return nil, err
+	}
+	if err := restricted(ctx, sku); err != nil {
+		return nil, err
+	}
+	return c.client.CheckAvailability(ctx, sku, postalCode)
+}
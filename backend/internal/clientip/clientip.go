@@ -0,0 +1,72 @@
+// Package clientip finds the address of the client that made a request.
+// X-Forwarded-For is only believed from the reverse proxies the server is
+// configured to trust; anyone connecting directly could set it to anything.
+package clientip
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// Proxies are the addresses of trusted reverse proxies
+type Proxies []netip.Prefix
+
+// ParseProxies parses IP addresses and CIDR ranges such as "10.0.0.0/8"
+func ParseProxies(list []string) (Proxies, error) {
+	proxies := make(Proxies, 0, len(list))
+	for _, s := range list {
+		if prefix, err := netip.ParsePrefix(s); err == nil {
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q is not an IP address or CIDR range", s)
+		}
+		addr = addr.Unmap()
+		proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return proxies, nil
+}
+
+// trusted reports whether addr is one of the proxies
+func (p Proxies) trusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IP returns the caller's IP. That's the connection's remote address unless
+// it's a trusted proxy, in which case X-Forwarded-For is read from the end,
+// past the entries trusted proxies appended, to the address the first of
+// them saw. Earlier entries came from the client and can be forged.
+func (p Proxies) IP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || !p.trusted(addr) {
+		return ip
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		ip = hop.Unmap().String()
+		if !p.trusted(hop) {
+			break
+		}
+	}
+	return ip
+}
@@ -0,0 +1,53 @@
+package clientip
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIP(t *testing.T) {
+	proxies, err := ParseProxies([]string{"10.0.0.0/8", "192.0.2.7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{"direct", "203.0.113.9:5555", "", "203.0.113.9"},
+		{"forged header without a proxy", "203.0.113.9:5555", "1.2.3.4", "203.0.113.9"},
+		{"behind a proxy", "10.0.0.1:5555", "1.2.3.4, 198.51.100.20", "198.51.100.20"},
+		{"behind a chain of proxies", "10.0.0.1:5555", "1.2.3.4, 198.51.100.20, 192.0.2.7", "198.51.100.20"},
+		{"proxy without a header", "10.0.0.1:5555", "", "10.0.0.1"},
+		{"garbage in the header", "10.0.0.1:5555", "198.51.100.20, not-an-ip", "10.0.0.1"},
+		{"IPv6", "[2001:db8::1]:5555", "1.2.3.4", "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tt.remoteAddr
+		if tt.forwarded != "" {
+			req.Header.Set("X-Forwarded-For", tt.forwarded)
+		}
+		if got := proxies.IP(req); got != tt.want {
+			t.Errorf("%s: IP %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	// Without trusted proxies the header is never believed
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:5555"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+	if got := Proxies(nil).IP(req); got != "10.0.0.1" {
+		t.Errorf("no trusted proxies: IP %q, want 10.0.0.1", got)
+	}
+}
+
+func TestParseProxiesRejectsInvalid(t *testing.T) {
+	if _, err := ParseProxies([]string{"10.0.0.0/8", "proxy.internal"}); err == nil {
+		t.Error("accepted a hostname")
+	}
+}
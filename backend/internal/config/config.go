@@ -1,91 +1,446 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds the application configuration
 type Config struct {
+	// Profile selected from the config file (dev, staging, prod); empty if none
+	Environment string
+
+	// Release, from APP_VERSION ("dev" if unset)
+	Version string
+
 	// Server
 	Port        string
 	FrontendURL string
+	PublicURL   string // Externally reachable backend URL (used in notification links)
+
+	// HTTPS served directly, with a certificate and key or with certificates
+	// from Let's Encrypt for AutocertHosts; plain HTTP if neither is set
+	TLSCertFile      string
+	TLSKeyFile       string
+	AutocertHosts    []string // hostnames certificates may be requested for
+	AutocertEmail    string   // told by Let's Encrypt about expiring certificates; optional
+	AutocertCacheDir string   // issued certificates, kept across restarts
+	HTTPRedirectAddr string   // plain HTTP listener redirecting to HTTPS, e.g. ":80"; disabled if empty
+
+	// Reverse proxies (IP addresses or CIDR ranges) whose X-Forwarded-For
+	// gives the client's IP; it's ignored from anyone else
+	TrustedProxies []string
+
+	// How long in-flight requests get to finish on SIGINT/SIGTERM
+	ShutdownTimeout time.Duration
+
+	// SKUs CheckStock looks up at once
+	CheckConcurrency int
 
 	// Best Buy API
-	BestBuyAPIKey string
-	UseMockData   bool
+	BestBuyAPIKey     string
+	BestBuyRegion     string        // Best Buy site to check: "us" (api.bestbuy.com) or "ca" (bestbuy.ca)
+	BestBuyDailyQuota int           // calls per day the key allows (UTC days)
+	APICallSampleRate float64       // share of Best Buy calls saved for analysis (0 to 1, 0 is off)
+	RestrictedSKUTTL  time.Duration // availability checks skipped after a SKU is refused as restricted
+	UseMockData       bool
+	UserAgent         string // sent on outbound API requests (app name/version and a contact)
+
+	// Kind of product tracked: "tcg" (default), "gpu", "consoles" or "lego"
+	ProductDomain   string
+	ProductCategory string // overrides the domain's Best Buy category; empty for the domain's
+
+	// Scripted stock changes for the mock Best Buy client (YAML file path)
+	ScenarioFile string
+
+	// Retailers served by mock adapters (comma-separated IDs, or "all")
+	MockRetailers []string
+	TargetAPIKey  string // RedSky key for the real Target client; Target is mocked without it
+	GameStopSite  string // GameStop storefront to check: "gamestop" (US) or "ebgames" (Canada)
+
+	// TCG set details (release dates, card counts, logos) from the Pokemon TCG API
+	TCGEnrichment    bool
+	PokemonTCGAPIKey string // optional; the API allows fewer calls without one
+
+	// Inject retailer failures into requests carrying an X-Chaos header (never in production)
+	ChaosEnabled bool
 
 	// Database
 	DatabaseURL string
 
-	// Google OAuth
+	// Read-only maintenance mode: serve reads, reject writes and skip migrations
+	MaintenanceMode       bool
+	MaintenanceRetryAfter time.Duration
+
+	// Banner shown to everyone in the web app, e.g. planned downtime; empty for none
+	Announcement string
+
+	// Public status feed (/status.json) for embedding; disabled without featured SKUs
+	FeaturedSKUs       []string
+	FeaturedPostalCode string
+	StatusCacheTTL     time.Duration
+
+	// Shared Best Buy response cache: Redis if set, else in memory per process
+	RedisURL         string
+	CacheTTLStores   time.Duration
+	CacheTTLProducts time.Duration
+	CacheTTLProduct  time.Duration
+
+	// Background stock watcher
+	PollInterval   time.Duration
+	HeartbeatURL   string // Pinged after each successful watcher cycle (dead man's switch)
+	EmbeddedPoller bool   // Run the watcher inside the API server (disable when running cmd/poller)
+	MetricsAddr    string // Listen address for Prometheus metrics, e.g. ":9090"; disabled if empty
+
+	// Login providers; each one with a client ID and secret is offered
 	GoogleClientID     string
 	GoogleClientSecret string
-	GoogleRedirectURL  string
+	GitHubClientID     string
+	GitHubClientSecret string
+	OIDCIssuerURL      string // any OpenID Connect issuer, e.g. https://auth.example.com/application/o/stockchecker
+	OIDCClientID       string
+	OIDCClientSecret   string
+	OIDCName           string // shown on the login button, e.g. "Authentik"
+	OAuthRedirectURL   string // callback URL registered with every provider
+
+	// SMTP server (notification email JSON config without "to") for magic-link sign-in; disabled if empty
+	LoginEmailConfig string
+
+	// Forwarded Best Buy emails, POSTed as raw MIME by the mail provider to
+	// /inbound/email; disabled unless both are set
+	InboundEmailDomain string // users get <token>@ this domain
+	InboundEmailSecret string // HTTP basic auth password the provider sends
 
 	// Security
 	SecureCookies bool
 
 	// Initial allowed emails (comma-separated)
 	InitialAllowedEmails []string
+
+	// Admin emails (comma-separated) - given the admin role at startup and sign-in
+	AdminEmails []string
+
+	// Admin notification channel for operational events (channel type + JSON config)
+	AdminNotifyChannel string
+	AdminNotifyConfig  string
+
+	// Key (base64, 32 bytes) encrypting credentials admins rotate at runtime; rotation is disabled without it
+	CredentialsKey string
+
+	// p95 latency budgets; the admin is told when one is exceeded for LatencySustain
+	RPCLatencyBudget      time.Duration
+	RetailerLatencyBudget time.Duration
+	LatencySustain        time.Duration
 }
 
-// Load loads the configuration from environment variables
-func Load() *Config {
-	port := os.Getenv("PORT")
-	if port == "" {
+// Load loads the configuration from environment variables. Variables that
+// aren't set fall back to the profile named by ENVIRONMENT in the config file.
+func Load() (*Config, error) {
+	configFile := os.Getenv("CONFIG_FILE")
+	if configFile == "" {
+		configFile = DefaultConfigFile
+	}
+	environment := os.Getenv("ENVIRONMENT")
+	src, err := loadProfile(configFile, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCertFile, tlsKeyFile := src.get("TLS_CERT_FILE"), src.get("TLS_KEY_FILE")
+	autocertHosts := parseList(src.get("AUTOCERT_HOSTS"))
+	if (tlsCertFile == "") != (tlsKeyFile == "") {
+		return nil, fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+	if tlsCertFile != "" && len(autocertHosts) > 0 {
+		return nil, fmt.Errorf("set either TLS_CERT_FILE and TLS_KEY_FILE or AUTOCERT_HOSTS, not both")
+	}
+	serveTLS := tlsCertFile != "" || len(autocertHosts) > 0
+
+	// Let's Encrypt only validates hosts on the standard HTTPS port
+	port := src.get("PORT")
+	if port == "" && len(autocertHosts) > 0 {
+		port = "443"
+	} else if port == "" {
 		port = "8080"
 	}
+	backendURL := "http://localhost:" + port
+	if len(autocertHosts) > 0 {
+		backendURL = "https://" + autocertHosts[0]
+		if port != "443" {
+			backendURL += ":" + port
+		}
+	} else if serveTLS {
+		backendURL = "https://localhost:" + port
+	}
 
-	frontendURL := os.Getenv("FRONTEND_URL")
+	autocertCacheDir := src.get("AUTOCERT_CACHE_DIR")
+	if autocertCacheDir == "" {
+		autocertCacheDir = "autocert"
+	}
+
+	frontendURL := src.get("FRONTEND_URL")
 	if frontendURL == "" {
 		frontendURL = "http://localhost:5173"
 	}
 
-	apiKey := os.Getenv("BESTBUY_API_KEY")
-	useMock := apiKey == ""
+	publicURL := src.get("PUBLIC_URL")
+	if publicURL == "" {
+		publicURL = backendURL
+	}
+
+	checkConcurrency := 4
+	if v := src.get("CHECK_STOCK_CONCURRENCY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			checkConcurrency = n
+		}
+	}
+
+	apiKey := src.get("BESTBUY_API_KEY")
+	bestBuyDailyQuota := 50000
+	if v := src.get("BESTBUY_DAILY_QUOTA"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			bestBuyDailyQuota = n
+		}
+	}
+	apiCallSampleRate := 0.1
+	if v := src.get("API_CALL_SAMPLE_RATE"); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil && f >= 0 && f <= 1 {
+			apiCallSampleRate = f
+		}
+	}
+	bestBuyRegion := src.get("BESTBUY_REGION")
+	useMock := apiKey == "" && !strings.EqualFold(bestBuyRegion, "ca") // bestbuy.ca needs no key
+
+	version := src.get("APP_VERSION")
+	if version == "" {
+		version = "dev"
+	}
+	userAgent := src.get("USER_AGENT")
+	if userAgent == "" {
+		userAgent = buildUserAgent(version, src.get("API_CONTACT"))
+	}
 
-	databaseURL := os.Getenv("DATABASE_URL")
+	databaseURL := src.get("DATABASE_URL")
 
-	googleClientID := os.Getenv("GOOGLE_CLIENT_ID")
-	googleClientSecret := os.Getenv("GOOGLE_CLIENT_SECRET")
-	googleRedirectURL := os.Getenv("GOOGLE_REDIRECT_URL")
-	if googleRedirectURL == "" {
-		googleRedirectURL = "http://localhost:" + port + "/auth/callback"
+	pollInterval := 5 * time.Minute
+	if v := src.get("POLL_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			pollInterval = d
+		}
 	}
 
-	secureCookies := os.Getenv("SECURE_COOKIES") == "true"
+	featuredPostalCode := src.get("FEATURED_POSTAL_CODE")
+	if featuredPostalCode == "" {
+		featuredPostalCode = "94103"
+	}
 
-	var allowedEmails []string
-	if emails := os.Getenv("ALLOWED_EMAILS"); emails != "" {
-		for _, email := range strings.Split(emails, ",") {
-			email = strings.TrimSpace(email)
-			if email != "" {
-				allowedEmails = append(allowedEmails, email)
-			}
+	statusCacheTTL := 5 * time.Minute
+	if v := src.get("STATUS_CACHE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			statusCacheTTL = d
 		}
 	}
 
+	rpcLatencyBudget := parseDuration(src, "RPC_LATENCY_BUDGET", 2*time.Second)
+	retailerLatencyBudget := parseDuration(src, "RETAILER_LATENCY_BUDGET", 3*time.Second)
+	latencySustain := parseDuration(src, "LATENCY_BUDGET_SUSTAIN", 5*time.Minute)
+
+	googleClientID := src.get("GOOGLE_CLIENT_ID")
+	googleClientSecret := src.get("GOOGLE_CLIENT_SECRET")
+	// GOOGLE_REDIRECT_URL is the name from when Google was the only provider
+	oauthRedirectURL := src.get("OAUTH_REDIRECT_URL")
+	if oauthRedirectURL == "" {
+		oauthRedirectURL = src.get("GOOGLE_REDIRECT_URL")
+	}
+	if oauthRedirectURL == "" {
+		oauthRedirectURL = backendURL + "/auth/callback"
+	}
+
+	// Cookies are only sent back over HTTPS when it's served here, unless told otherwise
+	secureCookies := src.get("SECURE_COOKIES") == "true" || src.get("SECURE_COOKIES") == "" && serveTLS
+
+	allowedEmails := parseEmailList(src.get("ALLOWED_EMAILS"))
+	adminEmails := parseEmailList(src.get("ADMIN_EMAILS"))
+
 	return &Config{
-		Port:                 port,
-		FrontendURL:          frontendURL,
-		BestBuyAPIKey:        apiKey,
-		UseMockData:          useMock,
-		DatabaseURL:          databaseURL,
-		GoogleClientID:       googleClientID,
-		GoogleClientSecret:   googleClientSecret,
-		GoogleRedirectURL:    googleRedirectURL,
-		SecureCookies:        secureCookies,
-		InitialAllowedEmails: allowedEmails,
+		Environment:           environment,
+		Version:               version,
+		Port:                  port,
+		FrontendURL:           frontendURL,
+		PublicURL:             publicURL,
+		TLSCertFile:           tlsCertFile,
+		TLSKeyFile:            tlsKeyFile,
+		AutocertHosts:         autocertHosts,
+		AutocertEmail:         src.get("AUTOCERT_EMAIL"),
+		AutocertCacheDir:      autocertCacheDir,
+		HTTPRedirectAddr:      src.get("HTTP_REDIRECT_ADDR"),
+		TrustedProxies:        parseList(src.get("TRUSTED_PROXIES")),
+		ShutdownTimeout:       parseDuration(src, "SHUTDOWN_TIMEOUT", 30*time.Second),
+		CheckConcurrency:      checkConcurrency,
+		BestBuyAPIKey:         apiKey,
+		BestBuyRegion:         bestBuyRegion,
+		BestBuyDailyQuota:     bestBuyDailyQuota,
+		APICallSampleRate:     apiCallSampleRate,
+		UseMockData:           useMock,
+		UserAgent:             userAgent,
+		ScenarioFile:          src.get("SCENARIO_FILE"),
+		MockRetailers:         parseList(src.get("MOCK_RETAILERS")),
+		TargetAPIKey:          src.get("TARGET_API_KEY"),
+		GameStopSite:          src.get("GAMESTOP_SITE"),
+		TCGEnrichment:         src.get("TCG_ENRICHMENT") != "false",
+		PokemonTCGAPIKey:      src.get("POKEMONTCG_API_KEY"),
+		ChaosEnabled:          src.get("CHAOS_ENABLED") == "true",
+		DatabaseURL:           databaseURL,
+		MaintenanceMode:       src.get("MAINTENANCE_MODE") == "true",
+		MaintenanceRetryAfter: parseDuration(src, "MAINTENANCE_RETRY_AFTER", 5*time.Minute),
+		Announcement:          src.get("ANNOUNCEMENT"),
+		FeaturedSKUs:          parseList(src.get("FEATURED_SKUS")),
+		FeaturedPostalCode:    featuredPostalCode,
+		StatusCacheTTL:        statusCacheTTL,
+		RedisURL:              src.get("REDIS_URL"),
+		CacheTTLStores:        parseDuration(src, "CACHE_TTL_STORES", 24*time.Hour),
+		CacheTTLProducts:      parseDuration(src, "CACHE_TTL_PRODUCTS", 15*time.Minute),
+		CacheTTLProduct:       parseDuration(src, "CACHE_TTL_PRODUCT", time.Hour),
+		RestrictedSKUTTL:      parseDuration(src, "RESTRICTED_SKU_TTL", 6*time.Hour),
+		ProductDomain:         src.get("PRODUCT_DOMAIN"),
+		ProductCategory:       src.get("PRODUCT_CATEGORY"),
+		PollInterval:          pollInterval,
+		HeartbeatURL:          src.get("HEARTBEAT_URL"),
+		EmbeddedPoller:        src.get("EMBEDDED_POLLER") != "false",
+		MetricsAddr:           src.get("METRICS_ADDR"),
+		GoogleClientID:        googleClientID,
+		GoogleClientSecret:    googleClientSecret,
+		GitHubClientID:        src.get("GITHUB_CLIENT_ID"),
+		GitHubClientSecret:    src.get("GITHUB_CLIENT_SECRET"),
+		OIDCIssuerURL:         src.get("OIDC_ISSUER_URL"),
+		OIDCClientID:          src.get("OIDC_CLIENT_ID"),
+		OIDCClientSecret:      src.get("OIDC_CLIENT_SECRET"),
+		OIDCName:              src.get("OIDC_NAME"),
+		OAuthRedirectURL:      oauthRedirectURL,
+		LoginEmailConfig:      src.get("LOGIN_EMAIL_CONFIG"),
+		InboundEmailDomain:    strings.ToLower(strings.TrimPrefix(src.get("INBOUND_EMAIL_DOMAIN"), "@")),
+		InboundEmailSecret:    src.get("INBOUND_EMAIL_SECRET"),
+		SecureCookies:         secureCookies,
+		InitialAllowedEmails:  allowedEmails,
+		AdminEmails:           adminEmails,
+		AdminNotifyChannel:    src.get("ADMIN_NOTIFY_CHANNEL"),
+		AdminNotifyConfig:     src.get("ADMIN_NOTIFY_CONFIG"),
+		CredentialsKey:        src.get("CREDENTIALS_KEY"),
+		RPCLatencyBudget:      rpcLatencyBudget,
+		RetailerLatencyBudget: retailerLatencyBudget,
+		LatencySustain:        latencySustain,
+	}, nil
+}
+
+// parseDuration reads a positive duration setting, or returns def
+func parseDuration(src source, key string, def time.Duration) time.Duration {
+	if v := src.get(key); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			return d
+		}
 	}
+	return def
+}
+
+// buildUserAgent builds a descriptive user agent such as
+// "stock-checker/1.4.0 (+mailto:ops@example.com)"
+func buildUserAgent(version, contact string) string {
+	if version == "" {
+		version = "dev"
+	}
+	if contact == "" {
+		contact = "https://github.com/tmcauley/stock-checker"
+	} else if strings.Contains(contact, "@") && !strings.HasPrefix(contact, "mailto:") {
+		contact = "mailto:" + contact
+	}
+	return fmt.Sprintf("stock-checker/%s (+%s)", version, contact)
+}
+
+// parseEmailList parses a comma-separated list of emails
+func parseEmailList(list string) []string {
+	return parseList(list)
+}
+
+// parseList parses a comma-separated list, skipping empty entries
+func parseList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ServesTLS returns true if the server serves HTTPS itself
+func (c *Config) ServesTLS() bool {
+	return c.TLSCertFile != "" || c.UsesAutocert()
+}
+
+// UsesAutocert returns true if certificates come from Let's Encrypt
+func (c *Config) UsesAutocert() bool {
+	return len(c.AutocertHosts) > 0
 }
 
-// HasAuth returns true if OAuth is configured
+// HasAuth returns true if any login provider is configured
 func (c *Config) HasAuth() bool {
+	return c.HasGoogleAuth() || c.HasGitHubAuth() || c.HasOIDCAuth() || c.HasEmailAuth()
+}
+
+// HasGoogleAuth returns true if signing in with Google is configured
+func (c *Config) HasGoogleAuth() bool {
 	return c.GoogleClientID != "" && c.GoogleClientSecret != ""
 }
 
+// HasGitHubAuth returns true if signing in with GitHub is configured
+func (c *Config) HasGitHubAuth() bool {
+	return c.GitHubClientID != "" && c.GitHubClientSecret != ""
+}
+
+// HasEmailAuth returns true if signing in with an emailed magic link is configured
+func (c *Config) HasEmailAuth() bool {
+	return c.LoginEmailConfig != ""
+}
+
+// HasInboundEmail returns true if users can forward Best Buy emails to get reminders
+func (c *Config) HasInboundEmail() bool {
+	return c.InboundEmailDomain != "" && c.InboundEmailSecret != ""
+}
+
+// HasOIDCAuth returns true if signing in with an OpenID Connect issuer is configured
+func (c *Config) HasOIDCAuth() bool {
+	return c.OIDCIssuerURL != "" && c.OIDCClientID != "" && c.OIDCClientSecret != ""
+}
+
+// HasAdminNotifications returns true if an admin notification channel is configured
+func (c *Config) HasAdminNotifications() bool {
+	return c.AdminNotifyChannel != ""
+}
+
+// HasCredentialsKey returns true if admins can rotate credentials at runtime
+func (c *Config) HasCredentialsKey() bool {
+	return c.CredentialsKey != ""
+}
+
 // HasDatabase returns true if database is configured
 func (c *Config) HasDatabase() bool {
 	return c.DatabaseURL != ""
 }
+
+// UseMockFor returns true if the retailer should use its mock adapter.
+// Without a Best Buy API key every retailer is mocked.
+func (c *Config) UseMockFor(retailer string) bool {
+	if c.UseMockData {
+		return true
+	}
+	for _, r := range c.MockRetailers {
+		if r == "all" || strings.EqualFold(r, retailer) {
+			return true
+		}
+	}
+	return false
+}
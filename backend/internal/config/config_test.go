@@ -0,0 +1,143 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeConfigFile writes a config file with dev, prod and staging profiles
+func writeConfigFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte(`
+profiles:
+  dev:
+    settings:
+      POLL_INTERVAL: 1m
+      SECURE_COOKIES: false
+  prod:
+    settings:
+      POLL_INTERVAL: 5m
+      SECURE_COOKIES: true
+      MOCK_RETAILERS: walmart
+  staging:
+    extends: prod
+    settings:
+      POLL_INTERVAL: 15m
+  loop:
+    extends: loop
+`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadProfileInheritance(t *testing.T) {
+	t.Setenv("CONFIG_FILE", writeConfigFile(t))
+	t.Setenv("ENVIRONMENT", "staging")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Environment != "staging" || cfg.PollInterval != 15*time.Minute {
+		t.Errorf("environment %q, poll interval %v; want staging's own 15m", cfg.Environment, cfg.PollInterval)
+	}
+	if !cfg.SecureCookies || len(cfg.MockRetailers) != 1 || cfg.MockRetailers[0] != "walmart" {
+		t.Errorf("secure cookies %v, mock retailers %v; want them inherited from prod", cfg.SecureCookies, cfg.MockRetailers)
+	}
+}
+
+func TestLoadEnvironmentOverridesProfile(t *testing.T) {
+	t.Setenv("CONFIG_FILE", writeConfigFile(t))
+	t.Setenv("ENVIRONMENT", "prod")
+	t.Setenv("POLL_INTERVAL", "2m")
+	t.Setenv("SECURE_COOKIES", "")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.PollInterval != 2*time.Minute {
+		t.Errorf("poll interval %v, want the environment's 2m", cfg.PollInterval)
+	}
+	if cfg.SecureCookies {
+		t.Error("an empty SECURE_COOKIES in the environment should still override the profile")
+	}
+}
+
+func TestLoadProfileErrors(t *testing.T) {
+	path := writeConfigFile(t)
+	for _, tt := range []struct{ file, env string }{
+		{path, "qa"},
+		{path, "loop"},
+		{filepath.Join(t.TempDir(), "missing.yaml"), "dev"},
+	} {
+		t.Setenv("CONFIG_FILE", tt.file)
+		t.Setenv("ENVIRONMENT", tt.env)
+		if _, err := Load(); err == nil {
+			t.Errorf("Load with ENVIRONMENT=%s and %s succeeded, want error", tt.env, filepath.Base(tt.file))
+		}
+	}
+
+	// Without ENVIRONMENT a missing file is fine
+	t.Setenv("ENVIRONMENT", "")
+	if _, err := Load(); err != nil {
+		t.Errorf("Load without a profile: %v", err)
+	}
+}
+
+func TestShippedProfiles(t *testing.T) {
+	t.Setenv("CONFIG_FILE", filepath.Join("..", "..", DefaultConfigFile))
+	for _, env := range []string{"dev", "staging", "prod"} {
+		t.Setenv("ENVIRONMENT", env)
+		if _, err := Load(); err != nil {
+			t.Errorf("%s: %v", env, err)
+		}
+	}
+}
+
+func TestLoadCanadaNeedsNoAPIKey(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "")
+	t.Setenv("BESTBUY_API_KEY", "")
+	t.Setenv("BESTBUY_REGION", "ca")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.UseMockData {
+		t.Error("bestbuy.ca without an API key should use the real client")
+	}
+}
+
+func TestLoadTLS(t *testing.T) {
+	t.Setenv("ENVIRONMENT", "")
+	t.Setenv("PORT", "")
+	t.Setenv("PUBLIC_URL", "")
+	t.Setenv("SECURE_COOKIES", "")
+	t.Setenv("AUTOCERT_HOSTS", "stock.example.com, www.stock.example.com")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.UsesAutocert() || cfg.Port != "443" || cfg.PublicURL != "https://stock.example.com" {
+		t.Errorf("autocert %v, port %s, public URL %s; want true, 443 and the first host", cfg.UsesAutocert(), cfg.Port, cfg.PublicURL)
+	}
+	if !cfg.SecureCookies {
+		t.Error("cookies should be secure by default when serving HTTPS")
+	}
+
+	t.Setenv("TLS_CERT_FILE", "cert.pem")
+	if _, err := Load(); err == nil {
+		t.Error("a certificate without a key loaded")
+	}
+	t.Setenv("TLS_KEY_FILE", "key.pem")
+	if _, err := Load(); err == nil {
+		t.Error("both a certificate and autocert loaded")
+	}
+}
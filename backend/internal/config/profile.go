@@ -0,0 +1,83 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigFile is read when CONFIG_FILE is not set
+const DefaultConfigFile = "config.yaml"
+
+// Profile is a named set of settings in the config file, keyed by environment
+// variable name. A profile can extend another and override some of its settings.
+type Profile struct {
+	Extends  string            `yaml:"extends"`
+	Settings map[string]string `yaml:"settings"`
+}
+
+// profileFile is the layout of the config file
+type profileFile struct {
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// source looks up settings: the environment first, then the selected profile
+type source map[string]string
+
+// get returns the value of a setting, or "" if it is set nowhere
+func (s source) get(key string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return s[key]
+}
+
+// loadProfile reads the config file and flattens the named profile and the
+// profiles it extends into one set of settings. Without a name no profile is
+// used, and a missing file is only an error when a profile was asked for.
+func loadProfile(path, name string) (source, error) {
+	if name == "" {
+		return source{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("ENVIRONMENT is %q but config file %s does not exist", name, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var f profileFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	// Walk up the chain, then apply from the root down so children win
+	var chain []Profile
+	seen := make(map[string]bool)
+	for p := name; p != ""; {
+		if seen[p] {
+			return nil, fmt.Errorf("profile %q is part of an extends loop", p)
+		}
+		seen[p] = true
+
+		profile, ok := f.Profiles[p]
+		if !ok {
+			return nil, fmt.Errorf("config file %s has no profile %q", path, p)
+		}
+		chain = append(chain, profile)
+		p = profile.Extends
+	}
+
+	settings := source{}
+	for i := len(chain) - 1; i >= 0; i-- {
+		for k, v := range chain[i].Settings {
+			settings[k] = v
+		}
+	}
+	return settings, nil
+}
@@ -0,0 +1,255 @@
+// Package costco is a client for the JSON endpoints behind costco.com's
+// warehouse locator, search and product pages. Costco sells most products
+// online only, so availability is reported per product for costco.com and,
+// for the items the site tracks in warehouses, per warehouse.
+package costco
+
+import (
+	"context"
+	"errors"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+// Client is the interface for Costco API operations
+type Client interface {
+	// SearchWarehouses searches for warehouses near a postal code within a radius
+	SearchWarehouses(ctx context.Context, postalCode string, radiusMiles int) ([]Warehouse, error)
+
+	// SearchProducts searches for products by keyword, optionally within a category
+	SearchProducts(ctx context.Context, query string, category string) ([]Product, error)
+
+	// GetProduct gets a single product, including its online availability, by item number
+	GetProduct(ctx context.Context, itemNumber string) (*Product, error)
+
+	// CheckWarehouses returns the warehouses near a postal code that have the
+	// product. Items not tracked in warehouses return no warehouses.
+	CheckWarehouses(ctx context.Context, itemNumber string, postalCode string) ([]WarehouseAvailability, error)
+}
+
+// Warehouse is a Costco warehouse
+type Warehouse struct {
+	Number     string
+	Name       string
+	Address    string
+	City       string
+	State      string
+	PostalCode string
+	Phone      string
+	Distance   float64
+}
+
+// Product is a Costco product, identified by its item number
+type Product struct {
+	ItemNumber    string
+	Name          string
+	Price         money.Cents
+	ImageURL      string
+	URL           string
+	OnlineInStock bool // can be ordered for delivery from costco.com
+}
+
+// WarehouseAvailability is a product's stock at one warehouse
+type WarehouseAvailability struct {
+	Number   string
+	Name     string
+	City     string
+	State    string
+	Distance float64
+	LowStock bool
+}
+
+// Inventory statuses reported online and per warehouse
+const (
+	statusInStock = "IN_STOCK"
+	statusLimited = "LIMITED"
+)
+
+// Defaults
+const (
+	defaultRadiusMiles = 25
+	availabilityRadius = 50 // miles searched for warehouse stock
+	searchPageSize     = 24
+)
+
+// APIClient is the real Costco API client
+type APIClient struct {
+	baseURL string
+	api     *webapi.Client
+}
+
+// NewAPIClient creates a Costco API client. The endpoints are public, so no
+// key is needed.
+func NewAPIClient(userAgent string) *APIClient {
+	return &APIClient{
+		baseURL: "https://www.costco.com",
+		api:     webapi.New("Costco", userAgent, time.Second), // the API is unofficial and quick to block bursts
+	}
+}
+
+// notFound is the error for an item number the API doesn't have
+func notFound(itemNumber string) error {
+	return &webapi.NotFoundError{API: "Costco", ID: itemNumber}
+}
+
+// get requests an endpoint and decodes its JSON response into v
+func (c *APIClient) get(ctx context.Context, endpoint string, params url.Values, v any) error {
+	return c.api.Get(ctx, c.baseURL+"/"+endpoint+"?"+params.Encode(), nil, v)
+}
+
+// apiProduct is a product in search and product responses
+type apiProduct struct {
+	ItemNumber      string      `json:"itemNumber"`
+	Name            string      `json:"name"`
+	Price           money.Cents `json:"price"`
+	ImageURL        string      `json:"imageUrl"`
+	URL             string      `json:"url"`
+	OnlineInventory string      `json:"onlineInventory"`
+}
+
+// product converts an API product
+func (p apiProduct) product() Product {
+	return Product{
+		ItemNumber:    p.ItemNumber,
+		Name:          html.UnescapeString(p.Name), // names come HTML-escaped
+		Price:         p.Price,
+		ImageURL:      p.ImageURL,
+		URL:           p.URL,
+		OnlineInStock: p.OnlineInventory == statusInStock || p.OnlineInventory == statusLimited,
+	}
+}
+
+// SearchWarehouses searches for warehouses near a postal code within a radius
+func (c *APIClient) SearchWarehouses(ctx context.Context, postalCode string, radiusMiles int) ([]Warehouse, error) {
+	if radiusMiles <= 0 {
+		radiusMiles = defaultRadiusMiles
+	}
+
+	var resp struct {
+		Warehouses []struct {
+			Number       string  `json:"warehouseNumber"`
+			LocationName string  `json:"locationName"`
+			Address1     string  `json:"address1"`
+			City         string  `json:"city"`
+			State        string  `json:"state"`
+			ZipCode      string  `json:"zipCode"`
+			Phone        string  `json:"phone"`
+			Distance     float64 `json:"distance"`
+		} `json:"warehouses"`
+	}
+	if err := c.get(ctx, "AjaxWarehouseBrowseLookupView", url.Values{
+		"postalCode": {postalCode},
+		"radius":     {strconv.Itoa(radiusMiles)},
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	warehouses := make([]Warehouse, 0, len(resp.Warehouses))
+	for _, w := range resp.Warehouses {
+		warehouses = append(warehouses, Warehouse{
+			Number:     w.Number,
+			Name:       w.LocationName,
+			Address:    w.Address1,
+			City:       w.City,
+			State:      w.State,
+			PostalCode: w.ZipCode,
+			Phone:      w.Phone,
+			Distance:   w.Distance,
+		})
+	}
+	return warehouses, nil
+}
+
+// SearchProducts searches for products by keyword, optionally within a category
+func (c *APIClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	params := url.Values{
+		"keyword":  {query},
+		"pageSize": {strconv.Itoa(searchPageSize)},
+	}
+	if category != "" {
+		params.Set("category", category)
+	}
+
+	var resp struct {
+		Products []apiProduct `json:"products"`
+	}
+	if err := c.get(ctx, "AjaxCatalogSearch", params, &resp); err != nil {
+		return nil, err
+	}
+
+	products := make([]Product, 0, len(resp.Products))
+	for _, p := range resp.Products {
+		products = append(products, p.product())
+	}
+	return products, nil
+}
+
+// GetProduct gets a single product by its item number
+func (c *APIClient) GetProduct(ctx context.Context, itemNumber string) (*Product, error) {
+	var resp struct {
+		Product *apiProduct `json:"product"`
+	}
+	if err := c.get(ctx, "AjaxGetProductDetails", url.Values{"itemNumber": {itemNumber}}, &resp); err != nil {
+		var statusErr *webapi.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return nil, notFound(itemNumber)
+		}
+		return nil, err
+	}
+	if resp.Product == nil {
+		return nil, notFound(itemNumber)
+	}
+
+	product := resp.Product.product()
+	return &product, nil
+}
+
+// CheckWarehouses returns the warehouses near a postal code that have the
+// product. The API refuses inventory lookups for items it doesn't track in
+// warehouses, which is reported as no warehouses rather than an error.
+func (c *APIClient) CheckWarehouses(ctx context.Context, itemNumber string, postalCode string) ([]WarehouseAvailability, error) {
+	var resp struct {
+		Warehouses []struct {
+			Number          string  `json:"warehouseNumber"`
+			LocationName    string  `json:"locationName"`
+			City            string  `json:"city"`
+			State           string  `json:"state"`
+			Distance        float64 `json:"distance"`
+			InventoryStatus string  `json:"inventoryStatus"`
+		} `json:"warehouses"`
+	}
+	err := c.get(ctx, "AjaxGetInventoryDetail", url.Values{
+		"itemNumber": {itemNumber},
+		"postalCode": {postalCode},
+		"radius":     {strconv.Itoa(availabilityRadius)},
+	}, &resp)
+	var statusErr *webapi.StatusError
+	if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusNotFound || statusErr.StatusCode == http.StatusForbidden) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var availability []WarehouseAvailability
+	for _, w := range resp.Warehouses {
+		if w.InventoryStatus != statusInStock && w.InventoryStatus != statusLimited {
+			continue
+		}
+		availability = append(availability, WarehouseAvailability{
+			Number:   w.Number,
+			Name:     w.LocationName,
+			City:     w.City,
+			State:    w.State,
+			Distance: w.Distance,
+			LowStock: w.InventoryStatus == statusLimited,
+		})
+	}
+	return availability, nil
+}
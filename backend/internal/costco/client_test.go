@@ -0,0 +1,123 @@
+package costco
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+// newTestClient returns an APIClient whose requests to each endpoint are
+// answered with the contents of the mapped testdata file; other endpoints 404
+func newTestClient(t *testing.T, files map[string]string) *APIClient {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := files[strings.TrimPrefix(r.URL.Path, "/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewAPIClient("")
+	c.baseURL = srv.URL
+	c.api.MinInterval = 0
+	c.api.MaxRetries = 1
+	c.api.RetryBaseWait = time.Millisecond
+	return c
+}
+
+func TestSearchWarehouses(t *testing.T) {
+	c := newTestClient(t, map[string]string{"AjaxWarehouseBrowseLookupView": "warehouses.json"})
+
+	warehouses, err := c.SearchWarehouses(context.Background(), "94103", 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warehouses) != 2 {
+		t.Fatalf("got %d warehouses, want 2", len(warehouses))
+	}
+	want := Warehouse{Number: "144", Name: "San Francisco", Address: "450 10th St", City: "San Francisco", State: "CA", PostalCode: "94103", Phone: "(415) 626-4288", Distance: 0.6}
+	if warehouses[0] != want {
+		t.Errorf("warehouse = %+v, want %+v", warehouses[0], want)
+	}
+}
+
+func TestGetProduct(t *testing.T) {
+	c := newTestClient(t, map[string]string{"AjaxGetProductDetails": "product.json"})
+
+	p, err := c.GetProduct(context.Background(), "1871342")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Pokémon Prismatic Evolutions Premium Collection Bundle" {
+		t.Errorf("name = %q, want HTML entities decoded", p.Name)
+	}
+	if p.Price != 9999 || !p.OnlineInStock {
+		t.Errorf("product = %+v", p)
+	}
+}
+
+func TestGetProductNotFound(t *testing.T) {
+	c := newTestClient(t, map[string]string{})
+
+	_, err := c.GetProduct(context.Background(), "1")
+	var notFound *webapi.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("err = %v, want NotFoundError", err)
+	}
+}
+
+func TestSearchProducts(t *testing.T) {
+	c := newTestClient(t, map[string]string{"AjaxCatalogSearch": "search.json"})
+
+	products, err := c.SearchProducts(context.Background(), "pokemon", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 2 || products[1].ItemNumber != "1793865" || products[1].Price != 4999 || products[1].OnlineInStock {
+		t.Errorf("products = %+v", products)
+	}
+}
+
+func TestCheckWarehouses(t *testing.T) {
+	c := newTestClient(t, map[string]string{"AjaxGetInventoryDetail": "inventory.json"})
+
+	availability, err := c.CheckWarehouses(context.Background(), "1793865", "94103")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(availability) != 2 {
+		t.Fatalf("got %d warehouses, want 2 (sold-out warehouse dropped)", len(availability))
+	}
+	if a := availability[0]; a.Number != "144" || a.LowStock {
+		t.Errorf("in stock warehouse = %+v", a)
+	}
+	if a := availability[1]; !a.LowStock {
+		t.Errorf("limited warehouse = %+v", a)
+	}
+}
+
+func TestCheckWarehousesOnlineOnly(t *testing.T) {
+	// The inventory endpoint 404s for items not tracked in warehouses
+	c := newTestClient(t, map[string]string{})
+
+	availability, err := c.CheckWarehouses(context.Background(), "1871342", "94103")
+	if err != nil || len(availability) != 0 {
+		t.Errorf("got %v, %v; want no warehouses and no error", availability, err)
+	}
+}
@@ -0,0 +1,140 @@
+package costco
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// mockWarehouses are Bay Area Costco warehouses
+var mockWarehouses = []Warehouse{
+	{Number: "144", Name: "San Francisco", Address: "450 10th St", City: "San Francisco", State: "CA", PostalCode: "94103", Phone: "(415) 626-4288"},
+	{Number: "1001", Name: "South San Francisco", Address: "451 S Airport Blvd", City: "South San Francisco", State: "CA", PostalCode: "94080", Phone: "(650) 872-1722"},
+	{Number: "117", Name: "Richmond", Address: "4801 Central Ave", City: "Richmond", State: "CA", PostalCode: "94804", Phone: "(510) 526-6010"},
+}
+
+// mockProducts are Pokemon TCG products Costco carries. Bundles are sold
+// online only; the rest are also stocked in warehouses.
+var mockProducts = []struct {
+	Product
+	inWarehouses bool
+}{
+	{Product{ItemNumber: "1871342", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Premium Collection Bundle", Price: 9999, URL: "https://www.costco.com/.product.1871342.html", OnlineInStock: true}, false},
+	{Product{ItemNumber: "1793865", Name: "Pokemon Trading Card Game: Surging Sparks Booster Bundle, 2-pack", Price: 4999, URL: "https://www.costco.com/.product.1793865.html"}, true},
+	{Product{ItemNumber: "1755912", Name: "Pokemon Trading Card Game: Scarlet & Violet 151 Collection Bundle", Price: 7999, URL: "https://www.costco.com/.product.1755912.html", OnlineInStock: true}, true},
+}
+
+// MockClient serves fixed Costco data with deterministic availability, for
+// local development without calling Costco
+type MockClient struct {
+	latency time.Duration
+}
+
+// NewMockClient creates a mock Costco client
+func NewMockClient() *MockClient {
+	return &MockClient{latency: 100 * time.Millisecond} // Simulate 100ms API latency
+}
+
+// simulateLatency adds a small delay to simulate network latency
+func (c *MockClient) simulateLatency(ctx context.Context) error {
+	select {
+	case <-time.After(c.latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SearchWarehouses returns every mock warehouse with a random distance within the radius
+func (c *MockClient) SearchWarehouses(ctx context.Context, postalCode string, radiusMiles int) ([]Warehouse, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+	if radiusMiles <= 0 {
+		radiusMiles = defaultRadiusMiles
+	}
+
+	warehouses := make([]Warehouse, len(mockWarehouses))
+	for i, w := range mockWarehouses {
+		warehouses[i] = w
+		warehouses[i].Distance = float64(rand.Intn(radiusMiles)) + rand.Float64()
+	}
+	return warehouses, nil
+}
+
+// SearchProducts returns mock products whose name or item number matches the query
+func (c *MockClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	queryLower := strings.ToLower(query)
+	var results []Product
+	for _, p := range mockProducts {
+		if query == "" || strings.Contains(strings.ToLower(p.Name), queryLower) || strings.Contains(p.ItemNumber, queryLower) {
+			results = append(results, p.Product)
+		}
+	}
+	return results, nil
+}
+
+// GetProduct gets a mock product by item number
+func (c *MockClient) GetProduct(ctx context.Context, itemNumber string) (*Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, p := range mockProducts {
+		if p.ItemNumber == itemNumber {
+			product := p.Product
+			return &product, nil
+		}
+	}
+	return nil, notFound(itemNumber)
+}
+
+// CheckWarehouses returns the mock warehouses that have the product, seeded
+// by warehouse and item number so repeated checks give the same answer.
+// Online-only products are in no warehouse.
+func (c *MockClient) CheckWarehouses(ctx context.Context, itemNumber string, postalCode string) ([]WarehouseAvailability, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	var inWarehouses, found bool
+	for _, p := range mockProducts {
+		if p.ItemNumber == itemNumber {
+			inWarehouses, found = p.inWarehouses, true
+		}
+	}
+	if !found {
+		return nil, notFound(itemNumber)
+	}
+	if !inWarehouses {
+		return nil, nil
+	}
+
+	var availability []WarehouseAvailability
+	for i, w := range mockWarehouses {
+		seed := int64(0)
+		for _, ch := range "costco" + w.Number + itemNumber {
+			seed += int64(ch)
+		}
+		roll := rand.New(rand.NewSource(seed)).Float64()
+
+		// 50% in stock, a third of which is low stock
+		if roll >= 0.5 {
+			continue
+		}
+		availability = append(availability, WarehouseAvailability{
+			Number:   w.Number,
+			Name:     w.Name,
+			City:     w.City,
+			State:    w.State,
+			Distance: float64(4 + 6*i),
+			LowStock: roll >= 0.33,
+		})
+	}
+	return availability, nil
+}
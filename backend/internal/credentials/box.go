@@ -0,0 +1,59 @@
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// box seals credential values with AES-256-GCM. Each value is bound to its
+// credential's name, so a sealed value can't be copied to another credential.
+type box struct {
+	aead cipher.AEAD
+}
+
+// newBox creates a box from a base64-encoded 32-byte key
+func newBox(key string) (*box, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(key))
+	if err != nil {
+		return nil, fmt.Errorf("key is not base64: %w", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("key is %d bytes, want 32", len(raw))
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &box{aead: aead}, nil
+}
+
+// seal encrypts a value, prefixing the random nonce
+func (b *box) seal(name, value string) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return b.aead.Seal(nonce, nonce, []byte(value), []byte(name)), nil
+}
+
+// open decrypts a value sealed for the named credential
+func (b *box) open(name string, sealed []byte) (string, error) {
+	if len(sealed) < b.aead.NonceSize() {
+		return "", errors.New("sealed value is too short")
+	}
+	nonce, ciphertext := sealed[:b.aead.NonceSize()], sealed[b.aead.NonceSize():]
+	value, err := b.aead.Open(nil, nonce, ciphertext, []byte(name))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt %s; was CREDENTIALS_KEY changed?", name)
+	}
+	return string(value), nil
+}
@@ -0,0 +1,389 @@
+// Package credentials lets admins rotate retailer API keys and the admin
+// notification channel at runtime. Values set by an admin are stored
+// encrypted in the database and override the environment; clearing one falls
+// back to the environment's value.
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/config"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/pokemontcg"
+	"github.com/tmcauley/stock-checker/backend/internal/target"
+)
+
+// DefaultReloadInterval is how often credentials are reread from the
+// database, so a rotation reaches every server and the poller
+const DefaultReloadInterval = time.Minute
+
+// Name identifies a credential by the environment variable it overrides
+type Name string
+
+const (
+	BestBuyAPIKey    Name = "BESTBUY_API_KEY"
+	TargetAPIKey     Name = "TARGET_API_KEY"
+	PokemonTCGAPIKey Name = "POKEMONTCG_API_KEY"
+
+	// AdminNotify is the admin channel as {"channel": "gotify", "config": {...}},
+	// overriding ADMIN_NOTIFY_CHANNEL and ADMIN_NOTIFY_CONFIG together
+	AdminNotify Name = "ADMIN_NOTIFY"
+)
+
+// Names lists the credentials admins can set
+var Names = []Name{BestBuyAPIKey, TargetAPIKey, PokemonTCGAPIKey, AdminNotify}
+
+// ErrUnknownName is returned for a credential that can't be set at runtime
+var ErrUnknownName = errors.New("unknown credential")
+
+// InvalidValueError is returned when a value can't be put into use
+type InvalidValueError struct {
+	Err error
+}
+
+func (e *InvalidValueError) Error() string {
+	return "invalid credential value: " + e.Err.Error()
+}
+
+func (e *InvalidValueError) Unwrap() error {
+	return e.Err
+}
+
+// ParseName parses a credential name, accepting any case
+func ParseName(s string) (Name, error) {
+	name := Name(strings.ToUpper(strings.TrimSpace(s)))
+	for _, n := range Names {
+		if n == name {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownName, s)
+}
+
+// ApplyFunc puts a credential's value into use, returning an error to reject it
+type ApplyFunc func(value string) error
+
+// Credential describes a credential without revealing its value
+type Credential struct {
+	Name           Name
+	Set            bool   // has a value, from the environment or an admin
+	Overridden     bool   // set by an admin rather than the environment
+	Hint           string // last four characters of a key, or the admin channel type
+	UpdatedByEmail string // empty if from the environment or the admin was deleted
+	UpdatedAt      time.Time
+}
+
+// Store holds the credentials in use and the functions that apply them
+type Store struct {
+	db       *database.DB
+	box      *box
+	defaults map[Name]string // from the environment
+
+	writeMu sync.Mutex // serializes changes, which call appliers outside mu
+	mu      sync.RWMutex
+	values  map[Name]string
+	applies map[Name][]ApplyFunc
+}
+
+// Defaults returns the credentials configured in the environment
+func Defaults(cfg *config.Config) map[Name]string {
+	defaults := map[Name]string{
+		BestBuyAPIKey:    cfg.BestBuyAPIKey,
+		TargetAPIKey:     cfg.TargetAPIKey,
+		PokemonTCGAPIKey: cfg.PokemonTCGAPIKey,
+	}
+	if cfg.HasAdminNotifications() {
+		raw := json.RawMessage(cfg.AdminNotifyConfig)
+		if !json.Valid(raw) {
+			raw = json.RawMessage("{}")
+		}
+		b, _ := json.Marshal(adminChannel{Channel: cfg.AdminNotifyChannel, Config: raw})
+		defaults[AdminNotify] = string(b)
+	}
+	return defaults
+}
+
+// New creates a store that encrypts values with key, a base64-encoded
+// 32-byte key, and uses defaults until Load reads the stored values
+func New(db *database.DB, key string, defaults map[Name]string) (*Store, error) {
+	b, err := newBox(key)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[Name]string, len(defaults))
+	for name, value := range defaults {
+		values[name] = value
+	}
+	return &Store{
+		db:       db,
+		box:      b,
+		defaults: defaults,
+		values:   values,
+		applies:  make(map[Name][]ApplyFunc),
+	}, nil
+}
+
+// Get returns a credential's current value
+func (s *Store) Get(name Name) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.values[name]
+}
+
+// Watch calls apply whenever a credential's value changes. Register every
+// watcher before Load so stored values reach them at startup.
+func (s *Store) Watch(name Name, apply ApplyFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.applies[name] = append(s.applies[name], apply)
+}
+
+// Clients are the clients whose credentials are rotated; nil ones are skipped
+type Clients struct {
+	BestBuy    *bestbuy.APIClient
+	Target     *target.APIClient
+	PokemonTCG *pokemontcg.APIClient
+	Admin      *notify.AdminNotifier
+}
+
+// Use watches the credentials of each client
+func (s *Store) Use(c Clients) {
+	if c.BestBuy != nil {
+		s.Watch(BestBuyAPIKey, func(key string) error {
+			c.BestBuy.SetAPIKey(key)
+			return nil
+		})
+	}
+	if c.Target != nil {
+		s.Watch(TargetAPIKey, func(key string) error {
+			c.Target.SetAPIKey(key)
+			return nil
+		})
+	}
+	if c.PokemonTCG != nil {
+		s.Watch(PokemonTCGAPIKey, func(key string) error {
+			c.PokemonTCG.SetAPIKey(key)
+			return nil
+		})
+	}
+	if c.Admin != nil {
+		s.Watch(AdminNotify, func(value string) error {
+			notifier, err := newAdminNotifier(value)
+			if err != nil {
+				return err
+			}
+			c.Admin.SetNotifier(notifier)
+			return nil
+		})
+	}
+}
+
+// adminChannel is the value of the AdminNotify credential
+type adminChannel struct {
+	Channel string          `json:"channel"`
+	Config  json.RawMessage `json:"config"`
+}
+
+// newAdminNotifier creates the notifier an AdminNotify value describes; an
+// empty value turns the admin channel off
+func newAdminNotifier(value string) (notify.Notifier, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var ch adminChannel
+	if err := json.Unmarshal([]byte(value), &ch); err != nil {
+		return nil, fmt.Errorf(`want {"channel": ..., "config": {...}}: %w`, err)
+	}
+	return notify.New(ch.Channel, ch.Config)
+}
+
+// hint describes a value without revealing it
+func hint(name Name, value string) string {
+	if name == AdminNotify {
+		var ch adminChannel
+		if json.Unmarshal([]byte(value), &ch) == nil {
+			return ch.Channel
+		}
+		return ""
+	}
+	// Short keys would be mostly given away
+	if len(value) < 12 {
+		return ""
+	}
+	return value[len(value)-4:]
+}
+
+// apply sets a credential's value and passes it to its watchers, stopping at
+// the first that rejects it. Callers hold writeMu.
+func (s *Store) apply(name Name, value string) error {
+	s.mu.RLock()
+	applies := s.applies[name]
+	s.mu.RUnlock()
+
+	for _, fn := range applies {
+		if err := fn(value); err != nil {
+			return &InvalidValueError{Err: err}
+		}
+	}
+
+	s.mu.Lock()
+	s.values[name] = value
+	s.mu.Unlock()
+	return nil
+}
+
+// Load reads the stored credentials and applies any that changed, falling
+// back to the environment for ones no longer stored. Values that can't be
+// decrypted or applied are skipped and reported in the error.
+func (s *Store) Load(ctx context.Context) error {
+	stored, err := s.db.GetCredentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	want := make(map[Name]string, len(Names))
+	for _, name := range Names {
+		want[name] = s.defaults[name]
+	}
+	var errs []error
+	for _, c := range stored {
+		name, err := ParseName(c.Name)
+		if err != nil {
+			continue // from a newer build
+		}
+		value, err := s.box.open(c.Name, c.Value)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		want[name] = value
+	}
+
+	for _, name := range Names {
+		if want[name] == s.Get(name) {
+			continue
+		}
+		if err := s.apply(name, want[name]); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		log.Printf("Using updated %s", name)
+	}
+	return errors.Join(errs...)
+}
+
+// Run reloads the stored credentials until ctx is done
+func (s *Store) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Load(ctx); err != nil {
+				log.Printf("Error reloading credentials: %v", err)
+			}
+		}
+	}
+}
+
+// Set puts a new value into use and stores it, encrypted, for every process
+func (s *Store) Set(ctx context.Context, name Name, value string, userID int) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return &InvalidValueError{Err: errors.New("the value is empty; clear the credential to use the environment's")}
+	}
+	sealed, err := s.box.seal(string(name), value)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	previous := s.Get(name)
+	if err := s.apply(name, value); err != nil {
+		return err
+	}
+	if err := s.db.SaveCredential(ctx, string(name), sealed, userID); err != nil {
+		if err := s.apply(name, previous); err != nil {
+			log.Printf("Error restoring %s: %v", name, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// Clear removes an admin's value, going back to the environment's. It
+// reports false if the credential wasn't overridden.
+func (s *Store) Clear(ctx context.Context, name Name) (bool, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	removed, err := s.db.DeleteCredential(ctx, string(name))
+	if err != nil || !removed {
+		return removed, err
+	}
+	if err := s.apply(name, s.defaults[name]); err != nil {
+		log.Printf("Error restoring %s from the environment: %v", name, err)
+	}
+	return true, nil
+}
+
+// List describes every credential admins can set
+func (s *Store) List(ctx context.Context) ([]Credential, error) {
+	stored, err := s.db.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]database.StoredCredential, len(stored))
+	for _, c := range stored {
+		byName[c.Name] = c
+	}
+
+	creds := make([]Credential, 0, len(Names))
+	for _, name := range Names {
+		c := Credential{Name: name}
+		value := s.defaults[name]
+		if sc, ok := byName[string(name)]; ok {
+			if v, err := s.box.open(sc.Name, sc.Value); err == nil {
+				value = v
+			}
+			c.Overridden = true
+			c.UpdatedByEmail = sc.UpdatedByEmail
+			c.UpdatedAt = sc.UpdatedAt
+		}
+		c.Set = value != ""
+		c.Hint = hint(name, value)
+		creds = append(creds, c)
+	}
+	return creds, nil
+}
+
+// Describe describes one credential
+func (s *Store) Describe(ctx context.Context, name Name) (Credential, error) {
+	creds, err := s.List(ctx)
+	if err != nil {
+		return Credential{}, err
+	}
+	for _, c := range creds {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return Credential{}, fmt.Errorf("%w: %q", ErrUnknownName, name)
+}
@@ -0,0 +1,76 @@
+package credentials
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+var testKey = base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+
+func TestBoxRoundTrip(t *testing.T) {
+	b, err := newBox(testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := b.seal(string(BestBuyAPIKey), "abcd1234efgh5678")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(sealed), "abcd1234") {
+		t.Error("sealed value contains the plaintext")
+	}
+	got, err := b.open(string(BestBuyAPIKey), sealed)
+	if err != nil || got != "abcd1234efgh5678" {
+		t.Errorf("open = %q, %v; want the sealed value", got, err)
+	}
+
+	// A value copied to another credential doesn't open
+	if _, err := b.open(string(TargetAPIKey), sealed); err == nil {
+		t.Error("opened a value sealed for another credential")
+	}
+
+	other, err := newBox(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 32))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.open(string(BestBuyAPIKey), sealed); err == nil {
+		t.Error("opened a value with a different key")
+	}
+}
+
+func TestNewBoxRejectsBadKeys(t *testing.T) {
+	for _, key := range []string{"", "not base64!", base64.StdEncoding.EncodeToString([]byte("short"))} {
+		if _, err := newBox(key); err == nil {
+			t.Errorf("newBox(%q) succeeded, want an error", key)
+		}
+	}
+}
+
+func TestHint(t *testing.T) {
+	tests := []struct {
+		name  Name
+		value string
+		want  string
+	}{
+		{BestBuyAPIKey, "abcd1234efgh5678", "5678"},
+		{BestBuyAPIKey, "short", ""}, // would give most of it away
+		{AdminNotify, `{"channel":"gotify","config":{"app_token":"secret"}}`, "gotify"},
+		{AdminNotify, "", ""},
+	}
+	for _, tt := range tests {
+		if got := hint(tt.name, tt.value); got != tt.want {
+			t.Errorf("hint(%s, %q) = %q, want %q", tt.name, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestParseName(t *testing.T) {
+	if name, err := ParseName(" bestbuy_api_key "); err != nil || name != BestBuyAPIKey {
+		t.Errorf("ParseName = %q, %v; want %q", name, err, BestBuyAPIKey)
+	}
+	if _, err := ParseName("DATABASE_URL"); err == nil {
+		t.Error("ParseName accepted a credential that can't be rotated")
+	}
+}
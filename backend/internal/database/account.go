@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// userTable is a table holding a user's data, for exports
+type userTable struct {
+	name   string
+	from   string   // FROM clause selecting the user's rows as t; $1 is the user ID
+	secret []string // columns left out of exports
+}
+
+// userTables lists every table with a user's data. Deleting the user deletes
+// their rows in all of them.
+var userTables = []userTable{
+	{name: "users", from: "users t WHERE t.id = $1"},
+	{name: "user_identities", from: "user_identities t WHERE t.user_id = $1"},
+	{name: "sessions", from: "sessions t WHERE t.user_id = $1", secret: []string{"token"}},
+	{name: "api_keys", from: "api_keys t WHERE t.user_id = $1", secret: []string{"key_hash"}},
+	{name: "user_stores", from: "user_stores t WHERE t.user_id = $1"},
+	{name: "user_products", from: "user_products t WHERE t.user_id = $1"},
+	{name: "user_locations", from: "user_locations t WHERE t.user_id = $1"},
+	{name: "set_watches", from: "set_watches t WHERE t.user_id = $1"},
+	{name: "product_watches", from: "product_watches t WHERE t.user_id = $1"},
+	{name: "product_watch_skus", from: "product_watch_skus t JOIN product_watches w ON w.id = t.watch_id WHERE w.user_id = $1"},
+	{name: "watchlist_changes", from: "watchlist_changes t WHERE t.user_id = $1"},
+	{name: "deleted_items", from: "deleted_items t WHERE t.user_id = $1"},
+	{name: "notification_channels", from: "notification_channels t WHERE t.user_id = $1"},
+	{name: "notification_preferences", from: "notification_preferences t WHERE t.user_id = $1"},
+	{name: "notification_templates", from: "notification_templates t WHERE t.user_id = $1"},
+	{name: "notification_alerts", from: "notification_alerts t WHERE t.user_id = $1", secret: []string{"token"}},
+	{name: "alert_rules", from: "alert_rules t WHERE t.user_id = $1"},
+	{name: "alert_digest_items", from: "alert_digest_items t WHERE t.user_id = $1"},
+	{name: "acquisitions", from: "acquisitions t WHERE t.user_id = $1"},
+	{name: "stock_confirmations", from: "stock_confirmations t WHERE t.user_id = $1"},
+	{name: "sightings", from: "sightings t WHERE t.user_id = $1"},
+	{name: "user_usage", from: "user_usage t WHERE t.user_id = $1"},
+	{name: "inbound_addresses", from: "inbound_addresses t WHERE t.user_id = $1", secret: []string{"token"}},
+	{name: "reminders", from: "reminders t WHERE t.user_id = $1"},
+}
+
+// ExportUserData dumps a user's rows in every table as a JSON object keyed by
+// table, from one consistent snapshot. Session tokens and key hashes are left out.
+func (db *DB) ExportUserData(ctx context.Context, userID int) ([]byte, error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tables := make(map[string]json.RawMessage, len(userTables))
+	for _, t := range userTables {
+		var rows []byte
+		if err := tx.QueryRowContext(ctx,
+			`SELECT COALESCE(jsonb_agg(to_jsonb(t) - $2::text[]), '[]'::jsonb) FROM `+t.from,
+			userID, pq.Array(t.secret),
+		).Scan(&rows); err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", t.name, err)
+		}
+		tables[t.name] = rows
+	}
+
+	return json.MarshalIndent(struct {
+		SchemaVersion int                        `json:"schema_version"`
+		ExportedAt    time.Time                  `json:"exported_at"`
+		Tables        map[string]json.RawMessage `json:"tables"`
+	}{SchemaVersion, time.Now().UTC(), tables}, "", "  ")
+}
+
+// DeleteUser deletes a user and, through their foreign keys, all their data,
+// along with magic links sent to their email. It returns false if there's no
+// such user or they're the only admin.
+func (db *DB) DeleteUser(ctx context.Context, userID int) (bool, error) {
+	var email string
+	err := db.QueryRowContext(ctx,
+		`DELETE FROM users
+		 WHERE id = $1 AND (role <> $2 OR (SELECT COUNT(*) FROM users WHERE role = $2) > 1)
+		 RETURNING email`,
+		userID, RoleAdmin,
+	).Scan(&email)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := db.ExecContext(ctx, "DELETE FROM login_links WHERE email = LOWER($1)", email); err != nil {
+		return true, err
+	}
+	return true, nil
+}
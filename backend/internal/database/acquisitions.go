@@ -0,0 +1,84 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// Acquisition is a purchase a user recorded against one of their saved products
+type Acquisition struct {
+	ID           int
+	UserID       int
+	Retailer     retailer.ID
+	SKU          string
+	ProductName  string
+	SetName      string // TCG set of the product; empty if none
+	Quantity     int
+	Price        money.Cents // per item, in CurrencyCode
+	CurrencyCode string      // empty for USD
+	StoreName    string      // empty if not given
+	PurchasedOn  time.Time   // date only, UTC
+	CreatedAt    time.Time
+}
+
+// GetUserAcquisitions gets a user's purchases made from the start of from
+// until the end of until, newest first. Zero times leave that end open.
+func (db *DB) GetUserAcquisitions(ctx context.Context, userID int, from, until time.Time) ([]Acquisition, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, user_id, retailer, sku, product_name, set_name, quantity, price_cents, currency_code, store_name, purchased_on, created_at
+		 FROM acquisitions
+		 WHERE user_id = $1 AND ($2::date IS NULL OR purchased_on >= $2) AND ($3::date IS NULL OR purchased_on <= $3)
+		 ORDER BY purchased_on DESC, id DESC`,
+		userID, nullDate(from), nullDate(until),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var acquisitions []Acquisition
+	for rows.Next() {
+		var a Acquisition
+		if err := rows.Scan(&a.ID, &a.UserID, &a.Retailer, &a.SKU, &a.ProductName, &a.SetName, &a.Quantity, &a.Price, &a.CurrencyCode, &a.StoreName, &a.PurchasedOn, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		acquisitions = append(acquisitions, a)
+	}
+	return acquisitions, rows.Err()
+}
+
+// AddAcquisition records a purchase, returning its ID
+func (db *DB) AddAcquisition(ctx context.Context, a Acquisition) (int, error) {
+	var id int
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO acquisitions (user_id, retailer, sku, product_name, set_name, quantity, price_cents, currency_code, store_name, purchased_on)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		 RETURNING id`,
+		a.UserID, orBestBuy(a.Retailer), a.SKU, a.ProductName, a.SetName, a.Quantity, a.Price, a.CurrencyCode, a.StoreName, a.PurchasedOn.Format(time.DateOnly),
+	).Scan(&id)
+	return id, err
+}
+
+// DeleteAcquisition removes one of a user's purchases, reporting whether it existed
+func (db *DB) DeleteAcquisition(ctx context.Context, userID, id int) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		"DELETE FROM acquisitions WHERE user_id = $1 AND id = $2",
+		userID, id,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// nullDate returns t as a date parameter, or nil if t is zero
+func nullDate(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,148 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// AllowedEmail is an email address allowed to sign in
+type AllowedEmail struct {
+	Email        string
+	AddedByEmail string // empty if seeded from config
+	CreatedAt    time.Time
+}
+
+// GetAllowedEmails gets the allowed email addresses, alphabetically
+func (db *DB) GetAllowedEmails(ctx context.Context) ([]AllowedEmail, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT a.email, COALESCE(u.email, ''), a.created_at
+		 FROM allowed_emails a
+		 LEFT JOIN users u ON u.id = a.added_by
+		 ORDER BY a.email`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []AllowedEmail
+	for rows.Next() {
+		var e AllowedEmail
+		if err := rows.Scan(&e.Email, &e.AddedByEmail, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		emails = append(emails, e)
+	}
+	return emails, rows.Err()
+}
+
+// RemoveAllowedEmail removes an email from the whitelist and signs out any
+// user with it, returning false if it wasn't allowed
+func (db *DB) RemoveAllowedEmail(ctx context.Context, email string) (bool, error) {
+	var removed int
+	err := db.QueryRowContext(ctx,
+		`WITH removed AS (
+		   DELETE FROM allowed_emails WHERE LOWER(email) = LOWER($1) RETURNING email
+		 ), signed_out AS (
+		   DELETE FROM sessions WHERE user_id IN (
+		     SELECT u.id FROM users u JOIN removed r ON LOWER(u.email) = LOWER(r.email)
+		   )
+		 )
+		 SELECT COUNT(*) FROM removed`,
+		email,
+	).Scan(&removed)
+	return removed > 0, err
+}
+
+// IsAllowedEmailListed checks if an email is in the whitelist itself, not
+// just by its domain
+func (db *DB) IsAllowedEmailListed(ctx context.Context, email string) (bool, error) {
+	var listed bool
+	err := db.QueryRowContext(ctx,
+		"SELECT EXISTS (SELECT 1 FROM allowed_emails WHERE LOWER(email) = LOWER($1))",
+		email,
+	).Scan(&listed)
+	return listed, err
+}
+
+// AllowedDomain is an email domain whose addresses are all allowed to sign in
+type AllowedDomain struct {
+	Domain       string
+	AddedByEmail string // empty if seeded from config
+	CreatedAt    time.Time
+}
+
+// AddAllowedDomain allows every address at a lowercase domain to sign in
+func (db *DB) AddAllowedDomain(ctx context.Context, domain string, addedBy *int) error {
+	_, err := db.ExecContext(ctx,
+		"INSERT INTO allowed_domains (domain, added_by) VALUES (LOWER($1), $2) ON CONFLICT (domain) DO NOTHING",
+		domain, addedBy,
+	)
+	return err
+}
+
+// GetAllowedDomains gets the allowed email domains, alphabetically
+func (db *DB) GetAllowedDomains(ctx context.Context) ([]AllowedDomain, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT d.domain, COALESCE(u.email, ''), d.created_at
+		 FROM allowed_domains d
+		 LEFT JOIN users u ON u.id = d.added_by
+		 ORDER BY d.domain`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var domains []AllowedDomain
+	for rows.Next() {
+		var d AllowedDomain
+		if err := rows.Scan(&d.Domain, &d.AddedByEmail, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+		domains = append(domains, d)
+	}
+	return domains, rows.Err()
+}
+
+// RemoveAllowedDomain removes a domain from the whitelist and signs out its
+// users who aren't allowed by their own address, returning false if it
+// wasn't allowed
+func (db *DB) RemoveAllowedDomain(ctx context.Context, domain string) (bool, error) {
+	var removed int
+	err := db.QueryRowContext(ctx,
+		`WITH removed AS (
+		   DELETE FROM allowed_domains WHERE domain = LOWER($1) RETURNING domain
+		 ), signed_out AS (
+		   DELETE FROM sessions WHERE user_id IN (
+		     SELECT u.id FROM users u JOIN removed r ON LOWER(SPLIT_PART(u.email, '@', 2)) = r.domain
+		     WHERE NOT EXISTS (SELECT 1 FROM allowed_emails a WHERE LOWER(a.email) = LOWER(u.email))
+		   )
+		 )
+		 SELECT COUNT(*) FROM removed`,
+		domain,
+	).Scan(&removed)
+	return removed > 0, err
+}
+
+// GetUsers gets every user, oldest first
+func (db *DB) GetUsers(ctx context.Context) ([]User, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, COALESCE(google_id, ''), email, name, picture_url, locale, role, created_at, updated_at
+		 FROM users ORDER BY id`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []User
+	for rows.Next() {
+		var u User
+		if err := rows.Scan(&u.ID, &u.GoogleID, &u.Email, &u.Name, &u.PictureURL, &u.Locale, &u.Role, &u.CreatedAt, &u.UpdatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// APIKey is a key a user created for programmatic access. The key itself is
+// only shown when created; Prefix identifies it afterwards.
+type APIKey struct {
+	ID         int
+	UserID     int
+	Name       string
+	Prefix     string
+	LastUsedAt time.Time // zero if never used
+	CreatedAt  time.Time
+}
+
+// CreateAPIKey saves a new key for a user by the SHA-256 hash of the key
+func (db *DB) CreateAPIKey(ctx context.Context, userID int, name, prefix, keyHash string) (APIKey, error) {
+	k := APIKey{UserID: userID, Name: name, Prefix: prefix}
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO api_keys (user_id, name, prefix, key_hash)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id, created_at`,
+		userID, name, prefix, keyHash,
+	).Scan(&k.ID, &k.CreatedAt)
+	return k, err
+}
+
+// GetUserAPIKeys gets a user's API keys, oldest first
+func (db *DB) GetUserAPIKeys(ctx context.Context, userID int) ([]APIKey, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, user_id, name, prefix, COALESCE(last_used_at, 'epoch'::timestamptz), created_at
+		 FROM api_keys WHERE user_id = $1 ORDER BY id`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []APIKey
+	for rows.Next() {
+		var k APIKey
+		if err := rows.Scan(&k.ID, &k.UserID, &k.Name, &k.Prefix, &k.LastUsedAt, &k.CreatedAt); err != nil {
+			return nil, err
+		}
+		if k.LastUsedAt.Equal(time.Unix(0, 0)) {
+			k.LastUsedAt = time.Time{}
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// DeleteAPIKey revokes one of a user's API keys, returning false if they have no such key
+func (db *DB) DeleteAPIKey(ctx context.Context, userID, id int) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		"DELETE FROM api_keys WHERE id = $1 AND user_id = $2",
+		id, userID,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// GetUserByAPIKey gets the owner of the key with the given hash, or nil if
+// there's no such key, and records that the key was used. Use is recorded
+// at most once a minute so busy scripts don't write on every call.
+func (db *DB) GetUserByAPIKey(ctx context.Context, keyHash string) (*User, error) {
+	var user User
+	err := db.QueryRowContext(ctx,
+		`WITH key AS (
+		   SELECT id, user_id FROM api_keys WHERE key_hash = $1
+		 ), used AS (
+		   UPDATE api_keys SET last_used_at = CURRENT_TIMESTAMP
+		   WHERE id IN (SELECT id FROM key)
+		     AND (last_used_at IS NULL OR last_used_at < CURRENT_TIMESTAMP - INTERVAL '1 minute')
+		 )
+		 SELECT u.id, COALESCE(u.google_id, ''), u.email, u.name, u.picture_url, u.locale, u.role, u.created_at, u.updated_at
+		 FROM users u JOIN key ON key.user_id = u.id`,
+		keyHash,
+	).Scan(&user.ID, &user.GoogleID, &user.Email, &user.Name, &user.PictureURL, &user.Locale, &user.Role, &user.CreatedAt, &user.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/reliability"
+)
+
+// ConfirmStock records whether a user found a product on a store's shelf,
+// replacing their earlier answer for the same product and store today
+func (db *DB) ConfirmStock(ctx context.Context, userID int, sku, storeID string, found bool) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO stock_confirmations (user_id, sku, store_id, found)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (user_id, sku, store_id, confirmed_on) DO UPDATE SET
+		   found = EXCLUDED.found,
+		   created_at = CURRENT_TIMESTAMP`,
+		userID, sku, storeID, found,
+	)
+	return err
+}
+
+// GetStoreTallies counts the recent confirmations at each store. Stores
+// without any are left out.
+func (db *DB) GetStoreTallies(ctx context.Context, storeIDs []string) (map[string]reliability.Tally, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT store_id, COUNT(*) FILTER (WHERE found), COUNT(*)
+		 FROM stock_confirmations
+		 WHERE store_id = ANY($1) AND confirmed_on > CURRENT_DATE - $2::int
+		 GROUP BY store_id`,
+		pq.Array(storeIDs), reliability.WindowDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tallies := make(map[string]reliability.Tally)
+	for rows.Next() {
+		var id string
+		var t reliability.Tally
+		if err := rows.Scan(&id, &t.Found, &t.Total); err != nil {
+			return nil, err
+		}
+		tallies[id] = t
+	}
+	return tallies, rows.Err()
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/geo"
+)
+
+// SaveStoreCoordinates records where stores are, keyed by store ID
+func (db *DB) SaveStoreCoordinates(ctx context.Context, coordinates map[string]geo.Point) error {
+	for storeID, p := range coordinates {
+		if !p.Valid() {
+			continue
+		}
+		if _, err := db.ExecContext(ctx,
+			`INSERT INTO store_coordinates (store_id, lat, lng)
+			 VALUES ($1, $2, $3)
+			 ON CONFLICT (store_id) DO UPDATE SET
+			   lat = EXCLUDED.lat,
+			   lng = EXCLUDED.lng,
+			   updated_at = CURRENT_TIMESTAMP`,
+			storeID, p.Lat, p.Lng,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetStoreCoordinates gets the known coordinates of the given stores.
+// Stores that have never shown up in a store search are missing from the result.
+func (db *DB) GetStoreCoordinates(ctx context.Context, storeIDs []string) (map[string]geo.Point, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT store_id, lat, lng FROM store_coordinates WHERE store_id = ANY($1)",
+		pq.Array(storeIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	coordinates := make(map[string]geo.Point)
+	for rows.Next() {
+		var id string
+		var p geo.Point
+		if err := rows.Scan(&id, &p.Lat, &p.Lng); err != nil {
+			return nil, err
+		}
+		coordinates[id] = p
+	}
+	return coordinates, rows.Err()
+}
@@ -0,0 +1,62 @@
+package database
+
+import (
+	"context"
+	"time"
+)
+
+// StoredCredential is an encrypted credential set by an admin
+type StoredCredential struct {
+	Name           string
+	Value          []byte // sealed; only the credentials package can open it
+	UpdatedByEmail string // empty if the admin has since been deleted
+	UpdatedAt      time.Time
+}
+
+// GetCredentials gets every stored credential
+func (db *DB) GetCredentials(ctx context.Context) ([]StoredCredential, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT c.name, c.value, COALESCE(u.email, ''), c.updated_at
+		 FROM credentials c
+		 LEFT JOIN users u ON u.id = c.updated_by
+		 ORDER BY c.name`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var creds []StoredCredential
+	for rows.Next() {
+		var c StoredCredential
+		if err := rows.Scan(&c.Name, &c.Value, &c.UpdatedByEmail, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		creds = append(creds, c)
+	}
+	return creds, rows.Err()
+}
+
+// SaveCredential creates or replaces a stored credential
+func (db *DB) SaveCredential(ctx context.Context, name string, value []byte, userID int) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO credentials (name, value, updated_by)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (name) DO UPDATE SET
+		   value = EXCLUDED.value,
+		   updated_by = EXCLUDED.updated_by,
+		   updated_at = CURRENT_TIMESTAMP`,
+		name, value, userID,
+	)
+	return err
+}
+
+// DeleteCredential removes a stored credential, reporting whether there was one
+func (db *DB) DeleteCredential(ctx context.Context, name string) (bool, error) {
+	result, err := db.ExecContext(ctx, "DELETE FROM credentials WHERE name = $1", name)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
@@ -4,12 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
-	"os"
-	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
 )
 
 // Note: Migrations are read from the migrations directory at runtime
@@ -42,40 +43,15 @@ func New(databaseURL string) (*DB, error) {
 	return &DB{db}, nil
 }
 
-// RunMigrations runs all SQL migrations
-func (db *DB) RunMigrations(migrationsDir string) error {
-	// Find migration files
-	files, err := filepath.Glob(filepath.Join(migrationsDir, "*.sql"))
-	if err != nil {
-		return fmt.Errorf("failed to find migrations: %w", err)
-	}
-
-	for _, file := range files {
-		migration, err := os.ReadFile(file)
-		if err != nil {
-			return fmt.Errorf("failed to read migration %s: %w", file, err)
-		}
-
-		// Execute migration
-		_, err = db.Exec(string(migration))
-		if err != nil {
-			return fmt.Errorf("failed to run migration %s: %w", file, err)
-		}
-
-		log.Printf("Applied migration: %s", filepath.Base(file))
-	}
-
-	log.Println("Database migrations completed successfully")
-	return nil
-}
-
 // User represents a user in the database
 type User struct {
 	ID         int
-	GoogleID   string
+	GoogleID   string // empty for users who never signed in with Google; see user_identities
 	Email      string
 	Name       string
 	PictureURL string
+	Locale     string
+	Role       Role
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -84,6 +60,7 @@ type User struct {
 type Store struct {
 	ID         int
 	UserID     int
+	Retailer   retailer.ID
 	StoreID    string
 	Name       string
 	Address    string
@@ -92,40 +69,69 @@ type Store struct {
 	PostalCode string
 	Phone      string
 	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
 // Product represents a saved product
 type Product struct {
 	ID           int
 	UserID       int
+	Retailer     retailer.ID
 	SKU          string
 	Name         string
-	SalePrice    float64
+	SalePrice    money.Cents
 	ThumbnailURL string
 	ProductURL   string
+	UPC          string // empty if unknown
+	ModelNumber  string // empty if unknown
+	SetName      string // TCG set parsed from the name; empty if none
+	ProductType  string // tcg.ProductType parsed from the name
+	Priority     string // PriorityMustHave, PriorityNiceToHave or empty for neither
 	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
+// Saved product priorities, which route their alerts
+const (
+	PriorityMustHave   = "must_have"    // alerts go to the user's urgent channels
+	PriorityNiceToHave = "nice_to_have" // alerts wait for the user's digest
+)
+
 // Session represents an auth session
 type Session struct {
-	ID        int
-	Token     string
-	UserID    int
-	ExpiresAt time.Time
-	CreatedAt time.Time
+	ID         int
+	Token      string
+	UserID     int
+	UserAgent  string // of the browser that signed in
+	IPAddress  string // the session signed in from
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	LastSeenAt time.Time
 }
 
-// IsEmailAllowed checks if an email is in the whitelist
+// IsEmailAllowed checks if an email is in the whitelist, either itself or
+// by its domain. Callers only pass addresses the provider verified.
 func (db *DB) IsEmailAllowed(ctx context.Context, email string) (bool, error) {
-	var count int
+	var allowed bool
 	err := db.QueryRowContext(ctx,
-		"SELECT COUNT(*) FROM allowed_emails WHERE LOWER(email) = LOWER($1)",
-		email,
-	).Scan(&count)
+		`SELECT EXISTS (SELECT 1 FROM allowed_emails WHERE LOWER(email) = LOWER($1))
+		     OR EXISTS (SELECT 1 FROM allowed_domains WHERE domain = LOWER($2))`,
+		email, EmailDomain(email),
+	).Scan(&allowed)
 	if err != nil {
 		return false, err
 	}
-	return count > 0, nil
+	return allowed, nil
+}
+
+// EmailDomain returns the domain of an email address, e.g. "example.com",
+// or an empty string if it has none
+func EmailDomain(email string) string {
+	i := strings.LastIndex(email, "@")
+	if i < 0 {
+		return ""
+	}
+	return email[i+1:]
 }
 
 // AddAllowedEmail adds an email to the whitelist
@@ -137,78 +143,127 @@ func (db *DB) AddAllowedEmail(ctx context.Context, email string, addedBy *int) e
 	return err
 }
 
-// GetOrCreateUser gets or creates a user by Google ID
-func (db *DB) GetOrCreateUser(ctx context.Context, googleID, email, name, pictureURL string) (*User, error) {
-	var user User
-	err := db.QueryRowContext(ctx,
-		`INSERT INTO users (google_id, email, name, picture_url)
-		 VALUES ($1, $2, $3, $4)
-		 ON CONFLICT (google_id) DO UPDATE SET
-		   email = EXCLUDED.email,
-		   name = EXCLUDED.name,
-		   picture_url = EXCLUDED.picture_url,
-		   updated_at = CURRENT_TIMESTAMP
-		 RETURNING id, google_id, email, name, picture_url, created_at, updated_at`,
-		googleID, email, name, pictureURL,
-	).Scan(&user.ID, &user.GoogleID, &user.Email, &user.Name, &user.PictureURL, &user.CreatedAt, &user.UpdatedAt)
-	if err != nil {
-		return nil, err
-	}
-	return &user, nil
-}
-
 // GetUserByID gets a user by ID
 func (db *DB) GetUserByID(ctx context.Context, id int) (*User, error) {
 	var user User
 	err := db.QueryRowContext(ctx,
-		"SELECT id, google_id, email, name, picture_url, created_at, updated_at FROM users WHERE id = $1",
+		"SELECT id, COALESCE(google_id, ''), email, name, picture_url, locale, role, created_at, updated_at FROM users WHERE id = $1",
 		id,
-	).Scan(&user.ID, &user.GoogleID, &user.Email, &user.Name, &user.PictureURL, &user.CreatedAt, &user.UpdatedAt)
+	).Scan(&user.ID, &user.GoogleID, &user.Email, &user.Name, &user.PictureURL, &user.Locale, &user.Role, &user.CreatedAt, &user.UpdatedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &user, nil
 }
 
+// SetUserLocale updates a user's locale
+func (db *DB) SetUserLocale(ctx context.Context, userID int, locale string) error {
+	_, err := db.ExecContext(ctx,
+		"UPDATE users SET locale = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2",
+		locale, userID,
+	)
+	return err
+}
+
 // CreateSession creates a new session for a user
-func (db *DB) CreateSession(ctx context.Context, userID int, token string, expiresAt time.Time) error {
+func (db *DB) CreateSession(ctx context.Context, s Session) error {
 	_, err := db.ExecContext(ctx,
-		"INSERT INTO sessions (user_id, token, expires_at) VALUES ($1, $2, $3)",
-		userID, token, expiresAt,
+		"INSERT INTO sessions (user_id, token, user_agent, ip_address, expires_at) VALUES ($1, $2, $3, $4, $5)",
+		s.UserID, s.Token, s.UserAgent, s.IPAddress, s.ExpiresAt,
 	)
 	return err
 }
 
-// GetSession gets a valid session by token
+// GetSession gets a valid session by token and records that it was used.
+// Use is recorded at most once a minute so page loads don't all write.
 func (db *DB) GetSession(ctx context.Context, token string) (*Session, error) {
 	var session Session
 	err := db.QueryRowContext(ctx,
-		"SELECT id, token, user_id, expires_at, created_at FROM sessions WHERE token = $1 AND expires_at > NOW()",
+		`WITH session AS (
+		   SELECT id, token, user_id, user_agent, ip_address, expires_at, created_at, COALESCE(last_seen_at, created_at) AS last_seen_at
+		   FROM sessions WHERE token = $1 AND expires_at > NOW()
+		 ), seen AS (
+		   UPDATE sessions SET last_seen_at = CURRENT_TIMESTAMP
+		   WHERE id IN (SELECT id FROM session)
+		     AND (last_seen_at IS NULL OR last_seen_at < CURRENT_TIMESTAMP - INTERVAL '1 minute')
+		 )
+		 SELECT id, token, user_id, user_agent, ip_address, expires_at, created_at, last_seen_at FROM session`,
 		token,
-	).Scan(&session.ID, &session.Token, &session.UserID, &session.ExpiresAt, &session.CreatedAt)
+	).Scan(&session.ID, &session.Token, &session.UserID, &session.UserAgent, &session.IPAddress,
+		&session.ExpiresAt, &session.CreatedAt, &session.LastSeenAt)
 	if err != nil {
 		return nil, err
 	}
 	return &session, nil
 }
 
+// GetUserSessions gets a user's unexpired sessions, most recently used first
+func (db *DB) GetUserSessions(ctx context.Context, userID int) ([]Session, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, token, user_id, user_agent, ip_address, expires_at, created_at, COALESCE(last_seen_at, created_at)
+		 FROM sessions WHERE user_id = $1 AND expires_at > NOW()
+		 ORDER BY COALESCE(last_seen_at, created_at) DESC, id DESC`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []Session
+	for rows.Next() {
+		var s Session
+		if err := rows.Scan(&s.ID, &s.Token, &s.UserID, &s.UserAgent, &s.IPAddress,
+			&s.ExpiresAt, &s.CreatedAt, &s.LastSeenAt); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
 // DeleteSession deletes a session by token
 func (db *DB) DeleteSession(ctx context.Context, token string) error {
 	_, err := db.ExecContext(ctx, "DELETE FROM sessions WHERE token = $1", token)
 	return err
 }
 
+// DeleteUserSession deletes one of a user's sessions by ID, reporting
+// whether the user had it
+func (db *DB) DeleteUserSession(ctx context.Context, userID, sessionID int) (bool, error) {
+	result, err := db.ExecContext(ctx, "DELETE FROM sessions WHERE id = $1 AND user_id = $2", sessionID, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// DeleteUserSessions signs a user out everywhere, returning how many
+// unexpired sessions were deleted
+func (db *DB) DeleteUserSessions(ctx context.Context, userID int) (int, error) {
+	var count int
+	err := db.QueryRowContext(ctx,
+		`WITH deleted AS (
+		   DELETE FROM sessions WHERE user_id = $1 RETURNING expires_at
+		 )
+		 SELECT COUNT(*) FROM deleted WHERE expires_at > NOW()`,
+		userID,
+	).Scan(&count)
+	return count, err
+}
+
 // CleanExpiredSessions removes expired sessions
 func (db *DB) CleanExpiredSessions(ctx context.Context) error {
 	_, err := db.ExecContext(ctx, "DELETE FROM sessions WHERE expires_at < NOW()")
 	return err
 }
 
-// GetUserStores gets all stores for a user
-func (db *DB) GetUserStores(ctx context.Context, userID int) ([]Store, error) {
+// GetUserStores gets a user's stores for a retailer, or for every retailer if r is empty
+func (db *DB) GetUserStores(ctx context.Context, userID int, r retailer.ID) ([]Store, error) {
 	rows, err := db.QueryContext(ctx,
-		"SELECT id, user_id, store_id, name, address, city, state, postal_code, phone, created_at FROM user_stores WHERE user_id = $1 ORDER BY created_at DESC",
-		userID,
+		"SELECT id, user_id, retailer, store_id, name, address, city, state, postal_code, phone, created_at, COALESCE(updated_at, created_at) FROM user_stores WHERE user_id = $1 AND ($2 = '' OR retailer = $2) ORDER BY created_at DESC",
+		userID, r,
 	)
 	if err != nil {
 		return nil, err
@@ -218,7 +273,7 @@ func (db *DB) GetUserStores(ctx context.Context, userID int) ([]Store, error) {
 	var stores []Store
 	for rows.Next() {
 		var s Store
-		if err := rows.Scan(&s.ID, &s.UserID, &s.StoreID, &s.Name, &s.Address, &s.City, &s.State, &s.PostalCode, &s.Phone, &s.CreatedAt); err != nil {
+		if err := rows.Scan(&s.ID, &s.UserID, &s.Retailer, &s.StoreID, &s.Name, &s.Address, &s.City, &s.State, &s.PostalCode, &s.Phone, &s.CreatedAt, &s.UpdatedAt); err != nil {
 			return nil, err
 		}
 		stores = append(stores, s)
@@ -226,31 +281,50 @@ func (db *DB) GetUserStores(ctx context.Context, userID int) ([]Store, error) {
 	return stores, rows.Err()
 }
 
-// AddUserStore adds a store to user's list
+// AddUserStore adds a store to user's list. Stores without a retailer are Best Buy stores.
 func (db *DB) AddUserStore(ctx context.Context, userID int, store Store) error {
-	_, err := db.ExecContext(ctx,
-		`INSERT INTO user_stores (user_id, store_id, name, address, city, state, postal_code, phone)
-		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-		 ON CONFLICT (user_id, store_id) DO NOTHING`,
-		userID, store.StoreID, store.Name, store.Address, store.City, store.State, store.PostalCode, store.Phone,
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	r := orBestBuy(store.Retailer)
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO user_stores (user_id, retailer, store_id, name, address, city, state, postal_code, phone)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		 ON CONFLICT (user_id, retailer, store_id) DO NOTHING`,
+		userID, r, store.StoreID, store.Name, store.Address, store.City, store.State, store.PostalCode, store.Phone,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return err
+	}
+
+	if err := recordWatchlistChange(ctx, tx, userID, WatchlistChange{
+		Kind:     DeletedStore,
+		Retailer: r,
+		ItemID:   store.StoreID,
+		Action:   WatchlistAdded,
+		Name:     store.Name,
+	}); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
-// RemoveUserStore removes a store from user's list
-func (db *DB) RemoveUserStore(ctx context.Context, userID int, storeID string) error {
-	_, err := db.ExecContext(ctx,
-		"DELETE FROM user_stores WHERE user_id = $1 AND store_id = $2",
-		userID, storeID,
-	)
-	return err
+// RemoveUserStore removes a retailer's store from user's list
+func (db *DB) RemoveUserStore(ctx context.Context, userID int, r retailer.ID, storeID string) error {
+	return db.removeSavedItem(ctx, userID, DeletedStore, orBestBuy(r), storeID)
 }
 
-// GetUserProducts gets all products for a user
-func (db *DB) GetUserProducts(ctx context.Context, userID int) ([]Product, error) {
+// GetUserProducts gets a user's products for a retailer, or for every retailer if r is empty
+func (db *DB) GetUserProducts(ctx context.Context, userID int, r retailer.ID) ([]Product, error) {
 	rows, err := db.QueryContext(ctx,
-		"SELECT id, user_id, sku, name, sale_price, thumbnail_url, product_url, created_at FROM user_products WHERE user_id = $1 ORDER BY created_at DESC",
-		userID,
+		"SELECT id, user_id, retailer, sku, name, COALESCE(sale_price_cents, 0), thumbnail_url, product_url, upc, model_number, set_name, product_type, priority, created_at, COALESCE(updated_at, created_at) FROM user_products WHERE user_id = $1 AND ($2 = '' OR retailer = $2) ORDER BY created_at DESC",
+		userID, r,
 	)
 	if err != nil {
 		return nil, err
@@ -260,7 +334,7 @@ func (db *DB) GetUserProducts(ctx context.Context, userID int) ([]Product, error
 	var products []Product
 	for rows.Next() {
 		var p Product
-		if err := rows.Scan(&p.ID, &p.UserID, &p.SKU, &p.Name, &p.SalePrice, &p.ThumbnailURL, &p.ProductURL, &p.CreatedAt); err != nil {
+		if err := rows.Scan(&p.ID, &p.UserID, &p.Retailer, &p.SKU, &p.Name, &p.SalePrice, &p.ThumbnailURL, &p.ProductURL, &p.UPC, &p.ModelNumber, &p.SetName, &p.ProductType, &p.Priority, &p.CreatedAt, &p.UpdatedAt); err != nil {
 			return nil, err
 		}
 		products = append(products, p)
@@ -268,22 +342,97 @@ func (db *DB) GetUserProducts(ctx context.Context, userID int) ([]Product, error
 	return products, rows.Err()
 }
 
-// AddUserProduct adds a product to user's list
+// GetUserProduct gets one of the user's saved products, or nil if they haven't saved it
+func (db *DB) GetUserProduct(ctx context.Context, userID int, r retailer.ID, sku string) (*Product, error) {
+	var p Product
+	err := db.QueryRowContext(ctx,
+		"SELECT id, user_id, retailer, sku, name, COALESCE(sale_price_cents, 0), thumbnail_url, product_url, upc, model_number, set_name, product_type, priority, created_at, COALESCE(updated_at, created_at) FROM user_products WHERE user_id = $1 AND retailer = $2 AND sku = $3",
+		userID, orBestBuy(r), sku,
+	).Scan(&p.ID, &p.UserID, &p.Retailer, &p.SKU, &p.Name, &p.SalePrice, &p.ThumbnailURL, &p.ProductURL, &p.UPC, &p.ModelNumber, &p.SetName, &p.ProductType, &p.Priority, &p.CreatedAt, &p.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// AddUserProduct adds a product to user's list. Products without a retailer are Best Buy products.
 func (db *DB) AddUserProduct(ctx context.Context, userID int, product Product) error {
-	_, err := db.ExecContext(ctx,
-		`INSERT INTO user_products (user_id, sku, name, sale_price, thumbnail_url, product_url)
-		 VALUES ($1, $2, $3, $4, $5, $6)
-		 ON CONFLICT (user_id, sku) DO NOTHING`,
-		userID, product.SKU, product.Name, product.SalePrice, product.ThumbnailURL, product.ProductURL,
+	_, err := db.changeUserProduct(ctx, userID, WatchlistAdded, product,
+		`INSERT INTO user_products (user_id, retailer, sku, name, sale_price_cents, thumbnail_url, product_url, upc, model_number, set_name, product_type, priority)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		 ON CONFLICT (user_id, retailer, sku) DO NOTHING`,
 	)
 	return err
 }
 
-// RemoveUserProduct removes a product from user's list
-func (db *DB) RemoveUserProduct(ctx context.Context, userID int, sku string) error {
-	_, err := db.ExecContext(ctx,
-		"DELETE FROM user_products WHERE user_id = $1 AND sku = $2",
-		userID, sku,
+// UpdateUserProduct replaces the details of a saved product, keeping its UPC
+// and model number unless new ones are given. It returns false if the user hasn't saved it.
+func (db *DB) UpdateUserProduct(ctx context.Context, userID int, product Product) (bool, error) {
+	return db.changeUserProduct(ctx, userID, WatchlistUpdated, product,
+		`UPDATE user_products
+		 SET name = $4, sale_price_cents = $5, thumbnail_url = $6, product_url = $7,
+		     upc = COALESCE(NULLIF($8, ''), upc), model_number = COALESCE(NULLIF($9, ''), model_number),
+		     set_name = $10, product_type = $11, priority = $12, updated_at = CURRENT_TIMESTAMP
+		 WHERE user_id = $1 AND retailer = $2 AND sku = $3`,
 	)
-	return err
+}
+
+// changeUserProduct runs an insert or update of a saved product, with the set
+// and product type parsed from its name, and, if it changed a row, logs the
+// change in the same transaction, with the details an update replaced. It
+// reports whether a row changed.
+func (db *DB) changeUserProduct(ctx context.Context, userID int, action string, product Product, query string) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	r := orBestBuy(product.Retailer)
+	info := tcg.Parse(product.Name)
+	var previous []byte
+	if action == WatchlistUpdated {
+		if previous, err = snapshotSavedItem(ctx, tx, userID, DeletedProduct, r, product.SKU); err != nil {
+			return false, err
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, query,
+		userID, r, product.SKU, product.Name, product.SalePrice, product.ThumbnailURL, product.ProductURL, product.UPC, product.ModelNumber,
+		info.Set, string(info.Type), product.Priority,
+	)
+	if err != nil {
+		return false, err
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return false, err
+	}
+
+	if err := recordWatchlistChange(ctx, tx, userID, WatchlistChange{
+		Kind:     DeletedProduct,
+		Retailer: r,
+		ItemID:   product.SKU,
+		Action:   action,
+		Name:     product.Name,
+		previous: previous,
+	}); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// RemoveUserProduct removes a retailer's product from user's list
+func (db *DB) RemoveUserProduct(ctx context.Context, userID int, r retailer.ID, sku string) error {
+	return db.removeSavedItem(ctx, userID, DeletedProduct, orBestBuy(r), sku)
+}
+
+// orBestBuy defaults an unset retailer to Best Buy, the only retailer before they were tracked
+func orBestBuy(r retailer.ID) retailer.ID {
+	if r == "" {
+		return retailer.BestBuy
+	}
+	return r
 }
@@ -0,0 +1,83 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// DigestItem is an alert for a nice-to-have product waiting for the user's digest
+type DigestItem struct {
+	ID            int
+	UserID        int
+	Retailer      retailer.ID
+	SKU           string
+	ProductName   string
+	SalePrice     money.Cents
+	ProductURL    string
+	StoreCount    int
+	NearestStore  string
+	DistanceMiles float64
+	CreatedAt     time.Time
+}
+
+// QueueDigestItem adds an alert to the user's next digest. A product already
+// waiting is updated with the newer stock, keeping its place in the queue.
+func (db *DB) QueueDigestItem(ctx context.Context, item DigestItem) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO alert_digest_items (user_id, retailer, sku, product_name, sale_price_cents, product_url, store_count, nearest_store, distance_miles)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		 ON CONFLICT (user_id, retailer, sku) DO UPDATE SET
+		   product_name = EXCLUDED.product_name,
+		   sale_price_cents = EXCLUDED.sale_price_cents,
+		   product_url = EXCLUDED.product_url,
+		   store_count = EXCLUDED.store_count,
+		   nearest_store = EXCLUDED.nearest_store,
+		   distance_miles = EXCLUDED.distance_miles`,
+		item.UserID, orBestBuy(item.Retailer), item.SKU, item.ProductName, item.SalePrice, item.ProductURL,
+		item.StoreCount, item.NearestStore, item.DistanceMiles,
+	)
+	return err
+}
+
+// GetDueDigests gets the waiting items of each user whose oldest item has
+// waited their digest interval, oldest first
+func (db *DB) GetDueDigests(ctx context.Context) (map[int][]DigestItem, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, user_id, retailer, sku, product_name, sale_price_cents, product_url, store_count, nearest_store, distance_miles, created_at
+		 FROM alert_digest_items
+		 WHERE user_id IN (
+		   SELECT d.user_id
+		   FROM alert_digest_items d
+		   LEFT JOIN notification_preferences p ON p.user_id = d.user_id
+		   GROUP BY d.user_id, p.digest_interval_hours
+		   HAVING MIN(d.created_at) <= CURRENT_TIMESTAMP - make_interval(hours => COALESCE(p.digest_interval_hours, $1))
+		 )
+		 ORDER BY user_id, created_at, id`,
+		DefaultDigestIntervalHours,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	digests := make(map[int][]DigestItem)
+	for rows.Next() {
+		var d DigestItem
+		if err := rows.Scan(&d.ID, &d.UserID, &d.Retailer, &d.SKU, &d.ProductName, &d.SalePrice, &d.ProductURL,
+			&d.StoreCount, &d.NearestStore, &d.DistanceMiles, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+		digests[d.UserID] = append(digests[d.UserID], d)
+	}
+	return digests, rows.Err()
+}
+
+// DeleteDigestItems removes items once their digest is sent
+func (db *DB) DeleteDigestItems(ctx context.Context, ids []int) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM alert_digest_items WHERE id = ANY($1)", pq.Array(ids))
+	return err
+}
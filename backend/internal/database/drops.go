@@ -0,0 +1,159 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Drop is an invitation-only sale of a restricted product: shoppers enter
+// while the window is open and the retailer invites some of them to buy
+type Drop struct {
+	ID             int
+	SKU            string
+	ProductName    string
+	Title          string
+	Details        string // how to enter
+	URL            string // the retailer's announcement
+	OpensAt        time.Time
+	ClosesAt       *time.Time // nil if not announced
+	NotifiedAt     *time.Time // nil until watchers are told the window opened
+	CreatedByEmail string     // empty if the admin has since been deleted
+	CreatedAt      time.Time
+}
+
+// dropWindowEnd is when a drop's window ends in SQL: when it closes, or a
+// day after it opens if no close was announced
+const dropWindowEnd = "COALESCE(d.closes_at, d.opens_at + INTERVAL '1 day')"
+
+// CreateDrop saves a new drop
+func (db *DB) CreateDrop(ctx context.Context, d Drop, createdBy int) (*Drop, error) {
+	var id int
+	if err := db.QueryRowContext(ctx,
+		`INSERT INTO drops (sku, product_name, title, details, url, opens_at, closes_at, created_by)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id`,
+		d.SKU, d.ProductName, d.Title, d.Details, d.URL, d.OpensAt, d.ClosesAt, createdBy,
+	).Scan(&id); err != nil {
+		return nil, err
+	}
+	return db.GetDrop(ctx, id)
+}
+
+// UpdateDrop updates a drop. Moving its opening time lets watchers be
+// notified again when the new window opens. It returns sql.ErrNoRows if
+// there is no such drop.
+func (db *DB) UpdateDrop(ctx context.Context, d Drop) (*Drop, error) {
+	result, err := db.ExecContext(ctx,
+		`UPDATE drops SET sku = $2, product_name = $3, title = $4, details = $5, url = $6,
+		     notified_at = CASE WHEN opens_at = $7 THEN notified_at END,
+		     opens_at = $7, closes_at = $8
+		 WHERE id = $1`,
+		d.ID, d.SKU, d.ProductName, d.Title, d.Details, d.URL, d.OpensAt, d.ClosesAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return nil, err
+	} else if n == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return db.GetDrop(ctx, d.ID)
+}
+
+// GetDrop gets a drop by ID
+func (db *DB) GetDrop(ctx context.Context, id int) (*Drop, error) {
+	drops, err := db.queryDrops(ctx, "WHERE d.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(drops) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &drops[0], nil
+}
+
+// GetUpcomingDrops gets the drops whose windows haven't ended by now, soonest first
+func (db *DB) GetUpcomingDrops(ctx context.Context, now time.Time) ([]Drop, error) {
+	return db.queryDrops(ctx, "WHERE "+dropWindowEnd+" > $1", now)
+}
+
+// GetOpeningDrops gets the drops whose windows are open at now and whose
+// watchers haven't been notified yet
+func (db *DB) GetOpeningDrops(ctx context.Context, now time.Time) ([]Drop, error) {
+	return db.queryDrops(ctx, "WHERE d.notified_at IS NULL AND d.opens_at <= $1 AND "+dropWindowEnd+" > $1", now)
+}
+
+// MarkDropNotified records that a drop's watchers were notified
+func (db *DB) MarkDropNotified(ctx context.Context, id int) error {
+	_, err := db.ExecContext(ctx, "UPDATE drops SET notified_at = CURRENT_TIMESTAMP WHERE id = $1", id)
+	return err
+}
+
+// DeleteDrop deletes a drop, returning false if there was none
+func (db *DB) DeleteDrop(ctx context.Context, id int) (bool, error) {
+	result, err := db.ExecContext(ctx, "DELETE FROM drops WHERE id = $1", id)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// GetSKUWatchers gets the IDs of users watching a Best Buy SKU
+func (db *DB) GetSKUWatchers(ctx context.Context, sku string) ([]int, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT DISTINCT user_id FROM user_products WHERE retailer = 'bestbuy' AND sku = $1 ORDER BY user_id",
+		sku,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, rows.Err()
+}
+
+// queryDrops gets the drops matching a WHERE clause, soonest opening first
+func (db *DB) queryDrops(ctx context.Context, where string, args ...any) ([]Drop, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT d.id, d.sku, d.product_name, d.title, d.details, d.url, d.opens_at, d.closes_at,
+		        d.notified_at, COALESCE(u.email, ''), d.created_at
+		 FROM drops d
+		 LEFT JOIN users u ON u.id = d.created_by
+		 `+where+`
+		 ORDER BY d.opens_at, d.id`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var drops []Drop
+	for rows.Next() {
+		var d Drop
+		var closesAt, notifiedAt sql.NullTime
+		if err := rows.Scan(&d.ID, &d.SKU, &d.ProductName, &d.Title, &d.Details, &d.URL, &d.OpensAt, &closesAt,
+			&notifiedAt, &d.CreatedByEmail, &d.CreatedAt); err != nil {
+			return nil, err
+		}
+		if closesAt.Valid {
+			d.ClosesAt = &closesAt.Time
+		}
+		if notifiedAt.Valid {
+			d.NotifiedAt = &notifiedAt.Time
+		}
+		drops = append(drops, d)
+	}
+	return drops, rows.Err()
+}
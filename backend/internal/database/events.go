@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// Stock event types
+const (
+	StockEventInStock    = "in_stock"
+	StockEventOutOfStock = "out_of_stock"
+)
+
+// StockEvent is one stock transition for a SKU at a store
+type StockEvent struct {
+	ID         int64
+	Retailer   retailer.ID
+	SKU        string
+	StoreID    string
+	StoreName  string
+	EventType  string
+	LowStock   bool
+	OccurredAt time.Time
+}
+
+// AppendStockEvents appends stock transitions to the event log
+func (db *DB) AppendStockEvents(ctx context.Context, events []StockEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx,
+		"INSERT INTO stock_events (retailer, sku, store_id, store_name, event_type, low_stock, occurred_at) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, e := range events {
+		if e.OccurredAt.IsZero() {
+			e.OccurredAt = time.Now()
+		}
+		if _, err := stmt.ExecContext(ctx, orBestBuy(e.Retailer), e.SKU, e.StoreID, e.StoreName, e.EventType, e.LowStock, e.OccurredAt); err != nil {
+			return fmt.Errorf("failed to append event: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// LastStockEventID gets the ID of the newest event, 0 if there are none
+func (db *DB) LastStockEventID(ctx context.Context) (int64, error) {
+	var id int64
+	err := db.QueryRowContext(ctx, "SELECT COALESCE(MAX(id), 0) FROM stock_events").Scan(&id)
+	return id, err
+}
+
+// GetLatestStockEvents gets the most recent event for every retailer/SKU/store, i.e. the current state
+func (db *DB) GetLatestStockEvents(ctx context.Context) ([]StockEvent, error) {
+	return queryStockEvents(ctx, db,
+		`SELECT DISTINCT ON (retailer, sku, store_id) id, retailer, sku, store_id, store_name, event_type, low_stock, occurred_at
+		 FROM stock_events
+		 ORDER BY retailer, sku, store_id, id DESC`,
+	)
+}
+
+// GetStockEvents gets events for a SKU (all SKUs if empty) after the given event ID, oldest first
+func (db *DB) GetStockEvents(ctx context.Context, sku string, afterID int64, limit int) ([]StockEvent, error) {
+	return queryStockEvents(ctx, db,
+		`SELECT id, retailer, sku, store_id, store_name, event_type, low_stock, occurred_at
+		 FROM stock_events
+		 WHERE ($1 = '' OR sku = $1) AND id > $2
+		 ORDER BY id
+		 LIMIT $3`,
+		sku, afterID, limit,
+	)
+}
+
+// GetRestocks gets the in-stock events at the given stores since a time, oldest first
+func (db *DB) GetRestocks(ctx context.Context, storeIDs []string, since time.Time) ([]StockEvent, error) {
+	return queryStockEvents(ctx, db,
+		`SELECT id, retailer, sku, store_id, store_name, event_type, low_stock, occurred_at
+		 FROM stock_events
+		 WHERE event_type = $1 AND store_id = ANY($2) AND occurred_at >= $3
+		 ORDER BY id`,
+		StockEventInStock, pq.Array(storeIDs), since,
+	)
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// queryStockEvents runs a query returning stock events
+func queryStockEvents(ctx context.Context, q queryer, query string, args ...any) ([]StockEvent, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []StockEvent
+	for rows.Next() {
+		var e StockEvent
+		if err := rows.Scan(&e.ID, &e.Retailer, &e.SKU, &e.StoreID, &e.StoreName, &e.EventType, &e.LowStock, &e.OccurredAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
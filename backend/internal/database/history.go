@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// StockHistoryEntry is the result of one availability check for a SKU at a store
+type StockHistoryEntry struct {
+	SKU       string
+	StoreID   string
+	InStock   bool
+	LowStock  bool
+	CheckedAt time.Time
+}
+
+// RecordStockHistory appends availability check results to the stock history
+func (db *DB) RecordStockHistory(ctx context.Context, entries []StockHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx,
+		"INSERT INTO stock_history (sku, store_id, in_stock, low_stock, checked_at) VALUES ($1, $2, $3, $4, $5)",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, e := range entries {
+		if e.CheckedAt.IsZero() {
+			e.CheckedAt = time.Now()
+		}
+		if _, err := stmt.ExecContext(ctx, e.SKU, e.StoreID, e.InStock, e.LowStock, e.CheckedAt); err != nil {
+			return fmt.Errorf("failed to record history: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetStockHistory gets the checks of a SKU at a store since a time, newest first, up to limit entries
+func (db *DB) GetStockHistory(ctx context.Context, sku, storeID string, since time.Time, limit int) ([]StockHistoryEntry, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT sku, store_id, in_stock, low_stock, checked_at FROM stock_history
+		 WHERE sku = $1 AND store_id = $2 AND checked_at >= $3
+		 ORDER BY checked_at DESC
+		 LIMIT $4`,
+		sku, storeID, since, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []StockHistoryEntry
+	for rows.Next() {
+		var e StockHistoryEntry
+		if err := rows.Scan(&e.SKU, &e.StoreID, &e.InStock, &e.LowStock, &e.CheckedAt); err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+// GetLastInStock gets when a SKU was last seen in stock at a store, or nil if it never was
+func (db *DB) GetLastInStock(ctx context.Context, sku, storeID string) (*time.Time, error) {
+	var t time.Time
+	err := db.QueryRowContext(ctx,
+		"SELECT checked_at FROM stock_history WHERE sku = $1 AND store_id = $2 AND in_stock ORDER BY checked_at DESC LIMIT 1",
+		sku, storeID,
+	).Scan(&t)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
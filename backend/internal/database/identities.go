@@ -0,0 +1,94 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Identity is an account at a login provider
+type Identity struct {
+	Provider   string // "google", "github" or "oidc"
+	Subject    string // the provider's ID for the account
+	Email      string // verified by the provider
+	Name       string
+	PictureURL string
+}
+
+// SignInIdentity gets the user a provider account belongs to, refreshing
+// their profile. An account not seen before is linked to the user with the
+// same email, or to a new user if there's none, so the caller must only
+// pass emails the provider has verified.
+func (db *DB) SignInIdentity(ctx context.Context, id Identity) (*User, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var userID int
+	err = tx.QueryRowContext(ctx,
+		`UPDATE user_identities SET email = $3, last_login_at = CURRENT_TIMESTAMP
+		 WHERE provider = $1 AND subject = $2
+		 RETURNING user_id`,
+		id.Provider, id.Subject, id.Email,
+	).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		userID, err = linkIdentity(ctx, tx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the sign-in email unless another user already has it
+	var user User
+	err = tx.QueryRowContext(ctx,
+		`UPDATE users SET
+		   email = CASE WHEN EXISTS (SELECT 1 FROM users WHERE LOWER(email) = LOWER($2) AND id <> $1) THEN email ELSE $2 END,
+		   name = COALESCE(NULLIF($3, ''), name),
+		   picture_url = COALESCE(NULLIF($4, ''), picture_url),
+		   updated_at = CURRENT_TIMESTAMP
+		 WHERE id = $1
+		 RETURNING id, COALESCE(google_id, ''), email, COALESCE(name, ''), COALESCE(picture_url, ''), locale, role, created_at, updated_at`,
+		userID, id.Email, id.Name, id.PictureURL,
+	).Scan(&user.ID, &user.GoogleID, &user.Email, &user.Name, &user.PictureURL, &user.Locale, &user.Role, &user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &user, tx.Commit()
+}
+
+// linkIdentity links a new provider account to the user with its email,
+// creating the user if there's none, and returns the user's ID
+func linkIdentity(ctx context.Context, tx *sql.Tx, id Identity) (int, error) {
+	var userID int
+	err := tx.QueryRowContext(ctx,
+		"SELECT id FROM users WHERE LOWER(email) = LOWER($1) FOR UPDATE",
+		id.Email,
+	).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		var googleID sql.NullString
+		if id.Provider == "google" {
+			googleID = sql.NullString{String: id.Subject, Valid: true}
+		}
+		err = tx.QueryRowContext(ctx,
+			`INSERT INTO users (google_id, email, name, picture_url)
+			 VALUES ($1, $2, $3, $4)
+			 RETURNING id`,
+			googleID, id.Email, id.Name, id.PictureURL,
+		).Scan(&userID)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO user_identities (user_id, provider, subject, email)
+		 VALUES ($1, $2, $3, $4)`,
+		userID, id.Provider, id.Subject, id.Email,
+	); err != nil {
+		return 0, fmt.Errorf("failed to link identity: %w", err)
+	}
+	return userID, nil
+}
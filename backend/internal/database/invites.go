@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Invite is a single-use link that allows whoever signs in with it
+type Invite struct {
+	ID             int
+	Note           string
+	CreatedByEmail string // empty if the admin has since been deleted
+	ExpiresAt      time.Time
+	UsedAt         *time.Time // nil until used
+	UsedByEmail    string
+	CreatedAt      time.Time
+}
+
+// CreateInvite saves an invite by the SHA-256 hash of its token
+func (db *DB) CreateInvite(ctx context.Context, tokenHash, note string, createdBy int, expiresAt time.Time) (*Invite, error) {
+	var id int
+	if err := db.QueryRowContext(ctx,
+		`INSERT INTO invites (token_hash, note, created_by, expires_at)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id`,
+		tokenHash, note, createdBy, expiresAt,
+	).Scan(&id); err != nil {
+		return nil, err
+	}
+	return db.GetInvite(ctx, id)
+}
+
+// GetInvite gets an invite by ID
+func (db *DB) GetInvite(ctx context.Context, id int) (*Invite, error) {
+	invites, err := db.queryInvites(ctx, "WHERE i.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(invites) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &invites[0], nil
+}
+
+// GetInvites gets every invite, newest first
+func (db *DB) GetInvites(ctx context.Context) ([]Invite, error) {
+	return db.queryInvites(ctx, "")
+}
+
+// queryInvites gets the invites matching a WHERE clause, newest first
+func (db *DB) queryInvites(ctx context.Context, where string, args ...any) ([]Invite, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT i.id, i.note, COALESCE(u.email, ''), i.expires_at, i.used_at, COALESCE(i.used_by_email, ''), i.created_at
+		 FROM invites i
+		 LEFT JOIN users u ON u.id = i.created_by
+		 `+where+`
+		 ORDER BY i.created_at DESC, i.id DESC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invites []Invite
+	for rows.Next() {
+		var i Invite
+		var usedAt sql.NullTime
+		if err := rows.Scan(&i.ID, &i.Note, &i.CreatedByEmail, &i.ExpiresAt, &usedAt, &i.UsedByEmail, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		if usedAt.Valid {
+			i.UsedAt = &usedAt.Time
+		}
+		invites = append(invites, i)
+	}
+	return invites, rows.Err()
+}
+
+// IsInviteUsable checks if the invite with the token hash exists and has
+// neither expired nor been used
+func (db *DB) IsInviteUsable(ctx context.Context, tokenHash string) (bool, error) {
+	var usable bool
+	err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (
+		   SELECT 1 FROM invites
+		   WHERE token_hash = $1 AND used_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+		 )`,
+		tokenHash,
+	).Scan(&usable)
+	return usable, err
+}
+
+// UseInvite marks the invite with the token hash used by an email and allows
+// the email to sign in, as if the invite's admin had added it. It returns
+// false if there's no such invite or it has expired or been used.
+func (db *DB) UseInvite(ctx context.Context, tokenHash, email string) (bool, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var createdBy sql.NullInt64
+	err = tx.QueryRowContext(ctx,
+		`UPDATE invites SET used_at = CURRENT_TIMESTAMP, used_by_email = LOWER($2)
+		 WHERE token_hash = $1 AND used_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+		 RETURNING created_by`,
+		tokenHash, email,
+	).Scan(&createdBy)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO allowed_emails (email, added_by) VALUES (LOWER($1), $2) ON CONFLICT DO NOTHING",
+		email, createdBy,
+	); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// DeleteUnusedInvite deletes an invite that hasn't been used, reporting
+// whether there was one
+func (db *DB) DeleteUnusedInvite(ctx context.Context, id int) (bool, error) {
+	result, err := db.ExecContext(ctx, "DELETE FROM invites WHERE id = $1 AND used_at IS NULL", id)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
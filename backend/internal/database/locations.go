@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/geo"
+)
+
+// Location is a named place a user searches from, such as home or the office
+type Location struct {
+	ID          int
+	UserID      int
+	Name        string
+	PostalCode  string
+	RadiusMiles int
+	Point       geo.Point // approximate; zero if unknown
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// GetUserLocations gets all locations for a user
+func (db *DB) GetUserLocations(ctx context.Context, userID int) ([]Location, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT id, user_id, name, postal_code, radius_miles, lat, lng, created_at, updated_at FROM user_locations WHERE user_id = $1 ORDER BY name",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var locations []Location
+	for rows.Next() {
+		var l Location
+		if err := rows.Scan(&l.ID, &l.UserID, &l.Name, &l.PostalCode, &l.RadiusMiles, &l.Point.Lat, &l.Point.Lng, &l.CreatedAt, &l.UpdatedAt); err != nil {
+			return nil, err
+		}
+		locations = append(locations, l)
+	}
+	return locations, rows.Err()
+}
+
+// GetUserLocation gets one of a user's locations by name, or nil if there is none
+func (db *DB) GetUserLocation(ctx context.Context, userID int, name string) (*Location, error) {
+	var l Location
+	err := db.QueryRowContext(ctx,
+		"SELECT id, user_id, name, postal_code, radius_miles, lat, lng, created_at, updated_at FROM user_locations WHERE user_id = $1 AND name = $2",
+		userID, name,
+	).Scan(&l.ID, &l.UserID, &l.Name, &l.PostalCode, &l.RadiusMiles, &l.Point.Lat, &l.Point.Lng, &l.CreatedAt, &l.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+// SaveUserLocation creates a location or replaces the one with the same name
+func (db *DB) SaveUserLocation(ctx context.Context, l Location) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO user_locations (user_id, name, postal_code, radius_miles, lat, lng)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 ON CONFLICT (user_id, name) DO UPDATE SET
+		   postal_code = EXCLUDED.postal_code,
+		   radius_miles = EXCLUDED.radius_miles,
+		   lat = EXCLUDED.lat,
+		   lng = EXCLUDED.lng,
+		   updated_at = CURRENT_TIMESTAMP`,
+		l.UserID, l.Name, l.PostalCode, l.RadiusMiles, l.Point.Lat, l.Point.Lng,
+	)
+	return err
+}
+
+// DeleteUserLocation removes a location, reporting whether it existed
+func (db *DB) DeleteUserLocation(ctx context.Context, userID int, name string) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		"DELETE FROM user_locations WHERE user_id = $1 AND name = $2",
+		userID, name,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// loginLinkRetention is how long login link requests are kept for rate limiting
+const loginLinkRetention = 24 * time.Hour
+
+// CreateLoginLink saves a magic link for an email by the SHA-256 hash of its
+// token, with the hash of the invite it was requested with, if any. Requests
+// older than a day are dropped.
+func (db *DB) CreateLoginLink(ctx context.Context, email, tokenHash, inviteHash, requestIP string, expiresAt time.Time) error {
+	if _, err := db.ExecContext(ctx,
+		"DELETE FROM login_links WHERE created_at < $1",
+		time.Now().Add(-loginLinkRetention),
+	); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO login_links (token_hash, email, invite_hash, request_ip, expires_at)
+		 VALUES ($1, LOWER($2), NULLIF($3, ''), $4, $5)`,
+		tokenHash, email, inviteHash, requestIP, expiresAt,
+	)
+	return err
+}
+
+// CountLoginLinks counts the magic links requested since a time for an email
+// and from an IP, for rate limiting
+func (db *DB) CountLoginLinks(ctx context.Context, email, requestIP string, since time.Time) (byEmail, byIP int, err error) {
+	err = db.QueryRowContext(ctx,
+		`SELECT
+		   COUNT(*) FILTER (WHERE email = LOWER($1)),
+		   COUNT(*) FILTER (WHERE request_ip = $2)
+		 FROM login_links
+		 WHERE created_at >= $3 AND (email = LOWER($1) OR request_ip = $2)`,
+		email, requestIP, since,
+	).Scan(&byEmail, &byIP)
+	return byEmail, byIP, err
+}
+
+// UseLoginLink marks the magic link with the token hash used, returning its
+// email and invite hash, or "" if there's no such link or it has expired or
+// been used
+func (db *DB) UseLoginLink(ctx context.Context, tokenHash string) (email, inviteHash string, err error) {
+	err = db.QueryRowContext(ctx,
+		`UPDATE login_links SET used_at = CURRENT_TIMESTAMP
+		 WHERE token_hash = $1 AND used_at IS NULL AND expires_at > CURRENT_TIMESTAMP
+		 RETURNING email, COALESCE(invite_hash, '')`,
+		tokenHash,
+	).Scan(&email, &inviteHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", "", nil
+	}
+	return email, inviteHash, err
+}
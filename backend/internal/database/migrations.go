@@ -0,0 +1,153 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// SchemaVersion is the migration this build expects the database to be at.
+// Bump it with every new file in migrations/.
+const SchemaVersion = 47
+
+// SchemaMismatchError is returned when the database schema doesn't match the build
+type SchemaMismatchError struct {
+	Database int // highest migration applied to the database
+	Expected int // SchemaVersion of this build
+}
+
+func (e *SchemaMismatchError) Error() string {
+	if e.Database > e.Expected {
+		return fmt.Sprintf("database schema is at version %d but this build only knows up to %d; deploy a newer build", e.Database, e.Expected)
+	}
+	return fmt.Sprintf("database schema is at version %d but this build needs %d; run the missing migrations", e.Database, e.Expected)
+}
+
+// migrationVersion parses the version from a migration file name such as "017_saved_item_retailer.sql"
+func migrationVersion(file string) (int, error) {
+	prefix, _, ok := strings.Cut(filepath.Base(file), "_")
+	version, err := strconv.Atoi(prefix)
+	if !ok || err != nil || version <= 0 {
+		return 0, fmt.Errorf("migration %s does not start with a version number", filepath.Base(file))
+	}
+	return version, nil
+}
+
+// RunMigrations applies the SQL migrations not yet recorded in schema_migrations,
+// each in its own transaction. Migrations are idempotent, so databases created
+// before the table existed simply re-run them once.
+func (db *DB) RunMigrations(migrationsDir string) error {
+	ctx := context.Background()
+
+	// Find migration files
+	files, err := filepath.Glob(filepath.Join(migrationsDir, "*.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to find migrations: %w", err)
+	}
+
+	if _, err := db.ExecContext(ctx,
+		`CREATE TABLE IF NOT EXISTS schema_migrations (
+		   version INTEGER PRIMARY KEY,
+		   name VARCHAR(255) NOT NULL,
+		   applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		 )`,
+	); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		version, err := migrationVersion(file)
+		if err != nil {
+			return err
+		}
+		if applied[version] {
+			continue
+		}
+
+		migration, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read migration %s: %w", file, err)
+		}
+		if err := db.applyMigration(ctx, version, filepath.Base(file), string(migration)); err != nil {
+			return fmt.Errorf("failed to run migration %s: %w", file, err)
+		}
+
+		log.Printf("Applied migration: %s", filepath.Base(file))
+	}
+
+	log.Println("Database migrations completed successfully")
+	return nil
+}
+
+// appliedMigrations returns the versions recorded in schema_migrations
+func (db *DB) appliedMigrations(ctx context.Context) (map[int]bool, error) {
+	rows, err := db.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, err
+		}
+		applied[version] = true
+	}
+	return applied, rows.Err()
+}
+
+// applyMigration runs one migration and records it
+func (db *DB) applyMigration(ctx context.Context, version int, name, migration string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, migration); err != nil {
+		return err
+	}
+	// Another process may have applied it concurrently; migrations are idempotent
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO schema_migrations (version, name) VALUES ($1, $2) ON CONFLICT (version) DO NOTHING",
+		version, name,
+	); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CheckSchema verifies that the database schema is at the version this build
+// expects, so a mismatch stops startup instead of surfacing as SQL errors in
+// the middle of requests
+func (db *DB) CheckSchema(ctx context.Context) error {
+	var version sql.NullInt64
+	err := db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_migrations").Scan(&version)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "42P01" { // undefined_table: never migrated
+		return &SchemaMismatchError{Database: 0, Expected: SchemaVersion}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	if int(version.Int64) != SchemaVersion {
+		return &SchemaMismatchError{Database: int(version.Int64), Expected: SchemaVersion}
+	}
+	return nil
+}
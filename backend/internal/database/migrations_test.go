@@ -0,0 +1,42 @@
+package database
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSchemaVersionMatchesMigrations(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "migrations", "*.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latest := 0
+	seen := make(map[int]string)
+	for _, file := range files {
+		version, err := migrationVersion(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if other, ok := seen[version]; ok {
+			t.Errorf("%s and %s share version %d", filepath.Base(other), filepath.Base(file), version)
+		}
+		seen[version] = file
+		latest = max(latest, version)
+	}
+
+	if latest != SchemaVersion {
+		t.Errorf("latest migration is %d but SchemaVersion is %d; bump SchemaVersion with new migrations", latest, SchemaVersion)
+	}
+}
+
+func TestMigrationVersion(t *testing.T) {
+	if v, err := migrationVersion("migrations/017_saved_item_retailer.sql"); err != nil || v != 17 {
+		t.Errorf("got %d, %v; want 17", v, err)
+	}
+	for _, name := range []string{"initial.sql", "abc_initial.sql", "000_initial.sql"} {
+		if _, err := migrationVersion(name); err == nil {
+			t.Errorf("migrationVersion(%q) succeeded, want error", name)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// NotificationChannel represents a user's configuration for one notification channel
+type NotificationChannel struct {
+	ID          int
+	UserID      int
+	ChannelType string
+	Config      json.RawMessage
+	Enabled     bool
+	Rollup      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// GetUserNotificationChannels gets all notification channels for a user
+func (db *DB) GetUserNotificationChannels(ctx context.Context, userID int) ([]NotificationChannel, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT id, user_id, channel_type, config, enabled, rollup, created_at, updated_at FROM notification_channels WHERE user_id = $1 ORDER BY channel_type",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var channels []NotificationChannel
+	for rows.Next() {
+		var c NotificationChannel
+		if err := rows.Scan(&c.ID, &c.UserID, &c.ChannelType, &c.Config, &c.Enabled, &c.Rollup, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, err
+		}
+		channels = append(channels, c)
+	}
+	return channels, rows.Err()
+}
+
+// UpsertNotificationChannel creates or replaces a user's config for a channel type
+func (db *DB) UpsertNotificationChannel(ctx context.Context, userID int, channelType string, config json.RawMessage, enabled bool, rollup string) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO notification_channels (user_id, channel_type, config, enabled, rollup)
+		 VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (user_id, channel_type) DO UPDATE SET
+		   config = EXCLUDED.config,
+		   enabled = EXCLUDED.enabled,
+		   rollup = EXCLUDED.rollup,
+		   updated_at = CURRENT_TIMESTAMP`,
+		userID, channelType, []byte(config), enabled, rollup,
+	)
+	return err
+}
+
+// DeleteNotificationChannel removes a user's config for a channel type
+func (db *DB) DeleteNotificationChannel(ctx context.Context, userID int, channelType string) error {
+	_, err := db.ExecContext(ctx,
+		"DELETE FROM notification_channels WHERE user_id = $1 AND channel_type = $2",
+		userID, channelType,
+	)
+	return err
+}
+
+// CreatePendingAlert records an alert that can be acknowledged via its token
+func (db *DB) CreatePendingAlert(ctx context.Context, userID int, token, title string) (int, error) {
+	var id int
+	err := db.QueryRowContext(ctx,
+		"INSERT INTO notification_alerts (user_id, token, title) VALUES ($1, $2, $3) RETURNING id",
+		userID, token, title,
+	).Scan(&id)
+	return id, err
+}
+
+// AcknowledgeAlert marks an alert acknowledged, returning false if the token is unknown or already used
+func (db *DB) AcknowledgeAlert(ctx context.Context, token string) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		"UPDATE notification_alerts SET acknowledged_at = CURRENT_TIMESTAMP WHERE token = $1 AND acknowledged_at IS NULL",
+		token,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// IsAlertAcknowledged checks whether an alert has been acknowledged
+func (db *DB) IsAlertAcknowledged(ctx context.Context, alertID int) (bool, error) {
+	var acked bool
+	err := db.QueryRowContext(ctx,
+		"SELECT acknowledged_at IS NOT NULL FROM notification_alerts WHERE id = $1",
+		alertID,
+	).Scan(&acked)
+	return acked, err
+}
+
+// MarkAlertEscalated records that an alert was escalated
+func (db *DB) MarkAlertEscalated(ctx context.Context, alertID int) error {
+	_, err := db.ExecContext(ctx,
+		"UPDATE notification_alerts SET escalated_at = CURRENT_TIMESTAMP WHERE id = $1",
+		alertID,
+	)
+	return err
+}
+
+// CountEscalationsSince counts a user's escalated alerts since the given time
+func (db *DB) CountEscalationsSince(ctx context.Context, userID int, since time.Time) (int, error) {
+	var count int
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM notification_alerts WHERE user_id = $1 AND escalated_at >= $2",
+		userID, since,
+	).Scan(&count)
+	return count, err
+}
@@ -0,0 +1,147 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// DefaultDigestIntervalHours is how long nice-to-have alerts wait for a digest unless the user picks otherwise
+const DefaultDigestIntervalHours = 24
+
+// NotificationPreferences control which alerts a user receives
+type NotificationPreferences struct {
+	UserID           int
+	AlertsEnabled    bool
+	IncludeLowStock  bool
+	MaxDistanceMiles float64 // 0 means no limit
+	// UrgentChannels are the channel types must-have alerts go to; empty for
+	// every enabled channel. Nice-to-have alerts are sent as a digest every
+	// DigestIntervalHours.
+	UrgentChannels      []string
+	DigestIntervalHours int
+	UpdatedAt           time.Time
+}
+
+// DefaultNotificationPreferences are used until a user saves their own
+func DefaultNotificationPreferences(userID int) NotificationPreferences {
+	return NotificationPreferences{
+		UserID:              userID,
+		AlertsEnabled:       true,
+		IncludeLowStock:     true,
+		DigestIntervalHours: DefaultDigestIntervalHours,
+	}
+}
+
+// AlertRule narrows when a watched product triggers an alert
+type AlertRule struct {
+	UserID        int
+	Retailer      retailer.ID
+	SKU           string
+	Enabled       bool
+	MaxPriceCents money.Cents // 0 means any price
+	MinStores     int
+	// Stores further than MaxDistanceMiles from Location are ignored. Without a
+	// location, distance is measured from the postal code the stock was checked near.
+	MaxDistanceMiles float64 // 0 means no limit
+	Location         string
+	UpdatedAt        time.Time
+}
+
+// DefaultAlertRule is used for products without a saved rule
+func DefaultAlertRule(userID int, r retailer.ID, sku string) AlertRule {
+	return AlertRule{
+		UserID:    userID,
+		Retailer:  r,
+		SKU:       sku,
+		Enabled:   true,
+		MinStores: 1,
+	}
+}
+
+// GetNotificationPreferences gets a user's preferences, or the defaults if none are saved
+func (db *DB) GetNotificationPreferences(ctx context.Context, userID int) (NotificationPreferences, error) {
+	p := DefaultNotificationPreferences(userID)
+	err := db.QueryRowContext(ctx,
+		"SELECT alerts_enabled, include_low_stock, max_distance_miles, urgent_channels, digest_interval_hours, updated_at FROM notification_preferences WHERE user_id = $1",
+		userID,
+	).Scan(&p.AlertsEnabled, &p.IncludeLowStock, &p.MaxDistanceMiles, pq.Array(&p.UrgentChannels), &p.DigestIntervalHours, &p.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return p, nil
+	}
+	return p, err
+}
+
+// SaveNotificationPreferences creates or replaces a user's preferences
+func (db *DB) SaveNotificationPreferences(ctx context.Context, p NotificationPreferences) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO notification_preferences (user_id, alerts_enabled, include_low_stock, max_distance_miles, urgent_channels, digest_interval_hours)
+		 VALUES ($1, $2, $3, $4, COALESCE($5::text[], '{}'), $6)
+		 ON CONFLICT (user_id) DO UPDATE SET
+		   alerts_enabled = EXCLUDED.alerts_enabled,
+		   include_low_stock = EXCLUDED.include_low_stock,
+		   max_distance_miles = EXCLUDED.max_distance_miles,
+		   urgent_channels = EXCLUDED.urgent_channels,
+		   digest_interval_hours = EXCLUDED.digest_interval_hours,
+		   updated_at = CURRENT_TIMESTAMP`,
+		p.UserID, p.AlertsEnabled, p.IncludeLowStock, p.MaxDistanceMiles, pq.Array(p.UrgentChannels), p.DigestIntervalHours,
+	)
+	return err
+}
+
+// GetAlertRules gets the alert rules a user has saved
+func (db *DB) GetAlertRules(ctx context.Context, userID int) ([]AlertRule, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT user_id, retailer, sku, enabled, max_price_cents, min_stores, max_distance_miles, location, updated_at FROM alert_rules WHERE user_id = $1 ORDER BY retailer, sku",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []AlertRule
+	for rows.Next() {
+		var r AlertRule
+		if err := rows.Scan(&r.UserID, &r.Retailer, &r.SKU, &r.Enabled, &r.MaxPriceCents, &r.MinStores, &r.MaxDistanceMiles, &r.Location, &r.UpdatedAt); err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, rows.Err()
+}
+
+// GetAlertRule gets the rule for one product, or the default rule if none is saved
+func (db *DB) GetAlertRule(ctx context.Context, userID int, r retailer.ID, sku string) (AlertRule, error) {
+	rule := DefaultAlertRule(userID, orBestBuy(r), sku)
+	err := db.QueryRowContext(ctx,
+		"SELECT enabled, max_price_cents, min_stores, max_distance_miles, location, updated_at FROM alert_rules WHERE user_id = $1 AND retailer = $2 AND sku = $3",
+		userID, rule.Retailer, sku,
+	).Scan(&rule.Enabled, &rule.MaxPriceCents, &rule.MinStores, &rule.MaxDistanceMiles, &rule.Location, &rule.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return rule, nil
+	}
+	return rule, err
+}
+
+// SaveAlertRule creates or replaces the rule for one product
+func (db *DB) SaveAlertRule(ctx context.Context, r AlertRule) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO alert_rules (user_id, retailer, sku, enabled, max_price_cents, min_stores, max_distance_miles, location)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 ON CONFLICT (user_id, retailer, sku) DO UPDATE SET
+		   enabled = EXCLUDED.enabled,
+		   max_price_cents = EXCLUDED.max_price_cents,
+		   min_stores = EXCLUDED.min_stores,
+		   max_distance_miles = EXCLUDED.max_distance_miles,
+		   location = EXCLUDED.location,
+		   updated_at = CURRENT_TIMESTAMP`,
+		r.UserID, orBestBuy(r.Retailer), r.SKU, r.Enabled, r.MaxPriceCents, r.MinStores, r.MaxDistanceMiles, r.Location,
+	)
+	return err
+}
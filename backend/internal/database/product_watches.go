@@ -0,0 +1,114 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ProductWatch is a user's search for new products in a category
+type ProductWatch struct {
+	ID         int
+	UserID     int
+	Query      string
+	CategoryID string
+	CheckedAt  time.Time // zero until the SKUs listed when it was added are recorded
+	CreatedAt  time.Time
+}
+
+// productWatchColumns are the columns scanned by scanProductWatch
+const productWatchColumns = "id, user_id, query, category_id, COALESCE(checked_at, 'epoch'::timestamptz), created_at"
+
+// scanProductWatch scans a row of productWatchColumns
+func scanProductWatch(row interface{ Scan(...any) error }) (ProductWatch, error) {
+	var w ProductWatch
+	err := row.Scan(&w.ID, &w.UserID, &w.Query, &w.CategoryID, &w.CheckedAt, &w.CreatedAt)
+	if w.CheckedAt.Equal(time.Unix(0, 0)) {
+		w.CheckedAt = time.Time{}
+	}
+	return w, err
+}
+
+// GetProductWatches gets every user's product watches
+func (db *DB) GetProductWatches(ctx context.Context) ([]ProductWatch, error) {
+	return db.queryProductWatches(ctx, "SELECT "+productWatchColumns+" FROM product_watches ORDER BY id")
+}
+
+// GetUserProductWatches gets a user's product watches, oldest first
+func (db *DB) GetUserProductWatches(ctx context.Context, userID int) ([]ProductWatch, error) {
+	return db.queryProductWatches(ctx, "SELECT "+productWatchColumns+" FROM product_watches WHERE user_id = $1 ORDER BY id", userID)
+}
+
+// queryProductWatches runs a query selecting productWatchColumns
+func (db *DB) queryProductWatches(ctx context.Context, query string, args ...any) ([]ProductWatch, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watches []ProductWatch
+	for rows.Next() {
+		w, err := scanProductWatch(rows)
+		if err != nil {
+			return nil, err
+		}
+		watches = append(watches, w)
+	}
+	return watches, rows.Err()
+}
+
+// AddProductWatch starts watching a search in a category for a user, or
+// returns their existing watch for it. Queries are matched ignoring case.
+func (db *DB) AddProductWatch(ctx context.Context, userID int, query, categoryID string) (ProductWatch, error) {
+	return scanProductWatch(db.QueryRowContext(ctx,
+		`INSERT INTO product_watches (user_id, query, category_id)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (user_id, LOWER(query), category_id) DO UPDATE SET query = product_watches.query
+		 RETURNING `+productWatchColumns,
+		userID, query, categoryID,
+	))
+}
+
+// RemoveProductWatch stops one of a user's product watches, returning false if they have no such watch
+func (db *DB) RemoveProductWatch(ctx context.Context, userID, id int) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		"DELETE FROM product_watches WHERE id = $1 AND user_id = $2",
+		id, userID,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// RecordWatchSKUs records the SKUs a watch's search found and marks it
+// checked, returning the SKUs it hadn't seen before
+func (db *DB) RecordWatchSKUs(ctx context.Context, watchID int, skus []string) ([]string, error) {
+	rows, err := db.QueryContext(ctx,
+		`WITH checked AS (
+		   UPDATE product_watches SET checked_at = CURRENT_TIMESTAMP WHERE id = $1
+		 )
+		 INSERT INTO product_watch_skus (watch_id, sku)
+		 SELECT $1, UNNEST($2::text[])
+		 ON CONFLICT (watch_id, sku) DO NOTHING
+		 RETURNING sku`,
+		watchID, pq.Array(skus),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var added []string
+	for rows.Next() {
+		var sku string
+		if err := rows.Scan(&sku); err != nil {
+			return nil, err
+		}
+		added = append(added, sku)
+	}
+	return added, rows.Err()
+}
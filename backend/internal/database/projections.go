@@ -0,0 +1,260 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// stockProjection is the checkpoint name of the stock availability projections
+const stockProjection = "stock_availability"
+
+// Availability is the current stock state of a watched product at a saved store
+type Availability struct {
+	SKU         string
+	ProductName string
+	StoreID     string
+	StoreName   string
+	InStock     bool
+	LowStock    bool
+	Since       time.Time
+}
+
+// DailyAvailability is how long a product was in stock at a store on one UTC day
+type DailyAvailability struct {
+	SKU        string
+	StoreID    string
+	Day        time.Time
+	InStockFor time.Duration
+}
+
+// ProjectStockEvents applies up to limit unprojected stock events to the
+// availability read models and returns how many were applied. Events and the
+// checkpoint are updated in one transaction, so a crash never double-counts.
+func (db *DB) ProjectStockEvents(ctx context.Context, limit int) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx,
+		"INSERT INTO projection_checkpoints (name) VALUES ($1) ON CONFLICT (name) DO NOTHING",
+		stockProjection,
+	); err != nil {
+		return 0, fmt.Errorf("failed to create checkpoint: %w", err)
+	}
+
+	// Lock the checkpoint so concurrent projectors don't apply the same events
+	var lastID int64
+	if err := tx.QueryRowContext(ctx,
+		"SELECT last_event_id FROM projection_checkpoints WHERE name = $1 FOR UPDATE",
+		stockProjection,
+	).Scan(&lastID); err != nil {
+		return 0, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	events, err := queryStockEvents(ctx, tx,
+		`SELECT id, retailer, sku, store_id, store_name, event_type, low_stock, occurred_at
+		 FROM stock_events
+		 WHERE id > $1
+		 ORDER BY id
+		 LIMIT $2`,
+		lastID, limit,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read events: %w", err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	for _, e := range events {
+		if err := projectStockEvent(ctx, tx, e); err != nil {
+			return 0, fmt.Errorf("failed to project event %d: %w", e.ID, err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE projection_checkpoints SET last_event_id = $2, updated_at = CURRENT_TIMESTAMP WHERE name = $1",
+		stockProjection, events[len(events)-1].ID,
+	); err != nil {
+		return 0, fmt.Errorf("failed to update checkpoint: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+// projectStockEvent applies one event to the current and daily availability tables
+func projectStockEvent(ctx context.Context, tx *sql.Tx, e StockEvent) error {
+	var wasInStock bool
+	var since time.Time
+	var storeName string
+	err := tx.QueryRowContext(ctx,
+		"SELECT in_stock, since, store_name FROM stock_availability WHERE sku = $1 AND store_id = $2",
+		e.SKU, e.StoreID,
+	).Scan(&wasInStock, &since, &storeName)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	// Out-of-stock events don't carry the store name, so keep the one we have
+	if e.StoreName != "" {
+		storeName = e.StoreName
+	}
+
+	inStock := e.EventType == StockEventInStock
+	if wasInStock && !inStock {
+		for day, d := range splitByDay(since, e.OccurredAt) {
+			if _, err := tx.ExecContext(ctx,
+				`INSERT INTO stock_daily_availability (sku, store_id, day, in_stock_seconds)
+				 VALUES ($1, $2, $3, $4)
+				 ON CONFLICT (sku, store_id, day) DO UPDATE SET
+				   in_stock_seconds = stock_daily_availability.in_stock_seconds + EXCLUDED.in_stock_seconds`,
+				e.SKU, e.StoreID, day, int64(d.Seconds()),
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Repeated in-stock events only refresh details; the interval keeps its start
+	if err == nil && wasInStock == inStock {
+		_, err = tx.ExecContext(ctx,
+			"UPDATE stock_availability SET store_name = $3, low_stock = $4 WHERE sku = $1 AND store_id = $2",
+			e.SKU, e.StoreID, storeName, e.LowStock,
+		)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO stock_availability (sku, store_id, store_name, in_stock, low_stock, since)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 ON CONFLICT (sku, store_id) DO UPDATE SET
+		   store_name = EXCLUDED.store_name,
+		   in_stock = EXCLUDED.in_stock,
+		   low_stock = EXCLUDED.low_stock,
+		   since = EXCLUDED.since`,
+		e.SKU, e.StoreID, storeName, inStock, e.LowStock, e.OccurredAt,
+	)
+	return err
+}
+
+// GetUserAvailability gets the current availability of the user's products at their saved stores
+func (db *DB) GetUserAvailability(ctx context.Context, userID int) ([]Availability, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT p.sku, p.name, s.store_id, s.name, a.in_stock, a.low_stock, a.since
+		 FROM user_products p
+		 JOIN user_stores s ON s.user_id = p.user_id AND s.retailer = p.retailer
+		 JOIN stock_availability a ON a.sku = p.sku AND a.store_id = s.store_id
+		 WHERE p.user_id = $1 AND p.retailer = 'bestbuy'
+		 ORDER BY a.in_stock DESC, p.name, s.name`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var availability []Availability
+	for rows.Next() {
+		var a Availability
+		if err := rows.Scan(&a.SKU, &a.ProductName, &a.StoreID, &a.StoreName, &a.InStock, &a.LowStock, &a.Since); err != nil {
+			return nil, err
+		}
+		availability = append(availability, a)
+	}
+	return availability, rows.Err()
+}
+
+// GetUserDailyAvailability gets daily in-stock time for the user's products at
+// their saved stores since the given day, including stock that is still on the shelf
+func (db *DB) GetUserDailyAvailability(ctx context.Context, userID int, since time.Time) ([]DailyAvailability, error) {
+	since = since.UTC().Truncate(24 * time.Hour)
+
+	rows, err := db.QueryContext(ctx,
+		`SELECT d.sku, d.store_id, d.day, d.in_stock_seconds
+		 FROM user_products p
+		 JOIN user_stores s ON s.user_id = p.user_id AND s.retailer = p.retailer
+		 JOIN stock_daily_availability d ON d.sku = p.sku AND d.store_id = s.store_id
+		 WHERE p.user_id = $1 AND p.retailer = 'bestbuy' AND d.day >= $2`,
+		userID, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type dayKey struct {
+		sku, storeID string
+		day          time.Time
+	}
+	totals := make(map[dayKey]time.Duration)
+	for rows.Next() {
+		var k dayKey
+		var seconds int64
+		if err := rows.Scan(&k.sku, &k.storeID, &k.day, &seconds); err != nil {
+			return nil, err
+		}
+		k.day = k.day.UTC()
+		totals[k] += time.Duration(seconds) * time.Second
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Open intervals only land in the daily table once the stock sells out
+	current, err := db.GetUserAvailability(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, a := range current {
+		if !a.InStock {
+			continue
+		}
+		start := a.Since
+		if start.Before(since) {
+			start = since
+		}
+		for day, d := range splitByDay(start, now) {
+			totals[dayKey{sku: a.SKU, storeID: a.StoreID, day: day}] += d
+		}
+	}
+
+	daily := make([]DailyAvailability, 0, len(totals))
+	for k, d := range totals {
+		daily = append(daily, DailyAvailability{SKU: k.sku, StoreID: k.storeID, Day: k.day, InStockFor: d})
+	}
+	sort.Slice(daily, func(i, j int) bool {
+		if !daily[i].Day.Equal(daily[j].Day) {
+			return daily[i].Day.Before(daily[j].Day)
+		}
+		if daily[i].SKU != daily[j].SKU {
+			return daily[i].SKU < daily[j].SKU
+		}
+		return daily[i].StoreID < daily[j].StoreID
+	})
+	return daily, nil
+}
+
+// splitByDay splits the interval [from, to) into durations per UTC day
+func splitByDay(from, to time.Time) map[time.Time]time.Duration {
+	days := make(map[time.Time]time.Duration)
+	from, to = from.UTC(), to.UTC()
+	for from.Before(to) {
+		day := from.Truncate(24 * time.Hour)
+		end := day.Add(24 * time.Hour)
+		if end.After(to) {
+			end = to
+		}
+		days[day] += end.Sub(from)
+		from = end
+	}
+	return days
+}
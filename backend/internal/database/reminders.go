@@ -0,0 +1,146 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Reminder is a reminder to act on a Best Buy email a user forwarded: to
+// buy before an invitation expires, or to pick up an order
+type Reminder struct {
+	ID          int
+	UserID      int
+	Kind        string // "invitation" or "order_ready"
+	SKU         string // empty if the email didn't name one
+	ProductName string
+	StoreID     string // empty if the email didn't name one
+	StoreName   string
+	Subject     string     // of the forwarded email
+	DueAt       *time.Time // nil if the email gave no deadline
+	RemindAt    time.Time
+	SentAt      *time.Time // nil until sent
+	CreatedAt   time.Time
+}
+
+// EnsureInboundAddress gives a user the inbound address token if they don't
+// have one yet, and returns their token
+func (db *DB) EnsureInboundAddress(ctx context.Context, userID int, token string) (string, error) {
+	if _, err := db.ExecContext(ctx,
+		"INSERT INTO inbound_addresses (user_id, token) VALUES ($1, $2) ON CONFLICT (user_id) DO NOTHING",
+		userID, token,
+	); err != nil {
+		return "", err
+	}
+	var current string
+	err := db.QueryRowContext(ctx, "SELECT token FROM inbound_addresses WHERE user_id = $1", userID).Scan(&current)
+	return current, err
+}
+
+// SetInboundAddress replaces a user's inbound address token, so mail to the
+// old address is no longer read
+func (db *DB) SetInboundAddress(ctx context.Context, userID int, token string) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO inbound_addresses (user_id, token) VALUES ($1, $2)
+		 ON CONFLICT (user_id) DO UPDATE SET token = EXCLUDED.token, created_at = CURRENT_TIMESTAMP`,
+		userID, token,
+	)
+	return err
+}
+
+// GetInboundAddressUser gets the ID of the user with an inbound address
+// token, or 0 if there's none
+func (db *DB) GetInboundAddressUser(ctx context.Context, token string) (int, error) {
+	var userID int
+	err := db.QueryRowContext(ctx, "SELECT user_id FROM inbound_addresses WHERE token = $1", token).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	return userID, err
+}
+
+// CreateReminder saves a reminder, returning false if the user already has
+// one for the same email, such as when it was forwarded twice
+func (db *DB) CreateReminder(ctx context.Context, r Reminder) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		`INSERT INTO reminders (user_id, kind, sku, product_name, store_id, store_name, subject, due_at, remind_at)
+		 SELECT $1::integer, $2, $3, $4, $5, $6, $7, $8::timestamptz, $9::timestamptz
+		 WHERE NOT EXISTS (
+		     SELECT 1 FROM reminders
+		     WHERE user_id = $1 AND kind = $2 AND sku = $3 AND store_id = $5 AND due_at IS NOT DISTINCT FROM $8
+		 )`,
+		r.UserID, r.Kind, r.SKU, r.ProductName, r.StoreID, r.StoreName, r.Subject, r.DueAt, r.RemindAt,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// GetUserReminders gets a user's reminders, latest first
+func (db *DB) GetUserReminders(ctx context.Context, userID int) ([]Reminder, error) {
+	return db.queryReminders(ctx, "WHERE user_id = $1 ORDER BY remind_at DESC, id DESC", userID)
+}
+
+// GetDueReminders gets the unsent reminders due by now, oldest first
+func (db *DB) GetDueReminders(ctx context.Context, now time.Time) ([]Reminder, error) {
+	return db.queryReminders(ctx, "WHERE sent_at IS NULL AND remind_at <= $1 ORDER BY remind_at, id", now)
+}
+
+// MarkReminderSent records that a reminder was sent
+func (db *DB) MarkReminderSent(ctx context.Context, id int) error {
+	_, err := db.ExecContext(ctx, "UPDATE reminders SET sent_at = CURRENT_TIMESTAMP WHERE id = $1", id)
+	return err
+}
+
+// DeleteReminder deletes one of a user's reminders, returning false if they
+// have no such reminder
+func (db *DB) DeleteReminder(ctx context.Context, userID, id int) (bool, error) {
+	result, err := db.ExecContext(ctx, "DELETE FROM reminders WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// DeleteSentRemindersBefore deletes reminders sent before t
+func (db *DB) DeleteSentRemindersBefore(ctx context.Context, t time.Time) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM reminders WHERE sent_at < $1", t)
+	return err
+}
+
+// queryReminders gets the reminders matching a WHERE and ORDER BY clause
+func (db *DB) queryReminders(ctx context.Context, clause string, args ...any) ([]Reminder, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, user_id, kind, sku, product_name, store_id, store_name, subject, due_at, remind_at,
+		        sent_at, created_at
+		 FROM reminders
+		 `+clause,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reminders []Reminder
+	for rows.Next() {
+		var r Reminder
+		var dueAt, sentAt sql.NullTime
+		if err := rows.Scan(&r.ID, &r.UserID, &r.Kind, &r.SKU, &r.ProductName, &r.StoreID, &r.StoreName, &r.Subject,
+			&dueAt, &r.RemindAt, &sentAt, &r.CreatedAt); err != nil {
+			return nil, err
+		}
+		if dueAt.Valid {
+			r.DueAt = &dueAt.Time
+		}
+		if sentAt.Valid {
+			r.SentAt = &sentAt.Time
+		}
+		reminders = append(reminders, r)
+	}
+	return reminders, rows.Err()
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// Role is what a user is allowed to do
+type Role string
+
+const (
+	RoleUser  Role = "user"  // manages their own watchlist and alerts
+	RoleAdmin Role = "admin" // also manages shared defaults, sign-ins and moderation
+)
+
+// IsAdmin reports whether the user has the admin role
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
+
+// SetUserRole changes a user's role, returning false if there's no such user
+func (db *DB) SetUserRole(ctx context.Context, userID int, role Role) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		"UPDATE users SET role = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2",
+		role, userID,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// PromoteAdmins gives the admin role to the users with any of the emails,
+// returning how many weren't admins already
+func (db *DB) PromoteAdmins(ctx context.Context, emails []string) (int64, error) {
+	if len(emails) == 0 {
+		return 0, nil
+	}
+	lower := make([]string, len(emails))
+	for i, email := range emails {
+		lower[i] = strings.ToLower(email)
+	}
+	result, err := db.ExecContext(ctx,
+		`UPDATE users SET role = $1, updated_at = CURRENT_TIMESTAMP
+		 WHERE LOWER(email) = ANY($2) AND role <> $1`,
+		RoleAdmin, pq.Array(lower),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,215 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/pokemontcg"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+)
+
+// SetWatch is a user's rule to watch every product from a TCG set
+type SetWatch struct {
+	UserID    int
+	SetName   string
+	CreatedAt time.Time
+}
+
+// GetSetWatches gets every user's set watches
+func (db *DB) GetSetWatches(ctx context.Context) ([]SetWatch, error) {
+	return db.querySetWatches(ctx, "SELECT user_id, set_name, created_at FROM set_watches ORDER BY user_id, set_name")
+}
+
+// GetUserSetWatches gets the sets a user watches
+func (db *DB) GetUserSetWatches(ctx context.Context, userID int) ([]SetWatch, error) {
+	return db.querySetWatches(ctx, "SELECT user_id, set_name, created_at FROM set_watches WHERE user_id = $1 ORDER BY set_name", userID)
+}
+
+// querySetWatches runs a query selecting set watches
+func (db *DB) querySetWatches(ctx context.Context, query string, args ...any) ([]SetWatch, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watches []SetWatch
+	for rows.Next() {
+		var w SetWatch
+		if err := rows.Scan(&w.UserID, &w.SetName, &w.CreatedAt); err != nil {
+			return nil, err
+		}
+		watches = append(watches, w)
+	}
+	return watches, rows.Err()
+}
+
+// AddSetWatch starts watching a set for a user. Set names are matched ignoring case.
+func (db *DB) AddSetWatch(ctx context.Context, userID int, setName string) error {
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO set_watches (user_id, set_name)
+		 VALUES ($1, $2)
+		 ON CONFLICT (user_id, LOWER(set_name)) DO NOTHING`,
+		userID, setName,
+	)
+	return err
+}
+
+// RemoveSetWatch stops watching a set, returning false if the user wasn't watching it.
+// Products already added from the set stay saved.
+func (db *DB) RemoveSetWatch(ctx context.Context, userID int, setName string) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		"DELETE FROM set_watches WHERE user_id = $1 AND LOWER(set_name) = LOWER($2)",
+		userID, setName,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// AddSetProduct saves a product for a set watch, unless the user has saved it
+// before: products they removed from a watched set stay removed. It reports
+// whether the product was added.
+func (db *DB) AddSetProduct(ctx context.Context, userID int, product Product) (bool, error) {
+	var known bool
+	if err := db.QueryRowContext(ctx,
+		`SELECT EXISTS (
+		   SELECT 1 FROM watchlist_changes
+		   WHERE user_id = $1 AND kind = 'product' AND retailer = $2 AND item_id = $3
+		 )`,
+		userID, orBestBuy(product.Retailer), product.SKU,
+	).Scan(&known); err != nil || known {
+		return false, err
+	}
+
+	if err := db.AddUserProduct(ctx, userID, product); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// BackfillProductSets parses the set and product type of saved products saved
+// before they were recorded, returning how many were filled in
+func (db *DB) BackfillProductSets(ctx context.Context) (int, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT id, name FROM user_products WHERE set_name = '' AND product_type = ''",
+	)
+	if err != nil {
+		return 0, err
+	}
+	type pending struct {
+		id   int
+		info tcg.Info
+	}
+	var todo []pending
+	for rows.Next() {
+		var id int
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		if info := tcg.Parse(name); info != (tcg.Info{}) {
+			todo = append(todo, pending{id, info})
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, p := range todo {
+		if _, err := db.ExecContext(ctx,
+			"UPDATE user_products SET set_name = $2, product_type = $3 WHERE id = $1",
+			p.id, p.info.Set, string(p.info.Type),
+		); err != nil {
+			return 0, err
+		}
+	}
+	return len(todo), nil
+}
+
+// GetTCGSet returns the cached Pokemon TCG API set for a name (nil if the API
+// had none) and when it was looked up; the zero time if it never was
+func (db *DB) GetTCGSet(ctx context.Context, name string) (*pokemontcg.Set, time.Time, error) {
+	var set pokemontcg.Set
+	var released sql.NullTime
+	var fetchedAt time.Time
+	err := db.QueryRowContext(ctx,
+		`SELECT set_id, name, series, release_date, printed_total, total, logo_url, symbol_url, fetched_at
+		 FROM tcg_sets WHERE lookup_name = $1`,
+		strings.ToLower(name),
+	).Scan(&set.ID, &set.Name, &set.Series, &released, &set.PrintedTotal, &set.Total, &set.LogoURL, &set.SymbolURL, &fetchedAt)
+	if err == sql.ErrNoRows {
+		return nil, time.Time{}, nil
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if set.ID == "" {
+		return nil, fetchedAt, nil
+	}
+	set.ReleaseDate = released.Time
+	return &set, fetchedAt, nil
+}
+
+// SaveTCGSet caches the Pokemon TCG API set for a name, nil if the API had none
+func (db *DB) SaveTCGSet(ctx context.Context, name string, set *pokemontcg.Set) error {
+	if set == nil {
+		set = &pokemontcg.Set{}
+	}
+	released := sql.NullTime{Time: set.ReleaseDate, Valid: !set.ReleaseDate.IsZero()}
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO tcg_sets (lookup_name, set_id, name, series, release_date, printed_total, total, logo_url, symbol_url, fetched_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, CURRENT_TIMESTAMP)
+		 ON CONFLICT (lookup_name) DO UPDATE SET
+		     set_id = EXCLUDED.set_id, name = EXCLUDED.name, series = EXCLUDED.series,
+		     release_date = EXCLUDED.release_date, printed_total = EXCLUDED.printed_total, total = EXCLUDED.total,
+		     logo_url = EXCLUDED.logo_url, symbol_url = EXCLUDED.symbol_url, fetched_at = EXCLUDED.fetched_at`,
+		strings.ToLower(name), set.ID, set.Name, set.Series, released, set.PrintedTotal, set.Total, set.LogoURL, set.SymbolURL,
+	)
+	return err
+}
+
+// GetMSRPs gets the maintained MSRPs
+func (db *DB) GetMSRPs(ctx context.Context) ([]tcg.MSRP, error) {
+	rows, err := db.QueryContext(ctx, "SELECT set_name, product_type, msrp_cents FROM msrps ORDER BY product_type, LOWER(set_name)")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var msrps []tcg.MSRP
+	for rows.Next() {
+		var m tcg.MSRP
+		if err := rows.Scan(&m.Set, &m.Type, &m.Price); err != nil {
+			return nil, err
+		}
+		msrps = append(msrps, m)
+	}
+	return msrps, rows.Err()
+}
+
+// SetMSRP sets the MSRP of a product type in a set, or every set if setName is
+// empty. A zero price removes it.
+func (db *DB) SetMSRP(ctx context.Context, m tcg.MSRP) error {
+	if m.Price <= 0 {
+		_, err := db.ExecContext(ctx,
+			"DELETE FROM msrps WHERE LOWER(set_name) = LOWER($1) AND product_type = $2",
+			m.Set, m.Type,
+		)
+		return err
+	}
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO msrps (set_name, product_type, msrp_cents)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (LOWER(set_name), product_type) DO UPDATE SET
+		     set_name = EXCLUDED.set_name, msrp_cents = EXCLUDED.msrp_cents, updated_at = CURRENT_TIMESTAMP`,
+		m.Set, m.Type, m.Price,
+	)
+	return err
+}
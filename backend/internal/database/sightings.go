@@ -0,0 +1,163 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/reliability"
+)
+
+// Sighting statuses
+const (
+	SightingPending   = "pending"
+	SightingConfirmed = "confirmed"
+	SightingRejected  = "rejected"
+)
+
+// Sighting is stock a user reported seeing on a store's shelf
+type Sighting struct {
+	ID               int
+	UserID           int
+	SKU              string
+	ProductName      string
+	StoreID          string
+	StoreName        string
+	Quantity         int // 0 if not counted
+	HasPhoto         bool
+	PhotoContentType string
+	Status           string
+	ModeratedBy      int // 0 until moderated
+	ModeratedAt      time.Time
+	CreatedAt        time.Time
+}
+
+// sightingColumns are the columns scanned by scanSighting
+const sightingColumns = `id, user_id, sku, product_name, store_id, store_name, quantity, photo IS NOT NULL, photo_content_type,
+	status, COALESCE(moderated_by, 0), COALESCE(moderated_at, 'epoch'::timestamptz), created_at`
+
+// scanSighting scans a row of sightingColumns
+func scanSighting(row interface{ Scan(...any) error }) (Sighting, error) {
+	var s Sighting
+	err := row.Scan(&s.ID, &s.UserID, &s.SKU, &s.ProductName, &s.StoreID, &s.StoreName, &s.Quantity, &s.HasPhoto, &s.PhotoContentType,
+		&s.Status, &s.ModeratedBy, &s.ModeratedAt, &s.CreatedAt)
+	if s.ModeratedBy == 0 {
+		s.ModeratedAt = time.Time{}
+	}
+	return s, err
+}
+
+// AddSighting records a reported sighting for moderation, returning its ID
+func (db *DB) AddSighting(ctx context.Context, s Sighting, photo []byte) (int, error) {
+	var id int
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO sightings (user_id, sku, product_name, store_id, store_name, quantity, photo, photo_content_type)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id`,
+		s.UserID, s.SKU, s.ProductName, s.StoreID, s.StoreName, s.Quantity, photo, s.PhotoContentType,
+	).Scan(&id)
+	return id, err
+}
+
+// GetSightings gets sightings with a status, or every sighting if status is
+// empty, oldest first so moderators work through the queue in order
+func (db *DB) GetSightings(ctx context.Context, status string) ([]Sighting, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT "+sightingColumns+" FROM sightings WHERE $1 = '' OR status = $1 ORDER BY created_at, id",
+		status,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sightings []Sighting
+	for rows.Next() {
+		s, err := scanSighting(rows)
+		if err != nil {
+			return nil, err
+		}
+		sightings = append(sightings, s)
+	}
+	return sightings, rows.Err()
+}
+
+// GetSighting gets a sighting, or nil if there is none
+func (db *DB) GetSighting(ctx context.Context, id int) (*Sighting, error) {
+	s, err := scanSighting(db.QueryRowContext(ctx, "SELECT "+sightingColumns+" FROM sightings WHERE id = $1", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// GetSightingPhoto gets a sighting's photo and its content type, or nil if it has none
+func (db *DB) GetSightingPhoto(ctx context.Context, id int) ([]byte, string, error) {
+	var photo []byte
+	var contentType string
+	err := db.QueryRowContext(ctx,
+		"SELECT photo, photo_content_type FROM sightings WHERE id = $1",
+		id,
+	).Scan(&photo, &contentType)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", nil
+	}
+	return photo, contentType, err
+}
+
+// ModerateSighting confirms or rejects a pending sighting, reporting whether it was pending
+func (db *DB) ModerateSighting(ctx context.Context, id int, status string, moderatorID int) (bool, error) {
+	result, err := db.ExecContext(ctx,
+		`UPDATE sightings SET status = $2, moderated_by = $3, moderated_at = CURRENT_TIMESTAMP
+		 WHERE id = $1 AND status = 'pending'`,
+		id, status, moderatorID,
+	)
+	if err != nil {
+		return false, err
+	}
+	n, err := result.RowsAffected()
+	return n > 0, err
+}
+
+// CountUserSightings counts the sightings a user reported in the last hour and day
+func (db *DB) CountUserSightings(ctx context.Context, userID int) (hour, day int, err error) {
+	err = db.QueryRowContext(ctx,
+		`SELECT COUNT(*) FILTER (WHERE created_at > CURRENT_TIMESTAMP - INTERVAL '1 hour'), COUNT(*)
+		 FROM sightings
+		 WHERE user_id = $1 AND created_at > CURRENT_TIMESTAMP - INTERVAL '1 day'`,
+		userID,
+	).Scan(&hour, &day)
+	return hour, day, err
+}
+
+// GetReporterTallies counts how many of each user's recently moderated
+// sightings were confirmed. Users without any are left out.
+func (db *DB) GetReporterTallies(ctx context.Context, userIDs []int) (map[int]reliability.Tally, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT user_id, COUNT(*) FILTER (WHERE status = 'confirmed'), COUNT(*)
+		 FROM sightings
+		 WHERE user_id = ANY($1) AND status <> 'pending' AND moderated_at > CURRENT_TIMESTAMP - make_interval(days => $2)
+		 GROUP BY user_id`,
+		pq.Array(userIDs), reliability.WindowDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tallies := make(map[int]reliability.Tally)
+	for rows.Next() {
+		var id int
+		var t reliability.Tally
+		if err := rows.Scan(&id, &t.Found, &t.Total); err != nil {
+			return nil, err
+		}
+		tallies[id] = t
+	}
+	return tallies, rows.Err()
+}
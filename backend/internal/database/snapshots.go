@@ -0,0 +1,61 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// StockSnapshot is the last stock state the watcher saw for a SKU near a postal code
+type StockSnapshot struct {
+	SKU             string
+	PostalCode      string
+	InStockStoreIDs []string
+	CheckedAt       time.Time
+}
+
+// GetStockSnapshots gets every saved stock snapshot
+func (db *DB) GetStockSnapshots(ctx context.Context) ([]StockSnapshot, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT sku, postal_code, in_stock_store_ids, checked_at FROM stock_snapshots",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snapshots []StockSnapshot
+	for rows.Next() {
+		var s StockSnapshot
+		var storeIDs []byte
+		if err := rows.Scan(&s.SKU, &s.PostalCode, &storeIDs, &s.CheckedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(storeIDs, &s.InStockStoreIDs); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, rows.Err()
+}
+
+// SaveStockSnapshot creates or replaces the snapshot for a SKU near a postal code
+func (db *DB) SaveStockSnapshot(ctx context.Context, sku, postalCode string, inStockStoreIDs []string) error {
+	if inStockStoreIDs == nil {
+		inStockStoreIDs = []string{}
+	}
+	storeIDs, err := json.Marshal(inStockStoreIDs)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx,
+		`INSERT INTO stock_snapshots (sku, postal_code, in_stock_store_ids, checked_at)
+		 VALUES ($1, $2, $3, CURRENT_TIMESTAMP)
+		 ON CONFLICT (sku, postal_code) DO UPDATE SET
+		   in_stock_store_ids = EXCLUDED.in_stock_store_ids,
+		   checked_at = EXCLUDED.checked_at`,
+		sku, postalCode, storeIDs,
+	)
+	return err
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/tmcauley/stock-checker/backend/internal/hours"
+	"github.com/tmcauley/stock-checker/backend/internal/restock"
+)
+
+// StoreHours is a store's opening hours as last seen in a store search
+type StoreHours struct {
+	StoreID   string
+	Hours     string              // weekly summary
+	Detailed  []hours.DetailedDay // hours of the coming days, including holidays
+	GMTOffset int                 // hours from GMT
+}
+
+// StatusAt returns whether the store is open at t and its hours that day.
+// It returns false if the store's hours can't be parsed.
+func (h StoreHours) StatusAt(t time.Time) (hours.Status, bool) {
+	schedule, err := hours.NewSchedule(h.Hours, h.Detailed)
+	if err != nil {
+		return hours.Status{}, false
+	}
+	return schedule.StatusAt(t.In(hours.Zone(h.GMTOffset))), true
+}
+
+// VisitHint suggests when to visit the store for restocked stock from the
+// times it restocked. It returns false if the store's hours can't be parsed.
+func (h StoreHours) VisitHint(restocks []time.Time, now time.Time) (restock.Hint, bool) {
+	schedule, err := hours.NewSchedule(h.Hours, h.Detailed)
+	if err != nil {
+		return restock.Hint{}, false
+	}
+	zone := hours.Zone(h.GMTOffset)
+	local := make([]time.Time, len(restocks))
+	for i, t := range restocks {
+		local[i] = t.In(zone)
+	}
+	return restock.Suggest(local, schedule, now.In(zone)), true
+}
+
+// SaveStoreHours records the opening hours of stores
+func (db *DB) SaveStoreHours(ctx context.Context, stores []StoreHours) error {
+	for _, s := range stores {
+		if s.Hours == "" && len(s.Detailed) == 0 {
+			continue
+		}
+		detailed := s.Detailed
+		if detailed == nil {
+			detailed = []hours.DetailedDay{}
+		}
+		detailedJSON, err := json.Marshal(detailed)
+		if err != nil {
+			return err
+		}
+		if _, err := db.ExecContext(ctx,
+			`INSERT INTO store_hours (store_id, hours, detailed_hours, gmt_offset)
+			 VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (store_id) DO UPDATE SET
+			   hours = EXCLUDED.hours,
+			   detailed_hours = EXCLUDED.detailed_hours,
+			   gmt_offset = EXCLUDED.gmt_offset,
+			   updated_at = CURRENT_TIMESTAMP`,
+			s.StoreID, s.Hours, detailedJSON, s.GMTOffset,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetStoreHours gets the known hours of the given stores, keyed by store ID.
+// Stores that have never shown up in a store search are missing from the result.
+func (db *DB) GetStoreHours(ctx context.Context, storeIDs []string) (map[string]StoreHours, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT store_id, hours, detailed_hours, gmt_offset FROM store_hours WHERE store_id = ANY($1)",
+		pq.Array(storeIDs),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stores := make(map[string]StoreHours)
+	for rows.Next() {
+		var s StoreHours
+		var detailed []byte
+		if err := rows.Scan(&s.StoreID, &s.Hours, &detailed, &s.GMTOffset); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(detailed, &s.Detailed); err != nil {
+			return nil, err
+		}
+		stores[s.StoreID] = s
+	}
+	return stores, rows.Err()
+}
@@ -0,0 +1,238 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// Kinds of saved items, as recorded in tombstones and watchlist changes
+const (
+	DeletedStore   = "store"
+	DeletedProduct = "product"
+)
+
+// DeletedItem records a saved store or product the user removed
+type DeletedItem struct {
+	Kind      string
+	ItemID    string // store ID or SKU
+	DeletedAt time.Time
+}
+
+// removeSavedItem removes one saved item
+func (db *DB) removeSavedItem(ctx context.Context, userID int, kind string, r retailer.ID, itemID string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := deleteSavedItem(ctx, tx, userID, kind, r, itemID, 0); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// RemoveUserProducts removes several of a user's products in one
+// transaction, returning how many were saved. SKUs that aren't saved are skipped.
+func (db *DB) RemoveUserProducts(ctx context.Context, userID int, r retailer.ID, skus []string) (int, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var removed int
+	for _, sku := range skus {
+		ok, err := deleteSavedItem(ctx, tx, userID, DeletedProduct, orBestBuy(r), sku, 0)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			removed++
+		}
+	}
+	return removed, tx.Commit()
+}
+
+// ClearWatchlist removes every product the user saved, and their stores if
+// includeStores is set, in one transaction. Each removal is logged as a
+// watchlist change, so it can be undone item by item. It returns how many
+// products and stores were removed.
+func (db *DB) ClearWatchlist(ctx context.Context, userID int, includeStores bool) (products, stores int, err error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	kinds := []string{DeletedProduct}
+	if includeStores {
+		kinds = append(kinds, DeletedStore)
+	}
+	for _, kind := range kinds {
+		n, err := clearSavedItems(ctx, tx, userID, kind)
+		if err != nil {
+			return 0, 0, err
+		}
+		if kind == DeletedProduct {
+			products = n
+		} else {
+			stores = n
+		}
+	}
+	return products, stores, tx.Commit()
+}
+
+// clearSavedItems deletes every saved item of a kind, returning how many there were
+func clearSavedItems(ctx context.Context, tx *sql.Tx, userID int, kind string) (int, error) {
+	t := savedItemTables[kind]
+	rows, err := tx.QueryContext(ctx,
+		fmt.Sprintf("SELECT retailer, %s FROM %s WHERE user_id = $1 ORDER BY id FOR UPDATE", t.idColumn, t.table),
+		userID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	type savedItem struct {
+		retailer retailer.ID
+		itemID   string
+	}
+	var items []savedItem
+	for rows.Next() {
+		var item savedItem
+		if err := rows.Scan(&item.retailer, &item.itemID); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		items = append(items, item)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, item := range items {
+		if _, err := deleteSavedItem(ctx, tx, userID, kind, item.retailer, item.itemID, 0); err != nil {
+			return 0, err
+		}
+	}
+	return len(items), nil
+}
+
+// deleteSavedItem deletes one saved item, logs the removal as a watchlist
+// change and records a tombstone for it in the same transaction, so sync
+// clients learn about the removal. It reports whether the item was saved.
+func deleteSavedItem(ctx context.Context, tx *sql.Tx, userID int, kind string, r retailer.ID, itemID string, undoOf int64) (bool, error) {
+	t := savedItemTables[kind]
+	var name string
+	var previous []byte
+	err := tx.QueryRowContext(ctx,
+		fmt.Sprintf("DELETE FROM %[1]s WHERE user_id = $1 AND retailer = $2 AND %[2]s = $3 RETURNING name, to_jsonb(%[1]s)", t.table, t.idColumn),
+		userID, r, itemID,
+	).Scan(&name, &previous)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if err := recordWatchlistChange(ctx, tx, userID, WatchlistChange{
+		Kind:     kind,
+		Retailer: r,
+		ItemID:   itemID,
+		Action:   WatchlistRemoved,
+		Name:     name,
+		previous: previous,
+		undoOf:   undoOf,
+	}); err != nil {
+		return false, err
+	}
+
+	// Only v1 clients sync, and they only know Best Buy
+	if r != retailer.BestBuy {
+		return true, nil
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO deleted_items (user_id, kind, item_id)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (user_id, kind, item_id) DO UPDATE SET deleted_at = CURRENT_TIMESTAMP`,
+		userID, kind, itemID,
+	); err != nil {
+		return false, fmt.Errorf("failed to record deletion: %w", err)
+	}
+	return true, nil
+}
+
+// Now returns the database's current time, used as a sync cursor so it lines
+// up with the timestamps the database assigns
+func (db *DB) Now(ctx context.Context) (time.Time, error) {
+	var now time.Time
+	err := db.QueryRowContext(ctx, "SELECT CURRENT_TIMESTAMP").Scan(&now)
+	return now, err
+}
+
+// GetDeletedItems gets the user's saved items removed at or after since and not saved again
+func (db *DB) GetDeletedItems(ctx context.Context, userID int, since time.Time) ([]DeletedItem, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT d.kind, d.item_id, d.deleted_at
+		 FROM deleted_items d
+		 WHERE d.user_id = $1 AND d.deleted_at >= $2
+		   AND NOT EXISTS (SELECT 1 FROM user_stores s WHERE d.kind = 'store' AND s.user_id = d.user_id AND s.retailer = 'bestbuy' AND s.store_id = d.item_id)
+		   AND NOT EXISTS (SELECT 1 FROM user_products p WHERE d.kind = 'product' AND p.user_id = d.user_id AND p.retailer = 'bestbuy' AND p.sku = d.item_id)
+		 ORDER BY d.deleted_at`,
+		userID, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []DeletedItem
+	for rows.Next() {
+		var d DeletedItem
+		if err := rows.Scan(&d.Kind, &d.ItemID, &d.DeletedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, d)
+	}
+	return items, rows.Err()
+}
+
+// GetUserStockSnapshots gets the snapshots for the user's saved products near
+// their saved stores that were checked at or after since
+func (db *DB) GetUserStockSnapshots(ctx context.Context, userID int, since time.Time) ([]StockSnapshot, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT sn.sku, sn.postal_code, sn.in_stock_store_ids, sn.checked_at
+		 FROM stock_snapshots sn
+		 WHERE sn.checked_at >= $2
+		   AND sn.sku IN (SELECT sku FROM user_products WHERE user_id = $1 AND retailer = 'bestbuy')
+		   AND sn.postal_code IN (SELECT postal_code FROM user_stores WHERE user_id = $1 AND retailer = 'bestbuy')
+		 ORDER BY sn.sku, sn.postal_code`,
+		userID, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var snapshots []StockSnapshot
+	for rows.Next() {
+		var s StockSnapshot
+		var storeIDs []byte
+		if err := rows.Scan(&s.SKU, &s.PostalCode, &storeIDs, &s.CheckedAt); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(storeIDs, &s.InStockStoreIDs); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, s)
+	}
+	return snapshots, rows.Err()
+}
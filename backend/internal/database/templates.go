@@ -0,0 +1,113 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// NotificationTemplate is a customized notification template.
+// UserID is nil for admin-defined defaults; an empty ChannelType applies to every channel.
+type NotificationTemplate struct {
+	ID            int
+	UserID        *int
+	ChannelType   string
+	TitleTemplate string
+	BodyTemplate  string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// GetUserNotificationTemplates gets the templates a user has customized
+func (db *DB) GetUserNotificationTemplates(ctx context.Context, userID int) ([]NotificationTemplate, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT id, user_id, channel_type, title_template, body_template, created_at, updated_at FROM notification_templates WHERE user_id = $1 ORDER BY channel_type",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []NotificationTemplate
+	for rows.Next() {
+		var t NotificationTemplate
+		if err := rows.Scan(&t.ID, &t.UserID, &t.ChannelType, &t.TitleTemplate, &t.BodyTemplate, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, rows.Err()
+}
+
+// GetDefaultNotificationTemplates gets the admin-defined default templates
+func (db *DB) GetDefaultNotificationTemplates(ctx context.Context) ([]NotificationTemplate, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT id, user_id, channel_type, title_template, body_template, created_at, updated_at FROM notification_templates WHERE user_id IS NULL ORDER BY channel_type",
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []NotificationTemplate
+	for rows.Next() {
+		var t NotificationTemplate
+		if err := rows.Scan(&t.ID, &t.UserID, &t.ChannelType, &t.TitleTemplate, &t.BodyTemplate, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, rows.Err()
+}
+
+// ResolveNotificationTemplate finds the template to use for a user and channel.
+// A user's channel template wins over their catch-all template, which wins over
+// the admin defaults. Returns nil if nothing is configured.
+func (db *DB) ResolveNotificationTemplate(ctx context.Context, userID int, channelType string) (*NotificationTemplate, error) {
+	var t NotificationTemplate
+	err := db.QueryRowContext(ctx,
+		`SELECT id, user_id, channel_type, title_template, body_template, created_at, updated_at
+		 FROM notification_templates
+		 WHERE (user_id = $1 OR user_id IS NULL) AND (channel_type = $2 OR channel_type = '')
+		 ORDER BY user_id NULLS LAST, channel_type DESC
+		 LIMIT 1`,
+		userID, channelType,
+	).Scan(&t.ID, &t.UserID, &t.ChannelType, &t.TitleTemplate, &t.BodyTemplate, &t.CreatedAt, &t.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// UpsertNotificationTemplate creates or replaces a template. A nil userID sets the admin default.
+func (db *DB) UpsertNotificationTemplate(ctx context.Context, userID *int, channelType, titleTemplate, bodyTemplate string) error {
+	conflict := "(user_id, channel_type) WHERE user_id IS NOT NULL"
+	if userID == nil {
+		conflict = "(channel_type) WHERE user_id IS NULL"
+	}
+
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO notification_templates (user_id, channel_type, title_template, body_template)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT `+conflict+` DO UPDATE SET
+		   title_template = EXCLUDED.title_template,
+		   body_template = EXCLUDED.body_template,
+		   updated_at = CURRENT_TIMESTAMP`,
+		userID, channelType, titleTemplate, bodyTemplate,
+	)
+	return err
+}
+
+// DeleteNotificationTemplate removes a template. A nil userID removes the admin default.
+func (db *DB) DeleteNotificationTemplate(ctx context.Context, userID *int, channelType string) error {
+	_, err := db.ExecContext(ctx,
+		"DELETE FROM notification_templates WHERE user_id IS NOT DISTINCT FROM $1 AND channel_type = $2",
+		userID, channelType,
+	)
+	return err
+}
@@ -0,0 +1,176 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/usage"
+)
+
+// AddAPICalls adds calls to an API's count for a UTC day and returns the new total
+func (db *DB) AddAPICalls(ctx context.Context, api string, day time.Time, calls int) (int, error) {
+	var total int
+	err := db.QueryRowContext(ctx,
+		`INSERT INTO api_usage (api, day, calls)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (api, day) DO UPDATE SET calls = api_usage.calls + EXCLUDED.calls
+		 RETURNING calls`,
+		api, day.UTC().Format("2006-01-02"), calls,
+	).Scan(&total)
+	return total, err
+}
+
+// SaveAPICalls saves sampled calls to an API
+func (db *DB) SaveAPICalls(ctx context.Context, api string, calls []bestbuy.Call) error {
+	if len(calls) == 0 {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx,
+		`INSERT INTO api_calls (api, endpoint, status, latency_ms, cost, priority, sample_rate, created_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, c := range calls {
+		if _, err := stmt.ExecContext(ctx,
+			api, c.Endpoint, c.Status, c.Latency.Milliseconds(), c.Cost, c.Priority, c.SampleRate, c.At,
+		); err != nil {
+			return fmt.Errorf("failed to save call: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteAPICallsBefore deletes sampled calls made before t
+func (db *DB) DeleteAPICallsBefore(ctx context.Context, t time.Time) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM api_calls WHERE created_at < $1", t)
+	return err
+}
+
+// APICallStats sums up the sampled calls to one endpoint at one priority.
+// Counts are estimates: each sampled call stands for 1/sample rate calls.
+type APICallStats struct {
+	Endpoint     string
+	Priority     string
+	Sampled      int     // calls in the sample
+	Calls        float64 // estimated calls made
+	QuotaCost    float64 // estimated calls counted against the quota
+	Errors       float64 // estimated calls without a 2xx or 304 response
+	AvgLatencyMs float64
+	P95LatencyMs float64
+}
+
+// GetAPICallStats sums up the sampled calls to an API since a time, the
+// most quota used first
+func (db *DB) GetAPICallStats(ctx context.Context, api string, since time.Time) ([]APICallStats, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT endpoint, priority,
+		        COUNT(*),
+		        SUM(1 / sample_rate),
+		        SUM(cost / sample_rate),
+		        COALESCE(SUM(1 / sample_rate) FILTER (WHERE status NOT BETWEEN 200 AND 299 AND status <> 304), 0),
+		        AVG(latency_ms),
+		        PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY latency_ms)
+		 FROM api_calls
+		 WHERE api = $1 AND created_at >= $2
+		 GROUP BY endpoint, priority
+		 ORDER BY 5 DESC, 4 DESC, endpoint, priority`,
+		api, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stats []APICallStats
+	for rows.Next() {
+		var s APICallStats
+		if err := rows.Scan(&s.Endpoint, &s.Priority, &s.Sampled, &s.Calls, &s.QuotaCost, &s.Errors, &s.AvgLatencyMs, &s.P95LatencyMs); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
+
+// AddUserUsage adds each day's counts to the user's stored counts for that day
+func (db *DB) AddUserUsage(ctx context.Context, days []usage.Day) error {
+	if len(days) == 0 {
+		return nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Counts for users deleted since they were recorded are dropped
+	stmt, err := tx.PrepareContext(ctx,
+		`INSERT INTO user_usage (user_id, day, interactive_checks, background_checks, notifications)
+		 SELECT id, $2, $3, $4, $5 FROM users WHERE id = $1
+		 ON CONFLICT (user_id, day) DO UPDATE SET
+		     interactive_checks = user_usage.interactive_checks + EXCLUDED.interactive_checks,
+		     background_checks = user_usage.background_checks + EXCLUDED.background_checks,
+		     notifications = user_usage.notifications + EXCLUDED.notifications`,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, d := range days {
+		if _, err := stmt.ExecContext(ctx,
+			d.UserID, d.Day.UTC().Format("2006-01-02"), d.InteractiveChecks, d.BackgroundChecks, d.Notifications,
+		); err != nil {
+			return fmt.Errorf("failed to save usage of user %d: %w", d.UserID, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// DeleteUserUsageBefore deletes usage counts for days before t
+func (db *DB) DeleteUserUsageBefore(ctx context.Context, t time.Time) error {
+	_, err := db.ExecContext(ctx, "DELETE FROM user_usage WHERE day < $1", t.UTC().Format("2006-01-02"))
+	return err
+}
+
+// GetUserUsage returns a user's daily usage counts since a UTC day, newest
+// first. Days without any usage are left out.
+func (db *DB) GetUserUsage(ctx context.Context, userID int, since time.Time) ([]usage.Day, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT day, interactive_checks, background_checks, notifications
+		 FROM user_usage
+		 WHERE user_id = $1 AND day >= $2
+		 ORDER BY day DESC`,
+		userID, since.UTC().Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var days []usage.Day
+	for rows.Next() {
+		d := usage.Day{UserID: userID}
+		if err := rows.Scan(&d.Day, &d.InteractiveChecks, &d.BackgroundChecks, &d.Notifications); err != nil {
+			return nil, err
+		}
+		days = append(days, d)
+	}
+	return days, rows.Err()
+}
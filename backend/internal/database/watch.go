@@ -0,0 +1,58 @@
+package database
+
+import (
+	"context"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// WatchTarget is one product a user watches at one of their saved stores of
+// the same retailer, or around one of their locations. Location targets have
+// no StoreID and match any store within RadiusMiles of the location's postal code.
+type WatchTarget struct {
+	UserID       int
+	Retailer     retailer.ID
+	SKU          string
+	ProductName  string
+	SalePrice    money.Cents
+	ThumbnailURL string
+	ProductURL   string
+	StoreID      string
+	StoreName    string
+	PostalCode   string
+	Location     string
+	RadiusMiles  int
+}
+
+// GetWatchTargets gets every (user, product, store) and (user, product, location)
+// combination the watcher should check
+func (db *DB) GetWatchTargets(ctx context.Context) ([]WatchTarget, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT p.user_id, p.retailer, p.sku, p.name, COALESCE(p.sale_price_cents, 0), COALESCE(p.thumbnail_url, ''), COALESCE(p.product_url, ''),
+		        s.store_id, s.name, s.postal_code, '', 0
+		 FROM user_products p
+		 JOIN user_stores s ON s.user_id = p.user_id AND s.retailer = p.retailer
+		 WHERE COALESCE(s.postal_code, '') <> ''
+		 UNION ALL
+		 SELECT p.user_id, p.retailer, p.sku, p.name, COALESCE(p.sale_price_cents, 0), COALESCE(p.thumbnail_url, ''), COALESCE(p.product_url, ''),
+		        '', '', l.postal_code, l.name, l.radius_miles
+		 FROM user_products p
+		 JOIN user_locations l ON l.user_id = p.user_id
+		 ORDER BY 2, 3, 10`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var targets []WatchTarget
+	for rows.Next() {
+		var t WatchTarget
+		if err := rows.Scan(&t.UserID, &t.Retailer, &t.SKU, &t.ProductName, &t.SalePrice, &t.ThumbnailURL, &t.ProductURL, &t.StoreID, &t.StoreName, &t.PostalCode, &t.Location, &t.RadiusMiles); err != nil {
+			return nil, err
+		}
+		targets = append(targets, t)
+	}
+	return targets, rows.Err()
+}
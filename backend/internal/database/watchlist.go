@@ -0,0 +1,233 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// Watchlist change actions
+const (
+	WatchlistAdded   = "added"
+	WatchlistUpdated = "updated"
+	WatchlistRemoved = "removed"
+)
+
+// ErrNothingToUndo is returned by UndoLastChange when the user has no recent change that can be undone
+var ErrNothingToUndo = errors.New("no change to undo")
+
+// WatchlistChange is one change to a user's saved stores or products
+type WatchlistChange struct {
+	ID        int64
+	Kind      string // DeletedStore or DeletedProduct
+	Retailer  retailer.ID
+	ItemID    string // store ID or SKU
+	Action    string
+	Name      string // name when the change was made
+	ChangedAt time.Time
+
+	previous []byte // saved row before a removal or update, as JSON
+	undoOf   int64  // change this one reversed
+}
+
+// savedItemTables are the table and ID column of each kind of saved item
+var savedItemTables = map[string]struct{ table, idColumn string }{
+	DeletedStore:   {"user_stores", "store_id"},
+	DeletedProduct: {"user_products", "sku"},
+}
+
+// recordWatchlistChange logs a change to a saved item in the transaction that made it
+func recordWatchlistChange(ctx context.Context, tx *sql.Tx, userID int, c WatchlistChange) error {
+	var previous any
+	if len(c.previous) > 0 {
+		previous = string(c.previous)
+	}
+	if _, err := tx.ExecContext(ctx,
+		`INSERT INTO watchlist_changes (user_id, kind, retailer, item_id, action, item_name, previous, undo_of)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		userID, c.Kind, c.Retailer, c.ItemID, c.Action, c.Name, previous, sql.NullInt64{Int64: c.undoOf, Valid: c.undoOf != 0},
+	); err != nil {
+		return fmt.Errorf("failed to record watchlist change: %w", err)
+	}
+	return nil
+}
+
+// snapshotSavedItem locks a saved item and returns its row as JSON, or nil if it isn't saved
+func snapshotSavedItem(ctx context.Context, tx *sql.Tx, userID int, kind string, r retailer.ID, itemID string) ([]byte, error) {
+	t := savedItemTables[kind]
+	var row []byte
+	err := tx.QueryRowContext(ctx,
+		fmt.Sprintf("SELECT to_jsonb(t) FROM %s t WHERE user_id = $1 AND retailer = $2 AND %s = $3 FOR UPDATE", t.table, t.idColumn),
+		userID, r, itemID,
+	).Scan(&row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return row, err
+}
+
+// GetWatchlistChanges gets the changes to the user's saved items made at or after since, oldest first
+func (db *DB) GetWatchlistChanges(ctx context.Context, userID int, since time.Time) ([]WatchlistChange, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, kind, retailer, item_id, action, item_name, changed_at
+		 FROM watchlist_changes
+		 WHERE user_id = $1 AND changed_at >= $2
+		 ORDER BY changed_at, id`,
+		userID, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []WatchlistChange
+	for rows.Next() {
+		var c WatchlistChange
+		if err := rows.Scan(&c.ID, &c.Kind, &c.Retailer, &c.ItemID, &c.Action, &c.Name, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// UndoLastChange reverses the user's most recent change to their saved items,
+// if it was made at or after since, and returns the change it undid. Undoing
+// again steps further back; undos themselves are logged but never undone.
+func (db *DB) UndoLastChange(ctx context.Context, userID int, since time.Time) (*WatchlistChange, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var c WatchlistChange
+	err = tx.QueryRowContext(ctx,
+		`SELECT id, kind, retailer, item_id, action, item_name, previous, changed_at
+		 FROM watchlist_changes
+		 WHERE user_id = $1 AND undo_of IS NULL AND undone_at IS NULL
+		 ORDER BY id DESC
+		 LIMIT 1
+		 FOR UPDATE`,
+		userID,
+	).Scan(&c.ID, &c.Kind, &c.Retailer, &c.ItemID, &c.Action, &c.Name, &c.previous, &c.ChangedAt)
+	if err == sql.ErrNoRows || (err == nil && c.ChangedAt.Before(since)) {
+		return nil, ErrNothingToUndo
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case c.Action == WatchlistAdded:
+		_, err = deleteSavedItem(ctx, tx, userID, c.Kind, c.Retailer, c.ItemID, c.ID)
+	case c.Action == WatchlistRemoved && c.previous != nil:
+		err = restoreSavedItem(ctx, tx, userID, c)
+	case c.Action == WatchlistUpdated && c.previous != nil && c.Kind == DeletedProduct:
+		err = revertSavedProduct(ctx, tx, userID, c)
+	default:
+		// Logged before changes kept the replaced row
+		return nil, ErrNothingToUndo
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE watchlist_changes SET undone_at = CURRENT_TIMESTAMP WHERE id = $1", c.ID,
+	); err != nil {
+		return nil, err
+	}
+	return &c, tx.Commit()
+}
+
+// restoreSavedItem puts back a removed item as it was, marked updated now so
+// sync clients pick it up again
+func restoreSavedItem(ctx context.Context, tx *sql.Tx, userID int, removed WatchlistChange) error {
+	t := savedItemTables[removed.Kind]
+	result, err := tx.ExecContext(ctx,
+		fmt.Sprintf(`INSERT INTO %[1]s
+		 SELECT * FROM jsonb_populate_record(NULL::%[1]s, $1::jsonb || jsonb_build_object('updated_at', CURRENT_TIMESTAMP))
+		 ON CONFLICT (user_id, retailer, %[2]s) DO NOTHING`, t.table, t.idColumn),
+		string(removed.previous),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore %s: %w", removed.Kind, err)
+	}
+	// Saved again since; nothing to restore
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return err
+	}
+
+	return recordWatchlistChange(ctx, tx, userID, WatchlistChange{
+		Kind:     removed.Kind,
+		Retailer: removed.Retailer,
+		ItemID:   removed.ItemID,
+		Action:   WatchlistAdded,
+		Name:     removed.Name,
+		undoOf:   removed.ID,
+	})
+}
+
+// revertSavedProduct restores the details a product had before an update
+func revertSavedProduct(ctx context.Context, tx *sql.Tx, userID int, updated WatchlistChange) error {
+	current, err := snapshotSavedItem(ctx, tx, userID, DeletedProduct, updated.Retailer, updated.ItemID)
+	if err != nil || current == nil {
+		return err
+	}
+
+	var name string
+	err = tx.QueryRowContext(ctx,
+		`UPDATE user_products p
+		 SET name = old.name, sale_price_cents = old.sale_price_cents, thumbnail_url = old.thumbnail_url,
+		     product_url = old.product_url, set_name = old.set_name, product_type = old.product_type,
+		     priority = COALESCE(old.priority, p.priority), updated_at = CURRENT_TIMESTAMP
+		 FROM jsonb_populate_record(NULL::user_products, $4::jsonb) old
+		 WHERE p.user_id = $1 AND p.retailer = $2 AND p.sku = $3
+		 RETURNING p.name`,
+		userID, updated.Retailer, updated.ItemID, string(updated.previous),
+	).Scan(&name)
+	if err != nil {
+		return fmt.Errorf("failed to revert product: %w", err)
+	}
+
+	return recordWatchlistChange(ctx, tx, userID, WatchlistChange{
+		Kind:     DeletedProduct,
+		Retailer: updated.Retailer,
+		ItemID:   updated.ItemID,
+		Action:   WatchlistUpdated,
+		Name:     name,
+		previous: current,
+		undoOf:   updated.ID,
+	})
+}
+
+// WatchlistCounts summarizes a user's watchlist
+type WatchlistCounts struct {
+	Stores          int
+	Products        int
+	InStockProducts int // products in stock at any of the user's Best Buy stores
+	ProductWatches  int
+}
+
+// GetWatchlistCounts counts a user's saved stores, products and product watches
+func (db *DB) GetWatchlistCounts(ctx context.Context, userID int) (WatchlistCounts, error) {
+	var c WatchlistCounts
+	err := db.QueryRowContext(ctx,
+		`SELECT
+		   (SELECT COUNT(*) FROM user_stores WHERE user_id = $1),
+		   (SELECT COUNT(*) FROM user_products WHERE user_id = $1),
+		   (SELECT COUNT(DISTINCT p.sku)
+		    FROM user_products p
+		    JOIN user_stores s ON s.user_id = p.user_id AND s.retailer = p.retailer
+		    JOIN stock_availability a ON a.sku = p.sku AND a.store_id = s.store_id
+		    WHERE p.user_id = $1 AND p.retailer = 'bestbuy' AND a.in_stock),
+		   (SELECT COUNT(*) FROM product_watches WHERE user_id = $1)`,
+		userID,
+	).Scan(&c.Stores, &c.Products, &c.InStockProducts, &c.ProductWatches)
+	return c, err
+}
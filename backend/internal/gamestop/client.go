@@ -0,0 +1,312 @@
+// Package gamestop is a client for the JSON endpoints behind the GameStop and
+// EB Games (GameStop Canada) storefronts: store locator, search, product
+// pages and per-store pickup availability.
+package gamestop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+// Client is the interface for GameStop API operations
+type Client interface {
+	// SearchStores searches for stores near a postal code within a radius
+	SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error)
+
+	// SearchProducts searches for products by keyword, optionally within a category
+	SearchProducts(ctx context.Context, query string, category string) ([]Product, error)
+
+	// GetProduct gets a single product by its product ID
+	GetProduct(ctx context.Context, pid string) (*Product, error)
+
+	// CheckAvailability returns the stores near a postal code with the product available for pickup or in store
+	CheckAvailability(ctx context.Context, pid string, postalCode string) ([]StoreAvailability, error)
+}
+
+// Site is a GameStop storefront
+type Site string
+
+// Storefronts sharing the same API
+const (
+	SiteGameStop Site = "gamestop" // www.gamestop.com
+	SiteEBGames  Site = "ebgames"  // www.ebgames.ca
+)
+
+// siteURLs are the storefront origin and API base URL of each site
+var siteURLs = map[Site]struct{ origin, api string }{
+	SiteGameStop: {"https://www.gamestop.com", "https://www.gamestop.com/on/demandware.store/Sites-gamestop-us-Site/default"},
+	SiteEBGames:  {"https://www.ebgames.ca", "https://www.ebgames.ca/on/demandware.store/Sites-ebgames-ca-Site/en_CA"},
+}
+
+// ParseSite parses a site name, defaulting to GameStop US when empty
+func ParseSite(s string) (Site, error) {
+	if s == "" {
+		return SiteGameStop, nil
+	}
+	site := Site(strings.ToLower(s))
+	if _, ok := siteURLs[site]; !ok {
+		return "", fmt.Errorf("unknown GameStop site %q (want %q or %q)", s, SiteGameStop, SiteEBGames)
+	}
+	return site, nil
+}
+
+// Store is a GameStop store
+type Store struct {
+	StoreID    string
+	Name       string
+	Address    string
+	City       string
+	State      string
+	PostalCode string
+	Phone      string
+	Distance   float64
+}
+
+// Product is a GameStop product, identified by its product ID
+type Product struct {
+	PID      string
+	Name     string
+	Price    money.Cents
+	ImageURL string
+	URL      string
+}
+
+// StoreAvailability is a product's stock at one store
+type StoreAvailability struct {
+	StoreID        string
+	StoreName      string
+	City           string
+	State          string
+	Distance       float64
+	InStock        bool
+	LowStock       bool
+	PickupEligible bool
+}
+
+// Inventory statuses reported per store
+const (
+	statusInStock      = "IN_STOCK"
+	statusLimitedStock = "LIMITED_STOCK"
+)
+
+// Defaults
+const (
+	defaultRadiusMiles = 25
+	availabilityRadius = 50 // miles searched for stock
+	searchPageSize     = 24
+)
+
+// APIClient is the real GameStop API client
+type APIClient struct {
+	origin  string
+	baseURL string
+	api     *webapi.Client
+}
+
+// NewAPIClient creates a GameStop API client for a storefront. The endpoints
+// are public, so no key is needed.
+func NewAPIClient(site Site, userAgent string) *APIClient {
+	urls, ok := siteURLs[site]
+	if !ok {
+		urls = siteURLs[SiteGameStop]
+	}
+	return &APIClient{
+		origin:  urls.origin,
+		baseURL: urls.api,
+		api:     webapi.New("GameStop", userAgent, time.Second), // the API is unofficial and quick to block bursts
+	}
+}
+
+// notFound is the error for a product ID the API doesn't have
+func notFound(pid string) error {
+	return &webapi.NotFoundError{API: "GameStop", ID: pid}
+}
+
+// get requests a storefront controller and decodes its JSON response into v
+func (c *APIClient) get(ctx context.Context, controller string, params url.Values, v any) error {
+	params.Set("format", "ajax")
+	return c.api.Get(ctx, c.baseURL+"/"+controller+"?"+params.Encode(), nil, v)
+}
+
+// apiStore is a store in store locator responses
+type apiStore struct {
+	ID         string  `json:"ID"`
+	Name       string  `json:"name"`
+	Address1   string  `json:"address1"`
+	City       string  `json:"city"`
+	StateCode  string  `json:"stateCode"`
+	PostalCode string  `json:"postalCode"`
+	Phone      string  `json:"phone"`
+	Distance   float64 `json:"distance"`
+	Inventory  *struct {
+		Status         string `json:"status"`
+		PickupEligible bool   `json:"pickupEligible"`
+	} `json:"inventory"` // only present when the request names a product
+}
+
+// apiProduct is a product in search and product responses
+type apiProduct struct {
+	ID          string `json:"id"`
+	ProductName string `json:"productName"`
+	Price       struct {
+		Sales struct {
+			Value money.Cents `json:"value"`
+		} `json:"sales"`
+	} `json:"price"`
+	Images struct {
+		Large []struct {
+			URL string `json:"url"`
+		} `json:"large"`
+	} `json:"images"`
+	SelectedProductURL string `json:"selectedProductUrl"`
+}
+
+// product converts an API product, resolving its storefront-relative URL
+func (c *APIClient) product(p apiProduct) Product {
+	product := Product{
+		PID:   p.ID,
+		Name:  html.UnescapeString(p.ProductName), // names come HTML-escaped
+		Price: p.Price.Sales.Value,
+		URL:   p.SelectedProductURL,
+	}
+	if len(p.Images.Large) > 0 {
+		product.ImageURL = p.Images.Large[0].URL
+	}
+	if strings.HasPrefix(product.URL, "/") {
+		product.URL = c.origin + product.URL
+	}
+	return product
+}
+
+// findStores calls the store locator, which reports each store's inventory
+// when params name a product
+func (c *APIClient) findStores(ctx context.Context, params url.Values) ([]apiStore, error) {
+	var resp struct {
+		Stores []apiStore `json:"stores"`
+	}
+	if err := c.get(ctx, "Stores-FindStores", params, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Stores, nil
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *APIClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	if radiusMiles <= 0 {
+		radiusMiles = defaultRadiusMiles
+	}
+
+	found, err := c.findStores(ctx, url.Values{
+		"postalCode": {postalCode},
+		"radius":     {strconv.Itoa(radiusMiles)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stores := make([]Store, 0, len(found))
+	for _, s := range found {
+		stores = append(stores, Store{
+			StoreID:    s.ID,
+			Name:       s.Name,
+			Address:    s.Address1,
+			City:       s.City,
+			State:      s.StateCode,
+			PostalCode: s.PostalCode,
+			Phone:      s.Phone,
+			Distance:   s.Distance,
+		})
+	}
+	return stores, nil
+}
+
+// SearchProducts searches for products by keyword, optionally within a category
+func (c *APIClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	params := url.Values{
+		"q":     {query},
+		"start": {"0"},
+		"sz":    {strconv.Itoa(searchPageSize)},
+	}
+	if category != "" {
+		params.Set("cgid", category)
+	}
+
+	var resp struct {
+		Products []apiProduct `json:"products"`
+	}
+	if err := c.get(ctx, "Search-Show", params, &resp); err != nil {
+		return nil, err
+	}
+
+	products := make([]Product, 0, len(resp.Products))
+	for _, p := range resp.Products {
+		products = append(products, c.product(p))
+	}
+	return products, nil
+}
+
+// GetProduct gets a single product by its product ID
+func (c *APIClient) GetProduct(ctx context.Context, pid string) (*Product, error) {
+	var resp struct {
+		Product *apiProduct `json:"product"`
+	}
+	if err := c.get(ctx, "Product-Variation", url.Values{"pid": {pid}}, &resp); err != nil {
+		var statusErr *webapi.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return nil, notFound(pid)
+		}
+		return nil, err
+	}
+	if resp.Product == nil || resp.Product.ID == "" {
+		return nil, notFound(pid)
+	}
+
+	product := c.product(*resp.Product)
+	return &product, nil
+}
+
+// CheckAvailability returns the stores near a postal code with the product
+// available for same-day pickup or in store
+func (c *APIClient) CheckAvailability(ctx context.Context, pid string, postalCode string) ([]StoreAvailability, error) {
+	found, err := c.findStores(ctx, url.Values{
+		"postalCode": {postalCode},
+		"radius":     {strconv.Itoa(availabilityRadius)},
+		"products":   {pid + ":1"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var availability []StoreAvailability
+	for _, s := range found {
+		if s.Inventory == nil {
+			continue
+		}
+		status := s.Inventory.Status
+		if status != statusInStock && status != statusLimitedStock {
+			continue
+		}
+
+		availability = append(availability, StoreAvailability{
+			StoreID:        s.ID,
+			StoreName:      s.Name,
+			City:           s.City,
+			State:          s.StateCode,
+			Distance:       s.Distance,
+			InStock:        true,
+			LowStock:       status == statusLimitedStock,
+			PickupEligible: s.Inventory.PickupEligible,
+		})
+	}
+	return availability, nil
+}
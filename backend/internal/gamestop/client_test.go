@@ -0,0 +1,135 @@
+package gamestop
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+// newTestClient returns an APIClient whose requests to each controller are
+// answered with the contents of the mapped testdata file. Store locator
+// requests that name a product are mapped under "Stores-FindStores+products".
+func newTestClient(t *testing.T, files map[string]string) *APIClient {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") != "ajax" {
+			t.Errorf("request without format=ajax: %s", r.URL)
+		}
+		controller := path.Base(r.URL.Path)
+		if r.URL.Query().Has("products") {
+			controller += "+products"
+		}
+		file, ok := files[controller]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewAPIClient(SiteGameStop, "")
+	c.baseURL = srv.URL
+	c.api.MinInterval = 0
+	c.api.MaxRetries = 1
+	c.api.RetryBaseWait = time.Millisecond
+	return c
+}
+
+func TestSearchStores(t *testing.T) {
+	c := newTestClient(t, map[string]string{"Stores-FindStores": "stores.json"})
+
+	stores, err := c.SearchStores(context.Background(), "94103", 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stores) != 2 {
+		t.Fatalf("got %d stores, want 2", len(stores))
+	}
+	want := Store{StoreID: "6542", Name: "Stonestown Galleria", Address: "3251 20th Ave", City: "San Francisco", State: "CA", PostalCode: "94132", Phone: "(415) 564-4112", Distance: 3.1}
+	if stores[0] != want {
+		t.Errorf("store = %+v, want %+v", stores[0], want)
+	}
+}
+
+func TestGetProduct(t *testing.T) {
+	c := newTestClient(t, map[string]string{"Product-Variation": "product.json"})
+
+	p, err := c.GetProduct(context.Background(), "20017594")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Pokémon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box" {
+		t.Errorf("name = %q, want HTML entities decoded", p.Name)
+	}
+	if p.Price != 4999 || p.URL != "https://www.gamestop.com/toys-games/trading-cards/products/20017594.html" {
+		t.Errorf("product = %+v", p)
+	}
+}
+
+func TestGetProductNotFound(t *testing.T) {
+	c := newTestClient(t, map[string]string{"Product-Variation": "product_missing.json"})
+
+	_, err := c.GetProduct(context.Background(), "1")
+	var notFound *webapi.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("err = %v, want NotFoundError", err)
+	}
+}
+
+func TestSearchProducts(t *testing.T) {
+	c := newTestClient(t, map[string]string{"Search-Show": "search.json"})
+
+	products, err := c.SearchProducts(context.Background(), "pokemon", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 2 || products[1].PID != "20017597" || products[1].Price != 2699 {
+		t.Errorf("products = %+v", products)
+	}
+}
+
+func TestCheckAvailability(t *testing.T) {
+	c := newTestClient(t, map[string]string{"Stores-FindStores+products": "availability.json"})
+
+	availability, err := c.CheckAvailability(context.Background(), "20017594", "94103")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(availability) != 2 {
+		t.Fatalf("got %d stores, want 2 (unavailable store dropped)", len(availability))
+	}
+
+	if a := availability[0]; !a.InStock || a.LowStock || !a.PickupEligible {
+		t.Errorf("in stock store = %+v", a)
+	}
+	// Limited stock that can't be reserved: buyable in store, but not for pickup
+	if a := availability[1]; !a.InStock || !a.LowStock || a.PickupEligible || a.City != "Daly City" {
+		t.Errorf("limited store = %+v", a)
+	}
+}
+
+func TestParseSite(t *testing.T) {
+	if site, err := ParseSite(""); err != nil || site != SiteGameStop {
+		t.Errorf("ParseSite(\"\") = %q, %v", site, err)
+	}
+	if site, err := ParseSite("EBGames"); err != nil || site != SiteEBGames {
+		t.Errorf("ParseSite(\"EBGames\") = %q, %v", site, err)
+	}
+	if _, err := ParseSite("thinkgeek"); err == nil {
+		t.Error("ParseSite accepted an unknown site")
+	}
+}
@@ -0,0 +1,125 @@
+package gamestop
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// mockStores are Bay Area GameStop stores
+var mockStores = []Store{
+	{StoreID: "6542", Name: "GameStop - Stonestown Galleria", Address: "3251 20th Ave", City: "San Francisco", State: "CA", PostalCode: "94132", Phone: "(415) 564-4112"},
+	{StoreID: "4263", Name: "GameStop - Serramonte Center", Address: "3 Serramonte Center", City: "Daly City", State: "CA", PostalCode: "94015", Phone: "(650) 755-4331"},
+	{StoreID: "3617", Name: "GameStop - Bay Street Emeryville", Address: "5614 Bay St", City: "Emeryville", State: "CA", PostalCode: "94608", Phone: "(510) 547-1372"},
+	{StoreID: "5188", Name: "GameStop - Tanforan", Address: "1150 El Camino Real", City: "San Bruno", State: "CA", PostalCode: "94066", Phone: "(650) 589-2155"},
+}
+
+// mockProducts are Pokemon TCG products GameStop carries
+var mockProducts = []Product{
+	{PID: "20017594", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box", Price: 4999, URL: "https://www.gamestop.com/toys-games/trading-cards/products/20017594.html"},
+	{PID: "20017597", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Booster Bundle", Price: 2699, URL: "https://www.gamestop.com/toys-games/trading-cards/products/20017597.html"},
+	{PID: "20016032", Name: "Pokemon Trading Card Game: Surging Sparks Elite Trainer Box", Price: 4999, URL: "https://www.gamestop.com/toys-games/trading-cards/products/20016032.html"},
+	{PID: "20009321", Name: "Pokemon Trading Card Game: Scarlet & Violet 151 Ultra Premium Collection", Price: 11999, URL: "https://www.gamestop.com/toys-games/trading-cards/products/20009321.html"},
+}
+
+// MockClient serves fixed GameStop data with deterministic availability, for
+// local development without calling GameStop
+type MockClient struct {
+	latency time.Duration
+}
+
+// NewMockClient creates a mock GameStop client
+func NewMockClient() *MockClient {
+	return &MockClient{latency: 100 * time.Millisecond} // Simulate 100ms API latency
+}
+
+// simulateLatency adds a small delay to simulate network latency
+func (c *MockClient) simulateLatency(ctx context.Context) error {
+	select {
+	case <-time.After(c.latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SearchStores returns every mock store with a random distance within the radius
+func (c *MockClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+	if radiusMiles <= 0 {
+		radiusMiles = defaultRadiusMiles
+	}
+
+	stores := make([]Store, len(mockStores))
+	for i, store := range mockStores {
+		stores[i] = store
+		stores[i].Distance = float64(rand.Intn(radiusMiles)) + rand.Float64()
+	}
+	return stores, nil
+}
+
+// SearchProducts returns mock products whose name or product ID matches the query
+func (c *MockClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	queryLower := strings.ToLower(query)
+	var results []Product
+	for _, p := range mockProducts {
+		if query == "" || strings.Contains(strings.ToLower(p.Name), queryLower) || strings.Contains(p.PID, queryLower) {
+			results = append(results, p)
+		}
+	}
+	return results, nil
+}
+
+// GetProduct gets a mock product by product ID
+func (c *MockClient) GetProduct(ctx context.Context, pid string) (*Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, p := range mockProducts {
+		if p.PID == pid {
+			return &p, nil
+		}
+	}
+	return nil, notFound(pid)
+}
+
+// CheckAvailability returns the mock stores that have the product, seeded by
+// store and product ID so repeated checks give the same answer
+func (c *MockClient) CheckAvailability(ctx context.Context, pid string, postalCode string) ([]StoreAvailability, error) {
+	if _, err := c.GetProduct(ctx, pid); err != nil {
+		return nil, err
+	}
+
+	var availability []StoreAvailability
+	for i, store := range mockStores {
+		seed := int64(0)
+		for _, ch := range "gamestop" + store.StoreID + pid {
+			seed += int64(ch)
+		}
+		roll := rand.New(rand.NewSource(seed)).Float64()
+
+		// Restocks are small: 40% in stock, half of which is low stock
+		if roll >= 0.4 {
+			continue
+		}
+		availability = append(availability, StoreAvailability{
+			StoreID:        store.StoreID,
+			StoreName:      store.Name,
+			City:           store.City,
+			State:          store.State,
+			Distance:       float64(2 + 5*i),
+			InStock:        true,
+			LowStock:       roll >= 0.2,
+			PickupEligible: true,
+		})
+	}
+	return availability, nil
+}
@@ -0,0 +1,34 @@
+// Package geo computes distances between coordinates.
+package geo
+
+import "math"
+
+// earthRadiusMiles is the mean radius of the Earth
+const earthRadiusMiles = 3958.8
+
+// Point is a latitude/longitude in degrees
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// Valid reports whether the point is set. Best Buy reports unknown
+// coordinates as 0,0, which is in the Atlantic and never a real store.
+func (p Point) Valid() bool {
+	return p.Lat != 0 || p.Lng != 0
+}
+
+// Miles returns the great-circle distance between two points
+func Miles(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMiles * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// radians converts degrees to radians
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,30 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMiles(t *testing.T) {
+	sf := Point{Lat: 37.7699, Lng: -122.4134}
+	oakland := Point{Lat: 37.8044, Lng: -122.2712}
+	la := Point{Lat: 34.0522, Lng: -118.2437}
+
+	tests := []struct {
+		name string
+		a, b Point
+		want float64
+	}{
+		{"same point", sf, sf, 0},
+		{"across the bay", sf, oakland, 8.1},
+		{"SF to LA", sf, la, 347.0},
+	}
+	for _, tt := range tests {
+		if got := Miles(tt.a, tt.b); math.Abs(got-tt.want) > 0.5 {
+			t.Errorf("%s: got %.1f miles, want %.1f", tt.name, got, tt.want)
+		}
+		if got, back := Miles(tt.a, tt.b), Miles(tt.b, tt.a); math.Abs(got-back) > 1e-9 {
+			t.Errorf("%s: distance isn't symmetric (%v vs %v)", tt.name, got, back)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+)
+
+// ExportMyData dumps everything stored about the user as JSON
+func (h *StockCheckerHandler) ExportMyData(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ExportMyDataRequest],
+) (*connect.Response[stockcheckerv1.ExportMyDataResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.db.ExportUserData(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ExportMyDataResponse{
+		Json:     string(data),
+		Filename: "stock-checker-" + time.Now().UTC().Format("2006-01-02") + ".json",
+	}), nil
+}
+
+// DeleteMyAccount deletes the user's account and, with it, their watchlist,
+// alerts, sessions and history. Their allowlist entry is left for admins to
+// manage, so they can sign in again to a fresh account.
+func (h *StockCheckerHandler) DeleteMyAccount(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.DeleteMyAccountRequest],
+) (*connect.Response[stockcheckerv1.DeleteMyAccountResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Msg.Confirm {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.confirm_required")
+	}
+
+	deleted, err := h.db.DeleteUser(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !deleted {
+		if user.IsAdmin() {
+			return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.cannot_delete_last_admin")
+		}
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.user_not_found", user.ID)
+	}
+
+	log.Printf("User %d deleted their account", user.ID)
+	return connect.NewResponse(&stockcheckerv1.DeleteMyAccountResponse{}), nil
+}
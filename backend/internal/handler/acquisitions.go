@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+)
+
+// acquisitionToProto converts a purchase to its protobuf message
+func acquisitionToProto(a database.Acquisition) *stockcheckerv1.Acquisition {
+	return &stockcheckerv1.Acquisition{
+		Id:           int32(a.ID),
+		Sku:          a.SKU,
+		ProductName:  a.ProductName,
+		SetName:      a.SetName,
+		Quantity:     int32(a.Quantity),
+		PriceCents:   int64(a.Price),
+		CurrencyCode: a.CurrencyCode,
+		StoreName:    a.StoreName,
+		PurchasedOn:  a.PurchasedOn.Format(time.DateOnly),
+	}
+}
+
+// parseDate parses an optional YYYY-MM-DD date, returning the zero time if it's empty
+func parseDate(ctx context.Context, s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_date", s)
+	}
+	return t, nil
+}
+
+// currencyCode normalizes an ISO 4217 code, with USD as empty like product prices
+func currencyCode(ctx context.Context, code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "USD" {
+		return "", nil
+	}
+	if code != "" && (len(code) != 3 || strings.Trim(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "") {
+		return "", localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_currency", code)
+	}
+	return code, nil
+}
+
+// spendKey groups purchases for a total
+type spendKey struct {
+	key      string
+	currency string
+}
+
+// spendTotals totals purchases grouped by key and currency
+func spendTotals(acquisitions []database.Acquisition, key func(database.Acquisition) string) []*stockcheckerv1.SpendTotal {
+	totals := make(map[spendKey]*stockcheckerv1.SpendTotal)
+	var order []spendKey
+	for _, a := range acquisitions {
+		k := spendKey{key(a), a.CurrencyCode}
+		t, ok := totals[k]
+		if !ok {
+			t = &stockcheckerv1.SpendTotal{Key: k.key, CurrencyCode: k.currency}
+			totals[k] = t
+			order = append(order, k)
+		}
+		t.TotalCents += int64(a.Price) * int64(a.Quantity)
+		t.Quantity += int32(a.Quantity)
+	}
+
+	result := make([]*stockcheckerv1.SpendTotal, 0, len(order))
+	for _, k := range order {
+		result = append(result, totals[k])
+	}
+	return result
+}
+
+// summarizeAcquisitions totals spend per month, per set and overall
+func summarizeAcquisitions(acquisitions []database.Acquisition) *stockcheckerv1.GetAcquisitionSummaryResponse {
+	months := spendTotals(acquisitions, func(a database.Acquisition) string { return a.PurchasedOn.Format("2006-01") })
+	slices.SortStableFunc(months, func(a, b *stockcheckerv1.SpendTotal) int {
+		return cmp.Or(strings.Compare(b.Key, a.Key), strings.Compare(a.CurrencyCode, b.CurrencyCode))
+	})
+
+	sets := spendTotals(acquisitions, func(a database.Acquisition) string { return a.SetName })
+	slices.SortStableFunc(sets, func(a, b *stockcheckerv1.SpendTotal) int {
+		return cmp.Or(cmp.Compare(b.TotalCents, a.TotalCents), strings.Compare(a.Key, b.Key))
+	})
+
+	totals := spendTotals(acquisitions, func(database.Acquisition) string { return "" })
+	slices.SortFunc(totals, func(a, b *stockcheckerv1.SpendTotal) int {
+		return strings.Compare(a.CurrencyCode, b.CurrencyCode)
+	})
+
+	return &stockcheckerv1.GetAcquisitionSummaryResponse{Months: months, Sets: sets, Totals: totals}
+}
+
+// userAcquisitions returns the authenticated user's purchases between two optional dates
+func (h *StockCheckerHandler) userAcquisitions(ctx context.Context, from, until string) ([]database.Acquisition, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDate, err := parseDate(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	untilDate, err := parseDate(ctx, until)
+	if err != nil {
+		return nil, err
+	}
+
+	acquisitions, err := h.db.GetUserAcquisitions(ctx, user.ID, fromDate, untilDate)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	return acquisitions, nil
+}
+
+// MarkPurchased records a purchase of one of the user's saved products
+func (h *StockCheckerHandler) MarkPurchased(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.MarkPurchasedRequest],
+) (*connect.Response[stockcheckerv1.MarkPurchasedResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := req.Msg.Acquisition
+	if pb == nil || pb.Sku == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+
+	quantity := int(pb.Quantity)
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_quantity")
+	}
+	if pb.PriceCents < 0 {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_price")
+	}
+	currency, err := currencyCode(ctx, pb.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+	purchasedOn, err := parseDate(ctx, pb.PurchasedOn)
+	if err != nil {
+		return nil, err
+	}
+	if purchasedOn.IsZero() {
+		purchasedOn = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+
+	product, err := h.db.GetUserProduct(ctx, user.ID, "", pb.Sku)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if product == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.product_not_saved", pb.Sku)
+	}
+
+	acquisition := database.Acquisition{
+		UserID:       user.ID,
+		Retailer:     product.Retailer,
+		SKU:          product.SKU,
+		ProductName:  product.Name,
+		SetName:      product.SetName,
+		Quantity:     quantity,
+		Price:        money.Cents(pb.PriceCents),
+		CurrencyCode: currency,
+		StoreName:    strings.TrimSpace(pb.StoreName),
+		PurchasedOn:  purchasedOn,
+	}
+	acquisition.ID, err = h.db.AddAcquisition(ctx, acquisition)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.MarkPurchasedResponse{
+		Acquisition: acquisitionToProto(acquisition),
+	}), nil
+}
+
+// GetMyAcquisitions returns the user's recorded purchases, newest first
+func (h *StockCheckerHandler) GetMyAcquisitions(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyAcquisitionsRequest],
+) (*connect.Response[stockcheckerv1.GetMyAcquisitionsResponse], error) {
+	acquisitions, err := h.userAcquisitions(ctx, req.Msg.From, req.Msg.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	page, next, err := paginate(ctx, acquisitions, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pbAcquisitions := make([]*stockcheckerv1.Acquisition, 0, len(page))
+	for _, a := range page {
+		pbAcquisitions = append(pbAcquisitions, acquisitionToProto(a))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetMyAcquisitionsResponse{
+		Acquisitions:  pbAcquisitions,
+		NextPageToken: next,
+	}), nil
+}
+
+// DeleteAcquisition removes one of the user's recorded purchases
+func (h *StockCheckerHandler) DeleteAcquisition(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.DeleteAcquisitionRequest],
+) (*connect.Response[stockcheckerv1.DeleteAcquisitionResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := h.db.DeleteAcquisition(ctx, user.ID, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.acquisition_not_found", req.Msg.Id)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.DeleteAcquisitionResponse{}), nil
+}
+
+// GetAcquisitionSummary totals the user's spend per month and set
+func (h *StockCheckerHandler) GetAcquisitionSummary(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetAcquisitionSummaryRequest],
+) (*connect.Response[stockcheckerv1.GetAcquisitionSummaryResponse], error) {
+	acquisitions, err := h.userAcquisitions(ctx, req.Msg.From, req.Msg.Until)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(summarizeAcquisitions(acquisitions)), nil
+}
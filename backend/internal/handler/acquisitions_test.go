@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+func TestSummarizeAcquisitions(t *testing.T) {
+	day := func(s string) time.Time {
+		d, _ := time.Parse(time.DateOnly, s)
+		return d
+	}
+	acquisitions := []database.Acquisition{
+		{SetName: "Prismatic Evolutions", Quantity: 2, Price: 4999, PurchasedOn: day("2026-02-14")},
+		{SetName: "Surging Sparks", Quantity: 1, Price: 16164, PurchasedOn: day("2026-02-01")},
+		{SetName: "Prismatic Evolutions", Quantity: 1, Price: 2694, PurchasedOn: day("2026-01-20")},
+		{SetName: "", Quantity: 3, Price: 449, PurchasedOn: day("2026-01-03")},
+		{SetName: "Prismatic Evolutions", Quantity: 1, Price: 6999, CurrencyCode: "CAD", PurchasedOn: day("2026-01-10")},
+	}
+
+	got := summarizeAcquisitions(acquisitions)
+
+	format := func(totals []*stockcheckerv1.SpendTotal) string {
+		var s string
+		for _, t := range totals {
+			s += fmt.Sprintf("[%s %s %d x%d]", t.Key, t.CurrencyCode, t.TotalCents, t.Quantity)
+		}
+		return s
+	}
+	tests := []struct {
+		name string
+		got  []*stockcheckerv1.SpendTotal
+		want string
+	}{
+		{"months", got.Months, "[2026-02  26162 x3][2026-01  4041 x4][2026-01 CAD 6999 x1]"},
+		{"sets", got.Sets, "[Surging Sparks  16164 x1][Prismatic Evolutions  12692 x3][Prismatic Evolutions CAD 6999 x1][  1347 x3]"},
+		{"totals", got.Totals, "[  30203 x7][ CAD 6999 x1]"},
+	}
+	for _, tt := range tests {
+		if s := format(tt.got); s != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, s, tt.want)
+		}
+	}
+}
+
+func TestCurrencyCode(t *testing.T) {
+	for code, want := range map[string]string{"": "", "usd": "", " cad ": "CAD", "EUR": "EUR"} {
+		if got, err := currencyCode(t.Context(), code); err != nil || got != want {
+			t.Errorf("currencyCode(%q) = %q, %v; want %q", code, got, err, want)
+		}
+	}
+	for _, code := range []string{"US", "DOLLARS", "C4D"} {
+		if _, err := currencyCode(t.Context(), code); err == nil {
+			t.Errorf("currencyCode(%q) succeeded, want error", code)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// userToProto converts a user to its protobuf message
+func userToProto(u *database.User) *stockcheckerv1.User {
+	return &stockcheckerv1.User{
+		Id:         int32(u.ID),
+		Email:      u.Email,
+		Name:       u.Name,
+		PictureUrl: u.PictureURL,
+		Locale:     u.Locale,
+		IsAdmin:    u.IsAdmin(),
+		CreatedAt:  timestamp(u.CreatedAt),
+		Role:       roleToProto(u.Role),
+	}
+}
+
+// parseEmail validates a bare email address, returning it lowercased
+func parseEmail(ctx context.Context, email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", localizedError(ctx, connect.CodeInvalidArgument, "error.email_required")
+	}
+	// Reject display names ("Ash <ash@example.com>"): only the address is stored
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_email", email)
+	}
+	return strings.ToLower(email), nil
+}
+
+// parseDomain validates an email domain, with or without a leading "@",
+// returning it lowercased without the "@"
+func parseDomain(ctx context.Context, domain string) (string, error) {
+	domain = strings.TrimPrefix(strings.TrimSpace(domain), "@")
+	if domain == "" {
+		return "", localizedError(ctx, connect.CodeInvalidArgument, "error.domain_required")
+	}
+	// A domain is valid if an address on it is. Requiring a dot keeps out
+	// single labels like "com" that would admit far too much.
+	probe := "user@" + domain
+	addr, err := mail.ParseAddress(probe)
+	if err != nil || addr.Address != probe || !strings.Contains(domain, ".") || strings.ContainsAny(domain, "@[]") {
+		return "", localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_domain", domain)
+	}
+	return strings.ToLower(domain), nil
+}
+
+// AdminAddAllowedEmail allows an email address to sign in (admin only)
+func (h *StockCheckerHandler) AdminAddAllowedEmail(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminAddAllowedEmailRequest],
+) (*connect.Response[stockcheckerv1.AdminAddAllowedEmailResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := parseEmail(ctx, req.Msg.Email)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db.AddAllowedEmail(ctx, email, &user.ID); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminAddAllowedEmailResponse{}), nil
+}
+
+// AdminRemoveAllowedEmail stops an email address signing in and signs out
+// anyone using it (admin only)
+func (h *StockCheckerHandler) AdminRemoveAllowedEmail(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminRemoveAllowedEmailRequest],
+) (*connect.Response[stockcheckerv1.AdminRemoveAllowedEmailResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	email, err := parseEmail(ctx, req.Msg.Email)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(email, user.Email) {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.cannot_remove_own_email")
+	}
+
+	removed, err := h.db.RemoveAllowedEmail(ctx, email)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !removed {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.allowed_email_not_found", email)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminRemoveAllowedEmailResponse{}), nil
+}
+
+// AdminListAllowedEmails lists the email addresses allowed to sign in (admin only)
+func (h *StockCheckerHandler) AdminListAllowedEmails(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminListAllowedEmailsRequest],
+) (*connect.Response[stockcheckerv1.AdminListAllowedEmailsResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	emails, err := h.db.GetAllowedEmails(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	page, next, err := paginate(ctx, emails, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pbEmails := make([]*stockcheckerv1.AllowedEmail, 0, len(page))
+	for _, e := range page {
+		pbEmails = append(pbEmails, &stockcheckerv1.AllowedEmail{
+			Email:     e.Email,
+			AddedBy:   e.AddedByEmail,
+			CreatedAt: timestamp(e.CreatedAt),
+		})
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminListAllowedEmailsResponse{
+		AllowedEmails: pbEmails,
+		NextPageToken: next,
+	}), nil
+}
+
+// AdminAddAllowedDomain allows every verified address on a domain to sign in (admin only)
+func (h *StockCheckerHandler) AdminAddAllowedDomain(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminAddAllowedDomainRequest],
+) (*connect.Response[stockcheckerv1.AdminAddAllowedDomainResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := parseDomain(ctx, req.Msg.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.db.AddAllowedDomain(ctx, domain, &user.ID); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminAddAllowedDomainResponse{}), nil
+}
+
+// AdminRemoveAllowedDomain stops a domain's addresses signing in and signs out
+// anyone on it who isn't allowed by their own address (admin only)
+func (h *StockCheckerHandler) AdminRemoveAllowedDomain(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminRemoveAllowedDomainRequest],
+) (*connect.Response[stockcheckerv1.AdminRemoveAllowedDomainResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := parseDomain(ctx, req.Msg.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(domain, database.EmailDomain(user.Email)) {
+		allowed, err := h.db.IsAllowedEmailListed(ctx, user.Email)
+		if err != nil {
+			return nil, h.dbError(err)
+		}
+		if !allowed {
+			return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.cannot_remove_own_domain")
+		}
+	}
+
+	removed, err := h.db.RemoveAllowedDomain(ctx, domain)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !removed {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.allowed_domain_not_found", domain)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminRemoveAllowedDomainResponse{}), nil
+}
+
+// AdminListAllowedDomains lists the email domains allowed to sign in (admin only)
+func (h *StockCheckerHandler) AdminListAllowedDomains(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminListAllowedDomainsRequest],
+) (*connect.Response[stockcheckerv1.AdminListAllowedDomainsResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	domains, err := h.db.GetAllowedDomains(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	page, next, err := paginate(ctx, domains, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pbDomains := make([]*stockcheckerv1.AllowedDomain, 0, len(page))
+	for _, d := range page {
+		pbDomains = append(pbDomains, &stockcheckerv1.AllowedDomain{
+			Domain:    d.Domain,
+			AddedBy:   d.AddedByEmail,
+			CreatedAt: timestamp(d.CreatedAt),
+		})
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminListAllowedDomainsResponse{
+		AllowedDomains: pbDomains,
+		NextPageToken:  next,
+	}), nil
+}
+
+// AdminListUsers lists the users who have signed in (admin only)
+func (h *StockCheckerHandler) AdminListUsers(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminListUsersRequest],
+) (*connect.Response[stockcheckerv1.AdminListUsersResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	users, err := h.db.GetUsers(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	page, next, err := paginate(ctx, users, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pbUsers := make([]*stockcheckerv1.User, 0, len(page))
+	for i := range page {
+		pbUsers = append(pbUsers, userToProto(&page[i]))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminListUsersResponse{
+		Users:         pbUsers,
+		NextPageToken: next,
+	}), nil
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"testing"
+)
+
+func TestParseEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string // empty if invalid
+	}{
+		{"ash@example.com", "ash@example.com"},
+		{"  Ash@Example.com ", "ash@example.com"},
+		{"", ""},
+		{"ash", ""},
+		{"Ash <ash@example.com>", ""}, // display names aren't stored
+		{"ash@example.com, misty@example.com", ""},
+	}
+	for _, tt := range tests {
+		got, err := parseEmail(context.Background(), tt.email)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%q: got %q, want an error", tt.email, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%q: got %q, %v; want %q", tt.email, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string // empty if invalid
+	}{
+		{"mycompany.com", "mycompany.com"},
+		{" @MyCompany.com ", "mycompany.com"},
+		{"mail.mycompany.co.uk", "mail.mycompany.co.uk"},
+		{"", ""},
+		{"@", ""},
+		{"com", ""}, // would allow a whole top-level domain
+		{"ash@mycompany.com", ""},
+		{"my company.com", ""},
+		{"[127.0.0.1]", ""},
+	}
+	for _, tt := range tests {
+		got, err := parseDomain(context.Background(), tt.domain)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%q: got %q, want an error", tt.domain, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%q: got %q, %v; want %q", tt.domain, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+)
+
+// API call stats limits in hours
+const (
+	defaultAPICallStatsHours = 24
+	maxAPICallStatsHours     = 30 * 24 // calls are kept for 30 days
+)
+
+// AdminGetApiCallStats sums up sampled Best Buy API calls by endpoint and
+// priority, the most quota used first (admin only)
+func (h *StockCheckerHandler) AdminGetApiCallStats(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminGetApiCallStatsRequest],
+) (*connect.Response[stockcheckerv1.AdminGetApiCallStatsResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	hours := int(req.Msg.Hours)
+	if hours <= 0 {
+		hours = defaultAPICallStatsHours
+	}
+	if hours > maxAPICallStatsHours {
+		hours = maxAPICallStatsHours
+	}
+
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+	stats, err := h.db.GetAPICallStats(ctx, "bestbuy", since)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbStats := make([]*stockcheckerv1.ApiCallStats, 0, len(stats))
+	for _, s := range stats {
+		pbStats = append(pbStats, &stockcheckerv1.ApiCallStats{
+			Endpoint:           s.Endpoint,
+			Priority:           s.Priority,
+			SampledCalls:       int32(s.Sampled),
+			EstimatedCalls:     s.Calls,
+			EstimatedQuotaCost: s.QuotaCost,
+			EstimatedErrors:    s.Errors,
+			AvgLatencyMs:       s.AvgLatencyMs,
+			P95LatencyMs:       s.P95LatencyMs,
+		})
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminGetApiCallStatsResponse{
+		Stats: pbStats,
+		Since: timestamp(since),
+	}), nil
+}
@@ -0,0 +1,248 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// deprecation schedules the removal of an RPC or field
+type deprecation struct {
+	target      string // full name of the method or field
+	replacement string // full name of what replaces it; empty if nothing does
+	since       time.Time
+	sunset      time.Time // zero until a removal date is announced
+	note        string
+}
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+// deprecations lists what integrators should move off. Fields marked
+// deprecated in the proto must be listed too. Sunsets are announced at
+// least 90 days ahead in the changelog.
+var deprecations = []deprecation{
+	{
+		target:      "stockchecker.v1.Product.sale_price",
+		replacement: "stockchecker.v1.Product.sale_price_cents",
+		since:       day(2026, time.October, 16),
+		sunset:      day(2027, time.April, 16),
+		note:        "Dollar amounts as doubles lose cents; prices are integer cents of currency_code.",
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.SearchStores",
+		replacement: "stockchecker.v2.StockCheckerService.SearchStores",
+		since:       day(2026, time.October, 16),
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.SearchProducts",
+		replacement: "stockchecker.v2.StockCheckerService.SearchProducts",
+		since:       day(2026, time.October, 16),
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.CheckStock",
+		replacement: "stockchecker.v2.StockCheckerService.CheckStock",
+		since:       day(2026, time.October, 16),
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.GetMyStores",
+		replacement: "stockchecker.v2.StockCheckerService.ListMyStores",
+		since:       day(2026, time.October, 16),
+		note:        "v2 pages through saved stores instead of returning them all.",
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.AddMyStore",
+		replacement: "stockchecker.v2.StockCheckerService.AddMyStore",
+		since:       day(2026, time.October, 16),
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.RemoveMyStore",
+		replacement: "stockchecker.v2.StockCheckerService.RemoveMyStore",
+		since:       day(2026, time.October, 16),
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.GetMyProducts",
+		replacement: "stockchecker.v2.StockCheckerService.ListMyProducts",
+		since:       day(2026, time.October, 16),
+		note:        "v2 pages through saved products instead of returning them all.",
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.AddMyProduct",
+		replacement: "stockchecker.v2.StockCheckerService.AddMyProduct",
+		since:       day(2026, time.October, 16),
+	},
+	{
+		target:      "stockchecker.v1.StockCheckerService.RemoveMyProduct",
+		replacement: "stockchecker.v2.StockCheckerService.RemoveMyProduct",
+		since:       day(2026, time.October, 16),
+	},
+}
+
+// apiChanges is the API changelog, newest first
+var apiChanges = []struct {
+	date    time.Time
+	changes []string
+}{
+	{day(2026, time.October, 16), []string{
+		"Added Product.retailer, so v1 clients can tell products saved from other retailers apart from Best Buy's.",
+		"Added GetMyInboundEmail and ListMyReminders: forwarded Best Buy invitation and order-ready emails become reminders.",
+		"Added UpcomingDrops, listing invitation-only drops of restricted products; watchers are notified when entries open.",
+		"Added GetMyUsage: checks and notifications counted per day, and how busy the Best Buy API is.",
+		"Added GetApiInfo, with this changelog and the deprecation schedule.",
+		"Calls to deprecated RPCs, or setting deprecated fields, return Deprecation and Sunset headers.",
+		"Deprecated the v1 store, product and stock RPCs that v2 replaces; v1 stays served until a sunset is announced here.",
+		"Deprecated Product.sale_price in favor of sale_price_cents, to be removed on 2027-04-16.",
+		"Added /openapi.json and /client.zip for generating clients.",
+		"Added WatchStock, ExportMyData and DeleteMyAccount.",
+	}},
+}
+
+// deprecationsByTarget indexes deprecations by full name
+var deprecationsByTarget = func() map[protoreflect.FullName]deprecation {
+	m := make(map[protoreflect.FullName]deprecation, len(deprecations))
+	for _, d := range deprecations {
+		m[protoreflect.FullName(d.target)] = d
+	}
+	return m
+}()
+
+// DeprecatedNames returns the full names of every deprecated RPC and field
+func DeprecatedNames() []string {
+	names := make([]string, len(deprecations))
+	for i, d := range deprecations {
+		names[i] = d.target
+	}
+	return names
+}
+
+// SetVersion sets the server's release reported by GetApiInfo
+func (h *StockCheckerHandler) SetVersion(version string) {
+	h.version = version
+}
+
+// GetApiInfo returns the API changelog and deprecation schedule
+func (h *StockCheckerHandler) GetApiInfo(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetApiInfoRequest],
+) (*connect.Response[stockcheckerv1.GetApiInfoResponse], error) {
+	resp := &stockcheckerv1.GetApiInfoResponse{Version: h.version}
+
+	sorted := append([]deprecation(nil), deprecations...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i].sunset, sorted[j].sunset
+		return !a.IsZero() && (b.IsZero() || a.Before(b))
+	})
+	for _, d := range sorted {
+		pb := &stockcheckerv1.ApiDeprecation{
+			Target:       d.target,
+			Replacement:  d.replacement,
+			DeprecatedAt: timestamppb.New(d.since),
+			Note:         d.note,
+		}
+		if !d.sunset.IsZero() {
+			pb.SunsetAt = timestamppb.New(d.sunset)
+		}
+		resp.Deprecations = append(resp.Deprecations, pb)
+	}
+	for _, c := range apiChanges {
+		resp.Changelog = append(resp.Changelog, &stockcheckerv1.ApiChange{
+			Date:    timestamppb.New(c.date),
+			Changes: c.changes,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DeprecationInterceptor adds Deprecation and Sunset headers (RFC 9745 and
+// RFC 8594) to calls of deprecated RPCs and to requests that set deprecated
+// fields, so integrators' tooling notices before anything is removed
+func DeprecationInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+
+			var used []deprecation
+			if d, ok := deprecationsByTarget[procedureName(req.Spec().Procedure)]; ok {
+				used = append(used, d)
+			}
+			if msg, ok := req.Any().(proto.Message); ok {
+				used = deprecatedFieldsSet(msg.ProtoReflect(), used)
+			}
+			if len(used) == 0 {
+				return resp, err
+			}
+
+			header := http.Header{}
+			setDeprecationHeaders(header, used)
+			var connectErr *connect.Error
+			switch {
+			case resp != nil:
+				for k, v := range header {
+					resp.Header()[k] = v
+				}
+			case errors.As(err, &connectErr):
+				for k, v := range header {
+					connectErr.Meta()[k] = v
+				}
+			}
+			return resp, err
+		}
+	})
+}
+
+// procedureName converts a procedure path like "/pkg.Service/Method" to
+// the method's full name, "pkg.Service.Method"
+func procedureName(procedure string) protoreflect.FullName {
+	service, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return protoreflect.FullName(service + "." + method)
+}
+
+// deprecatedFieldsSet appends the deprecations of fields set in msg, at any depth
+func deprecatedFieldsSet(msg protoreflect.Message, used []deprecation) []deprecation {
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if d, ok := deprecationsByTarget[fd.FullName()]; ok {
+			used = append(used, d)
+		}
+		if fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				used = deprecatedFieldsSet(list.Get(i).Message(), used)
+			}
+		} else {
+			used = deprecatedFieldsSet(v.Message(), used)
+		}
+		return true
+	})
+	return used
+}
+
+// setDeprecationHeaders sets the earliest deprecation and sunset among used
+func setDeprecationHeaders(header http.Header, used []deprecation) {
+	var since, sunset time.Time
+	for _, d := range used {
+		if since.IsZero() || d.since.Before(since) {
+			since = d.since
+		}
+		if !d.sunset.IsZero() && (sunset.IsZero() || d.sunset.Before(sunset)) {
+			sunset = d.sunset
+		}
+	}
+	header.Set("Deprecation", fmt.Sprintf("@%d", since.Unix()))
+	if !sunset.IsZero() {
+		header.Set("Sunset", sunset.UTC().Format(http.TimeFormat))
+	}
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	_ "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestDeprecationsMatchProto(t *testing.T) {
+	for _, d := range deprecations {
+		for _, name := range []string{d.target, d.replacement} {
+			if name == "" {
+				continue
+			}
+			if _, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name)); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+		if !d.sunset.IsZero() && d.sunset.Before(d.since) {
+			t.Errorf("%s sunsets before it was deprecated", d.target)
+		}
+	}
+
+	// Every field deprecated in the proto has a schedule
+	messages := stockcheckerv1.File_stockchecker_v1_service_proto.Messages()
+	for i := 0; i < messages.Len(); i++ {
+		fields := messages.Get(i).Fields()
+		for j := 0; j < fields.Len(); j++ {
+			field := fields.Get(j)
+			if field.Options().(*descriptorpb.FieldOptions).GetDeprecated() {
+				if _, ok := deprecationsByTarget[field.FullName()]; !ok {
+					t.Errorf("%s is deprecated in the proto but missing from deprecations", field.FullName())
+				}
+			}
+		}
+	}
+}
+
+func TestDeprecationInterceptor(t *testing.T) {
+	next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&stockcheckerv1.AddMyProductResponse{}), nil
+	})
+	call := DeprecationInterceptor().WrapUnary(next)
+
+	tests := []struct {
+		name        string
+		procedure   string
+		product     *stockcheckerv1.Product
+		deprecation string
+		sunset      string
+	}{
+		{"current", "/stockchecker.v1.StockCheckerService/UpdateMyProduct", &stockcheckerv1.Product{SalePriceCents: 4999}, "", ""},
+		{"deprecated field", "/stockchecker.v1.StockCheckerService/UpdateMyProduct", &stockcheckerv1.Product{SalePrice: 49.99}, "@1792108800", "Fri, 16 Apr 2027 00:00:00 GMT"},
+		{"deprecated RPC", "/stockchecker.v1.StockCheckerService/AddMyProduct", &stockcheckerv1.Product{}, "@1792108800", ""},
+	}
+	for _, tt := range tests {
+		req := &productRequest{connect.NewRequest(&stockcheckerv1.AddMyProductRequest{Product: tt.product}), tt.procedure}
+		resp, err := call(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := resp.Header().Get("Deprecation"); got != tt.deprecation {
+			t.Errorf("%s: Deprecation %q, want %q", tt.name, got, tt.deprecation)
+		}
+		if got := resp.Header().Get("Sunset"); got != tt.sunset {
+			t.Errorf("%s: Sunset %q, want %q", tt.name, got, tt.sunset)
+		}
+	}
+}
+
+// productRequest is a request carrying a product, routed to procedure
+type productRequest struct {
+	*connect.Request[stockcheckerv1.AddMyProductRequest]
+	procedure string
+}
+
+func (r *productRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: r.procedure, StreamType: connect.StreamTypeUnary}
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+const (
+	maxAPIKeysPerUser = 10
+	maxAPIKeyNameLen  = 100
+)
+
+// sessionOnlyProcedures can only be called from a signed-in session, so a
+// leaked key can't be used to mint more keys, sign the owner out, or export
+// or delete their account
+var sessionOnlyProcedures = map[string]bool{
+	stockcheckerv1connect.StockCheckerServiceGetMyApiKeysProcedure:    true,
+	stockcheckerv1connect.StockCheckerServiceCreateApiKeyProcedure:    true,
+	stockcheckerv1connect.StockCheckerServiceRevokeApiKeyProcedure:    true,
+	stockcheckerv1connect.StockCheckerServiceListSessionsProcedure:    true,
+	stockcheckerv1connect.StockCheckerServiceRevokeSessionProcedure:   true,
+	stockcheckerv1connect.StockCheckerServiceExportMyDataProcedure:    true,
+	stockcheckerv1connect.StockCheckerServiceDeleteMyAccountProcedure: true,
+}
+
+// apiKeyUser gets the owner of an API key. Keys can't be used for the
+// Admin* RPCs or to manage keys and sessions.
+func (h *StockCheckerHandler) apiKeyUser(ctx context.Context, procedure, key string) (*database.User, error) {
+	if isAdminProcedure(procedure) || sessionOnlyProcedures[procedure] {
+		return nil, localizedError(ctx, connect.CodePermissionDenied, "error.api_key_not_allowed")
+	}
+	user, err := h.db.GetUserByAPIKey(ctx, auth.HashAPIKey(key))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if user == nil {
+		return nil, localizedError(ctx, connect.CodeUnauthenticated, "error.invalid_api_key")
+	}
+	return user, nil
+}
+
+// apiKeyToProto converts an API key to its protobuf message
+func apiKeyToProto(k database.APIKey) *stockcheckerv1.ApiKey {
+	return &stockcheckerv1.ApiKey{
+		Id:         int32(k.ID),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		CreatedAt:  timestamp(k.CreatedAt),
+		LastUsedAt: timestamp(k.LastUsedAt),
+	}
+}
+
+// GetMyApiKeys lists the user's API keys
+func (h *StockCheckerHandler) GetMyApiKeys(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyApiKeysRequest],
+) (*connect.Response[stockcheckerv1.GetMyApiKeysResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := h.db.GetUserAPIKeys(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbKeys := make([]*stockcheckerv1.ApiKey, 0, len(keys))
+	for _, k := range keys {
+		pbKeys = append(pbKeys, apiKeyToProto(k))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetMyApiKeysResponse{
+		ApiKeys: pbKeys,
+	}), nil
+}
+
+// CreateApiKey creates an API key, returning the key itself this once
+func (h *StockCheckerHandler) CreateApiKey(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.CreateApiKeyRequest],
+) (*connect.Response[stockcheckerv1.CreateApiKeyResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.api_key_name_required")
+	}
+	if utf8.RuneCountInString(name) > maxAPIKeyNameLen {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.api_key_name_too_long", maxAPIKeyNameLen)
+	}
+
+	existing, err := h.db.GetUserAPIKeys(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if len(existing) >= maxAPIKeysPerUser {
+		return nil, localizedError(ctx, connect.CodeResourceExhausted, "error.too_many_api_keys", maxAPIKeysPerUser)
+	}
+
+	key, prefix, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	created, err := h.db.CreateAPIKey(ctx, user.ID, name, prefix, auth.HashAPIKey(key))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.CreateApiKeyResponse{
+		ApiKey: apiKeyToProto(created),
+		Key:    key,
+	}), nil
+}
+
+// RevokeApiKey revokes one of the user's API keys
+func (h *StockCheckerHandler) RevokeApiKey(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.RevokeApiKeyRequest],
+) (*connect.Response[stockcheckerv1.RevokeApiKeyResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := h.db.DeleteAPIKey(ctx, user.ID, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.api_key_not_found", req.Msg.Id)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.RevokeApiKeyResponse{}), nil
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2/stockcheckerv2connect"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// SessionAuthenticator finds the session whose cookie is in the request
+// headers and its user, returning nils if there's no valid session
+type SessionAuthenticator interface {
+	SessionFromHeader(ctx context.Context, header http.Header) (*database.Session, *database.User, error)
+}
+
+// authPolicy is who may call a procedure
+type authPolicy int
+
+const (
+	policySignedIn authPolicy = iota // the default: a session or API key is required
+	policyPublic                     // anyone; the user is still set if they're signed in
+)
+
+// procedurePolicies lists the procedures that don't require signing in.
+// Anything missing requires it, so new RPCs are private until listed here.
+var procedurePolicies = map[string]authPolicy{
+	stockcheckerv1connect.StockCheckerServiceSearchStoresProcedure:       policyPublic,
+	stockcheckerv1connect.StockCheckerServiceSearchProductsProcedure:     policyPublic,
+	stockcheckerv1connect.StockCheckerServiceGetProductDomainProcedure:   policyPublic,
+	stockcheckerv1connect.StockCheckerServiceGetClientBootstrapProcedure: policyPublic,
+	stockcheckerv1connect.StockCheckerServiceGetApiInfoProcedure:         policyPublic,
+	stockcheckerv2connect.StockCheckerServiceListRetailersProcedure:      policyPublic,
+	stockcheckerv2connect.StockCheckerServiceSearchStoresProcedure:       policyPublic,
+	stockcheckerv2connect.StockCheckerServiceSearchProductsProcedure:     policyPublic,
+}
+
+// AuthInterceptor authenticates each request by its session cookie or, for
+// scripts, an "Authorization: Bearer <key>" API key, then enforces the
+// procedure's policy. Streams are authenticated when they open.
+func (h *StockCheckerHandler) AuthInterceptor(sessions SessionAuthenticator) connect.Interceptor {
+	return &authInterceptor{h: h, sessions: sessions}
+}
+
+// authInterceptor authenticates unary calls and streams alike
+type authInterceptor struct {
+	h        *StockCheckerHandler
+	sessions SessionAuthenticator
+}
+
+// authenticate adds the caller's session and user to ctx
+func (a *authInterceptor) authenticate(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	session, user, err := a.sessions.SessionFromHeader(ctx, header)
+	if err != nil {
+		return nil, a.h.dbError(err)
+	}
+	if session != nil {
+		ctx = auth.WithSession(ctx, session.ID)
+	}
+	if user == nil {
+		if key := auth.BearerToken(header.Get("Authorization")); key != "" {
+			if user, err = a.h.apiKeyUser(ctx, procedure, key); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if user != nil {
+		ctx = auth.WithUser(ctx, user)
+	} else if procedurePolicies[procedure] != policyPublic {
+		return nil, localizedError(ctx, connect.CodeUnauthenticated, "error.not_authenticated")
+	}
+	return ctx, nil
+}
+
+func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := a.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := a.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// fakeSessions signs in anyone sending the "session_token=valid" cookie
+type fakeSessions struct{ user *database.User }
+
+func (f fakeSessions) SessionFromHeader(ctx context.Context, header http.Header) (*database.Session, *database.User, error) {
+	if header.Get("Cookie") == auth.SessionCookieName+"=valid" {
+		return &database.Session{ID: 3, UserID: f.user.ID}, f.user, nil
+	}
+	return nil, nil, nil
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	signedIn := &database.User{ID: 7}
+	var gotUser *database.User
+	next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		gotUser = auth.UserFromContext(ctx)
+		return connect.NewResponse(&stockcheckerv1.AdminListUsersResponse{}), nil
+	})
+	h := NewStockCheckerHandler(nil, nil, nil, nil)
+	call := h.AuthInterceptor(fakeSessions{signedIn}).WrapUnary(next)
+
+	tests := []struct {
+		name      string
+		procedure string
+		cookie    string
+		wantCode  connect.Code // 0 if allowed
+		wantUser  *database.User
+	}{
+		{"public signed out", stockcheckerv1connect.StockCheckerServiceSearchStoresProcedure, "", 0, nil},
+		{"public signed in", stockcheckerv1connect.StockCheckerServiceSearchProductsProcedure, "valid", 0, signedIn},
+		{"private signed out", stockcheckerv1connect.StockCheckerServiceGetMyStoresProcedure, "", connect.CodeUnauthenticated, nil},
+		{"private expired session", stockcheckerv1connect.StockCheckerServiceGetMyStoresProcedure, "expired", connect.CodeUnauthenticated, nil},
+		{"private signed in", stockcheckerv1connect.StockCheckerServiceGetMyStoresProcedure, "valid", 0, signedIn},
+	}
+	for _, tt := range tests {
+		gotUser = nil
+		req := connect.NewRequest(&stockcheckerv1.AdminListUsersRequest{})
+		if tt.cookie != "" {
+			req.Header().Set("Cookie", auth.SessionCookieName+"="+tt.cookie)
+		}
+		_, err := call(context.Background(), &procedureRequest{req, tt.procedure})
+		if got := connect.CodeOf(err); err != nil && got != tt.wantCode || err == nil && tt.wantCode != 0 {
+			t.Errorf("%s: err = %v, want code %v", tt.name, err, tt.wantCode)
+		}
+		if gotUser != tt.wantUser {
+			t.Errorf("%s: user = %v, want %v", tt.name, gotUser, tt.wantUser)
+		}
+	}
+}
+
+func TestAuthInterceptorRejectsAPIKeysForKeyManagement(t *testing.T) {
+	next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		t.Fatal("handler called")
+		return nil, nil
+	})
+	h := NewStockCheckerHandler(nil, nil, nil, nil)
+	call := h.AuthInterceptor(fakeSessions{}).WrapUnary(next)
+
+	for _, procedure := range []string{
+		stockcheckerv1connect.StockCheckerServiceCreateApiKeyProcedure,
+		stockcheckerv1connect.StockCheckerServiceRevokeSessionProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminListUsersProcedure,
+	} {
+		req := connect.NewRequest(&stockcheckerv1.AdminListUsersRequest{})
+		req.Header().Set("Authorization", "Bearer sck_leaked")
+		_, err := call(context.Background(), &procedureRequest{req, procedure})
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("%s: err = %v, want permission denied", procedure, err)
+		}
+	}
+}
+
+func TestAuthInterceptorSetsSession(t *testing.T) {
+	var gotSession int
+	next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		gotSession = auth.SessionIDFromContext(ctx)
+		return connect.NewResponse(&stockcheckerv1.ListSessionsResponse{}), nil
+	})
+	h := NewStockCheckerHandler(nil, nil, nil, nil)
+	call := h.AuthInterceptor(fakeSessions{&database.User{ID: 7}}).WrapUnary(next)
+
+	req := connect.NewRequest(&stockcheckerv1.AdminListUsersRequest{})
+	req.Header().Set("Cookie", auth.SessionCookieName+"=valid")
+	if _, err := call(context.Background(), &procedureRequest{req, stockcheckerv1connect.StockCheckerServiceListSessionsProcedure}); err != nil {
+		t.Fatal(err)
+	}
+	if gotSession != 3 {
+		t.Errorf("session ID = %d, want 3", gotSession)
+	}
+
+	if pb := sessionToProto(database.Session{ID: 3, Token: "secret"}, gotSession); !pb.Current {
+		t.Error("the caller's session isn't marked current")
+	}
+}
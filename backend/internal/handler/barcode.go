@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/barcode"
+)
+
+// Barcode rendering size in pixels
+const (
+	barcodeModuleWidth = 2
+	barcodeHeight      = 80
+)
+
+// GetProductBarcode returns a barcode for a product that a store associate can
+// scan to find it, e.g. for TCG products kept behind the counter. Products with
+// a UPC get a UPC-A barcode, which any register scanner reads; otherwise the
+// Best Buy SKU is encoded as Code 128.
+func (h *StockCheckerHandler) GetProductBarcode(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetProductBarcodeRequest],
+) (*connect.Response[stockcheckerv1.GetProductBarcodeResponse], error) {
+	if req.Msg.Sku == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+
+	product, err := h.bbClient.GetProductBySKU(ctx, req.Msg.Sku)
+	if err != nil {
+		log.Printf("Error getting product %s: %v", req.Msg.Sku, err)
+		return nil, bestBuyError(ctx, err)
+	}
+
+	sku := fmt.Sprintf("%d", product.SKU)
+	code, err := barcode.EncodeUPCA(product.UPC)
+	if err != nil {
+		if code, err = barcode.EncodeCode128(sku); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetProductBarcodeResponse{
+		Sku:         sku,
+		ProductName: product.Name,
+		Symbology:   string(code.Symbology),
+		Payload:     code.Text,
+		Svg:         code.SVG(barcodeModuleWidth, barcodeHeight),
+	}), nil
+}
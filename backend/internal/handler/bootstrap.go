@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+)
+
+// SetAnnouncement sets the banner shown to everyone in the web app
+func (h *StockCheckerHandler) SetAnnouncement(text string) {
+	h.announcement = text
+}
+
+// SetLoginProviders sets the ways to sign in offered on the login page
+func (h *StockCheckerHandler) SetLoginProviders(providers []*auth.Provider, emailLogin bool) {
+	h.loginProviders = providers
+	h.emailLogin = emailLogin
+}
+
+// GetClientBootstrap returns everything the web app needs on load in one
+// call. It works signed out, leaving the user's parts unset.
+func (h *StockCheckerHandler) GetClientBootstrap(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetClientBootstrapRequest],
+) (*connect.Response[stockcheckerv1.GetClientBootstrapResponse], error) {
+	resp := &stockcheckerv1.GetClientBootstrapResponse{
+		Features: &stockcheckerv1.ClientFeatures{
+			Watchlists:   h.db != nil,
+			StockWatcher: h.watcher != nil,
+			TcgSets:      h.tcgSets != nil,
+			Msrps:        h.msrps != nil,
+		},
+		Status: &stockcheckerv1.ServerStatus{
+			ReadOnly:      h.maintenance.Enabled(),
+			ProductDomain: h.domain.Name,
+		},
+		Announcement: h.announcement,
+		EmailLogin:   h.emailLogin,
+	}
+	for _, p := range h.loginProviders {
+		resp.LoginProviders = append(resp.LoginProviders, &stockcheckerv1.LoginProvider{
+			Id:   p.ID,
+			Name: p.Name,
+		})
+	}
+
+	user := auth.UserFromContext(ctx)
+	if user == nil || h.db == nil {
+		return connect.NewResponse(resp), nil
+	}
+	resp.User = userToProto(user)
+
+	channels, err := h.db.GetUserNotificationChannels(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	for _, c := range channels {
+		resp.Channels = append(resp.Channels, &stockcheckerv1.ChannelState{
+			ChannelType: c.ChannelType,
+			Enabled:     c.Enabled,
+		})
+	}
+
+	counts, err := h.db.GetWatchlistCounts(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	resp.Watchlist = &stockcheckerv1.WatchlistCounts{
+		Stores:          int32(counts.Stores),
+		Products:        int32(counts.Products),
+		InStockProducts: int32(counts.InStockProducts),
+		ProductWatches:  int32(counts.ProductWatches),
+	}
+
+	return connect.NewResponse(resp), nil
+}
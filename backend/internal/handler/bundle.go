@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"google.golang.org/protobuf/proto"
+)
+
+// bundleVersion hashes the content of a bundle (everything but the version
+// fields and generation time), so clients can skip downloading an unchanged one
+func bundleVersion(bundle *stockcheckerv1.GetOfflineBundleResponse) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(&stockcheckerv1.GetOfflineBundleResponse{
+		Stores:       bundle.Stores,
+		Products:     bundle.Products,
+		Availability: bundle.Availability,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:12]), nil
+}
+
+// GetOfflineBundle returns the user's saved stores and products with the
+// latest known stock, for the app to cache and show while offline
+func (h *StockCheckerHandler) GetOfflineBundle(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetOfflineBundleRequest],
+) (*connect.Response[stockcheckerv1.GetOfflineBundleResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stores, err := h.db.GetUserStores(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	products, err := h.db.GetUserProducts(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	availability, err := h.db.GetUserAvailability(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	bundle := &stockcheckerv1.GetOfflineBundleResponse{
+		Stores:       make([]*stockcheckerv1.Store, 0, len(stores)),
+		Products:     make([]*stockcheckerv1.Product, 0, len(products)),
+		Availability: make([]*stockcheckerv1.CurrentAvailability, 0, len(availability)),
+	}
+	for _, s := range stores {
+		bundle.Stores = append(bundle.Stores, savedStore(s))
+	}
+	for _, p := range products {
+		bundle.Products = append(bundle.Products, savedProduct(p))
+	}
+	for _, a := range availability {
+		bundle.Availability = append(bundle.Availability, availabilityToProto(a))
+	}
+
+	version, err := bundleVersion(bundle)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if version == req.Msg.Version {
+		return connect.NewResponse(&stockcheckerv1.GetOfflineBundleResponse{
+			NotModified: true,
+			Version:     version,
+		}), nil
+	}
+
+	bundle.Version = version
+	bundle.GeneratedAt = timestamp(time.Now())
+	return connect.NewResponse(bundle), nil
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// channelToProto converts a notification channel to its protobuf message, leaving out secrets
+func channelToProto(c database.NotificationChannel) (*stockcheckerv1.NotificationChannel, error) {
+	config, err := notify.RedactConfig(c.ChannelType, c.Config)
+	if err != nil {
+		return nil, err
+	}
+	return &stockcheckerv1.NotificationChannel{
+		ChannelType: c.ChannelType,
+		Config:      string(config),
+		Enabled:     c.Enabled,
+		Rollup:      c.Rollup,
+		CreatedAt:   timestamp(c.CreatedAt),
+		UpdatedAt:   timestamp(c.UpdatedAt),
+	}, nil
+}
+
+// findChannel returns the user's channel of the given type, or nil if it isn't configured
+func (h *StockCheckerHandler) findChannel(ctx context.Context, userID int, channelType string) (*database.NotificationChannel, error) {
+	channels, err := h.db.GetUserNotificationChannels(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range channels {
+		if channels[i].ChannelType == channelType {
+			return &channels[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// GetNotificationChannels returns the user's notification channels
+func (h *StockCheckerHandler) GetNotificationChannels(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetNotificationChannelsRequest],
+) (*connect.Response[stockcheckerv1.GetNotificationChannelsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := h.db.GetUserNotificationChannels(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbChannels := make([]*stockcheckerv1.NotificationChannel, 0, len(channels))
+	for _, c := range channels {
+		pb, err := channelToProto(c)
+		if err != nil {
+			log.Printf("Error reading %s channel config for user %d: %v", c.ChannelType, user.ID, err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		pbChannels = append(pbChannels, pb)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetNotificationChannelsResponse{
+		Channels: pbChannels,
+	}), nil
+}
+
+// SetNotificationChannel validates and saves a notification channel
+func (h *StockCheckerHandler) SetNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SetNotificationChannelRequest],
+) (*connect.Response[stockcheckerv1.SetNotificationChannelResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := req.Msg.Channel
+	if c == nil || c.ChannelType == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.channel_required")
+	}
+
+	config := json.RawMessage(c.Config)
+	if c.Config == "" {
+		config = json.RawMessage("{}")
+	}
+
+	rollup := c.Rollup
+	if rollup != "" && !notify.ValidRollup(rollup) {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_rollup", rollup)
+	}
+
+	existing, err := h.findChannel(ctx, user.ID, c.ChannelType)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if existing != nil {
+		config, err = notify.KeepSecrets(c.ChannelType, config, existing.Config)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if rollup == "" {
+			rollup = existing.Rollup
+		}
+	}
+	if rollup == "" {
+		rollup = notify.RollupSummary
+	}
+
+	// Building the notifier validates the config
+	if _, err := notify.New(c.ChannelType, config); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.db.UpsertNotificationChannel(ctx, user.ID, c.ChannelType, config, c.Enabled, rollup); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	saved, err := h.findChannel(ctx, user.ID, c.ChannelType)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	pb, err := channelToProto(*saved)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.SetNotificationChannelResponse{
+		Channel: pb,
+	}), nil
+}
+
+// DeleteNotificationChannel removes a notification channel
+func (h *StockCheckerHandler) DeleteNotificationChannel(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.DeleteNotificationChannelRequest],
+) (*connect.Response[stockcheckerv1.DeleteNotificationChannelResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.db.DeleteNotificationChannel(ctx, user.ID, req.Msg.ChannelType); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.DeleteNotificationChannelResponse{}), nil
+}
@@ -0,0 +1,355 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2/stockcheckerv2connect"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/handler"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+var update = flag.Bool("update", false, "rewrite contract files with the current wire shapes")
+
+// newContractServer serves both API versions in-memory against mock retailers,
+// without a database or auth, the way the server runs in local development
+func newContractServer(t *testing.T, opts ...connect.HandlerOption) *httptest.Server {
+	t.Helper()
+
+	bbClient := bestbuy.NewMockClientWithLatency(0)
+	retailers := retailer.NewRegistry()
+	retailers.Register(retailer.BestBuy, retailer.NewBestBuy(bbClient), true)
+	for _, id := range []retailer.ID{retailer.Walmart, retailer.Target, retailer.GameStop, retailer.Costco} {
+		client, err := retailer.NewMock(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		retailers.Register(id, client, true)
+	}
+
+	v1 := handler.NewStockCheckerHandler(bbClient, nil, nil, nil)
+
+	mux := http.NewServeMux()
+	path, h := stockcheckerv1connect.NewStockCheckerServiceHandler(v1, opts...)
+	mux.Handle(path, i18n.Middleware(h))
+	pathV2, hV2 := stockcheckerv2connect.NewStockCheckerServiceHandler(handler.NewStockCheckerV2Handler(v1, retailers), opts...)
+	mux.Handle(pathV2, i18n.Middleware(hV2))
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// call sends a Connect JSON request and returns the status and decoded body
+func call(t *testing.T, srv *httptest.Server, procedure, body string) (int, any) {
+	t.Helper()
+
+	resp, err := http.Post(srv.URL+procedure, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("%s: response isn't JSON: %v\n%s", procedure, err, data)
+	}
+	return resp.StatusCode, decoded
+}
+
+// shape replaces every JSON value with its type name. Array elements are
+// merged into one shape so the contract doesn't depend on which fields the
+// first element happens to set (protojson omits zero values).
+func shape(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, val := range v {
+			m[k] = shape(val)
+		}
+		return m
+	case []any:
+		var merged any
+		for _, el := range v {
+			merged = mergeShapes(merged, shape(el))
+		}
+		if merged == nil {
+			return []any{}
+		}
+		return []any{merged}
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "bool"
+	default:
+		return "null"
+	}
+}
+
+// mergeShapes combines two shapes, taking the union of object keys
+func mergeShapes(a, b any) any {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if !aok || !bok {
+		if b == nil {
+			return a
+		}
+		return b
+	}
+
+	merged := make(map[string]any, len(am)+len(bm))
+	for k, v := range am {
+		merged[k] = v
+	}
+	for k, v := range bm {
+		merged[k] = mergeShapes(merged[k], v)
+	}
+	return merged
+}
+
+// checkContract compares the shape of body with testdata/contract/name.json
+func checkContract(t *testing.T, name string, body any) {
+	t.Helper()
+
+	data, err := json.MarshalIndent(shape(body), "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = append(data, '\n')
+
+	path := filepath.Join("testdata", "contract", name+".json")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("wire shape of %s changed (run with -update if this is intended)\ngot:\n%s\nwant:\n%s", name, data, want)
+	}
+}
+
+func TestPublicRPCContracts(t *testing.T) {
+	srv := newContractServer(t)
+
+	tests := []struct {
+		name      string
+		procedure string
+		body      string
+	}{
+		{"v1/SearchStores", stockcheckerv1connect.StockCheckerServiceSearchStoresProcedure, `{"postalCode":"94103","radiusMiles":25}`},
+		{"v1/SearchProducts", stockcheckerv1connect.StockCheckerServiceSearchProductsProcedure, `{"query":"pokemon"}`},
+		{"v1/CheckStock", stockcheckerv1connect.StockCheckerServiceCheckStockProcedure, `{"postalCode":"94103","skus":["6579543","6579544","6543210"],"storeIds":["1118"]}`},
+		{"v1/BrowsePokemonProducts", stockcheckerv1connect.StockCheckerServiceBrowsePokemonProductsProcedure, `{}`},
+		{"v1/GetProductDetails", stockcheckerv1connect.StockCheckerServiceGetProductDetailsProcedure, `{"sku":"6579543"}`},
+		{"v1/GetProductDomain", stockcheckerv1connect.StockCheckerServiceGetProductDomainProcedure, `{}`},
+		{"v1/GetClientBootstrap", stockcheckerv1connect.StockCheckerServiceGetClientBootstrapProcedure, `{}`},
+		{"v1/GetApiInfo", stockcheckerv1connect.StockCheckerServiceGetApiInfoProcedure, `{}`},
+		{"v2/ListRetailers", stockcheckerv2connect.StockCheckerServiceListRetailersProcedure, `{}`},
+		{"v2/SearchStores", stockcheckerv2connect.StockCheckerServiceSearchStoresProcedure, `{"retailer":"RETAILER_BEST_BUY","postalCode":"94103","pageSize":2}`},
+		{"v2/SearchStores.walmart", stockcheckerv2connect.StockCheckerServiceSearchStoresProcedure, `{"retailer":"RETAILER_WALMART","postalCode":"94103"}`},
+		{"v2/SearchProducts", stockcheckerv2connect.StockCheckerServiceSearchProductsProcedure, `{"query":"pokemon","pageSize":3}`},
+		{"v2/SearchProducts.target", stockcheckerv2connect.StockCheckerServiceSearchProductsProcedure, `{"retailer":"RETAILER_TARGET","query":"pokemon"}`},
+		{"v2/CheckStock", stockcheckerv2connect.StockCheckerServiceCheckStockProcedure, `{"postalCode":"94103","skus":["6579543","6579544"],"storeIds":["1118"]}`},
+		{"v2/CheckStock.gamestop", stockcheckerv2connect.StockCheckerServiceCheckStockProcedure, `{"retailer":"RETAILER_GAMESTOP","postalCode":"94103","skus":["20017594"]}`},
+		{"v2/CheckStock.costco", stockcheckerv2connect.StockCheckerServiceCheckStockProcedure, `{"retailer":"RETAILER_COSTCO","postalCode":"94103","skus":["1871342","1755912"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := call(t, srv, tt.procedure, tt.body)
+			if status != http.StatusOK {
+				t.Fatalf("status %d: %v", status, body)
+			}
+			checkContract(t, tt.name, body)
+		})
+	}
+}
+
+func TestAuthenticatedRPCContracts(t *testing.T) {
+	srv := newContractServer(t)
+
+	// Without a session every user RPC must fail the same way, before touching the database
+	procedures := []string{
+		stockcheckerv1connect.StockCheckerServiceGetCurrentUserProcedure,
+		stockcheckerv1connect.StockCheckerServiceSetMyLocaleProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyStoresProcedure,
+		stockcheckerv1connect.StockCheckerServiceAddMyStoreProcedure,
+		stockcheckerv1connect.StockCheckerServiceRemoveMyStoreProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyProductsProcedure,
+		stockcheckerv1connect.StockCheckerServiceAddMyProductProcedure,
+		stockcheckerv1connect.StockCheckerServiceUpdateMyProductProcedure,
+		stockcheckerv1connect.StockCheckerServiceRemoveMyProductProcedure,
+		stockcheckerv1connect.StockCheckerServiceImportMyProductsProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetNotificationPreferencesProcedure,
+		stockcheckerv1connect.StockCheckerServiceUpdateNotificationPreferencesProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetAlertRulesProcedure,
+		stockcheckerv1connect.StockCheckerServiceUpdateAlertRuleProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetNotificationTemplatesProcedure,
+		stockcheckerv1connect.StockCheckerServiceSetNotificationTemplateProcedure,
+		stockcheckerv1connect.StockCheckerServiceDeleteNotificationTemplateProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetNotificationChannelsProcedure,
+		stockcheckerv1connect.StockCheckerServiceSetNotificationChannelProcedure,
+		stockcheckerv1connect.StockCheckerServiceDeleteNotificationChannelProcedure,
+		stockcheckerv1connect.StockCheckerServiceSendTestNotificationProcedure,
+		stockcheckerv1connect.StockCheckerServiceSimulateWatcherCycleProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyDashboardProcedure,
+		stockcheckerv1connect.StockCheckerServiceCheckStoreNowProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetStockHistoryProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetOfflineBundleProcedure,
+		stockcheckerv1connect.StockCheckerServiceSyncChangesProcedure,
+		stockcheckerv1connect.StockCheckerServiceListWatchlistChangesProcedure,
+		stockcheckerv1connect.StockCheckerServiceUndoLastChangeProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMySetWatchesProcedure,
+		stockcheckerv1connect.StockCheckerServiceWatchSetProcedure,
+		stockcheckerv1connect.StockCheckerServiceUnwatchSetProcedure,
+		stockcheckerv1connect.StockCheckerServiceListMsrpsProcedure,
+		stockcheckerv1connect.StockCheckerServiceSetMsrpProcedure,
+		stockcheckerv1connect.StockCheckerServiceMarkPurchasedProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyAcquisitionsProcedure,
+		stockcheckerv1connect.StockCheckerServiceDeleteAcquisitionProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetAcquisitionSummaryProcedure,
+		stockcheckerv1connect.StockCheckerServiceConfirmStockProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetStoreReliabilityProcedure,
+		stockcheckerv1connect.StockCheckerServiceReportSightingProcedure,
+		stockcheckerv1connect.StockCheckerServiceListSightingsProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetSightingPhotoProcedure,
+		stockcheckerv1connect.StockCheckerServiceModerateSightingProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyProductWatchesProcedure,
+		stockcheckerv1connect.StockCheckerServiceWatchProductsProcedure,
+		stockcheckerv1connect.StockCheckerServiceUnwatchProductsProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminAddAllowedEmailProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminRemoveAllowedEmailProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminListAllowedEmailsProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminAddAllowedDomainProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminRemoveAllowedDomainProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminListAllowedDomainsProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminCreateInviteProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminListInvitesProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminRevokeInviteProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminListUsersProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminSetUserRoleProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminListCredentialsProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminSetCredentialProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminClearCredentialProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminGetApiCallStatsProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyApiKeysProcedure,
+		stockcheckerv1connect.StockCheckerServiceCreateApiKeyProcedure,
+		stockcheckerv1connect.StockCheckerServiceRevokeApiKeyProcedure,
+		stockcheckerv1connect.StockCheckerServiceRemoveMyProductsProcedure,
+		stockcheckerv1connect.StockCheckerServiceClearWatchlistProcedure,
+		stockcheckerv1connect.StockCheckerServiceListSessionsProcedure,
+		stockcheckerv1connect.StockCheckerServiceRevokeSessionProcedure,
+		stockcheckerv1connect.StockCheckerServiceExportMyDataProcedure,
+		stockcheckerv1connect.StockCheckerServiceDeleteMyAccountProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyLocationsProcedure,
+		stockcheckerv1connect.StockCheckerServiceSetMyLocationProcedure,
+		stockcheckerv1connect.StockCheckerServiceDeleteMyLocationProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyUsageProcedure,
+		stockcheckerv1connect.StockCheckerServiceUpcomingDropsProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminSaveDropProcedure,
+		stockcheckerv1connect.StockCheckerServiceAdminDeleteDropProcedure,
+		stockcheckerv1connect.StockCheckerServiceGetMyInboundEmailProcedure,
+		stockcheckerv1connect.StockCheckerServiceResetMyInboundEmailProcedure,
+		stockcheckerv1connect.StockCheckerServiceListMyRemindersProcedure,
+		stockcheckerv1connect.StockCheckerServiceDeleteMyReminderProcedure,
+		stockcheckerv2connect.StockCheckerServiceListMyStoresProcedure,
+		stockcheckerv2connect.StockCheckerServiceListMyProductsProcedure,
+	}
+
+	for _, procedure := range procedures {
+		t.Run(strings.TrimPrefix(procedure, "/stockchecker."), func(t *testing.T) {
+			status, body := call(t, srv, procedure, `{}`)
+			if status != http.StatusUnauthorized {
+				t.Errorf("status %d, want 401", status)
+			}
+			if code := body.(map[string]any)["code"]; code != "unauthenticated" {
+				t.Errorf("code %v, want unauthenticated", code)
+			}
+			checkContract(t, "unauthenticated", body)
+		})
+	}
+}
+
+func TestErrorContracts(t *testing.T) {
+	srv := newContractServer(t)
+
+	tests := []struct {
+		name      string
+		procedure string
+		body      string
+		code      string
+	}{
+		{"unsupported retailer", stockcheckerv2connect.StockCheckerServiceSearchStoresProcedure, `{"retailer":99,"postalCode":"94103"}`, "invalid_argument"},
+		{"invalid page token", stockcheckerv2connect.StockCheckerServiceSearchProductsProcedure, `{"query":"pokemon","pageToken":"not-a-token"}`, "invalid_argument"},
+		{"invalid postal code", stockcheckerv1connect.StockCheckerServiceSearchStoresProcedure, `{"postalCode":"not a zip"}`, "invalid_argument"},
+		{"invalid postal code v2", stockcheckerv2connect.StockCheckerServiceCheckStockProcedure, `{"retailer":"RETAILER_TARGET","postalCode":"9410","skus":["93954435"]}`, "invalid_argument"},
+		{"save unsupported retailer", stockcheckerv2connect.StockCheckerServiceAddMyStoreProcedure, `{"store":{"retailer":99,"storeId":"2280"}}`, "invalid_argument"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, body := call(t, srv, tt.procedure, tt.body)
+			if code := body.(map[string]any)["code"]; code != tt.code {
+				t.Errorf("code %v, want %s", code, tt.code)
+			}
+		})
+	}
+}
+
+func TestMaintenanceContracts(t *testing.T) {
+	maintenance := handler.NewMaintenance(true, 90*time.Second)
+	srv := newContractServer(t, connect.WithInterceptors(maintenance.Interceptor()))
+
+	// Reads are served
+	if status, body := call(t, srv, stockcheckerv2connect.StockCheckerServiceCheckStockProcedure, `{"retailer":"RETAILER_BEST_BUY","postalCode":"94103","skus":["6579543"]}`); status != http.StatusOK {
+		t.Errorf("CheckStock: status %d, want 200: %v", status, body)
+	}
+
+	// Writes fail with a retryable, typed error
+	status, body := call(t, srv, stockcheckerv1connect.StockCheckerServiceAddMyStoreProcedure, `{"store":{"storeId":"2280"}}`)
+	if status != http.StatusServiceUnavailable {
+		t.Errorf("AddMyStore: status %d, want 503", status)
+	}
+	e := body.(map[string]any)
+	if e["code"] != "unavailable" {
+		t.Errorf("code %v, want unavailable", e["code"])
+	}
+	details, _ := e["details"].([]any)
+	if len(details) != 1 || details[0].(map[string]any)["type"] != "stockchecker.v1.MaintenanceError" {
+		t.Errorf("details %v, want one stockchecker.v1.MaintenanceError", details)
+	}
+
+	maintenance.SetEnabled(false)
+	if _, body := call(t, srv, stockcheckerv1connect.StockCheckerServiceAddMyStoreProcedure, `{"store":{"storeId":"2280"}}`); body.(map[string]any)["code"] == "unavailable" {
+		t.Error("AddMyStore still unavailable after maintenance ended")
+	}
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/credentials"
+)
+
+// SetCredentials lets admins rotate retailer keys and notifier settings at runtime
+func (h *StockCheckerHandler) SetCredentials(store *credentials.Store) {
+	h.creds = store
+}
+
+// credentialToProto converts a credential's description to its protobuf message
+func credentialToProto(c credentials.Credential) *stockcheckerv1.Credential {
+	return &stockcheckerv1.Credential{
+		Name:       string(c.Name),
+		Set:        c.Set,
+		Overridden: c.Overridden,
+		Hint:       c.Hint,
+		UpdatedBy:  c.UpdatedByEmail,
+		UpdatedAt:  timestamp(c.UpdatedAt),
+	}
+}
+
+// credentialName checks credentials can be rotated and parses the name of one
+func (h *StockCheckerHandler) credentialName(ctx context.Context, name string) (credentials.Name, error) {
+	if h.creds == nil {
+		return "", localizedError(ctx, connect.CodeFailedPrecondition, "error.credentials_disabled")
+	}
+	parsed, err := credentials.ParseName(name)
+	if err != nil {
+		return "", localizedError(ctx, connect.CodeInvalidArgument, "error.unknown_credential", name)
+	}
+	return parsed, nil
+}
+
+// AdminListCredentials lists the retailer keys and notifier settings admins
+// can rotate, without their values (admin only)
+func (h *StockCheckerHandler) AdminListCredentials(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminListCredentialsRequest],
+) (*connect.Response[stockcheckerv1.AdminListCredentialsResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+	if h.creds == nil {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.credentials_disabled")
+	}
+
+	creds, err := h.creds.List(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbCreds := make([]*stockcheckerv1.Credential, 0, len(creds))
+	for _, c := range creds {
+		pbCreds = append(pbCreds, credentialToProto(c))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminListCredentialsResponse{
+		Credentials: pbCreds,
+	}), nil
+}
+
+// AdminSetCredential rotates a retailer key or notifier setting. The new value
+// is used right away and reaches other processes within a minute (admin only).
+func (h *StockCheckerHandler) AdminSetCredential(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminSetCredentialRequest],
+) (*connect.Response[stockcheckerv1.AdminSetCredentialResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := h.credentialName(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.creds.Set(ctx, name, req.Msg.Value, user.ID); err != nil {
+		var invalid *credentials.InvalidValueError
+		if errors.As(err, &invalid) {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_credential", name, invalid.Err)
+		}
+		return nil, h.dbError(err)
+	}
+
+	cred, err := h.creds.Describe(ctx, name)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	return connect.NewResponse(&stockcheckerv1.AdminSetCredentialResponse{
+		Credential: credentialToProto(cred),
+	}), nil
+}
+
+// AdminClearCredential goes back to a credential's value from the environment (admin only)
+func (h *StockCheckerHandler) AdminClearCredential(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminClearCredentialRequest],
+) (*connect.Response[stockcheckerv1.AdminClearCredentialResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	name, err := h.credentialName(ctx, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	cleared, err := h.creds.Clear(ctx, name)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !cleared {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.credential_not_overridden", name)
+	}
+
+	cred, err := h.creds.Describe(ctx, name)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	return connect.NewResponse(&stockcheckerv1.AdminClearCredentialResponse{
+		Credential: credentialToProto(cred),
+	}), nil
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// Dashboard history limits in days
+const (
+	defaultDashboardDays = 7
+	maxDashboardDays     = 90
+)
+
+// availabilityToProto converts projected availability to its protobuf message
+func availabilityToProto(a database.Availability) *stockcheckerv1.CurrentAvailability {
+	return &stockcheckerv1.CurrentAvailability{
+		Sku:         a.SKU,
+		ProductName: a.ProductName,
+		StoreId:     a.StoreID,
+		StoreName:   a.StoreName,
+		InStock:     a.InStock,
+		LowStock:    a.LowStock,
+		Since:       a.Since.UTC().Format(time.RFC3339),
+	}
+}
+
+// GetMyDashboard returns current and recent availability of the user's watched products
+func (h *StockCheckerHandler) GetMyDashboard(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyDashboardRequest],
+) (*connect.Response[stockcheckerv1.GetMyDashboardResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(req.Msg.Days)
+	if days <= 0 {
+		days = defaultDashboardDays
+	}
+	if days > maxDashboardDays {
+		days = maxDashboardDays
+	}
+
+	availability, err := h.db.GetUserAvailability(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, 1-days)
+	daily, err := h.db.GetUserDailyAvailability(ctx, user.ID, since)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	resp := &stockcheckerv1.GetMyDashboardResponse{
+		Availability: make([]*stockcheckerv1.CurrentAvailability, 0, len(availability)),
+		Daily:        make([]*stockcheckerv1.DailyAvailability, 0, len(daily)),
+	}
+	for _, a := range availability {
+		resp.Availability = append(resp.Availability, availabilityToProto(a))
+	}
+	for _, d := range daily {
+		resp.Daily = append(resp.Daily, &stockcheckerv1.DailyAvailability{
+			Sku:            d.SKU,
+			StoreId:        d.StoreID,
+			Day:            d.Day.Format(time.DateOnly),
+			InStockMinutes: int32(d.InStockFor.Minutes()),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// maxHistoryChecks caps the checks returned by GetStockHistory
+const maxHistoryChecks = 2000
+
+// GetStockHistory returns the availability checks of a product at a store and
+// when it was last seen in stock
+func (h *StockCheckerHandler) GetStockHistory(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetStockHistoryRequest],
+) (*connect.Response[stockcheckerv1.GetStockHistoryResponse], error) {
+	if _, err := getUserFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if req.Msg.Sku == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+	if req.Msg.StoreId == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.store_required")
+	}
+
+	days := int(req.Msg.Days)
+	if days <= 0 {
+		days = defaultDashboardDays
+	}
+	if days > maxDashboardDays {
+		days = maxDashboardDays
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, 1-days).Truncate(24 * time.Hour)
+	entries, err := h.db.GetStockHistory(ctx, req.Msg.Sku, req.Msg.StoreId, since, maxHistoryChecks)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	lastInStock, err := h.db.GetLastInStock(ctx, req.Msg.Sku, req.Msg.StoreId)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	resp := &stockcheckerv1.GetStockHistoryResponse{
+		Checks: make([]*stockcheckerv1.StockCheck, 0, len(entries)),
+	}
+	for _, e := range entries {
+		resp.Checks = append(resp.Checks, &stockcheckerv1.StockCheck{
+			InStock:   e.InStock,
+			LowStock:  e.LowStock,
+			CheckedAt: timestamp(e.CheckedAt),
+		})
+	}
+	if lastInStock != nil {
+		resp.LastInStockAt = timestamp(*lastInStock)
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// SetProductDomain sets the kind of product the deployment tracks (Pokemon TCG by default)
+func (h *StockCheckerHandler) SetProductDomain(d bestbuy.Domain) {
+	h.domain = d
+}
+
+// sampleAlertData is the sample alert with the domain's sample product, for template previews
+func sampleAlertData(d bestbuy.Domain) notify.AlertData {
+	data := notify.SampleAlertData
+	sku := d.Sample.SKUString()
+	data.Product, data.SKU, data.Price, data.Image = d.Sample.Name, sku, d.Sample.SalePrice, d.Sample.ThumbnailImage
+	data.Links = notify.AlertLinks{
+		Product:   fmt.Sprintf("https://www.bestbuy.com/site/%s.p", sku),
+		AddToCart: fmt.Sprintf("https://api.bestbuy.com/click/-/%s/cart", sku),
+	}
+	return data
+}
+
+// GetProductDomain returns the kind of product the deployment tracks, so
+// clients can default their searches to it
+func (h *StockCheckerHandler) GetProductDomain(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetProductDomainRequest],
+) (*connect.Response[stockcheckerv1.GetProductDomainResponse], error) {
+	presets := make([]*stockcheckerv1.ProductPreset, 0, len(h.domain.Presets))
+	for _, p := range h.domain.Presets {
+		presets = append(presets, &stockcheckerv1.ProductPreset{Sku: p.SKU, Name: p.Name, MsrpCents: int64(p.MSRP)})
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetProductDomainResponse{
+		Id:             h.domain.ID,
+		Name:           h.domain.Name,
+		SearchCategory: h.domain.Subclass,
+		CategoryId:     h.domain.Category,
+		Presets:        presets,
+	}), nil
+}
+
+// applyPresetRule caps alerts for a newly saved preset product at its MSRP,
+// unless the user already has a rule for it
+func (h *StockCheckerHandler) applyPresetRule(ctx context.Context, userID int, sku string) error {
+	preset, ok := h.domain.Preset(sku)
+	if !ok || preset.MSRP <= 0 {
+		return nil
+	}
+	rule, err := h.db.GetAlertRule(ctx, userID, retailer.BestBuy, sku)
+	if err != nil || !rule.UpdatedAt.IsZero() {
+		return err
+	}
+	rule.MaxPriceCents = preset.MSRP
+	return h.db.SaveAlertRule(ctx, rule)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/input"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// Drop limits in characters
+const (
+	maxDropTitleLen       = 200
+	maxDropDetailsLen     = 1000
+	maxDropProductNameLen = 255
+)
+
+// dropToProto converts a drop to its protobuf message
+func dropToProto(d *database.Drop, watching bool) *stockcheckerv1.Drop {
+	pb := &stockcheckerv1.Drop{
+		Id:          int32(d.ID),
+		Sku:         d.SKU,
+		ProductName: d.ProductName,
+		Title:       d.Title,
+		Details:     d.Details,
+		Url:         d.URL,
+		OpensAt:     timestamp(d.OpensAt),
+		Watching:    watching,
+		CreatedAt:   timestamp(d.CreatedAt),
+	}
+	if d.ClosesAt != nil {
+		pb.ClosesAt = timestamp(*d.ClosesAt)
+	}
+	return pb
+}
+
+// UpcomingDrops lists invitation-only drops whose windows haven't ended,
+// soonest first
+func (h *StockCheckerHandler) UpcomingDrops(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.UpcomingDropsRequest],
+) (*connect.Response[stockcheckerv1.UpcomingDropsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	drops, err := h.db.GetUpcomingDrops(ctx, time.Now())
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	products, err := h.db.GetUserProducts(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	watched := make(map[string]bool, len(products))
+	for _, p := range products {
+		watched[p.SKU] = true
+	}
+
+	resp := &stockcheckerv1.UpcomingDropsResponse{Drops: []*stockcheckerv1.Drop{}}
+	for i := range drops {
+		watching := watched[drops[i].SKU]
+		if req.Msg.WatchedOnly && !watching {
+			continue
+		}
+		resp.Drops = append(resp.Drops, dropToProto(&drops[i], watching))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// AdminSaveDrop creates a drop from a retailer's announcement, or updates
+// one (admin only)
+func (h *StockCheckerHandler) AdminSaveDrop(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminSaveDropRequest],
+) (*connect.Response[stockcheckerv1.AdminSaveDropResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	drop, err := h.parseDrop(ctx, req.Msg.Drop)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved *database.Drop
+	if drop.ID == 0 {
+		saved, err = h.db.CreateDrop(ctx, drop, user.ID)
+	} else {
+		saved, err = h.db.UpdateDrop(ctx, drop)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.drop_not_found", drop.ID)
+	}
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminSaveDropResponse{Drop: dropToProto(saved, false)}), nil
+}
+
+// parseDrop validates a drop sent by an admin, looking up the product's
+// name if it was left out
+func (h *StockCheckerHandler) parseDrop(ctx context.Context, pb *stockcheckerv1.Drop) (database.Drop, error) {
+	if pb == nil {
+		pb = &stockcheckerv1.Drop{}
+	}
+
+	ref, err := input.ParseProductRef(pb.Sku)
+	if err != nil || ref.Kind != input.RefSKU {
+		return database.Drop{}, localizedError(ctx, connect.CodeInvalidArgument, "error.drop_sku_invalid", pb.Sku)
+	}
+	drop := database.Drop{
+		ID:          int(pb.Id),
+		SKU:         ref.Value,
+		ProductName: strings.TrimSpace(pb.ProductName),
+		Title:       strings.TrimSpace(pb.Title),
+		Details:     strings.TrimSpace(pb.Details),
+		URL:         strings.TrimSpace(pb.Url),
+	}
+
+	if drop.Title == "" || utf8.RuneCountInString(drop.Title) > maxDropTitleLen {
+		return database.Drop{}, localizedError(ctx, connect.CodeInvalidArgument, "error.drop_title_invalid", maxDropTitleLen)
+	}
+	if utf8.RuneCountInString(drop.Details) > maxDropDetailsLen {
+		return database.Drop{}, localizedError(ctx, connect.CodeInvalidArgument, "error.drop_details_too_long", maxDropDetailsLen)
+	}
+	if drop.URL != "" {
+		if u, err := url.Parse(drop.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+			return database.Drop{}, localizedError(ctx, connect.CodeInvalidArgument, "error.drop_url_invalid")
+		}
+	}
+
+	if !pb.OpensAt.IsValid() || (pb.ClosesAt != nil && !pb.ClosesAt.IsValid()) {
+		return database.Drop{}, localizedError(ctx, connect.CodeInvalidArgument, "error.drop_window_invalid")
+	}
+	drop.OpensAt = pb.OpensAt.AsTime()
+	if pb.ClosesAt != nil {
+		closes := pb.ClosesAt.AsTime()
+		if !closes.After(drop.OpensAt) {
+			return database.Drop{}, localizedError(ctx, connect.CodeInvalidArgument, "error.drop_window_invalid")
+		}
+		drop.ClosesAt = &closes
+	}
+
+	// Restricted products can usually still be looked up, just not checked
+	if drop.ProductName == "" {
+		if product, err := h.bbClient.GetProductBySKU(ctx, drop.SKU); err != nil {
+			log.Printf("Error looking up drop product %s: %v", drop.SKU, err)
+		} else {
+			drop.ProductName = product.Name
+		}
+	}
+	if utf8.RuneCountInString(drop.ProductName) > maxDropProductNameLen {
+		drop.ProductName = string([]rune(drop.ProductName)[:maxDropProductNameLen])
+	}
+	return drop, nil
+}
+
+// AdminDeleteDrop deletes a drop (admin only)
+func (h *StockCheckerHandler) AdminDeleteDrop(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminDeleteDropRequest],
+) (*connect.Response[stockcheckerv1.AdminDeleteDropResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	found, err := h.db.DeleteDrop(ctx, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.drop_not_found", req.Msg.Id)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminDeleteDropResponse{}), nil
+}
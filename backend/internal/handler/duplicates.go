@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+)
+
+// possibleDuplicates returns the saved products that may be the same item as
+// product: the same UPC or model number under another SKU, or the same set
+func possibleDuplicates(product database.Product, saved []database.Product) []*stockcheckerv1.PossibleDuplicate {
+	set := tcg.Parse(product.Name).Set
+
+	var duplicates []*stockcheckerv1.PossibleDuplicate
+	for _, p := range saved {
+		if p.SKU == product.SKU {
+			continue
+		}
+
+		var reason stockcheckerv1.DuplicateReason
+		switch {
+		case product.UPC != "" && p.UPC == product.UPC:
+			reason = stockcheckerv1.DuplicateReason_DUPLICATE_REASON_SAME_UPC
+		case product.ModelNumber != "" && strings.EqualFold(p.ModelNumber, product.ModelNumber):
+			reason = stockcheckerv1.DuplicateReason_DUPLICATE_REASON_SAME_MODEL_NUMBER
+		case tcg.SameSet(tcg.Parse(p.Name).Set, set):
+			reason = stockcheckerv1.DuplicateReason_DUPLICATE_REASON_SAME_SET
+		default:
+			continue
+		}
+		duplicates = append(duplicates, &stockcheckerv1.PossibleDuplicate{Sku: p.SKU, Name: p.Name, Reason: reason})
+	}
+	return duplicates
+}
+
+// lookUpIdentifiers fills in a Best Buy product's UPC and model number. Failures
+// are only logged; the product is just compared by name.
+func (h *StockCheckerHandler) lookUpIdentifiers(ctx context.Context, product *database.Product) {
+	details, err := h.bbClient.GetProductBySKU(ctx, product.SKU)
+	if err != nil {
+		log.Printf("Failed to look up identifiers for SKU %s: %v", product.SKU, err)
+		return
+	}
+	product.UPC = details.UPC
+	product.ModelNumber = details.ModelNumber
+}
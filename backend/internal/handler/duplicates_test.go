@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"testing"
+
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+func TestPossibleDuplicates(t *testing.T) {
+	saved := []database.Product{
+		{SKU: "6606082", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Booster Bundle", UPC: "820650875823"},
+		{SKU: "6569192", Name: "Pokemon TCG: Prismatic Evolutions ETB (Bundle)", UPC: "820650875816"},
+		{SKU: "6579543", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box", UPC: "820650875816"},
+		{SKU: "6547318", Name: "Pokemon Trading Card Game: Paldean Fates Elite Trainer Box", ModelNumber: "PKU-290-85656"},
+	}
+	product := database.Product{
+		SKU:         "6579543",
+		Name:        "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box",
+		UPC:         "820650875816",
+		ModelNumber: "pku-290-85656",
+	}
+
+	got := possibleDuplicates(product, saved)
+	want := map[string]stockcheckerv1.DuplicateReason{
+		"6606082": stockcheckerv1.DuplicateReason_DUPLICATE_REASON_SAME_SET,
+		"6569192": stockcheckerv1.DuplicateReason_DUPLICATE_REASON_SAME_UPC,
+		"6547318": stockcheckerv1.DuplicateReason_DUPLICATE_REASON_SAME_MODEL_NUMBER,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d duplicates, want %d: %v", len(got), len(want), got)
+	}
+	for _, d := range got {
+		if want[d.Sku] != d.Reason {
+			t.Errorf("SKU %s: reason %v, want %v", d.Sku, d.Reason, want[d.Sku])
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+	"slices"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// applyFieldMask copies the fields named in mask from src to dst, which must be
+// the same message type. Only top-level fields listed in mutable may be named;
+// an empty mask copies all of them, so clients that send whole resources keep working.
+func applyFieldMask(ctx context.Context, dst, src proto.Message, mask *fieldmaskpb.FieldMask, mutable ...string) error {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		paths = mutable
+	}
+
+	dstMsg, srcMsg := dst.ProtoReflect(), src.ProtoReflect()
+	fields := dstMsg.Descriptor().Fields()
+	for _, path := range paths {
+		fd := fields.ByName(protoreflect.Name(path))
+		if fd == nil || !slices.Contains(mutable, path) {
+			return localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_field_mask", path)
+		}
+
+		if srcMsg.Has(fd) {
+			dstMsg.Set(fd, srcMsg.Get(fd))
+		} else {
+			dstMsg.Clear(fd)
+		}
+	}
+	return nil
+}
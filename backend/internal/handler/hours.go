@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	stockcheckerv2 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// storeHours collects the opening hours of stores from a store search
+func storeHours(stores []bestbuy.Store) map[string]database.StoreHours {
+	hours := make(map[string]database.StoreHours, len(stores))
+	for _, s := range stores {
+		summary := s.Hours
+		if summary == "" {
+			summary = s.HoursAmPm
+		}
+		hours[s.StoreIDString()] = database.StoreHours{
+			StoreID:   s.StoreIDString(),
+			Hours:     summary,
+			Detailed:  s.DetailedHours,
+			GMTOffset: s.GMTOffset,
+		}
+	}
+	return hours
+}
+
+// saveStoreHours remembers the hours of stores from a store search, so saved
+// stores and alerts can tell whether they're open
+func (h *StockCheckerHandler) saveStoreHours(ctx context.Context, hours map[string]database.StoreHours) {
+	stores := make([]database.StoreHours, 0, len(hours))
+	for _, s := range hours {
+		stores = append(stores, s)
+	}
+	if err := h.db.SaveStoreHours(ctx, stores); err != nil {
+		log.Printf("Error saving store hours: %v", err)
+	}
+}
+
+// savedStoreHours loads the hours of saved stores. Missing hours only leave
+// the stores' open-now fields unset, so errors are logged rather than returned.
+func (h *StockCheckerHandler) savedStoreHours(ctx context.Context, storeIDs []string) map[string]database.StoreHours {
+	hours, err := h.db.GetStoreHours(ctx, storeIDs)
+	if err != nil {
+		log.Printf("Error loading store hours: %v", err)
+	}
+	return hours
+}
+
+// setOpenNow fills in whether a store is open now and its hours today
+func setOpenNow(store *stockcheckerv1.Store, hours database.StoreHours, now time.Time) {
+	status, ok := hours.StatusAt(now)
+	if !ok {
+		return
+	}
+	store.OpenNow = status.Open
+	store.HoursToday = status.Today
+	store.SpecialHours = status.Special
+	store.OpensAt = timestamp(status.Opens)
+}
+
+// setOpenNowV2 fills in whether a store is open now and its hours today
+func setOpenNowV2(store *stockcheckerv2.Store, hours database.StoreHours, now time.Time) {
+	status, ok := hours.StatusAt(now)
+	if !ok {
+		return
+	}
+	store.OpenNow = status.Open
+	store.HoursToday = status.Today
+	store.SpecialHours = status.Special
+	store.OpensAt = timestamp(status.Opens)
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/input"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+)
+
+// lookupRef finds the product a reference points to
+func (h *StockCheckerHandler) lookupRef(ctx context.Context, ref input.Ref) (*bestbuy.Product, error) {
+	if ref.Kind == input.RefSKU {
+		return h.bbClient.GetProductBySKU(ctx, ref.Value)
+	}
+
+	page, err := h.bbClient.SearchProducts(ctx, ref.Value, "", bestbuy.PageRequest{})
+	if err != nil || len(page.Products) == 0 {
+		return nil, err
+	}
+	return &page.Products[0], nil
+}
+
+// ImportMyProducts adds a pasted list of SKUs, UPCs or product URLs to the user's list
+func (h *StockCheckerHandler) ImportMyProducts(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ImportMyProductsRequest],
+) (*connect.Response[stockcheckerv1.ImportMyProductsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, rejected, err := input.ParseList(req.Msg.Text)
+	if err != nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.too_many_items", input.MaxListItems)
+	}
+
+	resp := &stockcheckerv1.ImportMyProductsResponse{
+		Products: []*stockcheckerv1.Product{},
+		Rejected: rejected,
+	}
+	for _, ref := range refs {
+		product, err := h.lookupRef(ctx, ref)
+		if err != nil || product == nil || product.SKU == 0 {
+			log.Printf("Import: no product for %s %s: %v", ref.Kind, ref.Value, err)
+			resp.Rejected = append(resp.Rejected, ref.Value)
+			continue
+		}
+
+		dbProduct := database.Product{
+			SKU:          product.SKUString(),
+			Name:         product.Name,
+			SalePrice:    product.SalePrice,
+			ThumbnailURL: product.ThumbnailImage,
+			ProductURL:   product.URL,
+		}
+		if err := h.db.AddUserProduct(ctx, user.ID, dbProduct); err != nil {
+			return nil, h.dbError(err)
+		}
+
+		info := tcg.Parse(dbProduct.Name)
+		resp.Products = append(resp.Products, &stockcheckerv1.Product{
+			Sku:            dbProduct.SKU,
+			Name:           dbProduct.Name,
+			SalePrice:      dbProduct.SalePrice.Dollars(),
+			SalePriceCents: int64(dbProduct.SalePrice),
+			ThumbnailUrl:   dbProduct.ThumbnailURL,
+			ProductUrl:     dbProduct.ProductURL,
+			SetName:        info.Set,
+			ProductType:    productTypes[info.Type],
+			Retailer:       stockcheckerv1.Retailer_RETAILER_BEST_BUY,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
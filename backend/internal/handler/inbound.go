@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/inbound"
+)
+
+// SetInboundEmail enables forwarding Best Buy emails to addresses at domain
+func (h *StockCheckerHandler) SetInboundEmail(domain string) {
+	h.inboundDomain = domain
+}
+
+// reminderToProto converts a reminder to its protobuf message
+func reminderToProto(r *database.Reminder) *stockcheckerv1.Reminder {
+	pb := &stockcheckerv1.Reminder{
+		Id:          int32(r.ID),
+		Kind:        r.Kind,
+		Sku:         r.SKU,
+		ProductName: r.ProductName,
+		StoreId:     r.StoreID,
+		StoreName:   r.StoreName,
+		Subject:     r.Subject,
+		RemindAt:    timestamp(r.RemindAt),
+		CreatedAt:   timestamp(r.CreatedAt),
+	}
+	if r.DueAt != nil {
+		pb.DueAt = timestamp(*r.DueAt)
+	}
+	if r.SentAt != nil {
+		pb.SentAt = timestamp(*r.SentAt)
+	}
+	return pb
+}
+
+// GetMyInboundEmail returns the user's address for forwarding Best Buy
+// emails, making one on the first call
+func (h *StockCheckerHandler) GetMyInboundEmail(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyInboundEmailRequest],
+) (*connect.Response[stockcheckerv1.GetMyInboundEmailResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.inboundDomain == "" {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.inbound_email_disabled")
+	}
+
+	token, err := inbound.NewToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	token, err = h.db.EnsureInboundAddress(ctx, user.ID, token)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetMyInboundEmailResponse{
+		Address: inbound.Address(token, h.inboundDomain),
+	}), nil
+}
+
+// ResetMyInboundEmail gives the user a new forwarding address
+func (h *StockCheckerHandler) ResetMyInboundEmail(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ResetMyInboundEmailRequest],
+) (*connect.Response[stockcheckerv1.ResetMyInboundEmailResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.inboundDomain == "" {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.inbound_email_disabled")
+	}
+
+	token, err := inbound.NewToken()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := h.db.SetInboundAddress(ctx, user.ID, token); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ResetMyInboundEmailResponse{
+		Address: inbound.Address(token, h.inboundDomain),
+	}), nil
+}
+
+// ListMyReminders lists the reminders made from the user's forwarded emails
+func (h *StockCheckerHandler) ListMyReminders(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ListMyRemindersRequest],
+) (*connect.Response[stockcheckerv1.ListMyRemindersResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders, err := h.db.GetUserReminders(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	resp := &stockcheckerv1.ListMyRemindersResponse{Reminders: make([]*stockcheckerv1.Reminder, 0, len(reminders))}
+	for i := range reminders {
+		resp.Reminders = append(resp.Reminders, reminderToProto(&reminders[i]))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// DeleteMyReminder deletes one of the user's reminders
+func (h *StockCheckerHandler) DeleteMyReminder(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.DeleteMyReminderRequest],
+) (*connect.Response[stockcheckerv1.DeleteMyReminderResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := h.db.DeleteReminder(ctx, user.ID, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.reminder_not_found", req.Msg.Id)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.DeleteMyReminderResponse{}), nil
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// stockRank orders in-store results: in stock, then low stock, then out of stock
+func stockRank(s *stockcheckerv1.StockStatus) int {
+	switch {
+	case s.InStock && !s.LowStock:
+		return 0
+	case s.InStock:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// CheckStoreNow live-checks every product the user watches at one of their
+// saved stores, for a user standing in the store. The checks skip the response
+// cache and go ahead of the background watcher's requests.
+func (h *StockCheckerHandler) CheckStoreNow(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.CheckStoreNowRequest],
+) (*connect.Response[stockcheckerv1.CheckStoreNowResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stores, err := h.db.GetUserStores(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	var store *database.Store
+	for i := range stores {
+		if stores[i].StoreID == req.Msg.StoreId {
+			store = &stores[i]
+			break
+		}
+	}
+	if store == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.store_not_saved", req.Msg.StoreId)
+	}
+
+	products, err := h.db.GetUserProducts(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	checkedAt := time.Now()
+	resp := &stockcheckerv1.CheckStoreNowResponse{
+		Store:     savedStore(*store),
+		Results:   make([]*stockcheckerv1.StockStatus, 0, len(products)),
+		CheckedAt: timestamp(checkedAt),
+	}
+	liveCtx := bestbuy.WithHighPriority(ctx)
+	h.countChecks(ctx, len(products))
+
+	var history []database.StockHistoryEntry
+	for _, p := range products {
+		availability, err := h.bbClient.CheckAvailability(liveCtx, p.SKU, store.PostalCode)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, connect.NewError(connect.CodeCanceled, ctx.Err())
+			}
+			log.Printf("Error checking %s at store %s: %v", p.SKU, store.StoreID, err)
+			resp.FailedSkus = append(resp.FailedSkus, p.SKU)
+			continue
+		}
+
+		status := &stockcheckerv1.StockStatus{
+			Product:   savedProduct(p),
+			IsMyStore: true,
+			CheckedAt: timestamp(checkedAt),
+		}
+		for _, a := range availability {
+			if a.StoreID == store.StoreID {
+				status.InStock = a.InStock
+				status.LowStock = a.LowStock
+				status.PickupEligible = a.PickupEligible
+				break
+			}
+		}
+		resp.Results = append(resp.Results, status)
+		history = append(history, database.StockHistoryEntry{
+			SKU:       p.SKU,
+			StoreID:   store.StoreID,
+			InStock:   status.InStock,
+			LowStock:  status.LowStock,
+			CheckedAt: checkedAt,
+		})
+	}
+
+	sort.SliceStable(resp.Results, func(i, j int) bool {
+		ri, rj := stockRank(resp.Results[i]), stockRank(resp.Results[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return resp.Results[i].Product.Name < resp.Results[j].Product.Name
+	})
+
+	if h.canWrite() {
+		if err := h.db.RecordStockHistory(ctx, history); err != nil {
+			log.Printf("Error recording stock history: %v", err)
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// maxInviteNoteLen is the longest note an invite can have, in characters
+const maxInviteNoteLen = 200
+
+// SetInviteURL enables invite links, which open base with the invite's token
+func (h *StockCheckerHandler) SetInviteURL(base string) {
+	h.inviteURL = base
+}
+
+// inviteToProto converts an invite to its protobuf message
+func inviteToProto(i *database.Invite) *stockcheckerv1.Invite {
+	pb := &stockcheckerv1.Invite{
+		Id:        int32(i.ID),
+		Note:      i.Note,
+		CreatedBy: i.CreatedByEmail,
+		ExpiresAt: timestamp(i.ExpiresAt),
+		UsedBy:    i.UsedByEmail,
+		CreatedAt: timestamp(i.CreatedAt),
+	}
+	if i.UsedAt != nil {
+		pb.UsedAt = timestamp(*i.UsedAt)
+	}
+	return pb
+}
+
+// AdminCreateInvite creates a single-use, expiring link that allows whoever
+// signs in with it (admin only)
+func (h *StockCheckerHandler) AdminCreateInvite(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminCreateInviteRequest],
+) (*connect.Response[stockcheckerv1.AdminCreateInviteResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.inviteURL == "" {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.invites_disabled")
+	}
+
+	note := strings.TrimSpace(req.Msg.Note)
+	if utf8.RuneCountInString(note) > maxInviteNoteLen {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invite_note_too_long", maxInviteNoteLen)
+	}
+	duration := auth.DefaultInviteDuration
+	if req.Msg.Hours > 0 {
+		duration = min(time.Duration(req.Msg.Hours)*time.Hour, auth.MaxInviteDuration)
+	}
+
+	token, err := auth.GenerateInvite()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	invite, err := h.db.CreateInvite(ctx, auth.HashInvite(token), note, user.ID, time.Now().Add(duration))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminCreateInviteResponse{
+		Invite: inviteToProto(invite),
+		Url:    h.inviteURL + "?token=" + url.QueryEscape(token),
+	}), nil
+}
+
+// AdminListInvites lists invite links, newest first (admin only)
+func (h *StockCheckerHandler) AdminListInvites(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminListInvitesRequest],
+) (*connect.Response[stockcheckerv1.AdminListInvitesResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	invites, err := h.db.GetInvites(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	page, next, err := paginate(ctx, invites, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pbInvites := make([]*stockcheckerv1.Invite, 0, len(page))
+	for i := range page {
+		pbInvites = append(pbInvites, inviteToProto(&page[i]))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminListInvitesResponse{
+		Invites:       pbInvites,
+		NextPageToken: next,
+	}), nil
+}
+
+// AdminRevokeInvite revokes an invite link that hasn't been used (admin only)
+func (h *StockCheckerHandler) AdminRevokeInvite(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminRevokeInviteRequest],
+) (*connect.Response[stockcheckerv1.AdminRevokeInviteResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	found, err := h.db.DeleteUnusedInvite(ctx, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.invite_not_found", req.Msg.Id)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminRevokeInviteResponse{}), nil
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/geo"
+	"github.com/tmcauley/stock-checker/backend/internal/input"
+)
+
+// Location radius limits, in miles
+const (
+	defaultRadiusMiles = 25
+	maxRadiusMiles     = 250 // the furthest CheckAvailability looks
+)
+
+// locationToProto converts a location to its protobuf message
+func locationToProto(l database.Location) *stockcheckerv1.Location {
+	return &stockcheckerv1.Location{
+		Name:        l.Name,
+		PostalCode:  l.PostalCode,
+		RadiusMiles: int32(l.RadiusMiles),
+		Latitude:    l.Point.Lat,
+		Longitude:   l.Point.Lng,
+	}
+}
+
+// storeCoordinates collects the coordinates of stores from a store search
+func storeCoordinates(stores []bestbuy.Store) map[string]geo.Point {
+	coordinates := make(map[string]geo.Point, len(stores))
+	for _, s := range stores {
+		coordinates[s.StoreIDString()] = geo.Point{Lat: s.Lat, Lng: s.Lng}
+	}
+	return coordinates
+}
+
+// locate approximates the coordinates of a postal code with those of the
+// closest Best Buy store, which is good enough for distance limits of a few
+// miles or more. It returns the zero point if there are no stores nearby.
+func (h *StockCheckerHandler) locate(ctx context.Context, postalCode string) geo.Point {
+	stores, err := h.bbClient.SearchStores(ctx, postalCode, defaultRadiusMiles)
+	if err != nil {
+		log.Printf("Error locating postal code %s: %v", postalCode, err)
+		return geo.Point{}
+	}
+	if err := h.db.SaveStoreCoordinates(ctx, storeCoordinates(stores)); err != nil {
+		log.Printf("Error saving store coordinates: %v", err)
+	}
+	h.saveStoreHours(ctx, storeHours(stores))
+
+	var closest *bestbuy.Store
+	for i, s := range stores {
+		if (geo.Point{Lat: s.Lat, Lng: s.Lng}).Valid() && (closest == nil || s.Distance < closest.Distance) {
+			closest = &stores[i]
+		}
+	}
+	if closest == nil {
+		return geo.Point{}
+	}
+	return geo.Point{Lat: closest.Lat, Lng: closest.Lng}
+}
+
+// userLocation returns the authenticated user's location with the given name
+func (h *StockCheckerHandler) userLocation(ctx context.Context, name string) (*database.Location, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := h.db.GetUserLocation(ctx, user.ID, name)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if location == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.location_not_found", name)
+	}
+	return location, nil
+}
+
+// GetMyLocations returns the user's locations
+func (h *StockCheckerHandler) GetMyLocations(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyLocationsRequest],
+) (*connect.Response[stockcheckerv1.GetMyLocationsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locations, err := h.db.GetUserLocations(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbLocations := make([]*stockcheckerv1.Location, 0, len(locations))
+	for _, l := range locations {
+		pbLocations = append(pbLocations, locationToProto(l))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetMyLocationsResponse{
+		Locations: pbLocations,
+	}), nil
+}
+
+// SetMyLocation validates and saves a location, replacing any with the same name
+func (h *StockCheckerHandler) SetMyLocation(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SetMyLocationRequest],
+) (*connect.Response[stockcheckerv1.SetMyLocationResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := req.Msg.Location
+	if pb == nil || strings.TrimSpace(pb.Name) == "" || pb.PostalCode == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.location_required")
+	}
+
+	postalCode, err := input.NormalizePostalCode(pb.PostalCode)
+	if err != nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_postal_code", pb.PostalCode)
+	}
+
+	radius := int(pb.RadiusMiles)
+	if radius == 0 {
+		radius = defaultRadiusMiles
+	}
+	if radius < 0 || radius > maxRadiusMiles {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_radius", maxRadiusMiles)
+	}
+
+	point := geo.Point{Lat: pb.Latitude, Lng: pb.Longitude}
+	if !point.Valid() {
+		point = h.locate(ctx, postalCode)
+	}
+
+	location := database.Location{
+		UserID:      user.ID,
+		Name:        strings.TrimSpace(pb.Name),
+		PostalCode:  postalCode,
+		RadiusMiles: radius,
+		Point:       point,
+	}
+	if err := h.db.SaveUserLocation(ctx, location); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.SetMyLocationResponse{
+		Location: locationToProto(location),
+	}), nil
+}
+
+// DeleteMyLocation removes one of the user's locations
+func (h *StockCheckerHandler) DeleteMyLocation(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.DeleteMyLocationRequest],
+) (*connect.Response[stockcheckerv1.DeleteMyLocationResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := h.db.DeleteUserLocation(ctx, user.ID, req.Msg.Name)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.location_not_found", req.Msg.Name)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.DeleteMyLocationResponse{}), nil
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+)
+
+// DefaultMaintenanceRetryAfter is how long clients are told to wait when no retry time is configured
+const DefaultMaintenanceRetryAfter = 5 * time.Minute
+
+// Maintenance is read-only mode for long database migrations. While enabled,
+// RPCs that change data fail with a retryable Unavailable error and reads
+// skip their incidental writes (stock history, store coordinates).
+type Maintenance struct {
+	enabled    atomic.Bool
+	retryAfter time.Duration
+}
+
+// NewMaintenance creates a maintenance switch that tells blocked clients to
+// retry after retryAfter (DefaultMaintenanceRetryAfter if zero)
+func NewMaintenance(enabled bool, retryAfter time.Duration) *Maintenance {
+	if retryAfter <= 0 {
+		retryAfter = DefaultMaintenanceRetryAfter
+	}
+	m := &Maintenance{retryAfter: retryAfter}
+	m.enabled.Store(enabled)
+	return m
+}
+
+// Enabled reports whether the server is read-only. A nil Maintenance is never enabled.
+func (m *Maintenance) Enabled() bool {
+	return m != nil && m.enabled.Load()
+}
+
+// SetEnabled turns read-only mode on or off
+func (m *Maintenance) SetEnabled(enabled bool) {
+	m.enabled.Store(enabled)
+}
+
+// Interceptor rejects RPCs with side effects while read-only mode is enabled
+func (m *Maintenance) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if m.Enabled() && req.Spec().IdempotencyLevel != connect.IdempotencyNoSideEffects {
+				return nil, m.error(ctx)
+			}
+			return next(ctx, req)
+		}
+	})
+}
+
+// error builds the Unavailable error for a blocked RPC, with the retry time
+// as a MaintenanceError detail and a Retry-After header
+func (m *Maintenance) error(ctx context.Context) *connect.Error {
+	seconds := int32(math.Ceil(m.retryAfter.Seconds()))
+	err := localizedError(ctx, connect.CodeUnavailable, "error.maintenance", int(math.Ceil(m.retryAfter.Minutes())))
+	if detail, detailErr := connect.NewErrorDetail(&stockcheckerv1.MaintenanceError{RetryAfterSeconds: seconds}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	err.Meta().Set("Retry-After", strconv.Itoa(int(seconds)))
+	return err
+}
+
+// SetMaintenance enables read-only mode checks for incidental writes
+func (h *StockCheckerHandler) SetMaintenance(m *Maintenance) {
+	h.maintenance = m
+}
+
+// canWrite reports whether reads may record incidental data
+func (h *StockCheckerHandler) canWrite() bool {
+	return h.db != nil && !h.maintenance.Enabled()
+}
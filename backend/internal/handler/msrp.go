@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+)
+
+// listedProduct converts a Best Buy listing to proto, with its set and
+// whether it's priced above MSRP
+func (h *StockCheckerHandler) listedProduct(ctx context.Context, product bestbuy.Product) *stockcheckerv1.Product {
+	info := tcg.Parse(product.Name)
+	pb := &stockcheckerv1.Product{
+		Sku:            product.SKUString(),
+		Name:           product.Name,
+		SalePrice:      product.SalePrice.Dollars(),
+		SalePriceCents: int64(product.SalePrice),
+		ThumbnailUrl:   product.ThumbnailImage,
+		ProductUrl:     product.URL,
+		CurrencyCode:   product.Currency,
+		SetName:        info.Set,
+		ProductType:    productTypes[info.Type],
+		Retailer:       stockcheckerv1.Retailer_RETAILER_BEST_BUY,
+	}
+
+	// MSRPs are US prices
+	if product.Currency == "" || product.Currency == "USD" {
+		msrp := h.msrps.Lookup(ctx, product.Name)
+		pb.MsrpCents = int64(msrp)
+		pb.AboveMsrp = tcg.AboveMSRP(product.SalePrice, msrp)
+	}
+	return pb
+}
+
+// productTypeName maps a product type enum back to the parsed product type
+func productTypeName(t stockcheckerv1.ProductType) tcg.ProductType {
+	for name, pb := range productTypes {
+		if pb == t {
+			return name
+		}
+	}
+	return tcg.TypeUnknown
+}
+
+// ListMsrps returns the MSRPs listings are compared against
+func (h *StockCheckerHandler) ListMsrps(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ListMsrpsRequest],
+) (*connect.Response[stockcheckerv1.ListMsrpsResponse], error) {
+	if _, err := getUserFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	msrps, err := h.db.GetMSRPs(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbMsrps := make([]*stockcheckerv1.Msrp, 0, len(msrps))
+	for _, m := range msrps {
+		pbMsrps = append(pbMsrps, &stockcheckerv1.Msrp{
+			SetName:     m.Set,
+			ProductType: productTypes[m.Type],
+			PriceCents:  int64(m.Price),
+		})
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ListMsrpsResponse{
+		Msrps: pbMsrps,
+	}), nil
+}
+
+// SetMsrp sets or removes an MSRP (admin only)
+func (h *StockCheckerHandler) SetMsrp(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SetMsrpRequest],
+) (*connect.Response[stockcheckerv1.SetMsrpResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	m := req.Msg.Msrp
+	if m == nil || productTypeName(m.ProductType) == tcg.TypeUnknown {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_type_required")
+	}
+
+	if err := h.db.SetMSRP(ctx, tcg.MSRP{
+		Set:   strings.TrimSpace(m.SetName),
+		Type:  productTypeName(m.ProductType),
+		Price: money.Cents(max(m.PriceCents, 0)),
+	}); err != nil {
+		return nil, h.dbError(err)
+	}
+	h.msrps.Invalidate()
+
+	return connect.NewResponse(&stockcheckerv1.SetMsrpResponse{}), nil
+}
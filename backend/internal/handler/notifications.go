@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// templateOwner returns the user ID a template change applies to (nil for admin defaults)
+func (h *StockCheckerHandler) templateOwner(ctx context.Context, user *database.User, isDefault bool) (*int, error) {
+	if !isDefault {
+		return &user.ID, nil
+	}
+	if !user.IsAdmin() {
+		return nil, localizedError(ctx, connect.CodePermissionDenied, "error.admin_required")
+	}
+	return nil, nil
+}
+
+// GetNotificationTemplates returns the user's notification templates and the admin defaults
+func (h *StockCheckerHandler) GetNotificationTemplates(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetNotificationTemplatesRequest],
+) (*connect.Response[stockcheckerv1.GetNotificationTemplatesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := h.db.GetUserNotificationTemplates(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	defaults, err := h.db.GetDefaultNotificationTemplates(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbTemplates := make([]*stockcheckerv1.NotificationTemplate, 0, len(templates)+len(defaults))
+	for _, t := range append(templates, defaults...) {
+		pbTemplates = append(pbTemplates, &stockcheckerv1.NotificationTemplate{
+			ChannelType:   t.ChannelType,
+			TitleTemplate: t.TitleTemplate,
+			BodyTemplate:  t.BodyTemplate,
+			IsDefault:     t.UserID == nil,
+		})
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetNotificationTemplatesResponse{
+		Templates: pbTemplates,
+	}), nil
+}
+
+// SetNotificationTemplate validates and saves a notification template
+func (h *StockCheckerHandler) SetNotificationTemplate(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SetNotificationTemplateRequest],
+) (*connect.Response[stockcheckerv1.SetNotificationTemplateResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t := req.Msg.Template
+	if t == nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.template_required")
+	}
+
+	owner, err := h.templateOwner(ctx, user, t.IsDefault)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := notify.ParseTemplate(localeFromContext(ctx), t.TitleTemplate, t.BodyTemplate); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.db.UpsertNotificationTemplate(ctx, owner, t.ChannelType, t.TitleTemplate, t.BodyTemplate); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.SetNotificationTemplateResponse{}), nil
+}
+
+// DeleteNotificationTemplate removes a notification template
+func (h *StockCheckerHandler) DeleteNotificationTemplate(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.DeleteNotificationTemplateRequest],
+) (*connect.Response[stockcheckerv1.DeleteNotificationTemplateResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := h.templateOwner(ctx, user, req.Msg.IsDefault)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.db.DeleteNotificationTemplate(ctx, owner, req.Msg.ChannelType); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.DeleteNotificationTemplateResponse{}), nil
+}
+
+// SendTestNotification renders a notification from sample data and sends it over a configured channel
+func (h *StockCheckerHandler) SendTestNotification(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SendTestNotificationRequest],
+) (*connect.Response[stockcheckerv1.SendTestNotificationResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locale := localeFromContext(ctx)
+
+	// Preview an unsaved template if one was given, otherwise the one that would be used
+	var tmpl *notify.Template
+	if t := req.Msg.Template; t != nil {
+		tmpl, err = notify.ParseTemplate(locale, t.TitleTemplate, t.BodyTemplate)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	} else {
+		tmpl, err = notify.ResolveTemplate(ctx, h.db, user.ID, req.Msg.ChannelType, locale)
+		if err != nil {
+			return nil, h.dbError(err)
+		}
+	}
+
+	msg, err := tmpl.Render(sampleAlertData(h.domain), notify.PriorityNormal)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	msg.Title = i18n.T(locale, "notify.test_prefix") + " " + msg.Title
+
+	resp := &stockcheckerv1.SendTestNotificationResponse{
+		Title: msg.Title,
+		Body:  msg.Body,
+	}
+	if req.Msg.PreviewOnly {
+		return connect.NewResponse(resp), nil
+	}
+
+	channel, err := h.findChannel(ctx, user.ID, req.Msg.ChannelType)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if channel == nil {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.channel_not_configured", req.Msg.ChannelType)
+	}
+
+	notifier, err := notify.New(channel.ChannelType, channel.Config)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err := notifier.Send(ctx, msg); err != nil {
+		log.Printf("Error sending test notification over %s: %v", channel.ChannelType, err)
+		return nil, deliveryError(ctx, err)
+	}
+	resp.Sent = true
+
+	return connect.NewResponse(resp), nil
+}
+
+// deliveryError reports a failed delivery without the destination's
+// response or network details, since the destination is user-supplied
+func deliveryError(ctx context.Context, err error) error {
+	var status *notify.StatusError
+	switch {
+	case errors.Is(err, notify.ErrBlockedDestination):
+		return localizedError(ctx, connect.CodeFailedPrecondition, "error.destination_blocked")
+	case errors.As(err, &status):
+		return localizedError(ctx, connect.CodeUnavailable, "error.delivery_failed_status", status.Status)
+	}
+	return localizedError(ctx, connect.CodeUnavailable, "error.delivery_failed")
+}
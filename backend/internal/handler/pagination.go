@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"connectrpc.com/connect"
+)
+
+// Page size limits for paginated RPCs
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// paginate returns the page of items selected by pageSize and pageToken along
+// with the token of the next page (empty on the last page). Tokens are opaque
+// to clients; they currently encode the offset of the page's first item.
+func paginate[T any](ctx context.Context, items []T, pageSize int32, pageToken string) ([]T, string, error) {
+	size := int(pageSize)
+	if size <= 0 {
+		size = defaultPageSize
+	}
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+
+	offset, err := decodePageToken(ctx, pageToken)
+	if err != nil || offset > len(items) {
+		return nil, "", localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_page_token")
+	}
+
+	end := offset + size
+	if end >= len(items) {
+		return items[offset:], "", nil
+	}
+	return items[offset:end], encodePageToken(end), nil
+}
+
+// encodePageToken returns the page token for position n
+func encodePageToken(n int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(n)))
+}
+
+// decodePageToken returns the position a page token encodes, 0 for an empty token
+func decodePageToken(ctx context.Context, pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(pageToken)
+	var n int
+	if err == nil {
+		n, err = strconv.Atoi(string(raw))
+	}
+	if err != nil || n < 0 {
+		return 0, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_page_token")
+	}
+	return n, nil
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// maxDigestIntervalHours is the longest nice-to-have alerts can wait for a digest
+const maxDigestIntervalHours = 7 * 24
+
+// preferencesToProto converts notification preferences to their protobuf message
+func preferencesToProto(p database.NotificationPreferences) *stockcheckerv1.NotificationPreferences {
+	return &stockcheckerv1.NotificationPreferences{
+		AlertsEnabled:       p.AlertsEnabled,
+		IncludeLowStock:     p.IncludeLowStock,
+		MaxDistanceMiles:    p.MaxDistanceMiles,
+		UpdatedAt:           timestamp(p.UpdatedAt),
+		UrgentChannels:      p.UrgentChannels,
+		DigestIntervalHours: int32(p.DigestIntervalHours),
+	}
+}
+
+// alertRuleToProto converts an alert rule to its protobuf message
+func alertRuleToProto(r database.AlertRule) *stockcheckerv1.AlertRule {
+	return &stockcheckerv1.AlertRule{
+		Sku:              r.SKU,
+		Enabled:          r.Enabled,
+		MaxPriceCents:    int64(r.MaxPriceCents),
+		MinStores:        int32(r.MinStores),
+		MaxDistanceMiles: r.MaxDistanceMiles,
+		Location:         r.Location,
+		UpdatedAt:        timestamp(r.UpdatedAt),
+	}
+}
+
+// GetNotificationPreferences returns the user's notification preferences
+func (h *StockCheckerHandler) GetNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetNotificationPreferencesRequest],
+) (*connect.Response[stockcheckerv1.GetNotificationPreferencesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs, err := h.db.GetNotificationPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetNotificationPreferencesResponse{
+		Preferences: preferencesToProto(prefs),
+	}), nil
+}
+
+// UpdateNotificationPreferences changes the preferences named in the update mask
+func (h *StockCheckerHandler) UpdateNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.UpdateNotificationPreferencesRequest],
+) (*connect.Response[stockcheckerv1.UpdateNotificationPreferencesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Preferences == nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.preferences_required")
+	}
+
+	current, err := h.db.GetNotificationPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	updated := preferencesToProto(current)
+	if err := applyFieldMask(ctx, updated, req.Msg.Preferences, req.Msg.UpdateMask,
+		"alerts_enabled", "include_low_stock", "max_distance_miles", "urgent_channels", "digest_interval_hours"); err != nil {
+		return nil, err
+	}
+	if updated.MaxDistanceMiles < 0 {
+		updated.MaxDistanceMiles = 0
+	}
+	for _, c := range updated.UrgentChannels {
+		if !notify.ValidChannel(c) {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_channel_type", c)
+		}
+	}
+	if updated.DigestIntervalHours <= 0 {
+		updated.DigestIntervalHours = database.DefaultDigestIntervalHours
+	}
+	updated.DigestIntervalHours = min(updated.DigestIntervalHours, maxDigestIntervalHours)
+
+	if err := h.db.SaveNotificationPreferences(ctx, database.NotificationPreferences{
+		UserID:              user.ID,
+		AlertsEnabled:       updated.AlertsEnabled,
+		IncludeLowStock:     updated.IncludeLowStock,
+		MaxDistanceMiles:    updated.MaxDistanceMiles,
+		UrgentChannels:      updated.UrgentChannels,
+		DigestIntervalHours: int(updated.DigestIntervalHours),
+	}); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	saved, err := h.db.GetNotificationPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.UpdateNotificationPreferencesResponse{
+		Preferences: preferencesToProto(saved),
+	}), nil
+}
+
+// GetAlertRules returns the user's per-product alert rules
+func (h *StockCheckerHandler) GetAlertRules(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetAlertRulesRequest],
+) (*connect.Response[stockcheckerv1.GetAlertRulesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := h.db.GetAlertRules(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbRules := make([]*stockcheckerv1.AlertRule, 0, len(rules))
+	for _, r := range rules {
+		pbRules = append(pbRules, alertRuleToProto(r))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetAlertRulesResponse{
+		Rules: pbRules,
+	}), nil
+}
+
+// UpdateAlertRule changes the rule fields named in the update mask
+func (h *StockCheckerHandler) UpdateAlertRule(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.UpdateAlertRuleRequest],
+) (*connect.Response[stockcheckerv1.UpdateAlertRuleResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := req.Msg.Rule
+	if rule == nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.rule_required")
+	}
+
+	product, err := h.db.GetUserProduct(ctx, user.ID, retailer.BestBuy, rule.Sku)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if product == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.product_not_saved", rule.Sku)
+	}
+
+	current, err := h.db.GetAlertRule(ctx, user.ID, retailer.BestBuy, rule.Sku)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	updated := alertRuleToProto(current)
+	if err := applyFieldMask(ctx, updated, rule, req.Msg.UpdateMask, "enabled", "max_price_cents", "min_stores", "max_distance_miles", "location"); err != nil {
+		return nil, err
+	}
+	if updated.MaxPriceCents < 0 {
+		updated.MaxPriceCents = 0
+	}
+	if updated.MinStores < 1 {
+		updated.MinStores = 1
+	}
+	if updated.MaxDistanceMiles < 0 {
+		updated.MaxDistanceMiles = 0
+	}
+	if updated.Location != "" {
+		if _, err := h.userLocation(ctx, updated.Location); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.db.SaveAlertRule(ctx, database.AlertRule{
+		UserID:           user.ID,
+		Retailer:         retailer.BestBuy,
+		SKU:              rule.Sku,
+		Enabled:          updated.Enabled,
+		MaxPriceCents:    money.Cents(updated.MaxPriceCents),
+		MinStores:        int(updated.MinStores),
+		MaxDistanceMiles: updated.MaxDistanceMiles,
+		Location:         updated.Location,
+	}); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	saved, err := h.db.GetAlertRule(ctx, user.ID, retailer.BestBuy, rule.Sku)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.UpdateAlertRuleResponse{
+		Rule: alertRuleToProto(saved),
+	}), nil
+}
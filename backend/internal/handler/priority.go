@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+)
+
+// PriorityInterceptor marks Best Buy calls made while serving an RPC as
+// interactive, so a user waiting on a search goes ahead of the poller's
+// background calls and may use the quota's interactive reserve
+func PriorityInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return next(bestbuy.WithPriority(ctx, bestbuy.PriorityInteractive), req)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// Product watch limits: each watch costs the poller a search every sweep
+const (
+	maxProductWatches     = 20
+	maxProductWatchLength = 200
+)
+
+// productWatchToProto converts a product watch to its protobuf message
+func productWatchToProto(w database.ProductWatch) *stockcheckerv1.ProductWatch {
+	return &stockcheckerv1.ProductWatch{
+		Id:         int32(w.ID),
+		Query:      w.Query,
+		CategoryId: w.CategoryID,
+		CreatedAt:  timestamp(w.CreatedAt),
+	}
+}
+
+// GetMyProductWatches returns the user's product watches
+func (h *StockCheckerHandler) GetMyProductWatches(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyProductWatchesRequest],
+) (*connect.Response[stockcheckerv1.GetMyProductWatchesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	watches, err := h.db.GetUserProductWatches(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbWatches := make([]*stockcheckerv1.ProductWatch, 0, len(watches))
+	for _, w := range watches {
+		pbWatches = append(pbWatches, productWatchToProto(w))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetMyProductWatchesResponse{
+		ProductWatches: pbWatches,
+	}), nil
+}
+
+// WatchProducts watches a search for new listings. What's listed now is
+// recorded right away so only products listed later alert.
+func (h *StockCheckerHandler) WatchProducts(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.WatchProductsRequest],
+) (*connect.Response[stockcheckerv1.WatchProductsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(req.Msg.Query)
+	if query == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.watch_query_required")
+	}
+	if utf8.RuneCountInString(query) > maxProductWatchLength {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.watch_query_too_long", maxProductWatchLength)
+	}
+	categoryID := strings.TrimSpace(req.Msg.CategoryId)
+	if categoryID == "" {
+		categoryID = h.domain.Category
+	}
+
+	existing, err := h.db.GetUserProductWatches(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if len(existing) >= maxProductWatches && !slices.ContainsFunc(existing, func(w database.ProductWatch) bool {
+		return w.CategoryID == categoryID && strings.EqualFold(w.Query, query)
+	}) {
+		return nil, localizedError(ctx, connect.CodeResourceExhausted, "error.too_many_product_watches", maxProductWatches)
+	}
+
+	watch, err := h.db.AddProductWatch(ctx, user.ID, query, categoryID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	resp := &stockcheckerv1.WatchProductsResponse{ProductWatch: productWatchToProto(watch)}
+
+	// The watch is saved either way; the poller records the listings if searching fails
+	products, err := poller.SearchWatch(ctx, h.bbClient, watch)
+	if err != nil {
+		log.Printf("Failed to search products for watch %d (%q): %v", watch.ID, query, err)
+		return connect.NewResponse(resp), nil
+	}
+	skus := make([]string, 0, len(products))
+	for _, p := range products {
+		skus = append(skus, p.SKUString())
+	}
+	if _, err := h.db.RecordWatchSKUs(ctx, watch.ID, skus); err != nil {
+		return nil, h.dbError(err)
+	}
+	resp.ListedCount = int32(len(products))
+
+	return connect.NewResponse(resp), nil
+}
+
+// UnwatchProducts stops one of the user's product watches
+func (h *StockCheckerHandler) UnwatchProducts(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.UnwatchProductsRequest],
+) (*connect.Response[stockcheckerv1.UnwatchProductsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := h.db.RemoveProductWatch(ctx, user.ID, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !removed {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.product_watch_not_found", req.Msg.Id)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.UnwatchProductsResponse{}), nil
+}
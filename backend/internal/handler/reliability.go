@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/reliability"
+)
+
+// maxReliabilityStores caps the stores scored in one request
+const maxReliabilityStores = 100
+
+// storeConfidences maps reliability levels to their enum
+var storeConfidences = map[reliability.Level]stockcheckerv1.StoreConfidence{
+	reliability.Low:    stockcheckerv1.StoreConfidence_STORE_CONFIDENCE_LOW,
+	reliability.Medium: stockcheckerv1.StoreConfidence_STORE_CONFIDENCE_MEDIUM,
+	reliability.High:   stockcheckerv1.StoreConfidence_STORE_CONFIDENCE_HIGH,
+}
+
+// storeReliability converts a store's tally to its protobuf message
+func storeReliability(storeID string, t reliability.Tally) *stockcheckerv1.StoreReliability {
+	return &stockcheckerv1.StoreReliability{
+		StoreId:           storeID,
+		FoundCount:        int32(t.Found),
+		ConfirmationCount: int32(t.Total),
+		Score:             t.Score(),
+		Confidence:        storeConfidences[t.Level()],
+	}
+}
+
+// ConfirmStock records whether an alerted product was really on a store's shelf
+func (h *StockCheckerHandler) ConfirmStock(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ConfirmStockRequest],
+) (*connect.Response[stockcheckerv1.ConfirmStockResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Sku == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+	if req.Msg.StoreId == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.store_required")
+	}
+
+	// Only products the user watches were alerted, which keeps drive-by votes out of the scores
+	product, err := h.db.GetUserProduct(ctx, user.ID, "", req.Msg.Sku)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if product == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.product_not_saved", req.Msg.Sku)
+	}
+
+	if err := h.db.ConfirmStock(ctx, user.ID, req.Msg.Sku, req.Msg.StoreId, req.Msg.Found); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	tallies, err := h.db.GetStoreTallies(ctx, []string{req.Msg.StoreId})
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ConfirmStockResponse{
+		Reliability: storeReliability(req.Msg.StoreId, tallies[req.Msg.StoreId]),
+	}), nil
+}
+
+// GetStoreReliability scores how often stores' reported stock was on the shelf
+func (h *StockCheckerHandler) GetStoreReliability(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetStoreReliabilityRequest],
+) (*connect.Response[stockcheckerv1.GetStoreReliabilityResponse], error) {
+	if _, err := getUserFromContext(ctx); err != nil {
+		return nil, err
+	}
+	if len(req.Msg.StoreIds) > maxReliabilityStores {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.too_many_stores", maxReliabilityStores)
+	}
+
+	tallies, err := h.db.GetStoreTallies(ctx, req.Msg.StoreIds)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	stores := make([]*stockcheckerv1.StoreReliability, 0, len(req.Msg.StoreIds))
+	for _, id := range req.Msg.StoreIds {
+		stores = append(stores, storeReliability(id, tallies[id]))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetStoreReliabilityResponse{
+		Stores: stores,
+	}), nil
+}
@@ -0,0 +1,345 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/cache"
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+	"google.golang.org/protobuf/proto"
+)
+
+// Response cache defaults
+const (
+	DefaultResponseCacheTTL = 30 * time.Second // bounds staleness from writes made by other processes
+	responseCacheEntries    = 10000
+)
+
+// cacheTag names data cached responses are built from. A write to it makes
+// every cached response built from it stale.
+type cacheTag string
+
+const (
+	tagStores    cacheTag = "stores"
+	tagProducts  cacheTag = "products"
+	tagAlerts    cacheTag = "alerts" // preferences, alert rules and channels
+	tagLocations cacheTag = "locations"
+	tagWatches   cacheTag = "watches" // set and product watches
+	tagStock     cacheTag = "stock"   // availability found by the watcher, shared by every user
+)
+
+// userTags are the tags of each user's own data
+var userTags = []cacheTag{tagStores, tagProducts, tagAlerts, tagLocations, tagWatches}
+
+// cachedReads lists the read RPCs whose responses are cached for each user,
+// with the data each is built from. Each is decorated by CachingHandler.
+var cachedReads = map[string][]cacheTag{
+	stockcheckerv1connect.StockCheckerServiceGetMyStoresProcedure:                {tagStores},
+	stockcheckerv1connect.StockCheckerServiceGetMyProductsProcedure:              {tagProducts},
+	stockcheckerv1connect.StockCheckerServiceGetMyDashboardProcedure:             {tagStores, tagProducts, tagStock},
+	stockcheckerv1connect.StockCheckerServiceGetMyLocationsProcedure:             {tagLocations},
+	stockcheckerv1connect.StockCheckerServiceGetNotificationPreferencesProcedure: {tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceGetAlertRulesProcedure:              {tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceGetNotificationChannelsProcedure:    {tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceGetMySetWatchesProcedure:            {tagWatches},
+	stockcheckerv1connect.StockCheckerServiceGetMyProductWatchesProcedure:        {tagWatches},
+}
+
+// cacheInvalidations lists the data each write RPC changes. Writes missing
+// here make all of the caller's cached responses stale, so a new RPC is
+// never served stale data before it's listed.
+var cacheInvalidations = map[string][]cacheTag{
+	stockcheckerv1connect.StockCheckerServiceAddMyStoreProcedure:                    {tagStores},
+	stockcheckerv1connect.StockCheckerServiceRemoveMyStoreProcedure:                 {tagStores},
+	stockcheckerv1connect.StockCheckerServiceAddMyProductProcedure:                  {tagProducts},
+	stockcheckerv1connect.StockCheckerServiceUpdateMyProductProcedure:               {tagProducts},
+	stockcheckerv1connect.StockCheckerServiceRemoveMyProductProcedure:               {tagProducts},
+	stockcheckerv1connect.StockCheckerServiceRemoveMyProductsProcedure:              {tagProducts},
+	stockcheckerv1connect.StockCheckerServiceClearWatchlistProcedure:                {tagProducts},
+	stockcheckerv1connect.StockCheckerServiceImportMyProductsProcedure:              {tagProducts},
+	stockcheckerv1connect.StockCheckerServiceUndoLastChangeProcedure:                {tagStores, tagProducts},
+	stockcheckerv1connect.StockCheckerServiceUpdateNotificationPreferencesProcedure: {tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceUpdateAlertRuleProcedure:               {tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceSetNotificationChannelProcedure:        {tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceDeleteNotificationChannelProcedure:     {tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceSetMyLocationProcedure:                 {tagLocations, tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceDeleteMyLocationProcedure:              {tagLocations, tagAlerts},
+	stockcheckerv1connect.StockCheckerServiceWatchSetProcedure:                      {tagWatches, tagProducts},
+	stockcheckerv1connect.StockCheckerServiceUnwatchSetProcedure:                    {tagWatches},
+	stockcheckerv1connect.StockCheckerServiceWatchProductsProcedure:                 {tagWatches},
+	stockcheckerv1connect.StockCheckerServiceUnwatchProductsProcedure:               {tagWatches},
+	stockcheckerv1connect.StockCheckerServiceSyncChangesProcedure:                   nil,
+	stockcheckerv1connect.StockCheckerServiceSetNotificationTemplateProcedure:       nil,
+	stockcheckerv1connect.StockCheckerServiceDeleteNotificationTemplateProcedure:    nil,
+	stockcheckerv1connect.StockCheckerServiceDeleteAcquisitionProcedure:             nil,
+	stockcheckerv1connect.StockCheckerServiceConfirmStockProcedure:                  nil,
+	stockcheckerv1connect.StockCheckerServiceReportSightingProcedure:                nil,
+}
+
+// responseCacheRequests counts cached reads by whether they were answered from the cache
+var responseCacheRequests = metrics.NewCounter(
+	"stock_checker_response_cache_requests_total",
+	"Cacheable read RPCs, by procedure and whether the cache answered them (hit or miss).",
+	"procedure", "result",
+)
+
+// ResponseCache caches read RPC responses per user in memory. Instead of
+// deleting entries, writes bump the generation of the data they change, and
+// generations are part of each entry's key, so stale entries are never found
+// again and just age out.
+type ResponseCache struct {
+	store cache.Store
+	ttl   time.Duration
+	now   func() time.Time
+
+	mu          sync.Mutex
+	generations map[int]*userGenerations // by user
+	stock       uint64
+	swept       time.Time // when generations were last swept
+}
+
+// userGenerations are the generations of one user's data
+type userGenerations struct {
+	tags    map[cacheTag]uint64
+	changed time.Time // when one was last bumped
+}
+
+// NewResponseCache creates a response cache keeping entries for ttl
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	return &ResponseCache{
+		store:       cache.NewMemory(responseCacheEntries),
+		ttl:         ttl,
+		now:         time.Now,
+		generations: make(map[int]*userGenerations),
+	}
+}
+
+// invalidate makes a user's cached responses built from tags stale
+func (c *ResponseCache) invalidate(userID int, tags ...cacheTag) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.sweep(now)
+	gens := c.generations[userID]
+	if gens == nil {
+		gens = &userGenerations{tags: make(map[cacheTag]uint64)}
+		c.generations[userID] = gens
+	}
+	gens.changed = now
+	for _, tag := range tags {
+		if tag == tagStock {
+			c.stock++
+		} else {
+			gens.tags[tag]++
+		}
+	}
+}
+
+// sweep forgets the generations of users whose data hasn't changed for a
+// TTL, at most once a TTL. Every entry cached before their last change has
+// expired by then, so starting their generations over can't find one again.
+// Callers hold mu.
+func (c *ResponseCache) sweep(now time.Time) {
+	if now.Sub(c.swept) < c.ttl {
+		return
+	}
+	c.swept = now
+	for userID, gens := range c.generations {
+		if now.Sub(gens.changed) >= c.ttl {
+			delete(c.generations, userID)
+		}
+	}
+}
+
+// InvalidateProducts makes a user's cached responses listing their products
+// stale, for products saved in the background rather than by an RPC
+func (c *ResponseCache) InvalidateProducts(userID int) {
+	c.invalidate(userID, tagProducts)
+}
+
+// InvalidateUser makes all of a user's cached responses stale
+func (c *ResponseCache) InvalidateUser(userID int) {
+	c.invalidate(userID, userTags...)
+}
+
+// InvalidateStock makes every cached response showing availability stale,
+// for when the watcher records new stock
+func (c *ResponseCache) InvalidateStock() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stock++
+}
+
+// key returns the cache key of a user's call to procedure with req, which
+// includes the current generation of the data its response is built from
+func (c *ResponseCache) key(procedure string, userID int, req proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:%d:%s", procedure, userID, hex.EncodeToString(sum[:8]))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, tag := range cachedReads[procedure] {
+		gen := c.stock
+		if tag != tagStock {
+			if gens := c.generations[userID]; gens != nil {
+				gen = gens.tags[tag]
+			}
+		}
+		fmt.Fprintf(&b, ":%s=%d", tag, gen)
+	}
+	return b.String(), nil
+}
+
+// Interceptor makes the caller's cached responses stale after each write
+// they make. It goes after the auth interceptor, which sets the caller.
+func (c *ResponseCache) Interceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			resp, err := next(ctx, req)
+			procedure := req.Spec().Procedure
+			if err != nil || req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects || cachedReads[procedure] != nil {
+				return resp, err
+			}
+			user := auth.UserFromContext(ctx)
+			if user == nil {
+				return resp, err
+			}
+			if tags, ok := cacheInvalidations[procedure]; ok {
+				c.invalidate(user.ID, tags...)
+			} else {
+				c.InvalidateUser(user.ID)
+			}
+			return resp, err
+		}
+	})
+}
+
+// cachedCall answers a read RPC from the cache if it can, and otherwise
+// calls it and caches the response. Calls without a user aren't cached.
+func cachedCall[Req, Res any](
+	ctx context.Context,
+	c *ResponseCache,
+	req *connect.Request[Req],
+	call func(context.Context, *connect.Request[Req]) (*connect.Response[Res], error),
+) (*connect.Response[Res], error) {
+	procedure := req.Spec().Procedure
+	user := auth.UserFromContext(ctx)
+	msg, ok := any(req.Msg).(proto.Message)
+	if user == nil || !ok {
+		return call(ctx, req)
+	}
+	key, err := c.key(procedure, user.ID, msg)
+	if err != nil {
+		return call(ctx, req)
+	}
+
+	if data, found, _ := c.store.Get(ctx, key); found {
+		var res Res
+		if proto.Unmarshal(data, any(&res).(proto.Message)) == nil {
+			responseCacheRequests.Inc(procedure, "hit")
+			return connect.NewResponse(&res), nil
+		}
+	}
+	responseCacheRequests.Inc(procedure, "miss")
+
+	resp, err := call(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	if data, err := proto.Marshal(any(resp.Msg).(proto.Message)); err == nil {
+		c.store.Set(ctx, key, data, c.ttl)
+	}
+	return resp, nil
+}
+
+// CachingHandler decorates a handler, answering its cached read RPCs from
+// a ResponseCache. Pair it with the cache's interceptor so writes make
+// cached responses stale.
+type CachingHandler struct {
+	stockcheckerv1connect.StockCheckerServiceHandler
+	cache *ResponseCache
+}
+
+// NewCachingHandler decorates next with responses cached in c
+func NewCachingHandler(next stockcheckerv1connect.StockCheckerServiceHandler, c *ResponseCache) *CachingHandler {
+	return &CachingHandler{StockCheckerServiceHandler: next, cache: c}
+}
+
+func (h *CachingHandler) GetMyStores(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyStoresRequest],
+) (*connect.Response[stockcheckerv1.GetMyStoresResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetMyStores)
+}
+
+func (h *CachingHandler) GetMyProducts(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyProductsRequest],
+) (*connect.Response[stockcheckerv1.GetMyProductsResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetMyProducts)
+}
+
+func (h *CachingHandler) GetMyDashboard(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyDashboardRequest],
+) (*connect.Response[stockcheckerv1.GetMyDashboardResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetMyDashboard)
+}
+
+func (h *CachingHandler) GetMyLocations(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyLocationsRequest],
+) (*connect.Response[stockcheckerv1.GetMyLocationsResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetMyLocations)
+}
+
+func (h *CachingHandler) GetNotificationPreferences(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetNotificationPreferencesRequest],
+) (*connect.Response[stockcheckerv1.GetNotificationPreferencesResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetNotificationPreferences)
+}
+
+func (h *CachingHandler) GetAlertRules(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetAlertRulesRequest],
+) (*connect.Response[stockcheckerv1.GetAlertRulesResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetAlertRules)
+}
+
+func (h *CachingHandler) GetNotificationChannels(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetNotificationChannelsRequest],
+) (*connect.Response[stockcheckerv1.GetNotificationChannelsResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetNotificationChannels)
+}
+
+func (h *CachingHandler) GetMySetWatches(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMySetWatchesRequest],
+) (*connect.Response[stockcheckerv1.GetMySetWatchesResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetMySetWatches)
+}
+
+func (h *CachingHandler) GetMyProductWatches(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyProductWatchesRequest],
+) (*connect.Response[stockcheckerv1.GetMyProductWatchesResponse], error) {
+	return cachedCall(ctx, h.cache, req, h.StockCheckerServiceHandler.GetMyProductWatches)
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// countingStores counts calls to the read RPCs it answers
+type countingStores struct {
+	stockcheckerv1connect.UnimplementedStockCheckerServiceHandler
+	stores, dashboards int
+}
+
+func (h *countingStores) GetMyStores(ctx context.Context, req *connect.Request[stockcheckerv1.GetMyStoresRequest]) (*connect.Response[stockcheckerv1.GetMyStoresResponse], error) {
+	h.stores++
+	return connect.NewResponse(&stockcheckerv1.GetMyStoresResponse{
+		Stores: []*stockcheckerv1.Store{{StoreId: "281"}},
+	}), nil
+}
+
+func (h *countingStores) GetMyDashboard(ctx context.Context, req *connect.Request[stockcheckerv1.GetMyDashboardRequest]) (*connect.Response[stockcheckerv1.GetMyDashboardResponse], error) {
+	h.dashboards++
+	return connect.NewResponse(&stockcheckerv1.GetMyDashboardResponse{}), nil
+}
+
+func (h *countingStores) AddMyStore(ctx context.Context, req *connect.Request[stockcheckerv1.AddMyStoreRequest]) (*connect.Response[stockcheckerv1.AddMyStoreResponse], error) {
+	return connect.NewResponse(&stockcheckerv1.AddMyStoreResponse{}), nil
+}
+
+func (h *countingStores) AddMyProduct(ctx context.Context, req *connect.Request[stockcheckerv1.AddMyProductRequest]) (*connect.Response[stockcheckerv1.AddMyProductResponse], error) {
+	return connect.NewResponse(&stockcheckerv1.AddMyProductResponse{}), nil
+}
+
+func (h *countingStores) DeleteMyAccount(ctx context.Context, req *connect.Request[stockcheckerv1.DeleteMyAccountRequest]) (*connect.Response[stockcheckerv1.DeleteMyAccountResponse], error) {
+	return connect.NewResponse(&stockcheckerv1.DeleteMyAccountResponse{}), nil
+}
+
+func TestResponseCache(t *testing.T) {
+	next := &countingStores{}
+	responses := NewResponseCache(time.Minute)
+	signedIn := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			return next(auth.WithUser(ctx, &database.User{ID: 1}), req)
+		}
+	})
+	mux := http.NewServeMux()
+	mux.Handle(stockcheckerv1connect.NewStockCheckerServiceHandler(
+		NewCachingHandler(next, responses),
+		connect.WithInterceptors(signedIn, responses.Interceptor()),
+	))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	client := stockcheckerv1connect.NewStockCheckerServiceClient(srv.Client(), srv.URL)
+	ctx := context.Background()
+
+	getStores := func() {
+		t.Helper()
+		resp, err := client.GetMyStores(ctx, connect.NewRequest(&stockcheckerv1.GetMyStoresRequest{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Msg.Stores) != 1 || resp.Msg.Stores[0].StoreId != "281" {
+			t.Fatalf("got stores %v", resp.Msg.Stores)
+		}
+	}
+	getDashboard := func() {
+		t.Helper()
+		if _, err := client.GetMyDashboard(ctx, connect.NewRequest(&stockcheckerv1.GetMyDashboardRequest{})); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	getStores()
+	getStores()
+	getDashboard()
+	if next.stores != 1 {
+		t.Errorf("GetMyStores called %d times, want 1 (second answered from the cache)", next.stores)
+	}
+
+	// Adding a product changes the dashboard but not the stores
+	if _, err := client.AddMyProduct(ctx, connect.NewRequest(&stockcheckerv1.AddMyProductRequest{})); err != nil {
+		t.Fatal(err)
+	}
+	getStores()
+	getDashboard()
+	if next.stores != 1 || next.dashboards != 2 {
+		t.Errorf("after AddMyProduct: %d store and %d dashboard calls, want 1 and 2", next.stores, next.dashboards)
+	}
+
+	if _, err := client.AddMyStore(ctx, connect.NewRequest(&stockcheckerv1.AddMyStoreRequest{})); err != nil {
+		t.Fatal(err)
+	}
+	getStores()
+	if next.stores != 2 {
+		t.Errorf("after AddMyStore: %d store calls, want 2", next.stores)
+	}
+
+	// New stock only changes the dashboard
+	responses.InvalidateStock()
+	getStores()
+	getDashboard()
+	if next.stores != 2 || next.dashboards != 3 {
+		t.Errorf("after new stock: %d store and %d dashboard calls, want 2 and 3", next.stores, next.dashboards)
+	}
+
+	// Writes not listed in cacheInvalidations make everything stale
+	if _, err := client.DeleteMyAccount(ctx, connect.NewRequest(&stockcheckerv1.DeleteMyAccountRequest{})); err != nil {
+		t.Fatal(err)
+	}
+	getStores()
+	if next.stores != 3 {
+		t.Errorf("after an unlisted write: %d store calls, want 3", next.stores)
+	}
+
+	// Products saved in the background change the dashboard too
+	getDashboard()
+	responses.InvalidateProducts(1)
+	getDashboard()
+	if next.dashboards != 5 {
+		t.Errorf("after a background product save: %d dashboard calls, want 5", next.dashboards)
+	}
+}
+
+func TestResponseCacheForgetsIdleUsers(t *testing.T) {
+	c := NewResponseCache(time.Minute)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	c.invalidate(1, tagStores)
+	now = now.Add(30 * time.Second)
+	c.invalidate(2, tagStores)
+	now = now.Add(45 * time.Second)
+	c.invalidate(3, tagStores)
+
+	if _, ok := c.generations[1]; ok {
+		t.Error("kept the generations of a user idle for longer than the TTL")
+	}
+	if _, ok := c.generations[2]; !ok {
+		t.Error("forgot the generations of a user whose entries may still be cached")
+	}
+}
+
+func TestCachedReadsAreReads(t *testing.T) {
+	for procedure := range cachedReads {
+		if _, ok := cacheInvalidations[procedure]; ok {
+			t.Errorf("%s is both cached and a write", procedure)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// AdminInterceptor restricts the Admin* RPCs, and the older admin RPCs listed
+// in adminProcedures, to users with the admin role, so a new admin RPC is
+// protected even if its handler forgets to check
+func AdminInterceptor() connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if isAdminProcedure(req.Spec().Procedure) {
+				user := auth.UserFromContext(ctx)
+				if user == nil {
+					return nil, localizedError(ctx, connect.CodeUnauthenticated, "error.not_authenticated")
+				}
+				if !user.IsAdmin() {
+					return nil, localizedError(ctx, connect.CodePermissionDenied, "error.admin_only")
+				}
+			}
+			return next(ctx, req)
+		}
+	})
+}
+
+// adminProcedures are the admin-only RPCs named before the Admin prefix was
+// the convention
+var adminProcedures = map[string]bool{
+	stockcheckerv1connect.StockCheckerServiceSetMsrpProcedure:              true,
+	stockcheckerv1connect.StockCheckerServiceSimulateWatcherCycleProcedure: true,
+	stockcheckerv1connect.StockCheckerServiceListSightingsProcedure:        true,
+	stockcheckerv1connect.StockCheckerServiceGetSightingPhotoProcedure:     true,
+	stockcheckerv1connect.StockCheckerServiceModerateSightingProcedure:     true,
+}
+
+// isAdminProcedure reports whether a procedure ("/package.Service/Method") is
+// an Admin* RPC or one of adminProcedures
+func isAdminProcedure(procedure string) bool {
+	_, method, _ := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return strings.HasPrefix(method, "Admin") || adminProcedures[procedure]
+}
+
+// adminUser returns the authenticated user if they're an admin
+func (h *StockCheckerHandler) adminUser(ctx context.Context) (*database.User, error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsAdmin() {
+		return nil, localizedError(ctx, connect.CodePermissionDenied, "error.admin_only")
+	}
+	return user, nil
+}
+
+// roleToProto converts a user's role to its protobuf enum
+func roleToProto(r database.Role) stockcheckerv1.UserRole {
+	if r == database.RoleAdmin {
+		return stockcheckerv1.UserRole_USER_ROLE_ADMIN
+	}
+	return stockcheckerv1.UserRole_USER_ROLE_USER
+}
+
+// AdminSetUserRole promotes a user to admin or demotes them (admin only).
+// Admins can't demote themselves, so there's always one left.
+func (h *StockCheckerHandler) AdminSetUserRole(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.AdminSetUserRoleRequest],
+) (*connect.Response[stockcheckerv1.AdminSetUserRoleResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var role database.Role
+	switch req.Msg.Role {
+	case stockcheckerv1.UserRole_USER_ROLE_USER:
+		role = database.RoleUser
+	case stockcheckerv1.UserRole_USER_ROLE_ADMIN:
+		role = database.RoleAdmin
+	default:
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.role_required")
+	}
+	if int(req.Msg.UserId) == user.ID && role != database.RoleAdmin {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.cannot_demote_self")
+	}
+
+	found, err := h.db.SetUserRole(ctx, int(req.Msg.UserId), role)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.user_not_found", req.Msg.UserId)
+	}
+	updated, err := h.db.GetUserByID(ctx, int(req.Msg.UserId))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.AdminSetUserRoleResponse{
+		User: userToProto(updated),
+	}), nil
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+func TestIsAdminProcedure(t *testing.T) {
+	tests := map[string]bool{
+		stockcheckerv1connect.StockCheckerServiceAdminListUsersProcedure:       true,
+		stockcheckerv1connect.StockCheckerServiceAdminAddAllowedEmailProcedure: true,
+		stockcheckerv1connect.StockCheckerServiceGetCurrentUserProcedure:       false,
+		"/stockchecker.v1.AdminService/ListThings":                             false, // only methods count
+	}
+	for procedure, want := range tests {
+		if got := isAdminProcedure(procedure); got != want {
+			t.Errorf("isAdminProcedure(%q) = %v, want %v", procedure, got, want)
+		}
+	}
+}
+
+func TestAdminInterceptor(t *testing.T) {
+	called := false
+	next := connect.UnaryFunc(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		called = true
+		return connect.NewResponse(&stockcheckerv1.AdminListUsersResponse{}), nil
+	})
+	call := AdminInterceptor().WrapUnary(next)
+
+	tests := []struct {
+		name string
+		user *database.User
+		want connect.Code // 0 if allowed
+	}{
+		{"anonymous", nil, connect.CodeUnauthenticated},
+		{"user", &database.User{ID: 1, Role: database.RoleUser}, connect.CodePermissionDenied},
+		{"admin", &database.User{ID: 2, Role: database.RoleAdmin}, 0},
+	}
+	for _, tt := range tests {
+		called = false
+		ctx := context.Background()
+		if tt.user != nil {
+			ctx = auth.WithUser(ctx, tt.user)
+		}
+		req := connect.NewRequest(&stockcheckerv1.AdminListUsersRequest{})
+		_, err := call(ctx, &procedureRequest{req, stockcheckerv1connect.StockCheckerServiceAdminListUsersProcedure})
+		if got := connect.CodeOf(err); err != nil && got != tt.want || err == nil && tt.want != 0 {
+			t.Errorf("%s: err = %v, want code %v", tt.name, err, tt.want)
+		}
+		if called != (tt.want == 0) {
+			t.Errorf("%s: handler called = %v", tt.name, called)
+		}
+	}
+}
+
+// procedureRequest is a request for a given procedure, as the server would route it
+type procedureRequest struct {
+	*connect.Request[stockcheckerv1.AdminListUsersRequest]
+	procedure string
+}
+
+func (r *procedureRequest) Spec() connect.Spec {
+	return connect.Spec{Procedure: r.procedure, StreamType: connect.StreamTypeUnary}
+}
+
+func TestAdminRPCsRejectNonAdmins(t *testing.T) {
+	h := NewStockCheckerHandler(nil, nil, nil, nil)
+	ctx := auth.WithUser(context.Background(), &database.User{ID: 1, Role: database.RoleUser})
+	interceptor := AdminInterceptor().WrapUnary(func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, nil
+	})
+
+	// The proto marks every admin RPC, whatever its name
+	proto, err := os.ReadFile(filepath.Join("..", "..", "..", "proto", "stockchecker", "v1", "service.proto"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminOnly := make(map[string]bool)
+	for _, m := range regexp.MustCompile(`\(admin only\)\n\s*rpc (\w+)\(`).FindAllStringSubmatch(string(proto), -1) {
+		adminOnly[m[1]] = true
+	}
+
+	service := stockcheckerv1.File_stockchecker_v1_service_proto.Services().ByName("StockCheckerService")
+	methods := service.Methods()
+	var checked int
+	for i := range methods.Len() {
+		name := string(methods.Get(i).Name())
+		procedure := "/" + string(service.FullName()) + "/" + name
+		if got := isAdminProcedure(procedure); got != adminOnly[name] {
+			t.Errorf("isAdminProcedure(%s) = %v, but the proto says admin only = %v", name, got, adminOnly[name])
+		}
+		if !adminOnly[name] {
+			continue
+		}
+		checked++
+
+		// The interceptor turns them away before the handler runs...
+		_, err := interceptor(ctx, &procedureRequest{connect.NewRequest(&stockcheckerv1.AdminListUsersRequest{}), procedure})
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("%s through the interceptor: err = %v, want permission denied", name, err)
+		}
+
+		// ...and the handler checks too, in case it's served without it
+		method := reflect.ValueOf(h).MethodByName(name)
+		if !method.IsValid() || method.Type().NumIn() != 2 {
+			t.Errorf("%s: no unary handler method", name)
+			continue
+		}
+		req := reflect.New(method.Type().In(1).Elem())
+		msg := req.Elem().FieldByName("Msg")
+		msg.Set(reflect.New(msg.Type().Elem()))
+		if code := callAdminHandler(method, ctx, req); code != connect.CodePermissionDenied {
+			t.Errorf("%s called directly: code %v, want permission denied", name, code)
+		}
+	}
+	if checked == 0 {
+		t.Fatal("found no admin RPCs")
+	}
+}
+
+// callAdminHandler calls a handler method with req and returns the code of
+// its error, treating a panic (it went on to use the nil database) as unknown
+func callAdminHandler(method reflect.Value, ctx context.Context, req reflect.Value) (code connect.Code) {
+	defer func() {
+		if recover() != nil {
+			code = connect.CodeUnknown
+		}
+	}()
+	out := method.Call([]reflect.Value{reflect.ValueOf(ctx), req})
+	err, _ := out[1].Interface().(error)
+	if err == nil {
+		return 0
+	}
+	return connect.CodeOf(err)
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// sessionToProto converts a session to its protobuf message, leaving out the token
+func sessionToProto(s database.Session, currentID int) *stockcheckerv1.Session {
+	return &stockcheckerv1.Session{
+		Id:         int32(s.ID),
+		UserAgent:  s.UserAgent,
+		IpAddress:  s.IPAddress,
+		CreatedAt:  timestamp(s.CreatedAt),
+		LastSeenAt: timestamp(s.LastSeenAt),
+		ExpiresAt:  timestamp(s.ExpiresAt),
+		Current:    s.ID == currentID,
+	}
+}
+
+// ListSessions lists the browsers the user is signed in on
+func (h *StockCheckerHandler) ListSessions(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ListSessionsRequest],
+) (*connect.Response[stockcheckerv1.ListSessionsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.db.GetUserSessions(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	currentID := auth.SessionIDFromContext(ctx)
+	pbSessions := make([]*stockcheckerv1.Session, 0, len(sessions))
+	for _, s := range sessions {
+		pbSessions = append(pbSessions, sessionToProto(s, currentID))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ListSessionsResponse{
+		Sessions: pbSessions,
+	}), nil
+}
+
+// RevokeSession signs out one of the user's sessions, or every session when
+// logging out everywhere. Revoking the current session signs the caller out.
+func (h *StockCheckerHandler) RevokeSession(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.RevokeSessionRequest],
+) (*connect.Response[stockcheckerv1.RevokeSessionResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.All {
+		revoked, err := h.db.DeleteUserSessions(ctx, user.ID)
+		if err != nil {
+			return nil, h.dbError(err)
+		}
+		h.endStreams(user.ID, 0)
+		return connect.NewResponse(&stockcheckerv1.RevokeSessionResponse{Revoked: int32(revoked)}), nil
+	}
+
+	found, err := h.db.DeleteUserSession(ctx, user.ID, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.session_not_found", req.Msg.Id)
+	}
+	h.endStreams(user.ID, int(req.Msg.Id))
+
+	return connect.NewResponse(&stockcheckerv1.RevokeSessionResponse{Revoked: 1}), nil
+}
+
+// endStreams ends the live stock streams of a revoked session, or of every
+// session of the user if sessionID is 0, since they'd otherwise outlive it
+func (h *StockCheckerHandler) endStreams(userID, sessionID int) {
+	if h.streams != nil {
+		h.streams.Revoke(userID, sessionID)
+	}
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+)
+
+// productTypes maps parsed product types to their enum
+var productTypes = map[tcg.ProductType]stockcheckerv1.ProductType{
+	tcg.TypeEliteTrainerBox: stockcheckerv1.ProductType_PRODUCT_TYPE_ELITE_TRAINER_BOX,
+	tcg.TypeBoosterBundle:   stockcheckerv1.ProductType_PRODUCT_TYPE_BOOSTER_BUNDLE,
+	tcg.TypeBoosterBox:      stockcheckerv1.ProductType_PRODUCT_TYPE_BOOSTER_BOX,
+	tcg.TypeBoosterPack:     stockcheckerv1.ProductType_PRODUCT_TYPE_BOOSTER_PACK,
+	tcg.TypeTin:             stockcheckerv1.ProductType_PRODUCT_TYPE_TIN,
+	tcg.TypeCollection:      stockcheckerv1.ProductType_PRODUCT_TYPE_COLLECTION,
+	tcg.TypeBlister:         stockcheckerv1.ProductType_PRODUCT_TYPE_BLISTER,
+}
+
+// setWatch converts a set watch to proto
+func setWatch(w database.SetWatch) *stockcheckerv1.SetWatch {
+	return &stockcheckerv1.SetWatch{
+		SetName:   w.SetName,
+		CreatedAt: timestamp(w.CreatedAt),
+	}
+}
+
+// GetMySetWatches returns the sets the user watches
+func (h *StockCheckerHandler) GetMySetWatches(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMySetWatchesRequest],
+) (*connect.Response[stockcheckerv1.GetMySetWatchesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	watches, err := h.db.GetUserSetWatches(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbWatches := make([]*stockcheckerv1.SetWatch, 0, len(watches))
+	for _, w := range watches {
+		pb := setWatch(w)
+		pb.TcgSet = h.lookUpTCGSet(ctx, w.SetName)
+		pbWatches = append(pbWatches, pb)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetMySetWatchesResponse{
+		SetWatches: pbWatches,
+	}), nil
+}
+
+// WatchSet watches a set and saves the products from it Best Buy lists now.
+// The poller saves ones listed later.
+func (h *StockCheckerHandler) WatchSet(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.WatchSetRequest],
+) (*connect.Response[stockcheckerv1.WatchSetResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	setName := strings.TrimSpace(req.Msg.SetName)
+	if setName == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.set_name_required")
+	}
+
+	if err := h.db.AddSetWatch(ctx, user.ID, setName); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	resp := &stockcheckerv1.WatchSetResponse{
+		SetWatch: &stockcheckerv1.SetWatch{SetName: setName, TcgSet: h.lookUpTCGSet(ctx, setName)},
+	}
+
+	// The watch is saved either way; the poller catches up if browsing fails
+	products, err := h.bbClient.BrowsePokemonProducts(ctx, bestbuy.BrowseOptions{AllPages: true})
+	if err != nil {
+		log.Printf("Failed to browse products for set %q: %v", setName, err)
+		return connect.NewResponse(resp), nil
+	}
+	for _, product := range poller.SetProducts(products, setName) {
+		added, err := h.db.AddSetProduct(ctx, user.ID, product)
+		if err != nil {
+			return nil, h.dbError(err)
+		}
+		if added {
+			resp.AddedProducts = append(resp.AddedProducts, savedProduct(product))
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// UnwatchSet stops watching a set. Products saved from it stay saved.
+func (h *StockCheckerHandler) UnwatchSet(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.UnwatchSetRequest],
+) (*connect.Response[stockcheckerv1.UnwatchSetResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := h.db.RemoveSetWatch(ctx, user.ID, req.Msg.SetName)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !removed {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.set_not_watched", req.Msg.SetName)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.UnwatchSetResponse{}), nil
+}
@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+	"github.com/tmcauley/stock-checker/backend/internal/reliability"
+)
+
+// maxSightingPhotoBytes caps the size of a sighting's photo
+const maxSightingPhotoBytes = 2 << 20
+
+// Sighting submission limits per user, so one account can't flood the moderation queue
+const (
+	sightingsPerHour = 5
+	sightingsPerDay  = 20
+)
+
+// sightingDeliveryTimeout bounds alerting watchers of an approved sighting
+const sightingDeliveryTimeout = time.Minute
+
+// sightingPhotoTypes are the image types accepted for sighting photos
+var sightingPhotoTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// sightingStatuses maps sighting statuses to their enum
+var sightingStatuses = map[string]stockcheckerv1.SightingStatus{
+	database.SightingPending:   stockcheckerv1.SightingStatus_SIGHTING_STATUS_PENDING,
+	database.SightingConfirmed: stockcheckerv1.SightingStatus_SIGHTING_STATUS_CONFIRMED,
+	database.SightingRejected:  stockcheckerv1.SightingStatus_SIGHTING_STATUS_REJECTED,
+}
+
+// sightingStatusName maps a sighting status enum back to the stored status,
+// empty for unspecified
+func sightingStatusName(s stockcheckerv1.SightingStatus) string {
+	for name, pb := range sightingStatuses {
+		if pb == s {
+			return name
+		}
+	}
+	return ""
+}
+
+// sightingToProto converts a sighting to its protobuf message
+func sightingToProto(s database.Sighting) *stockcheckerv1.Sighting {
+	return &stockcheckerv1.Sighting{
+		Id:          int32(s.ID),
+		Sku:         s.SKU,
+		ProductName: s.ProductName,
+		StoreId:     s.StoreID,
+		StoreName:   s.StoreName,
+		Quantity:    int32(s.Quantity),
+		HasPhoto:    s.HasPhoto,
+		Status:      sightingStatuses[s.Status],
+		CreatedAt:   timestamp(s.CreatedAt),
+		ModeratedAt: timestamp(s.ModeratedAt),
+	}
+}
+
+// reporterMuted reports whether a user's recent sightings were wrong often
+// enough to stop taking their reports. It lifts on its own as rejections age
+// out of the scoring window.
+func reporterMuted(t reliability.Tally) bool {
+	return t.Level() == reliability.Low
+}
+
+// checkSightingAbuse refuses a report from a muted or over-limit reporter
+func (h *StockCheckerHandler) checkSightingAbuse(ctx context.Context, userID int) error {
+	tallies, err := h.db.GetReporterTallies(ctx, []int{userID})
+	if err != nil {
+		return h.dbError(err)
+	}
+	if reporterMuted(tallies[userID]) {
+		return localizedError(ctx, connect.CodePermissionDenied, "error.sighting_reporter_muted", reliability.WindowDays)
+	}
+
+	hour, day, err := h.db.CountUserSightings(ctx, userID)
+	if err != nil {
+		return h.dbError(err)
+	}
+	if hour >= sightingsPerHour {
+		return localizedError(ctx, connect.CodeResourceExhausted, "error.sighting_hourly_limit", sightingsPerHour)
+	}
+	if day >= sightingsPerDay {
+		return localizedError(ctx, connect.CodeResourceExhausted, "error.sighting_daily_limit", sightingsPerDay)
+	}
+	return nil
+}
+
+// ReportSighting reports stock seen on a store's shelf, for moderation
+func (h *StockCheckerHandler) ReportSighting(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ReportSightingRequest],
+) (*connect.Response[stockcheckerv1.ReportSightingResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Sku == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+	if req.Msg.StoreId == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.store_required")
+	}
+	if req.Msg.Quantity < 0 {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_quantity")
+	}
+
+	// The content type is sniffed rather than trusted from the client
+	var contentType string
+	if len(req.Msg.Photo) > 0 {
+		if len(req.Msg.Photo) > maxSightingPhotoBytes {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.photo_too_large", maxSightingPhotoBytes>>20)
+		}
+		contentType = http.DetectContentType(req.Msg.Photo)
+		if !sightingPhotoTypes[contentType] {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_photo")
+		}
+	}
+
+	// Checked before the product lookup so throttled reports don't spend API quota
+	if err := h.checkSightingAbuse(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	product, err := h.bbClient.GetProductBySKU(ctx, req.Msg.Sku)
+	if err != nil {
+		log.Printf("Error getting product %s: %v", req.Msg.Sku, err)
+		return nil, bestBuyError(ctx, err)
+	}
+
+	sighting := database.Sighting{
+		UserID:           user.ID,
+		SKU:              req.Msg.Sku,
+		ProductName:      product.Name,
+		StoreID:          req.Msg.StoreId,
+		StoreName:        strings.TrimSpace(req.Msg.StoreName),
+		Quantity:         int(req.Msg.Quantity),
+		HasPhoto:         contentType != "",
+		PhotoContentType: contentType,
+		Status:           database.SightingPending,
+		CreatedAt:        time.Now(),
+	}
+	sighting.ID, err = h.db.AddSighting(ctx, sighting, req.Msg.Photo)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ReportSightingResponse{
+		Sighting: sightingToProto(sighting),
+	}), nil
+}
+
+// ListSightings lists reported sightings for moderation (admin only)
+func (h *StockCheckerHandler) ListSightings(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ListSightingsRequest],
+) (*connect.Response[stockcheckerv1.ListSightingsResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	sightings, err := h.db.GetSightings(ctx, sightingStatusName(req.Msg.Status))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	page, next, err := paginate(ctx, sightings, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reporters' track records help moderators weigh their sightings
+	reporterIDs := make([]int, 0, len(page))
+	for _, s := range page {
+		reporterIDs = append(reporterIDs, s.UserID)
+	}
+	tallies, err := h.db.GetReporterTallies(ctx, reporterIDs)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	pbSightings := make([]*stockcheckerv1.Sighting, 0, len(page))
+	for _, s := range page {
+		pb := sightingToProto(s)
+		pb.ReporterScore = tallies[s.UserID].Score()
+		pb.ReporterMuted = reporterMuted(tallies[s.UserID])
+		pbSightings = append(pbSightings, pb)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ListSightingsResponse{
+		Sightings:     pbSightings,
+		NextPageToken: next,
+	}), nil
+}
+
+// GetSightingPhoto returns a sighting's photo (admin only)
+func (h *StockCheckerHandler) GetSightingPhoto(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetSightingPhotoRequest],
+) (*connect.Response[stockcheckerv1.GetSightingPhotoResponse], error) {
+	if _, err := h.adminUser(ctx); err != nil {
+		return nil, err
+	}
+
+	photo, contentType, err := h.db.GetSightingPhoto(ctx, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if photo == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.sighting_photo_not_found", req.Msg.Id)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.GetSightingPhotoResponse{
+		Photo:       photo,
+		ContentType: contentType,
+	}), nil
+}
+
+// ModerateSighting approves or rejects a pending sighting. Approving it
+// alerts the users watching the product at the store.
+func (h *StockCheckerHandler) ModerateSighting(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ModerateSightingRequest],
+) (*connect.Response[stockcheckerv1.ModerateSightingResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := database.SightingRejected
+	if req.Msg.Approve {
+		status = database.SightingConfirmed
+	}
+	pending, err := h.db.ModerateSighting(ctx, int(req.Msg.Id), status, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	sighting, err := h.db.GetSighting(ctx, int(req.Msg.Id))
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if sighting == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.sighting_not_found", req.Msg.Id)
+	}
+	if !pending {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.sighting_already_moderated", req.Msg.Id)
+	}
+
+	resp := &stockcheckerv1.ModerateSightingResponse{Sighting: sightingToProto(*sighting)}
+	if req.Msg.Approve {
+		// The sighting is confirmed either way; alerts that fail are only logged
+		deliverCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sightingDeliveryTimeout)
+		defer cancel()
+		sent, err := poller.NewNotificationSink(h.db).DeliverSighting(deliverCtx, *sighting)
+		if err != nil {
+			log.Printf("Error alerting watchers of sighting %d: %v", sighting.ID, err)
+		}
+		resp.AlertsSent = int32(sent)
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/reliability"
+)
+
+func TestReporterMuted(t *testing.T) {
+	tests := []struct {
+		tally reliability.Tally
+		want  bool
+	}{
+		{reliability.Tally{}, false},                   // new reporter
+		{reliability.Tally{Found: 0, Total: 2}, false}, // too few to judge
+		{reliability.Tally{Found: 0, Total: 3}, true},
+		{reliability.Tally{Found: 1, Total: 4}, true},
+		{reliability.Tally{Found: 1, Total: 3}, false}, // hit and miss is still useful
+		{reliability.Tally{Found: 5, Total: 6}, false},
+	}
+	for _, tt := range tests {
+		if got := reporterMuted(tt.tally); got != tt.want {
+			t.Errorf("%+v: muted = %v, want %v", tt.tally, got, tt.want)
+		}
+	}
+}
@@ -2,29 +2,116 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
+	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
 	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1/stockcheckerv1connect"
 	"github.com/tmcauley/stock-checker/backend/internal/auth"
 	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/credentials"
 	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/input"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/pokemontcg"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"github.com/tmcauley/stock-checker/backend/internal/stream"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+	"github.com/tmcauley/stock-checker/backend/internal/usage"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// DefaultCheckConcurrency is how many SKUs CheckStock checks at once unless configured
+const DefaultCheckConcurrency = 4
+
 // StockCheckerHandler implements the StockCheckerService
 type StockCheckerHandler struct {
 	stockcheckerv1connect.UnimplementedStockCheckerServiceHandler
-	bbClient bestbuy.Client
-	db       *database.DB
+	bbClient       bestbuy.Client
+	db             *database.DB
+	admin          *notify.AdminNotifier
+	watcher        *poller.Poller
+	maintenance    *Maintenance       // read-only mode; nil when never enabled
+	tcgSets        *pokemontcg.Sets   // set details; nil if disabled
+	msrps          *tcg.MSRPs         // nil without a database
+	domain         bestbuy.Domain     // the kind of product the deployment tracks
+	announcement   string             // banner shown in the web app; empty for none
+	loginProviders []*auth.Provider   // offered on the login page; empty without auth
+	emailLogin     bool               // magic-link sign-in is offered
+	creds          *credentials.Store // rotatable retailer keys; nil without CREDENTIALS_KEY
+	inviteURL      string             // where invite links point; empty without login providers
+	streams        *stream.Hub        // live stock updates; nil without a database
+	version        string             // the server's release, reported by GetApiInfo
+	usage          *usage.Recorder    // counts checks users ask for; nil to not count
+	quota          *bestbuy.Quota     // Best Buy's daily quota, reported by GetMyUsage; nil if untracked
+	throttle       *bestbuy.Throttle  // pace of Best Buy calls, reported by GetMyUsage; nil if untracked
+	inboundDomain  string             // domain of users' addresses for forwarded emails; empty if not set up
+
+	checkConcurrency int // SKUs CheckStock checks at once
 }
 
 // NewStockCheckerHandler creates a new StockCheckerHandler
-func NewStockCheckerHandler(bbClient bestbuy.Client, db *database.DB) *StockCheckerHandler {
-	return &StockCheckerHandler{
+func NewStockCheckerHandler(bbClient bestbuy.Client, db *database.DB, admin *notify.AdminNotifier, watcher *poller.Poller) *StockCheckerHandler {
+	h := &StockCheckerHandler{
 		bbClient: bbClient,
 		db:       db,
+		admin:    admin,
+		watcher:  watcher,
+
+		checkConcurrency: DefaultCheckConcurrency,
+	}
+	if db != nil {
+		h.msrps = tcg.NewMSRPs(db)
+	}
+	h.domain, _ = bestbuy.LookupDomain(bestbuy.DefaultDomain)
+	return h
+}
+
+// SetCheckConcurrency sets how many SKUs CheckStock checks at once
+func (h *StockCheckerHandler) SetCheckConcurrency(n int) {
+	if n < 1 {
+		n = 1
+	}
+	h.checkConcurrency = n
+}
+
+// dbError converts a database error to a connect error, counting it towards the admin error-spike alert
+func (h *StockCheckerHandler) dbError(err error) *connect.Error {
+	h.admin.Count(notify.EventDBErrors, err.Error())
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// bestBuyError converts a Best Buy API error to a connect error with a code
+// the client can act on: fix the request, retry later, or give up
+func bestBuyError(ctx context.Context, err error) *connect.Error {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	case errors.Is(err, bestbuy.ErrNotFound):
+		return localizedError(ctx, connect.CodeNotFound, "error.bestbuy_not_found")
+	case errors.Is(err, bestbuy.ErrRestrictedSKU):
+		return localizedError(ctx, connect.CodeFailedPrecondition, "error.bestbuy_restricted")
+	case errors.Is(err, bestbuy.ErrInvalidQuery):
+		return localizedError(ctx, connect.CodeInvalidArgument, "error.bestbuy_invalid_query")
+	case errors.Is(err, bestbuy.ErrQuotaExceeded):
+		return localizedError(ctx, connect.CodeResourceExhausted, "error.bestbuy_quota_exceeded")
+	case errors.Is(err, bestbuy.ErrRateLimited):
+		return localizedError(ctx, connect.CodeResourceExhausted, "error.bestbuy_rate_limited")
+	case errors.Is(err, bestbuy.ErrAPIKeyRejected):
+		// The server's key, not the caller, is at fault
+		return localizedError(ctx, connect.CodeUnavailable, "error.bestbuy_unavailable")
+	default:
+		return connect.NewError(connect.CodeInternal, err)
 	}
 }
 
@@ -32,31 +119,88 @@ func NewStockCheckerHandler(bbClient bestbuy.Client, db *database.DB) *StockChec
 func getUserFromContext(ctx context.Context) (*database.User, error) {
 	user := auth.UserFromContext(ctx)
 	if user == nil {
-		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("not authenticated"))
+		return nil, localizedError(ctx, connect.CodeUnauthenticated, "error.not_authenticated")
 	}
 	return user, nil
 }
 
-// SearchStores searches for Best Buy stores near a location
+// localeFromContext returns the user's locale, or the one requested by the browser
+func localeFromContext(ctx context.Context) i18n.Locale {
+	if user := auth.UserFromContext(ctx); user != nil {
+		if locale, ok := i18n.Parse(user.Locale); ok {
+			return locale
+		}
+	}
+	return i18n.FromContext(ctx)
+}
+
+// localizedError creates a connect error with a message in the caller's language
+func localizedError(ctx context.Context, code connect.Code, key string, args ...any) *connect.Error {
+	return connect.NewError(code, errors.New(i18n.T(localeFromContext(ctx), key, args...)))
+}
+
+// salePrice returns a product's price, accepting the deprecated dollar field from older clients
+func salePrice(p *stockcheckerv1.Product) money.Cents {
+	if p.SalePriceCents != 0 {
+		return money.Cents(p.SalePriceCents)
+	}
+	return money.FromDollars(p.SalePrice)
+}
+
+// timestamp converts a time to a Timestamp, leaving zero times unset
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// SearchStores searches for Best Buy stores near a postal code or one of the user's locations
 func (h *StockCheckerHandler) SearchStores(
 	ctx context.Context,
 	req *connect.Request[stockcheckerv1.SearchStoresRequest],
 ) (*connect.Response[stockcheckerv1.SearchStoresResponse], error) {
-	radiusMiles := int(req.Msg.RadiusMiles)
-	if radiusMiles <= 0 {
-		radiusMiles = 25
+	var postalCode string
+	var radiusMiles int
+	if req.Msg.Location != "" {
+		location, err := h.userLocation(ctx, req.Msg.Location)
+		if err != nil {
+			return nil, err
+		}
+		postalCode, radiusMiles = location.PostalCode, location.RadiusMiles
+	} else {
+		var err error
+		postalCode, err = input.NormalizePostalCode(req.Msg.PostalCode)
+		if err != nil {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_postal_code", req.Msg.PostalCode)
+		}
+
+		radiusMiles = int(req.Msg.RadiusMiles)
+		if radiusMiles <= 0 {
+			radiusMiles = defaultRadiusMiles
+		}
 	}
 
-	stores, err := h.bbClient.SearchStores(ctx, req.Msg.PostalCode, radiusMiles)
+	stores, err := h.bbClient.SearchStores(ctx, postalCode, radiusMiles)
 	if err != nil {
 		log.Printf("Error searching stores: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, bestBuyError(ctx, err)
+	}
+
+	// Remember where stores are for rule distance limits, and when they're open
+	hours := storeHours(stores)
+	if h.canWrite() {
+		if err := h.db.SaveStoreCoordinates(ctx, storeCoordinates(stores)); err != nil {
+			log.Printf("Error saving store coordinates: %v", err)
+		}
+		h.saveStoreHours(ctx, hours)
 	}
 
 	// Convert to protobuf messages
+	now := time.Now()
 	pbStores := make([]*stockcheckerv1.Store, 0, len(stores))
 	for _, store := range stores {
-		pbStores = append(pbStores, &stockcheckerv1.Store{
+		pbStore := &stockcheckerv1.Store{
 			StoreId:       fmt.Sprintf("%d", store.StoreID),
 			Name:          store.Name,
 			Address:       store.Address,
@@ -65,7 +209,9 @@ func (h *StockCheckerHandler) SearchStores(
 			PostalCode:    store.PostalCode,
 			Phone:         store.Phone,
 			DistanceMiles: store.Distance,
-		})
+		}
+		setOpenNow(pbStore, hours[pbStore.StoreId], now)
+		pbStores = append(pbStores, pbStore)
 	}
 
 	return connect.NewResponse(&stockcheckerv1.SearchStoresResponse{
@@ -78,30 +224,45 @@ func (h *StockCheckerHandler) SearchProducts(
 	ctx context.Context,
 	req *connect.Request[stockcheckerv1.SearchProductsRequest],
 ) (*connect.Response[stockcheckerv1.SearchProductsResponse], error) {
-	products, err := h.bbClient.SearchProducts(ctx, req.Msg.Query, req.Msg.Category)
+	// Pasted product URLs and UPCs search by the SKU or UPC they contain
+	query := req.Msg.Query
+	if ref, err := input.ParseProductRef(query); err == nil {
+		query = ref.Value
+	}
+
+	// Search page tokens encode the 0-based page after the one returned
+	page, err := decodePageToken(ctx, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := h.bbClient.SearchProducts(ctx, query, req.Msg.Category, bestbuy.PageRequest{
+		Page: page + 1,
+		Size: int(req.Msg.PageSize),
+	})
 	if err != nil {
 		log.Printf("Error searching products: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, bestBuyError(ctx, err)
 	}
 
 	// Convert to protobuf messages
-	pbProducts := make([]*stockcheckerv1.Product, 0, len(products))
-	for _, product := range products {
-		pbProducts = append(pbProducts, &stockcheckerv1.Product{
-			Sku:          fmt.Sprintf("%d", product.SKU),
-			Name:         product.Name,
-			SalePrice:    product.SalePrice,
-			ThumbnailUrl: product.ThumbnailImage,
-			ProductUrl:   product.URL,
-		})
+	pbProducts := make([]*stockcheckerv1.Product, 0, len(result.Products))
+	for _, product := range result.Products {
+		pbProducts = append(pbProducts, h.listedProduct(ctx, product))
 	}
 
-	return connect.NewResponse(&stockcheckerv1.SearchProductsResponse{
-		Products: pbProducts,
-	}), nil
+	resp := &stockcheckerv1.SearchProductsResponse{
+		Products:  pbProducts,
+		TotalSize: int32(result.Total),
+	}
+	if result.HasMore() {
+		resp.NextPageToken = encodePageToken(result.Page)
+	}
+	return connect.NewResponse(resp), nil
 }
 
-// CheckStock checks inventory for products using postal code search
+// CheckStock checks inventory for products using postal code search. With a
+// location, stores outside the location's radius are left out.
 func (h *StockCheckerHandler) CheckStock(
 	ctx context.Context,
 	req *connect.Request[stockcheckerv1.CheckStockRequest],
@@ -109,6 +270,15 @@ func (h *StockCheckerHandler) CheckStock(
 	myStoreIDs := req.Msg.StoreIds // User's saved stores (for highlighting)
 	skus := req.Msg.Skus
 	postalCode := req.Msg.PostalCode
+	maxDistance := float64(maxRadiusMiles)
+
+	if req.Msg.Location != "" {
+		location, err := h.userLocation(ctx, req.Msg.Location)
+		if err != nil {
+			return nil, err
+		}
+		postalCode, maxDistance = location.PostalCode, float64(location.RadiusMiles)
+	}
 
 	if postalCode == "" || len(skus) == 0 {
 		return connect.NewResponse(&stockcheckerv1.CheckStockResponse{
@@ -116,60 +286,163 @@ func (h *StockCheckerHandler) CheckStock(
 		}), nil
 	}
 
+	postalCode, err := input.NormalizePostalCode(postalCode)
+	if err != nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_postal_code", req.Msg.PostalCode)
+	}
+	h.countChecks(ctx, len(skus))
+
 	// Build a set of user's saved store IDs for quick lookup
 	myStoresSet := make(map[string]bool)
 	for _, id := range myStoreIDs {
 		myStoresSet[id] = true
 	}
 
-	// Check availability for each SKU
-	var results []*stockcheckerv1.StockStatus
-
-	for _, sku := range skus {
-		// Get product info
-		product, err := h.bbClient.GetProductBySKU(ctx, sku)
-		if err != nil {
-			log.Printf("Error getting product %s: %v", sku, err)
-			continue
-		}
-
-		// Check availability using postal code (returns ALL stores with stock)
-		availability, err := h.bbClient.CheckAvailability(ctx, sku, postalCode)
-		if err != nil {
-			log.Printf("Error checking availability for %s: %v", sku, err)
+	// Check SKUs in parallel; the client's rate limiter still paces the
+	// requests, but their latencies overlap instead of adding up
+	checks := make([]skuCheck, len(skus))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(h.checkConcurrency, len(skus)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				checks[i] = h.checkSKU(ctx, skus[i], postalCode, myStoreIDs, myStoresSet, maxDistance)
+			}
+		}()
+	}
+	for i := range skus {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	results := []*stockcheckerv1.StockStatus{}
+	var skuErrors []*stockcheckerv1.SkuError
+	var history []database.StockHistoryEntry
+	for i, c := range checks {
+		if c.err != nil {
+			skuErrors = append(skuErrors, skuError(ctx, skus[i], c.err))
 			continue
 		}
+		results = append(results, c.results...)
+		history = append(history, c.history...)
+	}
 
-		// Convert to StockStatus, flagging user's saved stores
-		for _, avail := range availability {
-			isMyStore := myStoresSet[avail.StoreID]
-
-			results = append(results, &stockcheckerv1.StockStatus{
-				Store: &stockcheckerv1.Store{
-					StoreId:       avail.StoreID,
-					Name:          avail.StoreName,
-					City:          avail.City,
-					State:         avail.State,
-					DistanceMiles: avail.Distance,
-				},
-				Product: &stockcheckerv1.Product{
-					Sku:       fmt.Sprintf("%d", product.SKU),
-					Name:      product.Name,
-					SalePrice: product.SalePrice,
-				},
-				InStock:        avail.InStock,
-				LowStock:       avail.LowStock,
-				PickupEligible: avail.PickupEligible,
-				IsMyStore:      isMyStore,
-			})
+	if h.canWrite() {
+		if err := h.db.RecordStockHistory(ctx, history); err != nil {
+			log.Printf("Error recording stock history: %v", err)
 		}
 	}
 
 	return connect.NewResponse(&stockcheckerv1.CheckStockResponse{
 		Results: results,
+		Errors:  skuErrors,
 	}), nil
 }
 
+// skuCheck is the outcome of checking one SKU for CheckStock
+type skuCheck struct {
+	results []*stockcheckerv1.StockStatus
+	history []database.StockHistoryEntry
+	err     error
+}
+
+// skuError describes why a SKU couldn't be checked, so clients can tell a
+// failed check from a product that's out of stock
+func skuError(ctx context.Context, sku string, err error) *stockcheckerv1.SkuError {
+	var rateErr *bestbuy.RateLimitError
+
+	e := &stockcheckerv1.SkuError{Sku: sku}
+	switch {
+	case errors.Is(err, bestbuy.ErrNotFound):
+		e.Code, e.Message = stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_NOT_FOUND, "error.sku_not_found"
+	case errors.Is(err, bestbuy.ErrRestrictedSKU):
+		e.Code, e.Message = stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_RESTRICTED, "error.sku_restricted"
+	case errors.As(err, &rateErr):
+		e.Code, e.Message = stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_RATE_LIMITED, "error.sku_rate_limited"
+		e.RetryAfterSeconds = int32(math.Ceil(rateErr.RetryAfter.Seconds()))
+	case errors.Is(err, bestbuy.ErrQuotaExceeded):
+		e.Code, e.Message = stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_QUOTA_EXCEEDED, "error.sku_quota_exceeded"
+	case errors.Is(err, bestbuy.ErrAPIKeyRejected):
+		e.Code, e.Message = stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_API_KEY, "error.sku_api_key"
+	default:
+		e.Code, e.Message = stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_UNAVAILABLE, "error.sku_unavailable"
+	}
+	e.Message = i18n.T(localeFromContext(ctx), e.Message, sku)
+	return e
+}
+
+// checkSKU checks one SKU near a postal code, flagging the user's saved stores
+// and leaving out stores further than maxDistance
+func (h *StockCheckerHandler) checkSKU(ctx context.Context, sku, postalCode string, myStoreIDs []string, myStoresSet map[string]bool, maxDistance float64) skuCheck {
+	// Get product info
+	product, err := h.bbClient.GetProductBySKU(ctx, sku)
+	if err != nil {
+		log.Printf("Error getting product %s: %v", sku, err)
+		return skuCheck{err: err}
+	}
+
+	// Check availability using postal code (returns ALL stores with stock)
+	availability, err := h.bbClient.CheckAvailability(ctx, sku, postalCode)
+	if err != nil {
+		log.Printf("Error checking availability for %s: %v", sku, err)
+		return skuCheck{err: err}
+	}
+	checkedAt := timestamppb.Now()
+
+	// Saved stores missing from the results have no stock
+	skuStr := fmt.Sprintf("%d", product.SKU)
+	seen := make(map[string]bool)
+
+	// Convert to StockStatus, flagging user's saved stores
+	var c skuCheck
+	for _, avail := range availability {
+		seen[avail.StoreID] = true
+		c.history = append(c.history, database.StockHistoryEntry{
+			SKU:       skuStr,
+			StoreID:   avail.StoreID,
+			InStock:   avail.InStock,
+			LowStock:  avail.LowStock,
+			CheckedAt: checkedAt.AsTime(),
+		})
+
+		if avail.Distance > maxDistance {
+			continue
+		}
+		c.results = append(c.results, &stockcheckerv1.StockStatus{
+			Store: &stockcheckerv1.Store{
+				StoreId:       avail.StoreID,
+				Name:          avail.StoreName,
+				City:          avail.City,
+				State:         avail.State,
+				DistanceMiles: avail.Distance,
+			},
+			Product: &stockcheckerv1.Product{
+				Sku:            skuStr,
+				Name:           product.Name,
+				SalePrice:      product.SalePrice.Dollars(),
+				SalePriceCents: int64(product.SalePrice),
+				CurrencyCode:   product.Currency,
+				Retailer:       stockcheckerv1.Retailer_RETAILER_BEST_BUY,
+			},
+			InStock:        avail.InStock,
+			LowStock:       avail.LowStock,
+			PickupEligible: avail.PickupEligible,
+			IsMyStore:      myStoresSet[avail.StoreID],
+			CheckedAt:      checkedAt,
+		})
+	}
+
+	for _, id := range myStoreIDs {
+		if !seen[id] {
+			c.history = append(c.history, database.StockHistoryEntry{SKU: skuStr, StoreID: id, CheckedAt: checkedAt.AsTime()})
+		}
+	}
+	return c
+}
+
 // GetCurrentUser returns the currently authenticated user
 func (h *StockCheckerHandler) GetCurrentUser(
 	ctx context.Context,
@@ -181,15 +454,47 @@ func (h *StockCheckerHandler) GetCurrentUser(
 	}
 
 	return connect.NewResponse(&stockcheckerv1.GetCurrentUserResponse{
-		User: &stockcheckerv1.User{
-			Id:         int32(user.ID),
-			Email:      user.Email,
-			Name:       user.Name,
-			PictureUrl: user.PictureURL,
-		},
+		User: userToProto(user),
 	}), nil
 }
 
+// SetMyLocale sets the language used for the user's notifications and messages
+func (h *StockCheckerHandler) SetMyLocale(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SetMyLocaleRequest],
+) (*connect.Response[stockcheckerv1.SetMyLocaleResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locale, ok := i18n.Parse(req.Msg.Locale)
+	if !ok {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.unsupported_locale", req.Msg.Locale)
+	}
+
+	if err := h.db.SetUserLocale(ctx, user.ID, string(locale)); err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.SetMyLocaleResponse{}), nil
+}
+
+// savedStore converts a saved store to its protobuf message
+func savedStore(store database.Store) *stockcheckerv1.Store {
+	return &stockcheckerv1.Store{
+		StoreId:    store.StoreID,
+		Name:       store.Name,
+		Address:    store.Address,
+		City:       store.City,
+		State:      store.State,
+		PostalCode: store.PostalCode,
+		Phone:      store.Phone,
+		CreatedAt:  timestamp(store.CreatedAt),
+		UpdatedAt:  timestamp(store.UpdatedAt),
+	}
+}
+
 // GetMyStores returns the user's saved stores
 func (h *StockCheckerHandler) GetMyStores(
 	ctx context.Context,
@@ -200,22 +505,23 @@ func (h *StockCheckerHandler) GetMyStores(
 		return nil, err
 	}
 
-	stores, err := h.db.GetUserStores(ctx, user.ID)
+	stores, err := h.db.GetUserStores(ctx, user.ID, retailer.BestBuy)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, h.dbError(err)
 	}
 
+	storeIDs := make([]string, 0, len(stores))
+	for _, store := range stores {
+		storeIDs = append(storeIDs, store.StoreID)
+	}
+	hours := h.savedStoreHours(ctx, storeIDs)
+
+	now := time.Now()
 	pbStores := make([]*stockcheckerv1.Store, 0, len(stores))
 	for _, store := range stores {
-		pbStores = append(pbStores, &stockcheckerv1.Store{
-			StoreId:    store.StoreID,
-			Name:       store.Name,
-			Address:    store.Address,
-			City:       store.City,
-			State:      store.State,
-			PostalCode: store.PostalCode,
-			Phone:      store.Phone,
-		})
+		pbStore := savedStore(store)
+		setOpenNow(pbStore, hours[store.StoreID], now)
+		pbStores = append(pbStores, pbStore)
 	}
 
 	return connect.NewResponse(&stockcheckerv1.GetMyStoresResponse{
@@ -235,10 +541,11 @@ func (h *StockCheckerHandler) AddMyStore(
 
 	store := req.Msg.Store
 	if store == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("store is required"))
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.store_required")
 	}
 
 	dbStore := database.Store{
+		Retailer:   retailer.BestBuy,
 		StoreID:    store.StoreId,
 		Name:       store.Name,
 		Address:    store.Address,
@@ -249,7 +556,7 @@ func (h *StockCheckerHandler) AddMyStore(
 	}
 
 	if err := h.db.AddUserStore(ctx, user.ID, dbStore); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, h.dbError(err)
 	}
 
 	return connect.NewResponse(&stockcheckerv1.AddMyStoreResponse{}), nil
@@ -265,13 +572,65 @@ func (h *StockCheckerHandler) RemoveMyStore(
 		return nil, err
 	}
 
-	if err := h.db.RemoveUserStore(ctx, user.ID, req.Msg.StoreId); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	if err := h.db.RemoveUserStore(ctx, user.ID, retailer.BestBuy, req.Msg.StoreId); err != nil {
+		return nil, h.dbError(err)
 	}
 
 	return connect.NewResponse(&stockcheckerv1.RemoveMyStoreResponse{}), nil
 }
 
+// savedProduct converts a saved product to its protobuf message
+func savedProduct(product database.Product) *stockcheckerv1.Product {
+	return &stockcheckerv1.Product{
+		Sku:            product.SKU,
+		Name:           product.Name,
+		SalePrice:      product.SalePrice.Dollars(),
+		SalePriceCents: int64(product.SalePrice),
+		ThumbnailUrl:   product.ThumbnailURL,
+		ProductUrl:     product.ProductURL,
+		CreatedAt:      timestamp(product.CreatedAt),
+		UpdatedAt:      timestamp(product.UpdatedAt),
+		SetName:        product.SetName,
+		ProductType:    productTypes[tcg.ProductType(product.ProductType)],
+		Priority:       watchPriorities[product.Priority],
+		Retailer:       retailerV1(product.Retailer),
+	}
+}
+
+// v1Retailers maps adapter IDs to the v1 retailer enum
+var v1Retailers = map[retailer.ID]stockcheckerv1.Retailer{
+	retailer.BestBuy:  stockcheckerv1.Retailer_RETAILER_BEST_BUY,
+	retailer.Walmart:  stockcheckerv1.Retailer_RETAILER_WALMART,
+	retailer.Target:   stockcheckerv1.Retailer_RETAILER_TARGET,
+	retailer.GameStop: stockcheckerv1.Retailer_RETAILER_GAMESTOP,
+	retailer.Costco:   stockcheckerv1.Retailer_RETAILER_COSTCO,
+}
+
+// retailerV1 maps an adapter ID to the v1 retailer enum. Products without
+// one are Best Buy's.
+func retailerV1(id retailer.ID) stockcheckerv1.Retailer {
+	if id == "" {
+		return stockcheckerv1.Retailer_RETAILER_BEST_BUY
+	}
+	return v1Retailers[id]
+}
+
+// watchPriorities maps saved product priorities to their protobuf enum
+var watchPriorities = map[string]stockcheckerv1.WatchPriority{
+	database.PriorityMustHave:   stockcheckerv1.WatchPriority_WATCH_PRIORITY_MUST_HAVE,
+	database.PriorityNiceToHave: stockcheckerv1.WatchPriority_WATCH_PRIORITY_NICE_TO_HAVE,
+}
+
+// watchPriorityName maps a priority enum back to the saved priority
+func watchPriorityName(p stockcheckerv1.WatchPriority) string {
+	for name, pb := range watchPriorities {
+		if pb == p {
+			return name
+		}
+	}
+	return ""
+}
+
 // GetMyProducts returns the user's saved products
 func (h *StockCheckerHandler) GetMyProducts(
 	ctx context.Context,
@@ -282,20 +641,17 @@ func (h *StockCheckerHandler) GetMyProducts(
 		return nil, err
 	}
 
-	products, err := h.db.GetUserProducts(ctx, user.ID)
+	products, err := h.db.GetUserProducts(ctx, user.ID, retailer.BestBuy)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, h.dbError(err)
 	}
 
 	pbProducts := make([]*stockcheckerv1.Product, 0, len(products))
 	for _, product := range products {
-		pbProducts = append(pbProducts, &stockcheckerv1.Product{
-			Sku:          product.SKU,
-			Name:         product.Name,
-			SalePrice:    product.SalePrice,
-			ThumbnailUrl: product.ThumbnailURL,
-			ProductUrl:   product.ProductURL,
-		})
+		if req.Msg.SetName != "" && !tcg.SameSet(product.SetName, req.Msg.SetName) {
+			continue
+		}
+		pbProducts = append(pbProducts, savedProduct(product))
 	}
 
 	return connect.NewResponse(&stockcheckerv1.GetMyProductsResponse{
@@ -315,22 +671,94 @@ func (h *StockCheckerHandler) AddMyProduct(
 
 	product := req.Msg.Product
 	if product == nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("product is required"))
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
 	}
 
 	dbProduct := database.Product{
+		Retailer:     retailer.BestBuy,
 		SKU:          product.Sku,
 		Name:         product.Name,
-		SalePrice:    product.SalePrice,
+		SalePrice:    salePrice(product),
 		ThumbnailURL: product.ThumbnailUrl,
 		ProductURL:   product.ProductUrl,
+		Priority:     watchPriorityName(product.Priority),
+	}
+
+	// Best Buy lists the same item under several SKUs, so warn about saved
+	// products that look like this one
+	h.lookUpIdentifiers(ctx, &dbProduct)
+	saved, err := h.db.GetUserProducts(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
 	}
 
 	if err := h.db.AddUserProduct(ctx, user.ID, dbProduct); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, h.dbError(err)
+	}
+	if err := h.applyPresetRule(ctx, user.ID, dbProduct.SKU); err != nil {
+		return nil, h.dbError(err)
 	}
 
-	return connect.NewResponse(&stockcheckerv1.AddMyProductResponse{}), nil
+	return connect.NewResponse(&stockcheckerv1.AddMyProductResponse{
+		PossibleDuplicates: possibleDuplicates(dbProduct, saved),
+	}), nil
+}
+
+// UpdateMyProduct changes the fields of a saved product named in the update mask
+func (h *StockCheckerHandler) UpdateMyProduct(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.UpdateMyProductRequest],
+) (*connect.Response[stockcheckerv1.UpdateMyProductResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	product := req.Msg.Product
+	if product == nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+	product.SalePriceCents = int64(salePrice(product))
+
+	current, err := h.db.GetUserProduct(ctx, user.ID, retailer.BestBuy, product.Sku)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if current == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.product_not_saved", product.Sku)
+	}
+
+	updated := savedProduct(*current)
+	if err := applyFieldMask(ctx, updated, product, req.Msg.UpdateMask, "name", "sale_price_cents", "thumbnail_url", "product_url", "priority"); err != nil {
+		return nil, err
+	}
+
+	found, err := h.db.UpdateUserProduct(ctx, user.ID, database.Product{
+		SKU:          current.SKU,
+		Name:         updated.Name,
+		SalePrice:    money.Cents(updated.SalePriceCents),
+		ThumbnailURL: updated.ThumbnailUrl,
+		ProductURL:   updated.ProductUrl,
+		Priority:     watchPriorityName(updated.Priority),
+	})
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if !found {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.product_not_saved", product.Sku)
+	}
+
+	saved, err := h.db.GetUserProduct(ctx, user.ID, retailer.BestBuy, current.SKU)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if saved == nil {
+		return nil, localizedError(ctx, connect.CodeNotFound, "error.product_not_saved", product.Sku)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.UpdateMyProductResponse{
+		Product: savedProduct(*saved),
+	}), nil
 }
 
 // RemoveMyProduct removes a product from the user's list
@@ -343,34 +771,78 @@ func (h *StockCheckerHandler) RemoveMyProduct(
 		return nil, err
 	}
 
-	if err := h.db.RemoveUserProduct(ctx, user.ID, req.Msg.Sku); err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+	if err := h.db.RemoveUserProduct(ctx, user.ID, retailer.BestBuy, req.Msg.Sku); err != nil {
+		return nil, h.dbError(err)
 	}
 
 	return connect.NewResponse(&stockcheckerv1.RemoveMyProductResponse{}), nil
 }
 
-// BrowsePokemonProducts returns Pokemon products from Best Buy's trading cards category
+// RemoveMyProducts removes several products from the user's list in one transaction
+func (h *StockCheckerHandler) RemoveMyProducts(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.RemoveMyProductsRequest],
+) (*connect.Response[stockcheckerv1.RemoveMyProductsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Msg.Skus) > input.MaxListItems {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.too_many_items", input.MaxListItems)
+	}
+
+	removed, err := h.db.RemoveUserProducts(ctx, user.ID, retailer.BestBuy, req.Msg.Skus)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.RemoveMyProductsResponse{
+		Removed: int32(removed),
+	}), nil
+}
+
+// ClearWatchlist removes every product, and optionally every store, from the
+// user's list in one transaction
+func (h *StockCheckerHandler) ClearWatchlist(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ClearWatchlistRequest],
+) (*connect.Response[stockcheckerv1.ClearWatchlistResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !req.Msg.Confirm {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.confirm_required")
+	}
+
+	products, stores, err := h.db.ClearWatchlist(ctx, user.ID, req.Msg.IncludeStores)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	log.Printf("User %d cleared their watchlist: %d products, %d stores", user.ID, products, stores)
+
+	return connect.NewResponse(&stockcheckerv1.ClearWatchlistResponse{
+		RemovedProducts: int32(products),
+		RemovedStores:   int32(stores),
+	}), nil
+}
+
+// BrowsePokemonProducts returns the products of the deployment's product
+// domain, Pokemon products from Best Buy's trading cards category by default
 func (h *StockCheckerHandler) BrowsePokemonProducts(
 	ctx context.Context,
 	req *connect.Request[stockcheckerv1.BrowsePokemonProductsRequest],
 ) (*connect.Response[stockcheckerv1.BrowsePokemonProductsResponse], error) {
-	products, err := h.bbClient.BrowsePokemonProducts(ctx)
+	products, err := h.bbClient.BrowsePokemonProducts(ctx, bestbuy.BrowseOptions{AllPages: req.Msg.AllPages})
 	if err != nil {
-		log.Printf("Error browsing Pokemon products: %v", err)
-		return nil, connect.NewError(connect.CodeInternal, err)
+		log.Printf("Error browsing %s products: %v", h.domain.Name, err)
+		return nil, bestBuyError(ctx, err)
 	}
 
 	// Convert to protobuf messages
 	pbProducts := make([]*stockcheckerv1.Product, 0, len(products))
 	for _, product := range products {
-		pbProducts = append(pbProducts, &stockcheckerv1.Product{
-			Sku:          fmt.Sprintf("%d", product.SKU),
-			Name:         product.Name,
-			SalePrice:    product.SalePrice,
-			ThumbnailUrl: product.ThumbnailImage,
-			ProductUrl:   product.URL,
-		})
+		pbProducts = append(pbProducts, h.listedProduct(ctx, product))
 	}
 
 	return connect.NewResponse(&stockcheckerv1.BrowsePokemonProductsResponse{
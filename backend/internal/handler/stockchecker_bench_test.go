@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+)
+
+// BenchmarkCheckStock measures CheckStock with a zero-latency mock client
+func BenchmarkCheckStock(b *testing.B) {
+	ctx := context.Background()
+	client := bestbuy.NewMockClientWithLatency(0)
+	h := NewStockCheckerHandler(client, nil, nil, nil)
+
+	products, err := client.BrowsePokemonProducts(ctx, bestbuy.BrowseOptions{})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, n := range []int{1, 4, len(products)} {
+		skus := make([]string, 0, n)
+		for _, p := range products[:n] {
+			skus = append(skus, fmt.Sprintf("%d", p.SKU))
+		}
+		req := &stockcheckerv1.CheckStockRequest{
+			Skus:       skus,
+			StoreIds:   []string{"1118", "1009"},
+			PostalCode: "94103",
+		}
+
+		b.Run(fmt.Sprintf("skus=%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := h.CheckStock(ctx, connect.NewRequest(req)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
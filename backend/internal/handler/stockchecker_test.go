@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// slowClient answers every call after a delay. It has no product for
+// missingSKU and refuses availability for restrictedSKU.
+type slowClient struct {
+	bestbuy.Client
+	delay         time.Duration
+	missingSKU    string
+	restrictedSKU string
+}
+
+func (c *slowClient) GetProductBySKU(ctx context.Context, sku string) (*bestbuy.Product, error) {
+	time.Sleep(c.delay)
+	if sku == c.missingSKU {
+		return nil, &bestbuy.NotFoundError{Body: "not found"}
+	}
+	n, _ := strconv.Atoi(sku)
+	return &bestbuy.Product{SKU: n, Name: "Product " + sku}, nil
+}
+
+func (c *slowClient) CheckAvailability(ctx context.Context, sku, postalCode string) ([]bestbuy.StoreAvailability, error) {
+	time.Sleep(c.delay)
+	if sku == c.restrictedSKU {
+		return nil, &bestbuy.RestrictedError{SKU: sku, Err: errors.New("API key rejected (status 403)")}
+	}
+	return []bestbuy.StoreAvailability{{StoreID: "281", InStock: true, Distance: 3}}, nil
+}
+
+func TestCheckStockParallelPartialResults(t *testing.T) {
+	const delay = 20 * time.Millisecond
+	client := &slowClient{delay: delay, missingSKU: "6500003", restrictedSKU: "6500006"}
+	h := NewStockCheckerHandler(client, nil, nil, nil)
+	h.SetCheckConcurrency(4)
+
+	skus := []string{"6500001", "6500002", "6500003", "6500004", "6500005", "6500006", "6500007", "6500008"}
+	start := time.Now()
+	resp, err := h.CheckStock(context.Background(), connect.NewRequest(&stockcheckerv1.CheckStockRequest{
+		Skus:       skus,
+		PostalCode: "94103",
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sequentially this takes two calls per SKU
+	if elapsed, sequential := time.Since(start), time.Duration(2*len(skus))*delay; elapsed >= sequential/2 {
+		t.Errorf("took %v, want well under the sequential %v", elapsed, sequential)
+	}
+
+	want := []string{"6500001", "6500002", "6500004", "6500005", "6500007", "6500008"}
+	if got := len(resp.Msg.Results); got != len(want) {
+		t.Fatalf("got %d results, want %d", got, len(want))
+	}
+	for i, r := range resp.Msg.Results {
+		if r.Product.Sku != want[i] {
+			t.Errorf("result %d is for %s, want %s (request order)", i, r.Product.Sku, want[i])
+		}
+	}
+
+	errs := resp.Msg.Errors
+	if len(errs) != 2 ||
+		errs[0].Sku != "6500003" || errs[0].Code != stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_NOT_FOUND ||
+		errs[1].Sku != "6500006" || errs[1].Code != stockcheckerv1.SkuErrorCode_SKU_ERROR_CODE_RESTRICTED {
+		t.Errorf("got errors %v, want not found for 6500003 and restricted for 6500006", errs)
+	}
+}
+
+func TestBestBuyError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want connect.Code
+	}{
+		{&bestbuy.NotFoundError{}, connect.CodeNotFound},
+		{&bestbuy.RestrictedError{SKU: "6500006", Err: &bestbuy.APIKeyError{StatusCode: 403}}, connect.CodeFailedPrecondition},
+		{&bestbuy.InvalidQueryError{}, connect.CodeInvalidArgument},
+		{&bestbuy.QuotaExceededError{}, connect.CodeResourceExhausted},
+		{fmt.Errorf("max retries exceeded: %w", &bestbuy.RateLimitError{}), connect.CodeResourceExhausted},
+		{&bestbuy.APIKeyError{StatusCode: 401}, connect.CodeUnavailable},
+		{context.DeadlineExceeded, connect.CodeDeadlineExceeded},
+		{errors.New("connection reset"), connect.CodeInternal},
+	}
+	for _, tt := range tests {
+		if got := bestBuyError(context.Background(), tt.err).Code(); got != tt.want {
+			t.Errorf("bestBuyError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestSavedProductRetailer(t *testing.T) {
+	tests := []struct {
+		retailer retailer.ID
+		want     stockcheckerv1.Retailer
+	}{
+		{retailer.Costco, stockcheckerv1.Retailer_RETAILER_COSTCO},
+		{retailer.BestBuy, stockcheckerv1.Retailer_RETAILER_BEST_BUY},
+		{"", stockcheckerv1.Retailer_RETAILER_BEST_BUY},
+	}
+	for _, tt := range tests {
+		if got := savedProduct(database.Product{Retailer: tt.retailer, SKU: "1"}).Retailer; got != tt.want {
+			t.Errorf("%q: retailer %v, want %v", tt.retailer, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/stream"
+)
+
+// SetStreams enables WatchStock, streaming stock changes from hub
+func (h *StockCheckerHandler) SetStreams(hub *stream.Hub) {
+	h.streams = hub
+}
+
+// stockEventToProto converts a stock event to its protobuf message
+func stockEventToProto(e database.StockEvent) *stockcheckerv1.StockEvent {
+	return &stockcheckerv1.StockEvent{
+		Id:         e.ID,
+		Sku:        e.SKU,
+		StoreId:    e.StoreID,
+		StoreName:  e.StoreName,
+		InStock:    e.EventType == database.StockEventInStock,
+		LowStock:   e.LowStock,
+		OccurredAt: timestamp(e.OccurredAt),
+	}
+}
+
+// encodeResumeToken returns the resume token for a position in the event log
+func encodeResumeToken(position int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(position, 10)))
+}
+
+// decodeResumeToken returns the position a resume token encodes
+func decodeResumeToken(ctx context.Context, token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	var position int64
+	if err == nil {
+		position, err = strconv.ParseInt(string(raw), 10, 64)
+	}
+	if err != nil || position < 0 {
+		return 0, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_resume_token")
+	}
+	return position, nil
+}
+
+// WatchStock streams stock changes until the client disconnects. Changes
+// are held for a busy client up to its buffer size; past that, newer changes
+// replace older ones and the client is told how many it missed. A client
+// that stops reading altogether is disconnected. Every batch carries a
+// resume token, and one is sent at least every 30 seconds to keep the
+// connection alive.
+func (h *StockCheckerHandler) WatchStock(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.WatchStockRequest],
+	out *connect.ServerStream[stockcheckerv1.WatchStockResponse],
+) error {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if h.streams == nil {
+		return localizedError(ctx, connect.CodeFailedPrecondition, "error.streaming_unavailable")
+	}
+	if req.Msg.BufferSize < 0 || req.Msg.BufferSize > stream.MaxBufferSize {
+		return localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_buffer_size", stream.MaxBufferSize)
+	}
+
+	var after int64
+	if req.Msg.ResumeToken != "" {
+		if after, err = decodeResumeToken(ctx, req.Msg.ResumeToken); err != nil {
+			return err
+		}
+	} else if after, err = h.streams.Head(ctx); err != nil {
+		return h.dbError(err)
+	}
+
+	sub, err := h.streams.Subscribe(user.ID, auth.SessionIDFromContext(ctx), req.Msg.Skus, int(req.Msg.BufferSize), after)
+	if errors.Is(err, stream.ErrTooManyStreams) {
+		return localizedError(ctx, connect.CodeResourceExhausted, "error.too_many_streams", stream.MaxPerUser)
+	}
+	if errors.Is(err, stream.ErrClosed) {
+		return localizedError(ctx, connect.CodeUnavailable, "error.server_shutting_down")
+	}
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	send := func(b stream.Batch) error {
+		resp := &stockcheckerv1.WatchStockResponse{
+			Skipped:     int32(b.Skipped),
+			ResumeToken: encodeResumeToken(b.Position),
+		}
+		for _, e := range b.Events {
+			resp.Events = append(resp.Events, stockEventToProto(e))
+		}
+		return out.Send(resp)
+	}
+
+	// The first batch tells the client where it's resuming from
+	if err := send(sub.Next()); err != nil {
+		return err
+	}
+	keepalive := time.NewTicker(stream.KeepaliveInterval)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Done():
+			if errors.Is(sub.Err(), stream.ErrClosed) {
+				return localizedError(ctx, connect.CodeUnavailable, "error.server_shutting_down")
+			}
+			if errors.Is(sub.Err(), stream.ErrRevoked) {
+				return localizedError(ctx, connect.CodeUnauthenticated, "error.session_revoked")
+			}
+			return localizedError(ctx, connect.CodeResourceExhausted, "error.stream_stalled")
+		case <-sub.Ready():
+			b := sub.Next()
+			if len(b.Events) == 0 && b.Skipped == 0 {
+				continue
+			}
+			if err := send(b); err != nil {
+				return err
+			}
+			keepalive.Reset(stream.KeepaliveInterval)
+		case <-keepalive.C:
+			if err := send(sub.Next()); err != nil {
+				return err
+			}
+		}
+	}
+}
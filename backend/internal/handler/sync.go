@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// encodeSyncToken encodes a sync cursor. Tokens are opaque to clients; they
+// currently encode the database time the sync started.
+func encodeSyncToken(t time.Time) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(t.UTC().Format(time.RFC3339Nano)))
+}
+
+// decodeSyncToken decodes a token from encodeSyncToken
+func decodeSyncToken(ctx context.Context, token string) (time.Time, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return time.Time{}, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_sync_token")
+	}
+	t, err := time.Parse(time.RFC3339Nano, string(raw))
+	if err != nil {
+		return time.Time{}, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_sync_token")
+	}
+	return t, nil
+}
+
+// SyncChanges returns changes to the user's saved data and latest stock since
+// the cursor in since_token, or everything if no token is given. The cursor is
+// inclusive, so a change made while a sync runs is sent again on the next one.
+func (h *StockCheckerHandler) SyncChanges(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SyncChangesRequest],
+) (*connect.Response[stockcheckerv1.SyncChangesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var since time.Time
+	full := req.Msg.SinceToken == ""
+	if !full {
+		since, err = decodeSyncToken(ctx, req.Msg.SinceToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Take the cursor first so anything changed while we read is picked up next time
+	now, err := h.db.Now(ctx)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	resp := &stockcheckerv1.SyncChangesResponse{
+		NextToken: encodeSyncToken(now),
+		FullSync:  full,
+	}
+
+	stores, err := h.db.GetUserStores(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	for _, s := range stores {
+		if !s.UpdatedAt.Before(since) {
+			resp.Stores = append(resp.Stores, savedStore(s))
+		}
+	}
+
+	products, err := h.db.GetUserProducts(ctx, user.ID, retailer.BestBuy)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	for _, p := range products {
+		if !p.UpdatedAt.Before(since) {
+			resp.Products = append(resp.Products, savedProduct(p))
+		}
+	}
+
+	// A full sync replaces local state, so removals don't matter
+	if !full {
+		deleted, err := h.db.GetDeletedItems(ctx, user.ID, since)
+		if err != nil {
+			return nil, h.dbError(err)
+		}
+		for _, d := range deleted {
+			switch d.Kind {
+			case database.DeletedStore:
+				resp.RemovedStoreIds = append(resp.RemovedStoreIds, d.ItemID)
+			case database.DeletedProduct:
+				resp.RemovedSkus = append(resp.RemovedSkus, d.ItemID)
+			}
+		}
+	}
+
+	prefs, err := h.db.GetNotificationPreferences(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	if full || !prefs.UpdatedAt.Before(since) {
+		resp.Preferences = preferencesToProto(prefs)
+	}
+
+	rules, err := h.db.GetAlertRules(ctx, user.ID)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	for _, r := range rules {
+		if !r.UpdatedAt.Before(since) {
+			resp.AlertRules = append(resp.AlertRules, alertRuleToProto(r))
+		}
+	}
+
+	snapshots, err := h.db.GetUserStockSnapshots(ctx, user.ID, since)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+	for _, s := range snapshots {
+		resp.StockSnapshots = append(resp.StockSnapshots, &stockcheckerv1.StockSnapshot{
+			Sku:             s.SKU,
+			PostalCode:      s.PostalCode,
+			InStockStoreIds: s.InStockStoreIDs,
+			CheckedAt:       timestamp(s.CheckedAt),
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
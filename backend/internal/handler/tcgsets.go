@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/pokemontcg"
+)
+
+// SetTCGSets enables set details from the Pokemon TCG API in product and set watch responses
+func (h *StockCheckerHandler) SetTCGSets(sets *pokemontcg.Sets) {
+	h.tcgSets = sets
+}
+
+// lookUpTCGSet returns the details of a set, or nil if they're unknown or
+// disabled. Failures are only logged; responses just go without the details.
+func (h *StockCheckerHandler) lookUpTCGSet(ctx context.Context, name string) *stockcheckerv1.TcgSet {
+	if h.tcgSets == nil || name == "" {
+		return nil
+	}
+
+	// Read-only mode serves what's cached rather than caching more
+	lookup := h.tcgSets.Lookup
+	if !h.canWrite() {
+		lookup = h.tcgSets.Cached
+	}
+	set, err := lookup(ctx, name)
+	if err != nil {
+		log.Printf("Failed to look up TCG set %q: %v", name, err)
+		return nil
+	}
+	if set == nil {
+		return nil
+	}
+
+	return &stockcheckerv1.TcgSet{
+		Id:               set.ID,
+		Name:             set.Name,
+		Series:           set.Series,
+		ReleaseDate:      timestamp(set.ReleaseDate),
+		PrintedCardCount: int32(set.PrintedTotal),
+		CardCount:        int32(set.Total),
+		LogoUrl:          set.LogoURL,
+		SymbolUrl:        set.SymbolURL,
+	}
+}
+
+// GetProductDetails returns a Best Buy product with the details of its TCG set
+func (h *StockCheckerHandler) GetProductDetails(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetProductDetailsRequest],
+) (*connect.Response[stockcheckerv1.GetProductDetailsResponse], error) {
+	if req.Msg.Sku == "" {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+
+	product, err := h.bbClient.GetProductBySKU(ctx, req.Msg.Sku)
+	if err != nil {
+		log.Printf("Error getting product %s: %v", req.Msg.Sku, err)
+		return nil, bestBuyError(ctx, err)
+	}
+
+	pb := h.listedProduct(ctx, *product)
+	return connect.NewResponse(&stockcheckerv1.GetProductDetailsResponse{
+		Product: pb,
+		TcgSet:  h.lookUpTCGSet(ctx, pb.SetName),
+	}), nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/auth"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/usage"
+)
+
+// Usage limits in days
+const (
+	defaultUsageDays = 30
+	maxUsageDays     = int(usage.Retention / (24 * time.Hour))
+)
+
+// SetUsage counts the checks each user asks for
+func (h *StockCheckerHandler) SetUsage(r *usage.Recorder) {
+	h.usage = r
+}
+
+// SetAPILoad reports Best Buy's daily quota and call pace in GetMyUsage;
+// either can be nil when the server isn't calling the real API
+func (h *StockCheckerHandler) SetAPILoad(quota *bestbuy.Quota, throttle *bestbuy.Throttle) {
+	h.quota, h.throttle = quota, throttle
+}
+
+// countChecks counts n SKUs checked for the signed-in user, if any
+func (h *StockCheckerHandler) countChecks(ctx context.Context, n int) {
+	if user := auth.UserFromContext(ctx); user != nil {
+		h.usage.Add(user.ID, usage.InteractiveCheck, n)
+	}
+}
+
+// GetMyUsage returns the user's checks and notifications per day, with how
+// busy the Best Buy API is right now
+func (h *StockCheckerHandler) GetMyUsage(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.GetMyUsageRequest],
+) (*connect.Response[stockcheckerv1.GetMyUsageResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(req.Msg.Days)
+	if days <= 0 {
+		days = defaultUsageDays
+	}
+	if days > maxUsageDays {
+		days = maxUsageDays
+	}
+
+	// Counts are saved every 30 seconds, so the last few checks may not show yet
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+	usageDays, err := h.db.GetUserUsage(ctx, user.ID, since)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	resp := &stockcheckerv1.GetMyUsageResponse{
+		Days: make([]*stockcheckerv1.UsageDay, 0, len(usageDays)),
+		Load: &stockcheckerv1.ApiLoad{},
+	}
+	var totals usage.Counts
+	for _, d := range usageDays {
+		pb := usageDay(d.Counts)
+		pb.Day = timestamp(d.Day)
+		resp.Days = append(resp.Days, pb)
+		totals = totals.Plus(d.Counts)
+	}
+	resp.Totals = usageDay(totals)
+
+	if h.throttle != nil {
+		resp.Load.CallIntervalMs = int32(h.throttle.Interval().Milliseconds())
+	}
+	if h.quota != nil {
+		resp.Load.CallsRemainingToday = int32(h.quota.Remaining())
+		resp.Load.DailyCallLimit = int32(h.quota.Limit())
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func usageDay(c usage.Counts) *stockcheckerv1.UsageDay {
+	return &stockcheckerv1.UsageDay{
+		InteractiveChecks: int32(c.InteractiveChecks),
+		BackgroundChecks:  int32(c.BackgroundChecks),
+		Notifications:     int32(c.Notifications),
+	}
+}
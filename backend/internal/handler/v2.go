@@ -0,0 +1,754 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	stockcheckerv2 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2"
+	"github.com/tmcauley/stock-checker/backend/gen/stockchecker/v2/stockcheckerv2connect"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/input"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/resource"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StockCheckerV2Handler implements the v2 StockCheckerService on top of the v1
+// handler, so both API versions share one implementation during the migration
+type StockCheckerV2Handler struct {
+	stockcheckerv2connect.UnimplementedStockCheckerServiceHandler
+	v1        *StockCheckerHandler
+	retailers *retailer.Registry
+}
+
+// NewStockCheckerV2Handler creates a StockCheckerV2Handler backed by the v1 handler.
+// Best Buy is served by the v1 handler; other retailers by their client in the registry.
+func NewStockCheckerV2Handler(v1 *StockCheckerHandler, retailers *retailer.Registry) *StockCheckerV2Handler {
+	return &StockCheckerV2Handler{v1: v1, retailers: retailers}
+}
+
+// retailerIDs maps API retailers to adapter IDs
+var retailerIDs = map[stockcheckerv2.Retailer]retailer.ID{
+	stockcheckerv2.Retailer_RETAILER_BEST_BUY: retailer.BestBuy,
+	stockcheckerv2.Retailer_RETAILER_WALMART:  retailer.Walmart,
+	stockcheckerv2.Retailer_RETAILER_TARGET:   retailer.Target,
+	stockcheckerv2.Retailer_RETAILER_GAMESTOP: retailer.GameStop,
+	stockcheckerv2.Retailer_RETAILER_COSTCO:   retailer.Costco,
+}
+
+// retailerEnum maps an adapter ID to its API retailer
+func retailerEnum(id retailer.ID) stockcheckerv2.Retailer {
+	for r, rid := range retailerIDs {
+		if rid == id {
+			return r
+		}
+	}
+	return stockcheckerv2.Retailer_RETAILER_UNSPECIFIED
+}
+
+// isBestBuy returns true for requests served by the v1 handler
+func isBestBuy(r stockcheckerv2.Retailer) bool {
+	return r == stockcheckerv2.Retailer_RETAILER_UNSPECIFIED || r == stockcheckerv2.Retailer_RETAILER_BEST_BUY
+}
+
+// savedRetailer returns the adapter ID saved items of a retailer are stored
+// under, rejecting retailers the server has no client for
+func (h *StockCheckerV2Handler) savedRetailer(ctx context.Context, r stockcheckerv2.Retailer) (retailer.ID, error) {
+	if isBestBuy(r) {
+		return retailer.BestBuy, nil
+	}
+	if _, err := h.retailerClient(ctx, r); err != nil {
+		return "", err
+	}
+	return retailerIDs[r], nil
+}
+
+// listRetailer maps a list filter to an adapter ID; unspecified lists every retailer
+func listRetailer(r stockcheckerv2.Retailer) retailer.ID {
+	return retailerIDs[r]
+}
+
+// retailerClient returns the registered client for a retailer other than Best Buy
+func (h *StockCheckerV2Handler) retailerClient(ctx context.Context, r stockcheckerv2.Retailer) (retailer.Client, error) {
+	if id, ok := retailerIDs[r]; ok {
+		if client, ok := h.retailers.Get(id); ok {
+			return client, nil
+		}
+	}
+	return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.unsupported_retailer", r.String())
+}
+
+// usd converts US cents to Money
+func usd(c money.Cents) *stockcheckerv2.Money {
+	return amount(c, "USD")
+}
+
+// amount converts cents of a currency to Money (USD if currency is empty)
+func amount(c money.Cents, currency string) *stockcheckerv2.Money {
+	if currency == "" {
+		currency = "USD"
+	}
+	return &stockcheckerv2.Money{
+		CurrencyCode: currency,
+		Units:        int64(c / 100),
+		Nanos:        int32(c%100) * 10_000_000,
+	}
+}
+
+// cents converts Money to cents, dropping fractions of a cent (nil is zero)
+func cents(m *stockcheckerv2.Money) money.Cents {
+	if m == nil {
+		return 0
+	}
+	return money.Cents(m.Units*100 + int64(m.Nanos/10_000_000))
+}
+
+// storeToV2 converts a v1 store
+func storeToV2(s *stockcheckerv1.Store) *stockcheckerv2.Store {
+	if s == nil {
+		return nil
+	}
+	return &stockcheckerv2.Store{
+		Retailer:      stockcheckerv2.Retailer_RETAILER_BEST_BUY,
+		StoreId:       s.StoreId,
+		DisplayName:   s.Name,
+		Address:       s.Address,
+		City:          s.City,
+		State:         s.State,
+		PostalCode:    s.PostalCode,
+		Phone:         s.Phone,
+		DistanceMiles: s.DistanceMiles,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+		Name:          resource.StoreName(s.StoreId),
+		OpenNow:       s.OpenNow,
+		HoursToday:    s.HoursToday,
+		SpecialHours:  s.SpecialHours,
+		OpensAt:       s.OpensAt,
+	}
+}
+
+// productToV2 converts a v1 product
+func productToV2(p *stockcheckerv1.Product) *stockcheckerv2.Product {
+	if p == nil {
+		return nil
+	}
+	return &stockcheckerv2.Product{
+		Retailer:     stockcheckerv2.Retailer_RETAILER_BEST_BUY,
+		Sku:          p.Sku,
+		DisplayName:  p.Name,
+		SalePrice:    amount(money.Cents(p.SalePriceCents), p.CurrencyCode),
+		ThumbnailUrl: p.ThumbnailUrl,
+		ProductUrl:   p.ProductUrl,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+		Name:         resource.ProductName(p.Sku),
+	}
+}
+
+// retailerStoreToV2 converts a store from a retailer client
+func retailerStoreToV2(r stockcheckerv2.Retailer, s retailer.Store) *stockcheckerv2.Store {
+	return &stockcheckerv2.Store{
+		Retailer:      r,
+		StoreId:       s.ID,
+		DisplayName:   s.Name,
+		Address:       s.Address,
+		City:          s.City,
+		State:         s.State,
+		PostalCode:    s.PostalCode,
+		Phone:         s.Phone,
+		DistanceMiles: s.Distance,
+		Name:          resource.StoreName(s.ID),
+	}
+}
+
+// retailerProductToV2 converts a product from a retailer client
+func retailerProductToV2(r stockcheckerv2.Retailer, p retailer.Product) *stockcheckerv2.Product {
+	return &stockcheckerv2.Product{
+		Retailer:     r,
+		Sku:          p.SKU,
+		DisplayName:  p.Name,
+		SalePrice:    amount(p.SalePrice, p.Currency),
+		ThumbnailUrl: p.ThumbnailURL,
+		ProductUrl:   p.ProductURL,
+		Name:         resource.ProductName(p.SKU),
+		OnlineStatus: onlineStatus(p.Online),
+	}
+}
+
+// onlineStatus maps a retailer's online availability to an availability status
+func onlineStatus(s retailer.OnlineStatus) stockcheckerv2.AvailabilityStatus {
+	switch s {
+	case retailer.OnlineInStock:
+		return stockcheckerv2.AvailabilityStatus_AVAILABILITY_STATUS_IN_STOCK
+	case retailer.OnlineOutOfStock:
+		return stockcheckerv2.AvailabilityStatus_AVAILABILITY_STATUS_OUT_OF_STOCK
+	default:
+		return stockcheckerv2.AvailabilityStatus_AVAILABILITY_STATUS_UNSPECIFIED
+	}
+}
+
+// checkOwner rejects resources that belong to a user other than the caller
+func checkOwner(ctx context.Context, user *database.User, userID int, name string) error {
+	if userID != user.ID {
+		return localizedError(ctx, connect.CodePermissionDenied, "error.not_your_resource", name)
+	}
+	return nil
+}
+
+// checkParent checks that a users/{user} parent is the caller (empty means the caller)
+func checkParent(ctx context.Context, user *database.User, parent string) error {
+	if parent == "" {
+		return nil
+	}
+	userID, err := resource.ParseUser(parent, user.ID)
+	if err != nil {
+		return localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_resource_name", parent)
+	}
+	return checkOwner(ctx, user, userID, parent)
+}
+
+// availabilityStatus maps v1 stock flags to an availability status
+func availabilityStatus(inStock, lowStock bool) stockcheckerv2.AvailabilityStatus {
+	switch {
+	case inStock && lowStock:
+		return stockcheckerv2.AvailabilityStatus_AVAILABILITY_STATUS_LOW_STOCK
+	case inStock:
+		return stockcheckerv2.AvailabilityStatus_AVAILABILITY_STATUS_IN_STOCK
+	default:
+		return stockcheckerv2.AvailabilityStatus_AVAILABILITY_STATUS_OUT_OF_STOCK
+	}
+}
+
+// SearchStores searches for stores near a location
+func (h *StockCheckerV2Handler) SearchStores(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.SearchStoresRequest],
+) (*connect.Response[stockcheckerv2.SearchStoresResponse], error) {
+	var all []*stockcheckerv2.Store
+	if isBestBuy(req.Msg.Retailer) {
+		resp, err := h.v1.SearchStores(ctx, connect.NewRequest(&stockcheckerv1.SearchStoresRequest{
+			PostalCode:  req.Msg.PostalCode,
+			RadiusMiles: req.Msg.RadiusMiles,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range resp.Msg.Stores {
+			all = append(all, storeToV2(s))
+		}
+	} else {
+		client, err := h.retailerClient(ctx, req.Msg.Retailer)
+		if err != nil {
+			return nil, err
+		}
+		postalCode, err := input.NormalizePostalCode(req.Msg.PostalCode)
+		if err != nil {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_postal_code", req.Msg.PostalCode)
+		}
+		found, err := client.SearchStores(ctx, postalCode, int(req.Msg.RadiusMiles))
+		if err != nil {
+			log.Printf("Error searching %s stores: %v", retailerIDs[req.Msg.Retailer], err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, s := range found {
+			all = append(all, retailerStoreToV2(req.Msg.Retailer, s))
+		}
+	}
+
+	stores, next, err := paginate(ctx, all, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stockcheckerv2.SearchStoresResponse{
+		Stores:        stores,
+		NextPageToken: next,
+	}), nil
+}
+
+// SearchProducts searches for products by keyword or SKU
+func (h *StockCheckerV2Handler) SearchProducts(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.SearchProductsRequest],
+) (*connect.Response[stockcheckerv2.SearchProductsResponse], error) {
+	var all []*stockcheckerv2.Product
+	if isBestBuy(req.Msg.Retailer) {
+		resp, err := h.v1.SearchProducts(ctx, connect.NewRequest(&stockcheckerv1.SearchProductsRequest{
+			Query:    req.Msg.Query,
+			Category: req.Msg.Category,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range resp.Msg.Products {
+			all = append(all, productToV2(p))
+		}
+	} else {
+		client, err := h.retailerClient(ctx, req.Msg.Retailer)
+		if err != nil {
+			return nil, err
+		}
+		found, err := client.SearchProducts(ctx, req.Msg.Query, req.Msg.Category)
+		if err != nil {
+			log.Printf("Error searching %s products: %v", retailerIDs[req.Msg.Retailer], err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, p := range found {
+			all = append(all, retailerProductToV2(req.Msg.Retailer, p))
+		}
+	}
+
+	products, next, err := paginate(ctx, all, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stockcheckerv2.SearchProductsResponse{
+		Products:      products,
+		NextPageToken: next,
+	}), nil
+}
+
+// CheckStock checks inventory for products near a postal code
+func (h *StockCheckerV2Handler) CheckStock(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.CheckStockRequest],
+) (*connect.Response[stockcheckerv2.CheckStockResponse], error) {
+	if !isBestBuy(req.Msg.Retailer) {
+		return h.checkRetailerStock(ctx, req.Msg)
+	}
+
+	resp, err := h.v1.CheckStock(ctx, connect.NewRequest(&stockcheckerv1.CheckStockRequest{
+		StoreIds:   req.Msg.StoreIds,
+		Skus:       req.Msg.Skus,
+		PostalCode: req.Msg.PostalCode,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*stockcheckerv2.StockStatus, 0, len(resp.Msg.Results))
+	for _, r := range resp.Msg.Results {
+		results = append(results, &stockcheckerv2.StockStatus{
+			Store:          storeToV2(r.Store),
+			Product:        productToV2(r.Product),
+			Status:         availabilityStatus(r.InStock, r.LowStock),
+			PickupEligible: r.PickupEligible,
+			IsMyStore:      r.IsMyStore,
+			CheckedAt:      r.CheckedAt,
+		})
+	}
+
+	return connect.NewResponse(&stockcheckerv2.CheckStockResponse{
+		Results: results,
+	}), nil
+}
+
+// checkRetailerStock checks stock with a registered retailer client
+func (h *StockCheckerV2Handler) checkRetailerStock(
+	ctx context.Context,
+	req *stockcheckerv2.CheckStockRequest,
+) (*connect.Response[stockcheckerv2.CheckStockResponse], error) {
+	client, err := h.retailerClient(ctx, req.Retailer)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*stockcheckerv2.StockStatus{}
+	if req.PostalCode == "" || len(req.Skus) == 0 {
+		return connect.NewResponse(&stockcheckerv2.CheckStockResponse{Results: results}), nil
+	}
+
+	postalCode, err := input.NormalizePostalCode(req.PostalCode)
+	if err != nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_postal_code", req.PostalCode)
+	}
+	h.v1.countChecks(ctx, len(req.Skus))
+
+	myStoresSet := make(map[string]bool)
+	for _, id := range req.StoreIds {
+		myStoresSet[id] = true
+	}
+
+	for _, sku := range req.Skus {
+		product, err := client.GetProduct(ctx, sku)
+		if err != nil {
+			log.Printf("Error getting %s product %s: %v", retailerIDs[req.Retailer], sku, err)
+			continue
+		}
+
+		availability, err := client.CheckAvailability(ctx, sku, postalCode)
+		if errors.Is(err, bestbuy.ErrRestrictedSKU) {
+			// Report the product as restricted rather than leaving it out like a failed check
+			results = append(results, &stockcheckerv2.StockStatus{
+				Product:   retailerProductToV2(req.Retailer, *product),
+				Status:    stockcheckerv2.AvailabilityStatus_AVAILABILITY_STATUS_RESTRICTED,
+				CheckedAt: timestamppb.Now(),
+			})
+			continue
+		}
+		if err != nil {
+			log.Printf("Error checking %s availability for %s: %v", retailerIDs[req.Retailer], sku, err)
+			continue
+		}
+		checkedAt := timestamppb.Now()
+
+		pbProduct := retailerProductToV2(req.Retailer, *product)
+		if product.Online != retailer.OnlineUnknown {
+			results = append(results, &stockcheckerv2.StockStatus{
+				Product:   pbProduct,
+				Status:    pbProduct.OnlineStatus,
+				CheckedAt: checkedAt,
+			})
+		}
+		for _, avail := range availability {
+			results = append(results, &stockcheckerv2.StockStatus{
+				Store: retailerStoreToV2(req.Retailer, retailer.Store{
+					ID:       avail.StoreID,
+					Name:     avail.StoreName,
+					City:     avail.City,
+					State:    avail.State,
+					Distance: avail.Distance,
+				}),
+				Product:        pbProduct,
+				Status:         availabilityStatus(avail.InStock, avail.LowStock),
+				PickupEligible: avail.PickupEligible,
+				IsMyStore:      myStoresSet[avail.StoreID],
+				CheckedAt:      checkedAt,
+			})
+		}
+	}
+
+	return connect.NewResponse(&stockcheckerv2.CheckStockResponse{
+		Results: results,
+	}), nil
+}
+
+// ListRetailers returns the retailers the server has adapters for
+func (h *StockCheckerV2Handler) ListRetailers(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.ListRetailersRequest],
+) (*connect.Response[stockcheckerv2.ListRetailersResponse], error) {
+	ids := h.retailers.IDs()
+	retailers := make([]*stockcheckerv2.RetailerInfo, 0, len(ids))
+	for _, id := range ids {
+		r := retailerEnum(id)
+		retailers = append(retailers, &stockcheckerv2.RetailerInfo{
+			Retailer: r,
+			Mock:     h.retailers.IsMock(id),
+			CanSave:  true,
+		})
+	}
+
+	return connect.NewResponse(&stockcheckerv2.ListRetailersResponse{
+		Retailers: retailers,
+	}), nil
+}
+
+// ListMyStores returns the user's saved stores
+func (h *StockCheckerV2Handler) ListMyStores(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.ListMyStoresRequest],
+) (*connect.Response[stockcheckerv2.ListMyStoresResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkParent(ctx, user, req.Msg.Parent); err != nil {
+		return nil, err
+	}
+
+	stores, err := h.v1.db.GetUserStores(ctx, user.ID, listRetailer(req.Msg.Retailer))
+	if err != nil {
+		return nil, h.v1.dbError(err)
+	}
+
+	page, next, err := paginate(ctx, stores, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only Best Buy store hours are known
+	var storeIDs []string
+	for _, s := range page {
+		if s.Retailer == retailer.BestBuy {
+			storeIDs = append(storeIDs, s.StoreID)
+		}
+	}
+	hours := h.v1.savedStoreHours(ctx, storeIDs)
+
+	now := time.Now()
+	pbStores := make([]*stockcheckerv2.Store, 0, len(page))
+	for _, s := range page {
+		pbStore := &stockcheckerv2.Store{
+			Retailer:    retailerEnum(s.Retailer),
+			StoreId:     s.StoreID,
+			DisplayName: s.Name,
+			Address:     s.Address,
+			City:        s.City,
+			State:       s.State,
+			PostalCode:  s.PostalCode,
+			Phone:       s.Phone,
+			CreatedAt:   timestamp(s.CreatedAt),
+			UpdatedAt:   timestamp(s.UpdatedAt),
+			Name:        resource.UserStoreName(user.ID, s.StoreID),
+		}
+		if s.Retailer == retailer.BestBuy {
+			setOpenNowV2(pbStore, hours[s.StoreID], now)
+		}
+		pbStores = append(pbStores, pbStore)
+	}
+
+	return connect.NewResponse(&stockcheckerv2.ListMyStoresResponse{
+		Stores:        pbStores,
+		NextPageToken: next,
+	}), nil
+}
+
+// AddMyStore adds a store to the user's list
+func (h *StockCheckerV2Handler) AddMyStore(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.AddMyStoreRequest],
+) (*connect.Response[stockcheckerv2.AddMyStoreResponse], error) {
+	s := req.Msg.Store
+	if s == nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.store_required")
+	}
+	id, err := h.savedRetailer(ctx, s.Retailer)
+	if err != nil {
+		return nil, err
+	}
+
+	if id != retailer.BestBuy {
+		user, err := getUserFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.v1.db.AddUserStore(ctx, user.ID, database.Store{
+			Retailer:   id,
+			StoreID:    s.StoreId,
+			Name:       s.DisplayName,
+			Address:    s.Address,
+			City:       s.City,
+			State:      s.State,
+			PostalCode: s.PostalCode,
+			Phone:      s.Phone,
+		}); err != nil {
+			return nil, h.v1.dbError(err)
+		}
+		return connect.NewResponse(&stockcheckerv2.AddMyStoreResponse{}), nil
+	}
+
+	if _, err := h.v1.AddMyStore(ctx, connect.NewRequest(&stockcheckerv1.AddMyStoreRequest{
+		Store: &stockcheckerv1.Store{
+			StoreId:    s.StoreId,
+			Name:       s.DisplayName,
+			Address:    s.Address,
+			City:       s.City,
+			State:      s.State,
+			PostalCode: s.PostalCode,
+			Phone:      s.Phone,
+		},
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stockcheckerv2.AddMyStoreResponse{}), nil
+}
+
+// RemoveMyStore removes a store from the user's list
+func (h *StockCheckerV2Handler) RemoveMyStore(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.RemoveMyStoreRequest],
+) (*connect.Response[stockcheckerv2.RemoveMyStoreResponse], error) {
+	id, err := h.savedRetailer(ctx, req.Msg.Retailer)
+	if err != nil {
+		return nil, err
+	}
+
+	storeID := req.Msg.StoreId
+	if req.Msg.Name != "" {
+		user, err := getUserFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userID, id, err := resource.ParseUserStore(req.Msg.Name, user.ID)
+		if err != nil {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_resource_name", req.Msg.Name)
+		}
+		if err := checkOwner(ctx, user, userID, req.Msg.Name); err != nil {
+			return nil, err
+		}
+		storeID = id
+	}
+
+	if id != retailer.BestBuy {
+		user, err := getUserFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.v1.db.RemoveUserStore(ctx, user.ID, id, storeID); err != nil {
+			return nil, h.v1.dbError(err)
+		}
+		return connect.NewResponse(&stockcheckerv2.RemoveMyStoreResponse{}), nil
+	}
+
+	if _, err := h.v1.RemoveMyStore(ctx, connect.NewRequest(&stockcheckerv1.RemoveMyStoreRequest{
+		StoreId: storeID,
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stockcheckerv2.RemoveMyStoreResponse{}), nil
+}
+
+// ListMyProducts returns the user's saved products
+func (h *StockCheckerV2Handler) ListMyProducts(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.ListMyProductsRequest],
+) (*connect.Response[stockcheckerv2.ListMyProductsResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkParent(ctx, user, req.Msg.Parent); err != nil {
+		return nil, err
+	}
+
+	products, err := h.v1.db.GetUserProducts(ctx, user.ID, listRetailer(req.Msg.Retailer))
+	if err != nil {
+		return nil, h.v1.dbError(err)
+	}
+
+	page, next, err := paginate(ctx, products, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pbProducts := make([]*stockcheckerv2.Product, 0, len(page))
+	for _, p := range page {
+		pbProducts = append(pbProducts, savedProductToV2(p))
+	}
+
+	return connect.NewResponse(&stockcheckerv2.ListMyProductsResponse{
+		Products:      pbProducts,
+		NextPageToken: next,
+	}), nil
+}
+
+// savedProductToV2 converts a saved product
+func savedProductToV2(p database.Product) *stockcheckerv2.Product {
+	return &stockcheckerv2.Product{
+		Retailer:     retailerEnum(p.Retailer),
+		Sku:          p.SKU,
+		DisplayName:  p.Name,
+		SalePrice:    usd(p.SalePrice),
+		ThumbnailUrl: p.ThumbnailURL,
+		ProductUrl:   p.ProductURL,
+		CreatedAt:    timestamp(p.CreatedAt),
+		UpdatedAt:    timestamp(p.UpdatedAt),
+		Name:         resource.UserProductName(p.UserID, p.SKU),
+	}
+}
+
+// AddMyProduct adds a product to the user's list
+func (h *StockCheckerV2Handler) AddMyProduct(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.AddMyProductRequest],
+) (*connect.Response[stockcheckerv2.AddMyProductResponse], error) {
+	p := req.Msg.Product
+	if p == nil {
+		return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.product_required")
+	}
+	id, err := h.savedRetailer(ctx, p.Retailer)
+	if err != nil {
+		return nil, err
+	}
+
+	if id != retailer.BestBuy {
+		user, err := getUserFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.v1.db.AddUserProduct(ctx, user.ID, database.Product{
+			Retailer:     id,
+			SKU:          p.Sku,
+			Name:         p.DisplayName,
+			SalePrice:    cents(p.SalePrice),
+			ThumbnailURL: p.ThumbnailUrl,
+			ProductURL:   p.ProductUrl,
+		}); err != nil {
+			return nil, h.v1.dbError(err)
+		}
+		return connect.NewResponse(&stockcheckerv2.AddMyProductResponse{}), nil
+	}
+
+	if _, err := h.v1.AddMyProduct(ctx, connect.NewRequest(&stockcheckerv1.AddMyProductRequest{
+		Product: &stockcheckerv1.Product{
+			Sku:            p.Sku,
+			Name:           p.DisplayName,
+			SalePriceCents: int64(cents(p.SalePrice)),
+			ThumbnailUrl:   p.ThumbnailUrl,
+			ProductUrl:     p.ProductUrl,
+		},
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stockcheckerv2.AddMyProductResponse{}), nil
+}
+
+// RemoveMyProduct removes a product from the user's list
+func (h *StockCheckerV2Handler) RemoveMyProduct(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv2.RemoveMyProductRequest],
+) (*connect.Response[stockcheckerv2.RemoveMyProductResponse], error) {
+	id, err := h.savedRetailer(ctx, req.Msg.Retailer)
+	if err != nil {
+		return nil, err
+	}
+
+	sku := req.Msg.Sku
+	if req.Msg.Name != "" {
+		user, err := getUserFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userID, id, err := resource.ParseUserProduct(req.Msg.Name, user.ID)
+		if err != nil {
+			return nil, localizedError(ctx, connect.CodeInvalidArgument, "error.invalid_resource_name", req.Msg.Name)
+		}
+		if err := checkOwner(ctx, user, userID, req.Msg.Name); err != nil {
+			return nil, err
+		}
+		sku = id
+	}
+
+	if id != retailer.BestBuy {
+		user, err := getUserFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := h.v1.db.RemoveUserProduct(ctx, user.ID, id, sku); err != nil {
+			return nil, h.v1.dbError(err)
+		}
+		return connect.NewResponse(&stockcheckerv2.RemoveMyProductResponse{}), nil
+	}
+
+	if _, err := h.v1.RemoveMyProduct(ctx, connect.NewRequest(&stockcheckerv1.RemoveMyProductRequest{
+		Sku: sku,
+	})); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&stockcheckerv2.RemoveMyProductResponse{}), nil
+}
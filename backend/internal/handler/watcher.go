@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// SimulateWatcherCycle runs the stock watcher without sending anything and reports which notifications would fire
+func (h *StockCheckerHandler) SimulateWatcherCycle(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.SimulateWatcherCycleRequest],
+) (*connect.Response[stockcheckerv1.SimulateWatcherCycleResponse], error) {
+	user, err := h.adminUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if h.watcher == nil {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.watcher_unavailable")
+	}
+
+	client := h.bbClient
+	if req.Msg.UseMockData {
+		client = bestbuy.NewMockClient()
+	}
+
+	alerts, err := h.watcher.Simulate(ctx, client, req.Msg.FromEmpty)
+	if err != nil {
+		log.Printf("Error simulating watcher cycle: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	sink := poller.NewNotificationSink(h.db)
+	var notifications []*stockcheckerv1.SimulatedNotification
+	for _, alert := range alerts {
+		recipient, err := h.db.GetUserByID(ctx, alert.UserID)
+		if err != nil {
+			return nil, h.dbError(err)
+		}
+
+		rendered, err := sink.Render(ctx, alert)
+		if err != nil {
+			log.Printf("Error rendering simulated alert for user %d: %v", alert.UserID, err)
+		}
+
+		base := &stockcheckerv1.SimulatedNotification{
+			User: &stockcheckerv1.User{
+				Id:    int32(recipient.ID),
+				Email: recipient.Email,
+				Name:  recipient.Name,
+			},
+			Product: &stockcheckerv1.Product{
+				Sku:            alert.SKU,
+				Name:           alert.ProductName,
+				SalePrice:      alert.SalePrice.Dollars(),
+				SalePriceCents: int64(alert.SalePrice),
+				ThumbnailUrl:   alert.ThumbnailURL,
+				ProductUrl:     alert.ProductURL,
+				Retailer:       retailerV1(alert.Retailer),
+			},
+		}
+		for _, s := range alert.Stores {
+			base.Stores = append(base.Stores, &stockcheckerv1.Store{
+				StoreId:       s.StoreID,
+				Name:          s.StoreName,
+				City:          s.City,
+				State:         s.State,
+				DistanceMiles: s.Distance,
+			})
+		}
+
+		// Users without channels are still listed so missing setups are visible
+		if len(rendered) == 0 {
+			notifications = append(notifications, base)
+			continue
+		}
+		for _, r := range rendered {
+			n := &stockcheckerv1.SimulatedNotification{
+				User:        base.User,
+				Product:     base.Product,
+				Stores:      base.Stores,
+				ChannelType: r.Channel.ChannelType,
+				Title:       r.Message.Title,
+				Body:        r.Message.Body,
+			}
+			notifications = append(notifications, n)
+		}
+	}
+
+	log.Printf("Simulated watcher cycle for %s: %d alerts, %d notifications", user.Email, len(alerts), len(notifications))
+
+	return connect.NewResponse(&stockcheckerv1.SimulateWatcherCycleResponse{
+		Notifications: notifications,
+	}), nil
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	stockcheckerv1 "github.com/tmcauley/stock-checker/backend/gen/stockchecker/v1"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// watchlistActions maps logged change actions to their enum
+var watchlistActions = map[string]stockcheckerv1.WatchlistChangeAction{
+	database.WatchlistAdded:   stockcheckerv1.WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_ADDED,
+	database.WatchlistUpdated: stockcheckerv1.WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_UPDATED,
+	database.WatchlistRemoved: stockcheckerv1.WatchlistChangeAction_WATCHLIST_CHANGE_ACTION_REMOVED,
+}
+
+// undoWindow is how recent a watchlist change must be to undo it
+const undoWindow = time.Hour
+
+// watchlistChange converts a logged change to proto
+func watchlistChange(c database.WatchlistChange) *stockcheckerv1.WatchlistChange {
+	pb := &stockcheckerv1.WatchlistChange{
+		Retailer:    string(c.Retailer),
+		Action:      watchlistActions[c.Action],
+		ProductName: c.Name,
+		ChangedAt:   timestamp(c.ChangedAt),
+	}
+	if c.Kind == database.DeletedStore {
+		pb.StoreId = c.ItemID
+	} else {
+		pb.Sku = c.ItemID
+	}
+	return pb
+}
+
+// ListWatchlistChanges returns the changes to the user's saved stores and
+// products made since a time, from every client, oldest first
+func (h *StockCheckerHandler) ListWatchlistChanges(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.ListWatchlistChangesRequest],
+) (*connect.Response[stockcheckerv1.ListWatchlistChangesResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var since time.Time
+	if req.Msg.Since != nil {
+		since = req.Msg.Since.AsTime()
+	}
+	changes, err := h.db.GetWatchlistChanges(ctx, user.ID, since)
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	page, next, err := paginate(ctx, changes, req.Msg.PageSize, req.Msg.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pbChanges := make([]*stockcheckerv1.WatchlistChange, 0, len(page))
+	for _, c := range page {
+		pbChanges = append(pbChanges, watchlistChange(c))
+	}
+
+	return connect.NewResponse(&stockcheckerv1.ListWatchlistChangesResponse{
+		Changes:       pbChanges,
+		NextPageToken: next,
+	}), nil
+}
+
+// UndoLastChange reverses the user's most recent change to their saved stores
+// or products, if it was made within undoWindow
+func (h *StockCheckerHandler) UndoLastChange(
+	ctx context.Context,
+	req *connect.Request[stockcheckerv1.UndoLastChangeRequest],
+) (*connect.Response[stockcheckerv1.UndoLastChangeResponse], error) {
+	user, err := getUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	undone, err := h.db.UndoLastChange(ctx, user.ID, time.Now().Add(-undoWindow))
+	if errors.Is(err, database.ErrNothingToUndo) {
+		return nil, localizedError(ctx, connect.CodeFailedPrecondition, "error.nothing_to_undo", int(undoWindow.Minutes()))
+	}
+	if err != nil {
+		return nil, h.dbError(err)
+	}
+
+	return connect.NewResponse(&stockcheckerv1.UndoLastChangeResponse{
+		Undone: watchlistChange(*undone),
+	}), nil
+}
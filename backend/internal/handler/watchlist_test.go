@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+func TestWatchlistActionsCoverEveryAction(t *testing.T) {
+	for _, action := range []string{database.WatchlistAdded, database.WatchlistUpdated, database.WatchlistRemoved} {
+		if _, ok := watchlistActions[action]; !ok {
+			t.Errorf("no enum value for watchlist action %q", action)
+		}
+	}
+}
+
+func TestWatchlistChangeSetsStoreOrSKU(t *testing.T) {
+	store := watchlistChange(database.WatchlistChange{Kind: database.DeletedStore, ItemID: "281", Action: database.WatchlistRemoved})
+	if store.StoreId != "281" || store.Sku != "" {
+		t.Errorf("store change = %v, want store_id 281 and no sku", store)
+	}
+
+	product := watchlistChange(database.WatchlistChange{Kind: database.DeletedProduct, ItemID: "6579543", Action: database.WatchlistAdded})
+	if product.Sku != "6579543" || product.StoreId != "" {
+		t.Errorf("product change = %v, want sku 6579543 and no store_id", product)
+	}
+}
@@ -0,0 +1,450 @@
+// Package hours parses store opening hours as returned by the Best Buy API,
+// either as the summary strings of the hours and hoursAmPm fields
+// ("Mon: 10-9; Tue: 10-9; ...", "Mon: 10am-9pm; ...") or as detailedHours
+// entries for specific dates.
+package hours
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is an opening period as offsets from midnight. Close may be
+// past 24h for stores that stay open overnight.
+type Interval struct {
+	Open  time.Duration
+	Close time.Duration
+}
+
+// Week holds the opening intervals for each day, indexed by time.Weekday.
+// A day with no intervals is closed.
+type Week [7][]Interval
+
+// allDay is the interval for stores open 24 hours
+var allDay = Interval{Open: 0, Close: 24 * time.Hour}
+
+// dayNames maps every day spelling Best Buy uses to its weekday
+var dayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "weds": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var (
+	// A clock time: "10", "10:30", "10am", "10:30 p.m.", "21:00"
+	clockPattern = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(?:([ap])\.?\s*m?\.?)?$`)
+	dashReplacer = strings.NewReplacer("–", "-", "—", "-", " to ", "-")
+)
+
+// Parse parses a summary hours string such as "Mon: 10-9; Tue: 10-9" or
+// "Mon-Sat: 10am-9pm; Sun: Closed". Days that aren't mentioned are closed.
+func Parse(s string) (Week, error) {
+	var week Week
+	found := false
+
+	s = dashReplacer.Replace(strings.ToLower(s))
+	for _, entry := range strings.FieldsFunc(s, func(r rune) bool { return r == ';' || r == '\n' || r == '|' }) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		dayPart, hoursPart, err := splitEntry(entry)
+		if err != nil {
+			return Week{}, err
+		}
+		days, err := parseDays(dayPart)
+		if err != nil {
+			return Week{}, err
+		}
+		intervals, err := ParseRanges(hoursPart)
+		if err != nil {
+			return Week{}, fmt.Errorf("entry %q: %w", entry, err)
+		}
+
+		for _, d := range days {
+			week[d] = intervals
+		}
+		found = true
+	}
+
+	if !found {
+		return Week{}, fmt.Errorf("no hours in %q", s)
+	}
+	return week, nil
+}
+
+// splitEntry splits "mon: 10-9" or "mon 10:00-21:00" into its day and hours
+func splitEntry(entry string) (string, string, error) {
+	// Times like "10:30" also contain colons, so only a colon before the first digit separates the day
+	if i := strings.Index(entry, ":"); i > 0 && !strings.ContainsAny(entry[:i], "0123456789") {
+		return entry[:i], entry[i+1:], nil
+	}
+	if i := strings.IndexAny(entry, "0123456789"); i > 0 {
+		return entry[:i], entry[i:], nil
+	}
+	for _, word := range []string{"closed", "open"} {
+		if i := strings.Index(entry, word); i > 0 {
+			return entry[:i], entry[i:], nil
+		}
+	}
+	return "", "", fmt.Errorf("entry %q has no day", entry)
+}
+
+// parseDays parses "mon", "mon-fri", "sat, sun" or "sat & sun"
+func parseDays(s string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '&' || r == '/' }) {
+		part = strings.TrimSpace(part)
+		if from, to, ok := strings.Cut(part, "-"); ok {
+			start, err := parseDay(from)
+			if err != nil {
+				return nil, err
+			}
+			end, err := parseDay(to)
+			if err != nil {
+				return nil, err
+			}
+			for d := start; ; d = (d + 1) % 7 {
+				days = append(days, d)
+				if d == end {
+					break
+				}
+			}
+			continue
+		}
+
+		d, err := parseDay(part)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, d)
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("no day in %q", s)
+	}
+	return days, nil
+}
+
+// parseDay parses a single day name
+func parseDay(s string) (time.Weekday, error) {
+	d, ok := dayNames[strings.TrimSuffix(strings.TrimSpace(s), ".")]
+	if !ok {
+		return 0, fmt.Errorf("unknown day %q", s)
+	}
+	return d, nil
+}
+
+// ParseRanges parses the hours of one day: "10-9", "10am-9pm", "10:00-21:00",
+// "10-2, 3-9", "closed" or "open 24 hours"
+func ParseRanges(s string) ([]Interval, error) {
+	s = strings.TrimSpace(dashReplacer.Replace(strings.ToLower(s)))
+	switch s {
+	case "closed", "close", "":
+		return nil, nil
+	case "24 hours", "24hrs", "24 hrs", "open 24 hours", "open 24 hrs", "24/7":
+		return []Interval{allDay}, nil
+	}
+
+	var intervals []Interval
+	var after time.Duration
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '&' }) {
+		from, to, ok := strings.Cut(part, "-")
+		if !ok {
+			return nil, fmt.Errorf("range %q has no end time", strings.TrimSpace(part))
+		}
+		interval, err := parseRange(from, to, after)
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, interval)
+		after = interval.Close
+	}
+	if len(intervals) == 0 {
+		return nil, fmt.Errorf("no hours in %q", s)
+	}
+	return intervals, nil
+}
+
+// clock is a parsed clock time
+type clock struct {
+	hour     int
+	minute   int
+	meridiem byte // 'a', 'p', or 0 when not given
+}
+
+// parseClock parses a clock time
+func parseClock(s string) (clock, error) {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "noon":
+		return clock{hour: 12, meridiem: 'p'}, nil
+	case "midnight":
+		return clock{hour: 12, meridiem: 'a'}, nil
+	}
+
+	m := clockPattern.FindStringSubmatch(s)
+	if m == nil {
+		return clock{}, fmt.Errorf("invalid time %q", s)
+	}
+	c := clock{}
+	c.hour, _ = strconv.Atoi(m[1])
+	if m[2] != "" {
+		c.minute, _ = strconv.Atoi(m[2])
+	}
+	if m[3] != "" {
+		c.meridiem = m[3][0]
+	}
+
+	if c.minute > 59 || c.hour > 24 || (c.meridiem != 0 && (c.hour == 0 || c.hour > 12)) {
+		return clock{}, fmt.Errorf("invalid time %q", s)
+	}
+	return c, nil
+}
+
+// offset converts a 12-hour clock time to an offset from midnight
+func (c clock) offset(meridiem byte) time.Duration {
+	h := c.hour % 12
+	if meridiem == 'p' {
+		h += 12
+	}
+	return time.Duration(h)*time.Hour + time.Duration(c.minute)*time.Minute
+}
+
+// parseRange resolves an open and close time into an interval. Times without
+// am/pm follow the summary format's convention: 24-hour if either side is
+// past 12, otherwise the store opens in the morning (or at noon) and closes
+// in the afternoon or evening. A later range of a split day ("10-2, 3-9")
+// opens no earlier than after, the close of the range before it.
+func parseRange(from, to string, after time.Duration) (Interval, error) {
+	open, err := parseClock(from)
+	if err != nil {
+		return Interval{}, err
+	}
+	close, err := parseClock(to)
+	if err != nil {
+		return Interval{}, err
+	}
+
+	var interval Interval
+	switch {
+	case open.meridiem == 0 && close.meridiem == 0 && (open.hour > 12 || close.hour > 12 || open.hour == 0):
+		// 24-hour clock
+		interval.Open = time.Duration(open.hour)*time.Hour + time.Duration(open.minute)*time.Minute
+		interval.Close = time.Duration(close.hour)*time.Hour + time.Duration(close.minute)*time.Minute
+	default:
+		openMeridiem := open.meridiem
+		if openMeridiem == 0 {
+			openMeridiem = 'a'
+			if open.hour == 12 || open.offset('a') < after {
+				openMeridiem = 'p'
+			}
+		}
+		closeMeridiem := close.meridiem
+		if closeMeridiem == 0 {
+			closeMeridiem = 'p'
+			if close.hour == 12 {
+				closeMeridiem = 'a' // "10-12" closes at midnight
+			}
+		}
+		interval.Open = open.offset(openMeridiem)
+		interval.Close = close.offset(closeMeridiem)
+	}
+
+	// Closing at or before opening means closing after midnight
+	if interval.Close <= interval.Open {
+		interval.Close += 24 * time.Hour
+	}
+	if interval.Open >= 24*time.Hour {
+		return Interval{}, fmt.Errorf("invalid range %q-%q", strings.TrimSpace(from), strings.TrimSpace(to))
+	}
+	return interval, nil
+}
+
+// OpenAt reports whether the store is open at t, in the store's time zone
+func (w Week) OpenAt(t time.Time) bool {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := t.Sub(midnight)
+
+	for _, iv := range w[t.Weekday()] {
+		if offset >= iv.Open && offset < iv.Close {
+			return true
+		}
+	}
+
+	// Overnight hours from the day before
+	for _, iv := range w[(t.Weekday()+6)%7] {
+		if offset+24*time.Hour < iv.Close {
+			return true
+		}
+	}
+	return false
+}
+
+// String formats the week in a canonical form, starting on Monday:
+// "Mon 10:00-21:00; ...; Sun closed"
+func (w Week) String() string {
+	parts := make([]string, 0, 7)
+	for i := 1; i <= 7; i++ {
+		d := time.Weekday(i % 7)
+		parts = append(parts, d.String()[:3]+" "+FormatDay(w[d]))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// FormatDay formats a day's intervals as "10:00-21:00" or "closed"
+func FormatDay(intervals []Interval) string {
+	if len(intervals) == 0 {
+		return "closed"
+	}
+	parts := make([]string, 0, len(intervals))
+	for _, iv := range intervals {
+		parts = append(parts, formatOffset(iv.Open)+"-"+formatOffset(iv.Close))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatOffset formats an offset from midnight as hh:mm
+func formatOffset(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+// DetailedDay is one entry of the Best Buy detailedHours array
+type DetailedDay struct {
+	Day   string `json:"day"`   // "Monday"
+	Date  string `json:"date"`  // "2025-11-28"
+	Open  string `json:"open"`  // "10:00"
+	Close string `json:"close"` // "21:00"
+}
+
+// Schedule is a store's regular weekly hours plus hours for specific dates,
+// which take precedence (holidays, sale events)
+type Schedule struct {
+	Week  Week
+	Dates map[string][]Interval // keyed by YYYY-MM-DD
+}
+
+// ParseDetailed parses detailedHours entries into date-specific hours.
+// Entries without open and close times mark the store closed that day.
+func ParseDetailed(days []DetailedDay) (map[string][]Interval, error) {
+	dates := make(map[string][]Interval, len(days))
+	for _, d := range days {
+		if _, err := time.Parse(time.DateOnly, d.Date); err != nil {
+			return nil, fmt.Errorf("invalid date %q", d.Date)
+		}
+		if d.Open == "" && d.Close == "" {
+			dates[d.Date] = nil
+			continue
+		}
+
+		open, err := parse24(d.Open)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Date, err)
+		}
+		close, err := parse24(d.Close)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Date, err)
+		}
+		if close <= open {
+			close += 24 * time.Hour
+		}
+		dates[d.Date] = append(dates[d.Date], Interval{Open: open, Close: close})
+	}
+	return dates, nil
+}
+
+// parse24 parses a 24-hour "hh:mm" time
+func parse24(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// On returns the store's hours on the day of t, as offsets from that midnight
+func (s Schedule) On(t time.Time) []Interval {
+	if intervals, ok := s.Dates[t.Format(time.DateOnly)]; ok {
+		return intervals
+	}
+	return s.Week[t.Weekday()]
+}
+
+// OpenAt reports whether the store is open at t, in the store's time zone
+func (s Schedule) OpenAt(t time.Time) bool {
+	var day Week
+	day[t.Weekday()] = s.On(t)
+	yesterday := t.AddDate(0, 0, -1)
+	day[yesterday.Weekday()] = s.On(yesterday)
+	return day.OpenAt(t)
+}
+
+// NewSchedule builds a schedule from a summary hours string and detailedHours
+// entries. Either may be missing, but not both; a summary that doesn't parse
+// is ignored when there are detailed hours to fall back on.
+func NewSchedule(summary string, detailed []DetailedDay) (Schedule, error) {
+	dates, err := ParseDetailed(detailed)
+	if err != nil {
+		return Schedule{}, err
+	}
+	week, err := Parse(summary)
+	if err != nil && len(dates) == 0 {
+		return Schedule{}, err
+	}
+	return Schedule{Week: week, Dates: dates}, nil
+}
+
+// Zone returns the time zone of a store from its Best Buy gmtOffset in hours
+func Zone(gmtOffset int) *time.Location {
+	return time.FixedZone(fmt.Sprintf("GMT%+d", gmtOffset), gmtOffset*int(time.Hour/time.Second))
+}
+
+// Status is a store's opening status at a moment
+type Status struct {
+	Open  bool
+	Today string // the day's hours, "10:00-18:00" or "closed"
+
+	// Special is set when the day's hours differ from the regular week's,
+	// as they do around holidays and sale events
+	Special bool
+
+	// Opens is when a closed store next opens; zero if it is open or
+	// stays closed for the next week
+	Opens time.Time
+}
+
+// StatusAt returns the store's status at t, in the store's time zone
+func (s Schedule) StatusAt(t time.Time) Status {
+	today := s.On(t)
+	status := Status{Open: s.OpenAt(t), Today: FormatDay(today)}
+	if _, ok := s.Dates[t.Format(time.DateOnly)]; ok {
+		status.Special = !slices.Equal(today, s.Week[t.Weekday()])
+	}
+	if !status.Open {
+		status.Opens = s.nextOpen(t)
+	}
+	return status
+}
+
+// nextOpen returns when the store next opens after t, or the zero time if
+// it stays closed for the next week
+func (s Schedule) nextOpen(t time.Time) time.Time {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	for i := 0; i <= 7; i++ {
+		day := midnight.AddDate(0, 0, i)
+		for _, iv := range s.On(day) {
+			if open := day.Add(iv.Open); open.After(t) {
+				return open
+			}
+		}
+	}
+	return time.Time{}
+}
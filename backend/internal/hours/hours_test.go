@@ -0,0 +1,199 @@
+package hours
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCorpus(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "hours.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var input string
+	cases := 0
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		switch {
+		case text == "" || strings.HasPrefix(text, "#"):
+			continue
+		case !strings.HasPrefix(text, "=> "):
+			input = text
+			continue
+		}
+
+		want := strings.TrimPrefix(text, "=> ")
+		got := "error"
+		if week, err := Parse(input); err == nil {
+			got = week.String()
+		}
+		if got != want {
+			t.Errorf("line %d: Parse(%q)\ngot:  %s\nwant: %s", line, input, got, want)
+		}
+		cases++
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if cases == 0 {
+		t.Fatal("corpus is empty")
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	for _, s := range []string{"", "   ", ";;", "\n"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", s)
+		}
+	}
+}
+
+func TestParseDetailed(t *testing.T) {
+	dates, err := ParseDetailed([]DetailedDay{
+		{Day: "Thursday", Date: "2025-11-27"},
+		{Day: "Friday", Date: "2025-11-28", Open: "05:00", Close: "23:00"},
+		{Day: "Saturday", Date: "2025-11-29", Open: "08:00", Close: "01:00"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"2025-11-27": "closed",
+		"2025-11-28": "05:00-23:00",
+		"2025-11-29": "08:00-25:00",
+	}
+	for date, w := range want {
+		intervals, ok := dates[date]
+		if !ok {
+			t.Errorf("%s missing", date)
+			continue
+		}
+		if got := FormatDay(intervals); got != w {
+			t.Errorf("%s: got %s, want %s", date, got, w)
+		}
+	}
+
+	for _, bad := range []DetailedDay{
+		{Day: "Friday", Date: "11/28/2025", Open: "05:00", Close: "23:00"},
+		{Day: "Friday", Date: "2025-11-28", Open: "5am", Close: "23:00"},
+		{Day: "Friday", Date: "2025-11-28", Open: "05:00", Close: "25:00"},
+	} {
+		if _, err := ParseDetailed([]DetailedDay{bad}); err == nil {
+			t.Errorf("ParseDetailed(%+v) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestOpenAt(t *testing.T) {
+	week, err := Parse("Mon-Thurs: 10-9; Fri: 10am-1am; Sat: 9-9; Sun: Closed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc := time.FixedZone("PST", -8*60*60)
+	at := func(day, clock string) time.Time {
+		tm, err := time.ParseInLocation("2006-01-02 15:04", day+" "+clock, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tm
+	}
+
+	// 2025-11-24 is a Monday
+	tests := []struct {
+		t    time.Time
+		want bool
+	}{
+		{at("2025-11-24", "09:59"), false},
+		{at("2025-11-24", "10:00"), true},
+		{at("2025-11-24", "20:59"), true},
+		{at("2025-11-24", "21:00"), false},
+		{at("2025-11-28", "23:30"), true},  // Friday late
+		{at("2025-11-29", "00:30"), true},  // Friday's hours run past midnight
+		{at("2025-11-29", "01:00"), false}, // closed until Saturday opens
+		{at("2025-11-29", "09:00"), true},
+		{at("2025-11-30", "12:00"), false}, // Sunday
+		{at("2025-12-01", "00:30"), false}, // Sunday closed, so nothing carries over
+	}
+	for _, tt := range tests {
+		if got := week.OpenAt(tt.t); got != tt.want {
+			t.Errorf("OpenAt(%s) = %v, want %v", tt.t.Format("Mon 15:04"), got, tt.want)
+		}
+	}
+}
+
+func TestScheduleOpenAt(t *testing.T) {
+	week, err := Parse("Mon-Sat: 10-9; Sun: 11-7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dates, err := ParseDetailed([]DetailedDay{
+		{Day: "Thursday", Date: "2025-11-27"},                              // Thanksgiving
+		{Day: "Friday", Date: "2025-11-28", Open: "05:00", Close: "02:00"}, // Black Friday
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Schedule{Week: week, Dates: dates}
+
+	tests := []struct {
+		t    string
+		want bool
+	}{
+		{"2025-11-26T12:00:00Z", true},  // regular Wednesday
+		{"2025-11-27T12:00:00Z", false}, // closed for the holiday
+		{"2025-11-28T05:30:00Z", true},  // early opening
+		{"2025-11-29T01:30:00Z", true},  // Black Friday runs past midnight
+		{"2025-11-29T02:30:00Z", false},
+		{"2025-11-29T12:00:00Z", true}, // back to regular hours
+	}
+	for _, tt := range tests {
+		tm, _ := time.Parse(time.RFC3339, tt.t)
+		if got := s.OpenAt(tm); got != tt.want {
+			t.Errorf("OpenAt(%s) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestStatusAt(t *testing.T) {
+	s, err := NewSchedule("Mon-Sat: 10-9; Sun: 11-7", []DetailedDay{
+		{Day: "Wednesday", Date: "2025-11-26", Open: "10:00", Close: "21:00"}, // same as usual
+		{Day: "Thursday", Date: "2025-11-27"},                                 // Thanksgiving
+		{Day: "Friday", Date: "2025-11-28", Open: "05:00", Close: "23:00"},    // Black Friday
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := func(s string) time.Time {
+		tm, _ := time.Parse(time.RFC3339, s)
+		return tm
+	}
+	tests := []struct {
+		t    string
+		want Status
+	}{
+		{"2025-11-26T12:00:00Z", Status{Open: true, Today: "10:00-21:00"}},
+		{"2025-11-26T22:00:00Z", Status{Today: "10:00-21:00", Opens: at("2025-11-28T05:00:00Z")}},
+		{"2025-11-27T12:00:00Z", Status{Today: "closed", Special: true, Opens: at("2025-11-28T05:00:00Z")}},
+		{"2025-11-28T06:00:00Z", Status{Open: true, Today: "05:00-23:00", Special: true}},
+		{"2025-11-29T08:00:00Z", Status{Today: "10:00-21:00", Opens: at("2025-11-29T10:00:00Z")}},
+	}
+	for _, tt := range tests {
+		if got := s.StatusAt(at(tt.t)); got != tt.want {
+			t.Errorf("StatusAt(%s) = %+v, want %+v", tt.t, got, tt.want)
+		}
+	}
+
+	if _, err := NewSchedule("", nil); err == nil {
+		t.Error("NewSchedule with no hours succeeded, want error")
+	}
+}
@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Locale is a supported language code
+type Locale string
+
+// Supported locales
+const (
+	English Locale = "en"
+	Spanish Locale = "es"
+	French  Locale = "fr"
+)
+
+// Default is used when no supported locale was requested
+const Default = English
+
+// Supported lists the locales with translations
+var Supported = []Locale{English, Spanish, French}
+
+// Parse parses a language tag such as "fr" or "fr-CA" into a supported locale
+func Parse(tag string) (Locale, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	for _, l := range Supported {
+		if Locale(tag) == l {
+			return l, true
+		}
+	}
+	return Default, false
+}
+
+// FromAcceptLanguage picks the first supported locale from an Accept-Language header.
+// Quality values are ignored; browsers already list languages in preference order.
+func FromAcceptLanguage(header string) Locale {
+	for _, part := range strings.Split(header, ",") {
+		tag, _, _ := strings.Cut(part, ";")
+		if l, ok := Parse(tag); ok {
+			return l
+		}
+	}
+	return Default
+}
+
+// T returns the message for key in the given locale, falling back to English.
+// Messages with format verbs are formatted with args.
+func T(locale Locale, key string, args ...any) string {
+	translations, ok := messages[key]
+	if !ok {
+		return key
+	}
+	msg, ok := translations[locale]
+	if !ok {
+		msg = translations[Default]
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(msg, args...)
+	}
+	return msg
+}
+
+// Context key for locale
+type contextKey string
+
+const localeContextKey contextKey = "locale"
+
+// WithLocale returns a context carrying the locale
+func WithLocale(ctx context.Context, locale Locale) context.Context {
+	return context.WithValue(ctx, localeContextKey, locale)
+}
+
+// FromContext gets the locale from context
+func FromContext(ctx context.Context) Locale {
+	if l, ok := ctx.Value(localeContextKey).(Locale); ok {
+		return l
+	}
+	return Default
+}
+
+// Middleware stores the locale requested via Accept-Language in the request context
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithLocale(r.Context(), FromAcceptLanguage(r.Header.Get("Accept-Language")))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,836 @@
+package i18n
+
+// messages maps message keys to their translations.
+// Notification templates use Go text/template syntax (see notify.AlertData).
+var messages = map[string]map[Locale]string{
+	// User-facing errors
+	"error.not_authenticated": {
+		English: "not authenticated",
+		Spanish: "no has iniciado sesión",
+		French:  "vous n'êtes pas connecté",
+	},
+	"error.store_required": {
+		English: "store is required",
+		Spanish: "la tienda es obligatoria",
+		French:  "le magasin est obligatoire",
+	},
+	"error.product_required": {
+		English: "product is required",
+		Spanish: "el producto es obligatorio",
+		French:  "le produit est obligatoire",
+	},
+	"error.template_required": {
+		English: "template is required",
+		Spanish: "la plantilla es obligatoria",
+		French:  "le modèle est obligatoire",
+	},
+	"error.admin_required": {
+		English: "only admins can change default templates",
+		Spanish: "solo los administradores pueden cambiar las plantillas predeterminadas",
+		French:  "seuls les administrateurs peuvent modifier les modèles par défaut",
+	},
+	"error.admin_only": {
+		English: "this action requires an admin",
+		Spanish: "esta acción requiere un administrador",
+		French:  "cette action nécessite un administrateur",
+	},
+	"error.watcher_unavailable": {
+		English: "the stock watcher is not running",
+		Spanish: "el monitor de existencias no está en ejecución",
+		French:  "le suivi des stocks n'est pas actif",
+	},
+	"error.channel_required": {
+		English: "channel is required",
+		Spanish: "el canal es obligatorio",
+		French:  "le canal est obligatoire",
+	},
+	"error.sku_not_found": {
+		English: "Best Buy has no product with SKU %s",
+		Spanish: "Best Buy no tiene ningún producto con el SKU %s",
+		French:  "Best Buy n'a aucun produit avec le SKU %s",
+	},
+	"error.sku_restricted": {
+		English: "Best Buy doesn't publish store availability for SKU %s",
+		Spanish: "Best Buy no publica la disponibilidad en tienda del SKU %s",
+		French:  "Best Buy ne publie pas la disponibilité en magasin du SKU %s",
+	},
+	"error.sku_rate_limited": {
+		English: "too many requests to check SKU %s; try again shortly",
+		Spanish: "demasiadas solicitudes para comprobar el SKU %s; inténtalo de nuevo en breve",
+		French:  "trop de requêtes pour vérifier le SKU %s ; réessayez dans un instant",
+	},
+	"error.sku_quota_exceeded": {
+		English: "the daily Best Buy API quota is used up; SKU %s wasn't checked",
+		Spanish: "se agotó la cuota diaria de la API de Best Buy; no se comprobó el SKU %s",
+		French:  "le quota quotidien de l'API Best Buy est épuisé ; le SKU %s n'a pas été vérifié",
+	},
+	"error.sku_api_key": {
+		English: "the Best Buy API key was rejected; SKU %s wasn't checked",
+		Spanish: "la clave de la API de Best Buy fue rechazada; no se comprobó el SKU %s",
+		French:  "la clé de l'API Best Buy a été refusée ; le SKU %s n'a pas été vérifié",
+	},
+	"error.sku_unavailable": {
+		English: "couldn't check SKU %s; Best Buy didn't respond as expected",
+		Spanish: "no se pudo comprobar el SKU %s; Best Buy no respondió como se esperaba",
+		French:  "impossible de vérifier le SKU %s ; Best Buy n'a pas répondu comme prévu",
+	},
+	"error.bestbuy_not_found": {
+		English: "Best Buy has no such product",
+		Spanish: "Best Buy no tiene ese producto",
+		French:  "Best Buy n'a pas ce produit",
+	},
+	"error.bestbuy_restricted": {
+		English: "Best Buy doesn't share availability for this product",
+		Spanish: "Best Buy no comparte la disponibilidad de este producto",
+		French:  "Best Buy ne partage pas la disponibilité de ce produit",
+	},
+	"error.bestbuy_invalid_query": {
+		English: "Best Buy couldn't run that search; try other words",
+		Spanish: "Best Buy no pudo hacer esa búsqueda; prueba con otras palabras",
+		French:  "Best Buy n'a pas pu effectuer cette recherche ; essayez d'autres mots",
+	},
+	"error.bestbuy_quota_exceeded": {
+		English: "today's Best Buy lookups are used up; try again tomorrow",
+		Spanish: "las consultas a Best Buy de hoy se agotaron; inténtalo mañana",
+		French:  "les recherches Best Buy du jour sont épuisées ; réessayez demain",
+	},
+	"error.bestbuy_rate_limited": {
+		English: "Best Buy is busy; try again in a few seconds",
+		Spanish: "Best Buy está ocupado; inténtalo de nuevo en unos segundos",
+		French:  "Best Buy est occupé ; réessayez dans quelques secondes",
+	},
+	"error.bestbuy_unavailable": {
+		English: "Best Buy lookups are unavailable right now",
+		Spanish: "las consultas a Best Buy no están disponibles ahora",
+		French:  "les recherches Best Buy sont indisponibles pour le moment",
+	},
+	"error.maintenance": {
+		English: "stock checker is read-only during maintenance; try again in %d minutes",
+		Spanish: "stock checker es de solo lectura durante el mantenimiento; inténtalo de nuevo en %d minutos",
+		French:  "stock checker est en lecture seule pendant la maintenance ; réessayez dans %d minutes",
+	},
+	"error.invalid_channel_type": {
+		English: "unknown channel type %q",
+		Spanish: "tipo de canal desconocido %q",
+		French:  "type de canal inconnu %q",
+	},
+	"error.invalid_rollup": {
+		English: "unknown rollup mode %q (use summary or per_store)",
+		Spanish: "modo de agrupación desconocido %q (usa summary o per_store)",
+		French:  "mode de regroupement inconnu %q (utilisez summary ou per_store)",
+	},
+	"error.location_required": {
+		English: "location name and postal code are required",
+		Spanish: "el nombre de la ubicación y el código postal son obligatorios",
+		French:  "le nom du lieu et le code postal sont obligatoires",
+	},
+	"error.location_not_found": {
+		English: "no location named %q",
+		Spanish: "no hay ninguna ubicación llamada %q",
+		French:  "aucun lieu nommé %q",
+	},
+	"error.invalid_radius": {
+		English: "radius must be between 1 and %d miles",
+		Spanish: "el radio debe estar entre 1 y %d millas",
+		French:  "le rayon doit être compris entre 1 et %d miles",
+	},
+	"error.channel_not_configured": {
+		English: "channel %q is not configured",
+		Spanish: "el canal %q no está configurado",
+		French:  "le canal %q n'est pas configuré",
+	},
+	"error.delivery_failed": {
+		English: "delivery failed",
+		Spanish: "el envío falló",
+		French:  "l'envoi a échoué",
+	},
+	"error.delivery_failed_status": {
+		English: "delivery failed (status %d)",
+		Spanish: "el envío falló (estado %d)",
+		French:  "l'envoi a échoué (statut %d)",
+	},
+	"error.destination_blocked": {
+		English: "the channel points at a private or local address, which isn't allowed",
+		Spanish: "el canal apunta a una dirección privada o local, lo que no está permitido",
+		French:  "le canal pointe vers une adresse privée ou locale, ce qui n'est pas autorisé",
+	},
+	"error.unsupported_locale": {
+		English: "unsupported locale %q",
+		Spanish: "idioma no compatible: %q",
+		French:  "langue non prise en charge : %q",
+	},
+	"error.unsupported_retailer": {
+		English: "unsupported retailer %s",
+		Spanish: "minorista no compatible: %s",
+		French:  "enseigne non prise en charge : %s",
+	},
+	"error.invalid_postal_code": {
+		English: "invalid postal code %q",
+		Spanish: "código postal no válido %q",
+		French:  "code postal non valide %q",
+	},
+	"error.confirm_required": {
+		English: "this can't be done by accident; set confirm to go ahead",
+		Spanish: "esto no se puede hacer por accidente; activa confirm para continuar",
+		French:  "cette action ne peut pas être faite par accident ; activez confirm pour continuer",
+	},
+	"error.too_many_items": {
+		English: "lists are limited to %d products",
+		Spanish: "las listas están limitadas a %d productos",
+		French:  "les listes sont limitées à %d produits",
+	},
+	"error.too_many_stores": {
+		English: "at most %d stores can be requested at once",
+		Spanish: "se pueden solicitar como máximo %d tiendas a la vez",
+		French:  "au plus %d magasins peuvent être demandés à la fois",
+	},
+	"error.watch_query_required": {
+		English: "a search to watch is required",
+		Spanish: "se requiere una búsqueda para seguir",
+		French:  "une recherche à suivre est obligatoire",
+	},
+	"error.watch_query_too_long": {
+		English: "searches to watch are limited to %d characters",
+		Spanish: "las búsquedas a seguir están limitadas a %d caracteres",
+		French:  "les recherches à suivre sont limitées à %d caractères",
+	},
+	"error.too_many_product_watches": {
+		English: "you can watch at most %d searches",
+		Spanish: "puedes seguir como máximo %d búsquedas",
+		French:  "vous pouvez suivre au plus %d recherches",
+	},
+	"error.product_watch_not_found": {
+		English: "product watch %d not found",
+		Spanish: "no se encontró la búsqueda seguida %d",
+		French:  "recherche suivie %d introuvable",
+	},
+	"error.email_required": {
+		English: "an email address is required",
+		Spanish: "se requiere una dirección de correo electrónico",
+		French:  "une adresse e-mail est obligatoire",
+	},
+	"error.invalid_email": {
+		English: "%q is not a valid email address",
+		Spanish: "%q no es una dirección de correo electrónico válida",
+		French:  "%q n'est pas une adresse e-mail valide",
+	},
+	"error.allowed_email_not_found": {
+		English: "%s is not allowed to sign in",
+		Spanish: "%s no tiene permiso para iniciar sesión",
+		French:  "%s n'est pas autorisé à se connecter",
+	},
+	"error.cannot_remove_own_email": {
+		English: "you can't remove your own email address",
+		Spanish: "no puedes eliminar tu propia dirección de correo electrónico",
+		French:  "vous ne pouvez pas retirer votre propre adresse e-mail",
+	},
+	"error.domain_required": {
+		English: "an email domain is required",
+		Spanish: "se requiere un dominio de correo electrónico",
+		French:  "un domaine de messagerie est obligatoire",
+	},
+	"error.invalid_domain": {
+		English: "%q is not a valid email domain",
+		Spanish: "%q no es un dominio de correo electrónico válido",
+		French:  "%q n'est pas un domaine de messagerie valide",
+	},
+	"error.allowed_domain_not_found": {
+		English: "addresses on %s are not allowed to sign in",
+		Spanish: "las direcciones de %s no tienen permiso para iniciar sesión",
+		French:  "les adresses de %s ne sont pas autorisées à se connecter",
+	},
+	"error.cannot_remove_own_domain": {
+		English: "you can't remove your own email domain unless your address is allowed on its own",
+		Spanish: "no puedes eliminar tu propio dominio de correo electrónico a menos que tu dirección esté permitida por sí sola",
+		French:  "vous ne pouvez pas retirer votre propre domaine de messagerie sauf si votre adresse est autorisée individuellement",
+	},
+	"error.invites_disabled": {
+		English: "invite links need a login provider to be configured",
+		Spanish: "los enlaces de invitación necesitan un proveedor de inicio de sesión configurado",
+		French:  "les liens d'invitation nécessitent un fournisseur de connexion configuré",
+	},
+	"error.drop_sku_invalid": {
+		English: "%q is not a Best Buy SKU or product URL",
+		Spanish: "%q no es un SKU ni una URL de producto de Best Buy",
+		French:  "%q n'est ni un SKU ni une URL de produit Best Buy",
+	},
+	"error.drop_title_invalid": {
+		English: "drop titles are required and can be at most %d characters",
+		Spanish: "el título del lanzamiento es obligatorio y puede tener como máximo %d caracteres",
+		French:  "le titre du lancement est obligatoire et peut comporter au plus %d caractères",
+	},
+	"error.drop_details_too_long": {
+		English: "drop details can be at most %d characters",
+		Spanish: "los detalles del lanzamiento pueden tener como máximo %d caracteres",
+		French:  "les détails du lancement peuvent comporter au plus %d caractères",
+	},
+	"error.drop_window_invalid": {
+		English: "a drop needs an opening time, and must close after it opens",
+		Spanish: "un lanzamiento necesita una hora de apertura y debe cerrar después de abrir",
+		French:  "un lancement doit avoir une heure d'ouverture et se terminer après celle-ci",
+	},
+	"error.drop_url_invalid": {
+		English: "the announcement link must be an https URL",
+		Spanish: "el enlace del anuncio debe ser una URL https",
+		French:  "le lien de l'annonce doit être une URL https",
+	},
+	"error.drop_not_found": {
+		English: "drop %d not found",
+		Spanish: "no se encontró el lanzamiento %d",
+		French:  "lancement %d introuvable",
+	},
+	"error.inbound_email_disabled": {
+		English: "forwarding emails isn't set up on this server",
+		Spanish: "el reenvío de correos no está configurado en este servidor",
+		French:  "le transfert d'e-mails n'est pas configuré sur ce serveur",
+	},
+	"error.reminder_not_found": {
+		English: "reminder %d not found",
+		Spanish: "no se encontró el recordatorio %d",
+		French:  "rappel %d introuvable",
+	},
+	"error.invite_note_too_long": {
+		English: "invite notes can be at most %d characters",
+		Spanish: "las notas de invitación pueden tener como máximo %d caracteres",
+		French:  "les notes d'invitation peuvent comporter au plus %d caractères",
+	},
+	"error.invite_not_found": {
+		English: "unused invite %d not found",
+		Spanish: "no se encontró la invitación sin usar %d",
+		French:  "invitation inutilisée %d introuvable",
+	},
+	"error.role_required": {
+		English: "a role is required",
+		Spanish: "se requiere un rol",
+		French:  "un rôle est obligatoire",
+	},
+	"error.cannot_demote_self": {
+		English: "you can't remove your own admin role",
+		Spanish: "no puedes quitarte tu propio rol de administrador",
+		French:  "vous ne pouvez pas retirer votre propre rôle d'administrateur",
+	},
+	"error.cannot_delete_last_admin": {
+		English: "you're the only admin; make someone else an admin before deleting your account",
+		Spanish: "eres el único administrador; haz administrador a otra persona antes de eliminar tu cuenta",
+		French:  "vous êtes le seul administrateur ; nommez un autre administrateur avant de supprimer votre compte",
+	},
+	"error.streaming_unavailable": {
+		English: "live stock updates aren't available on this server",
+		Spanish: "las actualizaciones de existencias en directo no están disponibles en este servidor",
+		French:  "les mises à jour du stock en direct ne sont pas disponibles sur ce serveur",
+	},
+	"error.invalid_buffer_size": {
+		English: "buffer size must be between 1 and %d",
+		Spanish: "el tamaño del búfer debe estar entre 1 y %d",
+		French:  "la taille du tampon doit être comprise entre 1 et %d",
+	},
+	"error.invalid_resume_token": {
+		English: "invalid resume token; start a new stream without one",
+		Spanish: "token de reanudación no válido; inicia una nueva transmisión sin él",
+		French:  "jeton de reprise non valide ; démarrez un nouveau flux sans jeton",
+	},
+	"error.too_many_streams": {
+		English: "at most %d live updates can be open at once; close another tab and try again",
+		Spanish: "se pueden tener como máximo %d actualizaciones en directo abiertas a la vez; cierra otra pestaña e inténtalo de nuevo",
+		French:  "au plus %d mises à jour en direct peuvent être ouvertes à la fois ; fermez un autre onglet et réessayez",
+	},
+	"error.stream_stalled": {
+		English: "live updates stopped because they weren't being read; reconnect to resume",
+		Spanish: "las actualizaciones en directo se detuvieron porque no se estaban leyendo; vuelve a conectarte para continuar",
+		French:  "les mises à jour en direct se sont arrêtées car elles n'étaient pas lues ; reconnectez-vous pour reprendre",
+	},
+	"error.server_shutting_down": {
+		English: "the server is restarting; try again in a moment",
+		Spanish: "el servidor se está reiniciando; inténtalo de nuevo en un momento",
+		French:  "le serveur redémarre ; réessayez dans un instant",
+	},
+	"error.user_not_found": {
+		English: "user %d not found",
+		Spanish: "no se encontró el usuario %d",
+		French:  "utilisateur %d introuvable",
+	},
+	"error.invalid_api_key": {
+		English: "invalid API key",
+		Spanish: "clave de API no válida",
+		French:  "clé d'API non valide",
+	},
+	"error.api_key_not_allowed": {
+		English: "API keys can't be used for this; sign in instead",
+		Spanish: "las claves de API no se pueden usar para esto; inicia sesión",
+		French:  "les clés d'API ne peuvent pas servir à cela ; connectez-vous",
+	},
+	"error.api_key_name_required": {
+		English: "an API key name is required",
+		Spanish: "se requiere un nombre para la clave de API",
+		French:  "un nom de clé d'API est obligatoire",
+	},
+	"error.api_key_name_too_long": {
+		English: "API key names can be at most %d characters",
+		Spanish: "los nombres de las claves de API pueden tener como máximo %d caracteres",
+		French:  "les noms de clé d'API peuvent comporter au plus %d caractères",
+	},
+	"error.too_many_api_keys": {
+		English: "you can have at most %d API keys; revoke one first",
+		Spanish: "puedes tener como máximo %d claves de API; revoca una primero",
+		French:  "vous pouvez avoir au plus %d clés d'API ; révoquez-en une d'abord",
+	},
+	"error.api_key_not_found": {
+		English: "API key %d not found",
+		Spanish: "no se encontró la clave de API %d",
+		French:  "clé d'API %d introuvable",
+	},
+	"error.session_revoked": {
+		English: "this session was signed out",
+		Spanish: "se cerró esta sesión",
+		French:  "cette session a été déconnectée",
+	},
+	"error.session_not_found": {
+		English: "session %d not found",
+		Spanish: "no se encontró la sesión %d",
+		French:  "session %d introuvable",
+	},
+	"error.invalid_page_token": {
+		English: "invalid page token",
+		Spanish: "token de página no válido",
+		French:  "jeton de page non valide",
+	},
+	"error.invalid_sync_token": {
+		English: "invalid sync token",
+		Spanish: "token de sincronización no válido",
+		French:  "jeton de synchronisation non valide",
+	},
+	"error.invalid_field_mask": {
+		English: "field %q can't be updated",
+		Spanish: "el campo %q no se puede actualizar",
+		French:  "le champ %q ne peut pas être modifié",
+	},
+	"error.store_not_saved": {
+		English: "store %s is not in your list",
+		Spanish: "la tienda %s no está en tu lista",
+		French:  "le magasin %s n'est pas dans votre liste",
+	},
+	"error.product_not_saved": {
+		English: "product %s is not in your list",
+		Spanish: "el producto %s no está en tu lista",
+		French:  "le produit %s n'est pas dans votre liste",
+	},
+	"error.nothing_to_undo": {
+		English: "nothing changed in the last %d minutes to undo",
+		Spanish: "no hay cambios de los últimos %d minutos para deshacer",
+		French:  "aucune modification des %d dernières minutes à annuler",
+	},
+	"error.product_type_required": {
+		English: "product type is required",
+		Spanish: "el tipo de producto es obligatorio",
+		French:  "le type de produit est obligatoire",
+	},
+	"error.set_name_required": {
+		English: "set name is required",
+		Spanish: "el nombre de la expansión es obligatorio",
+		French:  "le nom de l'extension est obligatoire",
+	},
+	"error.set_not_watched": {
+		English: "you are not watching the set %s",
+		Spanish: "no estás siguiendo la expansión %s",
+		French:  "vous ne suivez pas l'extension %s",
+	},
+	"error.preferences_required": {
+		English: "preferences are required",
+		Spanish: "las preferencias son obligatorias",
+		French:  "les préférences sont obligatoires",
+	},
+	"error.rule_required": {
+		English: "rule is required",
+		Spanish: "la regla es obligatoria",
+		French:  "la règle est obligatoire",
+	},
+	"error.invalid_resource_name": {
+		English: "invalid resource name %q",
+		Spanish: "nombre de recurso no válido: %q",
+		French:  "nom de ressource non valide : %q",
+	},
+	"error.not_your_resource": {
+		English: "%s belongs to another user",
+		Spanish: "%s pertenece a otro usuario",
+		French:  "%s appartient à un autre utilisateur",
+	},
+	"error.invalid_quantity": {
+		English: "quantity must be at least 1",
+		Spanish: "la cantidad debe ser al menos 1",
+		French:  "la quantité doit être d'au moins 1",
+	},
+	"error.invalid_price": {
+		English: "price can't be negative",
+		Spanish: "el precio no puede ser negativo",
+		French:  "le prix ne peut pas être négatif",
+	},
+	"error.invalid_currency": {
+		English: "invalid currency code %q",
+		Spanish: "código de moneda no válido: %q",
+		French:  "code de devise non valide : %q",
+	},
+	"error.invalid_date": {
+		English: "invalid date %q; use YYYY-MM-DD",
+		Spanish: "fecha no válida: %q; usa AAAA-MM-DD",
+		French:  "date non valide : %q ; utilisez AAAA-MM-JJ",
+	},
+	"error.photo_too_large": {
+		English: "photos are limited to %d MB",
+		Spanish: "las fotos están limitadas a %d MB",
+		French:  "les photos sont limitées à %d Mo",
+	},
+	"error.invalid_photo": {
+		English: "photos must be JPEG, PNG or WebP images",
+		Spanish: "las fotos deben ser imágenes JPEG, PNG o WebP",
+		French:  "les photos doivent être des images JPEG, PNG ou WebP",
+	},
+	"error.sighting_not_found": {
+		English: "sighting %d not found",
+		Spanish: "no se encontró el avistamiento %d",
+		French:  "signalement %d introuvable",
+	},
+	"error.sighting_photo_not_found": {
+		English: "sighting %d has no photo",
+		Spanish: "el avistamiento %d no tiene foto",
+		French:  "le signalement %d n'a pas de photo",
+	},
+	"error.sighting_already_moderated": {
+		English: "sighting %d was already moderated",
+		Spanish: "el avistamiento %d ya fue moderado",
+		French:  "le signalement %d a déjà été modéré",
+	},
+	"error.sighting_hourly_limit": {
+		English: "you can report up to %d sightings an hour",
+		Spanish: "puedes reportar hasta %d avistamientos por hora",
+		French:  "vous pouvez faire jusqu'à %d signalements par heure",
+	},
+	"error.sighting_daily_limit": {
+		English: "you can report up to %d sightings a day",
+		Spanish: "puedes reportar hasta %d avistamientos por día",
+		French:  "vous pouvez faire jusqu'à %d signalements par jour",
+	},
+	"error.sighting_reporter_muted": {
+		English: "too many of your recent sightings were rejected; you can report again once they're older than %d days",
+		Spanish: "demasiados de tus avistamientos recientes fueron rechazados; podrás reportar de nuevo cuando tengan más de %d días",
+		French:  "trop de vos signalements récents ont été refusés ; vous pourrez signaler à nouveau lorsqu'ils auront plus de %d jours",
+	},
+	"error.acquisition_not_found": {
+		English: "purchase %d not found",
+		Spanish: "no se encontró la compra %d",
+		French:  "achat %d introuvable",
+	},
+	"error.credentials_disabled": {
+		English: "credentials can't be changed at runtime; set CREDENTIALS_KEY on the server",
+		Spanish: "las credenciales no se pueden cambiar en ejecución; configura CREDENTIALS_KEY en el servidor",
+		French:  "les identifiants ne peuvent pas être modifiés à chaud ; définissez CREDENTIALS_KEY sur le serveur",
+	},
+	"error.unknown_credential": {
+		English: "unknown credential %q",
+		Spanish: "credencial desconocida %q",
+		French:  "identifiant inconnu %q",
+	},
+	"error.invalid_credential": {
+		English: "invalid value for %s: %s",
+		Spanish: "valor no válido para %s: %s",
+		French:  "valeur invalide pour %s : %s",
+	},
+	"error.credential_not_overridden": {
+		English: "%s already uses the server's environment",
+		Spanish: "%s ya usa el entorno del servidor",
+		French:  "%s utilise déjà l'environnement du serveur",
+	},
+
+	// Notifications
+	"notify.title_template": {
+		English: `In stock: {{.Product}}`,
+		Spanish: `Disponible: {{.Product}}`,
+		French:  `En stock : {{.Product}}`,
+	},
+	"notify.body_template": {
+		English: `{{price .Price}} at {{len .Stores}} store{{if ne (len .Stores) 1}}s{{end}} (closest {{miles .Distance}})
+{{range .Stores}}- {{.Name}} ({{miles .Distance}}){{if .LowStock}} - low stock{{end}}
+{{end}}`,
+		Spanish: `{{price .Price}} en {{len .Stores}} tienda{{if ne (len .Stores) 1}}s{{end}} (la más cercana a {{miles .Distance}})
+{{range .Stores}}- {{.Name}} ({{miles .Distance}}){{if .LowStock}} - pocas unidades{{end}}
+{{end}}`,
+		French: `{{price .Price}} dans {{len .Stores}} magasin{{if gt (len .Stores) 1}}s{{end}} (le plus proche à {{miles .Distance}})
+{{range .Stores}}- {{.Name}} ({{miles .Distance}}){{if .LowStock}} - stock faible{{end}}
+{{end}}`,
+	},
+	"notify.view_product": {
+		English: "View on Best Buy",
+		Spanish: "Ver en Best Buy",
+		French:  "Voir sur Best Buy",
+	},
+	"notify.field_price": {
+		English: "Price",
+		Spanish: "Precio",
+		French:  "Prix",
+	},
+	"notify.field_store": {
+		English: "Closest store",
+		Spanish: "Tienda más cercana",
+		French:  "Magasin le plus proche",
+	},
+	"notify.field_msrp": {
+		English: "MSRP",
+		Spanish: "PVP recomendado",
+		French:  "Prix conseillé",
+	},
+	"notify.above_msrp_note": {
+		English: "Priced %s above MSRP. Check it isn't a marked-up bundle before buying.",
+		Spanish: "Cuesta %s más que el PVP recomendado. Comprueba que no sea un lote con sobreprecio antes de comprar.",
+		French:  "Vendu %s au-dessus du prix conseillé. Vérifiez qu'il ne s'agit pas d'un lot surévalué avant d'acheter.",
+	},
+	"notify.field_distance": {
+		English: "Distance",
+		Spanish: "Distancia",
+		French:  "Distance",
+	},
+	"notify.field_confidence": {
+		English: "On the shelf",
+		Spanish: "En el estante",
+		French:  "En rayon",
+	},
+	"notify.confidence_high": {
+		English: "Usually, say shoppers",
+		Spanish: "Casi siempre, según los compradores",
+		French:  "Presque toujours, selon les clients",
+	},
+	"notify.confidence_medium": {
+		English: "Sometimes, say shoppers",
+		Spanish: "A veces, según los compradores",
+		French:  "Parfois, selon les clients",
+	},
+	"notify.confidence_low": {
+		English: "Rarely, say shoppers",
+		Spanish: "Rara vez, según los compradores",
+		French:  "Rarement, selon les clients",
+	},
+	"notify.low_confidence_note": {
+		English: "Shoppers often haven't found listed stock at %s. Call ahead before making the trip.",
+		Spanish: "Los compradores a menudo no encuentran el stock anunciado en %s. Llama antes de ir.",
+		French:  "Les clients trouvent rarement le stock annoncé chez %s. Appelez avant de vous déplacer.",
+	},
+	"notify.field_hours_today": {
+		English: "Hours today",
+		Spanish: "Horario de hoy",
+		French:  "Horaires du jour",
+	},
+	"notify.field_special_hours": {
+		English: "Holiday hours today",
+		Spanish: "Horario festivo de hoy",
+		French:  "Horaires exceptionnels du jour",
+	},
+	"notify.drop_title": {
+		English: "Invitations open: %s",
+		Spanish: "Invitaciones abiertas: %s",
+		French:  "Invitations ouvertes : %s",
+	},
+	"notify.drop_body": {
+		English: "Entries are open for %s.",
+		Spanish: "Ya se puede participar en %s.",
+		French:  "Les inscriptions sont ouvertes pour %s.",
+	},
+	"notify.drop_view": {
+		English: "View announcement",
+		Spanish: "Ver anuncio",
+		French:  "Voir l'annonce",
+	},
+	"notify.field_drop_closes": {
+		English: "Entries close",
+		Spanish: "Cierre de participación",
+		French:  "Fin des inscriptions",
+	},
+	"notify.field_best_visit": {
+		English: "Best time to go",
+		Spanish: "Mejor momento para ir",
+		French:  "Meilleur moment pour y aller",
+	},
+	"notify.visit_at_open": {
+		English: "%s, at opening (trucks usually arrive %s)",
+		Spanish: "%s, a la apertura (los camiones suelen llegar: %s)",
+		French:  "%s, à l'ouverture (les camions arrivent en général : %s)",
+	},
+	"notify.visit_around": {
+		English: "%s (restocks usually show up %s around %s)",
+		Spanish: "%s (el reabastecimiento suele aparecer: %s hacia las %s)",
+		French:  "%s (le réassort arrive en général : %s vers %s)",
+	},
+	"notify.reminder_invitation_title": {
+		English: "Invitation to buy: %s",
+		Spanish: "Invitación de compra: %s",
+		French:  "Invitation à acheter : %s",
+	},
+	"notify.reminder_invitation_body": {
+		English: "Your Best Buy invitation to buy %s hasn't expired yet. Buy it before it does.",
+		Spanish: "Tu invitación de Best Buy para comprar %s aún no ha caducado. Cómpralo antes de que caduque.",
+		French:  "Votre invitation Best Buy à acheter %s n'a pas encore expiré. Achetez-le avant qu'elle expire.",
+	},
+	"notify.reminder_pickup_title": {
+		English: "Waiting for pickup: %s",
+		Spanish: "Pendiente de recoger: %s",
+		French:  "En attente de retrait : %s",
+	},
+	"notify.reminder_pickup_body": {
+		English: "Your Best Buy order of %s is waiting for you at the store.",
+		Spanish: "Tu pedido de Best Buy de %s te espera en la tienda.",
+		French:  "Votre commande Best Buy de %s vous attend en magasin.",
+	},
+	"notify.reminder_your_order": {
+		English: "your order",
+		Spanish: "tu pedido",
+		French:  "votre commande",
+	},
+	"notify.reminder_view": {
+		English: "View product",
+		Spanish: "Ver producto",
+		French:  "Voir le produit",
+	},
+	"notify.field_reminder_store": {
+		English: "Store",
+		Spanish: "Tienda",
+		French:  "Magasin",
+	},
+	"notify.field_invitation_expires": {
+		English: "Invitation expires",
+		Spanish: "La invitación caduca",
+		French:  "L'invitation expire",
+	},
+	"notify.field_pickup_by": {
+		English: "Pick up by",
+		Spanish: "Recoger antes de",
+		French:  "À retirer avant",
+	},
+	"notify.store_closed_note": {
+		English: "%s is closed right now.",
+		Spanish: "%s está cerrada en este momento.",
+		French:  "%s est fermé en ce moment.",
+	},
+	"notify.store_opens_note": {
+		English: "%s is closed right now and opens %s.",
+		Spanish: "%s está cerrada en este momento y abre el %s.",
+		French:  "%s est fermé en ce moment et ouvre %s.",
+	},
+	"notify.add_to_cart": {
+		English: "Add to cart",
+		Spanish: "Añadir al carrito",
+		French:  "Ajouter au panier",
+	},
+	"notify.stale_prefix": {
+		English: "[May be stale]",
+		Spanish: "[Puede estar desactualizado]",
+		French:  "[Peut-être obsolète]",
+	},
+	"notify.community_prefix": {
+		English: "[Shopper report]",
+		Spanish: "[Aviso de un comprador]",
+		French:  "[Signalé par un client]",
+	},
+	"notify.community_note": {
+		English: "A shopper saw this on the shelf and a moderator approved the report. Best Buy's inventory hasn't confirmed it.",
+		Spanish: "Un comprador lo vio en el estante y un moderador aprobó el aviso. El inventario de Best Buy no lo ha confirmado.",
+		French:  "Un client l'a vu en rayon et un modérateur a validé le signalement. L'inventaire de Best Buy ne l'a pas confirmé.",
+	},
+	"notify.field_reported_quantity": {
+		English: "Seen on the shelf",
+		Spanish: "Vistos en el estante",
+		French:  "Vus en rayon",
+	},
+	"notify.time_layout": {
+		English: "Mon Jan 2 15:04 MST",
+		Spanish: "02/01 15:04 MST",
+		French:  "02/01 15:04 MST",
+	},
+	"notify.stale_note": {
+		English: "Detected after the stock watcher was offline (last check %s), so this may have sold out already.",
+		Spanish: "Detectado después de que el monitor estuviera fuera de servicio (última comprobación %s), por lo que puede que ya se haya agotado.",
+		French:  "Détecté après une interruption du suivi des stocks (dernière vérification %s), l'article est peut-être déjà épuisé.",
+	},
+	"notify.ack_note": {
+		English: "Acknowledge within %d minutes to skip the phone call: %s",
+		Spanish: "Confirma en menos de %d minutos para evitar la llamada telefónica: %s",
+		French:  "Confirmez dans les %d minutes pour éviter l'appel téléphonique : %s",
+	},
+	"notify.ack_done": {
+		English: "Alert acknowledged. No phone call will be placed.",
+		Spanish: "Alerta confirmada. No se realizará ninguna llamada telefónica.",
+		French:  "Alerte confirmée. Aucun appel téléphonique ne sera passé.",
+	},
+	"notify.ack_missing_token": {
+		English: "The acknowledgment link is incomplete.",
+		Spanish: "El enlace de confirmación está incompleto.",
+		French:  "Le lien de confirmation est incomplet.",
+	},
+	"notify.ack_unknown": {
+		English: "This alert is unknown or was already acknowledged.",
+		Spanish: "Esta alerta no existe o ya se confirmó.",
+		French:  "Cette alerte est inconnue ou a déjà été confirmée.",
+	},
+	"notify.ack_failed": {
+		English: "The alert couldn't be acknowledged. Try again shortly.",
+		Spanish: "No se pudo confirmar la alerta. Inténtalo de nuevo en breve.",
+		French:  "Impossible de confirmer l'alerte. Réessayez dans un instant.",
+	},
+	// Phone calls; call_language is the Twilio <Say> language code
+	"notify.call_intro": {
+		English: "Stock alert.",
+		Spanish: "Alerta de existencias.",
+		French:  "Alerte de stock.",
+	},
+	"notify.call_repeat": {
+		English: "Repeating.",
+		Spanish: "Repito.",
+		French:  "Je répète.",
+	},
+	"notify.call_language": {
+		English: "en-US",
+		Spanish: "es-ES",
+		French:  "fr-FR",
+	},
+	"notify.new_listing_title": {
+		English: "New listing: %s",
+		Spanish: "Nuevo producto: %s",
+		French:  "Nouveau produit : %s",
+	},
+	"notify.new_listing_body": {
+		English: "Best Buy just listed a product matching your watch for \"%s\".",
+		Spanish: "Best Buy acaba de publicar un producto que coincide con tu búsqueda \"%s\".",
+		French:  "Best Buy vient de mettre en ligne un produit correspondant à votre recherche « %s ».",
+	},
+	"auth.login_link_title": {
+		English: "Your sign-in link",
+		Spanish: "Tu enlace para iniciar sesión",
+		French:  "Votre lien de connexion",
+	},
+	"auth.login_link_body": {
+		English: "Open this link to sign in to Stock Checker. It works once and expires in %d minutes. If you didn't ask for it, you can ignore this email.",
+		Spanish: "Abre este enlace para iniciar sesión en Stock Checker. Funciona una vez y caduca en %d minutos. Si no lo pediste, puedes ignorar este correo.",
+		French:  "Ouvrez ce lien pour vous connecter à Stock Checker. Il ne fonctionne qu'une fois et expire dans %d minutes. Si vous ne l'avez pas demandé, ignorez cet e-mail.",
+	},
+	"notify.digest_title": {
+		English: "Nice-to-have digest: %d in stock",
+		Spanish: "Resumen de deseos: %d disponibles",
+		French:  "Récapitulatif des envies : %d en stock",
+	},
+	"notify.digest_body": {
+		English: "These nice-to-have products came into stock since your last digest. Stock may have sold out since.",
+		Spanish: "Estos productos deseados se repusieron desde tu último resumen. Puede que ya se hayan agotado.",
+		French:  "Ces produits de votre liste d'envies ont été réapprovisionnés depuis votre dernier récapitulatif. Ils sont peut-être déjà épuisés.",
+	},
+	"notify.digest_line": {
+		English: "%s (%s) at %d stores",
+		Spanish: "%s (%s) en %d tiendas",
+		French:  "%s (%s) dans %d magasins",
+	},
+	"notify.digest_nearest": {
+		English: "— nearest %s, %s",
+		Spanish: "— la más cercana %s, %s",
+		French:  "— le plus proche %s, %s",
+	},
+	"notify.test_prefix": {
+		English: "[Test]",
+		Spanish: "[Prueba]",
+		French:  "[Test]",
+	},
+}
@@ -0,0 +1,196 @@
+// Package inbound reads the Best Buy emails users forward to their personal
+// inbound address. Invitations to buy a restricted product and orders ready
+// for pickup become reminders, sent before the invitation expires or the
+// order goes back to the shelf.
+package inbound
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Limits on what's read of a message
+const (
+	MaxMessageSize = 10 << 20 // bytes, attachments included
+	maxPartSize    = 1 << 20  // bytes of one text part
+	maxDepth       = 5        // nested multiparts and attached messages
+)
+
+// Email is the part of a received message that matters for reminders
+type Email struct {
+	Recipients []string  // addresses the message was sent to, in lower case
+	Subject    string    // of the forwarded email if it was attached, else the message's
+	Date       time.Time // when it was sent; zero if the Date header is missing
+	Text       string    // the body as plain text, converted from HTML if there's no plain part
+}
+
+var (
+	blockPattern  = regexp.MustCompile(`(?is)<(style|script|head)\b.*?</(?:style|script|head)>`)
+	anchorPattern = regexp.MustCompile(`(?i)<a\b[^>]*\bhref\s*=\s*["']([^"']*)["'][^>]*>`)
+	breakPattern  = regexp.MustCompile(`(?i)<(?:br|/p|/div|/tr|/li|/h[1-6])\b[^>]*>`)
+	tagPattern    = regexp.MustCompile(`<[^>]*>`)
+	spacePattern  = regexp.MustCompile(`[ \t\r\f\v\x{00a0}]+`)
+	blankPattern  = regexp.MustCompile(`\n\s*\n\s*`)
+)
+
+// Parse reads a raw RFC 5322 message, such as one forwarded inline or as
+// an attachment from a mail client
+func Parse(r io.Reader) (Email, error) {
+	msg, err := mail.ReadMessage(io.LimitReader(r, MaxMessageSize))
+	if err != nil {
+		return Email{}, err
+	}
+
+	e := Email{Subject: decodeHeader(msg.Header.Get("Subject"))}
+	if date, err := msg.Header.Date(); err == nil {
+		e.Date = date
+	}
+	for _, key := range []string{"Delivered-To", "X-Original-To", "To", "Cc"} {
+		for _, v := range msg.Header[key] {
+			addrs, err := mail.ParseAddressList(v)
+			if err != nil {
+				continue
+			}
+			for _, a := range addrs {
+				e.Recipients = append(e.Recipients, strings.ToLower(a.Address))
+			}
+		}
+	}
+
+	var b body
+	if err := b.read(textproto.MIMEHeader(msg.Header), msg.Body, 0); err != nil {
+		return Email{}, err
+	}
+	e.Text = b.text()
+
+	// An email forwarded as an attachment is what matters, not the note sent with it
+	if b.attached != nil {
+		e.Subject = b.attached.subject
+		e.Text = b.attached.text() + "\n\n" + e.Text
+	}
+	return e, nil
+}
+
+// body collects the first plain and HTML parts of a message
+type body struct {
+	plain, html string
+	subject     string
+	attached    *body // the first attached message
+}
+
+// text returns the plain part, or the HTML part converted to text
+func (b *body) text() string {
+	if strings.TrimSpace(b.plain) != "" {
+		return b.plain
+	}
+	return htmlText(b.html)
+}
+
+// read reads one part of a message, descending into multiparts and
+// attached messages
+func (b *body) read(header textproto.MIMEHeader, r io.Reader, depth int) error {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if depth >= maxDepth || params["boundary"] == "" {
+			return nil
+		}
+		mr := multipart.NewReader(r, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := b.read(part.Header, part, depth+1); err != nil {
+				return err
+			}
+		}
+
+	case mediaType == "message/rfc822":
+		if depth >= maxDepth {
+			return nil
+		}
+		if b.attached != nil {
+			return nil
+		}
+		msg, err := mail.ReadMessage(decodeTransfer(header.Get("Content-Transfer-Encoding"), r))
+		if err != nil {
+			return nil // a broken attachment doesn't spoil the rest
+		}
+		b.attached = &body{subject: decodeHeader(msg.Header.Get("Subject"))}
+		return b.attached.read(textproto.MIMEHeader(msg.Header), msg.Body, depth+1)
+
+	case mediaType == "text/plain" || mediaType == "text/html":
+		if disposition, _, _ := mime.ParseMediaType(header.Get("Content-Disposition")); disposition == "attachment" {
+			return nil
+		}
+		data, err := io.ReadAll(io.LimitReader(decodeTransfer(header.Get("Content-Transfer-Encoding"), r), maxPartSize))
+		if err != nil {
+			return err
+		}
+		if mediaType == "text/plain" && b.plain == "" {
+			b.plain = string(data)
+		} else if mediaType == "text/html" && b.html == "" {
+			b.html = string(data)
+		}
+	}
+	return nil
+}
+
+// decodeTransfer undoes a part's Content-Transfer-Encoding. Multipart parts
+// come with quoted-printable already decoded.
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, r) // line breaks are skipped
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	}
+	return r
+}
+
+// decodeHeader decodes RFC 2047 encoded words, keeping the raw header if
+// they're malformed
+func decodeHeader(v string) string {
+	decoded, err := new(mime.WordDecoder).DecodeHeader(v)
+	if err != nil {
+		return v
+	}
+	return decoded
+}
+
+// htmlText converts an HTML body to plain text, keeping link targets since
+// product pages are often only linked
+func htmlText(s string) string {
+	s = blockPattern.ReplaceAllString(s, " ")
+	s = anchorPattern.ReplaceAllString(s, " $1 ")
+	s = breakPattern.ReplaceAllString(s, "\n")
+	s = tagPattern.ReplaceAllString(s, " ")
+	s = html.UnescapeString(s)
+	s = spacePattern.ReplaceAllString(s, " ")
+
+	var lines bytes.Buffer
+	for _, line := range strings.Split(s, "\n") {
+		lines.WriteString(strings.TrimSpace(line))
+		lines.WriteByte('\n')
+	}
+	return strings.TrimSpace(blankPattern.ReplaceAllString(lines.String(), "\n\n"))
+}
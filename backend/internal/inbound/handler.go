@@ -0,0 +1,222 @@
+package inbound
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/json"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// Path is where the mail provider POSTs received messages
+const Path = "/inbound/email"
+
+// Column limits in characters
+const (
+	maxSubjectLen     = 255
+	maxProductNameLen = 255
+	maxStoreNameLen   = 100
+)
+
+// tokenEncoding spells tokens in lower case, since some mail servers lower-case addresses
+var tokenEncoding = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// NewToken generates the local part of a user's inbound address
+func NewToken() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return tokenEncoding.EncodeToString(b), nil
+}
+
+// Address returns the inbound address for a token
+func Address(token, domain string) string {
+	return token + "@" + domain
+}
+
+// Store finds whose address a message was sent to and saves their reminders
+type Store interface {
+	GetInboundAddressUser(ctx context.Context, token string) (int, error)
+	CreateReminder(ctx context.Context, r database.Reminder) (bool, error)
+}
+
+// Handler turns forwarded Best Buy emails into reminders. Messages it can't
+// use are acknowledged and dropped, so the provider doesn't retry them.
+type Handler struct {
+	store    Store
+	bbClient bestbuy.Client
+	domain   string
+	secret   string // basic auth password
+	now      func() time.Time
+}
+
+// NewHandler creates a Handler for addresses at domain. The provider must
+// send secret as the basic auth password.
+func NewHandler(store Store, bbClient bestbuy.Client, domain, secret string) *Handler {
+	return &Handler{
+		store:    store,
+		bbClient: bbClient,
+		domain:   strings.ToLower(domain),
+		secret:   secret,
+		now:      time.Now,
+	}
+}
+
+// ServeHTTP receives one message, either as the request body or as a form
+// field of the provider's webhook
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if _, password, ok := r.BasicAuth(); !ok || subtle.ConstantTimeCompare([]byte(password), []byte(h.secret)) != 1 {
+		w.Header().Set("WWW-Authenticate", `Basic realm="inbound email"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, 2*MaxMessageSize) // form encoding adds some
+	raw, envelope, err := readMessage(r)
+	if err != nil {
+		http.Error(w, "malformed request", http.StatusBadRequest)
+		return
+	}
+	email, err := Parse(bytes.NewReader(raw))
+	if err != nil {
+		http.Error(w, "malformed message", http.StatusBadRequest)
+		return
+	}
+
+	status, err := h.receive(r.Context(), email, envelope)
+	if err != nil {
+		log.Printf("Inbound email: %v", err)
+		http.Error(w, "failed to save reminder", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(status)
+}
+
+// receive saves a reminder from a message for the user it was sent to
+func (h *Handler) receive(ctx context.Context, email Email, envelope []string) (int, error) {
+	userID, err := h.recipient(ctx, append(envelope, email.Recipients...))
+	if err != nil {
+		return 0, err
+	}
+	if userID == 0 {
+		log.Printf("Inbound email: no user has the address it was sent to")
+		return http.StatusNoContent, nil
+	}
+
+	notice, ok := Classify(email)
+	if !ok {
+		log.Printf("Inbound email: message for user %d isn't a Best Buy invitation or pickup email", userID)
+		return http.StatusNoContent, nil
+	}
+	now := h.now()
+	remindAt, ok := notice.RemindAt(now)
+	if !ok {
+		log.Printf("Inbound email: %s for user %d was due at %s", notice.Kind, userID, notice.Due.Format(time.RFC3339))
+		return http.StatusNoContent, nil
+	}
+
+	reminder := database.Reminder{
+		UserID:    userID,
+		Kind:      string(notice.Kind),
+		SKU:       notice.SKU,
+		StoreID:   notice.StoreID,
+		StoreName: truncate(notice.StoreName, maxStoreNameLen),
+		Subject:   truncate(strings.TrimSpace(email.Subject), maxSubjectLen),
+		RemindAt:  remindAt,
+	}
+	if !notice.Due.IsZero() {
+		reminder.DueAt = &notice.Due
+	}
+	if notice.SKU != "" {
+		if product, err := h.bbClient.GetProductBySKU(ctx, notice.SKU); err != nil {
+			log.Printf("Error looking up reminder product %s: %v", notice.SKU, err)
+		} else {
+			reminder.ProductName = truncate(product.Name, maxProductNameLen)
+		}
+	}
+
+	created, err := h.store.CreateReminder(ctx, reminder)
+	if err != nil {
+		return 0, err
+	}
+	if created {
+		log.Printf("Inbound email: %s reminder for user %d at %s", notice.Kind, userID, remindAt.UTC().Format(time.RFC3339))
+	}
+	return http.StatusNoContent, nil
+}
+
+// recipient returns the user owning the first of the addresses at the
+// inbound domain, or 0 if none does. A "+tag" after the token is ignored.
+func (h *Handler) recipient(ctx context.Context, addresses []string) (int, error) {
+	for _, addr := range addresses {
+		local, domain, ok := strings.Cut(strings.ToLower(strings.TrimSpace(addr)), "@")
+		if !ok || domain != h.domain {
+			continue
+		}
+		token, _, _ := strings.Cut(local, "+")
+		userID, err := h.store.GetInboundAddressUser(ctx, token)
+		if err != nil || userID != 0 {
+			return userID, err
+		}
+	}
+	return 0, nil
+}
+
+// readMessage returns the raw message in a request, with the envelope
+// recipients if the provider sent them separately. Mailgun posts the message
+// as "body-mime" and SendGrid as "email"; others post it as the body.
+func readMessage(r *http.Request) ([]byte, []string, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" && mediaType != "application/x-www-form-urlencoded" {
+		raw, err := io.ReadAll(r.Body)
+		return raw, nil, err
+	}
+
+	if err := r.ParseMultipartForm(MaxMessageSize); err != nil && err != http.ErrNotMultipart {
+		return nil, nil, err
+	}
+	raw := r.FormValue("body-mime")
+	if raw == "" {
+		raw = r.FormValue("email")
+	}
+	if raw == "" {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+
+	var envelope []string
+	if recipient := r.FormValue("recipient"); recipient != "" {
+		envelope = strings.Split(recipient, ",")
+	}
+	var sendGrid struct {
+		To []string `json:"to"`
+	}
+	if err := json.Unmarshal([]byte(r.FormValue("envelope")), &sendGrid); err == nil {
+		envelope = append(envelope, sendGrid.To...)
+	}
+	return []byte(raw), envelope, nil
+}
+
+// truncate shortens s to at most n characters
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,217 @@
+package inbound
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// crlf turns a message written with \n line endings into wire format
+func crlf(s string) string {
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
+
+// An invitation forwarded inline from a mail client that sends HTML only
+var invitationHTML = crlf(`From: Sam <sam@example.com>
+To: abcdefgh@in.example.com
+Subject: Fwd: You're invited to buy!
+Date: Wed, 14 Oct 2026 09:30:00 -0700
+MIME-Version: 1.0
+Content-Type: multipart/alternative; boundary="b1"
+
+--b1
+Content-Type: text/html; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+<html><head><style>p { color: blue; }</style></head><body>
+<p>---------- Forwarded message ---------<br>From: Best Buy &lt;BestBuyInfo@emailinfo.bestbuy.com&gt;</p>
+<p>Good news! You=E2=80=99ve been invited to purchase the item below.</p>
+<a href=3D"https://www.bestbuy.com/site/pokemon-prismatic-evolutions-elite-trainer-box/6579543.p?skuId=3D6579543">Shop now</a>
+<p>Your invitation expires on Friday, October 16 at 11:59 PM.</p>
+</body></html>
+--b1--
+`)
+
+// An order-ready email forwarded as an attachment
+var orderReadyAttached = crlf(`From: Sam <sam@example.com>
+To: "Stock Checker" <abcdefgh+orders@in.example.com>
+Subject: Fwd: pickup
+Date: Wed, 14 Oct 2026 18:00:00 +0000
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: text/plain
+
+See attached.
+--outer
+Content-Type: message/rfc822
+
+From: Best Buy <BestBuyInfo@emailinfo.bestbuy.com>
+Subject: Your order is ready for pickup
+Content-Type: text/plain
+Content-Transfer-Encoding: base64
+
+WW91ciBvcmRlciBpcyByZWFkeSBmb3IgcGlja3VwIGF0IEJlc3QgQnV5IFVuaW9uIFNxdWFyZS4K
+U3RvcmUgIzE0OApTS1U6IDY1NDMyMTAKUGljayBpdCB1cCBieSAxMC8xOSBzbyB3ZSBkb24ndCBy
+ZXR1cm4gaXQu
+--outer--
+`)
+
+func TestClassifyInvitation(t *testing.T) {
+	email, err := Parse(strings.NewReader(invitationHTML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(email.Recipients) != 1 || email.Recipients[0] != "abcdefgh@in.example.com" {
+		t.Errorf("recipients %v", email.Recipients)
+	}
+	if strings.Contains(email.Text, "color") || strings.Contains(email.Text, "<p>") {
+		t.Errorf("HTML left in text: %q", email.Text)
+	}
+
+	n, ok := Classify(email)
+	if !ok || n.Kind != Invitation {
+		t.Fatalf("classified as %q (%v), want an invitation", n.Kind, ok)
+	}
+	if n.SKU != "6579543" {
+		t.Errorf("SKU %q, want the linked product's", n.SKU)
+	}
+	if want := time.Date(2026, time.October, 16, 23, 59, 0, 0, time.FixedZone("", -7*3600)); !n.Due.Equal(want) {
+		t.Errorf("due %v, want %v", n.Due, want)
+	}
+}
+
+func TestClassifyAttachedOrderReady(t *testing.T) {
+	email, err := Parse(strings.NewReader(orderReadyAttached))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if email.Subject != "Your order is ready for pickup" {
+		t.Errorf("subject %q, want the attached email's", email.Subject)
+	}
+
+	n, ok := Classify(email)
+	if !ok || n.Kind != OrderReady {
+		t.Fatalf("classified as %q (%v), want an order ready for pickup", n.Kind, ok)
+	}
+	if n.SKU != "6543210" || n.StoreID != "148" || n.StoreName != "Best Buy Union Square" {
+		t.Errorf("SKU %q at store %q (%q)", n.SKU, n.StoreID, n.StoreName)
+	}
+	// A date without a time is the end of the day
+	if want := time.Date(2026, time.October, 19, 23, 59, 59, 0, time.UTC); !n.Due.Equal(want) {
+		t.Errorf("due %v, want %v", n.Due, want)
+	}
+}
+
+func TestClassifyIgnoresOtherEmail(t *testing.T) {
+	tests := []Email{
+		{Subject: "You're invited!", Text: "Join us for a birthday party by October 20."},
+		{Subject: "Your Best Buy receipt", Text: "Thanks for shopping at Best Buy."},
+	}
+	for _, e := range tests {
+		if n, ok := Classify(e); ok {
+			t.Errorf("%q classified as %q", e.Subject, n.Kind)
+		}
+	}
+}
+
+func TestDeadline(t *testing.T) {
+	sent := time.Date(2026, time.December, 30, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		text string
+		want time.Time
+	}{
+		{"Purchase by Jan 2 at 9am", time.Date(2027, time.January, 2, 9, 0, 0, 0, time.UTC)},
+		{"Offer expires: 12/31/26 at 5:30 p.m.", time.Date(2026, time.December, 31, 17, 30, 0, 0, time.UTC)},
+		{"Entries closed by Dec 1, 2026. Buy before Sept. 4, 2027", time.Date(2027, time.September, 4, 23, 59, 59, 0, time.UTC)},
+		{"Pick up by phone 12", time.Time{}},
+	}
+	for _, tt := range tests {
+		if got := deadline(tt.text, sent); !got.Equal(tt.want) {
+			t.Errorf("%q: %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestRemindAt(t *testing.T) {
+	received := time.Date(2026, time.October, 14, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		notice Notice
+		want   time.Time
+		ok     bool
+	}{
+		{"before the deadline", Notice{Kind: Invitation, Due: received.Add(24 * time.Hour)}, received.Add(21 * time.Hour), true},
+		{"deadline close", Notice{Kind: OrderReady, Due: received.Add(time.Hour)}, received, true},
+		{"no deadline", Notice{Kind: OrderReady}, received.Add(48 * time.Hour), true},
+		{"expired", Notice{Kind: Invitation, Due: received.Add(-time.Hour)}, time.Time{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.notice.RemindAt(received)
+		if ok != tt.ok || !got.Equal(tt.want) {
+			t.Errorf("%s: %v (%v), want %v (%v)", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+// memoryStore knows one address and records saved reminders
+type memoryStore struct {
+	reminders []database.Reminder
+}
+
+func (s *memoryStore) GetInboundAddressUser(ctx context.Context, token string) (int, error) {
+	if token == "abcdefgh" {
+		return 7, nil
+	}
+	return 0, nil
+}
+
+func (s *memoryStore) CreateReminder(ctx context.Context, r database.Reminder) (bool, error) {
+	s.reminders = append(s.reminders, r)
+	return true, nil
+}
+
+func TestHandlerSavesReminder(t *testing.T) {
+	store := &memoryStore{}
+	h := NewHandler(store, bestbuy.NewMockClient(), "in.example.com", "s3cret")
+	h.now = func() time.Time { return time.Date(2026, time.October, 14, 18, 5, 0, 0, time.UTC) }
+
+	post := func(password, body string) int {
+		req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "message/rfc822")
+		req.SetBasicAuth("mail", password)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	if code := post("wrong", orderReadyAttached); code != http.StatusUnauthorized {
+		t.Errorf("wrong secret: status %d, want 401", code)
+	}
+	if code := post("s3cret", orderReadyAttached); code != http.StatusNoContent {
+		t.Fatalf("status %d, want 204", code)
+	}
+	if len(store.reminders) != 1 {
+		t.Fatalf("saved %d reminders, want 1", len(store.reminders))
+	}
+	r := store.reminders[0]
+	if r.UserID != 7 || r.Kind != string(OrderReady) || r.ProductName == "" {
+		t.Errorf("saved %+v, want an order-ready reminder with the product's name for user 7", r)
+	}
+	if want := time.Date(2026, time.October, 18, 23, 59, 59, 0, time.UTC); !r.RemindAt.Equal(want) {
+		t.Errorf("remind at %v, want a day before the pickup deadline", r.RemindAt)
+	}
+
+	// Mail to addresses nobody has is dropped without a retry
+	other := strings.Replace(orderReadyAttached, "abcdefgh+orders@", "nobody@", 1)
+	if code := post("s3cret", other); code != http.StatusNoContent || len(store.reminders) != 1 {
+		t.Errorf("unknown address: status %d with %d reminders, want 204 and none saved", code, len(store.reminders))
+	}
+}
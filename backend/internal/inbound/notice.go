@@ -0,0 +1,203 @@
+package inbound
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind is the kind of Best Buy email a notice came from
+type Kind string
+
+// Notice kinds
+const (
+	Invitation Kind = "invitation"  // invited to buy a restricted product
+	OrderReady Kind = "order_ready" // an order is ready for pickup in store
+)
+
+// Notice is what a Best Buy email says to act on
+type Notice struct {
+	Kind      Kind
+	SKU       string    // empty if the email doesn't name one
+	StoreID   string    // Best Buy store number; empty if not named
+	StoreName string    // e.g. "Best Buy Union Square"; empty if not named
+	Due       time.Time // when the invitation expires or the order goes back to the shelf; zero if not given
+}
+
+// How long before the deadline each kind is followed up, and how long after
+// the email arrives when there's no deadline. Invitations usually last a day
+// or two; orders are held for a few days.
+var timing = map[Kind]struct{ lead, fallback time.Duration }{
+	Invitation: {lead: 3 * time.Hour, fallback: 12 * time.Hour},
+	OrderReady: {lead: 24 * time.Hour, fallback: 48 * time.Hour},
+}
+
+var (
+	invitationPhrases = []string{
+		"you've been invited", "you're invited", "you have been invited", "you've been selected",
+		"invitation to purchase", "invitation to buy", "your invitation", "your invite",
+	}
+	orderReadyPhrases = []string{
+		"ready for pickup", "ready for pick up", "ready for pick-up", "ready to pick up", "ready to be picked up",
+	}
+
+	skuLabelPattern = regexp.MustCompile(`(?i)\bsku(?:\s*id)?\s*[:#=]?\s*(\d{6,8})\b`)
+	skuURLPattern   = regexp.MustCompile(`(?i)bestbuy\.(?:com|ca)/site/[^\s"'<>]*/(\d{6,8})\.p\b`)
+	storeIDPattern  = regexp.MustCompile(`(?i)\bstore\s*(?:#|no\.?|number:?)\s*(\d{1,5})\b`)
+	storeName       = regexp.MustCompile(`(?i)\b(?:pick\s?-?up at|ready at|store:)\s*(best buy [a-z0-9 '&.-]{2,60})`)
+	storeNameEnd    = regexp.MustCompile(`(?i)\s+(?:by|before|until|on|store|is|located)\b.*$`)
+
+	// "expires on Friday, October 20, 2026 at 11:59 PM", "pick up by 10/20"
+	deadlinePattern = regexp.MustCompile(`(?i)\b(?:expires?|expiring|until|before|through|by)\b:?\s+(?:on\s+)?(?:[a-z]+day,?\s+)?` +
+		`(?:([a-z]{3,9})\.?\s+(\d{1,2})(?:st|nd|rd|th)?(?:,?\s+(\d{4}))?|(\d{1,2})/(\d{1,2})(?:/(\d{4}|\d{2}))?)\b` +
+		`(?:,?\s+(?:at\s+)?(\d{1,2})(?::(\d{2}))?\s*([ap])\.?m\b\.?)?`)
+
+	months = []string{"january", "february", "march", "april", "may", "june",
+		"july", "august", "september", "october", "november", "december"}
+)
+
+// Classify reads what a Best Buy email asks of its recipient. It reports
+// false for anything that isn't an invitation to buy or an order ready for
+// pickup.
+func Classify(e Email) (Notice, bool) {
+	subject := normalize(e.Subject)
+	text := normalize(e.Text)
+	if !strings.Contains(subject+text, "best buy") && !strings.Contains(subject+text, "bestbuy") {
+		return Notice{}, false
+	}
+
+	// The subject says it best; bodies mention pickup in their footers
+	var n Notice
+	switch {
+	case containsAny(subject, orderReadyPhrases):
+		n.Kind = OrderReady
+	case containsAny(subject, invitationPhrases):
+		n.Kind = Invitation
+	case containsAny(text, orderReadyPhrases):
+		n.Kind = OrderReady
+	case containsAny(text, invitationPhrases):
+		n.Kind = Invitation
+	default:
+		return Notice{}, false
+	}
+
+	if m := skuLabelPattern.FindStringSubmatch(e.Text); m != nil {
+		n.SKU = m[1]
+	} else if m := skuURLPattern.FindStringSubmatch(e.Text); m != nil {
+		n.SKU = m[1]
+	}
+	if m := storeIDPattern.FindStringSubmatch(e.Text); m != nil {
+		n.StoreID = strings.TrimLeft(m[1], "0")
+	}
+	if m := storeName.FindStringSubmatch(e.Text); m != nil {
+		n.StoreName = strings.TrimRight(storeNameEnd.ReplaceAllString(strings.TrimSpace(m[1]), ""), ".")
+	}
+	n.Due = deadline(e.Text, e.Date)
+	return n, true
+}
+
+// RemindAt returns when to remind about a notice received at received: a
+// while before its deadline, or a while after it arrived if it has none. It
+// reports false if the deadline has passed.
+func (n Notice) RemindAt(received time.Time) (time.Time, bool) {
+	t := timing[n.Kind]
+	if n.Due.IsZero() {
+		return received.Add(t.fallback), true
+	}
+	if !n.Due.After(received) {
+		return time.Time{}, false
+	}
+	if at := n.Due.Add(-t.lead); at.After(received) {
+		return at, true
+	}
+	return received, true
+}
+
+// deadline finds the first date in text after a word like "expires" or
+// "by" that isn't before sent. A date without a year is the next one after
+// sent, and one without a time is the end of that day, in sent's time zone.
+func deadline(text string, sent time.Time) time.Time {
+	if sent.IsZero() {
+		sent = time.Now()
+	}
+	loc := sent.Location()
+
+	for _, m := range deadlinePattern.FindAllStringSubmatch(text, -1) {
+		var month time.Month
+		var day, year int
+		if m[1] != "" {
+			month = monthNamed(m[1])
+			day, _ = strconv.Atoi(m[2])
+			year, _ = strconv.Atoi(m[3])
+		} else {
+			n, _ := strconv.Atoi(m[4])
+			month = time.Month(n)
+			day, _ = strconv.Atoi(m[5])
+			year, _ = strconv.Atoi(m[6])
+			if year > 0 && year < 100 {
+				year += 2000
+			}
+		}
+		if month < time.January || month > time.December || day < 1 || day > 31 {
+			continue
+		}
+
+		hour, minute, second := 23, 59, 59
+		if m[7] != "" {
+			hour, _ = strconv.Atoi(m[7])
+			minute, _ = strconv.Atoi(m[8])
+			second = 0
+			if hour < 1 || hour > 12 || minute > 59 {
+				continue
+			}
+			hour %= 12
+			if strings.EqualFold(m[9], "p") {
+				hour += 12
+			}
+		}
+
+		guessed := year == 0
+		if guessed {
+			year = sent.Year()
+		}
+		due := time.Date(year, month, day, hour, minute, second, 0, loc)
+		if due.Day() != day {
+			continue // e.g. February 30
+		}
+		if guessed && due.Before(sent.AddDate(0, 0, -1)) {
+			due = due.AddDate(1, 0, 0)
+		}
+		if due.After(sent) {
+			return due
+		}
+	}
+	return time.Time{}
+}
+
+// monthNamed returns the month a name or abbreviation ("oct", "Sept")
+// stands for, or 0
+func monthNamed(name string) time.Month {
+	name = strings.ToLower(name)
+	for i, m := range months {
+		if strings.HasPrefix(m, name) && len(name) >= 3 {
+			return time.Month(i + 1)
+		}
+	}
+	return 0
+}
+
+// normalize lower-cases text and straightens apostrophes for phrase matching
+func normalize(s string) string {
+	return strings.ReplaceAll(strings.ToLower(s), "’", "'")
+}
+
+// containsAny reports whether s contains any of the phrases
+func containsAny(s string, phrases []string) bool {
+	for _, p := range phrases {
+		if strings.Contains(s, p) {
+			return true
+		}
+	}
+	return false
+}
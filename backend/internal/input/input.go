@@ -0,0 +1,154 @@
+// Package input parses product references, postal codes and pasted lists
+// typed or pasted by users, before they reach the retailer clients.
+package input
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// MaxListItems is the most products a pasted list may contain
+const MaxListItems = 200
+
+// RefKind is the kind of identifier a product reference holds
+type RefKind string
+
+// Product reference kinds
+const (
+	RefSKU RefKind = "sku"
+	RefUPC RefKind = "upc"
+)
+
+// Ref is a product identified by SKU or UPC
+type Ref struct {
+	Kind  RefKind
+	Value string
+}
+
+// ErrNotProductRef is returned for input that isn't a SKU, UPC or product URL
+var ErrNotProductRef = errors.New("not a SKU, UPC or product URL")
+
+var (
+	skuPattern     = regexp.MustCompile(`^\d{6,8}$`)
+	digitsPattern  = regexp.MustCompile(`^\d+$`)
+	skuPathPattern = regexp.MustCompile(`/(\d{6,8})\.p$`)
+	skuPrefix      = regexp.MustCompile(`(?i)^(?:sku|item)\s*[:#]?\s*`)
+	listMarker     = regexp.MustCompile(`^(?:[-*•]|\d{1,3}[.)])\s+`)
+	zipPattern     = regexp.MustCompile(`^(\d{5})(?:-?\d{4})?$`)
+	caPostalRegexp = regexp.MustCompile(`^([ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z])[ -]?(\d[ABCEGHJ-NPRSTV-Z]\d)$`)
+)
+
+// ParseProductRef parses a SKU ("6579543", "SKU: 6579543"), a 12-digit UPC or
+// 13-digit EAN of a US product, or a Best Buy product URL
+func ParseProductRef(s string) (Ref, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "#")
+	s = skuPrefix.ReplaceAllString(s, "")
+
+	if strings.Contains(s, "bestbuy.") {
+		return parseProductURL(s)
+	}
+
+	if !digitsPattern.MatchString(s) {
+		return Ref{}, ErrNotProductRef
+	}
+	switch {
+	case skuPattern.MatchString(s):
+		return Ref{Kind: RefSKU, Value: s}, nil
+	case len(s) == 13 && s[0] == '0' && validCheckDigit(s):
+		// An EAN-13 starting with 0 is a UPC-A with a leading zero
+		return Ref{Kind: RefUPC, Value: s[1:]}, nil
+	case len(s) == 12 && validCheckDigit(s):
+		return Ref{Kind: RefUPC, Value: s}, nil
+	}
+	return Ref{}, ErrNotProductRef
+}
+
+// parseProductURL extracts the SKU from a Best Buy product URL
+func parseProductURL(s string) (Ref, error) {
+	if !strings.Contains(s, "://") {
+		s = "https://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return Ref{}, ErrNotProductRef
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host != "bestbuy.com" && !strings.HasSuffix(host, ".bestbuy.com") {
+		return Ref{}, ErrNotProductRef
+	}
+
+	if sku := u.Query().Get("skuId"); skuPattern.MatchString(sku) {
+		return Ref{Kind: RefSKU, Value: sku}, nil
+	}
+	if m := skuPathPattern.FindStringSubmatch(u.Path); m != nil {
+		return Ref{Kind: RefSKU, Value: m[1]}, nil
+	}
+	return Ref{}, ErrNotProductRef
+}
+
+// validCheckDigit validates the GTIN check digit of a UPC-A or EAN-13
+func validCheckDigit(digits string) bool {
+	sum := 0
+	for i := len(digits) - 2; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		// Weights alternate 3, 1, 3, ... moving left from the check digit
+		if (len(digits)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(digits[len(digits)-1]-'0')
+}
+
+// NormalizePostalCode validates a US ZIP or ZIP+4 code and returns the
+// 5-digit ZIP, or a Canadian postal code ("k1a 0b1") and returns it in
+// upper case without the space ("K1A0B1")
+func NormalizePostalCode(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if m := zipPattern.FindStringSubmatch(s); m != nil {
+		return m[1], nil
+	}
+	if m := caPostalRegexp.FindStringSubmatch(strings.ToUpper(s)); m != nil {
+		return m[1] + m[2], nil
+	}
+	return "", fmt.Errorf("invalid postal code %q", s)
+}
+
+// ParseList parses a pasted list of product references separated by new
+// lines, commas, semicolons or tabs. Bullets and numbering are ignored and
+// duplicates dropped. Entries that aren't product references are returned
+// as rejected.
+func ParseList(text string) (refs []Ref, rejected []string, err error) {
+	seen := make(map[Ref]bool)
+
+	entries := strings.FieldsFunc(text, func(r rune) bool {
+		return r == '\n' || r == '\r' || r == ',' || r == ';' || r == '\t'
+	})
+	for _, entry := range entries {
+		entry = strings.TrimSpace(listMarker.ReplaceAllString(strings.TrimSpace(entry), ""))
+		if entry == "" {
+			continue
+		}
+
+		ref, err := ParseProductRef(entry)
+		if err != nil {
+			rejected = append(rejected, entry)
+			continue
+		}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+
+		if len(refs) == MaxListItems {
+			return nil, nil, fmt.Errorf("list has more than %d products", MaxListItems)
+		}
+		refs = append(refs, ref)
+	}
+	return refs, rejected, nil
+}
@@ -0,0 +1,112 @@
+package input
+
+import (
+	"strings"
+	"testing"
+)
+
+func FuzzParseProductRef(f *testing.F) {
+	for _, seed := range []string{
+		"6579543",
+		"SKU: 6579543",
+		"#6579543",
+		"820650875823",
+		"0820650875823",
+		"820650875824",
+		"https://www.bestbuy.com/site/pokemon-etb/6579543.p?skuId=6579543",
+		"bestbuy.com/site/6579544.p",
+		"https://evil.example/bestbuy.com/6579543.p",
+		"http://[::1",
+		"bestbuy.%zz",
+		"",
+		"sku",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		ref, err := ParseProductRef(s)
+		if err != nil {
+			return
+		}
+
+		switch ref.Kind {
+		case RefSKU:
+			if !skuPattern.MatchString(ref.Value) {
+				t.Fatalf("ParseProductRef(%q) = invalid SKU %q", s, ref.Value)
+			}
+		case RefUPC:
+			if len(ref.Value) != 12 || !digitsPattern.MatchString(ref.Value) || !validCheckDigit(ref.Value) {
+				t.Fatalf("ParseProductRef(%q) = invalid UPC %q", s, ref.Value)
+			}
+		default:
+			t.Fatalf("ParseProductRef(%q) = unknown kind %q", s, ref.Kind)
+		}
+
+		// The normalized value must parse back to itself
+		again, err := ParseProductRef(ref.Value)
+		if err != nil || again != ref {
+			t.Fatalf("ParseProductRef(%q) = %v, which re-parses as %v, %v", s, ref, again, err)
+		}
+	})
+}
+
+func FuzzNormalizePostalCode(f *testing.F) {
+	for _, seed := range []string{"94103", " 94103 ", "94103-1234", "941031234", "9410", "K1A 0B1", "k1a-0b1", "D1A 0B1", "", "٩٤١٠٣"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		zip, err := NormalizePostalCode(s)
+		if err != nil {
+			return
+		}
+		if !(len(zip) == 5 && digitsPattern.MatchString(zip)) && !caPostalRegexp.MatchString(zip) {
+			t.Fatalf("NormalizePostalCode(%q) = %q, want 5 digits or a Canadian postal code", s, zip)
+		}
+		if again, err := NormalizePostalCode(zip); err != nil || again != zip {
+			t.Fatalf("NormalizePostalCode(%q) = %q, which normalizes to %q, %v", s, zip, again, err)
+		}
+	})
+}
+
+func FuzzParseList(f *testing.F) {
+	for _, seed := range []string{
+		"6579543\n6579544",
+		"1. 6579543\n2) SKU: 6579544\n- 820650875823",
+		"6579543, 6579543; 6579543",
+		"• https://www.bestbuy.com/site/x/6579543.p\r\nnot a product",
+		strings.Repeat("6579543\n", 300),
+		"",
+		"\n\n,;\t",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		refs, rejected, err := ParseList(text)
+		if err != nil {
+			return
+		}
+		if len(refs) > MaxListItems {
+			t.Fatalf("ParseList returned %d refs, max is %d", len(refs), MaxListItems)
+		}
+
+		seen := make(map[Ref]bool)
+		for _, ref := range refs {
+			if seen[ref] {
+				t.Fatalf("ParseList returned duplicate %v", ref)
+			}
+			seen[ref] = true
+
+			if again, err := ParseProductRef(ref.Value); err != nil || again != ref {
+				t.Fatalf("ParseList returned %v, which re-parses as %v, %v", ref, again, err)
+			}
+		}
+		for _, r := range rejected {
+			if strings.TrimSpace(r) == "" {
+				t.Fatalf("ParseList rejected an empty entry")
+			}
+		}
+	})
+}
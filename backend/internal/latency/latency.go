@@ -0,0 +1,183 @@
+// Package latency tracks p95 latency of RPCs and retailer calls in-process and
+// tells the admin when one stays over its budget, so upstream slowness is
+// caught before users notice.
+package latency
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// Defaults
+const (
+	DefaultRPCBudget      = 2 * time.Second
+	DefaultRetailerBudget = 3 * time.Second
+	DefaultWindow         = time.Minute     // p95 is computed over each window
+	DefaultSustain        = 5 * time.Minute // how long p95 must stay over budget before alerting
+	minSamples            = 5               // fewer calls in a window say nothing about p95
+	maxSamples            = 1000            // per window; beyond this samples are kept at random
+)
+
+// Config configures a Tracker
+type Config struct {
+	Window  time.Duration
+	Sustain time.Duration
+}
+
+// Tracker collects call durations per endpoint and reports endpoints whose
+// p95 stays over budget for the sustain period
+type Tracker struct {
+	admin   *notify.AdminNotifier
+	window  time.Duration
+	windows int // consecutive slow windows that make a sustained breach
+
+	mu        sync.Mutex
+	endpoints map[string]*endpoint
+}
+
+// endpoint is the current window of one endpoint
+type endpoint struct {
+	budget      time.Duration
+	samples     []time.Duration
+	seen        int // calls in the window, including ones not sampled
+	slowWindows int // consecutive windows over budget
+}
+
+// Breach is an endpoint whose p95 has been over budget for the sustain period
+type Breach struct {
+	Name     string
+	P95      time.Duration
+	Budget   time.Duration
+	Duration time.Duration
+}
+
+// NewTracker creates a tracker that reports sustained breaches to admin
+func NewTracker(admin *notify.AdminNotifier, cfg Config) *Tracker {
+	if cfg.Window <= 0 {
+		cfg.Window = DefaultWindow
+	}
+	if cfg.Sustain <= 0 {
+		cfg.Sustain = DefaultSustain
+	}
+	return &Tracker{
+		admin:     admin,
+		window:    cfg.Window,
+		windows:   max(int(cfg.Sustain/cfg.Window), 1),
+		endpoints: make(map[string]*endpoint),
+	}
+}
+
+// Observe records one call to an endpoint and the budget its p95 is held to
+func (t *Tracker) Observe(name string, budget, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.endpoints[name]
+	if !ok {
+		e = &endpoint{}
+		t.endpoints[name] = e
+	}
+	e.budget = budget
+	e.seen++
+
+	if len(e.samples) < maxSamples {
+		e.samples = append(e.samples, d)
+	} else if i := rand.IntN(e.seen); i < maxSamples {
+		e.samples[i] = d
+	}
+}
+
+// Observer returns a callback recording calls as prefix + call, for clients
+// that report their own timings
+func (t *Tracker) Observer(prefix string, budget time.Duration) func(call string, d time.Duration) {
+	return func(call string, d time.Duration) {
+		t.Observe(prefix+call, budget, d)
+	}
+}
+
+// Interceptor times every unary RPC against budget
+func (t *Tracker) Interceptor(budget time.Duration) connect.Interceptor {
+	return connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			start := time.Now()
+			resp, err := next(ctx, req)
+			t.Observe("rpc "+req.Spec().Procedure, budget, time.Since(start))
+			return resp, err
+		}
+	})
+}
+
+// Run closes a window every window period until ctx is cancelled
+func (t *Tracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.window)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if breaches := t.Evaluate(); len(breaches) > 0 {
+				t.admin.Report(notify.EventSlowEndpoints, describe(breaches))
+			}
+		}
+	}
+}
+
+// Evaluate closes the current window and returns the endpoints that have now
+// been over budget for the sustain period. Windows with too few calls to
+// judge leave an endpoint's streak as it was.
+func (t *Tracker) Evaluate() []Breach {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var breaches []Breach
+	for name, e := range t.endpoints {
+		if len(e.samples) >= minSamples {
+			p95 := percentile(e.samples, 0.95)
+			if p95 > e.budget {
+				e.slowWindows++
+			} else {
+				e.slowWindows = 0
+			}
+
+			if e.slowWindows >= t.windows {
+				breaches = append(breaches, Breach{
+					Name:     name,
+					P95:      p95,
+					Budget:   e.budget,
+					Duration: time.Duration(e.slowWindows) * t.window,
+				})
+			}
+		}
+		e.samples, e.seen = e.samples[:0], 0
+	}
+
+	sort.Slice(breaches, func(i, j int) bool { return breaches[i].Name < breaches[j].Name })
+	return breaches
+}
+
+// percentile returns the p-th percentile of samples (nearest rank), sorting them in place
+func percentile(samples []time.Duration, p float64) time.Duration {
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	rank := int(float64(len(samples))*p+0.5) - 1
+	return samples[min(max(rank, 0), len(samples)-1)]
+}
+
+// describe formats breaches for the admin notification
+func describe(breaches []Breach) string {
+	lines := make([]string, 0, len(breaches))
+	for _, b := range breaches {
+		lines = append(lines, fmt.Sprintf("%s: p95 %v over the %v budget for %v",
+			b.Name, b.P95.Round(time.Millisecond), b.Budget, b.Duration))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,59 @@
+package latency
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvaluateSustainedBreach(t *testing.T) {
+	tr := NewTracker(nil, Config{Window: time.Minute, Sustain: 3 * time.Minute})
+
+	slowWindow := func() {
+		for i := 0; i < 20; i++ {
+			tr.Observe("bestbuy.CheckAvailability", time.Second, 5*time.Second)
+			tr.Observe("rpc /CheckStock", 2*time.Second, 100*time.Millisecond)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		slowWindow()
+		if breaches := tr.Evaluate(); len(breaches) != 0 {
+			t.Fatalf("window %d: breached before the sustain period: %v", i+1, breaches)
+		}
+	}
+
+	slowWindow()
+	breaches := tr.Evaluate()
+	if len(breaches) != 1 {
+		t.Fatalf("got %d breaches, want 1", len(breaches))
+	}
+	if b := breaches[0]; b.Name != "bestbuy.CheckAvailability" || b.P95 != 5*time.Second || b.Duration != 3*time.Minute {
+		t.Errorf("breach = %+v", b)
+	}
+
+	// A quiet window says nothing, but a fast one resets the streak
+	if breaches := tr.Evaluate(); len(breaches) != 0 {
+		t.Errorf("empty window still breached: %v", breaches)
+	}
+	for i := 0; i < 20; i++ {
+		tr.Observe("bestbuy.CheckAvailability", time.Second, 10*time.Millisecond)
+	}
+	tr.Evaluate()
+	slowWindow()
+	if breaches := tr.Evaluate(); len(breaches) != 0 {
+		t.Errorf("breached right after recovering: %v", breaches)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var samples []time.Duration
+	for i := 1; i <= 100; i++ {
+		samples = append(samples, time.Duration(101-i)*time.Millisecond)
+	}
+	if got := percentile(samples, 0.95); got != 95*time.Millisecond {
+		t.Errorf("p95 = %v, want 95ms", got)
+	}
+	if got := percentile([]time.Duration{time.Second}, 0.95); got != time.Second {
+		t.Errorf("p95 of one sample = %v, want 1s", got)
+	}
+}
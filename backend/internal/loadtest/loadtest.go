@@ -0,0 +1,174 @@
+// Package loadtest provides an in-memory watcher backend and synthetic
+// watchlists for benchmarking the poller and handlers against the mock client.
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// MemoryStore is an in-memory poller.Store
+type MemoryStore struct {
+	targets []database.WatchTarget
+
+	mu        sync.Mutex
+	snapshots map[string]database.StockSnapshot
+	events    []database.StockEvent
+	history   int
+}
+
+// NewMemoryStore creates a MemoryStore that serves the given watch targets
+func NewMemoryStore(targets []database.WatchTarget) *MemoryStore {
+	return &MemoryStore{
+		targets:   targets,
+		snapshots: make(map[string]database.StockSnapshot),
+	}
+}
+
+// GetWatchTargets returns the watch targets
+func (s *MemoryStore) GetWatchTargets(ctx context.Context) ([]database.WatchTarget, error) {
+	return s.targets, nil
+}
+
+// GetStockSnapshots returns the saved snapshots
+func (s *MemoryStore) GetStockSnapshots(ctx context.Context) ([]database.StockSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshots := make([]database.StockSnapshot, 0, len(s.snapshots))
+	for _, snap := range s.snapshots {
+		snapshots = append(snapshots, snap)
+	}
+	return snapshots, nil
+}
+
+// SaveStockSnapshot saves a snapshot, replacing the previous one for the SKU and postal code
+func (s *MemoryStore) SaveStockSnapshot(ctx context.Context, sku, postalCode string, inStockStoreIDs []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.snapshots[sku+"/"+postalCode] = database.StockSnapshot{
+		SKU:             sku,
+		PostalCode:      postalCode,
+		InStockStoreIDs: inStockStoreIDs,
+		CheckedAt:       time.Now(),
+	}
+	return nil
+}
+
+// GetLatestStockEvents returns the latest event per retailer, SKU and store
+func (s *MemoryStore) GetLatestStockEvents(ctx context.Context) ([]database.StockEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latest := make(map[string]database.StockEvent)
+	for _, e := range s.events {
+		latest[string(e.Retailer)+"/"+e.SKU+"/"+e.StoreID] = e
+	}
+	events := make([]database.StockEvent, 0, len(latest))
+	for _, e := range latest {
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// AppendStockEvents appends events to the log
+func (s *MemoryStore) AppendStockEvents(ctx context.Context, events []database.StockEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range events {
+		e.ID = int64(len(s.events) + 1)
+		s.events = append(s.events, e)
+	}
+	return nil
+}
+
+// RecordStockHistory counts the history entries
+func (s *MemoryStore) RecordStockHistory(ctx context.Context, entries []database.StockHistoryEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.history += len(entries)
+	return nil
+}
+
+// History returns the number of history entries recorded
+func (s *MemoryStore) History() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.history
+}
+
+// Events returns the number of events appended
+func (s *MemoryStore) Events() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events)
+}
+
+// CountingSink is a poller.Sink that counts alerts instead of delivering them
+type CountingSink struct {
+	alerts atomic.Int64
+}
+
+// Deliver counts the alert
+func (s *CountingSink) Deliver(ctx context.Context, alert poller.Alert) error {
+	s.alerts.Add(1)
+	return nil
+}
+
+// Alerts returns the number of alerts delivered
+func (s *CountingSink) Alerts() int64 {
+	return s.alerts.Load()
+}
+
+// Watchlists builds watch targets for simulated users. Each user watches
+// productsPerUser mock products at storesPerUser mock stores, drawn round-robin
+// from the mock catalog so users overlap the way real watchlists do.
+func Watchlists(ctx context.Context, client bestbuy.Client, users, productsPerUser, storesPerUser int, postalCodes []string) ([]database.WatchTarget, error) {
+	if len(postalCodes) == 0 {
+		return nil, fmt.Errorf("at least one postal code is required")
+	}
+
+	products, err := client.BrowsePokemonProducts(ctx, bestbuy.BrowseOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load products: %w", err)
+	}
+	stores, err := client.SearchStores(ctx, postalCodes[0], 25)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load stores: %w", err)
+	}
+	if len(products) == 0 || len(stores) == 0 {
+		return nil, fmt.Errorf("catalog is empty")
+	}
+
+	var targets []database.WatchTarget
+	for u := 0; u < users; u++ {
+		postalCode := postalCodes[u%len(postalCodes)]
+		for i := 0; i < productsPerUser; i++ {
+			p := products[(u+i)%len(products)]
+			for j := 0; j < storesPerUser; j++ {
+				s := stores[(u+j)%len(stores)]
+				targets = append(targets, database.WatchTarget{
+					UserID:       u + 1,
+					SKU:          fmt.Sprintf("%d", p.SKU),
+					ProductName:  p.Name,
+					SalePrice:    p.SalePrice,
+					ThumbnailURL: p.ThumbnailImage,
+					ProductURL:   p.URL,
+					StoreID:      fmt.Sprintf("%d", s.StoreID),
+					StoreName:    s.Name,
+					PostalCode:   postalCode,
+				})
+			}
+		}
+	}
+	return targets, nil
+}
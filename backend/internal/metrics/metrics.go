@@ -0,0 +1,193 @@
+// Package metrics keeps labelled counters and gauges and serves them in the
+// Prometheus text exposition format, so operators can scrape and alert on
+// them without the app depending on a metrics client library.
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Metric kinds
+const (
+	kindCounter = "counter"
+	kindGauge   = "gauge"
+)
+
+// Family is a metric with one value per combination of label values
+type Family struct {
+	name   string
+	help   string
+	kind   string
+	labels []string
+
+	mu     sync.Mutex
+	values map[string]*sample // keyed by the joined label values
+}
+
+// sample is the value of one label combination
+type sample struct {
+	labelValues []string
+	value       float64
+}
+
+// Registry is a set of metric families
+type Registry struct {
+	mu       sync.Mutex
+	families []*Family
+}
+
+// Default is the registry served by Handler
+var Default = &Registry{}
+
+// NewCounter registers a counter in the default registry
+func NewCounter(name, help string, labels ...string) *Family {
+	return Default.register(name, help, kindCounter, labels)
+}
+
+// NewGauge registers a gauge in the default registry
+func NewGauge(name, help string, labels ...string) *Family {
+	return Default.register(name, help, kindGauge, labels)
+}
+
+// register adds a family to the registry
+func (r *Registry) register(name, help, kind string, labels []string) *Family {
+	f := &Family{name: name, help: help, kind: kind, labels: labels, values: make(map[string]*sample)}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.families = append(r.families, f)
+	return f
+}
+
+// get returns the sample for a label combination, creating it at zero
+func (f *Family) get(labelValues []string) *sample {
+	if len(labelValues) != len(f.labels) {
+		panic(fmt.Sprintf("metrics: %s takes %d label values, got %d", f.name, len(f.labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	s, ok := f.values[key]
+	if !ok {
+		s = &sample{labelValues: append([]string(nil), labelValues...)}
+		f.values[key] = s
+	}
+	return s
+}
+
+// Inc adds one to a counter
+func (f *Family) Inc(labelValues ...string) {
+	f.Add(1, labelValues...)
+}
+
+// Add adds delta to the value. Counters only go up, so negative deltas are ignored for them.
+func (f *Family) Add(delta float64, labelValues ...string) {
+	if f.kind == kindCounter && delta < 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.get(labelValues).value += delta
+}
+
+// Set sets a gauge's value
+func (f *Family) Set(value float64, labelValues ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.get(labelValues).value = value
+}
+
+// Value returns the current value for a label combination
+func (f *Family) Value(labelValues ...string) float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if s, ok := f.values[strings.Join(labelValues, "\xff")]; ok {
+		return s.value
+	}
+	return 0
+}
+
+// WriteTo writes every family in the text exposition format
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	families := append([]*Family(nil), r.families...)
+	r.mu.Unlock()
+	sort.Slice(families, func(i, j int) bool { return families[i].name < families[j].name })
+
+	var b strings.Builder
+	for _, f := range families {
+		f.write(&b)
+	}
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// write writes one family, with samples in label order so output is stable
+func (f *Family) write(b *strings.Builder) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	fmt.Fprintf(b, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+	fmt.Fprintf(b, "# TYPE %s %s\n", f.name, f.kind)
+
+	keys := make([]string, 0, len(f.values))
+	for k := range f.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		s := f.values[k]
+		b.WriteString(f.name)
+		if len(f.labels) > 0 {
+			b.WriteByte('{')
+			for i, l := range f.labels {
+				if i > 0 {
+					b.WriteByte(',')
+				}
+				fmt.Fprintf(b, `%s="%s"`, l, escapeLabel(s.labelValues[i]))
+			}
+			b.WriteByte('}')
+		}
+		b.WriteByte(' ')
+		b.WriteString(strconv.FormatFloat(s.value, 'g', -1, 64))
+		b.WriteByte('\n')
+	}
+}
+
+// escapeHelp escapes a HELP line
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+// escapeLabel escapes a label value
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(s)
+}
+
+// Handler serves the default registry for Prometheus to scrape
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		Default.WriteTo(w)
+	})
+}
+
+// Serve serves /metrics on its own listener in the background, so metrics
+// stay off the public API port
+func Serve(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	go func() {
+		log.Printf("Metrics available at http://%s/metrics", addr)
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Printf("Metrics server stopped: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExposition(t *testing.T) {
+	r := &Registry{}
+	transitions := r.register("stock_transitions_total", "Stock transitions.", kindCounter, []string{"sku", "event"})
+	last := r.register("last_transition_timestamp_seconds", "Time of the last transition.", kindGauge, []string{"sku"})
+
+	transitions.Inc("6505997", "in_stock")
+	transitions.Inc("6505997", "in_stock")
+	transitions.Inc("6505997", "out_of_stock")
+	transitions.Add(-5, "6505997", "in_stock") // counters never go down
+	transitions.Inc(`we"ird`, "in_stock")
+	last.Set(1760000000, "6505997")
+
+	var b strings.Builder
+	if _, err := r.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `# HELP last_transition_timestamp_seconds Time of the last transition.
+# TYPE last_transition_timestamp_seconds gauge
+last_transition_timestamp_seconds{sku="6505997"} 1.76e+09
+# HELP stock_transitions_total Stock transitions.
+# TYPE stock_transitions_total counter
+stock_transitions_total{sku="6505997",event="in_stock"} 2
+stock_transitions_total{sku="6505997",event="out_of_stock"} 1
+stock_transitions_total{sku="we\"ird",event="in_stock"} 1
+`
+	if got := b.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	if got := transitions.Value("6505997", "in_stock"); got != 2 {
+		t.Errorf("Value = %v, want 2", got)
+	}
+}
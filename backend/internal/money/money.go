@@ -0,0 +1,116 @@
+// Package money represents prices as integer cents so that arithmetic and
+// comparisons (price history, target prices) are exact.
+package money
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Cents is an amount in minor units (cents) of the currency it goes with, e.g.
+// US cents for Best Buy US prices and Canadian cents for bestbuy.ca
+type Cents int64
+
+// FromDollars converts a dollar amount, rounding to the nearest cent
+func FromDollars(dollars float64) Cents {
+	return Cents(math.Round(dollars * 100))
+}
+
+// Parse parses a decimal dollar amount such as "59.99" or "$1,234.50" without
+// going through float64. Digits beyond the cent are rounded half up.
+func Parse(s string) (Cents, error) {
+	text := strings.TrimSpace(s)
+	neg := strings.HasPrefix(text, "-")
+	text = strings.TrimPrefix(text, "-")
+	text = strings.TrimPrefix(text, "$")
+
+	whole, frac, _ := strings.Cut(text, ".")
+	whole, grouped := ungroup(whole)
+	if whole+frac == "" || !grouped || !isDigits(whole) || !isDigits(frac) {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+
+	round := len(frac) > 2 && frac[2] >= '5'
+	n, err := strconv.ParseInt(whole+(frac + "00")[:2], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+	if round {
+		n++
+	}
+	if neg {
+		n = -n
+	}
+	return Cents(n), nil
+}
+
+// ungroup removes thousands separators from the whole part of an amount,
+// reporting false if they aren't every three digits
+func ungroup(whole string) (string, bool) {
+	groups := strings.Split(whole, ",")
+	if len(groups) == 1 {
+		return whole, true
+	}
+	if len(groups[0]) == 0 || len(groups[0]) > 3 {
+		return "", false
+	}
+	for _, g := range groups[1:] {
+		if len(g) != 3 {
+			return "", false
+		}
+	}
+	return strings.Join(groups, ""), true
+}
+
+// isDigits reports whether s only contains ASCII digits
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Dollars returns the amount in dollars, for display and legacy APIs only
+func (c Cents) Dollars() float64 {
+	return float64(c) / 100
+}
+
+// String formats the amount as a decimal, e.g. "59.99"
+func (c Cents) String() string {
+	sign := ""
+	if c < 0 {
+		sign = "-"
+		c = -c
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, c/100, c%100)
+}
+
+// UnmarshalJSON decodes a JSON number (or null) in dollars
+func (c *Cents) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if string(b) == "null" {
+		return nil
+	}
+
+	parsed, err := Parse(string(b))
+	if err != nil {
+		// Exponents are valid JSON numbers even though the API never sends them
+		f, ferr := strconv.ParseFloat(string(b), 64)
+		if ferr != nil {
+			return err
+		}
+		parsed = FromDollars(f)
+	}
+	*c = parsed
+	return nil
+}
+
+// MarshalJSON encodes the amount as a JSON number in dollars
+func (c Cents) MarshalJSON() ([]byte, error) {
+	return []byte(c.String()), nil
+}
@@ -0,0 +1,102 @@
+package money
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Cents
+		wantErr bool
+	}{
+		{in: "59.99", want: 5999},
+		{in: "59", want: 5900},
+		{in: "59.9", want: 5990},
+		{in: ".5", want: 50},
+		{in: " 0.01 ", want: 1},
+		{in: "-12.34", want: -1234},
+		{in: "$1,234.50", want: 123450},
+		{in: "-$1,234,567.89", want: -123456789},
+		{in: "1.005", want: 101}, // half up at the third decimal
+		{in: "1.0049", want: 100},
+		{in: "19.999", want: 2000},
+		{in: "-0.995", want: -100},
+		{in: "", wantErr: true},
+		{in: "   ", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "$", wantErr: true},
+		{in: ".", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "1e3", wantErr: true},
+		{in: "+5", wantErr: true},
+		{in: "12,34.00", wantErr: true},
+		{in: ",123", wantErr: true},
+		{in: "1,234.5,0", wantErr: true},
+		{in: "99999999999999999999", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%q) = %d, want an error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = %d, %v; want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		in   Cents
+		want string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{5999, "59.99"},
+		{123450, "1234.50"},
+		{-1, "-0.01"},
+		{-123456, "-1234.56"},
+	}
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("Cents(%d).String() = %q, want %q", int64(tt.in), got, tt.want)
+		}
+		// What's formatted parses back to the same amount
+		if back, err := Parse(tt.want); err != nil || back != tt.in {
+			t.Errorf("Parse(%q) = %d, %v; want %d", tt.want, back, err, int64(tt.in))
+		}
+	}
+}
+
+func TestFromDollars(t *testing.T) {
+	tests := []struct {
+		in   float64
+		want Cents
+	}{
+		{59.99, 5999},
+		{0.125, 13},
+		{-4.995, -500},
+		{1234.5, 123450},
+	}
+	for _, tt := range tests {
+		if got := FromDollars(tt.in); got != tt.want {
+			t.Errorf("FromDollars(%v) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var c Cents
+	if err := c.UnmarshalJSON([]byte("49.999")); err != nil || c != 5000 {
+		t.Errorf("UnmarshalJSON(49.999) = %d, %v; want 5000", c, err)
+	}
+	if err := c.UnmarshalJSON([]byte("1.5e2")); err != nil || c != 15000 {
+		t.Errorf("UnmarshalJSON(1.5e2) = %d, %v; want 15000", c, err)
+	}
+	if b, err := Cents(-250).MarshalJSON(); err != nil || string(b) != "-2.50" {
+		t.Errorf("MarshalJSON(-250) = %s, %v; want -2.50", b, err)
+	}
+}
@@ -0,0 +1,130 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Event is an operational problem reported to the admin channel
+type Event string
+
+// Operational events
+const (
+	EventAPIKeyInvalid  Event = "api_key_invalid"
+	EventQuotaExhausted Event = "quota_exhausted"
+	EventWatcherStalled Event = "watcher_stalled"
+	EventAdapterDown    Event = "adapter_down"
+	EventDBErrors       Event = "db_errors"
+	EventSlowEndpoints  Event = "slow_endpoints"
+)
+
+// eventTitles are the notification titles for each event
+var eventTitles = map[Event]string{
+	EventAPIKeyInvalid:  "Best Buy API key rejected",
+	EventQuotaExhausted: "Best Buy API quota exhausted",
+	EventWatcherStalled: "Stock watcher stalled",
+	EventAdapterDown:    "Retailer API unreachable",
+	EventDBErrors:       "Database errors spiking",
+	EventSlowEndpoints:  "Endpoints over latency budget",
+}
+
+// Admin alert defaults
+const (
+	DefaultAdminCooldown = 30 * time.Minute // minimum time between repeats of the same event
+	DefaultSpikeWindow   = 5 * time.Minute  // window used to detect error spikes
+	DefaultSpikeCount    = 10               // errors within the window that count as a spike
+)
+
+// AdminNotifier sends operational events to an admin-configured channel.
+// A nil *AdminNotifier is valid and drops every event, so callers don't need
+// to check whether an admin channel is configured.
+type AdminNotifier struct {
+	cooldown    time.Duration
+	spikeWindow time.Duration
+	spikeCount  int
+
+	mu       sync.Mutex
+	notifier Notifier // nil drops events until SetNotifier is called
+	lastSent map[Event]time.Time
+	recent   map[Event][]time.Time
+}
+
+// NewAdminNotifier creates an AdminNotifier that delivers over notifier,
+// which may be nil if the admin channel is set up later
+func NewAdminNotifier(notifier Notifier) *AdminNotifier {
+	return &AdminNotifier{
+		notifier:    notifier,
+		cooldown:    DefaultAdminCooldown,
+		spikeWindow: DefaultSpikeWindow,
+		spikeCount:  DefaultSpikeCount,
+		lastSent:    make(map[Event]time.Time),
+		recent:      make(map[Event][]time.Time),
+	}
+}
+
+// Report sends an event unless the same event was sent within the cooldown.
+// Delivery happens in the background so callers on request paths aren't slowed down.
+func (a *AdminNotifier) Report(event Event, detail string) {
+	if a == nil {
+		return
+	}
+
+	a.mu.Lock()
+	notifier := a.notifier
+	if last, ok := a.lastSent[event]; notifier == nil || ok && time.Since(last) < a.cooldown {
+		a.mu.Unlock()
+		return
+	}
+	a.lastSent[event] = time.Now()
+	a.mu.Unlock()
+
+	msg := Message{
+		Title:    "[Admin] " + eventTitles[event],
+		Body:     detail,
+		Priority: PriorityHigh,
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := notifier.Send(ctx, msg); err != nil {
+			log.Printf("Failed to send admin event %s: %v", event, err)
+		}
+	}()
+}
+
+// SetNotifier switches the channel events are delivered over, e.g. when the
+// admin channel's credentials are rotated; nil stops delivery
+func (a *AdminNotifier) SetNotifier(notifier Notifier) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.notifier = notifier
+}
+
+// Count records one occurrence of an event and reports it once occurrences
+// within the spike window reach the spike threshold.
+func (a *AdminNotifier) Count(event Event, detail string) {
+	if a == nil {
+		return
+	}
+
+	now := time.Now()
+
+	a.mu.Lock()
+	recent := a.recent[event]
+	i := 0
+	for i < len(recent) && now.Sub(recent[i]) > a.spikeWindow {
+		i++
+	}
+	recent = append(recent[i:], now)
+	a.recent[event] = recent
+	count := len(recent)
+	a.mu.Unlock()
+
+	if count >= a.spikeCount {
+		a.Report(event, fmt.Sprintf("%d errors in the last %v. Latest: %s", count, a.spikeWindow, detail))
+	}
+}
@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedDestination is returned for requests to hosts on the server's
+// own network, which users could otherwise probe through their channels
+var ErrBlockedDestination = errors.New("destination address is not allowed")
+
+// sharedAddressSpace is carrier-grade NAT (RFC 6598), reachable only inside
+// the provider's network
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// blockedAddr reports whether addr is loopback, private, link-local (which
+// includes cloud metadata endpoints such as 169.254.169.254), multicast or
+// unspecified
+func blockedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() || sharedAddressSpace.Contains(addr)
+}
+
+// publicURL reports whether raw is an http(s) URL whose host isn't a
+// blocked address or localhost. Hostnames are resolved when dialing, where
+// checkDial catches those pointing at the server's network.
+func publicURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return false
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && blockedAddr(addr) {
+		return false
+	}
+	return true
+}
+
+// checkDial refuses connections to blocked addresses. It runs after DNS
+// resolution for every connection, redirects included, so hostnames that
+// resolve to the server's network are caught too.
+func checkDial(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || blockedAddr(addr) {
+		return fmt.Errorf("%w: %s", ErrBlockedDestination, host)
+	}
+	return nil
+}
+
+// guardedTransport dials only public addresses. It ignores proxy settings,
+// which would bypass the check.
+func guardedTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkDial,
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+)
+
+func TestBlockedAddr(t *testing.T) {
+	tests := []struct {
+		addr    string
+		blocked bool
+	}{
+		{"127.0.0.1", true},
+		{"10.1.2.3", true},
+		{"192.168.0.10", true},
+		{"169.254.169.254", true}, // cloud metadata
+		{"100.64.0.1", true},
+		{"0.0.0.0", true},
+		{"::1", true},
+		{"fd00:ec2::254", true},
+		{"::ffff:127.0.0.1", true},
+		{"93.184.216.34", false},
+		{"2606:4700::6810:85e5", false},
+	}
+	for _, tt := range tests {
+		if got := blockedAddr(netip.MustParseAddr(tt.addr)); got != tt.blocked {
+			t.Errorf("%s: blocked %v, want %v", tt.addr, got, tt.blocked)
+		}
+	}
+}
+
+func TestDefaultClientRefusesLocalServers(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("internal secrets"))
+	}))
+	defer srv.Close()
+
+	_, err := sendJSON(context.Background(), defaultHTTPClient, http.MethodPost, srv.URL, nil, map[string]string{})
+	if !errors.Is(err, ErrBlockedDestination) {
+		t.Errorf("error %v, want ErrBlockedDestination", err)
+	}
+	if called {
+		t.Error("request reached the local server")
+	}
+}
+
+func TestStatusErrorLeavesOutBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("internal secrets"))
+	}))
+	defer srv.Close()
+
+	// The test server is local, so it's reached with an unguarded client
+	_, err := sendJSON(context.Background(), srv.Client(), http.MethodPost, srv.URL, nil, map[string]string{})
+	var status *StatusError
+	if !errors.As(err, &status) || status.Status != http.StatusForbidden {
+		t.Fatalf("error %v, want a 403 StatusError", err)
+	}
+	if msg := err.Error(); msg != status.Host+" returned status 403" {
+		t.Errorf("error %q includes more than the status", msg)
+	}
+}
+
+func TestNotifiersRejectPrivateURLs(t *testing.T) {
+	for _, u := range []string{"http://127.0.0.1/", "http://169.254.169.254/", "http://localhost:8080/", "http://[::1]/", "ftp://example.com/"} {
+		if _, err := NewWebhook(WebhookConfig{URL: u}); err == nil {
+			t.Errorf("webhook accepted %s", u)
+		}
+		if _, err := NewGotify(GotifyConfig{ServerURL: u, AppToken: "token"}); err == nil {
+			t.Errorf("gotify accepted %s", u)
+		}
+		if _, err := NewMatrix(MatrixConfig{HomeserverURL: u, AccessToken: "token", RoomID: "!room:example.com"}); err == nil {
+			t.Errorf("matrix accepted %s", u)
+		}
+	}
+	if _, err := NewWebhook(WebhookConfig{URL: "https://hooks.example.com/stock"}); err != nil {
+		t.Errorf("webhook rejected a public URL: %v", err)
+	}
+}
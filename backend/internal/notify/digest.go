@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"strings"
+
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+)
+
+// DigestEntry is a nice-to-have product that came into stock since the last digest
+type DigestEntry struct {
+	Product      string
+	Price        money.Cents
+	URL          string
+	Stores       int    // stores that had it
+	NearestStore string // empty if unknown
+	Distance     float64
+}
+
+// DigestMessage renders a digest of nice-to-have products, one line each.
+// Stock may be gone by the time it's read, so it's sent at low priority.
+func DigestMessage(locale i18n.Locale, entries []DigestEntry) Message {
+	lines := make([]string, 0, len(entries))
+	for _, e := range entries {
+		line := i18n.T(locale, "notify.digest_line", e.Product, formatPrice(locale, e.Price), e.Stores)
+		if e.NearestStore != "" {
+			line += " " + i18n.T(locale, "notify.digest_nearest", e.NearestStore, formatMiles(locale, e.Distance))
+		}
+		if e.URL != "" {
+			line += "\n  " + e.URL
+		}
+		lines = append(lines, "• "+line)
+	}
+
+	return Message{
+		Title:    i18n.T(locale, "notify.digest_title", len(entries)),
+		Body:     i18n.T(locale, "notify.digest_body") + "\n\n" + strings.Join(lines, "\n"),
+		Priority: PriorityLow,
+		Locale:   locale,
+	}
+}
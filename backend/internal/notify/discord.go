@@ -0,0 +1,277 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Discord limits
+const (
+	discordMaxTitle       = 256
+	discordMaxDescription = 4096
+	discordMaxRetries     = 3
+	discordMaxWait        = 30 * time.Second // longer rate-limit waits fail instead of blocking the watcher
+)
+
+// discordHosts are the hosts Discord serves webhooks from
+var discordHosts = map[string]bool{
+	"discord.com":        true,
+	"discordapp.com":     true,
+	"ptb.discord.com":    true,
+	"canary.discord.com": true,
+}
+
+// DiscordConfig is the per-user Discord configuration
+type DiscordConfig struct {
+	WebhookURL string `json:"webhook_url"`          // from Server Settings > Integrations > Webhooks
+	Username   string `json:"username,omitempty"`   // optional, overrides the webhook's name
+	AvatarURL  string `json:"avatar_url,omitempty"` // optional, overrides the webhook's avatar
+}
+
+// Discord posts notifications as rich embeds to a Discord webhook
+type Discord struct {
+	cfg        DiscordConfig
+	endpoint   string
+	httpClient *http.Client
+}
+
+// NewDiscord creates a Discord notifier
+func NewDiscord(cfg DiscordConfig) (*Discord, error) {
+	if cfg.WebhookURL == "" {
+		return nil, fmt.Errorf("discord requires webhook_url")
+	}
+
+	u, err := url.Parse(cfg.WebhookURL)
+	if err != nil || u.Scheme != "https" || !discordHosts[u.Host] || !strings.HasPrefix(u.Path, "/api/webhooks/") {
+		return nil, fmt.Errorf("discord webhook_url must be a https://discord.com/api/webhooks/... URL")
+	}
+
+	// wait=true makes Discord report delivery errors instead of accepting blindly
+	q := u.Query()
+	q.Set("wait", "true")
+	u.RawQuery = q.Encode()
+
+	return &Discord{cfg: cfg, endpoint: u.String(), httpClient: defaultHTTPClient}, nil
+}
+
+// Channel returns the channel type
+func (d *Discord) Channel() string {
+	return ChannelDiscord
+}
+
+// discordColor maps our priority to the embed's side bar color
+func discordColor(p Priority) int {
+	switch p {
+	case PriorityLow:
+		return 0x99aab5 // grey
+	case PriorityHigh:
+		return 0x57f287 // green
+	case PriorityEmergency:
+		return 0xed4245 // red
+	default:
+		return 0x0046be // Best Buy blue
+	}
+}
+
+// discordMessage is the payload for executing a webhook
+type discordMessage struct {
+	Username  string         `json:"username,omitempty"`
+	AvatarURL string         `json:"avatar_url,omitempty"`
+	Embeds    []discordEmbed `json:"embeds"`
+}
+
+// discordEmbed is a rich embed
+type discordEmbed struct {
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Color       int                 `json:"color"`
+	Thumbnail   *discordImage       `json:"thumbnail,omitempty"`
+	Fields      []discordEmbedField `json:"fields,omitempty"`
+	Timestamp   string              `json:"timestamp"`
+}
+
+// discordImage is an embed image
+type discordImage struct {
+	URL string `json:"url"`
+}
+
+// discordEmbedField is a labelled value in an embed
+type discordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-1]) + "…"
+}
+
+// embed builds the embed for a message
+func (d *Discord) embed(msg Message) discordEmbed {
+	description := msg.Body
+	for _, l := range msg.Links {
+		description += fmt.Sprintf("\n[%s](%s)", l.Name, l.Value)
+	}
+
+	e := discordEmbed{
+		Title:       truncate(msg.Title, discordMaxTitle),
+		Description: truncate(description, discordMaxDescription),
+		URL:         msg.URL,
+		Color:       discordColor(msg.Priority),
+		Timestamp:   time.Now().UTC().Format(time.RFC3339),
+	}
+	if msg.ImageURL != "" {
+		e.Thumbnail = &discordImage{URL: msg.ImageURL}
+	}
+	for _, f := range msg.Fields {
+		e.Fields = append(e.Fields, discordEmbedField{Name: f.Name, Value: f.Value, Inline: true})
+	}
+	return e
+}
+
+// Send delivers a message to the Discord webhook, waiting out rate limits
+func (d *Discord) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(discordMessage{
+		Username:  d.cfg.Username,
+		AvatarURL: d.cfg.AvatarURL,
+		Embeds:    []discordEmbed{d.embed(msg)},
+	})
+	if err != nil {
+		return fmt.Errorf("discord: failed to encode payload: %w", err)
+	}
+
+	bucket := discordBucketFor(d.cfg.WebhookURL)
+	for attempt := 0; ; attempt++ {
+		if err := bucket.wait(ctx); err != nil {
+			return fmt.Errorf("discord: %w", err)
+		}
+
+		retryAfter, err := d.post(ctx, body, bucket)
+		if err == nil {
+			return nil
+		}
+		if retryAfter == 0 || attempt+1 >= discordMaxRetries {
+			return fmt.Errorf("discord: %w", err)
+		}
+		bucket.block(retryAfter)
+	}
+}
+
+// post executes the webhook once. On a 429 it returns how long Discord asked us to wait.
+func (d *Discord) post(ctx context.Context, body []byte, bucket *discordBucket) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", d.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(resp.Body)
+
+	bucket.update(resp.Header)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		var limited struct {
+			RetryAfter float64 `json:"retry_after"` // seconds
+		}
+		json.Unmarshal(respBody, &limited)
+		wait := time.Duration(limited.RetryAfter * float64(time.Second))
+		if wait <= 0 {
+			wait = time.Second
+		}
+		return wait, fmt.Errorf("rate limited for %v", wait)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Printf("Notification to %s failed with status %d: %.500s", req.URL.Host, resp.StatusCode, respBody)
+		return 0, &StatusError{Host: req.URL.Host, Status: resp.StatusCode}
+	}
+	return 0, nil
+}
+
+// discordBucket tracks Discord's rate limit for one webhook, shared by every
+// notifier posting to it (notifiers are created per delivery)
+type discordBucket struct {
+	mu    sync.Mutex
+	until time.Time // no requests before this time
+}
+
+var (
+	discordBucketsMu sync.Mutex
+	discordBuckets   = make(map[string]*discordBucket)
+)
+
+// discordBucketFor returns the rate limit bucket of a webhook URL
+func discordBucketFor(webhookURL string) *discordBucket {
+	discordBucketsMu.Lock()
+	defer discordBucketsMu.Unlock()
+
+	b, ok := discordBuckets[webhookURL]
+	if !ok {
+		b = &discordBucket{}
+		discordBuckets[webhookURL] = b
+	}
+	return b
+}
+
+// wait blocks until the bucket allows a request
+func (b *discordBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	wait := time.Until(b.until)
+	b.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	if wait > discordMaxWait {
+		return fmt.Errorf("rate limited for another %v", wait.Round(time.Second))
+	}
+
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// block stops requests for d
+func (b *discordBucket) block(d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if until := time.Now().Add(d); until.After(b.until) {
+		b.until = until
+	}
+}
+
+// update applies Discord's rate limit headers: once the bucket's remaining
+// requests reach zero, wait for the reset before sending again
+func (b *discordBucket) update(h http.Header) {
+	if h.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+	resetAfter, err := strconv.ParseFloat(h.Get("X-RateLimit-Reset-After"), 64)
+	if err != nil {
+		return
+	}
+	b.block(time.Duration(resetAfter * float64(time.Second)))
+}
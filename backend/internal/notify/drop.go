@@ -0,0 +1,43 @@
+package notify
+
+import (
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+)
+
+// Drop is an invitation-only drop whose entry window just opened
+type Drop struct {
+	Title   string // e.g. "Prismatic Evolutions Super-Premium Collection invitations"
+	Product string
+	Details string    // how to enter
+	URL     string    // the retailer's announcement
+	Closes  time.Time // zero if not announced
+}
+
+// DropMessage renders the alert that a drop's entry window opened, sent
+// with the given priority
+func DropMessage(locale i18n.Locale, d Drop, priority Priority) Message {
+	name := d.Product
+	if name == "" {
+		name = d.Title
+	}
+	msg := Message{
+		Title:    i18n.T(locale, "notify.drop_title", name),
+		Body:     i18n.T(locale, "notify.drop_body", d.Title),
+		URL:      d.URL,
+		URLTitle: i18n.T(locale, "notify.drop_view"),
+		Priority: priority,
+		Locale:   locale,
+	}
+	if d.Details != "" {
+		msg.Body += "\n\n" + d.Details
+	}
+	if !d.Closes.IsZero() {
+		msg.Fields = append(msg.Fields, Field{
+			Name:  i18n.T(locale, "notify.field_drop_closes"),
+			Value: d.Closes.UTC().Format(i18n.T(locale, "notify.time_layout")),
+		})
+	}
+	return msg
+}
@@ -0,0 +1,151 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EmailConfig is the per-user SMTP configuration
+type EmailConfig struct {
+	Host     string `json:"host"`           // e.g. smtp.fastmail.com
+	Port     int    `json:"port,omitempty"` // defaults to 587 (STARTTLS); 465 uses implicit TLS
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	From     string `json:"from"` // sender address
+	To       string `json:"to"`   // recipient address
+}
+
+// Email sends notifications over SMTP
+type Email struct {
+	cfg     EmailConfig
+	from    *mail.Address
+	to      *mail.Address
+	timeout time.Duration
+}
+
+// NewEmail creates an email notifier
+func NewEmail(cfg EmailConfig) (*Email, error) {
+	if cfg.Host == "" || cfg.From == "" || cfg.To == "" {
+		return nil, fmt.Errorf("email requires host, from and to")
+	}
+	if cfg.Port == 0 {
+		cfg.Port = 587
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("email from address is invalid: %w", err)
+	}
+	to, err := mail.ParseAddress(cfg.To)
+	if err != nil {
+		return nil, fmt.Errorf("email to address is invalid: %w", err)
+	}
+
+	return &Email{cfg: cfg, from: from, to: to, timeout: 30 * time.Second}, nil
+}
+
+// Channel returns the channel type
+func (e *Email) Channel() string {
+	return ChannelEmail
+}
+
+// message builds the RFC 5322 message for msg
+func (e *Email) message(msg Message) []byte {
+	body := msg.Body
+	if msg.URL != "" {
+		body += "\n\n" + msg.URL
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", e.from)
+	fmt.Fprintf(&b, "To: %s\r\n", e.to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Title))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	if msg.Priority >= PriorityHigh {
+		b.WriteString("X-Priority: 1\r\nImportance: high\r\n")
+	}
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return b.Bytes()
+}
+
+// Send delivers a message by email
+func (e *Email) Send(ctx context.Context, msg Message) error {
+	if err := e.send(ctx, msg); err != nil {
+		return fmt.Errorf("email: %w", err)
+	}
+	return nil
+}
+
+// send runs the SMTP conversation
+func (e *Email) send(ctx context.Context, msg Message) error {
+	ctx, cancel := context.WithTimeout(ctx, e.timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(e.cfg.Host, strconv.Itoa(e.cfg.Port))
+	tlsConfig := &tls.Config{ServerName: e.cfg.Host}
+
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	if e.cfg.Port == 465 {
+		conn = tls.Client(conn, tlsConfig)
+	}
+
+	c, err := smtp.NewClient(conn, e.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok && e.cfg.Port != 465 {
+		if err := c.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if e.cfg.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.Host)); err != nil {
+			return fmt.Errorf("authentication failed: %w", err)
+		}
+	}
+
+	if err := c.Mail(e.from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(e.to.Address); err != nil {
+		return err
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(e.message(msg)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
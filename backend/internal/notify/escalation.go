@@ -0,0 +1,222 @@
+package notify
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+)
+
+// Escalation defaults
+const (
+	DefaultEscalationDelay = 5 * time.Minute // time the user has to acknowledge before we call
+	DefaultMaxCallsPerDay  = 3               // cap on escalation calls per user per 24h
+)
+
+// AckStore persists pending alerts so unacknowledged ones can be escalated
+type AckStore interface {
+	CreatePendingAlert(ctx context.Context, userID int, token, title string) (int, error)
+	AcknowledgeAlert(ctx context.Context, token string) (bool, error)
+	IsAlertAcknowledged(ctx context.Context, alertID int) (bool, error)
+	MarkAlertEscalated(ctx context.Context, alertID int) error
+	CountEscalationsSince(ctx context.Context, userID int, since time.Time) (int, error)
+}
+
+// Escalator sends an alert over the user's regular channels and, if nobody
+// acknowledges it within the escalation delay, follows up over an escalation
+// channel (e.g. a Twilio Voice call). The daily cap keeps a noisy rule from
+// turning into a stream of phone calls.
+type Escalator struct {
+	store     AckStore
+	ackURL    string
+	delay     time.Duration
+	maxPerDay int
+	done      chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// NewEscalator creates an Escalator. ackURL is the public URL of HandleAck.
+func NewEscalator(store AckStore, ackURL string) *Escalator {
+	return &Escalator{
+		store:     store,
+		ackURL:    ackURL,
+		delay:     DefaultEscalationDelay,
+		maxPerDay: DefaultMaxCallsPerDay,
+		done:      make(chan struct{}),
+	}
+}
+
+// generateAckToken generates a random acknowledgment token
+func generateAckToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// sendAll delivers a message over every notifier, collecting failures
+func sendAll(ctx context.Context, notifiers []Notifier, msg Message) error {
+	var errs []error
+	for _, n := range notifiers {
+		if err := n.Send(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", n.Channel(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Send delivers msg over the primary notifiers. Emergency-priority messages
+// also schedule a follow-up over the escalation notifier, which is cancelled
+// when the user opens the acknowledgment link included in the message.
+func (e *Escalator) Send(ctx context.Context, userID int, msg Message, primary []Notifier, escalation Notifier) error {
+	if escalation == nil || msg.Priority != PriorityEmergency {
+		return sendAll(ctx, primary, msg)
+	}
+
+	note, err := e.schedule(ctx, userID, msg, escalation)
+	if err != nil {
+		return err
+	}
+	withAck := msg
+	withAck.Body += note
+	return sendAll(ctx, primary, withAck)
+}
+
+// Outgoing is a message rendered for one notifier
+type Outgoing struct {
+	Notifier Notifier
+	Message  Message
+}
+
+// SendEach is Send for messages rendered separately for each notifier. The
+// emergency-priority messages share one follow-up, cancelled by the link in any of them.
+func (e *Escalator) SendEach(ctx context.Context, userID int, outgoing []Outgoing, escalation Notifier) error {
+	var note string
+	for _, o := range outgoing {
+		if escalation != nil && o.Message.Priority == PriorityEmergency {
+			var err error
+			if note, err = e.schedule(ctx, userID, o.Message, escalation); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	var errs []error
+	for _, o := range outgoing {
+		msg := o.Message
+		if msg.Priority == PriorityEmergency {
+			msg.Body += note
+		}
+		if err := o.Notifier.Send(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", o.Notifier.Channel(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// schedule records msg as pending and calls over escalation if it isn't
+// acknowledged in time, returning the acknowledgment note to add to the messages
+func (e *Escalator) schedule(ctx context.Context, userID int, msg Message, escalation Notifier) (string, error) {
+	token, err := generateAckToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate ack token: %w", err)
+	}
+
+	alertID, err := e.store.CreatePendingAlert(ctx, userID, token, msg.Title)
+	if err != nil {
+		return "", fmt.Errorf("failed to record pending alert: %w", err)
+	}
+
+	e.wg.Add(1)
+	go e.escalateAfterDelay(alertID, userID, msg, escalation)
+
+	link := e.ackURL + "?token=" + url.QueryEscape(token)
+	return "\n\n" + i18n.T(msg.Locale, "notify.ack_note", int(e.delay.Minutes()), link), nil
+}
+
+// escalateAfterDelay waits for the escalation delay and calls if the alert is still unacknowledged
+func (e *Escalator) escalateAfterDelay(alertID, userID int, msg Message, escalation Notifier) {
+	defer e.wg.Done()
+
+	timer := time.NewTimer(e.delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-e.done:
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	acked, err := e.store.IsAlertAcknowledged(ctx, alertID)
+	if err != nil {
+		log.Printf("Escalation: failed to check alert %d: %v", alertID, err)
+		return
+	}
+	if acked {
+		return
+	}
+
+	calls, err := e.store.CountEscalationsSince(ctx, userID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		log.Printf("Escalation: failed to count escalations for user %d: %v", userID, err)
+		return
+	}
+	if calls >= e.maxPerDay {
+		log.Printf("Escalation: user %d reached the daily limit of %d escalations, skipping alert %d", userID, e.maxPerDay, alertID)
+		return
+	}
+
+	if err := escalation.Send(ctx, msg); err != nil {
+		log.Printf("Escalation: %s failed for alert %d: %v", escalation.Channel(), alertID, err)
+		return
+	}
+
+	if err := e.store.MarkAlertEscalated(ctx, alertID); err != nil {
+		log.Printf("Escalation: failed to mark alert %d escalated: %v", alertID, err)
+	}
+}
+
+// HandleAck acknowledges an alert from the link included in the notification.
+// The page is in the language of the browser the link was opened in.
+func (e *Escalator) HandleAck(w http.ResponseWriter, r *http.Request) {
+	locale := i18n.FromAcceptLanguage(r.Header.Get("Accept-Language"))
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		http.Error(w, i18n.T(locale, "notify.ack_missing_token"), http.StatusBadRequest)
+		return
+	}
+
+	ok, err := e.store.AcknowledgeAlert(r.Context(), token)
+	if err != nil {
+		log.Printf("Escalation: failed to acknowledge alert: %v", err)
+		http.Error(w, i18n.T(locale, "notify.ack_failed"), http.StatusInternalServerError)
+		return
+	}
+	if !ok {
+		http.Error(w, i18n.T(locale, "notify.ack_unknown"), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write([]byte(i18n.T(locale, "notify.ack_done")))
+}
+
+// Close cancels pending escalations and waits for in-flight ones to finish
+func (e *Escalator) Close() {
+	e.closeOnce.Do(func() { close(e.done) })
+	e.wg.Wait()
+}
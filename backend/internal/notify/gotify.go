@@ -0,0 +1,101 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GotifyConfig is the per-user Gotify configuration
+type GotifyConfig struct {
+	ServerURL string `json:"server_url"` // e.g. https://gotify.example.com
+	AppToken  string `json:"app_token"`
+}
+
+// Gotify sends notifications to a self-hosted Gotify server
+type Gotify struct {
+	cfg        GotifyConfig
+	endpoint   string
+	httpClient *http.Client
+}
+
+// NewGotify creates a Gotify notifier
+func NewGotify(cfg GotifyConfig) (*Gotify, error) {
+	if cfg.ServerURL == "" || cfg.AppToken == "" {
+		return nil, fmt.Errorf("gotify requires server_url and app_token")
+	}
+
+	if !publicURL(cfg.ServerURL) {
+		return nil, fmt.Errorf("gotify server_url must be an http(s) URL on a public address")
+	}
+
+	return &Gotify{
+		cfg:        cfg,
+		endpoint:   strings.TrimRight(cfg.ServerURL, "/") + "/message",
+		httpClient: defaultHTTPClient,
+	}, nil
+}
+
+// Channel returns the channel type
+func (g *Gotify) Channel() string {
+	return ChannelGotify
+}
+
+// gotifyPriority maps our priority to Gotify's 0-10 scale.
+// The Gotify Android app only makes a sound at 4+ and shows a heads-up at 8+.
+func gotifyPriority(p Priority) int {
+	switch p {
+	case PriorityLow:
+		return 2
+	case PriorityHigh:
+		return 8
+	case PriorityEmergency:
+		return 10
+	default:
+		return 5
+	}
+}
+
+// gotifyMessage is the payload for POST /message
+type gotifyMessage struct {
+	Title    string         `json:"title"`
+	Message  string         `json:"message"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+}
+
+// Send delivers a message via Gotify
+func (g *Gotify) Send(ctx context.Context, msg Message) error {
+	body := msg.Body
+	if msg.URL != "" {
+		body += "\n\n" + msg.URL
+	}
+
+	payload := gotifyMessage{
+		Title:    msg.Title,
+		Message:  body,
+		Priority: gotifyPriority(msg.Priority),
+	}
+
+	// Let clients open the product page when the notification is tapped
+	if msg.URL != "" || msg.ImageURL != "" {
+		notification := map[string]any{}
+		if msg.URL != "" {
+			notification["click"] = map[string]string{"url": msg.URL}
+		}
+		if msg.ImageURL != "" {
+			notification["bigImageUrl"] = msg.ImageURL
+		}
+		payload.Extras = map[string]any{
+			"client::notification": notification,
+		}
+	}
+
+	headers := map[string]string{"X-Gotify-Key": g.cfg.AppToken}
+	if _, err := sendJSON(ctx, g.httpClient, "POST", g.endpoint, headers, payload); err != nil {
+		return fmt.Errorf("gotify: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+)
+
+// Listing is a product newly listed that matches a user's product watch
+type Listing struct {
+	Query   string // the watch's search
+	Product string
+	Price   money.Cents
+	URL     string
+	Image   string
+}
+
+// ListingMessage renders an alert for a new listing. Unlike stock alerts
+// there are no stores to list, so it doesn't use the user's templates.
+func ListingMessage(locale i18n.Locale, l Listing) Message {
+	return Message{
+		Title:    i18n.T(locale, "notify.new_listing_title", l.Product),
+		Body:     i18n.T(locale, "notify.new_listing_body", l.Query),
+		URL:      l.URL,
+		ImageURL: l.Image,
+		Priority: PriorityHigh,
+		Locale:   locale,
+		Fields:   []Field{{Name: i18n.T(locale, "notify.field_price"), Value: formatPrice(locale, l.Price)}},
+	}
+}
@@ -0,0 +1,121 @@
+package notify
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MatrixConfig is the per-user Matrix configuration
+type MatrixConfig struct {
+	HomeserverURL string `json:"homeserver_url"` // e.g. https://matrix.org
+	AccessToken   string `json:"access_token"`   // access token of the bot/user posting the message
+	RoomID        string `json:"room_id"`        // e.g. !abcdef:matrix.org
+}
+
+// Matrix posts notifications into a Matrix room
+type Matrix struct {
+	cfg        MatrixConfig
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewMatrix creates a Matrix notifier
+func NewMatrix(cfg MatrixConfig) (*Matrix, error) {
+	if cfg.HomeserverURL == "" || cfg.AccessToken == "" || cfg.RoomID == "" {
+		return nil, fmt.Errorf("matrix requires homeserver_url, access_token and room_id")
+	}
+
+	if !publicURL(cfg.HomeserverURL) {
+		return nil, fmt.Errorf("matrix homeserver_url must be an http(s) URL on a public address")
+	}
+
+	if !strings.HasPrefix(cfg.RoomID, "!") {
+		return nil, fmt.Errorf("matrix room_id must be an internal room ID (starting with '!'), not an alias")
+	}
+
+	return &Matrix{
+		cfg:        cfg,
+		baseURL:    strings.TrimRight(cfg.HomeserverURL, "/"),
+		httpClient: defaultHTTPClient,
+	}, nil
+}
+
+// Channel returns the channel type
+func (m *Matrix) Channel() string {
+	return ChannelMatrix
+}
+
+// matrixMessage is an m.room.message event with an HTML body
+type matrixMessage struct {
+	MsgType       string `json:"msgtype"`
+	Body          string `json:"body"`
+	Format        string `json:"format,omitempty"`
+	FormattedBody string `json:"formatted_body,omitempty"`
+}
+
+// formatMatrixMessage renders the plain-text and HTML versions of a message
+func formatMatrixMessage(msg Message) matrixMessage {
+	var plain, rich strings.Builder
+
+	prefix := ""
+	if msg.Priority >= PriorityHigh {
+		prefix = "🚨 "
+	}
+
+	plain.WriteString(prefix + msg.Title)
+	rich.WriteString("<strong>" + html.EscapeString(prefix+msg.Title) + "</strong>")
+
+	if msg.Body != "" {
+		plain.WriteString("\n" + msg.Body)
+		rich.WriteString("<br>" + strings.ReplaceAll(html.EscapeString(msg.Body), "\n", "<br>"))
+	}
+
+	if msg.URL != "" {
+		linkText := msg.URLTitle
+		if linkText == "" {
+			linkText = msg.URL
+		}
+		plain.WriteString("\n" + msg.URL)
+		rich.WriteString(fmt.Sprintf(`<br><a href="%s">%s</a>`, html.EscapeString(msg.URL), html.EscapeString(linkText)))
+	}
+
+	return matrixMessage{
+		MsgType:       "m.text",
+		Body:          plain.String(),
+		Format:        "org.matrix.custom.html",
+		FormattedBody: rich.String(),
+	}
+}
+
+// newTxnID generates a transaction ID so retried requests aren't posted twice
+func newTxnID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Send delivers a message to the configured Matrix room
+func (m *Matrix) Send(ctx context.Context, msg Message) error {
+	txnID, err := newTxnID()
+	if err != nil {
+		return fmt.Errorf("matrix: failed to generate transaction ID: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		m.baseURL, url.PathEscape(m.cfg.RoomID), txnID)
+
+	headers := map[string]string{"Authorization": "Bearer " + m.cfg.AccessToken}
+	if _, err := sendJSON(ctx, m.httpClient, "PUT", endpoint, headers, formatMatrixMessage(msg)); err != nil {
+		return fmt.Errorf("matrix: %w", err)
+	}
+
+	return nil
+}
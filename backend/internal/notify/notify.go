@@ -0,0 +1,241 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+)
+
+// Channel types
+const (
+	ChannelPushover    = "pushover"
+	ChannelGotify      = "gotify"
+	ChannelMatrix      = "matrix"
+	ChannelTwilioVoice = "twilio_voice"
+	ChannelEmail       = "email"
+	ChannelWebhook     = "webhook"
+	ChannelDiscord     = "discord"
+)
+
+// ValidChannel reports whether channelType is a known channel type
+func ValidChannel(channelType string) bool {
+	switch channelType {
+	case ChannelPushover, ChannelGotify, ChannelMatrix, ChannelTwilioVoice, ChannelEmail, ChannelWebhook, ChannelDiscord:
+		return true
+	}
+	return false
+}
+
+// Rollup modes control how a restock at several stores at once is sent on a channel
+const (
+	RollupSummary  = "summary"   // one message listing every store (the default)
+	RollupPerStore = "per_store" // one message per store
+)
+
+// ValidRollup reports whether mode is a known rollup mode
+func ValidRollup(mode string) bool {
+	return mode == RollupSummary || mode == RollupPerStore
+}
+
+// secretFields are the config fields of each channel that are never sent back to clients
+var secretFields = map[string][]string{
+	ChannelPushover:    {"app_token", "user_key"},
+	ChannelGotify:      {"app_token"},
+	ChannelMatrix:      {"access_token"},
+	ChannelTwilioVoice: {"auth_token"},
+	ChannelEmail:       {"password"},
+	ChannelWebhook:     {"secret"},
+	ChannelDiscord:     {"webhook_url"}, // the URL embeds the webhook token
+}
+
+// Priority indicates how urgently a notification should be delivered
+type Priority int
+
+const (
+	PriorityLow Priority = iota
+	PriorityNormal
+	PriorityHigh
+	// PriorityEmergency is reserved for alerts that must not be missed (e.g. invite drops)
+	PriorityEmergency
+)
+
+// Message is a single notification to deliver to a user
+type Message struct {
+	Title    string
+	Body     string
+	URL      string
+	URLTitle string
+	ImageURL string
+	Priority Priority
+
+	// Locale is the language the message is written in, for text added
+	// while it's delivered (acknowledgment notes, the call script)
+	Locale i18n.Locale
+
+	// Fields are labelled details (price, store, distance) for channels that
+	// can show them separately from the body, and Links extra labelled links
+	Fields []Field
+	Links  []Field
+}
+
+// Field is a labelled value in a message
+type Field struct {
+	Name  string
+	Value string
+}
+
+// Notifier delivers messages over a single notification channel
+type Notifier interface {
+	// Channel returns the channel type (e.g. "pushover")
+	Channel() string
+
+	// Send delivers a message
+	Send(ctx context.Context, msg Message) error
+}
+
+// New creates a notifier for a channel type from its stored JSON config
+func New(channelType string, config json.RawMessage) (Notifier, error) {
+	switch channelType {
+	case ChannelPushover:
+		var cfg PushoverConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid pushover config: %w", err)
+		}
+		return NewPushover(cfg)
+	case ChannelGotify:
+		var cfg GotifyConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid gotify config: %w", err)
+		}
+		return NewGotify(cfg)
+	case ChannelMatrix:
+		var cfg MatrixConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid matrix config: %w", err)
+		}
+		return NewMatrix(cfg)
+	case ChannelTwilioVoice:
+		var cfg TwilioVoiceConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid twilio voice config: %w", err)
+		}
+		return NewTwilioVoice(cfg)
+	case ChannelEmail:
+		var cfg EmailConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid email config: %w", err)
+		}
+		return NewEmail(cfg)
+	case ChannelWebhook:
+		var cfg WebhookConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid webhook config: %w", err)
+		}
+		return NewWebhook(cfg)
+	case ChannelDiscord:
+		var cfg DiscordConfig
+		if err := json.Unmarshal(config, &cfg); err != nil {
+			return nil, fmt.Errorf("invalid discord config: %w", err)
+		}
+		return NewDiscord(cfg)
+	default:
+		return nil, fmt.Errorf("unknown notification channel: %s", channelType)
+	}
+}
+
+// RedactConfig removes a channel config's secrets so it can be shown to the user
+func RedactConfig(channelType string, config json.RawMessage) (json.RawMessage, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(config, &fields); err != nil {
+		return nil, err
+	}
+	for _, f := range secretFields[channelType] {
+		delete(fields, f)
+	}
+	return json.Marshal(fields)
+}
+
+// KeepSecrets fills secrets missing from an updated channel config with their
+// previous values, so clients can edit a redacted config without re-entering them
+func KeepSecrets(channelType string, updated, previous json.RawMessage) (json.RawMessage, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(updated, &fields); err != nil {
+		return nil, err
+	}
+	var old map[string]any
+	if err := json.Unmarshal(previous, &old); err != nil {
+		return nil, err
+	}
+	for _, f := range secretFields[channelType] {
+		if v, ok := fields[f]; (!ok || v == "") && old[f] != nil {
+			fields[f] = old[f]
+		}
+	}
+	return json.Marshal(fields)
+}
+
+// defaultHTTPClient is shared by notifiers that don't need custom transport
+// settings. Destinations are user-supplied, so it only reaches public addresses.
+var defaultHTTPClient = &http.Client{
+	Timeout:   10 * time.Second,
+	Transport: guardedTransport(),
+}
+
+// StatusError is returned when a channel's service answers with a non-2xx
+// status. The response body is logged rather than kept, since it comes from
+// a user-chosen destination and errors can reach the user.
+type StatusError struct {
+	Host   string
+	Status int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s returned status %d", e.Host, e.Status)
+}
+
+// sendJSON sends a JSON payload with the given method and returns the response body
+func sendJSON(ctx context.Context, client *http.Client, method, endpoint string, headers map[string]string, payload any) ([]byte, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	return do(client, req)
+}
+
+// do executes a request and returns the body, treating non-2xx statuses as errors
+func do(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Printf("Notification to %s failed with status %d: %.500s", req.URL.Host, resp.StatusCode, respBody)
+		return respBody, &StatusError{Host: req.URL.Host, Status: resp.StatusCode}
+	}
+
+	return respBody, nil
+}
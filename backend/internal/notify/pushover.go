@@ -0,0 +1,154 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const pushoverEndpoint = "https://api.pushover.net/1/messages.json"
+
+// Pushover limits for emergency-priority messages
+const (
+	pushoverMinRetry      = 30 * time.Second
+	pushoverMaxExpire     = 3 * time.Hour
+	pushoverDefaultRetry  = 60 * time.Second
+	pushoverDefaultExpire = 1 * time.Hour
+)
+
+// PushoverConfig is the per-user Pushover configuration
+type PushoverConfig struct {
+	AppToken string `json:"app_token"`
+	UserKey  string `json:"user_key"`
+	Device   string `json:"device,omitempty"` // optional, defaults to all devices
+	Sound    string `json:"sound,omitempty"`  // optional Pushover sound name
+
+	// Emergency priority settings (seconds). Pushover re-alerts every RetrySeconds
+	// until the user acknowledges or ExpireSeconds elapses.
+	RetrySeconds  int `json:"retry_seconds,omitempty"`
+	ExpireSeconds int `json:"expire_seconds,omitempty"`
+}
+
+// Pushover sends notifications through the Pushover API
+type Pushover struct {
+	cfg        PushoverConfig
+	endpoint   string
+	httpClient *http.Client
+}
+
+// NewPushover creates a Pushover notifier
+func NewPushover(cfg PushoverConfig) (*Pushover, error) {
+	if cfg.AppToken == "" || cfg.UserKey == "" {
+		return nil, fmt.Errorf("pushover requires app_token and user_key")
+	}
+	return &Pushover{
+		cfg:        cfg,
+		endpoint:   pushoverEndpoint,
+		httpClient: defaultHTTPClient,
+	}, nil
+}
+
+// Channel returns the channel type
+func (p *Pushover) Channel() string {
+	return ChannelPushover
+}
+
+// pushoverPriority maps our priority to Pushover's -2..2 scale
+func pushoverPriority(p Priority) int {
+	switch p {
+	case PriorityLow:
+		return -1
+	case PriorityHigh:
+		return 1
+	case PriorityEmergency:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// retryExpire returns the retry/expire values clamped to Pushover's limits
+func (p *Pushover) retryExpire() (time.Duration, time.Duration) {
+	retry := time.Duration(p.cfg.RetrySeconds) * time.Second
+	if retry == 0 {
+		retry = pushoverDefaultRetry
+	}
+	if retry < pushoverMinRetry {
+		retry = pushoverMinRetry
+	}
+
+	expire := time.Duration(p.cfg.ExpireSeconds) * time.Second
+	if expire == 0 {
+		expire = pushoverDefaultExpire
+	}
+	if expire > pushoverMaxExpire {
+		expire = pushoverMaxExpire
+	}
+
+	return retry, expire
+}
+
+// pushoverResponse is the API response for message submissions
+type pushoverResponse struct {
+	Status  int      `json:"status"`
+	Request string   `json:"request"`
+	Receipt string   `json:"receipt"`
+	Errors  []string `json:"errors"`
+}
+
+// Send delivers a message via Pushover
+func (p *Pushover) Send(ctx context.Context, msg Message) error {
+	priority := pushoverPriority(msg.Priority)
+
+	form := url.Values{}
+	form.Set("token", p.cfg.AppToken)
+	form.Set("user", p.cfg.UserKey)
+	form.Set("title", msg.Title)
+	form.Set("message", msg.Body)
+	form.Set("priority", strconv.Itoa(priority))
+	if msg.URL != "" {
+		form.Set("url", msg.URL)
+		if msg.URLTitle != "" {
+			form.Set("url_title", msg.URLTitle)
+		}
+	}
+	if p.cfg.Device != "" {
+		form.Set("device", p.cfg.Device)
+	}
+	if p.cfg.Sound != "" {
+		form.Set("sound", p.cfg.Sound)
+	}
+
+	// Emergency priority requires retry/expire parameters
+	if priority == 2 {
+		retry, expire := p.retryExpire()
+		form.Set("retry", strconv.Itoa(int(retry.Seconds())))
+		form.Set("expire", strconv.Itoa(int(expire.Seconds())))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", p.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := do(p.httpClient, req)
+	if err != nil {
+		return fmt.Errorf("pushover: %w", err)
+	}
+
+	var result pushoverResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("pushover: failed to decode response: %w", err)
+	}
+	if result.Status != 1 {
+		return fmt.Errorf("pushover: request rejected: %s", strings.Join(result.Errors, "; "))
+	}
+
+	return nil
+}
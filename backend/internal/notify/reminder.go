@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+)
+
+// Reminder is a reminder to act on a Best Buy email the user forwarded
+type Reminder struct {
+	Pickup  bool   // an order ready for pickup; otherwise an invitation to buy
+	Product string // product name, or the email's subject if the product wasn't found
+	SKU     string // empty if unknown
+	Store   string // empty if unknown
+	Due     time.Time
+}
+
+// ReminderMessage renders a reminder. Invitations expire unbought, so
+// they're sent as high priority.
+func ReminderMessage(locale i18n.Locale, r Reminder) Message {
+	name := r.Product
+	if name == "" {
+		name = i18n.T(locale, "notify.reminder_your_order")
+	}
+
+	msg := Message{Priority: PriorityHigh, Locale: locale}
+	dueField := "notify.field_invitation_expires"
+	if r.Pickup {
+		msg.Title = i18n.T(locale, "notify.reminder_pickup_title", name)
+		msg.Body = i18n.T(locale, "notify.reminder_pickup_body", name)
+		msg.Priority = PriorityNormal
+		dueField = "notify.field_pickup_by"
+	} else {
+		msg.Title = i18n.T(locale, "notify.reminder_invitation_title", name)
+		msg.Body = i18n.T(locale, "notify.reminder_invitation_body", name)
+	}
+
+	if r.SKU != "" {
+		msg.URL = fmt.Sprintf("https://www.bestbuy.com/site/%s.p", r.SKU)
+		msg.URLTitle = i18n.T(locale, "notify.reminder_view")
+	}
+	if r.Store != "" {
+		msg.Fields = append(msg.Fields, Field{Name: i18n.T(locale, "notify.field_reminder_store"), Value: r.Store})
+	}
+	if !r.Due.IsZero() {
+		msg.Fields = append(msg.Fields, Field{
+			Name:  i18n.T(locale, dueField),
+			Value: r.Due.UTC().Format(i18n.T(locale, "notify.time_layout")),
+		})
+	}
+	return msg
+}
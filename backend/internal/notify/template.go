@@ -0,0 +1,289 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/reliability"
+	"github.com/tmcauley/stock-checker/backend/internal/restock"
+)
+
+// Template size limits (keeps stored templates and rendered messages reasonable)
+const (
+	maxTitleTemplateLen = 500
+	maxBodyTemplateLen  = 4000
+)
+
+// AlertData holds the variables available to notification templates
+type AlertData struct {
+	Product  string      // product name
+	SKU      string      // product SKU
+	Price    money.Cents // current sale price
+	Image    string      // product image URL
+	Stores   []AlertStore
+	Distance float64 // distance to the closest in-stock store, in miles
+	Links    AlertLinks
+	Stale    bool // replayed after downtime; the stock may already be gone
+
+	Community bool // reported by a shopper and approved by a moderator, not by the retailer
+	Quantity  int  // how many the shopper counted; 0 if not counted
+
+	MSRP      money.Cents // suggested retail price of the set and product type; 0 if unknown
+	AboveMSRP bool        // priced above MSRP, e.g. a marked-up bundle
+}
+
+// AlertStore is a store included in an alert
+type AlertStore struct {
+	ID       string
+	Name     string
+	City     string
+	State    string
+	Distance float64
+	LowStock bool
+
+	// Confidence is how often users found stock on the shelf when this
+	// store reported it; Unknown until enough have confirmed
+	Confidence reliability.Level
+
+	// Hours are the store's hours on the day of the alert, "10:00-21:00" or
+	// "closed", holiday hours included; empty if unknown. Open is whether
+	// the store was open when the alert was sent, and Opens when a closed
+	// store next opens, in its time zone.
+	Hours        string
+	SpecialHours bool // holiday or event hours instead of the usual ones
+	Open         bool
+	Opens        time.Time
+
+	// Visit suggests when to go for restocks, from the days and times the
+	// store usually restocks; unknown without enough history or hours
+	Visit restock.Hint
+}
+
+// Closed reports whether the store is known to be closed
+func (s AlertStore) Closed() bool {
+	return s.Hours != "" && !s.Open
+}
+
+// AllClosed reports whether every store in the alert is known to be closed,
+// so there's no rush to get there
+func (d AlertData) AllClosed() bool {
+	for _, s := range d.Stores {
+		if !s.Closed() {
+			return false
+		}
+	}
+	return len(d.Stores) > 0
+}
+
+// AlertLinks are the links available to templates
+type AlertLinks struct {
+	Product   string // product page
+	AddToCart string // one-click add to cart
+}
+
+// SampleAlertData is used to validate and preview templates
+var SampleAlertData = AlertData{
+	Product: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box",
+	SKU:     "6579543",
+	Price:   5999,
+	Image:   "https://pisces.bbystatic.com/image2/BestBuy_US/images/products/6579/6579543_sd.jpg",
+	Stores: []AlertStore{
+		{ID: "1118", Name: "Best Buy - San Francisco", City: "San Francisco", State: "CA", Distance: 2.1, Confidence: reliability.High, Hours: "10:00-21:00", Open: true},
+		{ID: "1009", Name: "Best Buy - Daly City", City: "Daly City", State: "CA", Distance: 8.4, LowStock: true},
+	},
+	Distance: 2.1,
+	Links: AlertLinks{
+		Product:   "https://www.bestbuy.com/site/6579543.p",
+		AddToCart: "https://api.bestbuy.com/click/-/6579543/cart",
+	},
+}
+
+// decimal uses the locale's decimal separator in a formatted number
+func decimal(locale i18n.Locale, s string) string {
+	if locale == i18n.English {
+		return s
+	}
+	return strings.Replace(s, ".", ",", 1)
+}
+
+// formatPrice formats a price for the locale
+func formatPrice(locale i18n.Locale, p money.Cents) string {
+	if locale == i18n.English {
+		return "$" + p.String()
+	}
+	return decimal(locale, p.String()) + " $"
+}
+
+// formatMiles formats a distance for the locale
+func formatMiles(locale i18n.Locale, d float64) string {
+	return decimal(locale, fmt.Sprintf("%.1f", d)) + " mi"
+}
+
+// formatVisit describes when to go to a store for restocks, e.g.
+// "Tue 10:00, at opening (trucks usually arrive Tue, Fri)"
+func formatVisit(locale i18n.Locale, h restock.Hint) string {
+	days := make([]string, len(h.Days))
+	for i, d := range h.Days {
+		days[i] = d.String()[:3]
+	}
+	when := h.Next.Format("Mon 15:04")
+	if h.AtOpen {
+		return i18n.T(locale, "notify.visit_at_open", when, strings.Join(days, ", "))
+	}
+	return i18n.T(locale, "notify.visit_around", when, strings.Join(days, ", "), fmt.Sprintf("%02d:00", h.Hour))
+}
+
+// templateFuncs returns the helpers available in templates, formatted for the locale
+func templateFuncs(locale i18n.Locale) template.FuncMap {
+	return template.FuncMap{
+		"price": func(p money.Cents) string { return formatPrice(locale, p) },
+		"miles": func(d float64) string { return formatMiles(locale, d) },
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+	}
+}
+
+// Template renders notification content
+type Template struct {
+	locale i18n.Locale
+	title  *template.Template
+	body   *template.Template
+}
+
+// ParseTemplate parses and validates title/body templates. Templates are
+// executed against sample data so references to unknown fields are caught
+// when they are saved rather than when an alert fires.
+func ParseTemplate(locale i18n.Locale, titleText, bodyText string) (*Template, error) {
+	if len(titleText) > maxTitleTemplateLen {
+		return nil, fmt.Errorf("title template is longer than %d characters", maxTitleTemplateLen)
+	}
+	if len(bodyText) > maxBodyTemplateLen {
+		return nil, fmt.Errorf("body template is longer than %d characters", maxBodyTemplateLen)
+	}
+
+	funcs := templateFuncs(locale)
+	title, err := template.New("title").Funcs(funcs).Option("missingkey=error").Parse(titleText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid title template: %w", err)
+	}
+	body, err := template.New("body").Funcs(funcs).Option("missingkey=error").Parse(bodyText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid body template: %w", err)
+	}
+
+	t := &Template{locale: locale, title: title, body: body}
+	if _, err := t.Render(SampleAlertData, PriorityNormal); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// DefaultTemplate returns the built-in template for a locale
+func DefaultTemplate(locale i18n.Locale) *Template {
+	t, err := ParseTemplate(locale, i18n.T(locale, "notify.title_template"), i18n.T(locale, "notify.body_template"))
+	if err != nil {
+		panic(fmt.Sprintf("default notification template is invalid: %v", err))
+	}
+	return t
+}
+
+// Render executes the template and builds a message
+func (t *Template) Render(data AlertData, priority Priority) (Message, error) {
+	var title, body bytes.Buffer
+	if err := t.title.Execute(&title, data); err != nil {
+		return Message{}, fmt.Errorf("failed to render title template: %w", err)
+	}
+	if err := t.body.Execute(&body, data); err != nil {
+		return Message{}, fmt.Errorf("failed to render body template: %w", err)
+	}
+
+	msg := Message{
+		Title:    strings.TrimSpace(title.String()),
+		Body:     strings.TrimSpace(body.String()),
+		URL:      data.Links.Product,
+		URLTitle: i18n.T(t.locale, "notify.view_product"),
+		ImageURL: data.Image,
+		Priority: priority,
+		Locale:   t.locale,
+	}
+
+	msg.Fields = append(msg.Fields, Field{Name: i18n.T(t.locale, "notify.field_price"), Value: formatPrice(t.locale, data.Price)})
+	// Markups are always pointed out, whatever the template says
+	if data.AboveMSRP {
+		msg.Fields = append(msg.Fields, Field{Name: i18n.T(t.locale, "notify.field_msrp"), Value: formatPrice(t.locale, data.MSRP)})
+		msg.Body += "\n\n" + i18n.T(t.locale, "notify.above_msrp_note", formatPrice(t.locale, data.Price-data.MSRP))
+	}
+	if len(data.Stores) > 0 {
+		closest := data.Stores[0]
+		msg.Fields = append(msg.Fields,
+			Field{Name: i18n.T(t.locale, "notify.field_store"), Value: closest.Name},
+			Field{Name: i18n.T(t.locale, "notify.field_distance"), Value: formatMiles(t.locale, data.Distance)},
+		)
+		if closest.Confidence != reliability.Unknown {
+			msg.Fields = append(msg.Fields, Field{
+				Name:  i18n.T(t.locale, "notify.field_confidence"),
+				Value: i18n.T(t.locale, "notify.confidence_"+string(closest.Confidence)),
+			})
+		}
+		if closest.Confidence == reliability.Low {
+			msg.Body += "\n\n" + i18n.T(t.locale, "notify.low_confidence_note", closest.Name)
+		}
+
+		// Holiday hours and closed stores are pointed out too, since drops
+		// often land when stores keep unusual hours
+		if closest.Hours != "" {
+			name := "notify.field_hours_today"
+			if closest.SpecialHours {
+				name = "notify.field_special_hours"
+			}
+			msg.Fields = append(msg.Fields, Field{Name: i18n.T(t.locale, name), Value: closest.Hours})
+		}
+		if closest.Visit.Known() {
+			msg.Fields = append(msg.Fields, Field{Name: i18n.T(t.locale, "notify.field_best_visit"), Value: formatVisit(t.locale, closest.Visit)})
+		}
+		if closest.Closed() {
+			if closest.Opens.IsZero() {
+				msg.Body += "\n\n" + i18n.T(t.locale, "notify.store_closed_note", closest.Name)
+			} else {
+				msg.Body += "\n\n" + i18n.T(t.locale, "notify.store_opens_note", closest.Name, closest.Opens.Format("Mon 15:04"))
+			}
+		}
+	}
+	if data.Links.AddToCart != "" {
+		msg.Links = append(msg.Links, Field{Name: i18n.T(t.locale, "notify.add_to_cart"), Value: data.Links.AddToCart})
+	}
+
+	return msg, nil
+}
+
+// TemplateStore looks up customized templates
+type TemplateStore interface {
+	ResolveNotificationTemplate(ctx context.Context, userID int, channelType string) (*database.NotificationTemplate, error)
+}
+
+// ResolveTemplate loads the template that applies to a user's channel, falling back to the built-in one
+func ResolveTemplate(ctx context.Context, store TemplateStore, userID int, channelType string, locale i18n.Locale) (*Template, error) {
+	t, err := store.ResolveNotificationTemplate(ctx, userID, channelType)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return DefaultTemplate(locale), nil
+	}
+
+	tmpl, err := ParseTemplate(locale, t.TitleTemplate, t.BodyTemplate)
+	if err != nil {
+		// Saved templates are validated, but the available fields may change over time
+		log.Printf("Notification template %d is no longer valid, using the default: %v", t.ID, err)
+		return DefaultTemplate(locale), nil
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,93 @@
+package notify
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+)
+
+const twilioAPIBase = "https://api.twilio.com/2010-04-01"
+
+// TwilioVoiceConfig is the per-user Twilio Voice configuration
+type TwilioVoiceConfig struct {
+	AccountSID string `json:"account_sid"`
+	AuthToken  string `json:"auth_token"`
+	FromNumber string `json:"from_number"` // Twilio number in E.164 format
+	ToNumber   string `json:"to_number"`   // user's phone number in E.164 format
+}
+
+// TwilioVoice places an automated phone call that reads out the alert.
+// Calls are only placed for emergency-priority messages; use an Escalator so the
+// call happens only when the user hasn't acknowledged the regular alert.
+type TwilioVoice struct {
+	cfg        TwilioVoiceConfig
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewTwilioVoice creates a Twilio Voice notifier
+func NewTwilioVoice(cfg TwilioVoiceConfig) (*TwilioVoice, error) {
+	if cfg.AccountSID == "" || cfg.AuthToken == "" || cfg.FromNumber == "" || cfg.ToNumber == "" {
+		return nil, fmt.Errorf("twilio voice requires account_sid, auth_token, from_number and to_number")
+	}
+	if !strings.HasPrefix(cfg.FromNumber, "+") || !strings.HasPrefix(cfg.ToNumber, "+") {
+		return nil, fmt.Errorf("twilio voice phone numbers must be in E.164 format (e.g. +15555550123)")
+	}
+	return &TwilioVoice{
+		cfg:        cfg,
+		baseURL:    twilioAPIBase,
+		httpClient: defaultHTTPClient,
+	}, nil
+}
+
+// Channel returns the channel type
+func (t *TwilioVoice) Channel() string {
+	return ChannelTwilioVoice
+}
+
+// twiml builds the TwiML document read out during the call, spoken in the
+// message's language. The alert is read twice so it isn't missed if the
+// user picks up late.
+func twiml(msg Message) string {
+	var intro, repeat strings.Builder
+	xml.EscapeText(&intro, []byte(i18n.T(msg.Locale, "notify.call_intro")+" "+msg.Title+". "+msg.Body))
+	xml.EscapeText(&repeat, []byte(i18n.T(msg.Locale, "notify.call_repeat")+" "+msg.Title+". "+msg.Body))
+
+	say := `<Say voice="alice" language="` + i18n.T(msg.Locale, "notify.call_language") + `">`
+	return `<Response>` +
+		say + intro.String() + `</Say>` +
+		`<Pause length="1"/>` +
+		say + repeat.String() + `</Say>` +
+		`</Response>`
+}
+
+// Send places the phone call. Non-emergency messages are rejected.
+func (t *TwilioVoice) Send(ctx context.Context, msg Message) error {
+	if msg.Priority != PriorityEmergency {
+		return fmt.Errorf("twilio voice: calls are only placed for emergency-priority alerts")
+	}
+
+	form := url.Values{}
+	form.Set("To", t.cfg.ToNumber)
+	form.Set("From", t.cfg.FromNumber)
+	form.Set("Twiml", twiml(msg))
+
+	endpoint := fmt.Sprintf("%s/Accounts/%s/Calls.json", t.baseURL, url.PathEscape(t.cfg.AccountSID))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(t.cfg.AccountSID, t.cfg.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if _, err := do(t.httpClient, req); err != nil {
+		return fmt.Errorf("twilio voice: %w", err)
+	}
+
+	return nil
+}
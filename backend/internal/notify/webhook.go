@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WebhookConfig is the per-user webhook configuration
+type WebhookConfig struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret,omitempty"` // optional, used to sign payloads
+}
+
+// Webhook posts notifications as JSON to a user-provided URL
+type Webhook struct {
+	cfg        WebhookConfig
+	httpClient *http.Client
+}
+
+// NewWebhook creates a webhook notifier
+func NewWebhook(cfg WebhookConfig) (*Webhook, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("webhook requires url")
+	}
+
+	if !publicURL(cfg.URL) {
+		return nil, fmt.Errorf("webhook url must be an http(s) URL on a public address")
+	}
+
+	return &Webhook{cfg: cfg, httpClient: defaultHTTPClient}, nil
+}
+
+// Channel returns the channel type
+func (w *Webhook) Channel() string {
+	return ChannelWebhook
+}
+
+// webhookPayload is the JSON body posted to the webhook
+type webhookPayload struct {
+	Title    string `json:"title"`
+	Body     string `json:"body"`
+	URL      string `json:"url,omitempty"`
+	URLTitle string `json:"url_title,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+	Priority string `json:"priority"`
+	SentAt   string `json:"sent_at"`
+}
+
+// priorityNames are the priority values used in webhook payloads
+var priorityNames = map[Priority]string{
+	PriorityLow:       "low",
+	PriorityNormal:    "normal",
+	PriorityHigh:      "high",
+	PriorityEmergency: "emergency",
+}
+
+// Sign returns the signature sent in the X-Stock-Checker-Signature header:
+// "sha256=" followed by the hex HMAC-SHA256 of the body keyed with the secret
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send delivers a message to the webhook
+func (w *Webhook) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(webhookPayload{
+		Title:    msg.Title,
+		Body:     msg.Body,
+		URL:      msg.URL,
+		URLTitle: msg.URLTitle,
+		ImageURL: msg.ImageURL,
+		Priority: priorityNames[msg.Priority],
+		SentAt:   time.Now().UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return fmt.Errorf("webhook: failed to encode payload: %w", err)
+	}
+
+	headers := map[string]string{}
+	if w.cfg.Secret != "" {
+		headers["X-Stock-Checker-Signature"] = Sign(w.cfg.Secret, body)
+	}
+
+	if _, err := sendJSON(ctx, w.httpClient, "POST", w.cfg.URL, headers, json.RawMessage(body)); err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Package pokemontcg looks up Pokemon TCG sets in the Pokemon TCG API
+// (pokemontcg.io) for their release dates, card counts and logos.
+package pokemontcg
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+// Client is the interface for Pokemon TCG API operations
+type Client interface {
+	// FindSet finds a set by name, ignoring case. It returns nil if there's no such set.
+	FindSet(ctx context.Context, name string) (*Set, error)
+}
+
+// Set is a Pokemon TCG expansion
+type Set struct {
+	ID           string // e.g. "sv8pt5"
+	Name         string
+	Series       string
+	ReleaseDate  time.Time
+	PrintedTotal int // cards numbered in the set, as printed on them
+	Total        int // every card, including secret rares
+	LogoURL      string
+	SymbolURL    string
+}
+
+// APIClient is the real Pokemon TCG API client
+type APIClient struct {
+	apiKey  atomic.Pointer[string] // replaced by SetAPIKey when the key is rotated
+	baseURL string
+	api     *webapi.Client
+}
+
+// NewAPIClient creates a Pokemon TCG API client. The API works without a key
+// at a lower rate limit, so apiKey may be empty.
+func NewAPIClient(apiKey string, userAgent string) *APIClient {
+	c := &APIClient{
+		baseURL: "https://api.pokemontcg.io/v2",
+		api:     webapi.New("Pokemon TCG", userAgent, 0),
+	}
+	// Sets are looked up while answering requests, with a cached set to fall
+	// back on, so fail fast rather than back off for long
+	c.api.HTTPClient.Timeout = 15 * time.Second
+	c.api.MaxRetries = 1
+	c.SetAPIKey(apiKey)
+	return c
+}
+
+// SetAPIKey replaces the API key; an empty key uses the keyless rate limit
+func (c *APIClient) SetAPIKey(key string) {
+	c.apiKey.Store(&key)
+}
+
+// apiSet is a set in API responses
+type apiSet struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Series       string `json:"series"`
+	PrintedTotal int    `json:"printedTotal"`
+	Total        int    `json:"total"`
+	ReleaseDate  string `json:"releaseDate"` // e.g. "2025/01/17"
+	Images       struct {
+		Symbol string `json:"symbol"`
+		Logo   string `json:"logo"`
+	} `json:"images"`
+}
+
+// set converts an API set
+func (s apiSet) set() *Set {
+	released, _ := time.Parse("2006/01/02", s.ReleaseDate)
+	return &Set{
+		ID:           s.ID,
+		Name:         s.Name,
+		Series:       s.Series,
+		ReleaseDate:  released,
+		PrintedTotal: s.PrintedTotal,
+		Total:        s.Total,
+		LogoURL:      s.Images.Logo,
+		SymbolURL:    s.Images.Symbol,
+	}
+}
+
+// FindSet finds a set by name, ignoring case. It returns nil if there's no such set.
+func (c *APIClient) FindSet(ctx context.Context, name string) (*Set, error) {
+	// The query syntax quotes phrases; quotes in the name can't be escaped
+	params := url.Values{"q": {fmt.Sprintf("name:%q", strings.ReplaceAll(name, `"`, ""))}}
+	header := http.Header{}
+	if key := *c.apiKey.Load(); key != "" {
+		header.Set("X-Api-Key", key)
+	}
+	var result struct {
+		Data []apiSet `json:"data"`
+	}
+	if err := c.api.Get(ctx, c.baseURL+"/sets?"+params.Encode(), header, &result); err != nil {
+		return nil, err
+	}
+	// The name query also matches longer names, e.g. "151" in "Celebrations: 151"
+	for _, s := range result.Data {
+		if strings.EqualFold(s.Name, name) {
+			return s.set(), nil
+		}
+	}
+	return nil, nil
+}
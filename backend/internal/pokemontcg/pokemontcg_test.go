@@ -0,0 +1,99 @@
+package pokemontcg
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFindSetMatchesWholeName(t *testing.T) {
+	var query, key string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, key = r.URL.Query().Get("q"), r.Header.Get("X-Api-Key")
+		w.Write([]byte(`{"data":[
+			{"id":"cel25c","name":"Celebrations: 151","series":"Sword & Shield"},
+			{"id":"sv3pt5","name":"151","series":"Scarlet & Violet","printedTotal":165,"total":207,"releaseDate":"2023/09/22",
+			 "images":{"symbol":"https://images.pokemontcg.io/sv3pt5/symbol.png","logo":"https://images.pokemontcg.io/sv3pt5/logo.png"}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	c := NewAPIClient("secret", "")
+	c.baseURL = srv.URL
+	set, err := c.FindSet(context.Background(), "151")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query != `name:"151"` || key != "secret" {
+		t.Errorf("sent q=%s, key %q", query, key)
+	}
+	want := Set{
+		ID: "sv3pt5", Name: "151", Series: "Scarlet & Violet",
+		ReleaseDate: time.Date(2023, 9, 22, 0, 0, 0, 0, time.UTC), PrintedTotal: 165, Total: 207,
+		LogoURL: "https://images.pokemontcg.io/sv3pt5/logo.png", SymbolURL: "https://images.pokemontcg.io/sv3pt5/symbol.png",
+	}
+	if set == nil || *set != want {
+		t.Errorf("FindSet = %+v, want %+v", set, want)
+	}
+}
+
+// memoryStore caches sets in memory
+type memoryStore struct {
+	sets    map[string]*Set
+	fetched map[string]time.Time
+}
+
+func (s *memoryStore) GetTCGSet(ctx context.Context, name string) (*Set, time.Time, error) {
+	return s.sets[name], s.fetched[name], nil
+}
+
+func (s *memoryStore) SaveTCGSet(ctx context.Context, name string, set *Set) error {
+	s.sets[name], s.fetched[name] = set, time.Now()
+	return nil
+}
+
+// countingClient counts lookups and fails when err is set
+type countingClient struct {
+	Client
+	calls int
+	err   error
+}
+
+func (c *countingClient) FindSet(ctx context.Context, name string) (*Set, error) {
+	c.calls++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.Client.FindSet(ctx, name)
+}
+
+func TestSetsCacheLookups(t *testing.T) {
+	ctx := context.Background()
+	client := &countingClient{Client: NewMockClient()}
+	store := &memoryStore{sets: map[string]*Set{}, fetched: map[string]time.Time{}}
+	sets := NewSets(client, store)
+
+	for range 2 {
+		set, err := sets.Lookup(ctx, "Prismatic Evolutions")
+		if err != nil || set == nil || set.ID != "sv8pt5" {
+			t.Fatalf("Lookup = %+v, %v", set, err)
+		}
+	}
+	if set, _ := sets.Lookup(ctx, "Not A Set"); set != nil {
+		t.Errorf("Lookup of unknown set = %+v, want nil", set)
+	}
+	sets.Lookup(ctx, "Not A Set")
+	if client.calls != 2 {
+		t.Errorf("API called %d times, want once per name", client.calls)
+	}
+
+	// A stale set is served when the API fails
+	store.fetched["Prismatic Evolutions"] = time.Now().Add(-setTTL)
+	client.err = errors.New("unavailable")
+	if set, err := sets.Lookup(ctx, "Prismatic Evolutions"); err != nil || set == nil {
+		t.Errorf("Lookup with API down = %+v, %v; want the cached set", set, err)
+	}
+}
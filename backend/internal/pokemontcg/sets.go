@@ -0,0 +1,76 @@
+package pokemontcg
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// How long looked up sets are kept before asking the API again
+const (
+	setTTL     = 7 * 24 * time.Hour // set details rarely change once announced
+	missingTTL = 24 * time.Hour     // newly announced sets appear in the API after a while
+)
+
+// Store caches looked up sets locally
+type Store interface {
+	// GetTCGSet returns the cached set for a name (nil if the API had none)
+	// and when it was looked up; the zero time if it never was
+	GetTCGSet(ctx context.Context, name string) (*Set, time.Time, error)
+	// SaveTCGSet caches the set for a name, nil if the API had none
+	SaveTCGSet(ctx context.Context, name string, set *Set) error
+}
+
+// Sets looks up sets through a local cache, so each set costs one API call a week
+type Sets struct {
+	client Client
+	store  Store
+}
+
+// NewSets creates a set lookup cached in store
+func NewSets(client Client, store Store) *Sets {
+	return &Sets{client: client, store: store}
+}
+
+// Lookup returns the set with a name, ignoring case, or nil if there's no such
+// set. When the API fails, a stale cached set is better than none.
+func (s *Sets) Lookup(ctx context.Context, name string) (*Set, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	cached, fetchedAt, err := s.store.GetTCGSet(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	ttl := setTTL
+	if cached == nil {
+		ttl = missingTTL
+	}
+	if !fetchedAt.IsZero() && time.Since(fetchedAt) < ttl {
+		return cached, nil
+	}
+
+	set, err := s.client.FindSet(ctx, name)
+	if err != nil {
+		if cached != nil {
+			log.Printf("Failed to refresh TCG set %q, using cached details: %v", name, err)
+			return cached, nil
+		}
+		return nil, err
+	}
+	if err := s.store.SaveTCGSet(ctx, name, set); err != nil {
+		log.Printf("Failed to cache TCG set %q: %v", name, err)
+	}
+	return set, nil
+}
+
+// Cached returns the cached set with a name however old, without asking the
+// API or writing to the cache, or nil if none is cached
+func (s *Sets) Cached(ctx context.Context, name string) (*Set, error) {
+	if name == "" {
+		return nil, nil
+	}
+	set, _, err := s.store.GetTCGSet(ctx, name)
+	return set, err
+}
@@ -0,0 +1,164 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// DefaultDigestInterval is how often the digester looks for digests that are due
+const DefaultDigestInterval = 15 * time.Minute
+
+// DigestStore provides the alerts waiting for each user's digest
+type DigestStore interface {
+	GetDueDigests(ctx context.Context) (map[int][]database.DigestItem, error)
+	DeleteDigestItems(ctx context.Context, ids []int) error
+}
+
+// DigestFunc sends a user their digest
+type DigestFunc func(ctx context.Context, userID int, items []database.DigestItem) error
+
+// Digester sends users the alerts for their nice-to-have products as a
+// digest once the oldest has waited their digest interval
+type Digester struct {
+	store    DigestStore
+	send     DigestFunc
+	interval time.Duration
+}
+
+// NewDigester creates a Digester (interval defaults to DefaultDigestInterval)
+func NewDigester(store DigestStore, send DigestFunc, interval time.Duration) *Digester {
+	if interval <= 0 {
+		interval = DefaultDigestInterval
+	}
+	return &Digester{store: store, send: send, interval: interval}
+}
+
+// Run sends due digests every interval until ctx is cancelled
+func (d *Digester) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := d.Sweep(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Digester: %v", err)
+		} else if sent > 0 {
+			log.Printf("Digester: sent %d digests", sent)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sweep sends each due digest, returning how many were sent. Items are
+// removed even if a channel fails, so a broken channel doesn't resend them
+// every sweep.
+func (d *Digester) Sweep(ctx context.Context) (int, error) {
+	digests, err := d.store.GetDueDigests(ctx)
+	if err != nil || len(digests) == 0 {
+		return 0, err
+	}
+
+	userIDs := make([]int, 0, len(digests))
+	for userID := range digests {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Ints(userIDs)
+
+	var sent int
+	var errs []error
+	for _, userID := range userIDs {
+		items := digests[userID]
+		if err := d.send(ctx, userID, items); err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", userID, err))
+		} else {
+			sent++
+		}
+
+		ids := make([]int, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		if err := d.store.DeleteDigestItems(ctx, ids); err != nil {
+			return sent, err
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// queueDigest adds an alert for a nice-to-have product to the user's next
+// digest, if their preferences and alert rule let it through
+func (s *NotificationSink) queueDigest(ctx context.Context, alert Alert) error {
+	alert, _, ok, err := s.applyRules(ctx, alert)
+	if err != nil || !ok {
+		return err
+	}
+
+	data := AlertData(alert)
+	item := database.DigestItem{
+		UserID:      alert.UserID,
+		Retailer:    alert.Retailer,
+		SKU:         alert.SKU,
+		ProductName: alert.ProductName,
+		SalePrice:   alert.SalePrice,
+		ProductURL:  data.Links.Product,
+		StoreCount:  len(data.Stores),
+	}
+	if len(data.Stores) > 0 {
+		item.NearestStore, item.DistanceMiles = data.Stores[0].Name, data.Stores[0].Distance
+	}
+	return s.db.QueueDigestItem(ctx, item)
+}
+
+// digestEntries converts digest items for rendering
+func digestEntries(items []database.DigestItem) []notify.DigestEntry {
+	entries := make([]notify.DigestEntry, 0, len(items))
+	for _, item := range items {
+		entries = append(entries, notify.DigestEntry{
+			Product:      item.ProductName,
+			Price:        item.SalePrice,
+			URL:          item.ProductURL,
+			Stores:       item.StoreCount,
+			NearestStore: item.NearestStore,
+			Distance:     item.DistanceMiles,
+		})
+	}
+	return entries
+}
+
+// DeliverDigest sends a user their digest over each of their enabled
+// channels. Nothing is sent if they've turned alerts off since the items
+// were queued.
+func (s *NotificationSink) DeliverDigest(ctx context.Context, userID int, items []database.DigestItem) error {
+	channels, err := s.db.GetUserNotificationChannels(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load channels: %w", err)
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+	prefs, err := s.db.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load preferences: %w", err)
+	}
+	if !prefs.AlertsEnabled {
+		return nil
+	}
+	user, err := s.db.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+	locale, _ := i18n.Parse(user.Locale)
+	return s.deliverToChannels(ctx, userID, channels, notify.DigestMessage(locale, digestEntries(items)))
+}
@@ -0,0 +1,52 @@
+package poller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// digestStore holds due digests and records which items were deleted
+type digestStore struct {
+	due     map[int][]database.DigestItem
+	deleted []int
+}
+
+func (s *digestStore) GetDueDigests(ctx context.Context) (map[int][]database.DigestItem, error) {
+	return s.due, nil
+}
+
+func (s *digestStore) DeleteDigestItems(ctx context.Context, ids []int) error {
+	s.deleted = append(s.deleted, ids...)
+	return nil
+}
+
+func TestDigesterSendsDueDigests(t *testing.T) {
+	store := &digestStore{due: map[int][]database.DigestItem{
+		1: {{ID: 10, UserID: 1, SKU: "6606082"}, {ID: 11, UserID: 1, SKU: "6579543"}},
+		2: {{ID: 12, UserID: 2, SKU: "6614313"}},
+	}}
+	sent := map[int]int{}
+	send := func(ctx context.Context, userID int, items []database.DigestItem) error {
+		sent[userID] = len(items)
+		if userID == 2 {
+			return errors.New("channel down")
+		}
+		return nil
+	}
+
+	n, err := poller.NewDigester(store, send, 0).Sweep(context.Background())
+	if err == nil {
+		t.Error("expected the failed digest to be reported")
+	}
+	if n != 1 || sent[1] != 2 || sent[2] != 1 {
+		t.Errorf("sent %d digests (%v), want one of 2 items for user 1 and a failed one for user 2", n, sent)
+	}
+	// Items are dropped even when sending fails, so they aren't resent every sweep
+	if len(store.deleted) != 3 {
+		t.Errorf("deleted items %v, want all 3", store.deleted)
+	}
+}
@@ -0,0 +1,162 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// DefaultDropInterval is how often the drop watcher looks for entry windows
+// that opened; windows can be short, so it's often
+const DefaultDropInterval = time.Minute
+
+// DropStore provides drops whose entry windows opened and who watches them
+type DropStore interface {
+	GetOpeningDrops(ctx context.Context, now time.Time) ([]database.Drop, error)
+	GetSKUWatchers(ctx context.Context, sku string) ([]int, error)
+	MarkDropNotified(ctx context.Context, id int) error
+}
+
+// DropFunc tells a user a drop's entry window opened
+type DropFunc func(ctx context.Context, userID int, drop database.Drop) error
+
+// DropWatcher notifies the users watching a drop's SKU when its entry window
+// opens, once per window
+type DropWatcher struct {
+	store    DropStore
+	send     DropFunc
+	interval time.Duration
+}
+
+// NewDropWatcher creates a DropWatcher (interval defaults to DefaultDropInterval)
+func NewDropWatcher(store DropStore, send DropFunc, interval time.Duration) *DropWatcher {
+	if interval <= 0 {
+		interval = DefaultDropInterval
+	}
+	return &DropWatcher{store: store, send: send, interval: interval}
+}
+
+// Run notifies watchers of opened drops every interval until ctx is cancelled
+func (w *DropWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := w.Sweep(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Drop watcher: %v", err)
+		} else if sent > 0 {
+			log.Printf("Drop watcher: notified %d watchers", sent)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sweep notifies the watchers of each drop whose window opened, returning how
+// many were notified. A drop is marked notified even if a channel fails, so a
+// broken channel doesn't resend it every sweep.
+func (w *DropWatcher) Sweep(ctx context.Context) (int, error) {
+	drops, err := w.store.GetOpeningDrops(ctx, time.Now())
+	if err != nil || len(drops) == 0 {
+		return 0, err
+	}
+
+	var sent int
+	var errs []error
+	for _, drop := range drops {
+		watchers, err := w.store.GetSKUWatchers(ctx, drop.SKU)
+		if err != nil {
+			return sent, fmt.Errorf("drop %d: failed to load watchers: %w", drop.ID, err)
+		}
+		for _, userID := range watchers {
+			if err := w.send(ctx, userID, drop); err != nil {
+				errs = append(errs, fmt.Errorf("drop %d, user %d: %w", drop.ID, userID, err))
+				continue
+			}
+			sent++
+		}
+		if err := w.store.MarkDropNotified(ctx, drop.ID); err != nil {
+			return sent, err
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// DeliverDrop tells a user a drop's entry window opened over each of their
+// enabled channels
+func (s *NotificationSink) DeliverDrop(ctx context.Context, userID int, drop database.Drop) error {
+	channels, err := s.db.GetUserNotificationChannels(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load channels: %w", err)
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+	prefs, err := s.db.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load preferences: %w", err)
+	}
+	if !prefs.AlertsEnabled {
+		return nil
+	}
+	user, err := s.db.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+	locale, _ := i18n.Parse(user.Locale)
+
+	d := notify.Drop{Title: drop.Title, Product: drop.ProductName, Details: drop.Details, URL: drop.URL}
+	if drop.ClosesAt != nil {
+		d.Closes = *drop.ClosesAt
+	}
+	priority, err := s.priority(ctx, Alert{UserID: userID, Retailer: retailer.BestBuy, SKU: drop.SKU})
+	if err != nil {
+		return err
+	}
+	msg := notify.DropMessage(locale, d, dropPriority(priority))
+	if priority != database.PriorityMustHave {
+		return s.deliverToChannels(ctx, userID, channels, msg)
+	}
+
+	// Like their stock alerts, drops for must-haves go to the urgent channels
+	// and are followed up with a call if the user doesn't acknowledge them
+	channels = slices.DeleteFunc(channels, func(c database.NotificationChannel) bool {
+		return !urgentChannel(prefs, c.ChannelType)
+	})
+	var call notify.Notifier
+	if s.escalator != nil {
+		if call, err = s.callChannel(ctx, userID); err != nil {
+			return err
+		}
+	}
+	if call == nil {
+		return s.deliverToChannels(ctx, userID, channels, msg)
+	}
+	primary, err := s.notifiers(userID, channels)
+	return errors.Join(err, s.escalator.Send(ctx, userID, msg, primary, call))
+}
+
+// dropPriority is the priority of a drop alert for a product with the
+// given priority. Entering is first come, first served at some retailers,
+// so drops are urgent unless the product is only nice to have.
+func dropPriority(product string) notify.Priority {
+	switch product {
+	case database.PriorityMustHave:
+		return notify.PriorityEmergency
+	case database.PriorityNiceToHave:
+		return notify.PriorityNormal
+	}
+	return notify.PriorityHigh
+}
@@ -0,0 +1,60 @@
+package poller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// dropStore holds opened drops and their watchers, recording which drops were marked notified
+type dropStore struct {
+	opened   []database.Drop
+	watchers map[string][]int
+	notified []int
+}
+
+func (s *dropStore) GetOpeningDrops(ctx context.Context, now time.Time) ([]database.Drop, error) {
+	return s.opened, nil
+}
+
+func (s *dropStore) GetSKUWatchers(ctx context.Context, sku string) ([]int, error) {
+	return s.watchers[sku], nil
+}
+
+func (s *dropStore) MarkDropNotified(ctx context.Context, id int) error {
+	s.notified = append(s.notified, id)
+	return nil
+}
+
+func TestDropWatcherNotifiesWatchers(t *testing.T) {
+	store := &dropStore{
+		opened: []database.Drop{{ID: 1, SKU: "6606082"}, {ID: 2, SKU: "6579543"}},
+		watchers: map[string][]int{
+			"6606082": {1, 2},
+		},
+	}
+	sent := map[int][]int{}
+	send := func(ctx context.Context, userID int, drop database.Drop) error {
+		sent[drop.ID] = append(sent[drop.ID], userID)
+		if userID == 2 {
+			return errors.New("channel down")
+		}
+		return nil
+	}
+
+	n, err := poller.NewDropWatcher(store, send, 0).Sweep(context.Background())
+	if err == nil {
+		t.Error("expected the failed notification to be reported")
+	}
+	if n != 1 || len(sent[1]) != 2 || len(sent[2]) != 0 {
+		t.Errorf("notified %d (%v), want user 1 and a failed try for user 2, both for drop 1", n, sent)
+	}
+	// Drops are marked even without watchers or when sending fails, so they aren't resent
+	if len(store.notified) != 2 {
+		t.Errorf("marked drops %v notified, want both", store.notified)
+	}
+}
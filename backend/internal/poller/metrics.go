@@ -0,0 +1,34 @@
+package poller
+
+import (
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+)
+
+// Stock transition metrics, counted from the same events the notifier alerts on,
+// so external alerts ("any restock in the last hour") match what users are sent
+var (
+	stockTransitions = metrics.NewCounter(
+		"stock_checker_stock_transitions_total",
+		"Store-level stock transitions seen by the watcher, by SKU and event (in_stock or out_of_stock).",
+		"sku", "event",
+	)
+	lastStockTransition = metrics.NewGauge(
+		"stock_checker_last_stock_transition_timestamp_seconds",
+		"Unix time of the latest stock transition, by SKU and event.",
+		"sku", "event",
+	)
+	alertsDelivered = metrics.NewCounter(
+		"stock_checker_alerts_total",
+		"Restock alerts delivered to users, by SKU.",
+		"sku",
+	)
+)
+
+// recordTransitions counts stock events in the transition metrics
+func recordTransitions(events []database.StockEvent, now float64) {
+	for _, e := range events {
+		stockTransitions.Inc(e.SKU, e.EventType)
+		lastStockTransition.Set(now, e.SKU, e.EventType)
+	}
+}
@@ -0,0 +1,707 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"github.com/tmcauley/stock-checker/backend/internal/usage"
+)
+
+// Poller defaults
+const (
+	DefaultInterval = 5 * time.Minute
+	stallCycles     = 3              // consecutive missed cycles before the admin is told the watcher stalled
+	maxReplayAge    = 24 * time.Hour // snapshots older than this are too old to replay missed transitions from
+	MaxConcurrency  = 8              // checks run at once, however fast the API answers
+)
+
+// Store provides the products/stores users are watching and persists stock snapshots
+type Store interface {
+	GetWatchTargets(ctx context.Context) ([]database.WatchTarget, error)
+	GetStockSnapshots(ctx context.Context) ([]database.StockSnapshot, error)
+	SaveStockSnapshot(ctx context.Context, sku, postalCode string, inStockStoreIDs []string) error
+	GetLatestStockEvents(ctx context.Context) ([]database.StockEvent, error)
+	AppendStockEvents(ctx context.Context, events []database.StockEvent) error
+	RecordStockHistory(ctx context.Context, entries []database.StockHistoryEntry) error
+}
+
+// Alert is a product that came into stock at one or more of a user's stores
+type Alert struct {
+	UserID       int
+	Retailer     retailer.ID
+	SKU          string
+	PostalCode   string
+	ProductName  string
+	SalePrice    money.Cents
+	ThumbnailURL string
+	ProductURL   string
+	Stores       []bestbuy.StoreAvailability
+
+	// Stale is set for transitions replayed after downtime. They happened some
+	// time between StaleSince and now, so the stock may already be gone.
+	Stale      bool
+	StaleSince time.Time
+
+	// Community is set for sightings reported by a user and confirmed by a
+	// moderator, rather than stock reported by the retailer. Quantity is how
+	// many the user counted, 0 if they didn't.
+	Community bool
+	Quantity  int
+}
+
+// Sink receives alerts produced by a cycle
+type Sink interface {
+	Deliver(ctx context.Context, alert Alert) error
+}
+
+// Config configures a Poller
+type Config struct {
+	Interval     time.Duration
+	HeartbeatURL string // pinged after every successful cycle (healthchecks.io-style dead man's switch)
+
+	// Retailers other than Best Buy whose watched products are polled too.
+	// Products from retailers missing here are only checked on demand.
+	Retailers map[retailer.ID]retailer.Client
+
+	// Quota paces cycles to Best Buy's daily call quota: they slow down when
+	// calls run ahead of the day and pause once only the interactive reserve
+	// is left. Nil runs every interval.
+	Quota *bestbuy.Quota
+
+	// Throttle sets how many checks run at once: enough to keep up with the
+	// pace it has tuned calls to, and one at a time while calls run ahead of
+	// the quota. Nil runs checks one at a time.
+	Throttle *bestbuy.Throttle
+
+	// Usage counts a background check for every user watching what was
+	// checked. Nil counts nothing.
+	Usage *usage.Recorder
+}
+
+// Poller periodically checks availability for everything users watch and
+// alerts them when a product comes into stock at one of their stores.
+// The client's own rate limiting paces a cycle, and the configured throttle
+// decides how many checks wait on it at once; a cycle that overruns the
+// interval just delays the next one.
+type Poller struct {
+	bbClient bestbuy.Client
+	store    Store
+	sink     Sink
+	admin    *notify.AdminNotifier
+	cfg      Config
+
+	httpClient *http.Client
+
+	mu          sync.Mutex
+	inStock     map[checkKey]map[string]bool // store IDs with stock, per SKU/postal code
+	restored    map[checkKey]time.Time       // snapshot times of state restored at startup
+	lastEvents  map[skuStore]string          // latest event type logged per retailer/SKU/store
+	lastSuccess time.Time
+}
+
+// checkKey identifies one CheckAvailability call
+type checkKey struct {
+	Retailer   retailer.ID
+	SKU        string
+	PostalCode string
+}
+
+// skuStore identifies a retailer's SKU at one of its stores in the event log
+type skuStore struct {
+	Retailer retailer.ID
+	SKU      string
+	StoreID  string
+}
+
+// checkResult is the outcome of one CheckAvailability call
+type checkResult struct {
+	key      checkKey
+	previous map[string]bool // store IDs in stock before this check
+	current  map[string]bool // store IDs in stock now
+	stores   map[string]bestbuy.StoreAvailability
+	watched  map[string]bool // store IDs users watch for this SKU/postal code
+	users    map[int]bool    // users watching this SKU/postal code
+	at       time.Time
+}
+
+// New creates a Poller
+func New(bbClient bestbuy.Client, store Store, sink Sink, admin *notify.AdminNotifier, cfg Config) *Poller {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	return &Poller{
+		bbClient:    bbClient,
+		store:       store,
+		sink:        sink,
+		admin:       admin,
+		cfg:         cfg,
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		inStock:     make(map[checkKey]map[string]bool),
+		lastEvents:  make(map[skuStore]string),
+		lastSuccess: time.Now(),
+	}
+}
+
+// Run runs a cycle every interval until ctx is cancelled
+func (p *Poller) Run(ctx context.Context) {
+	log.Printf("Poller: checking stock every %v", p.cfg.Interval)
+
+	// Users checking stock go ahead of the poller's calls
+	ctx = bestbuy.WithPriority(ctx, bestbuy.PriorityBackground)
+
+	if err := p.restore(ctx); err != nil {
+		log.Printf("Poller: failed to restore stock snapshots, missed transitions won't be replayed: %v", err)
+	}
+
+	for {
+		start := time.Now()
+		p.runOnce(ctx)
+
+		wait := p.cfg.Quota.Pace(p.cfg.Interval)
+		if wait > p.cfg.Interval {
+			log.Printf("Poller: %d Best Buy calls left today, next cycle in %v", p.cfg.Quota.Remaining(), wait.Round(time.Second))
+		}
+
+		timer := time.NewTimer(wait - time.Since(start))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// runOnce runs a cycle and handles the heartbeat and stall detection
+func (p *Poller) runOnce(ctx context.Context) {
+	if err := p.RunCycle(ctx); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Poller: cycle failed: %v", err)
+
+		p.mu.Lock()
+		sinceSuccess := time.Since(p.lastSuccess)
+		p.mu.Unlock()
+
+		if sinceSuccess > stallCycles*p.cfg.Interval {
+			p.admin.Report(notify.EventWatcherStalled, fmt.Sprintf("No successful cycle in %v. Last error: %v", sinceSuccess.Round(time.Second), err))
+		}
+		return
+	}
+
+	p.mu.Lock()
+	p.lastSuccess = time.Now()
+	p.mu.Unlock()
+
+	p.heartbeat(ctx)
+}
+
+// restore loads the last persisted stock state so the first cycle can replay
+// transitions that happened while the server was down. Only Best Buy state is
+// persisted; other retailers start from a fresh baseline.
+func (p *Poller) restore(ctx context.Context) error {
+	snapshots, err := p.store.GetStockSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+
+	events, err := p.store.GetLatestStockEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, e := range events {
+		p.lastEvents[skuStore{Retailer: e.Retailer, SKU: e.SKU, StoreID: e.StoreID}] = e.EventType
+	}
+
+	p.restored = make(map[checkKey]time.Time)
+	for _, s := range snapshots {
+		if time.Since(s.CheckedAt) > maxReplayAge {
+			continue
+		}
+		key := checkKey{Retailer: retailer.BestBuy, SKU: s.SKU, PostalCode: s.PostalCode}
+		stores := make(map[string]bool)
+		for _, id := range s.InStockStoreIDs {
+			stores[id] = true
+		}
+		p.inStock[key] = stores
+		p.restored[key] = s.CheckedAt
+	}
+
+	if len(p.restored) > 0 {
+		log.Printf("Poller: restored %d stock snapshots, replaying missed transitions on the first cycle", len(p.restored))
+	}
+	return nil
+}
+
+// RunCycle checks every watched product once and delivers alerts for new stock
+func (p *Poller) RunCycle(ctx context.Context) error {
+	p.mu.Lock()
+	state := p.inStock
+	restored := p.restored
+	p.restored = nil
+	p.mu.Unlock()
+
+	alerts, results, err := p.check(ctx, p.bbClient, state, true)
+	if err != nil {
+		return err
+	}
+
+	events := p.events(results)
+	recordTransitions(events, float64(time.Now().Unix()))
+	if err := p.store.AppendStockEvents(ctx, events); err != nil {
+		log.Printf("Poller: failed to append stock events: %v", err)
+	}
+
+	if err := p.store.RecordStockHistory(ctx, history(results)); err != nil {
+		log.Printf("Poller: failed to record stock history: %v", err)
+	}
+
+	// One call serves everyone watching a SKU, but each of them counts it
+	for _, r := range results {
+		for userID := range r.users {
+			p.cfg.Usage.Add(userID, usage.BackgroundCheck, 1)
+		}
+	}
+
+	// Persist the new state so transitions can be replayed after a restart
+	for _, r := range results {
+		if r.key.Retailer != retailer.BestBuy {
+			continue
+		}
+		storeIDs := make([]string, 0, len(r.current))
+		for id := range r.current {
+			storeIDs = append(storeIDs, id)
+		}
+
+		if err := p.store.SaveStockSnapshot(ctx, r.key.SKU, r.key.PostalCode, storeIDs); err != nil {
+			log.Printf("Poller: failed to save snapshot for %s near %s: %v", r.key.SKU, r.key.PostalCode, err)
+		}
+	}
+
+	for i, alert := range alerts {
+		if since, ok := restored[checkKey{Retailer: alert.Retailer, SKU: alert.SKU, PostalCode: alert.PostalCode}]; ok {
+			alerts[i].Stale = true
+			alerts[i].StaleSince = since
+		}
+	}
+
+	for _, alert := range mergeAlerts(alerts) {
+		if err := p.sink.Deliver(ctx, alert); err != nil {
+			log.Printf("Poller: failed to deliver alert for %s to user %d: %v", alert.SKU, alert.UserID, err)
+			continue
+		}
+		alertsDelivered.Inc(alert.SKU)
+	}
+	return nil
+}
+
+// Simulate runs a cycle against client without delivering alerts or changing
+// the watcher's state, returning the alerts that would fire. With fromEmpty,
+// every in-stock store counts as new; otherwise the watcher's current state
+// is the starting point, so the result is what the next real cycle would send.
+func (p *Poller) Simulate(ctx context.Context, client bestbuy.Client, fromEmpty bool) ([]Alert, error) {
+	state := make(map[checkKey]map[string]bool)
+	if !fromEmpty {
+		p.mu.Lock()
+		for key, stores := range p.inStock {
+			state[key] = stores
+		}
+		p.mu.Unlock()
+	}
+
+	alerts, _, err := p.check(ctx, client, state, !fromEmpty)
+	return mergeAlerts(alerts), err
+}
+
+// events converts check results into store-level transitions for the event log.
+// A store can show up under several postal codes, so the latest logged event
+// per retailer/SKU/store decides whether anything actually changed.
+func (p *Poller) events(results []checkResult) []database.StockEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var events []database.StockEvent
+	for _, r := range results {
+		for id, a := range r.stores {
+			key := skuStore{Retailer: r.key.Retailer, SKU: r.key.SKU, StoreID: id}
+			if p.lastEvents[key] == database.StockEventInStock {
+				continue
+			}
+			p.lastEvents[key] = database.StockEventInStock
+			events = append(events, database.StockEvent{
+				Retailer:  r.key.Retailer,
+				SKU:       r.key.SKU,
+				StoreID:   id,
+				StoreName: a.StoreName,
+				EventType: database.StockEventInStock,
+				LowStock:  a.LowStock,
+			})
+		}
+
+		// The API only lists stores with stock, so stores that dropped out of the results sold out
+		for id := range r.previous {
+			key := skuStore{Retailer: r.key.Retailer, SKU: r.key.SKU, StoreID: id}
+			if r.current[id] || p.lastEvents[key] != database.StockEventInStock {
+				continue
+			}
+			p.lastEvents[key] = database.StockEventOutOfStock
+			events = append(events, database.StockEvent{
+				Retailer:  r.key.Retailer,
+				SKU:       r.key.SKU,
+				StoreID:   id,
+				EventType: database.StockEventOutOfStock,
+			})
+		}
+	}
+	return events
+}
+
+// history converts check results into stock history entries for the stores
+// users watch. Watched stores missing from the results had no stock.
+func history(results []checkResult) []database.StockHistoryEntry {
+	var entries []database.StockHistoryEntry
+	for _, r := range results {
+		for id := range r.watched {
+			a, inStock := r.stores[id]
+			entries = append(entries, database.StockHistoryEntry{
+				SKU:       r.key.SKU,
+				StoreID:   id,
+				InStock:   inStock,
+				LowStock:  a.LowStock,
+				CheckedAt: r.at,
+			})
+		}
+	}
+	return entries
+}
+
+// check runs CheckAvailability once per watched SKU/postal code, updates state
+// and returns alerts for stores that came into stock along with the results of
+// each check. With baseline set, the first result for a SKU/postal code is
+// only recorded, so restarting the server doesn't re-alert on existing stock.
+func (p *Poller) check(ctx context.Context, client bestbuy.Client, state map[checkKey]map[string]bool, baseline bool) ([]Alert, []checkResult, error) {
+	targets, err := p.store.GetWatchTargets(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load watch targets: %w", err)
+	}
+
+	// One API call per SKU/postal code, shared by every user watching it
+	byKey := make(map[checkKey][]database.WatchTarget)
+	var keys []checkKey
+	for _, t := range targets {
+		if t.Retailer == "" {
+			t.Retailer = retailer.BestBuy
+		}
+		if _, polled := p.cfg.Retailers[t.Retailer]; t.Retailer != retailer.BestBuy && !polled {
+			continue
+		}
+
+		key := checkKey{Retailer: t.Retailer, SKU: t.SKU, PostalCode: t.PostalCode}
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], t)
+	}
+
+	checks, err := p.checkAll(ctx, client, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var alerts []Alert
+	var results []checkResult
+	var failures int
+	for i, key := range keys {
+		availability, err := checks[i].availability, checks[i].err
+		if errors.Is(err, bestbuy.ErrRestrictedSKU) {
+			// The API won't say, which is no sign anything is wrong with polling
+			log.Printf("Poller: skipping %s near %s: %v", key.SKU, key.PostalCode, err)
+			continue
+		}
+		if err != nil {
+			log.Printf("Poller: failed to check %s near %s: %v", key.SKU, key.PostalCode, err)
+			failures++
+			continue
+		}
+
+		current := make(map[string]bool)
+		byStore := make(map[string]bestbuy.StoreAvailability)
+		for _, a := range availability {
+			if a.InStock {
+				current[a.StoreID] = true
+				byStore[a.StoreID] = a
+			}
+		}
+
+		watched := make(map[string]bool)
+		users := make(map[int]bool)
+		for _, t := range byKey[key] {
+			if t.StoreID != "" {
+				watched[t.StoreID] = true
+			}
+			users[t.UserID] = true
+		}
+
+		p.mu.Lock()
+		previous, seen := state[key]
+		state[key] = current
+		p.mu.Unlock()
+		results = append(results, checkResult{key: key, previous: previous, current: current, stores: byStore, watched: watched, users: users, at: time.Now()})
+
+		if !seen && baseline {
+			continue
+		}
+		alerts = append(alerts, newAlerts(byKey[key], previous, current, byStore)...)
+	}
+
+	if len(keys) > 0 && failures == len(keys) {
+		return nil, nil, fmt.Errorf("all %d availability checks failed", failures)
+	}
+	return alerts, results, nil
+}
+
+// check is the outcome of one availability check
+type check struct {
+	availability []bestbuy.StoreAvailability
+	err          error
+}
+
+// concurrency returns how many checks to run at once this cycle
+func (p *Poller) concurrency() int {
+	// Calls already running ahead of the day's quota shouldn't go any faster
+	if p.cfg.Quota.Pace(p.cfg.Interval) > p.cfg.Interval {
+		return 1
+	}
+	return p.cfg.Throttle.Concurrency(MaxConcurrency)
+}
+
+// checkAll checks every key, several at once, returning the checks in the
+// order of keys. A fatal error stops the remaining checks, since every one
+// would fail the same way, instead of burning through retries.
+func (p *Poller) checkAll(ctx context.Context, client bestbuy.Client, keys []checkKey) ([]check, error) {
+	checks := make([]check, len(keys))
+	next := make(chan int)
+	stop := make(chan struct{})
+	var fatal error
+	var done int
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for range min(p.concurrency(), max(len(keys), 1)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				select {
+				case <-stop:
+					continue // a check already failed for good
+				default:
+				}
+				availability, err := p.checkAvailability(ctx, client, keys[i])
+				checks[i] = check{availability: availability, err: err}
+
+				mu.Lock()
+				done++
+				if err != nil && fatalAPIError(err) && fatal == nil {
+					fatal = fmt.Errorf("stopped after %d of %d checks: %w", done-1, len(keys), err)
+					close(stop)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+send:
+	for i := range keys {
+		select {
+		case next <- i:
+		case <-stop:
+			break send
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(next)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if fatal != nil {
+		return nil, fatal
+	}
+	return checks, nil
+}
+
+// checkAvailability checks one SKU/postal code with Best Buy's client, or the
+// registered client of the key's retailer
+func (p *Poller) checkAvailability(ctx context.Context, bbClient bestbuy.Client, key checkKey) ([]bestbuy.StoreAvailability, error) {
+	if key.Retailer == retailer.BestBuy {
+		return bbClient.CheckAvailability(ctx, key.SKU, key.PostalCode)
+	}
+
+	availability, err := p.cfg.Retailers[key.Retailer].CheckAvailability(ctx, key.SKU, key.PostalCode)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key.Retailer, err)
+	}
+	converted := make([]bestbuy.StoreAvailability, 0, len(availability))
+	for _, a := range availability {
+		converted = append(converted, bestbuy.StoreAvailability{
+			StoreID:        a.StoreID,
+			StoreName:      a.StoreName,
+			City:           a.City,
+			State:          a.State,
+			Distance:       a.Distance,
+			InStock:        a.InStock,
+			LowStock:       a.LowStock,
+			PickupEligible: a.PickupEligible,
+		})
+	}
+	return converted, nil
+}
+
+// fatalAPIError reports whether err means no further API calls can succeed this cycle
+func fatalAPIError(err error) bool {
+	if errors.Is(err, bestbuy.ErrRestrictedSKU) {
+		return false
+	}
+	var keyErr *bestbuy.APIKeyError
+	var quotaErr *bestbuy.QuotaExceededError
+	return errors.As(err, &keyErr) || errors.As(err, &quotaErr)
+}
+
+// newAlerts groups stores that just came into stock by user. Saved store
+// targets match their own store; location targets match every store within
+// the location's radius.
+func newAlerts(targets []database.WatchTarget, previous, current map[string]bool, byStore map[string]bestbuy.StoreAvailability) []Alert {
+	var alerts []Alert
+	index := make(map[int]int)
+	added := make(map[int]map[string]bool) // store IDs already in each user's alert
+	for _, t := range targets {
+		for _, id := range matchingStores(t, previous, current, byStore) {
+			i, ok := index[t.UserID]
+			if !ok {
+				i = len(alerts)
+				index[t.UserID] = i
+				added[t.UserID] = make(map[string]bool)
+				alerts = append(alerts, Alert{
+					UserID:       t.UserID,
+					Retailer:     t.Retailer,
+					SKU:          t.SKU,
+					PostalCode:   t.PostalCode,
+					ProductName:  t.ProductName,
+					SalePrice:    t.SalePrice,
+					ThumbnailURL: t.ThumbnailURL,
+					ProductURL:   t.ProductURL,
+				})
+			}
+			if added[t.UserID][id] {
+				continue
+			}
+			added[t.UserID][id] = true
+			alerts[i].Stores = append(alerts[i].Stores, byStore[id])
+		}
+	}
+	return alerts
+}
+
+// mergeAlerts rolls alerts for the same user and SKU from different postal
+// codes into one, so a restock at several stores is a single notification.
+// The merged alert is stale only if every part of it is.
+func mergeAlerts(alerts []Alert) []Alert {
+	type userSKU struct {
+		userID   int
+		retailer retailer.ID
+		sku      string
+	}
+
+	var merged []Alert
+	index := make(map[userSKU]int)
+	seen := make(map[userSKU]map[string]bool)
+	for _, a := range alerts {
+		key := userSKU{a.UserID, a.Retailer, a.SKU}
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			seen[key] = make(map[string]bool)
+			for _, st := range a.Stores {
+				seen[key][st.StoreID] = true
+			}
+			merged = append(merged, a)
+			continue
+		}
+
+		m := &merged[i]
+		for _, st := range a.Stores {
+			if !seen[key][st.StoreID] {
+				seen[key][st.StoreID] = true
+				m.Stores = append(m.Stores, st)
+			}
+		}
+		if !a.Stale {
+			m.Stale, m.StaleSince = false, time.Time{}
+		} else if m.Stale && a.StaleSince.Before(m.StaleSince) {
+			m.StaleSince = a.StaleSince
+		}
+	}
+	return merged
+}
+
+// matchingStores returns the IDs of stores that just came into stock for a target
+func matchingStores(t database.WatchTarget, previous, current map[string]bool, byStore map[string]bestbuy.StoreAvailability) []string {
+	if t.StoreID != "" {
+		if current[t.StoreID] && !previous[t.StoreID] {
+			return []string{t.StoreID}
+		}
+		return nil
+	}
+
+	var ids []string
+	for id := range current {
+		if !previous[id] && byStore[id].Distance <= float64(t.RadiusMiles) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// heartbeat pings the configured heartbeat URL
+func (p *Poller) heartbeat(ctx context.Context) {
+	if p.cfg.HeartbeatURL == "" {
+		return
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", p.cfg.HeartbeatURL, nil)
+	if err != nil {
+		log.Printf("Poller: invalid heartbeat URL: %v", err)
+		return
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		log.Printf("Poller: heartbeat failed: %v", err)
+		return
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Printf("Poller: heartbeat returned status %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,36 @@
+package poller_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/loadtest"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// BenchmarkRunCycle measures watcher throughput with a zero-latency mock
+// client, so the numbers reflect the poller's own overhead
+func BenchmarkRunCycle(b *testing.B) {
+	for _, users := range []int{10, 100, 1000} {
+		b.Run(fmt.Sprintf("users=%d", users), func(b *testing.B) {
+			ctx := context.Background()
+			client := bestbuy.NewMockClientWithLatency(0)
+
+			targets, err := loadtest.Watchlists(ctx, client, users, 3, 3, []string{"94103", "94105", "94110"})
+			if err != nil {
+				b.Fatal(err)
+			}
+			p := poller.New(client, loadtest.NewMemoryStore(targets), &loadtest.CountingSink{}, nil, poller.Config{})
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := p.RunCycle(ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(len(targets)*b.N)/b.Elapsed().Seconds(), "targets/s")
+		})
+	}
+}
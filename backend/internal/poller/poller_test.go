@@ -0,0 +1,327 @@
+package poller_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/loadtest"
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// stubClient returns canned availability per SKU
+type stubClient struct {
+	bestbuy.Client
+	inStock  map[string][]string // store IDs with stock, per SKU
+	distance map[string]float64  // miles to each store
+	err      error
+	errs     map[string]error // per SKU, ahead of err
+	calls    int
+}
+
+func (c *stubClient) CheckAvailability(ctx context.Context, sku, postalCode string) ([]bestbuy.StoreAvailability, error) {
+	c.calls++
+	if err := c.errs[sku]; err != nil {
+		return nil, err
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	var availability []bestbuy.StoreAvailability
+	for _, id := range c.inStock[sku] {
+		availability = append(availability, bestbuy.StoreAvailability{StoreID: id, InStock: true, Distance: c.distance[id]})
+	}
+	return availability, nil
+}
+
+// stubRetailer returns canned availability per SKU for another retailer
+type stubRetailer struct {
+	retailer.Client
+	inStock map[string][]string // store IDs with stock, per SKU
+}
+
+func (c *stubRetailer) CheckAvailability(ctx context.Context, sku, postalCode string) ([]retailer.Availability, error) {
+	var availability []retailer.Availability
+	for _, id := range c.inStock[sku] {
+		availability = append(availability, retailer.Availability{StoreID: id, InStock: true})
+	}
+	return availability, nil
+}
+
+func TestRunCyclePollsOtherRetailers(t *testing.T) {
+	ctx := context.Background()
+	client := &stubClient{inStock: map[string][]string{}}
+	gameStop := &stubRetailer{inStock: map[string][]string{}}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, Retailer: retailer.GameStop, SKU: "20017594", StoreID: "6542", PostalCode: "94103"},
+		{UserID: 1, Retailer: retailer.Walmart, SKU: "1512714018", StoreID: "2280", PostalCode: "94103"},
+	})
+	sink := &loadtest.CountingSink{}
+	p := poller.New(client, store, sink, nil, poller.Config{
+		Retailers: map[retailer.ID]retailer.Client{retailer.GameStop: gameStop},
+	})
+
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	gameStop.inStock["20017594"] = []string{"6542"}
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := sink.Alerts(); got != 1 {
+		t.Errorf("GameStop restock sent %d alerts, want 1", got)
+	}
+	if client.calls != 0 {
+		t.Errorf("made %d Best Buy calls for other retailers' products", client.calls)
+	}
+}
+
+func TestRunCycleLogsEventsPerRetailer(t *testing.T) {
+	ctx := context.Background()
+	client := &stubClient{inStock: map[string][]string{}}
+	gameStop := &stubRetailer{inStock: map[string][]string{}}
+	// The same SKU and store ID at two retailers are different products and stores
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+		{UserID: 1, Retailer: retailer.GameStop, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+	})
+	p := poller.New(client, store, &loadtest.CountingSink{}, nil, poller.Config{
+		Retailers: map[retailer.ID]retailer.Client{retailer.GameStop: gameStop},
+	})
+
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	client.inStock["6505997"] = []string{"281"}
+	gameStop.inStock["6505997"] = []string{"281"}
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.Events(); got != 2 {
+		t.Errorf("logged %d events, want 2 (one per retailer)", got)
+	}
+
+	gameStop.inStock["6505997"] = nil
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.Events(); got != 3 {
+		t.Errorf("logged %d events after the GameStop sell-out, want 3", got)
+	}
+}
+
+func TestRunCycleDetectsTransitions(t *testing.T) {
+	ctx := context.Background()
+	client := &stubClient{inStock: map[string][]string{}}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+		{UserID: 2, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+		{UserID: 2, SKU: "6505997", StoreID: "1419", PostalCode: "94103"},
+	})
+	sink := &loadtest.CountingSink{}
+	p := poller.New(client, store, sink, nil, poller.Config{})
+
+	// The first cycle only records a baseline
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if sink.Alerts() != 0 {
+		t.Fatalf("baseline cycle sent %d alerts", sink.Alerts())
+	}
+
+	client.inStock["6505997"] = []string{"281"}
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := sink.Alerts(); got != 2 {
+		t.Errorf("restock sent %d alerts, want 2 (one per user)", got)
+	}
+	if got := store.Events(); got != 1 {
+		t.Errorf("logged %d events, want 1", got)
+	}
+	if got := store.History(); got != 4 {
+		t.Errorf("recorded %d history entries, want 4 (two watched stores per cycle)", got)
+	}
+
+	// Stock that stays put doesn't alert again
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := sink.Alerts(); got != 2 {
+		t.Errorf("unchanged stock sent alerts (total %d)", got)
+	}
+
+	client.inStock["6505997"] = nil
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.Events(); got != 2 {
+		t.Errorf("logged %d events after sell-out, want 2", got)
+	}
+}
+
+func TestRunCycleCountsTransitions(t *testing.T) {
+	ctx := context.Background()
+	client := &stubClient{inStock: map[string][]string{}}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6568433", StoreID: "281", PostalCode: "94103"},
+	})
+	p := poller.New(client, store, &loadtest.CountingSink{}, nil, poller.Config{})
+
+	for _, stores := range [][]string{nil, {"281"}, nil} {
+		client.inStock["6568433"] = stores
+		if err := p.RunCycle(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var b strings.Builder
+	metrics.Default.WriteTo(&b)
+	for _, want := range []string{
+		`stock_checker_stock_transitions_total{sku="6568433",event="in_stock"} 1`,
+		`stock_checker_stock_transitions_total{sku="6568433",event="out_of_stock"} 1`,
+		`stock_checker_alerts_total{sku="6568433"} 1`,
+	} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("metrics missing %s", want)
+		}
+	}
+}
+
+func TestRunCycleLocationRadius(t *testing.T) {
+	ctx := context.Background()
+	client := &stubClient{
+		inStock:  map[string][]string{},
+		distance: map[string]float64{"281": 4, "1419": 12, "498": 40},
+	}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6505997", PostalCode: "94103", Location: "home", RadiusMiles: 15},
+		{UserID: 1, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+	})
+	sink := &loadtest.CountingSink{}
+	p := poller.New(client, store, sink, nil, poller.Config{})
+
+	alerts, err := p.Simulate(ctx, client, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 0 {
+		t.Fatalf("got %d alerts with nothing in stock", len(alerts))
+	}
+
+	client.inStock["6505997"] = []string{"281", "1419", "498"}
+	alerts, err = p.Simulate(ctx, client, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("got %d alerts, want 1", len(alerts))
+	}
+	var ids []string
+	for _, s := range alerts[0].Stores {
+		ids = append(ids, s.StoreID)
+	}
+	if len(ids) != 2 || ids[0] != "1419" || ids[1] != "281" {
+		t.Errorf("alerted stores %v, want [1419 281] (within 15 miles, saved store listed once)", ids)
+	}
+}
+
+func TestSimulateRollsUpPostalCodes(t *testing.T) {
+	client := &stubClient{inStock: map[string][]string{"6505997": {"281", "1419"}}}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+		{UserID: 1, SKU: "6505997", StoreID: "1419", PostalCode: "94608"},
+		{UserID: 1, SKU: "6522225", StoreID: "281", PostalCode: "94103"},
+	})
+	p := poller.New(client, store, &loadtest.CountingSink{}, nil, poller.Config{})
+
+	alerts, err := p.Simulate(context.Background(), client, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("got %d alerts, want 1 rolled up across postal codes", len(alerts))
+	}
+	if got := len(alerts[0].Stores); got != 2 {
+		t.Errorf("rolled-up alert lists %d stores, want 2", got)
+	}
+}
+
+func TestRunCycleStopsOnQuotaExhausted(t *testing.T) {
+	client := &stubClient{err: &bestbuy.QuotaExceededError{Body: "over quota"}}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+		{UserID: 1, SKU: "6522225", StoreID: "281", PostalCode: "94103"},
+		{UserID: 1, SKU: "6536991", StoreID: "281", PostalCode: "94103"},
+	})
+	p := poller.New(client, store, &loadtest.CountingSink{}, nil, poller.Config{})
+
+	err := p.RunCycle(context.Background())
+	var quotaErr *bestbuy.QuotaExceededError
+	if !errors.As(err, &quotaErr) {
+		t.Fatalf("got %v, want QuotaExceededError", err)
+	}
+	if client.calls != 1 {
+		t.Errorf("made %d calls after quota ran out, want 1", client.calls)
+	}
+}
+
+func TestRunCycleSkipsRestrictedSKUs(t *testing.T) {
+	ctx := context.Background()
+	client := &stubClient{
+		inStock: map[string][]string{},
+		errs: map[string]error{
+			"6606082": &bestbuy.RestrictedError{SKU: "6606082", Err: &bestbuy.ForbiddenError{Body: "forbidden"}},
+		},
+	}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6606082", StoreID: "281", PostalCode: "94103"},
+		{UserID: 1, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+	})
+	sink := &loadtest.CountingSink{}
+	p := poller.New(client, store, sink, nil, poller.Config{})
+
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	client.inStock["6505997"] = []string{"281"}
+	if err := p.RunCycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := sink.Alerts(); got != 1 {
+		t.Errorf("restock alongside a restricted SKU sent %d alerts, want 1", got)
+	}
+	if client.calls != 4 {
+		t.Errorf("made %d calls, want 4 (a restricted SKU doesn't stop the cycle)", client.calls)
+	}
+
+	// A cycle of nothing but restricted SKUs is quiet, not failed
+	client.errs["6505997"] = &bestbuy.RestrictedError{SKU: "6505997", Err: &bestbuy.ForbiddenError{Body: "forbidden"}}
+	if err := p.RunCycle(ctx); err != nil {
+		t.Errorf("all-restricted cycle: %v", err)
+	}
+}
+
+func TestRunCycleStopsOnRejectedKey(t *testing.T) {
+	client := &stubClient{err: &bestbuy.APIKeyError{StatusCode: 403, Body: "<h1>Developer Inactive</h1>"}}
+	store := loadtest.NewMemoryStore([]database.WatchTarget{
+		{UserID: 1, SKU: "6505997", StoreID: "281", PostalCode: "94103"},
+		{UserID: 1, SKU: "6522225", StoreID: "281", PostalCode: "94103"},
+	})
+	p := poller.New(client, store, &loadtest.CountingSink{}, nil, poller.Config{})
+
+	err := p.RunCycle(context.Background())
+	if !errors.Is(err, bestbuy.ErrAPIKeyRejected) || errors.Is(err, bestbuy.ErrRestrictedSKU) {
+		t.Fatalf("got %v, want the rejected key, not a restricted SKU", err)
+	}
+	if client.calls != 1 {
+		t.Errorf("made %d calls after the key was rejected, want 1", client.calls)
+	}
+}
@@ -0,0 +1,193 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// DefaultProductWatchInterval is how often product watches search for new listings
+const DefaultProductWatchInterval = 30 * time.Minute
+
+// maxWatchPages caps the result pages a product watch searches; a query
+// matching more than that is too broad to tell new listings apart
+const maxWatchPages = 5
+
+// ProductWatchStore provides product watches and remembers the SKUs they've seen
+type ProductWatchStore interface {
+	GetProductWatches(ctx context.Context) ([]database.ProductWatch, error)
+	RecordWatchSKUs(ctx context.Context, watchID int, skus []string) ([]string, error)
+}
+
+// NewProductsFunc alerts a watch's user to products newly listed for it
+type NewProductsFunc func(ctx context.Context, watch database.ProductWatch, products []bestbuy.Product) error
+
+// ProductWatcher alerts users when Best Buy lists a new SKU matching one of
+// their product watches
+type ProductWatcher struct {
+	bbClient bestbuy.Client
+	store    ProductWatchStore
+	notify   NewProductsFunc
+	interval time.Duration
+}
+
+// NewProductWatcher creates a ProductWatcher (interval defaults to DefaultProductWatchInterval)
+func NewProductWatcher(bbClient bestbuy.Client, store ProductWatchStore, notify NewProductsFunc, interval time.Duration) *ProductWatcher {
+	if interval <= 0 {
+		interval = DefaultProductWatchInterval
+	}
+	return &ProductWatcher{bbClient: bbClient, store: store, notify: notify, interval: interval}
+}
+
+// Run checks product watches every interval until ctx is cancelled
+func (w *ProductWatcher) Run(ctx context.Context) {
+	ctx = bestbuy.WithPriority(ctx, bestbuy.PriorityBackground)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if found, err := w.Sweep(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Product watcher: %v", err)
+		} else if found > 0 {
+			log.Printf("Product watcher: found %d new listings", found)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sweep runs each watch's search and alerts its user to SKUs it hasn't seen,
+// returning how many new listings were found. A watch's first search only
+// records what's already listed. Watches with the same search share one.
+func (w *ProductWatcher) Sweep(ctx context.Context) (int, error) {
+	watches, err := w.store.GetProductWatches(ctx)
+	if err != nil || len(watches) == 0 {
+		return 0, err
+	}
+
+	searches := make(map[string][]bestbuy.Product)
+	var found int
+	var errs []error
+	for _, watch := range watches {
+		key := watch.CategoryID + "\x00" + strings.ToLower(watch.Query)
+		products, ok := searches[key]
+		if !ok {
+			products, err = SearchWatch(ctx, w.bbClient, watch)
+			if err != nil {
+				if ctx.Err() != nil {
+					return found, ctx.Err()
+				}
+				errs = append(errs, fmt.Errorf("watch %d (%q): %w", watch.ID, watch.Query, err))
+				continue
+			}
+			searches[key] = products
+		}
+
+		added, err := w.store.RecordWatchSKUs(ctx, watch.ID, productSKUs(products))
+		if err != nil {
+			return found, err
+		}
+		if watch.CheckedAt.IsZero() || len(added) == 0 {
+			continue
+		}
+
+		listed := newListings(products, added)
+		found += len(listed)
+		if err := w.notify(ctx, watch, listed); err != nil {
+			errs = append(errs, fmt.Errorf("watch %d: %w", watch.ID, err))
+		}
+	}
+	return found, errors.Join(errs...)
+}
+
+// SearchWatch returns every product matching a watch's search, up to maxWatchPages pages
+func SearchWatch(ctx context.Context, bbClient bestbuy.Client, watch database.ProductWatch) ([]bestbuy.Product, error) {
+	var products []bestbuy.Product
+	for page := 1; page <= maxWatchPages; page++ {
+		result, err := bbClient.SearchProductsInCategory(ctx, watch.CategoryID, watch.Query, bestbuy.PageRequest{Page: page})
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, result.Products...)
+		if !result.HasMore() {
+			break
+		}
+	}
+	return products, nil
+}
+
+// productSKUs returns the SKUs of products
+func productSKUs(products []bestbuy.Product) []string {
+	skus := make([]string, 0, len(products))
+	for _, p := range products {
+		skus = append(skus, p.SKUString())
+	}
+	return skus
+}
+
+// newListings picks the products with the given SKUs
+func newListings(products []bestbuy.Product, skus []string) []bestbuy.Product {
+	added := make(map[string]bool, len(skus))
+	for _, sku := range skus {
+		added[sku] = true
+	}
+	var listed []bestbuy.Product
+	for _, p := range products {
+		if sku := p.SKUString(); added[sku] {
+			listed = append(listed, p)
+			delete(added, sku) // once, even if a search repeats a product across pages
+		}
+	}
+	return listed
+}
+
+// listing converts a product newly listed for a watch for its alert
+func listing(query string, p bestbuy.Product) notify.Listing {
+	url := p.URL
+	if url == "" {
+		url = fmt.Sprintf("https://www.bestbuy.com/site/%s.p", p.SKUString())
+	}
+	return notify.Listing{Query: query, Product: p.Name, Price: p.SalePrice, URL: url, Image: p.ThumbnailImage}
+}
+
+// DeliverNewProducts alerts a watch's user to newly listed products over
+// each of their enabled channels
+func (s *NotificationSink) DeliverNewProducts(ctx context.Context, watch database.ProductWatch, products []bestbuy.Product) error {
+	channels, err := s.db.GetUserNotificationChannels(ctx, watch.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load channels: %w", err)
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+	prefs, err := s.db.GetNotificationPreferences(ctx, watch.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load preferences: %w", err)
+	}
+	if !prefs.AlertsEnabled {
+		return nil
+	}
+	user, err := s.db.GetUserByID(ctx, watch.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+	locale, _ := i18n.Parse(user.Locale)
+
+	msgs := make([]notify.Message, 0, len(products))
+	for _, p := range products {
+		msgs = append(msgs, notify.ListingMessage(locale, listing(watch.Query, p)))
+	}
+	return s.deliverToChannels(ctx, watch.UserID, channels, msgs...)
+}
@@ -0,0 +1,94 @@
+package poller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// searchClient returns canned search results and counts searches
+type searchClient struct {
+	bestbuy.Client
+	products []bestbuy.Product
+	searches int
+}
+
+func (c *searchClient) SearchProductsInCategory(ctx context.Context, categoryID string, query string, page bestbuy.PageRequest) (*bestbuy.ProductPage, error) {
+	c.searches++
+	return &bestbuy.ProductPage{Products: c.products, Page: 1, TotalPages: 1, Total: len(c.products)}, nil
+}
+
+// productWatchStore holds product watches and the SKUs each has seen
+type productWatchStore struct {
+	watches []database.ProductWatch
+	seen    map[int]map[string]bool
+}
+
+func (s *productWatchStore) GetProductWatches(ctx context.Context) ([]database.ProductWatch, error) {
+	return s.watches, nil
+}
+
+func (s *productWatchStore) RecordWatchSKUs(ctx context.Context, watchID int, skus []string) ([]string, error) {
+	if s.seen[watchID] == nil {
+		s.seen[watchID] = map[string]bool{}
+	}
+	var added []string
+	for _, sku := range skus {
+		if !s.seen[watchID][sku] {
+			s.seen[watchID][sku] = true
+			added = append(added, sku)
+		}
+	}
+	for i := range s.watches {
+		if s.watches[i].ID == watchID {
+			s.watches[i].CheckedAt = time.Now()
+		}
+	}
+	return added, nil
+}
+
+func TestProductWatcherAlertsNewListings(t *testing.T) {
+	ctx := context.Background()
+	client := &searchClient{products: []bestbuy.Product{
+		{SKU: 6606082, Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Booster Bundle"},
+	}}
+	store := &productWatchStore{
+		watches: []database.ProductWatch{
+			{ID: 1, UserID: 1, Query: "Prismatic Evolutions", CategoryID: bestbuy.CategoryTradingCards},
+			{ID: 2, UserID: 2, Query: "prismatic evolutions", CategoryID: bestbuy.CategoryTradingCards},
+		},
+		seen: map[int]map[string]bool{},
+	}
+	alerted := map[int][]string{}
+	w := poller.NewProductWatcher(client, store, func(ctx context.Context, watch database.ProductWatch, products []bestbuy.Product) error {
+		for _, p := range products {
+			alerted[watch.UserID] = append(alerted[watch.UserID], p.SKUString())
+		}
+		return nil
+	}, 0)
+
+	// The first sweep only records what's already listed
+	if found, err := w.Sweep(ctx); err != nil || found != 0 || len(alerted) != 0 {
+		t.Fatalf("first sweep found %d (%v), alerted %v; want nothing", found, err, alerted)
+	}
+	if client.searches != 1 {
+		t.Errorf("got %d searches, want 1 shared by both watches", client.searches)
+	}
+
+	client.products = append(client.products, bestbuy.Product{SKU: 6579543, Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box"})
+	found, err := w.Sweep(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != 2 || len(alerted[1]) != 1 || alerted[1][0] != "6579543" || len(alerted[2]) != 1 {
+		t.Errorf("found %d, alerted %v; want the new ETB for both users", found, alerted)
+	}
+
+	if found, _ := w.Sweep(ctx); found != 0 {
+		t.Errorf("third sweep found %d, want 0", found)
+	}
+}
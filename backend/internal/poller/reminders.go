@@ -0,0 +1,134 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+)
+
+// DefaultReminderInterval is how often the reminder sender looks for due reminders
+const DefaultReminderInterval = time.Minute
+
+// ReminderRetention is how long sent reminders are kept for users to look back on
+const ReminderRetention = 30 * 24 * time.Hour
+
+// ReminderStore provides reminders made from forwarded emails that are due
+type ReminderStore interface {
+	GetDueReminders(ctx context.Context, now time.Time) ([]database.Reminder, error)
+	MarkReminderSent(ctx context.Context, id int) error
+	DeleteSentRemindersBefore(ctx context.Context, t time.Time) error
+}
+
+// ReminderFunc sends a user a reminder
+type ReminderFunc func(ctx context.Context, reminder database.Reminder) error
+
+// ReminderSender sends reminders made from forwarded emails when they're
+// due, once each
+type ReminderSender struct {
+	store    ReminderStore
+	send     ReminderFunc
+	interval time.Duration
+}
+
+// NewReminderSender creates a ReminderSender (interval defaults to DefaultReminderInterval)
+func NewReminderSender(store ReminderStore, send ReminderFunc, interval time.Duration) *ReminderSender {
+	if interval <= 0 {
+		interval = DefaultReminderInterval
+	}
+	return &ReminderSender{store: store, send: send, interval: interval}
+}
+
+// Run sends due reminders every interval until ctx is cancelled
+func (s *ReminderSender) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := s.Sweep(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Reminder sender: %v", err)
+		} else if sent > 0 {
+			log.Printf("Reminder sender: sent %d reminders", sent)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sweep sends the due reminders, returning how many were sent, and deletes
+// old ones. A reminder is marked sent even if a channel fails, so a broken
+// channel doesn't resend it every sweep.
+func (s *ReminderSender) Sweep(ctx context.Context) (int, error) {
+	now := time.Now()
+	if err := s.store.DeleteSentRemindersBefore(ctx, now.Add(-ReminderRetention)); err != nil {
+		return 0, fmt.Errorf("failed to delete old reminders: %w", err)
+	}
+	reminders, err := s.store.GetDueReminders(ctx, now)
+	if err != nil || len(reminders) == 0 {
+		return 0, err
+	}
+
+	var sent int
+	var errs []error
+	for _, r := range reminders {
+		if err := s.send(ctx, r); err != nil {
+			errs = append(errs, fmt.Errorf("reminder %d, user %d: %w", r.ID, r.UserID, err))
+		} else {
+			sent++
+		}
+		if err := s.store.MarkReminderSent(ctx, r.ID); err != nil {
+			return sent, err
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// DeliverReminder sends a user a reminder over each of their enabled channels
+func (s *NotificationSink) DeliverReminder(ctx context.Context, reminder database.Reminder) error {
+	userID := reminder.UserID
+	channels, err := s.db.GetUserNotificationChannels(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load channels: %w", err)
+	}
+	if len(channels) == 0 {
+		return nil
+	}
+	prefs, err := s.db.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load preferences: %w", err)
+	}
+	if !prefs.AlertsEnabled {
+		return nil
+	}
+	user, err := s.db.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to load user: %w", err)
+	}
+	locale, _ := i18n.Parse(user.Locale)
+
+	r := notify.Reminder{
+		Pickup:  reminder.Kind == "order_ready",
+		Product: reminder.ProductName,
+		SKU:     reminder.SKU,
+		Store:   reminder.StoreName,
+	}
+	if r.Product == "" {
+		r.Product = reminder.Subject
+	}
+	if r.Store == "" && reminder.StoreID != "" {
+		r.Store = "#" + reminder.StoreID
+	}
+	if reminder.DueAt != nil {
+		r.Due = *reminder.DueAt
+	}
+	return s.deliverToChannels(ctx, userID, channels, notify.ReminderMessage(locale, r))
+}
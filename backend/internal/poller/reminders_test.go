@@ -0,0 +1,59 @@
+package poller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// reminderStore holds due reminders, recording which were marked sent
+type reminderStore struct {
+	due      []database.Reminder
+	sent     []int
+	prunedTo time.Time
+}
+
+func (s *reminderStore) GetDueReminders(ctx context.Context, now time.Time) ([]database.Reminder, error) {
+	return s.due, nil
+}
+
+func (s *reminderStore) MarkReminderSent(ctx context.Context, id int) error {
+	s.sent = append(s.sent, id)
+	return nil
+}
+
+func (s *reminderStore) DeleteSentRemindersBefore(ctx context.Context, t time.Time) error {
+	s.prunedTo = t
+	return nil
+}
+
+func TestReminderSenderSendsOnce(t *testing.T) {
+	store := &reminderStore{due: []database.Reminder{{ID: 1, UserID: 1}, {ID: 2, UserID: 2}}}
+	var users []int
+	send := func(ctx context.Context, r database.Reminder) error {
+		users = append(users, r.UserID)
+		if r.UserID == 2 {
+			return errors.New("channel down")
+		}
+		return nil
+	}
+
+	n, err := poller.NewReminderSender(store, send, 0).Sweep(context.Background())
+	if err == nil {
+		t.Error("expected the failed reminder to be reported")
+	}
+	if n != 1 || len(users) != 2 {
+		t.Errorf("sent %d of %v, want 1 of users [1 2]", n, users)
+	}
+	// Failed reminders are marked too, so they aren't resent every sweep
+	if len(store.sent) != 2 {
+		t.Errorf("marked reminders %v sent, want both", store.sent)
+	}
+	if age := time.Since(store.prunedTo); age < poller.ReminderRetention-time.Minute {
+		t.Errorf("pruned reminders sent in the last %v, want only those older than %v", age, poller.ReminderRetention)
+	}
+}
@@ -0,0 +1,118 @@
+package poller
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+)
+
+// DefaultSetInterval is how often watched sets are checked for new products
+const DefaultSetInterval = time.Hour
+
+// SetStore provides set watches and saves the products they find
+type SetStore interface {
+	GetSetWatches(ctx context.Context) ([]database.SetWatch, error)
+	AddSetProduct(ctx context.Context, userID int, product database.Product) (bool, error)
+}
+
+// SetWatcher saves newly listed products from the sets users watch
+type SetWatcher struct {
+	bbClient bestbuy.Client
+	store    SetStore
+	interval time.Duration
+	added    func(userID int) // called after saving products for a user; may be nil
+}
+
+// NewSetWatcher creates a SetWatcher (interval defaults to DefaultSetInterval)
+func NewSetWatcher(bbClient bestbuy.Client, store SetStore, interval time.Duration) *SetWatcher {
+	if interval <= 0 {
+		interval = DefaultSetInterval
+	}
+	return &SetWatcher{bbClient: bbClient, store: store, interval: interval}
+}
+
+// OnAdded sets a function to call with each user a sweep saves products for,
+// so anything derived from their saved products can be refreshed
+func (w *SetWatcher) OnAdded(fn func(userID int)) {
+	w.added = fn
+}
+
+// Run checks watched sets every interval until ctx is cancelled
+func (w *SetWatcher) Run(ctx context.Context) {
+	ctx = bestbuy.WithPriority(ctx, bestbuy.PriorityBackground)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if added, err := w.Sweep(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Set watcher: %v", err)
+		} else if added > 0 {
+			log.Printf("Set watcher: saved %d new products from watched sets", added)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sweep saves every listed product from a watched set that its watchers
+// haven't saved before, returning how many were saved
+func (w *SetWatcher) Sweep(ctx context.Context) (int, error) {
+	watches, err := w.store.GetSetWatches(ctx)
+	if err != nil || len(watches) == 0 {
+		return 0, err
+	}
+
+	// New sets tend to sort past the first page, so walk them all
+	products, err := w.bbClient.BrowsePokemonProducts(ctx, bestbuy.BrowseOptions{AllPages: true})
+	if err != nil {
+		return 0, err
+	}
+
+	var added int
+	for _, watch := range watches {
+		for _, product := range SetProducts(products, watch.SetName) {
+			ok, err := w.store.AddSetProduct(ctx, watch.UserID, product)
+			if err != nil {
+				return added, err
+			}
+			if ok {
+				added++
+				if w.added != nil {
+					w.added(watch.UserID)
+				}
+			}
+		}
+	}
+	return added, nil
+}
+
+// SetProducts picks the Best Buy products from a set, ready to save
+func SetProducts(products []bestbuy.Product, setName string) []database.Product {
+	var matches []database.Product
+	for _, p := range products {
+		info := tcg.Parse(p.Name)
+		if !tcg.SameSet(info.Set, setName) {
+			continue
+		}
+		matches = append(matches, database.Product{
+			Retailer:     retailer.BestBuy,
+			SKU:          p.SKUString(),
+			Name:         p.Name,
+			SalePrice:    p.SalePrice,
+			ThumbnailURL: p.ThumbnailImage,
+			ProductURL:   p.URL,
+			SetName:      info.Set,
+			ProductType:  string(info.Type),
+		})
+	}
+	return matches
+}
@@ -0,0 +1,86 @@
+package poller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/poller"
+)
+
+// browseClient returns canned Pokemon products
+type browseClient struct {
+	bestbuy.Client
+	products []bestbuy.Product
+}
+
+func (c *browseClient) BrowsePokemonProducts(ctx context.Context, opts bestbuy.BrowseOptions) ([]bestbuy.Product, error) {
+	return c.products, nil
+}
+
+// setStore holds set watches and remembers every product it has saved per user
+type setStore struct {
+	watches []database.SetWatch
+	saved   map[int]map[string]bool
+}
+
+func (s *setStore) GetSetWatches(ctx context.Context) ([]database.SetWatch, error) {
+	return s.watches, nil
+}
+
+func (s *setStore) AddSetProduct(ctx context.Context, userID int, product database.Product) (bool, error) {
+	if s.saved[userID][product.SKU] {
+		return false, nil
+	}
+	if s.saved[userID] == nil {
+		s.saved[userID] = map[string]bool{}
+	}
+	s.saved[userID][product.SKU] = true
+	return true, nil
+}
+
+func TestSetWatcherSavesProductsFromWatchedSets(t *testing.T) {
+	ctx := context.Background()
+	client := &browseClient{products: []bestbuy.Product{
+		{SKU: 6606082, Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Booster Bundle"},
+		{SKU: 6579543, Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box"},
+		{SKU: 6548369, Name: "Pokemon Trading Card Game: Surging Sparks Elite Trainer Box"},
+	}}
+	store := &setStore{
+		watches: []database.SetWatch{
+			{UserID: 1, SetName: "prismatic evolutions"},
+			{UserID: 2, SetName: "Surging Sparks"},
+		},
+		saved: map[int]map[string]bool{1: {"6606082": true}}, // saved before, maybe removed since
+	}
+	w := poller.NewSetWatcher(client, store, 0)
+	notified := map[int]int{}
+	w.OnAdded(func(userID int) { notified[userID]++ })
+
+	added, err := w.Sweep(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 2 || !store.saved[1]["6579543"] || !store.saved[2]["6548369"] {
+		t.Errorf("added %d, saved %v; want the ETB for user 1 and Surging Sparks for user 2", added, store.saved)
+	}
+	if notified[1] != 1 || notified[2] != 1 {
+		t.Errorf("OnAdded called %v, want once for each user", notified)
+	}
+
+	// Nothing new is listed, so nothing more is saved
+	if added, _ := w.Sweep(ctx); added != 0 {
+		t.Errorf("second sweep added %d, want 0", added)
+	}
+}
+
+func TestSetProducts(t *testing.T) {
+	products := poller.SetProducts([]bestbuy.Product{
+		{SKU: 6579543, Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box"},
+		{SKU: 6548369, Name: "Pokemon Trading Card Game: Surging Sparks Elite Trainer Box"},
+	}, "Prismatic Evolutions")
+	if len(products) != 1 || products[0].SKU != "6579543" || products[0].ProductType != "elite_trainer_box" {
+		t.Errorf("SetProducts = %+v, want only the Prismatic Evolutions ETB", products)
+	}
+}
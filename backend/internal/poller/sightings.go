@@ -0,0 +1,97 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/geo"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+// sightingAlerts builds a community alert for each user watching the sighted
+// product at the sighted store. Location targets match if the store is within
+// their radius; locate returns a target's location, or the zero point if it's
+// unknown. The reporter isn't alerted about their own sighting.
+func sightingAlerts(targets []database.WatchTarget, s database.Sighting, store geo.Point, locate func(t database.WatchTarget) geo.Point) []Alert {
+	var alerts []Alert
+	alerted := make(map[int]bool)
+	for _, t := range targets {
+		if t.SKU != s.SKU || (t.Retailer != "" && t.Retailer != retailer.BestBuy) || t.UserID == s.UserID || alerted[t.UserID] {
+			continue
+		}
+
+		var distance float64
+		if t.StoreID == "" {
+			origin := locate(t)
+			if !store.Valid() || !origin.Valid() {
+				continue
+			}
+			if distance = geo.Miles(origin, store); distance > float64(t.RadiusMiles) {
+				continue
+			}
+		} else if t.StoreID != s.StoreID {
+			continue
+		}
+
+		alerted[t.UserID] = true
+		alerts = append(alerts, Alert{
+			UserID:       t.UserID,
+			Retailer:     retailer.BestBuy,
+			SKU:          t.SKU,
+			PostalCode:   t.PostalCode,
+			ProductName:  t.ProductName,
+			SalePrice:    t.SalePrice,
+			ThumbnailURL: t.ThumbnailURL,
+			ProductURL:   t.ProductURL,
+			Stores: []bestbuy.StoreAvailability{{
+				StoreID:   s.StoreID,
+				StoreName: s.StoreName,
+				Distance:  distance,
+				InStock:   true,
+			}},
+			Community: true,
+			Quantity:  s.Quantity,
+		})
+	}
+	return alerts
+}
+
+// DeliverSighting alerts the users watching a confirmed sighting's product at
+// its store, returning how many alerts were delivered
+func (s *NotificationSink) DeliverSighting(ctx context.Context, sighting database.Sighting) (int, error) {
+	targets, err := s.db.GetWatchTargets(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load watch targets: %w", err)
+	}
+	coordinates, err := s.db.GetStoreCoordinates(ctx, []string{sighting.StoreID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to load store coordinates: %w", err)
+	}
+
+	locate := func(t database.WatchTarget) geo.Point {
+		location, err := s.db.GetUserLocation(ctx, t.UserID, t.Location)
+		if err != nil {
+			log.Printf("Error loading location %q of user %d: %v", t.Location, t.UserID, err)
+			return geo.Point{}
+		}
+		if location == nil {
+			return geo.Point{}
+		}
+		return location.Point
+	}
+
+	var delivered int
+	var errs []error
+	for _, alert := range sightingAlerts(targets, sighting, coordinates[sighting.StoreID], locate) {
+		if err := s.Deliver(ctx, alert); err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", alert.UserID, err))
+			continue
+		}
+		delivered++
+	}
+	return delivered, errors.Join(errs...)
+}
@@ -0,0 +1,46 @@
+package poller
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/geo"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+func TestSightingAlerts(t *testing.T) {
+	store := geo.Point{Lat: 37.7699, Lng: -122.4134} // San Francisco
+	locations := map[int]geo.Point{
+		5: {Lat: 37.8044, Lng: -122.2712}, // Oakland, about 8 miles away
+		6: {Lat: 34.0522, Lng: -118.2437}, // Los Angeles
+	}
+	targets := []database.WatchTarget{
+		{UserID: 1, SKU: "6579543", StoreID: "1118"},                            // the reporter
+		{UserID: 2, SKU: "6579543", StoreID: "1118"},                            // watches the store
+		{UserID: 2, SKU: "6579543", Location: "home", RadiusMiles: 25},          // already alerted
+		{UserID: 3, SKU: "6579543", StoreID: "1009"},                            // another store
+		{UserID: 4, SKU: "6606082", StoreID: "1118"},                            // another product
+		{UserID: 5, SKU: "6579543", Location: "home", RadiusMiles: 25},          // nearby location
+		{UserID: 6, SKU: "6579543", Location: "home", RadiusMiles: 25},          // too far
+		{UserID: 7, SKU: "6579543", Location: "home", RadiusMiles: 25},          // location unknown
+		{UserID: 8, SKU: "6579543", StoreID: "1118", Retailer: retailer.Target}, // another retailer
+	}
+	sighting := database.Sighting{UserID: 1, SKU: "6579543", StoreID: "1118", StoreName: "Best Buy - San Francisco", Quantity: 4}
+
+	alerts := sightingAlerts(targets, sighting, store, func(t database.WatchTarget) geo.Point { return locations[t.UserID] })
+
+	var users []int
+	for _, a := range alerts {
+		users = append(users, a.UserID)
+		if !a.Community || a.Quantity != 4 || len(a.Stores) != 1 || a.Stores[0].StoreID != "1118" {
+			t.Errorf("user %d: alert %+v, want a community alert for store 1118", a.UserID, a)
+		}
+	}
+	if !slices.Equal(users, []int{2, 5}) {
+		t.Errorf("alerted users %v, want [2 5]", users)
+	}
+	if d := alerts[1].Stores[0].Distance; d < 5 || d > 12 {
+		t.Errorf("distance from Oakland = %.1f, want about 8 miles", d)
+	}
+}
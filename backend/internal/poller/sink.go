@@ -0,0 +1,506 @@
+package poller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/geo"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/restock"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+	"github.com/tmcauley/stock-checker/backend/internal/tcg"
+	"github.com/tmcauley/stock-checker/backend/internal/usage"
+)
+
+// NotificationSink delivers alerts over each user's enabled notification channels
+type NotificationSink struct {
+	db        *database.DB
+	msrps     *tcg.MSRPs
+	escalator *notify.Escalator // calls about unacknowledged must-have alerts; nil to never call
+	usage     *usage.Recorder   // counts messages sent to each user; nil to not count
+}
+
+// NewNotificationSink creates a NotificationSink
+func NewNotificationSink(db *database.DB) *NotificationSink {
+	s := &NotificationSink{db: db}
+	if db != nil {
+		s.msrps = tcg.NewMSRPs(db)
+	}
+	return s
+}
+
+// SetEscalator follows up unacknowledged must-have alerts with a call to
+// users who have a phone call channel
+func (s *NotificationSink) SetEscalator(e *notify.Escalator) {
+	s.escalator = e
+}
+
+// SetUsage counts every message sent to a user
+func (s *NotificationSink) SetUsage(r *usage.Recorder) {
+	s.usage = r
+}
+
+// counted wraps notifier so messages it sends are counted for userID
+func (s *NotificationSink) counted(userID int, notifier notify.Notifier) notify.Notifier {
+	if s.usage == nil {
+		return notifier
+	}
+	return countingNotifier{Notifier: notifier, usage: s.usage, userID: userID}
+}
+
+// notifiers creates counted notifiers for the user's enabled channels.
+// Phone calls are only placed by the escalator for emergency alerts, so
+// call channels are skipped.
+func (s *NotificationSink) notifiers(userID int, channels []database.NotificationChannel) ([]notify.Notifier, error) {
+	var notifiers []notify.Notifier
+	var errs []error
+	for _, c := range channels {
+		if !c.Enabled || c.ChannelType == notify.ChannelTwilioVoice {
+			continue
+		}
+		notifier, err := notify.New(c.ChannelType, c.Config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.ChannelType, err))
+			continue
+		}
+		notifiers = append(notifiers, s.counted(userID, notifier))
+	}
+	return notifiers, errors.Join(errs...)
+}
+
+// deliverToChannels sends msgs over each of the user's enabled channels,
+// moving on to the next channel after a failure
+func (s *NotificationSink) deliverToChannels(ctx context.Context, userID int, channels []database.NotificationChannel, msgs ...notify.Message) error {
+	notifiers, err := s.notifiers(userID, channels)
+	errs := []error{err}
+	for _, n := range notifiers {
+		for _, msg := range msgs {
+			if err := n.Send(ctx, msg); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", n.Channel(), err))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// countingNotifier counts the messages a notifier sends successfully
+type countingNotifier struct {
+	notify.Notifier
+	usage  *usage.Recorder
+	userID int
+}
+
+func (n countingNotifier) Send(ctx context.Context, msg notify.Message) error {
+	if err := n.Notifier.Send(ctx, msg); err != nil {
+		return err
+	}
+	n.usage.Add(n.userID, usage.Notification, 1)
+	return nil
+}
+
+// AlertData converts an alert into template variables
+func AlertData(alert Alert) notify.AlertData {
+	stores := make([]notify.AlertStore, 0, len(alert.Stores))
+	for _, s := range alert.Stores {
+		stores = append(stores, notify.AlertStore{
+			ID:       s.StoreID,
+			Name:     s.StoreName,
+			City:     s.City,
+			State:    s.State,
+			Distance: s.Distance,
+			LowStock: s.LowStock,
+		})
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Distance < stores[j].Distance })
+
+	var distance float64
+	if len(stores) > 0 {
+		distance = stores[0].Distance
+	}
+
+	// Only Best Buy links can be built from the SKU alone
+	links := notify.AlertLinks{Product: alert.ProductURL}
+	if alert.Retailer == "" || alert.Retailer == retailer.BestBuy {
+		if links.Product == "" {
+			links.Product = fmt.Sprintf("https://www.bestbuy.com/site/%s.p", alert.SKU)
+		}
+		links.AddToCart = fmt.Sprintf("https://api.bestbuy.com/click/-/%s/cart", alert.SKU)
+	}
+
+	return notify.AlertData{
+		Product:  alert.ProductName,
+		SKU:      alert.SKU,
+		Price:    alert.SalePrice,
+		Image:    alert.ThumbnailURL,
+		Stores:   stores,
+		Distance: distance,
+		Links:    links,
+		Stale:    alert.Stale,
+
+		Community: alert.Community,
+		Quantity:  alert.Quantity,
+	}
+}
+
+// filterAlert applies the user's preferences and the product's alert rule,
+// dropping stores the user doesn't care about. It returns false if nothing is left to send.
+func filterAlert(alert Alert, prefs database.NotificationPreferences, rule database.AlertRule) (Alert, bool) {
+	if !prefs.AlertsEnabled || !rule.Enabled {
+		return alert, false
+	}
+	if rule.MaxPriceCents > 0 && alert.SalePrice > rule.MaxPriceCents {
+		return alert, false
+	}
+
+	stores := make([]bestbuy.StoreAvailability, 0, len(alert.Stores))
+	for _, s := range alert.Stores {
+		if s.LowStock && !prefs.IncludeLowStock {
+			continue
+		}
+		if prefs.MaxDistanceMiles > 0 && s.Distance > prefs.MaxDistanceMiles {
+			continue
+		}
+		if rule.MaxDistanceMiles > 0 && s.Distance > rule.MaxDistanceMiles {
+			continue
+		}
+		stores = append(stores, s)
+	}
+	alert.Stores = stores
+
+	return alert, len(stores) > 0 && len(stores) >= rule.MinStores
+}
+
+// measureFrom replaces the distance of each store in the alert, which the API
+// measures from the watched postal code, with the distance from one of the
+// user's locations. Stores or locations without known coordinates keep the
+// API's distance.
+func (s *NotificationSink) measureFrom(ctx context.Context, alert Alert, locationName string) (Alert, error) {
+	location, err := s.db.GetUserLocation(ctx, alert.UserID, locationName)
+	if err != nil {
+		return alert, fmt.Errorf("failed to load location: %w", err)
+	}
+	if location == nil || !location.Point.Valid() {
+		return alert, nil
+	}
+
+	storeIDs := make([]string, 0, len(alert.Stores))
+	for _, st := range alert.Stores {
+		storeIDs = append(storeIDs, st.StoreID)
+	}
+	coordinates, err := s.db.GetStoreCoordinates(ctx, storeIDs)
+	if err != nil {
+		return alert, fmt.Errorf("failed to load store coordinates: %w", err)
+	}
+
+	alert.Stores = measureStores(alert.Stores, location.Point, coordinates)
+	return alert, nil
+}
+
+// measureStores returns a copy of stores with distances measured from origin
+func measureStores(stores []bestbuy.StoreAvailability, origin geo.Point, coordinates map[string]geo.Point) []bestbuy.StoreAvailability {
+	measured := make([]bestbuy.StoreAvailability, len(stores))
+	for i, st := range stores {
+		if p, ok := coordinates[st.StoreID]; ok {
+			st.Distance = geo.Miles(origin, p)
+		}
+		measured[i] = st
+	}
+	return measured
+}
+
+// addConfidence sets how reliable each store's reported stock has been
+func (s *NotificationSink) addConfidence(ctx context.Context, stores []notify.AlertStore) error {
+	storeIDs := make([]string, 0, len(stores))
+	for _, st := range stores {
+		storeIDs = append(storeIDs, st.ID)
+	}
+	tallies, err := s.db.GetStoreTallies(ctx, storeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to load store reliability: %w", err)
+	}
+	for i := range stores {
+		stores[i].Confidence = tallies[stores[i].ID].Level()
+	}
+	return nil
+}
+
+// addHours sets each store's hours today, whether it's open now and when to
+// go for restocks of sku. Stores that have never shown up in a store search
+// are left unknown.
+func (s *NotificationSink) addHours(ctx context.Context, stores []notify.AlertStore, sku string, now time.Time) error {
+	storeIDs := make([]string, 0, len(stores))
+	for _, st := range stores {
+		storeIDs = append(storeIDs, st.ID)
+	}
+	hours, err := s.db.GetStoreHours(ctx, storeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to load store hours: %w", err)
+	}
+	events, err := s.db.GetRestocks(ctx, storeIDs, now.AddDate(0, 0, -restock.WindowDays))
+	if err != nil {
+		return fmt.Errorf("failed to load restocks: %w", err)
+	}
+	restocks := make(map[string][]restock.Event)
+	for _, e := range events {
+		restocks[e.StoreID] = append(restocks[e.StoreID], restock.Event{SKU: e.SKU, At: e.OccurredAt})
+	}
+
+	for i := range stores {
+		h := hours[stores[i].ID]
+		status, ok := h.StatusAt(now)
+		if !ok {
+			continue
+		}
+		stores[i].Hours = status.Today
+		stores[i].SpecialHours = status.Special
+		stores[i].Open = status.Open
+		stores[i].Opens = status.Opens
+		stores[i].Visit, _ = h.VisitHint(restock.Times(restocks[stores[i].ID], sku), now)
+	}
+	return nil
+}
+
+// perStore splits alert data into one copy per store, for channels that
+// want a message per store instead of a summary
+func perStore(data notify.AlertData) []notify.AlertData {
+	split := make([]notify.AlertData, 0, len(data.Stores))
+	for _, st := range data.Stores {
+		d := data
+		d.Stores = []notify.AlertStore{st}
+		d.Distance = st.Distance
+		split = append(split, d)
+	}
+	return split
+}
+
+// Rendered is an alert rendered for one of the user's channels
+type Rendered struct {
+	Channel database.NotificationChannel
+	Message notify.Message
+}
+
+// priority returns the saved product's priority, which routes its alerts
+func (s *NotificationSink) priority(ctx context.Context, alert Alert) (string, error) {
+	product, err := s.db.GetUserProduct(ctx, alert.UserID, alert.Retailer, alert.SKU)
+	if err != nil {
+		return "", fmt.Errorf("failed to load product: %w", err)
+	}
+	if product == nil {
+		return "", nil
+	}
+	return product.Priority, nil
+}
+
+// applyRules narrows the alert by the user's preferences and the product's
+// alert rule. It returns false if nothing is left to send.
+func (s *NotificationSink) applyRules(ctx context.Context, alert Alert) (Alert, database.NotificationPreferences, bool, error) {
+	prefs, err := s.db.GetNotificationPreferences(ctx, alert.UserID)
+	if err != nil {
+		return alert, prefs, false, fmt.Errorf("failed to load preferences: %w", err)
+	}
+	rule, err := s.db.GetAlertRule(ctx, alert.UserID, alert.Retailer, alert.SKU)
+	if err != nil {
+		return alert, prefs, false, fmt.Errorf("failed to load alert rule: %w", err)
+	}
+	if rule.Location != "" {
+		if alert, err = s.measureFrom(ctx, alert, rule.Location); err != nil {
+			return alert, prefs, false, err
+		}
+	}
+	alert, ok := filterAlert(alert, prefs, rule)
+	return alert, prefs, ok, nil
+}
+
+// urgentChannel reports whether must-have alerts go to a channel
+func urgentChannel(prefs database.NotificationPreferences, channelType string) bool {
+	return len(prefs.UrgentChannels) == 0 || slices.Contains(prefs.UrgentChannels, channelType)
+}
+
+// Render renders the alert with the template of each enabled channel.
+// Alerts for nice-to-have products render nothing: they wait for the digest.
+func (s *NotificationSink) Render(ctx context.Context, alert Alert) ([]Rendered, error) {
+	priority, err := s.priority(ctx, alert)
+	if err != nil {
+		return nil, err
+	}
+	if priority == database.PriorityNiceToHave {
+		return nil, nil
+	}
+	return s.render(ctx, alert, priority)
+}
+
+// render renders the alert for a product with the given priority
+func (s *NotificationSink) render(ctx context.Context, alert Alert, priority string) ([]Rendered, error) {
+	channels, err := s.db.GetUserNotificationChannels(ctx, alert.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load channels: %w", err)
+	}
+	if len(channels) == 0 {
+		return nil, nil
+	}
+
+	alert, prefs, ok, err := s.applyRules(ctx, alert)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	user, err := s.db.GetUserByID(ctx, alert.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user: %w", err)
+	}
+	locale, _ := i18n.Parse(user.Locale)
+
+	data := AlertData(alert)
+	if err := s.addConfidence(ctx, data.Stores); err != nil {
+		return nil, err
+	}
+	if err := s.addHours(ctx, data.Stores, alert.SKU, time.Now()); err != nil {
+		return nil, err
+	}
+	data.MSRP = s.msrps.Lookup(ctx, alert.ProductName)
+	data.AboveMSRP = tcg.AboveMSRP(data.Price, data.MSRP)
+
+	// Must-haves are emergencies only while there's a store to get to:
+	// stock at stores closed for the night or a holiday can wait for morning
+	mustHave := priority == database.PriorityMustHave
+	urgent := mustHave && !data.AllClosed()
+	var rendered []Rendered
+	var errs []error
+	for _, c := range channels {
+		// Phone calls are only placed by the escalator for emergency alerts
+		if !c.Enabled || c.ChannelType == notify.ChannelTwilioVoice {
+			continue
+		}
+		if mustHave && !urgentChannel(prefs, c.ChannelType) {
+			continue
+		}
+
+		tmpl, err := notify.ResolveTemplate(ctx, s.db, alert.UserID, c.ChannelType, locale)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.ChannelType, err))
+			continue
+		}
+
+		batches := []notify.AlertData{data}
+		if c.Rollup == notify.RollupPerStore {
+			batches = perStore(data)
+		}
+
+		for _, d := range batches {
+			msg, err := tmpl.Render(d, notify.PriorityHigh)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", c.ChannelType, err))
+				break
+			}
+			if urgent {
+				msg.Priority = notify.PriorityEmergency
+			}
+
+			// Replayed alerts are always marked, whatever the template says
+			if alert.Stale {
+				msg.Title = i18n.T(locale, "notify.stale_prefix") + " " + msg.Title
+				msg.Body += "\n\n" + i18n.T(locale, "notify.stale_note", alert.StaleSince.Format(i18n.T(locale, "notify.time_layout")))
+				msg.Priority = notify.PriorityNormal
+			}
+
+			// So are community sightings, which the retailer hasn't confirmed
+			if alert.Community {
+				msg.Title = i18n.T(locale, "notify.community_prefix") + " " + msg.Title
+				msg.Body += "\n\n" + i18n.T(locale, "notify.community_note")
+				if alert.Quantity > 0 {
+					msg.Fields = append(msg.Fields, notify.Field{
+						Name:  i18n.T(locale, "notify.field_reported_quantity"),
+						Value: fmt.Sprint(alert.Quantity),
+					})
+				}
+			}
+
+			rendered = append(rendered, Rendered{Channel: c, Message: msg})
+		}
+	}
+
+	return rendered, errors.Join(errs...)
+}
+
+// Deliver renders the alert for each channel and sends it. Alerts for
+// nice-to-have products are queued for the user's digest instead, and
+// must-have ones are followed up with a call if the user has a phone call
+// channel and doesn't acknowledge them.
+func (s *NotificationSink) Deliver(ctx context.Context, alert Alert) error {
+	priority, err := s.priority(ctx, alert)
+	if err != nil {
+		return err
+	}
+	if priority == database.PriorityNiceToHave {
+		return s.queueDigest(ctx, alert)
+	}
+
+	rendered, err := s.render(ctx, alert, priority)
+
+	errs := []error{err}
+	outgoing := make([]notify.Outgoing, 0, len(rendered))
+	for _, r := range rendered {
+		notifier, err := notify.New(r.Channel.ChannelType, r.Channel.Config)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", r.Channel.ChannelType, err))
+			continue
+		}
+		outgoing = append(outgoing, notify.Outgoing{Notifier: s.counted(alert.UserID, notifier), Message: r.Message})
+	}
+
+	// Only emergencies are followed up with a call
+	var call notify.Notifier
+	if priority == database.PriorityMustHave && s.escalator != nil && len(outgoing) > 0 && emergency(rendered) {
+		if call, err = s.callChannel(ctx, alert.UserID); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if call == nil {
+		for _, o := range outgoing {
+			if err := o.Notifier.Send(ctx, o.Message); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", o.Notifier.Channel(), err))
+			}
+		}
+		return errors.Join(errs...)
+	}
+
+	errs = append(errs, s.escalator.SendEach(ctx, alert.UserID, outgoing, call))
+	return errors.Join(errs...)
+}
+
+// emergency reports whether any rendered message is an emergency
+func emergency(rendered []Rendered) bool {
+	return slices.ContainsFunc(rendered, func(r Rendered) bool {
+		return r.Message.Priority == notify.PriorityEmergency
+	})
+}
+
+// callChannel returns the user's phone call notifier for must-have alerts, or nil if they have none
+func (s *NotificationSink) callChannel(ctx context.Context, userID int) (notify.Notifier, error) {
+	channels, err := s.db.GetUserNotificationChannels(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load channels: %w", err)
+	}
+	prefs, err := s.db.GetNotificationPreferences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load preferences: %w", err)
+	}
+	for _, c := range channels {
+		if c.Enabled && c.ChannelType == notify.ChannelTwilioVoice && urgentChannel(prefs, c.ChannelType) {
+			notifier, err := notify.New(c.ChannelType, c.Config)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", c.ChannelType, err)
+			}
+			return notifier, nil
+		}
+	}
+	return nil, nil
+}
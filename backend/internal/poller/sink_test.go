@@ -0,0 +1,133 @@
+package poller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/geo"
+	"github.com/tmcauley/stock-checker/backend/internal/i18n"
+	"github.com/tmcauley/stock-checker/backend/internal/notify"
+	"github.com/tmcauley/stock-checker/backend/internal/retailer"
+)
+
+func TestRuleDistanceFromLocation(t *testing.T) {
+	home := geo.Point{Lat: 37.7699, Lng: -122.4134} // San Francisco
+	coordinates := map[string]geo.Point{
+		"1118": {Lat: 37.7699, Lng: -122.4134}, // in SF
+		"1009": {Lat: 34.0522, Lng: -118.2437}, // in LA
+	}
+	alert := Alert{
+		UserID: 1,
+		SKU:    "6505997",
+		// The API measured from a postal code near LA
+		Stores: []bestbuy.StoreAvailability{
+			{StoreID: "1118", Distance: 340},
+			{StoreID: "1009", Distance: 2},
+			{StoreID: "777", Distance: 9}, // no coordinates, keeps the API distance
+		},
+	}
+	alert.Stores = measureStores(alert.Stores, home, coordinates)
+
+	rule := database.DefaultAlertRule(1, retailer.BestBuy, "6505997")
+	rule.MaxDistanceMiles = 15
+	filtered, ok := filterAlert(alert, database.DefaultNotificationPreferences(1), rule)
+	if !ok {
+		t.Fatal("alert was dropped")
+	}
+
+	var ids []string
+	for _, s := range filtered.Stores {
+		ids = append(ids, s.StoreID)
+	}
+	if len(ids) != 2 || ids[0] != "1118" || ids[1] != "777" {
+		t.Errorf("kept stores %v, want [1118 777]", ids)
+	}
+
+	rule.MaxDistanceMiles = 1
+	alert.Stores = alert.Stores[1:]
+	if _, ok := filterAlert(alert, database.DefaultNotificationPreferences(1), rule); ok {
+		t.Error("alert with no store in range wasn't dropped")
+	}
+}
+
+func TestPerStoreSplitsAlert(t *testing.T) {
+	data := AlertData(Alert{
+		SKU: "6505997",
+		Stores: []bestbuy.StoreAvailability{
+			{StoreID: "281", StoreName: "Emeryville", Distance: 6.5},
+			{StoreID: "1419", StoreName: "San Francisco", Distance: 2.1},
+		},
+	})
+
+	split := perStore(data)
+	if len(split) != 2 {
+		t.Fatalf("got %d messages, want one per store", len(split))
+	}
+	for _, d := range split {
+		if len(d.Stores) != 1 || d.Distance != d.Stores[0].Distance {
+			t.Errorf("per-store data %+v should hold one store and its distance", d)
+		}
+	}
+	if split[0].Stores[0].ID != "1419" {
+		t.Errorf("first message is for store %s, want the closest (1419)", split[0].Stores[0].ID)
+	}
+}
+
+func TestClosedStoresAreNotEmergencies(t *testing.T) {
+	data := notify.AlertData{Stores: []notify.AlertStore{
+		{ID: "281", Name: "Emeryville", Hours: "closed", SpecialHours: true},
+		{ID: "1419", Name: "San Francisco"}, // hours unknown, so maybe open
+	}}
+	if data.AllClosed() {
+		t.Error("alert with a store of unknown hours counted as all closed")
+	}
+
+	data.Stores = data.Stores[:1]
+	if !data.AllClosed() {
+		t.Error("alert with only a store closed for the holiday wasn't all closed")
+	}
+
+	msg, err := notify.DefaultTemplate(i18n.English).Render(data, notify.PriorityHigh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(msg.Body, "Emeryville is closed right now") {
+		t.Errorf("body %q doesn't say the store is closed", msg.Body)
+	}
+	if emergency([]Rendered{{Message: msg}}) {
+		t.Error("message for a closed store is an emergency")
+	}
+}
+
+func TestDeliverToChannelsSkipsDisabledAndCalls(t *testing.T) {
+	s := NewNotificationSink(nil)
+	broken := []byte(`{}`) // fails notify.New if the channel is used
+	channels := []database.NotificationChannel{
+		{ChannelType: notify.ChannelPushover, Config: broken, Enabled: false},
+		{ChannelType: notify.ChannelTwilioVoice, Config: broken, Enabled: true},
+	}
+	if err := s.deliverToChannels(context.Background(), 1, channels, notify.Message{Title: "t"}); err != nil {
+		t.Errorf("error %v, want the disabled and call channels skipped", err)
+	}
+
+	channels = append(channels, database.NotificationChannel{ChannelType: notify.ChannelPushover, Config: broken, Enabled: true})
+	if err := s.deliverToChannels(context.Background(), 1, channels, notify.Message{Title: "t"}); err == nil || !strings.Contains(err.Error(), notify.ChannelPushover) {
+		t.Errorf("error %v, want the enabled pushover channel's", err)
+	}
+}
+
+func TestDropPriorityFollowsProduct(t *testing.T) {
+	tests := map[string]notify.Priority{
+		database.PriorityMustHave:   notify.PriorityEmergency,
+		"":                          notify.PriorityHigh,
+		database.PriorityNiceToHave: notify.PriorityNormal,
+	}
+	for product, want := range tests {
+		if got := dropPriority(product); got != want {
+			t.Errorf("%q: priority %v, want %v", product, got, want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Package projection keeps dashboard read models up to date with the stock event log.
+package projection
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Projector defaults
+const (
+	DefaultInterval = 30 * time.Second
+	batchSize       = 500
+)
+
+// Store applies stock events to the read models
+type Store interface {
+	ProjectStockEvents(ctx context.Context, limit int) (int, error)
+}
+
+// Projector periodically catches the read models up with the event log
+type Projector struct {
+	store     Store
+	interval  time.Duration
+	projected func() // called after events are applied
+}
+
+// New creates a Projector (interval defaults to DefaultInterval)
+func New(store Store, interval time.Duration) *Projector {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Projector{store: store, interval: interval}
+}
+
+// OnProjected sets a function to call whenever CatchUp applies events, so
+// anything derived from the read models can be refreshed
+func (p *Projector) OnProjected(fn func()) {
+	p.projected = fn
+}
+
+// Run projects new events every interval until ctx is cancelled
+func (p *Projector) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.CatchUp(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Projector: failed to project stock events: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// CatchUp applies every pending event in batches and returns how many were applied
+func (p *Projector) CatchUp(ctx context.Context) (int, error) {
+	var total int
+	for {
+		n, err := p.store.ProjectStockEvents(ctx, batchSize)
+		total += n
+		if err != nil || n < batchSize {
+			if total > 0 && p.projected != nil {
+				p.projected()
+			}
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Package reliability scores how often a store's reported stock is really on
+// the shelf, from users confirming or denying it after an alert. The same
+// scores rate community sighting reporters by how many of their reports
+// moderators confirmed.
+package reliability
+
+// WindowDays is how far back confirmations count toward a store's score, so a
+// store that fixes its inventory counts recovers
+const WindowDays = 90
+
+// minConfirmations is how many confirmations a store needs before it gets a
+// confidence level; fewer say more about the users than the store
+const minConfirmations = 3
+
+// Level is how far a store's reported stock can be trusted
+type Level string
+
+const (
+	Unknown Level = ""       // too few confirmations to say
+	Low     Level = "low"    // usually not on the shelf
+	Medium  Level = "medium" // hit and miss
+	High    Level = "high"   // usually on the shelf
+)
+
+// Tally counts confirmations for a store
+type Tally struct {
+	Found int // the product was on the shelf
+	Total int // confirmations either way
+}
+
+// Score estimates the chance the product is really on the shelf when the
+// store reports it in stock. It starts at 0.5 and moves toward the share
+// found as confirmations come in (Laplace smoothing).
+func (t Tally) Score() float64 {
+	return float64(t.Found+1) / float64(t.Total+2)
+}
+
+// Level buckets the score for display
+func (t Tally) Level() Level {
+	switch score := t.Score(); {
+	case t.Total < minConfirmations:
+		return Unknown
+	case score >= 0.7:
+		return High
+	case score >= 0.4:
+		return Medium
+	default:
+		return Low
+	}
+}
@@ -0,0 +1,32 @@
+package reliability
+
+import "testing"
+
+func TestLevel(t *testing.T) {
+	tests := []struct {
+		tally Tally
+		want  Level
+	}{
+		{Tally{}, Unknown},
+		{Tally{Found: 2, Total: 2}, Unknown},
+		{Tally{Found: 3, Total: 3}, High},
+		{Tally{Found: 8, Total: 10}, High},
+		{Tally{Found: 5, Total: 10}, Medium},
+		{Tally{Found: 1, Total: 10}, Low},
+		{Tally{Found: 0, Total: 3}, Low},
+	}
+	for _, tt := range tests {
+		if got := tt.tally.Level(); got != tt.want {
+			t.Errorf("%+v: level %q (score %.2f), want %q", tt.tally, got, tt.tally.Score(), tt.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	if got := (Tally{}).Score(); got != 0.5 {
+		t.Errorf("empty score = %v, want 0.5", got)
+	}
+	if a, b := (Tally{Found: 3, Total: 3}).Score(), (Tally{Found: 30, Total: 30}).Score(); a >= b {
+		t.Errorf("more confirmations should score higher: %v >= %v", a, b)
+	}
+}
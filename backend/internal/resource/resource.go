@@ -0,0 +1,133 @@
+// Package resource formats and parses API resource names such as
+// "users/42/products/6579543", so every RPC identifies resources the same way.
+package resource
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Resource name patterns. Segments in braces are IDs.
+const (
+	UserPattern        = "users/{user}"
+	UserStorePattern   = "users/{user}/stores/{store}"
+	UserProductPattern = "users/{user}/products/{product}"
+	StorePattern       = "stores/{store}"
+	ProductPattern     = "products/{product}"
+)
+
+// Me can be used in place of a user ID to refer to the caller
+const Me = "me"
+
+// Format fills the ID segments of pattern in order, escaping each ID
+func Format(pattern string, ids ...string) string {
+	parts := strings.Split(pattern, "/")
+	next := 0
+	for i, p := range parts {
+		if isVariable(p) && next < len(ids) {
+			parts[i] = url.PathEscape(ids[next])
+			next++
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// Parse matches name against pattern and returns its IDs keyed by variable name
+func Parse(pattern, name string) (map[string]string, error) {
+	want := strings.Split(pattern, "/")
+	got := strings.Split(name, "/")
+	if len(want) != len(got) {
+		return nil, fmt.Errorf("resource name %q does not match %s", name, pattern)
+	}
+
+	ids := make(map[string]string)
+	for i, w := range want {
+		if !isVariable(w) {
+			if got[i] != w {
+				return nil, fmt.Errorf("resource name %q does not match %s", name, pattern)
+			}
+			continue
+		}
+
+		id, err := url.PathUnescape(got[i])
+		if err != nil || id == "" {
+			return nil, fmt.Errorf("resource name %q has an invalid %s ID", name, strings.Trim(w, "{}"))
+		}
+		ids[strings.Trim(w, "{}")] = id
+	}
+	return ids, nil
+}
+
+// isVariable reports whether a pattern segment is an ID
+func isVariable(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// UserName formats a user's resource name
+func UserName(userID int) string {
+	return Format(UserPattern, strconv.Itoa(userID))
+}
+
+// UserStoreName formats the resource name of a user's saved store
+func UserStoreName(userID int, storeID string) string {
+	return Format(UserStorePattern, strconv.Itoa(userID), storeID)
+}
+
+// UserProductName formats the resource name of a user's saved product
+func UserProductName(userID int, sku string) string {
+	return Format(UserProductPattern, strconv.Itoa(userID), sku)
+}
+
+// StoreName formats the resource name of a retailer store
+func StoreName(storeID string) string {
+	return Format(StorePattern, storeID)
+}
+
+// ProductName formats the resource name of a retailer product
+func ProductName(sku string) string {
+	return Format(ProductPattern, sku)
+}
+
+// ParseUser parses "users/{user}". The user ID is resolved against callerID
+// when it is Me; any other user is returned as is for the caller to authorize.
+func ParseUser(name string, callerID int) (int, error) {
+	ids, err := Parse(UserPattern, name)
+	if err != nil {
+		return 0, err
+	}
+	return parseUserID(ids["user"], callerID)
+}
+
+// ParseUserStore parses "users/{user}/stores/{store}"
+func ParseUserStore(name string, callerID int) (userID int, storeID string, err error) {
+	ids, err := Parse(UserStorePattern, name)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err = parseUserID(ids["user"], callerID)
+	return userID, ids["store"], err
+}
+
+// ParseUserProduct parses "users/{user}/products/{product}"
+func ParseUserProduct(name string, callerID int) (userID int, sku string, err error) {
+	ids, err := Parse(UserProductPattern, name)
+	if err != nil {
+		return 0, "", err
+	}
+	userID, err = parseUserID(ids["user"], callerID)
+	return userID, ids["product"], err
+}
+
+// parseUserID converts a user ID segment, resolving Me to callerID
+func parseUserID(id string, callerID int) (int, error) {
+	if id == Me {
+		return callerID, nil
+	}
+	userID, err := strconv.Atoi(id)
+	if err != nil || userID <= 0 {
+		return 0, fmt.Errorf("invalid user ID %q", id)
+	}
+	return userID, nil
+}
@@ -0,0 +1,88 @@
+package resource
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		pattern string
+		ids     []string
+		name    string
+		parsed  map[string]string
+	}{
+		{UserPattern, []string{"42"}, "users/42", map[string]string{"user": "42"}},
+		{UserStorePattern, []string{"42", "281"}, "users/42/stores/281", map[string]string{"user": "42", "store": "281"}},
+		{UserProductPattern, []string{"me", "6579543"}, "users/me/products/6579543", map[string]string{"user": "me", "product": "6579543"}},
+		{StorePattern, []string{"281"}, "stores/281", map[string]string{"store": "281"}},
+		// IDs are escaped so they stay one segment
+		{ProductPattern, []string{"walmart/12 34"}, "products/walmart%2F12%2034", map[string]string{"product": "walmart/12 34"}},
+	}
+	for _, tt := range tests {
+		name := Format(tt.pattern, tt.ids...)
+		if name != tt.name {
+			t.Errorf("Format(%s, %v) = %q, want %q", tt.pattern, tt.ids, name, tt.name)
+			continue
+		}
+		ids, err := Parse(tt.pattern, name)
+		if err != nil {
+			t.Errorf("Parse(%s, %q): %v", tt.pattern, name, err)
+			continue
+		}
+		if !maps.Equal(ids, tt.parsed) {
+			t.Errorf("Parse(%s, %q) = %v, want %v", tt.pattern, name, ids, tt.parsed)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := []struct {
+		desc    string
+		pattern string
+		name    string
+	}{
+		{"wrong collection", UserProductPattern, "users/42/stores/6579543"},
+		{"wrong root collection", UserPattern, "accounts/42"},
+		{"empty ID", UserProductPattern, "users//products/6579543"},
+		{"empty last ID", UserProductPattern, "users/42/products/"},
+		{"empty name", StorePattern, ""},
+		{"extra segments", UserProductPattern, "users/42/products/6579543/reviews"},
+		{"trailing slash", StorePattern, "stores/281/"},
+		{"missing segments", UserStorePattern, "users/42/stores"},
+		{"bad escape", ProductPattern, "products/%zz"},
+	}
+	for _, tt := range tests {
+		if ids, err := Parse(tt.pattern, tt.name); err == nil {
+			t.Errorf("%s: Parse(%s, %q) = %v, want an error", tt.desc, tt.pattern, tt.name, ids)
+		}
+	}
+}
+
+func TestParseUserProduct(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantUser int
+		wantSKU  string
+		wantErr  bool
+	}{
+		{name: UserProductName(42, "6579543"), wantUser: 42, wantSKU: "6579543"},
+		{name: "users/me/products/6579543", wantUser: 7, wantSKU: "6579543"},
+		{name: "users/0/products/6579543", wantErr: true},
+		{name: "users/-3/products/6579543", wantErr: true},
+		{name: "users/bob/products/6579543", wantErr: true},
+		{name: "users/42/stores/6579543", wantErr: true},
+	}
+	for _, tt := range tests {
+		user, sku, err := ParseUserProduct(tt.name, 7)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseUserProduct(%q) = %d, %q; want an error", tt.name, user, sku)
+			}
+			continue
+		}
+		if err != nil || user != tt.wantUser || sku != tt.wantSKU {
+			t.Errorf("ParseUserProduct(%q) = %d, %q, %v; want %d, %q", tt.name, user, sku, err, tt.wantUser, tt.wantSKU)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Package restock works out when stores usually restock from when their stock
+// came back, so alerts can suggest when to go: at opening on the days trucks
+// arrive overnight, or around the hour stock usually shows up on the shelf.
+package restock
+
+import (
+	"sort"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/hours"
+)
+
+// WindowDays is how far back restocks count, so a store that changes its
+// truck schedule is picked up within a season
+const WindowDays = 90
+
+const (
+	minRestocks    = 4         // restocks needed before suggesting anything; fewer could be chance
+	truckDayShare  = 0.25      // share of restocks a weekday needs to count as a truck day; an even spread is 1/7
+	maxTruckDays   = 2         // truck days named in a hint
+	overnightSlack = time.Hour // restocks showing up this soon after opening were on the shelf at open
+)
+
+// Event is a product coming into stock at a store
+type Event struct {
+	SKU string
+	At  time.Time
+}
+
+// Times picks the restocks to model a visit for sku on: the product's own if
+// there are enough, otherwise every product's at the store, since the same
+// trucks bring them all
+func Times(events []Event, sku string) []time.Time {
+	var own, all []time.Time
+	for _, e := range events {
+		all = append(all, e.At)
+		if e.SKU == sku {
+			own = append(own, e.At)
+		}
+	}
+	if len(own) >= minRestocks {
+		return own
+	}
+	return all
+}
+
+// Hint suggests when to visit a store for restocked stock
+type Hint struct {
+	Days   []time.Weekday // days restocks usually show up, most frequent first
+	AtOpen bool           // restocks arrive overnight and are out when the store opens
+	Hour   int            // otherwise the local hour they usually show up
+	Next   time.Time      // the next suggested visit, in the store's time zone
+}
+
+// Known reports whether there's a suggestion
+func (h Hint) Known() bool {
+	return !h.Next.IsZero()
+}
+
+// Suggest works out a hint from a store's restock times and hours. Times,
+// the schedule and now are in the store's time zone. The hint is unknown
+// if there are too few restocks or they don't favor any days.
+func Suggest(restocks []time.Time, schedule hours.Schedule, now time.Time) Hint {
+	if len(restocks) < minRestocks {
+		return Hint{}
+	}
+
+	type shelved struct {
+		at     time.Time
+		atOpen bool
+	}
+	onShelf := make([]shelved, len(restocks))
+	var counts [7]int
+	for i, t := range restocks {
+		at, atOpen := shelfTime(schedule, t)
+		onShelf[i] = shelved{at, atOpen}
+		counts[at.Weekday()]++
+	}
+
+	hint := Hint{}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if float64(counts[day]) >= truckDayShare*float64(len(restocks)) {
+			hint.Days = append(hint.Days, day)
+		}
+	}
+	if len(hint.Days) == 0 {
+		return Hint{}
+	}
+	sort.SliceStable(hint.Days, func(i, j int) bool { return counts[hint.Days[i]] > counts[hint.Days[j]] })
+	if len(hint.Days) > maxTruckDays {
+		hint.Days = hint.Days[:maxTruckDays]
+	}
+
+	var atOpen, total int
+	var hoursOfDay []int
+	for _, s := range onShelf {
+		if !hint.on(s.at.Weekday()) {
+			continue
+		}
+		total++
+		if s.atOpen {
+			atOpen++
+		} else {
+			hoursOfDay = append(hoursOfDay, s.at.Hour())
+		}
+	}
+	hint.AtOpen = atOpen*2 >= total
+	if !hint.AtOpen {
+		sort.Ints(hoursOfDay)
+		hint.Hour = hoursOfDay[len(hoursOfDay)/2]
+	}
+
+	hint.Next = hint.next(schedule, now)
+	return hint
+}
+
+// shelfTime returns when a restock reported at t could first be bought, and
+// whether that was at opening: restocks reported while the store was closed
+// are on the shelf when it next opens. Without known hours it's t.
+func shelfTime(schedule hours.Schedule, t time.Time) (time.Time, bool) {
+	status := schedule.StatusAt(t)
+	if !status.Open {
+		if status.Opens.IsZero() {
+			return t, false
+		}
+		return status.Opens, true
+	}
+
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	for _, iv := range schedule.On(t) {
+		if offset := t.Sub(midnight); offset >= iv.Open && offset < iv.Open+overnightSlack {
+			return midnight.Add(iv.Open), true
+		}
+	}
+	return t, false
+}
+
+// on reports whether day is one of the hint's days
+func (h Hint) on(day time.Weekday) bool {
+	for _, d := range h.Days {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
+
+// next returns the first suggested visit after now within a week, or the
+// zero time if the store isn't open then
+func (h Hint) next(schedule hours.Schedule, now time.Time) time.Time {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for i := 0; i <= 7; i++ {
+		day := midnight.AddDate(0, 0, i)
+		if !h.on(day.Weekday()) {
+			continue
+		}
+		visit := day.Add(time.Duration(h.Hour) * time.Hour)
+		if h.AtOpen {
+			intervals := schedule.On(day)
+			if len(intervals) == 0 {
+				continue
+			}
+			visit = day.Add(intervals[0].Open)
+		}
+		if visit.After(now) && schedule.OpenAt(visit) {
+			return visit
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,102 @@
+package restock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/hours"
+)
+
+var zone = hours.Zone(-8)
+
+// at returns a time on a day of the week of 2026-10-12 (a Monday) in zone
+func at(day time.Weekday, hour, minute int) time.Time {
+	return time.Date(2026, time.October, 11+int(day), hour, minute, 0, 0, zone)
+}
+
+func schedule(t *testing.T) hours.Schedule {
+	t.Helper()
+	s, err := hours.NewSchedule("Mon-Sat: 10am-9pm; Sun: 11am-7pm", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSuggestOvernightTrucks(t *testing.T) {
+	// Stock that shows up overnight or just after opening was on the shelf at open
+	restocks := []time.Time{
+		at(time.Tuesday, 6, 15),
+		at(time.Tuesday, 10, 20).AddDate(0, 0, -7),
+		at(time.Monday, 22, 30).AddDate(0, 0, -14), // after closing: on the shelf Tuesday
+		at(time.Friday, 5, 0),
+		at(time.Friday, 9, 0).AddDate(0, 0, -7),
+		at(time.Wednesday, 15, 0),
+	}
+	now := at(time.Wednesday, 12, 0)
+
+	hint := Suggest(restocks, schedule(t), now)
+	if len(hint.Days) != 2 || hint.Days[0] != time.Tuesday || hint.Days[1] != time.Friday {
+		t.Errorf("days %v, want [Tuesday Friday]", hint.Days)
+	}
+	if !hint.AtOpen {
+		t.Error("not at open")
+	}
+	if want := at(time.Friday, 10, 0); !hint.Next.Equal(want) {
+		t.Errorf("next visit %v, want %v", hint.Next, want)
+	}
+}
+
+func TestSuggestDaytimeRestocks(t *testing.T) {
+	restocks := []time.Time{
+		at(time.Thursday, 14, 10),
+		at(time.Thursday, 15, 40).AddDate(0, 0, -7),
+		at(time.Thursday, 14, 50).AddDate(0, 0, -14),
+		at(time.Thursday, 13, 5).AddDate(0, 0, -21),
+	}
+	now := at(time.Thursday, 16, 0)
+
+	hint := Suggest(restocks, schedule(t), now)
+	if hint.AtOpen || hint.Hour != 14 {
+		t.Errorf("at open %v, hour %d; want 14:00", hint.AtOpen, hint.Hour)
+	}
+	// Today's window has passed
+	if want := at(time.Thursday, 14, 0).AddDate(0, 0, 7); !hint.Next.Equal(want) {
+		t.Errorf("next visit %v, want %v", hint.Next, want)
+	}
+}
+
+func TestSuggestUnknown(t *testing.T) {
+	tests := []struct {
+		name     string
+		restocks []time.Time
+		schedule hours.Schedule
+	}{
+		{"too few restocks", []time.Time{at(time.Tuesday, 6, 0), at(time.Tuesday, 7, 0)}, schedule(t)},
+		{"no favored day", []time.Time{
+			at(time.Sunday, 12, 0), at(time.Monday, 12, 0), at(time.Tuesday, 12, 0), at(time.Wednesday, 12, 0),
+			at(time.Thursday, 12, 0), at(time.Friday, 12, 0), at(time.Saturday, 12, 0),
+		}, schedule(t)},
+		{"hours unknown", []time.Time{
+			at(time.Tuesday, 6, 0), at(time.Tuesday, 7, 0), at(time.Tuesday, 8, 0), at(time.Tuesday, 9, 0),
+		}, hours.Schedule{}},
+	}
+	for _, tt := range tests {
+		if hint := Suggest(tt.restocks, tt.schedule, at(time.Monday, 12, 0)); hint.Known() {
+			t.Errorf("%s: hint %+v, want none", tt.name, hint)
+		}
+	}
+}
+
+func TestTimesPrefersTheProduct(t *testing.T) {
+	var events []Event
+	for i := range 4 {
+		events = append(events, Event{SKU: "1", At: at(time.Tuesday, 6, i)}, Event{SKU: "2", At: at(time.Friday, 6, i)})
+	}
+	if got := Times(events, "1"); len(got) != 4 {
+		t.Errorf("%d restocks for a product with its own history, want its 4", len(got))
+	}
+	if got := Times(events, "3"); len(got) != 8 {
+		t.Errorf("%d restocks for a product without history, want the store's 8", len(got))
+	}
+}
@@ -0,0 +1,91 @@
+package retailer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+)
+
+// bestBuyClient adapts a bestbuy.Client to the retailer interface
+type bestBuyClient struct {
+	client bestbuy.Client
+}
+
+// NewBestBuy wraps a Best Buy API (or mock) client
+func NewBestBuy(client bestbuy.Client) Client {
+	return &bestBuyClient{client: client}
+}
+
+// SearchStores searches for Best Buy stores near a postal code
+func (c *bestBuyClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	stores, err := c.client.SearchStores(ctx, postalCode, radiusMiles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Store, 0, len(stores))
+	for _, s := range stores {
+		result = append(result, Store{
+			ID:         s.StoreIDString(),
+			Name:       s.Name,
+			Address:    s.Address,
+			City:       s.City,
+			State:      s.State,
+			PostalCode: s.PostalCode,
+			Phone:      s.Phone,
+			Distance:   s.Distance,
+		})
+	}
+	return result, nil
+}
+
+// SearchProducts searches for Best Buy products
+func (c *bestBuyClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	page, err := c.client.SearchProducts(ctx, query, category, bestbuy.PageRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Product, 0, len(page.Products))
+	for _, p := range page.Products {
+		result = append(result, bestBuyProduct(p))
+	}
+	return result, nil
+}
+
+// GetProduct gets a Best Buy product by SKU
+func (c *bestBuyClient) GetProduct(ctx context.Context, sku string) (*Product, error) {
+	p, err := c.client.GetProductBySKU(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	product := bestBuyProduct(*p)
+	return &product, nil
+}
+
+// CheckAvailability checks Best Buy stores near a postal code
+func (c *bestBuyClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]Availability, error) {
+	availability, err := c.client.CheckAvailability(ctx, sku, postalCode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Availability, 0, len(availability))
+	for _, a := range availability {
+		result = append(result, Availability(a))
+	}
+	return result, nil
+}
+
+// bestBuyProduct converts a Best Buy product
+func bestBuyProduct(p bestbuy.Product) Product {
+	return Product{
+		SKU:          fmt.Sprintf("%d", p.SKU),
+		Name:         p.Name,
+		SalePrice:    p.SalePrice,
+		ThumbnailURL: p.ThumbnailImage,
+		ProductURL:   p.URL,
+		Currency:     p.Currency,
+	}
+}
@@ -0,0 +1,104 @@
+package retailer
+
+import (
+	"context"
+
+	"github.com/tmcauley/stock-checker/backend/internal/costco"
+)
+
+// costcoClient adapts a costco.Client to the retailer interface. Costco's
+// warehouses are its stores, and item numbers its SKUs.
+type costcoClient struct {
+	client costco.Client
+}
+
+// NewCostco wraps a Costco API (or mock) client
+func NewCostco(client costco.Client) Client {
+	return &costcoClient{client: client}
+}
+
+// SearchStores searches for Costco warehouses near a postal code
+func (c *costcoClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	warehouses, err := c.client.SearchWarehouses(ctx, postalCode, radiusMiles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Store, 0, len(warehouses))
+	for _, w := range warehouses {
+		result = append(result, Store{
+			ID:         w.Number,
+			Name:       w.Name,
+			Address:    w.Address,
+			City:       w.City,
+			State:      w.State,
+			PostalCode: w.PostalCode,
+			Phone:      w.Phone,
+			Distance:   w.Distance,
+		})
+	}
+	return result, nil
+}
+
+// SearchProducts searches for Costco products
+func (c *costcoClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	products, err := c.client.SearchProducts(ctx, query, category)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Product, 0, len(products))
+	for _, p := range products {
+		result = append(result, costcoProduct(p))
+	}
+	return result, nil
+}
+
+// GetProduct gets a Costco product by item number
+func (c *costcoClient) GetProduct(ctx context.Context, sku string) (*Product, error) {
+	p, err := c.client.GetProduct(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	product := costcoProduct(*p)
+	return &product, nil
+}
+
+// CheckAvailability checks Costco warehouses near a postal code. Warehouse
+// purchases are in person only, so nothing is pickup eligible.
+func (c *costcoClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]Availability, error) {
+	availability, err := c.client.CheckWarehouses(ctx, sku, postalCode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Availability, 0, len(availability))
+	for _, a := range availability {
+		result = append(result, Availability{
+			StoreID:   a.Number,
+			StoreName: a.Name,
+			City:      a.City,
+			State:     a.State,
+			Distance:  a.Distance,
+			InStock:   true,
+			LowStock:  a.LowStock,
+		})
+	}
+	return result, nil
+}
+
+// costcoProduct converts a Costco product, using the item number as the SKU
+func costcoProduct(p costco.Product) Product {
+	online := OnlineOutOfStock
+	if p.OnlineInStock {
+		online = OnlineInStock
+	}
+	return Product{
+		SKU:          p.ItemNumber,
+		Name:         p.Name,
+		SalePrice:    p.Price,
+		ThumbnailURL: p.ImageURL,
+		ProductURL:   p.URL,
+		Online:       online,
+	}
+}
@@ -0,0 +1,98 @@
+package retailer
+
+import (
+	"context"
+
+	"github.com/tmcauley/stock-checker/backend/internal/gamestop"
+)
+
+// gameStopClient adapts a gamestop.Client to the retailer interface
+type gameStopClient struct {
+	client gamestop.Client
+}
+
+// NewGameStop wraps a GameStop API (or mock) client
+func NewGameStop(client gamestop.Client) Client {
+	return &gameStopClient{client: client}
+}
+
+// SearchStores searches for GameStop stores near a postal code
+func (c *gameStopClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	stores, err := c.client.SearchStores(ctx, postalCode, radiusMiles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Store, 0, len(stores))
+	for _, s := range stores {
+		result = append(result, Store{
+			ID:         s.StoreID,
+			Name:       s.Name,
+			Address:    s.Address,
+			City:       s.City,
+			State:      s.State,
+			PostalCode: s.PostalCode,
+			Phone:      s.Phone,
+			Distance:   s.Distance,
+		})
+	}
+	return result, nil
+}
+
+// SearchProducts searches for GameStop products
+func (c *gameStopClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	products, err := c.client.SearchProducts(ctx, query, category)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Product, 0, len(products))
+	for _, p := range products {
+		result = append(result, gameStopProduct(p))
+	}
+	return result, nil
+}
+
+// GetProduct gets a GameStop product by product ID
+func (c *gameStopClient) GetProduct(ctx context.Context, sku string) (*Product, error) {
+	p, err := c.client.GetProduct(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	product := gameStopProduct(*p)
+	return &product, nil
+}
+
+// CheckAvailability checks GameStop stores near a postal code
+func (c *gameStopClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]Availability, error) {
+	availability, err := c.client.CheckAvailability(ctx, sku, postalCode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Availability, 0, len(availability))
+	for _, a := range availability {
+		result = append(result, Availability{
+			StoreID:        a.StoreID,
+			StoreName:      a.StoreName,
+			City:           a.City,
+			State:          a.State,
+			Distance:       a.Distance,
+			InStock:        a.InStock,
+			LowStock:       a.LowStock,
+			PickupEligible: a.PickupEligible,
+		})
+	}
+	return result, nil
+}
+
+// gameStopProduct converts a GameStop product, using the product ID as the SKU
+func gameStopProduct(p gamestop.Product) Product {
+	return Product{
+		SKU:          p.PID,
+		Name:         p.Name,
+		SalePrice:    p.Price,
+		ThumbnailURL: p.ImageURL,
+		ProductURL:   p.URL,
+	}
+}
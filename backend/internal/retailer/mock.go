@@ -0,0 +1,160 @@
+package retailer
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/costco"
+	"github.com/tmcauley/stock-checker/backend/internal/gamestop"
+	"github.com/tmcauley/stock-checker/backend/internal/target"
+)
+
+// mockCatalog is the fixed data served by a retailer's mock client
+type mockCatalog struct {
+	stores   []Store
+	products []Product
+}
+
+// mockCatalogs holds mock data for retailers without their own mock client
+var mockCatalogs = map[ID]mockCatalog{
+	Walmart: {
+		stores: []Store{
+			{ID: "2280", Name: "Walmart Supercenter - San Leandro", Address: "15555 Hesperian Blvd", City: "San Leandro", State: "CA", PostalCode: "94578", Phone: "(510) 481-9300"},
+			{ID: "2119", Name: "Walmart - Oakland", Address: "8400 Edgewater Dr", City: "Oakland", State: "CA", PostalCode: "94621", Phone: "(510) 632-4204"},
+			{ID: "3130", Name: "Walmart Supercenter - Mountain View", Address: "600 Showers Dr", City: "Mountain View", State: "CA", PostalCode: "94040", Phone: "(650) 917-0796"},
+			{ID: "5434", Name: "Walmart - Richmond", Address: "4505 Macdonald Ave", City: "Richmond", State: "CA", PostalCode: "94805", Phone: "(510) 237-1690"},
+		},
+		products: []Product{
+			{SKU: "1512714018", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box", SalePrice: 4999, ProductURL: "https://www.walmart.com/ip/1512714018"},
+			{SKU: "1512714020", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Booster Bundle", SalePrice: 2697, ProductURL: "https://www.walmart.com/ip/1512714020"},
+			{SKU: "5157428331", Name: "Pokemon Trading Card Game: Surging Sparks Elite Trainer Box", SalePrice: 4997, ProductURL: "https://www.walmart.com/ip/5157428331"},
+			{SKU: "1376508870", Name: "Pokemon Trading Card Game: Scarlet & Violet 151 Booster Bundle", SalePrice: 2694, ProductURL: "https://www.walmart.com/ip/1376508870"},
+		},
+	},
+}
+
+// MockClient serves a retailer's mock catalog with deterministic availability
+type MockClient struct {
+	retailer ID
+	catalog  mockCatalog
+	latency  time.Duration
+}
+
+// NewMock returns the mock client for a retailer. Retailers with their own
+// client package use its mock, so mock data matches in every API version.
+func NewMock(id ID) (Client, error) {
+	switch id {
+	case BestBuy:
+		return NewBestBuy(bestbuy.NewMockClient()), nil
+	case Target:
+		return NewTarget(target.NewMockClient()), nil
+	case GameStop:
+		return NewGameStop(gamestop.NewMockClient()), nil
+	case Costco:
+		return NewCostco(costco.NewMockClient()), nil
+	}
+
+	catalog, ok := mockCatalogs[id]
+	if !ok {
+		return nil, fmt.Errorf("no mock data for retailer %q", id)
+	}
+	return &MockClient{
+		retailer: id,
+		catalog:  catalog,
+		latency:  100 * time.Millisecond, // Simulate 100ms API latency
+	}, nil
+}
+
+// simulateLatency adds a small delay to simulate network latency
+func (c *MockClient) simulateLatency(ctx context.Context) error {
+	select {
+	case <-time.After(c.latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SearchStores returns every mock store with a random distance within the radius
+func (c *MockClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+	if radiusMiles <= 0 {
+		radiusMiles = 25
+	}
+
+	stores := make([]Store, len(c.catalog.stores))
+	for i, store := range c.catalog.stores {
+		stores[i] = store
+		stores[i].Distance = float64(rand.Intn(radiusMiles)) + rand.Float64()
+	}
+	return stores, nil
+}
+
+// SearchProducts returns mock products whose name or SKU matches the query
+func (c *MockClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	queryLower := strings.ToLower(query)
+	var results []Product
+	for _, p := range c.catalog.products {
+		if query == "" || strings.Contains(strings.ToLower(p.Name), queryLower) || strings.Contains(p.SKU, queryLower) {
+			results = append(results, p)
+		}
+	}
+	return results, nil
+}
+
+// GetProduct gets a mock product by SKU
+func (c *MockClient) GetProduct(ctx context.Context, sku string) (*Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, p := range c.catalog.products {
+		if p.SKU == sku {
+			return &p, nil
+		}
+	}
+	return nil, fmt.Errorf("%s product not found: %s", c.retailer, sku)
+}
+
+// CheckAvailability returns the mock stores that have the product, seeded by
+// store and SKU so repeated checks give the same answer
+func (c *MockClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]Availability, error) {
+	if _, err := c.GetProduct(ctx, sku); err != nil {
+		return nil, err
+	}
+
+	var availability []Availability
+	for i, store := range c.catalog.stores {
+		seed := int64(0)
+		for _, ch := range string(c.retailer) + store.ID + sku {
+			seed += int64(ch)
+		}
+		roll := rand.New(rand.NewSource(seed)).Float64()
+
+		// 60% in stock, a third of which is low stock
+		if roll >= 0.6 {
+			continue
+		}
+		availability = append(availability, Availability{
+			StoreID:        store.ID,
+			StoreName:      store.Name,
+			City:           store.City,
+			State:          store.State,
+			Distance:       float64(3 + 4*i),
+			InStock:        true,
+			LowStock:       roll >= 0.4,
+			PickupEligible: true,
+		})
+	}
+	return availability, nil
+}
@@ -0,0 +1,57 @@
+package retailer
+
+import "sort"
+
+// Registry holds the client used for each retailer
+type Registry struct {
+	clients map[ID]Client
+	mocked  map[ID]bool
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		clients: make(map[ID]Client),
+		mocked:  make(map[ID]bool),
+	}
+}
+
+// Register sets the client for a retailer, replacing any previous one
+func (r *Registry) Register(id ID, client Client, mock bool) {
+	r.clients[id] = client
+	r.mocked[id] = mock
+}
+
+// Get returns the client for a retailer
+func (r *Registry) Get(id ID) (Client, bool) {
+	client, ok := r.clients[id]
+	return client, ok
+}
+
+// IsMock reports whether a retailer is served by its mock client
+func (r *Registry) IsMock(id ID) bool {
+	return r.mocked[id]
+}
+
+// IDs lists the registered retailers in a stable order
+func (r *Registry) IDs() []ID {
+	ids := make([]ID, 0, len(r.clients))
+	for id := range r.clients {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Polled returns the clients of retailers other than Best Buy whose watched
+// products the background watcher should poll: those with a real client, plus
+// mocked ones when withMocks is set
+func (r *Registry) Polled(withMocks bool) map[ID]Client {
+	polled := make(map[ID]Client)
+	for id, client := range r.clients {
+		if id != BestBuy && (withMocks || !r.mocked[id]) {
+			polled[id] = client
+		}
+	}
+	return polled
+}
@@ -0,0 +1,84 @@
+// Package retailer defines a retailer-agnostic client interface and the
+// registry that picks a real or mock client for each retailer.
+package retailer
+
+import (
+	"context"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+)
+
+// ID identifies a retailer
+type ID string
+
+// Known retailers
+const (
+	BestBuy  ID = "bestbuy"
+	Walmart  ID = "walmart"
+	Target   ID = "target"
+	GameStop ID = "gamestop"
+	Costco   ID = "costco"
+)
+
+// All lists every known retailer
+var All = []ID{BestBuy, Walmart, Target, GameStop, Costco}
+
+// Store is a retailer store location
+type Store struct {
+	ID         string
+	Name       string
+	Address    string
+	City       string
+	State      string
+	PostalCode string
+	Phone      string
+	Distance   float64 // miles from the searched postal code
+}
+
+// OnlineStatus is a product's availability to order for delivery from the retailer's website
+type OnlineStatus int
+
+// Online statuses
+const (
+	OnlineUnknown    OnlineStatus = iota // the retailer doesn't report it
+	OnlineInStock                        // can be ordered online
+	OnlineOutOfStock                     // sold out online
+)
+
+// Product is a retailer product
+type Product struct {
+	SKU          string
+	Name         string
+	SalePrice    money.Cents
+	ThumbnailURL string
+	ProductURL   string
+	Online       OnlineStatus
+	Currency     string // ISO 4217 code of SalePrice; empty means USD
+}
+
+// Availability is a product's stock at one store
+type Availability struct {
+	StoreID        string
+	StoreName      string
+	City           string
+	State          string
+	Distance       float64
+	InStock        bool
+	LowStock       bool
+	PickupEligible bool
+}
+
+// Client is implemented by every retailer adapter
+type Client interface {
+	// SearchStores searches for stores near a postal code within a radius
+	SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error)
+
+	// SearchProducts searches for products by keyword or SKU, optionally within a category
+	SearchProducts(ctx context.Context, query string, category string) ([]Product, error)
+
+	// GetProduct gets a single product by SKU
+	GetProduct(ctx context.Context, sku string) (*Product, error)
+
+	// CheckAvailability returns the stores near a postal code that have the product in stock
+	CheckAvailability(ctx context.Context, sku string, postalCode string) ([]Availability, error)
+}
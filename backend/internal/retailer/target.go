@@ -0,0 +1,98 @@
+package retailer
+
+import (
+	"context"
+
+	"github.com/tmcauley/stock-checker/backend/internal/target"
+)
+
+// targetClient adapts a target.Client to the retailer interface
+type targetClient struct {
+	client target.Client
+}
+
+// NewTarget wraps a Target API (or mock) client
+func NewTarget(client target.Client) Client {
+	return &targetClient{client: client}
+}
+
+// SearchStores searches for Target stores near a postal code
+func (c *targetClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	stores, err := c.client.SearchStores(ctx, postalCode, radiusMiles)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Store, 0, len(stores))
+	for _, s := range stores {
+		result = append(result, Store{
+			ID:         s.StoreID,
+			Name:       s.Name,
+			Address:    s.Address,
+			City:       s.City,
+			State:      s.State,
+			PostalCode: s.PostalCode,
+			Phone:      s.Phone,
+			Distance:   s.Distance,
+		})
+	}
+	return result, nil
+}
+
+// SearchProducts searches for Target products
+func (c *targetClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	products, err := c.client.SearchProducts(ctx, query, category)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Product, 0, len(products))
+	for _, p := range products {
+		result = append(result, targetProduct(p))
+	}
+	return result, nil
+}
+
+// GetProduct gets a Target product by TCIN
+func (c *targetClient) GetProduct(ctx context.Context, sku string) (*Product, error) {
+	p, err := c.client.GetProduct(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	product := targetProduct(*p)
+	return &product, nil
+}
+
+// CheckAvailability checks Target stores near a postal code
+func (c *targetClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]Availability, error) {
+	availability, err := c.client.CheckAvailability(ctx, sku, postalCode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Availability, 0, len(availability))
+	for _, a := range availability {
+		result = append(result, Availability{
+			StoreID:        a.StoreID,
+			StoreName:      a.StoreName,
+			City:           a.City,
+			State:          a.State,
+			Distance:       a.Distance,
+			InStock:        a.InStock,
+			LowStock:       a.LowStock,
+			PickupEligible: a.PickupEligible,
+		})
+	}
+	return result, nil
+}
+
+// targetProduct converts a Target product, using the TCIN as the SKU
+func targetProduct(p target.Product) Product {
+	return Product{
+		SKU:          p.TCIN,
+		Name:         p.Name,
+		SalePrice:    p.Price,
+		ThumbnailURL: p.ImageURL,
+		ProductURL:   p.URL,
+	}
+}
@@ -0,0 +1,46 @@
+package retailer
+
+import (
+	"context"
+	"time"
+)
+
+// timedClient wraps a Client and reports how long each call took
+type timedClient struct {
+	client  Client
+	observe func(call string, d time.Duration)
+}
+
+// NewTimed creates a client that calls observe with the method name and duration of every call
+func NewTimed(client Client, observe func(call string, d time.Duration)) Client {
+	return &timedClient{client: client, observe: observe}
+}
+
+// since reports the time since start for a call
+func (c *timedClient) since(call string, start time.Time) {
+	c.observe(call, time.Since(start))
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *timedClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	defer c.since("SearchStores", time.Now())
+	return c.client.SearchStores(ctx, postalCode, radiusMiles)
+}
+
+// SearchProducts searches for products by keyword or SKU, optionally within a category
+func (c *timedClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	defer c.since("SearchProducts", time.Now())
+	return c.client.SearchProducts(ctx, query, category)
+}
+
+// GetProduct gets a single product by SKU
+func (c *timedClient) GetProduct(ctx context.Context, sku string) (*Product, error) {
+	defer c.since("GetProduct", time.Now())
+	return c.client.GetProduct(ctx, sku)
+}
+
+// CheckAvailability returns the stores near a postal code that have the product in stock
+func (c *timedClient) CheckAvailability(ctx context.Context, sku string, postalCode string) ([]Availability, error) {
+	defer c.since("CheckAvailability", time.Now())
+	return c.client.CheckAvailability(ctx, sku, postalCode)
+}
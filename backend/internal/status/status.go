@@ -0,0 +1,256 @@
+// Package status serves a small public JSON feed of stock for a few featured
+// SKUs, for community sites to embed a live tracker without API access.
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+	"github.com/tmcauley/stock-checker/backend/internal/clientip"
+)
+
+// Defaults
+const (
+	DefaultTTL               = 5 * time.Minute
+	DefaultRequestsPerMinute = 30
+	maxTrackedClients        = 10000 // forget every client rather than grow without bound
+	refreshTimeout           = 30 * time.Second
+)
+
+// Config configures the status feed
+type Config struct {
+	SKUs              []string
+	PostalCode        string           // where stock is checked from
+	TTL               time.Duration    // how long a response is served before checking again
+	RequestsPerMinute int              // per client IP
+	TrustedProxies    clientip.Proxies // whose X-Forwarded-For gives the client IP
+}
+
+// Feed is the JSON document served to embedders
+type Feed struct {
+	GeneratedAt time.Time `json:"generated_at"`
+	PostalCode  string    `json:"postal_code"`
+	Products    []Product `json:"products"`
+}
+
+// Product is the stock of one featured SKU
+type Product struct {
+	SKU        string  `json:"sku"`
+	Name       string  `json:"name,omitempty"`
+	URL        string  `json:"url,omitempty"`
+	InStock    bool    `json:"in_stock"`
+	StoreCount int     `json:"store_count"`
+	Stores     []Store `json:"stores"`
+	Error      string  `json:"error,omitempty"` // set if the SKU couldn't be checked
+}
+
+// Store is a store with a featured SKU in stock
+type Store struct {
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	City          string  `json:"city"`
+	State         string  `json:"state"`
+	DistanceMiles float64 `json:"distance_miles"`
+	LowStock      bool    `json:"low_stock"`
+}
+
+// Handler serves the feed, checking stock at most once per TTL however many
+// sites embed it, and rate limiting each client IP
+type Handler struct {
+	bbClient bestbuy.Client
+	cfg      Config
+
+	mu         sync.Mutex
+	body       []byte
+	expires    time.Time
+	refreshErr error         // why the last check failed, while there's no feed to serve
+	refreshing chan struct{} // closed when the check in progress finishes, so concurrent misses share it
+
+	limitMu sync.Mutex
+	windows map[string]*window
+}
+
+// window counts one client's requests in the current minute
+type window struct {
+	start time.Time
+	count int
+}
+
+// NewHandler creates a status feed handler
+func NewHandler(bbClient bestbuy.Client, cfg Config) *Handler {
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultTTL
+	}
+	if cfg.RequestsPerMinute <= 0 {
+		cfg.RequestsPerMinute = DefaultRequestsPerMinute
+	}
+	return &Handler{bbClient: bbClient, cfg: cfg, windows: make(map[string]*window)}
+}
+
+// ServeHTTP serves the feed as JSON
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Embeddable from any site, without cookies
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Del("Access-Control-Allow-Credentials")
+
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if retryAfter, ok := h.allow(h.cfg.TrustedProxies.IP(r), time.Now()); !ok {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+		http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+		return
+	}
+
+	body, expires, err := h.feed(r.Context())
+	if err != nil {
+		log.Printf("Status feed: %v", err)
+		http.Error(w, "status unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	maxAge := int(time.Until(expires).Seconds())
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", max(maxAge, 0)))
+	w.Write(body)
+}
+
+// feed returns the cached feed, checking stock again once it expires. If the
+// check fails entirely, the previous feed is served until the next attempt.
+func (h *Handler) feed(ctx context.Context) ([]byte, time.Time, error) {
+	h.mu.Lock()
+	if h.body != nil && time.Now().Before(h.expires) {
+		body, expires := h.body, h.expires
+		h.mu.Unlock()
+		return body, expires, nil
+	}
+	if done := h.refreshing; done != nil {
+		h.mu.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, time.Time{}, ctx.Err()
+		}
+	} else {
+		done = make(chan struct{})
+		h.refreshing = done
+		h.mu.Unlock()
+		h.refresh(ctx, done)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.body == nil {
+		return nil, time.Time{}, h.refreshErr
+	}
+	return h.body, h.expires, nil
+}
+
+// refresh checks stock without holding the lock, then swaps the result in.
+// The check has its own timeout rather than the request's context, so the
+// client that happened to start it can't cancel it for everyone waiting.
+func (h *Handler) refresh(ctx context.Context, done chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), refreshTimeout)
+	defer cancel()
+
+	now := time.Now()
+	body, err := h.build(ctx, now)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	defer close(done)
+	h.refreshing = nil
+
+	switch {
+	case err == nil:
+		h.body, h.expires, h.refreshErr = body, now.Add(h.cfg.TTL), nil
+	case h.body != nil:
+		log.Printf("Status feed: serving previous feed: %v", err)
+		h.expires = now.Add(h.cfg.TTL)
+	default:
+		h.refreshErr = err
+	}
+}
+
+// build checks every featured SKU and encodes the feed
+func (h *Handler) build(ctx context.Context, now time.Time) ([]byte, error) {
+	feed := Feed{GeneratedAt: now.UTC(), PostalCode: h.cfg.PostalCode, Products: []Product{}}
+
+	var failures int
+	for _, sku := range h.cfg.SKUs {
+		p := Product{SKU: sku, Stores: []Store{}}
+
+		if product, err := h.bbClient.GetProductBySKU(ctx, sku); err == nil {
+			p.Name, p.URL = product.Name, product.URL
+		}
+
+		availability, err := h.bbClient.CheckAvailability(ctx, sku, h.cfg.PostalCode)
+		if errors.Is(err, bestbuy.ErrRestrictedSKU) {
+			p.Error = "restricted"
+			feed.Products = append(feed.Products, p)
+			continue
+		}
+		if err != nil {
+			log.Printf("Status feed: failed to check %s: %v", sku, err)
+			p.Error = "check failed"
+			failures++
+			feed.Products = append(feed.Products, p)
+			continue
+		}
+
+		for _, a := range availability {
+			if !a.InStock {
+				continue
+			}
+			p.Stores = append(p.Stores, Store{
+				ID:            a.StoreID,
+				Name:          a.StoreName,
+				City:          a.City,
+				State:         a.State,
+				DistanceMiles: a.Distance,
+				LowStock:      a.LowStock,
+			})
+		}
+		p.StoreCount = len(p.Stores)
+		p.InStock = p.StoreCount > 0
+		feed.Products = append(feed.Products, p)
+	}
+
+	if len(h.cfg.SKUs) > 0 && failures == len(h.cfg.SKUs) {
+		return nil, fmt.Errorf("all %d featured SKUs failed to check", failures)
+	}
+	return json.Marshal(feed)
+}
+
+// allow counts a request from ip, returning false and how long to wait if
+// it's over the per-minute limit
+func (h *Handler) allow(ip string, now time.Time) (time.Duration, bool) {
+	h.limitMu.Lock()
+	defer h.limitMu.Unlock()
+
+	win, ok := h.windows[ip]
+	if !ok || now.Sub(win.start) >= time.Minute {
+		if !ok && len(h.windows) >= maxTrackedClients {
+			h.windows = make(map[string]*window)
+		}
+		win = &window{start: now}
+		h.windows[ip] = win
+	}
+
+	if win.count >= h.cfg.RequestsPerMinute {
+		return win.start.Add(time.Minute).Sub(now), false
+	}
+	win.count++
+	return 0, true
+}
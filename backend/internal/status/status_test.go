@@ -0,0 +1,132 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tmcauley/stock-checker/backend/internal/bestbuy"
+)
+
+// countingClient reports one store with stock for every SKU
+type countingClient struct {
+	bestbuy.Client
+	checks int
+}
+
+func (c *countingClient) GetProductBySKU(ctx context.Context, sku string) (*bestbuy.Product, error) {
+	return &bestbuy.Product{Name: "Product " + sku}, nil
+}
+
+func (c *countingClient) CheckAvailability(ctx context.Context, sku, postalCode string) ([]bestbuy.StoreAvailability, error) {
+	c.checks++
+	return []bestbuy.StoreAvailability{{StoreID: "281", StoreName: "Emeryville", InStock: true, Distance: 6.5}}, nil
+}
+
+func get(h http.Handler, ip string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("GET", "/status.json", nil)
+	req.RemoteAddr = ip + ":1234"
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestFeedIsCached(t *testing.T) {
+	client := &countingClient{}
+	h := NewHandler(client, Config{SKUs: []string{"6505997", "6522225"}, PostalCode: "94103"})
+
+	for i := 0; i < 3; i++ {
+		rec := get(h, "203.0.113.7")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status %d", i, rec.Code)
+		}
+		if rec.Header().Get("Access-Control-Allow-Origin") != "*" {
+			t.Error("feed isn't embeddable from other origins")
+		}
+
+		var feed Feed
+		if err := json.Unmarshal(rec.Body.Bytes(), &feed); err != nil {
+			t.Fatal(err)
+		}
+		if len(feed.Products) != 2 || !feed.Products[0].InStock || feed.Products[0].StoreCount != 1 {
+			t.Errorf("unexpected feed %+v", feed)
+		}
+	}
+
+	if client.checks != 2 {
+		t.Errorf("made %d availability checks for 3 requests, want 2 (one per SKU)", client.checks)
+	}
+}
+
+func TestFeedRateLimitsPerIP(t *testing.T) {
+	h := NewHandler(&countingClient{}, Config{SKUs: []string{"6505997"}, PostalCode: "94103", RequestsPerMinute: 2})
+
+	for i := 0; i < 2; i++ {
+		if rec := get(h, "203.0.113.7"); rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status %d", i, rec.Code)
+		}
+	}
+	rec := get(h, "203.0.113.7")
+	if rec.Code != http.StatusTooManyRequests || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("third request: status %d, Retry-After %q; want 429 with Retry-After", rec.Code, rec.Header().Get("Retry-After"))
+	}
+
+	if rec := get(h, "198.51.100.2"); rec.Code != http.StatusOK {
+		t.Errorf("another client was limited too (status %d)", rec.Code)
+	}
+}
+
+// blockingClient holds every availability check until released
+type blockingClient struct {
+	countingClient
+	started chan struct{}
+	release chan struct{}
+	ctxErr  error // of the last check once released
+}
+
+func (c *blockingClient) CheckAvailability(ctx context.Context, sku, postalCode string) ([]bestbuy.StoreAvailability, error) {
+	c.started <- struct{}{}
+	<-c.release
+	c.ctxErr = ctx.Err()
+	return []bestbuy.StoreAvailability{{StoreID: "281", InStock: true}}, nil
+}
+
+func TestFeedRefreshOutlivesRequest(t *testing.T) {
+	client := &blockingClient{started: make(chan struct{}), release: make(chan struct{})}
+	h := NewHandler(client, Config{SKUs: []string{"6505997"}, PostalCode: "94103"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan int)
+	go func() {
+		req := httptest.NewRequest("GET", "/status.json", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		first <- rec.Code
+	}()
+	<-client.started
+
+	// Other clients aren't stuck behind the check while it runs
+	waitCtx, waitCancel := context.WithCancel(context.Background())
+	waitCancel()
+	req := httptest.NewRequest("GET", "/status.json", nil).WithContext(waitCtx)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("gave-up request during a check: status %d, want 503", rec.Code)
+	}
+
+	// The client that started the check hanging up doesn't cancel it
+	cancel()
+	close(client.release)
+	if code := <-first; code != http.StatusOK {
+		t.Errorf("first request: status %d", code)
+	}
+	if client.ctxErr != nil {
+		t.Errorf("check was canceled with its request: %v", client.ctxErr)
+	}
+	if rec := get(h, "203.0.113.7"); rec.Code != http.StatusOK {
+		t.Errorf("feed wasn't cached after the check (status %d)", rec.Code)
+	}
+}
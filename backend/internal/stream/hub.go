@@ -0,0 +1,466 @@
+// Package stream fans the stock event log out to clients watching it live.
+// Each client gets a bounded buffer, so one that stops reading costs a fixed
+// amount of memory and is disconnected instead of growing the server.
+// Clients pick up from a position in the log, so one that reconnects after
+// a network blip gets the events it missed.
+package stream
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+	"github.com/tmcauley/stock-checker/backend/internal/metrics"
+)
+
+// Hub defaults
+const (
+	DefaultInterval     = 2 * time.Second // how often the event log is read
+	DefaultBufferSize   = 64              // events held for a busy client
+	MaxBufferSize       = 256
+	DefaultStallTimeout = time.Minute      // how long a client's buffer may stay full
+	KeepaliveInterval   = 30 * time.Second // longest a stream goes without a message
+	MaxPerUser          = 5                // open streams per user, e.g. browser tabs
+	maxCatchUp          = 5000             // events read to catch a resuming client up
+	batchSize           = 500
+)
+
+var (
+	// ErrTooManyStreams is returned when a user already has MaxPerUser streams open
+	ErrTooManyStreams = errors.New("too many open streams")
+	// ErrStalled ends a subscription whose client stopped reading
+	ErrStalled = errors.New("client stopped reading")
+	// ErrClosed ends subscriptions when the hub is closed
+	ErrClosed = errors.New("stream hub closed")
+	// ErrRevoked ends subscriptions whose session was signed out
+	ErrRevoked = errors.New("session revoked")
+)
+
+// Stream metrics, so operators can see slow clients losing events
+var (
+	streamsOpen = metrics.NewGauge(
+		"stock_checker_streams_open",
+		"Live stock streams currently open.",
+	)
+	streamsStalled = metrics.NewCounter(
+		"stock_checker_streams_stalled_total",
+		"Live stock streams closed because the client stopped reading.",
+	)
+	streamEventsMerged = metrics.NewCounter(
+		"stock_checker_stream_events_merged_total",
+		"Stock events replaced by a newer one for the same product and store before a slow client read them.",
+	)
+	streamEventsDropped = metrics.NewCounter(
+		"stock_checker_stream_events_dropped_total",
+		"Stock events dropped because a slow client's buffer was full.",
+	)
+)
+
+// Source is the stock event log
+type Source interface {
+	LastStockEventID(ctx context.Context) (int64, error)
+	GetStockEvents(ctx context.Context, sku string, afterID int64, limit int) ([]database.StockEvent, error)
+}
+
+// Hub reads new stock events and hands them to every subscription
+type Hub struct {
+	source   Source
+	interval time.Duration
+	stall    time.Duration
+
+	mu        sync.Mutex
+	subs      map[*Subscription]struct{}
+	perUser   map[int]int
+	following bool  // lastID is set; the log is only followed while someone is subscribed
+	lastID    int64 // newest event handed out
+	closed    bool
+}
+
+// NewHub creates a hub reading source every interval (DefaultInterval if 0)
+func NewHub(source Source, interval time.Duration) *Hub {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Hub{
+		source:   source,
+		interval: interval,
+		stall:    DefaultStallTimeout,
+		subs:     make(map[*Subscription]struct{}),
+		perUser:  make(map[int]int),
+	}
+}
+
+// Head returns the position of the newest event in the log, for
+// subscribing from now
+func (h *Hub) Head(ctx context.Context) (int64, error) {
+	return h.source.LastStockEventID(ctx)
+}
+
+// Subscribe opens a stream of the events after position after, for the
+// SKUs (every SKU if empty), holding at most bufferSize events
+// (DefaultBufferSize if 0) while the client is busy. sessionID is the
+// signed-in session streaming, so revoking it ends the stream, or 0 for an
+// API key. Events the hub has already handed out are read back from the log
+// on its next poll.
+func (h *Hub) Subscribe(userID, sessionID int, skus []string, bufferSize int, after int64) (*Subscription, error) {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	bufferSize = min(bufferSize, MaxBufferSize)
+
+	s := &Subscription{
+		hub:       h,
+		userID:    userID,
+		sessionID: sessionID,
+		size:      bufferSize,
+		ready:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
+		catchUp:   true,
+		position:  after,
+	}
+	if len(skus) > 0 {
+		s.skus = make(map[string]bool, len(skus))
+		for _, sku := range skus {
+			s.skus[sku] = true
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return nil, ErrClosed
+	}
+	if h.perUser[userID] >= MaxPerUser {
+		return nil, ErrTooManyStreams
+	}
+	h.subs[s] = struct{}{}
+	h.perUser[userID]++
+	streamsOpen.Set(float64(len(h.subs)))
+	return s, nil
+}
+
+// remove forgets a subscription. Callers hold mu.
+func (h *Hub) remove(s *Subscription) {
+	if _, ok := h.subs[s]; !ok {
+		return
+	}
+	delete(h.subs, s)
+	if h.perUser[s.userID]--; h.perUser[s.userID] <= 0 {
+		delete(h.perUser, s.userID)
+	}
+	if len(h.subs) == 0 {
+		h.following = false
+	}
+	streamsOpen.Set(float64(len(h.subs)))
+}
+
+// Close ends every subscription with ErrClosed and refuses new ones, so
+// clients reconnect to another server while this one shuts down
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for s := range h.subs {
+		h.remove(s)
+		s.close(ErrClosed)
+	}
+}
+
+// Revoke ends the user's subscriptions from a signed-out session with
+// ErrRevoked, or those from any of their sessions if sessionID is 0.
+// Subscriptions opened with an API key are left alone.
+func (h *Hub) Revoke(userID, sessionID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subs {
+		if s.userID != userID || s.sessionID == 0 || sessionID != 0 && s.sessionID != sessionID {
+			continue
+		}
+		h.remove(s)
+		s.close(ErrRevoked)
+	}
+}
+
+// Run reads new events every interval until ctx is done. The log is only
+// read while someone is subscribed.
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := h.poll(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("Stream hub: failed to read stock events: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// poll catches new subscriptions up to the hub, then hands out the events
+// recorded since the last poll
+func (h *Hub) poll(ctx context.Context) error {
+	h.mu.Lock()
+	subscribed, following, lastID := len(h.subs) > 0, h.following, h.lastID
+	var joining []*Subscription
+	for s := range h.subs {
+		if s.catchUp {
+			joining = append(joining, s)
+		}
+	}
+	h.mu.Unlock()
+	if !subscribed {
+		return nil
+	}
+
+	if !following {
+		id, err := h.source.LastStockEventID(ctx)
+		if err != nil {
+			return err
+		}
+		h.mu.Lock()
+		h.following, h.lastID = true, id
+		h.mu.Unlock()
+		lastID = id
+	}
+
+	for _, s := range joining {
+		if err := h.catchUp(ctx, s, lastID); err != nil {
+			return err
+		}
+	}
+
+	for {
+		events, err := h.source.GetStockEvents(ctx, "", lastID, batchSize)
+		if err != nil || len(events) == 0 {
+			return err
+		}
+		lastID = events[len(events)-1].ID
+		h.publish(events, lastID, time.Now())
+		if len(events) < batchSize {
+			return nil
+		}
+	}
+}
+
+// catchUp hands a new subscription the events between its position and
+// upTo, where the hub's live events start. A client too far behind skips
+// ahead to upTo and is told it missed events.
+func (h *Hub) catchUp(ctx context.Context, s *Subscription, upTo int64) error {
+	after := s.Position()
+	for read := 0; after < upTo; read += batchSize {
+		if read >= maxCatchUp {
+			s.skip(upTo)
+			break
+		}
+		events, err := h.source.GetStockEvents(ctx, "", after, batchSize)
+		if err != nil {
+			return err
+		}
+		full := len(events) == batchSize
+		for i, e := range events {
+			if e.ID > upTo {
+				events, full = events[:i], false
+				break
+			}
+		}
+		if len(events) == 0 {
+			break
+		}
+		after = events[len(events)-1].ID
+		if !s.offer(events, time.Now(), h.stall) || !full {
+			break // a stalled client is closed on its next live event
+		}
+	}
+
+	h.mu.Lock()
+	s.catchUp = false
+	h.mu.Unlock()
+	return nil
+}
+
+// publish hands events to every subscription, closing those whose client
+// has stopped reading. Subscriptions still catching up get them on the next
+// poll.
+func (h *Hub) publish(events []database.StockEvent, lastID int64, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.following {
+		return
+	}
+	h.lastID = lastID
+
+	for s := range h.subs {
+		if s.catchUp {
+			continue
+		}
+		if !s.offer(events, now, h.stall) {
+			h.remove(s)
+			s.close(ErrStalled)
+			streamsStalled.Inc()
+		}
+	}
+}
+
+// Batch is the events a subscription has ready for its client
+type Batch struct {
+	Events   []database.StockEvent // oldest first
+	Skipped  int                   // events dropped since the last batch because the buffer was full
+	Position int64                 // resume from here to get the events after this batch
+}
+
+// Subscription is one client's stream of events
+type Subscription struct {
+	hub       *Hub
+	userID    int
+	sessionID int             // 0 for an API key
+	skus      map[string]bool // nil for every SKU
+	size      int
+
+	ready   chan struct{} // signalled when events are pending
+	done    chan struct{} // closed when the subscription is ended
+	once    sync.Once
+	err     error // why it was ended; set before done is closed
+	catchUp bool  // still to be handed the events before the hub's position; guarded by hub.mu
+
+	mu        sync.Mutex
+	pending   []database.StockEvent
+	skipped   int       // events dropped since the last Next
+	position  int64     // newest event offered, whether held, filtered out or dropped
+	fullSince time.Time // when the buffer filled up; zero while there's room
+}
+
+// Ready is signalled when Next has events to return
+func (s *Subscription) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Done is closed when the subscription is ended by the hub, because the
+// client stopped reading for too long, its session was revoked or the hub
+// was closed
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns why the subscription was ended once Done is closed:
+// ErrStalled, ErrRevoked or ErrClosed
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Next takes the pending events
+func (s *Subscription) Next() Batch {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := Batch{Events: s.pending, Skipped: s.skipped, Position: s.position}
+	s.pending, s.skipped = nil, 0
+	s.fullSince = time.Time{}
+	return b
+}
+
+// Position returns the newest event the subscription has been offered
+func (s *Subscription) Position() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.position
+}
+
+// Close ends the subscription
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	s.hub.remove(s)
+	s.hub.mu.Unlock()
+}
+
+// close ends the subscription with err
+func (s *Subscription) close(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+// skip moves the subscription ahead to position, counting the events passed
+// over as one dropped
+func (s *Subscription) skip(position int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if position <= s.position {
+		return
+	}
+	s.position = position
+	s.skipped++
+	streamEventsDropped.Inc()
+	s.notify()
+}
+
+// offer adds the events the subscription wants to its buffer. When the
+// buffer is full an event replaces a held one for the same product and
+// store, since only the newest state matters, and otherwise pushes out the
+// oldest. It returns false if the buffer has been full for longer than stall.
+func (s *Subscription) offer(events []database.StockEvent, now time.Time, stall time.Duration) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	added := false
+	for _, e := range events {
+		if e.ID <= s.position {
+			continue // delivered before the client reconnected
+		}
+		s.position = e.ID
+		if s.skus != nil && !s.skus[e.SKU] {
+			continue
+		}
+		added = true
+		if len(s.pending) < s.size {
+			s.pending = append(s.pending, e)
+			continue
+		}
+
+		if s.fullSince.IsZero() {
+			s.fullSince = now
+		}
+		if i := s.held(e); i >= 0 {
+			s.pending = append(append(s.pending[:i], s.pending[i+1:]...), e)
+			streamEventsMerged.Inc()
+			continue
+		}
+		s.pending = append(s.pending[1:], e)
+		s.skipped++
+		streamEventsDropped.Inc()
+	}
+
+	if added {
+		s.notify()
+	}
+	return s.fullSince.IsZero() || now.Sub(s.fullSince) <= stall
+}
+
+// notify signals Ready without blocking. Callers hold mu.
+func (s *Subscription) notify() {
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// held returns the index of the held event for the same product and store
+// as e, or -1
+func (s *Subscription) held(e database.StockEvent) int {
+	for i, p := range s.pending {
+		if p.SKU == e.SKU && p.StoreID == e.StoreID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,274 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/database"
+)
+
+// fakeLog is an in-memory stock event log
+type fakeLog struct {
+	events []database.StockEvent
+}
+
+func (f *fakeLog) LastStockEventID(ctx context.Context) (int64, error) {
+	if len(f.events) == 0 {
+		return 0, nil
+	}
+	return f.events[len(f.events)-1].ID, nil
+}
+
+func (f *fakeLog) GetStockEvents(ctx context.Context, sku string, afterID int64, limit int) ([]database.StockEvent, error) {
+	var events []database.StockEvent
+	for _, e := range f.events {
+		if e.ID > afterID && (sku == "" || e.SKU == sku) && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (f *fakeLog) append(sku, storeID string) {
+	f.events = append(f.events, database.StockEvent{ID: int64(len(f.events) + 1), SKU: sku, StoreID: storeID})
+}
+
+func ids(events []database.StockEvent) []int64 {
+	var out []int64
+	for _, e := range events {
+		out = append(out, e.ID)
+	}
+	return out
+}
+
+func equal(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHubDeliversNewEvents(t *testing.T) {
+	log := &fakeLog{}
+	log.append("1", "a") // recorded before anyone subscribed
+	hub := NewHub(log, time.Second)
+	ctx := context.Background()
+
+	head, err := hub.Head(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	all, err := hub.Subscribe(1, 0, nil, 0, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	some, err := hub.Subscribe(2, 0, []string{"2"}, 0, head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hub.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	log.append("1", "a")
+	log.append("2", "a")
+	if err := hub.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-all.Ready():
+	default:
+		t.Fatal("subscription wasn't signalled")
+	}
+	if b := all.Next(); !equal(ids(b.Events), []int64{2, 3}) || b.Skipped != 0 || b.Position != 3 {
+		t.Errorf("all got %v, %d skipped, position %d; want [2 3], 0, 3", ids(b.Events), b.Skipped, b.Position)
+	}
+	if b := some.Next(); !equal(ids(b.Events), []int64{3}) || b.Position != 3 {
+		t.Errorf("filtered got %v, position %d; want [3], 3", ids(b.Events), b.Position)
+	}
+}
+
+func TestHubResumesFromPosition(t *testing.T) {
+	log := &fakeLog{}
+	hub := NewHub(log, time.Second)
+	ctx := context.Background()
+
+	live, err := hub.Subscribe(1, 0, nil, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := hub.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	log.append("1", "a")
+	log.append("1", "b")
+	log.append("1", "c")
+	if err := hub.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// A client that saw event 1 before its connection dropped
+	resumed, err := hub.Subscribe(2, 0, nil, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.append("1", "d")
+	if err := hub.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if b := resumed.Next(); !equal(ids(b.Events), []int64{2, 3, 4}) || b.Position != 4 {
+		t.Errorf("resumed got %v, position %d; want [2 3 4], 4", ids(b.Events), b.Position)
+	}
+	if b := live.Next(); !equal(ids(b.Events), []int64{1, 2, 3, 4}) {
+		t.Errorf("live got %v, want [1 2 3 4]", ids(b.Events))
+	}
+}
+
+func TestSubscriptionBuffer(t *testing.T) {
+	hub := NewHub(&fakeLog{}, time.Second)
+	s, err := hub.Subscribe(1, 0, nil, 3, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	events := []database.StockEvent{
+		{ID: 1, SKU: "1", StoreID: "a"},
+		{ID: 2, SKU: "2", StoreID: "a"},
+		{ID: 3, SKU: "3", StoreID: "a"},
+		{ID: 4, SKU: "2", StoreID: "a"}, // replaces 2
+		{ID: 5, SKU: "4", StoreID: "a"}, // pushes out 1
+	}
+	if !s.offer(events, now, time.Minute) {
+		t.Fatal("subscription stalled straight away")
+	}
+
+	b := s.Next()
+	if want := []int64{3, 4, 5}; !equal(ids(b.Events), want) {
+		t.Errorf("got %v, want %v", ids(b.Events), want)
+	}
+	if b.Skipped != 1 {
+		t.Errorf("skipped %d, want 1", b.Skipped)
+	}
+}
+
+func TestSubscriptionStalls(t *testing.T) {
+	hub := NewHub(&fakeLog{}, time.Second)
+	s, err := hub.Subscribe(1, 0, nil, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hub.following, s.catchUp = true, false
+	now := time.Now()
+	event := func(id int64) []database.StockEvent {
+		return []database.StockEvent{{ID: id, SKU: "1", StoreID: "a"}}
+	}
+
+	hub.publish(event(1), 1, now)
+	hub.publish(event(2), 2, now) // fills up
+	hub.publish(event(3), 3, now.Add(hub.stall/2))
+	select {
+	case <-s.Done():
+		t.Fatal("closed before the stall timeout")
+	default:
+	}
+
+	hub.publish(event(4), 4, now.Add(hub.stall+time.Second))
+	select {
+	case <-s.Done():
+	default:
+		t.Fatal("still open after the stall timeout")
+	}
+	if !errors.Is(s.Err(), ErrStalled) {
+		t.Errorf("Err() = %v, want ErrStalled", s.Err())
+	}
+	if len(hub.subs) != 0 {
+		t.Error("stalled subscription wasn't removed")
+	}
+}
+
+func TestSubscribeLimitsStreamsPerUser(t *testing.T) {
+	hub := NewHub(&fakeLog{}, time.Second)
+	var subs []*Subscription
+	for range MaxPerUser {
+		s, err := hub.Subscribe(1, 0, nil, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		subs = append(subs, s)
+	}
+	if _, err := hub.Subscribe(1, 0, nil, 0, 0); !errors.Is(err, ErrTooManyStreams) {
+		t.Fatalf("err = %v, want ErrTooManyStreams", err)
+	}
+	if _, err := hub.Subscribe(2, 0, nil, 0, 0); err != nil {
+		t.Errorf("another user: %v", err)
+	}
+
+	subs[0].Close()
+	if _, err := hub.Subscribe(1, 0, nil, 0, 0); err != nil {
+		t.Errorf("after closing one: %v", err)
+	}
+}
+
+func TestHubClose(t *testing.T) {
+	hub := NewHub(&fakeLog{}, time.Second)
+	s, err := hub.Subscribe(1, 0, nil, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hub.Close()
+	select {
+	case <-s.Done():
+	default:
+		t.Fatal("subscription still open after Close")
+	}
+	if !errors.Is(s.Err(), ErrClosed) {
+		t.Errorf("Err() = %v, want ErrClosed", s.Err())
+	}
+	if _, err := hub.Subscribe(2, 0, nil, 0, 0); !errors.Is(err, ErrClosed) {
+		t.Errorf("Subscribe after Close: err = %v, want ErrClosed", err)
+	}
+}
+
+func TestHubRevoke(t *testing.T) {
+	hub := NewHub(&fakeLog{}, time.Second)
+	subscribe := func(userID, sessionID int) *Subscription {
+		s, err := hub.Subscribe(userID, sessionID, nil, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	revoked, otherSession, apiKey, otherUser := subscribe(1, 10), subscribe(1, 11), subscribe(1, 0), subscribe(2, 20)
+
+	hub.Revoke(1, 10)
+	if !errors.Is(revoked.Err(), ErrRevoked) {
+		t.Errorf("revoked session: Err() = %v, want ErrRevoked", revoked.Err())
+	}
+	for name, s := range map[string]*Subscription{"other session": otherSession, "API key": apiKey, "other user": otherUser} {
+		if s.Err() != nil {
+			t.Errorf("%s ended: %v", name, s.Err())
+		}
+	}
+
+	// Signing out everywhere leaves API key streams open
+	hub.Revoke(1, 0)
+	if !errors.Is(otherSession.Err(), ErrRevoked) {
+		t.Errorf("signing out everywhere: Err() = %v, want ErrRevoked", otherSession.Err())
+	}
+	if apiKey.Err() != nil || otherUser.Err() != nil {
+		t.Errorf("signing out everywhere ended other streams: %v, %v", apiKey.Err(), otherUser.Err())
+	}
+	if len(hub.subs) != 2 {
+		t.Errorf("%d subscriptions left, want 2", len(hub.subs))
+	}
+}
@@ -0,0 +1,311 @@
+// Package target is a client for Target's RedSky aggregation API, the JSON API
+// behind target.com's store locator, search and "check nearby stores" pages.
+package target
+
+import (
+	"context"
+	"errors"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+// Client is the interface for Target API operations
+type Client interface {
+	// SearchStores searches for stores near a postal code within a radius
+	SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error)
+
+	// SearchProducts searches for products by keyword, optionally within a category
+	SearchProducts(ctx context.Context, query string, category string) ([]Product, error)
+
+	// GetProduct gets a single product by its TCIN
+	GetProduct(ctx context.Context, tcin string) (*Product, error)
+
+	// CheckAvailability returns the stores near a postal code that can sell the product today
+	CheckAvailability(ctx context.Context, tcin string, postalCode string) ([]StoreAvailability, error)
+}
+
+// Store is a Target store
+type Store struct {
+	StoreID    string
+	Name       string
+	Address    string
+	City       string
+	State      string
+	PostalCode string
+	Phone      string
+	Distance   float64
+}
+
+// Product is a Target product, identified by its TCIN (Target's item number)
+type Product struct {
+	TCIN     string
+	Name     string
+	Price    money.Cents
+	ImageURL string
+	URL      string
+}
+
+// StoreAvailability is a product's stock at one store
+type StoreAvailability struct {
+	StoreID        string
+	StoreName      string
+	City           string
+	State          string
+	Distance       float64
+	Quantity       int
+	InStock        bool
+	LowStock       bool
+	PickupEligible bool
+}
+
+// Availability statuses reported for pickup and in-store purchase
+const (
+	statusInStock      = "IN_STOCK"
+	statusLimitedStock = "LIMITED_STOCK"
+)
+
+// Defaults
+const (
+	defaultRadiusMiles = 25
+	availabilityRadius = 50 // miles searched for stock, Target's maximum
+	searchPageSize     = 24
+)
+
+// APIClient is the real Target API client
+type APIClient struct {
+	apiKey  atomic.Pointer[string] // replaced by SetAPIKey when the key is rotated
+	baseURL string
+	api     *webapi.Client
+}
+
+// NewAPIClient creates a Target API client. apiKey is the public key
+// target.com sends with its own RedSky requests.
+func NewAPIClient(apiKey string, userAgent string) *APIClient {
+	c := &APIClient{
+		baseURL: "https://redsky.target.com/redsky_aggregations/v1",
+		api:     webapi.New("Target", userAgent, 500*time.Millisecond), // the API is unofficial, so stay well clear of its limits
+	}
+	c.api.RetryBaseWait = time.Second
+	c.SetAPIKey(apiKey)
+	return c
+}
+
+// SetAPIKey replaces the API key, e.g. when target.com starts sending a new one
+func (c *APIClient) SetAPIKey(key string) {
+	c.apiKey.Store(&key)
+}
+
+// notFound is the error for a TCIN the API doesn't have
+func notFound(tcin string) error {
+	return &webapi.NotFoundError{API: "Target", ID: tcin}
+}
+
+// get requests an aggregation endpoint and decodes its JSON response into v
+func (c *APIClient) get(ctx context.Context, endpoint string, params url.Values, v any) error {
+	params.Set("key", *c.apiKey.Load())
+	params.Set("channel", "WEB")
+	return c.api.Get(ctx, c.baseURL+"/web/"+endpoint+"?"+params.Encode(), nil, v)
+}
+
+// apiAddress is a mailing address in API responses
+type apiAddress struct {
+	AddressLine1 string `json:"address_line1"`
+	City         string `json:"city"`
+	Region       string `json:"region"`
+	PostalCode   string `json:"postal_code"`
+}
+
+// apiItem is the item section of product responses
+type apiItem struct {
+	ProductDescription struct {
+		Title string `json:"title"`
+	} `json:"product_description"`
+	Enrichment struct {
+		BuyURL string `json:"buy_url"`
+		Images struct {
+			PrimaryImageURL string `json:"primary_image_url"`
+		} `json:"images"`
+	} `json:"enrichment"`
+}
+
+// apiProduct is a product in search and product responses
+type apiProduct struct {
+	TCIN  string  `json:"tcin"`
+	Item  apiItem `json:"item"`
+	Price struct {
+		CurrentRetail money.Cents `json:"current_retail"`
+	} `json:"price"`
+}
+
+// product converts an API product
+func (p apiProduct) product() Product {
+	return Product{
+		TCIN:     p.TCIN,
+		Name:     html.UnescapeString(p.Item.ProductDescription.Title), // titles come HTML-escaped
+		Price:    p.Price.CurrentRetail,
+		ImageURL: p.Item.Enrichment.Images.PrimaryImageURL,
+		URL:      p.Item.Enrichment.BuyURL,
+	}
+}
+
+// SearchStores searches for stores near a postal code within a radius
+func (c *APIClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	if radiusMiles <= 0 {
+		radiusMiles = defaultRadiusMiles
+	}
+
+	var resp struct {
+		Data struct {
+			NearbyStores struct {
+				Stores []struct {
+					StoreID              string     `json:"store_id"`
+					LocationName         string     `json:"location_name"`
+					MailingAddress       apiAddress `json:"mailing_address"`
+					MainVoicePhoneNumber string     `json:"main_voice_phone_number"`
+					Distance             float64    `json:"distance"`
+				} `json:"stores"`
+			} `json:"nearby_stores"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "nearby_stores_v1", url.Values{
+		"place":  {postalCode},
+		"within": {strconv.Itoa(radiusMiles)},
+		"limit":  {"20"},
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	stores := make([]Store, 0, len(resp.Data.NearbyStores.Stores))
+	for _, s := range resp.Data.NearbyStores.Stores {
+		stores = append(stores, Store{
+			StoreID:    s.StoreID,
+			Name:       s.LocationName,
+			Address:    s.MailingAddress.AddressLine1,
+			City:       s.MailingAddress.City,
+			State:      s.MailingAddress.Region,
+			PostalCode: s.MailingAddress.PostalCode,
+			Phone:      s.MainVoicePhoneNumber,
+			Distance:   s.Distance,
+		})
+	}
+	return stores, nil
+}
+
+// SearchProducts searches for products by keyword, optionally within a category
+func (c *APIClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	params := url.Values{
+		"keyword": {query},
+		"count":   {strconv.Itoa(searchPageSize)},
+		"offset":  {"0"},
+		"page":    {"/s/" + query},
+	}
+	if category != "" {
+		params.Set("category", category)
+	}
+
+	var resp struct {
+		Data struct {
+			Search struct {
+				Products []apiProduct `json:"products"`
+			} `json:"search"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "plp_search_v2", params, &resp); err != nil {
+		return nil, err
+	}
+
+	products := make([]Product, 0, len(resp.Data.Search.Products))
+	for _, p := range resp.Data.Search.Products {
+		products = append(products, p.product())
+	}
+	return products, nil
+}
+
+// GetProduct gets a single product by its TCIN
+func (c *APIClient) GetProduct(ctx context.Context, tcin string) (*Product, error) {
+	var resp struct {
+		Data struct {
+			Product *apiProduct `json:"product"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "pdp_client_v1", url.Values{"tcin": {tcin}}, &resp); err != nil {
+		var statusErr *webapi.StatusError
+		if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+			return nil, notFound(tcin)
+		}
+		return nil, err
+	}
+	if resp.Data.Product == nil {
+		return nil, notFound(tcin)
+	}
+
+	product := resp.Data.Product.product()
+	return &product, nil
+}
+
+// CheckAvailability returns the stores near a postal code that can sell the
+// product today, for pickup or in store
+func (c *APIClient) CheckAvailability(ctx context.Context, tcin string, postalCode string) ([]StoreAvailability, error) {
+	var resp struct {
+		Data struct {
+			FulfillmentFiats struct {
+				Locations []struct {
+					Store struct {
+						StoreID        string     `json:"store_id"`
+						LocationName   string     `json:"location_name"`
+						MailingAddress apiAddress `json:"mailing_address"`
+					} `json:"store"`
+					Distance    float64 `json:"distance"`
+					Quantity    float64 `json:"location_available_to_promise_quantity"`
+					OrderPickup struct {
+						AvailabilityStatus string `json:"availability_status"`
+					} `json:"order_pickup"`
+					InStoreOnly struct {
+						AvailabilityStatus string `json:"availability_status"`
+					} `json:"in_store_only"`
+				} `json:"locations"`
+			} `json:"fulfillment_fiats"`
+		} `json:"data"`
+	}
+	if err := c.get(ctx, "fiats_v1", url.Values{
+		"tcin":                          {tcin},
+		"nearby":                        {postalCode},
+		"radius":                        {strconv.Itoa(availabilityRadius)},
+		"limit":                         {"20"},
+		"include_only_available_stores": {"true"},
+		"requested_quantity":            {"1"},
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	var availability []StoreAvailability
+	for _, l := range resp.Data.FulfillmentFiats.Locations {
+		pickup := l.OrderPickup.AvailabilityStatus
+		inStore := l.InStoreOnly.AvailabilityStatus
+		inStock := pickup == statusInStock || pickup == statusLimitedStock ||
+			inStore == statusInStock || inStore == statusLimitedStock
+		if !inStock {
+			continue
+		}
+
+		availability = append(availability, StoreAvailability{
+			StoreID:        l.Store.StoreID,
+			StoreName:      l.Store.LocationName,
+			City:           l.Store.MailingAddress.City,
+			State:          l.Store.MailingAddress.Region,
+			Distance:       l.Distance,
+			Quantity:       int(l.Quantity),
+			InStock:        true,
+			LowStock:       pickup == statusLimitedStock || inStore == statusLimitedStock,
+			PickupEligible: pickup == statusInStock || pickup == statusLimitedStock,
+		})
+	}
+	return availability, nil
+}
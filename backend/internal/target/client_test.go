@@ -0,0 +1,118 @@
+package target
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/webapi"
+)
+
+// newTestClient returns an APIClient whose requests to each endpoint are
+// answered with the contents of the mapped testdata file
+func newTestClient(t *testing.T, files map[string]string) *APIClient {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("key") != "test-key" {
+			t.Errorf("request without the API key: %s", r.URL)
+		}
+		file, ok := files[strings.TrimPrefix(r.URL.Path, "/web/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		body, err := os.ReadFile(filepath.Join("testdata", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := NewAPIClient("test-key", "")
+	c.baseURL = srv.URL
+	c.api.MinInterval = 0
+	c.api.MaxRetries = 1
+	c.api.RetryBaseWait = time.Millisecond
+	return c
+}
+
+func TestSearchStores(t *testing.T) {
+	c := newTestClient(t, map[string]string{"nearby_stores_v1": "nearby_stores.json"})
+
+	stores, err := c.SearchStores(context.Background(), "94103", 25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stores) != 2 {
+		t.Fatalf("got %d stores, want 2", len(stores))
+	}
+	want := Store{StoreID: "2766", Name: "San Francisco Geary", Address: "2675 Geary Blvd", City: "San Francisco", State: "CA", PostalCode: "94118-3400", Phone: "415-343-6272", Distance: 1.4}
+	if stores[0] != want {
+		t.Errorf("store = %+v, want %+v", stores[0], want)
+	}
+}
+
+func TestGetProduct(t *testing.T) {
+	c := newTestClient(t, map[string]string{"pdp_client_v1": "product.json"})
+
+	p, err := c.GetProduct(context.Background(), "93954435")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Pokémon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box" {
+		t.Errorf("name = %q, want HTML entities decoded", p.Name)
+	}
+	if p.Price != 4999 || p.URL != "https://www.target.com/p/-/A-93954435" {
+		t.Errorf("product = %+v", p)
+	}
+}
+
+func TestGetProductNotFound(t *testing.T) {
+	c := newTestClient(t, map[string]string{"pdp_client_v1": "product_missing.json"})
+
+	_, err := c.GetProduct(context.Background(), "1")
+	var notFound *webapi.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("err = %v, want NotFoundError", err)
+	}
+}
+
+func TestSearchProducts(t *testing.T) {
+	c := newTestClient(t, map[string]string{"plp_search_v2": "search.json"})
+
+	products, err := c.SearchProducts(context.Background(), "pokemon", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(products) != 2 || products[1].TCIN != "91619922" || products[1].Price != 2699 {
+		t.Errorf("products = %+v", products)
+	}
+}
+
+func TestCheckAvailability(t *testing.T) {
+	c := newTestClient(t, map[string]string{"fiats_v1": "fiats.json"})
+
+	availability, err := c.CheckAvailability(context.Background(), "93954435", "94103")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(availability) != 2 {
+		t.Fatalf("got %d stores, want 2 (sold-out store dropped)", len(availability))
+	}
+
+	if a := availability[0]; !a.InStock || a.LowStock || !a.PickupEligible || a.Quantity != 7 {
+		t.Errorf("in stock store = %+v", a)
+	}
+	// In-store only with limited stock: can be bought, but not picked up
+	if a := availability[1]; !a.InStock || !a.LowStock || a.PickupEligible || a.City != "Colma" {
+		t.Errorf("limited store = %+v", a)
+	}
+}
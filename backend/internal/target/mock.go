@@ -0,0 +1,131 @@
+package target
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// mockStores are Bay Area Target stores
+var mockStores = []Store{
+	{StoreID: "2766", Name: "Target - San Francisco Mission St", Address: "2675 Geary Blvd", City: "San Francisco", State: "CA", PostalCode: "94118", Phone: "(415) 343-6272"},
+	{StoreID: "1497", Name: "Target - Colma", Address: "5001 Junipero Serra Blvd", City: "Colma", State: "CA", PostalCode: "94014", Phone: "(650) 992-8433"},
+	{StoreID: "2127", Name: "Target - Emeryville", Address: "1555 40th St", City: "Emeryville", State: "CA", PostalCode: "94608", Phone: "(510) 285-1620"},
+	{StoreID: "3240", Name: "Target - Daly City Serramonte", Address: "133 Serramonte Center", City: "Daly City", State: "CA", PostalCode: "94015", Phone: "(650) 550-2037"},
+}
+
+// mockProducts are Pokemon TCG products Target carries
+var mockProducts = []Product{
+	{TCIN: "93954435", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box", Price: 4999, URL: "https://www.target.com/p/-/A-93954435"},
+	{TCIN: "93954446", Name: "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Surprise Box", Price: 2499, URL: "https://www.target.com/p/-/A-93954446"},
+	{TCIN: "91619922", Name: "Pokemon Trading Card Game: Surging Sparks Booster Bundle", Price: 2699, URL: "https://www.target.com/p/-/A-91619922"},
+	{TCIN: "88897899", Name: "Pokemon Trading Card Game: Scarlet & Violet 151 Ultra Premium Collection", Price: 11999, URL: "https://www.target.com/p/-/A-88897899"},
+}
+
+// MockClient serves fixed Target data with deterministic availability, for
+// local development without calling Target
+type MockClient struct {
+	latency time.Duration
+}
+
+// NewMockClient creates a mock Target client
+func NewMockClient() *MockClient {
+	return &MockClient{latency: 100 * time.Millisecond} // Simulate 100ms API latency
+}
+
+// simulateLatency adds a small delay to simulate network latency
+func (c *MockClient) simulateLatency(ctx context.Context) error {
+	select {
+	case <-time.After(c.latency):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SearchStores returns every mock store with a random distance within the radius
+func (c *MockClient) SearchStores(ctx context.Context, postalCode string, radiusMiles int) ([]Store, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+	if radiusMiles <= 0 {
+		radiusMiles = defaultRadiusMiles
+	}
+
+	stores := make([]Store, len(mockStores))
+	for i, store := range mockStores {
+		stores[i] = store
+		stores[i].Distance = float64(rand.Intn(radiusMiles)) + rand.Float64()
+	}
+	return stores, nil
+}
+
+// SearchProducts returns mock products whose name or TCIN matches the query
+func (c *MockClient) SearchProducts(ctx context.Context, query string, category string) ([]Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	queryLower := strings.ToLower(query)
+	var results []Product
+	for _, p := range mockProducts {
+		if query == "" || strings.Contains(strings.ToLower(p.Name), queryLower) || strings.Contains(p.TCIN, queryLower) {
+			results = append(results, p)
+		}
+	}
+	return results, nil
+}
+
+// GetProduct gets a mock product by TCIN
+func (c *MockClient) GetProduct(ctx context.Context, tcin string) (*Product, error) {
+	if err := c.simulateLatency(ctx); err != nil {
+		return nil, err
+	}
+
+	for _, p := range mockProducts {
+		if p.TCIN == tcin {
+			return &p, nil
+		}
+	}
+	return nil, notFound(tcin)
+}
+
+// CheckAvailability returns the mock stores that have the product, seeded by
+// store and TCIN so repeated checks give the same answer
+func (c *MockClient) CheckAvailability(ctx context.Context, tcin string, postalCode string) ([]StoreAvailability, error) {
+	if _, err := c.GetProduct(ctx, tcin); err != nil {
+		return nil, err
+	}
+
+	var availability []StoreAvailability
+	for i, store := range mockStores {
+		seed := int64(0)
+		for _, ch := range "target" + store.StoreID + tcin {
+			seed += int64(ch)
+		}
+		roll := rand.New(rand.NewSource(seed)).Float64()
+
+		// 60% in stock, a third of which is low stock
+		if roll >= 0.6 {
+			continue
+		}
+		lowStock := roll >= 0.4
+		quantity := 6
+		if lowStock {
+			quantity = 2
+		}
+		availability = append(availability, StoreAvailability{
+			StoreID:        store.StoreID,
+			StoreName:      store.Name,
+			City:           store.City,
+			State:          store.State,
+			Distance:       float64(3 + 4*i),
+			Quantity:       quantity,
+			InStock:        true,
+			LowStock:       lowStock,
+			PickupEligible: true,
+		})
+	}
+	return availability, nil
+}
@@ -0,0 +1,96 @@
+package tcg
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tmcauley/stock-checker/backend/internal/money"
+)
+
+// msrpRefresh is how often MSRPs are reloaded, so changes reach every process
+const msrpRefresh = 5 * time.Minute
+
+// MSRP is the manufacturer's suggested retail price, in US cents, of a
+// product type in one set, or in every set if Set is empty
+type MSRP struct {
+	Set   string
+	Type  ProductType
+	Price money.Cents
+}
+
+// MSRPStore provides the maintained MSRPs
+type MSRPStore interface {
+	GetMSRPs(ctx context.Context) ([]MSRP, error)
+}
+
+// msrpKey identifies an MSRP; set is lower-cased, empty for every set
+type msrpKey struct {
+	set string
+	t   ProductType
+}
+
+// MSRPs looks up the MSRP of products by name. A set's own price wins over
+// the price for its product type in every set.
+type MSRPs struct {
+	store MSRPStore
+
+	mu       sync.Mutex
+	prices   map[msrpKey]money.Cents
+	loadedAt time.Time
+}
+
+// NewMSRPs creates an MSRP lookup loading prices from store
+func NewMSRPs(store MSRPStore) *MSRPs {
+	return &MSRPs{store: store}
+}
+
+// Invalidate makes the next lookup reload prices, after they change
+func (m *MSRPs) Invalidate() {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.loadedAt = time.Time{}
+}
+
+// Lookup returns the MSRP of a product, or 0 if it's unknown. Failing to load
+// prices is only logged; products just go unflagged.
+func (m *MSRPs) Lookup(ctx context.Context, name string) money.Cents {
+	if m == nil {
+		return 0
+	}
+	info := Parse(name)
+	if info.Type == TypeUnknown {
+		return 0
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if time.Since(m.loadedAt) > msrpRefresh {
+		msrps, err := m.store.GetMSRPs(ctx)
+		if err != nil {
+			log.Printf("Failed to load MSRPs: %v", err)
+		} else {
+			m.prices = make(map[msrpKey]money.Cents, len(msrps))
+			for _, p := range msrps {
+				m.prices[msrpKey{strings.ToLower(p.Set), p.Type}] = p.Price
+			}
+			m.loadedAt = time.Now()
+		}
+	}
+
+	if price, ok := m.prices[msrpKey{strings.ToLower(info.Set), info.Type}]; ok && info.Set != "" {
+		return price
+	}
+	return m.prices[msrpKey{"", info.Type}]
+}
+
+// AboveMSRP reports whether a price is marked up over a known MSRP
+func AboveMSRP(price, msrp money.Cents) bool {
+	return msrp > 0 && price > msrp
+}
@@ -0,0 +1,40 @@
+package tcg
+
+import (
+	"context"
+	"testing"
+)
+
+// msrpList is a fixed MSRPStore
+type msrpList []MSRP
+
+func (l msrpList) GetMSRPs(ctx context.Context) ([]MSRP, error) {
+	return l, nil
+}
+
+func TestMSRPsPreferSetPrice(t *testing.T) {
+	m := NewMSRPs(msrpList{
+		{Type: TypeEliteTrainerBox, Price: 4999},
+		{Set: "Prismatic Evolutions", Type: TypeEliteTrainerBox, Price: 5499},
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		want int64
+	}{
+		{"Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box", 5499},
+		{"Pokemon Trading Card Game: Surging Sparks Elite Trainer Box", 4999},
+		{"Pokemon Trading Card Game: Surging Sparks Booster Bundle", 0},
+		{"Nintendo Switch 2", 0},
+	}
+	for _, tt := range tests {
+		if got := m.Lookup(ctx, tt.name); int64(got) != tt.want {
+			t.Errorf("Lookup(%q) = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	if !AboveMSRP(8999, 4999) || AboveMSRP(4999, 4999) || AboveMSRP(8999, 0) {
+		t.Error("only prices above a known MSRP should be flagged")
+	}
+}
@@ -0,0 +1,177 @@
+// Package tcg reads set and product type out of trading card product names,
+// such as "Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions
+// Elite Trainer Box", so products can be grouped and watched by set.
+package tcg
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ProductType is the kind of sealed product
+type ProductType string
+
+// Product types
+const (
+	TypeUnknown         ProductType = ""
+	TypeEliteTrainerBox ProductType = "elite_trainer_box"
+	TypeBoosterBundle   ProductType = "booster_bundle"
+	TypeBoosterBox      ProductType = "booster_box"
+	TypeBoosterPack     ProductType = "booster_pack"
+	TypeTin             ProductType = "tin"
+	TypeCollection      ProductType = "collection"
+	TypeBlister         ProductType = "blister"
+)
+
+// Info is what a product name says about the product
+type Info struct {
+	Series string      // e.g. "Scarlet & Violet"; empty if not named
+	Set    string      // e.g. "Prismatic Evolutions"; empty if not found
+	Type   ProductType // TypeUnknown if not found
+}
+
+// typePhrases map the words naming a product type to it, longest first so
+// "booster bundle" wins over "booster"
+var typePhrases = []struct {
+	words []string
+	t     ProductType
+}{
+	{[]string{"ultra", "premium", "collection"}, TypeCollection},
+	{[]string{"super", "premium", "collection"}, TypeCollection},
+	{[]string{"elite", "trainer", "box"}, TypeEliteTrainerBox},
+	{[]string{"sleeved", "booster", "pack"}, TypeBoosterPack},
+	{[]string{"premium", "collection"}, TypeCollection},
+	{[]string{"booster", "bundle"}, TypeBoosterBundle},
+	{[]string{"booster", "display"}, TypeBoosterBox},
+	{[]string{"booster", "box"}, TypeBoosterBox},
+	{[]string{"booster", "pack"}, TypeBoosterPack},
+	{[]string{"mini", "tin"}, TypeTin},
+	{[]string{"etb"}, TypeEliteTrainerBox},
+	{[]string{"blister"}, TypeBlister},
+	{[]string{"collection"}, TypeCollection},
+	{[]string{"tin"}, TypeTin},
+	{[]string{"booster"}, TypeBoosterPack},
+}
+
+// series are the names of Pokemon TCG series, as their set names are prefixed
+var series = []struct {
+	words []string
+	name  string
+}{
+	{[]string{"scarlet", "&", "violet"}, "Scarlet & Violet"},
+	{[]string{"scarlet", "and", "violet"}, "Scarlet & Violet"},
+	{[]string{"sword", "&", "shield"}, "Sword & Shield"},
+	{[]string{"sword", "and", "shield"}, "Sword & Shield"},
+	{[]string{"sun", "&", "moon"}, "Sun & Moon"},
+	{[]string{"sun", "and", "moon"}, "Sun & Moon"},
+	{[]string{"mega", "evolution"}, "Mega Evolution"},
+}
+
+// noiseWords name the brand or retailer rather than the set
+var noiseWords = map[string]bool{
+	"pokemon": true, "trading": true, "card": true, "cards": true, "game": true, "tcg": true,
+	"center": true, "exclusive": true, "the": true,
+}
+
+// word is a word of a product name, as written and folded for matching
+type word struct {
+	text   string
+	folded string
+}
+
+// split breaks a name into words, treating punctuation other than "&" as a separator
+func split(name string) []word {
+	var words []word
+	for _, text := range strings.FieldsFunc(name, func(r rune) bool {
+		return r != '&' && !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	}) {
+		folded := strings.Map(func(r rune) rune {
+			switch r {
+			case 'é', 'É':
+				return 'e'
+			}
+			return unicode.ToLower(r)
+		}, text)
+		words = append(words, word{text: text, folded: folded})
+	}
+	return words
+}
+
+// matchAt reports whether phrase starts at words[i]
+func matchAt(words []word, i int, phrase []string) bool {
+	if i+len(phrase) > len(words) {
+		return false
+	}
+	for j, p := range phrase {
+		if words[i+j].folded != p {
+			return false
+		}
+	}
+	return true
+}
+
+// isCount reports whether words[i] starts a pack count like "3 Pack"
+func isCount(words []word, i int) bool {
+	if i+1 >= len(words) || (words[i+1].folded != "pack" && words[i+1].folded != "packs") {
+		return false
+	}
+	for _, r := range words[i].folded {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse reads the series, set and product type from a product name. The set
+// is whatever the name says before the product type, less the brand and
+// series. Names without either give an empty Info.
+func Parse(name string) Info {
+	words := split(name)
+	var info Info
+
+	// The first product type phrase ends the set name
+	end := len(words)
+types:
+	for i := range words {
+		for _, p := range typePhrases {
+			if matchAt(words, i, p.words) {
+				info.Type, end = p.t, i
+				break types
+			}
+		}
+	}
+
+	var set []string
+	for i := 0; i < end; i++ {
+		matched := false
+		for _, s := range series {
+			if matchAt(words, i, s.words) {
+				info.Series, matched = s.name, true
+				i += len(s.words) - 1
+				break
+			}
+		}
+		if matched || noiseWords[words[i].folded] {
+			continue
+		}
+		// Pack counts such as "3-Pack" describe the packaging
+		if isCount(words, i) {
+			i++
+			continue
+		}
+		set = append(set, words[i].text)
+	}
+	// Without a product type or series this isn't a sealed TCG product
+	if info.Type == TypeUnknown && info.Series == "" {
+		return Info{}
+	}
+	// A leftover "&" joins nothing once the series is gone
+	info.Set = strings.Trim(strings.Join(set, " "), "& ")
+	return info
+}
+
+// SameSet reports whether two set names are the same set
+func SameSet(a, b string) bool {
+	return a != "" && strings.EqualFold(a, b)
+}
@@ -0,0 +1,34 @@
+package tcg
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		want Info
+	}{
+		{"Pokemon Trading Card Game: Scarlet & Violet Prismatic Evolutions Elite Trainer Box", Info{"Scarlet & Violet", "Prismatic Evolutions", TypeEliteTrainerBox}},
+		{"Pokémon TCG: Scarlet & Violet—Prismatic Evolutions Booster Bundle", Info{"Scarlet & Violet", "Prismatic Evolutions", TypeBoosterBundle}},
+		{"Pokemon Trading Card Game: Scarlet & Violet 151 Ultra Premium Collection", Info{"Scarlet & Violet", "151", TypeCollection}},
+		{"Pokemon Trading Card Game: Surging Sparks Booster Pack", Info{"", "Surging Sparks", TypeBoosterPack}},
+		{"Pokemon Center Exclusive Surging Sparks Elite Trainer Box", Info{"", "Surging Sparks", TypeEliteTrainerBox}},
+		{"Pokemon TCG: Sword and Shield Lost Origin Booster Display Box (36 Packs)", Info{"Sword & Shield", "Lost Origin", TypeBoosterBox}},
+		{"Pokemon Trading Card Game: Paldean Fates Tech Sticker Collection", Info{"", "Paldean Fates Tech Sticker", TypeCollection}},
+		{"Pokemon Trading Card Game: Stellar Crown 3-Pack Blister", Info{"", "Stellar Crown", TypeBlister}},
+		{"Nintendo Switch 2", Info{}},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.name); got != tt.want {
+			t.Errorf("Parse(%q) = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSameSet(t *testing.T) {
+	if !SameSet("Prismatic Evolutions", "prismatic evolutions") {
+		t.Error("set names should match regardless of case")
+	}
+	if SameSet("", "") {
+		t.Error("unknown sets should never match")
+	}
+}
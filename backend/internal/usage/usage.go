@@ -0,0 +1,149 @@
+// Package usage counts what each user's account uses day by day: stock
+// checks they asked for, checks the watcher ran for them and notifications
+// sent to them. Users see the counts next to the refresh button, so a
+// throttled check can be explained.
+package usage
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// Recorder defaults
+const (
+	flushInterval = 30 * time.Second    // how often counts are saved
+	Retention     = 90 * 24 * time.Hour // how long daily counts are kept
+)
+
+// Kind is a kind of usage
+type Kind int
+
+const (
+	InteractiveCheck Kind = iota // a SKU checked because the user asked
+	BackgroundCheck              // a SKU the watcher checked for the user
+	Notification                 // a message sent over one of the user's channels
+)
+
+// Counts are a user's usage over some period
+type Counts struct {
+	InteractiveChecks int
+	BackgroundChecks  int
+	Notifications     int
+}
+
+// add adds n of kind
+func (c *Counts) add(kind Kind, n int) {
+	switch kind {
+	case InteractiveCheck:
+		c.InteractiveChecks += n
+	case BackgroundCheck:
+		c.BackgroundChecks += n
+	case Notification:
+		c.Notifications += n
+	}
+}
+
+// Plus returns the sum of c and o
+func (c Counts) Plus(o Counts) Counts {
+	return Counts{
+		InteractiveChecks: c.InteractiveChecks + o.InteractiveChecks,
+		BackgroundChecks:  c.BackgroundChecks + o.BackgroundChecks,
+		Notifications:     c.Notifications + o.Notifications,
+	}
+}
+
+// Day is a user's usage on one UTC day
+type Day struct {
+	UserID int
+	Day    time.Time
+	Counts
+}
+
+// Store saves daily counts
+type Store interface {
+	// AddUserUsage adds each day's counts to what is stored for it
+	AddUserUsage(ctx context.Context, days []Day) error
+	// DeleteUserUsageBefore deletes counts for days before t
+	DeleteUserUsageBefore(ctx context.Context, t time.Time) error
+}
+
+// dayKey identifies one user's counts for one day
+type dayKey struct {
+	userID int
+	day    time.Time
+}
+
+// Recorder counts usage in memory and saves it in batches, so counting
+// never waits on the database. A nil Recorder counts nothing.
+type Recorder struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	pending map[dayKey]Counts
+}
+
+// NewRecorder creates a Recorder
+func NewRecorder() *Recorder {
+	return &Recorder{now: time.Now, pending: make(map[dayKey]Counts)}
+}
+
+// Add counts n of kind for a user today
+func (r *Recorder) Add(userID int, kind Kind, n int) {
+	if r == nil || userID == 0 || n <= 0 {
+		return
+	}
+	key := dayKey{userID: userID, day: r.now().UTC().Truncate(24 * time.Hour)}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c := r.pending[key]
+	c.add(kind, n)
+	r.pending[key] = c
+}
+
+// Run saves counts to store every 30 seconds and deletes those older than
+// 90 days, until ctx is done
+func (r *Recorder) Run(ctx context.Context, store Store) {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.flush(ctx, store)
+		case <-ctx.Done():
+			// Save what's been counted since the last flush
+			r.flush(context.WithoutCancel(ctx), store)
+			return
+		}
+	}
+}
+
+// flush saves the pending counts and prunes old ones. Counts that fail to
+// save are kept for the next flush.
+func (r *Recorder) flush(ctx context.Context, store Store) {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[dayKey]Counts)
+	r.mu.Unlock()
+
+	if len(pending) > 0 {
+		days := make([]Day, 0, len(pending))
+		for key, c := range pending {
+			days = append(days, Day{UserID: key.userID, Day: key.day, Counts: c})
+		}
+		if err := store.AddUserUsage(ctx, days); err != nil {
+			log.Printf("Failed to save user usage: %v", err)
+			r.mu.Lock()
+			for key, c := range pending {
+				r.pending[key] = r.pending[key].Plus(c)
+			}
+			r.mu.Unlock()
+		}
+	}
+	if err := store.DeleteUserUsageBefore(ctx, r.now().Add(-Retention)); err != nil {
+		log.Printf("Failed to prune user usage: %v", err)
+	}
+}
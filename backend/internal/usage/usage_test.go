@@ -0,0 +1,86 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// memoryStore keeps saved counts in memory
+type memoryStore struct {
+	days map[dayKey]Counts
+	err  error
+}
+
+func (s *memoryStore) AddUserUsage(ctx context.Context, days []Day) error {
+	if s.err != nil {
+		return s.err
+	}
+	for _, d := range days {
+		key := dayKey{d.UserID, d.Day}
+		s.days[key] = s.days[key].Plus(d.Counts)
+	}
+	return nil
+}
+
+func (s *memoryStore) DeleteUserUsageBefore(ctx context.Context, t time.Time) error {
+	return nil
+}
+
+func TestRecorderCountsPerUserAndDay(t *testing.T) {
+	now := time.Date(2026, time.October, 16, 23, 59, 0, 0, time.UTC)
+	r := NewRecorder()
+	r.now = func() time.Time { return now }
+
+	r.Add(1, InteractiveCheck, 3)
+	r.Add(1, BackgroundCheck, 1)
+	r.Add(2, Notification, 1)
+	r.Add(0, InteractiveCheck, 1) // signed out
+	now = now.Add(2 * time.Minute)
+	r.Add(1, InteractiveCheck, 1)
+
+	store := &memoryStore{days: make(map[dayKey]Counts)}
+	r.flush(context.Background(), store)
+
+	oct16 := time.Date(2026, time.October, 16, 0, 0, 0, 0, time.UTC)
+	oct17 := oct16.AddDate(0, 0, 1)
+	want := map[dayKey]Counts{
+		{1, oct16}: {InteractiveChecks: 3, BackgroundChecks: 1},
+		{1, oct17}: {InteractiveChecks: 1},
+		{2, oct16}: {Notifications: 1},
+	}
+	if len(store.days) != len(want) {
+		t.Fatalf("saved %v, want %v", store.days, want)
+	}
+	for key, c := range want {
+		if store.days[key] != c {
+			t.Errorf("user %d on %s: %+v, want %+v", key.userID, key.day.Format(time.DateOnly), store.days[key], c)
+		}
+	}
+}
+
+func TestRecorderKeepsCountsThatFailToSave(t *testing.T) {
+	r := NewRecorder()
+	r.Add(1, Notification, 2)
+
+	store := &memoryStore{days: make(map[dayKey]Counts), err: errors.New("database down")}
+	r.flush(context.Background(), store)
+	r.Add(1, Notification, 1)
+
+	store.err = nil
+	r.flush(context.Background(), store)
+	for _, c := range store.days {
+		if c.Notifications != 3 {
+			t.Errorf("saved %d notifications, want 3", c.Notifications)
+		}
+	}
+	if len(store.days) != 1 {
+		t.Errorf("saved %d days, want 1", len(store.days))
+	}
+}
+
+func TestNilRecorder(t *testing.T) {
+	var r *Recorder
+	r.Add(1, InteractiveCheck, 1) // must not panic
+}
@@ -0,0 +1,142 @@
+// Package webapi makes the JSON requests behind the retailer and catalog
+// clients: spaced out so unofficial APIs don't block us, retried with backoff
+// when rate limited or on server errors, and failing with typed errors.
+package webapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultUserAgent identifies the app on outbound API requests when no user agent is configured
+const DefaultUserAgent = "stock-checker/dev (+https://github.com/tmcauley/stock-checker)"
+
+// StatusError is returned when an API answers with an unexpected status
+type StatusError struct {
+	API        string // e.g. "Target"
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s API returned status %d: %s", e.API, e.StatusCode, e.Body)
+}
+
+// NotFoundError is returned when an API has no such product
+type NotFoundError struct {
+	API string
+	ID  string // the retailer's product ID, e.g. a Target TCIN
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s product not found: %s", e.API, e.ID)
+}
+
+// Client requests one API
+type Client struct {
+	API           string // names the API in errors
+	UserAgent     string
+	HTTPClient    *http.Client
+	MinInterval   time.Duration // between requests; zero doesn't wait
+	MaxRetries    int
+	RetryBaseWait time.Duration // doubled on each retry
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+// New creates a client for api that waits minInterval between requests,
+// with userAgent (DefaultUserAgent if empty) and a 30 second timeout
+func New(api string, userAgent string, minInterval time.Duration) *Client {
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+	return &Client{
+		API:       api,
+		UserAgent: userAgent,
+		HTTPClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		MinInterval:   minInterval,
+		MaxRetries:    3,
+		RetryBaseWait: 2 * time.Second,
+	}
+}
+
+// waitTurn blocks until the minimum interval since the last request has passed
+func (c *Client) waitTurn(ctx context.Context) error {
+	c.mu.Lock()
+	wait := time.Until(c.lastRequest.Add(c.MinInterval))
+	c.lastRequest = time.Now().Add(max(wait, 0))
+	c.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(wait):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Get requests u with header, if any, and decodes its JSON response into v,
+// retrying with backoff when rate limited or on server errors. Other statuses
+// fail at once with a StatusError.
+func (c *Client) Get(ctx context.Context, u string, header http.Header, v any) error {
+	var lastErr error
+	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(c.RetryBaseWait * time.Duration(1<<(attempt-1))):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if err := c.waitTurn(ctx); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+		for k, vs := range header {
+			req.Header[k] = vs
+		}
+		req.Header.Set("User-Agent", c.UserAgent)
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to execute request: %w", err)
+			continue
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			lastErr = fmt.Errorf("failed to read response: %w", err)
+			continue
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			if err := json.Unmarshal(body, v); err != nil {
+				return fmt.Errorf("failed to parse response: %w", err)
+			}
+			return nil
+		case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+			lastErr = &StatusError{API: c.API, StatusCode: resp.StatusCode, Body: string(body)}
+			continue
+		default:
+			return &StatusError{API: c.API, StatusCode: resp.StatusCode, Body: string(body)}
+		}
+	}
+	return fmt.Errorf("max retries exceeded: %w", lastErr)
+}
@@ -0,0 +1,63 @@
+package webapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		name      string
+		statuses  []int // answered in turn, the last repeated
+		wantCalls int
+		wantErr   int // status of the StatusError wanted, 0 for success
+	}{
+		{"ok", []int{http.StatusOK}, 1, 0},
+		{"retried server error", []int{http.StatusServiceUnavailable, http.StatusOK}, 2, 0},
+		{"retried rate limit", []int{http.StatusTooManyRequests, http.StatusOK}, 2, 0},
+		{"not retried", []int{http.StatusNotFound}, 1, http.StatusNotFound},
+		{"retries exhausted", []int{http.StatusBadGateway}, 3, http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			var header http.Header
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header.Clone()
+				status := tt.statuses[min(calls, len(tt.statuses)-1)]
+				calls++
+				w.WriteHeader(status)
+				w.Write([]byte(`{"name":"ok"}`))
+			}))
+			defer srv.Close()
+
+			c := New("Test", "", 0)
+			c.MaxRetries = 2
+			c.RetryBaseWait = time.Millisecond
+			var v struct{ Name string }
+			err := c.Get(context.Background(), srv.URL, http.Header{"X-Api-Key": {"secret"}}, &v)
+
+			if calls != tt.wantCalls {
+				t.Errorf("made %d calls, want %d", calls, tt.wantCalls)
+			}
+			if tt.wantErr == 0 {
+				if err != nil || v.Name != "ok" {
+					t.Errorf("got %+v, %v", v, err)
+				}
+			} else {
+				var statusErr *StatusError
+				if !errors.As(err, &statusErr) || statusErr.StatusCode != tt.wantErr || statusErr.API != "Test" {
+					t.Errorf("got %v, want StatusError with status %d", err, tt.wantErr)
+				}
+			}
+			if header.Get("User-Agent") != DefaultUserAgent || header.Get("X-Api-Key") != "secret" {
+				t.Errorf("sent headers %v", header)
+			}
+		})
+	}
+}
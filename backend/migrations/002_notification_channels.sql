@@ -0,0 +1,16 @@
+-- Migration: 002_notification_channels
+-- Description: Per-user notification channel configuration
+
+-- Notification channels (one row per user per channel type, config is channel-specific JSON)
+CREATE TABLE IF NOT EXISTS notification_channels (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel_type VARCHAR(50) NOT NULL,
+    config JSONB NOT NULL DEFAULT '{}',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, channel_type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_channels_user_id ON notification_channels(user_id);
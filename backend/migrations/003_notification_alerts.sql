@@ -0,0 +1,15 @@
+-- Migration: 003_notification_alerts
+-- Description: Pending alerts awaiting acknowledgment (used for phone-call escalation)
+
+CREATE TABLE IF NOT EXISTS notification_alerts (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(255) UNIQUE NOT NULL,
+    title TEXT NOT NULL,
+    acknowledged_at TIMESTAMP WITH TIME ZONE,
+    escalated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_alerts_user_id ON notification_alerts(user_id);
+CREATE INDEX IF NOT EXISTS idx_notification_alerts_escalated_at ON notification_alerts(escalated_at);
@@ -0,0 +1,18 @@
+-- Migration: 004_notification_templates
+-- Description: Customizable notification templates (Go text/template syntax)
+
+-- user_id NULL is an admin-defined default; channel_type '' applies to every channel
+CREATE TABLE IF NOT EXISTS notification_templates (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id) ON DELETE CASCADE,
+    channel_type VARCHAR(50) NOT NULL DEFAULT '',
+    title_template TEXT NOT NULL,
+    body_template TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_templates_user_channel
+    ON notification_templates(user_id, channel_type) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_templates_default_channel
+    ON notification_templates(channel_type) WHERE user_id IS NULL;
@@ -0,0 +1,4 @@
+-- Migration: 005_user_locale
+-- Description: Per-user locale for notifications and error messages
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locale VARCHAR(10) NOT NULL DEFAULT 'en';
@@ -0,0 +1,10 @@
+-- Migration: 006_stock_snapshots
+-- Description: Last stock state seen by the watcher, used to replay transitions missed during downtime
+
+CREATE TABLE IF NOT EXISTS stock_snapshots (
+    sku VARCHAR(50) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    in_stock_store_ids JSONB NOT NULL DEFAULT '[]',
+    checked_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (sku, postal_code)
+);
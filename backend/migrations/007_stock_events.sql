@@ -0,0 +1,16 @@
+-- Migration: 007_stock_events
+-- Description: Append-only log of stock transitions per SKU and store.
+-- History, replays, feeds and analytics are derived from this stream; rows are never updated.
+
+CREATE TABLE IF NOT EXISTS stock_events (
+    id BIGSERIAL PRIMARY KEY,
+    sku VARCHAR(50) NOT NULL,
+    store_id VARCHAR(50) NOT NULL,
+    store_name VARCHAR(255) NOT NULL DEFAULT '',
+    event_type VARCHAR(20) NOT NULL,
+    low_stock BOOLEAN NOT NULL DEFAULT FALSE,
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_events_sku_store ON stock_events(sku, store_id, id);
+CREATE INDEX IF NOT EXISTS idx_stock_events_occurred_at ON stock_events(occurred_at);
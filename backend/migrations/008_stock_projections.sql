@@ -0,0 +1,32 @@
+-- Migration: 008_stock_projections
+-- Description: Read models projected from stock_events so dashboards don't scan raw history.
+-- Every table here can be dropped and rebuilt by replaying the event log.
+
+-- How far each projection has read the event log
+CREATE TABLE IF NOT EXISTS projection_checkpoints (
+    name VARCHAR(50) PRIMARY KEY,
+    last_event_id BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Current availability per SKU and store
+CREATE TABLE IF NOT EXISTS stock_availability (
+    sku VARCHAR(50) NOT NULL,
+    store_id VARCHAR(50) NOT NULL,
+    store_name VARCHAR(255) NOT NULL DEFAULT '',
+    in_stock BOOLEAN NOT NULL,
+    low_stock BOOLEAN NOT NULL DEFAULT FALSE,
+    since TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (sku, store_id)
+);
+
+-- Completed in-stock time per SKU, store and UTC day
+CREATE TABLE IF NOT EXISTS stock_daily_availability (
+    sku VARCHAR(50) NOT NULL,
+    store_id VARCHAR(50) NOT NULL,
+    day DATE NOT NULL,
+    in_stock_seconds BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (sku, store_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_daily_availability_day ON stock_daily_availability(day);
@@ -0,0 +1,15 @@
+-- Migration: 009_price_cents
+-- Description: Store prices as integer cents instead of decimal dollars so price math is exact
+
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS sale_price_cents BIGINT;
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'user_products' AND column_name = 'sale_price'
+    ) THEN
+        UPDATE user_products SET sale_price_cents = ROUND(sale_price * 100) WHERE sale_price IS NOT NULL;
+        ALTER TABLE user_products DROP COLUMN sale_price;
+    END IF;
+END $$;
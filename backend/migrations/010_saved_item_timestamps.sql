@@ -0,0 +1,10 @@
+-- Migration: 010_saved_item_timestamps
+-- Description: Track when saved stores and products were last changed
+
+ALTER TABLE user_stores ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE;
+UPDATE user_stores SET updated_at = created_at WHERE updated_at IS NULL;
+ALTER TABLE user_stores ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
+
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP WITH TIME ZONE;
+UPDATE user_products SET updated_at = created_at WHERE updated_at IS NULL;
+ALTER TABLE user_products ALTER COLUMN updated_at SET DEFAULT CURRENT_TIMESTAMP;
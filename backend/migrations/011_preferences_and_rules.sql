@@ -0,0 +1,24 @@
+-- Migration: 011_preferences_and_rules
+-- Description: Per-user notification preferences and per-product alert rules
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    alerts_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    include_low_stock BOOLEAN NOT NULL DEFAULT TRUE,
+    max_distance_miles DOUBLE PRECISION NOT NULL DEFAULT 0, -- 0 means no limit
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sku VARCHAR(50) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    max_price_cents BIGINT NOT NULL DEFAULT 0, -- 0 means any price
+    min_stores INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, sku)
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_rules_user_id ON alert_rules(user_id);
@@ -0,0 +1,12 @@
+-- Migration: 012_sync_tombstones
+-- Description: Record removed saved stores and products so sync clients can delete them locally
+
+CREATE TABLE IF NOT EXISTS deleted_items (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL, -- store, product
+    item_id VARCHAR(50) NOT NULL, -- store ID or SKU
+    deleted_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, kind, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_deleted_items_user_deleted_at ON deleted_items(user_id, deleted_at);
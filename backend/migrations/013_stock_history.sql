@@ -0,0 +1,13 @@
+-- Migration: 013_stock_history
+-- Description: Result of every availability check, for charting when a product was last in stock at a store
+
+CREATE TABLE IF NOT EXISTS stock_history (
+    id BIGSERIAL PRIMARY KEY,
+    sku VARCHAR(50) NOT NULL,
+    store_id VARCHAR(50) NOT NULL,
+    in_stock BOOLEAN NOT NULL,
+    low_stock BOOLEAN NOT NULL DEFAULT FALSE,
+    checked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_history_sku_store_checked_at ON stock_history(sku, store_id, checked_at DESC);
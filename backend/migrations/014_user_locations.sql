@@ -0,0 +1,15 @@
+-- Migration: 014_user_locations
+-- Description: Named home locations (home, office, ...) with a search radius, watched for stock near each
+
+CREATE TABLE IF NOT EXISTS user_locations (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    radius_miles INTEGER NOT NULL DEFAULT 25,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_locations_user_id ON user_locations(user_id);
@@ -0,0 +1,15 @@
+-- Migration: 015_alert_distance
+-- Description: Store and location coordinates, and a per-rule distance ceiling measured from one of the user's locations
+
+CREATE TABLE IF NOT EXISTS store_coordinates (
+    store_id VARCHAR(50) PRIMARY KEY,
+    lat DOUBLE PRECISION NOT NULL,
+    lng DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE user_locations ADD COLUMN IF NOT EXISTS lat DOUBLE PRECISION NOT NULL DEFAULT 0;
+ALTER TABLE user_locations ADD COLUMN IF NOT EXISTS lng DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+ALTER TABLE alert_rules ADD COLUMN IF NOT EXISTS max_distance_miles DOUBLE PRECISION NOT NULL DEFAULT 0; -- 0 means no limit
+ALTER TABLE alert_rules ADD COLUMN IF NOT EXISTS location VARCHAR(100) NOT NULL DEFAULT ''; -- measure from here; empty means the watched postal code
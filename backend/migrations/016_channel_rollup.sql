@@ -0,0 +1,4 @@
+-- Migration: 016_channel_rollup
+-- Description: Per-channel choice between one summary message per restock and one message per store
+
+ALTER TABLE notification_channels ADD COLUMN IF NOT EXISTS rollup VARCHAR(20) NOT NULL DEFAULT 'summary';
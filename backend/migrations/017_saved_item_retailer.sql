@@ -0,0 +1,11 @@
+-- Migration: 017_saved_item_retailer
+-- Description: Track which retailer a saved store or product belongs to, so the same
+-- store ID or SKU can be saved for more than one retailer
+
+ALTER TABLE user_stores ADD COLUMN IF NOT EXISTS retailer VARCHAR(20) NOT NULL DEFAULT 'bestbuy';
+ALTER TABLE user_stores DROP CONSTRAINT IF EXISTS user_stores_user_id_store_id_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_stores_user_retailer_store ON user_stores(user_id, retailer, store_id);
+
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS retailer VARCHAR(20) NOT NULL DEFAULT 'bestbuy';
+ALTER TABLE user_products DROP CONSTRAINT IF EXISTS user_products_user_id_sku_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_products_user_retailer_sku ON user_products(user_id, retailer, sku);
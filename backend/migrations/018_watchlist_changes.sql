@@ -0,0 +1,15 @@
+-- Migration: 018_watchlist_changes
+-- Description: Log every change to users' saved products, so clients can reconcile
+-- local watchlists and users can see what they added or removed
+
+CREATE TABLE IF NOT EXISTS watchlist_changes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    retailer VARCHAR(20) NOT NULL,
+    sku VARCHAR(50) NOT NULL,
+    action VARCHAR(20) NOT NULL, -- added, updated, removed
+    product_name VARCHAR(500) NOT NULL DEFAULT '', -- name at the time of the change
+    changed_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlist_changes_user_changed_at ON watchlist_changes(user_id, changed_at);
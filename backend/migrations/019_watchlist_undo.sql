@@ -0,0 +1,8 @@
+-- Migration: 019_watchlist_undo
+-- Description: Log saved store changes too, and keep what each change replaced
+-- so the most recent change can be undone
+
+ALTER TABLE watchlist_changes ADD COLUMN IF NOT EXISTS kind VARCHAR(20) NOT NULL DEFAULT 'product'; -- store or product
+ALTER TABLE watchlist_changes ADD COLUMN IF NOT EXISTS previous JSONB; -- the saved row before a removal or update
+ALTER TABLE watchlist_changes ADD COLUMN IF NOT EXISTS undo_of BIGINT REFERENCES watchlist_changes(id) ON DELETE SET NULL;
+ALTER TABLE watchlist_changes ADD COLUMN IF NOT EXISTS undone_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,10 @@
+-- Migration: 020_api_usage
+-- Description: Count calls per day against retailer API quotas, shared by every
+-- process using the same key
+
+CREATE TABLE IF NOT EXISTS api_usage (
+    api VARCHAR(20) NOT NULL,
+    day DATE NOT NULL, -- UTC
+    calls INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (api, day)
+);
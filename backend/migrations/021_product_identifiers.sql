@@ -0,0 +1,6 @@
+-- Migration: 021_product_identifiers
+-- Description: Keep saved products' UPC and model number, to spot the same item
+-- saved under another SKU
+
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS upc VARCHAR(20) NOT NULL DEFAULT '';
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS model_number VARCHAR(100) NOT NULL DEFAULT '';
@@ -0,0 +1,17 @@
+-- Migration: 022_product_sets
+-- Description: Keep the set and product type parsed from saved product names,
+-- and let users watch every product from a set
+
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS set_name VARCHAR(200) NOT NULL DEFAULT '';
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS product_type VARCHAR(30) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_user_products_set_name ON user_products(user_id, LOWER(set_name));
+
+CREATE TABLE IF NOT EXISTS set_watches (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    set_name VARCHAR(200) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_set_watches_user_set ON set_watches(user_id, LOWER(set_name));
@@ -0,0 +1,16 @@
+-- Migration: 023_tcg_sets
+-- Description: Cache TCG set details (release date, card counts, logos) looked
+-- up in the Pokemon TCG API, including names it had no set for
+
+CREATE TABLE IF NOT EXISTS tcg_sets (
+    lookup_name VARCHAR(200) PRIMARY KEY, -- lower-cased name looked up
+    set_id VARCHAR(50) NOT NULL DEFAULT '', -- empty if the API had no such set
+    name VARCHAR(200) NOT NULL DEFAULT '',
+    series VARCHAR(100) NOT NULL DEFAULT '',
+    release_date DATE,
+    printed_total INTEGER NOT NULL DEFAULT 0,
+    total INTEGER NOT NULL DEFAULT 0,
+    logo_url TEXT NOT NULL DEFAULT '',
+    symbol_url TEXT NOT NULL DEFAULT '',
+    fetched_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,21 @@
+-- Migration: 024_msrps
+-- Description: Maintain MSRPs per TCG product type, optionally per set, to flag
+-- listings priced above them
+
+CREATE TABLE IF NOT EXISTS msrps (
+    id SERIAL PRIMARY KEY,
+    set_name VARCHAR(200) NOT NULL DEFAULT '', -- empty for every set
+    product_type VARCHAR(30) NOT NULL,
+    msrp_cents BIGINT NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_msrps_set_type ON msrps(LOWER(set_name), product_type);
+
+-- US MSRPs of current Scarlet & Violet products, priced per pack at $4.49
+INSERT INTO msrps (set_name, product_type, msrp_cents) VALUES
+    ('', 'elite_trainer_box', 4999),
+    ('', 'booster_bundle', 2694),
+    ('', 'booster_box', 16164),
+    ('', 'booster_pack', 449)
+ON CONFLICT (LOWER(set_name), product_type) DO NOTHING;
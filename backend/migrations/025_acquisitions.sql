@@ -0,0 +1,20 @@
+-- Migration: 025_acquisitions
+-- Description: Purchases users record against their saved products, to total spend
+-- per month and set
+
+CREATE TABLE IF NOT EXISTS acquisitions (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    retailer VARCHAR(20) NOT NULL DEFAULT 'bestbuy',
+    sku VARCHAR(50) NOT NULL,
+    product_name VARCHAR(500) NOT NULL, -- copied so purchases outlive the saved product
+    set_name VARCHAR(200) NOT NULL DEFAULT '',
+    quantity INTEGER NOT NULL DEFAULT 1,
+    price_cents BIGINT NOT NULL, -- per item, in currency_code
+    currency_code VARCHAR(3) NOT NULL DEFAULT '', -- empty for USD
+    store_name VARCHAR(200) NOT NULL DEFAULT '', -- where it was bought; empty if not given
+    purchased_on DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_acquisitions_user_purchased ON acquisitions(user_id, purchased_on);
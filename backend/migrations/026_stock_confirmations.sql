@@ -0,0 +1,16 @@
+-- Migration: 026_stock_confirmations
+-- Description: Users confirming whether alerted stock was really on the shelf, to score
+-- how reliable each store's reported inventory is
+
+CREATE TABLE IF NOT EXISTS stock_confirmations (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sku VARCHAR(50) NOT NULL,
+    store_id VARCHAR(50) NOT NULL,
+    found BOOLEAN NOT NULL,
+    confirmed_on DATE NOT NULL DEFAULT CURRENT_DATE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(user_id, sku, store_id, confirmed_on) -- one vote per product and store a day; later ones replace it
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_confirmations_store ON stock_confirmations(store_id, confirmed_on);
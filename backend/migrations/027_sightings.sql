@@ -0,0 +1,21 @@
+-- Migration: 027_sightings
+-- Description: Stock sightings reported by users, moderated by admins before they
+-- alert anyone
+
+CREATE TABLE IF NOT EXISTS sightings (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    sku VARCHAR(50) NOT NULL,
+    product_name VARCHAR(500) NOT NULL DEFAULT '',
+    store_id VARCHAR(50) NOT NULL,
+    store_name VARCHAR(200) NOT NULL DEFAULT '',
+    quantity INTEGER NOT NULL DEFAULT 0, -- 0 if not counted
+    photo BYTEA, -- optional
+    photo_content_type VARCHAR(50) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, confirmed or rejected
+    moderated_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    moderated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_sightings_status_created ON sightings(status, created_at);
@@ -0,0 +1,5 @@
+-- Migration: 028_sighting_reporters
+-- Description: Index sightings by reporter, for per-user submission limits and
+-- reputation scores
+
+CREATE INDEX IF NOT EXISTS idx_sightings_user_created ON sightings(user_id, created_at);
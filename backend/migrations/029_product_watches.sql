@@ -0,0 +1,22 @@
+-- Migration: 029_product_watches
+-- Description: Let users watch a search in a category and be alerted when Best Buy
+-- lists a new SKU matching it
+
+CREATE TABLE IF NOT EXISTS product_watches (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    query VARCHAR(200) NOT NULL,
+    category_id VARCHAR(100) NOT NULL,
+    checked_at TIMESTAMP WITH TIME ZONE, -- NULL until the SKUs listed when it was added are recorded
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_watches_user_query ON product_watches(user_id, LOWER(query), category_id);
+
+-- SKUs each watch has already seen, so only new ones alert
+CREATE TABLE IF NOT EXISTS product_watch_skus (
+    watch_id INTEGER NOT NULL REFERENCES product_watches(id) ON DELETE CASCADE,
+    sku VARCHAR(50) NOT NULL,
+    first_seen_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (watch_id, sku)
+);
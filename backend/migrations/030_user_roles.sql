@@ -0,0 +1,6 @@
+-- Migration: 030_user_roles
+-- Description: Give users a role, so admins are managed in the database
+-- rather than only through the ADMIN_EMAILS setting
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user'
+    CHECK (role IN ('user', 'admin'));
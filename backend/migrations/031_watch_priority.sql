@@ -0,0 +1,24 @@
+-- Migration: 031_watch_priority
+-- Description: Add a priority to saved products that routes their alerts:
+-- must-haves go to the user's urgent channels, nice-to-haves to a digest
+
+ALTER TABLE user_products ADD COLUMN IF NOT EXISTS priority VARCHAR(20) NOT NULL DEFAULT ''; -- '', must_have or nice_to_have
+
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS urgent_channels TEXT[] NOT NULL DEFAULT '{}'; -- empty for every channel
+ALTER TABLE notification_preferences ADD COLUMN IF NOT EXISTS digest_interval_hours INTEGER NOT NULL DEFAULT 24;
+
+-- Alerts for nice-to-have products waiting for the user's next digest, one per product
+CREATE TABLE IF NOT EXISTS alert_digest_items (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    retailer VARCHAR(20) NOT NULL DEFAULT 'bestbuy',
+    sku VARCHAR(50) NOT NULL,
+    product_name VARCHAR(500) NOT NULL DEFAULT '',
+    sale_price_cents BIGINT NOT NULL DEFAULT 0,
+    product_url TEXT NOT NULL DEFAULT '',
+    store_count INTEGER NOT NULL DEFAULT 0,
+    nearest_store VARCHAR(200) NOT NULL DEFAULT '',
+    distance_miles DOUBLE PRECISION NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, retailer, sku)
+);
@@ -0,0 +1,15 @@
+-- Migration: 032_api_keys
+-- Description: Per-user API keys for scripts and bots. Only a SHA-256 hash of
+-- each key is stored; the prefix identifies it in listings.
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(20) NOT NULL,
+    key_hash VARCHAR(64) UNIQUE NOT NULL,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user ON api_keys(user_id);
@@ -0,0 +1,24 @@
+-- Migration: 033_user_identities
+-- Description: Login provider accounts (Google, GitHub, OIDC) linked to users.
+-- A user can sign in with any provider whose verified email matches theirs.
+
+CREATE TABLE IF NOT EXISTS user_identities (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider VARCHAR(20) NOT NULL,
+    subject VARCHAR(255) NOT NULL, -- the provider's ID for the account
+    email VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user ON user_identities(user_id);
+
+-- Existing users signed in with Google
+INSERT INTO user_identities (user_id, provider, subject, email)
+SELECT id, 'google', google_id, email FROM users WHERE google_id IS NOT NULL
+ON CONFLICT (provider, subject) DO NOTHING;
+
+-- Users who only sign in with another provider have no Google ID
+ALTER TABLE users ALTER COLUMN google_id DROP NOT NULL;
@@ -0,0 +1,17 @@
+-- Migration: 034_login_links
+-- Description: Single-use magic links for signing in by email (stored
+-- lowercased). Only a SHA-256 hash of each link's token is stored; requests
+-- are kept a day for rate limiting.
+
+CREATE TABLE IF NOT EXISTS login_links (
+    id SERIAL PRIMARY KEY,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    request_ip VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_links_email ON login_links(email, created_at);
+CREATE INDEX IF NOT EXISTS idx_login_links_ip ON login_links(request_ip, created_at);
@@ -0,0 +1,11 @@
+-- Migration: 035_store_hours
+-- Description: Store opening hours from store searches, including the
+-- date-specific hours Best Buy lists for holidays, for open-now checks
+
+CREATE TABLE IF NOT EXISTS store_hours (
+    store_id VARCHAR(50) PRIMARY KEY,
+    hours TEXT NOT NULL DEFAULT '', -- weekly summary, e.g. "Mon: 10-9; ..."
+    detailed_hours JSONB NOT NULL DEFAULT '[]', -- hours of the coming days, holidays included
+    gmt_offset INTEGER NOT NULL DEFAULT 0, -- hours from GMT, as reported by Best Buy
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
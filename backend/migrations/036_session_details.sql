@@ -0,0 +1,11 @@
+-- Migration: 036_session_details
+-- Description: Record the browser and address each session signed in from
+-- and when it was last used, so users can review and revoke their sessions
+
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS user_agent TEXT NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS ip_address VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMP WITH TIME ZONE;
+UPDATE sessions SET last_seen_at = created_at WHERE last_seen_at IS NULL;
+ALTER TABLE sessions ALTER COLUMN last_seen_at SET DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions(user_id);
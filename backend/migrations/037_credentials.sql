@@ -0,0 +1,10 @@
+-- Migration: 037_credentials
+-- Description: Retailer API keys and notifier settings set by admins at
+-- runtime, encrypted with CREDENTIALS_KEY. They override the environment.
+
+CREATE TABLE IF NOT EXISTS credentials (
+    name VARCHAR(50) PRIMARY KEY,
+    value BYTEA NOT NULL, -- AES-256-GCM: nonce followed by the sealed value
+    updated_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
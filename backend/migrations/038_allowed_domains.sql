@@ -0,0 +1,9 @@
+-- Migration: 038_allowed_domains
+-- Description: Allowlist entries for whole email domains, so any verified
+-- address at e.g. mycompany.com can sign in
+
+CREATE TABLE IF NOT EXISTS allowed_domains (
+    domain VARCHAR(255) PRIMARY KEY, -- lowercase, without the "@"
+    added_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
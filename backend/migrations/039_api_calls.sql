@@ -0,0 +1,19 @@
+-- Migration: 039_api_calls
+-- Description: A random sample of upstream API calls (endpoint template,
+-- status, latency, quota cost) for working out what uses up the quota. Each
+-- row stands for 1/sample_rate calls. Rows older than 30 days are deleted.
+
+CREATE TABLE IF NOT EXISTS api_calls (
+    id BIGSERIAL PRIMARY KEY,
+    api VARCHAR(50) NOT NULL,
+    endpoint VARCHAR(255) NOT NULL, -- path without values, e.g. /v1/products/{id}/stores.json
+    status INTEGER NOT NULL, -- 0 if no response arrived
+    latency_ms INTEGER NOT NULL,
+    cost INTEGER NOT NULL, -- calls counted against the daily quota
+    priority VARCHAR(20) NOT NULL,
+    sample_rate DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_calls_api_created ON api_calls(api, created_at);
+CREATE INDEX IF NOT EXISTS idx_api_calls_created ON api_calls(created_at);
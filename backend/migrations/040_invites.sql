@@ -0,0 +1,15 @@
+-- Migration: 040_invites
+-- Description: Single-use, expiring invite links created by admins. Signing
+-- in with one adds the account's email to allowed_emails. Only a SHA-256
+-- hash of each invite's token is stored.
+
+CREATE TABLE IF NOT EXISTS invites (
+    id SERIAL PRIMARY KEY,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    note VARCHAR(200) NOT NULL DEFAULT '', -- who it's for, as a reminder for admins
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    used_at TIMESTAMP WITH TIME ZONE,
+    used_by_email VARCHAR(255), -- lowercased
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,7 @@
+-- Migration: 041_account_deletion
+-- Description: Let users delete their accounts. Emails an admin allowed stay
+-- allowed after the admin's account is deleted.
+
+ALTER TABLE allowed_emails DROP CONSTRAINT IF EXISTS allowed_emails_added_by_fkey;
+ALTER TABLE allowed_emails ADD CONSTRAINT allowed_emails_added_by_fkey
+    FOREIGN KEY (added_by) REFERENCES users(id) ON DELETE SET NULL;
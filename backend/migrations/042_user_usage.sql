@@ -0,0 +1,14 @@
+-- Migration: 042_user_usage
+-- Description: Per-user daily counts of stock checks and notifications, so
+-- users can see what their account uses. Rows older than 90 days are deleted.
+
+CREATE TABLE IF NOT EXISTS user_usage (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day DATE NOT NULL, -- UTC
+    interactive_checks INTEGER NOT NULL DEFAULT 0, -- SKUs checked on request
+    background_checks INTEGER NOT NULL DEFAULT 0, -- watcher checks shared with other users count for each of them
+    notifications INTEGER NOT NULL DEFAULT 0, -- messages sent over the user's channels
+    PRIMARY KEY (user_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_usage_day ON user_usage(day);
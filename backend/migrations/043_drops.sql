@@ -0,0 +1,21 @@
+-- Migration: 043_drops
+-- Description: Invitation-only drops of restricted products, curated by
+-- admins from retailer announcements. Users watching a drop's SKU are
+-- notified once when its entry window opens.
+
+CREATE TABLE IF NOT EXISTS drops (
+    id SERIAL PRIMARY KEY,
+    sku VARCHAR(50) NOT NULL, -- Best Buy SKU
+    product_name VARCHAR(255) NOT NULL DEFAULT '',
+    title VARCHAR(200) NOT NULL,
+    details VARCHAR(1000) NOT NULL DEFAULT '', -- how to enter
+    url TEXT NOT NULL DEFAULT '', -- the retailer's announcement
+    opens_at TIMESTAMP WITH TIME ZONE NOT NULL, -- entries open
+    closes_at TIMESTAMP WITH TIME ZONE, -- entries close; NULL if not announced
+    notified_at TIMESTAMP WITH TIME ZONE, -- watchers told the window opened; reset when opens_at changes
+    created_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_drops_opens_at ON drops(opens_at);
+CREATE INDEX IF NOT EXISTS idx_drops_sku ON drops(sku);
@@ -0,0 +1,27 @@
+-- Migration: 044_inbound_email
+-- Description: Personal addresses users forward Best Buy emails to, and the
+-- reminders made from invitations to buy and orders ready for pickup.
+
+CREATE TABLE IF NOT EXISTS inbound_addresses (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token VARCHAR(32) NOT NULL UNIQUE, -- local part of the address; resetting it retires the old one
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS reminders (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL, -- invitation or order_ready
+    sku VARCHAR(50) NOT NULL DEFAULT '', -- Best Buy SKU; empty if the email didn't name one
+    product_name VARCHAR(255) NOT NULL DEFAULT '',
+    store_id VARCHAR(50) NOT NULL DEFAULT '', -- Best Buy store number; empty if not named
+    store_name VARCHAR(100) NOT NULL DEFAULT '',
+    subject VARCHAR(255) NOT NULL DEFAULT '', -- of the forwarded email
+    due_at TIMESTAMP WITH TIME ZONE, -- invitation expires or order goes back to the shelf; NULL if not given
+    remind_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    sent_at TIMESTAMP WITH TIME ZONE, -- NULL until the reminder is sent
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminders_user ON reminders(user_id, remind_at);
+CREATE INDEX IF NOT EXISTS idx_reminders_due ON reminders(remind_at) WHERE sent_at IS NULL;
@@ -0,0 +1,19 @@
+-- Migration: 045_watchlist_change_items
+-- Description: The sku and product_name columns of watchlist_changes hold
+-- store IDs and names for store changes, so they get names that fit both.
+
+DO $$
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'watchlist_changes' AND column_name = 'sku'
+    ) THEN
+        ALTER TABLE watchlist_changes RENAME COLUMN sku TO item_id;
+    END IF;
+    IF EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'watchlist_changes' AND column_name = 'product_name'
+    ) THEN
+        ALTER TABLE watchlist_changes RENAME COLUMN product_name TO item_name;
+    END IF;
+END $$;
@@ -0,0 +1,9 @@
+-- Migration: 046_retailer_events_rules
+-- Description: Track which retailer a stock event or alert rule belongs to, so the
+-- same SKU and store ID at two retailers are logged and filtered separately
+
+ALTER TABLE stock_events ADD COLUMN IF NOT EXISTS retailer VARCHAR(20) NOT NULL DEFAULT 'bestbuy';
+
+ALTER TABLE alert_rules ADD COLUMN IF NOT EXISTS retailer VARCHAR(20) NOT NULL DEFAULT 'bestbuy';
+ALTER TABLE alert_rules DROP CONSTRAINT IF EXISTS alert_rules_user_id_sku_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_alert_rules_user_retailer_sku ON alert_rules(user_id, retailer, sku);
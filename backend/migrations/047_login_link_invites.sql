@@ -0,0 +1,5 @@
+-- Migration: 047_login_link_invites
+-- Description: Remember the invite a magic link was requested with (by the
+-- hash of its token), so it's used wherever the link is opened
+
+ALTER TABLE login_links ADD COLUMN IF NOT EXISTS invite_hash VARCHAR(64);
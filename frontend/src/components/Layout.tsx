@@ -13,7 +13,7 @@ export function Layout({ children }: LayoutProps) {
   const navigate = useNavigate()
   const { stores: myStores } = useMyStores()
   const { products: myProducts } = useMyProducts()
-  const { user, isLoading: authLoading, isAuthenticated, login, logout } = useAuth()
+  const { user, bootstrap, isLoading: authLoading, isAuthenticated, login, logout } = useAuth()
 
   const canCheckStock = myStores.length > 0 && myProducts.length > 0
 
@@ -78,7 +78,7 @@ export function Layout({ children }: LayoutProps) {
                 </div>
               ) : (
                 <button
-                  onClick={login}
+                  onClick={() => login()}
                   className="px-3 sm:px-4 py-1.5 sm:py-2 bg-white text-blue-600 rounded-lg font-semibold hover:bg-blue-50 transition-colors text-sm sm:text-base"
                 >
                   Login
@@ -117,6 +117,17 @@ export function Layout({ children }: LayoutProps) {
         </div>
       </header>
 
+      {bootstrap?.status?.readOnly && (
+        <div className="bg-amber-100 text-amber-900 text-sm text-center px-4 py-2">
+          Maintenance in progress: changes are paused until it ends.
+        </div>
+      )}
+      {bootstrap?.announcement && (
+        <div className="bg-blue-50 text-blue-900 text-sm text-center px-4 py-2">
+          {bootstrap.announcement}
+        </div>
+      )}
+
       <main className="container mx-auto px-4 py-4 sm:py-8">{children}</main>
 
       {/* Mobile bottom padding for better scrolling */}
@@ -3,13 +3,18 @@ import type { ReactNode } from 'react'
 import { createClient } from '@connectrpc/connect'
 import { createConnectTransport } from '@connectrpc/connect-web'
 import { StockCheckerService } from '../gen/stockchecker/v1/service_pb.js'
-import type { User } from '../gen/stockchecker/v1/service_pb.js'
+import type { GetClientBootstrapResponse, User } from '../gen/stockchecker/v1/service_pb.js'
 
 interface AuthContextType {
   user: User | null
+  // Features, server status, announcement and watchlist counts, loaded with the user
+  bootstrap: GetClientBootstrapResponse | null
   isLoading: boolean
   isAuthenticated: boolean
-  login: () => void
+  // Signs in with a provider from bootstrap.loginProviders, or the first one
+  login: (provider?: string) => void
+  // Emails a sign-in link when bootstrap.emailLogin is set
+  requestLoginLink: (email: string) => Promise<void>
   logout: () => void
   refetchUser: () => Promise<void>
 }
@@ -31,14 +36,17 @@ const client = createClient(StockCheckerService, transport)
 
 export function AuthProvider({ children }: { children: ReactNode }) {
   const [user, setUser] = useState<User | null>(null)
+  const [bootstrap, setBootstrap] = useState<GetClientBootstrapResponse | null>(null)
   const [isLoading, setIsLoading] = useState(true)
 
   const fetchUser = useCallback(async () => {
     try {
-      const response = await client.getCurrentUser({})
+      // One call for everything the app needs on load; user is unset when signed out
+      const response = await client.getClientBootstrap({})
+      setBootstrap(response)
       setUser(response.user ?? null)
     } catch {
-      // Not authenticated or auth not enabled
+      // Server unreachable
       setUser(null)
     } finally {
       setIsLoading(false)
@@ -57,12 +65,39 @@ export function AuthProvider({ children }: { children: ReactNode }) {
       // Clear the URL params
       window.history.replaceState({}, '', window.location.pathname)
       alert('Your email is not on the allowed list. Contact the administrator for access.')
+    } else if (error === 'link_expired') {
+      window.history.replaceState({}, '', window.location.pathname)
+      alert('That sign-in link has expired or was already used. Ask for a new one.')
+    } else if (error === 'email_not_verified') {
+      window.history.replaceState({}, '', window.location.pathname)
+      alert('Your account has no verified email address. Verify one with the provider and try again.')
+    } else if (error === 'invite_invalid') {
+      window.history.replaceState({}, '', window.location.pathname)
+      alert('That invite link has expired or was already used. Ask for a new one.')
+    } else if (params.get('invited') === 'true') {
+      window.history.replaceState({}, '', window.location.pathname)
+      alert("You've been invited. Sign in within the hour to accept.")
     }
   }, [])
 
-  const login = () => {
+  const login = (provider?: string) => {
     const apiUrl = import.meta.env.VITE_API_URL || 'http://localhost:8080'
-    window.location.href = `${apiUrl}/auth/login`
+    const query = provider ? `?provider=${encodeURIComponent(provider)}` : ''
+    window.location.href = `${apiUrl}/auth/login${query}`
+  }
+
+  const requestLoginLink = async (email: string) => {
+    const apiUrl = import.meta.env.VITE_API_URL || 'http://localhost:8080'
+    const response = await fetch(`${apiUrl}/auth/email`, {
+      method: 'POST',
+      body: new URLSearchParams({ email }),
+    })
+    if (response.status === 429) {
+      throw new Error('Too many sign-in links requested. Try again in 15 minutes.')
+    }
+    if (!response.ok) {
+      throw new Error('Enter a valid email address.')
+    }
   }
 
   const logout = () => {
@@ -74,9 +109,11 @@ export function AuthProvider({ children }: { children: ReactNode }) {
     <AuthContext.Provider
       value={{
         user,
+        bootstrap,
         isLoading,
         isAuthenticated: user !== null,
         login,
+        requestLoginLink,
         logout,
         refetchUser: fetchUser,
       }}
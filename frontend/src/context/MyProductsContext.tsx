@@ -155,10 +155,20 @@ export function MyProductsProvider({ children }: { children: ReactNode }) {
     return products.some((p) => p.sku === sku)
   }
 
-  const clearProducts = () => {
+  const clearProducts = async () => {
+    const cleared = products
     setProducts([])
     if (!isAuthenticated) {
       localStorage.removeItem(STORAGE_KEY)
+      return
+    }
+
+    try {
+      // The page asks the user first, so confirm here
+      await client.clearWatchlist({ confirm: true })
+    } catch (e) {
+      console.error('Failed to clear products from API:', e)
+      setProducts(cleared)
     }
   }
 
@@ -2,8 +2,9 @@
 // @generated from file stockchecker/v1/service.proto (package stockchecker.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
 import type { Message } from "@bufbuild/protobuf";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
 
 /**
  * Describes the file stockchecker/v1/service.proto.
@@ -55,6 +56,49 @@ export declare type Store = Message<"stockchecker.v1.Store"> & {
    * @generated from field: double distance_miles = 8;
    */
   distanceMiles: number;
+
+  /**
+   * when the store was saved; unset in search results
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * when the saved store was last changed
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * Opening hours in the store's time zone, holiday hours included. Unset
+   * when the store's hours are unknown.
+   *
+   * @generated from field: bool open_now = 11;
+   */
+  openNow: boolean;
+
+  /**
+   * "10:00-21:00" or "closed"; empty if unknown
+   *
+   * @generated from field: string hours_today = 12;
+   */
+  hoursToday: string;
+
+  /**
+   * today's hours differ from the usual ones, e.g. a holiday
+   *
+   * @generated from field: bool special_hours = 13;
+   */
+  specialHours: boolean;
+
+  /**
+   * when a closed store next opens
+   *
+   * @generated from field: google.protobuf.Timestamp opens_at = 14;
+   */
+  opensAt?: Timestamp;
 };
 
 /**
@@ -80,7 +124,10 @@ export declare type Product = Message<"stockchecker.v1.Product"> & {
   name: string;
 
   /**
-   * @generated from field: double sale_price = 3;
+   * use sale_price_cents; kept for older clients
+   *
+   * @generated from field: double sale_price = 3 [deprecated = true];
+   * @deprecated
    */
   salePrice: number;
 
@@ -93,6 +140,76 @@ export declare type Product = Message<"stockchecker.v1.Product"> & {
    * @generated from field: string product_url = 5;
    */
   productUrl: string;
+
+  /**
+   * sale price in cents of currency_code
+   *
+   * @generated from field: int64 sale_price_cents = 6;
+   */
+  salePriceCents: bigint;
+
+  /**
+   * when the product was saved; unset in search results
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * when the saved product was last changed
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * ISO 4217 code of the prices, e.g. "CAD" on bestbuy.ca; empty means USD
+   *
+   * @generated from field: string currency_code = 9;
+   */
+  currencyCode: string;
+
+  /**
+   * TCG set read from the name, e.g. "Prismatic Evolutions"; empty if not found
+   *
+   * @generated from field: string set_name = 10;
+   */
+  setName: string;
+
+  /**
+   * sealed product type read from the name
+   *
+   * @generated from field: stockchecker.v1.ProductType product_type = 11;
+   */
+  productType: ProductType;
+
+  /**
+   * MSRP of the set and product type in US cents; 0 if unknown
+   *
+   * @generated from field: int64 msrp_cents = 12;
+   */
+  msrpCents: bigint;
+
+  /**
+   * priced above MSRP, e.g. a marked-up bundle
+   *
+   * @generated from field: bool above_msrp = 13;
+   */
+  aboveMsrp: boolean;
+
+  /**
+   * routes the saved product's alerts
+   *
+   * @generated from field: stockchecker.v1.WatchPriority priority = 14;
+   */
+  priority: WatchPriority;
+
+  /**
+   * who sells the product; unspecified is Best Buy
+   *
+   * @generated from field: stockchecker.v1.Retailer retailer = 15;
+   */
+  retailer: Retailer;
 };
 
 /**
@@ -138,6 +255,13 @@ export declare type StockStatus = Message<"stockchecker.v1.StockStatus"> & {
    * @generated from field: bool is_my_store = 6;
    */
   isMyStore: boolean;
+
+  /**
+   * when availability was fetched from the retailer
+   *
+   * @generated from field: google.protobuf.Timestamp checked_at = 7;
+   */
+  checkedAt?: Timestamp;
 };
 
 /**
@@ -147,8 +271,6 @@ export declare type StockStatus = Message<"stockchecker.v1.StockStatus"> & {
 export declare const StockStatusSchema: GenMessage<StockStatus>;
 
 /**
- * User represents an authenticated user
- *
  * @generated from message stockchecker.v1.User
  */
 export declare type User = Message<"stockchecker.v1.User"> & {
@@ -171,6 +293,28 @@ export declare type User = Message<"stockchecker.v1.User"> & {
    * @generated from field: string picture_url = 4;
    */
   pictureUrl: string;
+
+  /**
+   * Language for notifications and messages (en, es, fr)
+   *
+   * @generated from field: string locale = 5;
+   */
+  locale: string;
+
+  /**
+   * @generated from field: bool is_admin = 6;
+   */
+  isAdmin: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: stockchecker.v1.UserRole role = 8;
+   */
+  role: UserRole;
 };
 
 /**
@@ -196,6 +340,13 @@ export declare type SearchStoresRequest = Message<"stockchecker.v1.SearchStoresR
    * @generated from field: int32 radius_miles = 2;
    */
   radiusMiles: number;
+
+  /**
+   * name of one of the user's locations; replaces postal_code and radius_miles
+   *
+   * @generated from field: string location = 3;
+   */
+  location: string;
 };
 
 /**
@@ -241,6 +392,18 @@ export declare type SearchProductsRequest = Message<"stockchecker.v1.SearchProdu
    * @generated from field: string category = 2;
    */
   category: string;
+
+  /**
+   * default 50, max 100
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
 };
 
 /**
@@ -259,6 +422,20 @@ export declare type SearchProductsResponse = Message<"stockchecker.v1.SearchProd
    * @generated from field: repeated stockchecker.v1.Product products = 1;
    */
   products: Product[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+
+  /**
+   * products matching the search across all pages
+   *
+   * @generated from field: int32 total_size = 3;
+   */
+  totalSize: number;
 };
 
 /**
@@ -291,6 +468,13 @@ export declare type CheckStockRequest = Message<"stockchecker.v1.CheckStockReque
    * @generated from field: string postal_code = 3;
    */
   postalCode: string;
+
+  /**
+   * name of one of the user's locations; replaces postal_code and limits results to its radius
+   *
+   * @generated from field: string location = 4;
+   */
+  location: string;
 };
 
 /**
@@ -299,6 +483,65 @@ export declare type CheckStockRequest = Message<"stockchecker.v1.CheckStockReque
  */
 export declare const CheckStockRequestSchema: GenMessage<CheckStockRequest>;
 
+/**
+ * SkuError explains why a SKU couldn't be checked
+ *
+ * @generated from message stockchecker.v1.SkuError
+ */
+export declare type SkuError = Message<"stockchecker.v1.SkuError"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * localized description
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * @generated from field: stockchecker.v1.SkuErrorCode code = 3;
+   */
+  code: SkuErrorCode;
+
+  /**
+   * set for SKU_ERROR_CODE_RATE_LIMITED
+   *
+   * @generated from field: int32 retry_after_seconds = 4;
+   */
+  retryAfterSeconds: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.SkuError.
+ * Use `create(SkuErrorSchema)` to create a new message.
+ */
+export declare const SkuErrorSchema: GenMessage<SkuError>;
+
+/**
+ * MaintenanceError is attached to the Unavailable error returned by RPCs
+ * that change data while the server is read-only for database maintenance.
+ * Reads (RPCs without side effects) keep working.
+ *
+ * @generated from message stockchecker.v1.MaintenanceError
+ */
+export declare type MaintenanceError = Message<"stockchecker.v1.MaintenanceError"> & {
+  /**
+   * when to try again
+   *
+   * @generated from field: int32 retry_after_seconds = 1;
+   */
+  retryAfterSeconds: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.MaintenanceError.
+ * Use `create(MaintenanceErrorSchema)` to create a new message.
+ */
+export declare const MaintenanceErrorSchema: GenMessage<MaintenanceError>;
+
 /**
  * CheckStockResponse is the response containing stock status
  *
@@ -309,6 +552,13 @@ export declare type CheckStockResponse = Message<"stockchecker.v1.CheckStockResp
    * @generated from field: repeated stockchecker.v1.StockStatus results = 1;
    */
   results: StockStatus[];
+
+  /**
+   * SKUs that couldn't be checked; results cover the rest
+   *
+   * @generated from field: repeated stockchecker.v1.SkuError errors = 2;
+   */
+  errors: SkuError[];
 };
 
 /**
@@ -349,6 +599,40 @@ export declare type GetCurrentUserResponse = Message<"stockchecker.v1.GetCurrent
  */
 export declare const GetCurrentUserResponseSchema: GenMessage<GetCurrentUserResponse>;
 
+/**
+ * SetMyLocaleRequest sets the user's language
+ *
+ * @generated from message stockchecker.v1.SetMyLocaleRequest
+ */
+export declare type SetMyLocaleRequest = Message<"stockchecker.v1.SetMyLocaleRequest"> & {
+  /**
+   * en, es or fr
+   *
+   * @generated from field: string locale = 1;
+   */
+  locale: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocaleRequest.
+ * Use `create(SetMyLocaleRequestSchema)` to create a new message.
+ */
+export declare const SetMyLocaleRequestSchema: GenMessage<SetMyLocaleRequest>;
+
+/**
+ * SetMyLocaleResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.SetMyLocaleResponse
+ */
+export declare type SetMyLocaleResponse = Message<"stockchecker.v1.SetMyLocaleResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocaleResponse.
+ * Use `create(SetMyLocaleResponseSchema)` to create a new message.
+ */
+export declare const SetMyLocaleResponseSchema: GenMessage<SetMyLocaleResponse>;
+
 /**
  * GetMyStoresRequest is empty - user is determined from session
  *
@@ -446,11 +730,17 @@ export declare type RemoveMyStoreResponse = Message<"stockchecker.v1.RemoveMySto
 export declare const RemoveMyStoreResponseSchema: GenMessage<RemoveMyStoreResponse>;
 
 /**
- * GetMyProductsRequest is empty - user is determined from session
+ * GetMyProductsRequest - user is determined from session
  *
  * @generated from message stockchecker.v1.GetMyProductsRequest
  */
 export declare type GetMyProductsRequest = Message<"stockchecker.v1.GetMyProductsRequest"> & {
+  /**
+   * only products from this set, ignoring case; empty for all
+   *
+   * @generated from field: string set_name = 1;
+   */
+  setName: string;
 };
 
 /**
@@ -496,11 +786,44 @@ export declare type AddMyProductRequest = Message<"stockchecker.v1.AddMyProductR
 export declare const AddMyProductRequestSchema: GenMessage<AddMyProductRequest>;
 
 /**
- * AddMyProductResponse is empty on success
+ * PossibleDuplicate is a saved product that may duplicate the one being added
+ *
+ * @generated from message stockchecker.v1.PossibleDuplicate
+ */
+export declare type PossibleDuplicate = Message<"stockchecker.v1.PossibleDuplicate"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: stockchecker.v1.DuplicateReason reason = 3;
+   */
+  reason: DuplicateReason;
+};
+
+/**
+ * Describes the message stockchecker.v1.PossibleDuplicate.
+ * Use `create(PossibleDuplicateSchema)` to create a new message.
+ */
+export declare const PossibleDuplicateSchema: GenMessage<PossibleDuplicate>;
+
+/**
+ * AddMyProductResponse warns about saved products that look like the same
+ * item; the product is added either way
  *
  * @generated from message stockchecker.v1.AddMyProductResponse
  */
 export declare type AddMyProductResponse = Message<"stockchecker.v1.AddMyProductResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.PossibleDuplicate possible_duplicates = 1;
+   */
+  possibleDuplicates: PossibleDuplicate[];
 };
 
 /**
@@ -542,152 +865,6557 @@ export declare type RemoveMyProductResponse = Message<"stockchecker.v1.RemoveMyP
 export declare const RemoveMyProductResponseSchema: GenMessage<RemoveMyProductResponse>;
 
 /**
- * BrowsePokemonProductsRequest is empty
+ * RemoveMyProductsRequest removes several products from the user's list at once
  *
- * @generated from message stockchecker.v1.BrowsePokemonProductsRequest
+ * @generated from message stockchecker.v1.RemoveMyProductsRequest
  */
-export declare type BrowsePokemonProductsRequest = Message<"stockchecker.v1.BrowsePokemonProductsRequest"> & {
+export declare type RemoveMyProductsRequest = Message<"stockchecker.v1.RemoveMyProductsRequest"> & {
+  /**
+   * SKUs that aren't saved are skipped
+   *
+   * @generated from field: repeated string skus = 1;
+   */
+  skus: string[];
 };
 
 /**
- * Describes the message stockchecker.v1.BrowsePokemonProductsRequest.
- * Use `create(BrowsePokemonProductsRequestSchema)` to create a new message.
+ * Describes the message stockchecker.v1.RemoveMyProductsRequest.
+ * Use `create(RemoveMyProductsRequestSchema)` to create a new message.
  */
-export declare const BrowsePokemonProductsRequestSchema: GenMessage<BrowsePokemonProductsRequest>;
+export declare const RemoveMyProductsRequestSchema: GenMessage<RemoveMyProductsRequest>;
 
 /**
- * BrowsePokemonProductsResponse returns Pokemon products from the trading cards category
+ * RemoveMyProductsResponse returns how many products were removed
  *
- * @generated from message stockchecker.v1.BrowsePokemonProductsResponse
+ * @generated from message stockchecker.v1.RemoveMyProductsResponse
  */
-export declare type BrowsePokemonProductsResponse = Message<"stockchecker.v1.BrowsePokemonProductsResponse"> & {
+export declare type RemoveMyProductsResponse = Message<"stockchecker.v1.RemoveMyProductsResponse"> & {
   /**
-   * @generated from field: repeated stockchecker.v1.Product products = 1;
+   * @generated from field: int32 removed = 1;
    */
-  products: Product[];
+  removed: number;
 };
 
 /**
- * Describes the message stockchecker.v1.BrowsePokemonProductsResponse.
- * Use `create(BrowsePokemonProductsResponseSchema)` to create a new message.
+ * Describes the message stockchecker.v1.RemoveMyProductsResponse.
+ * Use `create(RemoveMyProductsResponseSchema)` to create a new message.
  */
-export declare const BrowsePokemonProductsResponseSchema: GenMessage<BrowsePokemonProductsResponse>;
+export declare const RemoveMyProductsResponseSchema: GenMessage<RemoveMyProductsResponse>;
 
 /**
- * StockCheckerService provides stock checking functionality
+ * ClearWatchlistRequest removes every product from the user's list
  *
- * @generated from service stockchecker.v1.StockCheckerService
+ * @generated from message stockchecker.v1.ClearWatchlistRequest
  */
-export declare const StockCheckerService: GenService<{
+export declare type ClearWatchlistRequest = Message<"stockchecker.v1.ClearWatchlistRequest"> & {
   /**
-   * SearchStores searches for Best Buy stores near a location
+   * must be set; guards against clearing by accident
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.SearchStores
+   * @generated from field: bool confirm = 1;
    */
-  searchStores: {
-    methodKind: "unary";
-    input: typeof SearchStoresRequestSchema;
-    output: typeof SearchStoresResponseSchema;
-  },
+  confirm: boolean;
+
   /**
-   * SearchProducts searches for products by keyword or SKU
+   * also remove the user's saved stores
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.SearchProducts
+   * @generated from field: bool include_stores = 2;
    */
-  searchProducts: {
-    methodKind: "unary";
-    input: typeof SearchProductsRequestSchema;
-    output: typeof SearchProductsResponseSchema;
-  },
+  includeStores: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.ClearWatchlistRequest.
+ * Use `create(ClearWatchlistRequestSchema)` to create a new message.
+ */
+export declare const ClearWatchlistRequestSchema: GenMessage<ClearWatchlistRequest>;
+
+/**
+ * ClearWatchlistResponse returns how much was removed. Each removal is a
+ * watchlist change, so UndoLastChange restores items one at a time.
+ *
+ * @generated from message stockchecker.v1.ClearWatchlistResponse
+ */
+export declare type ClearWatchlistResponse = Message<"stockchecker.v1.ClearWatchlistResponse"> & {
   /**
-   * CheckStock checks inventory for products at specified stores
-   *
-   * @generated from rpc stockchecker.v1.StockCheckerService.CheckStock
+   * @generated from field: int32 removed_products = 1;
    */
-  checkStock: {
-    methodKind: "unary";
-    input: typeof CheckStockRequestSchema;
-    output: typeof CheckStockResponseSchema;
-  },
+  removedProducts: number;
+
   /**
-   * GetCurrentUser returns the currently authenticated user
+   * @generated from field: int32 removed_stores = 2;
+   */
+  removedStores: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.ClearWatchlistResponse.
+ * Use `create(ClearWatchlistResponseSchema)` to create a new message.
+ */
+export declare const ClearWatchlistResponseSchema: GenMessage<ClearWatchlistResponse>;
+
+/**
+ * ImportMyProductsRequest adds a pasted list of SKUs, UPCs or product URLs to the user's list
+ *
+ * @generated from message stockchecker.v1.ImportMyProductsRequest
+ */
+export declare type ImportMyProductsRequest = Message<"stockchecker.v1.ImportMyProductsRequest"> & {
+  /**
+   * one product per line, or separated by commas, semicolons or tabs
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.GetCurrentUser
+   * @generated from field: string text = 1;
    */
-  getCurrentUser: {
-    methodKind: "unary";
-    input: typeof GetCurrentUserRequestSchema;
+  text: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ImportMyProductsRequest.
+ * Use `create(ImportMyProductsRequestSchema)` to create a new message.
+ */
+export declare const ImportMyProductsRequestSchema: GenMessage<ImportMyProductsRequest>;
+
+/**
+ * ImportMyProductsResponse lists what was added and what couldn't be
+ *
+ * @generated from message stockchecker.v1.ImportMyProductsResponse
+ */
+export declare type ImportMyProductsResponse = Message<"stockchecker.v1.ImportMyProductsResponse"> & {
+  /**
+   * products added to the list
+   *
+   * @generated from field: repeated stockchecker.v1.Product products = 1;
+   */
+  products: Product[];
+
+  /**
+   * entries that aren't products or weren't found
+   *
+   * @generated from field: repeated string rejected = 2;
+   */
+  rejected: string[];
+};
+
+/**
+ * Describes the message stockchecker.v1.ImportMyProductsResponse.
+ * Use `create(ImportMyProductsResponseSchema)` to create a new message.
+ */
+export declare const ImportMyProductsResponseSchema: GenMessage<ImportMyProductsResponse>;
+
+/**
+ * BrowsePokemonProductsRequest is the request for browsing Pokemon products
+ *
+ * @generated from message stockchecker.v1.BrowsePokemonProductsRequest
+ */
+export declare type BrowsePokemonProductsRequest = Message<"stockchecker.v1.BrowsePokemonProductsRequest"> & {
+  /**
+   * return every product in the subclass instead of the first 100; slower
+   *
+   * @generated from field: bool all_pages = 1;
+   */
+  allPages: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.BrowsePokemonProductsRequest.
+ * Use `create(BrowsePokemonProductsRequestSchema)` to create a new message.
+ */
+export declare const BrowsePokemonProductsRequestSchema: GenMessage<BrowsePokemonProductsRequest>;
+
+/**
+ * BrowsePokemonProductsResponse returns Pokemon products from the trading cards category
+ *
+ * @generated from message stockchecker.v1.BrowsePokemonProductsResponse
+ */
+export declare type BrowsePokemonProductsResponse = Message<"stockchecker.v1.BrowsePokemonProductsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Product products = 1;
+   */
+  products: Product[];
+};
+
+/**
+ * Describes the message stockchecker.v1.BrowsePokemonProductsResponse.
+ * Use `create(BrowsePokemonProductsResponseSchema)` to create a new message.
+ */
+export declare const BrowsePokemonProductsResponseSchema: GenMessage<BrowsePokemonProductsResponse>;
+
+/**
+ * NotificationChannel is a user's configuration for one way of receiving alerts
+ *
+ * @generated from message stockchecker.v1.NotificationChannel
+ */
+export declare type NotificationChannel = Message<"stockchecker.v1.NotificationChannel"> & {
+  /**
+   * pushover, gotify, matrix, twilio_voice, email, webhook, discord
+   *
+   * @generated from field: string channel_type = 1;
+   */
+  channelType: string;
+
+  /**
+   * channel-specific JSON; secrets are omitted in responses
+   *
+   * @generated from field: string config = 2;
+   */
+  config: string;
+
+  /**
+   * @generated from field: bool enabled = 3;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * summary (one message listing every store, the default) or per_store; empty keeps the saved mode
+   *
+   * @generated from field: string rollup = 6;
+   */
+  rollup: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.NotificationChannel.
+ * Use `create(NotificationChannelSchema)` to create a new message.
+ */
+export declare const NotificationChannelSchema: GenMessage<NotificationChannel>;
+
+/**
+ * GetNotificationChannelsRequest is empty - user is determined from session
+ *
+ * @generated from message stockchecker.v1.GetNotificationChannelsRequest
+ */
+export declare type GetNotificationChannelsRequest = Message<"stockchecker.v1.GetNotificationChannelsRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationChannelsRequest.
+ * Use `create(GetNotificationChannelsRequestSchema)` to create a new message.
+ */
+export declare const GetNotificationChannelsRequestSchema: GenMessage<GetNotificationChannelsRequest>;
+
+/**
+ * GetNotificationChannelsResponse returns the user's configured channels
+ *
+ * @generated from message stockchecker.v1.GetNotificationChannelsResponse
+ */
+export declare type GetNotificationChannelsResponse = Message<"stockchecker.v1.GetNotificationChannelsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.NotificationChannel channels = 1;
+   */
+  channels: NotificationChannel[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationChannelsResponse.
+ * Use `create(GetNotificationChannelsResponseSchema)` to create a new message.
+ */
+export declare const GetNotificationChannelsResponseSchema: GenMessage<GetNotificationChannelsResponse>;
+
+/**
+ * SetNotificationChannelRequest creates or replaces a channel's configuration.
+ * Secrets left out of config keep their saved values.
+ *
+ * @generated from message stockchecker.v1.SetNotificationChannelRequest
+ */
+export declare type SetNotificationChannelRequest = Message<"stockchecker.v1.SetNotificationChannelRequest"> & {
+  /**
+   * @generated from field: stockchecker.v1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationChannelRequest.
+ * Use `create(SetNotificationChannelRequestSchema)` to create a new message.
+ */
+export declare const SetNotificationChannelRequestSchema: GenMessage<SetNotificationChannelRequest>;
+
+/**
+ * SetNotificationChannelResponse returns the saved channel
+ *
+ * @generated from message stockchecker.v1.SetNotificationChannelResponse
+ */
+export declare type SetNotificationChannelResponse = Message<"stockchecker.v1.SetNotificationChannelResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.NotificationChannel channel = 1;
+   */
+  channel?: NotificationChannel;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationChannelResponse.
+ * Use `create(SetNotificationChannelResponseSchema)` to create a new message.
+ */
+export declare const SetNotificationChannelResponseSchema: GenMessage<SetNotificationChannelResponse>;
+
+/**
+ * DeleteNotificationChannelRequest removes a channel
+ *
+ * @generated from message stockchecker.v1.DeleteNotificationChannelRequest
+ */
+export declare type DeleteNotificationChannelRequest = Message<"stockchecker.v1.DeleteNotificationChannelRequest"> & {
+  /**
+   * @generated from field: string channel_type = 1;
+   */
+  channelType: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationChannelRequest.
+ * Use `create(DeleteNotificationChannelRequestSchema)` to create a new message.
+ */
+export declare const DeleteNotificationChannelRequestSchema: GenMessage<DeleteNotificationChannelRequest>;
+
+/**
+ * DeleteNotificationChannelResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.DeleteNotificationChannelResponse
+ */
+export declare type DeleteNotificationChannelResponse = Message<"stockchecker.v1.DeleteNotificationChannelResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationChannelResponse.
+ * Use `create(DeleteNotificationChannelResponseSchema)` to create a new message.
+ */
+export declare const DeleteNotificationChannelResponseSchema: GenMessage<DeleteNotificationChannelResponse>;
+
+/**
+ * NotificationTemplate customizes notification content using Go text/template syntax.
+ * Available fields: .Product, .SKU, .Price, .Image, .Stores, .Distance, .Links.Product, .Links.AddToCart
+ *
+ * @generated from message stockchecker.v1.NotificationTemplate
+ */
+export declare type NotificationTemplate = Message<"stockchecker.v1.NotificationTemplate"> & {
+  /**
+   * channel the template applies to; empty applies to every channel
+   *
+   * @generated from field: string channel_type = 1;
+   */
+  channelType: string;
+
+  /**
+   * @generated from field: string title_template = 2;
+   */
+  titleTemplate: string;
+
+  /**
+   * @generated from field: string body_template = 3;
+   */
+  bodyTemplate: string;
+
+  /**
+   * True for admin-defined defaults used when a user has no template
+   *
+   * @generated from field: bool is_default = 4;
+   */
+  isDefault: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.NotificationTemplate.
+ * Use `create(NotificationTemplateSchema)` to create a new message.
+ */
+export declare const NotificationTemplateSchema: GenMessage<NotificationTemplate>;
+
+/**
+ * GetNotificationTemplatesRequest is empty - user is determined from session
+ *
+ * @generated from message stockchecker.v1.GetNotificationTemplatesRequest
+ */
+export declare type GetNotificationTemplatesRequest = Message<"stockchecker.v1.GetNotificationTemplatesRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationTemplatesRequest.
+ * Use `create(GetNotificationTemplatesRequestSchema)` to create a new message.
+ */
+export declare const GetNotificationTemplatesRequestSchema: GenMessage<GetNotificationTemplatesRequest>;
+
+/**
+ * GetNotificationTemplatesResponse returns the user's templates and the admin defaults
+ *
+ * @generated from message stockchecker.v1.GetNotificationTemplatesResponse
+ */
+export declare type GetNotificationTemplatesResponse = Message<"stockchecker.v1.GetNotificationTemplatesResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.NotificationTemplate templates = 1;
+   */
+  templates: NotificationTemplate[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationTemplatesResponse.
+ * Use `create(GetNotificationTemplatesResponseSchema)` to create a new message.
+ */
+export declare const GetNotificationTemplatesResponseSchema: GenMessage<GetNotificationTemplatesResponse>;
+
+/**
+ * SetNotificationTemplateRequest creates or replaces a template
+ *
+ * @generated from message stockchecker.v1.SetNotificationTemplateRequest
+ */
+export declare type SetNotificationTemplateRequest = Message<"stockchecker.v1.SetNotificationTemplateRequest"> & {
+  /**
+   * is_default requires an admin
+   *
+   * @generated from field: stockchecker.v1.NotificationTemplate template = 1;
+   */
+  template?: NotificationTemplate;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationTemplateRequest.
+ * Use `create(SetNotificationTemplateRequestSchema)` to create a new message.
+ */
+export declare const SetNotificationTemplateRequestSchema: GenMessage<SetNotificationTemplateRequest>;
+
+/**
+ * SetNotificationTemplateResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.SetNotificationTemplateResponse
+ */
+export declare type SetNotificationTemplateResponse = Message<"stockchecker.v1.SetNotificationTemplateResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationTemplateResponse.
+ * Use `create(SetNotificationTemplateResponseSchema)` to create a new message.
+ */
+export declare const SetNotificationTemplateResponseSchema: GenMessage<SetNotificationTemplateResponse>;
+
+/**
+ * DeleteNotificationTemplateRequest removes a template, reverting to the default
+ *
+ * @generated from message stockchecker.v1.DeleteNotificationTemplateRequest
+ */
+export declare type DeleteNotificationTemplateRequest = Message<"stockchecker.v1.DeleteNotificationTemplateRequest"> & {
+  /**
+   * @generated from field: string channel_type = 1;
+   */
+  channelType: string;
+
+  /**
+   * remove the admin default instead (requires an admin)
+   *
+   * @generated from field: bool is_default = 2;
+   */
+  isDefault: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationTemplateRequest.
+ * Use `create(DeleteNotificationTemplateRequestSchema)` to create a new message.
+ */
+export declare const DeleteNotificationTemplateRequestSchema: GenMessage<DeleteNotificationTemplateRequest>;
+
+/**
+ * DeleteNotificationTemplateResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.DeleteNotificationTemplateResponse
+ */
+export declare type DeleteNotificationTemplateResponse = Message<"stockchecker.v1.DeleteNotificationTemplateResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationTemplateResponse.
+ * Use `create(DeleteNotificationTemplateResponseSchema)` to create a new message.
+ */
+export declare const DeleteNotificationTemplateResponseSchema: GenMessage<DeleteNotificationTemplateResponse>;
+
+/**
+ * SendTestNotificationRequest renders a notification from sample data and optionally sends it
+ *
+ * @generated from message stockchecker.v1.SendTestNotificationRequest
+ */
+export declare type SendTestNotificationRequest = Message<"stockchecker.v1.SendTestNotificationRequest"> & {
+  /**
+   * @generated from field: string channel_type = 1;
+   */
+  channelType: string;
+
+  /**
+   * optional unsaved template to preview; defaults to the configured one
+   *
+   * @generated from field: stockchecker.v1.NotificationTemplate template = 2;
+   */
+  template?: NotificationTemplate;
+
+  /**
+   * render without sending
+   *
+   * @generated from field: bool preview_only = 3;
+   */
+  previewOnly: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.SendTestNotificationRequest.
+ * Use `create(SendTestNotificationRequestSchema)` to create a new message.
+ */
+export declare const SendTestNotificationRequestSchema: GenMessage<SendTestNotificationRequest>;
+
+/**
+ * SendTestNotificationResponse returns the rendered notification
+ *
+ * @generated from message stockchecker.v1.SendTestNotificationResponse
+ */
+export declare type SendTestNotificationResponse = Message<"stockchecker.v1.SendTestNotificationResponse"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string body = 2;
+   */
+  body: string;
+
+  /**
+   * @generated from field: bool sent = 3;
+   */
+  sent: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.SendTestNotificationResponse.
+ * Use `create(SendTestNotificationResponseSchema)` to create a new message.
+ */
+export declare const SendTestNotificationResponseSchema: GenMessage<SendTestNotificationResponse>;
+
+/**
+ * SimulateWatcherCycleRequest configures a dry run of the stock watcher (admin only)
+ *
+ * @generated from message stockchecker.v1.SimulateWatcherCycleRequest
+ */
+export declare type SimulateWatcherCycleRequest = Message<"stockchecker.v1.SimulateWatcherCycleRequest"> & {
+  /**
+   * check against mock data instead of the live Best Buy API
+   *
+   * @generated from field: bool use_mock_data = 1;
+   */
+  useMockData: boolean;
+
+  /**
+   * treat every in-stock store as new instead of starting from the watcher's current state
+   *
+   * @generated from field: bool from_empty = 2;
+   */
+  fromEmpty: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.SimulateWatcherCycleRequest.
+ * Use `create(SimulateWatcherCycleRequestSchema)` to create a new message.
+ */
+export declare const SimulateWatcherCycleRequestSchema: GenMessage<SimulateWatcherCycleRequest>;
+
+/**
+ * SimulatedNotification is a notification the watcher would send
+ *
+ * @generated from message stockchecker.v1.SimulatedNotification
+ */
+export declare type SimulatedNotification = Message<"stockchecker.v1.SimulatedNotification"> & {
+  /**
+   * @generated from field: stockchecker.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: stockchecker.v1.Product product = 2;
+   */
+  product?: Product;
+
+  /**
+   * stores that came into stock
+   *
+   * @generated from field: repeated stockchecker.v1.Store stores = 3;
+   */
+  stores: Store[];
+
+  /**
+   * empty if the user has no enabled channel (nothing would be sent)
+   *
+   * @generated from field: string channel_type = 4;
+   */
+  channelType: string;
+
+  /**
+   * @generated from field: string title = 5;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string body = 6;
+   */
+  body: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.SimulatedNotification.
+ * Use `create(SimulatedNotificationSchema)` to create a new message.
+ */
+export declare const SimulatedNotificationSchema: GenMessage<SimulatedNotification>;
+
+/**
+ * SimulateWatcherCycleResponse lists the notifications that would fire
+ *
+ * @generated from message stockchecker.v1.SimulateWatcherCycleResponse
+ */
+export declare type SimulateWatcherCycleResponse = Message<"stockchecker.v1.SimulateWatcherCycleResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.SimulatedNotification notifications = 1;
+   */
+  notifications: SimulatedNotification[];
+};
+
+/**
+ * Describes the message stockchecker.v1.SimulateWatcherCycleResponse.
+ * Use `create(SimulateWatcherCycleResponseSchema)` to create a new message.
+ */
+export declare const SimulateWatcherCycleResponseSchema: GenMessage<SimulateWatcherCycleResponse>;
+
+/**
+ * GetMyDashboardRequest selects the range of the daily availability history
+ *
+ * @generated from message stockchecker.v1.GetMyDashboardRequest
+ */
+export declare type GetMyDashboardRequest = Message<"stockchecker.v1.GetMyDashboardRequest"> & {
+  /**
+   * days of history including today; defaults to 7, max 90
+   *
+   * @generated from field: int32 days = 1;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyDashboardRequest.
+ * Use `create(GetMyDashboardRequestSchema)` to create a new message.
+ */
+export declare const GetMyDashboardRequestSchema: GenMessage<GetMyDashboardRequest>;
+
+/**
+ * CurrentAvailability is the current stock state of a watched product at a saved store
+ *
+ * @generated from message stockchecker.v1.CurrentAvailability
+ */
+export declare type CurrentAvailability = Message<"stockchecker.v1.CurrentAvailability"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string product_name = 2;
+   */
+  productName: string;
+
+  /**
+   * @generated from field: string store_id = 3;
+   */
+  storeId: string;
+
+  /**
+   * @generated from field: string store_name = 4;
+   */
+  storeName: string;
+
+  /**
+   * @generated from field: bool in_stock = 5;
+   */
+  inStock: boolean;
+
+  /**
+   * @generated from field: bool low_stock = 6;
+   */
+  lowStock: boolean;
+
+  /**
+   * RFC 3339 time of the last change
+   *
+   * @generated from field: string since = 7;
+   */
+  since: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.CurrentAvailability.
+ * Use `create(CurrentAvailabilitySchema)` to create a new message.
+ */
+export declare const CurrentAvailabilitySchema: GenMessage<CurrentAvailability>;
+
+/**
+ * DailyAvailability is how long a product was in stock at a store on one UTC day
+ *
+ * @generated from message stockchecker.v1.DailyAvailability
+ */
+export declare type DailyAvailability = Message<"stockchecker.v1.DailyAvailability"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string store_id = 2;
+   */
+  storeId: string;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string day = 3;
+   */
+  day: string;
+
+  /**
+   * @generated from field: int32 in_stock_minutes = 4;
+   */
+  inStockMinutes: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.DailyAvailability.
+ * Use `create(DailyAvailabilitySchema)` to create a new message.
+ */
+export declare const DailyAvailabilitySchema: GenMessage<DailyAvailability>;
+
+/**
+ * GetMyDashboardResponse summarizes stock for the user's products at their saved stores
+ *
+ * @generated from message stockchecker.v1.GetMyDashboardResponse
+ */
+export declare type GetMyDashboardResponse = Message<"stockchecker.v1.GetMyDashboardResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.CurrentAvailability availability = 1;
+   */
+  availability: CurrentAvailability[];
+
+  /**
+   * @generated from field: repeated stockchecker.v1.DailyAvailability daily = 2;
+   */
+  daily: DailyAvailability[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyDashboardResponse.
+ * Use `create(GetMyDashboardResponseSchema)` to create a new message.
+ */
+export declare const GetMyDashboardResponseSchema: GenMessage<GetMyDashboardResponse>;
+
+/**
+ * UpdateMyProductRequest changes details of a saved product
+ *
+ * @generated from message stockchecker.v1.UpdateMyProductRequest
+ */
+export declare type UpdateMyProductRequest = Message<"stockchecker.v1.UpdateMyProductRequest"> & {
+  /**
+   * sku identifies the product and can't be changed
+   *
+   * @generated from field: stockchecker.v1.Product product = 1;
+   */
+  product?: Product;
+
+  /**
+   * name, sale_price_cents, thumbnail_url, product_url; empty updates all
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message stockchecker.v1.UpdateMyProductRequest.
+ * Use `create(UpdateMyProductRequestSchema)` to create a new message.
+ */
+export declare const UpdateMyProductRequestSchema: GenMessage<UpdateMyProductRequest>;
+
+/**
+ * UpdateMyProductResponse returns the updated product
+ *
+ * @generated from message stockchecker.v1.UpdateMyProductResponse
+ */
+export declare type UpdateMyProductResponse = Message<"stockchecker.v1.UpdateMyProductResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Product product = 1;
+   */
+  product?: Product;
+};
+
+/**
+ * Describes the message stockchecker.v1.UpdateMyProductResponse.
+ * Use `create(UpdateMyProductResponseSchema)` to create a new message.
+ */
+export declare const UpdateMyProductResponseSchema: GenMessage<UpdateMyProductResponse>;
+
+/**
+ * NotificationPreferences control which alerts the user receives
+ *
+ * @generated from message stockchecker.v1.NotificationPreferences
+ */
+export declare type NotificationPreferences = Message<"stockchecker.v1.NotificationPreferences"> & {
+  /**
+   * @generated from field: bool alerts_enabled = 1;
+   */
+  alertsEnabled: boolean;
+
+  /**
+   * alert on stores reporting low stock
+   *
+   * @generated from field: bool include_low_stock = 2;
+   */
+  includeLowStock: boolean;
+
+  /**
+   * ignore stores further away; 0 means no limit
+   *
+   * @generated from field: double max_distance_miles = 3;
+   */
+  maxDistanceMiles: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 4;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * channel types must-have alerts go to; empty for every enabled channel
+   *
+   * @generated from field: repeated string urgent_channels = 5;
+   */
+  urgentChannels: string[];
+
+  /**
+   * how long nice-to-have alerts wait for a digest (1-168, default 24)
+   *
+   * @generated from field: int32 digest_interval_hours = 6;
+   */
+  digestIntervalHours: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.NotificationPreferences.
+ * Use `create(NotificationPreferencesSchema)` to create a new message.
+ */
+export declare const NotificationPreferencesSchema: GenMessage<NotificationPreferences>;
+
+/**
+ * GetNotificationPreferencesRequest is empty - user is determined from session
+ *
+ * @generated from message stockchecker.v1.GetNotificationPreferencesRequest
+ */
+export declare type GetNotificationPreferencesRequest = Message<"stockchecker.v1.GetNotificationPreferencesRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationPreferencesRequest.
+ * Use `create(GetNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export declare const GetNotificationPreferencesRequestSchema: GenMessage<GetNotificationPreferencesRequest>;
+
+/**
+ * GetNotificationPreferencesResponse returns the user's preferences (defaults if never saved)
+ *
+ * @generated from message stockchecker.v1.GetNotificationPreferencesResponse
+ */
+export declare type GetNotificationPreferencesResponse = Message<"stockchecker.v1.GetNotificationPreferencesResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationPreferencesResponse.
+ * Use `create(GetNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export declare const GetNotificationPreferencesResponseSchema: GenMessage<GetNotificationPreferencesResponse>;
+
+/**
+ * UpdateNotificationPreferencesRequest changes the user's preferences
+ *
+ * @generated from message stockchecker.v1.UpdateNotificationPreferencesRequest
+ */
+export declare type UpdateNotificationPreferencesRequest = Message<"stockchecker.v1.UpdateNotificationPreferencesRequest"> & {
+  /**
+   * @generated from field: stockchecker.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences;
+
+  /**
+   * alerts_enabled, include_low_stock, max_distance_miles; empty updates all
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message stockchecker.v1.UpdateNotificationPreferencesRequest.
+ * Use `create(UpdateNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export declare const UpdateNotificationPreferencesRequestSchema: GenMessage<UpdateNotificationPreferencesRequest>;
+
+/**
+ * UpdateNotificationPreferencesResponse returns the updated preferences
+ *
+ * @generated from message stockchecker.v1.UpdateNotificationPreferencesResponse
+ */
+export declare type UpdateNotificationPreferencesResponse = Message<"stockchecker.v1.UpdateNotificationPreferencesResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.NotificationPreferences preferences = 1;
+   */
+  preferences?: NotificationPreferences;
+};
+
+/**
+ * Describes the message stockchecker.v1.UpdateNotificationPreferencesResponse.
+ * Use `create(UpdateNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export declare const UpdateNotificationPreferencesResponseSchema: GenMessage<UpdateNotificationPreferencesResponse>;
+
+/**
+ * AlertRule narrows when a watched product triggers an alert
+ *
+ * @generated from message stockchecker.v1.AlertRule
+ */
+export declare type AlertRule = Message<"stockchecker.v1.AlertRule"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * only alert at or below this price; 0 means any price
+   *
+   * @generated from field: int64 max_price_cents = 3;
+   */
+  maxPriceCents: bigint;
+
+  /**
+   * only alert when at least this many stores come into stock in one check
+   *
+   * @generated from field: int32 min_stores = 4;
+   */
+  minStores: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * only alert for stores within this distance of location; 0 means no limit
+   *
+   * @generated from field: double max_distance_miles = 6;
+   */
+  maxDistanceMiles: number;
+
+  /**
+   * name of one of the user's locations; empty measures from the watched postal code
+   *
+   * @generated from field: string location = 7;
+   */
+  location: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AlertRule.
+ * Use `create(AlertRuleSchema)` to create a new message.
+ */
+export declare const AlertRuleSchema: GenMessage<AlertRule>;
+
+/**
+ * GetAlertRulesRequest is empty - user is determined from session
+ *
+ * @generated from message stockchecker.v1.GetAlertRulesRequest
+ */
+export declare type GetAlertRulesRequest = Message<"stockchecker.v1.GetAlertRulesRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetAlertRulesRequest.
+ * Use `create(GetAlertRulesRequestSchema)` to create a new message.
+ */
+export declare const GetAlertRulesRequestSchema: GenMessage<GetAlertRulesRequest>;
+
+/**
+ * GetAlertRulesResponse returns the rules the user has saved; other products use the defaults
+ *
+ * @generated from message stockchecker.v1.GetAlertRulesResponse
+ */
+export declare type GetAlertRulesResponse = Message<"stockchecker.v1.GetAlertRulesResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.AlertRule rules = 1;
+   */
+  rules: AlertRule[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetAlertRulesResponse.
+ * Use `create(GetAlertRulesResponseSchema)` to create a new message.
+ */
+export declare const GetAlertRulesResponseSchema: GenMessage<GetAlertRulesResponse>;
+
+/**
+ * UpdateAlertRuleRequest creates or changes the rule for a watched product
+ *
+ * @generated from message stockchecker.v1.UpdateAlertRuleRequest
+ */
+export declare type UpdateAlertRuleRequest = Message<"stockchecker.v1.UpdateAlertRuleRequest"> & {
+  /**
+   * sku identifies the product
+   *
+   * @generated from field: stockchecker.v1.AlertRule rule = 1;
+   */
+  rule?: AlertRule;
+
+  /**
+   * enabled, max_price_cents, min_stores, max_distance_miles, location; empty updates all
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message stockchecker.v1.UpdateAlertRuleRequest.
+ * Use `create(UpdateAlertRuleRequestSchema)` to create a new message.
+ */
+export declare const UpdateAlertRuleRequestSchema: GenMessage<UpdateAlertRuleRequest>;
+
+/**
+ * UpdateAlertRuleResponse returns the updated rule
+ *
+ * @generated from message stockchecker.v1.UpdateAlertRuleResponse
+ */
+export declare type UpdateAlertRuleResponse = Message<"stockchecker.v1.UpdateAlertRuleResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.AlertRule rule = 1;
+   */
+  rule?: AlertRule;
+};
+
+/**
+ * Describes the message stockchecker.v1.UpdateAlertRuleResponse.
+ * Use `create(UpdateAlertRuleResponseSchema)` to create a new message.
+ */
+export declare const UpdateAlertRuleResponseSchema: GenMessage<UpdateAlertRuleResponse>;
+
+/**
+ * SyncChangesRequest asks for everything that changed since a previous sync
+ *
+ * @generated from message stockchecker.v1.SyncChangesRequest
+ */
+export declare type SyncChangesRequest = Message<"stockchecker.v1.SyncChangesRequest"> & {
+  /**
+   * next_token from the previous sync; empty for a full sync
+   *
+   * @generated from field: string since_token = 1;
+   */
+  sinceToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.SyncChangesRequest.
+ * Use `create(SyncChangesRequestSchema)` to create a new message.
+ */
+export declare const SyncChangesRequestSchema: GenMessage<SyncChangesRequest>;
+
+/**
+ * StockSnapshot is the latest stock state the watcher saw for a product near a postal code
+ *
+ * @generated from message stockchecker.v1.StockSnapshot
+ */
+export declare type StockSnapshot = Message<"stockchecker.v1.StockSnapshot"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string postal_code = 2;
+   */
+  postalCode: string;
+
+  /**
+   * @generated from field: repeated string in_stock_store_ids = 3;
+   */
+  inStockStoreIds: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 4;
+   */
+  checkedAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.StockSnapshot.
+ * Use `create(StockSnapshotSchema)` to create a new message.
+ */
+export declare const StockSnapshotSchema: GenMessage<StockSnapshot>;
+
+/**
+ * SyncChangesResponse lists the changes to apply locally. Changes can repeat
+ * across syncs, so clients should apply them as upserts.
+ *
+ * @generated from message stockchecker.v1.SyncChangesResponse
+ */
+export declare type SyncChangesResponse = Message<"stockchecker.v1.SyncChangesResponse"> & {
+  /**
+   * saved stores added or changed
+   *
+   * @generated from field: repeated stockchecker.v1.Store stores = 1;
+   */
+  stores: Store[];
+
+  /**
+   * @generated from field: repeated string removed_store_ids = 2;
+   */
+  removedStoreIds: string[];
+
+  /**
+   * saved products added or changed
+   *
+   * @generated from field: repeated stockchecker.v1.Product products = 3;
+   */
+  products: Product[];
+
+  /**
+   * @generated from field: repeated string removed_skus = 4;
+   */
+  removedSkus: string[];
+
+  /**
+   * unset if unchanged
+   *
+   * @generated from field: stockchecker.v1.NotificationPreferences preferences = 5;
+   */
+  preferences?: NotificationPreferences;
+
+  /**
+   * rules added or changed
+   *
+   * @generated from field: repeated stockchecker.v1.AlertRule alert_rules = 6;
+   */
+  alertRules: AlertRule[];
+
+  /**
+   * latest stock near the user's stores
+   *
+   * @generated from field: repeated stockchecker.v1.StockSnapshot stock_snapshots = 7;
+   */
+  stockSnapshots: StockSnapshot[];
+
+  /**
+   * pass as since_token on the next sync
+   *
+   * @generated from field: string next_token = 8;
+   */
+  nextToken: string;
+
+  /**
+   * true if this is a full snapshot that replaces local state
+   *
+   * @generated from field: bool full_sync = 9;
+   */
+  fullSync: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.SyncChangesResponse.
+ * Use `create(SyncChangesResponseSchema)` to create a new message.
+ */
+export declare const SyncChangesResponseSchema: GenMessage<SyncChangesResponse>;
+
+/**
+ * WatchlistChange is one change to the user's saved stores or products, from any client
+ *
+ * @generated from message stockchecker.v1.WatchlistChange
+ */
+export declare type WatchlistChange = Message<"stockchecker.v1.WatchlistChange"> & {
+  /**
+   * retailer ID, e.g. "bestbuy"
+   *
+   * @generated from field: string retailer = 1;
+   */
+  retailer: string;
+
+  /**
+   * set for product changes
+   *
+   * @generated from field: string sku = 2;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: stockchecker.v1.WatchlistChangeAction action = 3;
+   */
+  action: WatchlistChangeAction;
+
+  /**
+   * name of the product or store when the change was made
+   *
+   * @generated from field: string product_name = 4;
+   */
+  productName: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp changed_at = 5;
+   */
+  changedAt?: Timestamp;
+
+  /**
+   * set for store changes
+   *
+   * @generated from field: string store_id = 6;
+   */
+  storeId: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchlistChange.
+ * Use `create(WatchlistChangeSchema)` to create a new message.
+ */
+export declare const WatchlistChangeSchema: GenMessage<WatchlistChange>;
+
+/**
+ * ListWatchlistChangesRequest asks for changes to the user's saved stores and products
+ *
+ * @generated from message stockchecker.v1.ListWatchlistChangesRequest
+ */
+export declare type ListWatchlistChangesRequest = Message<"stockchecker.v1.ListWatchlistChangesRequest"> & {
+  /**
+   * changes at or after this time; unset for all
+   *
+   * @generated from field: google.protobuf.Timestamp since = 1;
+   */
+  since?: Timestamp;
+
+  /**
+   * default 50, max 200
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ListWatchlistChangesRequest.
+ * Use `create(ListWatchlistChangesRequestSchema)` to create a new message.
+ */
+export declare const ListWatchlistChangesRequestSchema: GenMessage<ListWatchlistChangesRequest>;
+
+/**
+ * ListWatchlistChangesResponse lists changes oldest first
+ *
+ * @generated from message stockchecker.v1.ListWatchlistChangesResponse
+ */
+export declare type ListWatchlistChangesResponse = Message<"stockchecker.v1.ListWatchlistChangesResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.WatchlistChange changes = 1;
+   */
+  changes: WatchlistChange[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ListWatchlistChangesResponse.
+ * Use `create(ListWatchlistChangesResponseSchema)` to create a new message.
+ */
+export declare const ListWatchlistChangesResponseSchema: GenMessage<ListWatchlistChangesResponse>;
+
+/**
+ * UndoLastChangeRequest asks to undo the user's most recent watchlist change
+ *
+ * @generated from message stockchecker.v1.UndoLastChangeRequest
+ */
+export declare type UndoLastChangeRequest = Message<"stockchecker.v1.UndoLastChangeRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.UndoLastChangeRequest.
+ * Use `create(UndoLastChangeRequestSchema)` to create a new message.
+ */
+export declare const UndoLastChangeRequestSchema: GenMessage<UndoLastChangeRequest>;
+
+/**
+ * UndoLastChangeResponse is the change that was undone. Undoing it is logged
+ * as a change of its own.
+ *
+ * @generated from message stockchecker.v1.UndoLastChangeResponse
+ */
+export declare type UndoLastChangeResponse = Message<"stockchecker.v1.UndoLastChangeResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.WatchlistChange undone = 1;
+   */
+  undone?: WatchlistChange;
+};
+
+/**
+ * Describes the message stockchecker.v1.UndoLastChangeResponse.
+ * Use `create(UndoLastChangeResponseSchema)` to create a new message.
+ */
+export declare const UndoLastChangeResponseSchema: GenMessage<UndoLastChangeResponse>;
+
+/**
+ * SetWatch is a rule to save every product from a TCG set
+ *
+ * @generated from message stockchecker.v1.SetWatch
+ */
+export declare type SetWatch = Message<"stockchecker.v1.SetWatch"> & {
+  /**
+   * @generated from field: string set_name = 1;
+   */
+  setName: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * details of the set; unset if unknown
+   *
+   * @generated from field: stockchecker.v1.TcgSet tcg_set = 3;
+   */
+  tcgSet?: TcgSet;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetWatch.
+ * Use `create(SetWatchSchema)` to create a new message.
+ */
+export declare const SetWatchSchema: GenMessage<SetWatch>;
+
+/**
+ * TcgSet is a TCG expansion's details, from the Pokemon TCG API
+ *
+ * @generated from message stockchecker.v1.TcgSet
+ */
+export declare type TcgSet = Message<"stockchecker.v1.TcgSet"> & {
+  /**
+   * e.g. "sv8pt5"
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string series = 3;
+   */
+  series: string;
+
+  /**
+   * midnight UTC on the release day
+   *
+   * @generated from field: google.protobuf.Timestamp release_date = 4;
+   */
+  releaseDate?: Timestamp;
+
+  /**
+   * cards numbered in the set, as printed on them
+   *
+   * @generated from field: int32 printed_card_count = 5;
+   */
+  printedCardCount: number;
+
+  /**
+   * every card, including secret rares
+   *
+   * @generated from field: int32 card_count = 6;
+   */
+  cardCount: number;
+
+  /**
+   * @generated from field: string logo_url = 7;
+   */
+  logoUrl: string;
+
+  /**
+   * @generated from field: string symbol_url = 8;
+   */
+  symbolUrl: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.TcgSet.
+ * Use `create(TcgSetSchema)` to create a new message.
+ */
+export declare const TcgSetSchema: GenMessage<TcgSet>;
+
+/**
+ * Msrp is the manufacturer's suggested retail price of a product type
+ *
+ * @generated from message stockchecker.v1.Msrp
+ */
+export declare type Msrp = Message<"stockchecker.v1.Msrp"> & {
+  /**
+   * empty for every set; a set's own price wins
+   *
+   * @generated from field: string set_name = 1;
+   */
+  setName: string;
+
+  /**
+   * @generated from field: stockchecker.v1.ProductType product_type = 2;
+   */
+  productType: ProductType;
+
+  /**
+   * US cents
+   *
+   * @generated from field: int64 price_cents = 3;
+   */
+  priceCents: bigint;
+};
+
+/**
+ * Describes the message stockchecker.v1.Msrp.
+ * Use `create(MsrpSchema)` to create a new message.
+ */
+export declare const MsrpSchema: GenMessage<Msrp>;
+
+/**
+ * ListMsrpsRequest is empty
+ *
+ * @generated from message stockchecker.v1.ListMsrpsRequest
+ */
+export declare type ListMsrpsRequest = Message<"stockchecker.v1.ListMsrpsRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.ListMsrpsRequest.
+ * Use `create(ListMsrpsRequestSchema)` to create a new message.
+ */
+export declare const ListMsrpsRequestSchema: GenMessage<ListMsrpsRequest>;
+
+/**
+ * ListMsrpsResponse lists the maintained MSRPs
+ *
+ * @generated from message stockchecker.v1.ListMsrpsResponse
+ */
+export declare type ListMsrpsResponse = Message<"stockchecker.v1.ListMsrpsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Msrp msrps = 1;
+   */
+  msrps: Msrp[];
+};
+
+/**
+ * Describes the message stockchecker.v1.ListMsrpsResponse.
+ * Use `create(ListMsrpsResponseSchema)` to create a new message.
+ */
+export declare const ListMsrpsResponseSchema: GenMessage<ListMsrpsResponse>;
+
+/**
+ * SetMsrpRequest sets an MSRP (admin only). A zero price removes it.
+ *
+ * @generated from message stockchecker.v1.SetMsrpRequest
+ */
+export declare type SetMsrpRequest = Message<"stockchecker.v1.SetMsrpRequest"> & {
+  /**
+   * @generated from field: stockchecker.v1.Msrp msrp = 1;
+   */
+  msrp?: Msrp;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetMsrpRequest.
+ * Use `create(SetMsrpRequestSchema)` to create a new message.
+ */
+export declare const SetMsrpRequestSchema: GenMessage<SetMsrpRequest>;
+
+/**
+ * SetMsrpResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.SetMsrpResponse
+ */
+export declare type SetMsrpResponse = Message<"stockchecker.v1.SetMsrpResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.SetMsrpResponse.
+ * Use `create(SetMsrpResponseSchema)` to create a new message.
+ */
+export declare const SetMsrpResponseSchema: GenMessage<SetMsrpResponse>;
+
+/**
+ * GetProductDetailsRequest asks for a Best Buy product's details
+ *
+ * @generated from message stockchecker.v1.GetProductDetailsRequest
+ */
+export declare type GetProductDetailsRequest = Message<"stockchecker.v1.GetProductDetailsRequest"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetProductDetailsRequest.
+ * Use `create(GetProductDetailsRequestSchema)` to create a new message.
+ */
+export declare const GetProductDetailsRequestSchema: GenMessage<GetProductDetailsRequest>;
+
+/**
+ * GetProductDetailsResponse is a product with the details of its TCG set
+ *
+ * @generated from message stockchecker.v1.GetProductDetailsResponse
+ */
+export declare type GetProductDetailsResponse = Message<"stockchecker.v1.GetProductDetailsResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Product product = 1;
+   */
+  product?: Product;
+
+  /**
+   * unset if the product isn't from a known set
+   *
+   * @generated from field: stockchecker.v1.TcgSet tcg_set = 2;
+   */
+  tcgSet?: TcgSet;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetProductDetailsResponse.
+ * Use `create(GetProductDetailsResponseSchema)` to create a new message.
+ */
+export declare const GetProductDetailsResponseSchema: GenMessage<GetProductDetailsResponse>;
+
+/**
+ * GetMySetWatchesRequest is empty - user is determined from session
+ *
+ * @generated from message stockchecker.v1.GetMySetWatchesRequest
+ */
+export declare type GetMySetWatchesRequest = Message<"stockchecker.v1.GetMySetWatchesRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMySetWatchesRequest.
+ * Use `create(GetMySetWatchesRequestSchema)` to create a new message.
+ */
+export declare const GetMySetWatchesRequestSchema: GenMessage<GetMySetWatchesRequest>;
+
+/**
+ * GetMySetWatchesResponse lists the sets the user watches
+ *
+ * @generated from message stockchecker.v1.GetMySetWatchesResponse
+ */
+export declare type GetMySetWatchesResponse = Message<"stockchecker.v1.GetMySetWatchesResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.SetWatch set_watches = 1;
+   */
+  setWatches: SetWatch[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMySetWatchesResponse.
+ * Use `create(GetMySetWatchesResponseSchema)` to create a new message.
+ */
+export declare const GetMySetWatchesResponseSchema: GenMessage<GetMySetWatchesResponse>;
+
+/**
+ * WatchSetRequest asks to save every product from a set, now and as they're listed
+ *
+ * @generated from message stockchecker.v1.WatchSetRequest
+ */
+export declare type WatchSetRequest = Message<"stockchecker.v1.WatchSetRequest"> & {
+  /**
+   * e.g. "Prismatic Evolutions"
+   *
+   * @generated from field: string set_name = 1;
+   */
+  setName: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchSetRequest.
+ * Use `create(WatchSetRequestSchema)` to create a new message.
+ */
+export declare const WatchSetRequestSchema: GenMessage<WatchSetRequest>;
+
+/**
+ * WatchSetResponse lists the products saved from the set right away
+ *
+ * @generated from message stockchecker.v1.WatchSetResponse
+ */
+export declare type WatchSetResponse = Message<"stockchecker.v1.WatchSetResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.SetWatch set_watch = 1;
+   */
+  setWatch?: SetWatch;
+
+  /**
+   * @generated from field: repeated stockchecker.v1.Product added_products = 2;
+   */
+  addedProducts: Product[];
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchSetResponse.
+ * Use `create(WatchSetResponseSchema)` to create a new message.
+ */
+export declare const WatchSetResponseSchema: GenMessage<WatchSetResponse>;
+
+/**
+ * UnwatchSetRequest asks to stop watching a set. Products already saved stay saved.
+ *
+ * @generated from message stockchecker.v1.UnwatchSetRequest
+ */
+export declare type UnwatchSetRequest = Message<"stockchecker.v1.UnwatchSetRequest"> & {
+  /**
+   * @generated from field: string set_name = 1;
+   */
+  setName: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.UnwatchSetRequest.
+ * Use `create(UnwatchSetRequestSchema)` to create a new message.
+ */
+export declare const UnwatchSetRequestSchema: GenMessage<UnwatchSetRequest>;
+
+/**
+ * UnwatchSetResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.UnwatchSetResponse
+ */
+export declare type UnwatchSetResponse = Message<"stockchecker.v1.UnwatchSetResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.UnwatchSetResponse.
+ * Use `create(UnwatchSetResponseSchema)` to create a new message.
+ */
+export declare const UnwatchSetResponseSchema: GenMessage<UnwatchSetResponse>;
+
+/**
+ * Acquisition is a purchase of one of the user's saved products
+ *
+ * @generated from message stockchecker.v1.Acquisition
+ */
+export declare type Acquisition = Message<"stockchecker.v1.Acquisition"> & {
+  /**
+   * assigned when recorded
+   *
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string sku = 2;
+   */
+  sku: string;
+
+  /**
+   * copied from the saved product
+   *
+   * @generated from field: string product_name = 3;
+   */
+  productName: string;
+
+  /**
+   * TCG set of the product; empty if none
+   *
+   * @generated from field: string set_name = 4;
+   */
+  setName: string;
+
+  /**
+   * defaults to 1
+   *
+   * @generated from field: int32 quantity = 5;
+   */
+  quantity: number;
+
+  /**
+   * price paid per item, in currency_code
+   *
+   * @generated from field: int64 price_cents = 6;
+   */
+  priceCents: bigint;
+
+  /**
+   * ISO 4217 code; empty means USD
+   *
+   * @generated from field: string currency_code = 7;
+   */
+  currencyCode: string;
+
+  /**
+   * where it was bought, e.g. a store name or "online"; optional
+   *
+   * @generated from field: string store_name = 8;
+   */
+  storeName: string;
+
+  /**
+   * YYYY-MM-DD; defaults to today (UTC)
+   *
+   * @generated from field: string purchased_on = 9;
+   */
+  purchasedOn: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.Acquisition.
+ * Use `create(AcquisitionSchema)` to create a new message.
+ */
+export declare const AcquisitionSchema: GenMessage<Acquisition>;
+
+/**
+ * MarkPurchasedRequest records a purchase of a saved product. The product's
+ * name and set are filled in from the user's list.
+ *
+ * @generated from message stockchecker.v1.MarkPurchasedRequest
+ */
+export declare type MarkPurchasedRequest = Message<"stockchecker.v1.MarkPurchasedRequest"> & {
+  /**
+   * @generated from field: stockchecker.v1.Acquisition acquisition = 1;
+   */
+  acquisition?: Acquisition;
+};
+
+/**
+ * Describes the message stockchecker.v1.MarkPurchasedRequest.
+ * Use `create(MarkPurchasedRequestSchema)` to create a new message.
+ */
+export declare const MarkPurchasedRequestSchema: GenMessage<MarkPurchasedRequest>;
+
+/**
+ * MarkPurchasedResponse returns the recorded purchase
+ *
+ * @generated from message stockchecker.v1.MarkPurchasedResponse
+ */
+export declare type MarkPurchasedResponse = Message<"stockchecker.v1.MarkPurchasedResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Acquisition acquisition = 1;
+   */
+  acquisition?: Acquisition;
+};
+
+/**
+ * Describes the message stockchecker.v1.MarkPurchasedResponse.
+ * Use `create(MarkPurchasedResponseSchema)` to create a new message.
+ */
+export declare const MarkPurchasedResponseSchema: GenMessage<MarkPurchasedResponse>;
+
+/**
+ * GetMyAcquisitionsRequest selects a range of the user's purchases
+ *
+ * @generated from message stockchecker.v1.GetMyAcquisitionsRequest
+ */
+export declare type GetMyAcquisitionsRequest = Message<"stockchecker.v1.GetMyAcquisitionsRequest"> & {
+  /**
+   * YYYY-MM-DD, inclusive; empty for no lower bound
+   *
+   * @generated from field: string from = 1;
+   */
+  from: string;
+
+  /**
+   * YYYY-MM-DD, inclusive; empty for no upper bound
+   *
+   * @generated from field: string until = 2;
+   */
+  until: string;
+
+  /**
+   * default 50, max 200
+   *
+   * @generated from field: int32 page_size = 3;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 4;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyAcquisitionsRequest.
+ * Use `create(GetMyAcquisitionsRequestSchema)` to create a new message.
+ */
+export declare const GetMyAcquisitionsRequestSchema: GenMessage<GetMyAcquisitionsRequest>;
+
+/**
+ * GetMyAcquisitionsResponse lists purchases newest first
+ *
+ * @generated from message stockchecker.v1.GetMyAcquisitionsResponse
+ */
+export declare type GetMyAcquisitionsResponse = Message<"stockchecker.v1.GetMyAcquisitionsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Acquisition acquisitions = 1;
+   */
+  acquisitions: Acquisition[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyAcquisitionsResponse.
+ * Use `create(GetMyAcquisitionsResponseSchema)` to create a new message.
+ */
+export declare const GetMyAcquisitionsResponseSchema: GenMessage<GetMyAcquisitionsResponse>;
+
+/**
+ * DeleteAcquisitionRequest selects one of the user's purchases
+ *
+ * @generated from message stockchecker.v1.DeleteAcquisitionRequest
+ */
+export declare type DeleteAcquisitionRequest = Message<"stockchecker.v1.DeleteAcquisitionRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteAcquisitionRequest.
+ * Use `create(DeleteAcquisitionRequestSchema)` to create a new message.
+ */
+export declare const DeleteAcquisitionRequestSchema: GenMessage<DeleteAcquisitionRequest>;
+
+/**
+ * DeleteAcquisitionResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.DeleteAcquisitionResponse
+ */
+export declare type DeleteAcquisitionResponse = Message<"stockchecker.v1.DeleteAcquisitionResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteAcquisitionResponse.
+ * Use `create(DeleteAcquisitionResponseSchema)` to create a new message.
+ */
+export declare const DeleteAcquisitionResponseSchema: GenMessage<DeleteAcquisitionResponse>;
+
+/**
+ * SpendTotal is the spend of a group of purchases in one currency
+ *
+ * @generated from message stockchecker.v1.SpendTotal
+ */
+export declare type SpendTotal = Message<"stockchecker.v1.SpendTotal"> & {
+  /**
+   * the month ("2026-01") or set name the total is for; empty for unparsed sets and overall totals
+   *
+   * @generated from field: string key = 1;
+   */
+  key: string;
+
+  /**
+   * empty means USD
+   *
+   * @generated from field: string currency_code = 2;
+   */
+  currencyCode: string;
+
+  /**
+   * @generated from field: int64 total_cents = 3;
+   */
+  totalCents: bigint;
+
+  /**
+   * items bought
+   *
+   * @generated from field: int32 quantity = 4;
+   */
+  quantity: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.SpendTotal.
+ * Use `create(SpendTotalSchema)` to create a new message.
+ */
+export declare const SpendTotalSchema: GenMessage<SpendTotal>;
+
+/**
+ * GetAcquisitionSummaryRequest selects the purchases to total
+ *
+ * @generated from message stockchecker.v1.GetAcquisitionSummaryRequest
+ */
+export declare type GetAcquisitionSummaryRequest = Message<"stockchecker.v1.GetAcquisitionSummaryRequest"> & {
+  /**
+   * YYYY-MM-DD, inclusive; empty for no lower bound
+   *
+   * @generated from field: string from = 1;
+   */
+  from: string;
+
+  /**
+   * YYYY-MM-DD, inclusive; empty for no upper bound
+   *
+   * @generated from field: string until = 2;
+   */
+  until: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetAcquisitionSummaryRequest.
+ * Use `create(GetAcquisitionSummaryRequestSchema)` to create a new message.
+ */
+export declare const GetAcquisitionSummaryRequestSchema: GenMessage<GetAcquisitionSummaryRequest>;
+
+/**
+ * StoreReliability scores a store's reported stock from the last 90 days of confirmations
+ *
+ * @generated from message stockchecker.v1.StoreReliability
+ */
+export declare type StoreReliability = Message<"stockchecker.v1.StoreReliability"> & {
+  /**
+   * @generated from field: string store_id = 1;
+   */
+  storeId: string;
+
+  /**
+   * confirmations that found the product on the shelf
+   *
+   * @generated from field: int32 found_count = 2;
+   */
+  foundCount: number;
+
+  /**
+   * @generated from field: int32 confirmation_count = 3;
+   */
+  confirmationCount: number;
+
+  /**
+   * estimated chance reported stock is on the shelf, 0-1; 0.5 without confirmations
+   *
+   * @generated from field: double score = 4;
+   */
+  score: number;
+
+  /**
+   * @generated from field: stockchecker.v1.StoreConfidence confidence = 5;
+   */
+  confidence: StoreConfidence;
+};
+
+/**
+ * Describes the message stockchecker.v1.StoreReliability.
+ * Use `create(StoreReliabilitySchema)` to create a new message.
+ */
+export declare const StoreReliabilitySchema: GenMessage<StoreReliability>;
+
+/**
+ * ConfirmStockRequest reports whether an alerted product was on a store's shelf
+ *
+ * @generated from message stockchecker.v1.ConfirmStockRequest
+ */
+export declare type ConfirmStockRequest = Message<"stockchecker.v1.ConfirmStockRequest"> & {
+  /**
+   * one of the user's saved products
+   *
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string store_id = 2;
+   */
+  storeId: string;
+
+  /**
+   * @generated from field: bool found = 3;
+   */
+  found: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.ConfirmStockRequest.
+ * Use `create(ConfirmStockRequestSchema)` to create a new message.
+ */
+export declare const ConfirmStockRequestSchema: GenMessage<ConfirmStockRequest>;
+
+/**
+ * ConfirmStockResponse returns the store's updated reliability
+ *
+ * @generated from message stockchecker.v1.ConfirmStockResponse
+ */
+export declare type ConfirmStockResponse = Message<"stockchecker.v1.ConfirmStockResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.StoreReliability reliability = 1;
+   */
+  reliability?: StoreReliability;
+};
+
+/**
+ * Describes the message stockchecker.v1.ConfirmStockResponse.
+ * Use `create(ConfirmStockResponseSchema)` to create a new message.
+ */
+export declare const ConfirmStockResponseSchema: GenMessage<ConfirmStockResponse>;
+
+/**
+ * GetStoreReliabilityRequest selects the stores to score
+ *
+ * @generated from message stockchecker.v1.GetStoreReliabilityRequest
+ */
+export declare type GetStoreReliabilityRequest = Message<"stockchecker.v1.GetStoreReliabilityRequest"> & {
+  /**
+   * @generated from field: repeated string store_ids = 1;
+   */
+  storeIds: string[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetStoreReliabilityRequest.
+ * Use `create(GetStoreReliabilityRequestSchema)` to create a new message.
+ */
+export declare const GetStoreReliabilityRequestSchema: GenMessage<GetStoreReliabilityRequest>;
+
+/**
+ * GetStoreReliabilityResponse scores each requested store, in request order
+ *
+ * @generated from message stockchecker.v1.GetStoreReliabilityResponse
+ */
+export declare type GetStoreReliabilityResponse = Message<"stockchecker.v1.GetStoreReliabilityResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.StoreReliability stores = 1;
+   */
+  stores: StoreReliability[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetStoreReliabilityResponse.
+ * Use `create(GetStoreReliabilityResponseSchema)` to create a new message.
+ */
+export declare const GetStoreReliabilityResponseSchema: GenMessage<GetStoreReliabilityResponse>;
+
+/**
+ * Sighting is stock a user reported seeing on a store's shelf
+ *
+ * @generated from message stockchecker.v1.Sighting
+ */
+export declare type Sighting = Message<"stockchecker.v1.Sighting"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string sku = 2;
+   */
+  sku: string;
+
+  /**
+   * looked up from the SKU
+   *
+   * @generated from field: string product_name = 3;
+   */
+  productName: string;
+
+  /**
+   * @generated from field: string store_id = 4;
+   */
+  storeId: string;
+
+  /**
+   * @generated from field: string store_name = 5;
+   */
+  storeName: string;
+
+  /**
+   * how many were on the shelf; 0 if not counted
+   *
+   * @generated from field: int32 quantity = 6;
+   */
+  quantity: number;
+
+  /**
+   * admins can fetch it with GetSightingPhoto
+   *
+   * @generated from field: bool has_photo = 7;
+   */
+  hasPhoto: boolean;
+
+  /**
+   * @generated from field: stockchecker.v1.SightingStatus status = 8;
+   */
+  status: SightingStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * unset while pending
+   *
+   * @generated from field: google.protobuf.Timestamp moderated_at = 10;
+   */
+  moderatedAt?: Timestamp;
+
+  /**
+   * Share of the reporter's recently moderated sightings that were confirmed,
+   * smoothed toward 0.5; set by ListSightings for moderators
+   *
+   * @generated from field: double reporter_score = 11;
+   */
+  reporterScore: number;
+
+  /**
+   * the reporter can't report sightings until their score recovers
+   *
+   * @generated from field: bool reporter_muted = 12;
+   */
+  reporterMuted: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.Sighting.
+ * Use `create(SightingSchema)` to create a new message.
+ */
+export declare const SightingSchema: GenMessage<Sighting>;
+
+/**
+ * ReportSightingRequest reports stock seen on a store's shelf
+ *
+ * @generated from message stockchecker.v1.ReportSightingRequest
+ */
+export declare type ReportSightingRequest = Message<"stockchecker.v1.ReportSightingRequest"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string store_id = 2;
+   */
+  storeId: string;
+
+  /**
+   * @generated from field: string store_name = 3;
+   */
+  storeName: string;
+
+  /**
+   * optional
+   *
+   * @generated from field: int32 quantity = 4;
+   */
+  quantity: number;
+
+  /**
+   * optional JPEG, PNG or WebP of at most 2 MB
+   *
+   * @generated from field: bytes photo = 5;
+   */
+  photo: Uint8Array;
+};
+
+/**
+ * Describes the message stockchecker.v1.ReportSightingRequest.
+ * Use `create(ReportSightingRequestSchema)` to create a new message.
+ */
+export declare const ReportSightingRequestSchema: GenMessage<ReportSightingRequest>;
+
+/**
+ * ReportSightingResponse returns the sighting, pending moderation
+ *
+ * @generated from message stockchecker.v1.ReportSightingResponse
+ */
+export declare type ReportSightingResponse = Message<"stockchecker.v1.ReportSightingResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Sighting sighting = 1;
+   */
+  sighting?: Sighting;
+};
+
+/**
+ * Describes the message stockchecker.v1.ReportSightingResponse.
+ * Use `create(ReportSightingResponseSchema)` to create a new message.
+ */
+export declare const ReportSightingResponseSchema: GenMessage<ReportSightingResponse>;
+
+/**
+ * ListSightingsRequest selects sightings to moderate (admin only)
+ *
+ * @generated from message stockchecker.v1.ListSightingsRequest
+ */
+export declare type ListSightingsRequest = Message<"stockchecker.v1.ListSightingsRequest"> & {
+  /**
+   * unset for every status
+   *
+   * @generated from field: stockchecker.v1.SightingStatus status = 1;
+   */
+  status: SightingStatus;
+
+  /**
+   * default 50, max 200
+   *
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 3;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ListSightingsRequest.
+ * Use `create(ListSightingsRequestSchema)` to create a new message.
+ */
+export declare const ListSightingsRequestSchema: GenMessage<ListSightingsRequest>;
+
+/**
+ * ListSightingsResponse lists sightings oldest first
+ *
+ * @generated from message stockchecker.v1.ListSightingsResponse
+ */
+export declare type ListSightingsResponse = Message<"stockchecker.v1.ListSightingsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Sighting sightings = 1;
+   */
+  sightings: Sighting[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ListSightingsResponse.
+ * Use `create(ListSightingsResponseSchema)` to create a new message.
+ */
+export declare const ListSightingsResponseSchema: GenMessage<ListSightingsResponse>;
+
+/**
+ * GetSightingPhotoRequest selects a sighting (admin only)
+ *
+ * @generated from message stockchecker.v1.GetSightingPhotoRequest
+ */
+export declare type GetSightingPhotoRequest = Message<"stockchecker.v1.GetSightingPhotoRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetSightingPhotoRequest.
+ * Use `create(GetSightingPhotoRequestSchema)` to create a new message.
+ */
+export declare const GetSightingPhotoRequestSchema: GenMessage<GetSightingPhotoRequest>;
+
+/**
+ * GetSightingPhotoResponse returns a sighting's photo
+ *
+ * @generated from message stockchecker.v1.GetSightingPhotoResponse
+ */
+export declare type GetSightingPhotoResponse = Message<"stockchecker.v1.GetSightingPhotoResponse"> & {
+  /**
+   * @generated from field: bytes photo = 1;
+   */
+  photo: Uint8Array;
+
+  /**
+   * e.g. "image/jpeg"
+   *
+   * @generated from field: string content_type = 2;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetSightingPhotoResponse.
+ * Use `create(GetSightingPhotoResponseSchema)` to create a new message.
+ */
+export declare const GetSightingPhotoResponseSchema: GenMessage<GetSightingPhotoResponse>;
+
+/**
+ * ModerateSightingRequest approves or rejects a pending sighting (admin only)
+ *
+ * @generated from message stockchecker.v1.ModerateSightingRequest
+ */
+export declare type ModerateSightingRequest = Message<"stockchecker.v1.ModerateSightingRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * approving alerts the users watching the product at the store
+   *
+   * @generated from field: bool approve = 2;
+   */
+  approve: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.ModerateSightingRequest.
+ * Use `create(ModerateSightingRequestSchema)` to create a new message.
+ */
+export declare const ModerateSightingRequestSchema: GenMessage<ModerateSightingRequest>;
+
+/**
+ * ModerateSightingResponse returns the moderated sighting
+ *
+ * @generated from message stockchecker.v1.ModerateSightingResponse
+ */
+export declare type ModerateSightingResponse = Message<"stockchecker.v1.ModerateSightingResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Sighting sighting = 1;
+   */
+  sighting?: Sighting;
+
+  /**
+   * watchers alerted about an approved sighting
+   *
+   * @generated from field: int32 alerts_sent = 2;
+   */
+  alertsSent: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.ModerateSightingResponse.
+ * Use `create(ModerateSightingResponseSchema)` to create a new message.
+ */
+export declare const ModerateSightingResponseSchema: GenMessage<ModerateSightingResponse>;
+
+/**
+ * GetAcquisitionSummaryResponse totals spend per month, per set and overall.
+ * Purchases in different currencies are totaled separately.
+ *
+ * @generated from message stockchecker.v1.GetAcquisitionSummaryResponse
+ */
+export declare type GetAcquisitionSummaryResponse = Message<"stockchecker.v1.GetAcquisitionSummaryResponse"> & {
+  /**
+   * newest first
+   *
+   * @generated from field: repeated stockchecker.v1.SpendTotal months = 1;
+   */
+  months: SpendTotal[];
+
+  /**
+   * highest spend first
+   *
+   * @generated from field: repeated stockchecker.v1.SpendTotal sets = 2;
+   */
+  sets: SpendTotal[];
+
+  /**
+   * one per currency
+   *
+   * @generated from field: repeated stockchecker.v1.SpendTotal totals = 3;
+   */
+  totals: SpendTotal[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetAcquisitionSummaryResponse.
+ * Use `create(GetAcquisitionSummaryResponseSchema)` to create a new message.
+ */
+export declare const GetAcquisitionSummaryResponseSchema: GenMessage<GetAcquisitionSummaryResponse>;
+
+/**
+ * GetOfflineBundleRequest asks for the data the app caches for offline viewing
+ *
+ * @generated from message stockchecker.v1.GetOfflineBundleRequest
+ */
+export declare type GetOfflineBundleRequest = Message<"stockchecker.v1.GetOfflineBundleRequest"> & {
+  /**
+   * version of the bundle the client has cached, if any
+   *
+   * @generated from field: string version = 1;
+   */
+  version: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetOfflineBundleRequest.
+ * Use `create(GetOfflineBundleRequestSchema)` to create a new message.
+ */
+export declare const GetOfflineBundleRequestSchema: GenMessage<GetOfflineBundleRequest>;
+
+/**
+ * GetOfflineBundleResponse is a compact snapshot of the user's watch list.
+ * If not_modified is set, the cached bundle is current and nothing else is filled in.
+ *
+ * @generated from message stockchecker.v1.GetOfflineBundleResponse
+ */
+export declare type GetOfflineBundleResponse = Message<"stockchecker.v1.GetOfflineBundleResponse"> & {
+  /**
+   * @generated from field: bool not_modified = 1;
+   */
+  notModified: boolean;
+
+  /**
+   * changes whenever the bundle's content changes
+   *
+   * @generated from field: string version = 2;
+   */
+  version: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp generated_at = 3;
+   */
+  generatedAt?: Timestamp;
+
+  /**
+   * saved stores with address and phone
+   *
+   * @generated from field: repeated stockchecker.v1.Store stores = 4;
+   */
+  stores: Store[];
+
+  /**
+   * saved products
+   *
+   * @generated from field: repeated stockchecker.v1.Product products = 5;
+   */
+  products: Product[];
+
+  /**
+   * latest known stock per product and store
+   *
+   * @generated from field: repeated stockchecker.v1.CurrentAvailability availability = 6;
+   */
+  availability: CurrentAvailability[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetOfflineBundleResponse.
+ * Use `create(GetOfflineBundleResponseSchema)` to create a new message.
+ */
+export declare const GetOfflineBundleResponseSchema: GenMessage<GetOfflineBundleResponse>;
+
+/**
+ * GetStockHistoryRequest selects a product at a store and the range of history to return
+ *
+ * @generated from message stockchecker.v1.GetStockHistoryRequest
+ */
+export declare type GetStockHistoryRequest = Message<"stockchecker.v1.GetStockHistoryRequest"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string store_id = 2;
+   */
+  storeId: string;
+
+  /**
+   * days of history including today; defaults to 7, max 90
+   *
+   * @generated from field: int32 days = 3;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetStockHistoryRequest.
+ * Use `create(GetStockHistoryRequestSchema)` to create a new message.
+ */
+export declare const GetStockHistoryRequestSchema: GenMessage<GetStockHistoryRequest>;
+
+/**
+ * StockCheck is the result of one availability check
+ *
+ * @generated from message stockchecker.v1.StockCheck
+ */
+export declare type StockCheck = Message<"stockchecker.v1.StockCheck"> & {
+  /**
+   * @generated from field: bool in_stock = 1;
+   */
+  inStock: boolean;
+
+  /**
+   * @generated from field: bool low_stock = 2;
+   */
+  lowStock: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 3;
+   */
+  checkedAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.StockCheck.
+ * Use `create(StockCheckSchema)` to create a new message.
+ */
+export declare const StockCheckSchema: GenMessage<StockCheck>;
+
+/**
+ * GetStockHistoryResponse returns the checks in range, newest first
+ *
+ * @generated from message stockchecker.v1.GetStockHistoryResponse
+ */
+export declare type GetStockHistoryResponse = Message<"stockchecker.v1.GetStockHistoryResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.StockCheck checks = 1;
+   */
+  checks: StockCheck[];
+
+  /**
+   * unset if never seen in stock
+   *
+   * @generated from field: google.protobuf.Timestamp last_in_stock_at = 2;
+   */
+  lastInStockAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetStockHistoryResponse.
+ * Use `create(GetStockHistoryResponseSchema)` to create a new message.
+ */
+export declare const GetStockHistoryResponseSchema: GenMessage<GetStockHistoryResponse>;
+
+/**
+ * StockEvent is a product coming into or going out of stock at a store
+ *
+ * @generated from message stockchecker.v1.StockEvent
+ */
+export declare type StockEvent = Message<"stockchecker.v1.StockEvent"> & {
+  /**
+   * increases with every event
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string sku = 2;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string store_id = 3;
+   */
+  storeId: string;
+
+  /**
+   * @generated from field: string store_name = 4;
+   */
+  storeName: string;
+
+  /**
+   * @generated from field: bool in_stock = 5;
+   */
+  inStock: boolean;
+
+  /**
+   * @generated from field: bool low_stock = 6;
+   */
+  lowStock: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp occurred_at = 7;
+   */
+  occurredAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.StockEvent.
+ * Use `create(StockEventSchema)` to create a new message.
+ */
+export declare const StockEventSchema: GenMessage<StockEvent>;
+
+/**
+ * WatchStockRequest selects the products to stream stock changes for
+ *
+ * @generated from message stockchecker.v1.WatchStockRequest
+ */
+export declare type WatchStockRequest = Message<"stockchecker.v1.WatchStockRequest"> & {
+  /**
+   * empty for every product
+   *
+   * @generated from field: repeated string skus = 1;
+   */
+  skus: string[];
+
+  /**
+   * Events held for the client while it's busy, 1-256 (default 64). When the
+   * buffer is full a newer event for the same product and store replaces the
+   * held one, and otherwise the oldest held event is dropped.
+   *
+   * @generated from field: int32 buffer_size = 2;
+   */
+  bufferSize: number;
+
+  /**
+   * Resume token from the last message of an earlier stream, to get the
+   * events recorded since it instead of starting from now
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchStockRequest.
+ * Use `create(WatchStockRequestSchema)` to create a new message.
+ */
+export declare const WatchStockRequestSchema: GenMessage<WatchStockRequest>;
+
+/**
+ * WatchStockResponse is a batch of stock changes, oldest first. A batch is
+ * sent when the stream opens and at least every 30 seconds, with no events
+ * if nothing changed, so dead connections are noticed.
+ *
+ * @generated from message stockchecker.v1.WatchStockResponse
+ */
+export declare type WatchStockResponse = Message<"stockchecker.v1.WatchStockResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.StockEvent events = 1;
+   */
+  events: StockEvent[];
+
+  /**
+   * Events dropped since the last batch because the buffer was full, or
+   * because a resumed stream was too far behind; when set, refresh with
+   * CheckStock to catch up
+   *
+   * @generated from field: int32 skipped = 2;
+   */
+  skipped: number;
+
+  /**
+   * pass to WatchStock to pick up after this batch
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchStockResponse.
+ * Use `create(WatchStockResponseSchema)` to create a new message.
+ */
+export declare const WatchStockResponseSchema: GenMessage<WatchStockResponse>;
+
+/**
+ * CheckStoreNowRequest selects one of the user's saved stores
+ *
+ * @generated from message stockchecker.v1.CheckStoreNowRequest
+ */
+export declare type CheckStoreNowRequest = Message<"stockchecker.v1.CheckStoreNowRequest"> & {
+  /**
+   * @generated from field: string store_id = 1;
+   */
+  storeId: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.CheckStoreNowRequest.
+ * Use `create(CheckStoreNowRequestSchema)` to create a new message.
+ */
+export declare const CheckStoreNowRequestSchema: GenMessage<CheckStoreNowRequest>;
+
+/**
+ * CheckStoreNowResponse lists the user's watched products at the store,
+ * in stock first, then low stock, then out of stock
+ *
+ * @generated from message stockchecker.v1.CheckStoreNowResponse
+ */
+export declare type CheckStoreNowResponse = Message<"stockchecker.v1.CheckStoreNowResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Store store = 1;
+   */
+  store?: Store;
+
+  /**
+   * store is left unset in each result
+   *
+   * @generated from field: repeated stockchecker.v1.StockStatus results = 2;
+   */
+  results: StockStatus[];
+
+  /**
+   * products that couldn't be checked
+   *
+   * @generated from field: repeated string failed_skus = 3;
+   */
+  failedSkus: string[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 4;
+   */
+  checkedAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.CheckStoreNowResponse.
+ * Use `create(CheckStoreNowResponseSchema)` to create a new message.
+ */
+export declare const CheckStoreNowResponseSchema: GenMessage<CheckStoreNowResponse>;
+
+/**
+ * Location is a named place the user searches from, such as home or the office
+ *
+ * @generated from message stockchecker.v1.Location
+ */
+export declare type Location = Message<"stockchecker.v1.Location"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string postal_code = 2;
+   */
+  postalCode: string;
+
+  /**
+   * defaults to 25, max 250
+   *
+   * @generated from field: int32 radius_miles = 3;
+   */
+  radiusMiles: number;
+
+  /**
+   * optional; looked up from the postal code if unset
+   *
+   * @generated from field: double latitude = 4;
+   */
+  latitude: number;
+
+  /**
+   * @generated from field: double longitude = 5;
+   */
+  longitude: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.Location.
+ * Use `create(LocationSchema)` to create a new message.
+ */
+export declare const LocationSchema: GenMessage<Location>;
+
+/**
+ * GetMyLocationsRequest is empty - user is determined from session
+ *
+ * @generated from message stockchecker.v1.GetMyLocationsRequest
+ */
+export declare type GetMyLocationsRequest = Message<"stockchecker.v1.GetMyLocationsRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyLocationsRequest.
+ * Use `create(GetMyLocationsRequestSchema)` to create a new message.
+ */
+export declare const GetMyLocationsRequestSchema: GenMessage<GetMyLocationsRequest>;
+
+/**
+ * GetMyLocationsResponse lists the user's locations
+ *
+ * @generated from message stockchecker.v1.GetMyLocationsResponse
+ */
+export declare type GetMyLocationsResponse = Message<"stockchecker.v1.GetMyLocationsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Location locations = 1;
+   */
+  locations: Location[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyLocationsResponse.
+ * Use `create(GetMyLocationsResponseSchema)` to create a new message.
+ */
+export declare const GetMyLocationsResponseSchema: GenMessage<GetMyLocationsResponse>;
+
+/**
+ * SetMyLocationRequest creates a location or replaces the one with the same name
+ *
+ * @generated from message stockchecker.v1.SetMyLocationRequest
+ */
+export declare type SetMyLocationRequest = Message<"stockchecker.v1.SetMyLocationRequest"> & {
+  /**
+   * @generated from field: stockchecker.v1.Location location = 1;
+   */
+  location?: Location;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocationRequest.
+ * Use `create(SetMyLocationRequestSchema)` to create a new message.
+ */
+export declare const SetMyLocationRequestSchema: GenMessage<SetMyLocationRequest>;
+
+/**
+ * SetMyLocationResponse returns the saved location
+ *
+ * @generated from message stockchecker.v1.SetMyLocationResponse
+ */
+export declare type SetMyLocationResponse = Message<"stockchecker.v1.SetMyLocationResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Location location = 1;
+   */
+  location?: Location;
+};
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocationResponse.
+ * Use `create(SetMyLocationResponseSchema)` to create a new message.
+ */
+export declare const SetMyLocationResponseSchema: GenMessage<SetMyLocationResponse>;
+
+/**
+ * DeleteMyLocationRequest removes a location
+ *
+ * @generated from message stockchecker.v1.DeleteMyLocationRequest
+ */
+export declare type DeleteMyLocationRequest = Message<"stockchecker.v1.DeleteMyLocationRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyLocationRequest.
+ * Use `create(DeleteMyLocationRequestSchema)` to create a new message.
+ */
+export declare const DeleteMyLocationRequestSchema: GenMessage<DeleteMyLocationRequest>;
+
+/**
+ * DeleteMyLocationResponse is empty
+ *
+ * @generated from message stockchecker.v1.DeleteMyLocationResponse
+ */
+export declare type DeleteMyLocationResponse = Message<"stockchecker.v1.DeleteMyLocationResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyLocationResponse.
+ * Use `create(DeleteMyLocationResponseSchema)` to create a new message.
+ */
+export declare const DeleteMyLocationResponseSchema: GenMessage<DeleteMyLocationResponse>;
+
+/**
+ * GetProductBarcodeRequest selects the product to show a barcode for
+ *
+ * @generated from message stockchecker.v1.GetProductBarcodeRequest
+ */
+export declare type GetProductBarcodeRequest = Message<"stockchecker.v1.GetProductBarcodeRequest"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetProductBarcodeRequest.
+ * Use `create(GetProductBarcodeRequestSchema)` to create a new message.
+ */
+export declare const GetProductBarcodeRequestSchema: GenMessage<GetProductBarcodeRequest>;
+
+/**
+ * GetProductBarcodeResponse is a barcode store associates can scan to look the product up
+ *
+ * @generated from message stockchecker.v1.GetProductBarcodeResponse
+ */
+export declare type GetProductBarcodeResponse = Message<"stockchecker.v1.GetProductBarcodeResponse"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string product_name = 2;
+   */
+  productName: string;
+
+  /**
+   * upc_a if the product has a UPC, otherwise code128 of the SKU
+   *
+   * @generated from field: string symbology = 3;
+   */
+  symbology: string;
+
+  /**
+   * the encoded text
+   *
+   * @generated from field: string payload = 4;
+   */
+  payload: string;
+
+  /**
+   * the barcode rendered as an SVG image
+   *
+   * @generated from field: string svg = 5;
+   */
+  svg: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetProductBarcodeResponse.
+ * Use `create(GetProductBarcodeResponseSchema)` to create a new message.
+ */
+export declare const GetProductBarcodeResponseSchema: GenMessage<GetProductBarcodeResponse>;
+
+/**
+ * ProductWatch is a search in a category that alerts when Best Buy lists a
+ * new product matching it
+ *
+ * @generated from message stockchecker.v1.ProductWatch
+ */
+export declare type ProductWatch = Message<"stockchecker.v1.ProductWatch"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string query = 2;
+   */
+  query: string;
+
+  /**
+   * Best Buy category searched
+   *
+   * @generated from field: string category_id = 3;
+   */
+  categoryId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.ProductWatch.
+ * Use `create(ProductWatchSchema)` to create a new message.
+ */
+export declare const ProductWatchSchema: GenMessage<ProductWatch>;
+
+/**
+ * GetProductDomainRequest is empty
+ *
+ * @generated from message stockchecker.v1.GetProductDomainRequest
+ */
+export declare type GetProductDomainRequest = Message<"stockchecker.v1.GetProductDomainRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetProductDomainRequest.
+ * Use `create(GetProductDomainRequestSchema)` to create a new message.
+ */
+export declare const GetProductDomainRequestSchema: GenMessage<GetProductDomainRequest>;
+
+/**
+ * GetProductDomainResponse describes the kind of product the deployment tracks
+ *
+ * @generated from message stockchecker.v1.GetProductDomainResponse
+ */
+export declare type GetProductDomainResponse = Message<"stockchecker.v1.GetProductDomainResponse"> & {
+  /**
+   * "tcg", "gpu", "consoles" or "lego"
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * e.g. "Pokemon TCG"
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * default SearchProductsRequest.category; empty searches everything
+   *
+   * @generated from field: string search_category = 3;
+   */
+  searchCategory: string;
+
+  /**
+   * category product watches search unless given one
+   *
+   * @generated from field: string category_id = 4;
+   */
+  categoryId: string;
+
+  /**
+   * popular products suggested to new users
+   *
+   * @generated from field: repeated stockchecker.v1.ProductPreset presets = 5;
+   */
+  presets: ProductPreset[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetProductDomainResponse.
+ * Use `create(GetProductDomainResponseSchema)` to create a new message.
+ */
+export declare const GetProductDomainResponseSchema: GenMessage<GetProductDomainResponse>;
+
+/**
+ * ProductPreset is a popular product the domain suggests watching. Adding it
+ * caps its alerts at MSRP unless the user already has an alert rule for it.
+ *
+ * @generated from message stockchecker.v1.ProductPreset
+ */
+export declare type ProductPreset = Message<"stockchecker.v1.ProductPreset"> & {
+  /**
+   * @generated from field: string sku = 1;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int64 msrp_cents = 3;
+   */
+  msrpCents: bigint;
+};
+
+/**
+ * Describes the message stockchecker.v1.ProductPreset.
+ * Use `create(ProductPresetSchema)` to create a new message.
+ */
+export declare const ProductPresetSchema: GenMessage<ProductPreset>;
+
+/**
+ * GetMyProductWatchesRequest is empty - user is determined from session
+ *
+ * @generated from message stockchecker.v1.GetMyProductWatchesRequest
+ */
+export declare type GetMyProductWatchesRequest = Message<"stockchecker.v1.GetMyProductWatchesRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyProductWatchesRequest.
+ * Use `create(GetMyProductWatchesRequestSchema)` to create a new message.
+ */
+export declare const GetMyProductWatchesRequestSchema: GenMessage<GetMyProductWatchesRequest>;
+
+/**
+ * GetMyProductWatchesResponse lists the user's product watches
+ *
+ * @generated from message stockchecker.v1.GetMyProductWatchesResponse
+ */
+export declare type GetMyProductWatchesResponse = Message<"stockchecker.v1.GetMyProductWatchesResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.ProductWatch product_watches = 1;
+   */
+  productWatches: ProductWatch[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyProductWatchesResponse.
+ * Use `create(GetMyProductWatchesResponseSchema)` to create a new message.
+ */
+export declare const GetMyProductWatchesResponseSchema: GenMessage<GetMyProductWatchesResponse>;
+
+/**
+ * WatchProductsRequest asks to be alerted when a product matching a search is listed
+ *
+ * @generated from message stockchecker.v1.WatchProductsRequest
+ */
+export declare type WatchProductsRequest = Message<"stockchecker.v1.WatchProductsRequest"> & {
+  /**
+   * e.g. "Prismatic Evolutions"
+   *
+   * @generated from field: string query = 1;
+   */
+  query: string;
+
+  /**
+   * optional; defaults to the product domain's category
+   *
+   * @generated from field: string category_id = 2;
+   */
+  categoryId: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchProductsRequest.
+ * Use `create(WatchProductsRequestSchema)` to create a new message.
+ */
+export declare const WatchProductsRequestSchema: GenMessage<WatchProductsRequest>;
+
+/**
+ * WatchProductsResponse returns the watch. Products listed now don't alert.
+ *
+ * @generated from message stockchecker.v1.WatchProductsResponse
+ */
+export declare type WatchProductsResponse = Message<"stockchecker.v1.WatchProductsResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.ProductWatch product_watch = 1;
+   */
+  productWatch?: ProductWatch;
+
+  /**
+   * products matching the search now
+   *
+   * @generated from field: int32 listed_count = 2;
+   */
+  listedCount: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchProductsResponse.
+ * Use `create(WatchProductsResponseSchema)` to create a new message.
+ */
+export declare const WatchProductsResponseSchema: GenMessage<WatchProductsResponse>;
+
+/**
+ * UnwatchProductsRequest asks to stop a product watch
+ *
+ * @generated from message stockchecker.v1.UnwatchProductsRequest
+ */
+export declare type UnwatchProductsRequest = Message<"stockchecker.v1.UnwatchProductsRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.UnwatchProductsRequest.
+ * Use `create(UnwatchProductsRequestSchema)` to create a new message.
+ */
+export declare const UnwatchProductsRequestSchema: GenMessage<UnwatchProductsRequest>;
+
+/**
+ * UnwatchProductsResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.UnwatchProductsResponse
+ */
+export declare type UnwatchProductsResponse = Message<"stockchecker.v1.UnwatchProductsResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.UnwatchProductsResponse.
+ * Use `create(UnwatchProductsResponseSchema)` to create a new message.
+ */
+export declare const UnwatchProductsResponseSchema: GenMessage<UnwatchProductsResponse>;
+
+/**
+ * AllowedEmail is an email address allowed to sign in
+ *
+ * @generated from message stockchecker.v1.AllowedEmail
+ */
+export declare type AllowedEmail = Message<"stockchecker.v1.AllowedEmail"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * email of the admin who added it; empty if seeded from ALLOWED_EMAILS
+   *
+   * @generated from field: string added_by = 2;
+   */
+  addedBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.AllowedEmail.
+ * Use `create(AllowedEmailSchema)` to create a new message.
+ */
+export declare const AllowedEmailSchema: GenMessage<AllowedEmail>;
+
+/**
+ * AdminAddAllowedEmailRequest allows an email address to sign in (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminAddAllowedEmailRequest
+ */
+export declare type AdminAddAllowedEmailRequest = Message<"stockchecker.v1.AdminAddAllowedEmailRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedEmailRequest.
+ * Use `create(AdminAddAllowedEmailRequestSchema)` to create a new message.
+ */
+export declare const AdminAddAllowedEmailRequestSchema: GenMessage<AdminAddAllowedEmailRequest>;
+
+/**
+ * AdminAddAllowedEmailResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.AdminAddAllowedEmailResponse
+ */
+export declare type AdminAddAllowedEmailResponse = Message<"stockchecker.v1.AdminAddAllowedEmailResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedEmailResponse.
+ * Use `create(AdminAddAllowedEmailResponseSchema)` to create a new message.
+ */
+export declare const AdminAddAllowedEmailResponseSchema: GenMessage<AdminAddAllowedEmailResponse>;
+
+/**
+ * AdminRemoveAllowedEmailRequest stops an email address signing in (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminRemoveAllowedEmailRequest
+ */
+export declare type AdminRemoveAllowedEmailRequest = Message<"stockchecker.v1.AdminRemoveAllowedEmailRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedEmailRequest.
+ * Use `create(AdminRemoveAllowedEmailRequestSchema)` to create a new message.
+ */
+export declare const AdminRemoveAllowedEmailRequestSchema: GenMessage<AdminRemoveAllowedEmailRequest>;
+
+/**
+ * AdminRemoveAllowedEmailResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.AdminRemoveAllowedEmailResponse
+ */
+export declare type AdminRemoveAllowedEmailResponse = Message<"stockchecker.v1.AdminRemoveAllowedEmailResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedEmailResponse.
+ * Use `create(AdminRemoveAllowedEmailResponseSchema)` to create a new message.
+ */
+export declare const AdminRemoveAllowedEmailResponseSchema: GenMessage<AdminRemoveAllowedEmailResponse>;
+
+/**
+ * AdminListAllowedEmailsRequest lists the allowed email addresses (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminListAllowedEmailsRequest
+ */
+export declare type AdminListAllowedEmailsRequest = Message<"stockchecker.v1.AdminListAllowedEmailsRequest"> & {
+  /**
+   * default 50, max 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedEmailsRequest.
+ * Use `create(AdminListAllowedEmailsRequestSchema)` to create a new message.
+ */
+export declare const AdminListAllowedEmailsRequestSchema: GenMessage<AdminListAllowedEmailsRequest>;
+
+/**
+ * AdminListAllowedEmailsResponse lists allowed email addresses alphabetically
+ *
+ * @generated from message stockchecker.v1.AdminListAllowedEmailsResponse
+ */
+export declare type AdminListAllowedEmailsResponse = Message<"stockchecker.v1.AdminListAllowedEmailsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.AllowedEmail allowed_emails = 1;
+   */
+  allowedEmails: AllowedEmail[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedEmailsResponse.
+ * Use `create(AdminListAllowedEmailsResponseSchema)` to create a new message.
+ */
+export declare const AdminListAllowedEmailsResponseSchema: GenMessage<AdminListAllowedEmailsResponse>;
+
+/**
+ * AllowedDomain is an email domain whose verified addresses may all sign in
+ *
+ * @generated from message stockchecker.v1.AllowedDomain
+ */
+export declare type AllowedDomain = Message<"stockchecker.v1.AllowedDomain"> & {
+  /**
+   * e.g. "mycompany.com"
+   *
+   * @generated from field: string domain = 1;
+   */
+  domain: string;
+
+  /**
+   * email of the admin who added it; empty if seeded from ALLOWED_EMAILS
+   *
+   * @generated from field: string added_by = 2;
+   */
+  addedBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.AllowedDomain.
+ * Use `create(AllowedDomainSchema)` to create a new message.
+ */
+export declare const AllowedDomainSchema: GenMessage<AllowedDomain>;
+
+/**
+ * AdminAddAllowedDomainRequest allows every address on a domain to sign in (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminAddAllowedDomainRequest
+ */
+export declare type AdminAddAllowedDomainRequest = Message<"stockchecker.v1.AdminAddAllowedDomainRequest"> & {
+  /**
+   * "mycompany.com" or "@mycompany.com"
+   *
+   * @generated from field: string domain = 1;
+   */
+  domain: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedDomainRequest.
+ * Use `create(AdminAddAllowedDomainRequestSchema)` to create a new message.
+ */
+export declare const AdminAddAllowedDomainRequestSchema: GenMessage<AdminAddAllowedDomainRequest>;
+
+/**
+ * AdminAddAllowedDomainResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.AdminAddAllowedDomainResponse
+ */
+export declare type AdminAddAllowedDomainResponse = Message<"stockchecker.v1.AdminAddAllowedDomainResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedDomainResponse.
+ * Use `create(AdminAddAllowedDomainResponseSchema)` to create a new message.
+ */
+export declare const AdminAddAllowedDomainResponseSchema: GenMessage<AdminAddAllowedDomainResponse>;
+
+/**
+ * AdminRemoveAllowedDomainRequest stops a domain's addresses signing in (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminRemoveAllowedDomainRequest
+ */
+export declare type AdminRemoveAllowedDomainRequest = Message<"stockchecker.v1.AdminRemoveAllowedDomainRequest"> & {
+  /**
+   * @generated from field: string domain = 1;
+   */
+  domain: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedDomainRequest.
+ * Use `create(AdminRemoveAllowedDomainRequestSchema)` to create a new message.
+ */
+export declare const AdminRemoveAllowedDomainRequestSchema: GenMessage<AdminRemoveAllowedDomainRequest>;
+
+/**
+ * AdminRemoveAllowedDomainResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.AdminRemoveAllowedDomainResponse
+ */
+export declare type AdminRemoveAllowedDomainResponse = Message<"stockchecker.v1.AdminRemoveAllowedDomainResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedDomainResponse.
+ * Use `create(AdminRemoveAllowedDomainResponseSchema)` to create a new message.
+ */
+export declare const AdminRemoveAllowedDomainResponseSchema: GenMessage<AdminRemoveAllowedDomainResponse>;
+
+/**
+ * AdminListAllowedDomainsRequest lists the allowed email domains (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminListAllowedDomainsRequest
+ */
+export declare type AdminListAllowedDomainsRequest = Message<"stockchecker.v1.AdminListAllowedDomainsRequest"> & {
+  /**
+   * default 50, max 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedDomainsRequest.
+ * Use `create(AdminListAllowedDomainsRequestSchema)` to create a new message.
+ */
+export declare const AdminListAllowedDomainsRequestSchema: GenMessage<AdminListAllowedDomainsRequest>;
+
+/**
+ * AdminListAllowedDomainsResponse lists allowed email domains alphabetically
+ *
+ * @generated from message stockchecker.v1.AdminListAllowedDomainsResponse
+ */
+export declare type AdminListAllowedDomainsResponse = Message<"stockchecker.v1.AdminListAllowedDomainsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.AllowedDomain allowed_domains = 1;
+   */
+  allowedDomains: AllowedDomain[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedDomainsResponse.
+ * Use `create(AdminListAllowedDomainsResponseSchema)` to create a new message.
+ */
+export declare const AdminListAllowedDomainsResponseSchema: GenMessage<AdminListAllowedDomainsResponse>;
+
+/**
+ * Invite is a single-use link an admin created; signing in with it allows the
+ * account's email
+ *
+ * @generated from message stockchecker.v1.Invite
+ */
+export declare type Invite = Message<"stockchecker.v1.Invite"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * who it's for
+   *
+   * @generated from field: string note = 2;
+   */
+  note: string;
+
+  /**
+   * email of the admin who created it
+   *
+   * @generated from field: string created_by = 3;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 4;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * unset until used
+   *
+   * @generated from field: google.protobuf.Timestamp used_at = 5;
+   */
+  usedAt?: Timestamp;
+
+  /**
+   * email that signed in with it; empty until used
+   *
+   * @generated from field: string used_by = 6;
+   */
+  usedBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.Invite.
+ * Use `create(InviteSchema)` to create a new message.
+ */
+export declare const InviteSchema: GenMessage<Invite>;
+
+/**
+ * AdminCreateInviteRequest creates an invite link (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminCreateInviteRequest
+ */
+export declare type AdminCreateInviteRequest = Message<"stockchecker.v1.AdminCreateInviteRequest"> & {
+  /**
+   * optional, at most 200 characters
+   *
+   * @generated from field: string note = 1;
+   */
+  note: string;
+
+  /**
+   * how long it works; defaults to 168 (a week), max 720
+   *
+   * @generated from field: int32 hours = 2;
+   */
+  hours: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminCreateInviteRequest.
+ * Use `create(AdminCreateInviteRequestSchema)` to create a new message.
+ */
+export declare const AdminCreateInviteRequestSchema: GenMessage<AdminCreateInviteRequest>;
+
+/**
+ * AdminCreateInviteResponse returns the invite and its link. The link is
+ * only ever returned here.
+ *
+ * @generated from message stockchecker.v1.AdminCreateInviteResponse
+ */
+export declare type AdminCreateInviteResponse = Message<"stockchecker.v1.AdminCreateInviteResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Invite invite = 1;
+   */
+  invite?: Invite;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminCreateInviteResponse.
+ * Use `create(AdminCreateInviteResponseSchema)` to create a new message.
+ */
+export declare const AdminCreateInviteResponseSchema: GenMessage<AdminCreateInviteResponse>;
+
+/**
+ * AdminListInvitesRequest lists invites (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminListInvitesRequest
+ */
+export declare type AdminListInvitesRequest = Message<"stockchecker.v1.AdminListInvitesRequest"> & {
+  /**
+   * default 50, max 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListInvitesRequest.
+ * Use `create(AdminListInvitesRequestSchema)` to create a new message.
+ */
+export declare const AdminListInvitesRequestSchema: GenMessage<AdminListInvitesRequest>;
+
+/**
+ * AdminListInvitesResponse lists invites, newest first
+ *
+ * @generated from message stockchecker.v1.AdminListInvitesResponse
+ */
+export declare type AdminListInvitesResponse = Message<"stockchecker.v1.AdminListInvitesResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Invite invites = 1;
+   */
+  invites: Invite[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListInvitesResponse.
+ * Use `create(AdminListInvitesResponseSchema)` to create a new message.
+ */
+export declare const AdminListInvitesResponseSchema: GenMessage<AdminListInvitesResponse>;
+
+/**
+ * AdminRevokeInviteRequest revokes an unused invite (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminRevokeInviteRequest
+ */
+export declare type AdminRevokeInviteRequest = Message<"stockchecker.v1.AdminRevokeInviteRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminRevokeInviteRequest.
+ * Use `create(AdminRevokeInviteRequestSchema)` to create a new message.
+ */
+export declare const AdminRevokeInviteRequestSchema: GenMessage<AdminRevokeInviteRequest>;
+
+/**
+ * AdminRevokeInviteResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.AdminRevokeInviteResponse
+ */
+export declare type AdminRevokeInviteResponse = Message<"stockchecker.v1.AdminRevokeInviteResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminRevokeInviteResponse.
+ * Use `create(AdminRevokeInviteResponseSchema)` to create a new message.
+ */
+export declare const AdminRevokeInviteResponseSchema: GenMessage<AdminRevokeInviteResponse>;
+
+/**
+ * AdminListUsersRequest lists the users who have signed in (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminListUsersRequest
+ */
+export declare type AdminListUsersRequest = Message<"stockchecker.v1.AdminListUsersRequest"> & {
+  /**
+   * default 50, max 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListUsersRequest.
+ * Use `create(AdminListUsersRequestSchema)` to create a new message.
+ */
+export declare const AdminListUsersRequestSchema: GenMessage<AdminListUsersRequest>;
+
+/**
+ * AdminListUsersResponse lists users oldest first
+ *
+ * @generated from message stockchecker.v1.AdminListUsersResponse
+ */
+export declare type AdminListUsersResponse = Message<"stockchecker.v1.AdminListUsersResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.User users = 1;
+   */
+  users: User[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListUsersResponse.
+ * Use `create(AdminListUsersResponseSchema)` to create a new message.
+ */
+export declare const AdminListUsersResponseSchema: GenMessage<AdminListUsersResponse>;
+
+/**
+ * AdminSetUserRoleRequest promotes or demotes a user (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminSetUserRoleRequest
+ */
+export declare type AdminSetUserRoleRequest = Message<"stockchecker.v1.AdminSetUserRoleRequest"> & {
+  /**
+   * @generated from field: int32 user_id = 1;
+   */
+  userId: number;
+
+  /**
+   * @generated from field: stockchecker.v1.UserRole role = 2;
+   */
+  role: UserRole;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminSetUserRoleRequest.
+ * Use `create(AdminSetUserRoleRequestSchema)` to create a new message.
+ */
+export declare const AdminSetUserRoleRequestSchema: GenMessage<AdminSetUserRoleRequest>;
+
+/**
+ * AdminSetUserRoleResponse returns the updated user
+ *
+ * @generated from message stockchecker.v1.AdminSetUserRoleResponse
+ */
+export declare type AdminSetUserRoleResponse = Message<"stockchecker.v1.AdminSetUserRoleResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.User user = 1;
+   */
+  user?: User;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminSetUserRoleResponse.
+ * Use `create(AdminSetUserRoleResponseSchema)` to create a new message.
+ */
+export declare const AdminSetUserRoleResponseSchema: GenMessage<AdminSetUserRoleResponse>;
+
+/**
+ * Credential is a retailer API key or notifier setting admins can rotate
+ * without a restart. Its value is never returned.
+ *
+ * @generated from message stockchecker.v1.Credential
+ */
+export declare type Credential = Message<"stockchecker.v1.Credential"> & {
+  /**
+   * the environment variable it overrides, e.g. BESTBUY_API_KEY
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * has a value, from the environment or an admin
+   *
+   * @generated from field: bool set = 2;
+   */
+  set: boolean;
+
+  /**
+   * set by an admin; clearing it goes back to the environment's value
+   *
+   * @generated from field: bool overridden = 3;
+   */
+  overridden: boolean;
+
+  /**
+   * last four characters of a key, or the admin channel type
+   *
+   * @generated from field: string hint = 4;
+   */
+  hint: string;
+
+  /**
+   * email of the admin who set it; empty if from the environment
+   *
+   * @generated from field: string updated_by = 5;
+   */
+  updatedBy: string;
+
+  /**
+   * unset if from the environment
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.Credential.
+ * Use `create(CredentialSchema)` to create a new message.
+ */
+export declare const CredentialSchema: GenMessage<Credential>;
+
+/**
+ * AdminListCredentialsRequest lists the credentials admins can rotate (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminListCredentialsRequest
+ */
+export declare type AdminListCredentialsRequest = Message<"stockchecker.v1.AdminListCredentialsRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListCredentialsRequest.
+ * Use `create(AdminListCredentialsRequestSchema)` to create a new message.
+ */
+export declare const AdminListCredentialsRequestSchema: GenMessage<AdminListCredentialsRequest>;
+
+/**
+ * AdminListCredentialsResponse lists every rotatable credential
+ *
+ * @generated from message stockchecker.v1.AdminListCredentialsResponse
+ */
+export declare type AdminListCredentialsResponse = Message<"stockchecker.v1.AdminListCredentialsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Credential credentials = 1;
+   */
+  credentials: Credential[];
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminListCredentialsResponse.
+ * Use `create(AdminListCredentialsResponseSchema)` to create a new message.
+ */
+export declare const AdminListCredentialsResponseSchema: GenMessage<AdminListCredentialsResponse>;
+
+/**
+ * AdminSetCredentialRequest rotates a credential (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminSetCredentialRequest
+ */
+export declare type AdminSetCredentialRequest = Message<"stockchecker.v1.AdminSetCredentialRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The new key, or for ADMIN_NOTIFY the channel as
+   * {"channel": "gotify", "config": {...}}
+   *
+   * @generated from field: string value = 2;
+   */
+  value: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminSetCredentialRequest.
+ * Use `create(AdminSetCredentialRequestSchema)` to create a new message.
+ */
+export declare const AdminSetCredentialRequestSchema: GenMessage<AdminSetCredentialRequest>;
+
+/**
+ * AdminSetCredentialResponse returns the rotated credential
+ *
+ * @generated from message stockchecker.v1.AdminSetCredentialResponse
+ */
+export declare type AdminSetCredentialResponse = Message<"stockchecker.v1.AdminSetCredentialResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Credential credential = 1;
+   */
+  credential?: Credential;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminSetCredentialResponse.
+ * Use `create(AdminSetCredentialResponseSchema)` to create a new message.
+ */
+export declare const AdminSetCredentialResponseSchema: GenMessage<AdminSetCredentialResponse>;
+
+/**
+ * AdminClearCredentialRequest goes back to a credential's value from the environment (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminClearCredentialRequest
+ */
+export declare type AdminClearCredentialRequest = Message<"stockchecker.v1.AdminClearCredentialRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminClearCredentialRequest.
+ * Use `create(AdminClearCredentialRequestSchema)` to create a new message.
+ */
+export declare const AdminClearCredentialRequestSchema: GenMessage<AdminClearCredentialRequest>;
+
+/**
+ * AdminClearCredentialResponse returns the credential as now configured
+ *
+ * @generated from message stockchecker.v1.AdminClearCredentialResponse
+ */
+export declare type AdminClearCredentialResponse = Message<"stockchecker.v1.AdminClearCredentialResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Credential credential = 1;
+   */
+  credential?: Credential;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminClearCredentialResponse.
+ * Use `create(AdminClearCredentialResponseSchema)` to create a new message.
+ */
+export declare const AdminClearCredentialResponseSchema: GenMessage<AdminClearCredentialResponse>;
+
+/**
+ * ApiCallStats sums up sampled Best Buy API calls to one endpoint at one
+ * priority. Estimates scale the sample up by the sample rate.
+ *
+ * @generated from message stockchecker.v1.ApiCallStats
+ */
+export declare type ApiCallStats = Message<"stockchecker.v1.ApiCallStats"> & {
+  /**
+   * path without values, e.g. "/v1/products/{id}/stores.json"
+   *
+   * @generated from field: string endpoint = 1;
+   */
+  endpoint: string;
+
+  /**
+   * "background", "normal", "interactive" or "live"
+   *
+   * @generated from field: string priority = 2;
+   */
+  priority: string;
+
+  /**
+   * @generated from field: int32 sampled_calls = 3;
+   */
+  sampledCalls: number;
+
+  /**
+   * @generated from field: double estimated_calls = 4;
+   */
+  estimatedCalls: number;
+
+  /**
+   * calls counted against the daily quota
+   *
+   * @generated from field: double estimated_quota_cost = 5;
+   */
+  estimatedQuotaCost: number;
+
+  /**
+   * calls without a 2xx or 304 response
+   *
+   * @generated from field: double estimated_errors = 6;
+   */
+  estimatedErrors: number;
+
+  /**
+   * @generated from field: double avg_latency_ms = 7;
+   */
+  avgLatencyMs: number;
+
+  /**
+   * @generated from field: double p95_latency_ms = 8;
+   */
+  p95LatencyMs: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.ApiCallStats.
+ * Use `create(ApiCallStatsSchema)` to create a new message.
+ */
+export declare const ApiCallStatsSchema: GenMessage<ApiCallStats>;
+
+/**
+ * AdminGetApiCallStatsRequest selects how far back to sum up sampled calls (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminGetApiCallStatsRequest
+ */
+export declare type AdminGetApiCallStatsRequest = Message<"stockchecker.v1.AdminGetApiCallStatsRequest"> & {
+  /**
+   * defaults to 24, max 720 (calls are kept for 30 days)
+   *
+   * @generated from field: int32 hours = 1;
+   */
+  hours: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminGetApiCallStatsRequest.
+ * Use `create(AdminGetApiCallStatsRequestSchema)` to create a new message.
+ */
+export declare const AdminGetApiCallStatsRequestSchema: GenMessage<AdminGetApiCallStatsRequest>;
+
+/**
+ * AdminGetApiCallStatsResponse lists call stats, the most quota used first
+ *
+ * @generated from message stockchecker.v1.AdminGetApiCallStatsResponse
+ */
+export declare type AdminGetApiCallStatsResponse = Message<"stockchecker.v1.AdminGetApiCallStatsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.ApiCallStats stats = 1;
+   */
+  stats: ApiCallStats[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 2;
+   */
+  since?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminGetApiCallStatsResponse.
+ * Use `create(AdminGetApiCallStatsResponseSchema)` to create a new message.
+ */
+export declare const AdminGetApiCallStatsResponseSchema: GenMessage<AdminGetApiCallStatsResponse>;
+
+/**
+ * ApiKey is a key a user created so scripts and bots can call the API
+ *
+ * @generated from message stockchecker.v1.ApiKey
+ */
+export declare type ApiKey = Message<"stockchecker.v1.ApiKey"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * start of the key, to tell keys apart
+   *
+   * @generated from field: string prefix = 3;
+   */
+  prefix: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * unset if never used
+   *
+   * @generated from field: google.protobuf.Timestamp last_used_at = 5;
+   */
+  lastUsedAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.ApiKey.
+ * Use `create(ApiKeySchema)` to create a new message.
+ */
+export declare const ApiKeySchema: GenMessage<ApiKey>;
+
+/**
+ * GetMyApiKeysRequest lists the user's API keys
+ *
+ * @generated from message stockchecker.v1.GetMyApiKeysRequest
+ */
+export declare type GetMyApiKeysRequest = Message<"stockchecker.v1.GetMyApiKeysRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyApiKeysRequest.
+ * Use `create(GetMyApiKeysRequestSchema)` to create a new message.
+ */
+export declare const GetMyApiKeysRequestSchema: GenMessage<GetMyApiKeysRequest>;
+
+/**
+ * GetMyApiKeysResponse returns the user's API keys
+ *
+ * @generated from message stockchecker.v1.GetMyApiKeysResponse
+ */
+export declare type GetMyApiKeysResponse = Message<"stockchecker.v1.GetMyApiKeysResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.ApiKey api_keys = 1;
+   */
+  apiKeys: ApiKey[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyApiKeysResponse.
+ * Use `create(GetMyApiKeysResponseSchema)` to create a new message.
+ */
+export declare const GetMyApiKeysResponseSchema: GenMessage<GetMyApiKeysResponse>;
+
+/**
+ * CreateApiKeyRequest creates an API key
+ *
+ * @generated from message stockchecker.v1.CreateApiKeyRequest
+ */
+export declare type CreateApiKeyRequest = Message<"stockchecker.v1.CreateApiKeyRequest"> & {
+  /**
+   * what the key is for, e.g. "discord bot"
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.CreateApiKeyRequest.
+ * Use `create(CreateApiKeyRequestSchema)` to create a new message.
+ */
+export declare const CreateApiKeyRequestSchema: GenMessage<CreateApiKeyRequest>;
+
+/**
+ * CreateApiKeyResponse returns the new key. The key is only ever returned
+ * here; send it as "Authorization: Bearer <key>".
+ *
+ * @generated from message stockchecker.v1.CreateApiKeyResponse
+ */
+export declare type CreateApiKeyResponse = Message<"stockchecker.v1.CreateApiKeyResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.ApiKey api_key = 1;
+   */
+  apiKey?: ApiKey;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.CreateApiKeyResponse.
+ * Use `create(CreateApiKeyResponseSchema)` to create a new message.
+ */
+export declare const CreateApiKeyResponseSchema: GenMessage<CreateApiKeyResponse>;
+
+/**
+ * RevokeApiKeyRequest revokes an API key
+ *
+ * @generated from message stockchecker.v1.RevokeApiKeyRequest
+ */
+export declare type RevokeApiKeyRequest = Message<"stockchecker.v1.RevokeApiKeyRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.RevokeApiKeyRequest.
+ * Use `create(RevokeApiKeyRequestSchema)` to create a new message.
+ */
+export declare const RevokeApiKeyRequestSchema: GenMessage<RevokeApiKeyRequest>;
+
+/**
+ * RevokeApiKeyResponse confirms the key was revoked
+ *
+ * @generated from message stockchecker.v1.RevokeApiKeyResponse
+ */
+export declare type RevokeApiKeyResponse = Message<"stockchecker.v1.RevokeApiKeyResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.RevokeApiKeyResponse.
+ * Use `create(RevokeApiKeyResponseSchema)` to create a new message.
+ */
+export declare const RevokeApiKeyResponseSchema: GenMessage<RevokeApiKeyResponse>;
+
+/**
+ * Session is a signed-in browser
+ *
+ * @generated from message stockchecker.v1.Session
+ */
+export declare type Session = Message<"stockchecker.v1.Session"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * of the browser that signed in
+   *
+   * @generated from field: string user_agent = 2;
+   */
+  userAgent: string;
+
+  /**
+   * the session signed in from
+   *
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress: string;
+
+  /**
+   * when the user signed in
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * to the minute
+   *
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 5;
+   */
+  lastSeenAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * the session making the request
+   *
+   * @generated from field: bool current = 7;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export declare const SessionSchema: GenMessage<Session>;
+
+/**
+ * ListSessionsRequest lists the user's sessions
+ *
+ * @generated from message stockchecker.v1.ListSessionsRequest
+ */
+export declare type ListSessionsRequest = Message<"stockchecker.v1.ListSessionsRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export declare const ListSessionsRequestSchema: GenMessage<ListSessionsRequest>;
+
+/**
+ * ListSessionsResponse returns the user's unexpired sessions, most recently used first
+ *
+ * @generated from message stockchecker.v1.ListSessionsResponse
+ */
+export declare type ListSessionsResponse = Message<"stockchecker.v1.ListSessionsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message stockchecker.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export declare const ListSessionsResponseSchema: GenMessage<ListSessionsResponse>;
+
+/**
+ * RevokeSessionRequest signs out one session, or every session with all
+ *
+ * @generated from message stockchecker.v1.RevokeSessionRequest
+ */
+export declare type RevokeSessionRequest = Message<"stockchecker.v1.RevokeSessionRequest"> & {
+  /**
+   * ignored when all is set
+   *
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * log out everywhere, including the current session
+   *
+   * @generated from field: bool all = 2;
+   */
+  all: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export declare const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest>;
+
+/**
+ * RevokeSessionResponse returns how many sessions were signed out
+ *
+ * @generated from message stockchecker.v1.RevokeSessionResponse
+ */
+export declare type RevokeSessionResponse = Message<"stockchecker.v1.RevokeSessionResponse"> & {
+  /**
+   * @generated from field: int32 revoked = 1;
+   */
+  revoked: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export declare const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse>;
+
+/**
+ * ExportMyDataRequest exports everything stored about the user
+ *
+ * @generated from message stockchecker.v1.ExportMyDataRequest
+ */
+export declare type ExportMyDataRequest = Message<"stockchecker.v1.ExportMyDataRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.ExportMyDataRequest.
+ * Use `create(ExportMyDataRequestSchema)` to create a new message.
+ */
+export declare const ExportMyDataRequestSchema: GenMessage<ExportMyDataRequest>;
+
+/**
+ * ExportMyDataResponse returns the user's rows in every table as a JSON
+ * object keyed by table name. Session tokens and key hashes are left out.
+ *
+ * @generated from message stockchecker.v1.ExportMyDataResponse
+ */
+export declare type ExportMyDataResponse = Message<"stockchecker.v1.ExportMyDataResponse"> & {
+  /**
+   * @generated from field: string json = 1;
+   */
+  json: string;
+
+  /**
+   * suggested name for saving the export
+   *
+   * @generated from field: string filename = 2;
+   */
+  filename: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ExportMyDataResponse.
+ * Use `create(ExportMyDataResponseSchema)` to create a new message.
+ */
+export declare const ExportMyDataResponseSchema: GenMessage<ExportMyDataResponse>;
+
+/**
+ * DeleteMyAccountRequest deletes the user's account and all their data
+ *
+ * @generated from message stockchecker.v1.DeleteMyAccountRequest
+ */
+export declare type DeleteMyAccountRequest = Message<"stockchecker.v1.DeleteMyAccountRequest"> & {
+  /**
+   * must be set; guards against deleting by accident
+   *
+   * @generated from field: bool confirm = 1;
+   */
+  confirm: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyAccountRequest.
+ * Use `create(DeleteMyAccountRequestSchema)` to create a new message.
+ */
+export declare const DeleteMyAccountRequestSchema: GenMessage<DeleteMyAccountRequest>;
+
+/**
+ * DeleteMyAccountResponse confirms the account was deleted; the session used
+ * to call it is signed out
+ *
+ * @generated from message stockchecker.v1.DeleteMyAccountResponse
+ */
+export declare type DeleteMyAccountResponse = Message<"stockchecker.v1.DeleteMyAccountResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyAccountResponse.
+ * Use `create(DeleteMyAccountResponseSchema)` to create a new message.
+ */
+export declare const DeleteMyAccountResponseSchema: GenMessage<DeleteMyAccountResponse>;
+
+/**
+ * GetClientBootstrapRequest is empty - the user, if any, is determined from session
+ *
+ * @generated from message stockchecker.v1.GetClientBootstrapRequest
+ */
+export declare type GetClientBootstrapRequest = Message<"stockchecker.v1.GetClientBootstrapRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetClientBootstrapRequest.
+ * Use `create(GetClientBootstrapRequestSchema)` to create a new message.
+ */
+export declare const GetClientBootstrapRequestSchema: GenMessage<GetClientBootstrapRequest>;
+
+/**
+ * ClientFeatures says which optional parts of the app this server supports
+ *
+ * @generated from message stockchecker.v1.ClientFeatures
+ */
+export declare type ClientFeatures = Message<"stockchecker.v1.ClientFeatures"> & {
+  /**
+   * saved stores, products and alerts (needs a database)
+   *
+   * @generated from field: bool watchlists = 1;
+   */
+  watchlists: boolean;
+
+  /**
+   * background checks that send alerts
+   *
+   * @generated from field: bool stock_watcher = 2;
+   */
+  stockWatcher: boolean;
+
+  /**
+   * set details from the Pokemon TCG API
+   *
+   * @generated from field: bool tcg_sets = 3;
+   */
+  tcgSets: boolean;
+
+  /**
+   * MSRP lookups and price-gouging flags
+   *
+   * @generated from field: bool msrps = 4;
+   */
+  msrps: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.ClientFeatures.
+ * Use `create(ClientFeaturesSchema)` to create a new message.
+ */
+export declare const ClientFeaturesSchema: GenMessage<ClientFeatures>;
+
+/**
+ * ServerStatus is the server's current operating state
+ *
+ * @generated from message stockchecker.v1.ServerStatus
+ */
+export declare type ServerStatus = Message<"stockchecker.v1.ServerStatus"> & {
+  /**
+   * maintenance mode: changes are rejected until it ends
+   *
+   * @generated from field: bool read_only = 1;
+   */
+  readOnly: boolean;
+
+  /**
+   * kind of product tracked, e.g. "Pokemon TCG"
+   *
+   * @generated from field: string product_domain = 2;
+   */
+  productDomain: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ServerStatus.
+ * Use `create(ServerStatusSchema)` to create a new message.
+ */
+export declare const ServerStatusSchema: GenMessage<ServerStatus>;
+
+/**
+ * ChannelState is whether one of the user's notification channels is on
+ *
+ * @generated from message stockchecker.v1.ChannelState
+ */
+export declare type ChannelState = Message<"stockchecker.v1.ChannelState"> & {
+  /**
+   * @generated from field: string channel_type = 1;
+   */
+  channelType: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.ChannelState.
+ * Use `create(ChannelStateSchema)` to create a new message.
+ */
+export declare const ChannelStateSchema: GenMessage<ChannelState>;
+
+/**
+ * WatchlistCounts summarizes the user's watchlist
+ *
+ * @generated from message stockchecker.v1.WatchlistCounts
+ */
+export declare type WatchlistCounts = Message<"stockchecker.v1.WatchlistCounts"> & {
+  /**
+   * @generated from field: int32 stores = 1;
+   */
+  stores: number;
+
+  /**
+   * @generated from field: int32 products = 2;
+   */
+  products: number;
+
+  /**
+   * products in stock at any saved store
+   *
+   * @generated from field: int32 in_stock_products = 3;
+   */
+  inStockProducts: number;
+
+  /**
+   * @generated from field: int32 product_watches = 4;
+   */
+  productWatches: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.WatchlistCounts.
+ * Use `create(WatchlistCountsSchema)` to create a new message.
+ */
+export declare const WatchlistCountsSchema: GenMessage<WatchlistCounts>;
+
+/**
+ * LoginProvider is a way to sign in, offered as a button on the login page
+ *
+ * @generated from message stockchecker.v1.LoginProvider
+ */
+export declare type LoginProvider = Message<"stockchecker.v1.LoginProvider"> & {
+  /**
+   * google, github or oidc; passed to /auth/login?provider=
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.LoginProvider.
+ * Use `create(LoginProviderSchema)` to create a new message.
+ */
+export declare const LoginProviderSchema: GenMessage<LoginProvider>;
+
+/**
+ * GetClientBootstrapResponse returns everything the web app needs on load
+ *
+ * @generated from message stockchecker.v1.GetClientBootstrapResponse
+ */
+export declare type GetClientBootstrapResponse = Message<"stockchecker.v1.GetClientBootstrapResponse"> & {
+  /**
+   * unset when signed out
+   *
+   * @generated from field: stockchecker.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: stockchecker.v1.ClientFeatures features = 2;
+   */
+  features?: ClientFeatures;
+
+  /**
+   * @generated from field: stockchecker.v1.ServerStatus status = 3;
+   */
+  status?: ServerStatus;
+
+  /**
+   * banner shown to everyone; empty for none
+   *
+   * @generated from field: string announcement = 4;
+   */
+  announcement: string;
+
+  /**
+   * empty when signed out
+   *
+   * @generated from field: repeated stockchecker.v1.ChannelState channels = 5;
+   */
+  channels: ChannelState[];
+
+  /**
+   * unset when signed out
+   *
+   * @generated from field: stockchecker.v1.WatchlistCounts watchlist = 6;
+   */
+  watchlist?: WatchlistCounts;
+
+  /**
+   * empty when sign-in isn't configured
+   *
+   * @generated from field: repeated stockchecker.v1.LoginProvider login_providers = 7;
+   */
+  loginProviders: LoginProvider[];
+
+  /**
+   * magic links can be requested by POSTing an email to /auth/email
+   *
+   * @generated from field: bool email_login = 8;
+   */
+  emailLogin: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetClientBootstrapResponse.
+ * Use `create(GetClientBootstrapResponseSchema)` to create a new message.
+ */
+export declare const GetClientBootstrapResponseSchema: GenMessage<GetClientBootstrapResponse>;
+
+/**
+ * ApiDeprecation is an RPC or field that will be removed. Calls using one
+ * get Deprecation and Sunset response headers (RFC 9745 and RFC 8594).
+ *
+ * @generated from message stockchecker.v1.ApiDeprecation
+ */
+export declare type ApiDeprecation = Message<"stockchecker.v1.ApiDeprecation"> & {
+  /**
+   * full name, e.g. "stockchecker.v1.Product.sale_price"
+   *
+   * @generated from field: string target = 1;
+   */
+  target: string;
+
+  /**
+   * full name of what to use instead; empty if nothing replaces it
+   *
+   * @generated from field: string replacement = 2;
+   */
+  replacement: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deprecated_at = 3;
+   */
+  deprecatedAt?: Timestamp;
+
+  /**
+   * when it's removed; unset until scheduled
+   *
+   * @generated from field: google.protobuf.Timestamp sunset_at = 4;
+   */
+  sunsetAt?: Timestamp;
+
+  /**
+   * @generated from field: string note = 5;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ApiDeprecation.
+ * Use `create(ApiDeprecationSchema)` to create a new message.
+ */
+export declare const ApiDeprecationSchema: GenMessage<ApiDeprecation>;
+
+/**
+ * ApiChange is one dated entry in the API changelog
+ *
+ * @generated from message stockchecker.v1.ApiChange
+ */
+export declare type ApiChange = Message<"stockchecker.v1.ApiChange"> & {
+  /**
+   * midnight UTC on the day the change shipped
+   *
+   * @generated from field: google.protobuf.Timestamp date = 1;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: repeated string changes = 2;
+   */
+  changes: string[];
+};
+
+/**
+ * Describes the message stockchecker.v1.ApiChange.
+ * Use `create(ApiChangeSchema)` to create a new message.
+ */
+export declare const ApiChangeSchema: GenMessage<ApiChange>;
+
+/**
+ * GetApiInfoRequest is empty
+ *
+ * @generated from message stockchecker.v1.GetApiInfoRequest
+ */
+export declare type GetApiInfoRequest = Message<"stockchecker.v1.GetApiInfoRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetApiInfoRequest.
+ * Use `create(GetApiInfoRequestSchema)` to create a new message.
+ */
+export declare const GetApiInfoRequestSchema: GenMessage<GetApiInfoRequest>;
+
+/**
+ * GetApiInfoResponse describes the API's version, what's deprecated and what changed
+ *
+ * @generated from message stockchecker.v1.GetApiInfoResponse
+ */
+export declare type GetApiInfoResponse = Message<"stockchecker.v1.GetApiInfoResponse"> & {
+  /**
+   * the server's release
+   *
+   * @generated from field: string version = 1;
+   */
+  version: string;
+
+  /**
+   * soonest sunset first
+   *
+   * @generated from field: repeated stockchecker.v1.ApiDeprecation deprecations = 2;
+   */
+  deprecations: ApiDeprecation[];
+
+  /**
+   * newest first
+   *
+   * @generated from field: repeated stockchecker.v1.ApiChange changelog = 3;
+   */
+  changelog: ApiChange[];
+};
+
+/**
+ * Describes the message stockchecker.v1.GetApiInfoResponse.
+ * Use `create(GetApiInfoResponseSchema)` to create a new message.
+ */
+export declare const GetApiInfoResponseSchema: GenMessage<GetApiInfoResponse>;
+
+/**
+ * UsageDay is what a user's account used on one UTC day
+ *
+ * @generated from message stockchecker.v1.UsageDay
+ */
+export declare type UsageDay = Message<"stockchecker.v1.UsageDay"> & {
+  /**
+   * midnight UTC
+   *
+   * @generated from field: google.protobuf.Timestamp day = 1;
+   */
+  day?: Timestamp;
+
+  /**
+   * SKUs checked because the user asked
+   *
+   * @generated from field: int32 interactive_checks = 2;
+   */
+  interactiveChecks: number;
+
+  /**
+   * SKUs the watcher checked for the user
+   *
+   * @generated from field: int32 background_checks = 3;
+   */
+  backgroundChecks: number;
+
+  /**
+   * messages sent over the user's channels
+   *
+   * @generated from field: int32 notifications = 4;
+   */
+  notifications: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.UsageDay.
+ * Use `create(UsageDaySchema)` to create a new message.
+ */
+export declare const UsageDaySchema: GenMessage<UsageDay>;
+
+/**
+ * ApiLoad describes how busy the retailer API shared by every user is. Fields
+ * are 0 when the server isn't calling the real API.
+ *
+ * @generated from message stockchecker.v1.ApiLoad
+ */
+export declare type ApiLoad = Message<"stockchecker.v1.ApiLoad"> & {
+  /**
+   * time left between calls; longer while the API pushes back
+   *
+   * @generated from field: int32 call_interval_ms = 1;
+   */
+  callIntervalMs: number;
+
+  /**
+   * the daily quota resets at midnight UTC
+   *
+   * @generated from field: int32 calls_remaining_today = 2;
+   */
+  callsRemainingToday: number;
+
+  /**
+   * @generated from field: int32 daily_call_limit = 3;
+   */
+  dailyCallLimit: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.ApiLoad.
+ * Use `create(ApiLoadSchema)` to create a new message.
+ */
+export declare const ApiLoadSchema: GenMessage<ApiLoad>;
+
+/**
+ * GetMyUsageRequest selects how many days of usage to return
+ *
+ * @generated from message stockchecker.v1.GetMyUsageRequest
+ */
+export declare type GetMyUsageRequest = Message<"stockchecker.v1.GetMyUsageRequest"> & {
+  /**
+   * including today; defaults to 30, at most 90
+   *
+   * @generated from field: int32 days = 1;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyUsageRequest.
+ * Use `create(GetMyUsageRequestSchema)` to create a new message.
+ */
+export declare const GetMyUsageRequestSchema: GenMessage<GetMyUsageRequest>;
+
+/**
+ * GetMyUsageResponse is the user's usage per day and the API's current load
+ *
+ * @generated from message stockchecker.v1.GetMyUsageResponse
+ */
+export declare type GetMyUsageResponse = Message<"stockchecker.v1.GetMyUsageResponse"> & {
+  /**
+   * newest first; days without usage are left out
+   *
+   * @generated from field: repeated stockchecker.v1.UsageDay days = 1;
+   */
+  days: UsageDay[];
+
+  /**
+   * over the requested days; day is unset
+   *
+   * @generated from field: stockchecker.v1.UsageDay totals = 2;
+   */
+  totals?: UsageDay;
+
+  /**
+   * @generated from field: stockchecker.v1.ApiLoad load = 3;
+   */
+  load?: ApiLoad;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyUsageResponse.
+ * Use `create(GetMyUsageResponseSchema)` to create a new message.
+ */
+export declare const GetMyUsageResponseSchema: GenMessage<GetMyUsageResponse>;
+
+/**
+ * Drop is an invitation-only sale of a restricted product: shoppers enter
+ * while the window is open and the retailer invites some of them to buy
+ *
+ * @generated from message stockchecker.v1.Drop
+ */
+export declare type Drop = Message<"stockchecker.v1.Drop"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * Best Buy SKU; AdminSaveDrop also takes a product URL
+   *
+   * @generated from field: string sku = 2;
+   */
+  sku: string;
+
+  /**
+   * looked up from the SKU if left empty
+   *
+   * @generated from field: string product_name = 3;
+   */
+  productName: string;
+
+  /**
+   * at most 200 characters
+   *
+   * @generated from field: string title = 4;
+   */
+  title: string;
+
+  /**
+   * how to enter, at most 1000 characters
+   *
+   * @generated from field: string details = 5;
+   */
+  details: string;
+
+  /**
+   * the retailer's announcement; https only
+   *
+   * @generated from field: string url = 6;
+   */
+  url: string;
+
+  /**
+   * entries open
+   *
+   * @generated from field: google.protobuf.Timestamp opens_at = 7;
+   */
+  opensAt?: Timestamp;
+
+  /**
+   * entries close; unset if not announced
+   *
+   * @generated from field: google.protobuf.Timestamp closes_at = 8;
+   */
+  closesAt?: Timestamp;
+
+  /**
+   * the signed-in user watches the SKU; output only
+   *
+   * @generated from field: bool watching = 9;
+   */
+  watching: boolean;
+
+  /**
+   * output only
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.Drop.
+ * Use `create(DropSchema)` to create a new message.
+ */
+export declare const DropSchema: GenMessage<Drop>;
+
+/**
+ * UpcomingDropsRequest lists drops whose windows haven't ended
+ *
+ * @generated from message stockchecker.v1.UpcomingDropsRequest
+ */
+export declare type UpcomingDropsRequest = Message<"stockchecker.v1.UpcomingDropsRequest"> & {
+  /**
+   * only drops of products the user watches
+   *
+   * @generated from field: bool watched_only = 1;
+   */
+  watchedOnly: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v1.UpcomingDropsRequest.
+ * Use `create(UpcomingDropsRequestSchema)` to create a new message.
+ */
+export declare const UpcomingDropsRequestSchema: GenMessage<UpcomingDropsRequest>;
+
+/**
+ * UpcomingDropsResponse lists drops soonest opening first, open windows
+ * included. A window with no announced close ends a day after it opens.
+ *
+ * @generated from message stockchecker.v1.UpcomingDropsResponse
+ */
+export declare type UpcomingDropsResponse = Message<"stockchecker.v1.UpcomingDropsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Drop drops = 1;
+   */
+  drops: Drop[];
+};
+
+/**
+ * Describes the message stockchecker.v1.UpcomingDropsResponse.
+ * Use `create(UpcomingDropsResponseSchema)` to create a new message.
+ */
+export declare const UpcomingDropsResponseSchema: GenMessage<UpcomingDropsResponse>;
+
+/**
+ * AdminSaveDropRequest creates a drop, or updates one with an id (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminSaveDropRequest
+ */
+export declare type AdminSaveDropRequest = Message<"stockchecker.v1.AdminSaveDropRequest"> & {
+  /**
+   * @generated from field: stockchecker.v1.Drop drop = 1;
+   */
+  drop?: Drop;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminSaveDropRequest.
+ * Use `create(AdminSaveDropRequestSchema)` to create a new message.
+ */
+export declare const AdminSaveDropRequestSchema: GenMessage<AdminSaveDropRequest>;
+
+/**
+ * AdminSaveDropResponse returns the saved drop
+ *
+ * @generated from message stockchecker.v1.AdminSaveDropResponse
+ */
+export declare type AdminSaveDropResponse = Message<"stockchecker.v1.AdminSaveDropResponse"> & {
+  /**
+   * @generated from field: stockchecker.v1.Drop drop = 1;
+   */
+  drop?: Drop;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminSaveDropResponse.
+ * Use `create(AdminSaveDropResponseSchema)` to create a new message.
+ */
+export declare const AdminSaveDropResponseSchema: GenMessage<AdminSaveDropResponse>;
+
+/**
+ * AdminDeleteDropRequest deletes a drop (admin only)
+ *
+ * @generated from message stockchecker.v1.AdminDeleteDropRequest
+ */
+export declare type AdminDeleteDropRequest = Message<"stockchecker.v1.AdminDeleteDropRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminDeleteDropRequest.
+ * Use `create(AdminDeleteDropRequestSchema)` to create a new message.
+ */
+export declare const AdminDeleteDropRequestSchema: GenMessage<AdminDeleteDropRequest>;
+
+/**
+ * AdminDeleteDropResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.AdminDeleteDropResponse
+ */
+export declare type AdminDeleteDropResponse = Message<"stockchecker.v1.AdminDeleteDropResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.AdminDeleteDropResponse.
+ * Use `create(AdminDeleteDropResponseSchema)` to create a new message.
+ */
+export declare const AdminDeleteDropResponseSchema: GenMessage<AdminDeleteDropResponse>;
+
+/**
+ * GetMyInboundEmailRequest gets the user's address for forwarding emails
+ *
+ * @generated from message stockchecker.v1.GetMyInboundEmailRequest
+ */
+export declare type GetMyInboundEmailRequest = Message<"stockchecker.v1.GetMyInboundEmailRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyInboundEmailRequest.
+ * Use `create(GetMyInboundEmailRequestSchema)` to create a new message.
+ */
+export declare const GetMyInboundEmailRequestSchema: GenMessage<GetMyInboundEmailRequest>;
+
+/**
+ * GetMyInboundEmailResponse is the address to forward Best Buy emails to
+ *
+ * @generated from message stockchecker.v1.GetMyInboundEmailResponse
+ */
+export declare type GetMyInboundEmailResponse = Message<"stockchecker.v1.GetMyInboundEmailResponse"> & {
+  /**
+   * @generated from field: string address = 1;
+   */
+  address: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.GetMyInboundEmailResponse.
+ * Use `create(GetMyInboundEmailResponseSchema)` to create a new message.
+ */
+export declare const GetMyInboundEmailResponseSchema: GenMessage<GetMyInboundEmailResponse>;
+
+/**
+ * ResetMyInboundEmailRequest gives the user a new forwarding address
+ *
+ * @generated from message stockchecker.v1.ResetMyInboundEmailRequest
+ */
+export declare type ResetMyInboundEmailRequest = Message<"stockchecker.v1.ResetMyInboundEmailRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.ResetMyInboundEmailRequest.
+ * Use `create(ResetMyInboundEmailRequestSchema)` to create a new message.
+ */
+export declare const ResetMyInboundEmailRequestSchema: GenMessage<ResetMyInboundEmailRequest>;
+
+/**
+ * ResetMyInboundEmailResponse is the new address; mail to the old one is ignored
+ *
+ * @generated from message stockchecker.v1.ResetMyInboundEmailResponse
+ */
+export declare type ResetMyInboundEmailResponse = Message<"stockchecker.v1.ResetMyInboundEmailResponse"> & {
+  /**
+   * @generated from field: string address = 1;
+   */
+  address: string;
+};
+
+/**
+ * Describes the message stockchecker.v1.ResetMyInboundEmailResponse.
+ * Use `create(ResetMyInboundEmailResponseSchema)` to create a new message.
+ */
+export declare const ResetMyInboundEmailResponseSchema: GenMessage<ResetMyInboundEmailResponse>;
+
+/**
+ * Reminder is made from a Best Buy email the user forwarded: an invitation
+ * to buy a restricted product, or an order ready for pickup
+ *
+ * @generated from message stockchecker.v1.Reminder
+ */
+export declare type Reminder = Message<"stockchecker.v1.Reminder"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * "invitation" or "order_ready"
+   *
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+
+  /**
+   * empty if the email didn't name one
+   *
+   * @generated from field: string sku = 3;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string product_name = 4;
+   */
+  productName: string;
+
+  /**
+   * empty if the email didn't name one
+   *
+   * @generated from field: string store_id = 5;
+   */
+  storeId: string;
+
+  /**
+   * @generated from field: string store_name = 6;
+   */
+  storeName: string;
+
+  /**
+   * of the forwarded email
+   *
+   * @generated from field: string subject = 7;
+   */
+  subject: string;
+
+  /**
+   * invitation expires or order goes back to the shelf; unset if not given
+   *
+   * @generated from field: google.protobuf.Timestamp due_at = 8;
+   */
+  dueAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp remind_at = 9;
+   */
+  remindAt?: Timestamp;
+
+  /**
+   * unset until sent
+   *
+   * @generated from field: google.protobuf.Timestamp sent_at = 10;
+   */
+  sentAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v1.Reminder.
+ * Use `create(ReminderSchema)` to create a new message.
+ */
+export declare const ReminderSchema: GenMessage<Reminder>;
+
+/**
+ * ListMyRemindersRequest lists the user's reminders
+ *
+ * @generated from message stockchecker.v1.ListMyRemindersRequest
+ */
+export declare type ListMyRemindersRequest = Message<"stockchecker.v1.ListMyRemindersRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.ListMyRemindersRequest.
+ * Use `create(ListMyRemindersRequestSchema)` to create a new message.
+ */
+export declare const ListMyRemindersRequestSchema: GenMessage<ListMyRemindersRequest>;
+
+/**
+ * ListMyRemindersResponse lists reminders latest first; sent ones are kept 30 days
+ *
+ * @generated from message stockchecker.v1.ListMyRemindersResponse
+ */
+export declare type ListMyRemindersResponse = Message<"stockchecker.v1.ListMyRemindersResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v1.Reminder reminders = 1;
+   */
+  reminders: Reminder[];
+};
+
+/**
+ * Describes the message stockchecker.v1.ListMyRemindersResponse.
+ * Use `create(ListMyRemindersResponseSchema)` to create a new message.
+ */
+export declare const ListMyRemindersResponseSchema: GenMessage<ListMyRemindersResponse>;
+
+/**
+ * DeleteMyReminderRequest deletes one of the user's reminders
+ *
+ * @generated from message stockchecker.v1.DeleteMyReminderRequest
+ */
+export declare type DeleteMyReminderRequest = Message<"stockchecker.v1.DeleteMyReminderRequest"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyReminderRequest.
+ * Use `create(DeleteMyReminderRequestSchema)` to create a new message.
+ */
+export declare const DeleteMyReminderRequestSchema: GenMessage<DeleteMyReminderRequest>;
+
+/**
+ * DeleteMyReminderResponse is empty on success
+ *
+ * @generated from message stockchecker.v1.DeleteMyReminderResponse
+ */
+export declare type DeleteMyReminderResponse = Message<"stockchecker.v1.DeleteMyReminderResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyReminderResponse.
+ * Use `create(DeleteMyReminderResponseSchema)` to create a new message.
+ */
+export declare const DeleteMyReminderResponseSchema: GenMessage<DeleteMyReminderResponse>;
+
+/**
+ * Retailer sells a product. v1 RPCs look products up at Best Buy, but saved
+ * products and alerts can come from any retailer v2 serves.
+ *
+ * @generated from enum stockchecker.v1.Retailer
+ */
+export enum Retailer {
+  /**
+   * @generated from enum value: RETAILER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RETAILER_BEST_BUY = 1;
+   */
+  BEST_BUY = 1,
+
+  /**
+   * @generated from enum value: RETAILER_WALMART = 2;
+   */
+  WALMART = 2,
+
+  /**
+   * @generated from enum value: RETAILER_TARGET = 3;
+   */
+  TARGET = 3,
+
+  /**
+   * GameStop, or EB Games when the server is configured for Canada
+   *
+   * @generated from enum value: RETAILER_GAMESTOP = 4;
+   */
+  GAMESTOP = 4,
+
+  /**
+   * @generated from enum value: RETAILER_COSTCO = 5;
+   */
+  COSTCO = 5,
+}
+
+/**
+ * Describes the enum stockchecker.v1.Retailer.
+ */
+export declare const RetailerSchema: GenEnum<Retailer>;
+
+/**
+ * WatchPriority routes a saved product's alerts
+ *
+ * @generated from enum stockchecker.v1.WatchPriority
+ */
+export enum WatchPriority {
+  /**
+   * alerts go to every enabled channel
+   *
+   * @generated from enum value: WATCH_PRIORITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * alerts go to the urgent channels as emergencies, with a follow-up call if set up
+   *
+   * @generated from enum value: WATCH_PRIORITY_MUST_HAVE = 1;
+   */
+  MUST_HAVE = 1,
+
+  /**
+   * alerts wait for the digest
+   *
+   * @generated from enum value: WATCH_PRIORITY_NICE_TO_HAVE = 2;
+   */
+  NICE_TO_HAVE = 2,
+}
+
+/**
+ * Describes the enum stockchecker.v1.WatchPriority.
+ */
+export declare const WatchPrioritySchema: GenEnum<WatchPriority>;
+
+/**
+ * ProductType is the kind of sealed TCG product, read from the product name
+ *
+ * @generated from enum stockchecker.v1.ProductType
+ */
+export enum ProductType {
+  /**
+   * not a recognised TCG product
+   *
+   * @generated from enum value: PRODUCT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PRODUCT_TYPE_ELITE_TRAINER_BOX = 1;
+   */
+  ELITE_TRAINER_BOX = 1,
+
+  /**
+   * @generated from enum value: PRODUCT_TYPE_BOOSTER_BUNDLE = 2;
+   */
+  BOOSTER_BUNDLE = 2,
+
+  /**
+   * @generated from enum value: PRODUCT_TYPE_BOOSTER_BOX = 3;
+   */
+  BOOSTER_BOX = 3,
+
+  /**
+   * @generated from enum value: PRODUCT_TYPE_BOOSTER_PACK = 4;
+   */
+  BOOSTER_PACK = 4,
+
+  /**
+   * @generated from enum value: PRODUCT_TYPE_TIN = 5;
+   */
+  TIN = 5,
+
+  /**
+   * @generated from enum value: PRODUCT_TYPE_COLLECTION = 6;
+   */
+  COLLECTION = 6,
+
+  /**
+   * @generated from enum value: PRODUCT_TYPE_BLISTER = 7;
+   */
+  BLISTER = 7,
+}
+
+/**
+ * Describes the enum stockchecker.v1.ProductType.
+ */
+export declare const ProductTypeSchema: GenEnum<ProductType>;
+
+/**
+ * User represents an authenticated user
+ * UserRole is what a user is allowed to do
+ *
+ * @generated from enum stockchecker.v1.UserRole
+ */
+export enum UserRole {
+  /**
+   * @generated from enum value: USER_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * manages their own watchlist and alerts
+   *
+   * @generated from enum value: USER_ROLE_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * also manages shared defaults, sign-ins and moderation
+   *
+   * @generated from enum value: USER_ROLE_ADMIN = 2;
+   */
+  ADMIN = 2,
+}
+
+/**
+ * Describes the enum stockchecker.v1.UserRole.
+ */
+export declare const UserRoleSchema: GenEnum<UserRole>;
+
+/**
+ * SkuErrorCode is why a SKU couldn't be checked
+ *
+ * @generated from enum stockchecker.v1.SkuErrorCode
+ */
+export enum SkuErrorCode {
+  /**
+   * @generated from enum value: SKU_ERROR_CODE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Best Buy has no product with this SKU
+   *
+   * @generated from enum value: SKU_ERROR_CODE_NOT_FOUND = 1;
+   */
+  NOT_FOUND = 1,
+
+  /**
+   * Best Buy doesn't publish availability for this SKU
+   *
+   * @generated from enum value: SKU_ERROR_CODE_RESTRICTED = 2;
+   */
+  RESTRICTED = 2,
+
+  /**
+   * try again after retry_after_seconds
+   *
+   * @generated from enum value: SKU_ERROR_CODE_RATE_LIMITED = 3;
+   */
+  RATE_LIMITED = 3,
+
+  /**
+   * the daily API quota is used up
+   *
+   * @generated from enum value: SKU_ERROR_CODE_QUOTA_EXCEEDED = 4;
+   */
+  QUOTA_EXCEEDED = 4,
+
+  /**
+   * the server's API key was rejected
+   *
+   * @generated from enum value: SKU_ERROR_CODE_API_KEY = 5;
+   */
+  API_KEY = 5,
+
+  /**
+   * the API failed, timed out or returned something unexpected
+   *
+   * @generated from enum value: SKU_ERROR_CODE_UNAVAILABLE = 6;
+   */
+  UNAVAILABLE = 6,
+}
+
+/**
+ * Describes the enum stockchecker.v1.SkuErrorCode.
+ */
+export declare const SkuErrorCodeSchema: GenEnum<SkuErrorCode>;
+
+/**
+ * DuplicateReason is why a saved product may be the same item as another
+ *
+ * @generated from enum stockchecker.v1.DuplicateReason
+ */
+export enum DuplicateReason {
+  /**
+   * @generated from enum value: DUPLICATE_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * same barcode under another SKU
+   *
+   * @generated from enum value: DUPLICATE_REASON_SAME_UPC = 1;
+   */
+  SAME_UPC = 1,
+
+  /**
+   * @generated from enum value: DUPLICATE_REASON_SAME_MODEL_NUMBER = 2;
+   */
+  SAME_MODEL_NUMBER = 2,
+
+  /**
+   * same set in different packaging, judged by name
+   *
+   * @generated from enum value: DUPLICATE_REASON_SAME_SET = 3;
+   */
+  SAME_SET = 3,
+}
+
+/**
+ * Describes the enum stockchecker.v1.DuplicateReason.
+ */
+export declare const DuplicateReasonSchema: GenEnum<DuplicateReason>;
+
+/**
+ * WatchlistChangeAction is what happened to a saved store or product
+ *
+ * @generated from enum stockchecker.v1.WatchlistChangeAction
+ */
+export enum WatchlistChangeAction {
+  /**
+   * @generated from enum value: WATCHLIST_CHANGE_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WATCHLIST_CHANGE_ACTION_ADDED = 1;
+   */
+  ADDED = 1,
+
+  /**
+   * @generated from enum value: WATCHLIST_CHANGE_ACTION_UPDATED = 2;
+   */
+  UPDATED = 2,
+
+  /**
+   * @generated from enum value: WATCHLIST_CHANGE_ACTION_REMOVED = 3;
+   */
+  REMOVED = 3,
+}
+
+/**
+ * Describes the enum stockchecker.v1.WatchlistChangeAction.
+ */
+export declare const WatchlistChangeActionSchema: GenEnum<WatchlistChangeAction>;
+
+/**
+ * StoreConfidence is how often users found stock on the shelf when a store reported it
+ *
+ * @generated from enum stockchecker.v1.StoreConfidence
+ */
+export enum StoreConfidence {
+  /**
+   * too few confirmations to say
+   *
+   * @generated from enum value: STORE_CONFIDENCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STORE_CONFIDENCE_LOW = 1;
+   */
+  LOW = 1,
+
+  /**
+   * @generated from enum value: STORE_CONFIDENCE_MEDIUM = 2;
+   */
+  MEDIUM = 2,
+
+  /**
+   * @generated from enum value: STORE_CONFIDENCE_HIGH = 3;
+   */
+  HIGH = 3,
+}
+
+/**
+ * Describes the enum stockchecker.v1.StoreConfidence.
+ */
+export declare const StoreConfidenceSchema: GenEnum<StoreConfidence>;
+
+/**
+ * SightingStatus is where a sighting is in moderation
+ *
+ * @generated from enum stockchecker.v1.SightingStatus
+ */
+export enum SightingStatus {
+  /**
+   * every status, when filtering
+   *
+   * @generated from enum value: SIGHTING_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SIGHTING_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * approved; watchers were alerted
+   *
+   * @generated from enum value: SIGHTING_STATUS_CONFIRMED = 2;
+   */
+  CONFIRMED = 2,
+
+  /**
+   * @generated from enum value: SIGHTING_STATUS_REJECTED = 3;
+   */
+  REJECTED = 3,
+}
+
+/**
+ * Describes the enum stockchecker.v1.SightingStatus.
+ */
+export declare const SightingStatusSchema: GenEnum<SightingStatus>;
+
+/**
+ * StockCheckerService provides stock checking functionality
+ *
+ * @generated from service stockchecker.v1.StockCheckerService
+ */
+export declare const StockCheckerService: GenService<{
+  /**
+   * SearchStores searches for Best Buy stores near a location
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SearchStores
+   */
+  searchStores: {
+    methodKind: "unary";
+    input: typeof SearchStoresRequestSchema;
+    output: typeof SearchStoresResponseSchema;
+  },
+  /**
+   * SearchProducts searches for products by keyword or SKU
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SearchProducts
+   */
+  searchProducts: {
+    methodKind: "unary";
+    input: typeof SearchProductsRequestSchema;
+    output: typeof SearchProductsResponseSchema;
+  },
+  /**
+   * CheckStock checks inventory for products at specified stores
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.CheckStock
+   */
+  checkStock: {
+    methodKind: "unary";
+    input: typeof CheckStockRequestSchema;
+    output: typeof CheckStockResponseSchema;
+  },
+  /**
+   * GetCurrentUser returns the currently authenticated user
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetCurrentUser
+   */
+  getCurrentUser: {
+    methodKind: "unary";
+    input: typeof GetCurrentUserRequestSchema;
     output: typeof GetCurrentUserResponseSchema;
   },
   /**
-   * GetMyStores returns the user's saved stores
+   * SetMyLocale sets the language used for the user's notifications and messages
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SetMyLocale
+   */
+  setMyLocale: {
+    methodKind: "unary";
+    input: typeof SetMyLocaleRequestSchema;
+    output: typeof SetMyLocaleResponseSchema;
+  },
+  /**
+   * GetMyStores returns the user's saved stores
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyStores
+   */
+  getMyStores: {
+    methodKind: "unary";
+    input: typeof GetMyStoresRequestSchema;
+    output: typeof GetMyStoresResponseSchema;
+  },
+  /**
+   * AddMyStore adds a store to the user's list
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AddMyStore
+   */
+  addMyStore: {
+    methodKind: "unary";
+    input: typeof AddMyStoreRequestSchema;
+    output: typeof AddMyStoreResponseSchema;
+  },
+  /**
+   * RemoveMyStore removes a store from the user's list
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.RemoveMyStore
+   */
+  removeMyStore: {
+    methodKind: "unary";
+    input: typeof RemoveMyStoreRequestSchema;
+    output: typeof RemoveMyStoreResponseSchema;
+  },
+  /**
+   * GetMyProducts returns the user's saved products
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyProducts
+   */
+  getMyProducts: {
+    methodKind: "unary";
+    input: typeof GetMyProductsRequestSchema;
+    output: typeof GetMyProductsResponseSchema;
+  },
+  /**
+   * AddMyProduct adds a product to the user's list
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AddMyProduct
+   */
+  addMyProduct: {
+    methodKind: "unary";
+    input: typeof AddMyProductRequestSchema;
+    output: typeof AddMyProductResponseSchema;
+  },
+  /**
+   * UpdateMyProduct changes the fields of a saved product named in the update mask
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.UpdateMyProduct
+   */
+  updateMyProduct: {
+    methodKind: "unary";
+    input: typeof UpdateMyProductRequestSchema;
+    output: typeof UpdateMyProductResponseSchema;
+  },
+  /**
+   * RemoveMyProduct removes a product from the user's list
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.RemoveMyProduct
+   */
+  removeMyProduct: {
+    methodKind: "unary";
+    input: typeof RemoveMyProductRequestSchema;
+    output: typeof RemoveMyProductResponseSchema;
+  },
+  /**
+   * RemoveMyProducts removes several products from the user's list in one transaction
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.RemoveMyProducts
+   */
+  removeMyProducts: {
+    methodKind: "unary";
+    input: typeof RemoveMyProductsRequestSchema;
+    output: typeof RemoveMyProductsResponseSchema;
+  },
+  /**
+   * ClearWatchlist removes every product (and optionally store) from the
+   * user's list in one transaction; confirm must be set
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ClearWatchlist
+   */
+  clearWatchlist: {
+    methodKind: "unary";
+    input: typeof ClearWatchlistRequestSchema;
+    output: typeof ClearWatchlistResponseSchema;
+  },
+  /**
+   * ImportMyProducts adds a pasted list of products to the user's list
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ImportMyProducts
+   */
+  importMyProducts: {
+    methodKind: "unary";
+    input: typeof ImportMyProductsRequestSchema;
+    output: typeof ImportMyProductsResponseSchema;
+  },
+  /**
+   * BrowsePokemonProducts returns Pokemon products from Best Buy's trading cards category
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.BrowsePokemonProducts
+   */
+  browsePokemonProducts: {
+    methodKind: "unary";
+    input: typeof BrowsePokemonProductsRequestSchema;
+    output: typeof BrowsePokemonProductsResponseSchema;
+  },
+  /**
+   * GetNotificationPreferences returns the user's notification preferences
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetNotificationPreferences
+   */
+  getNotificationPreferences: {
+    methodKind: "unary";
+    input: typeof GetNotificationPreferencesRequestSchema;
+    output: typeof GetNotificationPreferencesResponseSchema;
+  },
+  /**
+   * UpdateNotificationPreferences changes the preferences named in the update mask
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.UpdateNotificationPreferences
+   */
+  updateNotificationPreferences: {
+    methodKind: "unary";
+    input: typeof UpdateNotificationPreferencesRequestSchema;
+    output: typeof UpdateNotificationPreferencesResponseSchema;
+  },
+  /**
+   * GetAlertRules returns the user's per-product alert rules
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetAlertRules
+   */
+  getAlertRules: {
+    methodKind: "unary";
+    input: typeof GetAlertRulesRequestSchema;
+    output: typeof GetAlertRulesResponseSchema;
+  },
+  /**
+   * UpdateAlertRule changes the rule fields named in the update mask
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.UpdateAlertRule
+   */
+  updateAlertRule: {
+    methodKind: "unary";
+    input: typeof UpdateAlertRuleRequestSchema;
+    output: typeof UpdateAlertRuleResponseSchema;
+  },
+  /**
+   * GetNotificationTemplates returns the user's notification templates and the admin defaults
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetNotificationTemplates
+   */
+  getNotificationTemplates: {
+    methodKind: "unary";
+    input: typeof GetNotificationTemplatesRequestSchema;
+    output: typeof GetNotificationTemplatesResponseSchema;
+  },
+  /**
+   * SetNotificationTemplate validates and saves a notification template
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SetNotificationTemplate
+   */
+  setNotificationTemplate: {
+    methodKind: "unary";
+    input: typeof SetNotificationTemplateRequestSchema;
+    output: typeof SetNotificationTemplateResponseSchema;
+  },
+  /**
+   * DeleteNotificationTemplate removes a notification template
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.DeleteNotificationTemplate
+   */
+  deleteNotificationTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteNotificationTemplateRequestSchema;
+    output: typeof DeleteNotificationTemplateResponseSchema;
+  },
+  /**
+   * GetNotificationChannels returns the user's notification channels
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetNotificationChannels
+   */
+  getNotificationChannels: {
+    methodKind: "unary";
+    input: typeof GetNotificationChannelsRequestSchema;
+    output: typeof GetNotificationChannelsResponseSchema;
+  },
+  /**
+   * SetNotificationChannel validates and saves a notification channel
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SetNotificationChannel
+   */
+  setNotificationChannel: {
+    methodKind: "unary";
+    input: typeof SetNotificationChannelRequestSchema;
+    output: typeof SetNotificationChannelResponseSchema;
+  },
+  /**
+   * DeleteNotificationChannel removes a notification channel
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.DeleteNotificationChannel
+   */
+  deleteNotificationChannel: {
+    methodKind: "unary";
+    input: typeof DeleteNotificationChannelRequestSchema;
+    output: typeof DeleteNotificationChannelResponseSchema;
+  },
+  /**
+   * SendTestNotification previews a notification and optionally sends it over a configured channel
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SendTestNotification
+   */
+  sendTestNotification: {
+    methodKind: "unary";
+    input: typeof SendTestNotificationRequestSchema;
+    output: typeof SendTestNotificationResponseSchema;
+  },
+  /**
+   * SimulateWatcherCycle runs the stock watcher without sending anything and reports which notifications would fire (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SimulateWatcherCycle
+   */
+  simulateWatcherCycle: {
+    methodKind: "unary";
+    input: typeof SimulateWatcherCycleRequestSchema;
+    output: typeof SimulateWatcherCycleResponseSchema;
+  },
+  /**
+   * GetMyDashboard returns current and recent availability of the user's watched products
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyDashboard
+   */
+  getMyDashboard: {
+    methodKind: "unary";
+    input: typeof GetMyDashboardRequestSchema;
+    output: typeof GetMyDashboardResponseSchema;
+  },
+  /**
+   * GetMyLocations returns the user's locations
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyLocations
+   */
+  getMyLocations: {
+    methodKind: "unary";
+    input: typeof GetMyLocationsRequestSchema;
+    output: typeof GetMyLocationsResponseSchema;
+  },
+  /**
+   * SetMyLocation creates or replaces one of the user's locations
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SetMyLocation
+   */
+  setMyLocation: {
+    methodKind: "unary";
+    input: typeof SetMyLocationRequestSchema;
+    output: typeof SetMyLocationResponseSchema;
+  },
+  /**
+   * DeleteMyLocation removes one of the user's locations
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.DeleteMyLocation
+   */
+  deleteMyLocation: {
+    methodKind: "unary";
+    input: typeof DeleteMyLocationRequestSchema;
+    output: typeof DeleteMyLocationResponseSchema;
+  },
+  /**
+   * GetProductBarcode returns a scannable barcode for a product
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetProductBarcode
+   */
+  getProductBarcode: {
+    methodKind: "unary";
+    input: typeof GetProductBarcodeRequestSchema;
+    output: typeof GetProductBarcodeResponseSchema;
+  },
+  /**
+   * CheckStoreNow live-checks every watched product at one of the user's stores
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.CheckStoreNow
+   */
+  checkStoreNow: {
+    methodKind: "unary";
+    input: typeof CheckStoreNowRequestSchema;
+    output: typeof CheckStoreNowResponseSchema;
+  },
+  /**
+   * GetStockHistory returns the availability checks of a product at a store
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetStockHistory
+   */
+  getStockHistory: {
+    methodKind: "unary";
+    input: typeof GetStockHistoryRequestSchema;
+    output: typeof GetStockHistoryResponseSchema;
+  },
+  /**
+   * WatchStock streams stock changes as the watcher records them. A client
+   * that stops reading for a minute is disconnected; reconnecting with the
+   * last resume token delivers the changes missed in between.
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.WatchStock
+   */
+  watchStock: {
+    methodKind: "server_streaming";
+    input: typeof WatchStockRequestSchema;
+    output: typeof WatchStockResponseSchema;
+  },
+  /**
+   * GetOfflineBundle returns the user's watch list and latest stock for offline viewing
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetOfflineBundle
+   */
+  getOfflineBundle: {
+    methodKind: "unary";
+    input: typeof GetOfflineBundleRequestSchema;
+    output: typeof GetOfflineBundleResponseSchema;
+  },
+  /**
+   * SyncChanges returns changes to the user's saved data and latest stock since a previous sync
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.SyncChanges
+   */
+  syncChanges: {
+    methodKind: "unary";
+    input: typeof SyncChangesRequestSchema;
+    output: typeof SyncChangesResponseSchema;
+  },
+  /**
+   * ListWatchlistChanges returns the history of changes to the user's saved stores and products
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ListWatchlistChanges
+   */
+  listWatchlistChanges: {
+    methodKind: "unary";
+    input: typeof ListWatchlistChangesRequestSchema;
+    output: typeof ListWatchlistChangesResponseSchema;
+  },
+  /**
+   * UndoLastChange reverses the user's most recent change to their saved
+   * stores or products, if it was made within the last hour. Calling it again
+   * undoes the change before that.
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.UndoLastChange
+   */
+  undoLastChange: {
+    methodKind: "unary";
+    input: typeof UndoLastChangeRequestSchema;
+    output: typeof UndoLastChangeResponseSchema;
+  },
+  /**
+   * GetProductDetails returns a product with the details of its TCG set
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetProductDetails
+   */
+  getProductDetails: {
+    methodKind: "unary";
+    input: typeof GetProductDetailsRequestSchema;
+    output: typeof GetProductDetailsResponseSchema;
+  },
+  /**
+   * ListMsrps returns the MSRPs listings are compared against
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyStores
+   * @generated from rpc stockchecker.v1.StockCheckerService.ListMsrps
    */
-  getMyStores: {
+  listMsrps: {
     methodKind: "unary";
-    input: typeof GetMyStoresRequestSchema;
-    output: typeof GetMyStoresResponseSchema;
+    input: typeof ListMsrpsRequestSchema;
+    output: typeof ListMsrpsResponseSchema;
   },
   /**
-   * AddMyStore adds a store to the user's list
+   * SetMsrp sets or removes an MSRP (admin only)
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.AddMyStore
+   * @generated from rpc stockchecker.v1.StockCheckerService.SetMsrp
    */
-  addMyStore: {
+  setMsrp: {
     methodKind: "unary";
-    input: typeof AddMyStoreRequestSchema;
-    output: typeof AddMyStoreResponseSchema;
+    input: typeof SetMsrpRequestSchema;
+    output: typeof SetMsrpResponseSchema;
   },
   /**
-   * RemoveMyStore removes a store from the user's list
+   * GetMySetWatches returns the sets the user watches
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.RemoveMyStore
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMySetWatches
    */
-  removeMyStore: {
+  getMySetWatches: {
     methodKind: "unary";
-    input: typeof RemoveMyStoreRequestSchema;
-    output: typeof RemoveMyStoreResponseSchema;
+    input: typeof GetMySetWatchesRequestSchema;
+    output: typeof GetMySetWatchesResponseSchema;
   },
   /**
-   * GetMyProducts returns the user's saved products
+   * WatchSet saves every product from a set, including ones listed later.
+   * Products the user removes stay removed.
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyProducts
+   * @generated from rpc stockchecker.v1.StockCheckerService.WatchSet
    */
-  getMyProducts: {
+  watchSet: {
     methodKind: "unary";
-    input: typeof GetMyProductsRequestSchema;
-    output: typeof GetMyProductsResponseSchema;
+    input: typeof WatchSetRequestSchema;
+    output: typeof WatchSetResponseSchema;
   },
   /**
-   * AddMyProduct adds a product to the user's list
+   * UnwatchSet stops watching a set
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.AddMyProduct
+   * @generated from rpc stockchecker.v1.StockCheckerService.UnwatchSet
    */
-  addMyProduct: {
+  unwatchSet: {
     methodKind: "unary";
-    input: typeof AddMyProductRequestSchema;
-    output: typeof AddMyProductResponseSchema;
+    input: typeof UnwatchSetRequestSchema;
+    output: typeof UnwatchSetResponseSchema;
   },
   /**
-   * RemoveMyProduct removes a product from the user's list
+   * MarkPurchased records a purchase of one of the user's saved products
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.RemoveMyProduct
+   * @generated from rpc stockchecker.v1.StockCheckerService.MarkPurchased
    */
-  removeMyProduct: {
+  markPurchased: {
     methodKind: "unary";
-    input: typeof RemoveMyProductRequestSchema;
-    output: typeof RemoveMyProductResponseSchema;
+    input: typeof MarkPurchasedRequestSchema;
+    output: typeof MarkPurchasedResponseSchema;
   },
   /**
-   * BrowsePokemonProducts returns Pokemon products from Best Buy's trading cards category
+   * GetMyAcquisitions returns the user's recorded purchases, newest first
    *
-   * @generated from rpc stockchecker.v1.StockCheckerService.BrowsePokemonProducts
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyAcquisitions
    */
-  browsePokemonProducts: {
+  getMyAcquisitions: {
     methodKind: "unary";
-    input: typeof BrowsePokemonProductsRequestSchema;
-    output: typeof BrowsePokemonProductsResponseSchema;
+    input: typeof GetMyAcquisitionsRequestSchema;
+    output: typeof GetMyAcquisitionsResponseSchema;
+  },
+  /**
+   * DeleteAcquisition removes one of the user's recorded purchases
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.DeleteAcquisition
+   */
+  deleteAcquisition: {
+    methodKind: "unary";
+    input: typeof DeleteAcquisitionRequestSchema;
+    output: typeof DeleteAcquisitionResponseSchema;
+  },
+  /**
+   * GetAcquisitionSummary totals the user's spend per month and set
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetAcquisitionSummary
+   */
+  getAcquisitionSummary: {
+    methodKind: "unary";
+    input: typeof GetAcquisitionSummaryRequestSchema;
+    output: typeof GetAcquisitionSummaryResponseSchema;
+  },
+  /**
+   * ConfirmStock records whether an alerted product was really on a store's shelf
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ConfirmStock
+   */
+  confirmStock: {
+    methodKind: "unary";
+    input: typeof ConfirmStockRequestSchema;
+    output: typeof ConfirmStockResponseSchema;
+  },
+  /**
+   * GetStoreReliability scores how often stores' reported stock was on the shelf
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetStoreReliability
+   */
+  getStoreReliability: {
+    methodKind: "unary";
+    input: typeof GetStoreReliabilityRequestSchema;
+    output: typeof GetStoreReliabilityResponseSchema;
+  },
+  /**
+   * ReportSighting reports stock seen on a store's shelf, for moderation
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ReportSighting
+   */
+  reportSighting: {
+    methodKind: "unary";
+    input: typeof ReportSightingRequestSchema;
+    output: typeof ReportSightingResponseSchema;
+  },
+  /**
+   * ListSightings lists reported sightings for moderation (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ListSightings
+   */
+  listSightings: {
+    methodKind: "unary";
+    input: typeof ListSightingsRequestSchema;
+    output: typeof ListSightingsResponseSchema;
+  },
+  /**
+   * GetSightingPhoto returns a sighting's photo (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetSightingPhoto
+   */
+  getSightingPhoto: {
+    methodKind: "unary";
+    input: typeof GetSightingPhotoRequestSchema;
+    output: typeof GetSightingPhotoResponseSchema;
+  },
+  /**
+   * ModerateSighting approves or rejects a sighting, alerting watchers if approved (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ModerateSighting
+   */
+  moderateSighting: {
+    methodKind: "unary";
+    input: typeof ModerateSightingRequestSchema;
+    output: typeof ModerateSightingResponseSchema;
+  },
+  /**
+   * GetProductDomain returns the kind of product the deployment tracks
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetProductDomain
+   */
+  getProductDomain: {
+    methodKind: "unary";
+    input: typeof GetProductDomainRequestSchema;
+    output: typeof GetProductDomainResponseSchema;
+  },
+  /**
+   * GetMyProductWatches returns the user's product watches
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyProductWatches
+   */
+  getMyProductWatches: {
+    methodKind: "unary";
+    input: typeof GetMyProductWatchesRequestSchema;
+    output: typeof GetMyProductWatchesResponseSchema;
+  },
+  /**
+   * WatchProducts alerts the user when Best Buy lists a new product matching a search
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.WatchProducts
+   */
+  watchProducts: {
+    methodKind: "unary";
+    input: typeof WatchProductsRequestSchema;
+    output: typeof WatchProductsResponseSchema;
+  },
+  /**
+   * UnwatchProducts stops a product watch
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.UnwatchProducts
+   */
+  unwatchProducts: {
+    methodKind: "unary";
+    input: typeof UnwatchProductsRequestSchema;
+    output: typeof UnwatchProductsResponseSchema;
+  },
+  /**
+   * AdminAddAllowedEmail allows an email address to sign in (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminAddAllowedEmail
+   */
+  adminAddAllowedEmail: {
+    methodKind: "unary";
+    input: typeof AdminAddAllowedEmailRequestSchema;
+    output: typeof AdminAddAllowedEmailResponseSchema;
+  },
+  /**
+   * AdminRemoveAllowedEmail stops an email address signing in and signs it out (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminRemoveAllowedEmail
+   */
+  adminRemoveAllowedEmail: {
+    methodKind: "unary";
+    input: typeof AdminRemoveAllowedEmailRequestSchema;
+    output: typeof AdminRemoveAllowedEmailResponseSchema;
+  },
+  /**
+   * AdminListAllowedEmails lists the email addresses allowed to sign in (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminListAllowedEmails
+   */
+  adminListAllowedEmails: {
+    methodKind: "unary";
+    input: typeof AdminListAllowedEmailsRequestSchema;
+    output: typeof AdminListAllowedEmailsResponseSchema;
+  },
+  /**
+   * AdminAddAllowedDomain allows every verified address on a domain to sign in (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminAddAllowedDomain
+   */
+  adminAddAllowedDomain: {
+    methodKind: "unary";
+    input: typeof AdminAddAllowedDomainRequestSchema;
+    output: typeof AdminAddAllowedDomainResponseSchema;
+  },
+  /**
+   * AdminRemoveAllowedDomain stops a domain's addresses signing in and signs
+   * out those not allowed individually (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminRemoveAllowedDomain
+   */
+  adminRemoveAllowedDomain: {
+    methodKind: "unary";
+    input: typeof AdminRemoveAllowedDomainRequestSchema;
+    output: typeof AdminRemoveAllowedDomainResponseSchema;
+  },
+  /**
+   * AdminListAllowedDomains lists the email domains allowed to sign in (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminListAllowedDomains
+   */
+  adminListAllowedDomains: {
+    methodKind: "unary";
+    input: typeof AdminListAllowedDomainsRequestSchema;
+    output: typeof AdminListAllowedDomainsResponseSchema;
+  },
+  /**
+   * AdminCreateInvite creates a single-use, expiring link that allows
+   * whoever signs in with it (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminCreateInvite
+   */
+  adminCreateInvite: {
+    methodKind: "unary";
+    input: typeof AdminCreateInviteRequestSchema;
+    output: typeof AdminCreateInviteResponseSchema;
+  },
+  /**
+   * AdminListInvites lists invite links, used or not (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminListInvites
+   */
+  adminListInvites: {
+    methodKind: "unary";
+    input: typeof AdminListInvitesRequestSchema;
+    output: typeof AdminListInvitesResponseSchema;
+  },
+  /**
+   * AdminRevokeInvite revokes an invite link that hasn't been used (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminRevokeInvite
+   */
+  adminRevokeInvite: {
+    methodKind: "unary";
+    input: typeof AdminRevokeInviteRequestSchema;
+    output: typeof AdminRevokeInviteResponseSchema;
+  },
+  /**
+   * AdminListUsers lists the users who have signed in (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminListUsers
+   */
+  adminListUsers: {
+    methodKind: "unary";
+    input: typeof AdminListUsersRequestSchema;
+    output: typeof AdminListUsersResponseSchema;
+  },
+  /**
+   * AdminSetUserRole promotes a user to admin or demotes them (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminSetUserRole
+   */
+  adminSetUserRole: {
+    methodKind: "unary";
+    input: typeof AdminSetUserRoleRequestSchema;
+    output: typeof AdminSetUserRoleResponseSchema;
+  },
+  /**
+   * AdminListCredentials lists the retailer keys and notifier settings admins can rotate (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminListCredentials
+   */
+  adminListCredentials: {
+    methodKind: "unary";
+    input: typeof AdminListCredentialsRequestSchema;
+    output: typeof AdminListCredentialsResponseSchema;
+  },
+  /**
+   * AdminSetCredential rotates a retailer key or notifier setting without a restart (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminSetCredential
+   */
+  adminSetCredential: {
+    methodKind: "unary";
+    input: typeof AdminSetCredentialRequestSchema;
+    output: typeof AdminSetCredentialResponseSchema;
+  },
+  /**
+   * AdminClearCredential goes back to a credential's value from the environment (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminClearCredential
+   */
+  adminClearCredential: {
+    methodKind: "unary";
+    input: typeof AdminClearCredentialRequestSchema;
+    output: typeof AdminClearCredentialResponseSchema;
+  },
+  /**
+   * AdminGetApiCallStats sums up a sample of recent Best Buy API calls by
+   * endpoint and priority, to show what uses up the quota (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminGetApiCallStats
+   */
+  adminGetApiCallStats: {
+    methodKind: "unary";
+    input: typeof AdminGetApiCallStatsRequestSchema;
+    output: typeof AdminGetApiCallStatsResponseSchema;
+  },
+  /**
+   * GetMyApiKeys lists the user's API keys
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyApiKeys
+   */
+  getMyApiKeys: {
+    methodKind: "unary";
+    input: typeof GetMyApiKeysRequestSchema;
+    output: typeof GetMyApiKeysResponseSchema;
+  },
+  /**
+   * CreateApiKey creates an API key for calling the API without signing in
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.CreateApiKey
+   */
+  createApiKey: {
+    methodKind: "unary";
+    input: typeof CreateApiKeyRequestSchema;
+    output: typeof CreateApiKeyResponseSchema;
+  },
+  /**
+   * RevokeApiKey revokes one of the user's API keys
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.RevokeApiKey
+   */
+  revokeApiKey: {
+    methodKind: "unary";
+    input: typeof RevokeApiKeyRequestSchema;
+    output: typeof RevokeApiKeyResponseSchema;
+  },
+  /**
+   * ListSessions lists the browsers the user is signed in on
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * RevokeSession signs out one of the user's sessions, or all of them
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * ExportMyData dumps everything stored about the user as JSON, for keeping
+   * a copy or moving to another instance
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ExportMyData
+   */
+  exportMyData: {
+    methodKind: "unary";
+    input: typeof ExportMyDataRequestSchema;
+    output: typeof ExportMyDataResponseSchema;
+  },
+  /**
+   * DeleteMyAccount deletes the user's account and all their data. The only
+   * admin can't delete their account.
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.DeleteMyAccount
+   */
+  deleteMyAccount: {
+    methodKind: "unary";
+    input: typeof DeleteMyAccountRequestSchema;
+    output: typeof DeleteMyAccountResponseSchema;
+  },
+  /**
+   * GetClientBootstrap returns everything the web app needs on load in one call;
+   * it works signed out, leaving the user's parts unset
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetClientBootstrap
+   */
+  getClientBootstrap: {
+    methodKind: "unary";
+    input: typeof GetClientBootstrapRequestSchema;
+    output: typeof GetClientBootstrapResponseSchema;
+  },
+  /**
+   * GetApiInfo returns the API changelog and what's deprecated, for
+   * integrators to check before upgrading; it works signed out
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetApiInfo
+   */
+  getApiInfo: {
+    methodKind: "unary";
+    input: typeof GetApiInfoRequestSchema;
+    output: typeof GetApiInfoResponseSchema;
+  },
+  /**
+   * GetMyUsage returns the checks and notifications counted for the user
+   * each day, with how busy the shared retailer API is, so users can tell
+   * why checks are slower than usual
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyUsage
+   */
+  getMyUsage: {
+    methodKind: "unary";
+    input: typeof GetMyUsageRequestSchema;
+    output: typeof GetMyUsageResponseSchema;
+  },
+  /**
+   * UpcomingDrops lists invitation-only drops of restricted products whose
+   * entry windows haven't ended. Users watching a drop's SKU are notified
+   * when its window opens.
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.UpcomingDrops
+   */
+  upcomingDrops: {
+    methodKind: "unary";
+    input: typeof UpcomingDropsRequestSchema;
+    output: typeof UpcomingDropsResponseSchema;
+  },
+  /**
+   * AdminSaveDrop creates or updates a drop from a retailer's announcement;
+   * moving its opening time notifies watchers again (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminSaveDrop
+   */
+  adminSaveDrop: {
+    methodKind: "unary";
+    input: typeof AdminSaveDropRequestSchema;
+    output: typeof AdminSaveDropResponseSchema;
+  },
+  /**
+   * AdminDeleteDrop deletes a drop (admin only)
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.AdminDeleteDrop
+   */
+  adminDeleteDrop: {
+    methodKind: "unary";
+    input: typeof AdminDeleteDropRequestSchema;
+    output: typeof AdminDeleteDropResponseSchema;
+  },
+  /**
+   * GetMyInboundEmail returns the user's address for forwarding Best Buy
+   * invitation and order-ready emails, which become reminders before the
+   * invitation expires or the order goes back to the shelf. The address is
+   * made on the first call.
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.GetMyInboundEmail
+   */
+  getMyInboundEmail: {
+    methodKind: "unary";
+    input: typeof GetMyInboundEmailRequestSchema;
+    output: typeof GetMyInboundEmailResponseSchema;
+  },
+  /**
+   * ResetMyInboundEmail replaces the user's forwarding address, e.g. if it
+   * leaked; mail to the old one is ignored
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ResetMyInboundEmail
+   */
+  resetMyInboundEmail: {
+    methodKind: "unary";
+    input: typeof ResetMyInboundEmailRequestSchema;
+    output: typeof ResetMyInboundEmailResponseSchema;
+  },
+  /**
+   * ListMyReminders lists the reminders made from the user's forwarded emails
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.ListMyReminders
+   */
+  listMyReminders: {
+    methodKind: "unary";
+    input: typeof ListMyRemindersRequestSchema;
+    output: typeof ListMyRemindersResponseSchema;
+  },
+  /**
+   * DeleteMyReminder deletes a reminder, so it isn't sent
+   *
+   * @generated from rpc stockchecker.v1.StockCheckerService.DeleteMyReminder
+   */
+  deleteMyReminder: {
+    methodKind: "unary";
+    input: typeof DeleteMyReminderRequestSchema;
+    output: typeof DeleteMyReminderResponseSchema;
   },
 }>;
 
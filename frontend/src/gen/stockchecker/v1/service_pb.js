@@ -2,13 +2,14 @@
 // @generated from file stockchecker/v1/service.proto (package stockchecker.v1, syntax proto3)
 /* eslint-disable */
 
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 
 /**
  * Describes the file stockchecker/v1/service.proto.
  */
 export const file_stockchecker_v1_service = /*@__PURE__*/
-  fileDesc("Ch1zdG9ja2NoZWNrZXIvdjEvc2VydmljZS5wcm90bxIPc3RvY2tjaGVja2VyLnYxIpEBCgVTdG9yZRIQCghzdG9yZV9pZBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB2FkZHJlc3MYAyABKAkSDAoEY2l0eRgEIAEoCRINCgVzdGF0ZRgFIAEoCRITCgtwb3N0YWxfY29kZRgGIAEoCRINCgVwaG9uZRgHIAEoCRIWCg5kaXN0YW5jZV9taWxlcxgIIAEoASJkCgdQcm9kdWN0EgsKA3NrdRgBIAEoCRIMCgRuYW1lGAIgASgJEhIKCnNhbGVfcHJpY2UYAyABKAESFQoNdGh1bWJuYWlsX3VybBgEIAEoCRITCgtwcm9kdWN0X3VybBgFIAEoCSKyAQoLU3RvY2tTdGF0dXMSJQoFc3RvcmUYASABKAsyFi5zdG9ja2NoZWNrZXIudjEuU3RvcmUSKQoHcHJvZHVjdBgCIAEoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0EhAKCGluX3N0b2NrGAMgASgIEhEKCWxvd19zdG9jaxgEIAEoCBIXCg9waWNrdXBfZWxpZ2libGUYBSABKAgSEwoLaXNfbXlfc3RvcmUYBiABKAgiRAoEVXNlchIKCgJpZBgBIAEoBRINCgVlbWFpbBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC3BpY3R1cmVfdXJsGAQgASgJIkAKE1NlYXJjaFN0b3Jlc1JlcXVlc3QSEwoLcG9zdGFsX2NvZGUYASABKAkSFAoMcmFkaXVzX21pbGVzGAIgASgFIj4KFFNlYXJjaFN0b3Jlc1Jlc3BvbnNlEiYKBnN0b3JlcxgBIAMoCzIWLnN0b2NrY2hlY2tlci52MS5TdG9yZSI4ChVTZWFyY2hQcm9kdWN0c1JlcXVlc3QSDQoFcXVlcnkYASABKAkSEAoIY2F0ZWdvcnkYAiABKAkiRAoWU2VhcmNoUHJvZHVjdHNSZXNwb25zZRIqCghwcm9kdWN0cxgBIAMoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0IkkKEUNoZWNrU3RvY2tSZXF1ZXN0EhEKCXN0b3JlX2lkcxgBIAMoCRIMCgRza3VzGAIgAygJEhMKC3Bvc3RhbF9jb2RlGAMgASgJIkMKEkNoZWNrU3RvY2tSZXNwb25zZRItCgdyZXN1bHRzGAEgAygLMhwuc3RvY2tjaGVja2VyLnYxLlN0b2NrU3RhdHVzIhcKFUdldEN1cnJlbnRVc2VyUmVxdWVzdCI9ChZHZXRDdXJyZW50VXNlclJlc3BvbnNlEiMKBHVzZXIYASABKAsyFS5zdG9ja2NoZWNrZXIudjEuVXNlciIUChJHZXRNeVN0b3Jlc1JlcXVlc3QiPQoTR2V0TXlTdG9yZXNSZXNwb25zZRImCgZzdG9yZXMYASADKAsyFi5zdG9ja2NoZWNrZXIudjEuU3RvcmUiOgoRQWRkTXlTdG9yZVJlcXVlc3QSJQoFc3RvcmUYASABKAsyFi5zdG9ja2NoZWNrZXIudjEuU3RvcmUiFAoSQWRkTXlTdG9yZVJlc3BvbnNlIigKFFJlbW92ZU15U3RvcmVSZXF1ZXN0EhAKCHN0b3JlX2lkGAEgASgJIhcKFVJlbW92ZU15U3RvcmVSZXNwb25zZSIWChRHZXRNeVByb2R1Y3RzUmVxdWVzdCJDChVHZXRNeVByb2R1Y3RzUmVzcG9uc2USKgoIcHJvZHVjdHMYASADKAsyGC5zdG9ja2NoZWNrZXIudjEuUHJvZHVjdCJAChNBZGRNeVByb2R1Y3RSZXF1ZXN0EikKB3Byb2R1Y3QYASABKAsyGC5zdG9ja2NoZWNrZXIudjEuUHJvZHVjdCIWChRBZGRNeVByb2R1Y3RSZXNwb25zZSIlChZSZW1vdmVNeVByb2R1Y3RSZXF1ZXN0EgsKA3NrdRgBIAEoCSIZChdSZW1vdmVNeVByb2R1Y3RSZXNwb25zZSIeChxCcm93c2VQb2tlbW9uUHJvZHVjdHNSZXF1ZXN0IksKHUJyb3dzZVBva2Vtb25Qcm9kdWN0c1Jlc3BvbnNlEioKCHByb2R1Y3RzGAEgAygLMhguc3RvY2tjaGVja2VyLnYxLlByb2R1Y3QyuwgKE1N0b2NrQ2hlY2tlclNlcnZpY2USWwoMU2VhcmNoU3RvcmVzEiQuc3RvY2tjaGVja2VyLnYxLlNlYXJjaFN0b3Jlc1JlcXVlc3QaJS5zdG9ja2NoZWNrZXIudjEuU2VhcmNoU3RvcmVzUmVzcG9uc2USYQoOU2VhcmNoUHJvZHVjdHMSJi5zdG9ja2NoZWNrZXIudjEuU2VhcmNoUHJvZHVjdHNSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYxLlNlYXJjaFByb2R1Y3RzUmVzcG9uc2USVQoKQ2hlY2tTdG9jaxIiLnN0b2NrY2hlY2tlci52MS5DaGVja1N0b2NrUmVxdWVzdBojLnN0b2NrY2hlY2tlci52MS5DaGVja1N0b2NrUmVzcG9uc2USYQoOR2V0Q3VycmVudFVzZXISJi5zdG9ja2NoZWNrZXIudjEuR2V0Q3VycmVudFVzZXJSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYxLkdldEN1cnJlbnRVc2VyUmVzcG9uc2USWAoLR2V0TXlTdG9yZXMSIy5zdG9ja2NoZWNrZXIudjEuR2V0TXlTdG9yZXNSZXF1ZXN0GiQuc3RvY2tjaGVja2VyLnYxLkdldE15U3RvcmVzUmVzcG9uc2USVQoKQWRkTXlTdG9yZRIiLnN0b2NrY2hlY2tlci52MS5BZGRNeVN0b3JlUmVxdWVzdBojLnN0b2NrY2hlY2tlci52MS5BZGRNeVN0b3JlUmVzcG9uc2USXgoNUmVtb3ZlTXlTdG9yZRIlLnN0b2NrY2hlY2tlci52MS5SZW1vdmVNeVN0b3JlUmVxdWVzdBomLnN0b2NrY2hlY2tlci52MS5SZW1vdmVNeVN0b3JlUmVzcG9uc2USXgoNR2V0TXlQcm9kdWN0cxIlLnN0b2NrY2hlY2tlci52MS5HZXRNeVByb2R1Y3RzUmVxdWVzdBomLnN0b2NrY2hlY2tlci52MS5HZXRNeVByb2R1Y3RzUmVzcG9uc2USWwoMQWRkTXlQcm9kdWN0EiQuc3RvY2tjaGVja2VyLnYxLkFkZE15UHJvZHVjdFJlcXVlc3QaJS5zdG9ja2NoZWNrZXIudjEuQWRkTXlQcm9kdWN0UmVzcG9uc2USZAoPUmVtb3ZlTXlQcm9kdWN0Eicuc3RvY2tjaGVja2VyLnYxLlJlbW92ZU15UHJvZHVjdFJlcXVlc3QaKC5zdG9ja2NoZWNrZXIudjEuUmVtb3ZlTXlQcm9kdWN0UmVzcG9uc2USdgoVQnJvd3NlUG9rZW1vblByb2R1Y3RzEi0uc3RvY2tjaGVja2VyLnYxLkJyb3dzZVBva2Vtb25Qcm9kdWN0c1JlcXVlc3QaLi5zdG9ja2NoZWNrZXIudjEuQnJvd3NlUG9rZW1vblByb2R1Y3RzUmVzcG9uc2VCzgEKE2NvbS5zdG9ja2NoZWNrZXIudjFCDFNlcnZpY2VQcm90b1ABWkxnaXRodWIuY29tL3RtY2F1bGV5L3N0b2NrLWNoZWNrZXIvYmFja2VuZC9nZW4vc3RvY2tjaGVja2VyL3YxO3N0b2NrY2hlY2tlcnYxogIDU1hYqgIPU3RvY2tjaGVja2VyLlYxygIPU3RvY2tjaGVja2VyXFYx4gIbU3RvY2tjaGVja2VyXFYxXEdQQk1ldGFkYXRh6gIQU3RvY2tjaGVja2VyOjpWMWIGcHJvdG8z");
+  fileDesc("Ch1zdG9ja2NoZWNrZXIvdjEvc2VydmljZS5wcm90bxIPc3RvY2tjaGVja2VyLnYxGiBnb29nbGUvcHJvdG9idWYvZmllbGRfbWFzay5wcm90bxofZ29vZ2xlL3Byb3RvYnVmL3RpbWVzdGFtcC5wcm90byLdAgoFU3RvcmUSEAoIc3RvcmVfaWQYASABKAkSDAoEbmFtZRgCIAEoCRIPCgdhZGRyZXNzGAMgASgJEgwKBGNpdHkYBCABKAkSDQoFc3RhdGUYBSABKAkSEwoLcG9zdGFsX2NvZGUYBiABKAkSDQoFcGhvbmUYByABKAkSFgoOZGlzdGFuY2VfbWlsZXMYCCABKAESLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIb3Blbl9ub3cYCyABKAgSEwoLaG91cnNfdG9kYXkYDCABKAkSFQoNc3BlY2lhbF9ob3VycxgNIAEoCBIsCghvcGVuc19hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAixgMKB1Byb2R1Y3QSCwoDc2t1GAEgASgJEgwKBG5hbWUYAiABKAkSFgoKc2FsZV9wcmljZRgDIAEoAUICGAESFQoNdGh1bWJuYWlsX3VybBgEIAEoCRITCgtwcm9kdWN0X3VybBgFIAEoCRIYChBzYWxlX3ByaWNlX2NlbnRzGAYgASgDEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWN1cnJlbmN5X2NvZGUYCSABKAkSEAoIc2V0X25hbWUYCiABKAkSMgoMcHJvZHVjdF90eXBlGAsgASgOMhwuc3RvY2tjaGVja2VyLnYxLlByb2R1Y3RUeXBlEhIKCm1zcnBfY2VudHMYDCABKAMSEgoKYWJvdmVfbXNycBgNIAEoCBIwCghwcmlvcml0eRgOIAEoDjIeLnN0b2NrY2hlY2tlci52MS5XYXRjaFByaW9yaXR5EisKCHJldGFpbGVyGA8gASgOMhkuc3RvY2tjaGVja2VyLnYxLlJldGFpbGVyIuIBCgtTdG9ja1N0YXR1cxIlCgVzdG9yZRgBIAEoCzIWLnN0b2NrY2hlY2tlci52MS5TdG9yZRIpCgdwcm9kdWN0GAIgASgLMhguc3RvY2tjaGVja2VyLnYxLlByb2R1Y3QSEAoIaW5fc3RvY2sYAyABKAgSEQoJbG93X3N0b2NrGAQgASgIEhcKD3BpY2t1cF9lbGlnaWJsZRgFIAEoCBITCgtpc19teV9zdG9yZRgGIAEoCBIuCgpjaGVja2VkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK/AQoEVXNlchIKCgJpZBgBIAEoBRINCgVlbWFpbBgCIAEoCRIMCgRuYW1lGAMgASgJEhMKC3BpY3R1cmVfdXJsGAQgASgJEg4KBmxvY2FsZRgFIAEoCRIQCghpc19hZG1pbhgGIAEoCBIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBInCgRyb2xlGAggASgOMhkuc3RvY2tjaGVja2VyLnYxLlVzZXJSb2xlIlIKE1NlYXJjaFN0b3Jlc1JlcXVlc3QSEwoLcG9zdGFsX2NvZGUYASABKAkSFAoMcmFkaXVzX21pbGVzGAIgASgFEhAKCGxvY2F0aW9uGAMgASgJIj4KFFNlYXJjaFN0b3Jlc1Jlc3BvbnNlEiYKBnN0b3JlcxgBIAMoCzIWLnN0b2NrY2hlY2tlci52MS5TdG9yZSJfChVTZWFyY2hQcm9kdWN0c1JlcXVlc3QSDQoFcXVlcnkYASABKAkSEAoIY2F0ZWdvcnkYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkicQoWU2VhcmNoUHJvZHVjdHNSZXNwb25zZRIqCghwcm9kdWN0cxgBIAMoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgFIlsKEUNoZWNrU3RvY2tSZXF1ZXN0EhEKCXN0b3JlX2lkcxgBIAMoCRIMCgRza3VzGAIgAygJEhMKC3Bvc3RhbF9jb2RlGAMgASgJEhAKCGxvY2F0aW9uGAQgASgJInIKCFNrdUVycm9yEgsKA3NrdRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEisKBGNvZGUYAyABKA4yHS5zdG9ja2NoZWNrZXIudjEuU2t1RXJyb3JDb2RlEhsKE3JldHJ5X2FmdGVyX3NlY29uZHMYBCABKAUiLwoQTWFpbnRlbmFuY2VFcnJvchIbChNyZXRyeV9hZnRlcl9zZWNvbmRzGAEgASgFIm4KEkNoZWNrU3RvY2tSZXNwb25zZRItCgdyZXN1bHRzGAEgAygLMhwuc3RvY2tjaGVja2VyLnYxLlN0b2NrU3RhdHVzEikKBmVycm9ycxgCIAMoCzIZLnN0b2NrY2hlY2tlci52MS5Ta3VFcnJvciIXChVHZXRDdXJyZW50VXNlclJlcXVlc3QiPQoWR2V0Q3VycmVudFVzZXJSZXNwb25zZRIjCgR1c2VyGAEgASgLMhUuc3RvY2tjaGVja2VyLnYxLlVzZXIiJAoSU2V0TXlMb2NhbGVSZXF1ZXN0Eg4KBmxvY2FsZRgBIAEoCSIVChNTZXRNeUxvY2FsZVJlc3BvbnNlIhQKEkdldE15U3RvcmVzUmVxdWVzdCI9ChNHZXRNeVN0b3Jlc1Jlc3BvbnNlEiYKBnN0b3JlcxgBIAMoCzIWLnN0b2NrY2hlY2tlci52MS5TdG9yZSI6ChFBZGRNeVN0b3JlUmVxdWVzdBIlCgVzdG9yZRgBIAEoCzIWLnN0b2NrY2hlY2tlci52MS5TdG9yZSIUChJBZGRNeVN0b3JlUmVzcG9uc2UiKAoUUmVtb3ZlTXlTdG9yZVJlcXVlc3QSEAoIc3RvcmVfaWQYASABKAkiFwoVUmVtb3ZlTXlTdG9yZVJlc3BvbnNlIigKFEdldE15UHJvZHVjdHNSZXF1ZXN0EhAKCHNldF9uYW1lGAEgASgJIkMKFUdldE15UHJvZHVjdHNSZXNwb25zZRIqCghwcm9kdWN0cxgBIAMoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0IkAKE0FkZE15UHJvZHVjdFJlcXVlc3QSKQoHcHJvZHVjdBgBIAEoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0ImAKEVBvc3NpYmxlRHVwbGljYXRlEgsKA3NrdRgBIAEoCRIMCgRuYW1lGAIgASgJEjAKBnJlYXNvbhgDIAEoDjIgLnN0b2NrY2hlY2tlci52MS5EdXBsaWNhdGVSZWFzb24iVwoUQWRkTXlQcm9kdWN0UmVzcG9uc2USPwoTcG9zc2libGVfZHVwbGljYXRlcxgBIAMoCzIiLnN0b2NrY2hlY2tlci52MS5Qb3NzaWJsZUR1cGxpY2F0ZSIlChZSZW1vdmVNeVByb2R1Y3RSZXF1ZXN0EgsKA3NrdRgBIAEoCSIZChdSZW1vdmVNeVByb2R1Y3RSZXNwb25zZSInChdSZW1vdmVNeVByb2R1Y3RzUmVxdWVzdBIMCgRza3VzGAEgAygJIisKGFJlbW92ZU15UHJvZHVjdHNSZXNwb25zZRIPCgdyZW1vdmVkGAEgASgFIkAKFUNsZWFyV2F0Y2hsaXN0UmVxdWVzdBIPCgdjb25maXJtGAEgASgIEhYKDmluY2x1ZGVfc3RvcmVzGAIgASgIIkoKFkNsZWFyV2F0Y2hsaXN0UmVzcG9uc2USGAoQcmVtb3ZlZF9wcm9kdWN0cxgBIAEoBRIWCg5yZW1vdmVkX3N0b3JlcxgCIAEoBSInChdJbXBvcnRNeVByb2R1Y3RzUmVxdWVzdBIMCgR0ZXh0GAEgASgJIlgKGEltcG9ydE15UHJvZHVjdHNSZXNwb25zZRIqCghwcm9kdWN0cxgBIAMoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0EhAKCHJlamVjdGVkGAIgAygJIjEKHEJyb3dzZVBva2Vtb25Qcm9kdWN0c1JlcXVlc3QSEQoJYWxsX3BhZ2VzGAEgASgIIksKHUJyb3dzZVBva2Vtb25Qcm9kdWN0c1Jlc3BvbnNlEioKCHByb2R1Y3RzGAEgAygLMhguc3RvY2tjaGVja2VyLnYxLlByb2R1Y3QivAEKE05vdGlmaWNhdGlvbkNoYW5uZWwSFAoMY2hhbm5lbF90eXBlGAEgASgJEg4KBmNvbmZpZxgCIAEoCRIPCgdlbmFibGVkGAMgASgIEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg4KBnJvbGx1cBgGIAEoCSIgCh5HZXROb3RpZmljYXRpb25DaGFubmVsc1JlcXVlc3QiWQofR2V0Tm90aWZpY2F0aW9uQ2hhbm5lbHNSZXNwb25zZRI2CghjaGFubmVscxgBIAMoCzIkLnN0b2NrY2hlY2tlci52MS5Ob3RpZmljYXRpb25DaGFubmVsIlYKHVNldE5vdGlmaWNhdGlvbkNoYW5uZWxSZXF1ZXN0EjUKB2NoYW5uZWwYASABKAsyJC5zdG9ja2NoZWNrZXIudjEuTm90aWZpY2F0aW9uQ2hhbm5lbCJXCh5TZXROb3RpZmljYXRpb25DaGFubmVsUmVzcG9uc2USNQoHY2hhbm5lbBgBIAEoCzIkLnN0b2NrY2hlY2tlci52MS5Ob3RpZmljYXRpb25DaGFubmVsIjgKIERlbGV0ZU5vdGlmaWNhdGlvbkNoYW5uZWxSZXF1ZXN0EhQKDGNoYW5uZWxfdHlwZRgBIAEoCSIjCiFEZWxldGVOb3RpZmljYXRpb25DaGFubmVsUmVzcG9uc2UibwoUTm90aWZpY2F0aW9uVGVtcGxhdGUSFAoMY2hhbm5lbF90eXBlGAEgASgJEhYKDnRpdGxlX3RlbXBsYXRlGAIgASgJEhUKDWJvZHlfdGVtcGxhdGUYAyABKAkSEgoKaXNfZGVmYXVsdBgEIAEoCCIhCh9HZXROb3RpZmljYXRpb25UZW1wbGF0ZXNSZXF1ZXN0IlwKIEdldE5vdGlmaWNhdGlvblRlbXBsYXRlc1Jlc3BvbnNlEjgKCXRlbXBsYXRlcxgBIAMoCzIlLnN0b2NrY2hlY2tlci52MS5Ob3RpZmljYXRpb25UZW1wbGF0ZSJZCh5TZXROb3RpZmljYXRpb25UZW1wbGF0ZVJlcXVlc3QSNwoIdGVtcGxhdGUYASABKAsyJS5zdG9ja2NoZWNrZXIudjEuTm90aWZpY2F0aW9uVGVtcGxhdGUiIQofU2V0Tm90aWZpY2F0aW9uVGVtcGxhdGVSZXNwb25zZSJNCiFEZWxldGVOb3RpZmljYXRpb25UZW1wbGF0ZVJlcXVlc3QSFAoMY2hhbm5lbF90eXBlGAEgASgJEhIKCmlzX2RlZmF1bHQYAiABKAgiJAoiRGVsZXRlTm90aWZpY2F0aW9uVGVtcGxhdGVSZXNwb25zZSKCAQobU2VuZFRlc3ROb3RpZmljYXRpb25SZXF1ZXN0EhQKDGNoYW5uZWxfdHlwZRgBIAEoCRI3Cgh0ZW1wbGF0ZRgCIAEoCzIlLnN0b2NrY2hlY2tlci52MS5Ob3RpZmljYXRpb25UZW1wbGF0ZRIUCgxwcmV2aWV3X29ubHkYAyABKAgiSQocU2VuZFRlc3ROb3RpZmljYXRpb25SZXNwb25zZRINCgV0aXRsZRgBIAEoCRIMCgRib2R5GAIgASgJEgwKBHNlbnQYAyABKAgiSAobU2ltdWxhdGVXYXRjaGVyQ3ljbGVSZXF1ZXN0EhUKDXVzZV9tb2NrX2RhdGEYASABKAgSEgoKZnJvbV9lbXB0eRgCIAEoCCLCAQoVU2ltdWxhdGVkTm90aWZpY2F0aW9uEiMKBHVzZXIYASABKAsyFS5zdG9ja2NoZWNrZXIudjEuVXNlchIpCgdwcm9kdWN0GAIgASgLMhguc3RvY2tjaGVja2VyLnYxLlByb2R1Y3QSJgoGc3RvcmVzGAMgAygLMhYuc3RvY2tjaGVja2VyLnYxLlN0b3JlEhQKDGNoYW5uZWxfdHlwZRgEIAEoCRINCgV0aXRsZRgFIAEoCRIMCgRib2R5GAYgASgJIl0KHFNpbXVsYXRlV2F0Y2hlckN5Y2xlUmVzcG9uc2USPQoNbm90aWZpY2F0aW9ucxgBIAMoCzImLnN0b2NrY2hlY2tlci52MS5TaW11bGF0ZWROb3RpZmljYXRpb24iJQoVR2V0TXlEYXNoYm9hcmRSZXF1ZXN0EgwKBGRheXMYASABKAUikgEKE0N1cnJlbnRBdmFpbGFiaWxpdHkSCwoDc2t1GAEgASgJEhQKDHByb2R1Y3RfbmFtZRgCIAEoCRIQCghzdG9yZV9pZBgDIAEoCRISCgpzdG9yZV9uYW1lGAQgASgJEhAKCGluX3N0b2NrGAUgASgIEhEKCWxvd19zdG9jaxgGIAEoCBINCgVzaW5jZRgHIAEoCSJZChFEYWlseUF2YWlsYWJpbGl0eRILCgNza3UYASABKAkSEAoIc3RvcmVfaWQYAiABKAkSCwoDZGF5GAMgASgJEhgKEGluX3N0b2NrX21pbnV0ZXMYBCABKAUihwEKFkdldE15RGFzaGJvYXJkUmVzcG9uc2USOgoMYXZhaWxhYmlsaXR5GAEgAygLMiQuc3RvY2tjaGVja2VyLnYxLkN1cnJlbnRBdmFpbGFiaWxpdHkSMQoFZGFpbHkYAiADKAsyIi5zdG9ja2NoZWNrZXIudjEuRGFpbHlBdmFpbGFiaWxpdHkidAoWVXBkYXRlTXlQcm9kdWN0UmVxdWVzdBIpCgdwcm9kdWN0GAEgASgLMhguc3RvY2tjaGVja2VyLnYxLlByb2R1Y3QSLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrIkQKF1VwZGF0ZU15UHJvZHVjdFJlc3BvbnNlEikKB3Byb2R1Y3QYASABKAsyGC5zdG9ja2NoZWNrZXIudjEuUHJvZHVjdCLQAQoXTm90aWZpY2F0aW9uUHJlZmVyZW5jZXMSFgoOYWxlcnRzX2VuYWJsZWQYASABKAgSGQoRaW5jbHVkZV9sb3dfc3RvY2sYAiABKAgSGgoSbWF4X2Rpc3RhbmNlX21pbGVzGAMgASgBEi4KCnVwZGF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhcKD3VyZ2VudF9jaGFubmVscxgFIAMoCRIdChVkaWdlc3RfaW50ZXJ2YWxfaG91cnMYBiABKAUiIwohR2V0Tm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXF1ZXN0ImMKIkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVzcG9uc2USPQoLcHJlZmVyZW5jZXMYASABKAsyKC5zdG9ja2NoZWNrZXIudjEuTm90aWZpY2F0aW9uUHJlZmVyZW5jZXMilgEKJFVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBI9CgtwcmVmZXJlbmNlcxgBIAEoCzIoLnN0b2NrY2hlY2tlci52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlcxIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2siZgolVXBkYXRlTm90aWZpY2F0aW9uUHJlZmVyZW5jZXNSZXNwb25zZRI9CgtwcmVmZXJlbmNlcxgBIAEoCzIoLnN0b2NrY2hlY2tlci52MS5Ob3RpZmljYXRpb25QcmVmZXJlbmNlcyK0AQoJQWxlcnRSdWxlEgsKA3NrdRgBIAEoCRIPCgdlbmFibGVkGAIgASgIEhcKD21heF9wcmljZV9jZW50cxgDIAEoAxISCgptaW5fc3RvcmVzGAQgASgFEi4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhoKEm1heF9kaXN0YW5jZV9taWxlcxgGIAEoARIQCghsb2NhdGlvbhgHIAEoCSIWChRHZXRBbGVydFJ1bGVzUmVxdWVzdCJCChVHZXRBbGVydFJ1bGVzUmVzcG9uc2USKQoFcnVsZXMYASADKAsyGi5zdG9ja2NoZWNrZXIudjEuQWxlcnRSdWxlInMKFlVwZGF0ZUFsZXJ0UnVsZVJlcXVlc3QSKAoEcnVsZRgBIAEoCzIaLnN0b2NrY2hlY2tlci52MS5BbGVydFJ1bGUSLwoLdXBkYXRlX21hc2sYAiABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrIkMKF1VwZGF0ZUFsZXJ0UnVsZVJlc3BvbnNlEigKBHJ1bGUYASABKAsyGi5zdG9ja2NoZWNrZXIudjEuQWxlcnRSdWxlIikKElN5bmNDaGFuZ2VzUmVxdWVzdBITCgtzaW5jZV90b2tlbhgBIAEoCSJ9Cg1TdG9ja1NuYXBzaG90EgsKA3NrdRgBIAEoCRITCgtwb3N0YWxfY29kZRgCIAEoCRIaChJpbl9zdG9ja19zdG9yZV9pZHMYAyADKAkSLgoKY2hlY2tlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi6gIKE1N5bmNDaGFuZ2VzUmVzcG9uc2USJgoGc3RvcmVzGAEgAygLMhYuc3RvY2tjaGVja2VyLnYxLlN0b3JlEhkKEXJlbW92ZWRfc3RvcmVfaWRzGAIgAygJEioKCHByb2R1Y3RzGAMgAygLMhguc3RvY2tjaGVja2VyLnYxLlByb2R1Y3QSFAoMcmVtb3ZlZF9za3VzGAQgAygJEj0KC3ByZWZlcmVuY2VzGAUgASgLMiguc3RvY2tjaGVja2VyLnYxLk5vdGlmaWNhdGlvblByZWZlcmVuY2VzEi8KC2FsZXJ0X3J1bGVzGAYgAygLMhouc3RvY2tjaGVja2VyLnYxLkFsZXJ0UnVsZRI3Cg9zdG9ja19zbmFwc2hvdHMYByADKAsyHi5zdG9ja2NoZWNrZXIudjEuU3RvY2tTbmFwc2hvdBISCgpuZXh0X3Rva2VuGAggASgJEhEKCWZ1bGxfc3luYxgJIAEoCCLAAQoPV2F0Y2hsaXN0Q2hhbmdlEhAKCHJldGFpbGVyGAEgASgJEgsKA3NrdRgCIAEoCRI2CgZhY3Rpb24YAyABKA4yJi5zdG9ja2NoZWNrZXIudjEuV2F0Y2hsaXN0Q2hhbmdlQWN0aW9uEhQKDHByb2R1Y3RfbmFtZRgEIAEoCRIuCgpjaGFuZ2VkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghzdG9yZV9pZBgGIAEoCSJvChtMaXN0V2F0Y2hsaXN0Q2hhbmdlc1JlcXVlc3QSKQoFc2luY2UYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXBhZ2Vfc2l6ZRgCIAEoBRISCgpwYWdlX3Rva2VuGAMgASgJImoKHExpc3RXYXRjaGxpc3RDaGFuZ2VzUmVzcG9uc2USMQoHY2hhbmdlcxgBIAMoCzIgLnN0b2NrY2hlY2tlci52MS5XYXRjaGxpc3RDaGFuZ2USFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIhcKFVVuZG9MYXN0Q2hhbmdlUmVxdWVzdCJKChZVbmRvTGFzdENoYW5nZVJlc3BvbnNlEjAKBnVuZG9uZRgBIAEoCzIgLnN0b2NrY2hlY2tlci52MS5XYXRjaGxpc3RDaGFuZ2UidgoIU2V0V2F0Y2gSEAoIc2V0X25hbWUYASABKAkSLgoKY3JlYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKAoHdGNnX3NldBgDIAEoCzIXLnN0b2NrY2hlY2tlci52MS5UY2dTZXQiugEKBlRjZ1NldBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnNlcmllcxgDIAEoCRIwCgxyZWxlYXNlX2RhdGUYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhoKEnByaW50ZWRfY2FyZF9jb3VudBgFIAEoBRISCgpjYXJkX2NvdW50GAYgASgFEhAKCGxvZ29fdXJsGAcgASgJEhIKCnN5bWJvbF91cmwYCCABKAkiYQoETXNycBIQCghzZXRfbmFtZRgBIAEoCRIyCgxwcm9kdWN0X3R5cGUYAiABKA4yHC5zdG9ja2NoZWNrZXIudjEuUHJvZHVjdFR5cGUSEwoLcHJpY2VfY2VudHMYAyABKAMiEgoQTGlzdE1zcnBzUmVxdWVzdCI5ChFMaXN0TXNycHNSZXNwb25zZRIkCgVtc3JwcxgBIAMoCzIVLnN0b2NrY2hlY2tlci52MS5Nc3JwIjUKDlNldE1zcnBSZXF1ZXN0EiMKBG1zcnAYASABKAsyFS5zdG9ja2NoZWNrZXIudjEuTXNycCIRCg9TZXRNc3JwUmVzcG9uc2UiJwoYR2V0UHJvZHVjdERldGFpbHNSZXF1ZXN0EgsKA3NrdRgBIAEoCSJwChlHZXRQcm9kdWN0RGV0YWlsc1Jlc3BvbnNlEikKB3Byb2R1Y3QYASABKAsyGC5zdG9ja2NoZWNrZXIudjEuUHJvZHVjdBIoCgd0Y2dfc2V0GAIgASgLMhcuc3RvY2tjaGVja2VyLnYxLlRjZ1NldCIYChZHZXRNeVNldFdhdGNoZXNSZXF1ZXN0IkkKF0dldE15U2V0V2F0Y2hlc1Jlc3BvbnNlEi4KC3NldF93YXRjaGVzGAEgAygLMhkuc3RvY2tjaGVja2VyLnYxLlNldFdhdGNoIiMKD1dhdGNoU2V0UmVxdWVzdBIQCghzZXRfbmFtZRgBIAEoCSJyChBXYXRjaFNldFJlc3BvbnNlEiwKCXNldF93YXRjaBgBIAEoCzIZLnN0b2NrY2hlY2tlci52MS5TZXRXYXRjaBIwCg5hZGRlZF9wcm9kdWN0cxgCIAMoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0IiUKEVVud2F0Y2hTZXRSZXF1ZXN0EhAKCHNldF9uYW1lGAEgASgJIhQKElVud2F0Y2hTZXRSZXNwb25zZSK2AQoLQWNxdWlzaXRpb24SCgoCaWQYASABKAUSCwoDc2t1GAIgASgJEhQKDHByb2R1Y3RfbmFtZRgDIAEoCRIQCghzZXRfbmFtZRgEIAEoCRIQCghxdWFudGl0eRgFIAEoBRITCgtwcmljZV9jZW50cxgGIAEoAxIVCg1jdXJyZW5jeV9jb2RlGAcgASgJEhIKCnN0b3JlX25hbWUYCCABKAkSFAoMcHVyY2hhc2VkX29uGAkgASgJIkkKFE1hcmtQdXJjaGFzZWRSZXF1ZXN0EjEKC2FjcXVpc2l0aW9uGAEgASgLMhwuc3RvY2tjaGVja2VyLnYxLkFjcXVpc2l0aW9uIkoKFU1hcmtQdXJjaGFzZWRSZXNwb25zZRIxCgthY3F1aXNpdGlvbhgBIAEoCzIcLnN0b2NrY2hlY2tlci52MS5BY3F1aXNpdGlvbiJeChhHZXRNeUFjcXVpc2l0aW9uc1JlcXVlc3QSDAoEZnJvbRgBIAEoCRINCgV1bnRpbBgCIAEoCRIRCglwYWdlX3NpemUYAyABKAUSEgoKcGFnZV90b2tlbhgEIAEoCSJoChlHZXRNeUFjcXVpc2l0aW9uc1Jlc3BvbnNlEjIKDGFjcXVpc2l0aW9ucxgBIAMoCzIcLnN0b2NrY2hlY2tlci52MS5BY3F1aXNpdGlvbhIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiJgoYRGVsZXRlQWNxdWlzaXRpb25SZXF1ZXN0EgoKAmlkGAEgASgFIhsKGURlbGV0ZUFjcXVpc2l0aW9uUmVzcG9uc2UiVwoKU3BlbmRUb3RhbBILCgNrZXkYASABKAkSFQoNY3VycmVuY3lfY29kZRgCIAEoCRITCgt0b3RhbF9jZW50cxgDIAEoAxIQCghxdWFudGl0eRgEIAEoBSI7ChxHZXRBY3F1aXNpdGlvblN1bW1hcnlSZXF1ZXN0EgwKBGZyb20YASABKAkSDQoFdW50aWwYAiABKAkimgEKEFN0b3JlUmVsaWFiaWxpdHkSEAoIc3RvcmVfaWQYASABKAkSEwoLZm91bmRfY291bnQYAiABKAUSGgoSY29uZmlybWF0aW9uX2NvdW50GAMgASgFEg0KBXNjb3JlGAQgASgBEjQKCmNvbmZpZGVuY2UYBSABKA4yIC5zdG9ja2NoZWNrZXIudjEuU3RvcmVDb25maWRlbmNlIkMKE0NvbmZpcm1TdG9ja1JlcXVlc3QSCwoDc2t1GAEgASgJEhAKCHN0b3JlX2lkGAIgASgJEg0KBWZvdW5kGAMgASgIIk4KFENvbmZpcm1TdG9ja1Jlc3BvbnNlEjYKC3JlbGlhYmlsaXR5GAEgASgLMiEuc3RvY2tjaGVja2VyLnYxLlN0b3JlUmVsaWFiaWxpdHkiLwoaR2V0U3RvcmVSZWxpYWJpbGl0eVJlcXVlc3QSEQoJc3RvcmVfaWRzGAEgAygJIlAKG0dldFN0b3JlUmVsaWFiaWxpdHlSZXNwb25zZRIxCgZzdG9yZXMYASADKAsyIS5zdG9ja2NoZWNrZXIudjEuU3RvcmVSZWxpYWJpbGl0eSLHAgoIU2lnaHRpbmcSCgoCaWQYASABKAUSCwoDc2t1GAIgASgJEhQKDHByb2R1Y3RfbmFtZRgDIAEoCRIQCghzdG9yZV9pZBgEIAEoCRISCgpzdG9yZV9uYW1lGAUgASgJEhAKCHF1YW50aXR5GAYgASgFEhEKCWhhc19waG90bxgHIAEoCBIvCgZzdGF0dXMYCCABKA4yHy5zdG9ja2NoZWNrZXIudjEuU2lnaHRpbmdTdGF0dXMSLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoMbW9kZXJhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg5yZXBvcnRlcl9zY29yZRgLIAEoARIWCg5yZXBvcnRlcl9tdXRlZBgMIAEoCCJrChVSZXBvcnRTaWdodGluZ1JlcXVlc3QSCwoDc2t1GAEgASgJEhAKCHN0b3JlX2lkGAIgASgJEhIKCnN0b3JlX25hbWUYAyABKAkSEAoIcXVhbnRpdHkYBCABKAUSDQoFcGhvdG8YBSABKAwiRQoWUmVwb3J0U2lnaHRpbmdSZXNwb25zZRIrCghzaWdodGluZxgBIAEoCzIZLnN0b2NrY2hlY2tlci52MS5TaWdodGluZyJuChRMaXN0U2lnaHRpbmdzUmVxdWVzdBIvCgZzdGF0dXMYASABKA4yHy5zdG9ja2NoZWNrZXIudjEuU2lnaHRpbmdTdGF0dXMSEQoJcGFnZV9zaXplGAIgASgFEhIKCnBhZ2VfdG9rZW4YAyABKAkiXgoVTGlzdFNpZ2h0aW5nc1Jlc3BvbnNlEiwKCXNpZ2h0aW5ncxgBIAMoCzIZLnN0b2NrY2hlY2tlci52MS5TaWdodGluZxIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiJQoXR2V0U2lnaHRpbmdQaG90b1JlcXVlc3QSCgoCaWQYASABKAUiPwoYR2V0U2lnaHRpbmdQaG90b1Jlc3BvbnNlEg0KBXBob3RvGAEgASgMEhQKDGNvbnRlbnRfdHlwZRgCIAEoCSI2ChdNb2RlcmF0ZVNpZ2h0aW5nUmVxdWVzdBIKCgJpZBgBIAEoBRIPCgdhcHByb3ZlGAIgASgIIlwKGE1vZGVyYXRlU2lnaHRpbmdSZXNwb25zZRIrCghzaWdodGluZxgBIAEoCzIZLnN0b2NrY2hlY2tlci52MS5TaWdodGluZxITCgthbGVydHNfc2VudBgCIAEoBSKkAQodR2V0QWNxdWlzaXRpb25TdW1tYXJ5UmVzcG9uc2USKwoGbW9udGhzGAEgAygLMhsuc3RvY2tjaGVja2VyLnYxLlNwZW5kVG90YWwSKQoEc2V0cxgCIAMoCzIbLnN0b2NrY2hlY2tlci52MS5TcGVuZFRvdGFsEisKBnRvdGFscxgDIAMoCzIbLnN0b2NrY2hlY2tlci52MS5TcGVuZFRvdGFsIioKF0dldE9mZmxpbmVCdW5kbGVSZXF1ZXN0Eg8KB3ZlcnNpb24YASABKAkigwIKGEdldE9mZmxpbmVCdW5kbGVSZXNwb25zZRIUCgxub3RfbW9kaWZpZWQYASABKAgSDwoHdmVyc2lvbhgCIAEoCRIwCgxnZW5lcmF0ZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEiYKBnN0b3JlcxgEIAMoCzIWLnN0b2NrY2hlY2tlci52MS5TdG9yZRIqCghwcm9kdWN0cxgFIAMoCzIYLnN0b2NrY2hlY2tlci52MS5Qcm9kdWN0EjoKDGF2YWlsYWJpbGl0eRgGIAMoCzIkLnN0b2NrY2hlY2tlci52MS5DdXJyZW50QXZhaWxhYmlsaXR5IkUKFkdldFN0b2NrSGlzdG9yeVJlcXVlc3QSCwoDc2t1GAEgASgJEhAKCHN0b3JlX2lkGAIgASgJEgwKBGRheXMYAyABKAUiYQoKU3RvY2tDaGVjaxIQCghpbl9zdG9jaxgBIAEoCBIRCglsb3dfc3RvY2sYAiABKAgSLgoKY2hlY2tlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAifAoXR2V0U3RvY2tIaXN0b3J5UmVzcG9uc2USKwoGY2hlY2tzGAEgAygLMhsuc3RvY2tjaGVja2VyLnYxLlN0b2NrQ2hlY2sSNAoQbGFzdF9pbl9zdG9ja19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAioQEKClN0b2NrRXZlbnQSCgoCaWQYASABKAMSCwoDc2t1GAIgASgJEhAKCHN0b3JlX2lkGAMgASgJEhIKCnN0b3JlX25hbWUYBCABKAkSEAoIaW5fc3RvY2sYBSABKAgSEQoJbG93X3N0b2NrGAYgASgIEi8KC29jY3VycmVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJMChFXYXRjaFN0b2NrUmVxdWVzdBIMCgRza3VzGAEgAygJEhMKC2J1ZmZlcl9zaXplGAIgASgFEhQKDHJlc3VtZV90b2tlbhgDIAEoCSJoChJXYXRjaFN0b2NrUmVzcG9uc2USKwoGZXZlbnRzGAEgAygLMhsuc3RvY2tjaGVja2VyLnYxLlN0b2NrRXZlbnQSDwoHc2tpcHBlZBgCIAEoBRIUCgxyZXN1bWVfdG9rZW4YAyABKAkiKAoUQ2hlY2tTdG9yZU5vd1JlcXVlc3QSEAoIc3RvcmVfaWQYASABKAkisgEKFUNoZWNrU3RvcmVOb3dSZXNwb25zZRIlCgVzdG9yZRgBIAEoCzIWLnN0b2NrY2hlY2tlci52MS5TdG9yZRItCgdyZXN1bHRzGAIgAygLMhwuc3RvY2tjaGVja2VyLnYxLlN0b2NrU3RhdHVzEhMKC2ZhaWxlZF9za3VzGAMgAygJEi4KCmNoZWNrZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wImgKCExvY2F0aW9uEgwKBG5hbWUYASABKAkSEwoLcG9zdGFsX2NvZGUYAiABKAkSFAoMcmFkaXVzX21pbGVzGAMgASgFEhAKCGxhdGl0dWRlGAQgASgBEhEKCWxvbmdpdHVkZRgFIAEoASIXChVHZXRNeUxvY2F0aW9uc1JlcXVlc3QiRgoWR2V0TXlMb2NhdGlvbnNSZXNwb25zZRIsCglsb2NhdGlvbnMYASADKAsyGS5zdG9ja2NoZWNrZXIudjEuTG9jYXRpb24iQwoUU2V0TXlMb2NhdGlvblJlcXVlc3QSKwoIbG9jYXRpb24YASABKAsyGS5zdG9ja2NoZWNrZXIudjEuTG9jYXRpb24iRAoVU2V0TXlMb2NhdGlvblJlc3BvbnNlEisKCGxvY2F0aW9uGAEgASgLMhkuc3RvY2tjaGVja2VyLnYxLkxvY2F0aW9uIicKF0RlbGV0ZU15TG9jYXRpb25SZXF1ZXN0EgwKBG5hbWUYASABKAkiGgoYRGVsZXRlTXlMb2NhdGlvblJlc3BvbnNlIicKGEdldFByb2R1Y3RCYXJjb2RlUmVxdWVzdBILCgNza3UYASABKAkibwoZR2V0UHJvZHVjdEJhcmNvZGVSZXNwb25zZRILCgNza3UYASABKAkSFAoMcHJvZHVjdF9uYW1lGAIgASgJEhEKCXN5bWJvbG9neRgDIAEoCRIPCgdwYXlsb2FkGAQgASgJEgsKA3N2ZxgFIAEoCSJuCgxQcm9kdWN0V2F0Y2gSCgoCaWQYASABKAUSDQoFcXVlcnkYAiABKAkSEwoLY2F0ZWdvcnlfaWQYAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiGQoXR2V0UHJvZHVjdERvbWFpblJlcXVlc3QikwEKGEdldFByb2R1Y3REb21haW5SZXNwb25zZRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhcKD3NlYXJjaF9jYXRlZ29yeRgDIAEoCRITCgtjYXRlZ29yeV9pZBgEIAEoCRIvCgdwcmVzZXRzGAUgAygLMh4uc3RvY2tjaGVja2VyLnYxLlByb2R1Y3RQcmVzZXQiPgoNUHJvZHVjdFByZXNldBILCgNza3UYASABKAkSDAoEbmFtZRgCIAEoCRISCgptc3JwX2NlbnRzGAMgASgDIhwKGkdldE15UHJvZHVjdFdhdGNoZXNSZXF1ZXN0IlUKG0dldE15UHJvZHVjdFdhdGNoZXNSZXNwb25zZRI2Cg9wcm9kdWN0X3dhdGNoZXMYASADKAsyHS5zdG9ja2NoZWNrZXIudjEuUHJvZHVjdFdhdGNoIjoKFFdhdGNoUHJvZHVjdHNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEhMKC2NhdGVnb3J5X2lkGAIgASgJImMKFVdhdGNoUHJvZHVjdHNSZXNwb25zZRI0Cg1wcm9kdWN0X3dhdGNoGAEgASgLMh0uc3RvY2tjaGVja2VyLnYxLlByb2R1Y3RXYXRjaBIUCgxsaXN0ZWRfY291bnQYAiABKAUiJAoWVW53YXRjaFByb2R1Y3RzUmVxdWVzdBIKCgJpZBgBIAEoBSIZChdVbndhdGNoUHJvZHVjdHNSZXNwb25zZSJfCgxBbGxvd2VkRW1haWwSDQoFZW1haWwYASABKAkSEAoIYWRkZWRfYnkYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiLAobQWRtaW5BZGRBbGxvd2VkRW1haWxSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJIh4KHEFkbWluQWRkQWxsb3dlZEVtYWlsUmVzcG9uc2UiLwoeQWRtaW5SZW1vdmVBbGxvd2VkRW1haWxSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJIiEKH0FkbWluUmVtb3ZlQWxsb3dlZEVtYWlsUmVzcG9uc2UiRgodQWRtaW5MaXN0QWxsb3dlZEVtYWlsc1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkicAoeQWRtaW5MaXN0QWxsb3dlZEVtYWlsc1Jlc3BvbnNlEjUKDmFsbG93ZWRfZW1haWxzGAEgAygLMh0uc3RvY2tjaGVja2VyLnYxLkFsbG93ZWRFbWFpbBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiYQoNQWxsb3dlZERvbWFpbhIOCgZkb21haW4YASABKAkSEAoIYWRkZWRfYnkYAiABKAkSLgoKY3JlYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiLgocQWRtaW5BZGRBbGxvd2VkRG9tYWluUmVxdWVzdBIOCgZkb21haW4YASABKAkiHwodQWRtaW5BZGRBbGxvd2VkRG9tYWluUmVzcG9uc2UiMQofQWRtaW5SZW1vdmVBbGxvd2VkRG9tYWluUmVxdWVzdBIOCgZkb21haW4YASABKAkiIgogQWRtaW5SZW1vdmVBbGxvd2VkRG9tYWluUmVzcG9uc2UiRwoeQWRtaW5MaXN0QWxsb3dlZERvbWFpbnNSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJInMKH0FkbWluTGlzdEFsbG93ZWREb21haW5zUmVzcG9uc2USNwoPYWxsb3dlZF9kb21haW5zGAEgAygLMh4uc3RvY2tjaGVja2VyLnYxLkFsbG93ZWREb21haW4SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJItQBCgZJbnZpdGUSCgoCaWQYASABKAUSDAoEbm90ZRgCIAEoCRISCgpjcmVhdGVkX2J5GAMgASgJEi4KCmV4cGlyZXNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKB3VzZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB3VzZWRfYnkYBiABKAkSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiNwoYQWRtaW5DcmVhdGVJbnZpdGVSZXF1ZXN0EgwKBG5vdGUYASABKAkSDQoFaG91cnMYAiABKAUiUQoZQWRtaW5DcmVhdGVJbnZpdGVSZXNwb25zZRInCgZpbnZpdGUYASABKAsyFy5zdG9ja2NoZWNrZXIudjEuSW52aXRlEgsKA3VybBgCIAEoCSJAChdBZG1pbkxpc3RJbnZpdGVzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCSJdChhBZG1pbkxpc3RJbnZpdGVzUmVzcG9uc2USKAoHaW52aXRlcxgBIAMoCzIXLnN0b2NrY2hlY2tlci52MS5JbnZpdGUSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIiYKGEFkbWluUmV2b2tlSW52aXRlUmVxdWVzdBIKCgJpZBgBIAEoBSIbChlBZG1pblJldm9rZUludml0ZVJlc3BvbnNlIj4KFUFkbWluTGlzdFVzZXJzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCSJXChZBZG1pbkxpc3RVc2Vyc1Jlc3BvbnNlEiQKBXVzZXJzGAEgAygLMhUuc3RvY2tjaGVja2VyLnYxLlVzZXISFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIlMKF0FkbWluU2V0VXNlclJvbGVSZXF1ZXN0Eg8KB3VzZXJfaWQYASABKAUSJwoEcm9sZRgCIAEoDjIZLnN0b2NrY2hlY2tlci52MS5Vc2VyUm9sZSI/ChhBZG1pblNldFVzZXJSb2xlUmVzcG9uc2USIwoEdXNlchgBIAEoCzIVLnN0b2NrY2hlY2tlci52MS5Vc2VyIo0BCgpDcmVkZW50aWFsEgwKBG5hbWUYASABKAkSCwoDc2V0GAIgASgIEhIKCm92ZXJyaWRkZW4YAyABKAgSDAoEaGludBgEIAEoCRISCgp1cGRhdGVkX2J5GAUgASgJEi4KCnVwZGF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIh0KG0FkbWluTGlzdENyZWRlbnRpYWxzUmVxdWVzdCJQChxBZG1pbkxpc3RDcmVkZW50aWFsc1Jlc3BvbnNlEjAKC2NyZWRlbnRpYWxzGAEgAygLMhsuc3RvY2tjaGVja2VyLnYxLkNyZWRlbnRpYWwiOAoZQWRtaW5TZXRDcmVkZW50aWFsUmVxdWVzdBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIk0KGkFkbWluU2V0Q3JlZGVudGlhbFJlc3BvbnNlEi8KCmNyZWRlbnRpYWwYASABKAsyGy5zdG9ja2NoZWNrZXIudjEuQ3JlZGVudGlhbCIrChtBZG1pbkNsZWFyQ3JlZGVudGlhbFJlcXVlc3QSDAoEbmFtZRgBIAEoCSJPChxBZG1pbkNsZWFyQ3JlZGVudGlhbFJlc3BvbnNlEi8KCmNyZWRlbnRpYWwYASABKAsyGy5zdG9ja2NoZWNrZXIudjEuQ3JlZGVudGlhbCLKAQoMQXBpQ2FsbFN0YXRzEhAKCGVuZHBvaW50GAEgASgJEhAKCHByaW9yaXR5GAIgASgJEhUKDXNhbXBsZWRfY2FsbHMYAyABKAUSFwoPZXN0aW1hdGVkX2NhbGxzGAQgASgBEhwKFGVzdGltYXRlZF9xdW90YV9jb3N0GAUgASgBEhgKEGVzdGltYXRlZF9lcnJvcnMYBiABKAESFgoOYXZnX2xhdGVuY3lfbXMYByABKAESFgoOcDk1X2xhdGVuY3lfbXMYCCABKAEiLAobQWRtaW5HZXRBcGlDYWxsU3RhdHNSZXF1ZXN0Eg0KBWhvdXJzGAEgASgFIncKHEFkbWluR2V0QXBpQ2FsbFN0YXRzUmVzcG9uc2USLAoFc3RhdHMYASADKAsyHS5zdG9ja2NoZWNrZXIudjEuQXBpQ2FsbFN0YXRzEikKBXNpbmNlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKUAQoGQXBpS2V5EgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSDgoGcHJlZml4GAMgASgJEi4KCmNyZWF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGxhc3RfdXNlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiFQoTR2V0TXlBcGlLZXlzUmVxdWVzdCJBChRHZXRNeUFwaUtleXNSZXNwb25zZRIpCghhcGlfa2V5cxgBIAMoCzIXLnN0b2NrY2hlY2tlci52MS5BcGlLZXkiIwoTQ3JlYXRlQXBpS2V5UmVxdWVzdBIMCgRuYW1lGAEgASgJIk0KFENyZWF0ZUFwaUtleVJlc3BvbnNlEigKB2FwaV9rZXkYASABKAsyFy5zdG9ja2NoZWNrZXIudjEuQXBpS2V5EgsKA2tleRgCIAEoCSIhChNSZXZva2VBcGlLZXlSZXF1ZXN0EgoKAmlkGAEgASgFIhYKFFJldm9rZUFwaUtleVJlc3BvbnNlIuABCgdTZXNzaW9uEgoKAmlkGAEgASgFEhIKCnVzZXJfYWdlbnQYAiABKAkSEgoKaXBfYWRkcmVzcxgDIAEoCRIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxsYXN0X3NlZW5fYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB2N1cnJlbnQYByABKAgiFQoTTGlzdFNlc3Npb25zUmVxdWVzdCJCChRMaXN0U2Vzc2lvbnNSZXNwb25zZRIqCghzZXNzaW9ucxgBIAMoCzIYLnN0b2NrY2hlY2tlci52MS5TZXNzaW9uIi8KFFJldm9rZVNlc3Npb25SZXF1ZXN0EgoKAmlkGAEgASgFEgsKA2FsbBgCIAEoCCIoChVSZXZva2VTZXNzaW9uUmVzcG9uc2USDwoHcmV2b2tlZBgBIAEoBSIVChNFeHBvcnRNeURhdGFSZXF1ZXN0IjYKFEV4cG9ydE15RGF0YVJlc3BvbnNlEgwKBGpzb24YASABKAkSEAoIZmlsZW5hbWUYAiABKAkiKQoWRGVsZXRlTXlBY2NvdW50UmVxdWVzdBIPCgdjb25maXJtGAEgASgIIhkKF0RlbGV0ZU15QWNjb3VudFJlc3BvbnNlIhsKGUdldENsaWVudEJvb3RzdHJhcFJlcXVlc3QiXAoOQ2xpZW50RmVhdHVyZXMSEgoKd2F0Y2hsaXN0cxgBIAEoCBIVCg1zdG9ja193YXRjaGVyGAIgASgIEhAKCHRjZ19zZXRzGAMgASgIEg0KBW1zcnBzGAQgASgIIjkKDFNlcnZlclN0YXR1cxIRCglyZWFkX29ubHkYASABKAgSFgoOcHJvZHVjdF9kb21haW4YAiABKAkiNQoMQ2hhbm5lbFN0YXRlEhQKDGNoYW5uZWxfdHlwZRgBIAEoCRIPCgdlbmFibGVkGAIgASgIImcKD1dhdGNobGlzdENvdW50cxIOCgZzdG9yZXMYASABKAUSEAoIcHJvZHVjdHMYAiABKAUSGQoRaW5fc3RvY2tfcHJvZHVjdHMYAyABKAUSFwoPcHJvZHVjdF93YXRjaGVzGAQgASgFIikKDUxvZ2luUHJvdmlkZXISCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCSLtAgoaR2V0Q2xpZW50Qm9vdHN0cmFwUmVzcG9uc2USIwoEdXNlchgBIAEoCzIVLnN0b2NrY2hlY2tlci52MS5Vc2VyEjEKCGZlYXR1cmVzGAIgASgLMh8uc3RvY2tjaGVja2VyLnYxLkNsaWVudEZlYXR1cmVzEi0KBnN0YXR1cxgDIAEoCzIdLnN0b2NrY2hlY2tlci52MS5TZXJ2ZXJTdGF0dXMSFAoMYW5ub3VuY2VtZW50GAQgASgJEi8KCGNoYW5uZWxzGAUgAygLMh0uc3RvY2tjaGVja2VyLnYxLkNoYW5uZWxTdGF0ZRIzCgl3YXRjaGxpc3QYBiABKAsyIC5zdG9ja2NoZWNrZXIudjEuV2F0Y2hsaXN0Q291bnRzEjcKD2xvZ2luX3Byb3ZpZGVycxgHIAMoCzIeLnN0b2NrY2hlY2tlci52MS5Mb2dpblByb3ZpZGVyEhMKC2VtYWlsX2xvZ2luGAggASgIIqUBCg5BcGlEZXByZWNhdGlvbhIOCgZ0YXJnZXQYASABKAkSEwoLcmVwbGFjZW1lbnQYAiABKAkSMQoNZGVwcmVjYXRlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLQoJc3Vuc2V0X2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRub3RlGAUgASgJIkYKCUFwaUNoYW5nZRIoCgRkYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdjaGFuZ2VzGAIgAygJIhMKEUdldEFwaUluZm9SZXF1ZXN0IosBChJHZXRBcGlJbmZvUmVzcG9uc2USDwoHdmVyc2lvbhgBIAEoCRI1CgxkZXByZWNhdGlvbnMYAiADKAsyHy5zdG9ja2NoZWNrZXIudjEuQXBpRGVwcmVjYXRpb24SLQoJY2hhbmdlbG9nGAMgAygLMhouc3RvY2tjaGVja2VyLnYxLkFwaUNoYW5nZSKBAQoIVXNhZ2VEYXkSJwoDZGF5GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIaChJpbnRlcmFjdGl2ZV9jaGVja3MYAiABKAUSGQoRYmFja2dyb3VuZF9jaGVja3MYAyABKAUSFQoNbm90aWZpY2F0aW9ucxgEIAEoBSJcCgdBcGlMb2FkEhgKEGNhbGxfaW50ZXJ2YWxfbXMYASABKAUSHQoVY2FsbHNfcmVtYWluaW5nX3RvZGF5GAIgASgFEhgKEGRhaWx5X2NhbGxfbGltaXQYAyABKAUiIQoRR2V0TXlVc2FnZVJlcXVlc3QSDAoEZGF5cxgBIAEoBSKQAQoSR2V0TXlVc2FnZVJlc3BvbnNlEicKBGRheXMYASADKAsyGS5zdG9ja2NoZWNrZXIudjEuVXNhZ2VEYXkSKQoGdG90YWxzGAIgASgLMhkuc3RvY2tjaGVja2VyLnYxLlVzYWdlRGF5EiYKBGxvYWQYAyABKAsyGC5zdG9ja2NoZWNrZXIudjEuQXBpTG9hZCKBAgoERHJvcBIKCgJpZBgBIAEoBRILCgNza3UYAiABKAkSFAoMcHJvZHVjdF9uYW1lGAMgASgJEg0KBXRpdGxlGAQgASgJEg8KB2RldGFpbHMYBSABKAkSCwoDdXJsGAYgASgJEiwKCG9wZW5zX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCgljbG9zZXNfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCHdhdGNoaW5nGAkgASgIEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIiwKFFVwY29taW5nRHJvcHNSZXF1ZXN0EhQKDHdhdGNoZWRfb25seRgBIAEoCCI9ChVVcGNvbWluZ0Ryb3BzUmVzcG9uc2USJAoFZHJvcHMYASADKAsyFS5zdG9ja2NoZWNrZXIudjEuRHJvcCI7ChRBZG1pblNhdmVEcm9wUmVxdWVzdBIjCgRkcm9wGAEgASgLMhUuc3RvY2tjaGVja2VyLnYxLkRyb3AiPAoVQWRtaW5TYXZlRHJvcFJlc3BvbnNlEiMKBGRyb3AYASABKAsyFS5zdG9ja2NoZWNrZXIudjEuRHJvcCIkChZBZG1pbkRlbGV0ZURyb3BSZXF1ZXN0EgoKAmlkGAEgASgFIhkKF0FkbWluRGVsZXRlRHJvcFJlc3BvbnNlIhoKGEdldE15SW5ib3VuZEVtYWlsUmVxdWVzdCIsChlHZXRNeUluYm91bmRFbWFpbFJlc3BvbnNlEg8KB2FkZHJlc3MYASABKAkiHAoaUmVzZXRNeUluYm91bmRFbWFpbFJlcXVlc3QiLgobUmVzZXRNeUluYm91bmRFbWFpbFJlc3BvbnNlEg8KB2FkZHJlc3MYASABKAkitgIKCFJlbWluZGVyEgoKAmlkGAEgASgFEgwKBGtpbmQYAiABKAkSCwoDc2t1GAMgASgJEhQKDHByb2R1Y3RfbmFtZRgEIAEoCRIQCghzdG9yZV9pZBgFIAEoCRISCgpzdG9yZV9uYW1lGAYgASgJEg8KB3N1YmplY3QYByABKAkSKgoGZHVlX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBItCglyZW1pbmRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKB3NlbnRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIhgKFkxpc3RNeVJlbWluZGVyc1JlcXVlc3QiRwoXTGlzdE15UmVtaW5kZXJzUmVzcG9uc2USLAoJcmVtaW5kZXJzGAEgAygLMhkuc3RvY2tjaGVja2VyLnYxLlJlbWluZGVyIiUKF0RlbGV0ZU15UmVtaW5kZXJSZXF1ZXN0EgoKAmlkGAEgASgFIhoKGERlbGV0ZU15UmVtaW5kZXJSZXNwb25zZSqSAQoIUmV0YWlsZXISGAoUUkVUQUlMRVJfVU5TUEVDSUZJRUQQABIVChFSRVRBSUxFUl9CRVNUX0JVWRABEhQKEFJFVEFJTEVSX1dBTE1BUlQQAhITCg9SRVRBSUxFUl9UQVJHRVQQAxIVChFSRVRBSUxFUl9HQU1FU1RPUBAEEhMKD1JFVEFJTEVSX0NPU1RDTxAFKm4KDVdhdGNoUHJpb3JpdHkSHgoaV0FUQ0hfUFJJT1JJVFlfVU5TUEVDSUZJRUQQABIcChhXQVRDSF9QUklPUklUWV9NVVNUX0hBVkUQARIfChtXQVRDSF9QUklPUklUWV9OSUNFX1RPX0hBVkUQAir6AQoLUHJvZHVjdFR5cGUSHAoYUFJPRFVDVF9UWVBFX1VOU1BFQ0lGSUVEEAASIgoeUFJPRFVDVF9UWVBFX0VMSVRFX1RSQUlORVJfQk9YEAESHwobUFJPRFVDVF9UWVBFX0JPT1NURVJfQlVORExFEAISHAoYUFJPRFVDVF9UWVBFX0JPT1NURVJfQk9YEAMSHQoZUFJPRFVDVF9UWVBFX0JPT1NURVJfUEFDSxAEEhQKEFBST0RVQ1RfVFlQRV9USU4QBRIbChdQUk9EVUNUX1RZUEVfQ09MTEVDVElPThAGEhgKFFBST0RVQ1RfVFlQRV9CTElTVEVSEAcqTgoIVXNlclJvbGUSGQoVVVNFUl9ST0xFX1VOU1BFQ0lGSUVEEAASEgoOVVNFUl9ST0xFX1VTRVIQARITCg9VU0VSX1JPTEVfQURNSU4QAirrAQoMU2t1RXJyb3JDb2RlEh4KGlNLVV9FUlJPUl9DT0RFX1VOU1BFQ0lGSUVEEAASHAoYU0tVX0VSUk9SX0NPREVfTk9UX0ZPVU5EEAESHQoZU0tVX0VSUk9SX0NPREVfUkVTVFJJQ1RFRBACEh8KG1NLVV9FUlJPUl9DT0RFX1JBVEVfTElNSVRFRBADEiEKHVNLVV9FUlJPUl9DT0RFX1FVT1RBX0VYQ0VFREVEEAQSGgoWU0tVX0VSUk9SX0NPREVfQVBJX0tFWRAFEh4KGlNLVV9FUlJPUl9DT0RFX1VOQVZBSUxBQkxFEAYqmQEKD0R1cGxpY2F0ZVJlYXNvbhIgChxEVVBMSUNBVEVfUkVBU09OX1VOU1BFQ0lGSUVEEAASHQoZRFVQTElDQVRFX1JFQVNPTl9TQU1FX1VQQxABEiYKIkRVUExJQ0FURV9SRUFTT05fU0FNRV9NT0RFTF9OVU1CRVIQAhIdChlEVVBMSUNBVEVfUkVBU09OX1NBTUVfU0VUEAMqrQEKFVdhdGNobGlzdENoYW5nZUFjdGlvbhInCiNXQVRDSExJU1RfQ0hBTkdFX0FDVElPTl9VTlNQRUNJRklFRBAAEiEKHVdBVENITElTVF9DSEFOR0VfQUNUSU9OX0FEREVEEAESIwofV0FUQ0hMSVNUX0NIQU5HRV9BQ1RJT05fVVBEQVRFRBACEiMKH1dBVENITElTVF9DSEFOR0VfQUNUSU9OX1JFTU9WRUQQAyqFAQoPU3RvcmVDb25maWRlbmNlEiAKHFNUT1JFX0NPTkZJREVOQ0VfVU5TUEVDSUZJRUQQABIYChRTVE9SRV9DT05GSURFTkNFX0xPVxABEhsKF1NUT1JFX0NPTkZJREVOQ0VfTUVESVVNEAISGQoVU1RPUkVfQ09ORklERU5DRV9ISUdIEAMqiwEKDlNpZ2h0aW5nU3RhdHVzEh8KG1NJR0hUSU5HX1NUQVRVU19VTlNQRUNJRklFRBAAEhsKF1NJR0hUSU5HX1NUQVRVU19QRU5ESU5HEAESHQoZU0lHSFRJTkdfU1RBVFVTX0NPTkZJUk1FRBACEhwKGFNJR0hUSU5HX1NUQVRVU19SRUpFQ1RFRBADMqBNChNTdG9ja0NoZWNrZXJTZXJ2aWNlEmAKDFNlYXJjaFN0b3JlcxIkLnN0b2NrY2hlY2tlci52MS5TZWFyY2hTdG9yZXNSZXF1ZXN0GiUuc3RvY2tjaGVja2VyLnYxLlNlYXJjaFN0b3Jlc1Jlc3BvbnNlIgOQAgESZgoOU2VhcmNoUHJvZHVjdHMSJi5zdG9ja2NoZWNrZXIudjEuU2VhcmNoUHJvZHVjdHNSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYxLlNlYXJjaFByb2R1Y3RzUmVzcG9uc2UiA5ACARJaCgpDaGVja1N0b2NrEiIuc3RvY2tjaGVja2VyLnYxLkNoZWNrU3RvY2tSZXF1ZXN0GiMuc3RvY2tjaGVja2VyLnYxLkNoZWNrU3RvY2tSZXNwb25zZSIDkAIBEmYKDkdldEN1cnJlbnRVc2VyEiYuc3RvY2tjaGVja2VyLnYxLkdldEN1cnJlbnRVc2VyUmVxdWVzdBonLnN0b2NrY2hlY2tlci52MS5HZXRDdXJyZW50VXNlclJlc3BvbnNlIgOQAgESWAoLU2V0TXlMb2NhbGUSIy5zdG9ja2NoZWNrZXIudjEuU2V0TXlMb2NhbGVSZXF1ZXN0GiQuc3RvY2tjaGVja2VyLnYxLlNldE15TG9jYWxlUmVzcG9uc2USXQoLR2V0TXlTdG9yZXMSIy5zdG9ja2NoZWNrZXIudjEuR2V0TXlTdG9yZXNSZXF1ZXN0GiQuc3RvY2tjaGVja2VyLnYxLkdldE15U3RvcmVzUmVzcG9uc2UiA5ACARJVCgpBZGRNeVN0b3JlEiIuc3RvY2tjaGVja2VyLnYxLkFkZE15U3RvcmVSZXF1ZXN0GiMuc3RvY2tjaGVja2VyLnYxLkFkZE15U3RvcmVSZXNwb25zZRJeCg1SZW1vdmVNeVN0b3JlEiUuc3RvY2tjaGVja2VyLnYxLlJlbW92ZU15U3RvcmVSZXF1ZXN0GiYuc3RvY2tjaGVja2VyLnYxLlJlbW92ZU15U3RvcmVSZXNwb25zZRJjCg1HZXRNeVByb2R1Y3RzEiUuc3RvY2tjaGVja2VyLnYxLkdldE15UHJvZHVjdHNSZXF1ZXN0GiYuc3RvY2tjaGVja2VyLnYxLkdldE15UHJvZHVjdHNSZXNwb25zZSIDkAIBElsKDEFkZE15UHJvZHVjdBIkLnN0b2NrY2hlY2tlci52MS5BZGRNeVByb2R1Y3RSZXF1ZXN0GiUuc3RvY2tjaGVja2VyLnYxLkFkZE15UHJvZHVjdFJlc3BvbnNlEmQKD1VwZGF0ZU15UHJvZHVjdBInLnN0b2NrY2hlY2tlci52MS5VcGRhdGVNeVByb2R1Y3RSZXF1ZXN0Giguc3RvY2tjaGVja2VyLnYxLlVwZGF0ZU15UHJvZHVjdFJlc3BvbnNlEmQKD1JlbW92ZU15UHJvZHVjdBInLnN0b2NrY2hlY2tlci52MS5SZW1vdmVNeVByb2R1Y3RSZXF1ZXN0Giguc3RvY2tjaGVja2VyLnYxLlJlbW92ZU15UHJvZHVjdFJlc3BvbnNlEmcKEFJlbW92ZU15UHJvZHVjdHMSKC5zdG9ja2NoZWNrZXIudjEuUmVtb3ZlTXlQcm9kdWN0c1JlcXVlc3QaKS5zdG9ja2NoZWNrZXIudjEuUmVtb3ZlTXlQcm9kdWN0c1Jlc3BvbnNlEmEKDkNsZWFyV2F0Y2hsaXN0EiYuc3RvY2tjaGVja2VyLnYxLkNsZWFyV2F0Y2hsaXN0UmVxdWVzdBonLnN0b2NrY2hlY2tlci52MS5DbGVhcldhdGNobGlzdFJlc3BvbnNlEmcKEEltcG9ydE15UHJvZHVjdHMSKC5zdG9ja2NoZWNrZXIudjEuSW1wb3J0TXlQcm9kdWN0c1JlcXVlc3QaKS5zdG9ja2NoZWNrZXIudjEuSW1wb3J0TXlQcm9kdWN0c1Jlc3BvbnNlEnsKFUJyb3dzZVBva2Vtb25Qcm9kdWN0cxItLnN0b2NrY2hlY2tlci52MS5Ccm93c2VQb2tlbW9uUHJvZHVjdHNSZXF1ZXN0Gi4uc3RvY2tjaGVja2VyLnYxLkJyb3dzZVBva2Vtb25Qcm9kdWN0c1Jlc3BvbnNlIgOQAgESigEKGkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzEjIuc3RvY2tjaGVja2VyLnYxLkdldE5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBozLnN0b2NrY2hlY2tlci52MS5HZXROb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlIgOQAgESjgEKHVVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzEjUuc3RvY2tjaGVja2VyLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblByZWZlcmVuY2VzUmVxdWVzdBo2LnN0b2NrY2hlY2tlci52MS5VcGRhdGVOb3RpZmljYXRpb25QcmVmZXJlbmNlc1Jlc3BvbnNlEmMKDUdldEFsZXJ0UnVsZXMSJS5zdG9ja2NoZWNrZXIudjEuR2V0QWxlcnRSdWxlc1JlcXVlc3QaJi5zdG9ja2NoZWNrZXIudjEuR2V0QWxlcnRSdWxlc1Jlc3BvbnNlIgOQAgESZAoPVXBkYXRlQWxlcnRSdWxlEicuc3RvY2tjaGVja2VyLnYxLlVwZGF0ZUFsZXJ0UnVsZVJlcXVlc3QaKC5zdG9ja2NoZWNrZXIudjEuVXBkYXRlQWxlcnRSdWxlUmVzcG9uc2UShAEKGEdldE5vdGlmaWNhdGlvblRlbXBsYXRlcxIwLnN0b2NrY2hlY2tlci52MS5HZXROb3RpZmljYXRpb25UZW1wbGF0ZXNSZXF1ZXN0GjEuc3RvY2tjaGVja2VyLnYxLkdldE5vdGlmaWNhdGlvblRlbXBsYXRlc1Jlc3BvbnNlIgOQAgESfAoXU2V0Tm90aWZpY2F0aW9uVGVtcGxhdGUSLy5zdG9ja2NoZWNrZXIudjEuU2V0Tm90aWZpY2F0aW9uVGVtcGxhdGVSZXF1ZXN0GjAuc3RvY2tjaGVja2VyLnYxLlNldE5vdGlmaWNhdGlvblRlbXBsYXRlUmVzcG9uc2UShQEKGkRlbGV0ZU5vdGlmaWNhdGlvblRlbXBsYXRlEjIuc3RvY2tjaGVja2VyLnYxLkRlbGV0ZU5vdGlmaWNhdGlvblRlbXBsYXRlUmVxdWVzdBozLnN0b2NrY2hlY2tlci52MS5EZWxldGVOb3RpZmljYXRpb25UZW1wbGF0ZVJlc3BvbnNlEoEBChdHZXROb3RpZmljYXRpb25DaGFubmVscxIvLnN0b2NrY2hlY2tlci52MS5HZXROb3RpZmljYXRpb25DaGFubmVsc1JlcXVlc3QaMC5zdG9ja2NoZWNrZXIudjEuR2V0Tm90aWZpY2F0aW9uQ2hhbm5lbHNSZXNwb25zZSIDkAIBEnkKFlNldE5vdGlmaWNhdGlvbkNoYW5uZWwSLi5zdG9ja2NoZWNrZXIudjEuU2V0Tm90aWZpY2F0aW9uQ2hhbm5lbFJlcXVlc3QaLy5zdG9ja2NoZWNrZXIudjEuU2V0Tm90aWZpY2F0aW9uQ2hhbm5lbFJlc3BvbnNlEoIBChlEZWxldGVOb3RpZmljYXRpb25DaGFubmVsEjEuc3RvY2tjaGVja2VyLnYxLkRlbGV0ZU5vdGlmaWNhdGlvbkNoYW5uZWxSZXF1ZXN0GjIuc3RvY2tjaGVja2VyLnYxLkRlbGV0ZU5vdGlmaWNhdGlvbkNoYW5uZWxSZXNwb25zZRJzChRTZW5kVGVzdE5vdGlmaWNhdGlvbhIsLnN0b2NrY2hlY2tlci52MS5TZW5kVGVzdE5vdGlmaWNhdGlvblJlcXVlc3QaLS5zdG9ja2NoZWNrZXIudjEuU2VuZFRlc3ROb3RpZmljYXRpb25SZXNwb25zZRJzChRTaW11bGF0ZVdhdGNoZXJDeWNsZRIsLnN0b2NrY2hlY2tlci52MS5TaW11bGF0ZVdhdGNoZXJDeWNsZVJlcXVlc3QaLS5zdG9ja2NoZWNrZXIudjEuU2ltdWxhdGVXYXRjaGVyQ3ljbGVSZXNwb25zZRJmCg5HZXRNeURhc2hib2FyZBImLnN0b2NrY2hlY2tlci52MS5HZXRNeURhc2hib2FyZFJlcXVlc3QaJy5zdG9ja2NoZWNrZXIudjEuR2V0TXlEYXNoYm9hcmRSZXNwb25zZSIDkAIBEmYKDkdldE15TG9jYXRpb25zEiYuc3RvY2tjaGVja2VyLnYxLkdldE15TG9jYXRpb25zUmVxdWVzdBonLnN0b2NrY2hlY2tlci52MS5HZXRNeUxvY2F0aW9uc1Jlc3BvbnNlIgOQAgESXgoNU2V0TXlMb2NhdGlvbhIlLnN0b2NrY2hlY2tlci52MS5TZXRNeUxvY2F0aW9uUmVxdWVzdBomLnN0b2NrY2hlY2tlci52MS5TZXRNeUxvY2F0aW9uUmVzcG9uc2USZwoQRGVsZXRlTXlMb2NhdGlvbhIoLnN0b2NrY2hlY2tlci52MS5EZWxldGVNeUxvY2F0aW9uUmVxdWVzdBopLnN0b2NrY2hlY2tlci52MS5EZWxldGVNeUxvY2F0aW9uUmVzcG9uc2USbwoRR2V0UHJvZHVjdEJhcmNvZGUSKS5zdG9ja2NoZWNrZXIudjEuR2V0UHJvZHVjdEJhcmNvZGVSZXF1ZXN0Giouc3RvY2tjaGVja2VyLnYxLkdldFByb2R1Y3RCYXJjb2RlUmVzcG9uc2UiA5ACARJjCg1DaGVja1N0b3JlTm93EiUuc3RvY2tjaGVja2VyLnYxLkNoZWNrU3RvcmVOb3dSZXF1ZXN0GiYuc3RvY2tjaGVja2VyLnYxLkNoZWNrU3RvcmVOb3dSZXNwb25zZSIDkAIBEmkKD0dldFN0b2NrSGlzdG9yeRInLnN0b2NrY2hlY2tlci52MS5HZXRTdG9ja0hpc3RvcnlSZXF1ZXN0Giguc3RvY2tjaGVja2VyLnYxLkdldFN0b2NrSGlzdG9yeVJlc3BvbnNlIgOQAgESVwoKV2F0Y2hTdG9jaxIiLnN0b2NrY2hlY2tlci52MS5XYXRjaFN0b2NrUmVxdWVzdBojLnN0b2NrY2hlY2tlci52MS5XYXRjaFN0b2NrUmVzcG9uc2UwARJsChBHZXRPZmZsaW5lQnVuZGxlEiguc3RvY2tjaGVja2VyLnYxLkdldE9mZmxpbmVCdW5kbGVSZXF1ZXN0Gikuc3RvY2tjaGVja2VyLnYxLkdldE9mZmxpbmVCdW5kbGVSZXNwb25zZSIDkAIBElgKC1N5bmNDaGFuZ2VzEiMuc3RvY2tjaGVja2VyLnYxLlN5bmNDaGFuZ2VzUmVxdWVzdBokLnN0b2NrY2hlY2tlci52MS5TeW5jQ2hhbmdlc1Jlc3BvbnNlEngKFExpc3RXYXRjaGxpc3RDaGFuZ2VzEiwuc3RvY2tjaGVja2VyLnYxLkxpc3RXYXRjaGxpc3RDaGFuZ2VzUmVxdWVzdBotLnN0b2NrY2hlY2tlci52MS5MaXN0V2F0Y2hsaXN0Q2hhbmdlc1Jlc3BvbnNlIgOQAgESYQoOVW5kb0xhc3RDaGFuZ2USJi5zdG9ja2NoZWNrZXIudjEuVW5kb0xhc3RDaGFuZ2VSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYxLlVuZG9MYXN0Q2hhbmdlUmVzcG9uc2USbwoRR2V0UHJvZHVjdERldGFpbHMSKS5zdG9ja2NoZWNrZXIudjEuR2V0UHJvZHVjdERldGFpbHNSZXF1ZXN0Giouc3RvY2tjaGVja2VyLnYxLkdldFByb2R1Y3REZXRhaWxzUmVzcG9uc2UiA5ACARJXCglMaXN0TXNycHMSIS5zdG9ja2NoZWNrZXIudjEuTGlzdE1zcnBzUmVxdWVzdBoiLnN0b2NrY2hlY2tlci52MS5MaXN0TXNycHNSZXNwb25zZSIDkAIBEkwKB1NldE1zcnASHy5zdG9ja2NoZWNrZXIudjEuU2V0TXNycFJlcXVlc3QaIC5zdG9ja2NoZWNrZXIudjEuU2V0TXNycFJlc3BvbnNlEmkKD0dldE15U2V0V2F0Y2hlcxInLnN0b2NrY2hlY2tlci52MS5HZXRNeVNldFdhdGNoZXNSZXF1ZXN0Giguc3RvY2tjaGVja2VyLnYxLkdldE15U2V0V2F0Y2hlc1Jlc3BvbnNlIgOQAgESTwoIV2F0Y2hTZXQSIC5zdG9ja2NoZWNrZXIudjEuV2F0Y2hTZXRSZXF1ZXN0GiEuc3RvY2tjaGVja2VyLnYxLldhdGNoU2V0UmVzcG9uc2USVQoKVW53YXRjaFNldBIiLnN0b2NrY2hlY2tlci52MS5VbndhdGNoU2V0UmVxdWVzdBojLnN0b2NrY2hlY2tlci52MS5VbndhdGNoU2V0UmVzcG9uc2USXgoNTWFya1B1cmNoYXNlZBIlLnN0b2NrY2hlY2tlci52MS5NYXJrUHVyY2hhc2VkUmVxdWVzdBomLnN0b2NrY2hlY2tlci52MS5NYXJrUHVyY2hhc2VkUmVzcG9uc2USbwoRR2V0TXlBY3F1aXNpdGlvbnMSKS5zdG9ja2NoZWNrZXIudjEuR2V0TXlBY3F1aXNpdGlvbnNSZXF1ZXN0Giouc3RvY2tjaGVja2VyLnYxLkdldE15QWNxdWlzaXRpb25zUmVzcG9uc2UiA5ACARJqChFEZWxldGVBY3F1aXNpdGlvbhIpLnN0b2NrY2hlY2tlci52MS5EZWxldGVBY3F1aXNpdGlvblJlcXVlc3QaKi5zdG9ja2NoZWNrZXIudjEuRGVsZXRlQWNxdWlzaXRpb25SZXNwb25zZRJ7ChVHZXRBY3F1aXNpdGlvblN1bW1hcnkSLS5zdG9ja2NoZWNrZXIudjEuR2V0QWNxdWlzaXRpb25TdW1tYXJ5UmVxdWVzdBouLnN0b2NrY2hlY2tlci52MS5HZXRBY3F1aXNpdGlvblN1bW1hcnlSZXNwb25zZSIDkAIBElsKDENvbmZpcm1TdG9jaxIkLnN0b2NrY2hlY2tlci52MS5Db25maXJtU3RvY2tSZXF1ZXN0GiUuc3RvY2tjaGVja2VyLnYxLkNvbmZpcm1TdG9ja1Jlc3BvbnNlEnUKE0dldFN0b3JlUmVsaWFiaWxpdHkSKy5zdG9ja2NoZWNrZXIudjEuR2V0U3RvcmVSZWxpYWJpbGl0eVJlcXVlc3QaLC5zdG9ja2NoZWNrZXIudjEuR2V0U3RvcmVSZWxpYWJpbGl0eVJlc3BvbnNlIgOQAgESYQoOUmVwb3J0U2lnaHRpbmcSJi5zdG9ja2NoZWNrZXIudjEuUmVwb3J0U2lnaHRpbmdSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYxLlJlcG9ydFNpZ2h0aW5nUmVzcG9uc2USYwoNTGlzdFNpZ2h0aW5ncxIlLnN0b2NrY2hlY2tlci52MS5MaXN0U2lnaHRpbmdzUmVxdWVzdBomLnN0b2NrY2hlY2tlci52MS5MaXN0U2lnaHRpbmdzUmVzcG9uc2UiA5ACARJsChBHZXRTaWdodGluZ1Bob3RvEiguc3RvY2tjaGVja2VyLnYxLkdldFNpZ2h0aW5nUGhvdG9SZXF1ZXN0Gikuc3RvY2tjaGVja2VyLnYxLkdldFNpZ2h0aW5nUGhvdG9SZXNwb25zZSIDkAIBEmcKEE1vZGVyYXRlU2lnaHRpbmcSKC5zdG9ja2NoZWNrZXIudjEuTW9kZXJhdGVTaWdodGluZ1JlcXVlc3QaKS5zdG9ja2NoZWNrZXIudjEuTW9kZXJhdGVTaWdodGluZ1Jlc3BvbnNlEmwKEEdldFByb2R1Y3REb21haW4SKC5zdG9ja2NoZWNrZXIudjEuR2V0UHJvZHVjdERvbWFpblJlcXVlc3QaKS5zdG9ja2NoZWNrZXIudjEuR2V0UHJvZHVjdERvbWFpblJlc3BvbnNlIgOQAgESdQoTR2V0TXlQcm9kdWN0V2F0Y2hlcxIrLnN0b2NrY2hlY2tlci52MS5HZXRNeVByb2R1Y3RXYXRjaGVzUmVxdWVzdBosLnN0b2NrY2hlY2tlci52MS5HZXRNeVByb2R1Y3RXYXRjaGVzUmVzcG9uc2UiA5ACARJeCg1XYXRjaFByb2R1Y3RzEiUuc3RvY2tjaGVja2VyLnYxLldhdGNoUHJvZHVjdHNSZXF1ZXN0GiYuc3RvY2tjaGVja2VyLnYxLldhdGNoUHJvZHVjdHNSZXNwb25zZRJkCg9VbndhdGNoUHJvZHVjdHMSJy5zdG9ja2NoZWNrZXIudjEuVW53YXRjaFByb2R1Y3RzUmVxdWVzdBooLnN0b2NrY2hlY2tlci52MS5VbndhdGNoUHJvZHVjdHNSZXNwb25zZRJzChRBZG1pbkFkZEFsbG93ZWRFbWFpbBIsLnN0b2NrY2hlY2tlci52MS5BZG1pbkFkZEFsbG93ZWRFbWFpbFJlcXVlc3QaLS5zdG9ja2NoZWNrZXIudjEuQWRtaW5BZGRBbGxvd2VkRW1haWxSZXNwb25zZRJ8ChdBZG1pblJlbW92ZUFsbG93ZWRFbWFpbBIvLnN0b2NrY2hlY2tlci52MS5BZG1pblJlbW92ZUFsbG93ZWRFbWFpbFJlcXVlc3QaMC5zdG9ja2NoZWNrZXIudjEuQWRtaW5SZW1vdmVBbGxvd2VkRW1haWxSZXNwb25zZRJ+ChZBZG1pbkxpc3RBbGxvd2VkRW1haWxzEi4uc3RvY2tjaGVja2VyLnYxLkFkbWluTGlzdEFsbG93ZWRFbWFpbHNSZXF1ZXN0Gi8uc3RvY2tjaGVja2VyLnYxLkFkbWluTGlzdEFsbG93ZWRFbWFpbHNSZXNwb25zZSIDkAIBEnYKFUFkbWluQWRkQWxsb3dlZERvbWFpbhItLnN0b2NrY2hlY2tlci52MS5BZG1pbkFkZEFsbG93ZWREb21haW5SZXF1ZXN0Gi4uc3RvY2tjaGVja2VyLnYxLkFkbWluQWRkQWxsb3dlZERvbWFpblJlc3BvbnNlEn8KGEFkbWluUmVtb3ZlQWxsb3dlZERvbWFpbhIwLnN0b2NrY2hlY2tlci52MS5BZG1pblJlbW92ZUFsbG93ZWREb21haW5SZXF1ZXN0GjEuc3RvY2tjaGVja2VyLnYxLkFkbWluUmVtb3ZlQWxsb3dlZERvbWFpblJlc3BvbnNlEoEBChdBZG1pbkxpc3RBbGxvd2VkRG9tYWlucxIvLnN0b2NrY2hlY2tlci52MS5BZG1pbkxpc3RBbGxvd2VkRG9tYWluc1JlcXVlc3QaMC5zdG9ja2NoZWNrZXIudjEuQWRtaW5MaXN0QWxsb3dlZERvbWFpbnNSZXNwb25zZSIDkAIBEmoKEUFkbWluQ3JlYXRlSW52aXRlEikuc3RvY2tjaGVja2VyLnYxLkFkbWluQ3JlYXRlSW52aXRlUmVxdWVzdBoqLnN0b2NrY2hlY2tlci52MS5BZG1pbkNyZWF0ZUludml0ZVJlc3BvbnNlEmwKEEFkbWluTGlzdEludml0ZXMSKC5zdG9ja2NoZWNrZXIudjEuQWRtaW5MaXN0SW52aXRlc1JlcXVlc3QaKS5zdG9ja2NoZWNrZXIudjEuQWRtaW5MaXN0SW52aXRlc1Jlc3BvbnNlIgOQAgESagoRQWRtaW5SZXZva2VJbnZpdGUSKS5zdG9ja2NoZWNrZXIudjEuQWRtaW5SZXZva2VJbnZpdGVSZXF1ZXN0Giouc3RvY2tjaGVja2VyLnYxLkFkbWluUmV2b2tlSW52aXRlUmVzcG9uc2USZgoOQWRtaW5MaXN0VXNlcnMSJi5zdG9ja2NoZWNrZXIudjEuQWRtaW5MaXN0VXNlcnNSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYxLkFkbWluTGlzdFVzZXJzUmVzcG9uc2UiA5ACARJnChBBZG1pblNldFVzZXJSb2xlEiguc3RvY2tjaGVja2VyLnYxLkFkbWluU2V0VXNlclJvbGVSZXF1ZXN0Gikuc3RvY2tjaGVja2VyLnYxLkFkbWluU2V0VXNlclJvbGVSZXNwb25zZRJ4ChRBZG1pbkxpc3RDcmVkZW50aWFscxIsLnN0b2NrY2hlY2tlci52MS5BZG1pbkxpc3RDcmVkZW50aWFsc1JlcXVlc3QaLS5zdG9ja2NoZWNrZXIudjEuQWRtaW5MaXN0Q3JlZGVudGlhbHNSZXNwb25zZSIDkAIBEm0KEkFkbWluU2V0Q3JlZGVudGlhbBIqLnN0b2NrY2hlY2tlci52MS5BZG1pblNldENyZWRlbnRpYWxSZXF1ZXN0Gisuc3RvY2tjaGVja2VyLnYxLkFkbWluU2V0Q3JlZGVudGlhbFJlc3BvbnNlEnMKFEFkbWluQ2xlYXJDcmVkZW50aWFsEiwuc3RvY2tjaGVja2VyLnYxLkFkbWluQ2xlYXJDcmVkZW50aWFsUmVxdWVzdBotLnN0b2NrY2hlY2tlci52MS5BZG1pbkNsZWFyQ3JlZGVudGlhbFJlc3BvbnNlEngKFEFkbWluR2V0QXBpQ2FsbFN0YXRzEiwuc3RvY2tjaGVja2VyLnYxLkFkbWluR2V0QXBpQ2FsbFN0YXRzUmVxdWVzdBotLnN0b2NrY2hlY2tlci52MS5BZG1pbkdldEFwaUNhbGxTdGF0c1Jlc3BvbnNlIgOQAgESYAoMR2V0TXlBcGlLZXlzEiQuc3RvY2tjaGVja2VyLnYxLkdldE15QXBpS2V5c1JlcXVlc3QaJS5zdG9ja2NoZWNrZXIudjEuR2V0TXlBcGlLZXlzUmVzcG9uc2UiA5ACARJbCgxDcmVhdGVBcGlLZXkSJC5zdG9ja2NoZWNrZXIudjEuQ3JlYXRlQXBpS2V5UmVxdWVzdBolLnN0b2NrY2hlY2tlci52MS5DcmVhdGVBcGlLZXlSZXNwb25zZRJbCgxSZXZva2VBcGlLZXkSJC5zdG9ja2NoZWNrZXIudjEuUmV2b2tlQXBpS2V5UmVxdWVzdBolLnN0b2NrY2hlY2tlci52MS5SZXZva2VBcGlLZXlSZXNwb25zZRJgCgxMaXN0U2Vzc2lvbnMSJC5zdG9ja2NoZWNrZXIudjEuTGlzdFNlc3Npb25zUmVxdWVzdBolLnN0b2NrY2hlY2tlci52MS5MaXN0U2Vzc2lvbnNSZXNwb25zZSIDkAIBEl4KDVJldm9rZVNlc3Npb24SJS5zdG9ja2NoZWNrZXIudjEuUmV2b2tlU2Vzc2lvblJlcXVlc3QaJi5zdG9ja2NoZWNrZXIudjEuUmV2b2tlU2Vzc2lvblJlc3BvbnNlEmAKDEV4cG9ydE15RGF0YRIkLnN0b2NrY2hlY2tlci52MS5FeHBvcnRNeURhdGFSZXF1ZXN0GiUuc3RvY2tjaGVja2VyLnYxLkV4cG9ydE15RGF0YVJlc3BvbnNlIgOQAgESZAoPRGVsZXRlTXlBY2NvdW50Eicuc3RvY2tjaGVja2VyLnYxLkRlbGV0ZU15QWNjb3VudFJlcXVlc3QaKC5zdG9ja2NoZWNrZXIudjEuRGVsZXRlTXlBY2NvdW50UmVzcG9uc2UScgoSR2V0Q2xpZW50Qm9vdHN0cmFwEiouc3RvY2tjaGVja2VyLnYxLkdldENsaWVudEJvb3RzdHJhcFJlcXVlc3QaKy5zdG9ja2NoZWNrZXIudjEuR2V0Q2xpZW50Qm9vdHN0cmFwUmVzcG9uc2UiA5ACARJaCgpHZXRBcGlJbmZvEiIuc3RvY2tjaGVja2VyLnYxLkdldEFwaUluZm9SZXF1ZXN0GiMuc3RvY2tjaGVja2VyLnYxLkdldEFwaUluZm9SZXNwb25zZSIDkAIBEloKCkdldE15VXNhZ2USIi5zdG9ja2NoZWNrZXIudjEuR2V0TXlVc2FnZVJlcXVlc3QaIy5zdG9ja2NoZWNrZXIudjEuR2V0TXlVc2FnZVJlc3BvbnNlIgOQAgESYwoNVXBjb21pbmdEcm9wcxIlLnN0b2NrY2hlY2tlci52MS5VcGNvbWluZ0Ryb3BzUmVxdWVzdBomLnN0b2NrY2hlY2tlci52MS5VcGNvbWluZ0Ryb3BzUmVzcG9uc2UiA5ACARJeCg1BZG1pblNhdmVEcm9wEiUuc3RvY2tjaGVja2VyLnYxLkFkbWluU2F2ZURyb3BSZXF1ZXN0GiYuc3RvY2tjaGVja2VyLnYxLkFkbWluU2F2ZURyb3BSZXNwb25zZRJkCg9BZG1pbkRlbGV0ZURyb3ASJy5zdG9ja2NoZWNrZXIudjEuQWRtaW5EZWxldGVEcm9wUmVxdWVzdBooLnN0b2NrY2hlY2tlci52MS5BZG1pbkRlbGV0ZURyb3BSZXNwb25zZRJqChFHZXRNeUluYm91bmRFbWFpbBIpLnN0b2NrY2hlY2tlci52MS5HZXRNeUluYm91bmRFbWFpbFJlcXVlc3QaKi5zdG9ja2NoZWNrZXIudjEuR2V0TXlJbmJvdW5kRW1haWxSZXNwb25zZRJwChNSZXNldE15SW5ib3VuZEVtYWlsEisuc3RvY2tjaGVja2VyLnYxLlJlc2V0TXlJbmJvdW5kRW1haWxSZXF1ZXN0Giwuc3RvY2tjaGVja2VyLnYxLlJlc2V0TXlJbmJvdW5kRW1haWxSZXNwb25zZRJpCg9MaXN0TXlSZW1pbmRlcnMSJy5zdG9ja2NoZWNrZXIudjEuTGlzdE15UmVtaW5kZXJzUmVxdWVzdBooLnN0b2NrY2hlY2tlci52MS5MaXN0TXlSZW1pbmRlcnNSZXNwb25zZSIDkAIBEmcKEERlbGV0ZU15UmVtaW5kZXISKC5zdG9ja2NoZWNrZXIudjEuRGVsZXRlTXlSZW1pbmRlclJlcXVlc3QaKS5zdG9ja2NoZWNrZXIudjEuRGVsZXRlTXlSZW1pbmRlclJlc3BvbnNlQs4BChNjb20uc3RvY2tjaGVja2VyLnYxQgxTZXJ2aWNlUHJvdG9QAVpMZ2l0aHViLmNvbS90bWNhdWxleS9zdG9jay1jaGVja2VyL2JhY2tlbmQvZ2VuL3N0b2NrY2hlY2tlci92MTtzdG9ja2NoZWNrZXJ2MaICA1NYWKoCD1N0b2NrY2hlY2tlci5WMcoCD1N0b2NrY2hlY2tlclxWMeICG1N0b2NrY2hlY2tlclxWMVxHUEJNZXRhZGF0YeoCEFN0b2NrY2hlY2tlcjo6VjFiBnByb3RvMw", [file_google_protobuf_field_mask, file_google_protobuf_timestamp]);
 
 /**
  * Describes the message stockchecker.v1.Store.
@@ -73,124 +74,1680 @@ export const SearchProductsResponseSchema = /*@__PURE__*/
 export const CheckStockRequestSchema = /*@__PURE__*/
   messageDesc(file_stockchecker_v1_service, 8);
 
+/**
+ * Describes the message stockchecker.v1.SkuError.
+ * Use `create(SkuErrorSchema)` to create a new message.
+ */
+export const SkuErrorSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 9);
+
+/**
+ * Describes the message stockchecker.v1.MaintenanceError.
+ * Use `create(MaintenanceErrorSchema)` to create a new message.
+ */
+export const MaintenanceErrorSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 10);
+
 /**
  * Describes the message stockchecker.v1.CheckStockResponse.
  * Use `create(CheckStockResponseSchema)` to create a new message.
  */
 export const CheckStockResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 9);
+  messageDesc(file_stockchecker_v1_service, 11);
 
 /**
  * Describes the message stockchecker.v1.GetCurrentUserRequest.
  * Use `create(GetCurrentUserRequestSchema)` to create a new message.
  */
 export const GetCurrentUserRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 10);
+  messageDesc(file_stockchecker_v1_service, 12);
 
 /**
  * Describes the message stockchecker.v1.GetCurrentUserResponse.
  * Use `create(GetCurrentUserResponseSchema)` to create a new message.
  */
 export const GetCurrentUserResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 11);
+  messageDesc(file_stockchecker_v1_service, 13);
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocaleRequest.
+ * Use `create(SetMyLocaleRequestSchema)` to create a new message.
+ */
+export const SetMyLocaleRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 14);
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocaleResponse.
+ * Use `create(SetMyLocaleResponseSchema)` to create a new message.
+ */
+export const SetMyLocaleResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 15);
 
 /**
  * Describes the message stockchecker.v1.GetMyStoresRequest.
  * Use `create(GetMyStoresRequestSchema)` to create a new message.
  */
 export const GetMyStoresRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 12);
+  messageDesc(file_stockchecker_v1_service, 16);
 
 /**
  * Describes the message stockchecker.v1.GetMyStoresResponse.
  * Use `create(GetMyStoresResponseSchema)` to create a new message.
  */
 export const GetMyStoresResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 13);
+  messageDesc(file_stockchecker_v1_service, 17);
 
 /**
  * Describes the message stockchecker.v1.AddMyStoreRequest.
  * Use `create(AddMyStoreRequestSchema)` to create a new message.
  */
 export const AddMyStoreRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 14);
+  messageDesc(file_stockchecker_v1_service, 18);
 
 /**
  * Describes the message stockchecker.v1.AddMyStoreResponse.
  * Use `create(AddMyStoreResponseSchema)` to create a new message.
  */
 export const AddMyStoreResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 15);
+  messageDesc(file_stockchecker_v1_service, 19);
 
 /**
  * Describes the message stockchecker.v1.RemoveMyStoreRequest.
  * Use `create(RemoveMyStoreRequestSchema)` to create a new message.
  */
 export const RemoveMyStoreRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 16);
+  messageDesc(file_stockchecker_v1_service, 20);
 
 /**
  * Describes the message stockchecker.v1.RemoveMyStoreResponse.
  * Use `create(RemoveMyStoreResponseSchema)` to create a new message.
  */
 export const RemoveMyStoreResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 17);
+  messageDesc(file_stockchecker_v1_service, 21);
 
 /**
  * Describes the message stockchecker.v1.GetMyProductsRequest.
  * Use `create(GetMyProductsRequestSchema)` to create a new message.
  */
 export const GetMyProductsRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 18);
+  messageDesc(file_stockchecker_v1_service, 22);
 
 /**
  * Describes the message stockchecker.v1.GetMyProductsResponse.
  * Use `create(GetMyProductsResponseSchema)` to create a new message.
  */
 export const GetMyProductsResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 19);
+  messageDesc(file_stockchecker_v1_service, 23);
 
 /**
  * Describes the message stockchecker.v1.AddMyProductRequest.
  * Use `create(AddMyProductRequestSchema)` to create a new message.
  */
 export const AddMyProductRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 20);
+  messageDesc(file_stockchecker_v1_service, 24);
+
+/**
+ * Describes the message stockchecker.v1.PossibleDuplicate.
+ * Use `create(PossibleDuplicateSchema)` to create a new message.
+ */
+export const PossibleDuplicateSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 25);
 
 /**
  * Describes the message stockchecker.v1.AddMyProductResponse.
  * Use `create(AddMyProductResponseSchema)` to create a new message.
  */
 export const AddMyProductResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 21);
+  messageDesc(file_stockchecker_v1_service, 26);
 
 /**
  * Describes the message stockchecker.v1.RemoveMyProductRequest.
  * Use `create(RemoveMyProductRequestSchema)` to create a new message.
  */
 export const RemoveMyProductRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 22);
+  messageDesc(file_stockchecker_v1_service, 27);
 
 /**
  * Describes the message stockchecker.v1.RemoveMyProductResponse.
  * Use `create(RemoveMyProductResponseSchema)` to create a new message.
  */
 export const RemoveMyProductResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 23);
+  messageDesc(file_stockchecker_v1_service, 28);
+
+/**
+ * Describes the message stockchecker.v1.RemoveMyProductsRequest.
+ * Use `create(RemoveMyProductsRequestSchema)` to create a new message.
+ */
+export const RemoveMyProductsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 29);
+
+/**
+ * Describes the message stockchecker.v1.RemoveMyProductsResponse.
+ * Use `create(RemoveMyProductsResponseSchema)` to create a new message.
+ */
+export const RemoveMyProductsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 30);
+
+/**
+ * Describes the message stockchecker.v1.ClearWatchlistRequest.
+ * Use `create(ClearWatchlistRequestSchema)` to create a new message.
+ */
+export const ClearWatchlistRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 31);
+
+/**
+ * Describes the message stockchecker.v1.ClearWatchlistResponse.
+ * Use `create(ClearWatchlistResponseSchema)` to create a new message.
+ */
+export const ClearWatchlistResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 32);
+
+/**
+ * Describes the message stockchecker.v1.ImportMyProductsRequest.
+ * Use `create(ImportMyProductsRequestSchema)` to create a new message.
+ */
+export const ImportMyProductsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 33);
+
+/**
+ * Describes the message stockchecker.v1.ImportMyProductsResponse.
+ * Use `create(ImportMyProductsResponseSchema)` to create a new message.
+ */
+export const ImportMyProductsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 34);
 
 /**
  * Describes the message stockchecker.v1.BrowsePokemonProductsRequest.
  * Use `create(BrowsePokemonProductsRequestSchema)` to create a new message.
  */
 export const BrowsePokemonProductsRequestSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 24);
+  messageDesc(file_stockchecker_v1_service, 35);
 
 /**
  * Describes the message stockchecker.v1.BrowsePokemonProductsResponse.
  * Use `create(BrowsePokemonProductsResponseSchema)` to create a new message.
  */
 export const BrowsePokemonProductsResponseSchema = /*@__PURE__*/
-  messageDesc(file_stockchecker_v1_service, 25);
+  messageDesc(file_stockchecker_v1_service, 36);
+
+/**
+ * Describes the message stockchecker.v1.NotificationChannel.
+ * Use `create(NotificationChannelSchema)` to create a new message.
+ */
+export const NotificationChannelSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 37);
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationChannelsRequest.
+ * Use `create(GetNotificationChannelsRequestSchema)` to create a new message.
+ */
+export const GetNotificationChannelsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 38);
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationChannelsResponse.
+ * Use `create(GetNotificationChannelsResponseSchema)` to create a new message.
+ */
+export const GetNotificationChannelsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 39);
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationChannelRequest.
+ * Use `create(SetNotificationChannelRequestSchema)` to create a new message.
+ */
+export const SetNotificationChannelRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 40);
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationChannelResponse.
+ * Use `create(SetNotificationChannelResponseSchema)` to create a new message.
+ */
+export const SetNotificationChannelResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 41);
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationChannelRequest.
+ * Use `create(DeleteNotificationChannelRequestSchema)` to create a new message.
+ */
+export const DeleteNotificationChannelRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 42);
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationChannelResponse.
+ * Use `create(DeleteNotificationChannelResponseSchema)` to create a new message.
+ */
+export const DeleteNotificationChannelResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 43);
+
+/**
+ * Describes the message stockchecker.v1.NotificationTemplate.
+ * Use `create(NotificationTemplateSchema)` to create a new message.
+ */
+export const NotificationTemplateSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 44);
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationTemplatesRequest.
+ * Use `create(GetNotificationTemplatesRequestSchema)` to create a new message.
+ */
+export const GetNotificationTemplatesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 45);
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationTemplatesResponse.
+ * Use `create(GetNotificationTemplatesResponseSchema)` to create a new message.
+ */
+export const GetNotificationTemplatesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 46);
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationTemplateRequest.
+ * Use `create(SetNotificationTemplateRequestSchema)` to create a new message.
+ */
+export const SetNotificationTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 47);
+
+/**
+ * Describes the message stockchecker.v1.SetNotificationTemplateResponse.
+ * Use `create(SetNotificationTemplateResponseSchema)` to create a new message.
+ */
+export const SetNotificationTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 48);
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationTemplateRequest.
+ * Use `create(DeleteNotificationTemplateRequestSchema)` to create a new message.
+ */
+export const DeleteNotificationTemplateRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 49);
+
+/**
+ * Describes the message stockchecker.v1.DeleteNotificationTemplateResponse.
+ * Use `create(DeleteNotificationTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteNotificationTemplateResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 50);
+
+/**
+ * Describes the message stockchecker.v1.SendTestNotificationRequest.
+ * Use `create(SendTestNotificationRequestSchema)` to create a new message.
+ */
+export const SendTestNotificationRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 51);
+
+/**
+ * Describes the message stockchecker.v1.SendTestNotificationResponse.
+ * Use `create(SendTestNotificationResponseSchema)` to create a new message.
+ */
+export const SendTestNotificationResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 52);
+
+/**
+ * Describes the message stockchecker.v1.SimulateWatcherCycleRequest.
+ * Use `create(SimulateWatcherCycleRequestSchema)` to create a new message.
+ */
+export const SimulateWatcherCycleRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 53);
+
+/**
+ * Describes the message stockchecker.v1.SimulatedNotification.
+ * Use `create(SimulatedNotificationSchema)` to create a new message.
+ */
+export const SimulatedNotificationSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 54);
+
+/**
+ * Describes the message stockchecker.v1.SimulateWatcherCycleResponse.
+ * Use `create(SimulateWatcherCycleResponseSchema)` to create a new message.
+ */
+export const SimulateWatcherCycleResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 55);
+
+/**
+ * Describes the message stockchecker.v1.GetMyDashboardRequest.
+ * Use `create(GetMyDashboardRequestSchema)` to create a new message.
+ */
+export const GetMyDashboardRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 56);
+
+/**
+ * Describes the message stockchecker.v1.CurrentAvailability.
+ * Use `create(CurrentAvailabilitySchema)` to create a new message.
+ */
+export const CurrentAvailabilitySchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 57);
+
+/**
+ * Describes the message stockchecker.v1.DailyAvailability.
+ * Use `create(DailyAvailabilitySchema)` to create a new message.
+ */
+export const DailyAvailabilitySchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 58);
+
+/**
+ * Describes the message stockchecker.v1.GetMyDashboardResponse.
+ * Use `create(GetMyDashboardResponseSchema)` to create a new message.
+ */
+export const GetMyDashboardResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 59);
+
+/**
+ * Describes the message stockchecker.v1.UpdateMyProductRequest.
+ * Use `create(UpdateMyProductRequestSchema)` to create a new message.
+ */
+export const UpdateMyProductRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 60);
+
+/**
+ * Describes the message stockchecker.v1.UpdateMyProductResponse.
+ * Use `create(UpdateMyProductResponseSchema)` to create a new message.
+ */
+export const UpdateMyProductResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 61);
+
+/**
+ * Describes the message stockchecker.v1.NotificationPreferences.
+ * Use `create(NotificationPreferencesSchema)` to create a new message.
+ */
+export const NotificationPreferencesSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 62);
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationPreferencesRequest.
+ * Use `create(GetNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export const GetNotificationPreferencesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 63);
+
+/**
+ * Describes the message stockchecker.v1.GetNotificationPreferencesResponse.
+ * Use `create(GetNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export const GetNotificationPreferencesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 64);
+
+/**
+ * Describes the message stockchecker.v1.UpdateNotificationPreferencesRequest.
+ * Use `create(UpdateNotificationPreferencesRequestSchema)` to create a new message.
+ */
+export const UpdateNotificationPreferencesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 65);
+
+/**
+ * Describes the message stockchecker.v1.UpdateNotificationPreferencesResponse.
+ * Use `create(UpdateNotificationPreferencesResponseSchema)` to create a new message.
+ */
+export const UpdateNotificationPreferencesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 66);
+
+/**
+ * Describes the message stockchecker.v1.AlertRule.
+ * Use `create(AlertRuleSchema)` to create a new message.
+ */
+export const AlertRuleSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 67);
+
+/**
+ * Describes the message stockchecker.v1.GetAlertRulesRequest.
+ * Use `create(GetAlertRulesRequestSchema)` to create a new message.
+ */
+export const GetAlertRulesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 68);
+
+/**
+ * Describes the message stockchecker.v1.GetAlertRulesResponse.
+ * Use `create(GetAlertRulesResponseSchema)` to create a new message.
+ */
+export const GetAlertRulesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 69);
+
+/**
+ * Describes the message stockchecker.v1.UpdateAlertRuleRequest.
+ * Use `create(UpdateAlertRuleRequestSchema)` to create a new message.
+ */
+export const UpdateAlertRuleRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 70);
+
+/**
+ * Describes the message stockchecker.v1.UpdateAlertRuleResponse.
+ * Use `create(UpdateAlertRuleResponseSchema)` to create a new message.
+ */
+export const UpdateAlertRuleResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 71);
+
+/**
+ * Describes the message stockchecker.v1.SyncChangesRequest.
+ * Use `create(SyncChangesRequestSchema)` to create a new message.
+ */
+export const SyncChangesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 72);
+
+/**
+ * Describes the message stockchecker.v1.StockSnapshot.
+ * Use `create(StockSnapshotSchema)` to create a new message.
+ */
+export const StockSnapshotSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 73);
+
+/**
+ * Describes the message stockchecker.v1.SyncChangesResponse.
+ * Use `create(SyncChangesResponseSchema)` to create a new message.
+ */
+export const SyncChangesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 74);
+
+/**
+ * Describes the message stockchecker.v1.WatchlistChange.
+ * Use `create(WatchlistChangeSchema)` to create a new message.
+ */
+export const WatchlistChangeSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 75);
+
+/**
+ * Describes the message stockchecker.v1.ListWatchlistChangesRequest.
+ * Use `create(ListWatchlistChangesRequestSchema)` to create a new message.
+ */
+export const ListWatchlistChangesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 76);
+
+/**
+ * Describes the message stockchecker.v1.ListWatchlistChangesResponse.
+ * Use `create(ListWatchlistChangesResponseSchema)` to create a new message.
+ */
+export const ListWatchlistChangesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 77);
+
+/**
+ * Describes the message stockchecker.v1.UndoLastChangeRequest.
+ * Use `create(UndoLastChangeRequestSchema)` to create a new message.
+ */
+export const UndoLastChangeRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 78);
+
+/**
+ * Describes the message stockchecker.v1.UndoLastChangeResponse.
+ * Use `create(UndoLastChangeResponseSchema)` to create a new message.
+ */
+export const UndoLastChangeResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 79);
+
+/**
+ * Describes the message stockchecker.v1.SetWatch.
+ * Use `create(SetWatchSchema)` to create a new message.
+ */
+export const SetWatchSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 80);
+
+/**
+ * Describes the message stockchecker.v1.TcgSet.
+ * Use `create(TcgSetSchema)` to create a new message.
+ */
+export const TcgSetSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 81);
+
+/**
+ * Describes the message stockchecker.v1.Msrp.
+ * Use `create(MsrpSchema)` to create a new message.
+ */
+export const MsrpSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 82);
+
+/**
+ * Describes the message stockchecker.v1.ListMsrpsRequest.
+ * Use `create(ListMsrpsRequestSchema)` to create a new message.
+ */
+export const ListMsrpsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 83);
+
+/**
+ * Describes the message stockchecker.v1.ListMsrpsResponse.
+ * Use `create(ListMsrpsResponseSchema)` to create a new message.
+ */
+export const ListMsrpsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 84);
+
+/**
+ * Describes the message stockchecker.v1.SetMsrpRequest.
+ * Use `create(SetMsrpRequestSchema)` to create a new message.
+ */
+export const SetMsrpRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 85);
+
+/**
+ * Describes the message stockchecker.v1.SetMsrpResponse.
+ * Use `create(SetMsrpResponseSchema)` to create a new message.
+ */
+export const SetMsrpResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 86);
+
+/**
+ * Describes the message stockchecker.v1.GetProductDetailsRequest.
+ * Use `create(GetProductDetailsRequestSchema)` to create a new message.
+ */
+export const GetProductDetailsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 87);
+
+/**
+ * Describes the message stockchecker.v1.GetProductDetailsResponse.
+ * Use `create(GetProductDetailsResponseSchema)` to create a new message.
+ */
+export const GetProductDetailsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 88);
+
+/**
+ * Describes the message stockchecker.v1.GetMySetWatchesRequest.
+ * Use `create(GetMySetWatchesRequestSchema)` to create a new message.
+ */
+export const GetMySetWatchesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 89);
+
+/**
+ * Describes the message stockchecker.v1.GetMySetWatchesResponse.
+ * Use `create(GetMySetWatchesResponseSchema)` to create a new message.
+ */
+export const GetMySetWatchesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 90);
+
+/**
+ * Describes the message stockchecker.v1.WatchSetRequest.
+ * Use `create(WatchSetRequestSchema)` to create a new message.
+ */
+export const WatchSetRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 91);
+
+/**
+ * Describes the message stockchecker.v1.WatchSetResponse.
+ * Use `create(WatchSetResponseSchema)` to create a new message.
+ */
+export const WatchSetResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 92);
+
+/**
+ * Describes the message stockchecker.v1.UnwatchSetRequest.
+ * Use `create(UnwatchSetRequestSchema)` to create a new message.
+ */
+export const UnwatchSetRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 93);
+
+/**
+ * Describes the message stockchecker.v1.UnwatchSetResponse.
+ * Use `create(UnwatchSetResponseSchema)` to create a new message.
+ */
+export const UnwatchSetResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 94);
+
+/**
+ * Describes the message stockchecker.v1.Acquisition.
+ * Use `create(AcquisitionSchema)` to create a new message.
+ */
+export const AcquisitionSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 95);
+
+/**
+ * Describes the message stockchecker.v1.MarkPurchasedRequest.
+ * Use `create(MarkPurchasedRequestSchema)` to create a new message.
+ */
+export const MarkPurchasedRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 96);
+
+/**
+ * Describes the message stockchecker.v1.MarkPurchasedResponse.
+ * Use `create(MarkPurchasedResponseSchema)` to create a new message.
+ */
+export const MarkPurchasedResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 97);
+
+/**
+ * Describes the message stockchecker.v1.GetMyAcquisitionsRequest.
+ * Use `create(GetMyAcquisitionsRequestSchema)` to create a new message.
+ */
+export const GetMyAcquisitionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 98);
+
+/**
+ * Describes the message stockchecker.v1.GetMyAcquisitionsResponse.
+ * Use `create(GetMyAcquisitionsResponseSchema)` to create a new message.
+ */
+export const GetMyAcquisitionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 99);
+
+/**
+ * Describes the message stockchecker.v1.DeleteAcquisitionRequest.
+ * Use `create(DeleteAcquisitionRequestSchema)` to create a new message.
+ */
+export const DeleteAcquisitionRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 100);
+
+/**
+ * Describes the message stockchecker.v1.DeleteAcquisitionResponse.
+ * Use `create(DeleteAcquisitionResponseSchema)` to create a new message.
+ */
+export const DeleteAcquisitionResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 101);
+
+/**
+ * Describes the message stockchecker.v1.SpendTotal.
+ * Use `create(SpendTotalSchema)` to create a new message.
+ */
+export const SpendTotalSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 102);
+
+/**
+ * Describes the message stockchecker.v1.GetAcquisitionSummaryRequest.
+ * Use `create(GetAcquisitionSummaryRequestSchema)` to create a new message.
+ */
+export const GetAcquisitionSummaryRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 103);
+
+/**
+ * Describes the message stockchecker.v1.StoreReliability.
+ * Use `create(StoreReliabilitySchema)` to create a new message.
+ */
+export const StoreReliabilitySchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 104);
+
+/**
+ * Describes the message stockchecker.v1.ConfirmStockRequest.
+ * Use `create(ConfirmStockRequestSchema)` to create a new message.
+ */
+export const ConfirmStockRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 105);
+
+/**
+ * Describes the message stockchecker.v1.ConfirmStockResponse.
+ * Use `create(ConfirmStockResponseSchema)` to create a new message.
+ */
+export const ConfirmStockResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 106);
+
+/**
+ * Describes the message stockchecker.v1.GetStoreReliabilityRequest.
+ * Use `create(GetStoreReliabilityRequestSchema)` to create a new message.
+ */
+export const GetStoreReliabilityRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 107);
+
+/**
+ * Describes the message stockchecker.v1.GetStoreReliabilityResponse.
+ * Use `create(GetStoreReliabilityResponseSchema)` to create a new message.
+ */
+export const GetStoreReliabilityResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 108);
+
+/**
+ * Describes the message stockchecker.v1.Sighting.
+ * Use `create(SightingSchema)` to create a new message.
+ */
+export const SightingSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 109);
+
+/**
+ * Describes the message stockchecker.v1.ReportSightingRequest.
+ * Use `create(ReportSightingRequestSchema)` to create a new message.
+ */
+export const ReportSightingRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 110);
+
+/**
+ * Describes the message stockchecker.v1.ReportSightingResponse.
+ * Use `create(ReportSightingResponseSchema)` to create a new message.
+ */
+export const ReportSightingResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 111);
+
+/**
+ * Describes the message stockchecker.v1.ListSightingsRequest.
+ * Use `create(ListSightingsRequestSchema)` to create a new message.
+ */
+export const ListSightingsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 112);
+
+/**
+ * Describes the message stockchecker.v1.ListSightingsResponse.
+ * Use `create(ListSightingsResponseSchema)` to create a new message.
+ */
+export const ListSightingsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 113);
+
+/**
+ * Describes the message stockchecker.v1.GetSightingPhotoRequest.
+ * Use `create(GetSightingPhotoRequestSchema)` to create a new message.
+ */
+export const GetSightingPhotoRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 114);
+
+/**
+ * Describes the message stockchecker.v1.GetSightingPhotoResponse.
+ * Use `create(GetSightingPhotoResponseSchema)` to create a new message.
+ */
+export const GetSightingPhotoResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 115);
+
+/**
+ * Describes the message stockchecker.v1.ModerateSightingRequest.
+ * Use `create(ModerateSightingRequestSchema)` to create a new message.
+ */
+export const ModerateSightingRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 116);
+
+/**
+ * Describes the message stockchecker.v1.ModerateSightingResponse.
+ * Use `create(ModerateSightingResponseSchema)` to create a new message.
+ */
+export const ModerateSightingResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 117);
+
+/**
+ * Describes the message stockchecker.v1.GetAcquisitionSummaryResponse.
+ * Use `create(GetAcquisitionSummaryResponseSchema)` to create a new message.
+ */
+export const GetAcquisitionSummaryResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 118);
+
+/**
+ * Describes the message stockchecker.v1.GetOfflineBundleRequest.
+ * Use `create(GetOfflineBundleRequestSchema)` to create a new message.
+ */
+export const GetOfflineBundleRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 119);
+
+/**
+ * Describes the message stockchecker.v1.GetOfflineBundleResponse.
+ * Use `create(GetOfflineBundleResponseSchema)` to create a new message.
+ */
+export const GetOfflineBundleResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 120);
+
+/**
+ * Describes the message stockchecker.v1.GetStockHistoryRequest.
+ * Use `create(GetStockHistoryRequestSchema)` to create a new message.
+ */
+export const GetStockHistoryRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 121);
+
+/**
+ * Describes the message stockchecker.v1.StockCheck.
+ * Use `create(StockCheckSchema)` to create a new message.
+ */
+export const StockCheckSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 122);
+
+/**
+ * Describes the message stockchecker.v1.GetStockHistoryResponse.
+ * Use `create(GetStockHistoryResponseSchema)` to create a new message.
+ */
+export const GetStockHistoryResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 123);
+
+/**
+ * Describes the message stockchecker.v1.StockEvent.
+ * Use `create(StockEventSchema)` to create a new message.
+ */
+export const StockEventSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 124);
+
+/**
+ * Describes the message stockchecker.v1.WatchStockRequest.
+ * Use `create(WatchStockRequestSchema)` to create a new message.
+ */
+export const WatchStockRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 125);
+
+/**
+ * Describes the message stockchecker.v1.WatchStockResponse.
+ * Use `create(WatchStockResponseSchema)` to create a new message.
+ */
+export const WatchStockResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 126);
+
+/**
+ * Describes the message stockchecker.v1.CheckStoreNowRequest.
+ * Use `create(CheckStoreNowRequestSchema)` to create a new message.
+ */
+export const CheckStoreNowRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 127);
+
+/**
+ * Describes the message stockchecker.v1.CheckStoreNowResponse.
+ * Use `create(CheckStoreNowResponseSchema)` to create a new message.
+ */
+export const CheckStoreNowResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 128);
+
+/**
+ * Describes the message stockchecker.v1.Location.
+ * Use `create(LocationSchema)` to create a new message.
+ */
+export const LocationSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 129);
+
+/**
+ * Describes the message stockchecker.v1.GetMyLocationsRequest.
+ * Use `create(GetMyLocationsRequestSchema)` to create a new message.
+ */
+export const GetMyLocationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 130);
+
+/**
+ * Describes the message stockchecker.v1.GetMyLocationsResponse.
+ * Use `create(GetMyLocationsResponseSchema)` to create a new message.
+ */
+export const GetMyLocationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 131);
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocationRequest.
+ * Use `create(SetMyLocationRequestSchema)` to create a new message.
+ */
+export const SetMyLocationRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 132);
+
+/**
+ * Describes the message stockchecker.v1.SetMyLocationResponse.
+ * Use `create(SetMyLocationResponseSchema)` to create a new message.
+ */
+export const SetMyLocationResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 133);
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyLocationRequest.
+ * Use `create(DeleteMyLocationRequestSchema)` to create a new message.
+ */
+export const DeleteMyLocationRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 134);
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyLocationResponse.
+ * Use `create(DeleteMyLocationResponseSchema)` to create a new message.
+ */
+export const DeleteMyLocationResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 135);
+
+/**
+ * Describes the message stockchecker.v1.GetProductBarcodeRequest.
+ * Use `create(GetProductBarcodeRequestSchema)` to create a new message.
+ */
+export const GetProductBarcodeRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 136);
+
+/**
+ * Describes the message stockchecker.v1.GetProductBarcodeResponse.
+ * Use `create(GetProductBarcodeResponseSchema)` to create a new message.
+ */
+export const GetProductBarcodeResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 137);
+
+/**
+ * Describes the message stockchecker.v1.ProductWatch.
+ * Use `create(ProductWatchSchema)` to create a new message.
+ */
+export const ProductWatchSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 138);
+
+/**
+ * Describes the message stockchecker.v1.GetProductDomainRequest.
+ * Use `create(GetProductDomainRequestSchema)` to create a new message.
+ */
+export const GetProductDomainRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 139);
+
+/**
+ * Describes the message stockchecker.v1.GetProductDomainResponse.
+ * Use `create(GetProductDomainResponseSchema)` to create a new message.
+ */
+export const GetProductDomainResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 140);
+
+/**
+ * Describes the message stockchecker.v1.ProductPreset.
+ * Use `create(ProductPresetSchema)` to create a new message.
+ */
+export const ProductPresetSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 141);
+
+/**
+ * Describes the message stockchecker.v1.GetMyProductWatchesRequest.
+ * Use `create(GetMyProductWatchesRequestSchema)` to create a new message.
+ */
+export const GetMyProductWatchesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 142);
+
+/**
+ * Describes the message stockchecker.v1.GetMyProductWatchesResponse.
+ * Use `create(GetMyProductWatchesResponseSchema)` to create a new message.
+ */
+export const GetMyProductWatchesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 143);
+
+/**
+ * Describes the message stockchecker.v1.WatchProductsRequest.
+ * Use `create(WatchProductsRequestSchema)` to create a new message.
+ */
+export const WatchProductsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 144);
+
+/**
+ * Describes the message stockchecker.v1.WatchProductsResponse.
+ * Use `create(WatchProductsResponseSchema)` to create a new message.
+ */
+export const WatchProductsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 145);
+
+/**
+ * Describes the message stockchecker.v1.UnwatchProductsRequest.
+ * Use `create(UnwatchProductsRequestSchema)` to create a new message.
+ */
+export const UnwatchProductsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 146);
+
+/**
+ * Describes the message stockchecker.v1.UnwatchProductsResponse.
+ * Use `create(UnwatchProductsResponseSchema)` to create a new message.
+ */
+export const UnwatchProductsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 147);
+
+/**
+ * Describes the message stockchecker.v1.AllowedEmail.
+ * Use `create(AllowedEmailSchema)` to create a new message.
+ */
+export const AllowedEmailSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 148);
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedEmailRequest.
+ * Use `create(AdminAddAllowedEmailRequestSchema)` to create a new message.
+ */
+export const AdminAddAllowedEmailRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 149);
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedEmailResponse.
+ * Use `create(AdminAddAllowedEmailResponseSchema)` to create a new message.
+ */
+export const AdminAddAllowedEmailResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 150);
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedEmailRequest.
+ * Use `create(AdminRemoveAllowedEmailRequestSchema)` to create a new message.
+ */
+export const AdminRemoveAllowedEmailRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 151);
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedEmailResponse.
+ * Use `create(AdminRemoveAllowedEmailResponseSchema)` to create a new message.
+ */
+export const AdminRemoveAllowedEmailResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 152);
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedEmailsRequest.
+ * Use `create(AdminListAllowedEmailsRequestSchema)` to create a new message.
+ */
+export const AdminListAllowedEmailsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 153);
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedEmailsResponse.
+ * Use `create(AdminListAllowedEmailsResponseSchema)` to create a new message.
+ */
+export const AdminListAllowedEmailsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 154);
+
+/**
+ * Describes the message stockchecker.v1.AllowedDomain.
+ * Use `create(AllowedDomainSchema)` to create a new message.
+ */
+export const AllowedDomainSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 155);
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedDomainRequest.
+ * Use `create(AdminAddAllowedDomainRequestSchema)` to create a new message.
+ */
+export const AdminAddAllowedDomainRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 156);
+
+/**
+ * Describes the message stockchecker.v1.AdminAddAllowedDomainResponse.
+ * Use `create(AdminAddAllowedDomainResponseSchema)` to create a new message.
+ */
+export const AdminAddAllowedDomainResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 157);
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedDomainRequest.
+ * Use `create(AdminRemoveAllowedDomainRequestSchema)` to create a new message.
+ */
+export const AdminRemoveAllowedDomainRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 158);
+
+/**
+ * Describes the message stockchecker.v1.AdminRemoveAllowedDomainResponse.
+ * Use `create(AdminRemoveAllowedDomainResponseSchema)` to create a new message.
+ */
+export const AdminRemoveAllowedDomainResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 159);
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedDomainsRequest.
+ * Use `create(AdminListAllowedDomainsRequestSchema)` to create a new message.
+ */
+export const AdminListAllowedDomainsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 160);
+
+/**
+ * Describes the message stockchecker.v1.AdminListAllowedDomainsResponse.
+ * Use `create(AdminListAllowedDomainsResponseSchema)` to create a new message.
+ */
+export const AdminListAllowedDomainsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 161);
+
+/**
+ * Describes the message stockchecker.v1.Invite.
+ * Use `create(InviteSchema)` to create a new message.
+ */
+export const InviteSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 162);
+
+/**
+ * Describes the message stockchecker.v1.AdminCreateInviteRequest.
+ * Use `create(AdminCreateInviteRequestSchema)` to create a new message.
+ */
+export const AdminCreateInviteRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 163);
+
+/**
+ * Describes the message stockchecker.v1.AdminCreateInviteResponse.
+ * Use `create(AdminCreateInviteResponseSchema)` to create a new message.
+ */
+export const AdminCreateInviteResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 164);
+
+/**
+ * Describes the message stockchecker.v1.AdminListInvitesRequest.
+ * Use `create(AdminListInvitesRequestSchema)` to create a new message.
+ */
+export const AdminListInvitesRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 165);
+
+/**
+ * Describes the message stockchecker.v1.AdminListInvitesResponse.
+ * Use `create(AdminListInvitesResponseSchema)` to create a new message.
+ */
+export const AdminListInvitesResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 166);
+
+/**
+ * Describes the message stockchecker.v1.AdminRevokeInviteRequest.
+ * Use `create(AdminRevokeInviteRequestSchema)` to create a new message.
+ */
+export const AdminRevokeInviteRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 167);
+
+/**
+ * Describes the message stockchecker.v1.AdminRevokeInviteResponse.
+ * Use `create(AdminRevokeInviteResponseSchema)` to create a new message.
+ */
+export const AdminRevokeInviteResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 168);
+
+/**
+ * Describes the message stockchecker.v1.AdminListUsersRequest.
+ * Use `create(AdminListUsersRequestSchema)` to create a new message.
+ */
+export const AdminListUsersRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 169);
+
+/**
+ * Describes the message stockchecker.v1.AdminListUsersResponse.
+ * Use `create(AdminListUsersResponseSchema)` to create a new message.
+ */
+export const AdminListUsersResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 170);
+
+/**
+ * Describes the message stockchecker.v1.AdminSetUserRoleRequest.
+ * Use `create(AdminSetUserRoleRequestSchema)` to create a new message.
+ */
+export const AdminSetUserRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 171);
+
+/**
+ * Describes the message stockchecker.v1.AdminSetUserRoleResponse.
+ * Use `create(AdminSetUserRoleResponseSchema)` to create a new message.
+ */
+export const AdminSetUserRoleResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 172);
+
+/**
+ * Describes the message stockchecker.v1.Credential.
+ * Use `create(CredentialSchema)` to create a new message.
+ */
+export const CredentialSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 173);
+
+/**
+ * Describes the message stockchecker.v1.AdminListCredentialsRequest.
+ * Use `create(AdminListCredentialsRequestSchema)` to create a new message.
+ */
+export const AdminListCredentialsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 174);
+
+/**
+ * Describes the message stockchecker.v1.AdminListCredentialsResponse.
+ * Use `create(AdminListCredentialsResponseSchema)` to create a new message.
+ */
+export const AdminListCredentialsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 175);
+
+/**
+ * Describes the message stockchecker.v1.AdminSetCredentialRequest.
+ * Use `create(AdminSetCredentialRequestSchema)` to create a new message.
+ */
+export const AdminSetCredentialRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 176);
+
+/**
+ * Describes the message stockchecker.v1.AdminSetCredentialResponse.
+ * Use `create(AdminSetCredentialResponseSchema)` to create a new message.
+ */
+export const AdminSetCredentialResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 177);
+
+/**
+ * Describes the message stockchecker.v1.AdminClearCredentialRequest.
+ * Use `create(AdminClearCredentialRequestSchema)` to create a new message.
+ */
+export const AdminClearCredentialRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 178);
+
+/**
+ * Describes the message stockchecker.v1.AdminClearCredentialResponse.
+ * Use `create(AdminClearCredentialResponseSchema)` to create a new message.
+ */
+export const AdminClearCredentialResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 179);
+
+/**
+ * Describes the message stockchecker.v1.ApiCallStats.
+ * Use `create(ApiCallStatsSchema)` to create a new message.
+ */
+export const ApiCallStatsSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 180);
+
+/**
+ * Describes the message stockchecker.v1.AdminGetApiCallStatsRequest.
+ * Use `create(AdminGetApiCallStatsRequestSchema)` to create a new message.
+ */
+export const AdminGetApiCallStatsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 181);
+
+/**
+ * Describes the message stockchecker.v1.AdminGetApiCallStatsResponse.
+ * Use `create(AdminGetApiCallStatsResponseSchema)` to create a new message.
+ */
+export const AdminGetApiCallStatsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 182);
+
+/**
+ * Describes the message stockchecker.v1.ApiKey.
+ * Use `create(ApiKeySchema)` to create a new message.
+ */
+export const ApiKeySchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 183);
+
+/**
+ * Describes the message stockchecker.v1.GetMyApiKeysRequest.
+ * Use `create(GetMyApiKeysRequestSchema)` to create a new message.
+ */
+export const GetMyApiKeysRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 184);
+
+/**
+ * Describes the message stockchecker.v1.GetMyApiKeysResponse.
+ * Use `create(GetMyApiKeysResponseSchema)` to create a new message.
+ */
+export const GetMyApiKeysResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 185);
+
+/**
+ * Describes the message stockchecker.v1.CreateApiKeyRequest.
+ * Use `create(CreateApiKeyRequestSchema)` to create a new message.
+ */
+export const CreateApiKeyRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 186);
+
+/**
+ * Describes the message stockchecker.v1.CreateApiKeyResponse.
+ * Use `create(CreateApiKeyResponseSchema)` to create a new message.
+ */
+export const CreateApiKeyResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 187);
+
+/**
+ * Describes the message stockchecker.v1.RevokeApiKeyRequest.
+ * Use `create(RevokeApiKeyRequestSchema)` to create a new message.
+ */
+export const RevokeApiKeyRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 188);
+
+/**
+ * Describes the message stockchecker.v1.RevokeApiKeyResponse.
+ * Use `create(RevokeApiKeyResponseSchema)` to create a new message.
+ */
+export const RevokeApiKeyResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 189);
+
+/**
+ * Describes the message stockchecker.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 190);
+
+/**
+ * Describes the message stockchecker.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 191);
+
+/**
+ * Describes the message stockchecker.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 192);
+
+/**
+ * Describes the message stockchecker.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 193);
+
+/**
+ * Describes the message stockchecker.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 194);
+
+/**
+ * Describes the message stockchecker.v1.ExportMyDataRequest.
+ * Use `create(ExportMyDataRequestSchema)` to create a new message.
+ */
+export const ExportMyDataRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 195);
+
+/**
+ * Describes the message stockchecker.v1.ExportMyDataResponse.
+ * Use `create(ExportMyDataResponseSchema)` to create a new message.
+ */
+export const ExportMyDataResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 196);
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyAccountRequest.
+ * Use `create(DeleteMyAccountRequestSchema)` to create a new message.
+ */
+export const DeleteMyAccountRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 197);
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyAccountResponse.
+ * Use `create(DeleteMyAccountResponseSchema)` to create a new message.
+ */
+export const DeleteMyAccountResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 198);
+
+/**
+ * Describes the message stockchecker.v1.GetClientBootstrapRequest.
+ * Use `create(GetClientBootstrapRequestSchema)` to create a new message.
+ */
+export const GetClientBootstrapRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 199);
+
+/**
+ * Describes the message stockchecker.v1.ClientFeatures.
+ * Use `create(ClientFeaturesSchema)` to create a new message.
+ */
+export const ClientFeaturesSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 200);
+
+/**
+ * Describes the message stockchecker.v1.ServerStatus.
+ * Use `create(ServerStatusSchema)` to create a new message.
+ */
+export const ServerStatusSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 201);
+
+/**
+ * Describes the message stockchecker.v1.ChannelState.
+ * Use `create(ChannelStateSchema)` to create a new message.
+ */
+export const ChannelStateSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 202);
+
+/**
+ * Describes the message stockchecker.v1.WatchlistCounts.
+ * Use `create(WatchlistCountsSchema)` to create a new message.
+ */
+export const WatchlistCountsSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 203);
+
+/**
+ * Describes the message stockchecker.v1.LoginProvider.
+ * Use `create(LoginProviderSchema)` to create a new message.
+ */
+export const LoginProviderSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 204);
+
+/**
+ * Describes the message stockchecker.v1.GetClientBootstrapResponse.
+ * Use `create(GetClientBootstrapResponseSchema)` to create a new message.
+ */
+export const GetClientBootstrapResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 205);
+
+/**
+ * Describes the message stockchecker.v1.ApiDeprecation.
+ * Use `create(ApiDeprecationSchema)` to create a new message.
+ */
+export const ApiDeprecationSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 206);
+
+/**
+ * Describes the message stockchecker.v1.ApiChange.
+ * Use `create(ApiChangeSchema)` to create a new message.
+ */
+export const ApiChangeSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 207);
+
+/**
+ * Describes the message stockchecker.v1.GetApiInfoRequest.
+ * Use `create(GetApiInfoRequestSchema)` to create a new message.
+ */
+export const GetApiInfoRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 208);
+
+/**
+ * Describes the message stockchecker.v1.GetApiInfoResponse.
+ * Use `create(GetApiInfoResponseSchema)` to create a new message.
+ */
+export const GetApiInfoResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 209);
+
+/**
+ * Describes the message stockchecker.v1.UsageDay.
+ * Use `create(UsageDaySchema)` to create a new message.
+ */
+export const UsageDaySchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 210);
+
+/**
+ * Describes the message stockchecker.v1.ApiLoad.
+ * Use `create(ApiLoadSchema)` to create a new message.
+ */
+export const ApiLoadSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 211);
+
+/**
+ * Describes the message stockchecker.v1.GetMyUsageRequest.
+ * Use `create(GetMyUsageRequestSchema)` to create a new message.
+ */
+export const GetMyUsageRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 212);
+
+/**
+ * Describes the message stockchecker.v1.GetMyUsageResponse.
+ * Use `create(GetMyUsageResponseSchema)` to create a new message.
+ */
+export const GetMyUsageResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 213);
+
+/**
+ * Describes the message stockchecker.v1.Drop.
+ * Use `create(DropSchema)` to create a new message.
+ */
+export const DropSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 214);
+
+/**
+ * Describes the message stockchecker.v1.UpcomingDropsRequest.
+ * Use `create(UpcomingDropsRequestSchema)` to create a new message.
+ */
+export const UpcomingDropsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 215);
+
+/**
+ * Describes the message stockchecker.v1.UpcomingDropsResponse.
+ * Use `create(UpcomingDropsResponseSchema)` to create a new message.
+ */
+export const UpcomingDropsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 216);
+
+/**
+ * Describes the message stockchecker.v1.AdminSaveDropRequest.
+ * Use `create(AdminSaveDropRequestSchema)` to create a new message.
+ */
+export const AdminSaveDropRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 217);
+
+/**
+ * Describes the message stockchecker.v1.AdminSaveDropResponse.
+ * Use `create(AdminSaveDropResponseSchema)` to create a new message.
+ */
+export const AdminSaveDropResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 218);
+
+/**
+ * Describes the message stockchecker.v1.AdminDeleteDropRequest.
+ * Use `create(AdminDeleteDropRequestSchema)` to create a new message.
+ */
+export const AdminDeleteDropRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 219);
+
+/**
+ * Describes the message stockchecker.v1.AdminDeleteDropResponse.
+ * Use `create(AdminDeleteDropResponseSchema)` to create a new message.
+ */
+export const AdminDeleteDropResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 220);
+
+/**
+ * Describes the message stockchecker.v1.GetMyInboundEmailRequest.
+ * Use `create(GetMyInboundEmailRequestSchema)` to create a new message.
+ */
+export const GetMyInboundEmailRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 221);
+
+/**
+ * Describes the message stockchecker.v1.GetMyInboundEmailResponse.
+ * Use `create(GetMyInboundEmailResponseSchema)` to create a new message.
+ */
+export const GetMyInboundEmailResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 222);
+
+/**
+ * Describes the message stockchecker.v1.ResetMyInboundEmailRequest.
+ * Use `create(ResetMyInboundEmailRequestSchema)` to create a new message.
+ */
+export const ResetMyInboundEmailRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 223);
+
+/**
+ * Describes the message stockchecker.v1.ResetMyInboundEmailResponse.
+ * Use `create(ResetMyInboundEmailResponseSchema)` to create a new message.
+ */
+export const ResetMyInboundEmailResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 224);
+
+/**
+ * Describes the message stockchecker.v1.Reminder.
+ * Use `create(ReminderSchema)` to create a new message.
+ */
+export const ReminderSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 225);
+
+/**
+ * Describes the message stockchecker.v1.ListMyRemindersRequest.
+ * Use `create(ListMyRemindersRequestSchema)` to create a new message.
+ */
+export const ListMyRemindersRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 226);
+
+/**
+ * Describes the message stockchecker.v1.ListMyRemindersResponse.
+ * Use `create(ListMyRemindersResponseSchema)` to create a new message.
+ */
+export const ListMyRemindersResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 227);
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyReminderRequest.
+ * Use `create(DeleteMyReminderRequestSchema)` to create a new message.
+ */
+export const DeleteMyReminderRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 228);
+
+/**
+ * Describes the message stockchecker.v1.DeleteMyReminderResponse.
+ * Use `create(DeleteMyReminderResponseSchema)` to create a new message.
+ */
+export const DeleteMyReminderResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v1_service, 229);
+
+/**
+ * Describes the enum stockchecker.v1.Retailer.
+ */
+export const RetailerSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 0);
+
+/**
+ * Retailer sells a product. v1 RPCs look products up at Best Buy, but saved
+ * products and alerts can come from any retailer v2 serves.
+ *
+ * @generated from enum stockchecker.v1.Retailer
+ */
+export const Retailer = /*@__PURE__*/
+  tsEnum(RetailerSchema);
+
+/**
+ * Describes the enum stockchecker.v1.WatchPriority.
+ */
+export const WatchPrioritySchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 1);
+
+/**
+ * WatchPriority routes a saved product's alerts
+ *
+ * @generated from enum stockchecker.v1.WatchPriority
+ */
+export const WatchPriority = /*@__PURE__*/
+  tsEnum(WatchPrioritySchema);
+
+/**
+ * Describes the enum stockchecker.v1.ProductType.
+ */
+export const ProductTypeSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 2);
+
+/**
+ * ProductType is the kind of sealed TCG product, read from the product name
+ *
+ * @generated from enum stockchecker.v1.ProductType
+ */
+export const ProductType = /*@__PURE__*/
+  tsEnum(ProductTypeSchema);
+
+/**
+ * Describes the enum stockchecker.v1.UserRole.
+ */
+export const UserRoleSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 3);
+
+/**
+ * User represents an authenticated user
+ * UserRole is what a user is allowed to do
+ *
+ * @generated from enum stockchecker.v1.UserRole
+ */
+export const UserRole = /*@__PURE__*/
+  tsEnum(UserRoleSchema);
+
+/**
+ * Describes the enum stockchecker.v1.SkuErrorCode.
+ */
+export const SkuErrorCodeSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 4);
+
+/**
+ * SkuErrorCode is why a SKU couldn't be checked
+ *
+ * @generated from enum stockchecker.v1.SkuErrorCode
+ */
+export const SkuErrorCode = /*@__PURE__*/
+  tsEnum(SkuErrorCodeSchema);
+
+/**
+ * Describes the enum stockchecker.v1.DuplicateReason.
+ */
+export const DuplicateReasonSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 5);
+
+/**
+ * DuplicateReason is why a saved product may be the same item as another
+ *
+ * @generated from enum stockchecker.v1.DuplicateReason
+ */
+export const DuplicateReason = /*@__PURE__*/
+  tsEnum(DuplicateReasonSchema);
+
+/**
+ * Describes the enum stockchecker.v1.WatchlistChangeAction.
+ */
+export const WatchlistChangeActionSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 6);
+
+/**
+ * WatchlistChangeAction is what happened to a saved store or product
+ *
+ * @generated from enum stockchecker.v1.WatchlistChangeAction
+ */
+export const WatchlistChangeAction = /*@__PURE__*/
+  tsEnum(WatchlistChangeActionSchema);
+
+/**
+ * Describes the enum stockchecker.v1.StoreConfidence.
+ */
+export const StoreConfidenceSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 7);
+
+/**
+ * StoreConfidence is how often users found stock on the shelf when a store reported it
+ *
+ * @generated from enum stockchecker.v1.StoreConfidence
+ */
+export const StoreConfidence = /*@__PURE__*/
+  tsEnum(StoreConfidenceSchema);
+
+/**
+ * Describes the enum stockchecker.v1.SightingStatus.
+ */
+export const SightingStatusSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v1_service, 8);
+
+/**
+ * SightingStatus is where a sighting is in moderation
+ *
+ * @generated from enum stockchecker.v1.SightingStatus
+ */
+export const SightingStatus = /*@__PURE__*/
+  tsEnum(SightingStatusSchema);
 
 /**
  * StockCheckerService provides stock checking functionality
@@ -0,0 +1,1006 @@
+// @generated by protoc-gen-es v2.10.2
+// @generated from file stockchecker/v2/service.proto (package stockchecker.v2, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file stockchecker/v2/service.proto.
+ */
+export declare const file_stockchecker_v2_service: GenFile;
+
+/**
+ * Money is an amount in a currency, laid out like google.type.Money
+ *
+ * @generated from message stockchecker.v2.Money
+ */
+export declare type Money = Message<"stockchecker.v2.Money"> & {
+  /**
+   * ISO 4217, e.g. "USD"
+   *
+   * @generated from field: string currency_code = 1;
+   */
+  currencyCode: string;
+
+  /**
+   * whole units of the currency
+   *
+   * @generated from field: int64 units = 2;
+   */
+  units: bigint;
+
+  /**
+   * billionths of a unit, same sign as units
+   *
+   * @generated from field: int32 nanos = 3;
+   */
+  nanos: number;
+};
+
+/**
+ * Describes the message stockchecker.v2.Money.
+ * Use `create(MoneySchema)` to create a new message.
+ */
+export declare const MoneySchema: GenMessage<Money>;
+
+/**
+ * Store represents a retailer store location
+ *
+ * @generated from message stockchecker.v2.Store
+ */
+export declare type Store = Message<"stockchecker.v2.Store"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * @generated from field: string store_id = 2;
+   */
+  storeId: string;
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: string address = 4;
+   */
+  address: string;
+
+  /**
+   * @generated from field: string city = 5;
+   */
+  city: string;
+
+  /**
+   * @generated from field: string state = 6;
+   */
+  state: string;
+
+  /**
+   * @generated from field: string postal_code = 7;
+   */
+  postalCode: string;
+
+  /**
+   * @generated from field: string phone = 8;
+   */
+  phone: string;
+
+  /**
+   * @generated from field: double distance_miles = 9;
+   */
+  distanceMiles: number;
+
+  /**
+   * when the store was saved; unset in search results
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * when the saved store was last changed
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 11;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * resource name: users/{user}/stores/{store} when saved, otherwise stores/{store}
+   *
+   * @generated from field: string name = 12;
+   */
+  name: string;
+
+  /**
+   * Opening hours in the store's time zone, holiday hours included. Unset
+   * when the store's hours are unknown.
+   *
+   * @generated from field: bool open_now = 13;
+   */
+  openNow: boolean;
+
+  /**
+   * "10:00-21:00" or "closed"; empty if unknown
+   *
+   * @generated from field: string hours_today = 14;
+   */
+  hoursToday: string;
+
+  /**
+   * today's hours differ from the usual ones, e.g. a holiday
+   *
+   * @generated from field: bool special_hours = 15;
+   */
+  specialHours: boolean;
+
+  /**
+   * when a closed store next opens
+   *
+   * @generated from field: google.protobuf.Timestamp opens_at = 16;
+   */
+  opensAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v2.Store.
+ * Use `create(StoreSchema)` to create a new message.
+ */
+export declare const StoreSchema: GenMessage<Store>;
+
+/**
+ * Product represents a retailer product
+ *
+ * @generated from message stockchecker.v2.Product
+ */
+export declare type Product = Message<"stockchecker.v2.Product"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * @generated from field: string sku = 2;
+   */
+  sku: string;
+
+  /**
+   * @generated from field: string display_name = 3;
+   */
+  displayName: string;
+
+  /**
+   * @generated from field: stockchecker.v2.Money sale_price = 4;
+   */
+  salePrice?: Money;
+
+  /**
+   * @generated from field: string thumbnail_url = 5;
+   */
+  thumbnailUrl: string;
+
+  /**
+   * @generated from field: string product_url = 6;
+   */
+  productUrl: string;
+
+  /**
+   * when the product was saved; unset in search results
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * when the saved product was last changed
+   *
+   * @generated from field: google.protobuf.Timestamp updated_at = 8;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * resource name: users/{user}/products/{product} when saved, otherwise products/{product}
+   *
+   * @generated from field: string name = 9;
+   */
+  name: string;
+
+  /**
+   * availability to order from the retailer's website; unspecified if the retailer doesn't report it
+   *
+   * @generated from field: stockchecker.v2.AvailabilityStatus online_status = 10;
+   */
+  onlineStatus: AvailabilityStatus;
+};
+
+/**
+ * Describes the message stockchecker.v2.Product.
+ * Use `create(ProductSchema)` to create a new message.
+ */
+export declare const ProductSchema: GenMessage<Product>;
+
+/**
+ * StockStatus represents the availability of a product at a store. For
+ * retailers that report online availability (Costco), CheckStock also returns
+ * a status without a store for ordering from the website.
+ *
+ * @generated from message stockchecker.v2.StockStatus
+ */
+export declare type StockStatus = Message<"stockchecker.v2.StockStatus"> & {
+  /**
+   * @generated from field: stockchecker.v2.Store store = 1;
+   */
+  store?: Store;
+
+  /**
+   * @generated from field: stockchecker.v2.Product product = 2;
+   */
+  product?: Product;
+
+  /**
+   * @generated from field: stockchecker.v2.AvailabilityStatus status = 3;
+   */
+  status: AvailabilityStatus;
+
+  /**
+   * @generated from field: bool pickup_eligible = 4;
+   */
+  pickupEligible: boolean;
+
+  /**
+   * True if store is in user's "My Stores" list
+   *
+   * @generated from field: bool is_my_store = 5;
+   */
+  isMyStore: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 6;
+   */
+  checkedAt?: Timestamp;
+};
+
+/**
+ * Describes the message stockchecker.v2.StockStatus.
+ * Use `create(StockStatusSchema)` to create a new message.
+ */
+export declare const StockStatusSchema: GenMessage<StockStatus>;
+
+/**
+ * SearchStoresRequest is the request for searching stores
+ *
+ * @generated from message stockchecker.v2.SearchStoresRequest
+ */
+export declare type SearchStoresRequest = Message<"stockchecker.v2.SearchStoresRequest"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * @generated from field: string postal_code = 2;
+   */
+  postalCode: string;
+
+  /**
+   * defaults to 25 if not specified
+   *
+   * @generated from field: int32 radius_miles = 3;
+   */
+  radiusMiles: number;
+
+  /**
+   * defaults to 50, max 200
+   *
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize: number;
+
+  /**
+   * next_page_token from a previous response
+   *
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.SearchStoresRequest.
+ * Use `create(SearchStoresRequestSchema)` to create a new message.
+ */
+export declare const SearchStoresRequestSchema: GenMessage<SearchStoresRequest>;
+
+/**
+ * SearchStoresResponse is a page of matching stores
+ *
+ * @generated from message stockchecker.v2.SearchStoresResponse
+ */
+export declare type SearchStoresResponse = Message<"stockchecker.v2.SearchStoresResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v2.Store stores = 1;
+   */
+  stores: Store[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.SearchStoresResponse.
+ * Use `create(SearchStoresResponseSchema)` to create a new message.
+ */
+export declare const SearchStoresResponseSchema: GenMessage<SearchStoresResponse>;
+
+/**
+ * SearchProductsRequest is the request for searching products
+ *
+ * @generated from message stockchecker.v2.SearchProductsRequest
+ */
+export declare type SearchProductsRequest = Message<"stockchecker.v2.SearchProductsRequest"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * search term or SKU
+   *
+   * @generated from field: string query = 2;
+   */
+  query: string;
+
+  /**
+   * optional category filter (e.g., "POKEMON CARDS")
+   *
+   * @generated from field: string category = 3;
+   */
+  category: string;
+
+  /**
+   * defaults to 50, max 200
+   *
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize: number;
+
+  /**
+   * next_page_token from a previous response
+   *
+   * @generated from field: string page_token = 5;
+   */
+  pageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.SearchProductsRequest.
+ * Use `create(SearchProductsRequestSchema)` to create a new message.
+ */
+export declare const SearchProductsRequestSchema: GenMessage<SearchProductsRequest>;
+
+/**
+ * SearchProductsResponse is a page of matching products
+ *
+ * @generated from message stockchecker.v2.SearchProductsResponse
+ */
+export declare type SearchProductsResponse = Message<"stockchecker.v2.SearchProductsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v2.Product products = 1;
+   */
+  products: Product[];
+
+  /**
+   * empty on the last page
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.SearchProductsResponse.
+ * Use `create(SearchProductsResponseSchema)` to create a new message.
+ */
+export declare const SearchProductsResponseSchema: GenMessage<SearchProductsResponse>;
+
+/**
+ * CheckStockRequest is the request for checking stock
+ *
+ * @generated from message stockchecker.v2.CheckStockRequest
+ */
+export declare type CheckStockRequest = Message<"stockchecker.v2.CheckStockRequest"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * User's saved store IDs (for highlighting)
+   *
+   * @generated from field: repeated string store_ids = 2;
+   */
+  storeIds: string[];
+
+  /**
+   * @generated from field: repeated string skus = 3;
+   */
+  skus: string[];
+
+  /**
+   * Postal code to search from (250 mile radius)
+   *
+   * @generated from field: string postal_code = 4;
+   */
+  postalCode: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.CheckStockRequest.
+ * Use `create(CheckStockRequestSchema)` to create a new message.
+ */
+export declare const CheckStockRequestSchema: GenMessage<CheckStockRequest>;
+
+/**
+ * CheckStockResponse is the response containing stock status
+ *
+ * @generated from message stockchecker.v2.CheckStockResponse
+ */
+export declare type CheckStockResponse = Message<"stockchecker.v2.CheckStockResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v2.StockStatus results = 1;
+   */
+  results: StockStatus[];
+};
+
+/**
+ * Describes the message stockchecker.v2.CheckStockResponse.
+ * Use `create(CheckStockResponseSchema)` to create a new message.
+ */
+export declare const CheckStockResponseSchema: GenMessage<CheckStockResponse>;
+
+/**
+ * ListMyStoresRequest pages through the user's saved stores
+ *
+ * @generated from message stockchecker.v2.ListMyStoresRequest
+ */
+export declare type ListMyStoresRequest = Message<"stockchecker.v2.ListMyStoresRequest"> & {
+  /**
+   * defaults to 50, max 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * users/{user}; defaults to users/me
+   *
+   * @generated from field: string parent = 3;
+   */
+  parent: string;
+
+  /**
+   * only list this retailer's stores; every retailer if unspecified
+   *
+   * @generated from field: stockchecker.v2.Retailer retailer = 4;
+   */
+  retailer: Retailer;
+};
+
+/**
+ * Describes the message stockchecker.v2.ListMyStoresRequest.
+ * Use `create(ListMyStoresRequestSchema)` to create a new message.
+ */
+export declare const ListMyStoresRequestSchema: GenMessage<ListMyStoresRequest>;
+
+/**
+ * ListMyStoresResponse is a page of the user's saved stores, newest first
+ *
+ * @generated from message stockchecker.v2.ListMyStoresResponse
+ */
+export declare type ListMyStoresResponse = Message<"stockchecker.v2.ListMyStoresResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v2.Store stores = 1;
+   */
+  stores: Store[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.ListMyStoresResponse.
+ * Use `create(ListMyStoresResponseSchema)` to create a new message.
+ */
+export declare const ListMyStoresResponseSchema: GenMessage<ListMyStoresResponse>;
+
+/**
+ * AddMyStoreRequest adds a store to the user's list
+ *
+ * @generated from message stockchecker.v2.AddMyStoreRequest
+ */
+export declare type AddMyStoreRequest = Message<"stockchecker.v2.AddMyStoreRequest"> & {
+  /**
+   * @generated from field: stockchecker.v2.Store store = 1;
+   */
+  store?: Store;
+};
+
+/**
+ * Describes the message stockchecker.v2.AddMyStoreRequest.
+ * Use `create(AddMyStoreRequestSchema)` to create a new message.
+ */
+export declare const AddMyStoreRequestSchema: GenMessage<AddMyStoreRequest>;
+
+/**
+ * AddMyStoreResponse is empty on success
+ *
+ * @generated from message stockchecker.v2.AddMyStoreResponse
+ */
+export declare type AddMyStoreResponse = Message<"stockchecker.v2.AddMyStoreResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v2.AddMyStoreResponse.
+ * Use `create(AddMyStoreResponseSchema)` to create a new message.
+ */
+export declare const AddMyStoreResponseSchema: GenMessage<AddMyStoreResponse>;
+
+/**
+ * RemoveMyStoreRequest removes a store from the user's list
+ *
+ * @generated from message stockchecker.v2.RemoveMyStoreRequest
+ */
+export declare type RemoveMyStoreRequest = Message<"stockchecker.v2.RemoveMyStoreRequest"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * used when name is empty
+   *
+   * @generated from field: string store_id = 2;
+   */
+  storeId: string;
+
+  /**
+   * users/{user}/stores/{store}
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyStoreRequest.
+ * Use `create(RemoveMyStoreRequestSchema)` to create a new message.
+ */
+export declare const RemoveMyStoreRequestSchema: GenMessage<RemoveMyStoreRequest>;
+
+/**
+ * RemoveMyStoreResponse is empty on success
+ *
+ * @generated from message stockchecker.v2.RemoveMyStoreResponse
+ */
+export declare type RemoveMyStoreResponse = Message<"stockchecker.v2.RemoveMyStoreResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyStoreResponse.
+ * Use `create(RemoveMyStoreResponseSchema)` to create a new message.
+ */
+export declare const RemoveMyStoreResponseSchema: GenMessage<RemoveMyStoreResponse>;
+
+/**
+ * ListMyProductsRequest pages through the user's saved products
+ *
+ * @generated from message stockchecker.v2.ListMyProductsRequest
+ */
+export declare type ListMyProductsRequest = Message<"stockchecker.v2.ListMyProductsRequest"> & {
+  /**
+   * defaults to 50, max 200
+   *
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * users/{user}; defaults to users/me
+   *
+   * @generated from field: string parent = 3;
+   */
+  parent: string;
+
+  /**
+   * only list this retailer's products; every retailer if unspecified
+   *
+   * @generated from field: stockchecker.v2.Retailer retailer = 4;
+   */
+  retailer: Retailer;
+};
+
+/**
+ * Describes the message stockchecker.v2.ListMyProductsRequest.
+ * Use `create(ListMyProductsRequestSchema)` to create a new message.
+ */
+export declare const ListMyProductsRequestSchema: GenMessage<ListMyProductsRequest>;
+
+/**
+ * ListMyProductsResponse is a page of the user's saved products, newest first
+ *
+ * @generated from message stockchecker.v2.ListMyProductsResponse
+ */
+export declare type ListMyProductsResponse = Message<"stockchecker.v2.ListMyProductsResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v2.Product products = 1;
+   */
+  products: Product[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.ListMyProductsResponse.
+ * Use `create(ListMyProductsResponseSchema)` to create a new message.
+ */
+export declare const ListMyProductsResponseSchema: GenMessage<ListMyProductsResponse>;
+
+/**
+ * AddMyProductRequest adds a product to the user's list
+ *
+ * @generated from message stockchecker.v2.AddMyProductRequest
+ */
+export declare type AddMyProductRequest = Message<"stockchecker.v2.AddMyProductRequest"> & {
+  /**
+   * @generated from field: stockchecker.v2.Product product = 1;
+   */
+  product?: Product;
+};
+
+/**
+ * Describes the message stockchecker.v2.AddMyProductRequest.
+ * Use `create(AddMyProductRequestSchema)` to create a new message.
+ */
+export declare const AddMyProductRequestSchema: GenMessage<AddMyProductRequest>;
+
+/**
+ * AddMyProductResponse is empty on success
+ *
+ * @generated from message stockchecker.v2.AddMyProductResponse
+ */
+export declare type AddMyProductResponse = Message<"stockchecker.v2.AddMyProductResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v2.AddMyProductResponse.
+ * Use `create(AddMyProductResponseSchema)` to create a new message.
+ */
+export declare const AddMyProductResponseSchema: GenMessage<AddMyProductResponse>;
+
+/**
+ * RemoveMyProductRequest removes a product from the user's list
+ *
+ * @generated from message stockchecker.v2.RemoveMyProductRequest
+ */
+export declare type RemoveMyProductRequest = Message<"stockchecker.v2.RemoveMyProductRequest"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * used when name is empty
+   *
+   * @generated from field: string sku = 2;
+   */
+  sku: string;
+
+  /**
+   * users/{user}/products/{product}
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyProductRequest.
+ * Use `create(RemoveMyProductRequestSchema)` to create a new message.
+ */
+export declare const RemoveMyProductRequestSchema: GenMessage<RemoveMyProductRequest>;
+
+/**
+ * RemoveMyProductResponse is empty on success
+ *
+ * @generated from message stockchecker.v2.RemoveMyProductResponse
+ */
+export declare type RemoveMyProductResponse = Message<"stockchecker.v2.RemoveMyProductResponse"> & {
+};
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyProductResponse.
+ * Use `create(RemoveMyProductResponseSchema)` to create a new message.
+ */
+export declare const RemoveMyProductResponseSchema: GenMessage<RemoveMyProductResponse>;
+
+/**
+ * RetailerInfo describes a retailer the server can search and check
+ *
+ * @generated from message stockchecker.v2.RetailerInfo
+ */
+export declare type RetailerInfo = Message<"stockchecker.v2.RetailerInfo"> & {
+  /**
+   * @generated from field: stockchecker.v2.Retailer retailer = 1;
+   */
+  retailer: Retailer;
+
+  /**
+   * served by offline mock data rather than the retailer's API
+   *
+   * @generated from field: bool mock = 2;
+   */
+  mock: boolean;
+
+  /**
+   * stores and products can be added to the user's lists (always true; kept for older clients)
+   *
+   * @generated from field: bool can_save = 3;
+   */
+  canSave: boolean;
+};
+
+/**
+ * Describes the message stockchecker.v2.RetailerInfo.
+ * Use `create(RetailerInfoSchema)` to create a new message.
+ */
+export declare const RetailerInfoSchema: GenMessage<RetailerInfo>;
+
+/**
+ * ListRetailersRequest is empty
+ *
+ * @generated from message stockchecker.v2.ListRetailersRequest
+ */
+export declare type ListRetailersRequest = Message<"stockchecker.v2.ListRetailersRequest"> & {
+};
+
+/**
+ * Describes the message stockchecker.v2.ListRetailersRequest.
+ * Use `create(ListRetailersRequestSchema)` to create a new message.
+ */
+export declare const ListRetailersRequestSchema: GenMessage<ListRetailersRequest>;
+
+/**
+ * ListRetailersResponse lists the available retailers
+ *
+ * @generated from message stockchecker.v2.ListRetailersResponse
+ */
+export declare type ListRetailersResponse = Message<"stockchecker.v2.ListRetailersResponse"> & {
+  /**
+   * @generated from field: repeated stockchecker.v2.RetailerInfo retailers = 1;
+   */
+  retailers: RetailerInfo[];
+};
+
+/**
+ * Describes the message stockchecker.v2.ListRetailersResponse.
+ * Use `create(ListRetailersResponseSchema)` to create a new message.
+ */
+export declare const ListRetailersResponseSchema: GenMessage<ListRetailersResponse>;
+
+/**
+ * Retailer identifies the retailer a store or product belongs to
+ *
+ * @generated from enum stockchecker.v2.Retailer
+ */
+export enum Retailer {
+  /**
+   * treated as RETAILER_BEST_BUY in requests
+   *
+   * @generated from enum value: RETAILER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: RETAILER_BEST_BUY = 1;
+   */
+  BEST_BUY = 1,
+
+  /**
+   * @generated from enum value: RETAILER_WALMART = 2;
+   */
+  WALMART = 2,
+
+  /**
+   * @generated from enum value: RETAILER_TARGET = 3;
+   */
+  TARGET = 3,
+
+  /**
+   * GameStop, or EB Games when the server is configured for Canada
+   *
+   * @generated from enum value: RETAILER_GAMESTOP = 4;
+   */
+  GAMESTOP = 4,
+
+  /**
+   * @generated from enum value: RETAILER_COSTCO = 5;
+   */
+  COSTCO = 5,
+}
+
+/**
+ * Describes the enum stockchecker.v2.Retailer.
+ */
+export declare const RetailerSchema: GenEnum<Retailer>;
+
+/**
+ * AvailabilityStatus is the stock level of a product at a store
+ *
+ * @generated from enum stockchecker.v2.AvailabilityStatus
+ */
+export enum AvailabilityStatus {
+  /**
+   * @generated from enum value: AVAILABILITY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AVAILABILITY_STATUS_IN_STOCK = 1;
+   */
+  IN_STOCK = 1,
+
+  /**
+   * @generated from enum value: AVAILABILITY_STATUS_LOW_STOCK = 2;
+   */
+  LOW_STOCK = 2,
+
+  /**
+   * @generated from enum value: AVAILABILITY_STATUS_OUT_OF_STOCK = 3;
+   */
+  OUT_OF_STOCK = 3,
+
+  /**
+   * the retailer won't report store stock for the product
+   *
+   * @generated from enum value: AVAILABILITY_STATUS_RESTRICTED = 4;
+   */
+  RESTRICTED = 4,
+}
+
+/**
+ * Describes the enum stockchecker.v2.AvailabilityStatus.
+ */
+export declare const AvailabilityStatusSchema: GenEnum<AvailabilityStatus>;
+
+/**
+ * StockCheckerService is the retailer-agnostic version of stockchecker.v1.StockCheckerService.
+ * Account, notification and admin RPCs remain on v1 during the migration.
+ *
+ * @generated from service stockchecker.v2.StockCheckerService
+ */
+export declare const StockCheckerService: GenService<{
+  /**
+   * ListRetailers returns the retailers the server has adapters for
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.ListRetailers
+   */
+  listRetailers: {
+    methodKind: "unary";
+    input: typeof ListRetailersRequestSchema;
+    output: typeof ListRetailersResponseSchema;
+  },
+  /**
+   * SearchStores searches for stores near a location
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.SearchStores
+   */
+  searchStores: {
+    methodKind: "unary";
+    input: typeof SearchStoresRequestSchema;
+    output: typeof SearchStoresResponseSchema;
+  },
+  /**
+   * SearchProducts searches for products by keyword or SKU
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.SearchProducts
+   */
+  searchProducts: {
+    methodKind: "unary";
+    input: typeof SearchProductsRequestSchema;
+    output: typeof SearchProductsResponseSchema;
+  },
+  /**
+   * CheckStock checks inventory for products near a postal code
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.CheckStock
+   */
+  checkStock: {
+    methodKind: "unary";
+    input: typeof CheckStockRequestSchema;
+    output: typeof CheckStockResponseSchema;
+  },
+  /**
+   * ListMyStores returns the user's saved stores
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.ListMyStores
+   */
+  listMyStores: {
+    methodKind: "unary";
+    input: typeof ListMyStoresRequestSchema;
+    output: typeof ListMyStoresResponseSchema;
+  },
+  /**
+   * AddMyStore adds a store to the user's list
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.AddMyStore
+   */
+  addMyStore: {
+    methodKind: "unary";
+    input: typeof AddMyStoreRequestSchema;
+    output: typeof AddMyStoreResponseSchema;
+  },
+  /**
+   * RemoveMyStore removes a store from the user's list
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.RemoveMyStore
+   */
+  removeMyStore: {
+    methodKind: "unary";
+    input: typeof RemoveMyStoreRequestSchema;
+    output: typeof RemoveMyStoreResponseSchema;
+  },
+  /**
+   * ListMyProducts returns the user's saved products
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.ListMyProducts
+   */
+  listMyProducts: {
+    methodKind: "unary";
+    input: typeof ListMyProductsRequestSchema;
+    output: typeof ListMyProductsResponseSchema;
+  },
+  /**
+   * AddMyProduct adds a product to the user's list
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.AddMyProduct
+   */
+  addMyProduct: {
+    methodKind: "unary";
+    input: typeof AddMyProductRequestSchema;
+    output: typeof AddMyProductResponseSchema;
+  },
+  /**
+   * RemoveMyProduct removes a product from the user's list
+   *
+   * @generated from rpc stockchecker.v2.StockCheckerService.RemoveMyProduct
+   */
+  removeMyProduct: {
+    methodKind: "unary";
+    input: typeof RemoveMyProductRequestSchema;
+    output: typeof RemoveMyProductResponseSchema;
+  },
+}>;
+
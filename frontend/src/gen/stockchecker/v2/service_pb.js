@@ -0,0 +1,225 @@
+// @generated by protoc-gen-es v2.10.2
+// @generated from file stockchecker/v2/service.proto (package stockchecker.v2, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv2";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+
+/**
+ * Describes the file stockchecker/v2/service.proto.
+ */
+export const file_stockchecker_v2_service = /*@__PURE__*/
+  fileDesc("Ch1zdG9ja2NoZWNrZXIvdjIvc2VydmljZS5wcm90bxIPc3RvY2tjaGVja2VyLnYyGh9nb29nbGUvcHJvdG9idWYvdGltZXN0YW1wLnByb3RvIjwKBU1vbmV5EhUKDWN1cnJlbmN5X2NvZGUYASABKAkSDQoFdW5pdHMYAiABKAMSDQoFbmFub3MYAyABKAUioAMKBVN0b3JlEisKCHJldGFpbGVyGAEgASgOMhkuc3RvY2tjaGVja2VyLnYyLlJldGFpbGVyEhAKCHN0b3JlX2lkGAIgASgJEhQKDGRpc3BsYXlfbmFtZRgDIAEoCRIPCgdhZGRyZXNzGAQgASgJEgwKBGNpdHkYBSABKAkSDQoFc3RhdGUYBiABKAkSEwoLcG9zdGFsX2NvZGUYByABKAkSDQoFcGhvbmUYCCABKAkSFgoOZGlzdGFuY2VfbWlsZXMYCSABKAESLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEbmFtZRgMIAEoCRIQCghvcGVuX25vdxgNIAEoCBITCgtob3Vyc190b2RheRgOIAEoCRIVCg1zcGVjaWFsX2hvdXJzGA8gASgIEiwKCG9wZW5zX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLbAgoHUHJvZHVjdBIrCghyZXRhaWxlchgBIAEoDjIZLnN0b2NrY2hlY2tlci52Mi5SZXRhaWxlchILCgNza3UYAiABKAkSFAoMZGlzcGxheV9uYW1lGAMgASgJEioKCnNhbGVfcHJpY2UYBCABKAsyFi5zdG9ja2NoZWNrZXIudjIuTW9uZXkSFQoNdGh1bWJuYWlsX3VybBgFIAEoCRITCgtwcm9kdWN0X3VybBgGIAEoCRIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIMCgRuYW1lGAkgASgJEjoKDW9ubGluZV9zdGF0dXMYCiABKA4yIy5zdG9ja2NoZWNrZXIudjIuQXZhaWxhYmlsaXR5U3RhdHVzIvIBCgtTdG9ja1N0YXR1cxIlCgVzdG9yZRgBIAEoCzIWLnN0b2NrY2hlY2tlci52Mi5TdG9yZRIpCgdwcm9kdWN0GAIgASgLMhguc3RvY2tjaGVja2VyLnYyLlByb2R1Y3QSMwoGc3RhdHVzGAMgASgOMiMuc3RvY2tjaGVja2VyLnYyLkF2YWlsYWJpbGl0eVN0YXR1cxIXCg9waWNrdXBfZWxpZ2libGUYBCABKAgSEwoLaXNfbXlfc3RvcmUYBSABKAgSLgoKY2hlY2tlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAilAEKE1NlYXJjaFN0b3Jlc1JlcXVlc3QSKwoIcmV0YWlsZXIYASABKA4yGS5zdG9ja2NoZWNrZXIudjIuUmV0YWlsZXISEwoLcG9zdGFsX2NvZGUYAiABKAkSFAoMcmFkaXVzX21pbGVzGAMgASgFEhEKCXBhZ2Vfc2l6ZRgEIAEoBRISCgpwYWdlX3Rva2VuGAUgASgJIlcKFFNlYXJjaFN0b3Jlc1Jlc3BvbnNlEiYKBnN0b3JlcxgBIAMoCzIWLnN0b2NrY2hlY2tlci52Mi5TdG9yZRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkijAEKFVNlYXJjaFByb2R1Y3RzUmVxdWVzdBIrCghyZXRhaWxlchgBIAEoDjIZLnN0b2NrY2hlY2tlci52Mi5SZXRhaWxlchINCgVxdWVyeRgCIAEoCRIQCghjYXRlZ29yeRgDIAEoCRIRCglwYWdlX3NpemUYBCABKAUSEgoKcGFnZV90b2tlbhgFIAEoCSJdChZTZWFyY2hQcm9kdWN0c1Jlc3BvbnNlEioKCHByb2R1Y3RzGAEgAygLMhguc3RvY2tjaGVja2VyLnYyLlByb2R1Y3QSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInYKEUNoZWNrU3RvY2tSZXF1ZXN0EisKCHJldGFpbGVyGAEgASgOMhkuc3RvY2tjaGVja2VyLnYyLlJldGFpbGVyEhEKCXN0b3JlX2lkcxgCIAMoCRIMCgRza3VzGAMgAygJEhMKC3Bvc3RhbF9jb2RlGAQgASgJIkMKEkNoZWNrU3RvY2tSZXNwb25zZRItCgdyZXN1bHRzGAEgAygLMhwuc3RvY2tjaGVja2VyLnYyLlN0b2NrU3RhdHVzInkKE0xpc3RNeVN0b3Jlc1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkSDgoGcGFyZW50GAMgASgJEisKCHJldGFpbGVyGAQgASgOMhkuc3RvY2tjaGVja2VyLnYyLlJldGFpbGVyIlcKFExpc3RNeVN0b3Jlc1Jlc3BvbnNlEiYKBnN0b3JlcxgBIAMoCzIWLnN0b2NrY2hlY2tlci52Mi5TdG9yZRIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkiOgoRQWRkTXlTdG9yZVJlcXVlc3QSJQoFc3RvcmUYASABKAsyFi5zdG9ja2NoZWNrZXIudjIuU3RvcmUiFAoSQWRkTXlTdG9yZVJlc3BvbnNlImMKFFJlbW92ZU15U3RvcmVSZXF1ZXN0EisKCHJldGFpbGVyGAEgASgOMhkuc3RvY2tjaGVja2VyLnYyLlJldGFpbGVyEhAKCHN0b3JlX2lkGAIgASgJEgwKBG5hbWUYAyABKAkiFwoVUmVtb3ZlTXlTdG9yZVJlc3BvbnNlInsKFUxpc3RNeVByb2R1Y3RzUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCRIOCgZwYXJlbnQYAyABKAkSKwoIcmV0YWlsZXIYBCABKA4yGS5zdG9ja2NoZWNrZXIudjIuUmV0YWlsZXIiXQoWTGlzdE15UHJvZHVjdHNSZXNwb25zZRIqCghwcm9kdWN0cxgBIAMoCzIYLnN0b2NrY2hlY2tlci52Mi5Qcm9kdWN0EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJAChNBZGRNeVByb2R1Y3RSZXF1ZXN0EikKB3Byb2R1Y3QYASABKAsyGC5zdG9ja2NoZWNrZXIudjIuUHJvZHVjdCIWChRBZGRNeVByb2R1Y3RSZXNwb25zZSJgChZSZW1vdmVNeVByb2R1Y3RSZXF1ZXN0EisKCHJldGFpbGVyGAEgASgOMhkuc3RvY2tjaGVja2VyLnYyLlJldGFpbGVyEgsKA3NrdRgCIAEoCRIMCgRuYW1lGAMgASgJIhkKF1JlbW92ZU15UHJvZHVjdFJlc3BvbnNlIlsKDFJldGFpbGVySW5mbxIrCghyZXRhaWxlchgBIAEoDjIZLnN0b2NrY2hlY2tlci52Mi5SZXRhaWxlchIMCgRtb2NrGAIgASgIEhAKCGNhbl9zYXZlGAMgASgIIhYKFExpc3RSZXRhaWxlcnNSZXF1ZXN0IkkKFUxpc3RSZXRhaWxlcnNSZXNwb25zZRIwCglyZXRhaWxlcnMYASADKAsyHS5zdG9ja2NoZWNrZXIudjIuUmV0YWlsZXJJbmZvKpIBCghSZXRhaWxlchIYChRSRVRBSUxFUl9VTlNQRUNJRklFRBAAEhUKEVJFVEFJTEVSX0JFU1RfQlVZEAESFAoQUkVUQUlMRVJfV0FMTUFSVBACEhMKD1JFVEFJTEVSX1RBUkdFVBADEhUKEVJFVEFJTEVSX0dBTUVTVE9QEAQSEwoPUkVUQUlMRVJfQ09TVENPEAUqyAEKEkF2YWlsYWJpbGl0eVN0YXR1cxIjCh9BVkFJTEFCSUxJVFlfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocQVZBSUxBQklMSVRZX1NUQVRVU19JTl9TVE9DSxABEiEKHUFWQUlMQUJJTElUWV9TVEFUVVNfTE9XX1NUT0NLEAISJAogQVZBSUxBQklMSVRZX1NUQVRVU19PVVRfT0ZfU1RPQ0sQAxIiCh5BVkFJTEFCSUxJVFlfU1RBVFVTX1JFU1RSSUNURUQQBDLkBwoTU3RvY2tDaGVja2VyU2VydmljZRJjCg1MaXN0UmV0YWlsZXJzEiUuc3RvY2tjaGVja2VyLnYyLkxpc3RSZXRhaWxlcnNSZXF1ZXN0GiYuc3RvY2tjaGVja2VyLnYyLkxpc3RSZXRhaWxlcnNSZXNwb25zZSIDkAIBEmAKDFNlYXJjaFN0b3JlcxIkLnN0b2NrY2hlY2tlci52Mi5TZWFyY2hTdG9yZXNSZXF1ZXN0GiUuc3RvY2tjaGVja2VyLnYyLlNlYXJjaFN0b3Jlc1Jlc3BvbnNlIgOQAgESZgoOU2VhcmNoUHJvZHVjdHMSJi5zdG9ja2NoZWNrZXIudjIuU2VhcmNoUHJvZHVjdHNSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYyLlNlYXJjaFByb2R1Y3RzUmVzcG9uc2UiA5ACARJaCgpDaGVja1N0b2NrEiIuc3RvY2tjaGVja2VyLnYyLkNoZWNrU3RvY2tSZXF1ZXN0GiMuc3RvY2tjaGVja2VyLnYyLkNoZWNrU3RvY2tSZXNwb25zZSIDkAIBEmAKDExpc3RNeVN0b3JlcxIkLnN0b2NrY2hlY2tlci52Mi5MaXN0TXlTdG9yZXNSZXF1ZXN0GiUuc3RvY2tjaGVja2VyLnYyLkxpc3RNeVN0b3Jlc1Jlc3BvbnNlIgOQAgESVQoKQWRkTXlTdG9yZRIiLnN0b2NrY2hlY2tlci52Mi5BZGRNeVN0b3JlUmVxdWVzdBojLnN0b2NrY2hlY2tlci52Mi5BZGRNeVN0b3JlUmVzcG9uc2USXgoNUmVtb3ZlTXlTdG9yZRIlLnN0b2NrY2hlY2tlci52Mi5SZW1vdmVNeVN0b3JlUmVxdWVzdBomLnN0b2NrY2hlY2tlci52Mi5SZW1vdmVNeVN0b3JlUmVzcG9uc2USZgoOTGlzdE15UHJvZHVjdHMSJi5zdG9ja2NoZWNrZXIudjIuTGlzdE15UHJvZHVjdHNSZXF1ZXN0Gicuc3RvY2tjaGVja2VyLnYyLkxpc3RNeVByb2R1Y3RzUmVzcG9uc2UiA5ACARJbCgxBZGRNeVByb2R1Y3QSJC5zdG9ja2NoZWNrZXIudjIuQWRkTXlQcm9kdWN0UmVxdWVzdBolLnN0b2NrY2hlY2tlci52Mi5BZGRNeVByb2R1Y3RSZXNwb25zZRJkCg9SZW1vdmVNeVByb2R1Y3QSJy5zdG9ja2NoZWNrZXIudjIuUmVtb3ZlTXlQcm9kdWN0UmVxdWVzdBooLnN0b2NrY2hlY2tlci52Mi5SZW1vdmVNeVByb2R1Y3RSZXNwb25zZULOAQoTY29tLnN0b2NrY2hlY2tlci52MkIMU2VydmljZVByb3RvUAFaTGdpdGh1Yi5jb20vdG1jYXVsZXkvc3RvY2stY2hlY2tlci9iYWNrZW5kL2dlbi9zdG9ja2NoZWNrZXIvdjI7c3RvY2tjaGVja2VydjKiAgNTWFiqAg9TdG9ja2NoZWNrZXIuVjLKAg9TdG9ja2NoZWNrZXJcVjLiAhtTdG9ja2NoZWNrZXJcVjJcR1BCTWV0YWRhdGHqAhBTdG9ja2NoZWNrZXI6OlYyYgZwcm90bzM", [file_google_protobuf_timestamp]);
+
+/**
+ * Describes the message stockchecker.v2.Money.
+ * Use `create(MoneySchema)` to create a new message.
+ */
+export const MoneySchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 0);
+
+/**
+ * Describes the message stockchecker.v2.Store.
+ * Use `create(StoreSchema)` to create a new message.
+ */
+export const StoreSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 1);
+
+/**
+ * Describes the message stockchecker.v2.Product.
+ * Use `create(ProductSchema)` to create a new message.
+ */
+export const ProductSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 2);
+
+/**
+ * Describes the message stockchecker.v2.StockStatus.
+ * Use `create(StockStatusSchema)` to create a new message.
+ */
+export const StockStatusSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 3);
+
+/**
+ * Describes the message stockchecker.v2.SearchStoresRequest.
+ * Use `create(SearchStoresRequestSchema)` to create a new message.
+ */
+export const SearchStoresRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 4);
+
+/**
+ * Describes the message stockchecker.v2.SearchStoresResponse.
+ * Use `create(SearchStoresResponseSchema)` to create a new message.
+ */
+export const SearchStoresResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 5);
+
+/**
+ * Describes the message stockchecker.v2.SearchProductsRequest.
+ * Use `create(SearchProductsRequestSchema)` to create a new message.
+ */
+export const SearchProductsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 6);
+
+/**
+ * Describes the message stockchecker.v2.SearchProductsResponse.
+ * Use `create(SearchProductsResponseSchema)` to create a new message.
+ */
+export const SearchProductsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 7);
+
+/**
+ * Describes the message stockchecker.v2.CheckStockRequest.
+ * Use `create(CheckStockRequestSchema)` to create a new message.
+ */
+export const CheckStockRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 8);
+
+/**
+ * Describes the message stockchecker.v2.CheckStockResponse.
+ * Use `create(CheckStockResponseSchema)` to create a new message.
+ */
+export const CheckStockResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 9);
+
+/**
+ * Describes the message stockchecker.v2.ListMyStoresRequest.
+ * Use `create(ListMyStoresRequestSchema)` to create a new message.
+ */
+export const ListMyStoresRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 10);
+
+/**
+ * Describes the message stockchecker.v2.ListMyStoresResponse.
+ * Use `create(ListMyStoresResponseSchema)` to create a new message.
+ */
+export const ListMyStoresResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 11);
+
+/**
+ * Describes the message stockchecker.v2.AddMyStoreRequest.
+ * Use `create(AddMyStoreRequestSchema)` to create a new message.
+ */
+export const AddMyStoreRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 12);
+
+/**
+ * Describes the message stockchecker.v2.AddMyStoreResponse.
+ * Use `create(AddMyStoreResponseSchema)` to create a new message.
+ */
+export const AddMyStoreResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 13);
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyStoreRequest.
+ * Use `create(RemoveMyStoreRequestSchema)` to create a new message.
+ */
+export const RemoveMyStoreRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 14);
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyStoreResponse.
+ * Use `create(RemoveMyStoreResponseSchema)` to create a new message.
+ */
+export const RemoveMyStoreResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 15);
+
+/**
+ * Describes the message stockchecker.v2.ListMyProductsRequest.
+ * Use `create(ListMyProductsRequestSchema)` to create a new message.
+ */
+export const ListMyProductsRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 16);
+
+/**
+ * Describes the message stockchecker.v2.ListMyProductsResponse.
+ * Use `create(ListMyProductsResponseSchema)` to create a new message.
+ */
+export const ListMyProductsResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 17);
+
+/**
+ * Describes the message stockchecker.v2.AddMyProductRequest.
+ * Use `create(AddMyProductRequestSchema)` to create a new message.
+ */
+export const AddMyProductRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 18);
+
+/**
+ * Describes the message stockchecker.v2.AddMyProductResponse.
+ * Use `create(AddMyProductResponseSchema)` to create a new message.
+ */
+export const AddMyProductResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 19);
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyProductRequest.
+ * Use `create(RemoveMyProductRequestSchema)` to create a new message.
+ */
+export const RemoveMyProductRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 20);
+
+/**
+ * Describes the message stockchecker.v2.RemoveMyProductResponse.
+ * Use `create(RemoveMyProductResponseSchema)` to create a new message.
+ */
+export const RemoveMyProductResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 21);
+
+/**
+ * Describes the message stockchecker.v2.RetailerInfo.
+ * Use `create(RetailerInfoSchema)` to create a new message.
+ */
+export const RetailerInfoSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 22);
+
+/**
+ * Describes the message stockchecker.v2.ListRetailersRequest.
+ * Use `create(ListRetailersRequestSchema)` to create a new message.
+ */
+export const ListRetailersRequestSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 23);
+
+/**
+ * Describes the message stockchecker.v2.ListRetailersResponse.
+ * Use `create(ListRetailersResponseSchema)` to create a new message.
+ */
+export const ListRetailersResponseSchema = /*@__PURE__*/
+  messageDesc(file_stockchecker_v2_service, 24);
+
+/**
+ * Describes the enum stockchecker.v2.Retailer.
+ */
+export const RetailerSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v2_service, 0);
+
+/**
+ * Retailer identifies the retailer a store or product belongs to
+ *
+ * @generated from enum stockchecker.v2.Retailer
+ */
+export const Retailer = /*@__PURE__*/
+  tsEnum(RetailerSchema);
+
+/**
+ * Describes the enum stockchecker.v2.AvailabilityStatus.
+ */
+export const AvailabilityStatusSchema = /*@__PURE__*/
+  enumDesc(file_stockchecker_v2_service, 1);
+
+/**
+ * AvailabilityStatus is the stock level of a product at a store
+ *
+ * @generated from enum stockchecker.v2.AvailabilityStatus
+ */
+export const AvailabilityStatus = /*@__PURE__*/
+  tsEnum(AvailabilityStatusSchema);
+
+/**
+ * StockCheckerService is the retailer-agnostic version of stockchecker.v1.StockCheckerService.
+ * Account, notification and admin RPCs remain on v1 during the migration.
+ *
+ * @generated from service stockchecker.v2.StockCheckerService
+ */
+export const StockCheckerService = /*@__PURE__*/
+  serviceDesc(file_stockchecker_v2_service, 0);
+
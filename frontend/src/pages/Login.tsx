@@ -1,7 +1,24 @@
+import { useState } from 'react'
+import type { FormEvent } from 'react'
 import { useAuth } from '../context/AuthContext'
 
 export function Login() {
-  const { login, isLoading } = useAuth()
+  const { login, requestLoginLink, isLoading, bootstrap } = useAuth()
+  const [email, setEmail] = useState('')
+  const [linkStatus, setLinkStatus] = useState<string | null>(null)
+
+  // Servers from before GetClientBootstrap only offered Google
+  const providers = bootstrap ? bootstrap.loginProviders : [{ id: 'google', name: 'Google' }]
+
+  const handleEmailLogin = async (e: FormEvent) => {
+    e.preventDefault()
+    try {
+      await requestLoginLink(email)
+      setLinkStatus(`If ${email} can sign in, a link is on its way. Check your email.`)
+    } catch (err) {
+      setLinkStatus(err instanceof Error ? err.message : 'Failed to send a sign-in link.')
+    }
+  }
 
   return (
     <div className="min-h-screen bg-gradient-to-br from-blue-600 to-blue-800 flex items-center justify-center px-4">
@@ -34,31 +51,57 @@ export function Login() {
         </div>
 
         <div className="space-y-4">
-          <button
-            onClick={login}
-            disabled={isLoading}
-            className="w-full flex items-center justify-center gap-3 px-6 py-3 bg-white border-2 border-gray-300 rounded-lg font-semibold text-gray-700 hover:bg-gray-50 hover:border-gray-400 transition-colors disabled:opacity-50 disabled:cursor-not-allowed"
-          >
-            <svg className="w-5 h-5" viewBox="0 0 24 24">
-              <path
-                fill="#4285F4"
-                d="M22.56 12.25c0-.78-.07-1.53-.2-2.25H12v4.26h5.92c-.26 1.37-1.04 2.53-2.21 3.31v2.77h3.57c2.08-1.92 3.28-4.74 3.28-8.09z"
-              />
-              <path
-                fill="#34A853"
-                d="M12 23c2.97 0 5.46-.98 7.28-2.66l-3.57-2.77c-.98.66-2.23 1.06-3.71 1.06-2.86 0-5.29-1.93-6.16-4.53H2.18v2.84C3.99 20.53 7.7 23 12 23z"
-              />
-              <path
-                fill="#FBBC05"
-                d="M5.84 14.09c-.22-.66-.35-1.36-.35-2.09s.13-1.43.35-2.09V7.07H2.18C1.43 8.55 1 10.22 1 12s.43 3.45 1.18 4.93l2.85-2.22.81-.62z"
-              />
-              <path
-                fill="#EA4335"
-                d="M12 5.38c1.62 0 3.06.56 4.21 1.64l3.15-3.15C17.45 2.09 14.97 1 12 1 7.7 1 3.99 3.47 2.18 7.07l3.66 2.84c.87-2.6 3.3-4.53 6.16-4.53z"
+          {providers.map((provider) => (
+            <button
+              key={provider.id}
+              onClick={() => login(provider.id)}
+              disabled={isLoading}
+              className="w-full flex items-center justify-center gap-3 px-6 py-3 bg-white border-2 border-gray-300 rounded-lg font-semibold text-gray-700 hover:bg-gray-50 hover:border-gray-400 transition-colors disabled:opacity-50 disabled:cursor-not-allowed"
+            >
+              {provider.id === 'google' && (
+                <svg className="w-5 h-5" viewBox="0 0 24 24">
+                  <path
+                    fill="#4285F4"
+                    d="M22.56 12.25c0-.78-.07-1.53-.2-2.25H12v4.26h5.92c-.26 1.37-1.04 2.53-2.21 3.31v2.77h3.57c2.08-1.92 3.28-4.74 3.28-8.09z"
+                  />
+                  <path
+                    fill="#34A853"
+                    d="M12 23c2.97 0 5.46-.98 7.28-2.66l-3.57-2.77c-.98.66-2.23 1.06-3.71 1.06-2.86 0-5.29-1.93-6.16-4.53H2.18v2.84C3.99 20.53 7.7 23 12 23z"
+                  />
+                  <path
+                    fill="#FBBC05"
+                    d="M5.84 14.09c-.22-.66-.35-1.36-.35-2.09s.13-1.43.35-2.09V7.07H2.18C1.43 8.55 1 10.22 1 12s.43 3.45 1.18 4.93l2.85-2.22.81-.62z"
+                  />
+                  <path
+                    fill="#EA4335"
+                    d="M12 5.38c1.62 0 3.06.56 4.21 1.64l3.15-3.15C17.45 2.09 14.97 1 12 1 7.7 1 3.99 3.47 2.18 7.07l3.66 2.84c.87-2.6 3.3-4.53 6.16-4.53z"
+                  />
+                </svg>
+              )}
+              {isLoading ? 'Loading...' : `Sign in with ${provider.name}`}
+            </button>
+          ))}
+
+          {bootstrap?.emailLogin && (
+            <form onSubmit={handleEmailLogin} className="space-y-2">
+              <input
+                type="email"
+                required
+                value={email}
+                onChange={(e) => setEmail(e.target.value)}
+                placeholder="you@example.com"
+                className="w-full px-4 py-3 border-2 border-gray-300 rounded-lg focus:outline-none focus:border-blue-500"
               />
-            </svg>
-            {isLoading ? 'Loading...' : 'Sign in with Google'}
-          </button>
+              <button
+                type="submit"
+                disabled={isLoading || !email}
+                className="w-full px-6 py-3 bg-blue-600 text-white rounded-lg font-semibold hover:bg-blue-700 transition-colors disabled:opacity-50 disabled:cursor-not-allowed"
+              >
+                Email me a sign-in link
+              </button>
+              {linkStatus && <p className="text-sm text-gray-600">{linkStatus}</p>}
+            </form>
+          )}
 
           <p className="text-sm text-gray-500">
             Access is restricted to invited users only
@@ -63,6 +63,12 @@ export function MyStores() {
                   {store.address}, {store.city}, {store.state} {store.postalCode}
                 </div>
                 <div className="text-gray-500 text-sm">{store.phone}</div>
+                {store.hoursToday && (
+                  <div className={`text-sm ${store.openNow ? 'text-green-700' : 'text-red-700'}`}>
+                    {store.openNow ? 'Open now' : 'Closed now'} •{' '}
+                    {store.specialHours ? 'Holiday hours' : 'Hours'} today: {store.hoursToday}
+                  </div>
+                )}
               </div>
               <button
                 onClick={() => removeStore(store.storeId)}
@@ -1,9 +1,9 @@
-import { useState } from 'react'
+import { useEffect, useState } from 'react'
 import { stockCheckerClient } from '../lib/api'
 import { useMyProducts } from '../context/MyProductsContext'
 import { useToast } from '../components/Toast'
 import { ProductCardSkeleton } from '../components/Skeleton'
-import type { Product } from '../gen/stockchecker/v1/service_pb.js'
+import type { Product, ProductPreset } from '../gen/stockchecker/v1/service_pb.js'
 
 // Category options for the dropdown
 const CATEGORIES = [
@@ -17,12 +17,29 @@ export function ProductSearch() {
   const [loading, setLoading] = useState(false)
   const [error, setError] = useState<string | null>(null)
   const [query, setQuery] = useState('')
-  const [category, setCategory] = useState('POKEMON CARDS') // Default to Pokemon
+  const [category, setCategory] = useState('POKEMON CARDS') // Until the server says what it tracks
+  const [domain, setDomain] = useState({ id: 'tcg', name: 'Pokemon TCG', searchCategory: 'POKEMON CARDS' })
+  const [presets, setPresets] = useState<ProductPreset[]>([])
   const [hasSearched, setHasSearched] = useState(false)
 
   const { addProduct, isProductInList } = useMyProducts()
   const { showToast } = useToast()
 
+  // Default the category to the kind of product this deployment tracks
+  useEffect(() => {
+    stockCheckerClient.getProductDomain({})
+      .then((d) => {
+        setDomain({ id: d.id, name: d.name, searchCategory: d.searchCategory })
+        setCategory(d.searchCategory)
+        setPresets(d.presets)
+      })
+      .catch((err) => console.error('Failed to load product domain:', err))
+  }, [])
+
+  const categories = CATEGORIES.some((c) => c.value === domain.searchCategory)
+    ? CATEGORIES
+    : [...CATEGORIES, { value: domain.searchCategory, label: domain.name }]
+
   const handleSearch = async () => {
     if (!query && !category) return
 
@@ -59,13 +76,13 @@ export function ProductSearch() {
       const response = await stockCheckerClient.browsePokemonProducts({})
       setProducts(response.products)
       if (response.products.length === 0) {
-        showToast('No Pokemon products found in Best Buy catalog', 'info')
+        showToast(`No ${domain.name} products found in Best Buy catalog`, 'info')
       } else {
-        showToast(`Found ${response.products.length} Pokemon products`, 'success')
+        showToast(`Found ${response.products.length} ${domain.name} products`, 'success')
       }
     } catch (err) {
-      console.error('Browse Pokemon error:', err)
-      const message = err instanceof Error ? err.message : 'Failed to browse Pokemon products'
+      console.error('Browse error:', err)
+      const message = err instanceof Error ? err.message : `Failed to browse ${domain.name} products`
       setError(message)
       setProducts([])
     } finally {
@@ -104,7 +121,7 @@ export function ProductSearch() {
             onChange={(e) => setCategory(e.target.value)}
             className="w-full sm:w-auto px-4 py-2 border border-gray-300 rounded-lg focus:outline-none focus:ring-2 focus:ring-yellow-500 text-base bg-white"
           >
-            {CATEGORIES.map((cat) => (
+            {categories.map((cat) => (
               <option key={cat.value} value={cat.value}>
                 {cat.label}
               </option>
@@ -147,22 +164,37 @@ export function ProductSearch() {
             disabled={loading}
             className="px-3 py-1 text-sm bg-purple-100 text-purple-700 rounded-full hover:bg-purple-200 transition-colors disabled:opacity-50"
           >
-            Browse All Pokemon
-          </button>
-          <button
-            onClick={() => { setQuery('prismatic evolutions'); }}
-            disabled={loading}
-            className="px-3 py-1 text-sm bg-blue-100 text-blue-700 rounded-full hover:bg-blue-200 transition-colors disabled:opacity-50"
-          >
-            Prismatic Evolutions
-          </button>
-          <button
-            onClick={() => { setQuery('surging sparks'); }}
-            disabled={loading}
-            className="px-3 py-1 text-sm bg-yellow-100 text-yellow-700 rounded-full hover:bg-yellow-200 transition-colors disabled:opacity-50"
-          >
-            Surging Sparks
+            Browse All {domain.id === 'tcg' ? 'Pokemon' : domain.name}
           </button>
+          {domain.id === 'tcg' && (
+            <>
+              <button
+                onClick={() => { setQuery('prismatic evolutions'); }}
+                disabled={loading}
+                className="px-3 py-1 text-sm bg-blue-100 text-blue-700 rounded-full hover:bg-blue-200 transition-colors disabled:opacity-50"
+              >
+                Prismatic Evolutions
+              </button>
+              <button
+                onClick={() => { setQuery('surging sparks'); }}
+                disabled={loading}
+                className="px-3 py-1 text-sm bg-yellow-100 text-yellow-700 rounded-full hover:bg-yellow-200 transition-colors disabled:opacity-50"
+              >
+                Surging Sparks
+              </button>
+            </>
+          )}
+          {presets.map((p) => (
+            <button
+              key={p.sku}
+              onClick={() => { setQuery(p.name); }}
+              disabled={loading}
+              title={`Alerts start capped at MSRP ($${(Number(p.msrpCents) / 100).toFixed(2)})`}
+              className="px-3 py-1 text-sm bg-blue-100 text-blue-700 rounded-full hover:bg-blue-200 transition-colors disabled:opacity-50"
+            >
+              {p.name}
+            </button>
+          ))}
         </div>
       </div>
 
@@ -129,6 +129,12 @@ export function StoreSearch() {
                     <div className="text-gray-500 text-sm">
                       {store.phone} • {store.distanceMiles.toFixed(1)} miles away
                     </div>
+                    {store.hoursToday && (
+                      <div className={`text-sm ${store.openNow ? 'text-green-700' : 'text-red-700'}`}>
+                        {store.openNow ? 'Open now' : 'Closed now'} •{' '}
+                        {store.specialHours ? 'Holiday hours' : 'Hours'} today: {store.hoursToday}
+                      </div>
+                    )}
                   </div>
                   <button
                     onClick={() => handleAddStore(store)}